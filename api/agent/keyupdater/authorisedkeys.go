@@ -78,3 +78,41 @@ func (c *Client) WatchAuthorisedKeys(ctx context.Context, tag names.MachineTag)
 	w := apiwatcher.NewNotifyWatcher(c.facade.RawAPICaller(), result)
 	return w, nil
 }
+
+// SSHCertificateAuthority describes the SSH certificate authority that a
+// machine trusts to sign user certificates.
+type SSHCertificateAuthority struct {
+	// PublicKeys are the public keys of the certificate authority.
+	PublicKeys []string
+	// Principals are the principals accepted in the user certificates, for
+	// logging in as the machine's default user.
+	Principals []string
+}
+
+// SSHCertificateAuthority returns the SSH certificate authority that the
+// machine specified by machineTag should trust. A [errors.NotImplemented]
+// error is returned if the controller doesn't issue SSH certificates.
+func (c *Client) SSHCertificateAuthority(ctx context.Context, tag names.MachineTag) (SSHCertificateAuthority, error) {
+	if c.facade.BestAPIVersion() < 2 {
+		return SSHCertificateAuthority{}, errors.NotImplementedf("ssh certificate authority")
+	}
+	var results params.SSHCertificateAuthorityResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: tag.String()}},
+	}
+	err := c.facade.FacadeCall(ctx, "SSHCertificateAuthority", args, &results)
+	if err != nil {
+		return SSHCertificateAuthority{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return SSHCertificateAuthority{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if err := result.Error; err != nil {
+		return SSHCertificateAuthority{}, err
+	}
+	return SSHCertificateAuthority{
+		PublicKeys: result.PublicKeys,
+		Principals: result.Principals,
+	}, nil
+}
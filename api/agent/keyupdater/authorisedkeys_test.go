@@ -6,6 +6,7 @@ package keyupdater_test
 import (
 	stdtesting "testing"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
@@ -68,3 +69,43 @@ func (s *keyupdaterSuite) TestWatchAuthorisedKeys(c *tc.C) {
 	_, err := client.WatchAuthorisedKeys(c.Context(), tag)
 	c.Assert(err, tc.ErrorMatches, "FAIL")
 }
+
+func (s *keyupdaterSuite) TestSSHCertificateAuthority(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, tc.Equals, "KeyUpdater")
+		c.Check(version, tc.Equals, 2)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "SSHCertificateAuthority")
+		c.Check(arg, tc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "machine-666"}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.SSHCertificateAuthorityResults{})
+		*(result.(*params.SSHCertificateAuthorityResults)) = params.SSHCertificateAuthorityResults{
+			Results: []params.SSHCertificateAuthorityResult{{
+				PublicKeys: []string{"ssh-ed25519 AAAA"},
+				Principals: []string{"juju-admin@deadbeef"},
+			}},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	tag := names.NewMachineTag("666")
+	client := keyupdater.NewClient(caller)
+	ca, err := client.SSHCertificateAuthority(c.Context(), tag)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ca, tc.DeepEquals, keyupdater.SSHCertificateAuthority{
+		PublicKeys: []string{"ssh-ed25519 AAAA"},
+		Principals: []string{"juju-admin@deadbeef"},
+	})
+}
+
+func (s *keyupdaterSuite) TestSSHCertificateAuthorityNotImplemented(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected api call %q", request)
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 1}
+	client := keyupdater.NewClient(caller)
+	_, err := client.SSHCertificateAuthority(c.Context(), names.NewMachineTag("666"))
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
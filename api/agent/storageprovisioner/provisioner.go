@@ -103,6 +103,100 @@ func (st *Client) WatchFilesystems(ctx context.Context, scope names.Tag) (watche
 	return st.watchStorageEntities(ctx, "WatchFilesystems", scope)
 }
 
+// WatchVolumeResizes watches for changes to the volumes scoped to the
+// specified machine, so that requests to resize them can be observed.
+func (st *Client) WatchVolumeResizes(ctx context.Context, scope names.MachineTag) (watcher.StringsWatcher, error) {
+	if st.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("watching volume resizes on this version of Juju")
+	}
+	return st.watchStorageEntities(ctx, "WatchVolumeResizes", scope)
+}
+
+// WatchStorageSnapshots watches for changes to the snapshots of the
+// volumes scoped to the specified machine.
+func (st *Client) WatchStorageSnapshots(ctx context.Context, m names.MachineTag) (watcher.NotifyWatcher, error) {
+	if st.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("watching storage snapshots on this version of Juju")
+	}
+	var results params.NotifyWatchResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: m.String()}},
+	}
+	err := st.facade.FacadeCall(ctx, "WatchStorageSnapshots", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	w := apiwatcher.NewNotifyWatcher(st.facade.RawAPICaller(), result)
+	return w, nil
+}
+
+// MachineStorageSnapshots returns the snapshots of the volumes scoped to
+// the specified machine.
+func (st *Client) MachineStorageSnapshots(ctx context.Context, m names.MachineTag) ([]params.MachineStorageSnapshot, error) {
+	if st.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("storage snapshots on this version of Juju")
+	}
+	var results params.MachineStorageSnapshotsResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: m.String()}},
+	}
+	err := st.facade.FacadeCall(ctx, "MachineStorageSnapshots", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Result, nil
+}
+
+// SetStorageSnapshotInfo records the outcome of taking machine-scoped
+// storage snapshots.
+func (st *Client) SetStorageSnapshotInfo(ctx context.Context, snapshots []params.StorageSnapshotInfo) ([]params.ErrorResult, error) {
+	if st.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("storage snapshots on this version of Juju")
+	}
+	args := params.StorageSnapshotInfos{Snapshots: snapshots}
+	var results params.ErrorResults
+	err := st.facade.FacadeCall(ctx, "SetStorageSnapshotInfo", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(snapshots) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(snapshots), len(results.Results))
+	}
+	return results.Results, nil
+}
+
+// RemoveStorageSnapshots removes the records of the specified dying
+// machine-scoped storage snapshots.
+func (st *Client) RemoveStorageSnapshots(ctx context.Context, uuids []string) ([]params.ErrorResult, error) {
+	if st.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("storage snapshots on this version of Juju")
+	}
+	args := params.RemoveStorageSnapshots{Snapshots: uuids}
+	var results params.ErrorResults
+	err := st.facade.FacadeCall(ctx, "RemoveStorageSnapshots", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(uuids) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(uuids), len(results.Results))
+	}
+	return results.Results, nil
+}
+
 func (st *Client) watchStorageEntities(ctx context.Context, method string, scope names.Tag) (watcher.StringsWatcher, error) {
 	var results params.StringsWatchResults
 	args := params.Entities{
@@ -73,6 +73,144 @@ func (s *provisionerSuite) TestWatchVolumes(c *tc.C) {
 	c.Check(callCount, tc.Equals, 1)
 }
 
+func (s *provisionerSuite) TestWatchVolumeResizes(c *tc.C) {
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, tc.Equals, "StorageProvisioner")
+		c.Check(version, tc.Equals, 5)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "WatchVolumeResizes")
+		c.Check(arg, tc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "machine-123"}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.StringsWatchResults{})
+		*(result.(*params.StringsWatchResults)) = params.StringsWatchResults{
+			Results: []params.StringsWatchResult{{
+				Error: &params.Error{Message: "FAIL"},
+			}},
+		}
+		callCount++
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 5})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.WatchVolumeResizes(c.Context(), names.NewMachineTag("123"))
+	c.Check(err, tc.ErrorMatches, "FAIL")
+	c.Check(callCount, tc.Equals, 1)
+}
+
+func (s *provisionerSuite) TestWatchVolumeResizesNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected call to %s", request)
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 4})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.WatchVolumeResizes(c.Context(), names.NewMachineTag("123"))
+	c.Check(err, tc.ErrorMatches, "watching volume resizes on this version of Juju not supported")
+}
+
+func (s *provisionerSuite) TestMachineStorageSnapshots(c *tc.C) {
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, tc.Equals, "StorageProvisioner")
+		c.Check(version, tc.Equals, 5)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "MachineStorageSnapshots")
+		c.Check(arg, tc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "machine-123"}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.MachineStorageSnapshotsResults{})
+		*(result.(*params.MachineStorageSnapshotsResults)) = params.MachineStorageSnapshotsResults{
+			Results: []params.MachineStorageSnapshotsResult{{
+				Result: []params.MachineStorageSnapshot{{
+					UUID: "deadbeef", VolumeTag: "volume-123-0", VolumeId: "loop-0", Provider: "loop",
+				}},
+			}},
+		}
+		callCount++
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 5})
+	c.Assert(err, tc.ErrorIsNil)
+	snapshots, err := st.MachineStorageSnapshots(c.Context(), names.NewMachineTag("123"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(callCount, tc.Equals, 1)
+	c.Check(snapshots, tc.DeepEquals, []params.MachineStorageSnapshot{{
+		UUID: "deadbeef", VolumeTag: "volume-123-0", VolumeId: "loop-0", Provider: "loop",
+	}})
+}
+
+func (s *provisionerSuite) TestSetStorageSnapshotInfo(c *tc.C) {
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, tc.Equals, "StorageProvisioner")
+		c.Check(version, tc.Equals, 5)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "SetStorageSnapshotInfo")
+		c.Check(arg, tc.DeepEquals, params.StorageSnapshotInfos{
+			Snapshots: []params.StorageSnapshotInfo{{UUID: "deadbeef", ProviderId: "snap-0", Size: 1024}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{}},
+		}
+		callCount++
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 5})
+	c.Assert(err, tc.ErrorIsNil)
+	results, err := st.SetStorageSnapshotInfo(c.Context(), []params.StorageSnapshotInfo{
+		{UUID: "deadbeef", ProviderId: "snap-0", Size: 1024},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(callCount, tc.Equals, 1)
+	c.Check(results, tc.DeepEquals, []params.ErrorResult{{}})
+}
+
+func (s *provisionerSuite) TestRemoveStorageSnapshots(c *tc.C) {
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, tc.Equals, "StorageProvisioner")
+		c.Check(version, tc.Equals, 5)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "RemoveStorageSnapshots")
+		c.Check(arg, tc.DeepEquals, params.RemoveStorageSnapshots{Snapshots: []string{"deadbeef"}})
+		c.Assert(result, tc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "FAIL"}}},
+		}
+		callCount++
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 5})
+	c.Assert(err, tc.ErrorIsNil)
+	results, err := st.RemoveStorageSnapshots(c.Context(), []string{"deadbeef"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(callCount, tc.Equals, 1)
+	c.Assert(results, tc.HasLen, 1)
+	c.Check(results[0].Error, tc.ErrorMatches, "FAIL")
+}
+
+func (s *provisionerSuite) TestStorageSnapshotsNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected call to %s", request)
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 4})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.WatchStorageSnapshots(c.Context(), names.NewMachineTag("123"))
+	c.Check(err, tc.ErrorMatches, "watching storage snapshots on this version of Juju not supported")
+	_, err = st.MachineStorageSnapshots(c.Context(), names.NewMachineTag("123"))
+	c.Check(err, tc.ErrorMatches, "storage snapshots on this version of Juju not supported")
+}
+
 func (s *provisionerSuite) TestWatchFilesystems(c *tc.C) {
 	var callCount int
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
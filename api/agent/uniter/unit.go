@@ -274,6 +274,91 @@ func (u *Unit) ConfigSettings(ctx context.Context) (charm.Settings, error) {
 	return charm.Settings(result.Settings), nil
 }
 
+// HookTimeout returns the maximum duration the unit may spend running a single
+// hook. A zero timeout means that hooks run without a deadline.
+func (u *Unit) HookTimeout(ctx context.Context) (time.Duration, error) {
+	if u.client.BestAPIVersion() < 22 {
+		// HookTimeouts() was introduced in UniterAPIV22.
+		return 0, errors.NotImplementedf("HookTimeout() (need V22+)")
+	}
+	var results params.HookTimeoutResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: u.tag.String()}},
+	}
+	err := u.client.facade.FacadeCall(ctx, "HookTimeouts", args, &results)
+	if err != nil {
+		return 0, errors.Trace(apiservererrors.RestoreError(err))
+	}
+	if len(results.Results) != 1 {
+		return 0, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.Timeout, nil
+}
+
+// HookSchedules returns the named schedules on which the unit runs the
+// scheduled hook, ordered by name. Any override of the schedules declared by
+// the charm is taken into account.
+func (u *Unit) HookSchedules(ctx context.Context) ([]charm.Schedule, error) {
+	if u.client.BestAPIVersion() < 23 {
+		// HookSchedules() was introduced in UniterAPIV23.
+		return nil, errors.NotImplementedf("HookSchedules() (need V23+)")
+	}
+	var results params.HookSchedulesResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: u.tag.String()}},
+	}
+	err := u.client.facade.FacadeCall(ctx, "HookSchedules", args, &results)
+	if err != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(err))
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	schedules := make([]charm.Schedule, len(result.Schedules))
+	for i, s := range result.Schedules {
+		schedules[i] = charm.Schedule{
+			Name:   s.Name,
+			Cron:   s.Cron,
+			Jitter: s.Jitter,
+		}
+	}
+	return schedules, nil
+}
+
+// WatchHookSchedules returns a watcher for observing changes to the
+// operator's overrides of the unit's hook schedules.
+func (u *Unit) WatchHookSchedules(ctx context.Context) (watcher.NotifyWatcher, error) {
+	if u.client.BestAPIVersion() < 23 {
+		// WatchHookSchedules() was introduced in UniterAPIV23.
+		return nil, errors.NotImplementedf("WatchHookSchedules() (need V23+)")
+	}
+	var results params.NotifyWatchResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: u.tag.String()}},
+	}
+	err := u.client.facade.FacadeCall(ctx, "WatchHookSchedules", args, &results)
+	if err != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(err))
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	w := apiwatcher.NewNotifyWatcher(u.client.facade.RawAPICaller(), result)
+	return w, nil
+}
+
 // ApplicationName returns the application name.
 func (u *Unit) ApplicationName() string {
 	application, err := names.UnitApplication(u.Name())
@@ -820,6 +820,124 @@ func (s *unitSuite) TestConfigSettingsNotImplemented(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
 }
 
+func (s *unitSuite) TestHookTimeout(c *tc.C) {
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Assert(objType, tc.Equals, "Uniter")
+		c.Assert(request, tc.Equals, "HookTimeouts")
+		c.Assert(arg, tc.DeepEquals, params.Entities{Entities: []params.Entity{{Tag: "unit-mysql-0"}}})
+		c.Assert(result, tc.FitsTypeOf, &params.HookTimeoutResults{})
+		*(result.(*params.HookTimeoutResults)) = params.HookTimeoutResults{
+			Results: []params.HookTimeoutResult{{
+				Timeout: 10 * time.Minute,
+			}},
+		}
+		return nil
+	})
+	caller := basetesting.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 22}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+
+	unit := uniter.CreateUnit(client, names.NewUnitTag("mysql/0"))
+	timeout, err := unit.HookTimeout(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(timeout, tc.Equals, 10*time.Minute)
+}
+
+func (s *unitSuite) TestHookTimeoutNotImplemented(c *tc.C) {
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected api call %q", request)
+		return nil
+	})
+	caller := basetesting.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 21}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+
+	unit := uniter.CreateUnit(client, names.NewUnitTag("mysql/0"))
+	_, err := unit.HookTimeout(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
+
+func (s *unitSuite) TestHookSchedules(c *tc.C) {
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Assert(objType, tc.Equals, "Uniter")
+		c.Assert(request, tc.Equals, "HookSchedules")
+		c.Assert(arg, tc.DeepEquals, params.Entities{Entities: []params.Entity{{Tag: "unit-mysql-0"}}})
+		c.Assert(result, tc.FitsTypeOf, &params.HookSchedulesResults{})
+		*(result.(*params.HookSchedulesResults)) = params.HookSchedulesResults{
+			Results: []params.HookSchedulesResult{{
+				Schedules: []params.HookSchedule{{
+					Name:   "nightly-backup",
+					Cron:   "0 3 * * *",
+					Jitter: 10 * time.Minute,
+				}},
+			}},
+		}
+		return nil
+	})
+	caller := basetesting.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 23}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+
+	unit := uniter.CreateUnit(client, names.NewUnitTag("mysql/0"))
+	schedules, err := unit.HookSchedules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(schedules, tc.DeepEquals, []charm.Schedule{{
+		Name:   "nightly-backup",
+		Cron:   "0 3 * * *",
+		Jitter: 10 * time.Minute,
+	}})
+}
+
+func (s *unitSuite) TestHookSchedulesNotImplemented(c *tc.C) {
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected api call %q", request)
+		return nil
+	})
+	caller := basetesting.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 22}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+
+	unit := uniter.CreateUnit(client, names.NewUnitTag("mysql/0"))
+	_, err := unit.HookSchedules(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+
+	_, err = unit.WatchHookSchedules(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
+
+func (s *unitSuite) TestWatchHookSchedules(c *tc.C) {
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		if objType == "NotifyWatcher" {
+			if request != "Next" && request != "Stop" {
+				c.Fatalf("unexpected watcher request %q", request)
+			}
+			return nil
+		}
+		c.Assert(objType, tc.Equals, "Uniter")
+		c.Assert(request, tc.Equals, "WatchHookSchedules")
+		c.Assert(arg, tc.DeepEquals, params.Entities{Entities: []params.Entity{{Tag: "unit-mysql-0"}}})
+		c.Assert(result, tc.FitsTypeOf, &params.NotifyWatchResults{})
+		*(result.(*params.NotifyWatchResults)) = params.NotifyWatchResults{
+			Results: []params.NotifyWatchResult{{
+				NotifyWatcherId: "1",
+			}},
+		}
+		return nil
+	})
+	caller := basetesting.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 23}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+
+	unit := uniter.CreateUnit(client, names.NewUnitTag("mysql/0"))
+	w, err := unit.WatchHookSchedules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	wc := watchertest.NewNotifyWatcherC(c, w)
+	defer wc.AssertStops()
+
+	// Initial event.
+	select {
+	case _, ok := <-w.Changes():
+		c.Assert(ok, tc.IsTrue)
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("watcher did not send change")
+	}
+}
+
 func (s *unitSuite) TestWatchConfigSettingsHash(c *tc.C) {
 	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
 		if objType == "StringsWatcher" {
@@ -7,6 +7,8 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -898,6 +900,106 @@ func (c *Client) MergeBindings(ctx context.Context, req params.ApplicationMergeB
 	return results.OneError()
 }
 
+// HookSchedule describes a hook schedule declared by the charm of an
+// application. Cron and Jitter are in effect for the application, and
+// differ from CharmCron and CharmJitter when overridden by an operator.
+type HookSchedule struct {
+	Name        string
+	Cron        string
+	Jitter      time.Duration
+	CharmCron   string
+	CharmJitter time.Duration
+}
+
+// HookScheduleOverride holds an override for a hook schedule. A nil Cron or
+// Jitter leaves that part of the schedule unchanged.
+type HookScheduleOverride struct {
+	Cron   *string
+	Jitter *time.Duration
+}
+
+// HookSchedules returns the hook schedules declared by the charm of the
+// application, ordered by name.
+func (c *Client) HookSchedules(ctx context.Context, application string) ([]HookSchedule, error) {
+	if c.facade.BestAPIVersion() < 21 {
+		return nil, errors.NotImplementedf("hook schedules")
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag(application).String()}},
+	}
+	var results params.ApplicationHookSchedulesResults
+	err := c.facade.FacadeCall(ctx, "HookSchedules", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, params.TranslateWellKnownError(result.Error)
+	}
+	schedules := make([]HookSchedule, len(result.Schedules))
+	for i, s := range result.Schedules {
+		schedules[i] = HookSchedule{
+			Name:        s.Name,
+			Cron:        s.Cron,
+			Jitter:      s.Jitter,
+			CharmCron:   s.CharmCron,
+			CharmJitter: s.CharmJitter,
+		}
+	}
+	return schedules, nil
+}
+
+// SetHookSchedules overrides the cron expression or jitter of the named hook
+// schedules of the application.
+func (c *Client) SetHookSchedules(ctx context.Context, application string, overrides map[string]HookScheduleOverride) error {
+	if c.facade.BestAPIVersion() < 21 {
+		return errors.NotImplementedf("hook schedules")
+	}
+	schedules := make([]params.HookScheduleOverride, 0, len(overrides))
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		schedules = append(schedules, params.HookScheduleOverride{
+			Name:   name,
+			Cron:   overrides[name].Cron,
+			Jitter: overrides[name].Jitter,
+		})
+	}
+	args := params.SetApplicationHookSchedulesArgs{
+		Args: []params.SetApplicationHookSchedules{{
+			ApplicationTag: names.NewApplicationTag(application).String(),
+			Schedules:      schedules,
+		}},
+	}
+	var results params.ErrorResults
+	err := c.facade.FacadeCall(ctx, "SetHookSchedules", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// UnsetHookSchedules resets the named hook schedules of the application to
+// those declared by its charm.
+func (c *Client) UnsetHookSchedules(ctx context.Context, application string, scheduleNames []string) error {
+	if c.facade.BestAPIVersion() < 21 {
+		return errors.NotImplementedf("hook schedules")
+	}
+	args := params.UnsetApplicationHookSchedulesArgs{
+		Args: []params.UnsetApplicationHookSchedules{{
+			ApplicationTag: names.NewApplicationTag(application).String(),
+			Names:          scheduleNames,
+		}},
+	}
+	var results params.ErrorResults
+	err := c.facade.FacadeCall(ctx, "UnsetHookSchedules", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // UnitInfo holds information about a unit.
 type UnitInfo struct {
 	Error error
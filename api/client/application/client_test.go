@@ -841,6 +841,134 @@ func (s *applicationSuite) TestSetConfig(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "FAIL")
 }
 
+func (s *applicationSuite) TestHookSchedules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: "application-foo"}},
+	}
+	result := new(params.ApplicationHookSchedulesResults)
+	results := params.ApplicationHookSchedulesResults{
+		Results: []params.ApplicationHookSchedulesResult{{
+			Schedules: []params.ApplicationHookSchedule{{
+				Name:        "nightly-backup",
+				Cron:        "30 1 * * *",
+				Jitter:      time.Minute,
+				CharmCron:   "0 3 * * *",
+				CharmJitter: 10 * time.Minute,
+			}},
+		}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "HookSchedules", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	schedules, err := client.HookSchedules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(schedules, tc.DeepEquals, []application.HookSchedule{{
+		Name:        "nightly-backup",
+		Cron:        "30 1 * * *",
+		Jitter:      time.Minute,
+		CharmCron:   "0 3 * * *",
+		CharmJitter: 10 * time.Minute,
+	}})
+}
+
+func (s *applicationSuite) TestHookSchedulesError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	result := new(params.ApplicationHookSchedulesResults)
+	results := params.ApplicationHookSchedulesResults{
+		Results: []params.ApplicationHookSchedulesResult{{
+			Error: &params.Error{Code: params.CodeNotFound, Message: "application foo not found"},
+		}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "HookSchedules", gomock.Any(), result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.HookSchedules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *applicationSuite) TestHookSchedulesNotImplemented(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(20).Times(3)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.HookSchedules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+	err = client.SetHookSchedules(c.Context(), "foo", nil)
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+	err = client.UnsetHookSchedules(c.Context(), "foo", nil)
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
+
+func (s *applicationSuite) TestSetHookSchedules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	cron := "30 1 * * *"
+	jitter := time.Hour
+	args := params.SetApplicationHookSchedulesArgs{
+		Args: []params.SetApplicationHookSchedules{{
+			ApplicationTag: "application-foo",
+			Schedules: []params.HookScheduleOverride{
+				{Name: "hourly-report", Jitter: &jitter},
+				{Name: "nightly-backup", Cron: &cron},
+			},
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{
+			{Error: &params.Error{Message: "FAIL"}},
+		},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SetHookSchedules", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	err := client.SetHookSchedules(c.Context(), "foo", map[string]application.HookScheduleOverride{
+		"nightly-backup": {Cron: &cron},
+		"hourly-report":  {Jitter: &jitter},
+	})
+	c.Assert(err, tc.ErrorMatches, "FAIL")
+}
+
+func (s *applicationSuite) TestUnsetHookSchedules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.UnsetApplicationHookSchedulesArgs{
+		Args: []params.UnsetApplicationHookSchedules{{
+			ApplicationTag: "application-foo",
+			Names:          []string{"nightly-backup"},
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{
+			{Error: &params.Error{Message: "FAIL"}},
+		},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "UnsetHookSchedules", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	err := client.UnsetHookSchedules(c.Context(), "foo", []string{"nightly-backup"})
+	c.Assert(err, tc.ErrorMatches, "FAIL")
+}
+
 func (s *applicationSuite) TestUnsetApplicationConfig(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -0,0 +1,48 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the audit log API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the audit log API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "AuditLog", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// Query returns the audit log entries matching the given arguments that
+// were recorded by the controller the client is connected to, along with
+// the ID of that controller. If more entries matched than the controller
+// returns in a single query, only the most recent are returned and truncated
+// is true.
+func (c *Client) Query(ctx context.Context, args params.AuditLogQueryArgs) (controllerID string, entries []params.AuditLogEntry, truncated bool, err error) {
+	var result params.AuditLogQueryResult
+	if err := c.facade.FacadeCall(ctx, "Query", args, &result); err != nil {
+		return "", nil, false, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return result.ControllerID, nil, false, result.Error
+	}
+	return result.ControllerID, result.Entries, result.Truncated, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/auditlog"
+	"github.com/juju/juju/rpc/params"
+)
+
+type auditLogMockSuite struct{}
+
+func TestAuditLogMockSuite(t *testing.T) {
+	tc.Run(t, &auditLogMockSuite{})
+}
+
+func (s *auditLogMockSuite) TestQuery(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	args := params.AuditLogQueryArgs{
+		User:  "admin",
+		From:  &from,
+		Limit: 5,
+	}
+	entries := []params.AuditLogEntry{{
+		ConversationID: "0123456789abcdef",
+		RequestID:      1,
+		When:           from.Add(time.Minute),
+		User:           "admin",
+		Facade:         "Application",
+		Method:         "Deploy",
+		Version:        20,
+	}}
+	result := new(params.AuditLogQueryResult)
+	results := params.AuditLogQueryResult{
+		ControllerID: "0",
+		Entries:      entries,
+		Truncated:    true,
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "Query", args, result).SetArg(3, results).Return(nil)
+
+	client := auditlog.NewClientFromCaller(mockFacadeCaller)
+	controllerID, obtained, truncated, err := client.Query(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(controllerID, tc.Equals, "0")
+	c.Check(obtained, tc.DeepEquals, entries)
+	c.Check(truncated, tc.IsTrue)
+}
+
+func (s *auditLogMockSuite) TestQueryError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	result := new(params.AuditLogQueryResult)
+	results := params.AuditLogQueryResult{
+		ControllerID: "1",
+		Error:        &params.Error{Message: "permission denied", Code: params.CodeUnauthorized},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "Query", params.AuditLogQueryArgs{}, result).SetArg(3, results).Return(nil)
+
+	client := auditlog.NewClientFromCaller(mockFacadeCaller)
+	controllerID, _, _, err := client.Query(c.Context(), params.AuditLogQueryArgs{})
+	c.Check(controllerID, tc.Equals, "1")
+	c.Assert(err, tc.Satisfies, params.IsCodeUnauthorized)
+}
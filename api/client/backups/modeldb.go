@@ -0,0 +1,61 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/juju/errors"
+	"gopkg.in/httprequest.v1"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type exportModelDBParams struct {
+	httprequest.Route `httprequest:"GET /db-snapshot"`
+}
+
+// ExportModelDB returns an io.ReadCloser for a snapshot of the database of
+// the model that the client is connected to, along with the objects that
+// the database references.
+func (c *Client) ExportModelDB(ctx context.Context) (io.ReadCloser, error) {
+	httpClient, err := c.st.HTTPClient()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var resp *http.Response
+	if err := httpClient.Call(ctx, &exportModelDBParams{}, &resp); err != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return resp.Body, nil
+}
+
+// ImportModelDB replaces the contents of the database of the model that the
+// client is connected to with the snapshot read from the reader. The model
+// must not have any applications or machines.
+func (c *Client) ImportModelDB(ctx context.Context, r io.Reader) error {
+	req, err := http.NewRequest("PUT", "/db-snapshot", r)
+	if err != nil {
+		return errors.Annotate(err, "cannot create import request")
+	}
+	req.Header.Set("Content-Type", params.ContentTypeRaw)
+
+	httpClient, err := c.st.HTTPClient()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var resp params.ErrorResult
+	if err := httpClient.Do(ctx, req, &resp); err != nil {
+		return errors.Trace(apiservererrors.RestoreError(err))
+	}
+	if resp.Error != nil {
+		return errors.Trace(apiservererrors.RestoreError(resp.Error))
+	}
+	return nil
+}
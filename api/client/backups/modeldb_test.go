@@ -0,0 +1,67 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+	"gopkg.in/httprequest.v1"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+type modelDBSuite struct {
+	baseSuite
+}
+
+func TestModelDBSuite(t *testing.T) {
+	tc.Run(t, &modelDBSuite{})
+}
+
+func (s *modelDBSuite) TestExportModelDB(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, "GET")
+		c.Check(r.URL.String(), tc.Equals, "/db-snapshot")
+		_, err := w.Write([]byte("snapshot"))
+		c.Check(err, tc.ErrorIsNil)
+	}))
+	defer srv.Close()
+	s.apiCaller.EXPECT().HTTPClient().Return(&httprequest.Client{BaseURL: srv.URL}, nil)
+
+	rdr, err := s.newClient().ExportModelDB(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = rdr.Close() }()
+
+	data, err := io.ReadAll(rdr)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "snapshot")
+}
+
+func (s *modelDBSuite) TestImportModelDB(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, "PUT")
+		c.Check(r.URL.String(), tc.Equals, "/db-snapshot")
+		c.Check(r.Header.Get("Content-Type"), tc.Equals, params.ContentTypeRaw)
+		data, err := io.ReadAll(r.Body)
+		c.Check(err, tc.ErrorIsNil)
+		c.Check(string(data), tc.Equals, "snapshot")
+		w.Header().Set("Content-Type", params.ContentTypeJSON)
+		_, err = w.Write([]byte("{}"))
+		c.Check(err, tc.ErrorIsNil)
+	}))
+	defer srv.Close()
+	s.apiCaller.EXPECT().HTTPClient().Return(&httprequest.Client{BaseURL: srv.URL}, nil)
+
+	err := s.newClient().ImportModelDB(c.Context(), strings.NewReader("snapshot"))
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -5,6 +5,7 @@ package block
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 
@@ -53,9 +54,64 @@ func (c *Client) List(ctx context.Context) ([]params.Block, error) {
 // SwitchBlockOn switches desired block on for the current model.
 // Valid block types are "BlockDestroy", "BlockRemove" and "BlockChange".
 func (c *Client) SwitchBlockOn(ctx context.Context, blockType, msg string) error {
-	args := params.BlockSwitchParams{
+	return c.SwitchBlockOnWithArgs(ctx, BlockArgs{
 		Type:    blockType,
 		Message: msg,
+	})
+}
+
+// BlockArgs holds the arguments for switching a block on.
+type BlockArgs struct {
+	// Type is the block type, one of "BlockDestroy", "BlockRemove" and
+	// "BlockChange".
+	Type string
+
+	// Message is an optional message explaining the block.
+	Message string
+
+	// ExpiresAt is the optional time at which the block stops applying.
+	ExpiresAt *time.Time
+
+	// Applications optionally limits the block to operations on the named
+	// applications.
+	Applications []string
+
+	// Methods optionally limits the block to the named facade methods, in
+	// the form "Facade.Method" or "Facade.*".
+	Methods []string
+
+	// ExemptUsers holds the names of the users not subject to the block.
+	ExemptUsers []string
+
+	// ExemptRoles holds the model access levels not subject to the block.
+	ExemptRoles []string
+}
+
+func (a BlockArgs) scoped() bool {
+	return a.ExpiresAt != nil ||
+		len(a.Applications) > 0 ||
+		len(a.Methods) > 0 ||
+		len(a.ExemptUsers) > 0 ||
+		len(a.ExemptRoles) > 0
+}
+
+// SwitchBlockOnWithArgs switches the described block on for the current
+// model. Blocks that expire or are limited in scope require a controller
+// that supports them; older controllers return an error satisfying
+// [errors.NotSupported].
+func (c *Client) SwitchBlockOnWithArgs(ctx context.Context, in BlockArgs) error {
+	if in.scoped() && c.facade.BestAPIVersion() < 3 {
+		return errors.NotSupportedf("expiring or scoped blocks on this controller")
+	}
+
+	args := params.BlockSwitchParams{
+		Type:         in.Type,
+		Message:      in.Message,
+		ExpiresAt:    in.ExpiresAt,
+		Applications: in.Applications,
+		Methods:      in.Methods,
+		ExemptUsers:  in.ExemptUsers,
+		ExemptRoles:  in.ExemptRoles,
 	}
 	var result params.ErrorResult
 	if err := c.facade.FacadeCall(ctx, "SwitchBlockOn", args, &result); err != nil {
@@ -5,6 +5,7 @@ package block_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/tc"
@@ -43,6 +44,54 @@ func (s *blockMockSuite) TestSwitchBlockOn(c *tc.C) {
 	c.Assert(err, tc.IsNil)
 }
 
+func (s *blockMockSuite) TestSwitchBlockOnWithArgs(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	expiresAt := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	args := params.BlockSwitchParams{
+		Type:         params.BlockChange,
+		Message:      "release freeze",
+		ExpiresAt:    &expiresAt,
+		Applications: []string{"foo"},
+		Methods:      []string{"Application.SetCharm"},
+		ExemptUsers:  []string{"mary"},
+		ExemptRoles:  []string{"admin"},
+	}
+	result := new(params.ErrorResult)
+	results := params.ErrorResult{Error: nil}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(3)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SwitchBlockOn", args, result).SetArg(3, results).Return(nil)
+
+	blockClient := block.NewClientFromCaller(mockFacadeCaller)
+	err := blockClient.SwitchBlockOnWithArgs(c.Context(), block.BlockArgs{
+		Type:         params.BlockChange,
+		Message:      "release freeze",
+		ExpiresAt:    &expiresAt,
+		Applications: []string{"foo"},
+		Methods:      []string{"Application.SetCharm"},
+		ExemptUsers:  []string{"mary"},
+		ExemptRoles:  []string{"admin"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *blockMockSuite) TestSwitchBlockOnWithArgsNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(2)
+
+	blockClient := block.NewClientFromCaller(mockFacadeCaller)
+	err := blockClient.SwitchBlockOnWithArgs(c.Context(), block.BlockArgs{
+		Type:         params.BlockChange,
+		Applications: []string{"foo"},
+	})
+	c.Assert(err, tc.Satisfies, errors.IsNotSupported)
+}
+
 func (s *blockMockSuite) TestSwitchBlockOnError(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -0,0 +1,77 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changefeed
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	apiwatcher "github.com/juju/juju/api/watcher"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the change feed API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the change feed API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "ChangeFeed", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// Namespaces returns the names of the namespaces that can be watched with
+// the change feed of the current model.
+func (c *Client) Namespaces(ctx context.Context) ([]string, error) {
+	var result params.ChangeFeedNamespacesResult
+	if err := c.facade.FacadeCall(ctx, "Namespaces", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Namespaces, nil
+}
+
+// WatchChanges returns a watcher that emits the changes made to the given
+// namespaces of the current model. If no namespaces are given, changes to all
+// namespaces are emitted.
+func (c *Client) WatchChanges(ctx context.Context, namespaces ...string) (watcher.ChangesWatcher, error) {
+	return c.watchChanges(ctx, params.WatchChangesArgs{
+		Namespaces: namespaces,
+	})
+}
+
+// WatchChangesFrom returns a watcher that first emits all the changes made
+// after the given change log ID, before emitting live changes. If no
+// namespaces are given, changes to all namespaces are emitted.
+func (c *Client) WatchChangesFrom(ctx context.Context, changeLogID int64, namespaces ...string) (watcher.ChangesWatcher, error) {
+	return c.watchChanges(ctx, params.WatchChangesArgs{
+		Namespaces:      namespaces,
+		FromChangeLogID: &changeLogID,
+	})
+}
+
+func (c *Client) watchChanges(ctx context.Context, args params.WatchChangesArgs) (watcher.ChangesWatcher, error) {
+	var result params.ChangeFeedWatchResult
+	if err := c.facade.FacadeCall(ctx, "WatchChanges", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return apiwatcher.NewChangeFeedWatcher(c.facade.RawAPICaller(), result), nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changefeed_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/changefeed"
+	"github.com/juju/juju/rpc/params"
+)
+
+type changeFeedMockSuite struct{}
+
+func TestChangeFeedMockSuite(t *testing.T) {
+	tc.Run(t, &changeFeedMockSuite{})
+}
+
+func (s *changeFeedMockSuite) TestNamespaces(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	result := new(params.ChangeFeedNamespacesResult)
+	results := params.ChangeFeedNamespacesResult{
+		Namespaces: []string{"application", "unit"},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "Namespaces", nil, result).SetArg(3, results).Return(nil)
+
+	client := changefeed.NewClientFromCaller(mockFacadeCaller)
+	namespaces, err := client.Namespaces(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(namespaces, tc.DeepEquals, []string{"application", "unit"})
+}
+
+func (s *changeFeedMockSuite) TestWatchChangesError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.WatchChangesArgs{
+		Namespaces: []string{"foo"},
+	}
+	result := new(params.ChangeFeedWatchResult)
+	results := params.ChangeFeedWatchResult{
+		Error: &params.Error{Code: params.CodeNotFound, Message: `namespace "foo"`},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "WatchChanges", args, result).SetArg(3, results).Return(nil)
+
+	client := changefeed.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.WatchChanges(c.Context(), "foo")
+	c.Assert(err, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *changeFeedMockSuite) TestWatchChangesFromError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	from := int64(42)
+	args := params.WatchChangesArgs{
+		FromChangeLogID: &from,
+	}
+	result := new(params.ChangeFeedWatchResult)
+	results := params.ChangeFeedWatchResult{
+		Error: &params.Error{Code: params.CodeNotValid, Message: "change log has been pruned"},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "WatchChanges", args, result).SetArg(3, results).Return(nil)
+
+	client := changefeed.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.WatchChangesFrom(c.Context(), 42)
+	c.Assert(err, tc.ErrorMatches, "change log has been pruned")
+}
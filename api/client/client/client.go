@@ -83,6 +83,7 @@ func (c *Client) StatusHistory(ctx context.Context, kind status.HistoryKind, tag
 			Size:    filter.Size,
 			Date:    filter.FromDate,
 			Delta:   filter.Delta,
+			ToDate:  filter.ToDate,
 			Exclude: filter.Exclude.Values(),
 		},
 		Tag: tag.String(),
@@ -0,0 +1,106 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the object store API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the object store API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "ObjectStore", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// ListObjects returns the objects in the object stores of the given models.
+// If no models are given, the objects of every model are returned.
+func (c *Client) ListObjects(ctx context.Context, modelUUIDs []string) ([]params.ObjectStoreListResult, error) {
+	args := params.ObjectStoreModelArgs{ModelUUIDs: modelUUIDs}
+	var result params.ObjectStoreListResults
+	if err := c.facade.FacadeCall(ctx, "ListObjects", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Results, nil
+}
+
+// ShowObject returns the object in the object store of the model, identified
+// by either a path of the object, its SHA256 hash or a unique prefix of its
+// SHA256 hash.
+func (c *Client) ShowObject(ctx context.Context, modelUUID, key string) (params.ObjectStoreObject, error) {
+	args := params.ObjectStoreShowArgs{
+		ModelUUID: modelUUID,
+		Key:       key,
+	}
+	var result params.ObjectStoreShowResult
+	if err := c.facade.FacadeCall(ctx, "ShowObject", args, &result); err != nil {
+		return params.ObjectStoreObject{}, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return params.ObjectStoreObject{}, result.Error
+	}
+	if result.Object == nil {
+		return params.ObjectStoreObject{}, errors.NotFoundf("object %q", key)
+	}
+	return *result.Object, nil
+}
+
+// VerifyObjects checks the contents of every object in the object stores of
+// the given models against their metadata. If no models are given, the
+// objects of every model are verified.
+func (c *Client) VerifyObjects(ctx context.Context, modelUUIDs []string) ([]params.ObjectStoreVerifyResult, error) {
+	args := params.ObjectStoreModelArgs{ModelUUIDs: modelUUIDs}
+	var result params.ObjectStoreVerifyResults
+	if err := c.facade.FacadeCall(ctx, "VerifyObjects", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Results, nil
+}
+
+// PruneObjects removes the unreferenced objects, which were last stored
+// before minAge, from the object stores of the given models. If minAge is
+// zero, the controller's default is used. If dryRun is true, the objects are
+// reported but not removed. If no models are given, every model is pruned.
+func (c *Client) PruneObjects(ctx context.Context, modelUUIDs []string, minAge time.Duration, dryRun bool) ([]params.ObjectStorePruneResult, error) {
+	args := params.ObjectStorePruneArgs{
+		ModelUUIDs: modelUUIDs,
+		DryRun:     dryRun,
+		MinAge:     minAge,
+	}
+	var result params.ObjectStorePruneResults
+	if err := c.facade.FacadeCall(ctx, "PruneObjects", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Results, nil
+}
+
+// Usage returns the type of object store used by the controller and the
+// usage of the object stores of the given models. If no models are given,
+// the usage of every model is returned.
+func (c *Client) Usage(ctx context.Context, modelUUIDs []string) (string, []params.ObjectStoreUsageResult, error) {
+	args := params.ObjectStoreModelArgs{ModelUUIDs: modelUUIDs}
+	var result params.ObjectStoreUsageResults
+	if err := c.facade.FacadeCall(ctx, "Usage", args, &result); err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	return result.Backend, result.Results, nil
+}
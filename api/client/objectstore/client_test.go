@@ -0,0 +1,126 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/objectstore"
+	"github.com/juju/juju/rpc/params"
+)
+
+type objectStoreMockSuite struct{}
+
+func TestObjectStoreMockSuite(t *testing.T) {
+	tc.Run(t, &objectStoreMockSuite{})
+}
+
+func (s *objectStoreMockSuite) TestListObjects(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ObjectStoreModelArgs{ModelUUIDs: []string{"model-uuid"}}
+	results := params.ObjectStoreListResults{
+		Results: []params.ObjectStoreListResult{{
+			ModelUUID: "model-uuid",
+			Objects: []params.ObjectStoreObject{{
+				SHA256: "sha256",
+				SHA384: "sha384",
+				Size:   42,
+				Paths:  []string{"foo"},
+			}},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListObjects", args, gomock.Any()).SetArg(3, results).Return(nil)
+
+	client := objectstore.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := client.ListObjects(c.Context(), []string{"model-uuid"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.DeepEquals, results.Results)
+}
+
+func (s *objectStoreMockSuite) TestShowObject(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ObjectStoreShowArgs{ModelUUID: "model-uuid", Key: "foo"}
+	object := params.ObjectStoreObject{
+		SHA256: "sha256",
+		References: []params.ObjectStoreReference{{
+			Kind: "charm",
+			Name: "foo-1",
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ShowObject", args, gomock.Any()).
+		SetArg(3, params.ObjectStoreShowResult{Object: &object}).Return(nil)
+
+	client := objectstore.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := client.ShowObject(c.Context(), "model-uuid", "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.DeepEquals, object)
+}
+
+func (s *objectStoreMockSuite) TestShowObjectError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ObjectStoreShowArgs{ModelUUID: "model-uuid", Key: "foo"}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ShowObject", args, gomock.Any()).
+		SetArg(3, params.ObjectStoreShowResult{Error: &params.Error{Message: "path not found"}}).Return(nil)
+
+	client := objectstore.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.ShowObject(c.Context(), "model-uuid", "foo")
+	c.Assert(err, tc.ErrorMatches, "path not found")
+}
+
+func (s *objectStoreMockSuite) TestPruneObjects(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ObjectStorePruneArgs{DryRun: true, MinAge: time.Hour}
+	results := params.ObjectStorePruneResults{
+		Results: []params.ObjectStorePruneResult{{
+			ModelUUID: "model-uuid",
+			Bytes:     42,
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "PruneObjects", args, gomock.Any()).SetArg(3, results).Return(nil)
+
+	client := objectstore.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := client.PruneObjects(c.Context(), nil, time.Hour, true)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.DeepEquals, results.Results)
+}
+
+func (s *objectStoreMockSuite) TestUsage(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ObjectStoreModelArgs{}
+	results := params.ObjectStoreUsageResults{
+		Backend: "file",
+		Results: []params.ObjectStoreUsageResult{{
+			ModelUUID: "model-uuid",
+			Objects:   2,
+			Bytes:     42,
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "Usage", args, gomock.Any()).SetArg(3, results).Return(nil)
+
+	client := objectstore.NewClientFromCaller(mockFacadeCaller)
+	backend, obtained, err := client.Usage(c.Context(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(backend, tc.Equals, "file")
+	c.Check(obtained, tc.DeepEquals, results.Results)
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the removal API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the removal API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "Removal", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// ListRemovals returns the removal jobs scheduled in the model.
+func (c *Client) ListRemovals(ctx context.Context) ([]params.RemovalJob, error) {
+	var result params.RemovalJobsResult
+	if err := c.facade.FacadeCall(ctx, "ListRemovals", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Jobs, nil
+}
+
+// CancelRemovals cancels the removal jobs with the given UUIDs, reverting
+// the life of the entities being removed to alive. An error is returned for
+// each job, in the order that they were supplied.
+func (c *Client) CancelRemovals(ctx context.Context, jobUUIDs []string) ([]error, error) {
+	return c.applyToJobs(ctx, "CancelRemovals", jobUUIDs)
+}
+
+// ForceRemovals forces the removal jobs with the given UUIDs to be executed
+// immediately. An error is returned for each job, in the order that they
+// were supplied.
+func (c *Client) ForceRemovals(ctx context.Context, jobUUIDs []string) ([]error, error) {
+	return c.applyToJobs(ctx, "ForceRemovals", jobUUIDs)
+}
+
+func (c *Client) applyToJobs(ctx context.Context, method string, jobUUIDs []string) ([]error, error) {
+	args := params.RemovalJobArgs{
+		Jobs: make([]params.RemovalJobArg, len(jobUUIDs)),
+	}
+	for i, jobUUID := range jobUUIDs {
+		args.Jobs[i].UUID = jobUUID
+	}
+
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, method, args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(jobUUIDs) {
+		return nil, errors.Errorf("expected %d results, got %d", len(jobUUIDs), len(results.Results))
+	}
+
+	errs := make([]error, len(results.Results))
+	for i, result := range results.Results {
+		if result.Error != nil {
+			errs[i] = result.Error
+		}
+	}
+	return errs, nil
+}
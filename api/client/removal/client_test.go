@@ -0,0 +1,114 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/removal"
+	"github.com/juju/juju/rpc/params"
+)
+
+type removalMockSuite struct{}
+
+func TestRemovalMockSuite(t *testing.T) {
+	tc.Run(t, &removalMockSuite{})
+}
+
+func (s *removalMockSuite) TestListRemovals(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	result := params.RemovalJobsResult{
+		Jobs: []params.RemovalJob{{
+			UUID:         "job-uuid",
+			EntityType:   "unit",
+			EntityUUID:   "unit-uuid",
+			EntityName:   "foo/0",
+			ScheduledFor: time.Now().UTC(),
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListRemovals", nil, gomock.Any()).SetArg(3, result).Return(nil)
+
+	client := removal.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := client.ListRemovals(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.DeepEquals, result.Jobs)
+}
+
+func (s *removalMockSuite) TestListRemovalsError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	result := params.RemovalJobsResult{
+		Error: &params.Error{Message: "boom"},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListRemovals", nil, gomock.Any()).SetArg(3, result).Return(nil)
+
+	client := removal.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.ListRemovals(c.Context())
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *removalMockSuite) TestCancelRemovals(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RemovalJobArgs{
+		Jobs: []params.RemovalJobArg{{UUID: "job-1"}, {UUID: "job-2"}},
+	}
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{}, {Error: &params.Error{Message: "not cancellable"}}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "CancelRemovals", args, gomock.Any()).SetArg(3, results).Return(nil)
+
+	client := removal.NewClientFromCaller(mockFacadeCaller)
+	errs, err := client.CancelRemovals(c.Context(), []string{"job-1", "job-2"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errs, tc.HasLen, 2)
+	c.Check(errs[0], tc.IsNil)
+	c.Check(errs[1], tc.ErrorMatches, "not cancellable")
+}
+
+func (s *removalMockSuite) TestForceRemovals(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RemovalJobArgs{
+		Jobs: []params.RemovalJobArg{{UUID: "job-1"}},
+	}
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ForceRemovals", args, gomock.Any()).SetArg(3, results).Return(nil)
+
+	client := removal.NewClientFromCaller(mockFacadeCaller)
+	errs, err := client.ForceRemovals(c.Context(), []string{"job-1"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(errs, tc.DeepEquals, []error{nil})
+}
+
+func (s *removalMockSuite) TestForceRemovalsResultCountMismatch(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RemovalJobArgs{
+		Jobs: []params.RemovalJobArg{{UUID: "job-1"}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ForceRemovals", args, gomock.Any()).SetArg(3, params.ErrorResults{}).Return(nil)
+
+	client := removal.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.ForceRemovals(c.Context(), []string{"job-1"})
+	c.Assert(err, tc.ErrorMatches, "expected 1 results, got 0")
+}
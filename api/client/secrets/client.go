@@ -139,10 +139,15 @@ func (api *Client) ListSecrets(ctx context.Context, reveal bool, filter secrets.
 	return result, err
 }
 
-func (c *Client) CreateSecret(ctx context.Context, name, description string, data map[string]string) (string, error) {
+func (c *Client) CreateSecret(
+	ctx context.Context, name, description string, data map[string]string, rotation RotationArgs,
+) (string, error) {
 	if c.BestAPIVersion() < 2 {
 		return "", errors.NotSupportedf("user secrets")
 	}
+	if !rotation.isEmpty() && c.BestAPIVersion() < 4 {
+		return "", errors.NotSupportedf("generated secret content")
+	}
 	var results params.StringResults
 	arg := params.CreateSecretArg{
 		UpsertSecretArg: params.UpsertSecretArg{
@@ -155,6 +160,7 @@ func (c *Client) CreateSecret(ctx context.Context, name, description string, dat
 	if description != "" {
 		arg.Description = &description
 	}
+	rotation.apply(&arg.UpsertSecretArg)
 
 	err := c.facade.FacadeCall(ctx, "CreateSecrets", params.CreateSecretArgs{Args: []params.CreateSecretArg{arg}}, &results)
 	if err != nil {
@@ -170,15 +176,42 @@ func (c *Client) CreateSecret(ctx context.Context, name, description string, dat
 	return result.Result, nil
 }
 
+// RotationArgs holds the settings for a user secret whose
+// content is generated, and periodically rotated, by Juju.
+type RotationArgs struct {
+	// RotatePolicy is how often new content is generated.
+	RotatePolicy *secrets.RotatePolicy
+	// Generator is the spec used to generate the content,
+	// eg "password,length=24".
+	Generator string
+	// KeepRevisions is the number of revisions to keep
+	// when old revisions are pruned.
+	KeepRevisions *int
+}
+
+func (r RotationArgs) isEmpty() bool {
+	return r.RotatePolicy == nil && r.Generator == "" && r.KeepRevisions == nil
+}
+
+func (r RotationArgs) apply(arg *params.UpsertSecretArg) {
+	arg.RotatePolicy = r.RotatePolicy
+	arg.Generator = r.Generator
+	arg.KeepRevisions = r.KeepRevisions
+}
+
 // UpdateSecret updates an existing secret.
 func (c *Client) UpdateSecret(
 	ctx context.Context,
 	uri *secrets.URI, name string, autoPrune *bool,
 	newName string, description string, data map[string]string,
+	rotation RotationArgs,
 ) error {
 	if c.BestAPIVersion() < 2 {
 		return errors.NotSupportedf("user secrets")
 	}
+	if !rotation.isEmpty() && c.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("generated secret content")
+	}
 	var results params.ErrorResults
 	arg := params.UpdateUserSecretArg{
 		AutoPrune: autoPrune,
@@ -204,6 +237,7 @@ func (c *Client) UpdateSecret(
 	if description != "" {
 		arg.UpsertSecretArg.Description = &description
 	}
+	rotation.apply(&arg.UpsertSecretArg)
 	err := c.facade.FacadeCall(ctx, "UpdateSecrets", params.UpdateUserSecretArgs{Args: []params.UpdateUserSecretArg{arg}}, &results)
 	if err != nil {
 		return errors.Trace(err)
@@ -309,3 +343,41 @@ func (c *Client) RevokeSecret(ctx context.Context, uri *secrets.URI, name string
 	}
 	return processErrors(results), nil
 }
+
+// SecretAccessLog returns the recorded attempts to read the content of the
+// specified secret, ordered from the most recent to the oldest. If limit is
+// positive, at most that many entries are returned.
+func (c *Client) SecretAccessLog(ctx context.Context, uri *secrets.URI, limit int) ([]secrets.AccessLogEntry, error) {
+	if c.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("secret access log")
+	}
+	arg := params.SecretAccessLogArgs{
+		Args: []params.SecretAccessLogArg{{
+			URI:   uri.String(),
+			Limit: limit,
+		}},
+	}
+
+	var results params.SecretAccessLogResults
+	err := c.facade.FacadeCall(ctx, "SecretAccessLog", arg, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, params.TranslateWellKnownError(result.Error)
+	}
+	entries := make([]secrets.AccessLogEntry, len(result.Entries))
+	for i, e := range result.Entries {
+		entries[i] = secrets.AccessLogEntry{
+			Accessor: e.AccessorTag,
+			Revision: e.Revision,
+			Time:     e.AccessTime,
+			Denied:   e.Denied,
+		}
+	}
+	return entries, nil
+}
@@ -7,6 +7,7 @@ import (
 	stdtesting "testing"
 	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/base/testing"
@@ -160,7 +161,7 @@ func (s *SecretsSuite) TestCreateSecretError(c *tc.C) {
 	})
 	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 1}
 	client := apisecrets.NewClient(caller)
-	_, err := client.CreateSecret(c.Context(), "label", "this is a secret.", map[string]string{"foo": "bar"})
+	_, err := client.CreateSecret(c.Context(), "label", "this is a secret.", map[string]string{"foo": "bar"}, apisecrets.RotationArgs{})
 	c.Assert(err, tc.ErrorMatches, "user secrets not supported")
 }
 
@@ -189,11 +190,72 @@ func (s *SecretsSuite) TestCreateSecret(c *tc.C) {
 	})
 	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
 	client := apisecrets.NewClient(caller)
-	result, err := client.CreateSecret(c.Context(), "my-secret", "this is a secret.", map[string]string{"foo": "bar"})
+	result, err := client.CreateSecret(c.Context(), "my-secret", "this is a secret.", map[string]string{"foo": "bar"}, apisecrets.RotationArgs{})
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(result, tc.DeepEquals, uri.String())
 }
 
+func (s *SecretsSuite) TestCreateSecretWithGenerator(c *tc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "CreateSecrets")
+		c.Assert(arg, tc.DeepEquals, params.CreateSecretArgs{
+			Args: []params.CreateSecretArg{
+				{
+					UpsertSecretArg: params.UpsertSecretArg{
+						Label:         ptr("my-secret"),
+						RotatePolicy:  ptr(secrets.RotateDaily),
+						Generator:     "ssh-key",
+						KeepRevisions: ptr(2),
+					},
+				},
+			},
+		})
+		*(result.(*params.StringResults)) = params.StringResults{
+			Results: []params.StringResult{
+				{Result: uri.String()},
+			},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 4}
+	client := apisecrets.NewClient(caller)
+	result, err := client.CreateSecret(c.Context(), "my-secret", "", nil, apisecrets.RotationArgs{
+		RotatePolicy:  ptr(secrets.RotateDaily),
+		Generator:     "ssh-key",
+		KeepRevisions: ptr(2),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, uri.String())
+}
+
+func (s *SecretsSuite) TestCreateSecretWithGeneratorNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected api call %q", request)
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	_, err := client.CreateSecret(c.Context(), "my-secret", "", nil, apisecrets.RotationArgs{
+		Generator: "ssh-key",
+	})
+	c.Assert(err, tc.ErrorMatches, "generated secret content not supported")
+}
+
+func (s *SecretsSuite) TestUpdateSecretWithRotatePolicyNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected api call %q", request)
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	err := client.UpdateSecret(c.Context(), secrets.NewURI(), "", nil, "", "", nil, apisecrets.RotationArgs{
+		RotatePolicy: ptr(secrets.RotateDaily),
+	})
+	c.Assert(err, tc.ErrorMatches, "generated secret content not supported")
+}
+
 func (s *SecretsSuite) TestUpdateSecretError(c *tc.C) {
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
 		return nil
@@ -201,7 +263,7 @@ func (s *SecretsSuite) TestUpdateSecretError(c *tc.C) {
 	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 1}
 	client := apisecrets.NewClient(caller)
 	uri := secrets.NewURI()
-	err := client.UpdateSecret(c.Context(), uri, "", ptr(true), "new-name", "this is a secret.", map[string]string{"foo": "bar"})
+	err := client.UpdateSecret(c.Context(), uri, "", ptr(true), "new-name", "this is a secret.", map[string]string{"foo": "bar"}, apisecrets.RotationArgs{})
 	c.Assert(err, tc.ErrorMatches, "user secrets not supported")
 }
 
@@ -227,7 +289,7 @@ func (s *SecretsSuite) TestUpdateSecretWithoutContent(c *tc.C) {
 	})
 	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
 	client := apisecrets.NewClient(caller)
-	err := client.UpdateSecret(c.Context(), uri, "", ptr(true), "new-name", "this is a secret.", nil)
+	err := client.UpdateSecret(c.Context(), uri, "", ptr(true), "new-name", "this is a secret.", nil, apisecrets.RotationArgs{})
 	c.Assert(err, tc.ErrorIsNil)
 }
 
@@ -252,7 +314,7 @@ func (s *SecretsSuite) TestUpdateSecretByName(c *tc.C) {
 	})
 	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
 	client := apisecrets.NewClient(caller)
-	err := client.UpdateSecret(c.Context(), nil, "name", ptr(true), "new-name", "this is a secret.", nil)
+	err := client.UpdateSecret(c.Context(), nil, "name", ptr(true), "new-name", "this is a secret.", nil, apisecrets.RotationArgs{})
 	c.Assert(err, tc.ErrorIsNil)
 }
 
@@ -279,7 +341,7 @@ func (s *SecretsSuite) TestUpdateSecret(c *tc.C) {
 	})
 	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
 	client := apisecrets.NewClient(caller)
-	err := client.UpdateSecret(c.Context(), uri, "", ptr(true), "label", "this is a secret.", map[string]string{"foo": "bar"})
+	err := client.UpdateSecret(c.Context(), uri, "", ptr(true), "label", "this is a secret.", map[string]string{"foo": "bar"}, apisecrets.RotationArgs{})
 	c.Assert(err, tc.ErrorIsNil)
 }
 
@@ -455,3 +517,49 @@ func (s *SecretsSuite) TestRevokeSecretByName(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(result, tc.DeepEquals, []error{nil})
 }
+
+func (s *SecretsSuite) TestSecretAccessLog(c *tc.C) {
+	uri := secrets.NewURI()
+	now := time.Now()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "SecretAccessLog")
+		c.Assert(arg, tc.DeepEquals, params.SecretAccessLogArgs{
+			Args: []params.SecretAccessLogArg{{
+				URI:   uri.String(),
+				Limit: 10,
+			}},
+		})
+		*(result.(*params.SecretAccessLogResults)) = params.SecretAccessLogResults{
+			Results: []params.SecretAccessLogResult{{
+				Entries: []params.SecretAccessLogEntry{{
+					AccessorTag: "unit-mysql-0",
+					Revision:    2,
+					AccessTime:  now,
+					Denied:      true,
+				}},
+			}},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	result, err := client.SecretAccessLog(c.Context(), uri, 10)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, []secrets.AccessLogEntry{{
+		Accessor: "unit-mysql-0",
+		Revision: 2,
+		Time:     now,
+		Denied:   true,
+	}})
+}
+
+func (s *SecretsSuite) TestSecretAccessLogNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	_, err := client.SecretAccessLog(c.Context(), secrets.NewURI(), 0)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
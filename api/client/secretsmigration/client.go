@@ -0,0 +1,116 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretsmigration
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the secrets migration API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the secrets migration API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "SecretsMigration", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// SecretRevisions holds the revisions of a secret which are
+// stored in the secret backend being migrated from.
+type SecretRevisions struct {
+	URI       *secrets.URI
+	Label     string
+	OwnerTag  string
+	Revisions []int
+}
+
+// RevisionResult holds the outcome of migrating a secret revision.
+type RevisionResult struct {
+	// Skipped is true if the revision was already stored in
+	// the target backend.
+	Skipped bool
+	// Checksum is the verified checksum of the migrated content.
+	Checksum string
+	Error    error
+}
+
+// ListSecretRevisionsToMigrate returns the secrets with revisions stored
+// in the "from" backend.
+func (c *Client) ListSecretRevisionsToMigrate(ctx context.Context, fromBackend, toBackend string) ([]SecretRevisions, error) {
+	arg := params.MigrateSecretsArgs{
+		FromBackend: fromBackend,
+		ToBackend:   toBackend,
+	}
+	var results params.SecretRevisionsToMigrateResults
+	if err := c.facade.FacadeCall(ctx, "ListSecretRevisionsToMigrate", arg, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]SecretRevisions, len(results.Results))
+	for i, r := range results.Results {
+		uri, err := secrets.ParseURI(r.URI)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result[i] = SecretRevisions{
+			URI:       uri,
+			Label:     r.Label,
+			OwnerTag:  r.OwnerTag,
+			Revisions: r.Revisions,
+		}
+	}
+	return result, nil
+}
+
+// MigrateSecretRevisions moves the content of the specified revisions of a
+// secret from one backend to another. A result is returned for each revision,
+// in the order that they were supplied.
+func (c *Client) MigrateSecretRevisions(
+	ctx context.Context, fromBackend, toBackend string, uri *secrets.URI, revisions ...int,
+) ([]RevisionResult, error) {
+	args := params.MigrateSecretRevisionsArgs{
+		FromBackend: fromBackend,
+		ToBackend:   toBackend,
+		Args:        make([]params.MigrateSecretRevisionArg, len(revisions)),
+	}
+	for i, rev := range revisions {
+		args.Args[i] = params.MigrateSecretRevisionArg{
+			URI:      uri.String(),
+			Revision: rev,
+		}
+	}
+	var results params.MigrateSecretRevisionResults
+	if err := c.facade.FacadeCall(ctx, "MigrateSecretRevisions", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(revisions) {
+		return nil, errors.Errorf("expected %d results, got %d", len(revisions), len(results.Results))
+	}
+	result := make([]RevisionResult, len(results.Results))
+	for i, r := range results.Results {
+		result[i] = RevisionResult{
+			Skipped:  r.Skipped,
+			Checksum: r.Checksum,
+		}
+		if r.Error != nil {
+			result[i].Error = r.Error
+		}
+	}
+	return result, nil
+}
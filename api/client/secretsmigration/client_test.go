@@ -0,0 +1,100 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretsmigration_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/secretsmigration"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/rpc/params"
+)
+
+type secretsMigrationSuite struct{}
+
+func TestSecretsMigrationSuite(t *testing.T) {
+	tc.Run(t, &secretsMigrationSuite{})
+}
+
+func (s *secretsMigrationSuite) TestListSecretRevisionsToMigrate(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	uri := coresecrets.NewURI()
+	arg := params.MigrateSecretsArgs{
+		FromBackend: "myvault",
+		ToBackend:   "internal",
+	}
+	result := params.SecretRevisionsToMigrateResults{
+		Results: []params.SecretRevisionsToMigrate{{
+			URI:       uri.String(),
+			Label:     "foo",
+			OwnerTag:  "application-mariadb",
+			Revisions: []int{1, 2},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListSecretRevisionsToMigrate", arg, gomock.Any()).SetArg(3, result).Return(nil)
+
+	client := secretsmigration.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := client.ListSecretRevisionsToMigrate(c.Context(), "myvault", "internal")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.DeepEquals, []secretsmigration.SecretRevisions{{
+		URI:       uri,
+		Label:     "foo",
+		OwnerTag:  "application-mariadb",
+		Revisions: []int{1, 2},
+	}})
+}
+
+func (s *secretsMigrationSuite) TestMigrateSecretRevisions(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	uri := coresecrets.NewURI()
+	args := params.MigrateSecretRevisionsArgs{
+		FromBackend: "myvault",
+		ToBackend:   "internal",
+		Args: []params.MigrateSecretRevisionArg{
+			{URI: uri.String(), Revision: 1},
+			{URI: uri.String(), Revision: 2},
+			{URI: uri.String(), Revision: 3},
+		},
+	}
+	results := params.MigrateSecretRevisionResults{
+		Results: []params.MigrateSecretRevisionResult{
+			{Checksum: "deadbeef"},
+			{Skipped: true},
+			{Error: &params.Error{Message: "boom"}},
+		},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "MigrateSecretRevisions", args, gomock.Any()).SetArg(3, results).Return(nil)
+
+	client := secretsmigration.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := client.MigrateSecretRevisions(c.Context(), "myvault", "internal", uri, 1, 2, 3)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(obtained, tc.HasLen, 3)
+	c.Check(obtained[0], tc.DeepEquals, secretsmigration.RevisionResult{Checksum: "deadbeef"})
+	c.Check(obtained[1], tc.DeepEquals, secretsmigration.RevisionResult{Skipped: true})
+	c.Check(obtained[2].Error, tc.ErrorMatches, "boom")
+}
+
+func (s *secretsMigrationSuite) TestMigrateSecretRevisionsResultCountMismatch(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	uri := coresecrets.NewURI()
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "MigrateSecretRevisions", gomock.Any(), gomock.Any()).SetArg(
+		3, params.MigrateSecretRevisionResults{}).Return(nil)
+
+	client := secretsmigration.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.MigrateSecretRevisions(c.Context(), "myvault", "internal", uri, 1)
+	c.Assert(err, tc.ErrorMatches, "expected 1 results, got 0")
+}
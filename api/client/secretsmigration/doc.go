@@ -0,0 +1,6 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package secretsmigration provides a client for the SecretsMigration facade,
+// which moves the content of a model's secrets between secret backends.
+package secretsmigration
@@ -5,6 +5,7 @@ package sshclient
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -93,6 +94,107 @@ func (facade *Facade) VirtualHostname(ctx context.Context, target string, contai
 	return out.Address, nil
 }
 
+// SessionRecording describes the recording of an interactive SSH session
+// proxied through the controller.
+type SessionRecording struct {
+	// Path identifies the recording.
+	Path string
+	// User is the name of the user that started the session.
+	User string
+	// Target is the name of the machine, unit or container the session
+	// was proxied to.
+	Target string
+	// Started is the time the session started.
+	Started time.Time
+	// Size is the size of the recording in bytes.
+	Size int64
+}
+
+// ListSessionRecordings returns the recordings of the interactive SSH
+// sessions to the model's machines and units. The recordings can be
+// filtered by user and target, an empty filter value matches everything.
+func (facade *Facade) ListSessionRecordings(ctx context.Context, user, target string) ([]SessionRecording, error) {
+	if facade.caller.BestAPIVersion() < 6 {
+		return nil, errors.NotImplementedf("listing ssh session recordings")
+	}
+	in := params.SSHSessionRecordingFilter{
+		User:   user,
+		Target: target,
+	}
+	var out params.SSHSessionRecordingsResult
+	err := facade.caller.FacadeCall(ctx, "ListSessionRecordings", in, &out)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	recordings := make([]SessionRecording, len(out.Recordings))
+	for i, r := range out.Recordings {
+		recordings[i] = SessionRecording{
+			Path:    r.Path,
+			User:    r.User,
+			Target:  r.Target,
+			Started: r.Started,
+			Size:    r.Size,
+		}
+	}
+	return recordings, nil
+}
+
+// SessionRecording returns the content of the recording of an interactive
+// SSH session, in the asciicast v2 format.
+func (facade *Facade) SessionRecording(ctx context.Context, path string) (string, error) {
+	if facade.caller.BestAPIVersion() < 6 {
+		return "", errors.NotImplementedf("getting ssh session recordings")
+	}
+	in := params.SSHSessionRecordingArg{
+		Path: path,
+	}
+	var out params.SSHSessionRecordingResult
+	err := facade.caller.FacadeCall(ctx, "SessionRecording", in, &out)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := out.Error; err != nil {
+		return "", errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return out.Content, nil
+}
+
+// UserCertificate is a short-lived SSH user certificate issued by the
+// controller.
+type UserCertificate struct {
+	// Certificate is the signed certificate, in the authorized_keys format.
+	Certificate string
+	// Principals are the principals the certificate is valid for.
+	Principals []string
+	// ValidBefore is when the certificate expires.
+	ValidBefore time.Time
+}
+
+// UserCertificate requests a short-lived user certificate for the public key,
+// which is in the authorized_keys format. The machines of the model accept
+// the certificate instead of the public key being authorised on them.
+func (facade *Facade) UserCertificate(ctx context.Context, publicKey string) (UserCertificate, error) {
+	if facade.caller.BestAPIVersion() < 7 {
+		return UserCertificate{}, errors.NotImplementedf("ssh user certificates")
+	}
+	in := params.SSHUserCertificateArg{
+		PublicKey: publicKey,
+	}
+	var out params.SSHUserCertificateResult
+	err := facade.caller.FacadeCall(ctx, "UserCertificate", in, &out)
+	if err != nil {
+		return UserCertificate{}, errors.Trace(err)
+	}
+	if err := out.Error; err != nil {
+		return UserCertificate{}, errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return UserCertificate{
+		Certificate: out.Certificate,
+		Principals:  out.Principals,
+		ValidBefore: out.ValidBefore,
+	}, nil
+}
+
 func (facade *Facade) addressCall(ctx context.Context, callName, target string) (string, error) {
 	entities, err := targetToEntities(target)
 	if err != nil {
@@ -5,6 +5,7 @@ package sshclient_test
 
 import (
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -453,3 +454,131 @@ func (s *FacadeSuite) TestVirtualHostnameError(c *tc.C) {
 	_, err := facade.VirtualHostname(c.Context(), "foo/0", nil)
 	c.Check(err, tc.ErrorMatches, "boom")
 }
+
+func (s *FacadeSuite) TestListSessionRecordings(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	started := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	expectedArg := params.SSHSessionRecordingFilter{
+		User: "bob",
+	}
+	res := new(params.SSHSessionRecordingsResult)
+	ress := params.SSHSessionRecordingsResult{
+		Recordings: []params.SSHSessionRecording{{
+			Path:    "ssh-recordings/bob/unit-foo-0/20250301T120000.000000000Z.cast",
+			User:    "bob",
+			Target:  "unit-foo-0",
+			Started: started,
+			Size:    42,
+		}},
+	}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(6)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListSessionRecordings", expectedArg, res).SetArg(3, ress).Return(nil)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	recordings, err := facade.ListSessionRecordings(c.Context(), "bob", "")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(recordings, tc.DeepEquals, []sshclient.SessionRecording{{
+		Path:    "ssh-recordings/bob/unit-foo-0/20250301T120000.000000000Z.cast",
+		User:    "bob",
+		Target:  "unit-foo-0",
+		Started: started,
+		Size:    42,
+	}})
+}
+
+func (s *FacadeSuite) TestListSessionRecordingsNotImplemented(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(5)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	_, err := facade.ListSessionRecordings(c.Context(), "", "")
+	c.Check(err, tc.ErrorIs, errors.NotImplemented)
+}
+
+func (s *FacadeSuite) TestSessionRecording(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	expectedArg := params.SSHSessionRecordingArg{
+		Path: "ssh-recordings/bob/unit-foo-0/20250301T120000.000000000Z.cast",
+	}
+	res := new(params.SSHSessionRecordingResult)
+	ress := params.SSHSessionRecordingResult{
+		Content: `{"version":2}`,
+	}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(6)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SessionRecording", expectedArg, res).SetArg(3, ress).Return(nil)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	content, err := facade.SessionRecording(c.Context(), "ssh-recordings/bob/unit-foo-0/20250301T120000.000000000Z.cast")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(content, tc.Equals, `{"version":2}`)
+}
+
+func (s *FacadeSuite) TestSessionRecordingError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.SSHSessionRecordingResult)
+	ress := params.SSHSessionRecordingResult{
+		Error: apiservererrors.ServerError(errors.NotFoundf("session recording")),
+	}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(6)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SessionRecording", gomock.Any(), res).SetArg(3, ress).Return(nil)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	_, err := facade.SessionRecording(c.Context(), "ssh-recordings/missing")
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *FacadeSuite) TestUserCertificate(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	validBefore := time.Date(2025, 3, 1, 12, 5, 0, 0, time.UTC)
+	expectedArg := params.SSHUserCertificateArg{
+		PublicKey: "ssh-ed25519 AAAA bob@host",
+	}
+	res := new(params.SSHUserCertificateResult)
+	ress := params.SSHUserCertificateResult{
+		Certificate: "ssh-ed25519-cert-v01@openssh.com AAAA",
+		Principals:  []string{"juju-admin@deadbeef"},
+		ValidBefore: validBefore,
+	}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "UserCertificate", expectedArg, res).SetArg(3, ress).Return(nil)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	cert, err := facade.UserCertificate(c.Context(), "ssh-ed25519 AAAA bob@host")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cert, tc.DeepEquals, sshclient.UserCertificate{
+		Certificate: "ssh-ed25519-cert-v01@openssh.com AAAA",
+		Principals:  []string{"juju-admin@deadbeef"},
+		ValidBefore: validBefore,
+	})
+}
+
+func (s *FacadeSuite) TestUserCertificateNotImplemented(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(6)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	_, err := facade.UserCertificate(c.Context(), "ssh-ed25519 AAAA bob@host")
+	c.Check(err, tc.ErrorIs, errors.NotImplemented)
+}
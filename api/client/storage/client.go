@@ -191,6 +191,11 @@ func (c *Client) ListFilesystems(ctx context.Context, machines []string) ([]para
 // NOTE(axw) for old controllers, the results will only
 // contain errors.
 func (c *Client) AddToUnit(ctx context.Context, storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
+	for _, one := range storages {
+		if one.Directives.Snapshot != "" && c.facade.BestAPIVersion() < 8 {
+			return nil, errors.NotSupportedf("adding storage from a snapshot on this version of Juju")
+		}
+	}
 	out := params.AddStorageResults{}
 	in := params.StoragesAddParams{Storages: storages}
 	err := c.facade.FacadeCall(ctx, "AddToUnit", in, &out)
@@ -292,6 +297,96 @@ func (c *Client) Detach(ctx context.Context, storageIds []string, force *bool, m
 	return results.Results, nil
 }
 
+// Resize grows the specified storage instance to the given size in MiB.
+func (c *Client) Resize(ctx context.Context, storageId string, size uint64) error {
+	if c.facade.BestAPIVersion() < 7 {
+		return errors.NotSupportedf("resizing storage on this version of Juju")
+	}
+	if !names.IsValidStorage(storageId) {
+		return errors.NotValidf("storage ID %q", storageId)
+	}
+	args := params.ResizeStorage{
+		Storage: []params.ResizeStorageInstance{{
+			Tag:  names.NewStorageTag(storageId).String(),
+			Size: size,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "ResizeStorage", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// Snapshot takes a snapshot of the volume backing the specified
+// storage instance.
+func (c *Client) Snapshot(ctx context.Context, storageId string) (params.StorageSnapshotDetails, error) {
+	if c.facade.BestAPIVersion() < 8 {
+		return params.StorageSnapshotDetails{}, errors.NotSupportedf("snapshotting storage on this version of Juju")
+	}
+	if !names.IsValidStorage(storageId) {
+		return params.StorageSnapshotDetails{}, errors.NotValidf("storage ID %q", storageId)
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewStorageTag(storageId).String()}},
+	}
+	var results params.StorageSnapshotResults
+	if err := c.facade.FacadeCall(ctx, "SnapshotStorage", args, &results); err != nil {
+		return params.StorageSnapshotDetails{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return params.StorageSnapshotDetails{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return params.StorageSnapshotDetails{}, errors.Trace(err)
+	}
+	return *results.Results[0].Result, nil
+}
+
+// ListSnapshots lists the snapshots taken of the specified storage
+// instance, or of all storage in the model if storageId is empty.
+func (c *Client) ListSnapshots(ctx context.Context, storageId string) ([]params.StorageSnapshotDetails, error) {
+	if c.facade.BestAPIVersion() < 8 {
+		return nil, errors.NotSupportedf("listing storage snapshots on this version of Juju")
+	}
+	var filter params.StorageSnapshotFilter
+	if storageId != "" {
+		if !names.IsValidStorage(storageId) {
+			return nil, errors.NotValidf("storage ID %q", storageId)
+		}
+		filter.StorageTag = names.NewStorageTag(storageId).String()
+	}
+	args := params.StorageSnapshotFilters{
+		Filters: []params.StorageSnapshotFilter{filter},
+	}
+	var results params.StorageSnapshotsResults
+	if err := c.facade.FacadeCall(ctx, "ListStorageSnapshots", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results[0].Result, nil
+}
+
+// RemoveSnapshot removes the storage snapshot with the specified UUID.
+func (c *Client) RemoveSnapshot(ctx context.Context, uuid string) error {
+	if c.facade.BestAPIVersion() < 8 {
+		return errors.NotSupportedf("removing storage snapshots on this version of Juju")
+	}
+	args := params.RemoveStorageSnapshots{
+		Snapshots: []string{uuid},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "RemoveStorageSnapshots", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // Import imports storage into the model.
 func (c *Client) Import(
 	ctx context.Context,
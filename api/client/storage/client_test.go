@@ -795,3 +795,128 @@ func (s *storageMockSuite) TestUpdatePoolFacadeCallError(c *tc.C) {
 	err := storageClient.UpdatePool(c.Context(), "", "", nil)
 	c.Assert(err, tc.ErrorMatches, msg)
 }
+
+func (s *storageMockSuite) TestResize(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ResizeStorage{Storage: []params.ResizeStorageInstance{
+		{Tag: "storage-foo-0", Size: 2048},
+	}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{Error: &params.Error{Message: "baz"}}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ResizeStorage", args, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	err := storageClient.Resize(c.Context(), "foo/0", 2048)
+	c.Assert(err, tc.ErrorMatches, "baz")
+}
+
+func (s *storageMockSuite) TestResizeNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(6)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	err := storageClient.Resize(c.Context(), "foo/0", 2048)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageMockSuite) TestAddToUnitFromSnapshotNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	_, err := storageClient.AddToUnit(c.Context(), []params.StorageAddParams{{
+		UnitTag:     "unit-foo-0",
+		StorageName: "data",
+		Directives:  params.StorageDirectives{Snapshot: "deadbeef"},
+	}})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageMockSuite) TestSnapshot(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "storage-foo-0"}}}
+	details := params.StorageSnapshotDetails{
+		UUID:       "deadbeef",
+		StorageTag: "storage-foo-0",
+		Status:     "available",
+	}
+	result := new(params.StorageSnapshotResults)
+	results := params.StorageSnapshotResults{
+		Results: []params.StorageSnapshotResult{{Result: &details}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SnapshotStorage", args, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	snapshot, err := storageClient.Snapshot(c.Context(), "foo/0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(snapshot, tc.DeepEquals, details)
+}
+
+func (s *storageMockSuite) TestSnapshotNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	_, err := storageClient.Snapshot(c.Context(), "foo/0")
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageMockSuite) TestListSnapshots(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.StorageSnapshotFilters{Filters: []params.StorageSnapshotFilter{{StorageTag: "storage-foo-0"}}}
+	details := []params.StorageSnapshotDetails{{
+		UUID:       "deadbeef",
+		StorageTag: "storage-foo-0",
+	}}
+	result := new(params.StorageSnapshotsResults)
+	results := params.StorageSnapshotsResults{
+		Results: []params.StorageSnapshotsResult{{Result: details}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListStorageSnapshots", args, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	snapshots, err := storageClient.ListSnapshots(c.Context(), "foo/0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(snapshots, tc.DeepEquals, details)
+}
+
+func (s *storageMockSuite) TestRemoveSnapshot(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RemoveStorageSnapshots{Snapshots: []string{"deadbeef"}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{Error: &params.Error{Message: "baz"}}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveStorageSnapshots", args, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	err := storageClient.RemoveSnapshot(c.Context(), "deadbeef")
+	c.Assert(err, tc.ErrorMatches, "baz")
+}
@@ -308,16 +308,96 @@ func (s *MigrationSpec) Validate() error {
 // but we don't need that at the client side yet (and may never) so
 // this call just supports starting one migration at a time.
 func (c *Client) InitiateMigration(ctx context.Context, spec MigrationSpec) (string, error) {
+	args, err := initiateMigrationArgs(spec)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	response := params.InitiateMigrationResults{}
+	if err := c.facade.FacadeCall(ctx, "InitiateMigration", args, &response); err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(response.Results) != 1 {
+		return "", errors.New("unexpected number of results returned")
+	}
+	result := response.Results[0]
+	if result.Error != nil {
+		return "", errors.Trace(result.Error)
+	}
+	return result.MigrationId, nil
+}
+
+// MigrationDryRunReport holds the outcome of a migration dry run.
+type MigrationDryRunReport struct {
+	// Blockers are the problems that prevent the model from being
+	// migrated.
+	Blockers []string
+
+	// Warnings are the problems that would prevent a migration that
+	// was started now, but which are expected to resolve themselves.
+	Warnings []string
+
+	// Transfer estimates the binaries that the migration sends to the
+	// target controller.
+	Transfer MigrationTransferEstimate
+}
+
+// MigrationTransferEstimate holds an estimate of the binaries that a
+// migration sends to the target controller.
+type MigrationTransferEstimate struct {
+	Charms           int
+	CharmBytes       int64
+	Resources        int
+	ResourceBytes    int64
+	AgentBinaries    int
+	AgentBinaryBytes int64
+}
+
+// DryRunMigration runs every migration precheck for the specified
+// model against the target controller, without starting the migration.
+func (c *Client) DryRunMigration(ctx context.Context, spec MigrationSpec) (MigrationDryRunReport, error) {
+	if c.BestAPIVersion() < 14 {
+		return MigrationDryRunReport{}, errors.NotSupportedf("migration dry run")
+	}
+	args, err := initiateMigrationArgs(spec)
+	if err != nil {
+		return MigrationDryRunReport{}, errors.Trace(err)
+	}
+	response := params.MigrationDryRunResults{}
+	if err := c.facade.FacadeCall(ctx, "DryRunMigration", args, &response); err != nil {
+		return MigrationDryRunReport{}, errors.Trace(err)
+	}
+	if len(response.Results) != 1 {
+		return MigrationDryRunReport{}, errors.New("unexpected number of results returned")
+	}
+	result := response.Results[0]
+	if result.Error != nil {
+		return MigrationDryRunReport{}, errors.Trace(result.Error)
+	}
+	return MigrationDryRunReport{
+		Blockers: result.Blockers,
+		Warnings: result.Warnings,
+		Transfer: MigrationTransferEstimate{
+			Charms:           result.Transfer.Charms,
+			CharmBytes:       result.Transfer.CharmBytes,
+			Resources:        result.Transfer.Resources,
+			ResourceBytes:    result.Transfer.ResourceBytes,
+			AgentBinaries:    result.Transfer.AgentBinaries,
+			AgentBinaryBytes: result.Transfer.AgentBinaryBytes,
+		},
+	}, nil
+}
+
+func initiateMigrationArgs(spec MigrationSpec) (params.InitiateMigrationArgs, error) {
 	if err := spec.Validate(); err != nil {
-		return "", errors.Annotatef(err, "client-side validation failed")
+		return params.InitiateMigrationArgs{}, errors.Annotatef(err, "client-side validation failed")
 	}
 
 	macsJSON, err := macaroonsToJSON(spec.TargetMacaroons)
 	if err != nil {
-		return "", errors.Annotatef(err, "client-side validation failed")
+		return params.InitiateMigrationArgs{}, errors.Annotatef(err, "client-side validation failed")
 	}
 
-	args := params.InitiateMigrationArgs{
+	return params.InitiateMigrationArgs{
 		Specs: []params.MigrationSpec{{
 			ModelTag: names.NewModelTag(spec.ModelUUID).String(),
 			TargetInfo: params.MigrationTargetInfo{
@@ -330,19 +410,7 @@ func (c *Client) InitiateMigration(ctx context.Context, spec MigrationSpec) (str
 				Macaroons:       macsJSON,
 			},
 		}},
-	}
-	response := params.InitiateMigrationResults{}
-	if err := c.facade.FacadeCall(ctx, "InitiateMigration", args, &response); err != nil {
-		return "", errors.Trace(err)
-	}
-	if len(response.Results) != 1 {
-		return "", errors.New("unexpected number of results returned")
-	}
-	result := response.Results[0]
-	if result.Error != nil {
-		return "", errors.Trace(result.Error)
-	}
-	return result.MigrationId, nil
+	}, nil
 }
 
 func macaroonsToJSON(macs []macaroon.Slice) (string, error) {
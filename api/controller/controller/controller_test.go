@@ -237,6 +237,75 @@ func (s *Suite) TestHostedModelConfigs_FormatResults(c *tc.C) {
 	c.Assert(second.Error.Error(), tc.Equals, "validating CloudSpec: empty Type not valid")
 }
 
+func (s *Suite) TestDryRunMigration(c *tc.C) {
+	var stub testhelpers.Stub
+	apiCaller := apitesting.BestVersionCaller{
+		BestVersion: 14,
+		APICallerFunc: func(objType string, version int, id, request string, arg, result interface{}) error {
+			stub.AddCall(objType+"."+request, arg)
+			out := result.(*params.MigrationDryRunResults)
+			*out = params.MigrationDryRunResults{
+				Results: []params.MigrationDryRunResult{{
+					Blockers: []string{"model is dying"},
+					Warnings: []string{"cleanup needed"},
+					Transfer: params.MigrationTransferEstimate{
+						Charms:     1,
+						CharmBytes: 1024,
+					},
+				}},
+			}
+			return nil
+		},
+	}
+	client := controller.NewClient(apiCaller)
+
+	spec := makeSpec()
+	report, err := client.DryRunMigration(c.Context(), spec)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report, tc.DeepEquals, controller.MigrationDryRunReport{
+		Blockers: []string{"model is dying"},
+		Warnings: []string{"cleanup needed"},
+		Transfer: controller.MigrationTransferEstimate{
+			Charms:     1,
+			CharmBytes: 1024,
+		},
+	})
+	stub.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "Controller.DryRunMigration", Args: []interface{}{specToArgs(spec)}},
+	})
+}
+
+func (s *Suite) TestDryRunMigrationError(c *tc.C) {
+	apiCaller := apitesting.BestVersionCaller{
+		BestVersion: 14,
+		APICallerFunc: func(objType string, version int, id, request string, arg, result interface{}) error {
+			out := result.(*params.MigrationDryRunResults)
+			*out = params.MigrationDryRunResults{
+				Results: []params.MigrationDryRunResult{{
+					Error: apiservererrors.ServerError(errors.New("boom")),
+				}},
+			}
+			return nil
+		},
+	}
+	client := controller.NewClient(apiCaller)
+	_, err := client.DryRunMigration(c.Context(), makeSpec())
+	c.Check(err, tc.ErrorMatches, "boom")
+}
+
+func (s *Suite) TestDryRunMigrationNotSupported(c *tc.C) {
+	apiCaller := apitesting.BestVersionCaller{
+		BestVersion: 13,
+		APICallerFunc: func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Fatalf("unexpected API call")
+			return nil
+		},
+	}
+	client := controller.NewClient(apiCaller)
+	_, err := client.DryRunMigration(c.Context(), makeSpec())
+	c.Check(err, tc.ErrorIs, errors.NotSupported)
+}
+
 func makeInitiateMigrationClient(results params.InitiateMigrationResults) (
 	*controller.Client, *testhelpers.Stub,
 ) {
@@ -58,12 +58,41 @@ func (c *Client) BestFacadeVersion() int {
 // Prechecks checks that the target controller is able to accept the
 // model being migrated.
 func (c *Client) Prechecks(ctx context.Context, model coremigration.ModelInfo) error {
+	args, err := migrationModelInfo(model)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(c.caller.FacadeCall(ctx, "Prechecks", args, nil))
+}
+
+// PrechecksReport runs every check that Prechecks does on the target
+// controller, without stopping at the first failure, and returns the
+// problems found.
+func (c *Client) PrechecksReport(ctx context.Context, model coremigration.ModelInfo) (coremigration.PrecheckReport, error) {
+	if c.caller.BestAPIVersion() < 7 {
+		return coremigration.PrecheckReport{}, errors.NotSupportedf("prechecks report")
+	}
+	args, err := migrationModelInfo(model)
+	if err != nil {
+		return coremigration.PrecheckReport{}, errors.Trace(err)
+	}
+	var result params.MigrationPrecheckReport
+	if err := c.caller.FacadeCall(ctx, "PrechecksReport", args, &result); err != nil {
+		return coremigration.PrecheckReport{}, errors.Trace(err)
+	}
+	return coremigration.PrecheckReport{
+		Blockers: result.Blockers,
+		Warnings: result.Warnings,
+	}, nil
+}
+
+func migrationModelInfo(model coremigration.ModelInfo) (params.MigrationModelInfo, error) {
 	// The model description is marshalled into YAML (description package does
 	// not support JSON) to prevent potential issues with
 	// marshalling/unmarshalling on the target API controller.
 	serialised, err := description.Serialize(model.ModelDescription)
 	if err != nil {
-		return errors.Annotate(err, "failed to marshal model description")
+		return params.MigrationModelInfo{}, errors.Annotate(err, "failed to marshal model description")
 	}
 
 	// Pass all the known facade versions to the controller so that it
@@ -76,7 +105,7 @@ func (c *Client) Prechecks(ctx context.Context, model coremigration.ModelInfo) e
 		versions[name] = version
 	}
 
-	args := params.MigrationModelInfo{
+	return params.MigrationModelInfo{
 		UUID:                   model.UUID,
 		Name:                   model.Name,
 		Qualifier:              model.Qualifier.String(),
@@ -84,8 +113,7 @@ func (c *Client) Prechecks(ctx context.Context, model coremigration.ModelInfo) e
 		ControllerAgentVersion: model.ControllerAgentVersion,
 		FacadeVersions:         versions,
 		ModelDescription:       serialised,
-	}
-	return errors.Trace(c.caller.FacadeCall(ctx, "Prechecks", args, nil))
+	}, nil
 }
 
 // Import takes a serialized model and imports it into the target
@@ -91,6 +91,42 @@ func (s *ClientSuite) TestPrechecks(c *tc.C) {
 	c.Check(arg, mc, expectedArg)
 }
 
+func (s *ClientSuite) TestPrechecksReport(c *tc.C) {
+	var stub testhelpers.Stub
+	apiCaller := apitesting.BestVersionCaller{APICallerFunc: apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		stub.AddCall(objType+"."+request, id, arg)
+		*(result.(*params.MigrationPrecheckReport)) = params.MigrationPrecheckReport{
+			Blockers: []string{"upgrade in progress"},
+			Warnings: []string{"model is being migrated out of target controller"},
+		}
+		return nil
+	}), BestVersion: 7}
+	client := migrationtarget.NewClient(apiCaller)
+
+	report, err := client.PrechecksReport(c.Context(), coremigration.ModelInfo{
+		UUID:             "uuid",
+		Qualifier:        "prod",
+		Name:             "name",
+		ModelDescription: description.NewModel(description.ModelArgs{}),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report, tc.DeepEquals, coremigration.PrecheckReport{
+		Blockers: []string{"upgrade in progress"},
+		Warnings: []string{"model is being migrated out of target controller"},
+	})
+	stub.CheckCallNames(c, "MigrationTarget.PrechecksReport")
+}
+
+func (s *ClientSuite) TestPrechecksReportNotSupported(c *tc.C) {
+	client, stub := s.getClientAndStub()
+
+	_, err := client.PrechecksReport(c.Context(), coremigration.ModelInfo{
+		ModelDescription: description.NewModel(description.ModelArgs{}),
+	})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+	stub.CheckNoCalls(c)
+}
+
 func (s *ClientSuite) TestImport(c *tc.C) {
 	client, stub := s.getClientAndStub()
 
@@ -6,6 +6,8 @@ package usersecrets
 import (
 	"context"
 
+	"github.com/juju/errors"
+
 	"github.com/juju/juju/api/base"
 	apiwatcher "github.com/juju/juju/api/watcher"
 	"github.com/juju/juju/core/watcher"
@@ -50,3 +52,40 @@ func (c *Client) WatchRevisionsToPrune(ctx context.Context) (watcher.NotifyWatch
 func (c *Client) DeleteObsoleteUserSecretRevisions(ctx context.Context) error {
 	return c.facade.FacadeCall(ctx, "DeleteObsoleteUserSecretRevisions", nil, nil)
 }
+
+// WatchUserSecretsRotationChanges returns a watcher which serves changes to
+// the rotation schedule of user secrets with a generator.
+func (c *Client) WatchUserSecretsRotationChanges(ctx context.Context) (watcher.SecretTriggerWatcher, error) {
+	if c.facade.BestAPIVersion() < 2 {
+		return nil, errors.NotSupportedf("user secret rotation")
+	}
+	var result params.SecretTriggerWatchResult
+	err := c.facade.FacadeCall(ctx, "WatchUserSecretsRotationChanges", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Error != nil {
+		return nil, params.TranslateWellKnownError(result.Error)
+	}
+	w := apiwatcher.NewSecretsTriggerWatcher(c.facade.RawAPICaller(), result)
+	return w, nil
+}
+
+// RotateUserSecrets generates new revisions for the specified user secrets.
+func (c *Client) RotateUserSecrets(ctx context.Context, uris ...string) error {
+	if c.facade.BestAPIVersion() < 2 {
+		return errors.NotSupportedf("user secret rotation")
+	}
+	args := params.SecretURIArgs{
+		Args: make([]params.SecretURIArg, len(uris)),
+	}
+	for i, uri := range uris {
+		args.Args[i] = params.SecretURIArg{URI: uri}
+	}
+	var results params.ErrorResults
+	err := c.facade.FacadeCall(ctx, "RotateUserSecrets", args, &results)
+	if err != nil {
+		return err
+	}
+	return results.Combine()
+}
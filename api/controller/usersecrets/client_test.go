@@ -63,3 +63,53 @@ func (s *secretSuite) TestDeleteObsoleteUserSecretRevisions(c *tc.C) {
 	err := client.DeleteObsoleteUserSecretRevisions(c.Context())
 	c.Assert(err, tc.ErrorMatches, "boom")
 }
+
+func (s *secretSuite) TestWatchUserSecretsRotationChanges(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, tc.Equals, "UserSecretsManager")
+		c.Check(version, tc.Equals, 2)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "WatchUserSecretsRotationChanges")
+		c.Check(arg, tc.IsNil)
+		c.Assert(result, tc.FitsTypeOf, &params.SecretTriggerWatchResult{})
+		*(result.(*params.SecretTriggerWatchResult)) = params.SecretTriggerWatchResult{
+			Error: &params.Error{Message: "FAIL"},
+		}
+		return nil
+	})
+	client := usersecrets.NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2})
+	_, err := client.WatchUserSecretsRotationChanges(c.Context())
+	c.Assert(err, tc.ErrorMatches, "FAIL")
+}
+
+func (s *secretSuite) TestRotateUserSecrets(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, tc.Equals, "UserSecretsManager")
+		c.Check(version, tc.Equals, 2)
+		c.Check(id, tc.Equals, "")
+		c.Check(request, tc.Equals, "RotateUserSecrets")
+		c.Check(arg, tc.DeepEquals, params.SecretURIArgs{
+			Args: []params.SecretURIArg{{URI: "secret:9m4e2mr0ui3e8a215n4g"}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "boom"}}},
+		}
+		return nil
+	})
+	client := usersecrets.NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2})
+	err := client.RotateUserSecrets(c.Context(), "secret:9m4e2mr0ui3e8a215n4g")
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *secretSuite) TestRotateUserSecretsNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected api call %q", request)
+		return nil
+	})
+	client := usersecrets.NewClient(testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 1})
+	_, err := client.WatchUserSecretsRotationChanges(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+	err = client.RotateUserSecrets(c.Context(), "secret:9m4e2mr0ui3e8a215n4g")
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
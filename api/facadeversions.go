@@ -26,10 +26,11 @@ var facadeVersions = facades.FacadeVersions{
 	"AgentLifeFlag":                {1},
 	"AgentTools":                   {1},
 	"Annotations":                  {2},
-	"Application":                  {19, 20},
+	"Application":                  {19, 20, 21},
 	"ApplicationOffers":            {5, 6},
+	"AuditLog":                     {1},
 	"Backups":                      {3},
-	"Block":                        {2},
+	"Block":                        {2, 3},
 	"Bundle":                       {8},
 	"CAASAgent":                    {2},
 	"CAASAdmission":                {1},
@@ -38,11 +39,13 @@ var facadeVersions = facades.FacadeVersions{
 	"CAASModelConfigManager":       {1},
 	"CAASModelOperator":            {1},
 	"CAASOperatorUpgrader":         {1},
+	"ChangeFeed":                   {1},
+	"ChangeFeedWatcher":            {1},
 	"Charms":                       {7},
 	"Cleaner":                      {2},
 	"Client":                       {8},
 	"Cloud":                        {7},
-	"Controller":                   {12, 13},
+	"Controller":                   {12, 13, 14},
 	"CredentialManager":            {1},
 	"CredentialValidator":          {2, 3},
 	"CrossController":              {1},
@@ -61,7 +64,7 @@ var facadeVersions = facades.FacadeVersions{
 	"InstanceMutater":              {3},
 	"InstancePoller":               {4},
 	"KeyManager":                   {1},
-	"KeyUpdater":                   {1},
+	"KeyUpdater":                   {1, 2},
 	"LeadershipService":            {2},
 	"Logger":                       {1},
 	"MachineActions":               {1},
@@ -72,12 +75,13 @@ var facadeVersions = facades.FacadeVersions{
 	"MigrationMaster":              {4, 5},
 	"MigrationMinion":              {1},
 	"MigrationStatusWatcher":       {1},
-	"MigrationTarget":              {4, 5, 6},
+	"MigrationTarget":              {4, 5, 6, 7},
 	"ModelConfig":                  {3, 4},
 	"ModelManager":                 {9, 10, 11},
 	"ModelSummaryWatcher":          {1},
 	"ModelUpgrader":                {1},
 	"NotifyWatcher":                {1},
+	"ObjectStore":                  {1},
 	"OfferStatusWatcher":           {1},
 	"Pinger":                       {1},
 	"Provisioner":                  {11},
@@ -87,6 +91,7 @@ var facadeVersions = facades.FacadeVersions{
 	"RelationUnitsWatcher":         {1},
 	"RemoteRelations":              {2},
 	"RemoteRelationWatcher":        {1},
+	"Removal":                      {1},
 	"Resources":                    {3},
 	"ResourcesHookContext":         {1},
 	"RetryStrategy":                {1},
@@ -95,20 +100,21 @@ var facadeVersions = facades.FacadeVersions{
 	"SecretBackendsManager":        {1},
 	"SecretBackendsRotateWatcher":  {1},
 	"SecretsRevisionWatcher":       {1},
-	"Secrets":                      {1, 2},
+	"Secrets":                      {1, 2, 3, 4},
 	"SecretsManager":               {3},
 	"SecretsDrain":                 {1},
+	"SecretsMigration":             {1},
 	"UserSecretsDrain":             {1},
-	"UserSecretsManager":           {1},
+	"UserSecretsManager":           {1, 2},
 	"Spaces":                       {6},
-	"SSHClient":                    {4, 5},
-	"Storage":                      {6},
-	"StorageProvisioner":           {4},
+	"SSHClient":                    {4, 5, 6, 7},
+	"Storage":                      {6, 7, 8},
+	"StorageProvisioner":           {4, 5},
 	"StringsWatcher":               {1},
 	"Subnets":                      {5},
 	"Undertaker":                   {1},
 	"UnitAssigner":                 {1},
-	"Uniter":                       {19, 20, 21},
+	"Uniter":                       {19, 20, 21, 22, 23},
 	"Upgrader":                     {1},
 	"UserManager":                  {3},
 	"VolumeAttachmentsWatcher":     {2},
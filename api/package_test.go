@@ -59,6 +59,7 @@ func (*ImportSuite) TestImports(c *tc.C) {
 		"internal/charm/assumes",
 		"internal/charm/hooks",
 		"internal/charm/resource",
+		"internal/cron",
 		"internal/errors",
 		"internal/featureflag",
 		"internal/http",
@@ -1200,3 +1200,75 @@ func (w *SecretsRevisionWatcher) loop(initialChanges []params.SecretRevisionChan
 func (w *SecretsRevisionWatcher) Changes() watcher.SecretRevisionChannel {
 	return w.out
 }
+
+// changeFeedWatcher will send notifications of changes from the change feed.
+type changeFeedWatcher struct {
+	commonWatcher
+	caller    base.APICaller
+	watcherId string
+	out       chan []watcher.ChangeEvent
+}
+
+// NewChangeFeedWatcher returns a new change feed watcher.
+func NewChangeFeedWatcher(
+	caller base.APICaller, result params.ChangeFeedWatchResult,
+) watcher.ChangesWatcher {
+	w := &changeFeedWatcher{
+		caller:    caller,
+		watcherId: result.WatcherId,
+		out:       make(chan []watcher.ChangeEvent),
+	}
+	w.newResult = func() interface{} { return new(params.ChangeFeedWatchResult) }
+	w.tomb.Go(func() error {
+		defer close(w.out)
+		return w.loop(result.Changes)
+	})
+	return w
+}
+
+func (w *changeFeedWatcher) loop(initialChanges []params.ChangeFeedEvent) error {
+	w.call = makeWatcherAPICaller(w.caller, "ChangeFeedWatcher", w.watcherId)
+	w.commonWatcher.init()
+	go w.commonLoop()
+
+	copyChanges := func(changes []params.ChangeFeedEvent) []watcher.ChangeEvent {
+		result := make([]watcher.ChangeEvent, len(changes))
+		for i, ch := range changes {
+			result[i] = watcher.ChangeEvent{
+				ID:        ch.ID,
+				Namespace: ch.Namespace,
+				Changed:   ch.Changed,
+				Deleted:   ch.Deleted,
+			}
+		}
+		return result
+	}
+	out := w.out
+	changes := copyChanges(initialChanges)
+	for {
+		select {
+		case <-w.tomb.Dying():
+			return tomb.ErrDying
+		// Read the next change.
+		case data, ok := <-w.in:
+			if !ok {
+				// The tomb is already killed with the correct error
+				// at this point, so just return.
+				return nil
+			}
+			// The order of the changes is significant, so they're
+			// appended rather than merged.
+			changes = append(changes, copyChanges(data.(*params.ChangeFeedWatchResult).Changes)...)
+			out = w.out
+		case out <- changes:
+			out = nil
+			changes = nil
+		}
+	}
+}
+
+// Changes returns a channel that will receive the changes from the
+// change feed, in the order that they were made.
+func (w *changeFeedWatcher) Changes() <-chan []watcher.ChangeEvent {
+	return w.out
+}
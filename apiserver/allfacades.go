@@ -40,9 +40,11 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/annotations" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/application"
 	"github.com/juju/juju/apiserver/facades/client/applicationoffers" // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/auditlog"          // Controller Superuser
 	"github.com/juju/juju/apiserver/facades/client/backups"           // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/block"             // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/bundle"
+	"github.com/juju/juju/apiserver/facades/client/changefeed" // ModelUser Read
 	"github.com/juju/juju/apiserver/facades/client/charms"     // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/client"     // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/cloud"      // ModelUser Read
@@ -55,10 +57,13 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/modelconfig"    // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/modelmanager"   // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/modelupgrader"
+	"github.com/juju/juju/apiserver/facades/client/objectstore" // Controller Superuser
 	"github.com/juju/juju/apiserver/facades/client/pinger"
+	"github.com/juju/juju/apiserver/facades/client/removal" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/resources"
 	"github.com/juju/juju/apiserver/facades/client/secretbackends"
 	"github.com/juju/juju/apiserver/facades/client/secrets"
+	"github.com/juju/juju/apiserver/facades/client/secretsmigration"
 	"github.com/juju/juju/apiserver/facades/client/spaces"    // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/sshclient" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/storage"
@@ -161,9 +166,11 @@ func AllFacades() *facade.Registry {
 	annotations.Register(registry)
 	application.Register(registry)
 	applicationoffers.Register(registry)
+	auditlog.Register(registry)
 	backups.Register(registry)
 	block.Register(registry)
 	bundle.Register(registry)
+	changefeed.Register(registry)
 	charms.Register(registry)
 	cleaner.Register(registry)
 	client.Register(registry)
@@ -210,12 +217,14 @@ func AllFacades() *facade.Registry {
 	modelconfig.Register(registry)
 	modelmanager.Register(registry)
 	modelupgrader.Register(registry)
+	objectstore.Register(registry)
 	payloadshookcontext.Register(registry)
 	pinger.Register(registry)
 	provisioner.Register(registry)
 	proxyupdater.Register(registry)
 	reboot.Register(registry)
 	remoterelations.Register(registry)
+	removal.Register(registry)
 	resources.Register(registry)
 	resourceshookcontext.Register(registry)
 	retrystrategy.Register(registry)
@@ -224,6 +233,7 @@ func AllFacades() *facade.Registry {
 	secretbackendmanager.Register(registry)
 	secretsmanager.Register(registry)
 	secretsdrain.Register(registry)
+	secretsmigration.Register(registry)
 	usersecrets.Register(registry)
 	usersecretsdrain.Register(registry)
 	sshclient.Register(registry)
@@ -281,4 +291,5 @@ func registerWatchers(registry *facade.Registry) {
 	registry.MustRegister("SecretsTriggerWatcher", 1, newSecretsTriggerWatcher, reflect.TypeOf((*srvSecretTriggerWatcher)(nil)))
 	registry.MustRegister("SecretBackendsRotateWatcher", 1, newSecretBackendsRotateWatcher, reflect.TypeOf((*srvSecretBackendsRotateWatcher)(nil)))
 	registry.MustRegister("SecretsRevisionWatcher", 1, newSecretsRevisionWatcher, reflect.TypeOf((*srvSecretsRevisionWatcher)(nil)))
+	registry.MustRegister("ChangeFeedWatcher", 1, newChangeFeedWatcher, reflect.TypeOf((*srvChangeFeedWatcher)(nil)))
 }
@@ -31,6 +31,7 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/httpcontext"
+	handlersbackups "github.com/juju/juju/apiserver/internal/handlers/backups"
 	"github.com/juju/juju/apiserver/internal/handlers/objects"
 	handlersresources "github.com/juju/juju/apiserver/internal/handlers/resources"
 	resourcesdownload "github.com/juju/juju/apiserver/internal/handlers/resources/download"
@@ -49,6 +50,7 @@ import (
 	coreresource "github.com/juju/juju/core/resource"
 	coretrace "github.com/juju/juju/core/trace"
 	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/internal/backups"
 	internalerrors "github.com/juju/juju/internal/errors"
 	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/internal/resource"
@@ -849,6 +851,13 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		&migratingResourceServiceGetter{ctxt: httpCtxt},
 		logger,
 	), "applications")
+	backupsDownloadHandler := srv.monitoredHandler(handlersbackups.NewBackupsHTTPHandler(
+		&backupDirGetter{ctxt: httpCtxt},
+	), "backups")
+	modelDBHandler := srv.monitoredHandler(handlersbackups.NewModelDBHTTPHandler(
+		&modelDBServiceGetter{ctxt: httpCtxt},
+		srv.clock,
+	), "db-snapshot")
 	registerHandler := srv.monitoredHandler(&registerUserHandler{
 		ctxt: httpCtxt,
 	}, "register")
@@ -906,6 +915,16 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		handler:    logTransferHandler,
 		tracked:    true,
 		authorizer: controllerAdminAuthorizer,
+	}, {
+		pattern:    modelRoutePrefix + "/backups",
+		methods:    []string{"GET"},
+		handler:    backupsDownloadHandler,
+		authorizer: controllerAdminAuthorizer,
+	}, {
+		pattern:    modelRoutePrefix + "/db-snapshot",
+		methods:    []string{"GET", "PUT"},
+		handler:    modelDBHandler,
+		authorizer: controllerAdminAuthorizer,
 	}, {
 		pattern:         "/api",
 		handler:         mainAPIHandler,
@@ -1255,6 +1274,55 @@ func (a *objectStoreServiceGetter) ObjectStore(r *http.Request) (objects.ObjectS
 	return objectStore, nil
 }
 
+type backupDirGetter struct {
+	ctxt httpContext
+}
+
+// BackupDir returns the directory in which backups of the controller are
+// created. Backups can only be downloaded through the controller model.
+func (a *backupDirGetter) BackupDir(r *http.Request) (string, error) {
+	modelUUID, ok := httpcontext.RequestModelUUID(r.Context())
+	if !ok {
+		return "", errors.Trace(apiservererrors.ErrPerm)
+	}
+	if coremodel.UUID(modelUUID) != a.ctxt.srv.shared.controllerModelUUID {
+		return "", errors.BadRequestf("backups are only supported from the controller model")
+	}
+
+	domainServices, err := a.ctxt.domainServicesForRequest(r.Context())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	cfg, err := domainServices.Config().ModelConfig(r.Context())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return backups.BackupDir(cfg.BackupDir()), nil
+}
+
+type modelDBServiceGetter struct {
+	ctxt httpContext
+}
+
+// ModelDB returns the service for exporting and importing the database of
+// the request's model.
+func (a *modelDBServiceGetter) ModelDB(r *http.Request) (handlersbackups.ModelDBService, error) {
+	domainServices, err := a.ctxt.domainServicesForRequest(r.Context())
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return domainServices.Backup(), nil
+}
+
+// ObjectStore returns the object store of the request's model.
+func (a *modelDBServiceGetter) ObjectStore(r *http.Request) (objectstore.ObjectStore, error) {
+	objectStore, err := a.ctxt.objectStoreForRequest(r.Context())
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return objectStore, nil
+}
+
 type resourceServiceGetter struct {
 	ctxt httpContext
 }
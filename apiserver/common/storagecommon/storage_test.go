@@ -110,6 +110,7 @@ func (s *VolumeStorageAttachmentInfoSuite) TestStorageAttachmentPlanInfoDeviceNa
 	c.Assert(info, tc.DeepEquals, &storage.StorageAttachmentInfo{
 		Kind:     storage.StorageKindBlock,
 		Location: "/dev/sdb",
+		Size:     1024,
 	})
 	s.blockDeviceGetter.CheckCallNames(c, "BlockDevices")
 }
@@ -122,6 +123,7 @@ func (s *VolumeStorageAttachmentInfoSuite) TestStorageAttachmentInfoPersistentDe
 	c.Assert(info, tc.DeepEquals, &storage.StorageAttachmentInfo{
 		Kind:     storage.StorageKindBlock,
 		Location: "/dev/sda",
+		Size:     1024,
 	})
 	s.blockDeviceGetter.CheckCallNames(c, "BlockDevices")
 }
@@ -148,6 +150,7 @@ func (s *VolumeStorageAttachmentInfoSuite) TestStorageAttachmentInfoPersistentDe
 	c.Assert(info, tc.DeepEquals, &storage.StorageAttachmentInfo{
 		Kind:     storage.StorageKindBlock,
 		Location: "/dev/sda",
+		Size:     1024,
 	})
 	s.blockDeviceGetter.CheckCallNames(c, "BlockDevices")
 }
@@ -160,6 +163,7 @@ func (s *VolumeStorageAttachmentInfoSuite) TestStorageAttachmentInfoPersistentDe
 	c.Assert(info, tc.DeepEquals, &storage.StorageAttachmentInfo{
 		Kind:     storage.StorageKindBlock,
 		Location: "/dev/disk/by-id/verbatim",
+		Size:     1024,
 	})
 	s.blockDeviceGetter.CheckCallNames(c, "BlockDevices")
 }
@@ -172,6 +176,7 @@ func (s *VolumeStorageAttachmentInfoSuite) TestStorageAttachmentInfoPersistentHa
 	c.Assert(info, tc.DeepEquals, &storage.StorageAttachmentInfo{
 		Kind:     storage.StorageKindBlock,
 		Location: "/dev/disk/by-id/whatever",
+		Size:     1024,
 	})
 	s.blockDeviceGetter.CheckCallNames(c, "BlockDevices")
 }
@@ -184,6 +189,7 @@ func (s *VolumeStorageAttachmentInfoSuite) TestStorageAttachmentInfoPersistentWW
 	c.Assert(info, tc.DeepEquals, &storage.StorageAttachmentInfo{
 		Kind:     storage.StorageKindBlock,
 		Location: "/dev/disk/by-id/wwn-drbr",
+		Size:     1024,
 	})
 	s.blockDeviceGetter.CheckCallNames(c, "BlockDevices")
 }
@@ -205,6 +211,7 @@ func (s *VolumeStorageAttachmentInfoSuite) TestStorageAttachmentInfoMatchingBloc
 	c.Assert(info, tc.DeepEquals, &storage.StorageAttachmentInfo{
 		Kind:     storage.StorageKindBlock,
 		Location: "/dev/sdb",
+		Size:     1024,
 	})
 	s.blockDeviceGetter.CheckCallNames(c, "BlockDevices")
 }
@@ -287,6 +294,7 @@ func (s *FilesystemStorageAttachmentInfoSuite) TestStorageAttachmentInfo(c *tc.C
 	c.Assert(info, tc.DeepEquals, &storage.StorageAttachmentInfo{
 		Kind:     storage.StorageKindFilesystem,
 		Location: "/path/to/here",
+		Size:     1024,
 	})
 }
 
@@ -40,11 +40,12 @@ func VolumeParams(
 	registry storage.ProviderRegistry,
 ) (params.VolumeParams, error) {
 
-	var pool string
+	var pool, snapshotId string
 	var size uint64
 	if stateVolumeParams, ok := v.Params(); ok {
 		pool = stateVolumeParams.Pool
 		size = stateVolumeParams.Size
+		snapshotId = stateVolumeParams.SnapshotId
 	} else {
 		volumeInfo, err := v.Info()
 		if err != nil {
@@ -70,6 +71,8 @@ func VolumeParams(
 		cfg.Attrs(),
 		volumeTags,
 		nil, // attachment params set by the caller
+		snapshotId,
+		cfg.Name(),
 	}, nil
 }
 
@@ -145,9 +148,11 @@ func VolumeFromState(v state.Volume) (params.Volume, error) {
 	if err != nil {
 		return params.Volume{}, errors.Trace(err)
 	}
+	requestedSize, _ := v.RequestedSize()
 	return params.Volume{
-		v.VolumeTag().String(),
-		VolumeInfoFromState(info),
+		VolumeTag:     v.VolumeTag().String(),
+		Info:          VolumeInfoFromState(info),
+		RequestedSize: requestedSize,
 	}, nil
 }
 
@@ -94,3 +94,20 @@ func (*volumesSuite) TestVolumeParamsStorageTags(c *tc.C) {
 		},
 	})
 }
+
+func (*volumesSuite) TestVolumeParamsFromSnapshot(c *tc.C) {
+	p, err := storagecommon.VolumeParams(
+		c.Context(),
+		&fakeVolume{tag: names.NewVolumeTag("100"), params: &state.VolumeParams{
+			Pool: "loop", Size: 1024, SnapshotId: "snap-0",
+		}},
+		nil, // StorageInstance
+		testing.ModelTag.Id(),
+		testing.ControllerTag.Id(),
+		testing.CustomModelConfig(c, nil),
+		&fakeStoragePoolGetter{},
+		provider.CommonStorageProviders(),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(p.SnapshotId, tc.Equals, "snap-0")
+}
@@ -9,12 +9,16 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
+	"github.com/juju/worker/v4"
 
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/internal"
 	coremachine "github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	"github.com/juju/juju/rpc/params"
 )
@@ -22,9 +26,19 @@ import (
 // KeyUpdaterAPI implements the KeyUpdater interface and is the concrete
 // implementation of the api end point.
 type KeyUpdaterAPI struct {
-	getCanRead        common.GetAuthFunc
-	keyUpdaterService KeyUpdaterService
-	watcherRegistry   facade.WatcherRegistry
+	getCanRead         common.GetAuthFunc
+	keyUpdaterService  KeyUpdaterService
+	sshCAService       SSHCertificateAuthorityService
+	modelConfigService ModelConfigService
+	modelUUID          model.UUID
+	watcherRegistry    facade.WatcherRegistry
+}
+
+// KeyUpdaterAPIV1 provides the KeyUpdater API facade version 1, which
+// doesn't have SSHCertificateAuthority and reports the keys of the model's
+// users in AuthorisedKeys.
+type KeyUpdaterAPIV1 struct {
+	*KeyUpdaterAPI
 }
 
 // newKeyUpdaterAPI constructs a new [KeyUpdaterAPI] for use in the Juju facade
@@ -32,17 +46,24 @@ type KeyUpdaterAPI struct {
 func newKeyUpdaterAPI(
 	getCanRead common.GetAuthFunc,
 	keyUpdaterService KeyUpdaterService,
+	sshCAService SSHCertificateAuthorityService,
+	modelConfigService ModelConfigService,
+	modelUUID model.UUID,
 	watcherRegistry facade.WatcherRegistry,
 ) *KeyUpdaterAPI {
 	return &KeyUpdaterAPI{
-		getCanRead:        getCanRead,
-		keyUpdaterService: keyUpdaterService,
-		watcherRegistry:   watcherRegistry,
+		getCanRead:         getCanRead,
+		keyUpdaterService:  keyUpdaterService,
+		sshCAService:       sshCAService,
+		modelConfigService: modelConfigService,
+		modelUUID:          modelUUID,
+		watcherRegistry:    watcherRegistry,
 	}
 }
 
 // WatchAuthorisedKeys starts a watcher to track changes to the authorised ssh
-// keys for the specified machines.
+// keys for the specified machines. Changes to the model config are included,
+// as they decide whether the keys of the model's users are authorised.
 // The following param error codes can be expected:
 // - [params.CodeTagInvalid] When a tag provided does not parse and is
 // considered invalid.
@@ -114,6 +135,14 @@ func (api *KeyUpdaterAPI) WatchAuthorisedKeys(ctx context.Context, arg params.En
 			)
 		}
 
+		keysWatcher, err = api.withModelConfigChanges(ctx, keysWatcher)
+		if err != nil {
+			return params.NotifyWatchResults{}, fmt.Errorf(
+				"cannot watch model config for machine %q authorised keys: %w",
+				machineId, err,
+			)
+		}
+
 		results[i].NotifyWatcherId, _, err = internal.EnsureRegisterWatcher[struct{}](
 			ctx, api.watcherRegistry, keysWatcher,
 		)
@@ -127,9 +156,32 @@ func (api *KeyUpdaterAPI) WatchAuthorisedKeys(ctx context.Context, arg params.En
 	return params.NotifyWatchResults{Results: results}, nil
 }
 
+// withModelConfigChanges returns a watcher that notifies of the changes
+// reported by keysWatcher and of any changes to the model config.
+func (api *KeyUpdaterAPI) withModelConfigChanges(
+	ctx context.Context,
+	keysWatcher watcher.NotifyWatcher,
+) (watcher.NotifyWatcher, error) {
+	configWatcher, err := api.modelConfigService.Watch()
+	if err != nil {
+		_ = worker.Stop(keysWatcher)
+		return nil, err
+	}
+	configNotifyWatcher, err := watcher.Normalise(configWatcher)
+	if err != nil {
+		_ = worker.Stop(keysWatcher)
+		return nil, err
+	}
+	return eventsource.NewMultiNotifyWatcher(ctx, keysWatcher, configNotifyWatcher)
+}
+
 // AuthorisedKeys reports the authorised ssh keys for the specified machines.
-// The current implementation fetches all keys on the model that have been
-// granted for use on a machine.
+// The machines trust the SSH certificate authority of the controller, so
+// users can log into them with short-lived certificates. The keys of the
+// model's users are reported alongside the controller wide keys unless the
+// model is configured with ssh-user-certificates-only, in which case only
+// the controller wide keys are reported, so that revoking a user's access to
+// the model revokes their access to its machines.
 // The following param error codes can be expected:
 // - [params.CodeTagInvalid] When a tag provided does not parse and is
 // considered invalid.
@@ -143,6 +195,44 @@ func (api *KeyUpdaterAPI) WatchAuthorisedKeys(ctx context.Context, arg params.En
 func (api *KeyUpdaterAPI) AuthorisedKeys(
 	ctx context.Context,
 	arg params.Entities,
+) (params.StringsResults, error) {
+	return api.authorisedKeys(ctx, arg, api.getAuthorisedKeysForMachine)
+}
+
+// getAuthorisedKeysForMachine returns the authorised keys for the machine,
+// leaving out the keys of the model's users when the model is configured for
+// them to log in only with certificates.
+func (api *KeyUpdaterAPI) getAuthorisedKeysForMachine(
+	ctx context.Context,
+	machineName coremachine.Name,
+) ([]string, error) {
+	cfg, err := api.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting model config: %w", err)
+	}
+	if cfg.SSHUserCertificatesOnly() {
+		return api.keyUpdaterService.GetControllerAuthorisedKeysForMachine(ctx, machineName)
+	}
+	return api.keyUpdaterService.GetAuthorisedKeysForMachine(ctx, machineName)
+}
+
+// AuthorisedKeys reports the authorised ssh keys for the specified machines.
+// Machines using version 1 of the facade don't trust the SSH certificate
+// authority of the controller, so all keys on the model that have been
+// granted for use on a machine are always reported.
+func (api *KeyUpdaterAPIV1) AuthorisedKeys(
+	ctx context.Context,
+	arg params.Entities,
+) (params.StringsResults, error) {
+	return api.authorisedKeys(ctx, arg, api.keyUpdaterService.GetAuthorisedKeysForMachine)
+}
+
+// authorisedKeys reports the authorised ssh keys for the specified machines,
+// as returned by getKeys.
+func (api *KeyUpdaterAPI) authorisedKeys(
+	ctx context.Context,
+	arg params.Entities,
+	getKeys func(context.Context, coremachine.Name) ([]string, error),
 ) (params.StringsResults, error) {
 	if len(arg.Entities) == 0 {
 		return params.StringsResults{}, nil
@@ -188,7 +278,7 @@ func (api *KeyUpdaterAPI) AuthorisedKeys(
 		}
 
 		machineName := coremachine.Name(tag.Id())
-		keys, err := api.keyUpdaterService.GetAuthorisedKeysForMachine(ctx, machineName)
+		keys, err := getKeys(ctx, machineName)
 
 		switch {
 		case errors.Is(err, errors.NotValid):
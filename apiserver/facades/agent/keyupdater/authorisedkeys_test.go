@@ -17,18 +17,23 @@ import (
 	facademocks "github.com/juju/juju/apiserver/facade/mocks"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	coremachine "github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/watchertest"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
 
 type authorisedKeysSuite struct {
-	authorizer        apiservertesting.FakeAuthorizer
-	keyUpdaterService *MockKeyUpdaterService
-	machineTag        names.MachineTag
-	watcherRegistry   *facademocks.MockWatcherRegistry
+	authorizer         apiservertesting.FakeAuthorizer
+	keyUpdaterService  *MockKeyUpdaterService
+	sshCAService       *MockSSHCertificateAuthorityService
+	modelConfigService *MockModelConfigService
+	machineTag         names.MachineTag
+	modelUUID          model.UUID
+	watcherRegistry    *facademocks.MockWatcherRegistry
 }
 
 func TestAuthorisedKeysSuite(t *stdtesting.T) {
@@ -38,12 +43,15 @@ func TestAuthorisedKeysSuite(t *stdtesting.T) {
 func (s *authorisedKeysSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.keyUpdaterService = NewMockKeyUpdaterService(ctrl)
+	s.sshCAService = NewMockSSHCertificateAuthorityService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
 	s.watcherRegistry = facademocks.NewMockWatcherRegistry(ctrl)
 	return ctrl
 }
 
 func (s *authorisedKeysSuite) SetUpTest(c *tc.C) {
 	s.machineTag = names.NewMachineTag("0")
+	s.modelUUID = model.UUID("8419cd78-4993-4c3a-928e-c646226beeee")
 
 	// The default auth is as a controller
 	s.authorizer = apiservertesting.FakeAuthorizer{
@@ -59,7 +67,7 @@ func (s *authorisedKeysSuite) getCanRead(context.Context) (common.AuthFunc, erro
 // authorised keys for zero entities.
 func (s *authorisedKeysSuite) TestWatchAuthorisedKeysNothing(c *tc.C) {
 	endPoint := newKeyUpdaterAPI(
-		s.getCanRead, s.keyUpdaterService, s.watcherRegistry,
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
 	)
 	results, err := endPoint.WatchAuthorisedKeys(c.Context(), params.Entities{})
 	c.Assert(err, tc.ErrorIsNil)
@@ -73,7 +81,7 @@ func (s *authorisedKeysSuite) TestWatchAuthorisedKeys(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	endPoint := newKeyUpdaterAPI(
-		s.getCanRead, s.keyUpdaterService, s.watcherRegistry,
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
 	)
 
 	args := params.Entities{
@@ -107,6 +115,9 @@ func (s *authorisedKeysSuite) TestWatchAuthorisedKeys(c *tc.C) {
 		})
 		return w, nil
 	})
+	configCh := make(chan []string, 1)
+	configCh <- []string{"ssh-user-certificates-only"}
+	s.modelConfigService.EXPECT().Watch().Return(watchertest.NewMockStringsWatcher(configCh), nil)
 	s.watcherRegistry.EXPECT().Register(gomock.Any()).Return("1", nil)
 
 	result, err := endPoint.WatchAuthorisedKeys(c.Context(), args)
@@ -130,7 +141,7 @@ func (s *authorisedKeysSuite) TestWatchAuthorisedKeys(c *tc.C) {
 // for zero machines we back an empty result with no errors.
 func (s *authorisedKeysSuite) TestAuthorisedKeysForNone(c *tc.C) {
 	endPoint := newKeyUpdaterAPI(
-		s.getCanRead, s.keyUpdaterService, s.watcherRegistry,
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
 	)
 	// Not an error to watch nothing
 	results, err := endPoint.AuthorisedKeys(c.Context(), params.Entities{})
@@ -139,12 +150,13 @@ func (s *authorisedKeysSuite) TestAuthorisedKeysForNone(c *tc.C) {
 }
 
 // TestAuthorisedKeys is asserting that the caller can get back authorised keys
-// for the authenticated machine. For any other machines that the caller is not
-// authenticated for we back unauthorised errors.
+// for the authenticated machine, including those of the model's users. For any
+// other machines that the caller is not authenticated for we back unauthorised
+// errors.
 func (s *authorisedKeysSuite) TestAuthorisedKeys(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	endPoint := newKeyUpdaterAPI(
-		s.getCanRead, s.keyUpdaterService, s.watcherRegistry,
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
 	)
 
 	args := params.Entities{
@@ -155,6 +167,7 @@ func (s *authorisedKeysSuite) TestAuthorisedKeys(c *tc.C) {
 		},
 	}
 
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(testing.ModelConfig(c), nil)
 	s.keyUpdaterService.EXPECT().GetAuthorisedKeysForMachine(gomock.Any(), coremachine.Name("0")).
 		Return([]string{"key1", "key2"}, nil)
 
@@ -175,12 +188,70 @@ func (s *authorisedKeysSuite) TestAuthorisedKeys(c *tc.C) {
 	})
 }
 
+// TestAuthorisedKeysUserCertificatesOnly is asserting that when the model is
+// configured for users to log in only with certificates, the keys of the
+// model's users are not reported and only the controller wide keys are.
+func (s *authorisedKeysSuite) TestAuthorisedKeysUserCertificatesOnly(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	endPoint := newKeyUpdaterAPI(
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
+	)
+
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: s.machineTag.String()},
+		},
+	}
+
+	cfg := testing.CustomModelConfig(c, testing.Attrs{
+		config.SSHUserCertificatesOnlyKey: true,
+	})
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+	s.keyUpdaterService.EXPECT().GetControllerAuthorisedKeysForMachine(gomock.Any(), coremachine.Name("0")).
+		Return([]string{"controller-key"}, nil)
+
+	result, err := endPoint.AuthorisedKeys(c.Context(), args)
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{
+			{Result: []string{"controller-key"}},
+		},
+	})
+}
+
+// TestAuthorisedKeysV1 is asserting that callers of version 1 of the facade,
+// which don't trust the SSH certificate authority, get back all the authorised
+// keys for the machine including those of the model's users.
+func (s *authorisedKeysSuite) TestAuthorisedKeysV1(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	endPoint := &KeyUpdaterAPIV1{KeyUpdaterAPI: newKeyUpdaterAPI(
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
+	)}
+
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: s.machineTag.String()},
+		},
+	}
+
+	s.keyUpdaterService.EXPECT().GetAuthorisedKeysForMachine(gomock.Any(), coremachine.Name("0")).
+		Return([]string{"controller-key", "user-key"}, nil)
+
+	result, err := endPoint.AuthorisedKeys(c.Context(), args)
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{
+			{Result: []string{"controller-key", "user-key"}},
+		},
+	})
+}
+
 // TestAuthorisedKeysForNonMachineEntity is asserting that if we try and get
 // authorised keys for a non machine enitity we get back a
 // [params.CodeTagKindNotSupported] error.
 func (s *authorisedKeysSuite) TestAuthorisedKeysForNonMachineEntity(c *tc.C) {
 	endPoint := newKeyUpdaterAPI(
-		s.getCanRead, s.keyUpdaterService, s.watcherRegistry,
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
 	)
 
 	args := params.Entities{
@@ -206,7 +277,7 @@ func (s *authorisedKeysSuite) TestAuthorisedKeysForNonMachineEntity(c *tc.C) {
 // [params.CodeTagKindNotSupported] error.
 func (s *authorisedKeysSuite) TestWatchAuthorisedKeysForNonMachineEntity(c *tc.C) {
 	endPoint := newKeyUpdaterAPI(
-		s.getCanRead, s.keyUpdaterService, s.watcherRegistry,
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
 	)
 
 	args := params.Entities{
@@ -232,7 +303,7 @@ func (s *authorisedKeysSuite) TestWatchAuthorisedKeysForNonMachineEntity(c *tc.C
 func (s *authorisedKeysSuite) TestAuthorisedKeysForNotFoundMachine(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	endPoint := newKeyUpdaterAPI(
-		s.getCanRead, s.keyUpdaterService, s.watcherRegistry,
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
 	)
 
 	args := params.Entities{
@@ -241,6 +312,7 @@ func (s *authorisedKeysSuite) TestAuthorisedKeysForNotFoundMachine(c *tc.C) {
 		},
 	}
 
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(testing.ModelConfig(c), nil)
 	s.keyUpdaterService.EXPECT().GetAuthorisedKeysForMachine(
 		gomock.Any(), coremachine.Name("0"),
 	).Return(nil, machineerrors.MachineNotFound)
@@ -16,6 +16,13 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("KeyUpdater", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		api, err := makeKeyUpdaterAPI(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("making KeyUpdater api: %w", err)
+		}
+		return &KeyUpdaterAPIV1{KeyUpdaterAPI: api}, nil
+	}, reflect.TypeOf((*KeyUpdaterAPIV1)(nil)))
+	registry.MustRegister("KeyUpdater", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		api, err := makeKeyUpdaterAPI(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("making KeyUpdater api: %w", err)
@@ -39,9 +46,13 @@ func makeKeyUpdaterAPI(
 	getCanRead := func(context.Context) (common.AuthFunc, error) {
 		return authorizer.AuthOwner, nil
 	}
+	domainServices := ctx.DomainServices()
 	return newKeyUpdaterAPI(
 		getCanRead,
-		ctx.DomainServices().KeyUpdater(),
+		domainServices.KeyUpdater(),
+		domainServices.SSHCertificateAuthority(),
+		domainServices.Config(),
+		ctx.ModelUUID(),
 		ctx.WatcherRegistry(),
 	), nil
 }
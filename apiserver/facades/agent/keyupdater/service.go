@@ -8,6 +8,7 @@ import (
 
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/environs/config"
 )
 
 // KeyUpdaterService is the interface for retrieving the authorised keys of a
@@ -21,8 +22,34 @@ type KeyUpdaterService interface {
 	// not exist.
 	GetAuthorisedKeysForMachine(context.Context, coremachine.Name) ([]string, error)
 
+	// GetControllerAuthorisedKeysForMachine is responsible for fetching the
+	// controller wide authorised keys that should be available on a machine,
+	// without the keys of the model's users. The following errors can be
+	// expected:
+	// - [github.com/juju/errors.NotValid] if the machine id is not valid.
+	// - [github.com/juju/juju/domain/machine/errors.NotFound] if the machine does
+	// not exist.
+	GetControllerAuthorisedKeysForMachine(context.Context, coremachine.Name) ([]string, error)
+
 	// WatchAuthorisedKeysForMachine will watch for authorised key changes for a
 	// give machine name. The following errors can be expected:
 	// - [github.com/juju/errors.NotValid] if the machine id is not valid.
 	WatchAuthorisedKeysForMachine(context.Context, coremachine.Name) (watcher.NotifyWatcher, error)
 }
+
+// SSHCertificateAuthorityService is the interface for retrieving the SSH
+// certificate authority of the controller.
+type SSHCertificateAuthorityService interface {
+	// CertificateAuthorityPublicKey returns the public key of the
+	// controller's SSH certificate authority, in the authorized_keys format.
+	CertificateAuthorityPublicKey(context.Context) (string, error)
+}
+
+// ModelConfigService provides access to the model's configuration.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(context.Context) (*config.Config, error)
+	// Watch returns a watcher that returns keys for any changes to model
+	// config.
+	Watch() (watcher.StringsWatcher, error)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/keyupdater (interfaces: KeyUpdaterService)
+// Source: github.com/juju/juju/apiserver/facades/agent/keyupdater (interfaces: KeyUpdaterService,SSHCertificateAuthorityService,ModelConfigService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package keyupdater -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/keyupdater KeyUpdaterService
+//	mockgen -typed -package keyupdater -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/keyupdater KeyUpdaterService,SSHCertificateAuthorityService,ModelConfigService
 //
 
 // Package keyupdater is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 
 	machine "github.com/juju/juju/core/machine"
 	watcher "github.com/juju/juju/core/watcher"
+	config "github.com/juju/juju/environs/config"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -80,6 +81,45 @@ func (c *MockKeyUpdaterServiceGetAuthorisedKeysForMachineCall) DoAndReturn(f fun
 	return c
 }
 
+// GetControllerAuthorisedKeysForMachine mocks base method.
+func (m *MockKeyUpdaterService) GetControllerAuthorisedKeysForMachine(arg0 context.Context, arg1 machine.Name) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetControllerAuthorisedKeysForMachine", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetControllerAuthorisedKeysForMachine indicates an expected call of GetControllerAuthorisedKeysForMachine.
+func (mr *MockKeyUpdaterServiceMockRecorder) GetControllerAuthorisedKeysForMachine(arg0, arg1 any) *MockKeyUpdaterServiceGetControllerAuthorisedKeysForMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControllerAuthorisedKeysForMachine", reflect.TypeOf((*MockKeyUpdaterService)(nil).GetControllerAuthorisedKeysForMachine), arg0, arg1)
+	return &MockKeyUpdaterServiceGetControllerAuthorisedKeysForMachineCall{Call: call}
+}
+
+// MockKeyUpdaterServiceGetControllerAuthorisedKeysForMachineCall wrap *gomock.Call
+type MockKeyUpdaterServiceGetControllerAuthorisedKeysForMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockKeyUpdaterServiceGetControllerAuthorisedKeysForMachineCall) Return(arg0 []string, arg1 error) *MockKeyUpdaterServiceGetControllerAuthorisedKeysForMachineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockKeyUpdaterServiceGetControllerAuthorisedKeysForMachineCall) Do(f func(context.Context, machine.Name) ([]string, error)) *MockKeyUpdaterServiceGetControllerAuthorisedKeysForMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockKeyUpdaterServiceGetControllerAuthorisedKeysForMachineCall) DoAndReturn(f func(context.Context, machine.Name) ([]string, error)) *MockKeyUpdaterServiceGetControllerAuthorisedKeysForMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchAuthorisedKeysForMachine mocks base method.
 func (m *MockKeyUpdaterService) WatchAuthorisedKeysForMachine(arg0 context.Context, arg1 machine.Name) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
@@ -118,3 +158,166 @@ func (c *MockKeyUpdaterServiceWatchAuthorisedKeysForMachineCall) DoAndReturn(f f
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSSHCertificateAuthorityService is a mock of SSHCertificateAuthorityService interface.
+type MockSSHCertificateAuthorityService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHCertificateAuthorityServiceMockRecorder
+}
+
+// MockSSHCertificateAuthorityServiceMockRecorder is the mock recorder for MockSSHCertificateAuthorityService.
+type MockSSHCertificateAuthorityServiceMockRecorder struct {
+	mock *MockSSHCertificateAuthorityService
+}
+
+// NewMockSSHCertificateAuthorityService creates a new mock instance.
+func NewMockSSHCertificateAuthorityService(ctrl *gomock.Controller) *MockSSHCertificateAuthorityService {
+	mock := &MockSSHCertificateAuthorityService{ctrl: ctrl}
+	mock.recorder = &MockSSHCertificateAuthorityServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHCertificateAuthorityService) EXPECT() *MockSSHCertificateAuthorityServiceMockRecorder {
+	return m.recorder
+}
+
+// CertificateAuthorityPublicKey mocks base method.
+func (m *MockSSHCertificateAuthorityService) CertificateAuthorityPublicKey(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertificateAuthorityPublicKey", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertificateAuthorityPublicKey indicates an expected call of CertificateAuthorityPublicKey.
+func (mr *MockSSHCertificateAuthorityServiceMockRecorder) CertificateAuthorityPublicKey(arg0 any) *MockSSHCertificateAuthorityServiceCertificateAuthorityPublicKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertificateAuthorityPublicKey", reflect.TypeOf((*MockSSHCertificateAuthorityService)(nil).CertificateAuthorityPublicKey), arg0)
+	return &MockSSHCertificateAuthorityServiceCertificateAuthorityPublicKeyCall{Call: call}
+}
+
+// MockSSHCertificateAuthorityServiceCertificateAuthorityPublicKeyCall wrap *gomock.Call
+type MockSSHCertificateAuthorityServiceCertificateAuthorityPublicKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCertificateAuthorityServiceCertificateAuthorityPublicKeyCall) Return(arg0 string, arg1 error) *MockSSHCertificateAuthorityServiceCertificateAuthorityPublicKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCertificateAuthorityServiceCertificateAuthorityPublicKeyCall) Do(f func(context.Context) (string, error)) *MockSSHCertificateAuthorityServiceCertificateAuthorityPublicKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCertificateAuthorityServiceCertificateAuthorityPublicKeyCall) DoAndReturn(f func(context.Context) (string, error)) *MockSSHCertificateAuthorityServiceCertificateAuthorityPublicKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock *MockModelConfigService
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(arg0 context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(arg0 any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockModelConfigService)(nil).ModelConfig), arg0)
+	return &MockModelConfigServiceModelConfigCall{Call: call}
+}
+
+// MockModelConfigServiceModelConfigCall wrap *gomock.Call
+type MockModelConfigServiceModelConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceModelConfigCall) Return(arg0 *config.Config, arg1 error) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceModelConfigCall) Do(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceModelConfigCall) DoAndReturn(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Watch mocks base method.
+func (m *MockModelConfigService) Watch() (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch")
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockModelConfigServiceMockRecorder) Watch() *MockModelConfigServiceWatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockModelConfigService)(nil).Watch))
+	return &MockModelConfigServiceWatchCall{Call: call}
+}
+
+// MockModelConfigServiceWatchCall wrap *gomock.Call
+type MockModelConfigServiceWatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceWatchCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceWatchCall) Do(f func() (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceWatchCall) DoAndReturn(f func() (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
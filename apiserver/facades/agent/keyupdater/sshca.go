@@ -0,0 +1,98 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package keyupdater
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/domain/sshca"
+	"github.com/juju/juju/rpc/params"
+)
+
+// SSHCertificateAuthority is not available in version 1.
+func (*KeyUpdaterAPIV1) SSHCertificateAuthority(_, _, _ struct{}) {}
+
+// SSHCertificateAuthority reports the SSH certificate authority that the
+// specified machines should trust to sign user certificates, along with the
+// principals that they should accept in those certificates. The principals
+// are those of the machine's model, so that a certificate issued for one
+// model can't be used to log into the machines of another.
+// The following param error codes can be expected:
+// - [params.CodeTagInvalid] When a tag provided does not parse and is
+// considered invalid.
+// - [params.CodeTagKindNotSupported] When a tag has been supplied that is not a
+// machine tag.
+// - [params.CodeUnathorized] When the caller does not have permissions to get
+// the certificate authority for a requested tag.
+func (api *KeyUpdaterAPI) SSHCertificateAuthority(
+	ctx context.Context,
+	arg params.Entities,
+) (params.SSHCertificateAuthorityResults, error) {
+	if len(arg.Entities) == 0 {
+		return params.SSHCertificateAuthorityResults{}, nil
+	}
+	results := make([]params.SSHCertificateAuthorityResult, len(arg.Entities))
+
+	canRead, err := api.getCanRead(ctx)
+	if err != nil {
+		return params.SSHCertificateAuthorityResults{}, fmt.Errorf(
+			"checking can read for ssh certificate authority: %w",
+			err,
+		)
+	}
+
+	var publicKey string
+	for i, entity := range arg.Entities {
+		tag, err := names.ParseTag(entity.Tag)
+		if err != nil {
+			results[i].Error = apiservererrors.ParamsErrorf(
+				params.CodeTagInvalid,
+				"cannot parse tag %q: %s",
+				entity.Tag,
+				err.Error(),
+			)
+			continue
+		}
+
+		if tag.Kind() != names.MachineTagKind {
+			results[i].Error = apiservererrors.ParamsErrorf(
+				params.CodeTagKindNotSupported,
+				"tag %q unsupported, can only accept tags of kind %q",
+				tag, names.MachineTagKind,
+			)
+			continue
+		}
+
+		if !canRead(tag) {
+			results[i].Error = apiservererrors.ParamsErrorf(
+				params.CodeUnauthorized,
+				"no permission to read ssh certificate authority for %q",
+				tag,
+			)
+			continue
+		}
+
+		if publicKey == "" {
+			publicKey, err = api.sshCAService.CertificateAuthorityPublicKey(ctx)
+			if err != nil {
+				// We don't understand this error. At this stage we consider it
+				// an internal server error and bail out of the call completely.
+				return params.SSHCertificateAuthorityResults{}, fmt.Errorf(
+					"cannot get ssh certificate authority: %w", err,
+				)
+			}
+		}
+
+		results[i].PublicKeys = []string{publicKey}
+		results[i].Principals = []string{sshca.ModelAdminPrincipal(api.modelUUID)}
+	}
+
+	return params.SSHCertificateAuthorityResults{
+		Results: results,
+	}, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package keyupdater
+
+import (
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// TestSSHCertificateAuthority is asserting that for machines the caller is
+// allowed to read we get back the certificate authority of the controller
+// and the principals of the machine's model.
+func (s *authorisedKeysSuite) TestSSHCertificateAuthority(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	endPoint := newKeyUpdaterAPI(
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
+	)
+
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: s.machineTag.String()},
+			{Tag: "machine-42"},
+			{Tag: names.NewUnitTag("ubuntu/1").String()},
+		},
+	}
+
+	s.sshCAService.EXPECT().CertificateAuthorityPublicKey(gomock.Any()).Return("ssh-ed25519 AAAA", nil)
+
+	result, err := endPoint.SSHCertificateAuthority(c.Context(), args)
+	c.Check(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.SSHCertificateAuthorityResults{
+		Results: []params.SSHCertificateAuthorityResult{
+			{
+				PublicKeys: []string{"ssh-ed25519 AAAA"},
+				Principals: []string{"juju-admin@8419cd78-4993-4c3a-928e-c646226beeee"},
+			},
+			{Error: &params.Error{
+				Code:    params.CodeUnauthorized,
+				Message: "no permission to read ssh certificate authority for \"machine-42\"",
+			}},
+			{Error: &params.Error{
+				Code:    params.CodeTagKindNotSupported,
+				Message: "tag \"unit-ubuntu-1\" unsupported, can only accept tags of kind \"machine\"",
+			}},
+		},
+	})
+}
+
+// TestSSHCertificateAuthorityNothing is asserting that it is not an error to
+// ask for the certificate authority of zero entities.
+func (s *authorisedKeysSuite) TestSSHCertificateAuthorityNothing(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	endPoint := newKeyUpdaterAPI(
+		s.getCanRead, s.keyUpdaterService, s.sshCAService, s.modelConfigService, s.modelUUID, s.watcherRegistry,
+	)
+
+	result, err := endPoint.SSHCertificateAuthority(c.Context(), params.Entities{})
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(result.Results, tc.HasLen, 0)
+}
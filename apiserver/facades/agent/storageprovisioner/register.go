@@ -17,6 +17,21 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("StorageProvisioner", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV4(stdCtx, ctx)
 	}, reflect.TypeOf((*StorageProvisionerAPIv4)(nil)))
+	registry.MustRegister("StorageProvisioner", 5, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV5(stdCtx, ctx) // add WatchVolumeResizes and storage snapshots.
+	}, reflect.TypeOf((*StorageProvisionerAPIv5)(nil)))
+}
+
+// newFacadeV5 provides the signature required for facade registration.
+func newFacadeV5(stdCtx context.Context, ctx facade.ModelContext) (*StorageProvisionerAPIv5, error) {
+	api, err := newFacadeV4(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &StorageProvisionerAPIv5{
+		StorageProvisionerAPIv4: api,
+		snapshotService:         ctx.DomainServices().Storage(),
+	}, nil
 }
 
 // newFacadeV4 provides the signature required for facade registration.
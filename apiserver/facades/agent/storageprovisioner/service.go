@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/core/watcher"
 	domainstorage "github.com/juju/juju/domain/storage"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/storage"
 )
 
 // ControllerConfigService provides access to the controller configuration.
@@ -69,6 +70,34 @@ type StoragePoolGetter interface {
 	GetStoragePoolByName(ctx context.Context, name string) (domainstorage.StoragePool, error)
 }
 
+// StorageSnapshotService provides access to the snapshots of machine-scoped
+// volumes, which are taken and removed by the machines' storage
+// provisioners.
+type StorageSnapshotService interface {
+	// WatchMachineStorageSnapshots returns a watcher that notifies when the
+	// snapshots of volumes scoped to the specified machine are added or
+	// change.
+	WatchMachineStorageSnapshots(ctx context.Context, machineID string) (watcher.NotifyWatcher, error)
+	// GetMachineStorageSnapshots returns the snapshots of volumes scoped to
+	// the specified machine.
+	GetMachineStorageSnapshots(ctx context.Context, machineID string) ([]domainstorage.StorageSnapshot, error)
+	// GetStorageSnapshot returns the storage snapshot with the specified UUID.
+	GetStorageSnapshot(ctx context.Context, uuid domainstorage.SnapshotUUID) (domainstorage.StorageSnapshot, error)
+	// SetMachineStorageSnapshotTaken records that the specified snapshot
+	// has been taken.
+	SetMachineStorageSnapshotTaken(ctx context.Context, uuid domainstorage.SnapshotUUID, providerID string, size uint64) error
+	// SetMachineStorageSnapshotFailed records that the specified snapshot
+	// could not be taken.
+	SetMachineStorageSnapshotFailed(ctx context.Context, uuid domainstorage.SnapshotUUID, message string) error
+	// DeleteMachineStorageSnapshot removes the record of the specified
+	// dying snapshot.
+	DeleteMachineStorageSnapshot(ctx context.Context, uuid domainstorage.SnapshotUUID) error
+	// GetStoragePoolProvider returns the storage provider of the named
+	// storage pool, along with the configuration with which to create the
+	// provider's volume sources.
+	GetStoragePoolProvider(ctx context.Context, poolName string) (storage.Provider, *storage.Config, error)
+}
+
 // ApplicationService is an interface for the application domain service.
 type ApplicationService interface {
 	// GetUnitLife returns the life status of a unit identified by its name.
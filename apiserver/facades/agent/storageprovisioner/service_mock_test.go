@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/storageprovisioner (interfaces: ApplicationService,MachineService)
+// Source: github.com/juju/juju/apiserver/facades/agent/storageprovisioner (interfaces: ApplicationService,MachineService,StoragePoolGetter,StorageSnapshotService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package storageprovisioner -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/storageprovisioner ApplicationService,MachineService
+//	mockgen -typed -package storageprovisioner -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/storageprovisioner ApplicationService,MachineService,StoragePoolGetter,StorageSnapshotService
 //
 
 // Package storageprovisioner is a generated GoMock package.
@@ -18,6 +18,8 @@ import (
 	machine "github.com/juju/juju/core/machine"
 	unit "github.com/juju/juju/core/unit"
 	watcher "github.com/juju/juju/core/watcher"
+	storage "github.com/juju/juju/domain/storage"
+	storage0 "github.com/juju/juju/internal/storage"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -417,3 +419,359 @@ func (c *MockMachineServiceGetMachineUUIDCall) DoAndReturn(f func(context.Contex
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockStoragePoolGetter is a mock of StoragePoolGetter interface.
+type MockStoragePoolGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoragePoolGetterMockRecorder
+}
+
+// MockStoragePoolGetterMockRecorder is the mock recorder for MockStoragePoolGetter.
+type MockStoragePoolGetterMockRecorder struct {
+	mock *MockStoragePoolGetter
+}
+
+// NewMockStoragePoolGetter creates a new mock instance.
+func NewMockStoragePoolGetter(ctrl *gomock.Controller) *MockStoragePoolGetter {
+	mock := &MockStoragePoolGetter{ctrl: ctrl}
+	mock.recorder = &MockStoragePoolGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStoragePoolGetter) EXPECT() *MockStoragePoolGetterMockRecorder {
+	return m.recorder
+}
+
+// GetStoragePoolByName mocks base method.
+func (m *MockStoragePoolGetter) GetStoragePoolByName(arg0 context.Context, arg1 string) (storage.StoragePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoragePoolByName", arg0, arg1)
+	ret0, _ := ret[0].(storage.StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoragePoolByName indicates an expected call of GetStoragePoolByName.
+func (mr *MockStoragePoolGetterMockRecorder) GetStoragePoolByName(arg0, arg1 any) *MockStoragePoolGetterGetStoragePoolByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolByName", reflect.TypeOf((*MockStoragePoolGetter)(nil).GetStoragePoolByName), arg0, arg1)
+	return &MockStoragePoolGetterGetStoragePoolByNameCall{Call: call}
+}
+
+// MockStoragePoolGetterGetStoragePoolByNameCall wrap *gomock.Call
+type MockStoragePoolGetterGetStoragePoolByNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoragePoolGetterGetStoragePoolByNameCall) Return(arg0 storage.StoragePool, arg1 error) *MockStoragePoolGetterGetStoragePoolByNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoragePoolGetterGetStoragePoolByNameCall) Do(f func(context.Context, string) (storage.StoragePool, error)) *MockStoragePoolGetterGetStoragePoolByNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoragePoolGetterGetStoragePoolByNameCall) DoAndReturn(f func(context.Context, string) (storage.StoragePool, error)) *MockStoragePoolGetterGetStoragePoolByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStorageSnapshotService is a mock of StorageSnapshotService interface.
+type MockStorageSnapshotService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageSnapshotServiceMockRecorder
+}
+
+// MockStorageSnapshotServiceMockRecorder is the mock recorder for MockStorageSnapshotService.
+type MockStorageSnapshotServiceMockRecorder struct {
+	mock *MockStorageSnapshotService
+}
+
+// NewMockStorageSnapshotService creates a new mock instance.
+func NewMockStorageSnapshotService(ctrl *gomock.Controller) *MockStorageSnapshotService {
+	mock := &MockStorageSnapshotService{ctrl: ctrl}
+	mock.recorder = &MockStorageSnapshotServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageSnapshotService) EXPECT() *MockStorageSnapshotServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteMachineStorageSnapshot mocks base method.
+func (m *MockStorageSnapshotService) DeleteMachineStorageSnapshot(arg0 context.Context, arg1 storage.SnapshotUUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMachineStorageSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMachineStorageSnapshot indicates an expected call of DeleteMachineStorageSnapshot.
+func (mr *MockStorageSnapshotServiceMockRecorder) DeleteMachineStorageSnapshot(arg0, arg1 any) *MockStorageSnapshotServiceDeleteMachineStorageSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMachineStorageSnapshot", reflect.TypeOf((*MockStorageSnapshotService)(nil).DeleteMachineStorageSnapshot), arg0, arg1)
+	return &MockStorageSnapshotServiceDeleteMachineStorageSnapshotCall{Call: call}
+}
+
+// MockStorageSnapshotServiceDeleteMachineStorageSnapshotCall wrap *gomock.Call
+type MockStorageSnapshotServiceDeleteMachineStorageSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSnapshotServiceDeleteMachineStorageSnapshotCall) Return(arg0 error) *MockStorageSnapshotServiceDeleteMachineStorageSnapshotCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSnapshotServiceDeleteMachineStorageSnapshotCall) Do(f func(context.Context, storage.SnapshotUUID) error) *MockStorageSnapshotServiceDeleteMachineStorageSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSnapshotServiceDeleteMachineStorageSnapshotCall) DoAndReturn(f func(context.Context, storage.SnapshotUUID) error) *MockStorageSnapshotServiceDeleteMachineStorageSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMachineStorageSnapshots mocks base method.
+func (m *MockStorageSnapshotService) GetMachineStorageSnapshots(arg0 context.Context, arg1 string) ([]storage.StorageSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineStorageSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]storage.StorageSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineStorageSnapshots indicates an expected call of GetMachineStorageSnapshots.
+func (mr *MockStorageSnapshotServiceMockRecorder) GetMachineStorageSnapshots(arg0, arg1 any) *MockStorageSnapshotServiceGetMachineStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineStorageSnapshots", reflect.TypeOf((*MockStorageSnapshotService)(nil).GetMachineStorageSnapshots), arg0, arg1)
+	return &MockStorageSnapshotServiceGetMachineStorageSnapshotsCall{Call: call}
+}
+
+// MockStorageSnapshotServiceGetMachineStorageSnapshotsCall wrap *gomock.Call
+type MockStorageSnapshotServiceGetMachineStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSnapshotServiceGetMachineStorageSnapshotsCall) Return(arg0 []storage.StorageSnapshot, arg1 error) *MockStorageSnapshotServiceGetMachineStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSnapshotServiceGetMachineStorageSnapshotsCall) Do(f func(context.Context, string) ([]storage.StorageSnapshot, error)) *MockStorageSnapshotServiceGetMachineStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSnapshotServiceGetMachineStorageSnapshotsCall) DoAndReturn(f func(context.Context, string) ([]storage.StorageSnapshot, error)) *MockStorageSnapshotServiceGetMachineStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStoragePoolProvider mocks base method.
+func (m *MockStorageSnapshotService) GetStoragePoolProvider(arg0 context.Context, arg1 string) (storage0.Provider, *storage0.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoragePoolProvider", arg0, arg1)
+	ret0, _ := ret[0].(storage0.Provider)
+	ret1, _ := ret[1].(*storage0.Config)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStoragePoolProvider indicates an expected call of GetStoragePoolProvider.
+func (mr *MockStorageSnapshotServiceMockRecorder) GetStoragePoolProvider(arg0, arg1 any) *MockStorageSnapshotServiceGetStoragePoolProviderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolProvider", reflect.TypeOf((*MockStorageSnapshotService)(nil).GetStoragePoolProvider), arg0, arg1)
+	return &MockStorageSnapshotServiceGetStoragePoolProviderCall{Call: call}
+}
+
+// MockStorageSnapshotServiceGetStoragePoolProviderCall wrap *gomock.Call
+type MockStorageSnapshotServiceGetStoragePoolProviderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSnapshotServiceGetStoragePoolProviderCall) Return(arg0 storage0.Provider, arg1 *storage0.Config, arg2 error) *MockStorageSnapshotServiceGetStoragePoolProviderCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSnapshotServiceGetStoragePoolProviderCall) Do(f func(context.Context, string) (storage0.Provider, *storage0.Config, error)) *MockStorageSnapshotServiceGetStoragePoolProviderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSnapshotServiceGetStoragePoolProviderCall) DoAndReturn(f func(context.Context, string) (storage0.Provider, *storage0.Config, error)) *MockStorageSnapshotServiceGetStoragePoolProviderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStorageSnapshot mocks base method.
+func (m *MockStorageSnapshotService) GetStorageSnapshot(arg0 context.Context, arg1 storage.SnapshotUUID) (storage.StorageSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(storage.StorageSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshot indicates an expected call of GetStorageSnapshot.
+func (mr *MockStorageSnapshotServiceMockRecorder) GetStorageSnapshot(arg0, arg1 any) *MockStorageSnapshotServiceGetStorageSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshot", reflect.TypeOf((*MockStorageSnapshotService)(nil).GetStorageSnapshot), arg0, arg1)
+	return &MockStorageSnapshotServiceGetStorageSnapshotCall{Call: call}
+}
+
+// MockStorageSnapshotServiceGetStorageSnapshotCall wrap *gomock.Call
+type MockStorageSnapshotServiceGetStorageSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSnapshotServiceGetStorageSnapshotCall) Return(arg0 storage.StorageSnapshot, arg1 error) *MockStorageSnapshotServiceGetStorageSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSnapshotServiceGetStorageSnapshotCall) Do(f func(context.Context, storage.SnapshotUUID) (storage.StorageSnapshot, error)) *MockStorageSnapshotServiceGetStorageSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSnapshotServiceGetStorageSnapshotCall) DoAndReturn(f func(context.Context, storage.SnapshotUUID) (storage.StorageSnapshot, error)) *MockStorageSnapshotServiceGetStorageSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetMachineStorageSnapshotFailed mocks base method.
+func (m *MockStorageSnapshotService) SetMachineStorageSnapshotFailed(arg0 context.Context, arg1 storage.SnapshotUUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMachineStorageSnapshotFailed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMachineStorageSnapshotFailed indicates an expected call of SetMachineStorageSnapshotFailed.
+func (mr *MockStorageSnapshotServiceMockRecorder) SetMachineStorageSnapshotFailed(arg0, arg1, arg2 any) *MockStorageSnapshotServiceSetMachineStorageSnapshotFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMachineStorageSnapshotFailed", reflect.TypeOf((*MockStorageSnapshotService)(nil).SetMachineStorageSnapshotFailed), arg0, arg1, arg2)
+	return &MockStorageSnapshotServiceSetMachineStorageSnapshotFailedCall{Call: call}
+}
+
+// MockStorageSnapshotServiceSetMachineStorageSnapshotFailedCall wrap *gomock.Call
+type MockStorageSnapshotServiceSetMachineStorageSnapshotFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSnapshotServiceSetMachineStorageSnapshotFailedCall) Return(arg0 error) *MockStorageSnapshotServiceSetMachineStorageSnapshotFailedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSnapshotServiceSetMachineStorageSnapshotFailedCall) Do(f func(context.Context, storage.SnapshotUUID, string) error) *MockStorageSnapshotServiceSetMachineStorageSnapshotFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSnapshotServiceSetMachineStorageSnapshotFailedCall) DoAndReturn(f func(context.Context, storage.SnapshotUUID, string) error) *MockStorageSnapshotServiceSetMachineStorageSnapshotFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetMachineStorageSnapshotTaken mocks base method.
+func (m *MockStorageSnapshotService) SetMachineStorageSnapshotTaken(arg0 context.Context, arg1 storage.SnapshotUUID, arg2 string, arg3 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMachineStorageSnapshotTaken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMachineStorageSnapshotTaken indicates an expected call of SetMachineStorageSnapshotTaken.
+func (mr *MockStorageSnapshotServiceMockRecorder) SetMachineStorageSnapshotTaken(arg0, arg1, arg2, arg3 any) *MockStorageSnapshotServiceSetMachineStorageSnapshotTakenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMachineStorageSnapshotTaken", reflect.TypeOf((*MockStorageSnapshotService)(nil).SetMachineStorageSnapshotTaken), arg0, arg1, arg2, arg3)
+	return &MockStorageSnapshotServiceSetMachineStorageSnapshotTakenCall{Call: call}
+}
+
+// MockStorageSnapshotServiceSetMachineStorageSnapshotTakenCall wrap *gomock.Call
+type MockStorageSnapshotServiceSetMachineStorageSnapshotTakenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSnapshotServiceSetMachineStorageSnapshotTakenCall) Return(arg0 error) *MockStorageSnapshotServiceSetMachineStorageSnapshotTakenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSnapshotServiceSetMachineStorageSnapshotTakenCall) Do(f func(context.Context, storage.SnapshotUUID, string, uint64) error) *MockStorageSnapshotServiceSetMachineStorageSnapshotTakenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSnapshotServiceSetMachineStorageSnapshotTakenCall) DoAndReturn(f func(context.Context, storage.SnapshotUUID, string, uint64) error) *MockStorageSnapshotServiceSetMachineStorageSnapshotTakenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchMachineStorageSnapshots mocks base method.
+func (m *MockStorageSnapshotService) WatchMachineStorageSnapshots(arg0 context.Context, arg1 string) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMachineStorageSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchMachineStorageSnapshots indicates an expected call of WatchMachineStorageSnapshots.
+func (mr *MockStorageSnapshotServiceMockRecorder) WatchMachineStorageSnapshots(arg0, arg1 any) *MockStorageSnapshotServiceWatchMachineStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMachineStorageSnapshots", reflect.TypeOf((*MockStorageSnapshotService)(nil).WatchMachineStorageSnapshots), arg0, arg1)
+	return &MockStorageSnapshotServiceWatchMachineStorageSnapshotsCall{Call: call}
+}
+
+// MockStorageSnapshotServiceWatchMachineStorageSnapshotsCall wrap *gomock.Call
+type MockStorageSnapshotServiceWatchMachineStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageSnapshotServiceWatchMachineStorageSnapshotsCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockStorageSnapshotServiceWatchMachineStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageSnapshotServiceWatchMachineStorageSnapshotsCall) Do(f func(context.Context, string) (watcher.Watcher[struct{}], error)) *MockStorageSnapshotServiceWatchMachineStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageSnapshotServiceWatchMachineStorageSnapshotsCall) DoAndReturn(f func(context.Context, string) (watcher.Watcher[struct{}], error)) *MockStorageSnapshotServiceWatchMachineStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storageprovisioner
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/internal"
+	domainstorage "github.com/juju/juju/domain/storage"
+	"github.com/juju/juju/rpc/params"
+)
+
+// WatchStorageSnapshots watches for changes to the snapshots of the volumes
+// scoped to the machines with the specified tags, so that the machine
+// storage provisioners can take and remove them.
+func (s *StorageProvisionerAPIv5) WatchStorageSnapshots(ctx context.Context, args params.Entities) (params.NotifyWatchResults, error) {
+	canAccess, err := s.getScopeAuthFunc(ctx)
+	if err != nil {
+		return params.NotifyWatchResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	results := params.NotifyWatchResults{
+		Results: make([]params.NotifyWatchResult, len(args.Entities)),
+	}
+	one := func(arg params.Entity) (string, error) {
+		tag, err := names.ParseMachineTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			return "", apiservererrors.ErrPerm
+		}
+		w, err := s.snapshotService.WatchMachineStorageSnapshots(ctx, tag.Id())
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		watcherId, _, err := internal.EnsureRegisterWatcher[struct{}](ctx, s.watcherRegistry, w)
+		return watcherId, err
+	}
+	for i, arg := range args.Entities {
+		var result params.NotifyWatchResult
+		id, err := one(arg)
+		if err != nil {
+			result.Error = apiservererrors.ServerError(err)
+		} else {
+			result.NotifyWatcherId = id
+		}
+		results.Results[i] = result
+	}
+	return results, nil
+}
+
+// MachineStorageSnapshots returns the snapshots of the volumes scoped to
+// the machines with the specified tags.
+func (s *StorageProvisionerAPIv5) MachineStorageSnapshots(ctx context.Context, args params.Entities) (params.MachineStorageSnapshotsResults, error) {
+	canAccess, err := s.getScopeAuthFunc(ctx)
+	if err != nil {
+		return params.MachineStorageSnapshotsResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	one := func(arg params.Entity) ([]params.MachineStorageSnapshot, error) {
+		tag, err := names.ParseMachineTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			return nil, apiservererrors.ErrPerm
+		}
+		snapshots, err := s.snapshotService.GetMachineStorageSnapshots(ctx, tag.Id())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result := make([]params.MachineStorageSnapshot, len(snapshots))
+		for i, snapshot := range snapshots {
+			_, cfg, err := s.snapshotService.GetStoragePoolProvider(ctx, snapshot.Pool)
+			if err != nil {
+				return nil, errors.Annotatef(err, "getting provider of snapshot %q", snapshot.UUID)
+			}
+			lifeValue, err := snapshot.Life.Value()
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			result[i] = params.MachineStorageSnapshot{
+				UUID:       snapshot.UUID.String(),
+				VolumeTag:  snapshot.VolumeTag,
+				VolumeId:   snapshot.VolumeID,
+				Provider:   string(cfg.Provider()),
+				Pool:       cfg.Name(),
+				Attributes: cfg.Attrs(),
+				ProviderId: snapshot.ProviderID,
+				Life:       lifeValue,
+				Status:     snapshot.Status.String(),
+			}
+		}
+		return result, nil
+	}
+	results := params.MachineStorageSnapshotsResults{
+		Results: make([]params.MachineStorageSnapshotsResult, len(args.Entities)),
+	}
+	for i, arg := range args.Entities {
+		snapshots, err := one(arg)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = snapshots
+	}
+	return results, nil
+}
+
+// SetStorageSnapshotInfo records the outcome of taking the specified
+// machine-scoped storage snapshots.
+func (s *StorageProvisionerAPIv5) SetStorageSnapshotInfo(ctx context.Context, args params.StorageSnapshotInfos) (params.ErrorResults, error) {
+	canAccess, err := s.getScopeAuthFunc(ctx)
+	if err != nil {
+		return params.ErrorResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	one := func(arg params.StorageSnapshotInfo) error {
+		uuid := domainstorage.SnapshotUUID(arg.UUID)
+		if err := s.checkCanAccessSnapshot(ctx, canAccess, uuid); err != nil {
+			return err
+		}
+		if arg.Error != "" {
+			return s.snapshotService.SetMachineStorageSnapshotFailed(ctx, uuid, arg.Error)
+		}
+		return s.snapshotService.SetMachineStorageSnapshotTaken(ctx, uuid, arg.ProviderId, arg.Size)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Snapshots)),
+	}
+	for i, arg := range args.Snapshots {
+		results.Results[i].Error = apiservererrors.ServerError(one(arg))
+	}
+	return results, nil
+}
+
+// RemoveStorageSnapshots removes the records of the specified dying
+// machine-scoped storage snapshots, once they have been removed from
+// the storage provider.
+func (s *StorageProvisionerAPIv5) RemoveStorageSnapshots(ctx context.Context, args params.RemoveStorageSnapshots) (params.ErrorResults, error) {
+	canAccess, err := s.getScopeAuthFunc(ctx)
+	if err != nil {
+		return params.ErrorResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	one := func(arg string) error {
+		uuid := domainstorage.SnapshotUUID(arg)
+		if err := s.checkCanAccessSnapshot(ctx, canAccess, uuid); err != nil {
+			return err
+		}
+		return s.snapshotService.DeleteMachineStorageSnapshot(ctx, uuid)
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Snapshots)),
+	}
+	for i, arg := range args.Snapshots {
+		results.Results[i].Error = apiservererrors.ServerError(one(arg))
+	}
+	return results, nil
+}
+
+// checkCanAccessSnapshot returns ErrPerm unless the specified snapshot is
+// taken by the storage provisioner of a machine that can be accessed.
+func (s *StorageProvisionerAPIv5) checkCanAccessSnapshot(
+	ctx context.Context, canAccess common.AuthFunc, uuid domainstorage.SnapshotUUID,
+) error {
+	snapshot, err := s.snapshotService.GetStorageSnapshot(ctx, uuid)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if snapshot.MachineID == "" || !canAccess(names.NewMachineTag(snapshot.MachineID)) {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storageprovisioner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/apiserver/common"
+	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/watcher/watchertest"
+	domainlife "github.com/juju/juju/domain/life"
+	domainstorage "github.com/juju/juju/domain/storage"
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type snapshotSuite struct {
+	testhelpers.IsolationSuite
+
+	api *StorageProvisionerAPIv5
+
+	snapshotService *MockStorageSnapshotService
+	watcherRegistry *facademocks.MockWatcherRegistry
+}
+
+func TestSnapshotSuite(t *testing.T) {
+	tc.Run(t, &snapshotSuite{})
+}
+
+func (s *snapshotSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.snapshotService = NewMockStorageSnapshotService(ctrl)
+	s.watcherRegistry = facademocks.NewMockWatcherRegistry(ctrl)
+
+	s.api = &StorageProvisionerAPIv5{
+		StorageProvisionerAPIv4: &StorageProvisionerAPIv4{
+			watcherRegistry: s.watcherRegistry,
+			getScopeAuthFunc: func(context.Context) (common.AuthFunc, error) {
+				return func(tag names.Tag) bool {
+					return tag == names.NewMachineTag("0")
+				}, nil
+			},
+		},
+		snapshotService: s.snapshotService,
+	}
+	return ctrl
+}
+
+func (s *snapshotSuite) TestWatchStorageSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan struct{}, 1)
+	ch <- struct{}{}
+	s.snapshotService.EXPECT().WatchMachineStorageSnapshots(gomock.Any(), "0").
+		Return(watchertest.NewMockNotifyWatcher(ch), nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any()).Return("1", nil)
+
+	results, err := s.api.WatchStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}, {Tag: "machine-1"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, params.NotifyWatchResults{
+		Results: []params.NotifyWatchResult{
+			{NotifyWatcherId: "1"},
+			{Error: &params.Error{Message: "permission denied", Code: params.CodeUnauthorized}},
+		},
+	})
+}
+
+func (s *snapshotSuite) TestMachineStorageSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.snapshotService.EXPECT().GetMachineStorageSnapshots(gomock.Any(), "0").Return([]domainstorage.StorageSnapshot{{
+		UUID:      "deadbeef",
+		VolumeTag: "volume-0-1",
+		VolumeID:  "loop-1",
+		MachineID: "0",
+		Pool:      "fast-loop",
+		Life:      domainlife.Alive,
+		Status:    domainstorage.SnapshotStatusPending,
+	}}, nil)
+	cfg, err := storage.NewConfig("fast-loop", "loop", map[string]any{"foo": "bar"})
+	c.Assert(err, tc.ErrorIsNil)
+	s.snapshotService.EXPECT().GetStoragePoolProvider(gomock.Any(), "fast-loop").Return(nil, cfg, nil)
+
+	results, err := s.api.MachineStorageSnapshots(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, params.MachineStorageSnapshotsResults{
+		Results: []params.MachineStorageSnapshotsResult{{
+			Result: []params.MachineStorageSnapshot{{
+				UUID:       "deadbeef",
+				VolumeTag:  "volume-0-1",
+				VolumeId:   "loop-1",
+				Provider:   "loop",
+				Pool:       "fast-loop",
+				Attributes: map[string]interface{}{"foo": "bar"},
+				Life:       life.Alive,
+				Status:     "pending",
+			}},
+		}},
+	})
+}
+
+func (s *snapshotSuite) TestSetStorageSnapshotInfo(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.snapshotService.EXPECT().GetStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID("taken")).
+		Return(domainstorage.StorageSnapshot{MachineID: "0"}, nil)
+	s.snapshotService.EXPECT().SetMachineStorageSnapshotTaken(gomock.Any(), domainstorage.SnapshotUUID("taken"), "snap-1", uint64(1024))
+	s.snapshotService.EXPECT().GetStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID("failed")).
+		Return(domainstorage.StorageSnapshot{MachineID: "0"}, nil)
+	s.snapshotService.EXPECT().SetMachineStorageSnapshotFailed(gomock.Any(), domainstorage.SnapshotUUID("failed"), "boom")
+	s.snapshotService.EXPECT().GetStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID("other")).
+		Return(domainstorage.StorageSnapshot{MachineID: "1"}, nil)
+
+	results, err := s.api.SetStorageSnapshotInfo(c.Context(), params.StorageSnapshotInfos{
+		Snapshots: []params.StorageSnapshotInfo{
+			{UUID: "taken", ProviderId: "snap-1", Size: 1024},
+			{UUID: "failed", Error: "boom"},
+			{UUID: "other", ProviderId: "snap-2"},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{},
+			{},
+			{Error: &params.Error{Message: "permission denied", Code: params.CodeUnauthorized}},
+		},
+	})
+}
+
+func (s *snapshotSuite) TestRemoveStorageSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.snapshotService.EXPECT().GetStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID("machine")).
+		Return(domainstorage.StorageSnapshot{MachineID: "0"}, nil)
+	s.snapshotService.EXPECT().DeleteMachineStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID("machine"))
+	s.snapshotService.EXPECT().GetStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID("model")).
+		Return(domainstorage.StorageSnapshot{}, nil)
+
+	results, err := s.api.RemoveStorageSnapshots(c.Context(), params.RemoveStorageSnapshots{
+		Snapshots: []string{"machine", "model"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{},
+			{Error: &params.Error{Message: "permission denied", Code: params.CodeUnauthorized}},
+		},
+	})
+}
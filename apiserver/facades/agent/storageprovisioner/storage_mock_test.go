@@ -1268,6 +1268,44 @@ func (c *MockStorageBackendWatchMachineVolumeAttachmentsCall) DoAndReturn(f func
 	return c
 }
 
+// WatchMachineVolumeResizes mocks base method.
+func (m *MockStorageBackend) WatchMachineVolumeResizes(arg0 names.MachineTag) state.StringsWatcher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMachineVolumeResizes", arg0)
+	ret0, _ := ret[0].(state.StringsWatcher)
+	return ret0
+}
+
+// WatchMachineVolumeResizes indicates an expected call of WatchMachineVolumeResizes.
+func (mr *MockStorageBackendMockRecorder) WatchMachineVolumeResizes(arg0 any) *MockStorageBackendWatchMachineVolumeResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMachineVolumeResizes", reflect.TypeOf((*MockStorageBackend)(nil).WatchMachineVolumeResizes), arg0)
+	return &MockStorageBackendWatchMachineVolumeResizesCall{Call: call}
+}
+
+// MockStorageBackendWatchMachineVolumeResizesCall wrap *gomock.Call
+type MockStorageBackendWatchMachineVolumeResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageBackendWatchMachineVolumeResizesCall) Return(arg0 state.StringsWatcher) *MockStorageBackendWatchMachineVolumeResizesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageBackendWatchMachineVolumeResizesCall) Do(f func(names.MachineTag) state.StringsWatcher) *MockStorageBackendWatchMachineVolumeResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageBackendWatchMachineVolumeResizesCall) DoAndReturn(f func(names.MachineTag) state.StringsWatcher) *MockStorageBackendWatchMachineVolumeResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchMachineVolumes mocks base method.
 func (m *MockStorageBackend) WatchMachineVolumes(arg0 names.MachineTag) state.StringsWatcher {
 	m.ctrl.T.Helper()
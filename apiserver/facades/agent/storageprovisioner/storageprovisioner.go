@@ -59,6 +59,15 @@ type StorageProvisionerAPIv4 struct {
 	modelUUID      model.UUID
 }
 
+// StorageProvisionerAPIv5 provides the StorageProvisioner API v5 facade,
+// which adds WatchVolumeResizes and the taking and removal of
+// machine-scoped storage snapshots.
+type StorageProvisionerAPIv5 struct {
+	*StorageProvisionerAPIv4
+
+	snapshotService StorageSnapshotService
+}
+
 // NewStorageProvisionerAPIv4 creates a new server-side StorageProvisioner v3 facade.
 func NewStorageProvisionerAPIv4(
 	ctx context.Context,
@@ -358,6 +367,42 @@ func (s *StorageProvisionerAPIv4) WatchVolumes(ctx context.Context, args params.
 	return s.watchStorageEntities(ctx, args, s.sb.WatchModelVolumes, s.sb.WatchMachineVolumes, nil)
 }
 
+// WatchVolumeResizes watches for changes to the volumes scoped to the
+// machines with the specified tags, so that the machine storage
+// provisioners can observe requests to resize them.
+func (s *StorageProvisionerAPIv5) WatchVolumeResizes(ctx context.Context, args params.Entities) (params.StringsWatchResults, error) {
+	canAccess, err := s.getScopeAuthFunc(ctx)
+	if err != nil {
+		return params.StringsWatchResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	results := params.StringsWatchResults{
+		Results: make([]params.StringsWatchResult, len(args.Entities)),
+	}
+	one := func(arg params.Entity) (string, []string, error) {
+		tag, err := names.ParseMachineTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			return "", nil, apiservererrors.ErrPerm
+		}
+		w := s.sb.WatchMachineVolumeResizes(tag)
+		if changes, ok := <-w.Changes(); ok {
+			return s.resources.Register(w), changes, nil
+		}
+		return "", nil, watcher.EnsureErr(w)
+	}
+	for i, arg := range args.Entities {
+		var result params.StringsWatchResult
+		id, changes, err := one(arg)
+		if err != nil {
+			result.Error = apiservererrors.ServerError(err)
+		} else {
+			result.StringsWatcherId = id
+			result.Changes = changes
+		}
+		results.Results[i] = result
+	}
+	return results, nil
+}
+
 // WatchFilesystems watches for changes to filesystems scoped
 // to the entity with the tag passed to NewState.
 func (s *StorageProvisionerAPIv4) WatchFilesystems(ctx context.Context, args params.Entities) (params.StringsWatchResults, error) {
@@ -598,7 +643,24 @@ func (s *StorageProvisionerAPIv4) Volumes(ctx context.Context, args params.Entit
 		} else if err != nil {
 			return params.Volume{}, err
 		}
-		return storagecommon.VolumeFromState(volume)
+		result, err := storagecommon.VolumeFromState(volume)
+		if err != nil {
+			return params.Volume{}, err
+		}
+		if result.RequestedSize > result.Info.Size && result.Info.Pool != "" {
+			// The provider and pool configuration are needed to resize
+			// the volume, and cannot be obtained from the volume params
+			// once it is attached.
+			providerType, cfg, err := storagecommon.StoragePoolConfig(
+				ctx, result.Info.Pool, s.storagePoolGetter, s.registry,
+			)
+			if err != nil {
+				return params.Volume{}, errors.Trace(err)
+			}
+			result.Provider = string(providerType)
+			result.Attributes = cfg.Attrs()
+		}
+		return result, nil
 	}
 	for i, arg := range args.Entities {
 		var result params.VolumeResult
@@ -837,6 +899,8 @@ func (s *StorageProvisionerAPIv4) VolumeParams(ctx context.Context, args params.
 				InstanceId: instanceId.String(),
 				Provider:   volumeParams.Provider,
 				ReadOnly:   volumeAttachmentParams.ReadOnly,
+				Pool:       volumeParams.Pool,
+				Attributes: volumeParams.Attributes,
 			}
 		}
 		return volumeParams, nil
@@ -885,16 +949,18 @@ func (s *StorageProvisionerAPIv4) RemoveVolumeParams(ctx context.Context, args p
 		if err != nil {
 			return params.RemoveVolumeParams{}, err
 		}
-		provider, _, err := storagecommon.StoragePoolConfig(
+		provider, cfg, err := storagecommon.StoragePoolConfig(
 			ctx, volumeInfo.Pool, s.storagePoolGetter, s.registry,
 		)
 		if err != nil {
 			return params.RemoveVolumeParams{}, err
 		}
 		return params.RemoveVolumeParams{
-			Provider: string(provider),
-			VolumeId: volumeInfo.VolumeId,
-			Destroy:  !volume.Releasing(),
+			Provider:   string(provider),
+			Pool:       cfg.Name(),
+			Attributes: cfg.Attrs(),
+			VolumeId:   volumeInfo.VolumeId,
+			Destroy:    !volume.Releasing(),
 		}, nil
 	}
 	for i, arg := range args.Entities {
@@ -1067,7 +1133,7 @@ func (s *StorageProvisionerAPIv4) VolumeAttachmentParams(
 			volumeId = volumeInfo.VolumeId
 			pool = volumeInfo.Pool
 		}
-		providerType, _, err := storagecommon.StoragePoolConfig(ctx, pool, s.storagePoolGetter, s.registry)
+		providerType, cfg, err := storagecommon.StoragePoolConfig(ctx, pool, s.storagePoolGetter, s.registry)
 		if err != nil {
 			return params.VolumeAttachmentParams{}, errors.Trace(err)
 		}
@@ -1090,6 +1156,8 @@ func (s *StorageProvisionerAPIv4) VolumeAttachmentParams(
 			InstanceId: instanceId.String(),
 			Provider:   string(providerType),
 			ReadOnly:   readOnly,
+			Pool:       cfg.Name(),
+			Attributes: cfg.Attrs(),
 		}, nil
 	}
 	for i, arg := range args.Ids {
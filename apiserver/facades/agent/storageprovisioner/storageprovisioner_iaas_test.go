@@ -41,6 +41,7 @@ RemoveFilesystemParams.
  - Test set filesystem attachment info
  - Test watch filesystems
  - Test watch volume attachments
+ - Test watch volume resizes for machine-scoped volumes
  - Test volume life
  - Test filesystem life
  - Test attachment life
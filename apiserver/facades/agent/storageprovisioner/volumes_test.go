@@ -0,0 +1,107 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storageprovisioner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/apiserver/common"
+	domainstorage "github.com/juju/juju/domain/storage"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+type volumesSuite struct {
+	testhelpers.IsolationSuite
+
+	api *StorageProvisionerAPIv4
+
+	backend           *MockStorageBackend
+	storagePoolGetter *MockStoragePoolGetter
+}
+
+func TestVolumesSuite(t *testing.T) {
+	tc.Run(t, &volumesSuite{})
+}
+
+func (s *volumesSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.backend = NewMockStorageBackend(ctrl)
+	s.storagePoolGetter = NewMockStoragePoolGetter(ctrl)
+
+	s.api = &StorageProvisionerAPIv4{
+		sb:                s.backend,
+		storagePoolGetter: s.storagePoolGetter,
+		getStorageEntityAuthFunc: func(context.Context) (common.AuthFunc, error) {
+			return func(names.Tag) bool { return true }, nil
+		},
+	}
+	return ctrl
+}
+
+// volume is a state.Volume with just enough
+// implemented to be converted to a params.Volume.
+type volume struct {
+	state.Volume
+	tag           names.VolumeTag
+	info          state.VolumeInfo
+	requestedSize uint64
+}
+
+func (v *volume) VolumeTag() names.VolumeTag { return v.tag }
+
+func (v *volume) Info() (state.VolumeInfo, error) { return v.info, nil }
+
+func (v *volume) RequestedSize() (uint64, bool) { return v.requestedSize, v.requestedSize > 0 }
+
+func (s *volumesSuite) expectVolume(tag names.VolumeTag, info state.VolumeInfo, requestedSize uint64) {
+	s.backend.EXPECT().Volume(tag).Return(&volume{
+		tag: tag, info: info, requestedSize: requestedSize,
+	}, nil)
+}
+
+func (s *volumesSuite) TestVolumesResizeRequestedIncludesProvider(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Volume 0/0 has an outstanding request to grow, so the provider
+	// and configuration of its pool are included; volume 0/1 does not.
+	s.expectVolume(names.NewVolumeTag("0/0"), state.VolumeInfo{
+		VolumeId: "vol-0", Pool: "fast", Size: 1024,
+	}, 2048)
+	s.expectVolume(names.NewVolumeTag("0/1"), state.VolumeInfo{
+		VolumeId: "vol-1", Pool: "fast", Size: 1024,
+	}, 0)
+	s.storagePoolGetter.EXPECT().GetStoragePoolByName(gomock.Any(), "fast").Return(domainstorage.StoragePool{
+		Name: "fast", Provider: "ebs",
+		Attrs: map[string]string{"volume-type": "io1"},
+	}, nil)
+
+	results, err := s.api.Volumes(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "volume-0-0"}, {Tag: "volume-0-1"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, params.VolumeResults{
+		Results: []params.VolumeResult{{
+			Result: params.Volume{
+				VolumeTag:     "volume-0-0",
+				Info:          params.VolumeInfo{VolumeId: "vol-0", Pool: "fast", Size: 1024},
+				RequestedSize: 2048,
+				Provider:      "ebs",
+				Attributes:    map[string]interface{}{"volume-type": "io1"},
+			},
+		}, {
+			Result: params.Volume{
+				VolumeTag: "volume-0-1",
+				Info:      params.VolumeInfo{VolumeId: "vol-1", Pool: "fast", Size: 1024},
+			},
+		}},
+	})
+}
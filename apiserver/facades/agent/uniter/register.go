@@ -25,6 +25,12 @@ func Register(registry facade.FacadeRegistry) {
 		return newUniterAPIv20(stdCtx, ctx)
 	}, reflect.TypeOf((*UniterAPIv20)(nil)))
 	registry.MustRegister("Uniter", 21, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newUniterAPIv21(stdCtx, ctx)
+	}, reflect.TypeOf((*UniterAPIv21)(nil)))
+	registry.MustRegister("Uniter", 22, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newUniterAPIv22(stdCtx, ctx)
+	}, reflect.TypeOf((*UniterAPIv22)(nil)))
+	registry.MustRegister("Uniter", 23, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newUniterAPI(stdCtx, ctx)
 	}, reflect.TypeOf((*UniterAPI)(nil)))
 }
@@ -38,11 +44,27 @@ func newUniterAPIv19(stdCtx context.Context, ctx facade.ModelContext) (*UniterAP
 }
 
 func newUniterAPIv20(stdCtx context.Context, ctx facade.ModelContext) (*UniterAPIv20, error) {
+	api, err := newUniterAPIv21(stdCtx, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIv20{UniterAPIv21: api}, nil
+}
+
+func newUniterAPIv21(stdCtx context.Context, ctx facade.ModelContext) (*UniterAPIv21, error) {
+	api, err := newUniterAPIv22(stdCtx, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIv21{UniterAPIv22: api}, nil
+}
+
+func newUniterAPIv22(stdCtx context.Context, ctx facade.ModelContext) (*UniterAPIv22, error) {
 	api, err := newUniterAPI(stdCtx, ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &UniterAPIv20{UniterAPI: api}, nil
+	return &UniterAPIv22{UniterAPI: api}, nil
 }
 
 // newUniterAPI creates a new instance of the core Uniter API.
@@ -5,6 +5,7 @@ package uniter
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/juju/controller"
 	coreapplication "github.com/juju/juju/core/application"
@@ -154,6 +155,28 @@ type ApplicationService interface {
 	// found.
 	GetApplicationIDByName(ctx context.Context, name string) (coreapplication.ID, error)
 
+	// GetApplicationHookTimeout returns the application hook timeout setting.
+	// A nil duration is returned if the application does not override the
+	// model hook timeout.
+	//
+	// Returns [applicationerrors.ApplicationNotFound] if the application is not
+	// found.
+	GetApplicationHookTimeout(ctx context.Context, appName string) (*time.Duration, error)
+
+	// GetApplicationSchedules returns the schedules declared by the charm of
+	// the application, taking any override into account.
+	//
+	// Returns [applicationerrors.ApplicationNotFound] if the application is not
+	// found.
+	GetApplicationSchedules(ctx context.Context, appName string) ([]domainapplication.ApplicationSchedule, error)
+
+	// WatchApplicationSchedules watches for changes to the overrides of the
+	// schedules of the specified application.
+	//
+	// Returns [applicationerrors.ApplicationNotFound] if the application is not
+	// found.
+	WatchApplicationSchedules(ctx context.Context, appName string) (watcher.NotifyWatcher, error)
+
 	// GetCharmModifiedVersion looks up the charm modified version of the given
 	// application.
 	GetCharmModifiedVersion(ctx context.Context, id coreapplication.ID) (int, error)
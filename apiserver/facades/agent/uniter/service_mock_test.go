@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/uniter (interfaces: ApplicationService,ResolveService,StatusService,RelationService,ModelInfoService,MachineService,NetworkService)
+// Source: github.com/juju/juju/apiserver/facades/agent/uniter (interfaces: ApplicationService,ResolveService,StatusService,RelationService,ModelConfigService,ModelInfoService,MachineService,NetworkService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package uniter -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/uniter ApplicationService,ResolveService,StatusService,RelationService,ModelInfoService,MachineService,NetworkService
+//	mockgen -typed -package uniter -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/uniter ApplicationService,ResolveService,StatusService,RelationService,ModelConfigService,ModelInfoService,MachineService,NetworkService
 //
 
 // Package uniter is a generated GoMock package.
@@ -12,6 +12,7 @@ package uniter
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	application "github.com/juju/juju/core/application"
 	config "github.com/juju/juju/core/config"
@@ -28,6 +29,7 @@ import (
 	charm "github.com/juju/juju/domain/application/charm"
 	relation0 "github.com/juju/juju/domain/relation"
 	resolve "github.com/juju/juju/domain/resolve"
+	config0 "github.com/juju/juju/environs/config"
 	charm0 "github.com/juju/juju/internal/charm"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -285,6 +287,45 @@ func (c *MockApplicationServiceGetApplicationConfigWithDefaultsCall) DoAndReturn
 	return c
 }
 
+// GetApplicationHookTimeout mocks base method.
+func (m *MockApplicationService) GetApplicationHookTimeout(arg0 context.Context, arg1 string) (*time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationHookTimeout", arg0, arg1)
+	ret0, _ := ret[0].(*time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationHookTimeout indicates an expected call of GetApplicationHookTimeout.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationHookTimeout(arg0, arg1 any) *MockApplicationServiceGetApplicationHookTimeoutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationHookTimeout", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationHookTimeout), arg0, arg1)
+	return &MockApplicationServiceGetApplicationHookTimeoutCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationHookTimeoutCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationHookTimeoutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationHookTimeoutCall) Return(arg0 *time.Duration, arg1 error) *MockApplicationServiceGetApplicationHookTimeoutCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationHookTimeoutCall) Do(f func(context.Context, string) (*time.Duration, error)) *MockApplicationServiceGetApplicationHookTimeoutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationHookTimeoutCall) DoAndReturn(f func(context.Context, string) (*time.Duration, error)) *MockApplicationServiceGetApplicationHookTimeoutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationIDByName mocks base method.
 func (m *MockApplicationService) GetApplicationIDByName(arg0 context.Context, arg1 string) (application.ID, error) {
 	m.ctrl.T.Helper()
@@ -402,6 +443,45 @@ func (c *MockApplicationServiceGetApplicationLifeByNameCall) DoAndReturn(f func(
 	return c
 }
 
+// GetApplicationSchedules mocks base method.
+func (m *MockApplicationService) GetApplicationSchedules(arg0 context.Context, arg1 string) ([]application0.ApplicationSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationSchedules", arg0, arg1)
+	ret0, _ := ret[0].([]application0.ApplicationSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationSchedules indicates an expected call of GetApplicationSchedules.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationSchedules(arg0, arg1 any) *MockApplicationServiceGetApplicationSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationSchedules", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationSchedules), arg0, arg1)
+	return &MockApplicationServiceGetApplicationSchedulesCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationSchedulesCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationSchedulesCall) Return(arg0 []application0.ApplicationSchedule, arg1 error) *MockApplicationServiceGetApplicationSchedulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationSchedulesCall) Do(f func(context.Context, string) ([]application0.ApplicationSchedule, error)) *MockApplicationServiceGetApplicationSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationSchedulesCall) DoAndReturn(f func(context.Context, string) ([]application0.ApplicationSchedule, error)) *MockApplicationServiceGetApplicationSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetAvailableCharmArchiveSHA256 mocks base method.
 func (m *MockApplicationService) GetAvailableCharmArchiveSHA256(arg0 context.Context, arg1 charm.CharmLocator) (string, error) {
 	m.ctrl.T.Helper()
@@ -1066,6 +1146,45 @@ func (c *MockApplicationServiceWatchApplicationConfigHashCall) DoAndReturn(f fun
 	return c
 }
 
+// WatchApplicationSchedules mocks base method.
+func (m *MockApplicationService) WatchApplicationSchedules(arg0 context.Context, arg1 string) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchApplicationSchedules", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchApplicationSchedules indicates an expected call of WatchApplicationSchedules.
+func (mr *MockApplicationServiceMockRecorder) WatchApplicationSchedules(arg0, arg1 any) *MockApplicationServiceWatchApplicationSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchApplicationSchedules", reflect.TypeOf((*MockApplicationService)(nil).WatchApplicationSchedules), arg0, arg1)
+	return &MockApplicationServiceWatchApplicationSchedulesCall{Call: call}
+}
+
+// MockApplicationServiceWatchApplicationSchedulesCall wrap *gomock.Call
+type MockApplicationServiceWatchApplicationSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceWatchApplicationSchedulesCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockApplicationServiceWatchApplicationSchedulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceWatchApplicationSchedulesCall) Do(f func(context.Context, string) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchApplicationSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceWatchApplicationSchedulesCall) DoAndReturn(f func(context.Context, string) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchApplicationSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchUnitActions mocks base method.
 func (m *MockApplicationService) WatchUnitActions(arg0 context.Context, arg1 unit.Name) (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -2220,6 +2339,107 @@ func (c *MockRelationServiceWatchRelatedUnitsCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock *MockModelConfigService
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(arg0 context.Context) (*config0.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config0.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(arg0 any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockModelConfigService)(nil).ModelConfig), arg0)
+	return &MockModelConfigServiceModelConfigCall{Call: call}
+}
+
+// MockModelConfigServiceModelConfigCall wrap *gomock.Call
+type MockModelConfigServiceModelConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceModelConfigCall) Return(arg0 *config0.Config, arg1 error) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceModelConfigCall) Do(f func(context.Context) (*config0.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceModelConfigCall) DoAndReturn(f func(context.Context) (*config0.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Watch mocks base method.
+func (m *MockModelConfigService) Watch() (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch")
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockModelConfigServiceMockRecorder) Watch() *MockModelConfigServiceWatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockModelConfigService)(nil).Watch))
+	return &MockModelConfigServiceWatchCall{Call: call}
+}
+
+// MockModelConfigServiceWatchCall wrap *gomock.Call
+type MockModelConfigServiceWatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceWatchCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceWatchCall) Do(f func() (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceWatchCall) DoAndReturn(f func() (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockModelInfoService is a mock of ModelInfoService interface.
 type MockModelInfoService struct {
 	ctrl     *gomock.Controller
@@ -217,6 +217,7 @@ func (s *StorageAPI) fromStateStorageAttachment(ctx context.Context, stateStorag
 		Kind:       params.StorageKind(stateStorageInstance.Kind()),
 		Location:   info.Location,
 		Life:       life.Value(stateStorageAttachment.Life().String()),
+		Size:       info.Size,
 	}, nil
 }
 
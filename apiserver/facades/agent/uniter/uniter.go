@@ -7,6 +7,7 @@ import (
 	"context"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/juju/clock"
 	"github.com/juju/collections/transform"
@@ -102,6 +103,14 @@ type UniterAPIv19 struct {
 }
 
 type UniterAPIv20 struct {
+	*UniterAPIv21
+}
+
+type UniterAPIv21 struct {
+	*UniterAPIv22
+}
+
+type UniterAPIv22 struct {
 	*UniterAPI
 }
 
@@ -1030,6 +1039,159 @@ func (u *UniterAPI) ConfigSettings(ctx context.Context, args params.Entities) (p
 	return result, nil
 }
 
+// HookTimeouts returns the maximum duration each given unit may spend running
+// a single hook. The application setting takes precedence over the model
+// hook-timeout. A zero timeout means that hooks run without a deadline.
+func (u *UniterAPI) HookTimeouts(ctx context.Context, args params.Entities) (params.HookTimeoutResults, error) {
+	result := params.HookTimeoutResults{
+		Results: make([]params.HookTimeoutResult, len(args.Entities)),
+	}
+	canAccess, err := u.accessUnit(ctx)
+	if err != nil {
+		return params.HookTimeoutResults{}, err
+	}
+
+	var modelTimeout *time.Duration
+	for i, entity := range args.Entities {
+		tag, err := names.ParseUnitTag(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		if !canAccess(tag) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+
+		unitName, err := coreunit.NewName(tag.Id())
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		timeout, err := u.applicationService.GetApplicationHookTimeout(ctx, unitName.Application())
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		} else if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if timeout != nil {
+			result.Results[i].Timeout = *timeout
+			continue
+		}
+
+		// Fall back to the model hook timeout, which is only read once
+		// per call.
+		if modelTimeout == nil {
+			modelConfig, err := u.modelConfigService.ModelConfig(ctx)
+			if err != nil {
+				return params.HookTimeoutResults{}, errors.Trace(err)
+			}
+			t := modelConfig.HookTimeout()
+			modelTimeout = &t
+		}
+		result.Results[i].Timeout = *modelTimeout
+	}
+	return result, nil
+}
+
+// HookSchedules returns the named schedules on which each given unit runs
+// the scheduled hook. The schedules are declared by the charm of the unit's
+// application, and may be overridden by the operator.
+func (u *UniterAPI) HookSchedules(ctx context.Context, args params.Entities) (params.HookSchedulesResults, error) {
+	result := params.HookSchedulesResults{
+		Results: make([]params.HookSchedulesResult, len(args.Entities)),
+	}
+	canAccess, err := u.accessUnit(ctx)
+	if err != nil {
+		return params.HookSchedulesResults{}, err
+	}
+
+	for i, entity := range args.Entities {
+		tag, err := names.ParseUnitTag(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		if !canAccess(tag) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+
+		unitName, err := coreunit.NewName(tag.Id())
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		schedules, err := u.applicationService.GetApplicationSchedules(ctx, unitName.Application())
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		} else if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		result.Results[i].Schedules = transform.Slice(schedules, func(s domainapplication.ApplicationSchedule) params.HookSchedule {
+			return params.HookSchedule{
+				Name:   s.Name,
+				Cron:   s.Cron,
+				Jitter: s.Jitter,
+			}
+		})
+	}
+	return result, nil
+}
+
+// WatchHookSchedules returns a NotifyWatcher for each given unit, which
+// notifies when the operator overrides the hook schedules of the unit's
+// application. Changes to the schedules declared by the charm are observed
+// by watching the unit's charm URL.
+func (u *UniterAPI) WatchHookSchedules(ctx context.Context, args params.Entities) (params.NotifyWatchResults, error) {
+	result := params.NotifyWatchResults{
+		Results: make([]params.NotifyWatchResult, len(args.Entities)),
+	}
+	canAccess, err := u.accessUnit(ctx)
+	if err != nil {
+		return params.NotifyWatchResults{}, err
+	}
+
+	for i, entity := range args.Entities {
+		tag, err := names.ParseUnitTag(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		if !canAccess(tag) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+
+		unitName, err := coreunit.NewName(tag.Id())
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		watcher, err := u.applicationService.WatchApplicationSchedules(ctx, unitName.Application())
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		} else if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		id, _, err := internal.EnsureRegisterWatcher[struct{}](ctx, u.watcherRegistry, watcher)
+		result.Results[i].NotifyWatcherId = id
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
 // CharmArchiveSha256 returns the SHA256 digest of the charm archive
 // (bundle) data for each charm url in the given parameters.
 func (u *UniterAPI) CharmArchiveSha256(ctx context.Context, args params.CharmURLs) (params.StringResults, error) {
@@ -3013,6 +3175,15 @@ func (u *UniterAPI) Read(ctx context.Context, _, _ struct{}) {}
 // WatchLeadershipSettings is not implemented in version 21 of the uniter.
 func (u *UniterAPI) WatchLeadershipSettings(ctx context.Context, _, _ struct{}) {}
 
+// HookTimeouts isn't on the v21 API.
+func (u *UniterAPIv21) HookTimeouts(_ context.Context, _ struct{}) {}
+
+// HookSchedules isn't on the v22 API.
+func (u *UniterAPIv22) HookSchedules(_ context.Context, _ struct{}) {}
+
+// WatchHookSchedules isn't on the v22 API.
+func (u *UniterAPIv22) WatchHookSchedules(_ context.Context, _ struct{}) {}
+
 func ptr[T any](v T) *T {
 	return &v
 }
@@ -32,6 +32,7 @@ import (
 	unittesting "github.com/juju/juju/core/unit/testing"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/watchertest"
+	domainapplication "github.com/juju/juju/domain/application"
 	"github.com/juju/juju/domain/application/architecture"
 	domaincharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
@@ -55,6 +56,7 @@ type uniterSuite struct {
 
 	applicationService *MockApplicationService
 	machineService     *MockMachineService
+	modelConfigService *MockModelConfigService
 	networkService     *MockNetworkService
 	resolveService     *MockResolveService
 	watcherRegistry    *MockWatcherRegistry
@@ -638,6 +640,120 @@ func (s *uniterSuite) TestConfigSettings(c *tc.C) {
 	})
 }
 
+func (s *uniterSuite) TestHookTimeouts(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange:
+	args := params.Entities{Entities: []params.Entity{
+		{Tag: "unit-mysql-0"},
+		{Tag: "unit-wordpress-0"},
+		{Tag: "unit-postgresql-0"},
+		{Tag: "unit-foo-42"},
+	}}
+
+	s.applicationService.EXPECT().GetApplicationHookTimeout(gomock.Any(), "mysql").Return(ptr(10*time.Minute), nil)
+	s.applicationService.EXPECT().GetApplicationHookTimeout(gomock.Any(), "wordpress").Return(nil, nil)
+	s.applicationService.EXPECT().GetApplicationHookTimeout(gomock.Any(), "postgresql").Return(nil, applicationerrors.ApplicationNotFound)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(coretesting.CustomModelConfig(c,
+		coretesting.Attrs{
+			"hook-timeout": "1h",
+		},
+	), nil)
+	s.badTag = names.NewUnitTag("foo/42")
+
+	// Act:
+	result, err := s.uniter.HookTimeouts(c.Context(), args)
+
+	// Assert:
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.HookTimeoutResults{
+		Results: []params.HookTimeoutResult{
+			{Timeout: 10 * time.Minute},
+			{Timeout: time.Hour},
+			{Error: apiservertesting.ErrUnauthorized},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+}
+
+func (s *uniterSuite) TestHookSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange:
+	args := params.Entities{Entities: []params.Entity{
+		{Tag: "unit-mysql-0"},
+		{Tag: "unit-wordpress-0"},
+		{Tag: "unit-postgresql-0"},
+		{Tag: "unit-foo-42"},
+	}}
+
+	s.applicationService.EXPECT().GetApplicationSchedules(gomock.Any(), "mysql").Return([]domainapplication.ApplicationSchedule{{
+		Name:        "nightly-backup",
+		Cron:        "30 1 * * *",
+		Jitter:      time.Minute,
+		CharmCron:   "0 3 * * *",
+		CharmJitter: time.Minute,
+	}}, nil)
+	s.applicationService.EXPECT().GetApplicationSchedules(gomock.Any(), "wordpress").Return(nil, nil)
+	s.applicationService.EXPECT().GetApplicationSchedules(gomock.Any(), "postgresql").Return(nil, applicationerrors.ApplicationNotFound)
+	s.badTag = names.NewUnitTag("foo/42")
+
+	// Act:
+	result, err := s.uniter.HookSchedules(c.Context(), args)
+
+	// Assert:
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.HookSchedulesResults{
+		Results: []params.HookSchedulesResult{
+			{Schedules: []params.HookSchedule{{
+				Name:   "nightly-backup",
+				Cron:   "30 1 * * *",
+				Jitter: time.Minute,
+			}}},
+			{Schedules: []params.HookSchedule{}},
+			{Error: apiservertesting.ErrUnauthorized},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+}
+
+func (s *uniterSuite) TestWatchHookSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange:
+	args := params.Entities{Entities: []params.Entity{
+		{Tag: "unit-mysql-0"},
+		{Tag: "unit-wordpress-0"},
+		{Tag: "unit-postgresql-0"},
+	}}
+
+	// Arrange: expect a watcher for mysql
+	ch := make(chan struct{}, 1)
+	w := watchertest.NewMockNotifyWatcher(ch)
+	s.applicationService.EXPECT().WatchApplicationSchedules(gomock.Any(), "mysql").Return(w, nil)
+	s.watcherRegistry.EXPECT().Register(w).Return("1", nil)
+	ch <- struct{}{}
+
+	// Arrange: wordpress/0 is unauthorised.
+	s.badTag = names.NewUnitTag("wordpress/0")
+
+	// Arrange: postgresql has been removed.
+	s.applicationService.EXPECT().WatchApplicationSchedules(gomock.Any(), "postgresql").Return(nil, applicationerrors.ApplicationNotFound)
+
+	// Act:
+	result, err := s.uniter.WatchHookSchedules(c.Context(), args)
+
+	// Assert:
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.NotifyWatchResults{
+		Results: []params.NotifyWatchResult{
+			{NotifyWatcherId: "1"},
+			{Error: apiservertesting.ErrUnauthorized},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+}
+
 func (s *uniterSuite) TestHasSubordinates(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -877,6 +993,7 @@ func (s *uniterSuite) setupMocks(c *tc.C) *gomock.Controller {
 
 	s.applicationService = NewMockApplicationService(ctrl)
 	s.machineService = NewMockMachineService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
 	s.networkService = NewMockNetworkService(ctrl)
 	s.resolveService = NewMockResolveService(ctrl)
 	s.watcherRegistry = NewMockWatcherRegistry(ctrl)
@@ -889,6 +1006,7 @@ func (s *uniterSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.uniter = &UniterAPI{
 		applicationService: s.applicationService,
 		machineService:     s.machineService,
+		modelConfigService: s.modelConfigService,
 		networkService:     s.networkService,
 		resolveService:     s.resolveService,
 		accessUnit:         authFunc,
@@ -899,6 +1017,7 @@ func (s *uniterSuite) setupMocks(c *tc.C) *gomock.Controller {
 	c.Cleanup(func() {
 		s.applicationService = nil
 		s.machineService = nil
+		s.modelConfigService = nil
 		s.networkService = nil
 		s.resolveService = nil
 		s.watcherRegistry = nil
@@ -1014,8 +1133,12 @@ func (s *uniterv19Suite) SetUpTest(c *tc.C) {
 
 		s.uniter = &UniterAPIv19{
 			UniterAPIv20: &UniterAPIv20{
-				UniterAPI: &UniterAPI{
-					watcherRegistry: s.watcherRegistry,
+				UniterAPIv21: &UniterAPIv21{
+					UniterAPIv22: &UniterAPIv22{
+						UniterAPI: &UniterAPI{
+							watcherRegistry: s.watcherRegistry,
+						},
+					},
 				},
 			},
 		}
@@ -1040,10 +1163,14 @@ func (s *uniterv20Suite) SetUpTest(c *tc.C) {
 		s.watcherRegistry.EXPECT().Register(gomock.Any()).Return("watcher1", nil).AnyTimes()
 
 		s.uniter = &UniterAPIv20{
-			UniterAPI: &UniterAPI{
-				modelUUID:       model.UUID(coretesting.ModelTag.Id()),
-				modelType:       model.IAAS,
-				watcherRegistry: s.watcherRegistry,
+			UniterAPIv21: &UniterAPIv21{
+				UniterAPIv22: &UniterAPIv22{
+					UniterAPI: &UniterAPI{
+						modelUUID:       model.UUID(coretesting.ModelTag.Id()),
+						modelType:       model.IAAS,
+						watcherRegistry: s.watcherRegistry,
+					},
+				},
 			},
 		}
 
@@ -46,6 +46,7 @@ import (
 	"github.com/juju/juju/domain/application/architecture"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/blockcommand"
 	"github.com/juju/juju/domain/deployment"
 	"github.com/juju/juju/domain/relation"
 	"github.com/juju/juju/domain/resolve"
@@ -65,9 +66,14 @@ import (
 
 var ClassifyDetachedStorage = storagecommon.ClassifyDetachedStorage
 
+// APIv21 provides the Application API facade for version 21.
+type APIv21 struct {
+	*APIBase
+}
+
 // APIv20 provides the Application API facade for version 20.
 type APIv20 struct {
-	*APIBase
+	*APIv21
 }
 
 // APIv19 provides the Application API facade for version 19.
@@ -299,7 +305,10 @@ func (api *APIBase) Deploy(ctx context.Context, args params.ApplicationsDeploy)
 	result := params.ErrorResults{
 		Results: make([]params.ErrorResult, len(args.Applications)),
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	appNames := transform.Slice(args.Applications, func(arg params.ApplicationDeploy) string {
+		return arg.ApplicationName
+	})
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, appNames...)); err != nil {
 		return result, errors.Trace(err)
 	}
 
@@ -356,7 +365,14 @@ func (api *APIBase) cleanupResourcesAddedBeforeApp(ctx context.Context, appName
 
 // ConfigSchema returns the config schema and defaults for an application.
 func ConfigSchema() (configschema.Fields, schema.Defaults, error) {
-	return trustFields, trustDefaults, nil
+	fields := make(configschema.Fields, len(trustFields)+len(hookTimeoutFields))
+	for name, field := range trustFields {
+		fields[name] = field
+	}
+	for name, field := range hookTimeoutFields {
+		fields[name] = field
+	}
+	return fields, trustDefaults, nil
 }
 
 func splitApplicationAndCharmConfig(inConfig map[string]string) (
@@ -855,7 +871,7 @@ func (api *APIBase) SetCharm(ctx context.Context, args params.ApplicationSetChar
 
 	// when forced units in error, don't block
 	if !args.ForceUnits {
-		if err := api.check.ChangeAllowed(ctx); err != nil {
+		if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, args.ApplicationName)); err != nil {
 			return errors.Trace(err)
 		}
 	}
@@ -1248,7 +1264,7 @@ func (api *APIBase) Expose(ctx context.Context, args params.ApplicationExpose) e
 	if err := api.checkCanWrite(ctx); err != nil {
 		return errors.Trace(err)
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, args.ApplicationName)); err != nil {
 		return errors.Trace(err)
 	}
 
@@ -1307,7 +1323,7 @@ func (api *APIBase) Unexpose(ctx context.Context, args params.ApplicationUnexpos
 	if err := api.checkCanWrite(ctx); err != nil {
 		return err
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, args.ApplicationName)); err != nil {
 		return errors.Trace(err)
 	}
 
@@ -1326,7 +1342,7 @@ func (api *APIBase) AddUnits(ctx context.Context, args params.AddApplicationUnit
 	if err := api.checkCanWrite(ctx); err != nil {
 		return params.AddApplicationUnitsResults{}, errors.Trace(err)
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, args.ApplicationName)); err != nil {
 		return params.AddApplicationUnitsResults{}, errors.Trace(err)
 	}
 
@@ -1420,7 +1436,10 @@ func (api *APIBase) DestroyUnit(ctx context.Context, args params.DestroyUnitsPar
 	if err := api.checkCanWrite(ctx); err != nil {
 		return params.DestroyUnitResults{}, errors.Trace(err)
 	}
-	if err := api.check.RemoveAllowed(ctx); err != nil {
+	appNames := transform.Slice(args.Units, func(arg params.DestroyUnitParams) string {
+		return applicationNameFromTag(arg.UnitTag)
+	})
+	if err := api.check.RemoveAllowed(blockcommand.WithApplications(ctx, appNames...)); err != nil {
 		return params.DestroyUnitResults{}, errors.Trace(err)
 	}
 
@@ -1525,12 +1544,37 @@ func (api *APIBase) DestroyUnit(ctx context.Context, args params.DestroyUnitsPar
 	}, nil
 }
 
+// applicationNameFromTag returns the name of the application identified by
+// the given application or unit tag, for scoping command block checks. An
+// empty name is returned if the tag is not valid; the error is reported
+// when the tag is processed.
+func applicationNameFromTag(tag string) string {
+	t, err := names.ParseTag(tag)
+	if err != nil {
+		return ""
+	}
+	switch t := t.(type) {
+	case names.ApplicationTag:
+		return t.Id()
+	case names.UnitTag:
+		appName, err := names.UnitApplication(t.Id())
+		if err != nil {
+			return ""
+		}
+		return appName
+	}
+	return ""
+}
+
 // DestroyApplication removes a given set of applications.
 func (api *APIBase) DestroyApplication(ctx context.Context, args params.DestroyApplicationsParams) (params.DestroyApplicationResults, error) {
 	if err := api.checkCanWrite(ctx); err != nil {
 		return params.DestroyApplicationResults{}, err
 	}
-	if err := api.check.RemoveAllowed(ctx); err != nil {
+	appNames := transform.Slice(args.Applications, func(arg params.DestroyApplicationParams) string {
+		return applicationNameFromTag(arg.ApplicationTag)
+	})
+	if err := api.check.RemoveAllowed(blockcommand.WithApplications(ctx, appNames...)); err != nil {
 		return params.DestroyApplicationResults{}, errors.Trace(err)
 	}
 	destroyApp := func(arg params.DestroyApplicationParams) (*params.DestroyApplicationInfo, error) {
@@ -1656,7 +1700,10 @@ func (api *APIBase) DestroyConsumedApplications(ctx context.Context, args params
 	if err := api.checkCanWrite(ctx); err != nil {
 		return params.ErrorResults{}, err
 	}
-	if err := api.check.RemoveAllowed(ctx); err != nil {
+	appNames := transform.Slice(args.Applications, func(arg params.DestroyConsumedApplicationParams) string {
+		return applicationNameFromTag(arg.ApplicationTag)
+	})
+	if err := api.check.RemoveAllowed(blockcommand.WithApplications(ctx, appNames...)); err != nil {
 		return params.ErrorResults{}, errors.Trace(err)
 	}
 	results := make([]params.ErrorResult, len(args.Applications))
@@ -1677,7 +1724,10 @@ func (api *APIBase) ScaleApplications(ctx context.Context, args params.ScaleAppl
 	if err := api.checkCanWrite(ctx); err != nil {
 		return params.ScaleApplicationResults{}, errors.Trace(err)
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	appNames := transform.Slice(args.Applications, func(arg params.ScaleApplicationParams) string {
+		return applicationNameFromTag(arg.ApplicationTag)
+	})
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, appNames...)); err != nil {
 		return params.ScaleApplicationResults{}, errors.Trace(err)
 	}
 	scaleApplication := func(arg params.ScaleApplicationParams) (*params.ScaleApplicationInfo, error) {
@@ -1768,7 +1818,7 @@ func (api *APIBase) SetConstraints(ctx context.Context, args params.SetConstrain
 	if err := api.checkCanWrite(ctx); err != nil {
 		return err
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, args.ApplicationName)); err != nil {
 		return errors.Trace(err)
 	}
 
@@ -1801,7 +1851,7 @@ func (api *APIBase) AddRelation(ctx context.Context, args params.AddRelation) (_
 	if err := api.checkCanWrite(ctx); err != nil {
 		return params.AddRelationResults{}, internalerrors.Capture(err)
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, relationApplicationNames(args.Endpoints)...)); err != nil {
 		return params.AddRelationResults{}, internalerrors.Capture(err)
 	}
 
@@ -1841,13 +1891,24 @@ func encodeRelation(rel charm.Relation) params.CharmRelation {
 	}
 }
 
+// relationApplicationNames returns the names of the applications of the
+// given relation endpoints, each of the form "application[:endpoint]".
+func relationApplicationNames(endpoints []string) []string {
+	return transform.Slice(endpoints, func(endpoint string) string {
+		appName, _, _ := strings.Cut(endpoint, ":")
+		return appName
+	})
+}
+
 // DestroyRelation removes the relation between the
 // specified endpoints or an id.
 func (api *APIBase) DestroyRelation(ctx context.Context, args params.DestroyRelation) (err error) {
 	if err := api.checkCanWrite(ctx); err != nil {
 		return err
 	}
-	if err := api.check.RemoveAllowed(ctx); err != nil {
+	// A relation given by its id doesn't name its applications, so blocks
+	// scoped to applications apply to its removal.
+	if err := api.check.RemoveAllowed(blockcommand.WithApplications(ctx, relationApplicationNames(args.Endpoints)...)); err != nil {
 		return internalerrors.Capture(err)
 	}
 
@@ -1957,7 +2018,10 @@ func (api *APIBase) SetConfigs(ctx context.Context, args params.ConfigSetArgs) (
 	if err := api.checkCanWrite(ctx); err != nil {
 		return result, errors.Trace(err)
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	appNames := transform.Slice(args.Args, func(arg params.ConfigSet) string {
+		return arg.ApplicationName
+	})
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, appNames...)); err != nil {
 		return result, errors.Trace(err)
 	}
 	result.Results = make([]params.ErrorResult, len(args.Args))
@@ -1998,7 +2062,10 @@ func (api *APIBase) UnsetApplicationsConfig(ctx context.Context, args params.App
 	if err := api.checkCanWrite(ctx); err != nil {
 		return result, errors.Trace(err)
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	appNames := transform.Slice(args.Args, func(arg params.ApplicationUnset) string {
+		return arg.ApplicationName
+	})
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, appNames...)); err != nil {
 		return result, errors.Trace(err)
 	}
 	result.Results = make([]params.ErrorResult, len(args.Args))
@@ -2064,7 +2131,12 @@ func (api *APIBase) ResolveUnitErrors(ctx context.Context, p params.UnitsResolve
 	if err := api.checkCanWrite(ctx); err != nil {
 		return result, errors.Trace(err)
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	// Resolving all units doesn't name their applications, so blocks scoped
+	// to applications apply to it.
+	appNames := transform.Slice(p.Tags.Entities, func(entity params.Entity) string {
+		return applicationNameFromTag(entity.Tag)
+	})
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, appNames...)); err != nil {
 		return result, errors.Trace(err)
 	}
 
@@ -2303,7 +2375,10 @@ func (api *APIBase) MergeBindings(ctx context.Context, in params.ApplicationMerg
 	if err := api.checkCanWrite(ctx); err != nil {
 		return params.ErrorResults{}, err
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	appNames := transform.Slice(in.Args, func(arg params.ApplicationMergeBindings) string {
+		return applicationNameFromTag(arg.ApplicationTag)
+	})
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, appNames...)); err != nil {
 		return params.ErrorResults{}, errors.Trace(err)
 	}
 
@@ -2570,6 +2645,21 @@ func (api *APIBase) Leader(ctx context.Context, entity params.Entity) (params.St
 	return result, nil
 }
 
+// deployApplicationNames returns the names of the applications being
+// deployed. An application deployed without a name is named after its charm,
+// which isn't known until the charm is resolved. In that case no names are
+// returned, so that blocks scoped to applications apply to the deployment.
+func deployApplicationNames(args []params.DeployFromRepositoryArg) []string {
+	appNames := make([]string, len(args))
+	for i, arg := range args {
+		if arg.ApplicationName == "" {
+			return nil
+		}
+		appNames[i] = arg.ApplicationName
+	}
+	return appNames
+}
+
 // DeployFromRepository is a one-stop deployment method for repository
 // charms. Only a charm name is required to deploy. If argument validation
 // fails, a list of all errors found in validation will be returned. If a
@@ -2579,7 +2669,7 @@ func (api *APIBase) DeployFromRepository(ctx context.Context, args params.Deploy
 	if err := api.checkCanWrite(ctx); err != nil {
 		return params.DeployFromRepositoryResults{}, errors.Trace(err)
 	}
-	if err := api.check.ChangeAllowed(ctx); err != nil {
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, deployApplicationNames(args.Args)...)); err != nil {
 		return params.DeployFromRepositoryResults{}, errors.Trace(err)
 	}
 
@@ -53,6 +53,9 @@ func (api *APIBase) getConfig(
 	appSettings := config.ConfigAttributes{
 		coreapplication.TrustConfigOptionName: appInfo.Trust,
 	}
+	if appInfo.HookTimeout != nil {
+		appSettings[coreapplication.HookTimeoutConfigOptionName] = appInfo.HookTimeout.String()
+	}
 
 	providerSchema, providerDefaults, err := ConfigSchema()
 	if err != nil {
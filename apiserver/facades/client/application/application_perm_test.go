@@ -4,13 +4,17 @@
 package application
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/juju/clock"
 	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/blockcommand"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -212,6 +216,28 @@ func (s *permBaseSuite) TestDestroyApplicationBlocked(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "blocked")
 }
 
+func (s *permBaseSuite) TestDestroyApplicationBlockedForApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.blockChecker.EXPECT().RemoveAllowed(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		op, _ := blockcommand.OperationFromContext(ctx)
+		c.Check(op.Applications, tc.DeepEquals, []string{"foo", "bar"})
+		return fmt.Errorf("blocked")
+	})
+
+	s.newAPI(c)
+
+	_, err := s.api.DestroyApplication(c.Context(), params.DestroyApplicationsParams{
+		Applications: []params.DestroyApplicationParams{
+			{ApplicationTag: "application-foo"},
+			{ApplicationTag: "application-bar"},
+		},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
 func (s *permBaseSuite) TestDestroyConsumedApplicationsPermission(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -237,6 +263,25 @@ func (s *permBaseSuite) TestDestroyConsumedApplicationsBlocked(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "blocked")
 }
 
+func (s *permBaseSuite) TestDestroyConsumedApplicationsBlockedForApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.blockChecker.EXPECT().RemoveAllowed(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		op, _ := blockcommand.OperationFromContext(ctx)
+		c.Check(op.Applications, tc.DeepEquals, []string{"foo"})
+		return fmt.Errorf("blocked")
+	})
+
+	s.newAPI(c)
+
+	_, err := s.api.DestroyConsumedApplications(c.Context(), params.DestroyConsumedApplicationsParams{
+		Applications: []params.DestroyConsumedApplicationParams{{ApplicationTag: "application-foo"}},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
 func (s *permBaseSuite) TestGetConstraintsPermission(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -299,6 +344,25 @@ func (s *permBaseSuite) TestAddRelationBlocked(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "blocked")
 }
 
+func (s *permBaseSuite) TestAddRelationBlockedForApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		op, _ := blockcommand.OperationFromContext(ctx)
+		c.Check(op.Applications, tc.DeepEquals, []string{"foo", "bar"})
+		return fmt.Errorf("blocked")
+	})
+
+	s.newAPI(c)
+
+	_, err := s.api.AddRelation(c.Context(), params.AddRelation{
+		Endpoints: []string{"foo:db", "bar"},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
 func (s *permBaseSuite) TestDestroyRelationPermission(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -324,6 +388,44 @@ func (s *permBaseSuite) TestDestroyRelationBlocked(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "blocked")
 }
 
+func (s *permBaseSuite) TestDestroyRelationBlockedForApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.blockChecker.EXPECT().RemoveAllowed(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		op, _ := blockcommand.OperationFromContext(ctx)
+		c.Check(op.Applications, tc.DeepEquals, []string{"foo", "bar"})
+		return fmt.Errorf("blocked")
+	})
+
+	s.newAPI(c)
+
+	err := s.api.DestroyRelation(c.Context(), params.DestroyRelation{
+		Endpoints: []string{"foo:db", "bar:db"},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
+func (s *permBaseSuite) TestDestroyRelationByIDBlockedForApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.blockChecker.EXPECT().RemoveAllowed(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		op, _ := blockcommand.OperationFromContext(ctx)
+		c.Check(op.Applications, tc.DeepEquals, []string(nil))
+		return fmt.Errorf("blocked")
+	})
+
+	s.newAPI(c)
+
+	err := s.api.DestroyRelation(c.Context(), params.DestroyRelation{
+		RelationId: 1,
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
 func (s *permBaseSuite) TestSetRelationsSuspendedPermission(c *tc.C) {
 	c.Skip("cross model relations are disabled until backend functionality is moved to domain")
 	defer s.setupMocks(c).Finish()
@@ -489,6 +591,25 @@ func (s *permBaseSuite) TestResolveUnitErrorsBlocked(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "blocked")
 }
 
+func (s *permBaseSuite) TestResolveUnitErrorsBlockedForApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		op, _ := blockcommand.OperationFromContext(ctx)
+		c.Check(op.Applications, tc.DeepEquals, []string{"foo"})
+		return fmt.Errorf("blocked")
+	})
+
+	s.newAPI(c)
+
+	_, err := s.api.ResolveUnitErrors(c.Context(), params.UnitsResolved{
+		Tags: params.Entities{Entities: []params.Entity{{Tag: "unit-foo-0"}}},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
 func (s *permBaseSuite) TestApplicationsInfoPermission(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -526,6 +647,25 @@ func (s *permBaseSuite) TestMergeBindingsBlocked(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "blocked")
 }
 
+func (s *permBaseSuite) TestMergeBindingsBlockedForApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		op, _ := blockcommand.OperationFromContext(ctx)
+		c.Check(op.Applications, tc.DeepEquals, []string{"foo"})
+		return fmt.Errorf("blocked")
+	})
+
+	s.newAPI(c)
+
+	_, err := s.api.MergeBindings(c.Context(), params.ApplicationMergeBindingsArgs{
+		Args: []params.ApplicationMergeBindings{{ApplicationTag: "application-foo"}},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
 func (s *permBaseSuite) TestUnitsInfoPermission(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -575,6 +715,47 @@ func (s *permBaseSuite) TestDeployFromRepositoryBlocked(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "blocked")
 }
 
+func (s *permBaseSuite) TestDeployFromRepositoryBlockedForApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		op, _ := blockcommand.OperationFromContext(ctx)
+		c.Check(op.Applications, tc.DeepEquals, []string{"foo"})
+		return fmt.Errorf("blocked")
+	})
+
+	s.newAPI(c)
+
+	_, err := s.api.DeployFromRepository(c.Context(), params.DeployFromRepositoryArgs{
+		Args: []params.DeployFromRepositoryArg{{CharmName: "mysql", ApplicationName: "foo"}},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
+func (s *permBaseSuite) TestDeployFromRepositoryUnnamedBlockedForApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		op, _ := blockcommand.OperationFromContext(ctx)
+		c.Check(op.Applications, tc.DeepEquals, []string(nil))
+		return fmt.Errorf("blocked")
+	})
+
+	s.newAPI(c)
+
+	_, err := s.api.DeployFromRepository(c.Context(), params.DeployFromRepositoryArgs{
+		Args: []params.DeployFromRepositoryArg{
+			{CharmName: "mysql", ApplicationName: "foo"},
+			{CharmName: "wordpress"},
+		},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
 type permSuiteIAAS struct {
 	permBaseSuite
 }
@@ -641,6 +822,25 @@ func (s *permSuiteIAAS) TestDestroyUnitBlocked(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "blocked")
 }
 
+func (s *permSuiteIAAS) TestDestroyUnitBlockedForApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.blockChecker.EXPECT().RemoveAllowed(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		op, _ := blockcommand.OperationFromContext(ctx)
+		c.Check(op.Applications, tc.DeepEquals, []string{"foo"})
+		return fmt.Errorf("blocked")
+	})
+
+	s.newAPI(c)
+
+	_, err := s.api.DestroyUnit(c.Context(), params.DestroyUnitsParams{
+		Units: []params.DestroyUnitParams{{UnitTag: "unit-foo-0"}},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
 func (s *permSuiteIAAS) TestScaleApplicationsInvalidForIAAS(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
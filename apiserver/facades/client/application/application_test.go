@@ -1408,6 +1408,120 @@ func (s *applicationSuite) TestUnitsInfoApplicationNotFound(c *tc.C) {
 	c.Check(res.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
 }
 
+func (s *applicationSuite) TestHookSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().GetApplicationSchedules(gomock.Any(), "foo").Return([]domainapplication.ApplicationSchedule{{
+		Name:        "nightly-backup",
+		Cron:        "30 1 * * *",
+		Jitter:      time.Minute,
+		CharmCron:   "0 3 * * *",
+		CharmJitter: 10 * time.Minute,
+	}}, nil)
+	s.applicationService.EXPECT().GetApplicationSchedules(gomock.Any(), "bar").Return(nil, applicationerrors.ApplicationNotFound)
+
+	res, err := s.api.HookSchedules(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewApplicationTag("foo").String()},
+			{Tag: names.NewApplicationTag("bar").String()},
+			{Tag: names.NewUnitTag("foo/0").String()},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 3)
+	c.Check(res.Results[0], tc.DeepEquals, params.ApplicationHookSchedulesResult{
+		Schedules: []params.ApplicationHookSchedule{{
+			Name:        "nightly-backup",
+			Cron:        "30 1 * * *",
+			Jitter:      time.Minute,
+			CharmCron:   "0 3 * * *",
+			CharmJitter: 10 * time.Minute,
+		}},
+	})
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+	c.Check(res.Results[2].Error, tc.ErrorMatches, `"unit-foo-0" is not a valid application tag`)
+}
+
+func (s *applicationSuite) TestSetHookSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	cron := "30 1 * * *"
+	jitter := time.Hour
+	s.applicationService.EXPECT().SetApplicationScheduleOverrides(gomock.Any(), "foo", map[string]domainapplication.ScheduleOverride{
+		"nightly-backup": {Cron: &cron},
+		"hourly-report":  {Jitter: &jitter},
+	}).Return(nil)
+
+	res, err := s.api.SetHookSchedules(c.Context(), params.SetApplicationHookSchedulesArgs{
+		Args: []params.SetApplicationHookSchedules{{
+			ApplicationTag: names.NewApplicationTag("foo").String(),
+			Schedules: []params.HookScheduleOverride{
+				{Name: "nightly-backup", Cron: &cron},
+				{Name: "hourly-report", Jitter: &jitter},
+			},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error, tc.IsNil)
+}
+
+func (s *applicationSuite) TestSetHookSchedulesErrors(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().SetApplicationScheduleOverrides(gomock.Any(), "foo", gomock.Any()).Return(applicationerrors.ScheduleNotFound)
+	s.applicationService.EXPECT().SetApplicationScheduleOverrides(gomock.Any(), "bar", gomock.Any()).Return(applicationerrors.InvalidSchedule)
+	s.applicationService.EXPECT().SetApplicationScheduleOverrides(gomock.Any(), "baz", gomock.Any()).Return(applicationerrors.ApplicationNotFound)
+
+	cron := "0 3 * *"
+	res, err := s.api.SetHookSchedules(c.Context(), params.SetApplicationHookSchedulesArgs{
+		Args: []params.SetApplicationHookSchedules{{
+			ApplicationTag: names.NewApplicationTag("foo").String(),
+			Schedules:      []params.HookScheduleOverride{{Name: "weekly-cleanup", Cron: &cron}},
+		}, {
+			ApplicationTag: names.NewApplicationTag("bar").String(),
+			Schedules:      []params.HookScheduleOverride{{Name: "nightly-backup", Cron: &cron}},
+		}, {
+			ApplicationTag: names.NewApplicationTag("baz").String(),
+			Schedules:      []params.HookScheduleOverride{{Name: "nightly-backup", Cron: &cron}},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 3)
+	c.Check(res.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotValid)
+	c.Check(res.Results[2].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *applicationSuite) TestUnsetHookSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().UnsetApplicationScheduleOverrides(gomock.Any(), "foo", []string{"nightly-backup"}).Return(nil)
+	s.applicationService.EXPECT().UnsetApplicationScheduleOverrides(gomock.Any(), "bar", []string{"nightly-backup"}).Return(applicationerrors.ApplicationNotFound)
+
+	res, err := s.api.UnsetHookSchedules(c.Context(), params.UnsetApplicationHookSchedulesArgs{
+		Args: []params.UnsetApplicationHookSchedules{{
+			ApplicationTag: names.NewApplicationTag("foo").String(),
+			Names:          []string{"nightly-backup"},
+		}, {
+			ApplicationTag: names.NewApplicationTag("bar").String(),
+			Names:          []string{"nightly-backup"},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 2)
+	c.Check(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
 func (s *applicationSuite) TestSetRelationsSuspendedStub(c *tc.C) {
 	c.Skip("Suspending relation requires CMR support, which is not yet implemented.\n" +
 		"Once it will be implemented, at minimum, the following tests should be added:\n" +
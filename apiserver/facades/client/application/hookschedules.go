@@ -0,0 +1,153 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/collections/transform"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/blockcommand"
+	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// HookSchedules isn't on the v20 API.
+func (api *APIv20) HookSchedules(_ context.Context, _ struct{}) {}
+
+// SetHookSchedules isn't on the v20 API.
+func (api *APIv20) SetHookSchedules(_ context.Context, _ struct{}) {}
+
+// UnsetHookSchedules isn't on the v20 API.
+func (api *APIv20) UnsetHookSchedules(_ context.Context, _ struct{}) {}
+
+// HookSchedules returns the hook schedules declared by the charms of the
+// specified applications, along with the cron expression and jitter in effect
+// for each of them.
+func (api *APIBase) HookSchedules(ctx context.Context, args params.Entities) (params.ApplicationHookSchedulesResults, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.ApplicationHookSchedulesResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.ApplicationHookSchedulesResult, len(args.Entities))
+	for i, entity := range args.Entities {
+		tag, err := names.ParseApplicationTag(entity.Tag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		schedules, err := api.applicationService.GetApplicationSchedules(ctx, tag.Id())
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			results[i].Error = apiservererrors.ParamsErrorf(params.CodeNotFound, "application %s not found", tag.Id())
+			continue
+		} else if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		results[i].Schedules = transform.Slice(schedules, func(s application.ApplicationSchedule) params.ApplicationHookSchedule {
+			return params.ApplicationHookSchedule{
+				Name:        s.Name,
+				Cron:        s.Cron,
+				Jitter:      s.Jitter,
+				CharmCron:   s.CharmCron,
+				CharmJitter: s.CharmJitter,
+			}
+		})
+	}
+	return params.ApplicationHookSchedulesResults{Results: results}, nil
+}
+
+// SetHookSchedules overrides the cron expression or jitter of hook schedules
+// declared by the charms of the specified applications.
+func (api *APIBase) SetHookSchedules(ctx context.Context, args params.SetApplicationHookSchedulesArgs) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, hookScheduleAppNames(args.Args, func(arg params.SetApplicationHookSchedules) string {
+		return arg.ApplicationTag
+	})...)); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		tag, err := names.ParseApplicationTag(arg.ApplicationTag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		overrides := make(map[string]application.ScheduleOverride, len(arg.Schedules))
+		for _, schedule := range arg.Schedules {
+			overrides[schedule.Name] = application.ScheduleOverride{
+				Cron:   schedule.Cron,
+				Jitter: schedule.Jitter,
+			}
+		}
+
+		err = api.applicationService.SetApplicationScheduleOverrides(ctx, tag.Id(), overrides)
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			results[i].Error = apiservererrors.ParamsErrorf(params.CodeNotFound, "application %s not found", tag.Id())
+		} else if errors.Is(err, applicationerrors.ScheduleNotFound) {
+			results[i].Error = apiservererrors.ServerError(internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotFound)))
+		} else if errors.Is(err, applicationerrors.InvalidSchedule) {
+			results[i].Error = apiservererrors.ServerError(internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotValid)))
+		} else if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+// UnsetHookSchedules removes the overrides of the named hook schedules of the
+// specified applications, so that the schedules declared by their charms
+// apply.
+func (api *APIBase) UnsetHookSchedules(ctx context.Context, args params.UnsetApplicationHookSchedulesArgs) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(blockcommand.WithApplications(ctx, hookScheduleAppNames(args.Args, func(arg params.UnsetApplicationHookSchedules) string {
+		return arg.ApplicationTag
+	})...)); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		tag, err := names.ParseApplicationTag(arg.ApplicationTag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		err = api.applicationService.UnsetApplicationScheduleOverrides(ctx, tag.Id(), arg.Names)
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			results[i].Error = apiservererrors.ParamsErrorf(params.CodeNotFound, "application %s not found", tag.Id())
+		} else if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+// hookScheduleAppNames returns the names of the applications targeted by the
+// args, skipping any with an invalid tag; these are reported individually.
+func hookScheduleAppNames[T any](args []T, appTag func(T) string) []string {
+	var appNames []string
+	for _, arg := range args {
+		tag, err := names.ParseApplicationTag(appTag(arg))
+		if err != nil {
+			continue
+		}
+		appNames = append(appNames, tag.Id())
+	}
+	return appNames
+}
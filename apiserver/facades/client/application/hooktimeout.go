@@ -0,0 +1,19 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/juju/core/application"
+	"github.com/juju/juju/internal/configschema"
+)
+
+// hookTimeoutFields holds the hook timeout application setting. There is no
+// default, as an unset value falls back to the model hook-timeout.
+var hookTimeoutFields = configschema.Fields{
+	application.HookTimeoutConfigOptionName: {
+		Description: "The maximum duration a hook may run before it is killed. Overrides the model hook-timeout when set",
+		Type:        configschema.Tstring,
+		Group:       configschema.JujuGroup,
+	},
+}
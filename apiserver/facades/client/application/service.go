@@ -332,6 +332,31 @@ type ApplicationService interface {
 	// If no application is found, an error satisfying
 	// [applicationerrors.ApplicationNotFound] is returned.
 	MergeExposeSettings(ctx context.Context, appName string, exposedEndpoints map[string]application.ExposedEndpoint) error
+
+	// GetApplicationSchedules returns the schedules declared by the charm of
+	// the application, taking any operator overrides into account.
+	//
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	GetApplicationSchedules(ctx context.Context, appName string) ([]application.ApplicationSchedule, error)
+
+	// SetApplicationScheduleOverrides overrides the cron expression or jitter
+	// of schedules declared by the charm of the application.
+	//
+	// The following errors may be returned:
+	//   - [applicationerrors.ApplicationNotFound] if the application doesn't exist
+	//   - [applicationerrors.ScheduleNotFound] if a schedule is not declared by
+	//     the charm of the application
+	//   - [applicationerrors.InvalidSchedule] if a cron expression or jitter is
+	//     not valid
+	SetApplicationScheduleOverrides(ctx context.Context, appName string, overrides map[string]application.ScheduleOverride) error
+
+	// UnsetApplicationScheduleOverrides removes the overrides of the named
+	// schedules.
+	//
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	UnsetApplicationScheduleOverrides(ctx context.Context, appName string, names []string) error
 }
 
 type ResolveService interface {
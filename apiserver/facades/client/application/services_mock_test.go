@@ -1270,6 +1270,45 @@ func (c *MockApplicationServiceGetApplicationLifeCall) DoAndReturn(f func(contex
 	return c
 }
 
+// GetApplicationSchedules mocks base method.
+func (m *MockApplicationService) GetApplicationSchedules(arg0 context.Context, arg1 string) ([]application0.ApplicationSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationSchedules", arg0, arg1)
+	ret0, _ := ret[0].([]application0.ApplicationSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationSchedules indicates an expected call of GetApplicationSchedules.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationSchedules(arg0, arg1 any) *MockApplicationServiceGetApplicationSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationSchedules", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationSchedules), arg0, arg1)
+	return &MockApplicationServiceGetApplicationSchedulesCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationSchedulesCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationSchedulesCall) Return(arg0 []application0.ApplicationSchedule, arg1 error) *MockApplicationServiceGetApplicationSchedulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationSchedulesCall) Do(f func(context.Context, string) ([]application0.ApplicationSchedule, error)) *MockApplicationServiceGetApplicationSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationSchedulesCall) DoAndReturn(f func(context.Context, string) ([]application0.ApplicationSchedule, error)) *MockApplicationServiceGetApplicationSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetCharm mocks base method.
 func (m *MockApplicationService) GetCharm(arg0 context.Context, arg1 charm0.CharmLocator) (charm1.Charm, charm0.CharmLocator, bool, error) {
 	m.ctrl.T.Helper()
@@ -2125,6 +2164,44 @@ func (c *MockApplicationServiceSetApplicationScaleCall) DoAndReturn(f func(conte
 	return c
 }
 
+// SetApplicationScheduleOverrides mocks base method.
+func (m *MockApplicationService) SetApplicationScheduleOverrides(arg0 context.Context, arg1 string, arg2 map[string]application0.ScheduleOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationScheduleOverrides", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationScheduleOverrides indicates an expected call of SetApplicationScheduleOverrides.
+func (mr *MockApplicationServiceMockRecorder) SetApplicationScheduleOverrides(arg0, arg1, arg2 any) *MockApplicationServiceSetApplicationScheduleOverridesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationScheduleOverrides", reflect.TypeOf((*MockApplicationService)(nil).SetApplicationScheduleOverrides), arg0, arg1, arg2)
+	return &MockApplicationServiceSetApplicationScheduleOverridesCall{Call: call}
+}
+
+// MockApplicationServiceSetApplicationScheduleOverridesCall wrap *gomock.Call
+type MockApplicationServiceSetApplicationScheduleOverridesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceSetApplicationScheduleOverridesCall) Return(arg0 error) *MockApplicationServiceSetApplicationScheduleOverridesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceSetApplicationScheduleOverridesCall) Do(f func(context.Context, string, map[string]application0.ScheduleOverride) error) *MockApplicationServiceSetApplicationScheduleOverridesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceSetApplicationScheduleOverridesCall) DoAndReturn(f func(context.Context, string, map[string]application0.ScheduleOverride) error) *MockApplicationServiceSetApplicationScheduleOverridesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UnsetApplicationConfigKeys mocks base method.
 func (m *MockApplicationService) UnsetApplicationConfigKeys(arg0 context.Context, arg1 application.ID, arg2 []string) error {
 	m.ctrl.T.Helper()
@@ -2163,6 +2240,44 @@ func (c *MockApplicationServiceUnsetApplicationConfigKeysCall) DoAndReturn(f fun
 	return c
 }
 
+// UnsetApplicationScheduleOverrides mocks base method.
+func (m *MockApplicationService) UnsetApplicationScheduleOverrides(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetApplicationScheduleOverrides", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetApplicationScheduleOverrides indicates an expected call of UnsetApplicationScheduleOverrides.
+func (mr *MockApplicationServiceMockRecorder) UnsetApplicationScheduleOverrides(arg0, arg1, arg2 any) *MockApplicationServiceUnsetApplicationScheduleOverridesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetApplicationScheduleOverrides", reflect.TypeOf((*MockApplicationService)(nil).UnsetApplicationScheduleOverrides), arg0, arg1, arg2)
+	return &MockApplicationServiceUnsetApplicationScheduleOverridesCall{Call: call}
+}
+
+// MockApplicationServiceUnsetApplicationScheduleOverridesCall wrap *gomock.Call
+type MockApplicationServiceUnsetApplicationScheduleOverridesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceUnsetApplicationScheduleOverridesCall) Return(arg0 error) *MockApplicationServiceUnsetApplicationScheduleOverridesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceUnsetApplicationScheduleOverridesCall) Do(f func(context.Context, string, []string) error) *MockApplicationServiceUnsetApplicationScheduleOverridesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceUnsetApplicationScheduleOverridesCall) DoAndReturn(f func(context.Context, string, []string) error) *MockApplicationServiceUnsetApplicationScheduleOverridesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UnsetExposeSettings mocks base method.
 func (m *MockApplicationService) UnsetExposeSettings(arg0 context.Context, arg1 string, arg2 set.Strings) error {
 	m.ctrl.T.Helper()
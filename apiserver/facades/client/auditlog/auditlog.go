@@ -0,0 +1,145 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"context"
+	"slices"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/auditlog"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// MaxQueryEntries is the maximum number of entries returned by a single
+// query. Only the most recent entries are returned if more match.
+const MaxQueryEntries = 10000
+
+// Authorizer defines the methods that the AuditLog facade requires from
+// the authorizer.
+type Authorizer interface {
+	// HasPermission reports whether the given access is allowed for the given
+	// target by the authenticated entity.
+	HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error
+}
+
+// ControllerConfigService provides access to the controller config.
+type ControllerConfigService interface {
+	// ControllerConfig returns the config values for the controller.
+	ControllerConfig(context.Context) (controller.Config, error)
+}
+
+// ReadEntriesFunc reads the entries matching the filter from the audit log
+// files in the given directory.
+type ReadEntriesFunc func(logDir string, filter auditlog.Filter) ([]auditlog.Entry, error)
+
+// API implements the AuditLog facade, which gives controller superusers
+// access to the audit records written by the controller they are connected
+// to.
+type API struct {
+	controllerTag names.ControllerTag
+	controllerID  string
+	logDir        string
+	authorizer    Authorizer
+	readEntries   ReadEntriesFunc
+
+	controllerConfigService ControllerConfigService
+}
+
+// Query returns the audit log entries recorded by this controller that
+// match the supplied filters, oldest first. At most the requested limit, or
+// MaxQueryEntries, are returned; if more match, only the most recent are
+// returned and the result is marked as truncated.
+//
+// The entries are read from the files written by the file sink, so an error
+// is returned if the file sink isn't in use.
+func (a *API) Query(ctx context.Context, args params.AuditLogQueryArgs) (params.AuditLogQueryResult, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.SuperuserAccess, a.controllerTag); err != nil {
+		return params.AuditLogQueryResult{}, err
+	}
+
+	cfg, err := a.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return params.AuditLogQueryResult{}, errors.Capture(err)
+	}
+	if !slices.Contains(cfg.AuditLogSinks(), auditlog.FileSink) {
+		return params.AuditLogQueryResult{
+			ControllerID: a.controllerID,
+			Error: apiservererrors.ServerError(errors.Errorf(
+				"audit log %q sink not enabled, the audit log can only be queried from its files", auditlog.FileSink,
+			).Add(coreerrors.NotSupported)),
+		}, nil
+	}
+
+	maxEntries := args.Limit
+	if maxEntries <= 0 || maxEntries > MaxQueryEntries {
+		maxEntries = MaxQueryEntries
+	}
+
+	filter := auditlog.Filter{
+		Who:        args.User,
+		ModelUUID:  args.ModelUUID,
+		Facade:     args.Facade,
+		Method:     args.Method,
+		ErrorsOnly: args.ErrorsOnly,
+		// Read one more entry than can be returned, to know whether
+		// the results are truncated.
+		Limit: maxEntries + 1,
+	}
+	if args.From != nil {
+		filter.From = *args.From
+	}
+	if args.To != nil {
+		filter.To = *args.To
+	}
+
+	entries, err := a.readEntries(a.logDir, filter)
+	if err != nil {
+		return params.AuditLogQueryResult{
+			ControllerID: a.controllerID,
+			Error:        apiservererrors.ServerError(err),
+		}, nil
+	}
+
+	var truncated bool
+	if len(entries) > maxEntries {
+		entries = entries[len(entries)-maxEntries:]
+		truncated = true
+	}
+
+	result := params.AuditLogQueryResult{
+		ControllerID: a.controllerID,
+		Entries:      make([]params.AuditLogEntry, len(entries)),
+		Truncated:    truncated,
+	}
+	for i, e := range entries {
+		result.Entries[i] = params.AuditLogEntry{
+			ConversationID: e.ConversationID,
+			ConnectionID:   e.ConnectionID,
+			RequestID:      e.RequestID,
+			When:           e.When,
+			User:           e.Who,
+			Command:        e.What,
+			ModelName:      e.ModelName,
+			ModelUUID:      e.ModelUUID,
+			Facade:         e.Facade,
+			Method:         e.Method,
+			Version:        e.Version,
+			Args:           e.Args,
+		}
+		for _, err := range e.Errors {
+			result.Entries[i].Errors = append(result.Entries[i].Errors, params.AuditLogError{
+				Message: err.Message,
+				Code:    err.Code,
+			})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type auditLogSuite struct {
+	authorizer              *MockAuthorizer
+	controllerConfigService *MockControllerConfigService
+}
+
+func TestAuditLogSuite(t *testing.T) {
+	tc.Run(t, &auditLogSuite{})
+}
+
+func (s *auditLogSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	return ctrl
+}
+
+func (s *auditLogSuite) expectSinks(sinks string) {
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.AuditLogSinks: sinks,
+	}, nil)
+}
+
+func (s *auditLogSuite) newAPI(read ReadEntriesFunc) *API {
+	return &API{
+		controllerTag: names.NewControllerTag("deadbeef-1bad-500d-9000-4b1d0d06f00d"),
+		controllerID:  "1",
+		logDir:        "/var/log/juju",
+		authorizer:    s.authorizer,
+		readEntries:   read,
+
+		controllerConfigService: s.controllerConfigService,
+	}
+}
+
+func (s *auditLogSuite) TestQuery(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	when := from.Add(time.Hour)
+
+	var gotDir string
+	var gotFilter auditlog.Filter
+	api := s.newAPI(func(logDir string, filter auditlog.Filter) ([]auditlog.Entry, error) {
+		gotDir = logDir
+		gotFilter = filter
+		return []auditlog.Entry{{
+			ConversationID: "0123456789abcdef",
+			ConnectionID:   "C0",
+			RequestID:      3,
+			When:           when,
+			Who:            "admin",
+			What:           "juju deploy ubuntu",
+			ModelName:      "default",
+			ModelUUID:      "model-uuid",
+			Facade:         "Application",
+			Method:         "Deploy",
+			Version:        20,
+			Args:           `{"applications":[]}`,
+			Errors: []*auditlog.Error{{
+				Message: "boom",
+				Code:    "not found",
+			}},
+		}}, nil
+	})
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, api.controllerTag).Return(nil)
+	s.expectSinks("file,webhook")
+
+	result, err := api.Query(c.Context(), params.AuditLogQueryArgs{
+		User:       "admin",
+		ModelUUID:  "model-uuid",
+		Facade:     "Application",
+		Method:     "Deploy",
+		From:       &from,
+		ErrorsOnly: true,
+		Limit:      10,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotDir, tc.Equals, "/var/log/juju")
+	c.Check(gotFilter, tc.DeepEquals, auditlog.Filter{
+		Who:        "admin",
+		ModelUUID:  "model-uuid",
+		Facade:     "Application",
+		Method:     "Deploy",
+		From:       from,
+		ErrorsOnly: true,
+		Limit:      11,
+	})
+	c.Check(result, tc.DeepEquals, params.AuditLogQueryResult{
+		ControllerID: "1",
+		Entries: []params.AuditLogEntry{{
+			ConversationID: "0123456789abcdef",
+			ConnectionID:   "C0",
+			RequestID:      3,
+			When:           when,
+			User:           "admin",
+			Command:        "juju deploy ubuntu",
+			ModelName:      "default",
+			ModelUUID:      "model-uuid",
+			Facade:         "Application",
+			Method:         "Deploy",
+			Version:        20,
+			Args:           `{"applications":[]}`,
+			Errors: []params.AuditLogError{{
+				Message: "boom",
+				Code:    "not found",
+			}},
+		}},
+	})
+}
+
+func (s *auditLogSuite) TestQueryReadError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	api := s.newAPI(func(string, auditlog.Filter) ([]auditlog.Entry, error) {
+		return nil, errors.New("boom")
+	})
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, api.controllerTag).Return(nil)
+	s.expectSinks("file")
+
+	result, err := api.Query(c.Context(), params.AuditLogQueryArgs{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.ControllerID, tc.Equals, "1")
+	c.Check(result.Error, tc.ErrorMatches, "boom")
+}
+
+func (s *auditLogSuite) TestQueryFileSinkNotEnabled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	api := s.newAPI(func(string, auditlog.Filter) ([]auditlog.Entry, error) {
+		c.Fatalf("unexpected read")
+		return nil, nil
+	})
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, api.controllerTag).Return(nil)
+	s.expectSinks("webhook")
+
+	result, err := api.Query(c.Context(), params.AuditLogQueryArgs{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.ControllerID, tc.Equals, "1")
+	c.Check(result.Error, tc.Satisfies, params.IsCodeNotSupported)
+}
+
+func (s *auditLogSuite) TestQueryTruncated(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var gotFilter auditlog.Filter
+	api := s.newAPI(func(_ string, filter auditlog.Filter) ([]auditlog.Entry, error) {
+		gotFilter = filter
+		entries := make([]auditlog.Entry, MaxQueryEntries+1)
+		for i := range entries {
+			entries[i].RequestID = uint64(i)
+		}
+		return entries, nil
+	})
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, api.controllerTag).Return(nil)
+	s.expectSinks("file")
+
+	// An unlimited query is capped, keeping the most recent entries.
+	result, err := api.Query(c.Context(), params.AuditLogQueryArgs{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotFilter.Limit, tc.Equals, MaxQueryEntries+1)
+	c.Check(result.Truncated, tc.IsTrue)
+	c.Assert(result.Entries, tc.HasLen, MaxQueryEntries)
+	c.Check(result.Entries[0].RequestID, tc.Equals, uint64(1))
+}
+
+func (s *auditLogSuite) TestQueryTruncatedByLimit(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var gotFilter auditlog.Filter
+	api := s.newAPI(func(_ string, filter auditlog.Filter) ([]auditlog.Entry, error) {
+		gotFilter = filter
+		entries := make([]auditlog.Entry, filter.Limit)
+		for i := range entries {
+			entries[i].RequestID = uint64(i)
+		}
+		return entries, nil
+	})
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, api.controllerTag).Return(nil).Times(2)
+	s.expectSinks("file")
+	s.expectSinks("file")
+
+	// More entries match than the requested limit, so the most recent
+	// are kept and the result is marked as truncated.
+	result, err := api.Query(c.Context(), params.AuditLogQueryArgs{Limit: 5})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotFilter.Limit, tc.Equals, 6)
+	c.Check(result.Truncated, tc.IsTrue)
+	c.Assert(result.Entries, tc.HasLen, 5)
+	c.Check(result.Entries[0].RequestID, tc.Equals, uint64(1))
+
+	// A limit above the maximum is capped.
+	result, err = api.Query(c.Context(), params.AuditLogQueryArgs{Limit: MaxQueryEntries + 10})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotFilter.Limit, tc.Equals, MaxQueryEntries+1)
+	c.Check(result.Truncated, tc.IsTrue)
+	c.Check(result.Entries, tc.HasLen, MaxQueryEntries)
+}
+
+func (s *auditLogSuite) TestQueryPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	api := s.newAPI(func(string, auditlog.Filter) ([]auditlog.Entry, error) {
+		c.Fatalf("unexpected read")
+		return nil, nil
+	})
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, api.controllerTag).Return(apiservererrors.ErrPerm)
+
+	_, err := api.Query(c.Context(), params.AuditLogQueryArgs{})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
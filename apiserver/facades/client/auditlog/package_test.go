@@ -0,0 +1,6 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package auditlog -destination service_mock_test.go -source=./auditlog.go
@@ -0,0 +1,45 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/auditlog"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("AuditLog", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return NewAPI(ctx)
+	}, reflect.TypeOf((*API)(nil)))
+}
+
+// NewAPI returns a new audit log API facade.
+func NewAPI(ctx facade.ModelContext) (*API, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+
+	var controllerID string
+	if tag := ctx.MachineTag(); tag != nil {
+		controllerID = tag.Id()
+	}
+
+	return &API{
+		controllerTag: names.NewControllerTag(ctx.ControllerUUID()),
+		controllerID:  controllerID,
+		logDir:        ctx.LogDir(),
+		authorizer:    authorizer,
+		readEntries:   auditlog.ReadEntries,
+
+		controllerConfigService: ctx.DomainServices().ControllerConfig(),
+	}, nil
+}
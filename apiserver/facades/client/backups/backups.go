@@ -6,12 +6,19 @@ package backups
 import (
 	"context"
 
+	"github.com/juju/clock"
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/controller"
 	corebackups "github.com/juju/juju/core/backups"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/backups"
 )
 
 // ControllerConfigService is an interface that provides the controller config.
@@ -20,29 +27,85 @@ type ControllerConfigService interface {
 	ControllerConfig(context.Context) (controller.Config, error)
 }
 
+// ControllerNodeService is an interface that provides the controller nodes.
+type ControllerNodeService interface {
+	// GetControllerIDs returns the IDs of the controller nodes.
+	GetControllerIDs(context.Context) ([]string, error)
+}
+
+// ModelService is an interface that lists the models on the controller.
+type ModelService interface {
+	// ListModelUUIDs returns the UUIDs of all active models on the
+	// controller.
+	ListModelUUIDs(context.Context) ([]model.UUID, error)
+}
+
+// ModelConfigService is an interface that provides the controller model's
+// config.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(context.Context) (*config.Config, error)
+}
+
+// ModelDumperGetter returns the dumper for the database of the model with
+// the input UUID.
+type ModelDumperGetter func(context.Context, model.UUID) (backups.DatabaseDumper, error)
+
 // API provides backup-specific API methods.
 type API struct {
 	controllerConfigService ControllerConfigService
-	paths                   *corebackups.Paths
+	controllerNodeService   ControllerNodeService
+	modelService            ModelService
+	modelConfigService      ModelConfigService
+	controllerDumper        backups.DatabaseDumper
+	modelDumper             ModelDumperGetter
+
+	paths *corebackups.Paths
+
+	controllerUUID      string
+	controllerModelUUID model.UUID
 
 	// machineID is the ID of the machine where the API server is running.
 	machineID string
+
+	clock  clock.Clock
+	logger corelogger.Logger
+}
+
+// Services holds the services required by the Backups facade.
+type Services struct {
+	ControllerConfigService ControllerConfigService
+	ControllerNodeService   ControllerNodeService
+	ModelService            ModelService
+	ModelConfigService      ModelConfigService
+	ControllerDumper        backups.DatabaseDumper
+	ModelDumper             ModelDumperGetter
 }
 
 // NewAPI creates a new instance of the Backups API facade.
 func NewAPI(
-	controllerConfigService ControllerConfigService,
+	ctx context.Context,
+	services Services,
 	authorizer facade.Authorizer,
+	controllerUUID string,
+	modelUUID, controllerModelUUID model.UUID,
 	machineTag names.Tag,
 	dataDir, logDir string,
+	clock clock.Clock,
+	logger corelogger.Logger,
 ) (*API, error) {
-	// TODO (manadart 2023-10-11) Restore this assignment based on super-user
-	// access to the controller when re-implemented for Dqlite.
-	isControllerAdmin := true
-
-	if !authorizer.AuthClient() || !isControllerAdmin {
+	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
 	}
+	err := authorizer.HasPermission(ctx, permission.SuperuserAccess, names.NewControllerTag(controllerUUID))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if modelUUID != controllerModelUUID {
+		return nil, errors.New("backups are only supported from the controller model\n" +
+			"Use juju switch to select the controller model")
+	}
 
 	paths := corebackups.Paths{
 		DataDir: dataDir,
@@ -50,9 +113,18 @@ func NewAPI(
 	}
 
 	b := API{
-		controllerConfigService: controllerConfigService,
+		controllerConfigService: services.ControllerConfigService,
+		controllerNodeService:   services.ControllerNodeService,
+		modelService:            services.ModelService,
+		modelConfigService:      services.ModelConfigService,
+		controllerDumper:        services.ControllerDumper,
+		modelDumper:             services.ModelDumper,
 		paths:                   &paths,
+		controllerUUID:          controllerUUID,
+		controllerModelUUID:     controllerModelUUID,
 		machineID:               machineTag.Id(),
+		clock:                   clock,
+		logger:                  logger,
 	}
 	return &b, nil
 }
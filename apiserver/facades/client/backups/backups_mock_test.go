@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/backups (interfaces: DatabaseDumper)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package backups -destination backups_mock_test.go github.com/juju/juju/internal/backups DatabaseDumper
+//
+
+// Package backups is a generated GoMock package.
+package backups
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDatabaseDumper is a mock of DatabaseDumper interface.
+type MockDatabaseDumper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseDumperMockRecorder
+}
+
+// MockDatabaseDumperMockRecorder is the mock recorder for MockDatabaseDumper.
+type MockDatabaseDumperMockRecorder struct {
+	mock *MockDatabaseDumper
+}
+
+// NewMockDatabaseDumper creates a new mock instance.
+func NewMockDatabaseDumper(ctrl *gomock.Controller) *MockDatabaseDumper {
+	mock := &MockDatabaseDumper{ctrl: ctrl}
+	mock.recorder = &MockDatabaseDumperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseDumper) EXPECT() *MockDatabaseDumperMockRecorder {
+	return m.recorder
+}
+
+// DumpDatabase mocks base method.
+func (m *MockDatabaseDumper) DumpDatabase(arg0 context.Context, arg1 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpDatabase", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DumpDatabase indicates an expected call of DumpDatabase.
+func (mr *MockDatabaseDumperMockRecorder) DumpDatabase(arg0, arg1 any) *MockDatabaseDumperDumpDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpDatabase", reflect.TypeOf((*MockDatabaseDumper)(nil).DumpDatabase), arg0, arg1)
+	return &MockDatabaseDumperDumpDatabaseCall{Call: call}
+}
+
+// MockDatabaseDumperDumpDatabaseCall wrap *gomock.Call
+type MockDatabaseDumperDumpDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDatabaseDumperDumpDatabaseCall) Return(arg0 error) *MockDatabaseDumperDumpDatabaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDatabaseDumperDumpDatabaseCall) Do(f func(context.Context, io.Writer) error) *MockDatabaseDumperDumpDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDatabaseDumperDumpDatabaseCall) DoAndReturn(f func(context.Context, io.Writer) error) *MockDatabaseDumperDumpDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
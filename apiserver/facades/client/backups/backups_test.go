@@ -0,0 +1,230 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/controller"
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/model"
+	modeltesting "github.com/juju/juju/core/model/testing"
+	coreos "github.com/juju/juju/core/os"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/backups"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type backupsSuite struct {
+	coretesting.BaseSuite
+
+	controllerConfigService *MockControllerConfigService
+	controllerNodeService   *MockControllerNodeService
+	modelService            *MockModelService
+	modelConfigService      *MockModelConfigService
+	controllerDumper        *MockDatabaseDumper
+	modelDumper             *MockDatabaseDumper
+
+	controllerModelUUID model.UUID
+	modelUUID           model.UUID
+	dataDir             string
+	backupDir           string
+}
+
+func TestBackupsSuite(t *testing.T) {
+	tc.Run(t, &backupsSuite{})
+}
+
+func (s *backupsSuite) SetUpTest(c *tc.C) {
+	s.BaseSuite.SetUpTest(c)
+
+	s.controllerModelUUID = modeltesting.GenModelUUID(c)
+	s.modelUUID = modeltesting.GenModelUUID(c)
+	s.dataDir = c.MkDir()
+	s.backupDir = c.MkDir()
+
+	s.PatchValue(&coreos.HostBase, func() (corebase.Base, error) {
+		return corebase.MustParseBaseFromString("ubuntu@24.04"), nil
+	})
+}
+
+func (s *backupsSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.controllerNodeService = NewMockControllerNodeService(ctrl)
+	s.modelService = NewMockModelService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	s.controllerDumper = NewMockDatabaseDumper(ctrl)
+	s.modelDumper = NewMockDatabaseDumper(ctrl)
+
+	c.Cleanup(func() {
+		s.controllerConfigService = nil
+		s.controllerNodeService = nil
+		s.modelService = nil
+		s.modelConfigService = nil
+		s.controllerDumper = nil
+		s.modelDumper = nil
+	})
+
+	return ctrl
+}
+
+func (s *backupsSuite) newAPI(c *tc.C, authorizer apiservertesting.FakeAuthorizer, modelUUID model.UUID) (*API, error) {
+	return NewAPI(
+		c.Context(),
+		Services{
+			ControllerConfigService: s.controllerConfigService,
+			ControllerNodeService:   s.controllerNodeService,
+			ModelService:            s.modelService,
+			ModelConfigService:      s.modelConfigService,
+			ControllerDumper:        s.controllerDumper,
+			ModelDumper: func(_ context.Context, uuid model.UUID) (backups.DatabaseDumper, error) {
+				c.Check(uuid, tc.Equals, s.modelUUID)
+				return s.modelDumper, nil
+			},
+		},
+		authorizer,
+		coretesting.ControllerTag.Id(),
+		modelUUID,
+		s.controllerModelUUID,
+		names.NewMachineTag("0"),
+		s.dataDir,
+		c.MkDir(),
+		testclock.NewClock(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+		loggertesting.WrapCheckLog(c),
+	)
+}
+
+func (s *backupsSuite) adminAuthorizer() apiservertesting.FakeAuthorizer {
+	return apiservertesting.FakeAuthorizer{
+		Tag:      names.NewUserTag("admin"),
+		AdminTag: names.NewUserTag("admin"),
+	}
+}
+
+func (s *backupsSuite) TestNewAPIRequiresSuperuser(c *tc.C) {
+	_, err := s.newAPI(c, apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("bob"),
+	}, s.controllerModelUUID)
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *backupsSuite) TestNewAPIRequiresClient(c *tc.C) {
+	_, err := s.newAPI(c, apiservertesting.FakeAuthorizer{
+		Tag: names.NewMachineTag("0"),
+	}, s.controllerModelUUID)
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *backupsSuite) TestNewAPIRequiresControllerModel(c *tc.C) {
+	_, err := s.newAPI(c, s.adminAuthorizer(), s.modelUUID)
+	c.Assert(err, tc.ErrorMatches, "backups are only supported from the controller model\n.*")
+}
+
+func (s *backupsSuite) expectCreate(c *tc.C, objectStoreType string) {
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.ObjectStoreType: objectStoreType,
+	}, nil)
+
+	cfg, err := config.New(config.NoDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		"backup-dir": s.backupDir,
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+
+	s.controllerNodeService.EXPECT().GetControllerIDs(gomock.Any()).Return([]string{"0", "1", "2"}, nil)
+	s.modelService.EXPECT().ListModelUUIDs(gomock.Any()).Return([]model.UUID{s.modelUUID}, nil)
+
+	s.controllerDumper.EXPECT().DumpDatabase(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, "controller dump\n")
+		return err
+	})
+	s.modelDumper.EXPECT().DumpDatabase(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, "model dump\n")
+		return err
+	})
+}
+
+func (s *backupsSuite) openArchive(c *tc.C, filename string) *backups.Archive {
+	f, err := os.Open(filename)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Cleanup(func() { _ = f.Close() })
+
+	archive, err := backups.OpenArchive(f)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Cleanup(func() { _ = archive.Close() })
+	return archive
+}
+
+func (s *backupsSuite) TestCreate(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	objectDir := filepath.Join(s.dataDir, "objectstore", s.modelUUID.String())
+	c.Assert(os.MkdirAll(objectDir, 0700), tc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(objectDir, "abc"), []byte("object"), 0600), tc.ErrorIsNil)
+
+	s.expectCreate(c, "file")
+
+	api, err := s.newAPI(c, s.adminAuthorizer(), s.controllerModelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := api.Create(c.Context(), params.BackupsCreateArgs{Notes: "before upgrade"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.ID, tc.Equals, "20250102-030405."+s.controllerModelUUID.String())
+	c.Check(result.Filename, tc.Equals, filepath.Join(s.backupDir, "juju-backup-20250102-030405.tar.gz"))
+	c.Check(result.Notes, tc.Equals, "before upgrade")
+	c.Check(result.Model, tc.Equals, s.controllerModelUUID.String())
+	c.Check(result.Machine, tc.Equals, "0")
+	c.Check(result.Base, tc.Equals, "ubuntu@24.04/stable")
+	c.Check(result.ControllerUUID, tc.Equals, coretesting.ControllerTag.Id())
+	c.Check(result.ControllerMachineID, tc.Equals, "0")
+	c.Check(result.HANodes, tc.Equals, int64(3))
+	c.Check(result.Databases, tc.DeepEquals, []string{"controller", s.modelUUID.String()})
+	c.Check(result.Size, tc.Not(tc.Equals), int64(0))
+
+	archive := s.openArchive(c, result.Filename)
+	target := c.MkDir()
+	c.Assert(archive.RestoreFiles(target), tc.ErrorIsNil)
+	data, err := os.ReadFile(filepath.Join(target, "objectstore", s.modelUUID.String(), "abc"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "object")
+}
+
+func (s *backupsSuite) TestCreateS3ObjectStore(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	objectDir := filepath.Join(s.dataDir, "objectstore", s.modelUUID.String())
+	c.Assert(os.MkdirAll(objectDir, 0700), tc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(objectDir, "abc"), []byte("object"), 0600), tc.ErrorIsNil)
+
+	s.expectCreate(c, "s3")
+
+	api, err := s.newAPI(c, s.adminAuthorizer(), s.controllerModelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := api.Create(c.Context(), params.BackupsCreateArgs{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Objects held in S3 are not part of the backup, so any stale files
+	// left on disk are not included.
+	archive := s.openArchive(c, result.Filename)
+	target := c.MkDir()
+	c.Assert(archive.RestoreFiles(target), tc.ErrorIsNil)
+	_, err = os.Stat(filepath.Join(target, "objectstore"))
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+}
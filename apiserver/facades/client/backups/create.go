@@ -5,15 +5,112 @@ package backups
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 
 	"github.com/juju/errors"
 
+	corebackups "github.com/juju/juju/core/backups"
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/objectstore"
+	coreos "github.com/juju/juju/core/os"
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/internal/backups"
 	"github.com/juju/juju/rpc/params"
 )
 
 // Create is the API method that requests juju to create a new backup
-// of its state.
-func (a *API) Create(context.Context, params.BackupsCreateArgs) (params.BackupsMetadataResult, error) {
+// of its state. The backup contains a consistent dump of the controller
+// database and of every model database, and the file backed object store if
+// the controller uses one.
+func (a *API) Create(ctx context.Context, args params.BackupsCreateArgs) (params.BackupsMetadataResult, error) {
 	result := params.BackupsMetadataResult{}
-	return result, errors.NotImplementedf("Dqlite-based backups")
+
+	controllerConfig, err := a.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return result, errors.Annotate(err, "getting controller config")
+	}
+	modelConfig, err := a.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return result, errors.Annotate(err, "getting model config")
+	}
+	controllerIDs, err := a.controllerNodeService.GetControllerIDs(ctx)
+	if err != nil {
+		return result, errors.Annotate(err, "getting controller nodes")
+	}
+	modelUUIDs, err := a.modelService.ListModelUUIDs(ctx)
+	if err != nil {
+		return result, errors.Annotate(err, "listing models")
+	}
+
+	meta, err := a.newMetadata(args.Notes, int64(len(controllerIDs)))
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+
+	databases := []backups.Database{{
+		Namespace: coredatabase.ControllerNS,
+		Dumper:    a.controllerDumper,
+	}}
+	for _, modelUUID := range modelUUIDs {
+		dumper, err := a.modelDumper(ctx, modelUUID)
+		if err != nil {
+			return result, errors.Annotatef(err, "getting database for model %q", modelUUID)
+		}
+		databases = append(databases, backups.Database{
+			Namespace: modelUUID.String(),
+			Dumper:    dumper,
+		})
+	}
+
+	// Only the file backed object store lives on the controller's disk.
+	// Objects held in S3 are not part of the backup.
+	var dataDir string
+	if controllerConfig.ObjectStoreType() == objectstore.FileBackend {
+		dataDir = a.paths.DataDir
+	}
+
+	backupDir := backups.BackupDir(modelConfig.BackupDir())
+	filename, err := backups.Create(ctx, backups.CreateArgs{
+		Metadata:  meta,
+		Databases: databases,
+		DataDir:   dataDir,
+		BackupDir: backupDir,
+	})
+	if err != nil {
+		return result, errors.Annotate(err, "creating backup")
+	}
+	a.logger.Infof(ctx, "created backup %q with %d databases", filename, len(databases))
+
+	return params.CreateResult(meta, filepath.Join(backupDir, filename)), nil
+}
+
+func (a *API) newMetadata(notes string, haNodes int64) (*corebackups.Metadata, error) {
+	meta := corebackups.NewMetadata()
+	meta.Started = a.clock.Now().UTC()
+	meta.Notes = notes
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, errors.Annotate(err, "getting hostname")
+	}
+	base, err := coreos.HostBase()
+	if err != nil {
+		return nil, errors.Annotate(err, "getting host base")
+	}
+	meta.Origin = corebackups.Origin{
+		Model:    a.controllerModelUUID.String(),
+		Machine:  a.machineID,
+		Hostname: hostname,
+		Version:  jujuversion.Current,
+		Base:     base.String(),
+	}
+	meta.Controller = corebackups.ControllerMetadata{
+		UUID:      a.controllerUUID,
+		MachineID: a.machineID,
+		HANodes:   haNodes,
+	}
+
+	meta.SetID(meta.Started.Format("20060102-150405") + "." + a.controllerModelUUID.String())
+	return meta, nil
 }
@@ -0,0 +1,7 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package backups -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/backups ControllerConfigService,ControllerNodeService,ModelService,ModelConfigService
+//go:generate go run go.uber.org/mock/mockgen -typed -package backups -destination backups_mock_test.go github.com/juju/juju/internal/backups DatabaseDumper
@@ -8,22 +8,44 @@ import (
 	"reflect"
 
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/backups"
 )
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
-	registry.MustRegister("Backups", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newFacade(ctx)
+	registry.MustRegisterForMultiModel("Backups", 3, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		return newFacade(stdCtx, ctx)
 	}, reflect.TypeOf((*API)(nil)))
 }
 
 // newFacade provides the required signature for facade registration.
-func newFacade(ctx facade.ModelContext) (*API, error) {
+func newFacade(stdCtx context.Context, ctx facade.MultiModelContext) (*API, error) {
+	domainServices := ctx.DomainServices()
 	return NewAPI(
-		ctx.DomainServices().ControllerConfig(),
+		stdCtx,
+		Services{
+			ControllerConfigService: domainServices.ControllerConfig(),
+			ControllerNodeService:   domainServices.ControllerNode(),
+			ModelService:            domainServices.Model(),
+			ModelConfigService:      domainServices.Config(),
+			ControllerDumper:        domainServices.ControllerBackup(),
+			ModelDumper: func(stdCtx context.Context, modelUUID model.UUID) (backups.DatabaseDumper, error) {
+				modelServices, err := ctx.DomainServicesForModel(stdCtx, modelUUID)
+				if err != nil {
+					return nil, err
+				}
+				return modelServices.Backup(), nil
+			},
+		},
 		ctx.Auth(),
+		ctx.ControllerUUID(),
+		ctx.ModelUUID(),
+		ctx.ControllerModelUUID(),
 		ctx.MachineTag(),
 		ctx.DataDir(),
 		ctx.LogDir(),
+		ctx.Clock(),
+		ctx.Logger().Child("backups"),
 	)
 }
@@ -18,8 +18,10 @@ import (
 // BlockCommandService defines the methods that the BlockCommandService
 // facade requires from the domain service.
 type BlockCommandService interface {
-	// SwitchBlockOn switches on a command block for a given type and message.
-	SwitchBlockOn(ctx context.Context, t blockcommand.BlockType, message string) error
+	// SwitchBlockOnWithArgs switches on a command block for a given type,
+	// which may expire or be limited to particular applications, methods or
+	// users.
+	SwitchBlockOnWithArgs(ctx context.Context, t blockcommand.BlockType, args blockcommand.BlockArgs) error
 	// SwitchBlockOff disables block of specified type for the current model.
 	SwitchBlockOff(ctx context.Context, t blockcommand.BlockType) error
 	// GetBlocks returns all the blocks for the current model.
@@ -28,12 +30,20 @@ type BlockCommandService interface {
 
 // Authorizer defines the methods that the BlockCommandService
 type Authorizer interface {
+	// GetAuthTag returns the tag of the authenticated entity.
+	GetAuthTag() names.Tag
 
 	// HasPermission reports whether the given access is allowed for the given
 	// target by the authenticated entity.
 	HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error
 }
 
+// APIv2 provides the Block API facade for version 2, which doesn't support
+// expiring or scoped blocks.
+type APIv2 struct {
+	*API
+}
+
 // API implements Block interface and is the concrete
 // implementation of the api end point.
 type API struct {
@@ -72,10 +82,22 @@ func (a *API) List(ctx context.Context) (params.BlockResults, error) {
 func convertBlock(modelTag names.ModelTag, b blockcommand.Block) params.BlockResult {
 	result := params.BlockResult{}
 	result.Result = params.Block{
-		Id:      b.UUID,
-		Tag:     modelTag.String(),
-		Type:    b.Type.String(),
-		Message: b.Message,
+		Id:           b.UUID,
+		Tag:          modelTag.String(),
+		Type:         encodeBlockType(b.Type),
+		Message:      b.Message,
+		CreatedBy:    b.CreatedBy,
+		ExpiresAt:    b.ExpiresAt,
+		Applications: b.Applications,
+		Methods:      b.Methods,
+		ExemptUsers:  b.ExemptUsers,
+	}
+	if !b.CreatedAt.IsZero() {
+		createdAt := b.CreatedAt
+		result.Result.CreatedAt = &createdAt
+	}
+	for _, role := range b.ExemptRoles {
+		result.Result.ExemptRoles = append(result.Result.ExemptRoles, string(role))
 	}
 	return result
 }
@@ -91,7 +113,24 @@ func (a *API) SwitchBlockOn(ctx context.Context, args params.BlockSwitchParams)
 		return params.ErrorResult{Error: apiservererrors.ServerError(err)}
 	}
 
-	err = a.service.SwitchBlockOn(ctx, blockType, args.Message)
+	blockArgs := blockcommand.BlockArgs{
+		Message:      args.Message,
+		Applications: args.Applications,
+		Methods:      args.Methods,
+		ExemptUsers:  args.ExemptUsers,
+	}
+	if userTag, ok := a.authorizer.GetAuthTag().(names.UserTag); ok {
+		blockArgs.CreatedBy = userTag.Id()
+	}
+	if args.ExpiresAt != nil {
+		expiresAt := args.ExpiresAt.UTC()
+		blockArgs.ExpiresAt = &expiresAt
+	}
+	for _, role := range args.ExemptRoles {
+		blockArgs.ExemptRoles = append(blockArgs.ExemptRoles, permission.Access(role))
+	}
+
+	err = a.service.SwitchBlockOnWithArgs(ctx, blockType, blockArgs)
 	return params.ErrorResult{Error: apiservererrors.ServerError(err)}
 }
 
@@ -122,3 +161,16 @@ func parseBlockType(str string) (blockcommand.BlockType, error) {
 		return -1, errors.NotValidf("unknown block type %q", str)
 	}
 }
+
+func encodeBlockType(t blockcommand.BlockType) string {
+	switch t {
+	case blockcommand.DestroyBlock:
+		return params.BlockDestroy
+	case blockcommand.RemoveBlock:
+		return params.BlockRemove
+	case blockcommand.ChangeBlock:
+		return params.BlockChange
+	default:
+		return "unknown"
+	}
+}
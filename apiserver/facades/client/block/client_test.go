@@ -5,6 +5,7 @@ package block
 
 import (
 	"testing"
+	"time"
 
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
@@ -53,7 +54,11 @@ func (s *blockSuite) TestSwitchValidBlockOn(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, s.api.modelTag).Return(nil)
-	s.service.EXPECT().SwitchBlockOn(gomock.Any(), blockcommand.DestroyBlock, "for TestSwitchValidBlockOn").Return(nil)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("fred"))
+	s.service.EXPECT().SwitchBlockOnWithArgs(gomock.Any(), blockcommand.DestroyBlock, blockcommand.BlockArgs{
+		Message:   "for TestSwitchValidBlockOn",
+		CreatedBy: "fred",
+	}).Return(nil)
 
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
 	s.service.EXPECT().GetBlocks(gomock.Any()).Return([]blockcommand.Block{
@@ -63,6 +68,70 @@ func (s *blockSuite) TestSwitchValidBlockOn(c *tc.C) {
 	s.assertSwitchBlockOn(c, params.BlockDestroy, "for TestSwitchValidBlockOn")
 }
 
+func (s *blockSuite) TestSwitchScopedBlockOn(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expiresAt := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, s.api.modelTag).Return(nil)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("fred"))
+	s.service.EXPECT().SwitchBlockOnWithArgs(gomock.Any(), blockcommand.ChangeBlock, blockcommand.BlockArgs{
+		Message:      "release freeze",
+		CreatedBy:    "fred",
+		ExpiresAt:    &expiresAt,
+		Applications: []string{"foo"},
+		Methods:      []string{"Application.SetCharm"},
+		ExemptUsers:  []string{"mary"},
+		ExemptRoles:  []permission.Access{permission.AdminAccess},
+	}).Return(nil)
+
+	result := s.api.SwitchBlockOn(c.Context(), params.BlockSwitchParams{
+		Type:         params.BlockChange,
+		Message:      "release freeze",
+		ExpiresAt:    &expiresAt,
+		Applications: []string{"foo"},
+		Methods:      []string{"Application.SetCharm"},
+		ExemptUsers:  []string{"mary"},
+		ExemptRoles:  []string{"admin"},
+	})
+	c.Assert(result.Error, tc.IsNil)
+}
+
+func (s *blockSuite) TestListScopedBlock(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	createdAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().GetBlocks(gomock.Any()).Return([]blockcommand.Block{{
+		UUID:         "deadbeef",
+		Type:         blockcommand.ChangeBlock,
+		Message:      "release freeze",
+		CreatedBy:    "fred",
+		CreatedAt:    createdAt,
+		ExpiresAt:    &expiresAt,
+		Applications: []string{"foo"},
+		ExemptRoles:  []permission.Access{permission.AdminAccess},
+	}}, nil)
+
+	results, err := s.api.List(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results.Results, tc.DeepEquals, []params.BlockResult{{
+		Result: params.Block{
+			Id:           "deadbeef",
+			Tag:          s.api.modelTag.String(),
+			Type:         params.BlockChange,
+			Message:      "release freeze",
+			CreatedBy:    "fred",
+			CreatedAt:    &createdAt,
+			ExpiresAt:    &expiresAt,
+			Applications: []string{"foo"},
+			ExemptRoles:  []string{"admin"},
+		},
+	}})
+}
+
 func (s *blockSuite) TestSwitchInvalidBlockOn(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -16,6 +16,13 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Block", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		api, err := NewAPI(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &APIv2{API: api}, nil
+	}, reflect.TypeOf((*APIv2)(nil)))
+	registry.MustRegister("Block", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return NewAPI(ctx)
 	}, reflect.TypeOf((*API)(nil)))
 }
@@ -119,40 +119,40 @@ func (c *MockBlockCommandServiceSwitchBlockOffCall) DoAndReturn(f func(context.C
 	return c
 }
 
-// SwitchBlockOn mocks base method.
-func (m *MockBlockCommandService) SwitchBlockOn(arg0 context.Context, arg1 blockcommand.BlockType, arg2 string) error {
+// SwitchBlockOnWithArgs mocks base method.
+func (m *MockBlockCommandService) SwitchBlockOnWithArgs(arg0 context.Context, arg1 blockcommand.BlockType, arg2 blockcommand.BlockArgs) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SwitchBlockOn", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SwitchBlockOnWithArgs", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SwitchBlockOn indicates an expected call of SwitchBlockOn.
-func (mr *MockBlockCommandServiceMockRecorder) SwitchBlockOn(arg0, arg1, arg2 any) *MockBlockCommandServiceSwitchBlockOnCall {
+// SwitchBlockOnWithArgs indicates an expected call of SwitchBlockOnWithArgs.
+func (mr *MockBlockCommandServiceMockRecorder) SwitchBlockOnWithArgs(arg0, arg1, arg2 any) *MockBlockCommandServiceSwitchBlockOnWithArgsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwitchBlockOn", reflect.TypeOf((*MockBlockCommandService)(nil).SwitchBlockOn), arg0, arg1, arg2)
-	return &MockBlockCommandServiceSwitchBlockOnCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwitchBlockOnWithArgs", reflect.TypeOf((*MockBlockCommandService)(nil).SwitchBlockOnWithArgs), arg0, arg1, arg2)
+	return &MockBlockCommandServiceSwitchBlockOnWithArgsCall{Call: call}
 }
 
-// MockBlockCommandServiceSwitchBlockOnCall wrap *gomock.Call
-type MockBlockCommandServiceSwitchBlockOnCall struct {
+// MockBlockCommandServiceSwitchBlockOnWithArgsCall wrap *gomock.Call
+type MockBlockCommandServiceSwitchBlockOnWithArgsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockBlockCommandServiceSwitchBlockOnCall) Return(arg0 error) *MockBlockCommandServiceSwitchBlockOnCall {
+func (c *MockBlockCommandServiceSwitchBlockOnWithArgsCall) Return(arg0 error) *MockBlockCommandServiceSwitchBlockOnWithArgsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockBlockCommandServiceSwitchBlockOnCall) Do(f func(context.Context, blockcommand.BlockType, string) error) *MockBlockCommandServiceSwitchBlockOnCall {
+func (c *MockBlockCommandServiceSwitchBlockOnWithArgsCall) Do(f func(context.Context, blockcommand.BlockType, blockcommand.BlockArgs) error) *MockBlockCommandServiceSwitchBlockOnWithArgsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockBlockCommandServiceSwitchBlockOnCall) DoAndReturn(f func(context.Context, blockcommand.BlockType, string) error) *MockBlockCommandServiceSwitchBlockOnCall {
+func (c *MockBlockCommandServiceSwitchBlockOnWithArgsCall) DoAndReturn(f func(context.Context, blockcommand.BlockType, blockcommand.BlockArgs) error) *MockBlockCommandServiceSwitchBlockOnWithArgsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -180,6 +180,44 @@ func (m *MockAuthorizer) EXPECT() *MockAuthorizerMockRecorder {
 	return m.recorder
 }
 
+// GetAuthTag mocks base method.
+func (m *MockAuthorizer) GetAuthTag() names.Tag {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthTag")
+	ret0, _ := ret[0].(names.Tag)
+	return ret0
+}
+
+// GetAuthTag indicates an expected call of GetAuthTag.
+func (mr *MockAuthorizerMockRecorder) GetAuthTag() *MockAuthorizerGetAuthTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthTag", reflect.TypeOf((*MockAuthorizer)(nil).GetAuthTag))
+	return &MockAuthorizerGetAuthTagCall{Call: call}
+}
+
+// MockAuthorizerGetAuthTagCall wrap *gomock.Call
+type MockAuthorizerGetAuthTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthorizerGetAuthTagCall) Return(arg0 names.Tag) *MockAuthorizerGetAuthTagCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthorizerGetAuthTagCall) Do(f func() names.Tag) *MockAuthorizerGetAuthTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthorizerGetAuthTagCall) DoAndReturn(f func() names.Tag) *MockAuthorizerGetAuthTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // HasPermission mocks base method.
 func (m *MockAuthorizer) HasPermission(arg0 context.Context, arg1 permission.Access, arg2 names.Tag) error {
 	m.ctrl.T.Helper()
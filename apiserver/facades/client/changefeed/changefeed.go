@@ -0,0 +1,156 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changefeed
+
+import (
+	"context"
+
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/internal"
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/changefeed"
+	changefeederrors "github.com/juju/juju/domain/changefeed/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ChangeFeedService defines the methods that the ChangeFeed facade requires
+// from the domain service.
+type ChangeFeedService interface {
+	// GetNamespaces returns the names of all the namespaces that can be
+	// watched in the change feed within the given scope.
+	GetNamespaces(ctx context.Context, scope changefeed.Scope) ([]string, error)
+
+	// WatchChanges returns a watcher that emits the change events for the
+	// requested namespaces.
+	WatchChanges(ctx context.Context, scope changefeed.Scope, namespaces ...string) (watcher.ChangesWatcher, error)
+
+	// WatchChangesFrom returns a watcher that first emits all the change
+	// events after the given change log ID for the requested namespaces,
+	// before emitting live change events.
+	WatchChangesFrom(ctx context.Context, scope changefeed.Scope, changeLogID int64, namespaces ...string) (watcher.ChangesWatcher, error)
+}
+
+// Authorizer defines the methods that the ChangeFeed facade requires from
+// the authorizer.
+type Authorizer interface {
+	// HasPermission reports whether the given access is allowed for the given
+	// target by the authenticated entity.
+	HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error
+}
+
+// API implements the ChangeFeed facade.
+type API struct {
+	controllerTag   names.ControllerTag
+	modelTag        names.ModelTag
+	service         ChangeFeedService
+	authorizer      Authorizer
+	watcherRegistry facade.WatcherRegistry
+}
+
+// Namespaces returns the names of the namespaces that can be watched with
+// the change feed by the authenticated user.
+func (a *API) Namespaces(ctx context.Context) (params.ChangeFeedNamespacesResult, error) {
+	scope, err := a.scope(ctx)
+	if err != nil {
+		return params.ChangeFeedNamespacesResult{}, err
+	}
+
+	namespaces, err := a.service.GetNamespaces(ctx, scope)
+	if err != nil {
+		return params.ChangeFeedNamespacesResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return params.ChangeFeedNamespacesResult{Namespaces: namespaces}, nil
+}
+
+// WatchChanges starts a watcher that emits the changes for the requested
+// namespaces of the model. If a change log ID is supplied, all the changes
+// after that ID are replayed before live changes are emitted. Users without
+// admin access to the model can only watch the namespaces describing the
+// topology of the model.
+func (a *API) WatchChanges(ctx context.Context, args params.WatchChangesArgs) (params.ChangeFeedWatchResult, error) {
+	scope, err := a.scope(ctx)
+	if err != nil {
+		return params.ChangeFeedWatchResult{}, err
+	}
+
+	var w watcher.ChangesWatcher
+	if args.FromChangeLogID != nil {
+		w, err = a.service.WatchChangesFrom(ctx, scope, *args.FromChangeLogID, args.Namespaces...)
+	} else {
+		w, err = a.service.WatchChanges(ctx, scope, args.Namespaces...)
+	}
+	if errors.Is(err, changefeederrors.NamespaceNotFound) {
+		return params.ChangeFeedWatchResult{
+			Error: apiservererrors.ParamsErrorf(params.CodeNotFound, "%v", err),
+		}, nil
+	} else if errors.Is(err, changefeederrors.NamespaceNotPermitted) {
+		return params.ChangeFeedWatchResult{
+			Error: apiservererrors.ParamsErrorf(params.CodeUnauthorized, "%v", err),
+		}, nil
+	} else if err != nil {
+		return params.ChangeFeedWatchResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+
+	id, changes, err := internal.EnsureRegisterWatcher(ctx, a.watcherRegistry, w)
+	if errors.Is(err, database.ErrChangeLogPruned) {
+		return params.ChangeFeedWatchResult{
+			Error: apiservererrors.ParamsErrorf(params.CodeNotValid, "change log ID %d: %v", *args.FromChangeLogID, err),
+		}, nil
+	} else if err != nil {
+		return params.ChangeFeedWatchResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return params.ChangeFeedWatchResult{
+		WatcherId: id,
+		Changes:   TranslateChanges(changes),
+	}, nil
+}
+
+// scope returns the namespaces of the change feed the authenticated user can
+// watch. Superusers and model admins can watch every namespace, while users
+// with read access are limited to the namespaces that are safe to reveal.
+func (a *API) scope(ctx context.Context) (changefeed.Scope, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.ReadAccess, a.modelTag); err != nil {
+		return changefeed.ScopeRead, err
+	}
+
+	err := a.authorizer.HasPermission(ctx, permission.SuperuserAccess, a.controllerTag)
+	if err == nil {
+		return changefeed.ScopeAll, nil
+	} else if !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return changefeed.ScopeRead, err
+	}
+
+	err = a.authorizer.HasPermission(ctx, permission.AdminAccess, a.modelTag)
+	if err == nil {
+		return changefeed.ScopeAll, nil
+	} else if !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return changefeed.ScopeRead, err
+	}
+	return changefeed.ScopeRead, nil
+}
+
+// TranslateChanges converts the change feed events emitted by a watcher
+// into their params representation.
+func TranslateChanges(changes []watcher.ChangeEvent) []params.ChangeFeedEvent {
+	if changes == nil {
+		return nil
+	}
+	result := make([]params.ChangeFeedEvent, len(changes))
+	for i, c := range changes {
+		result[i] = params.ChangeFeedEvent{
+			ID:        c.ID,
+			Namespace: c.Namespace,
+			Changed:   c.Changed,
+			Deleted:   c.Deleted,
+		}
+	}
+	return result
+}
@@ -0,0 +1,240 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changefeed
+
+import (
+	"testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v4/workertest"
+	gomock "go.uber.org/mock/gomock"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/changefeed"
+	changefeederrors "github.com/juju/juju/domain/changefeed/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type changeFeedSuite struct {
+	api *API
+
+	service         *MockChangeFeedService
+	authorizer      *MockAuthorizer
+	watcherRegistry *facademocks.MockWatcherRegistry
+}
+
+func TestChangeFeedSuite(t *testing.T) {
+	tc.Run(t, &changeFeedSuite{})
+}
+
+func (s *changeFeedSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.service = NewMockChangeFeedService(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.watcherRegistry = facademocks.NewMockWatcherRegistry(ctrl)
+
+	s.api = &API{
+		controllerTag:   names.NewControllerTag("deadbeef-0bad-400d-8000-4b1d0d06f00d"),
+		modelTag:        names.NewModelTag("beef1beef1-0000-0000-000011112222"),
+		service:         s.service,
+		authorizer:      s.authorizer,
+		watcherRegistry: s.watcherRegistry,
+	}
+
+	return ctrl
+}
+
+func (s *changeFeedSuite) expectReadAccess() {
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, s.api.controllerTag).Return(authentication.ErrorEntityMissingPermission)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, s.api.modelTag).Return(authentication.ErrorEntityMissingPermission)
+}
+
+func (s *changeFeedSuite) expectAdminAccess() {
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, s.api.controllerTag).Return(authentication.ErrorEntityMissingPermission)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, s.api.modelTag).Return(nil)
+}
+
+func (s *changeFeedSuite) TestNamespaces(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectReadAccess()
+	s.service.EXPECT().GetNamespaces(gomock.Any(), changefeed.ScopeRead).Return([]string{"application", "unit"}, nil)
+
+	result, err := s.api.Namespaces(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ChangeFeedNamespacesResult{
+		Namespaces: []string{"application", "unit"},
+	})
+}
+
+func (s *changeFeedSuite) TestNamespacesAdmin(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAdminAccess()
+	s.service.EXPECT().GetNamespaces(gomock.Any(), changefeed.ScopeAll).Return([]string{"application", "secret_metadata", "unit"}, nil)
+
+	result, err := s.api.Namespaces(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ChangeFeedNamespacesResult{
+		Namespaces: []string{"application", "secret_metadata", "unit"},
+	})
+}
+
+func (s *changeFeedSuite) TestNamespacesPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(apiservererrors.ErrPerm)
+
+	_, err := s.api.Namespaces(c.Context())
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *changeFeedSuite) TestWatchChanges(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan []watcher.ChangeEvent, 1)
+	ch <- []watcher.ChangeEvent{}
+	w := newChangesWatcher(ch)
+	defer workertest.CleanKill(c, w)
+
+	s.expectReadAccess()
+	s.service.EXPECT().WatchChanges(gomock.Any(), changefeed.ScopeRead, "unit").Return(w, nil)
+	s.watcherRegistry.EXPECT().Register(w).Return("1", nil)
+
+	result, err := s.api.WatchChanges(c.Context(), params.WatchChangesArgs{
+		Namespaces: []string{"unit"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ChangeFeedWatchResult{
+		WatcherId: "1",
+		Changes:   []params.ChangeFeedEvent{},
+	})
+}
+
+func (s *changeFeedSuite) TestWatchChangesFrom(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan []watcher.ChangeEvent, 1)
+	ch <- []watcher.ChangeEvent{}
+	w := newChangesWatcher(ch)
+	defer workertest.CleanKill(c, w)
+
+	s.expectReadAccess()
+	s.service.EXPECT().WatchChangesFrom(gomock.Any(), changefeed.ScopeRead, int64(42)).Return(w, nil)
+	s.watcherRegistry.EXPECT().Register(w).Return("1", nil)
+
+	from := int64(42)
+	result, err := s.api.WatchChanges(c.Context(), params.WatchChangesArgs{
+		FromChangeLogID: &from,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.WatcherId, tc.Equals, "1")
+	c.Check(result.Error, tc.IsNil)
+}
+
+func (s *changeFeedSuite) TestWatchChangesNamespaceNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectReadAccess()
+	s.service.EXPECT().WatchChanges(gomock.Any(), changefeed.ScopeRead, "foo").Return(nil, changefeederrors.NamespaceNotFound)
+
+	result, err := s.api.WatchChanges(c.Context(), params.WatchChangesArgs{
+		Namespaces: []string{"foo"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.NotNil)
+	c.Check(result.Error.Code, tc.Equals, params.CodeNotFound)
+}
+
+func (s *changeFeedSuite) TestWatchChangesNamespaceNotPermitted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectReadAccess()
+	s.service.EXPECT().WatchChanges(gomock.Any(), changefeed.ScopeRead, "secret_metadata").Return(nil, changefeederrors.NamespaceNotPermitted)
+
+	result, err := s.api.WatchChanges(c.Context(), params.WatchChangesArgs{
+		Namespaces: []string{"secret_metadata"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.NotNil)
+	c.Check(result.Error.Code, tc.Equals, params.CodeUnauthorized)
+}
+
+func (s *changeFeedSuite) TestWatchChangesAdmin(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan []watcher.ChangeEvent, 1)
+	ch <- []watcher.ChangeEvent{}
+	w := newChangesWatcher(ch)
+	defer workertest.CleanKill(c, w)
+
+	s.expectAdminAccess()
+	s.service.EXPECT().WatchChanges(gomock.Any(), changefeed.ScopeAll, "secret_metadata").Return(w, nil)
+	s.watcherRegistry.EXPECT().Register(w).Return("1", nil)
+
+	result, err := s.api.WatchChanges(c.Context(), params.WatchChangesArgs{
+		Namespaces: []string{"secret_metadata"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.WatcherId, tc.Equals, "1")
+	c.Check(result.Error, tc.IsNil)
+}
+
+func (s *changeFeedSuite) TestWatchChangesPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(apiservererrors.ErrPerm)
+
+	_, err := s.api.WatchChanges(c.Context(), params.WatchChangesArgs{})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *changeFeedSuite) TestTranslateChanges(c *tc.C) {
+	c.Check(TranslateChanges(nil), tc.IsNil)
+	c.Check(TranslateChanges([]watcher.ChangeEvent{{
+		ID:        1,
+		Namespace: "unit",
+		Changed:   "foo/0",
+		Deleted:   true,
+	}}), tc.DeepEquals, []params.ChangeFeedEvent{{
+		ID:        1,
+		Namespace: "unit",
+		Changed:   "foo/0",
+		Deleted:   true,
+	}})
+}
+
+type changesWatcher struct {
+	tomb tomb.Tomb
+	ch   <-chan []watcher.ChangeEvent
+}
+
+func newChangesWatcher(ch <-chan []watcher.ChangeEvent) *changesWatcher {
+	w := &changesWatcher{ch: ch}
+	w.tomb.Go(func() error {
+		<-w.tomb.Dying()
+		return tomb.ErrDying
+	})
+	return w
+}
+
+func (w *changesWatcher) Changes() <-chan []watcher.ChangeEvent {
+	return w.ch
+}
+
+func (w *changesWatcher) Kill() {
+	w.tomb.Kill(nil)
+}
+
+func (w *changesWatcher) Wait() error {
+	return w.tomb.Wait()
+}
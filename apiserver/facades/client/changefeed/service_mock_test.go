@@ -0,0 +1,232 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./changefeed.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -package changefeed -destination service_mock_test.go -source=./changefeed.go
+//
+
+// Package changefeed is a generated GoMock package.
+package changefeed
+
+import (
+	context "context"
+	reflect "reflect"
+
+	permission "github.com/juju/juju/core/permission"
+	watcher "github.com/juju/juju/core/watcher"
+	changefeed "github.com/juju/juju/domain/changefeed"
+	names "github.com/juju/names/v6"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChangeFeedService is a mock of ChangeFeedService interface.
+type MockChangeFeedService struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeFeedServiceMockRecorder
+}
+
+// MockChangeFeedServiceMockRecorder is the mock recorder for MockChangeFeedService.
+type MockChangeFeedServiceMockRecorder struct {
+	mock *MockChangeFeedService
+}
+
+// NewMockChangeFeedService creates a new mock instance.
+func NewMockChangeFeedService(ctrl *gomock.Controller) *MockChangeFeedService {
+	mock := &MockChangeFeedService{ctrl: ctrl}
+	mock.recorder = &MockChangeFeedServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeFeedService) EXPECT() *MockChangeFeedServiceMockRecorder {
+	return m.recorder
+}
+
+// GetNamespaces mocks base method.
+func (m *MockChangeFeedService) GetNamespaces(ctx context.Context, scope changefeed.Scope) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaces", ctx, scope)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaces indicates an expected call of GetNamespaces.
+func (mr *MockChangeFeedServiceMockRecorder) GetNamespaces(ctx, scope any) *MockChangeFeedServiceGetNamespacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaces", reflect.TypeOf((*MockChangeFeedService)(nil).GetNamespaces), ctx, scope)
+	return &MockChangeFeedServiceGetNamespacesCall{Call: call}
+}
+
+// MockChangeFeedServiceGetNamespacesCall wrap *gomock.Call
+type MockChangeFeedServiceGetNamespacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChangeFeedServiceGetNamespacesCall) Return(arg0 []string, arg1 error) *MockChangeFeedServiceGetNamespacesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChangeFeedServiceGetNamespacesCall) Do(f func(context.Context, changefeed.Scope) ([]string, error)) *MockChangeFeedServiceGetNamespacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChangeFeedServiceGetNamespacesCall) DoAndReturn(f func(context.Context, changefeed.Scope) ([]string, error)) *MockChangeFeedServiceGetNamespacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchChanges mocks base method.
+func (m *MockChangeFeedService) WatchChanges(ctx context.Context, scope changefeed.Scope, namespaces ...string) (watcher.ChangesWatcher, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, scope}
+	for _, a := range namespaces {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchChanges", varargs...)
+	ret0, _ := ret[0].(watcher.ChangesWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchChanges indicates an expected call of WatchChanges.
+func (mr *MockChangeFeedServiceMockRecorder) WatchChanges(ctx, scope any, namespaces ...any) *MockChangeFeedServiceWatchChangesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, scope}, namespaces...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchChanges", reflect.TypeOf((*MockChangeFeedService)(nil).WatchChanges), varargs...)
+	return &MockChangeFeedServiceWatchChangesCall{Call: call}
+}
+
+// MockChangeFeedServiceWatchChangesCall wrap *gomock.Call
+type MockChangeFeedServiceWatchChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChangeFeedServiceWatchChangesCall) Return(arg0 watcher.ChangesWatcher, arg1 error) *MockChangeFeedServiceWatchChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChangeFeedServiceWatchChangesCall) Do(f func(context.Context, changefeed.Scope, ...string) (watcher.ChangesWatcher, error)) *MockChangeFeedServiceWatchChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChangeFeedServiceWatchChangesCall) DoAndReturn(f func(context.Context, changefeed.Scope, ...string) (watcher.ChangesWatcher, error)) *MockChangeFeedServiceWatchChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchChangesFrom mocks base method.
+func (m *MockChangeFeedService) WatchChangesFrom(ctx context.Context, scope changefeed.Scope, changeLogID int64, namespaces ...string) (watcher.ChangesWatcher, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, scope, changeLogID}
+	for _, a := range namespaces {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchChangesFrom", varargs...)
+	ret0, _ := ret[0].(watcher.ChangesWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchChangesFrom indicates an expected call of WatchChangesFrom.
+func (mr *MockChangeFeedServiceMockRecorder) WatchChangesFrom(ctx, scope, changeLogID any, namespaces ...any) *MockChangeFeedServiceWatchChangesFromCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, scope, changeLogID}, namespaces...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchChangesFrom", reflect.TypeOf((*MockChangeFeedService)(nil).WatchChangesFrom), varargs...)
+	return &MockChangeFeedServiceWatchChangesFromCall{Call: call}
+}
+
+// MockChangeFeedServiceWatchChangesFromCall wrap *gomock.Call
+type MockChangeFeedServiceWatchChangesFromCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChangeFeedServiceWatchChangesFromCall) Return(arg0 watcher.ChangesWatcher, arg1 error) *MockChangeFeedServiceWatchChangesFromCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChangeFeedServiceWatchChangesFromCall) Do(f func(context.Context, changefeed.Scope, int64, ...string) (watcher.ChangesWatcher, error)) *MockChangeFeedServiceWatchChangesFromCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChangeFeedServiceWatchChangesFromCall) DoAndReturn(f func(context.Context, changefeed.Scope, int64, ...string) (watcher.ChangesWatcher, error)) *MockChangeFeedServiceWatchChangesFromCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockAuthorizer is a mock of Authorizer interface.
+type MockAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizerMockRecorder
+}
+
+// MockAuthorizerMockRecorder is the mock recorder for MockAuthorizer.
+type MockAuthorizerMockRecorder struct {
+	mock *MockAuthorizer
+}
+
+// NewMockAuthorizer creates a new mock instance.
+func NewMockAuthorizer(ctrl *gomock.Controller) *MockAuthorizer {
+	mock := &MockAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockAuthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizer) EXPECT() *MockAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// HasPermission mocks base method.
+func (m *MockAuthorizer) HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermission", ctx, operation, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockAuthorizerMockRecorder) HasPermission(ctx, operation, target any) *MockAuthorizerHasPermissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockAuthorizer)(nil).HasPermission), ctx, operation, target)
+	return &MockAuthorizerHasPermissionCall{Call: call}
+}
+
+// MockAuthorizerHasPermissionCall wrap *gomock.Call
+type MockAuthorizerHasPermissionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthorizerHasPermissionCall) Return(arg0 error) *MockAuthorizerHasPermissionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthorizerHasPermissionCall) Do(f func(context.Context, permission.Access, names.Tag) error) *MockAuthorizerHasPermissionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthorizerHasPermissionCall) DoAndReturn(f func(context.Context, permission.Access, names.Tag) error) *MockAuthorizerHasPermissionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -79,6 +79,7 @@ func (c *Client) StatusHistory(ctx context.Context, requests params.StatusHistor
 			Size:  request.Filter.Size,
 			Date:  request.Filter.Date,
 			Delta: request.Filter.Delta,
+			To:    request.Filter.ToDate,
 		},
 		Tag: tag.Id(),
 	})
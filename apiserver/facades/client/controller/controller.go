@@ -56,6 +56,11 @@ type ModelExporter interface {
 
 // ControllerAPIV12 implements the controller APIV12.
 type ControllerAPIV12 struct {
+	*ControllerAPIV13
+}
+
+// ControllerAPIV13 implements the controller APIV13.
+type ControllerAPIV13 struct {
 	*ControllerAPI
 }
 
@@ -542,38 +547,40 @@ func (c *ControllerAPI) InitiateMigration(ctx context.Context, reqArgs params.In
 	return out, nil
 }
 
-func (c *ControllerAPI) initiateOneMigration(ctx context.Context, spec params.MigrationSpec) (string, error) {
+// migrationSpecTarget returns the model to be migrated and the
+// details of the target controller held by the migration spec.
+func (c *ControllerAPI) migrationSpecTarget(ctx context.Context, spec params.MigrationSpec) (coremodel.Model, coremigration.TargetInfo, error) {
 	modelTag, err := names.ParseModelTag(spec.ModelTag)
 	if err != nil {
-		return "", errors.Annotate(err, "model tag")
+		return coremodel.Model{}, coremigration.TargetInfo{}, errors.Annotate(err, "model tag")
 	}
 	modelUUID := coremodel.UUID(modelTag.Id())
 
 	// Ensure the model exists.
 	model, err := c.modelService.Model(ctx, modelUUID)
 	if interrors.Is(err, modelerrors.NotFound) {
-		return "", interrors.Errorf("model %q not found", modelUUID).Add(coreerrors.NotFound)
+		return coremodel.Model{}, coremigration.TargetInfo{}, interrors.Errorf("model %q not found", modelUUID).Add(coreerrors.NotFound)
 	} else if err != nil {
-		return "", interrors.Capture(err)
+		return coremodel.Model{}, coremigration.TargetInfo{}, interrors.Capture(err)
 	}
 
 	// Construct target info.
 	specTarget := spec.TargetInfo
 	controllerTag, err := names.ParseControllerTag(specTarget.ControllerTag)
 	if err != nil {
-		return "", errors.Annotate(err, "controller tag")
+		return coremodel.Model{}, coremigration.TargetInfo{}, errors.Annotate(err, "controller tag")
 	}
 	authTag, err := names.ParseUserTag(specTarget.AuthTag)
 	if err != nil {
-		return "", errors.Annotate(err, "auth tag")
+		return coremodel.Model{}, coremigration.TargetInfo{}, errors.Annotate(err, "auth tag")
 	}
 	var macs []macaroon.Slice
 	if specTarget.Macaroons != "" {
 		if err := json.Unmarshal([]byte(specTarget.Macaroons), &macs); err != nil {
-			return "", errors.Annotate(err, "invalid macaroons")
+			return coremodel.Model{}, coremigration.TargetInfo{}, errors.Annotate(err, "invalid macaroons")
 		}
 	}
-	targetInfo := coremigration.TargetInfo{
+	return model, coremigration.TargetInfo{
 		ControllerTag:   controllerTag,
 		ControllerAlias: specTarget.ControllerAlias,
 		Addrs:           specTarget.Addrs,
@@ -582,7 +589,15 @@ func (c *ControllerAPI) initiateOneMigration(ctx context.Context, spec params.Mi
 		Password:        specTarget.Password,
 		Macaroons:       macs,
 		Token:           specTarget.Token,
+	}, nil
+}
+
+func (c *ControllerAPI) initiateOneMigration(ctx context.Context, spec params.MigrationSpec) (string, error) {
+	model, targetInfo, err := c.migrationSpecTarget(ctx, spec)
+	if err != nil {
+		return "", err
 	}
+	modelUUID := model.UUID
 
 	modelConfigService, err := c.modelConfigServiceGetter(ctx, modelUUID)
 	if err != nil {
@@ -635,6 +650,93 @@ func (c *ControllerAPI) initiateOneMigration(ctx context.Context, spec params.Mi
 	return mig.Id(), nil
 }
 
+// DryRunMigration runs the migration prechecks for one or more models
+// without starting the migrations. Unlike InitiateMigration, every
+// source and target precheck is run, so that the report for each model
+// lists all the problems found rather than just the first. The report
+// also estimates the size of the binaries that the migration would
+// transfer.
+func (c *ControllerAPI) DryRunMigration(ctx context.Context, reqArgs params.InitiateMigrationArgs) (
+	params.MigrationDryRunResults, error,
+) {
+	out := params.MigrationDryRunResults{
+		Results: make([]params.MigrationDryRunResult, len(reqArgs.Specs)),
+	}
+	if err := c.checkIsSuperUser(ctx); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	for i, spec := range reqArgs.Specs {
+		result, err := c.dryRunOneMigration(ctx, spec)
+		if err != nil {
+			result.Error = apiservererrors.ServerError(err)
+		}
+		result.ModelTag = spec.ModelTag
+		out.Results[i] = result
+	}
+	return out, nil
+}
+
+func (c *ControllerAPI) dryRunOneMigration(ctx context.Context, spec params.MigrationSpec) (params.MigrationDryRunResult, error) {
+	model, targetInfo, err := c.migrationSpecTarget(ctx, spec)
+	if err != nil {
+		return params.MigrationDryRunResult{}, err
+	}
+
+	modelConfigService, err := c.modelConfigServiceGetter(ctx, model.UUID)
+	if err != nil {
+		return params.MigrationDryRunResult{}, errors.Trace(err)
+	}
+	systemState, err := c.statePool.SystemState()
+	if err != nil {
+		return params.MigrationDryRunResult{}, errors.Trace(err)
+	}
+	hostedState, err := c.statePool.Get(model.UUID.String())
+	if err != nil {
+		return params.MigrationDryRunResult{}, errors.Trace(err)
+	}
+	defer hostedState.Release()
+
+	report, estimate, err := runMigrationDryRun(
+		ctx,
+		c.logger,
+		hostedState.State,
+		systemState,
+		&targetInfo,
+		c.controllerConfigService,
+		c.credentialServiceGetter,
+		c.modelAgentServiceGetter,
+		modelConfigService,
+		c.upgradeServiceGetter,
+		c.modelService,
+		c.applicationServiceGetter,
+		c.relationServiceGetter,
+		c.statusServiceGetter,
+		c.modelExporter,
+		c.store,
+		model,
+		c.controllerModelUUID,
+	)
+	if err != nil {
+		return params.MigrationDryRunResult{}, errors.Trace(err)
+	}
+	return params.MigrationDryRunResult{
+		Blockers: report.Blockers,
+		Warnings: report.Warnings,
+		Transfer: params.MigrationTransferEstimate{
+			Charms:           estimate.Charms,
+			CharmBytes:       estimate.CharmBytes,
+			Resources:        estimate.Resources,
+			ResourceBytes:    estimate.ResourceBytes,
+			AgentBinaries:    estimate.AgentBinaries,
+			AgentBinaryBytes: estimate.AgentBinaryBytes,
+		},
+	}, nil
+}
+
+// DryRunMigration isn't on the v13 API.
+func (c *ControllerAPIV13) DryRunMigration(_ context.Context, _ struct{}) {}
+
 // ModifyControllerAccess changes the model access granted to users.
 func (c *ControllerAPI) ModifyControllerAccess(ctx context.Context, args params.ModifyControllerAccessRequest) (params.ErrorResults, error) {
 	result := params.ErrorResults{
@@ -767,36 +869,25 @@ var runMigrationPrechecks = func(
 		return errors.Annotate(err, "creating backend")
 	}
 
-	credentialServiceGetterShim := func(ctx context.Context, modelUUID coremodel.UUID) (migration.CredentialService, error) {
-		return credentialServiceGetter(ctx, modelUUID)
-	}
-	upgradeServiceGetterShim := func(ctx context.Context, modelUUID coremodel.UUID) (migration.UpgradeService, error) {
-		return upgradeServiceGetter(ctx, modelUUID)
-	}
-	applicationServiceGetterShim := func(ctx context.Context, modelUUID coremodel.UUID) (migration.ApplicationService, error) {
-		return applicationServiceGetter(ctx, modelUUID)
-	}
-	relationServiceGetterShim := func(ctx context.Context, modelUUID coremodel.UUID) (migration.RelationService, error) {
-		return relationServiceGetter(ctx, modelUUID)
-	}
-	statusServiceGetterShim := func(ctx context.Context, modelUUID coremodel.UUID) (migration.StatusService, error) {
-		return statusServiceGetter(ctx, modelUUID)
-	}
-	modelAgentServiceGetterShim := func(ctx context.Context, modelUUID coremodel.UUID) (migration.ModelAgentService, error) {
-		return modelAgentServiceGetter(ctx, modelUUID)
-	}
-
+	getters := newPrecheckServiceGetters(
+		credentialServiceGetter,
+		upgradeServiceGetter,
+		applicationServiceGetter,
+		relationServiceGetter,
+		statusServiceGetter,
+		modelAgentServiceGetter,
+	)
 	if err := migration.SourcePrecheck(
 		ctx,
 		backend,
 		model.UUID,
 		controllerModelUUID,
-		credentialServiceGetterShim,
-		upgradeServiceGetterShim,
-		applicationServiceGetterShim,
-		relationServiceGetterShim,
-		statusServiceGetterShim,
-		modelAgentServiceGetterShim,
+		getters.credential,
+		getters.upgrade,
+		getters.application,
+		getters.relation,
+		getters.status,
+		getters.modelAgent,
 	); err != nil {
 		return errors.Annotate(err, "source prechecks failed")
 	}
@@ -843,6 +934,187 @@ var runMigrationPrechecks = func(
 	return errors.Annotate(err, "target prechecks failed")
 }
 
+// runMigrationDryRun runs every source and target precheck on the
+// migration, collecting the problems found into a report, and estimates
+// the size of the binaries that the migration would transfer.
+var runMigrationDryRun = func(
+	ctx context.Context,
+	logger corelogger.Logger,
+	st, ctlrSt *state.State,
+	targetInfo *coremigration.TargetInfo,
+	controllerConfigService ControllerConfigService,
+	credentialServiceGetter func(context.Context, coremodel.UUID) (CredentialService, error),
+	modelAgentServiceGetter func(context.Context, coremodel.UUID) (ModelAgentService, error),
+	modelConfigService ModelConfigService,
+	upgradeServiceGetter func(context.Context, coremodel.UUID) (UpgradeService, error),
+	modelService ModelService,
+	applicationServiceGetter func(context.Context, coremodel.UUID) (ApplicationService, error),
+	relationServiceGetter func(context.Context, coremodel.UUID) (RelationService, error),
+	statusServiceGetter func(context.Context, coremodel.UUID) (StatusService, error),
+	modelExporter func(context.Context, coremodel.UUID, facade.LegacyStateExporter) (ModelExporter, error),
+	store objectstore.ObjectStore,
+	model coremodel.Model,
+	controllerModelUUID coremodel.UUID,
+) (coremigration.PrecheckReport, migration.TransferEstimate, error) {
+	var report coremigration.PrecheckReport
+
+	// Check model and source controller.
+	backend, err := migration.PrecheckShim(st, ctlrSt)
+	if err != nil {
+		return report, migration.TransferEstimate{}, errors.Annotate(err, "creating backend")
+	}
+	getters := newPrecheckServiceGetters(
+		credentialServiceGetter,
+		upgradeServiceGetter,
+		applicationServiceGetter,
+		relationServiceGetter,
+		statusServiceGetter,
+		modelAgentServiceGetter,
+	)
+	sourceReport, err := migration.SourcePrecheckReport(
+		ctx,
+		backend,
+		model.UUID,
+		controllerModelUUID,
+		getters.credential,
+		getters.upgrade,
+		getters.application,
+		getters.relation,
+		getters.status,
+		getters.modelAgent,
+	)
+	if err != nil {
+		return report, migration.TransferEstimate{}, errors.Annotate(err, "source prechecks")
+	}
+	report.Merge(sourceReport)
+
+	modelAgentService, err := modelAgentServiceGetter(ctx, model.UUID)
+	if err != nil {
+		return report, migration.TransferEstimate{}, errors.Trace(err)
+	}
+	modelInfo, srcUserList, err := makeModelInfo(ctx, st,
+		controllerConfigService, modelService, modelAgentService, modelExporter, store, model)
+	if err != nil {
+		return report, migration.TransferEstimate{}, errors.Trace(err)
+	}
+
+	applicationService, err := applicationServiceGetter(ctx, model.UUID)
+	if err != nil {
+		return report, migration.TransferEstimate{}, errors.Trace(err)
+	}
+	estimate, err := migration.EstimateTransfer(ctx, modelInfo.ModelDescription, migration.CharmArchiveSizer(applicationService))
+	if err != nil {
+		return report, migration.TransferEstimate{}, errors.Annotate(err, "estimating migration transfer")
+	}
+
+	// Check target controller. Failing to reach the target controller
+	// prevents the migration, so it is reported as a blocker.
+	targetReport, err := targetPrecheckReport(ctx, targetInfo, modelInfo, srcUserList)
+	if err != nil {
+		targetReport.Blockers = append(targetReport.Blockers, err.Error())
+	}
+	for _, blocker := range targetReport.Blockers {
+		report.Blockers = append(report.Blockers, "target controller: "+blocker)
+	}
+	for _, warning := range targetReport.Warnings {
+		report.Warnings = append(report.Warnings, "target controller: "+warning)
+	}
+	return report, estimate, nil
+}
+
+// targetPrecheckReport runs every precheck on the target controller.
+// Older target controllers only support failing on the first problem,
+// in which case that problem is the only one reported.
+func targetPrecheckReport(
+	ctx context.Context,
+	targetInfo *coremigration.TargetInfo,
+	modelInfo coremigration.ModelInfo,
+	srcUserList userList,
+) (coremigration.PrecheckReport, error) {
+	var report coremigration.PrecheckReport
+
+	loginProvider := migration.NewLoginProvider(*targetInfo)
+	targetConn, err := api.Open(ctx, targetToAPIInfo(targetInfo), migration.ControllerDialOpts(loginProvider))
+	if err != nil {
+		return report, errors.Annotate(err, "connect to target controller")
+	}
+	defer targetConn.Close()
+
+	dstUserList, err := getTargetControllerUsers(ctx, targetConn)
+	if err != nil {
+		return report, errors.Trace(err)
+	}
+	if err := srcUserList.checkCompatibilityWith(dstUserList); err != nil {
+		report.Blockers = append(report.Blockers, err.Error())
+	}
+
+	client := migrationtarget.NewClient(targetConn)
+	if targetInfo.CACert == "" {
+		targetInfo.CACert, err = client.CACert(ctx)
+		if err != nil {
+			if !params.IsCodeNotImplemented(err) {
+				return report, errors.Annotatef(err, "cannot retrieve CA certificate")
+			}
+			// If the call's not implemented, it indicates an earlier version
+			// of the controller, which we can't migrate to.
+			return report, errors.New("controller API version is too old")
+		}
+	}
+
+	targetReport, err := client.PrechecksReport(ctx, modelInfo)
+	if errors.Is(err, errors.NotSupported) {
+		if err := client.Prechecks(ctx, modelInfo); err != nil {
+			report.Blockers = append(report.Blockers, err.Error())
+		}
+		return report, nil
+	} else if err != nil {
+		return report, errors.Annotate(err, "target prechecks failed")
+	}
+	report.Merge(targetReport)
+	return report, nil
+}
+
+// precheckServiceGetters holds the service getters required by the
+// migration prechecks.
+type precheckServiceGetters struct {
+	credential  func(context.Context, coremodel.UUID) (migration.CredentialService, error)
+	upgrade     func(context.Context, coremodel.UUID) (migration.UpgradeService, error)
+	application func(context.Context, coremodel.UUID) (migration.ApplicationService, error)
+	relation    func(context.Context, coremodel.UUID) (migration.RelationService, error)
+	status      func(context.Context, coremodel.UUID) (migration.StatusService, error)
+	modelAgent  func(context.Context, coremodel.UUID) (migration.ModelAgentService, error)
+}
+
+func newPrecheckServiceGetters(
+	credentialServiceGetter func(context.Context, coremodel.UUID) (CredentialService, error),
+	upgradeServiceGetter func(context.Context, coremodel.UUID) (UpgradeService, error),
+	applicationServiceGetter func(context.Context, coremodel.UUID) (ApplicationService, error),
+	relationServiceGetter func(context.Context, coremodel.UUID) (RelationService, error),
+	statusServiceGetter func(context.Context, coremodel.UUID) (StatusService, error),
+	modelAgentServiceGetter func(context.Context, coremodel.UUID) (ModelAgentService, error),
+) precheckServiceGetters {
+	return precheckServiceGetters{
+		credential: func(ctx context.Context, modelUUID coremodel.UUID) (migration.CredentialService, error) {
+			return credentialServiceGetter(ctx, modelUUID)
+		},
+		upgrade: func(ctx context.Context, modelUUID coremodel.UUID) (migration.UpgradeService, error) {
+			return upgradeServiceGetter(ctx, modelUUID)
+		},
+		application: func(ctx context.Context, modelUUID coremodel.UUID) (migration.ApplicationService, error) {
+			return applicationServiceGetter(ctx, modelUUID)
+		},
+		relation: func(ctx context.Context, modelUUID coremodel.UUID) (migration.RelationService, error) {
+			return relationServiceGetter(ctx, modelUUID)
+		},
+		status: func(ctx context.Context, modelUUID coremodel.UUID) (migration.StatusService, error) {
+			return statusServiceGetter(ctx, modelUUID)
+		},
+		modelAgent: func(ctx context.Context, modelUUID coremodel.UUID) (migration.ModelAgentService, error) {
+			return modelAgentServiceGetter(ctx, modelUUID)
+		},
+	}
+}
+
 // userList encapsulates information about the users who have been granted
 // access to a model or the users known to a particular controller.
 type userList struct {
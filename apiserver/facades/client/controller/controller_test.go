@@ -33,6 +33,7 @@ import (
 	"github.com/juju/juju/cloud"
 	corecontroller "github.com/juju/juju/controller"
 	"github.com/juju/juju/core/leadership"
+	coremigration "github.com/juju/juju/core/migration"
 	"github.com/juju/juju/core/model"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	"github.com/juju/juju/core/permission"
@@ -48,6 +49,7 @@ import (
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/docker"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/migration"
 	internalservices "github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/testing/factory"
@@ -770,6 +772,63 @@ func (s *controllerSuite) TestInitiateMigrationPrecheckFail(c *tc.C) {
 	c.Check(active, tc.IsFalse)
 }
 
+func (s *controllerSuite) TestDryRunMigration(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	st := s.Factory.MakeModel(c, &factory.ModelParams{UUID: s.DefaultModelUUID})
+	defer st.Close()
+
+	controller.SetDryRunResult(s, coremigration.PrecheckReport{
+		Blockers: []string{"model is dying"},
+		Warnings: []string{"cleanup needed"},
+	}, migration.TransferEstimate{
+		Charms:           1,
+		CharmBytes:       1024,
+		AgentBinaries:    1,
+		AgentBinaryBytes: 2048,
+	}, nil)
+
+	m, err := st.Model()
+	c.Assert(err, tc.ErrorIsNil)
+	s.mockModelService.EXPECT().Model(gomock.Any(), model.UUID(m.ModelTag().Id())).Return(
+		model.Model{
+			UUID:      model.UUID(m.UUID()),
+			Name:      m.Name(),
+			Qualifier: model.Qualifier(m.Owner().Id()),
+		}, nil,
+	)
+
+	args := params.InitiateMigrationArgs{
+		Specs: []params.MigrationSpec{{
+			ModelTag: m.ModelTag().String(),
+			TargetInfo: params.MigrationTargetInfo{
+				ControllerTag: randomControllerTag(),
+				Addrs:         []string{"1.1.1.1:1111"},
+				CACert:        "cert1",
+				AuthTag:       names.NewUserTag("admin1").String(),
+				Password:      "secret1",
+			},
+		}},
+	}
+	out, err := s.controller.DryRunMigration(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(out.Results, tc.DeepEquals, []params.MigrationDryRunResult{{
+		ModelTag: m.ModelTag().String(),
+		Blockers: []string{"model is dying"},
+		Warnings: []string{"cleanup needed"},
+		Transfer: params.MigrationTransferEstimate{
+			Charms:           1,
+			CharmBytes:       1024,
+			AgentBinaries:    1,
+			AgentBinaryBytes: 2048,
+		},
+	}})
+
+	// A dry run never starts the migration.
+	active, err := st.IsMigrationActive()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(active, tc.IsFalse)
+}
+
 func randomControllerTag() string {
 	uuid := uuid.MustNewUUID().String()
 	return names.NewControllerTag(uuid).String()
@@ -11,6 +11,7 @@ import (
 	coremigration "github.com/juju/juju/core/migration"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/migration"
 	"github.com/juju/juju/state"
 )
 
@@ -41,3 +42,27 @@ func SetPrecheckResult(p patcher, err error) {
 		return err
 	})
 }
+
+func SetDryRunResult(p patcher, report coremigration.PrecheckReport, estimate migration.TransferEstimate, err error) {
+	p.PatchValue(&runMigrationDryRun, func(
+		ctx context.Context,
+		logger logger.Logger,
+		st, ctlrSt *state.State,
+		targetInfo *coremigration.TargetInfo,
+		controllerConfigService ControllerConfigService,
+		credentialServiceGetter func(context.Context, coremodel.UUID) (CredentialService, error),
+		modelAgentServiceGetter func(context.Context, coremodel.UUID) (ModelAgentService, error),
+		modelConfigService ModelConfigService,
+		upgradeServiceGetter func(context.Context, coremodel.UUID) (UpgradeService, error),
+		modelService ModelService,
+		applicationServiceGetter func(context.Context, coremodel.UUID) (ApplicationService, error),
+		relationServiceGetter func(context.Context, coremodel.UUID) (RelationService, error),
+		statusServiceGetter func(context.Context, coremodel.UUID) (StatusService, error),
+		modelExporter func(context.Context, coremodel.UUID, facade.LegacyStateExporter) (ModelExporter, error),
+		store objectstore.ObjectStore,
+		model coremodel.Model,
+		controllerModelUUID coremodel.UUID,
+	) (coremigration.PrecheckReport, migration.TransferEstimate, error) {
+		return report, estimate, err
+	})
+}
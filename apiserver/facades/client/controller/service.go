@@ -18,6 +18,7 @@ import (
 	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain/access"
+	"github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/blockcommand"
 	"github.com/juju/juju/domain/relation"
 	domainstatus "github.com/juju/juju/domain/status"
@@ -97,6 +98,10 @@ type ApplicationService interface {
 
 	// GetUnitNamesForApplication returns a slice of the unit names for the given application
 	GetUnitNamesForApplication(ctx context.Context, appName string) ([]unit.Name, error)
+
+	// GetCharmArchiveSize returns the size in bytes of the archive for the
+	// charm using the charm name, source and revision.
+	GetCharmArchiveSize(context.Context, charm.CharmLocator) (int64, error)
 }
 
 // RelationService provides access to the relation service.
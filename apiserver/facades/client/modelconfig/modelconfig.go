@@ -186,6 +186,18 @@ func (c *ModelConfigAPI) ModelSet(ctx context.Context, args params.ModelSet) err
 		return errors.Trace(err)
 	}
 
+	// Only controller admins get to make the controller forward the
+	// model's logs to an external endpoint.
+	if !isLoggingAdmin {
+		for _, key := range config.LoggingForwardKeys {
+			if _, ok := args.Config[key]; ok {
+				return internalerrors.Errorf(
+					"only controller admins can set model config %q: %w", key, apiservererrors.ErrPerm,
+				)
+			}
+		}
+	}
+
 	logValidator := LogTracingValidator(isLoggingAdmin)
 
 	if val, has := args.Config[config.AgentStreamKey]; has {
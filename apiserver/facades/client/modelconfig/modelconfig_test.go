@@ -261,6 +261,33 @@ func (s *modelconfigSuite) TestSetModelConfigAgentStreamInvalid(c *tc.C) {
 	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
 }
 
+// TestSetModelConfigLoggingForwardNotControllerAdmin tests that only
+// controller admins can set the endpoints that the model's logs are
+// forwarded to.
+func (s *modelconfigSuite) TestSetModelConfigLoggingForwardNotControllerAdmin(c *tc.C) {
+	for _, key := range []string{"logging-forward-loki-url", "logging-forward-otlp-url"} {
+		s.assertLoggingForwardNotControllerAdmin(c, key)
+	}
+}
+
+func (s *modelconfigSuite) assertLoggingForwardNotControllerAdmin(c *tc.C, key string) {
+	defer s.setupMocks(c).Finish()
+	api := s.getAPI(c)
+
+	s.expectModelWriteAccess()
+	s.expectModelAdminAccess()
+	s.expectNoControllerAdminAccess()
+	s.expectNoBlocks()
+
+	err := api.ModelSet(c.Context(), params.ModelSet{
+		Config: map[string]any{
+			key: "https://logs.example.com",
+		},
+	})
+	c.Check(err, tc.ErrorIs, apiservererrors.ErrPerm)
+	c.Check(err, tc.ErrorMatches, `only controller admins can set model config "`+key+`": permission denied`)
+}
+
 func (s *modelconfigSuite) assertBlocked(c *tc.C, err error, msg string) {
 	c.Assert(params.IsCodeOperationBlocked(err), tc.IsTrue, tc.Commentf("error: %#v", err))
 	c.Assert(errors.Cause(err), tc.DeepEquals, &params.Error{
@@ -207,6 +207,16 @@ func (m *ModelManagerAPI) CreateModel(ctx context.Context, args params.ModelCrea
 		if !canAddModel {
 			return result, apiservererrors.ErrPerm
 		}
+
+		// Only controller admins get to make the controller forward the
+		// model's logs to an external endpoint.
+		for _, key := range config.LoggingForwardKeys {
+			if _, ok := args.Config[key]; ok {
+				return result, internalerrors.Errorf(
+					"only controller admins can set model config %q: %w", key, apiservererrors.ErrPerm,
+				)
+			}
+		}
 	}
 
 	qualifier := coremodel.Qualifier(args.Qualifier)
@@ -348,6 +348,27 @@ func (s *modelManagerSuite) TestCreateModelQualifierMismatch(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, `cannot create model with qualifier "prod"`)
 }
 
+func (s *modelManagerSuite) TestCreateModelLoggingForwardNotControllerAdmin(c *tc.C) {
+	ctrl := s.setUpAPIWithUser(c, names.NewUserTag("add-model"))
+	defer ctrl.Finish()
+
+	s.modelService.EXPECT().DefaultModelCloudInfo(
+		gomock.Any()).Return("dummy", "dummy-region", nil)
+
+	args := params.ModelCreateArgs{
+		Name:      "foo",
+		Qualifier: "prod",
+		Config: map[string]interface{}{
+			"logging-forward-loki-url": "https://logs.example.com",
+		},
+		CloudTag: "cloud-dummy",
+	}
+
+	_, err := s.api.CreateModel(c.Context(), args)
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+	c.Check(err, tc.ErrorMatches, `only controller admins can set model config "logging-forward-loki-url": permission denied`)
+}
+
 func (s *modelManagerSuite) TestCreateModelArgsWithCloud(c *tc.C) {
 	ctrl := s.setUpAPI(c)
 	defer ctrl.Finish()
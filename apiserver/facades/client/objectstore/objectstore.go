@@ -0,0 +1,412 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/collections/transform"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/permission"
+	domainobjectstore "github.com/juju/juju/domain/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Authorizer defines the methods that the ObjectStore facade requires from
+// the authorizer.
+type Authorizer interface {
+	// HasPermission reports whether the given access is allowed for the given
+	// target by the authenticated entity.
+	HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error
+}
+
+// ObjectStoreService describes the methods used to inspect the metadata of
+// the object store of a model.
+type ObjectStoreService interface {
+	// ListObjects returns every object in the object store, along with the
+	// paths it's stored at and the entities that reference it.
+	ListObjects(ctx context.Context) ([]domainobjectstore.Object, error)
+
+	// GetObject returns the object identified by the key, which is either a
+	// path of the object, its SHA256 hash or a unique prefix of its SHA256
+	// hash.
+	GetObject(ctx context.Context, key string) (domainobjectstore.Object, error)
+}
+
+// ModelService describes the methods used to find the models on the
+// controller.
+type ModelService interface {
+	// ListModelUUIDs returns the uuids of every active model on the
+	// controller.
+	ListModelUUIDs(ctx context.Context) ([]coremodel.UUID, error)
+}
+
+// ControllerConfigService describes the methods used to get the controller
+// configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller configuration.
+	ControllerConfig(ctx context.Context) (controller.Config, error)
+}
+
+// DefaultPruneMinAge is the time since an object was last stored before it
+// can be pruned, if no minimum age is requested.
+const DefaultPruneMinAge = time.Hour
+
+// ObjectStoreServiceGetter returns the object store service for a model.
+type ObjectStoreServiceGetter func(ctx context.Context, modelUUID coremodel.UUID) (ObjectStoreService, error)
+
+// ObjectStoreGetter returns the object store for a model.
+type ObjectStoreGetter func(ctx context.Context, modelUUID coremodel.UUID) (coreobjectstore.ObjectStore, error)
+
+// API implements the ObjectStore facade, which lets controller superusers
+// inspect, verify and prune the object stores of the models on the
+// controller.
+type API struct {
+	controllerTag            names.ControllerTag
+	authorizer               Authorizer
+	modelService             ModelService
+	controllerConfigService  ControllerConfigService
+	objectStoreServiceGetter ObjectStoreServiceGetter
+	objectStoreGetter        ObjectStoreGetter
+	clock                    clock.Clock
+}
+
+// ListObjects returns the objects in the object stores of the given models.
+func (a *API) ListObjects(ctx context.Context, args params.ObjectStoreModelArgs) (params.ObjectStoreListResults, error) {
+	if err := a.checkCanAdmin(ctx); err != nil {
+		return params.ObjectStoreListResults{}, err
+	}
+
+	modelUUIDs, err := a.modelUUIDs(ctx, args.ModelUUIDs)
+	if err != nil {
+		return params.ObjectStoreListResults{}, apiservererrors.ServerError(err)
+	}
+
+	results := params.ObjectStoreListResults{
+		Results: make([]params.ObjectStoreListResult, len(modelUUIDs)),
+	}
+	for i, modelUUID := range modelUUIDs {
+		results.Results[i].ModelUUID = modelUUID.String()
+
+		objects, err := a.listObjects(ctx, modelUUID)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Objects = transform.Slice(objects, encodeObject)
+	}
+	return results, nil
+}
+
+// ShowObject returns a single object in the object store of a model, along
+// with the entities that reference it.
+func (a *API) ShowObject(ctx context.Context, arg params.ObjectStoreShowArgs) (params.ObjectStoreShowResult, error) {
+	if err := a.checkCanAdmin(ctx); err != nil {
+		return params.ObjectStoreShowResult{}, err
+	}
+
+	modelUUID := coremodel.UUID(arg.ModelUUID)
+	if err := modelUUID.Validate(); err != nil {
+		return params.ObjectStoreShowResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+
+	service, err := a.objectStoreServiceGetter(ctx, modelUUID)
+	if err != nil {
+		return params.ObjectStoreShowResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	object, err := service.GetObject(ctx, arg.Key)
+	if err != nil {
+		return params.ObjectStoreShowResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	result := encodeObject(object)
+	return params.ObjectStoreShowResult{Object: &result}, nil
+}
+
+// VerifyObjects reads every object in the object stores of the given models,
+// reporting those whose contents don't match the size and hashes in their
+// metadata.
+func (a *API) VerifyObjects(ctx context.Context, args params.ObjectStoreModelArgs) (params.ObjectStoreVerifyResults, error) {
+	if err := a.checkCanAdmin(ctx); err != nil {
+		return params.ObjectStoreVerifyResults{}, err
+	}
+
+	modelUUIDs, err := a.modelUUIDs(ctx, args.ModelUUIDs)
+	if err != nil {
+		return params.ObjectStoreVerifyResults{}, apiservererrors.ServerError(err)
+	}
+
+	results := params.ObjectStoreVerifyResults{
+		Results: make([]params.ObjectStoreVerifyResult, len(modelUUIDs)),
+	}
+	for i, modelUUID := range modelUUIDs {
+		results.Results[i].ModelUUID = modelUUID.String()
+
+		checked, failures, err := a.verifyObjects(ctx, modelUUID)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Checked = checked
+		results.Results[i].Failures = failures
+	}
+	return results, nil
+}
+
+// PruneObjects removes the objects in the object stores of the given models
+// that aren't referenced by any entity, and haven't been stored for at least
+// the minimum age. With a dry run, the objects are reported but not removed.
+func (a *API) PruneObjects(ctx context.Context, args params.ObjectStorePruneArgs) (params.ObjectStorePruneResults, error) {
+	if err := a.checkCanAdmin(ctx); err != nil {
+		return params.ObjectStorePruneResults{}, err
+	}
+	if args.MinAge < 0 {
+		return params.ObjectStorePruneResults{}, apiservererrors.ServerError(
+			errors.Errorf("negative minimum age %v not valid", args.MinAge).Add(coreerrors.NotValid),
+		)
+	}
+	minAge := args.MinAge
+	if minAge == 0 {
+		minAge = DefaultPruneMinAge
+	}
+
+	modelUUIDs, err := a.modelUUIDs(ctx, args.ModelUUIDs)
+	if err != nil {
+		return params.ObjectStorePruneResults{}, apiservererrors.ServerError(err)
+	}
+
+	results := params.ObjectStorePruneResults{
+		Results: make([]params.ObjectStorePruneResult, len(modelUUIDs)),
+	}
+	for i, modelUUID := range modelUUIDs {
+		results.Results[i].ModelUUID = modelUUID.String()
+
+		pruned, err := a.pruneObjects(ctx, modelUUID, minAge, args.DryRun)
+		// Report the objects pruned before any failure, as they're gone
+		// regardless.
+		results.Results[i].Objects = transform.Slice(pruned, encodeObject)
+		for _, object := range pruned {
+			results.Results[i].Bytes += object.Size
+		}
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
+
+// Usage returns the number and total size of the objects in the object stores
+// of the given models, along with the type of object store used by the
+// controller.
+func (a *API) Usage(ctx context.Context, args params.ObjectStoreModelArgs) (params.ObjectStoreUsageResults, error) {
+	if err := a.checkCanAdmin(ctx); err != nil {
+		return params.ObjectStoreUsageResults{}, err
+	}
+
+	config, err := a.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return params.ObjectStoreUsageResults{}, apiservererrors.ServerError(err)
+	}
+
+	modelUUIDs, err := a.modelUUIDs(ctx, args.ModelUUIDs)
+	if err != nil {
+		return params.ObjectStoreUsageResults{}, apiservererrors.ServerError(err)
+	}
+
+	results := params.ObjectStoreUsageResults{
+		Backend: config.ObjectStoreType().String(),
+		Results: make([]params.ObjectStoreUsageResult, len(modelUUIDs)),
+	}
+	for i, modelUUID := range modelUUIDs {
+		results.Results[i].ModelUUID = modelUUID.String()
+
+		objects, err := a.listObjects(ctx, modelUUID)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Objects = len(objects)
+		for _, object := range objects {
+			results.Results[i].Bytes += object.Size
+		}
+	}
+	return results, nil
+}
+
+func (a *API) checkCanAdmin(ctx context.Context) error {
+	return a.authorizer.HasPermission(ctx, permission.SuperuserAccess, a.controllerTag)
+}
+
+// modelUUIDs validates the requested model uuids, falling back to every
+// model on the controller if none were requested.
+func (a *API) modelUUIDs(ctx context.Context, requested []string) ([]coremodel.UUID, error) {
+	if len(requested) == 0 {
+		modelUUIDs, err := a.modelService.ListModelUUIDs(ctx)
+		if err != nil {
+			return nil, errors.Errorf("listing models: %w", err)
+		}
+		return modelUUIDs, nil
+	}
+
+	modelUUIDs := make([]coremodel.UUID, len(requested))
+	for i, uuid := range requested {
+		modelUUID := coremodel.UUID(uuid)
+		if err := modelUUID.Validate(); err != nil {
+			return nil, errors.Capture(err)
+		}
+		modelUUIDs[i] = modelUUID
+	}
+	return modelUUIDs, nil
+}
+
+func (a *API) listObjects(ctx context.Context, modelUUID coremodel.UUID) ([]domainobjectstore.Object, error) {
+	service, err := a.objectStoreServiceGetter(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return service.ListObjects(ctx)
+}
+
+func (a *API) verifyObjects(ctx context.Context, modelUUID coremodel.UUID) (int, []params.ObjectStoreVerifyFailure, error) {
+	objects, err := a.listObjects(ctx, modelUUID)
+	if err != nil {
+		return 0, nil, errors.Capture(err)
+	}
+	store, err := a.objectStoreGetter(ctx, modelUUID)
+	if err != nil {
+		return 0, nil, errors.Capture(err)
+	}
+
+	var failures []params.ObjectStoreVerifyFailure
+	for _, object := range objects {
+		if err := verifyObject(ctx, store, object); err != nil {
+			failures = append(failures, params.ObjectStoreVerifyFailure{
+				SHA256: object.SHA256,
+				Paths:  object.Paths,
+				Reason: err.Error(),
+			})
+		}
+	}
+	return len(objects), failures, nil
+}
+
+// verifyObject reads the object from the store, checking the contents match
+// the size and hashes in its metadata.
+func verifyObject(ctx context.Context, store coreobjectstore.ObjectStore, object domainobjectstore.Object) error {
+	reader, _, err := store.GetBySHA256(ctx, object.SHA256)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	hash384 := sha512.New384()
+	hash256 := sha256.New()
+	size, err := io.Copy(io.MultiWriter(hash384, hash256), reader)
+	if err != nil {
+		return errors.Errorf("reading object: %w", err)
+	}
+
+	if size != object.Size {
+		return errors.Errorf("size mismatch: expected %d, got %d", object.Size, size)
+	}
+	if encoded := hex.EncodeToString(hash384.Sum(nil)); encoded != object.SHA384 {
+		return errors.Errorf("SHA384 mismatch: expected %q, got %q", object.SHA384, encoded)
+	}
+	if encoded := hex.EncodeToString(hash256.Sum(nil)); encoded != object.SHA256 {
+		return errors.Errorf("SHA256 mismatch: expected %q, got %q", object.SHA256, encoded)
+	}
+	return nil
+}
+
+// pruneObjects removes every path of the objects that aren't referenced, the
+// object itself is removed by the object store once its last path is gone.
+// The removed objects are returned.
+//
+// Objects are stored before the entity referencing them is added, so only
+// objects that were last stored before the minimum age are removed. Each
+// object is checked again immediately before it's removed, and the object
+// store refuses to remove the metadata of an object that is referenced by
+// the time its last path is removed.
+func (a *API) pruneObjects(ctx context.Context, modelUUID coremodel.UUID, minAge time.Duration, dryRun bool) ([]domainobjectstore.Object, error) {
+	service, err := a.objectStoreServiceGetter(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	objects, err := service.ListObjects(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	cutoff := a.clock.Now().Add(-minAge)
+	var unreferenced []domainobjectstore.Object
+	for _, object := range objects {
+		if prunable(object, cutoff) {
+			unreferenced = append(unreferenced, object)
+		}
+	}
+	if dryRun || len(unreferenced) == 0 {
+		return unreferenced, nil
+	}
+
+	store, err := a.objectStoreGetter(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var pruned []domainobjectstore.Object
+	for _, object := range unreferenced {
+		// The object may have been referenced, or stored again, since it
+		// was listed.
+		current, err := service.GetObject(ctx, object.SHA256)
+		if errors.Is(err, objectstoreerrors.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return pruned, errors.Errorf("checking %q: %w", object.SHA256, err)
+		} else if !prunable(current, cutoff) {
+			continue
+		}
+
+		for _, path := range current.Paths {
+			if err := store.Remove(ctx, path); err != nil {
+				return pruned, errors.Errorf("removing %q: %w", path, err)
+			}
+		}
+		pruned = append(pruned, current)
+	}
+	return pruned, nil
+}
+
+// prunable returns true if the object isn't referenced, and was last stored
+// before the cutoff.
+func prunable(object domainobjectstore.Object, cutoff time.Time) bool {
+	return len(object.References) == 0 && object.Added.Before(cutoff)
+}
+
+func encodeObject(object domainobjectstore.Object) params.ObjectStoreObject {
+	return params.ObjectStoreObject{
+		SHA256: object.SHA256,
+		SHA384: object.SHA384,
+		Size:   object.Size,
+		Paths:  object.Paths,
+		References: transform.Slice(object.References, func(ref domainobjectstore.ObjectReference) params.ObjectStoreReference {
+			return params.ObjectStoreReference{
+				Kind: string(ref.Kind),
+				Name: ref.Name,
+			}
+		}),
+	}
+}
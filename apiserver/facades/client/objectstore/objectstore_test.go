@@ -0,0 +1,415 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
+	coremodel "github.com/juju/juju/core/model"
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/permission"
+	domainobjectstore "github.com/juju/juju/domain/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+const modelUUID = coremodel.UUID("deadbeef-0bad-400d-8000-4b1d0d06f00d")
+
+var now = time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+
+type objectStoreSuite struct {
+	authorizer              *MockAuthorizer
+	modelService            *MockModelService
+	controllerConfigService *MockControllerConfigService
+	objectStoreService      *MockObjectStoreService
+	objectStore             *MockObjectStore
+}
+
+func TestObjectStoreSuite(t *testing.T) {
+	tc.Run(t, &objectStoreSuite{})
+}
+
+func (s *objectStoreSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.modelService = NewMockModelService(ctrl)
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.objectStoreService = NewMockObjectStoreService(ctrl)
+	s.objectStore = NewMockObjectStore(ctrl)
+	return ctrl
+}
+
+func (s *objectStoreSuite) newAPI(c *tc.C) *API {
+	return &API{
+		controllerTag:           names.NewControllerTag("deadbeef-1bad-500d-9000-4b1d0d06f00d"),
+		authorizer:              s.authorizer,
+		modelService:            s.modelService,
+		controllerConfigService: s.controllerConfigService,
+		objectStoreServiceGetter: func(_ context.Context, uuid coremodel.UUID) (ObjectStoreService, error) {
+			c.Check(uuid, tc.Equals, modelUUID)
+			return s.objectStoreService, nil
+		},
+		objectStoreGetter: func(_ context.Context, uuid coremodel.UUID) (coreobjectstore.ObjectStore, error) {
+			c.Check(uuid, tc.Equals, modelUUID)
+			return s.objectStore, nil
+		},
+		clock: testclock.NewClock(now),
+	}
+}
+
+func (s *objectStoreSuite) expectSuperuser() {
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+}
+
+func (s *objectStoreSuite) TestListObjectsNotSuperuser(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).
+		Return(apiservererrors.ErrPerm)
+
+	_, err := s.newAPI(c).ListObjects(c.Context(), params.ObjectStoreModelArgs{})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *objectStoreSuite) TestListObjects(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser()
+	s.objectStoreService.EXPECT().ListObjects(gomock.Any()).Return([]domainobjectstore.Object{{
+		SHA256: "sha256",
+		SHA384: "sha384",
+		Size:   42,
+		Paths:  []string{"foo"},
+		References: []domainobjectstore.ObjectReference{{
+			Kind: domainobjectstore.CharmReference,
+			Name: "foo-1",
+		}},
+	}}, nil)
+
+	result, err := s.newAPI(c).ListObjects(c.Context(), params.ObjectStoreModelArgs{
+		ModelUUIDs: []string{modelUUID.String()},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ObjectStoreListResults{
+		Results: []params.ObjectStoreListResult{{
+			ModelUUID: modelUUID.String(),
+			Objects: []params.ObjectStoreObject{{
+				SHA256: "sha256",
+				SHA384: "sha384",
+				Size:   42,
+				Paths:  []string{"foo"},
+				References: []params.ObjectStoreReference{{
+					Kind: "charm",
+					Name: "foo-1",
+				}},
+			}},
+		}},
+	})
+}
+
+func (s *objectStoreSuite) TestListObjectsAllModels(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser()
+	s.modelService.EXPECT().ListModelUUIDs(gomock.Any()).Return([]coremodel.UUID{modelUUID}, nil)
+	s.objectStoreService.EXPECT().ListObjects(gomock.Any()).Return(nil, errors.New("boom"))
+
+	result, err := s.newAPI(c).ListObjects(c.Context(), params.ObjectStoreModelArgs{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].ModelUUID, tc.Equals, modelUUID.String())
+	c.Check(result.Results[0].Error, tc.ErrorMatches, "boom")
+}
+
+func (s *objectStoreSuite) TestListObjectsInvalidModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser()
+
+	_, err := s.newAPI(c).ListObjects(c.Context(), params.ObjectStoreModelArgs{
+		ModelUUIDs: []string{"foo"},
+	})
+	c.Assert(err, tc.ErrorMatches, `.*"foo".*`)
+}
+
+func (s *objectStoreSuite) TestShowObject(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser()
+	s.objectStoreService.EXPECT().GetObject(gomock.Any(), "foo").Return(domainobjectstore.Object{
+		SHA256: "sha256",
+		SHA384: "sha384",
+		Size:   42,
+		Paths:  []string{"foo"},
+		References: []domainobjectstore.ObjectReference{{
+			Kind: domainobjectstore.ResourceReference,
+			Name: "app/blob",
+		}},
+	}, nil)
+
+	result, err := s.newAPI(c).ShowObject(c.Context(), params.ObjectStoreShowArgs{
+		ModelUUID: modelUUID.String(),
+		Key:       "foo",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ObjectStoreShowResult{
+		Object: &params.ObjectStoreObject{
+			SHA256: "sha256",
+			SHA384: "sha384",
+			Size:   42,
+			Paths:  []string{"foo"},
+			References: []params.ObjectStoreReference{{
+				Kind: "resource",
+				Name: "app/blob",
+			}},
+		},
+	})
+}
+
+func (s *objectStoreSuite) TestShowObjectNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser()
+	s.objectStoreService.EXPECT().GetObject(gomock.Any(), "foo").
+		Return(domainobjectstore.Object{}, objectstoreerrors.ErrNotFound)
+
+	result, err := s.newAPI(c).ShowObject(c.Context(), params.ObjectStoreShowArgs{
+		ModelUUID: modelUUID.String(),
+		Key:       "foo",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Object, tc.IsNil)
+	c.Check(result.Error, tc.ErrorMatches, "path not found")
+}
+
+func (s *objectStoreSuite) TestVerifyObjects(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	healthy := newObject("some content", "foo")
+	corrupt := newObject("other content", "bar")
+
+	s.expectSuperuser()
+	s.objectStoreService.EXPECT().ListObjects(gomock.Any()).
+		Return([]domainobjectstore.Object{healthy, corrupt}, nil)
+	s.objectStore.EXPECT().GetBySHA256(gomock.Any(), healthy.SHA256).
+		Return(io.NopCloser(strings.NewReader("some content")), healthy.Size, nil)
+	s.objectStore.EXPECT().GetBySHA256(gomock.Any(), corrupt.SHA256).
+		Return(io.NopCloser(strings.NewReader("other c0ntent")), corrupt.Size, nil)
+
+	result, err := s.newAPI(c).VerifyObjects(c.Context(), params.ObjectStoreModelArgs{
+		ModelUUIDs: []string{modelUUID.String()},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[0].Checked, tc.Equals, 2)
+	c.Assert(result.Results[0].Failures, tc.HasLen, 1)
+	c.Check(result.Results[0].Failures[0].SHA256, tc.Equals, corrupt.SHA256)
+	c.Check(result.Results[0].Failures[0].Paths, tc.DeepEquals, []string{"bar"})
+	c.Check(result.Results[0].Failures[0].Reason, tc.Matches, "SHA384 mismatch.*")
+}
+
+func (s *objectStoreSuite) TestPruneObjects(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	referenced := newObject("some content", "foo")
+	referenced.References = []domainobjectstore.ObjectReference{{
+		Kind: domainobjectstore.CharmReference,
+		Name: "foo-1",
+	}}
+	unreferenced := newObject("other content", "bar", "baz")
+
+	s.expectSuperuser()
+	s.objectStoreService.EXPECT().ListObjects(gomock.Any()).
+		Return([]domainobjectstore.Object{referenced, unreferenced}, nil)
+	s.objectStoreService.EXPECT().GetObject(gomock.Any(), unreferenced.SHA256).Return(unreferenced, nil)
+	s.objectStore.EXPECT().Remove(gomock.Any(), "bar").Return(nil)
+	s.objectStore.EXPECT().Remove(gomock.Any(), "baz").Return(nil)
+
+	result, err := s.newAPI(c).PruneObjects(c.Context(), params.ObjectStorePruneArgs{
+		ModelUUIDs: []string{modelUUID.String()},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[0].Bytes, tc.Equals, unreferenced.Size)
+	c.Assert(result.Results[0].Objects, tc.HasLen, 1)
+	c.Check(result.Results[0].Objects[0].SHA256, tc.Equals, unreferenced.SHA256)
+}
+
+func (s *objectStoreSuite) TestPruneObjectsDryRun(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unreferenced := newObject("other content", "bar")
+
+	s.expectSuperuser()
+	s.objectStoreService.EXPECT().ListObjects(gomock.Any()).
+		Return([]domainobjectstore.Object{unreferenced}, nil)
+
+	result, err := s.newAPI(c).PruneObjects(c.Context(), params.ObjectStorePruneArgs{
+		ModelUUIDs: []string{modelUUID.String()},
+		DryRun:     true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Bytes, tc.Equals, unreferenced.Size)
+	c.Assert(result.Results[0].Objects, tc.HasLen, 1)
+}
+
+func (s *objectStoreSuite) TestPruneObjectsRemoveFails(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	first := newObject("some content", "foo")
+	second := newObject("other content", "bar")
+
+	s.expectSuperuser()
+	s.objectStoreService.EXPECT().ListObjects(gomock.Any()).
+		Return([]domainobjectstore.Object{first, second}, nil)
+	s.objectStoreService.EXPECT().GetObject(gomock.Any(), first.SHA256).Return(first, nil)
+	s.objectStoreService.EXPECT().GetObject(gomock.Any(), second.SHA256).Return(second, nil)
+	s.objectStore.EXPECT().Remove(gomock.Any(), "foo").Return(nil)
+	s.objectStore.EXPECT().Remove(gomock.Any(), "bar").Return(errors.New("boom"))
+
+	result, err := s.newAPI(c).PruneObjects(c.Context(), params.ObjectStorePruneArgs{
+		ModelUUIDs: []string{modelUUID.String()},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.ErrorMatches, `removing "bar": boom`)
+	c.Assert(result.Results[0].Objects, tc.HasLen, 1)
+	c.Check(result.Results[0].Objects[0].SHA256, tc.Equals, first.SHA256)
+}
+
+func (s *objectStoreSuite) TestPruneObjectsMinAge(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The object was stored too recently to be pruned by default, as the
+	// entity referencing it may not have been added yet.
+	recent := newObject("some content", "foo")
+	recent.Added = now.Add(-10 * time.Minute)
+
+	s.expectSuperuser()
+	s.objectStoreService.EXPECT().ListObjects(gomock.Any()).
+		Return([]domainobjectstore.Object{recent}, nil)
+
+	result, err := s.newAPI(c).PruneObjects(c.Context(), params.ObjectStorePruneArgs{
+		ModelUUIDs: []string{modelUUID.String()},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Objects, tc.HasLen, 0)
+
+	s.expectSuperuser()
+	s.objectStoreService.EXPECT().ListObjects(gomock.Any()).
+		Return([]domainobjectstore.Object{recent}, nil)
+	s.objectStoreService.EXPECT().GetObject(gomock.Any(), recent.SHA256).Return(recent, nil)
+	s.objectStore.EXPECT().Remove(gomock.Any(), "foo").Return(nil)
+
+	result, err = s.newAPI(c).PruneObjects(c.Context(), params.ObjectStorePruneArgs{
+		ModelUUIDs: []string{modelUUID.String()},
+		MinAge:     5 * time.Minute,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Objects, tc.HasLen, 1)
+}
+
+func (s *objectStoreSuite) TestPruneObjectsNegativeMinAge(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser()
+
+	_, err := s.newAPI(c).PruneObjects(c.Context(), params.ObjectStorePruneArgs{
+		MinAge: -time.Minute,
+	})
+	c.Check(err, tc.ErrorMatches, "negative minimum age -1m0s not valid")
+	c.Check(err, tc.Satisfies, params.IsCodeNotValid)
+}
+
+func (s *objectStoreSuite) TestPruneObjectsChangedSinceListed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	referenced := newObject("some content", "foo")
+	stored := newObject("other content", "bar")
+	removed := newObject("more content", "baz")
+
+	s.expectSuperuser()
+	s.objectStoreService.EXPECT().ListObjects(gomock.Any()).
+		Return([]domainobjectstore.Object{referenced, stored, removed}, nil)
+
+	// Since the objects were listed, the first has been referenced, the
+	// second stored again and the third removed.
+	nowReferenced := referenced
+	nowReferenced.References = []domainobjectstore.ObjectReference{{
+		Kind: domainobjectstore.CharmReference,
+		Name: "foo-1",
+	}}
+	s.objectStoreService.EXPECT().GetObject(gomock.Any(), referenced.SHA256).Return(nowReferenced, nil)
+	storedAgain := stored
+	storedAgain.Paths = []string{"bar", "qux"}
+	storedAgain.Added = now
+	s.objectStoreService.EXPECT().GetObject(gomock.Any(), stored.SHA256).Return(storedAgain, nil)
+	s.objectStoreService.EXPECT().GetObject(gomock.Any(), removed.SHA256).
+		Return(domainobjectstore.Object{}, objectstoreerrors.ErrNotFound)
+
+	result, err := s.newAPI(c).PruneObjects(c.Context(), params.ObjectStorePruneArgs{
+		ModelUUIDs: []string{modelUUID.String()},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[0].Objects, tc.HasLen, 0)
+}
+
+func (s *objectStoreSuite) TestUsage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser()
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.ObjectStoreType: "s3",
+	}, nil)
+	s.objectStoreService.EXPECT().ListObjects(gomock.Any()).Return([]domainobjectstore.Object{
+		{Size: 40},
+		{Size: 2},
+	}, nil)
+
+	result, err := s.newAPI(c).Usage(c.Context(), params.ObjectStoreModelArgs{
+		ModelUUIDs: []string{modelUUID.String()},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ObjectStoreUsageResults{
+		Backend: "s3",
+		Results: []params.ObjectStoreUsageResult{{
+			ModelUUID: modelUUID.String(),
+			Objects:   2,
+			Bytes:     42,
+		}},
+	})
+}
+
+func newObject(content string, paths ...string) domainobjectstore.Object {
+	hash384 := sha512.Sum384([]byte(content))
+	hash256 := sha256.Sum256([]byte(content))
+	return domainobjectstore.Object{
+		SHA256: hex.EncodeToString(hash256[:]),
+		SHA384: hex.EncodeToString(hash384[:]),
+		Size:   int64(len(content)),
+		Paths:  paths,
+	}
+}
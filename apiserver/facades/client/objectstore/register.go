@@ -0,0 +1,51 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	coremodel "github.com/juju/juju/core/model"
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegisterForMultiModel("ObjectStore", 1, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		return NewAPI(ctx)
+	}, reflect.TypeOf((*API)(nil)))
+}
+
+// NewAPI returns a new object store API facade.
+func NewAPI(ctx facade.MultiModelContext) (*API, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+
+	domainServices := ctx.DomainServices()
+	return &API{
+		controllerTag:           names.NewControllerTag(ctx.ControllerUUID()),
+		authorizer:              authorizer,
+		modelService:            domainServices.Model(),
+		controllerConfigService: domainServices.ControllerConfig(),
+		objectStoreServiceGetter: func(c context.Context, modelUUID coremodel.UUID) (ObjectStoreService, error) {
+			svc, err := ctx.DomainServicesForModel(c, modelUUID)
+			if err != nil {
+				return nil, errors.Capture(err)
+			}
+			return svc.ObjectStore(), nil
+		},
+		objectStoreGetter: func(c context.Context, modelUUID coremodel.UUID) (coreobjectstore.ObjectStore, error) {
+			return ctx.ObjectStoreForModel(c, modelUUID.String())
+		},
+		clock: ctx.Clock(),
+	}, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal
+
+import (
+	"context"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Authorizer defines the methods that the Removal facade requires from the
+// authorizer.
+type Authorizer interface {
+	// HasPermission reports whether the given access is allowed for the given
+	// target by the authenticated entity.
+	HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error
+}
+
+// RemovalService describes the methods used to inspect and intervene in the
+// removal jobs scheduled in a model.
+type RemovalService interface {
+	// GetAllJobDetails returns all removal jobs, along with the names of
+	// the entities being removed and the last error recorded for each job.
+	GetAllJobDetails(ctx context.Context) ([]removal.JobDetail, error)
+
+	// CancelJob cancels the removal job with the input UUID, reverting the
+	// life of the entity being removed to alive.
+	CancelJob(ctx context.Context, jobUUID removal.UUID) error
+
+	// ForceJob qualifies the removal job with the input UUID with force and
+	// reschedules it to be executed immediately.
+	ForceJob(ctx context.Context, jobUUID removal.UUID) error
+}
+
+// API implements the Removal facade, which lets users inspect the removal
+// jobs scheduled in a model, and cancel or force those that are stuck.
+type API struct {
+	modelTag       names.ModelTag
+	authorizer     Authorizer
+	removalService RemovalService
+}
+
+// ListRemovals returns the removal jobs scheduled in the model.
+func (a *API) ListRemovals(ctx context.Context) (params.RemovalJobsResult, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.ReadAccess, a.modelTag); err != nil {
+		return params.RemovalJobsResult{}, err
+	}
+
+	jobs, err := a.removalService.GetAllJobDetails(ctx)
+	if err != nil {
+		return params.RemovalJobsResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+
+	result := params.RemovalJobsResult{
+		Jobs: make([]params.RemovalJob, len(jobs)),
+	}
+	for i, job := range jobs {
+		result.Jobs[i] = encodeJob(job)
+	}
+	return result, nil
+}
+
+// CancelRemovals cancels the input removal jobs, reverting the life of the
+// entities being removed to alive. Only removals that haven't been forced,
+// and whose entities can be safely reverted, can be cancelled.
+func (a *API) CancelRemovals(ctx context.Context, args params.RemovalJobArgs) (params.ErrorResults, error) {
+	return a.applyToJobs(ctx, args, a.removalService.CancelJob)
+}
+
+// ForceRemovals forces the input removal jobs, causing them to be executed
+// immediately without waiting for the entities to be cleanly removed.
+func (a *API) ForceRemovals(ctx context.Context, args params.RemovalJobArgs) (params.ErrorResults, error) {
+	return a.applyToJobs(ctx, args, a.removalService.ForceJob)
+}
+
+func (a *API) applyToJobs(
+	ctx context.Context, args params.RemovalJobArgs, apply func(context.Context, removal.UUID) error,
+) (params.ErrorResults, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.WriteAccess, a.modelTag); err != nil {
+		return params.ErrorResults{}, err
+	}
+
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Jobs)),
+	}
+	for i, arg := range args.Jobs {
+		err := apply(ctx, removal.UUID(arg.UUID))
+		switch {
+		case errors.Is(err, removalerrors.RemovalJobNotFound):
+			results.Results[i].Error = apiservererrors.ParamsErrorf(params.CodeNotFound, "removal job %q not found", arg.UUID)
+		case err != nil:
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
+
+func encodeJob(job removal.JobDetail) params.RemovalJob {
+	result := params.RemovalJob{
+		UUID:         job.UUID.String(),
+		EntityType:   job.RemovalType.String(),
+		EntityUUID:   job.EntityUUID,
+		EntityName:   job.EntityName,
+		Force:        job.Force,
+		ScheduledFor: job.ScheduledFor,
+		LastError:    job.LastError,
+	}
+	if !job.LastErrorAt.IsZero() {
+		lastErrorAt := job.LastErrorAt
+		result.LastErrorAt = &lastErrorAt
+	}
+	return result
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	jobUUID      = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+	otherJobUUID = "deadbeef-0bad-400d-8000-4b1d0d06f00e"
+)
+
+var modelTag = names.NewModelTag("deadbeef-1bad-500d-9000-4b1d0d06f00d")
+
+type removalSuite struct {
+	authorizer     *MockAuthorizer
+	removalService *MockRemovalService
+}
+
+func TestRemovalSuite(t *testing.T) {
+	tc.Run(t, &removalSuite{})
+}
+
+func (s *removalSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.removalService = NewMockRemovalService(ctrl)
+	return ctrl
+}
+
+func (s *removalSuite) newAPI() *API {
+	return &API{
+		modelTag:       modelTag,
+		authorizer:     s.authorizer,
+		removalService: s.removalService,
+	}
+}
+
+func (s *removalSuite) TestListRemovals(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now().UTC()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, modelTag).Return(nil)
+	s.removalService.EXPECT().GetAllJobDetails(gomock.Any()).Return([]removal.JobDetail{{
+		Job: removal.Job{
+			UUID:         jobUUID,
+			RemovalType:  removal.UnitJob,
+			EntityUUID:   "unit-uuid",
+			ScheduledFor: now,
+		},
+		EntityName:  "foo/0",
+		LastError:   "the front fell off",
+		LastErrorAt: now,
+	}, {
+		Job: removal.Job{
+			UUID:         otherJobUUID,
+			RemovalType:  removal.RelationJob,
+			EntityUUID:   "relation-uuid",
+			Force:        true,
+			ScheduledFor: now.Add(time.Minute),
+		},
+		EntityName: "foo:db bar:db",
+	}}, nil)
+
+	result, err := s.newAPI().ListRemovals(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.RemovalJobsResult{
+		Jobs: []params.RemovalJob{{
+			UUID:         jobUUID,
+			EntityType:   "unit",
+			EntityUUID:   "unit-uuid",
+			EntityName:   "foo/0",
+			ScheduledFor: now,
+			LastError:    "the front fell off",
+			LastErrorAt:  &now,
+		}, {
+			UUID:         otherJobUUID,
+			EntityType:   "relation",
+			EntityUUID:   "relation-uuid",
+			EntityName:   "foo:db bar:db",
+			Force:        true,
+			ScheduledFor: now.Add(time.Minute),
+		}},
+	})
+}
+
+func (s *removalSuite) TestListRemovalsError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, modelTag).Return(nil)
+	s.removalService.EXPECT().GetAllJobDetails(gomock.Any()).Return(nil, errors.New("boom"))
+
+	result, err := s.newAPI().ListRemovals(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error, tc.ErrorMatches, "boom")
+}
+
+func (s *removalSuite) TestListRemovalsPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, modelTag).Return(apiservererrors.ErrPerm)
+
+	_, err := s.newAPI().ListRemovals(c.Context())
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *removalSuite) TestCancelRemovals(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, modelTag).Return(nil)
+	s.removalService.EXPECT().CancelJob(gomock.Any(), removal.UUID(jobUUID)).Return(nil)
+	s.removalService.EXPECT().CancelJob(gomock.Any(), removal.UUID(otherJobUUID)).Return(
+		errors.Errorf("removal of relation %q can not be reverted", "relation-uuid").Add(removalerrors.RemovalJobNotCancellable))
+
+	result, err := s.newAPI().CancelRemovals(c.Context(), params.RemovalJobArgs{
+		Jobs: []params.RemovalJobArg{{UUID: jobUUID}, {UUID: otherJobUUID}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[1].Error, tc.ErrorMatches, `removal of relation "relation-uuid" can not be reverted`)
+}
+
+func (s *removalSuite) TestCancelRemovalsNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, modelTag).Return(nil)
+	s.removalService.EXPECT().CancelJob(gomock.Any(), removal.UUID(jobUUID)).Return(removalerrors.RemovalJobNotFound)
+
+	result, err := s.newAPI().CancelRemovals(c.Context(), params.RemovalJobArgs{
+		Jobs: []params.RemovalJobArg{{UUID: jobUUID}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *removalSuite) TestCancelRemovalsPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, modelTag).Return(apiservererrors.ErrPerm)
+
+	_, err := s.newAPI().CancelRemovals(c.Context(), params.RemovalJobArgs{
+		Jobs: []params.RemovalJobArg{{UUID: jobUUID}},
+	})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *removalSuite) TestForceRemovals(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, modelTag).Return(nil)
+	s.removalService.EXPECT().ForceJob(gomock.Any(), removal.UUID(jobUUID)).Return(nil)
+
+	result, err := s.newAPI().ForceRemovals(c.Context(), params.RemovalJobArgs{
+		Jobs: []params.RemovalJobArg{{UUID: jobUUID}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.IsNil)
+}
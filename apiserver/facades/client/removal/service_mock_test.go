@@ -0,0 +1,219 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./removal.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -package removal -destination service_mock_test.go -source=./removal.go
+//
+
+// Package removal is a generated GoMock package.
+package removal
+
+import (
+	context "context"
+	reflect "reflect"
+
+	permission "github.com/juju/juju/core/permission"
+	removal "github.com/juju/juju/domain/removal"
+	names "github.com/juju/names/v6"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthorizer is a mock of Authorizer interface.
+type MockAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizerMockRecorder
+}
+
+// MockAuthorizerMockRecorder is the mock recorder for MockAuthorizer.
+type MockAuthorizerMockRecorder struct {
+	mock *MockAuthorizer
+}
+
+// NewMockAuthorizer creates a new mock instance.
+func NewMockAuthorizer(ctrl *gomock.Controller) *MockAuthorizer {
+	mock := &MockAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockAuthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizer) EXPECT() *MockAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// HasPermission mocks base method.
+func (m *MockAuthorizer) HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermission", ctx, operation, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockAuthorizerMockRecorder) HasPermission(ctx, operation, target any) *MockAuthorizerHasPermissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockAuthorizer)(nil).HasPermission), ctx, operation, target)
+	return &MockAuthorizerHasPermissionCall{Call: call}
+}
+
+// MockAuthorizerHasPermissionCall wrap *gomock.Call
+type MockAuthorizerHasPermissionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthorizerHasPermissionCall) Return(arg0 error) *MockAuthorizerHasPermissionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthorizerHasPermissionCall) Do(f func(context.Context, permission.Access, names.Tag) error) *MockAuthorizerHasPermissionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthorizerHasPermissionCall) DoAndReturn(f func(context.Context, permission.Access, names.Tag) error) *MockAuthorizerHasPermissionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockRemovalService is a mock of RemovalService interface.
+type MockRemovalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemovalServiceMockRecorder
+}
+
+// MockRemovalServiceMockRecorder is the mock recorder for MockRemovalService.
+type MockRemovalServiceMockRecorder struct {
+	mock *MockRemovalService
+}
+
+// NewMockRemovalService creates a new mock instance.
+func NewMockRemovalService(ctrl *gomock.Controller) *MockRemovalService {
+	mock := &MockRemovalService{ctrl: ctrl}
+	mock.recorder = &MockRemovalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelJob mocks base method.
+func (m *MockRemovalService) CancelJob(ctx context.Context, jobUUID removal.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJob", ctx, jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelJob indicates an expected call of CancelJob.
+func (mr *MockRemovalServiceMockRecorder) CancelJob(ctx, jobUUID any) *MockRemovalServiceCancelJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockRemovalService)(nil).CancelJob), ctx, jobUUID)
+	return &MockRemovalServiceCancelJobCall{Call: call}
+}
+
+// MockRemovalServiceCancelJobCall wrap *gomock.Call
+type MockRemovalServiceCancelJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServiceCancelJobCall) Return(arg0 error) *MockRemovalServiceCancelJobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServiceCancelJobCall) Do(f func(context.Context, removal.UUID) error) *MockRemovalServiceCancelJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServiceCancelJobCall) DoAndReturn(f func(context.Context, removal.UUID) error) *MockRemovalServiceCancelJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ForceJob mocks base method.
+func (m *MockRemovalService) ForceJob(ctx context.Context, jobUUID removal.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceJob", ctx, jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceJob indicates an expected call of ForceJob.
+func (mr *MockRemovalServiceMockRecorder) ForceJob(ctx, jobUUID any) *MockRemovalServiceForceJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceJob", reflect.TypeOf((*MockRemovalService)(nil).ForceJob), ctx, jobUUID)
+	return &MockRemovalServiceForceJobCall{Call: call}
+}
+
+// MockRemovalServiceForceJobCall wrap *gomock.Call
+type MockRemovalServiceForceJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServiceForceJobCall) Return(arg0 error) *MockRemovalServiceForceJobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServiceForceJobCall) Do(f func(context.Context, removal.UUID) error) *MockRemovalServiceForceJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServiceForceJobCall) DoAndReturn(f func(context.Context, removal.UUID) error) *MockRemovalServiceForceJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllJobDetails mocks base method.
+func (m *MockRemovalService) GetAllJobDetails(ctx context.Context) ([]removal.JobDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllJobDetails", ctx)
+	ret0, _ := ret[0].([]removal.JobDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllJobDetails indicates an expected call of GetAllJobDetails.
+func (mr *MockRemovalServiceMockRecorder) GetAllJobDetails(ctx any) *MockRemovalServiceGetAllJobDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllJobDetails", reflect.TypeOf((*MockRemovalService)(nil).GetAllJobDetails), ctx)
+	return &MockRemovalServiceGetAllJobDetailsCall{Call: call}
+}
+
+// MockRemovalServiceGetAllJobDetailsCall wrap *gomock.Call
+type MockRemovalServiceGetAllJobDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServiceGetAllJobDetailsCall) Return(arg0 []removal.JobDetail, arg1 error) *MockRemovalServiceGetAllJobDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServiceGetAllJobDetailsCall) Do(f func(context.Context) ([]removal.JobDetail, error)) *MockRemovalServiceGetAllJobDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServiceGetAllJobDetailsCall) DoAndReturn(f func(context.Context) ([]removal.JobDetail, error)) *MockRemovalServiceGetAllJobDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
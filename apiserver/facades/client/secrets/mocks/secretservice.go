@@ -120,19 +120,58 @@ func (c *MockSecretServiceDeleteSecretCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetSecretAccessLog mocks base method.
+func (m *MockSecretService) GetSecretAccessLog(arg0 context.Context, arg1 *secrets.URI, arg2 int) ([]service.SecretAccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretAccessLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]service.SecretAccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretAccessLog indicates an expected call of GetSecretAccessLog.
+func (mr *MockSecretServiceMockRecorder) GetSecretAccessLog(arg0, arg1, arg2 any) *MockSecretServiceGetSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretAccessLog", reflect.TypeOf((*MockSecretService)(nil).GetSecretAccessLog), arg0, arg1, arg2)
+	return &MockSecretServiceGetSecretAccessLogCall{Call: call}
+}
+
+// MockSecretServiceGetSecretAccessLogCall wrap *gomock.Call
+type MockSecretServiceGetSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceGetSecretAccessLogCall) Return(arg0 []service.SecretAccessLogEntry, arg1 error) *MockSecretServiceGetSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceGetSecretAccessLogCall) Do(f func(context.Context, *secrets.URI, int) ([]service.SecretAccessLogEntry, error)) *MockSecretServiceGetSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceGetSecretAccessLogCall) DoAndReturn(f func(context.Context, *secrets.URI, int) ([]service.SecretAccessLogEntry, error)) *MockSecretServiceGetSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretContentFromBackend mocks base method.
-func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int) (secrets.SecretValue, error) {
+func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int, arg3 service.SecretAccessor) (secrets.SecretValue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSecretContentFromBackend", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetSecretContentFromBackend", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(secrets.SecretValue)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSecretContentFromBackend indicates an expected call of GetSecretContentFromBackend.
-func (mr *MockSecretServiceMockRecorder) GetSecretContentFromBackend(arg0, arg1, arg2 any) *MockSecretServiceGetSecretContentFromBackendCall {
+func (mr *MockSecretServiceMockRecorder) GetSecretContentFromBackend(arg0, arg1, arg2, arg3 any) *MockSecretServiceGetSecretContentFromBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentFromBackend", reflect.TypeOf((*MockSecretService)(nil).GetSecretContentFromBackend), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentFromBackend", reflect.TypeOf((*MockSecretService)(nil).GetSecretContentFromBackend), arg0, arg1, arg2, arg3)
 	return &MockSecretServiceGetSecretContentFromBackendCall{Call: call}
 }
 
@@ -148,13 +187,13 @@ func (c *MockSecretServiceGetSecretContentFromBackendCall) Return(arg0 secrets.S
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretServiceGetSecretContentFromBackendCall) Do(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+func (c *MockSecretServiceGetSecretContentFromBackendCall) Do(f func(context.Context, *secrets.URI, int, service.SecretAccessor) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretServiceGetSecretContentFromBackendCall) DoAndReturn(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+func (c *MockSecretServiceGetSecretContentFromBackendCall) DoAndReturn(f func(context.Context, *secrets.URI, int, service.SecretAccessor) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -32,3 +32,16 @@ func NewTestAPI(
 		secretBackendService: secretBackendService,
 	}, nil
 }
+
+func NewTestAPIV3(
+	authTag names.Tag,
+	authorizer facade.Authorizer,
+	secretService SecretService,
+	secretBackendService SecretBackendService,
+) (*SecretsAPIV3, error) {
+	api, err := NewTestAPI(authTag, authorizer, secretService, secretBackendService)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretsAPIV3{SecretsAPI: api}, nil
+}
@@ -19,16 +19,38 @@ func Register(registry facade.FacadeRegistry) {
 		return newSecretsAPIV1(stdCtx, ctx)
 	}, reflect.TypeOf((*SecretsAPI)(nil)))
 	registry.MustRegister("Secrets", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretsAPIV2(stdCtx, ctx)
+	}, reflect.TypeOf((*SecretsAPIV2)(nil)))
+	registry.MustRegister("Secrets", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretsAPIV3(stdCtx, ctx)
+	}, reflect.TypeOf((*SecretsAPIV3)(nil)))
+	registry.MustRegister("Secrets", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newSecretsAPI(stdCtx, ctx)
 	}, reflect.TypeOf((*SecretsAPI)(nil)))
 }
 
 func newSecretsAPIV1(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV1, error) {
+	api, err := newSecretsAPIV2(stdCtx, context)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &SecretsAPIV1{SecretsAPIV2: api}, nil
+}
+
+func newSecretsAPIV2(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV2, error) {
+	api, err := newSecretsAPIV3(stdCtx, context)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &SecretsAPIV2{SecretsAPIV3: api}, nil
+}
+
+func newSecretsAPIV3(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV3, error) {
 	api, err := newSecretsAPI(stdCtx, context)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &SecretsAPIV1{SecretsAPI: api}, nil
+	return &SecretsAPIV3{SecretsAPI: api}, nil
 }
 
 // newSecretsAPI creates a SecretsAPI.
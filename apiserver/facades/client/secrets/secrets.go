@@ -36,9 +36,19 @@ type SecretsAPI struct {
 	secretService        SecretService
 }
 
+// SecretsAPIV3 is the backend for the Secrets facade v3.
+type SecretsAPIV3 struct {
+	*SecretsAPI
+}
+
+// SecretsAPIV2 is the backend for the Secrets facade v2.
+type SecretsAPIV2 struct {
+	*SecretsAPIV3
+}
+
 // SecretsAPIV1 is the backend for the Secrets facade v1.
 type SecretsAPIV1 struct {
-	*SecretsAPI
+	*SecretsAPIV2
 }
 
 func (s *SecretsAPI) checkCanRead(ctx context.Context) error {
@@ -185,7 +195,10 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 			if arg.Filter.Revision != nil {
 				rev = *arg.Filter.Revision
 			}
-			val, err := s.secretService.GetSecretContentFromBackend(ctx, m.URI, rev)
+			val, err := s.secretService.GetSecretContentFromBackend(ctx, m.URI, rev, secretservice.SecretAccessor{
+				Kind: secretservice.UserAccessor,
+				ID:   s.authTag.Id(),
+			})
 			valueResult := &params.SecretValueResult{
 				Error: apiservererrors.ServerError(err),
 			}
@@ -203,10 +216,12 @@ func tagFromSubject(access secretservice.SecretAccessor) (names.Tag, error) {
 	switch kind := access.Kind; kind {
 	case secretservice.UnitAccessor:
 		return names.NewUnitTag(access.ID), nil
-	case secretservice.ApplicationAccessor:
+	case secretservice.ApplicationAccessor, secretservice.RemoteApplicationAccessor:
 		return names.NewApplicationTag(access.ID), nil
 	case secretservice.ModelAccessor:
 		return names.NewModelTag(access.ID), nil
+	case secretservice.UserAccessor:
+		return names.NewUserTag(access.ID), nil
 	default:
 		return nil, errors.NotValidf("subject kind %q", kind)
 	}
@@ -230,6 +245,17 @@ func tagFromAccessScope(access secretservice.SecretAccessScope) (names.Tag, erro
 // CreateSecrets isn't on the v1 API.
 func (s *SecretsAPIV1) CreateSecrets(_ context.Context, _ struct{}) {}
 
+// CreateSecrets creates new secrets. Secrets with generated
+// content aren't supported on the v3 API.
+func (s *SecretsAPIV3) CreateSecrets(ctx context.Context, args params.CreateSecretArgs) (params.StringResults, error) {
+	for _, arg := range args.Args {
+		if hasGeneratedContent(arg.UpsertSecretArg) {
+			return params.StringResults{}, errors.NotSupportedf("generated secret content on Secrets facade v3")
+		}
+	}
+	return s.SecretsAPI.CreateSecrets(ctx, args)
+}
+
 // CreateSecrets creates new secrets.
 func (s *SecretsAPI) CreateSecrets(ctx context.Context, args params.CreateSecretArgs) (params.StringResults, error) {
 	result := params.StringResults{
@@ -253,7 +279,7 @@ func (s *SecretsAPI) createSecret(ctx context.Context, arg params.CreateSecretAr
 	if arg.OwnerTag != "" && arg.OwnerTag != s.modelUUID {
 		return "", errors.NotValidf("owner tag %q", arg.OwnerTag)
 	}
-	if len(arg.Content.Data) == 0 {
+	if len(arg.Content.Data) == 0 && arg.Generator == "" {
 		return "", errors.NotValidf("empty secret value")
 	}
 
@@ -268,18 +294,21 @@ func (s *SecretsAPI) createSecret(ctx context.Context, arg params.CreateSecretAr
 		uri = coresecrets.NewURI()
 	}
 
-	if len(arg.Content.Data) == 0 {
-		return "", errors.NotValidf("empty secret value")
+	if len(arg.Content.Data) > 0 {
+		v := coresecrets.NewSecretValue(arg.Content.Data)
+		checksum, err := v.Checksum()
+		if err != nil {
+			return "", errors.Annotate(err, "calculating secret checksum")
+		}
+		arg.UpsertSecretArg.Content.Checksum = checksum
 	}
-	v := coresecrets.NewSecretValue(arg.Content.Data)
-	checksum, err := v.Checksum()
+	upsertParams, err := fromUpsertParams(s.modelUUID, nil, arg.UpsertSecretArg)
 	if err != nil {
-		return "", errors.Annotate(err, "calculating secret checksum")
+		return "", errors.Trace(err)
 	}
-	arg.UpsertSecretArg.Content.Checksum = checksum
 	err = s.secretService.CreateUserSecret(ctx, uri, secretservice.CreateUserSecretParams{
 		Version:                secrets.Version,
-		UpdateUserSecretParams: fromUpsertParams(s.modelUUID, nil, arg.UpsertSecretArg),
+		UpdateUserSecretParams: upsertParams,
 	})
 	if err != nil {
 		return "", errors.Trace(err)
@@ -287,21 +316,48 @@ func (s *SecretsAPI) createSecret(ctx context.Context, arg params.CreateSecretAr
 	return uri.String(), nil
 }
 
-func fromUpsertParams(modelUUID string, autoPrune *bool, p params.UpsertSecretArg) secretservice.UpdateUserSecretParams {
-	return secretservice.UpdateUserSecretParams{
-		Accessor:    secretservice.SecretAccessor{Kind: secretservice.ModelAccessor, ID: modelUUID},
-		AutoPrune:   autoPrune,
-		Description: p.Description,
-		Label:       p.Label,
-		Params:      p.Params,
-		Data:        p.Content.Data,
-		Checksum:    p.Content.Checksum,
+// hasGeneratedContent returns true if the arg sets any
+// of the attributes of a secret with generated content.
+func hasGeneratedContent(p params.UpsertSecretArg) bool {
+	return p.Generator != "" || p.RotatePolicy != nil || p.KeepRevisions != nil
+}
+
+func fromUpsertParams(modelUUID string, autoPrune *bool, p params.UpsertSecretArg) (secretservice.UpdateUserSecretParams, error) {
+	result := secretservice.UpdateUserSecretParams{
+		Accessor:      secretservice.SecretAccessor{Kind: secretservice.ModelAccessor, ID: modelUUID},
+		AutoPrune:     autoPrune,
+		Description:   p.Description,
+		Label:         p.Label,
+		Params:        p.Params,
+		Data:          p.Content.Data,
+		Checksum:      p.Content.Checksum,
+		RotatePolicy:  p.RotatePolicy,
+		KeepRevisions: p.KeepRevisions,
+	}
+	if p.Generator != "" {
+		generator, err := coresecrets.ParseGenerator(p.Generator)
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		result.Generator = generator
 	}
+	return result, nil
 }
 
 // UpdateSecrets isn't on the v1 API.
 func (s *SecretsAPIV1) UpdateSecrets(ctx context.Context, _ struct{}) {}
 
+// UpdateSecrets updates user secrets. Secrets with generated
+// content aren't supported on the v3 API.
+func (s *SecretsAPIV3) UpdateSecrets(ctx context.Context, args params.UpdateUserSecretArgs) (params.ErrorResults, error) {
+	for _, arg := range args.Args {
+		if hasGeneratedContent(arg.UpsertSecretArg) {
+			return params.ErrorResults{}, errors.NotSupportedf("generated secret content on Secrets facade v3")
+		}
+	}
+	return s.SecretsAPI.UpdateSecrets(ctx, args)
+}
+
 // UpdateSecrets updates user secrets.
 func (s *SecretsAPI) UpdateSecrets(ctx context.Context, args params.UpdateUserSecretArgs) (params.ErrorResults, error) {
 	result := params.ErrorResults{
@@ -336,7 +392,11 @@ func (s *SecretsAPI) updateSecret(ctx context.Context, arg params.UpdateUserSecr
 		}
 		arg.Content.Checksum = checksum
 	}
-	err = s.secretService.UpdateUserSecret(ctx, uri, fromUpsertParams(s.modelUUID, arg.AutoPrune, arg.UpsertSecretArg))
+	upsertParams, err := fromUpsertParams(s.modelUUID, arg.AutoPrune, arg.UpsertSecretArg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = s.secretService.UpdateUserSecret(ctx, uri, upsertParams)
 	return errors.Trace(err)
 }
 
@@ -441,3 +501,53 @@ func (s *SecretsAPI) secretsGrantRevoke(ctx context.Context, arg params.GrantRev
 	}
 	return results, nil
 }
+
+// SecretAccessLog isn't on the v2 API.
+func (s *SecretsAPIV2) SecretAccessLog(_ context.Context, _ struct{}) {}
+
+// SecretAccessLog returns the recorded attempts to read the content of the
+// specified secrets, ordered from the most recent to the oldest.
+func (s *SecretsAPI) SecretAccessLog(ctx context.Context, args params.SecretAccessLogArgs) (params.SecretAccessLogResults, error) {
+	result := params.SecretAccessLogResults{
+		Results: make([]params.SecretAccessLogResult, len(args.Args)),
+	}
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Args {
+		entries, err := s.secretAccessLog(ctx, arg)
+		result.Results[i] = params.SecretAccessLogResult{
+			Entries: entries,
+			Error:   apiservererrors.ServerError(err),
+		}
+	}
+	return result, nil
+}
+
+func (s *SecretsAPI) secretAccessLog(ctx context.Context, arg params.SecretAccessLogArg) ([]params.SecretAccessLogEntry, error) {
+	uri, err := coresecrets.ParseURI(arg.URI)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if arg.Limit < 0 {
+		return nil, errors.NotValidf("negative limit %d", arg.Limit)
+	}
+	entries, err := s.secretService.GetSecretAccessLog(ctx, uri, arg.Limit)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]params.SecretAccessLogEntry, len(entries))
+	for i, entry := range entries {
+		accessorTag, err := tagFromSubject(entry.Accessor)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result[i] = params.SecretAccessLogEntry{
+			AccessorTag: accessorTag.String(),
+			Revision:    entry.Revision,
+			AccessTime:  entry.AccessTime,
+			Denied:      entry.Denied,
+		}
+	}
+	return result, nil
+}
@@ -138,7 +138,10 @@ func (s *SecretsSuite) assertListSecrets(c *tc.C, reveal bool) {
 		valueResult = &params.SecretValueResult{
 			Data: map[string]string{"foo": "bar"},
 		}
-		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2).Return(
+		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2, secretservice.SecretAccessor{
+			Kind: secretservice.UserAccessor,
+			ID:   "foo",
+		}).Return(
 			coresecrets.NewSecretValue(valueResult.Data), nil,
 		)
 	}
@@ -287,6 +290,109 @@ func (s *SecretsSuite) TestCreateSecrets(c *tc.C) {
 	c.Assert(result.Results[0], tc.DeepEquals, params.StringResult{Result: uri.String()})
 }
 
+func (s *SecretsSuite) TestCreateSecretsWithGenerator(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
+
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().CreateUserSecret(gomock.Any(), uri, gomock.Any()).DoAndReturn(func(_ context.Context, _ *coresecrets.URI, params secretservice.CreateUserSecretParams) error {
+		c.Assert(params.Data, tc.HasLen, 0)
+		c.Assert(params.Checksum, tc.Equals, "")
+		c.Assert(params.Generator, tc.DeepEquals, &coresecrets.Generator{
+			Type:   coresecrets.GeneratePassword,
+			Length: 24,
+		})
+		c.Assert(params.RotatePolicy, tc.DeepEquals, ptr(coresecrets.RotateDaily))
+		c.Assert(params.KeepRevisions, tc.DeepEquals, ptr(3))
+		return nil
+	})
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := facade.CreateSecrets(c.Context(), params.CreateSecretArgs{
+		Args: []params.CreateSecretArg{
+			{
+				OwnerTag: coretesting.ModelTag.Id(),
+				URI:      ptr(uri.String()),
+				UpsertSecretArg: params.UpsertSecretArg{
+					Generator:     "password,length=24",
+					RotatePolicy:  ptr(coresecrets.RotateDaily),
+					KeepRevisions: ptr(3),
+				},
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results[0], tc.DeepEquals, params.StringResult{Result: uri.String()})
+}
+
+func (s *SecretsSuite) TestCreateSecretsInvalidGenerator(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := facade.CreateSecrets(c.Context(), params.CreateSecretArgs{
+		Args: []params.CreateSecretArg{
+			{
+				OwnerTag: coretesting.ModelTag.Id(),
+				UpsertSecretArg: params.UpsertSecretArg{
+					Generator: "token",
+				},
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results[0].Error, tc.ErrorMatches, `secret generator type "token" not valid`)
+}
+
+func (s *SecretsSuite) TestCreateSecretsWithGeneratorV3(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+
+	facade, err := apisecrets.NewTestAPIV3(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = facade.CreateSecrets(c.Context(), params.CreateSecretArgs{
+		Args: []params.CreateSecretArg{
+			{
+				OwnerTag: coretesting.ModelTag.Id(),
+				UpsertSecretArg: params.UpsertSecretArg{
+					Generator: "password,length=24",
+				},
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *SecretsSuite) TestUpdateSecretsWithRotatePolicyV3(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+
+	facade, err := apisecrets.NewTestAPIV3(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = facade.UpdateSecrets(c.Context(), params.UpdateUserSecretArgs{
+		Args: []params.UpdateUserSecretArg{
+			{
+				URI: coresecrets.NewURI().String(),
+				UpsertSecretArg: params.UpsertSecretArg{
+					RotatePolicy: ptr(coresecrets.RotateDaily),
+				},
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
 func (s *SecretsSuite) assertUpdateSecrets(c *tc.C, uri *coresecrets.URI) {
 	defer s.setup(c).Finish()
 
@@ -597,3 +703,68 @@ func (s *SecretsSuite) TestRevokeSecretPermissionDenied(c *tc.C) {
 	_, err = facade.RevokeSecret(c.Context(), params.GrantRevokeUserSecretArg{Label: "my-secret"})
 	c.Assert(err, tc.ErrorMatches, "permission denied")
 }
+
+func (s *SecretsSuite) TestSecretAccessLog(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, tc.ErrorIsNil)
+
+	now := time.Now()
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().GetSecretAccessLog(gomock.Any(), uri, 5).Return([]secretservice.SecretAccessLogEntry{{
+		Accessor:   secretservice.SecretAccessor{Kind: secretservice.UserAccessor, ID: "fred"},
+		Revision:   2,
+		AccessTime: now,
+	}, {
+		Accessor:   secretservice.SecretAccessor{Kind: secretservice.UnitAccessor, ID: "mysql/0"},
+		Revision:   1,
+		AccessTime: now.Add(-time.Hour),
+		Denied:     true,
+	}}, nil)
+
+	results, err := facade.SecretAccessLog(c.Context(), params.SecretAccessLogArgs{
+		Args: []params.SecretAccessLogArg{{
+			URI:   uri.String(),
+			Limit: 5,
+		}, {
+			URI: "bad",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 2)
+	c.Assert(results.Results[0], tc.DeepEquals, params.SecretAccessLogResult{
+		Entries: []params.SecretAccessLogEntry{{
+			AccessorTag: "user-fred",
+			Revision:    2,
+			AccessTime:  now,
+		}, {
+			AccessorTag: "unit-mysql-0",
+			Revision:    1,
+			AccessTime:  now.Add(-time.Hour),
+			Denied:      true,
+		}},
+	})
+	c.Assert(results.Results[1].Error, tc.ErrorMatches, `secret URI "bad" not valid`)
+}
+
+func (s *SecretsSuite) TestSecretAccessLogPermissionDenied(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = facade.SecretAccessLog(c.Context(), params.SecretAccessLogArgs{
+		Args: []params.SecretAccessLogArg{{URI: coresecrets.NewURI().String()}},
+	})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
@@ -23,7 +23,10 @@ type SecretService interface {
 	// View and fetch secrets.
 
 	GetUserSecretURIByLabel(ctx context.Context, label string) (*secrets.URI, error)
-	GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error)
+	GetSecretContentFromBackend(
+		ctx context.Context, uri *secrets.URI, rev int, accessor secretservice.SecretAccessor,
+	) (secrets.SecretValue, error)
+	GetSecretAccessLog(ctx context.Context, uri *secrets.URI, limit int) ([]secretservice.SecretAccessLogEntry, error)
 	ListSecrets(ctx context.Context, uri *secrets.URI,
 		revision *int,
 		labels domainsecret.Labels,
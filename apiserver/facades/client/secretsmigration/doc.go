@@ -0,0 +1,13 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package secretsmigration provides the SecretsMigration facade, which is used
+// by model administrators to move the content of a model's secrets from one
+// secret backend to another.
+//
+// The migration is driven by the client one revision at a time so that
+// progress can be reported as it happens. Each revision is copied, verified
+// against the checksum of the original content and only then is the old
+// content removed. Revisions which are already stored in the target backend
+// are skipped, which allows an interrupted migration to be resumed.
+package secretsmigration
@@ -0,0 +1,124 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretsmigration
+
+import (
+	"context"
+
+	"github.com/juju/names/v6"
+
+	commonmodel "github.com/juju/juju/apiserver/common/model"
+	commonsecrets "github.com/juju/juju/apiserver/common/secrets"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	coresecrets "github.com/juju/juju/core/secrets"
+	secretservice "github.com/juju/juju/domain/secret/service"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// SecretService describes the methods used to migrate secret content
+// between secret backends.
+type SecretService interface {
+	// ListSecretRevisionsToMigrate returns the secrets with revisions stored
+	// in the backend being migrated from.
+	ListSecretRevisionsToMigrate(
+		ctx context.Context, params secretservice.MigrateSecretsParams,
+	) ([]secretservice.SecretRevisionsToMigrate, error)
+
+	// MigrateSecretRevision moves the content of the specified secret revision
+	// to the target backend, verifying it once copied.
+	MigrateSecretRevision(
+		ctx context.Context, uri *coresecrets.URI, revision int, params secretservice.MigrateSecretsParams,
+	) (secretservice.MigrateSecretRevisionResult, error)
+}
+
+// API implements the SecretsMigration facade.
+type API struct {
+	controllerTag names.ControllerTag
+	modelTag      names.ModelTag
+	authorizer    facade.Authorizer
+	secretService SecretService
+}
+
+func (a *API) checkCanAdmin(ctx context.Context) error {
+	isAdmin, err := commonmodel.HasModelAdmin(ctx, a.authorizer, a.controllerTag, a.modelTag)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if !isAdmin {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// ListSecretRevisionsToMigrate returns the secrets, both user and charm owned,
+// with revisions stored in the secret backend being migrated from.
+func (a *API) ListSecretRevisionsToMigrate(
+	ctx context.Context, arg params.MigrateSecretsArgs,
+) (params.SecretRevisionsToMigrateResults, error) {
+	if err := a.checkCanAdmin(ctx); err != nil {
+		return params.SecretRevisionsToMigrateResults{}, err
+	}
+
+	toMigrate, err := a.secretService.ListSecretRevisionsToMigrate(ctx, secretservice.MigrateSecretsParams{
+		FromBackend: arg.FromBackend,
+		ToBackend:   arg.ToBackend,
+	})
+	if err != nil {
+		return params.SecretRevisionsToMigrateResults{}, apiservererrors.ServerError(err)
+	}
+
+	result := params.SecretRevisionsToMigrateResults{
+		Results: make([]params.SecretRevisionsToMigrate, len(toMigrate)),
+	}
+	for i, s := range toMigrate {
+		ownerTag, err := commonsecrets.OwnerTagFromOwner(s.Owner)
+		if err != nil {
+			// This should never happen.
+			return params.SecretRevisionsToMigrateResults{}, errors.Capture(err)
+		}
+		result.Results[i] = params.SecretRevisionsToMigrate{
+			URI:       s.URI.String(),
+			Label:     s.Label,
+			OwnerTag:  ownerTag.String(),
+			Revisions: s.Revisions,
+		}
+	}
+	return result, nil
+}
+
+// MigrateSecretRevisions moves the content of the specified secret revisions
+// from one secret backend to another. The content of each revision is verified
+// once copied, before it is removed from the original backend.
+func (a *API) MigrateSecretRevisions(
+	ctx context.Context, args params.MigrateSecretRevisionsArgs,
+) (params.MigrateSecretRevisionResults, error) {
+	if err := a.checkCanAdmin(ctx); err != nil {
+		return params.MigrateSecretRevisionResults{}, err
+	}
+
+	migrateParams := secretservice.MigrateSecretsParams{
+		FromBackend: args.FromBackend,
+		ToBackend:   args.ToBackend,
+	}
+	results := params.MigrateSecretRevisionResults{
+		Results: make([]params.MigrateSecretRevisionResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		uri, err := coresecrets.ParseURI(arg.URI)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result, err := a.secretService.MigrateSecretRevision(ctx, uri, arg.Revision, migrateParams)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Skipped = result.Skipped
+		results.Results[i].Checksum = result.Checksum
+	}
+	return results, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretsmigration
+
+import (
+	"testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/apiserver/authentication"
+	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	"github.com/juju/juju/core/permission"
+	coresecrets "github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	secretservice "github.com/juju/juju/domain/secret/service"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type migrationSuite struct {
+	authorizer    *facademocks.MockAuthorizer
+	secretService *MockSecretService
+}
+
+func TestMigrationSuite(t *testing.T) {
+	tc.Run(t, &migrationSuite{})
+}
+
+func (s *migrationSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.authorizer = facademocks.NewMockAuthorizer(ctrl)
+	s.secretService = NewMockSecretService(ctrl)
+	return ctrl
+}
+
+func (s *migrationSuite) newAPI() *API {
+	return &API{
+		controllerTag: coretesting.ControllerTag,
+		modelTag:      coretesting.ModelTag,
+		authorizer:    s.authorizer,
+		secretService: s.secretService,
+	}
+}
+
+func (s *migrationSuite) expectAdmin() {
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+}
+
+func (s *migrationSuite) TestListSecretRevisionsToMigrate(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.expectAdmin()
+	s.secretService.EXPECT().ListSecretRevisionsToMigrate(gomock.Any(), secretservice.MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "internal",
+	}).Return([]secretservice.SecretRevisionsToMigrate{{
+		URI:       uri,
+		Label:     "foo",
+		Owner:     coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: coretesting.ModelTag.Id()},
+		Revisions: []int{1, 2},
+	}}, nil)
+
+	result, err := s.newAPI().ListSecretRevisionsToMigrate(c.Context(), params.MigrateSecretsArgs{
+		FromBackend: "myvault",
+		ToBackend:   "internal",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.SecretRevisionsToMigrateResults{
+		Results: []params.SecretRevisionsToMigrate{{
+			URI:       uri.String(),
+			Label:     "foo",
+			OwnerTag:  coretesting.ModelTag.String(),
+			Revisions: []int{1, 2},
+		}},
+	})
+}
+
+func (s *migrationSuite) TestListSecretRevisionsToMigratePermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		authentication.ErrorEntityMissingPermission)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		authentication.ErrorEntityMissingPermission)
+
+	_, err := s.newAPI().ListSecretRevisionsToMigrate(c.Context(), params.MigrateSecretsArgs{
+		FromBackend: "myvault",
+		ToBackend:   "internal",
+	})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *migrationSuite) TestMigrateSecretRevisions(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	migrateParams := secretservice.MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "internal",
+	}
+	s.expectAdmin()
+	s.secretService.EXPECT().MigrateSecretRevision(gomock.Any(), uri, 1, migrateParams).Return(
+		secretservice.MigrateSecretRevisionResult{Checksum: "deadbeef"}, nil)
+	s.secretService.EXPECT().MigrateSecretRevision(gomock.Any(), uri, 2, migrateParams).Return(
+		secretservice.MigrateSecretRevisionResult{Skipped: true}, nil)
+	s.secretService.EXPECT().MigrateSecretRevision(gomock.Any(), uri, 3, migrateParams).Return(
+		secretservice.MigrateSecretRevisionResult{}, secreterrors.SecretRevisionNotFound)
+
+	result, err := s.newAPI().MigrateSecretRevisions(c.Context(), params.MigrateSecretRevisionsArgs{
+		FromBackend: "myvault",
+		ToBackend:   "internal",
+		Args: []params.MigrateSecretRevisionArg{
+			{URI: uri.String(), Revision: 1},
+			{URI: uri.String(), Revision: 2},
+			{URI: uri.String(), Revision: 3},
+			{URI: "bad", Revision: 1},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 4)
+	c.Check(result.Results[0], tc.DeepEquals, params.MigrateSecretRevisionResult{Checksum: "deadbeef"})
+	c.Check(result.Results[1], tc.DeepEquals, params.MigrateSecretRevisionResult{Skipped: true})
+	c.Check(result.Results[2].Error, tc.Satisfies, params.IsCodeSecretRevisionNotFound)
+	c.Check(result.Results[3].Error, tc.ErrorMatches, `secret URI "bad" not valid`)
+}
+
+func (s *migrationSuite) TestMigrateSecretRevisionsModelAdmin(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		authentication.ErrorEntityMissingPermission)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, names.NewModelTag(coretesting.ModelTag.Id())).Return(nil)
+
+	result, err := s.newAPI().MigrateSecretRevisions(c.Context(), params.MigrateSecretRevisionsArgs{
+		FromBackend: "myvault",
+		ToBackend:   "internal",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 0)
+}
@@ -0,0 +1,6 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretsmigration
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package secretsmigration -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/secretsmigration SecretService
@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/secretsmigration (interfaces: SecretService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package secretsmigration -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/secretsmigration SecretService
+//
+
+// Package secretsmigration is a generated GoMock package.
+package secretsmigration
+
+import (
+	context "context"
+	reflect "reflect"
+
+	secrets "github.com/juju/juju/core/secrets"
+	service "github.com/juju/juju/domain/secret/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock *MockSecretService
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// ListSecretRevisionsToMigrate mocks base method.
+func (m *MockSecretService) ListSecretRevisionsToMigrate(arg0 context.Context, arg1 service.MigrateSecretsParams) ([]service.SecretRevisionsToMigrate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretRevisionsToMigrate", arg0, arg1)
+	ret0, _ := ret[0].([]service.SecretRevisionsToMigrate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretRevisionsToMigrate indicates an expected call of ListSecretRevisionsToMigrate.
+func (mr *MockSecretServiceMockRecorder) ListSecretRevisionsToMigrate(arg0, arg1 any) *MockSecretServiceListSecretRevisionsToMigrateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretRevisionsToMigrate", reflect.TypeOf((*MockSecretService)(nil).ListSecretRevisionsToMigrate), arg0, arg1)
+	return &MockSecretServiceListSecretRevisionsToMigrateCall{Call: call}
+}
+
+// MockSecretServiceListSecretRevisionsToMigrateCall wrap *gomock.Call
+type MockSecretServiceListSecretRevisionsToMigrateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceListSecretRevisionsToMigrateCall) Return(arg0 []service.SecretRevisionsToMigrate, arg1 error) *MockSecretServiceListSecretRevisionsToMigrateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceListSecretRevisionsToMigrateCall) Do(f func(context.Context, service.MigrateSecretsParams) ([]service.SecretRevisionsToMigrate, error)) *MockSecretServiceListSecretRevisionsToMigrateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceListSecretRevisionsToMigrateCall) DoAndReturn(f func(context.Context, service.MigrateSecretsParams) ([]service.SecretRevisionsToMigrate, error)) *MockSecretServiceListSecretRevisionsToMigrateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MigrateSecretRevision mocks base method.
+func (m *MockSecretService) MigrateSecretRevision(arg0 context.Context, arg1 *secrets.URI, arg2 int, arg3 service.MigrateSecretsParams) (service.MigrateSecretRevisionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSecretRevision", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(service.MigrateSecretRevisionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSecretRevision indicates an expected call of MigrateSecretRevision.
+func (mr *MockSecretServiceMockRecorder) MigrateSecretRevision(arg0, arg1, arg2, arg3 any) *MockSecretServiceMigrateSecretRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSecretRevision", reflect.TypeOf((*MockSecretService)(nil).MigrateSecretRevision), arg0, arg1, arg2, arg3)
+	return &MockSecretServiceMigrateSecretRevisionCall{Call: call}
+}
+
+// MockSecretServiceMigrateSecretRevisionCall wrap *gomock.Call
+type MockSecretServiceMigrateSecretRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceMigrateSecretRevisionCall) Return(arg0 service.MigrateSecretRevisionResult, arg1 error) *MockSecretServiceMigrateSecretRevisionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceMigrateSecretRevisionCall) Do(f func(context.Context, *secrets.URI, int, service.MigrateSecretsParams) (service.MigrateSecretRevisionResult, error)) *MockSecretServiceMigrateSecretRevisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceMigrateSecretRevisionCall) DoAndReturn(f func(context.Context, *secrets.URI, int, service.MigrateSecretsParams) (service.MigrateSecretRevisionResult, error)) *MockSecretServiceMigrateSecretRevisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -5,6 +5,7 @@ package sshclient
 
 import (
 	"context"
+	"io"
 	"sort"
 
 	"github.com/juju/errors"
@@ -14,12 +15,19 @@ import (
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/leadership"
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/sshrecording"
 	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/core/virtualhostname"
+	accesserrors "github.com/juju/juju/domain/access/errors"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/sshca"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -34,14 +42,30 @@ type Facade struct {
 	networkService       NetworkService
 	modelConfigService   ModelConfigService
 	modelProviderService ModelProviderService
+	objectStoreMetadata  ObjectStoreMetadataService
+	objectStore          objectstore.ReadObjectStore
+	accessService        AccessService
+	sshCAService         SSHCertificateAuthorityService
 	modelTag             names.ModelTag
 	controllerTag        names.ControllerTag
 }
 
+// FacadeV7 provides the SSH Client API facade version 7
+// which adds UserCertificate.
+type FacadeV7 struct {
+	*Facade
+}
+
+// FacadeV6 provides the SSH Client API facade version 6
+// which adds ListSessionRecordings and SessionRecording.
+type FacadeV6 struct {
+	*FacadeV7
+}
+
 // FacadeV5 provides the SSH Client API facade version 5
 // which adds VirtualHostname.
 type FacadeV5 struct {
-	*Facade
+	*FacadeV6
 }
 
 // FacadeV4 provides the SSH Client API facade version 4.
@@ -57,6 +81,10 @@ func internalFacade(
 	networkService NetworkService,
 	modelConfigService ModelConfigService,
 	modelProviderService ModelProviderService,
+	objectStoreMetadata ObjectStoreMetadataService,
+	objectStore objectstore.ReadObjectStore,
+	accessService AccessService,
+	sshCAService SSHCertificateAuthorityService,
 	leadershipReader leadership.Reader, auth facade.Authorizer,
 ) (*Facade, error) {
 	if !auth.AuthClient() {
@@ -67,6 +95,10 @@ func internalFacade(
 		backend:              backend,
 		modelConfigService:   modelConfigService,
 		modelProviderService: modelProviderService,
+		objectStoreMetadata:  objectStoreMetadata,
+		objectStore:          objectStore,
+		accessService:        accessService,
+		sshCAService:         sshCAService,
 		networkService:       networkService,
 		controllerTag:        controllerTag,
 		modelTag:             modelTag,
@@ -108,6 +140,138 @@ func (facade *Facade) VirtualHostname(ctx context.Context, arg params.VirtualHos
 	}, nil
 }
 
+// ListSessionRecordings is not implemented in v5.
+func (f *FacadeV5) ListSessionRecordings(_, _, _ struct{}) {}
+
+// SessionRecording is not implemented in v5.
+func (f *FacadeV5) SessionRecording(_, _, _ struct{}) {}
+
+// ListSessionRecordings returns the recordings of the interactive SSH
+// sessions to the model's machines and units, filtered by user and target.
+func (facade *Facade) ListSessionRecordings(ctx context.Context, arg params.SSHSessionRecordingFilter) (params.SSHSessionRecordingsResult, error) {
+	if err := facade.checkIsModelAdmin(ctx); err != nil {
+		return params.SSHSessionRecordingsResult{}, errors.Trace(err)
+	}
+
+	metadata, err := facade.objectStoreMetadata.ListMetadata(ctx)
+	if err != nil {
+		return params.SSHSessionRecordingsResult{}, errors.Trace(err)
+	}
+
+	recordings := []params.SSHSessionRecording{}
+	for _, m := range metadata {
+		// Anything that isn't a session recording is skipped.
+		key, err := sshrecording.ParsePath(m.Path)
+		if err != nil {
+			continue
+		}
+		if arg.User != "" && key.User != arg.User {
+			continue
+		}
+		if arg.Target != "" && key.Target != arg.Target {
+			continue
+		}
+		recordings = append(recordings, params.SSHSessionRecording{
+			Path:    m.Path,
+			User:    key.User,
+			Target:  key.Target,
+			Started: key.Started,
+			Size:    m.Size,
+		})
+	}
+	sort.Slice(recordings, func(i, j int) bool {
+		return recordings[i].Started.Before(recordings[j].Started)
+	})
+	return params.SSHSessionRecordingsResult{Recordings: recordings}, nil
+}
+
+// SessionRecording returns the content of the recording of an interactive
+// SSH session, in the asciicast v2 format.
+func (facade *Facade) SessionRecording(ctx context.Context, arg params.SSHSessionRecordingArg) (params.SSHSessionRecordingResult, error) {
+	if err := facade.checkIsModelAdmin(ctx); err != nil {
+		return params.SSHSessionRecordingResult{}, errors.Trace(err)
+	}
+
+	// Only session recordings can be read through this API, never any
+	// other object in the model's object store.
+	if _, err := sshrecording.ParsePath(arg.Path); err != nil {
+		return params.SSHSessionRecordingResult{
+			Error: apiservererrors.ServerError(errors.NotValidf("session recording %q", arg.Path)),
+		}, nil
+	}
+
+	reader, _, err := facade.objectStore.Get(ctx, arg.Path)
+	if err != nil {
+		return params.SSHSessionRecordingResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return params.SSHSessionRecordingResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return params.SSHSessionRecordingResult{Content: string(content)}, nil
+}
+
+// UserCertificate is not implemented in v6.
+func (f *FacadeV6) UserCertificate(_, _, _ struct{}) {}
+
+// UserCertificate issues a short-lived SSH user certificate for the public
+// key of the authenticated user. The principals of the certificate are
+// derived from the user's access to the model, and the model's machines only
+// accept the principals of their own model. A user without any principals
+// isn't issued a certificate.
+func (facade *Facade) UserCertificate(ctx context.Context, arg params.SSHUserCertificateArg) (params.SSHUserCertificateResult, error) {
+	userTag, ok := facade.authorizer.GetAuthTag().(names.UserTag)
+	if !ok {
+		return params.SSHUserCertificateResult{}, apiservererrors.ErrPerm
+	}
+	access, err := facade.modelAccess(ctx, userTag)
+	if err != nil {
+		return params.SSHUserCertificateResult{}, errors.Trace(err)
+	}
+	principals := sshca.Principals(model.UUID(facade.modelTag.Id()), access)
+	if len(principals) == 0 {
+		return params.SSHUserCertificateResult{}, apiservererrors.ErrPerm
+	}
+
+	cert, err := facade.sshCAService.IssueUserCertificate(ctx, arg.PublicKey, userTag.Id(), principals)
+	if errors.Is(err, coreerrors.NotValid) {
+		return params.SSHUserCertificateResult{
+			Error: apiservererrors.ServerError(errors.NotValidf("public key")),
+		}, nil
+	} else if err != nil {
+		return params.SSHUserCertificateResult{}, errors.Trace(err)
+	}
+	return params.SSHUserCertificateResult{
+		Certificate: cert.Certificate,
+		Principals:  cert.Principals,
+		ValidBefore: cert.ValidBefore,
+	}, nil
+}
+
+// modelAccess returns the access that the user has to the model. Controller
+// superusers are admins of every model.
+func (facade *Facade) modelAccess(ctx context.Context, userTag names.UserTag) (permission.Access, error) {
+	err := facade.authorizer.HasPermission(ctx, permission.SuperuserAccess, facade.controllerTag)
+	if err == nil {
+		return permission.AdminAccess, nil
+	} else if !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return "", errors.Trace(err)
+	}
+
+	access, err := facade.accessService.ReadUserAccessLevelForTarget(ctx, user.NameFromTag(userTag), permission.ID{
+		ObjectType: permission.Model,
+		Key:        facade.modelTag.Id(),
+	})
+	if errors.Is(err, accesserrors.AccessNotFound) {
+		return permission.NoAccess, nil
+	} else if err != nil {
+		return "", errors.Trace(err)
+	}
+	return access, nil
+}
+
 // PublicAddress reports the preferred public network address for one
 // or more entities. Machines and units are supported.
 func (facade *Facade) PublicAddress(ctx context.Context, args params.Entities) (params.SSHAddressResults, error) {
@@ -8,7 +8,11 @@ import (
 
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/sshca"
 	"github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
 )
@@ -41,3 +45,27 @@ type ModelProviderService interface {
 	// GetCloudSpecForSSH returns the cloud spec for sshing into a k8s pod.
 	GetCloudSpecForSSH(ctx context.Context) (cloudspec.CloudSpec, error)
 }
+
+// ObjectStoreMetadataService provides access to the metadata of the objects
+// stored in the model's object store.
+type ObjectStoreMetadataService interface {
+	// ListMetadata returns the persistence metadata for all paths.
+	ListMetadata(ctx context.Context) ([]objectstore.Metadata, error)
+}
+
+// AccessService provides the access that users have to the model.
+type AccessService interface {
+	// ReadUserAccessLevelForTarget returns the user access level for the
+	// given user on the given target.
+	// If the access level of a user cannot be found then
+	// [accesserrors.AccessNotFound] is returned.
+	ReadUserAccessLevelForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.Access, error)
+}
+
+// SSHCertificateAuthorityService issues the short-lived user certificates
+// for logging into the model's machines.
+type SSHCertificateAuthorityService interface {
+	// IssueUserCertificate signs a short-lived user certificate for the
+	// public key, which is only valid for the given principals.
+	IssueUserCertificate(ctx context.Context, publicKey string, keyID string, principals []string) (sshca.UserCertificate, error)
+}
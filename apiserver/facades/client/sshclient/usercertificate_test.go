@@ -0,0 +1,169 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshclient_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"golang.org/x/crypto/ssh"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facades/client/sshclient"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+	sshcaservice "github.com/juju/juju/domain/sshca/service"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type userCertificateSuite struct {
+	clock     *testclock.Clock
+	access    *fakeAccessService
+	sshCA     *sshcaservice.Service
+	modelTag  names.ModelTag
+	userTag   names.UserTag
+	publicKey ssh.PublicKey
+}
+
+func TestUserCertificateSuite(t *stdtesting.T) {
+	tc.Run(t, &userCertificateSuite{})
+}
+
+func (s *userCertificateSuite) SetUpTest(c *tc.C) {
+	s.clock = testclock.NewClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.access = &fakeAccessService{access: make(map[user.Name]permission.Access)}
+	s.sshCA = sshcaservice.NewService(&fakeSSHCAState{}, s.clock)
+	s.modelTag = names.NewModelTag(testing.ModelTag.Id())
+	s.userTag = names.NewUserTag("bob")
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	c.Assert(err, tc.ErrorIsNil)
+	s.publicKey, err = ssh.NewPublicKey(pub)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *userCertificateSuite) facade(c *tc.C) *sshclient.Facade {
+	facade, err := sshclient.InternalFacade(
+		testing.ControllerTag, s.modelTag,
+		nil, nil, nil, nil, nil, nil, nil,
+		s.access, s.sshCA, nil,
+		apiservertesting.FakeAuthorizer{Tag: s.userTag},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	return facade
+}
+
+func (s *userCertificateSuite) userCertificate(c *tc.C) (params.SSHUserCertificateResult, error) {
+	return s.facade(c).UserCertificate(c.Context(), params.SSHUserCertificateArg{
+		PublicKey: string(ssh.MarshalAuthorizedKey(s.publicKey)),
+	})
+}
+
+// TestRevokedAccess is asserting that a model admin is issued certificates
+// accepted by the model's machines, that stop being accepted once they
+// expire, and that no more are issued after the user's access to the model
+// is revoked. Machines trusting the certificate authority don't authorise
+// the user's key, so the user can no longer log into them.
+func (s *userCertificateSuite) TestRevokedAccess(c *tc.C) {
+	s.access.access[user.NameFromTag(s.userTag)] = permission.AdminAccess
+
+	result, err := s.userCertificate(c)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.IsNil)
+
+	principal := sshca.ModelAdminPrincipal(model.UUID(testing.ModelTag.Id()))
+	c.Check(result.Principals, tc.DeepEquals, []string{principal})
+	c.Check(result.ValidBefore.After(s.clock.Now().Add(sshcaservice.CertificateValidity)), tc.IsFalse)
+
+	// The certificate is accepted by machines trusting the certificate
+	// authority for the principal of the model.
+	caPublicKey, err := s.sshCA.CertificateAuthorityPublicKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	checker := certChecker(c, caPublicKey, s.clock.Now)
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(result.Certificate))
+	c.Assert(err, tc.ErrorIsNil)
+	cert, ok := key.(*ssh.Certificate)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(checker.CheckCert(principal, cert), tc.ErrorIsNil)
+	c.Check(checker.CheckCert(sshca.ModelAdminPrincipal("other-model"), cert), tc.NotNil)
+
+	// Revoke the user's access to the model.
+	delete(s.access.access, user.NameFromTag(s.userTag))
+
+	_, err = s.userCertificate(c)
+	c.Check(err, tc.ErrorIs, apiservererrors.ErrPerm)
+
+	// The certificate already issued is no longer accepted once it expires.
+	s.clock.Advance(sshcaservice.CertificateValidity)
+	c.Check(checker.CheckCert(principal, cert), tc.NotNil)
+}
+
+// TestReadAccess is asserting that users who can't administer the model are
+// not issued certificates.
+func (s *userCertificateSuite) TestReadAccess(c *tc.C) {
+	s.access.access[user.NameFromTag(s.userTag)] = permission.ReadAccess
+
+	_, err := s.userCertificate(c)
+	c.Check(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+// certChecker returns a checker accepting the certificates signed by the
+// certificate authority, as a machine trusting it does.
+func certChecker(c *tc.C, caPublicKey string, now func() time.Time) *ssh.CertChecker {
+	caKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(caPublicKey))
+	c.Assert(err, tc.ErrorIsNil)
+	return &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return string(auth.Marshal()) == string(caKey.Marshal())
+		},
+		Clock: now,
+	}
+}
+
+// fakeAccessService reports the access users have to the model.
+type fakeAccessService struct {
+	access map[user.Name]permission.Access
+}
+
+func (f *fakeAccessService) ReadUserAccessLevelForTarget(
+	_ context.Context, subject user.Name, _ permission.ID,
+) (permission.Access, error) {
+	access, ok := f.access[subject]
+	if !ok {
+		return "", accesserrors.AccessNotFound
+	}
+	return access, nil
+}
+
+// fakeSSHCAState stores the certificate authority in memory.
+type fakeSSHCAState struct {
+	ca *sshca.CertificateAuthority
+}
+
+func (f *fakeSSHCAState) GetCertificateAuthority(context.Context) (sshca.CertificateAuthority, error) {
+	if f.ca == nil {
+		return sshca.CertificateAuthority{}, sshcaerrors.CertificateAuthorityNotFound
+	}
+	return *f.ca, nil
+}
+
+func (f *fakeSSHCAState) CreateCertificateAuthority(_ context.Context, ca sshca.CertificateAuthority) error {
+	if f.ca != nil {
+		return sshcaerrors.CertificateAuthorityAlreadyExists
+	}
+	f.ca = &ca
+	return nil
+}
@@ -134,6 +134,8 @@ const (
 	destroyStorageInstanceCall              = "destroyStorageInstance"
 	releaseStorageInstanceCall              = "releaseStorageInstance"
 	addExistingFilesystemCall               = "addExistingFilesystem"
+	setVolumeInfoCall                       = "setVolumeInfo"
+	requestVolumeResizeCall                 = "requestVolumeResize"
 )
 
 func (s *baseStorageSuite) constructStorageAccessor() *mockStorageAccessor {
@@ -319,6 +321,14 @@ func (s *baseStorageSuite) constructStorageAccessor() *mockStorageAccessor {
 			s.stub.AddCall(addExistingFilesystemCall, f, v, storageName)
 			return s.storageTag, s.stub.NextErr()
 		},
+		setVolumeInfo: func(tag names.VolumeTag, info state.VolumeInfo) error {
+			s.stub.AddCall(setVolumeInfoCall, tag, info)
+			return s.stub.NextErr()
+		},
+		requestVolumeResize: func(tag names.VolumeTag, size uint64) error {
+			s.stub.AddCall(requestVolumeResizeCall, tag, size)
+			return s.stub.NextErr()
+		},
 	}
 }
 
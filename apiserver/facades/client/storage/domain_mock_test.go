@@ -159,6 +159,85 @@ func (c *MockStorageServiceGetStoragePoolByNameCall) DoAndReturn(f func(context.
 	return c
 }
 
+// GetStoragePoolProvider mocks base method.
+func (m *MockStorageService) GetStoragePoolProvider(arg0 context.Context, arg1 string) (storage0.Provider, *storage0.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoragePoolProvider", arg0, arg1)
+	ret0, _ := ret[0].(storage0.Provider)
+	ret1, _ := ret[1].(*storage0.Config)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStoragePoolProvider indicates an expected call of GetStoragePoolProvider.
+func (mr *MockStorageServiceMockRecorder) GetStoragePoolProvider(arg0, arg1 any) *MockStorageServiceGetStoragePoolProviderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolProvider", reflect.TypeOf((*MockStorageService)(nil).GetStoragePoolProvider), arg0, arg1)
+	return &MockStorageServiceGetStoragePoolProviderCall{Call: call}
+}
+
+// MockStorageServiceGetStoragePoolProviderCall wrap *gomock.Call
+type MockStorageServiceGetStoragePoolProviderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceGetStoragePoolProviderCall) Return(arg0 storage0.Provider, arg1 *storage0.Config, arg2 error) *MockStorageServiceGetStoragePoolProviderCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceGetStoragePoolProviderCall) Do(f func(context.Context, string) (storage0.Provider, *storage0.Config, error)) *MockStorageServiceGetStoragePoolProviderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceGetStoragePoolProviderCall) DoAndReturn(f func(context.Context, string) (storage0.Provider, *storage0.Config, error)) *MockStorageServiceGetStoragePoolProviderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStorageSnapshot mocks base method.
+func (m *MockStorageService) GetStorageSnapshot(arg0 context.Context, arg1 storage.SnapshotUUID) (storage.StorageSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(storage.StorageSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshot indicates an expected call of GetStorageSnapshot.
+func (mr *MockStorageServiceMockRecorder) GetStorageSnapshot(arg0, arg1 any) *MockStorageServiceGetStorageSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshot", reflect.TypeOf((*MockStorageService)(nil).GetStorageSnapshot), arg0, arg1)
+	return &MockStorageServiceGetStorageSnapshotCall{Call: call}
+}
+
+// MockStorageServiceGetStorageSnapshotCall wrap *gomock.Call
+type MockStorageServiceGetStorageSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceGetStorageSnapshotCall) Return(arg0 storage.StorageSnapshot, arg1 error) *MockStorageServiceGetStorageSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceGetStorageSnapshotCall) Do(f func(context.Context, storage.SnapshotUUID) (storage.StorageSnapshot, error)) *MockStorageServiceGetStorageSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceGetStorageSnapshotCall) DoAndReturn(f func(context.Context, storage.SnapshotUUID) (storage.StorageSnapshot, error)) *MockStorageServiceGetStorageSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListStoragePools mocks base method.
 func (m *MockStorageService) ListStoragePools(arg0 context.Context) ([]storage.StoragePool, error) {
 	m.ctrl.T.Helper()
@@ -315,6 +394,83 @@ func (c *MockStorageServiceListStoragePoolsByProvidersCall) DoAndReturn(f func(c
 	return c
 }
 
+// ListStorageSnapshots mocks base method.
+func (m *MockStorageService) ListStorageSnapshots(arg0 context.Context, arg1 string) ([]storage.StorageSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]storage.StorageSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageSnapshots indicates an expected call of ListStorageSnapshots.
+func (mr *MockStorageServiceMockRecorder) ListStorageSnapshots(arg0, arg1 any) *MockStorageServiceListStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageSnapshots", reflect.TypeOf((*MockStorageService)(nil).ListStorageSnapshots), arg0, arg1)
+	return &MockStorageServiceListStorageSnapshotsCall{Call: call}
+}
+
+// MockStorageServiceListStorageSnapshotsCall wrap *gomock.Call
+type MockStorageServiceListStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceListStorageSnapshotsCall) Return(arg0 []storage.StorageSnapshot, arg1 error) *MockStorageServiceListStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceListStorageSnapshotsCall) Do(f func(context.Context, string) ([]storage.StorageSnapshot, error)) *MockStorageServiceListStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceListStorageSnapshotsCall) DoAndReturn(f func(context.Context, string) ([]storage.StorageSnapshot, error)) *MockStorageServiceListStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveStorageSnapshot mocks base method.
+func (m *MockStorageService) RemoveStorageSnapshot(arg0 context.Context, arg1 storage.SnapshotUUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveStorageSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveStorageSnapshot indicates an expected call of RemoveStorageSnapshot.
+func (mr *MockStorageServiceMockRecorder) RemoveStorageSnapshot(arg0, arg1 any) *MockStorageServiceRemoveStorageSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveStorageSnapshot", reflect.TypeOf((*MockStorageService)(nil).RemoveStorageSnapshot), arg0, arg1)
+	return &MockStorageServiceRemoveStorageSnapshotCall{Call: call}
+}
+
+// MockStorageServiceRemoveStorageSnapshotCall wrap *gomock.Call
+type MockStorageServiceRemoveStorageSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceRemoveStorageSnapshotCall) Return(arg0 error) *MockStorageServiceRemoveStorageSnapshotCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceRemoveStorageSnapshotCall) Do(f func(context.Context, storage.SnapshotUUID) error) *MockStorageServiceRemoveStorageSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceRemoveStorageSnapshotCall) DoAndReturn(f func(context.Context, storage.SnapshotUUID) error) *MockStorageServiceRemoveStorageSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReplaceStoragePool mocks base method.
 func (m *MockStorageService) ReplaceStoragePool(arg0 context.Context, arg1 string, arg2 storage0.ProviderType, arg3 service.PoolAttrs) error {
 	m.ctrl.T.Helper()
@@ -353,6 +509,45 @@ func (c *MockStorageServiceReplaceStoragePoolCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// SnapshotStorage mocks base method.
+func (m *MockStorageService) SnapshotStorage(arg0 context.Context, arg1 service.SnapshotStorageParams) (storage.StorageSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotStorage", arg0, arg1)
+	ret0, _ := ret[0].(storage.StorageSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapshotStorage indicates an expected call of SnapshotStorage.
+func (mr *MockStorageServiceMockRecorder) SnapshotStorage(arg0, arg1 any) *MockStorageServiceSnapshotStorageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotStorage", reflect.TypeOf((*MockStorageService)(nil).SnapshotStorage), arg0, arg1)
+	return &MockStorageServiceSnapshotStorageCall{Call: call}
+}
+
+// MockStorageServiceSnapshotStorageCall wrap *gomock.Call
+type MockStorageServiceSnapshotStorageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceSnapshotStorageCall) Return(arg0 storage.StorageSnapshot, arg1 error) *MockStorageServiceSnapshotStorageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceSnapshotStorageCall) Do(f func(context.Context, service.SnapshotStorageParams) (storage.StorageSnapshot, error)) *MockStorageServiceSnapshotStorageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceSnapshotStorageCall) DoAndReturn(f func(context.Context, service.SnapshotStorageParams) (storage.StorageSnapshot, error)) *MockStorageServiceSnapshotStorageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockApplicationService is a mock of ApplicationService interface.
 type MockApplicationService struct {
 	ctrl     *gomock.Controller
@@ -51,6 +51,8 @@ type mockStorageAccessor struct {
 	attachStorage                       func(names.StorageTag, names.UnitTag) error
 	detachStorage                       func(names.StorageTag, names.UnitTag, bool) error
 	addExistingFilesystem               func(state.FilesystemInfo, *state.VolumeInfo, string) (names.StorageTag, error)
+	setVolumeInfo                       func(names.VolumeTag, state.VolumeInfo) error
+	requestVolumeResize                 func(names.VolumeTag, uint64) error
 }
 
 func (st *mockStorageAccessor) StorageInstance(s names.StorageTag) (state.StorageInstance, error) {
@@ -150,6 +152,14 @@ func (st *mockStorageAccessor) AddExistingFilesystem(f state.FilesystemInfo, v *
 	return st.addExistingFilesystem(f, v, s)
 }
 
+func (st *mockStorageAccessor) SetVolumeInfo(tag names.VolumeTag, info state.VolumeInfo) error {
+	return st.setVolumeInfo(tag, info)
+}
+
+func (st *mockStorageAccessor) RequestVolumeResize(tag names.VolumeTag, size uint64) error {
+	return st.requestVolumeResize(tag, size)
+}
+
 type mockVolume struct {
 	state.Volume
 	tag     names.VolumeTag
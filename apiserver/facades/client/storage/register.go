@@ -16,10 +16,32 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Storage", 6, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newStorageAPI(stdCtx, ctx) // modify Remove to support force and maxWait; add DetachStorage to support force and maxWait.
+		return newStorageAPIV6(stdCtx, ctx) // modify Remove to support force and maxWait; add DetachStorage to support force and maxWait.
+	}, reflect.TypeOf((*StorageAPIV6)(nil)))
+	registry.MustRegister("Storage", 7, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newStorageAPIV7(stdCtx, ctx) // add ResizeStorage.
+	}, reflect.TypeOf((*StorageAPIV7)(nil)))
+	registry.MustRegister("Storage", 8, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newStorageAPI(stdCtx, ctx) // add storage snapshots.
 	}, reflect.TypeOf((*StorageAPI)(nil)))
 }
 
+func newStorageAPIV6(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPIV6, error) {
+	api, err := newStorageAPIV7(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &StorageAPIV6{StorageAPIV7: api}, nil
+}
+
+func newStorageAPIV7(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPIV7, error) {
+	api, err := newStorageAPI(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &StorageAPIV7{StorageAPI: api}, nil
+}
+
 // newStorageAPI returns a new storage API facade.
 func newStorageAPI(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPI, error) {
 	domainServices := ctx.DomainServices()
@@ -74,6 +74,13 @@ type storageVolume interface {
 
 	// AddExistingFilesystem imports an existing filesystem into the model.
 	AddExistingFilesystem(f state.FilesystemInfo, v *state.VolumeInfo, storageName string) (names.StorageTag, error)
+
+	// SetVolumeInfo records the provisioned information of a volume.
+	SetVolumeInfo(names.VolumeTag, state.VolumeInfo) error
+
+	// RequestVolumeResize records that the provisioned volume should
+	// grow to the given size, for the storage provisioner to action.
+	RequestVolumeResize(names.VolumeTag, uint64) error
 }
 
 type storageFile interface {
@@ -0,0 +1,209 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/domain/life"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	storageservice "github.com/juju/juju/domain/storage/service"
+	"github.com/juju/juju/environs/tags"
+	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+// SnapshotStorage takes snapshots of the volumes backing the specified
+// storage instances.
+// A "CHANGE" block can block this operation.
+func (a *StorageAPI) SnapshotStorage(ctx context.Context, args params.Entities) (params.StorageSnapshotResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.StorageSnapshotResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.blockCommandService)
+	if err := blockChecker.ChangeAllowed(ctx); err != nil {
+		return params.StorageSnapshotResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.StorageSnapshotResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		snapshot, err := a.snapshotStorage(ctx, arg.Tag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		details, err := storageSnapshotDetails(snapshot)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Result = &details
+	}
+	return params.StorageSnapshotResults{Results: results}, nil
+}
+
+func (a *StorageAPI) snapshotStorage(ctx context.Context, tag string) (domainstorage.StorageSnapshot, error) {
+	storageTag, err := names.ParseStorageTag(tag)
+	if err != nil {
+		return domainstorage.StorageSnapshot{}, errors.Trace(err)
+	}
+	volume, err := a.storageAccess.StorageInstanceVolume(storageTag)
+	if errors.Is(err, errors.NotFound) {
+		return domainstorage.StorageSnapshot{}, errors.NotSupportedf(
+			"snapshotting %s without a backing volume", names.ReadableString(storageTag),
+		)
+	} else if err != nil {
+		return domainstorage.StorageSnapshot{}, errors.Trace(err)
+	}
+	volumeInfo, err := volume.Info()
+	if err != nil {
+		return domainstorage.StorageSnapshot{}, errors.Trace(err)
+	}
+	return a.storageService.SnapshotStorage(ctx, storageservice.SnapshotStorageParams{
+		StorageID: storageTag.Id(),
+		VolumeTag: volume.VolumeTag(),
+		VolumeID:  volumeInfo.VolumeId,
+		Pool:      volumeInfo.Pool,
+		Size:      volumeInfo.Size,
+		ResourceTags: map[string]string{
+			tags.JujuModel:      a.modelUUID.String(),
+			tags.JujuController: a.controllerUUID,
+		},
+	})
+}
+
+// ListStorageSnapshots returns the storage snapshots matching each filter.
+func (a *StorageAPI) ListStorageSnapshots(
+	ctx context.Context, filters params.StorageSnapshotFilters,
+) (params.StorageSnapshotsResults, error) {
+	if err := a.checkCanRead(ctx); err != nil {
+		return params.StorageSnapshotsResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.StorageSnapshotsResult, len(filters.Filters))
+	for i, filter := range filters.Filters {
+		list, err := a.listStorageSnapshots(ctx, filter)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Result = list
+	}
+	return params.StorageSnapshotsResults{Results: results}, nil
+}
+
+func (a *StorageAPI) listStorageSnapshots(
+	ctx context.Context, filter params.StorageSnapshotFilter,
+) ([]params.StorageSnapshotDetails, error) {
+	var storageID string
+	if filter.StorageTag != "" {
+		storageTag, err := names.ParseStorageTag(filter.StorageTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		storageID = storageTag.Id()
+	}
+	snapshots, err := a.storageService.ListStorageSnapshots(ctx, storageID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]params.StorageSnapshotDetails, len(snapshots))
+	for i, snapshot := range snapshots {
+		if result[i], err = storageSnapshotDetails(snapshot); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return result, nil
+}
+
+// RemoveStorageSnapshots removes the specified storage snapshots from
+// the storage provider, and then from the model.
+// A "REMOVE" block can block this operation.
+func (a *StorageAPI) RemoveStorageSnapshots(
+	ctx context.Context, args params.RemoveStorageSnapshots,
+) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.blockCommandService)
+	if err := blockChecker.RemoveAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.ErrorResult, len(args.Snapshots))
+	for i, uuid := range args.Snapshots {
+		err := a.storageService.RemoveStorageSnapshot(ctx, domainstorage.SnapshotUUID(uuid))
+		results[i].Error = apiservererrors.ServerError(err)
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+// SnapshotStorage is not available on version 7 of the Storage API.
+func (a *StorageAPIV7) SnapshotStorage(_ context.Context, _ struct{}) {}
+
+// ListStorageSnapshots is not available on version 7 of the Storage API.
+func (a *StorageAPIV7) ListStorageSnapshots(_ context.Context, _ struct{}) {}
+
+// RemoveStorageSnapshots is not available on version 7 of the Storage API.
+func (a *StorageAPIV7) RemoveStorageSnapshots(_ context.Context, _ struct{}) {}
+
+// snapshotConstraints returns the storage constraints for creating storage
+// from the specified snapshot, which must be available. The pool and size
+// default to those of the snapshot.
+func (a *StorageAPI) snapshotConstraints(
+	ctx context.Context, uuid string, cons state.StorageConstraints,
+) (state.StorageConstraints, error) {
+	snapshot, err := a.storageService.GetStorageSnapshot(ctx, domainstorage.SnapshotUUID(uuid))
+	if err != nil {
+		return state.StorageConstraints{}, errors.Trace(err)
+	}
+	if snapshot.Life != life.Alive || snapshot.Status != domainstorage.SnapshotStatusAvailable {
+		return state.StorageConstraints{}, internalerrors.Errorf(
+			"snapshot %q is %s: %w", uuid, snapshot.Status, storageerrors.SnapshotNotAvailable,
+		)
+	}
+	if cons.Pool == "" {
+		cons.Pool = snapshot.Pool
+	} else if cons.Pool != snapshot.Pool {
+		return state.StorageConstraints{}, errors.NotValidf(
+			"pool %q for snapshot %q taken from pool %q", cons.Pool, uuid, snapshot.Pool,
+		)
+	}
+	if cons.Size == 0 {
+		cons.Size = snapshot.Size
+	} else if cons.Size < snapshot.Size {
+		return state.StorageConstraints{}, errors.NotValidf(
+			"size %dMiB for snapshot %q, must be at least snapshot size %dMiB", cons.Size, uuid, snapshot.Size,
+		)
+	}
+	cons.SnapshotId = snapshot.ProviderID
+	return cons, nil
+}
+
+func storageSnapshotDetails(snapshot domainstorage.StorageSnapshot) (params.StorageSnapshotDetails, error) {
+	lifeValue, err := snapshot.Life.Value()
+	if err != nil {
+		return params.StorageSnapshotDetails{}, errors.Trace(err)
+	}
+	return params.StorageSnapshotDetails{
+		UUID:       snapshot.UUID.String(),
+		StorageTag: names.NewStorageTag(snapshot.StorageID).String(),
+		Pool:       snapshot.Pool,
+		ProviderId: snapshot.ProviderID,
+		Size:       snapshot.Size,
+		Life:       lifeValue,
+		Status:     snapshot.Status.String(),
+		Message:    snapshot.Message,
+		Created:    snapshot.CreatedAt,
+	}, nil
+}
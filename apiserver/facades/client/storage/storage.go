@@ -81,6 +81,32 @@ type StorageService interface {
 	// The following errors can be expected:
 	// - [storageerrors.PoolNotFoundError] if a pool with the specified name does not exist.
 	GetStoragePoolByName(ctx context.Context, name string) (domainstorage.StoragePool, error)
+
+	// GetStoragePoolProvider returns the storage provider of the named
+	// storage pool, along with the configuration with which to create
+	// the provider's volume or filesystem sources.
+	GetStoragePoolProvider(ctx context.Context, poolName string) (storage.Provider, *storage.Config, error)
+
+	// SnapshotStorage takes a snapshot of the volume backing a storage
+	// instance and records it in the model.
+	// A NotSupported error is returned if the pool's storage provider
+	// does not support snapshots.
+	SnapshotStorage(ctx context.Context, arg storageservice.SnapshotStorageParams) (domainstorage.StorageSnapshot, error)
+
+	// GetStorageSnapshot returns the specified storage snapshot.
+	// The following errors can be expected:
+	// - [storageerrors.SnapshotNotFound] if the snapshot does not exist.
+	GetStorageSnapshot(ctx context.Context, uuid domainstorage.SnapshotUUID) (domainstorage.StorageSnapshot, error)
+
+	// ListStorageSnapshots returns the snapshots taken of the specified
+	// storage instance, or of all storage instances if storageID is empty.
+	ListStorageSnapshots(ctx context.Context, storageID string) ([]domainstorage.StorageSnapshot, error)
+
+	// RemoveStorageSnapshot removes the specified storage snapshot from
+	// the storage provider, and then from the model.
+	// The following errors can be expected:
+	// - [storageerrors.SnapshotNotFound] if the snapshot does not exist.
+	RemoveStorageSnapshot(ctx context.Context, uuid domainstorage.SnapshotUUID) error
 }
 
 // ApplicationService defines apis on the application service.
@@ -90,7 +116,7 @@ type ApplicationService interface {
 
 type storageRegistryGetter func(context.Context) (storage.ProviderRegistry, error)
 
-// StorageAPI implements the latest version (v6) of the Storage API.
+// StorageAPI implements the latest version (v8) of the Storage API.
 type StorageAPI struct {
 	storageAccess         storageAccess
 	blockDeviceGetter     blockDeviceGetter
@@ -104,6 +130,18 @@ type StorageAPI struct {
 	modelUUID      coremodel.UUID
 }
 
+// StorageAPIV7 implements version 7 of the Storage API,
+// which does not support storage snapshots.
+type StorageAPIV7 struct {
+	*StorageAPI
+}
+
+// StorageAPIV6 implements version 6 of the Storage API,
+// which does not support resizing storage.
+type StorageAPIV6 struct {
+	*StorageAPIV7
+}
+
 func NewStorageAPI(
 	controllerUUID string,
 	modelUUID coremodel.UUID,
@@ -538,9 +576,16 @@ func (a *StorageAPI) addToUnit(ctx context.Context, args params.StoragesAddParam
 			continue
 		}
 
-		storageTags, err := a.storageAccess.AddStorageForUnit(
-			u, one.StorageName, paramsToState(one.Directives),
-		)
+		cons := paramsToState(one.Directives)
+		if one.Directives.Snapshot != "" {
+			cons, err = a.snapshotConstraints(ctx, one.Directives.Snapshot, cons)
+			if err != nil {
+				result[i].Error = apiservererrors.ServerError(err)
+				continue
+			}
+		}
+
+		storageTags, err := a.storageAccess.AddStorageForUnit(u, one.StorageName, cons)
 		if err != nil {
 			result[i].Error = apiservererrors.ServerError(err)
 		}
@@ -827,6 +872,93 @@ func (a *StorageAPI) importFilesystem(
 	}, nil
 }
 
+// ResizeStorage grows the volumes backing the specified storage
+// instances to the requested sizes, in MiB.
+// A "CHANGE" block can block this operation.
+func (a *StorageAPI) ResizeStorage(ctx context.Context, args params.ResizeStorage) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.blockCommandService)
+	if err := blockChecker.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.ErrorResult, len(args.Storage))
+	for i, arg := range args.Storage {
+		results[i].Error = apiservererrors.ServerError(a.resizeStorage(ctx, arg))
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+// ResizeStorage is not available on version 6 of the Storage API.
+func (a *StorageAPIV6) ResizeStorage(_ context.Context, _ struct{}) {}
+
+func (a *StorageAPI) resizeStorage(ctx context.Context, arg params.ResizeStorageInstance) error {
+	storageTag, err := names.ParseStorageTag(arg.Tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := a.storageAccess.StorageInstance(storageTag); err != nil {
+		return errors.Trace(err)
+	}
+	volume, err := a.storageAccess.StorageInstanceVolume(storageTag)
+	if errors.Is(err, errors.NotFound) {
+		return errors.NotSupportedf("resizing %s without a backing volume", names.ReadableString(storageTag))
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	volumeInfo, err := volume.Info()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if arg.Size <= volumeInfo.Size {
+		return errors.NotValidf(
+			"size %dMiB for %s, must be greater than current size %dMiB",
+			arg.Size, names.ReadableString(storageTag), volumeInfo.Size,
+		)
+	}
+
+	provider, cfg, err := a.storageService.GetStoragePoolProvider(ctx, volumeInfo.Pool)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if provider.Scope() != storage.ScopeEnviron {
+		// Machine-scoped volumes are resized by the storage
+		// provisioner on the machine, which records the new
+		// size once the volume has grown.
+		return errors.Trace(a.storageAccess.RequestVolumeResize(volume.VolumeTag(), arg.Size))
+	}
+	volumeSource, err := provider.VolumeSource(cfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	volumeResizer, ok := volumeSource.(storage.VolumeResizer)
+	if !ok {
+		return errors.NotSupportedf("resizing volumes with storage provider %q", cfg.Provider())
+	}
+	results, err := volumeResizer.ResizeVolumes(ctx, []storage.ResizeVolumeParams{{
+		Tag:      volume.VolumeTag(),
+		VolumeId: volumeInfo.VolumeId,
+		Size:     arg.Size,
+	}})
+	if err != nil {
+		return errors.Annotate(err, "resizing volume")
+	}
+	if len(results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Error != nil {
+		return errors.Annotate(results[0].Error, "resizing volume")
+	}
+
+	// Recording the new size of the volume also records the
+	// new size of any filesystem backed by it.
+	volumeInfo.Size = results[0].Size
+	return errors.Trace(a.storageAccess.SetVolumeInfo(volume.VolumeTag(), volumeInfo))
+}
+
 // RemovePool deletes the named pool
 func (a *StorageAPI) RemovePool(ctx context.Context, p params.StoragePoolDeleteArgs) (params.ErrorResults, error) {
 	results := params.ErrorResults{
@@ -0,0 +1,145 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/domain/blockcommand"
+	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/storage/provider/dummy"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+type storageResizeSuite struct {
+	baseStorageSuite
+
+	volumeSource *dummy.VolumeSource
+}
+
+func TestStorageResizeSuite(t *testing.T) {
+	tc.Run(t, &storageResizeSuite{})
+}
+
+func (s *storageResizeSuite) setupResize(c *tc.C, scope storage.Scope) *gomock.Controller {
+	ctrl := s.setupMocks(c)
+
+	s.volume.info = &state.VolumeInfo{
+		VolumeId: "vol-0",
+		Pool:     "radiance",
+		Size:     1024,
+	}
+
+	s.volumeSource = &dummy.VolumeSource{
+		ResizeVolumesFunc: func(_ context.Context, args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+			results := make([]storage.ResizeVolumesResult, len(args))
+			for i, arg := range args {
+				results[i].Size = arg.Size
+			}
+			return results, nil
+		},
+	}
+	provider := &dummy.StorageProvider{
+		StorageScope: scope,
+		IsDynamic:    true,
+		VolumeSourceFunc: func(*storage.Config) (storage.VolumeSource, error) {
+			return s.volumeSource, nil
+		},
+	}
+	cfg, err := storage.NewConfig("radiance", "radiance", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	s.storageService.EXPECT().GetStoragePoolProvider(gomock.Any(), "radiance").Return(provider, cfg, nil).AnyTimes()
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).Return("", blockcommanderrors.NotFound)
+	return ctrl
+}
+
+func (s *storageResizeSuite) TestResizeStorage(c *tc.C) {
+	defer s.setupResize(c, storage.ScopeEnviron).Finish()
+
+	results, err := s.api.ResizeStorage(c.Context(), params.ResizeStorage{Storage: []params.ResizeStorageInstance{{
+		Tag:  s.storageTag.String(),
+		Size: 2048,
+	}}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.DeepEquals, []params.ErrorResult{{}})
+
+	s.volumeSource.CheckCallNames(c, "ResizeVolumes")
+	c.Check(s.volumeSource.Calls()[0].Args[1], tc.DeepEquals, []storage.ResizeVolumeParams{{
+		Tag:      s.volumeTag,
+		VolumeId: "vol-0",
+		Size:     2048,
+	}})
+	s.stub.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: storageInstanceCall, Args: []interface{}{s.storageTag}},
+		{FuncName: storageInstanceVolumeCall},
+		{FuncName: setVolumeInfoCall, Args: []interface{}{
+			s.volumeTag,
+			state.VolumeInfo{VolumeId: "vol-0", Pool: "radiance", Size: 2048},
+		}},
+	})
+}
+
+func (s *storageResizeSuite) TestResizeStorageShrink(c *tc.C) {
+	defer s.setupResize(c, storage.ScopeEnviron).Finish()
+
+	results, err := s.api.ResizeStorage(c.Context(), params.ResizeStorage{Storage: []params.ResizeStorageInstance{{
+		Tag:  s.storageTag.String(),
+		Size: 512,
+	}}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.DeepEquals, []params.ErrorResult{{
+		Error: &params.Error{
+			Message: `size 512MiB for storage data/0, must be greater than current size 1024MiB not valid`,
+			Code:    params.CodeNotValid,
+		},
+	}})
+	s.volumeSource.CheckNoCalls(c)
+}
+
+func (s *storageResizeSuite) TestResizeStorageMachineScoped(c *tc.C) {
+	defer s.setupResize(c, storage.ScopeMachine).Finish()
+
+	results, err := s.api.ResizeStorage(c.Context(), params.ResizeStorage{Storage: []params.ResizeStorageInstance{{
+		Tag:  s.storageTag.String(),
+		Size: 2048,
+	}}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.DeepEquals, []params.ErrorResult{{}})
+
+	// The storage provisioner on the machine resizes the volume.
+	s.volumeSource.CheckNoCalls(c)
+	s.stub.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: storageInstanceCall, Args: []interface{}{s.storageTag}},
+		{FuncName: storageInstanceVolumeCall},
+		{FuncName: requestVolumeResizeCall, Args: []interface{}{s.volumeTag, uint64(2048)}},
+	})
+}
+
+func (s *storageResizeSuite) TestResizeStorageInvalidTag(c *tc.C) {
+	defer s.setupResize(c, storage.ScopeEnviron).Finish()
+
+	results, err := s.api.ResizeStorage(c.Context(), params.ResizeStorage{Storage: []params.ResizeStorageInstance{{
+		Tag:  "volume-0",
+		Size: 2048,
+	}}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.ErrorMatches, `"volume-0" is not a valid storage tag`)
+}
+
+func (s *storageResizeSuite) TestResizeStorageBlocked(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockAllChanges(c, "resize")
+	_, err := s.api.ResizeStorage(c.Context(), params.ResizeStorage{})
+	s.assertBlocked(c, err, "resize")
+}
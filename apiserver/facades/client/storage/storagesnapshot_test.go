@@ -0,0 +1,268 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	corelife "github.com/juju/juju/core/life"
+	"github.com/juju/juju/domain/blockcommand"
+	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
+	"github.com/juju/juju/domain/life"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	storageservice "github.com/juju/juju/domain/storage/service"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+type storageSnapshotSuite struct {
+	baseStorageSuite
+
+	created time.Time
+}
+
+func TestStorageSnapshotSuite(t *testing.T) {
+	tc.Run(t, &storageSnapshotSuite{})
+}
+
+const snapshotUUID = "1f5a0e4f-0d7e-4d4c-8e4b-1e2a9d6c3b7a"
+
+func (s *storageSnapshotSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := s.baseStorageSuite.setupMocks(c)
+
+	s.volume.info = &state.VolumeInfo{
+		VolumeId: "vol-0",
+		Pool:     "radiance",
+		Size:     1024,
+	}
+	s.created = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	return ctrl
+}
+
+func (s *storageSnapshotSuite) snapshot() domainstorage.StorageSnapshot {
+	return domainstorage.StorageSnapshot{
+		UUID:       snapshotUUID,
+		StorageID:  "data/0",
+		VolumeID:   "vol-0",
+		Pool:       "radiance",
+		ProviderID: "snap-0",
+		Size:       1024,
+		Life:       life.Alive,
+		Status:     domainstorage.SnapshotStatusAvailable,
+		CreatedAt:  s.created,
+	}
+}
+
+func (s *storageSnapshotSuite) snapshotDetails() params.StorageSnapshotDetails {
+	return params.StorageSnapshotDetails{
+		UUID:       snapshotUUID,
+		StorageTag: "storage-data-0",
+		Pool:       "radiance",
+		ProviderId: "snap-0",
+		Size:       1024,
+		Life:       corelife.Alive,
+		Status:     "available",
+		Created:    s.created,
+	}
+}
+
+func (s *storageSnapshotSuite) TestSnapshotStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).Return("", blockcommanderrors.NotFound)
+	s.storageService.EXPECT().SnapshotStorage(gomock.Any(), storageservice.SnapshotStorageParams{
+		StorageID: "data/0",
+		VolumeTag: s.volumeTag,
+		VolumeID:  "vol-0",
+		Pool:      "radiance",
+		Size:      1024,
+		ResourceTags: map[string]string{
+			tags.JujuModel:      s.modelUUID.String(),
+			tags.JujuController: s.controllerUUID,
+		},
+	}).Return(s.snapshot(), nil)
+
+	results, err := s.api.SnapshotStorage(c.Context(), params.Entities{Entities: []params.Entity{
+		{Tag: s.storageTag.String()},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	details := s.snapshotDetails()
+	c.Assert(results.Results, tc.DeepEquals, []params.StorageSnapshotResult{{Result: &details}})
+	s.stub.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: storageInstanceVolumeCall},
+	})
+}
+
+func (s *storageSnapshotSuite) TestSnapshotStorageNoVolume(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).Return("", blockcommanderrors.NotFound)
+	s.storageAccessor.storageInstanceVolume = func(names.StorageTag) (state.Volume, error) {
+		return nil, errors.NotFoundf("volume")
+	}
+
+	results, err := s.api.SnapshotStorage(c.Context(), params.Entities{Entities: []params.Entity{
+		{Tag: s.storageTag.String()},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.DeepEquals, []params.StorageSnapshotResult{{
+		Error: &params.Error{
+			Message: "snapshotting storage data/0 without a backing volume not supported",
+			Code:    params.CodeNotSupported,
+		},
+	}})
+}
+
+func (s *storageSnapshotSuite) TestSnapshotStorageBlocked(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockAllChanges(c, "snapshot")
+	_, err := s.api.SnapshotStorage(c.Context(), params.Entities{})
+	s.assertBlocked(c, err, "snapshot")
+}
+
+func (s *storageSnapshotSuite) TestListStorageSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.storageService.EXPECT().ListStorageSnapshots(gomock.Any(), "").Return([]domainstorage.StorageSnapshot{s.snapshot()}, nil)
+	s.storageService.EXPECT().ListStorageSnapshots(gomock.Any(), "data/0").Return(nil, nil)
+
+	results, err := s.api.ListStorageSnapshots(c.Context(), params.StorageSnapshotFilters{
+		Filters: []params.StorageSnapshotFilter{{}, {StorageTag: s.storageTag.String()}, {StorageTag: "volume-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 3)
+	c.Check(results.Results[0], tc.DeepEquals, params.StorageSnapshotsResult{
+		Result: []params.StorageSnapshotDetails{s.snapshotDetails()},
+	})
+	c.Check(results.Results[1], tc.DeepEquals, params.StorageSnapshotsResult{
+		Result: []params.StorageSnapshotDetails{},
+	})
+	c.Check(results.Results[2].Error, tc.ErrorMatches, `"volume-0" is not a valid storage tag`)
+}
+
+func (s *storageSnapshotSuite) TestRemoveStorageSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.RemoveBlock).Return("", blockcommanderrors.NotFound)
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).Return("", blockcommanderrors.NotFound)
+	s.storageService.EXPECT().RemoveStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID(snapshotUUID)).Return(nil)
+	s.storageService.EXPECT().RemoveStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID("missing")).Return(storageerrors.SnapshotNotFound)
+
+	results, err := s.api.RemoveStorageSnapshots(c.Context(), params.RemoveStorageSnapshots{
+		Snapshots: []string{snapshotUUID, "missing"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 2)
+	c.Check(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[1].Error, tc.ErrorMatches, "storage snapshot not found")
+}
+
+func (s *storageSnapshotSuite) TestRemoveStorageSnapshotsBlocked(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.addBlock(c, blockcommand.RemoveBlock, "remove")
+	_, err := s.api.RemoveStorageSnapshots(c.Context(), params.RemoveStorageSnapshots{})
+	s.assertBlocked(c, err, "remove")
+}
+
+func (s *storageSnapshotSuite) addFromSnapshot(c *tc.C, directives params.StorageDirectives) (state.StorageConstraints, *params.Error) {
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).Return("", blockcommanderrors.NotFound)
+
+	var cons state.StorageConstraints
+	s.storageAccessor.addStorageForUnit = func(u names.UnitTag, name string, c state.StorageConstraints) ([]names.StorageTag, error) {
+		s.stub.AddCall(addStorageForUnitCall)
+		cons = c
+		return nil, nil
+	}
+	results, err := s.api.AddToUnit(c.Context(), params.StoragesAddParams{Storages: []params.StorageAddParams{{
+		UnitTag:     s.unitTag.String(),
+		StorageName: "data",
+		Directives:  directives,
+	}}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	return cons, results.Results[0].Error
+}
+
+func (s *storageSnapshotSuite) TestAddToUnitFromSnapshot(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.storageService.EXPECT().GetStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID(snapshotUUID)).Return(s.snapshot(), nil)
+
+	cons, resultErr := s.addFromSnapshot(c, params.StorageDirectives{Snapshot: snapshotUUID})
+	c.Assert(resultErr, tc.IsNil)
+	c.Check(cons, tc.DeepEquals, state.StorageConstraints{
+		Pool:       "radiance",
+		Size:       1024,
+		SnapshotId: "snap-0",
+	})
+}
+
+func (s *storageSnapshotSuite) TestAddToUnitFromSnapshotLarger(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.storageService.EXPECT().GetStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID(snapshotUUID)).Return(s.snapshot(), nil)
+
+	size := uint64(4096)
+	cons, resultErr := s.addFromSnapshot(c, params.StorageDirectives{
+		Pool:     "radiance",
+		Size:     &size,
+		Snapshot: snapshotUUID,
+	})
+	c.Assert(resultErr, tc.IsNil)
+	c.Check(cons, tc.DeepEquals, state.StorageConstraints{
+		Pool:       "radiance",
+		Size:       4096,
+		SnapshotId: "snap-0",
+	})
+}
+
+func (s *storageSnapshotSuite) TestAddToUnitFromSnapshotTooSmall(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.storageService.EXPECT().GetStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID(snapshotUUID)).Return(s.snapshot(), nil)
+
+	size := uint64(512)
+	_, resultErr := s.addFromSnapshot(c, params.StorageDirectives{
+		Size:     &size,
+		Snapshot: snapshotUUID,
+	})
+	c.Assert(resultErr, tc.ErrorMatches, `size 512MiB for snapshot ".*", must be at least snapshot size 1024MiB not valid`)
+	s.stub.CheckNoCalls(c)
+}
+
+func (s *storageSnapshotSuite) TestAddToUnitFromSnapshotOtherPool(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.storageService.EXPECT().GetStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID(snapshotUUID)).Return(s.snapshot(), nil)
+
+	_, resultErr := s.addFromSnapshot(c, params.StorageDirectives{
+		Pool:     "other",
+		Snapshot: snapshotUUID,
+	})
+	c.Assert(resultErr, tc.ErrorMatches, `pool "other" for snapshot ".*" taken from pool "radiance" not valid`)
+	s.stub.CheckNoCalls(c)
+}
+
+func (s *storageSnapshotSuite) TestAddToUnitFromSnapshotNotAvailable(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	snapshot := s.snapshot()
+	snapshot.Status = domainstorage.SnapshotStatusPending
+	s.storageService.EXPECT().GetStorageSnapshot(gomock.Any(), domainstorage.SnapshotUUID(snapshotUUID)).Return(snapshot, nil)
+
+	_, resultErr := s.addFromSnapshot(c, params.StorageDirectives{Snapshot: snapshotUUID})
+	c.Assert(resultErr, tc.ErrorMatches, `snapshot ".*" is pending: storage snapshot not available`)
+	s.stub.CheckNoCalls(c)
+}
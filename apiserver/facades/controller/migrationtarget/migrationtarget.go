@@ -136,6 +136,11 @@ type APIV4 struct {
 
 // APIV5 implements the APIV5.
 type APIV5 struct {
+	*APIV6
+}
+
+// APIV6 implements the APIV6.
+type APIV6 struct {
 	*API
 }
 
@@ -212,42 +217,121 @@ func (api *API) Prechecks(ctx context.Context, model params.MigrationModelInfo)
 		)
 	}
 
-	// Ensure that when attempting to migrate a model, the source
-	// controller has the required facades for the migration.
+	if err := api.checkSourceFacadeVersions(model); err != nil {
+		return err
+	}
+
+	err = migration.ImportDescriptionPrecheck(ctx, modelDescription)
+	if err != nil {
+		return fmt.Errorf("migration import prechecks: %w", err)
+	}
+
+	backend, modelAgentService, err := api.precheckBackend(ctx, model.UUID)
+	if err != nil {
+		return err
+	}
+
+	if err := migration.TargetPrecheck(
+		ctx,
+		backend,
+		migration.PoolShim(api.pool),
+		precheckModelInfo(model, modelDescription),
+		api.upgradeService,
+		api.statusService,
+		modelAgentService,
+	); err != nil {
+		return errors.Errorf("migration target prechecks failed: %w", err)
+	}
+	return nil
+}
+
+// PrechecksReport runs the same checks as Prechecks, but doesn't stop at
+// the first failure. Every problem found is returned in the report, so
+// that a migration can be planned without being started.
+func (api *API) PrechecksReport(ctx context.Context, model params.MigrationModelInfo) (params.MigrationPrecheckReport, error) {
+	modelDescription, err := description.Deserialize(model.ModelDescription)
+	if err != nil {
+		return params.MigrationPrecheckReport{}, errors.Errorf(
+			"cannot deserialize model %q description during prechecks: %w",
+			model.UUID,
+			err,
+		)
+	}
+
+	var report coremigration.PrecheckReport
+	if err := api.checkSourceFacadeVersions(model); err != nil {
+		report.Blockers = append(report.Blockers, err.Error())
+	}
+
+	report.Merge(migration.ImportDescriptionPrecheckReport(ctx, modelDescription))
+
+	backend, modelAgentService, err := api.precheckBackend(ctx, model.UUID)
+	if err != nil {
+		return params.MigrationPrecheckReport{}, err
+	}
+
+	targetReport, err := migration.TargetPrecheckReport(
+		ctx,
+		backend,
+		migration.PoolShim(api.pool),
+		precheckModelInfo(model, modelDescription),
+		api.upgradeService,
+		api.statusService,
+		modelAgentService,
+	)
+	if err != nil {
+		return params.MigrationPrecheckReport{}, errors.Errorf("migration target prechecks failed: %w", err)
+	}
+	report.Merge(targetReport)
+
+	return params.MigrationPrecheckReport{
+		Blockers: report.Blockers,
+		Warnings: report.Warnings,
+	}, nil
+}
+
+// PrechecksReport isn't on the v6 API.
+func (api *APIV6) PrechecksReport(_ context.Context, _ struct{}) {}
+
+// checkSourceFacadeVersions ensures that when attempting to migrate a
+// model, the source controller has the required facades for the
+// migration.
+func (api *API) checkSourceFacadeVersions(model params.MigrationModelInfo) error {
 	sourceFacadeVersions := facades.FacadeVersions{}
 	for name, versions := range model.FacadeVersions {
 		sourceFacadeVersions[name] = versions
 	}
-	if !facades.CompleteIntersection(api.requiredMigrationFacadeVersions, sourceFacadeVersions) {
-		majorMinor := fmt.Sprintf("%d.%d",
-			model.ControllerAgentVersion.Major,
-			model.ControllerAgentVersion.Minor,
-		)
+	if facades.CompleteIntersection(api.requiredMigrationFacadeVersions, sourceFacadeVersions) {
+		return nil
+	}
 
-		// If the patch is zero, then we don't need to mention it.
-		var patchMessage string
-		if model.ControllerAgentVersion.Patch > 0 {
-			patchMessage = fmt.Sprintf(", that is greater than %s.%d", majorMinor, model.ControllerAgentVersion.Patch)
-		}
+	majorMinor := fmt.Sprintf("%d.%d",
+		model.ControllerAgentVersion.Major,
+		model.ControllerAgentVersion.Minor,
+	)
 
-		return errors.Errorf(`
+	// If the patch is zero, then we don't need to mention it.
+	var patchMessage string
+	if model.ControllerAgentVersion.Patch > 0 {
+		patchMessage = fmt.Sprintf(", that is greater than %s.%d", majorMinor, model.ControllerAgentVersion.Patch)
+	}
+
+	return errors.Errorf(`
 Source controller does not support required facades for performing migration.
 Upgrade the controller to a newer version of %s%s or migrate to a controller
 with an earlier version of the target controller and try again.
 
 `[1:], majorMinor, patchMessage)
-	}
-
-	err = migration.ImportDescriptionPrecheck(ctx, modelDescription)
-	if err != nil {
-		return fmt.Errorf("migration import prechecks: %w", err)
-	}
+}
 
+// precheckBackend returns the backend and model agent service used to
+// run the target prechecks for the model being migrated.
+func (api *API) precheckBackend(ctx context.Context, modelUUID string) (migration.PrecheckBackend, ModelAgentService, error) {
 	controllerState, err := api.pool.SystemState()
 	if err != nil {
-		return errors.Errorf(
+		return nil, nil, errors.Errorf(
 			"getting system state during prechecks for model %q: %w",
-			model.UUID,
+			modelUUID,
 			err,
 		)
 	}
@@ -258,32 +342,24 @@ with an earlier version of the target controller and try again.
 	// controllerState.
 	modelAgentService, err := api.modelAgentServiceGetter(ctx, coremodel.UUID(controllerState.ModelUUID()))
 	if err != nil {
-		return errors.Errorf("cannot get model agent service: %w", err)
+		return nil, nil, errors.Errorf("cannot get model agent service: %w", err)
 	}
 	backend, err := migration.PrecheckShim(api.state, controllerState)
 	if err != nil {
-		return errors.Errorf("cannot create prechecks backend: %w", err)
+		return nil, nil, errors.Errorf("cannot create prechecks backend: %w", err)
 	}
+	return backend, modelAgentService, nil
+}
 
-	if err := migration.TargetPrecheck(
-		ctx,
-		backend,
-		migration.PoolShim(api.pool),
-		coremigration.ModelInfo{
-			UUID:                   model.UUID,
-			Name:                   model.Name,
-			Qualifier:              coremodel.Qualifier(model.Qualifier),
-			AgentVersion:           model.AgentVersion,
-			ControllerAgentVersion: model.ControllerAgentVersion,
-			ModelDescription:       modelDescription,
-		},
-		api.upgradeService,
-		api.statusService,
-		modelAgentService,
-	); err != nil {
-		return errors.Errorf("migration target prechecks failed: %w", err)
+func precheckModelInfo(model params.MigrationModelInfo, modelDescription description.Model) coremigration.ModelInfo {
+	return coremigration.ModelInfo{
+		UUID:                   model.UUID,
+		Name:                   model.Name,
+		Qualifier:              coremodel.Qualifier(model.Qualifier),
+		AgentVersion:           model.AgentVersion,
+		ControllerAgentVersion: model.ControllerAgentVersion,
+		ModelDescription:       modelDescription,
 	}
-	return nil
 }
 
 // Import takes a serialized Juju model, deserializes it, and
@@ -209,6 +209,29 @@ with an earlier version of the target controller and try again.
 `[1:])
 }
 
+func (s *Suite) TestPrechecksReport(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.upgradeService.EXPECT().IsUpgrading(gomock.Any()).Return(true, nil)
+
+	api := s.mustNewAPIWithFacadeVersions(c, facades.FacadeVersions{
+		"MigrationTarget": []int{1},
+	})
+	args := params.MigrationModelInfo{
+		UUID:                   "uuid",
+		Name:                   "some-model",
+		Qualifier:              "someone",
+		AgentVersion:           s.controllerVersion(c),
+		ControllerAgentVersion: s.controllerVersion(c),
+	}
+	report, err := api.PrechecksReport(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(report.Blockers, tc.HasLen, 2)
+	c.Check(report.Blockers[0], tc.Matches, `(?s)Source controller does not support required facades.*`)
+	c.Check(report.Blockers[1], tc.Equals, "upgrade in progress")
+	c.Check(report.Warnings, tc.HasLen, 0)
+}
+
 func (s *Suite) TestImport(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
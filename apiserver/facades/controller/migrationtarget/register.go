@@ -34,11 +34,19 @@ func Register(requiredMigrationFacadeVersions facades.FacadeVersions) func(regis
 		}, reflect.TypeOf((*APIV5)(nil)))
 		// v6 handles requests with a model qualifier instead of a model owner.
 		registry.MustRegisterForMultiModel("MigrationTarget", 6, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
-			api, err := makeFacade(stdCtx, ctx, requiredMigrationFacadeVersions)
+			api, err := makeFacadeV6(stdCtx, ctx, requiredMigrationFacadeVersions)
 			if err != nil {
 				return nil, errors.Errorf("making migration target version 6: %w", err)
 			}
 			return api, nil
+		}, reflect.TypeOf((*APIV6)(nil)))
+		// v7 adds PrechecksReport.
+		registry.MustRegisterForMultiModel("MigrationTarget", 7, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+			api, err := makeFacade(stdCtx, ctx, requiredMigrationFacadeVersions)
+			if err != nil {
+				return nil, errors.Errorf("making migration target version 7: %w", err)
+			}
+			return api, nil
 		}, reflect.TypeOf((*API)(nil)))
 	}
 }
@@ -60,11 +68,23 @@ func makeFacadeV5(
 	ctx facade.MultiModelContext,
 	facadeVersions facades.FacadeVersions,
 ) (*APIV5, error) {
+	api, err := makeFacadeV6(stdCtx, ctx, facadeVersions)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return &APIV5{APIV6: api}, err
+}
+
+func makeFacadeV6(
+	stdCtx context.Context,
+	ctx facade.MultiModelContext,
+	facadeVersions facades.FacadeVersions,
+) (*APIV6, error) {
 	api, err := makeFacade(stdCtx, ctx, facadeVersions)
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
-	return &APIV5{API: api}, err
+	return &APIV6{API: api}, err
 }
 
 // makeFacade is responsible for constructing a new migration target facade and
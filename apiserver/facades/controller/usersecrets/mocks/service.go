@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/controller/usersecrets (interfaces: SecretService)
+// Source: service.go
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/service.go github.com/juju/juju/apiserver/facades/controller/usersecrets SecretService
+//	mockgen -typed -package mocks -destination mocks/service.go -source service.go
 //
 
 // Package mocks is a generated GoMock package.
@@ -42,17 +42,17 @@ func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
 }
 
 // DeleteObsoleteUserSecretRevisions mocks base method.
-func (m *MockSecretService) DeleteObsoleteUserSecretRevisions(arg0 context.Context) error {
+func (m *MockSecretService) DeleteObsoleteUserSecretRevisions(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteObsoleteUserSecretRevisions", arg0)
+	ret := m.ctrl.Call(m, "DeleteObsoleteUserSecretRevisions", ctx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteObsoleteUserSecretRevisions indicates an expected call of DeleteObsoleteUserSecretRevisions.
-func (mr *MockSecretServiceMockRecorder) DeleteObsoleteUserSecretRevisions(arg0 any) *MockSecretServiceDeleteObsoleteUserSecretRevisionsCall {
+func (mr *MockSecretServiceMockRecorder) DeleteObsoleteUserSecretRevisions(ctx any) *MockSecretServiceDeleteObsoleteUserSecretRevisionsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObsoleteUserSecretRevisions", reflect.TypeOf((*MockSecretService)(nil).DeleteObsoleteUserSecretRevisions), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObsoleteUserSecretRevisions", reflect.TypeOf((*MockSecretService)(nil).DeleteObsoleteUserSecretRevisions), ctx)
 	return &MockSecretServiceDeleteObsoleteUserSecretRevisionsCall{Call: call}
 }
 
@@ -80,18 +80,18 @@ func (c *MockSecretServiceDeleteObsoleteUserSecretRevisionsCall) DoAndReturn(f f
 }
 
 // GetSecret mocks base method.
-func (m *MockSecretService) GetSecret(arg0 context.Context, arg1 *secrets.URI) (*secrets.SecretMetadata, error) {
+func (m *MockSecretService) GetSecret(ctx context.Context, uri *secrets.URI) (*secrets.SecretMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSecret", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetSecret", ctx, uri)
 	ret0, _ := ret[0].(*secrets.SecretMetadata)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSecret indicates an expected call of GetSecret.
-func (mr *MockSecretServiceMockRecorder) GetSecret(arg0, arg1 any) *MockSecretServiceGetSecretCall {
+func (mr *MockSecretServiceMockRecorder) GetSecret(ctx, uri any) *MockSecretServiceGetSecretCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockSecretService)(nil).GetSecret), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockSecretService)(nil).GetSecret), ctx, uri)
 	return &MockSecretServiceGetSecretCall{Call: call}
 }
 
@@ -118,19 +118,57 @@ func (c *MockSecretServiceGetSecretCall) DoAndReturn(f func(context.Context, *se
 	return c
 }
 
+// RotateUserSecret mocks base method.
+func (m *MockSecretService) RotateUserSecret(ctx context.Context, uri *secrets.URI) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateUserSecret", ctx, uri)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateUserSecret indicates an expected call of RotateUserSecret.
+func (mr *MockSecretServiceMockRecorder) RotateUserSecret(ctx, uri any) *MockSecretServiceRotateUserSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateUserSecret", reflect.TypeOf((*MockSecretService)(nil).RotateUserSecret), ctx, uri)
+	return &MockSecretServiceRotateUserSecretCall{Call: call}
+}
+
+// MockSecretServiceRotateUserSecretCall wrap *gomock.Call
+type MockSecretServiceRotateUserSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceRotateUserSecretCall) Return(arg0 error) *MockSecretServiceRotateUserSecretCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceRotateUserSecretCall) Do(f func(context.Context, *secrets.URI) error) *MockSecretServiceRotateUserSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceRotateUserSecretCall) DoAndReturn(f func(context.Context, *secrets.URI) error) *MockSecretServiceRotateUserSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchObsoleteUserSecretsToPrune mocks base method.
-func (m *MockSecretService) WatchObsoleteUserSecretsToPrune(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+func (m *MockSecretService) WatchObsoleteUserSecretsToPrune(ctx context.Context) (watcher.NotifyWatcher, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WatchObsoleteUserSecretsToPrune", arg0)
-	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret := m.ctrl.Call(m, "WatchObsoleteUserSecretsToPrune", ctx)
+	ret0, _ := ret[0].(watcher.NotifyWatcher)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // WatchObsoleteUserSecretsToPrune indicates an expected call of WatchObsoleteUserSecretsToPrune.
-func (mr *MockSecretServiceMockRecorder) WatchObsoleteUserSecretsToPrune(arg0 any) *MockSecretServiceWatchObsoleteUserSecretsToPruneCall {
+func (mr *MockSecretServiceMockRecorder) WatchObsoleteUserSecretsToPrune(ctx any) *MockSecretServiceWatchObsoleteUserSecretsToPruneCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchObsoleteUserSecretsToPrune", reflect.TypeOf((*MockSecretService)(nil).WatchObsoleteUserSecretsToPrune), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchObsoleteUserSecretsToPrune", reflect.TypeOf((*MockSecretService)(nil).WatchObsoleteUserSecretsToPrune), ctx)
 	return &MockSecretServiceWatchObsoleteUserSecretsToPruneCall{Call: call}
 }
 
@@ -140,19 +178,58 @@ type MockSecretServiceWatchObsoleteUserSecretsToPruneCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretServiceWatchObsoleteUserSecretsToPruneCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockSecretServiceWatchObsoleteUserSecretsToPruneCall {
+func (c *MockSecretServiceWatchObsoleteUserSecretsToPruneCall) Return(arg0 watcher.NotifyWatcher, arg1 error) *MockSecretServiceWatchObsoleteUserSecretsToPruneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceWatchObsoleteUserSecretsToPruneCall) Do(f func(context.Context) (watcher.NotifyWatcher, error)) *MockSecretServiceWatchObsoleteUserSecretsToPruneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceWatchObsoleteUserSecretsToPruneCall) DoAndReturn(f func(context.Context) (watcher.NotifyWatcher, error)) *MockSecretServiceWatchObsoleteUserSecretsToPruneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchUserSecretsRotationChanges mocks base method.
+func (m *MockSecretService) WatchUserSecretsRotationChanges(ctx context.Context) (watcher.SecretTriggerWatcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchUserSecretsRotationChanges", ctx)
+	ret0, _ := ret[0].(watcher.SecretTriggerWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchUserSecretsRotationChanges indicates an expected call of WatchUserSecretsRotationChanges.
+func (mr *MockSecretServiceMockRecorder) WatchUserSecretsRotationChanges(ctx any) *MockSecretServiceWatchUserSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchUserSecretsRotationChanges", reflect.TypeOf((*MockSecretService)(nil).WatchUserSecretsRotationChanges), ctx)
+	return &MockSecretServiceWatchUserSecretsRotationChangesCall{Call: call}
+}
+
+// MockSecretServiceWatchUserSecretsRotationChangesCall wrap *gomock.Call
+type MockSecretServiceWatchUserSecretsRotationChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceWatchUserSecretsRotationChangesCall) Return(arg0 watcher.SecretTriggerWatcher, arg1 error) *MockSecretServiceWatchUserSecretsRotationChangesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretServiceWatchObsoleteUserSecretsToPruneCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockSecretServiceWatchObsoleteUserSecretsToPruneCall {
+func (c *MockSecretServiceWatchUserSecretsRotationChangesCall) Do(f func(context.Context) (watcher.SecretTriggerWatcher, error)) *MockSecretServiceWatchUserSecretsRotationChangesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretServiceWatchObsoleteUserSecretsToPruneCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockSecretServiceWatchObsoleteUserSecretsToPruneCall {
+func (c *MockSecretServiceWatchUserSecretsRotationChangesCall) DoAndReturn(f func(context.Context) (watcher.SecretTriggerWatcher, error)) *MockSecretServiceWatchUserSecretsRotationChangesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -14,10 +14,21 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("UserSecretsManager", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newUserSecretsManagerV1(stdCtx, ctx)
+	}, reflect.TypeOf((*UserSecretsManagerV1)(nil)))
+	registry.MustRegister("UserSecretsManager", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return NewUserSecretsManager(stdCtx, ctx)
 	}, reflect.TypeOf((*UserSecretsManager)(nil)))
 }
 
+func newUserSecretsManagerV1(stdCtx context.Context, ctx facade.ModelContext) (*UserSecretsManagerV1, error) {
+	api, err := NewUserSecretsManager(stdCtx, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UserSecretsManagerV1{UserSecretsManager: api}, nil
+}
+
 // NewUserSecretsManager creates a UserSecretsManager.
 func NewUserSecretsManager(stdCtx context.Context, ctx facade.ModelContext) (*UserSecretsManager, error) {
 	if !ctx.Auth().AuthController() {
@@ -11,6 +11,8 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/internal"
+	coresecrets "github.com/juju/juju/core/secrets"
+	corewatcher "github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -20,6 +22,11 @@ type UserSecretsManager struct {
 	secretService   SecretService
 }
 
+// UserSecretsManagerV1 is the implementation for the usersecrets facade v1.
+type UserSecretsManagerV1 struct {
+	*UserSecretsManager
+}
+
 // WatchRevisionsToPrune returns a watcher for notifying when:
 //   - a secret revision owned by the model no longer
 //     has any consumers and should be pruned.
@@ -38,3 +45,49 @@ func (s *UserSecretsManager) WatchRevisionsToPrune(ctx context.Context) (params.
 func (s *UserSecretsManager) DeleteObsoleteUserSecretRevisions(ctx context.Context) error {
 	return s.secretService.DeleteObsoleteUserSecretRevisions(ctx)
 }
+
+// WatchUserSecretsRotationChanges isn't on the v1 API.
+func (s *UserSecretsManagerV1) WatchUserSecretsRotationChanges(_ context.Context, _ struct{}) {}
+
+// WatchUserSecretsRotationChanges returns a watcher which serves changes to
+// the rotation schedule of user secrets with a generator.
+func (s *UserSecretsManager) WatchUserSecretsRotationChanges(ctx context.Context) (params.SecretTriggerWatchResult, error) {
+	result := params.SecretTriggerWatchResult{}
+	w, err := s.secretService.WatchUserSecretsRotationChanges(ctx)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	id, secretChanges, err := internal.EnsureRegisterWatcher[[]corewatcher.SecretTriggerChange](ctx, s.watcherRegistry, w)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+	changes := make([]params.SecretTriggerChange, len(secretChanges))
+	for i, c := range secretChanges {
+		changes[i] = params.SecretTriggerChange{
+			URI:             c.URI.ID,
+			NextTriggerTime: c.NextTriggerTime,
+		}
+	}
+	result.WatcherId = id
+	result.Changes = changes
+	return result, nil
+}
+
+// RotateUserSecrets isn't on the v1 API.
+func (s *UserSecretsManagerV1) RotateUserSecrets(_ context.Context, _ struct{}) {}
+
+// RotateUserSecrets generates new revisions for the specified user secrets.
+func (s *UserSecretsManager) RotateUserSecrets(ctx context.Context, args params.SecretURIArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		uri, err := coresecrets.ParseURI(arg.URI)
+		if err == nil {
+			err = s.secretService.RotateUserSecret(ctx, uri)
+		}
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
@@ -5,6 +5,7 @@ package usersecrets_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
@@ -12,6 +13,9 @@ import (
 	facademocks "github.com/juju/juju/apiserver/facade/mocks"
 	"github.com/juju/juju/apiserver/facades/controller/usersecrets"
 	"github.com/juju/juju/apiserver/facades/controller/usersecrets/mocks"
+	coresecrets "github.com/juju/juju/core/secrets"
+	corewatcher "github.com/juju/juju/core/watcher"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/rpc/params"
 )
@@ -21,8 +25,9 @@ type userSecretsSuite struct {
 
 	authorizer *facademocks.MockAuthorizer
 
-	secretService *mocks.MockSecretService
-	watcher       *mocks.MockNotifyWatcher
+	secretService  *mocks.MockSecretService
+	watcher        *mocks.MockNotifyWatcher
+	triggerWatcher *mocks.MockSecretTriggerWatcher
 
 	facade          *usersecrets.UserSecretsManager
 	watcherRegistry *facademocks.MockWatcherRegistry
@@ -37,6 +42,7 @@ func (s *userSecretsSuite) setup(c *tc.C) *gomock.Controller {
 
 	s.authorizer = facademocks.NewMockAuthorizer(ctrl)
 	s.watcher = mocks.NewMockNotifyWatcher(ctrl)
+	s.triggerWatcher = mocks.NewMockSecretTriggerWatcher(ctrl)
 	s.secretService = mocks.NewMockSecretService(ctrl)
 	s.watcherRegistry = facademocks.NewMockWatcherRegistry(ctrl)
 
@@ -72,3 +78,48 @@ func (s *userSecretsSuite) TestDeleteRevisionsAutoPruneEnabled(c *tc.C) {
 	err := s.facade.DeleteObsoleteUserSecretRevisions(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 }
+
+func (s *userSecretsSuite) TestWatchUserSecretsRotationChanges(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	next := time.Now().Add(time.Hour)
+	s.secretService.EXPECT().WatchUserSecretsRotationChanges(gomock.Any()).Return(s.triggerWatcher, nil)
+	ch := make(chan []corewatcher.SecretTriggerChange, 1)
+	ch <- []corewatcher.SecretTriggerChange{{
+		URI:             uri,
+		Revision:        2,
+		NextTriggerTime: next,
+	}}
+	s.triggerWatcher.EXPECT().Changes().Return(ch)
+
+	s.watcherRegistry.EXPECT().Register(gomock.Any()).Return("watcher-id", nil)
+
+	result, err := s.facade.WatchUserSecretsRotationChanges(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.SecretTriggerWatchResult{
+		WatcherId: "watcher-id",
+		Changes: []params.SecretTriggerChange{{
+			URI:             uri.ID,
+			NextTriggerTime: next,
+		}},
+	})
+}
+
+func (s *userSecretsSuite) TestRotateUserSecrets(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri1 := coresecrets.NewURI()
+	uri2 := coresecrets.NewURI()
+	s.secretService.EXPECT().RotateUserSecret(gomock.Any(), uri1).Return(nil)
+	s.secretService.EXPECT().RotateUserSecret(gomock.Any(), uri2).Return(secreterrors.SecretGeneratorNotFound)
+
+	result, err := s.facade.RotateUserSecrets(c.Context(), params.SecretURIArgs{
+		Args: []params.SecretURIArg{{URI: uri1.String()}, {URI: uri2.String()}, {URI: "bad"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 3)
+	c.Assert(result.Results[0].Error, tc.IsNil)
+	c.Assert(result.Results[1].Error, tc.ErrorMatches, "secret generator not found")
+	c.Assert(result.Results[2].Error, tc.ErrorMatches, `secret URI "bad" not valid`)
+}
@@ -15,4 +15,6 @@ type SecretService interface {
 	GetSecret(ctx context.Context, uri *secrets.URI) (*secrets.SecretMetadata, error)
 	DeleteObsoleteUserSecretRevisions(ctx context.Context) error
 	WatchObsoleteUserSecretsToPrune(ctx context.Context) (watcher.NotifyWatcher, error)
+	WatchUserSecretsRotationChanges(ctx context.Context) (watcher.SecretTriggerWatcher, error)
+	RotateUserSecret(ctx context.Context, uri *secrets.URI) error
 }
@@ -130,10 +130,7 @@ func (s *SecretsDrainAPI) getSecretContent(ctx context.Context, arg params.GetSe
 		return nil, nil, false, errors.Trace(err)
 	}
 
-	val, valueRef, err := s.secretService.GetSecretValue(ctx, md.URI, md.LatestRevision, secretservice.SecretAccessor{
-		Kind: secretservice.ModelAccessor,
-		ID:   s.modelUUID.String(),
-	})
+	val, valueRef, err := s.secretService.GetSecretValueToDrain(ctx, md.URI, md.LatestRevision)
 	if err != nil {
 		return nil, nil, false, errors.Trace(err)
 	}
@@ -187,10 +184,7 @@ func (s *SecretsDrainAPI) GetSecretRevisionContentInfo(ctx context.Context, arg
 	}
 
 	for i, rev := range arg.Revisions {
-		val, valueRef, err := s.secretService.GetSecretValue(ctx, uri, rev, secretservice.SecretAccessor{
-			Kind: secretservice.ModelAccessor,
-			ID:   s.modelUUID.String(),
-		})
+		val, valueRef, err := s.secretService.GetSecretValueToDrain(ctx, uri, rev)
 		if err != nil {
 			result.Results[i].Error = apiservererrors.ServerError(err)
 			continue
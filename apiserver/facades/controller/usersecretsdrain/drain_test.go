@@ -140,10 +140,7 @@ func (s *drainSuite) TestGetSecretContentInternal(c *tc.C) {
 	val := coresecrets.NewSecretValue(data)
 	uri := coresecrets.NewURI()
 	s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(&coresecrets.SecretMetadata{URI: uri, LatestRevision: 668}, nil)
-	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 668, secretservice.SecretAccessor{
-		Kind: secretservice.ModelAccessor,
-		ID:   coretesting.ModelTag.Id(),
-	}).Return(
+	s.secretService.EXPECT().GetSecretValueToDrain(gomock.Any(), uri, 668).Return(
 		val, nil, nil,
 	)
 
@@ -165,10 +162,7 @@ func (s *drainSuite) TestGetSecretContentExternal(c *tc.C) {
 
 	uri := coresecrets.NewURI()
 	s.secretService.EXPECT().GetSecret(gomock.Any(), uri).Return(&coresecrets.SecretMetadata{URI: uri, LatestRevision: 668}, nil)
-	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 668, secretservice.SecretAccessor{
-		Kind: secretservice.ModelAccessor,
-		ID:   coretesting.ModelTag.Id(),
-	}).Return(
+	s.secretService.EXPECT().GetSecretValueToDrain(gomock.Any(), uri, 668).Return(
 		nil, &coresecrets.ValueRef{
 			BackendID:  "backend-id",
 			RevisionID: "rev-id",
@@ -232,10 +226,7 @@ func (s *drainSuite) TestGetSecretRevisionContentInfoInternal(c *tc.C) {
 	uri := coresecrets.NewURI()
 	data := map[string]string{"foo": "bar"}
 	val := coresecrets.NewSecretValue(data)
-	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secretservice.SecretAccessor{
-		Kind: secretservice.ModelAccessor,
-		ID:   coretesting.ModelTag.Id(),
-	}).Return(
+	s.secretService.EXPECT().GetSecretValueToDrain(gomock.Any(), uri, 666).Return(
 		val, nil, nil,
 	)
 
@@ -255,10 +246,7 @@ func (s *drainSuite) TestGetSecretRevisionContentInfoExternal(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secretservice.SecretAccessor{
-		Kind: secretservice.ModelAccessor,
-		ID:   coretesting.ModelTag.Id(),
-	}).Return(
+	s.secretService.EXPECT().GetSecretValueToDrain(gomock.Any(), uri, 666).Return(
 		nil, &coresecrets.ValueRef{
 			BackendID:  "backend-id",
 			RevisionID: "rev-id",
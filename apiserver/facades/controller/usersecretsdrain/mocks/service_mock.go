@@ -82,42 +82,42 @@ func (c *MockSecretServiceGetSecretCall) DoAndReturn(f func(context.Context, *se
 	return c
 }
 
-// GetSecretValue mocks base method.
-func (m *MockSecretService) GetSecretValue(arg0 context.Context, arg1 *secrets.URI, arg2 int, arg3 service.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error) {
+// GetSecretValueToDrain mocks base method.
+func (m *MockSecretService) GetSecretValueToDrain(arg0 context.Context, arg1 *secrets.URI, arg2 int) (secrets.SecretValue, *secrets.ValueRef, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSecretValue", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetSecretValueToDrain", arg0, arg1, arg2)
 	ret0, _ := ret[0].(secrets.SecretValue)
 	ret1, _ := ret[1].(*secrets.ValueRef)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetSecretValue indicates an expected call of GetSecretValue.
-func (mr *MockSecretServiceMockRecorder) GetSecretValue(arg0, arg1, arg2, arg3 any) *MockSecretServiceGetSecretValueCall {
+// GetSecretValueToDrain indicates an expected call of GetSecretValueToDrain.
+func (mr *MockSecretServiceMockRecorder) GetSecretValueToDrain(arg0, arg1, arg2 any) *MockSecretServiceGetSecretValueToDrainCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MockSecretService)(nil).GetSecretValue), arg0, arg1, arg2, arg3)
-	return &MockSecretServiceGetSecretValueCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValueToDrain", reflect.TypeOf((*MockSecretService)(nil).GetSecretValueToDrain), arg0, arg1, arg2)
+	return &MockSecretServiceGetSecretValueToDrainCall{Call: call}
 }
 
-// MockSecretServiceGetSecretValueCall wrap *gomock.Call
-type MockSecretServiceGetSecretValueCall struct {
+// MockSecretServiceGetSecretValueToDrainCall wrap *gomock.Call
+type MockSecretServiceGetSecretValueToDrainCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretServiceGetSecretValueCall) Return(arg0 secrets.SecretValue, arg1 *secrets.ValueRef, arg2 error) *MockSecretServiceGetSecretValueCall {
+func (c *MockSecretServiceGetSecretValueToDrainCall) Return(arg0 secrets.SecretValue, arg1 *secrets.ValueRef, arg2 error) *MockSecretServiceGetSecretValueToDrainCall {
 	c.Call = c.Call.Return(arg0, arg1, arg2)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretServiceGetSecretValueCall) Do(f func(context.Context, *secrets.URI, int, service.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error)) *MockSecretServiceGetSecretValueCall {
+func (c *MockSecretServiceGetSecretValueToDrainCall) Do(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, *secrets.ValueRef, error)) *MockSecretServiceGetSecretValueToDrainCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretServiceGetSecretValueCall) DoAndReturn(f func(context.Context, *secrets.URI, int, service.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error)) *MockSecretServiceGetSecretValueCall {
+func (c *MockSecretServiceGetSecretValueToDrainCall) DoAndReturn(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, *secrets.ValueRef, error)) *MockSecretServiceGetSecretValueToDrainCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,110 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	jujuerrors "github.com/juju/errors"
+
+	internalhttp "github.com/juju/juju/apiserver/internal/http"
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/internal/errors"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/rpc/params"
+)
+
+var logger = internallogger.GetLogger("juju.apiserver.backups")
+
+// BackupDirGetter is an interface that provides the directory holding the
+// backup archives created on the controller.
+type BackupDirGetter interface {
+	// BackupDir returns the backup directory for the request.
+	BackupDir(*http.Request) (string, error)
+}
+
+// BackupsHTTPHandler implements the http.Handler interface for downloading
+// backup archives.
+type BackupsHTTPHandler struct {
+	backupDirGetter BackupDirGetter
+}
+
+// NewBackupsHTTPHandler returns a new BackupsHTTPHandler.
+func NewBackupsHTTPHandler(backupDirGetter BackupDirGetter) *BackupsHTTPHandler {
+	return &BackupsHTTPHandler{
+		backupDirGetter: backupDirGetter,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *BackupsHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var err error
+	switch r.Method {
+	case "GET":
+		err = h.ServeGet(w, r)
+		if err != nil {
+			err = errors.Errorf("cannot download backup: %w", err)
+		}
+	default:
+		http.Error(w, fmt.Sprintf("http method %s not implemented", r.Method), http.StatusNotImplemented)
+		return
+	}
+
+	if err == nil {
+		return
+	}
+
+	if err := internalhttp.SendError(w, errors.Capture(err), logger); err != nil {
+		logger.Errorf(r.Context(), "%v", errors.Errorf("cannot return error to user: %w", err))
+	}
+}
+
+// ServeGet sends the backup archive identified in the request body. The
+// archive is only served from the backup directory, whatever path the
+// request identifies it by.
+func (h *BackupsHTTPHandler) ServeGet(w http.ResponseWriter, r *http.Request) error {
+	var args params.BackupsDownloadArgs
+	if err := json.NewDecoder(r.Body).Decode(&args); err != nil {
+		return jujuerrors.BadRequestf("decoding request body: %v", err)
+	}
+
+	filename := filepath.Base(args.ID)
+	if !strings.HasPrefix(filename, corebackups.FilenamePrefix) {
+		return jujuerrors.BadRequestf("invalid backup filename %q", args.ID)
+	}
+
+	backupDir, err := h.backupDirGetter.BackupDir(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	archive, err := os.Open(filepath.Join(backupDir, filename))
+	if os.IsNotExist(err) {
+		return jujuerrors.NotFoundf("backup %q", filename)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	info, err := archive.Stat()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	w.Header().Set("Content-Type", params.ContentTypeRaw)
+	w.Header().Set("Content-Length", strconv.FormatInt(info.Size(), 10))
+	if _, err := io.Copy(w, archive); err != nil {
+		// Having begun writing, it is too late to send an error response
+		// here, so just log it.
+		logger.Errorf(r.Context(), "sending backup %q: %v", filename, err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/internal/testing"
+)
+
+const (
+	backupsRoutePrefix = "/model-:modeluuid/backups"
+)
+
+type backupsHandlerSuite struct {
+	backupDirGetter *MockBackupDirGetter
+
+	backupDir string
+
+	mux *apiserverhttp.Mux
+	srv *httptest.Server
+}
+
+func TestBackupsHandlerSuite(t *stdtesting.T) {
+	tc.Run(t, &backupsHandlerSuite{})
+}
+
+func (s *backupsHandlerSuite) SetUpTest(c *tc.C) {
+	s.backupDir = c.MkDir()
+	s.mux = apiserverhttp.NewMux()
+	s.srv = httptest.NewServer(s.mux)
+}
+
+func (s *backupsHandlerSuite) TearDownTest(c *tc.C) {
+	s.srv.Close()
+}
+
+func (s *backupsHandlerSuite) get(c *tc.C, body string) *http.Response {
+	handler := NewBackupsHTTPHandler(s.backupDirGetter)
+	s.mux.AddHandler("GET", backupsRoutePrefix, handler)
+	c.Cleanup(func() { s.mux.RemoveHandler("GET", backupsRoutePrefix) })
+
+	url := fmt.Sprintf("%s/model-%s/backups", s.srv.URL, testing.ModelTag.Id())
+	req, err := http.NewRequest("GET", url, strings.NewReader(body))
+	c.Assert(err, tc.ErrorIsNil)
+	resp, err := http.DefaultClient.Do(req)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func (s *backupsHandlerSuite) TestServeGet(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := os.WriteFile(filepath.Join(s.backupDir, "juju-backup-20250102-030405.tar.gz"), []byte("archive"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	s.backupDirGetter.EXPECT().BackupDir(gomock.Any()).Return(s.backupDir, nil)
+
+	resp := s.get(c, `{"id":"/var/lib/juju/backups/juju-backup-20250102-030405.tar.gz"}`)
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+	c.Check(resp.Header.Get("Content-Length"), tc.Equals, "7")
+
+	data, err := io.ReadAll(resp.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "archive")
+}
+
+func (s *backupsHandlerSuite) TestServeGetNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.backupDirGetter.EXPECT().BackupDir(gomock.Any()).Return(s.backupDir, nil)
+
+	resp := s.get(c, `{"id":"juju-backup-20250102-030405.tar.gz"}`)
+	c.Check(resp.StatusCode, tc.Equals, http.StatusNotFound)
+}
+
+func (s *backupsHandlerSuite) TestServeGetInvalidFilename(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	resp := s.get(c, `{"id":"/etc/passwd"}`)
+	c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest)
+}
+
+func (s *backupsHandlerSuite) TestServeGetInvalidBody(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	resp := s.get(c, `not json`)
+	c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest)
+}
+
+func (s *backupsHandlerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.backupDirGetter = NewMockBackupDirGetter(ctrl)
+
+	c.Cleanup(func() {
+		s.backupDirGetter = nil
+	})
+
+	return ctrl
+}
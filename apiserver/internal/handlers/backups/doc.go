@@ -0,0 +1,7 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package backups provides the handlers for downloading controller backups,
+// and for exporting and importing snapshots of a model database.
+
+package backups
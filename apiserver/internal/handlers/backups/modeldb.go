@@ -0,0 +1,151 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/juju/clock"
+	jujuerrors "github.com/juju/errors"
+
+	internalhttp "github.com/juju/juju/apiserver/internal/http"
+	"github.com/juju/juju/core/objectstore"
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	"github.com/juju/juju/internal/backups"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ModelDBService exports and imports snapshots of a model database.
+type ModelDBService interface {
+	backups.ModelExporter
+	backups.ModelImporter
+}
+
+// ModelDBServiceGetter is an interface that provides the services for the
+// model of the request.
+type ModelDBServiceGetter interface {
+	// ModelDB returns the service for exporting and importing the database
+	// of the request's model.
+	ModelDB(*http.Request) (ModelDBService, error)
+
+	// ObjectStore returns the object store of the request's model.
+	ObjectStore(*http.Request) (objectstore.ObjectStore, error)
+}
+
+// ModelDBHTTPHandler implements the http.Handler interface for exporting and
+// importing snapshots of a model database.
+type ModelDBHTTPHandler struct {
+	serviceGetter ModelDBServiceGetter
+	clock         clock.Clock
+}
+
+// NewModelDBHTTPHandler returns a new ModelDBHTTPHandler.
+func NewModelDBHTTPHandler(serviceGetter ModelDBServiceGetter, clock clock.Clock) *ModelDBHTTPHandler {
+	return &ModelDBHTTPHandler{
+		serviceGetter: serviceGetter,
+		clock:         clock,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *ModelDBHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var err error
+	switch r.Method {
+	case "GET":
+		err = h.ServeGet(w, r)
+		if err != nil {
+			err = errors.Errorf("cannot export model database: %w", err)
+		}
+	case "PUT":
+		err = h.ServePut(w, r)
+		if err != nil {
+			err = errors.Errorf("cannot import model database: %w", err)
+		}
+	default:
+		http.Error(w, fmt.Sprintf("http method %s not implemented", r.Method), http.StatusNotImplemented)
+		return
+	}
+
+	if err == nil {
+		return
+	}
+
+	if err := internalhttp.SendError(w, errors.Capture(err), logger); err != nil {
+		logger.Errorf(r.Context(), "%v", errors.Errorf("cannot return error to user: %w", err))
+	}
+}
+
+// ServeGet sends a snapshot of the model's database and of the objects it
+// references. The snapshot is written to a temporary file before it is sent,
+// so that any error taking it can be reported to the client.
+func (h *ModelDBHTTPHandler) ServeGet(w http.ResponseWriter, r *http.Request) error {
+	service, err := h.serviceGetter.ModelDB(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	store, err := h.serviceGetter.ObjectStore(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	f, err := os.CreateTemp("", "juju-model-db-")
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	if err := backups.ExportModel(r.Context(), service, store, h.clock, f); err != nil {
+		return errors.Capture(err)
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return errors.Capture(err)
+	}
+
+	w.Header().Set("Content-Type", params.ContentTypeRaw)
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	if _, err := io.Copy(w, f); err != nil {
+		// Having begun writing, it is too late to send an error response
+		// here, so just log it.
+		logger.Errorf(r.Context(), "sending model database snapshot: %v", err)
+	}
+	return nil
+}
+
+// ServePut imports the snapshot in the request body into the model, which
+// must not have any applications or machines.
+func (h *ModelDBHTTPHandler) ServePut(w http.ResponseWriter, r *http.Request) error {
+	if contentType := r.Header.Get("Content-Type"); contentType != params.ContentTypeRaw {
+		return jujuerrors.BadRequestf("expected Content-Type: %s, got: %s", params.ContentTypeRaw, contentType)
+	}
+
+	service, err := h.serviceGetter.ModelDB(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	store, err := h.serviceGetter.ObjectStore(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = backups.ImportModel(r.Context(), service, store, r.Body)
+	if errors.IsOneOf(err, backuperrors.IncompatibleSchema, backuperrors.ModelNotEmpty) {
+		return jujuerrors.NewBadRequest(err, "")
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+
+	return errors.Capture(internalhttp.SendStatusAndJSON(w, http.StatusOK, &params.ErrorResult{}))
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	stdtesting "testing"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/domain/backup"
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	"github.com/juju/juju/internal/backups"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	modelDBRoutePrefix = "/model-:modeluuid/db-snapshot"
+)
+
+type modelDBHandlerSuite struct {
+	serviceGetter *MockModelDBServiceGetter
+	service       *MockModelDBService
+	objectStore   *MockObjectStore
+
+	mux *apiserverhttp.Mux
+	srv *httptest.Server
+}
+
+func TestModelDBHandlerSuite(t *stdtesting.T) {
+	tc.Run(t, &modelDBHandlerSuite{})
+}
+
+func (s *modelDBHandlerSuite) SetUpTest(c *tc.C) {
+	s.mux = apiserverhttp.NewMux()
+	s.srv = httptest.NewServer(s.mux)
+}
+
+func (s *modelDBHandlerSuite) TearDownTest(c *tc.C) {
+	s.srv.Close()
+}
+
+func (s *modelDBHandlerSuite) snapshot() backup.ModelSnapshot {
+	return backup.ModelSnapshot{
+		ModelUUID:     "model-uuid",
+		SchemaVersion: 42,
+		SchemaHash:    "hash",
+	}
+}
+
+func (s *modelDBHandlerSuite) do(c *tc.C, method, contentType string, body io.Reader) *http.Response {
+	handler := NewModelDBHTTPHandler(s.serviceGetter, clock.WallClock)
+	s.mux.AddHandler(method, modelDBRoutePrefix, handler)
+	c.Cleanup(func() { s.mux.RemoveHandler(method, modelDBRoutePrefix) })
+
+	url := fmt.Sprintf("%s/model-%s/db-snapshot", s.srv.URL, testing.ModelTag.Id())
+	req, err := http.NewRequest(method, url, body)
+	c.Assert(err, tc.ErrorIsNil)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func (s *modelDBHandlerSuite) expectServices() {
+	s.serviceGetter.EXPECT().ModelDB(gomock.Any()).Return(s.service, nil)
+	s.serviceGetter.EXPECT().ObjectStore(gomock.Any()).Return(s.objectStore, nil)
+}
+
+func (s *modelDBHandlerSuite) expectExport() {
+	s.service.EXPECT().ExportModel(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, w io.Writer) (backup.ModelSnapshot, error) {
+		_, err := io.WriteString(w, "model dump\n")
+		return s.snapshot(), err
+	})
+}
+
+func (s *modelDBHandlerSuite) TestServeGet(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectServices()
+	s.expectExport()
+
+	resp := s.do(c, "GET", "", nil)
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+	c.Check(resp.Header.Get("Content-Type"), tc.Equals, params.ContentTypeRaw)
+
+	gzr, err := gzip.NewReader(resp.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	tr := tar.NewReader(gzr)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, tc.ErrorIsNil)
+		names = append(names, hdr.Name)
+	}
+	c.Check(names, tc.DeepEquals, []string{"metadata.json", "model.jsonl"})
+}
+
+func (s *modelDBHandlerSuite) archive(c *tc.C) []byte {
+	s.expectExport()
+
+	var buf bytes.Buffer
+	err := backups.ExportModel(c.Context(), s.service, s.objectStore, clock.WallClock, &buf)
+	c.Assert(err, tc.ErrorIsNil)
+	return buf.Bytes()
+}
+
+func (s *modelDBHandlerSuite) TestServePut(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	archive := s.archive(c)
+	s.expectServices()
+	s.service.EXPECT().CheckModelImport(gomock.Any(), s.snapshot()).Return(nil)
+	s.service.EXPECT().ImportModel(gomock.Any(), s.snapshot(), gomock.Any(), gomock.Any()).Return(nil)
+
+	resp := s.do(c, "PUT", params.ContentTypeRaw, bytes.NewReader(archive))
+	c.Check(resp.StatusCode, tc.Equals, http.StatusOK)
+}
+
+func (s *modelDBHandlerSuite) TestServePutModelNotEmpty(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	archive := s.archive(c)
+	s.expectServices()
+	s.service.EXPECT().CheckModelImport(gomock.Any(), s.snapshot()).Return(nil)
+	s.service.EXPECT().ImportModel(gomock.Any(), s.snapshot(), gomock.Any(), gomock.Any()).Return(backuperrors.ModelNotEmpty)
+
+	resp := s.do(c, "PUT", params.ContentTypeRaw, bytes.NewReader(archive))
+	c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest)
+}
+
+func (s *modelDBHandlerSuite) TestServePutIncompatibleSchema(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	archive := s.archive(c)
+	s.expectServices()
+	s.service.EXPECT().CheckModelImport(gomock.Any(), s.snapshot()).Return(backuperrors.IncompatibleSchema)
+
+	resp := s.do(c, "PUT", params.ContentTypeRaw, bytes.NewReader(archive))
+	c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest)
+}
+
+func (s *modelDBHandlerSuite) TestServePutInvalidContentType(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	resp := s.do(c, "PUT", params.ContentTypeJSON, bytes.NewReader(nil))
+	c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest)
+}
+
+func (s *modelDBHandlerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.serviceGetter = NewMockModelDBServiceGetter(ctrl)
+	s.service = NewMockModelDBService(ctrl)
+	s.objectStore = NewMockObjectStore(ctrl)
+
+	c.Cleanup(func() {
+		s.serviceGetter = nil
+		s.service = nil
+		s.objectStore = nil
+	})
+
+	return ctrl
+}
@@ -0,0 +1,7 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package backups -destination service_mock_test.go github.com/juju/juju/apiserver/internal/handlers/backups BackupDirGetter,ModelDBServiceGetter,ModelDBService
+//go:generate go run go.uber.org/mock/mockgen -typed -package backups -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStore
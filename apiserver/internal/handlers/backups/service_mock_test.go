@@ -0,0 +1,322 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/internal/handlers/backups (interfaces: BackupDirGetter,ModelDBServiceGetter,ModelDBService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package backups -destination service_mock_test.go github.com/juju/juju/apiserver/internal/handlers/backups BackupDirGetter,ModelDBServiceGetter,ModelDBService
+//
+
+// Package backups is a generated GoMock package.
+package backups
+
+import (
+	context "context"
+	io "io"
+	http "net/http"
+	reflect "reflect"
+
+	objectstore "github.com/juju/juju/core/objectstore"
+	backup "github.com/juju/juju/domain/backup"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBackupDirGetter is a mock of BackupDirGetter interface.
+type MockBackupDirGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupDirGetterMockRecorder
+}
+
+// MockBackupDirGetterMockRecorder is the mock recorder for MockBackupDirGetter.
+type MockBackupDirGetterMockRecorder struct {
+	mock *MockBackupDirGetter
+}
+
+// NewMockBackupDirGetter creates a new mock instance.
+func NewMockBackupDirGetter(ctrl *gomock.Controller) *MockBackupDirGetter {
+	mock := &MockBackupDirGetter{ctrl: ctrl}
+	mock.recorder = &MockBackupDirGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupDirGetter) EXPECT() *MockBackupDirGetterMockRecorder {
+	return m.recorder
+}
+
+// BackupDir mocks base method.
+func (m *MockBackupDirGetter) BackupDir(arg0 *http.Request) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupDir", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupDir indicates an expected call of BackupDir.
+func (mr *MockBackupDirGetterMockRecorder) BackupDir(arg0 any) *MockBackupDirGetterBackupDirCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupDir", reflect.TypeOf((*MockBackupDirGetter)(nil).BackupDir), arg0)
+	return &MockBackupDirGetterBackupDirCall{Call: call}
+}
+
+// MockBackupDirGetterBackupDirCall wrap *gomock.Call
+type MockBackupDirGetterBackupDirCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackupDirGetterBackupDirCall) Return(arg0 string, arg1 error) *MockBackupDirGetterBackupDirCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackupDirGetterBackupDirCall) Do(f func(*http.Request) (string, error)) *MockBackupDirGetterBackupDirCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackupDirGetterBackupDirCall) DoAndReturn(f func(*http.Request) (string, error)) *MockBackupDirGetterBackupDirCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelDBServiceGetter is a mock of ModelDBServiceGetter interface.
+type MockModelDBServiceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelDBServiceGetterMockRecorder
+}
+
+// MockModelDBServiceGetterMockRecorder is the mock recorder for MockModelDBServiceGetter.
+type MockModelDBServiceGetterMockRecorder struct {
+	mock *MockModelDBServiceGetter
+}
+
+// NewMockModelDBServiceGetter creates a new mock instance.
+func NewMockModelDBServiceGetter(ctrl *gomock.Controller) *MockModelDBServiceGetter {
+	mock := &MockModelDBServiceGetter{ctrl: ctrl}
+	mock.recorder = &MockModelDBServiceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelDBServiceGetter) EXPECT() *MockModelDBServiceGetterMockRecorder {
+	return m.recorder
+}
+
+// ModelDB mocks base method.
+func (m *MockModelDBServiceGetter) ModelDB(arg0 *http.Request) (ModelDBService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelDB", arg0)
+	ret0, _ := ret[0].(ModelDBService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelDB indicates an expected call of ModelDB.
+func (mr *MockModelDBServiceGetterMockRecorder) ModelDB(arg0 any) *MockModelDBServiceGetterModelDBCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelDB", reflect.TypeOf((*MockModelDBServiceGetter)(nil).ModelDB), arg0)
+	return &MockModelDBServiceGetterModelDBCall{Call: call}
+}
+
+// MockModelDBServiceGetterModelDBCall wrap *gomock.Call
+type MockModelDBServiceGetterModelDBCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBServiceGetterModelDBCall) Return(arg0 ModelDBService, arg1 error) *MockModelDBServiceGetterModelDBCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBServiceGetterModelDBCall) Do(f func(*http.Request) (ModelDBService, error)) *MockModelDBServiceGetterModelDBCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBServiceGetterModelDBCall) DoAndReturn(f func(*http.Request) (ModelDBService, error)) *MockModelDBServiceGetterModelDBCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ObjectStore mocks base method.
+func (m *MockModelDBServiceGetter) ObjectStore(arg0 *http.Request) (objectstore.ObjectStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStore", arg0)
+	ret0, _ := ret[0].(objectstore.ObjectStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectStore indicates an expected call of ObjectStore.
+func (mr *MockModelDBServiceGetterMockRecorder) ObjectStore(arg0 any) *MockModelDBServiceGetterObjectStoreCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStore", reflect.TypeOf((*MockModelDBServiceGetter)(nil).ObjectStore), arg0)
+	return &MockModelDBServiceGetterObjectStoreCall{Call: call}
+}
+
+// MockModelDBServiceGetterObjectStoreCall wrap *gomock.Call
+type MockModelDBServiceGetterObjectStoreCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBServiceGetterObjectStoreCall) Return(arg0 objectstore.ObjectStore, arg1 error) *MockModelDBServiceGetterObjectStoreCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBServiceGetterObjectStoreCall) Do(f func(*http.Request) (objectstore.ObjectStore, error)) *MockModelDBServiceGetterObjectStoreCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBServiceGetterObjectStoreCall) DoAndReturn(f func(*http.Request) (objectstore.ObjectStore, error)) *MockModelDBServiceGetterObjectStoreCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelDBService is a mock of ModelDBService interface.
+type MockModelDBService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelDBServiceMockRecorder
+}
+
+// MockModelDBServiceMockRecorder is the mock recorder for MockModelDBService.
+type MockModelDBServiceMockRecorder struct {
+	mock *MockModelDBService
+}
+
+// NewMockModelDBService creates a new mock instance.
+func NewMockModelDBService(ctrl *gomock.Controller) *MockModelDBService {
+	mock := &MockModelDBService{ctrl: ctrl}
+	mock.recorder = &MockModelDBServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelDBService) EXPECT() *MockModelDBServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckModelImport mocks base method.
+func (m *MockModelDBService) CheckModelImport(arg0 context.Context, arg1 backup.ModelSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckModelImport", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckModelImport indicates an expected call of CheckModelImport.
+func (mr *MockModelDBServiceMockRecorder) CheckModelImport(arg0, arg1 any) *MockModelDBServiceCheckModelImportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckModelImport", reflect.TypeOf((*MockModelDBService)(nil).CheckModelImport), arg0, arg1)
+	return &MockModelDBServiceCheckModelImportCall{Call: call}
+}
+
+// MockModelDBServiceCheckModelImportCall wrap *gomock.Call
+type MockModelDBServiceCheckModelImportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBServiceCheckModelImportCall) Return(arg0 error) *MockModelDBServiceCheckModelImportCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBServiceCheckModelImportCall) Do(f func(context.Context, backup.ModelSnapshot) error) *MockModelDBServiceCheckModelImportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBServiceCheckModelImportCall) DoAndReturn(f func(context.Context, backup.ModelSnapshot) error) *MockModelDBServiceCheckModelImportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportModel mocks base method.
+func (m *MockModelDBService) ExportModel(arg0 context.Context, arg1 io.Writer) (backup.ModelSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportModel", arg0, arg1)
+	ret0, _ := ret[0].(backup.ModelSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportModel indicates an expected call of ExportModel.
+func (mr *MockModelDBServiceMockRecorder) ExportModel(arg0, arg1 any) *MockModelDBServiceExportModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportModel", reflect.TypeOf((*MockModelDBService)(nil).ExportModel), arg0, arg1)
+	return &MockModelDBServiceExportModelCall{Call: call}
+}
+
+// MockModelDBServiceExportModelCall wrap *gomock.Call
+type MockModelDBServiceExportModelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBServiceExportModelCall) Return(arg0 backup.ModelSnapshot, arg1 error) *MockModelDBServiceExportModelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBServiceExportModelCall) Do(f func(context.Context, io.Writer) (backup.ModelSnapshot, error)) *MockModelDBServiceExportModelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBServiceExportModelCall) DoAndReturn(f func(context.Context, io.Writer) (backup.ModelSnapshot, error)) *MockModelDBServiceExportModelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportModel mocks base method.
+func (m *MockModelDBService) ImportModel(arg0 context.Context, arg1 backup.ModelSnapshot, arg2 io.ReadSeeker, arg3 map[objectstore.UUID]objectstore.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportModel", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportModel indicates an expected call of ImportModel.
+func (mr *MockModelDBServiceMockRecorder) ImportModel(arg0, arg1, arg2, arg3 any) *MockModelDBServiceImportModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportModel", reflect.TypeOf((*MockModelDBService)(nil).ImportModel), arg0, arg1, arg2, arg3)
+	return &MockModelDBServiceImportModelCall{Call: call}
+}
+
+// MockModelDBServiceImportModelCall wrap *gomock.Call
+type MockModelDBServiceImportModelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBServiceImportModelCall) Return(arg0 error) *MockModelDBServiceImportModelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBServiceImportModelCall) Do(f func(context.Context, backup.ModelSnapshot, io.ReadSeeker, map[objectstore.UUID]objectstore.UUID) error) *MockModelDBServiceImportModelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBServiceImportModelCall) DoAndReturn(f func(context.Context, backup.ModelSnapshot, io.ReadSeeker, map[objectstore.UUID]objectstore.UUID) error) *MockModelDBServiceImportModelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
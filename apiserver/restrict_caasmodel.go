@@ -21,6 +21,8 @@ var commonModelFacadeNames = set.NewStrings(
 	"Annotations",
 	"Application",
 	"Block",
+	"ChangeFeed",
+	"ChangeFeedWatcher",
 	"CharmDownloader",
 	"CharmRevisionUpdater",
 	"Charms",
@@ -56,11 +58,13 @@ var commonModelFacadeNames = set.NewStrings(
 	"RelationUnitsWatcher",
 	"ResourcesHookContext",
 	"RemoteRelations",
+	"Removal",
 	"Resumer",
 	"RetryStrategy",
 	"Secrets",
 	"SecretsManager",
 	"SecretsDrain",
+	"SecretsMigration",
 	"UserSecretsDrain",
 	"SecretBackendsManager",
 	"SecretBackendsRotateWatcher",
@@ -16,6 +16,7 @@ import (
 var controllerFacadeNames = set.NewStrings(
 	"AllModelWatcher",
 	"ApplicationOffers",
+	"AuditLog",
 	"Cloud",
 	"Controller",
 	"CrossController",
@@ -23,6 +24,7 @@ var controllerFacadeNames = set.NewStrings(
 	"ModelManager",
 	"ModelUpgrader",
 	"ModelSummaryWatcher",
+	"ObjectStore",
 	"SecretBackends",
 	"UserManager",
 )
@@ -30,6 +30,7 @@ import (
 	"github.com/juju/juju/core/trace"
 	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/core/watcher/registry"
+	"github.com/juju/juju/domain/blockcommand"
 	domainmodelmigration "github.com/juju/juju/domain/modelmigration"
 	"github.com/juju/juju/internal/migration"
 	"github.com/juju/juju/internal/rpcreflect"
@@ -368,6 +369,7 @@ func (r *apiHandler) EntityHasPermission(
 type srvCaller struct {
 	objMethod rpcreflect.ObjMethod
 	creator   func(ctx context.Context, id string) (reflect.Value, error)
+	operation blockcommand.Operation
 }
 
 // ParamsType defines the parameters that should be supplied to this function.
@@ -385,6 +387,10 @@ func (s *srvCaller) ResultType() reflect.Type {
 // Call takes the object Id and an instance of ParamsType to create an object and place
 // a call on its method. It then returns an instance of ResultType.
 func (s *srvCaller) Call(ctx context.Context, objId string, arg reflect.Value) (reflect.Value, error) {
+	// Describe the call in the context, so that command blocks scoped to
+	// particular methods or exempting particular users can be applied.
+	ctx = blockcommand.WithOperation(ctx, s.operation)
+
 	objVal, err := s.creator(ctx, objId)
 	if err != nil {
 		return reflect.Value{}, err
@@ -623,9 +629,32 @@ func (r *apiRoot) FindMethod(rootName string, version int, methodName string) (r
 	return &srvCaller{
 		creator:   creator,
 		objMethod: objMethod,
+		operation: r.blockOperation(rootName, methodName),
 	}, nil
 }
 
+// blockOperation describes a call to the given facade method by the
+// authenticated entity, for checking against command blocks.
+func (r *apiRoot) blockOperation(rootName, methodName string) blockcommand.Operation {
+	op := blockcommand.Operation{
+		Method: rootName + "." + methodName,
+	}
+	if r.authorizer == nil {
+		return op
+	}
+	userTag, ok := r.authorizer.GetAuthTag().(names.UserTag)
+	if !ok {
+		return op
+	}
+
+	op.User = userTag.Id()
+	modelTag := names.NewModelTag(r.modelUUID.String())
+	op.HasAccess = func(ctx context.Context, access permission.Access) bool {
+		return r.authorizer.HasPermission(ctx, access, modelTag) == nil
+	}
+	return op
+}
+
 func (r *apiRoot) lookupMethod(rootName string, version int, methodName string) (reflect.Type, rpcreflect.ObjMethod, error) {
 	goType, err := r.facades.GetType(rootName, version)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/juju/juju/apiserver"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/pinger"
+	"github.com/juju/juju/domain/blockcommand"
 	"github.com/juju/juju/internal/rpcreflect"
 	"github.com/juju/juju/internal/testing"
 )
@@ -246,6 +247,26 @@ func (r *rootSuite) TestFindMethodCachesFacades(c *tc.C) {
 	c.Check(count, tc.Equals, int64(2))
 }
 
+type operationType struct{}
+
+func (operationType) Describe(ctx context.Context) stringVar {
+	op, _ := blockcommand.OperationFromContext(ctx)
+	return stringVar{op.Method}
+}
+
+func (r *rootSuite) TestFindMethodDescribesBlockOperation(c *tc.C) {
+	registry := new(facade.Registry)
+	newOperation := func(context.Context, facade.ModelContext) (facade.Facade, error) {
+		return &operationType{}, nil
+	}
+	registry.MustRegister("my-operation-facade", 0, newOperation, reflect.TypeOf((*operationType)(nil)))
+	srvRoot := apiserver.TestingAPIRoot(registry)
+
+	caller, err := srvRoot.FindMethod("my-operation-facade", 0, "Describe")
+	c.Assert(err, tc.ErrorIsNil)
+	assertCallResult(c, caller, "", "my-operation-facade.Describe")
+}
+
 func (r *rootSuite) TestFindMethodForMultiModelCachesFacades(c *tc.C) {
 	registry := new(facade.Registry)
 	var count int64
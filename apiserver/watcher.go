@@ -13,6 +13,7 @@ import (
 	"github.com/juju/juju/apiserver/common/storagecommon"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/facades/client/changefeed"
 	"github.com/juju/juju/apiserver/internal"
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/migration"
@@ -705,6 +706,44 @@ func (w *srvSecretBackendsRotateWatcher) translateChanges(changes []corewatcher.
 	return result
 }
 
+// srvChangeFeedWatcher defines the API wrapping a ChangesWatcher.
+type srvChangeFeedWatcher struct {
+	watcherCommon
+	watcher corewatcher.ChangesWatcher
+}
+
+func newChangeFeedWatcher(_ context.Context, context facade.ModelContext) (facade.Facade, error) {
+	auth := context.Auth()
+	if !isAgentOrUser(auth) {
+		return nil, apiservererrors.ErrPerm
+	}
+	w, err := GetWatcherByID(context.WatcherRegistry(), context.Resources(), context.ID())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	watcher, ok := w.(corewatcher.ChangesWatcher)
+	if !ok {
+		return nil, apiservererrors.ErrUnknownWatcher
+	}
+	return &srvChangeFeedWatcher{
+		watcherCommon: newWatcherCommon(context),
+		watcher:       watcher,
+	}, nil
+}
+
+// Next returns when a change has occurred to the change feed being watched
+// since the most recent call to Next or the WatchChanges call that created
+// the srvChangeFeedWatcher.
+func (w *srvChangeFeedWatcher) Next(ctx context.Context) (params.ChangeFeedWatchResult, error) {
+	changes, err := internal.FirstResult[[]corewatcher.ChangeEvent](ctx, w.watcher)
+	if err != nil {
+		return params.ChangeFeedWatchResult{}, errors.Trace(err)
+	}
+	return params.ChangeFeedWatchResult{
+		Changes: changefeed.TranslateChanges(changes),
+	}, nil
+}
+
 type secretService interface {
 	GetSecret(ctx context.Context, uri *coresecrets.URI) (*coresecrets.SecretMetadata, error)
 }
@@ -38,6 +38,7 @@ func (*importSuite) TestImports(c *tc.C) {
 		"cmd/containeragent/utils",
 		"controller",
 		"core/arch",
+		"core/auditlog",
 		"core/backups",
 		"core/base",
 		"core/charm/metrics",
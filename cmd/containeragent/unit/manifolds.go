@@ -390,6 +390,7 @@ func Manifolds(config manifoldsConfig) dependency.Manifolds {
 			Sidecar:                      true,
 			EnforcedCharmModifiedVersion: config.CharmModifiedVersion,
 			ContainerNames:               config.ContainerNames,
+			PrometheusRegisterer:         config.PrometheusRegisterer,
 		}))),
 
 		traceName: trace.Manifold(trace.ManifoldConfig{
@@ -0,0 +1,264 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/application"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+const (
+	hookSchedulesSummary = `Gets, sets, or resets the hook schedules of an application.`
+	hookSchedulesDetails = `
+Charms may declare named schedules in their metadata, each with a cron
+expression and an optional jitter. The units of the application run the
+scheduled hook whenever one of its schedules is due, delayed by a random
+duration of up to the jitter. Cron expressions are evaluated in UTC.
+
+With only an application name, the schedules of the application are shown,
+along with whether each is as declared by the charm or has been overridden.
+
+The cron expression and jitter of a schedule may be overridden with
+<schedule>.cron=<expression> and <schedule>.jitter=<duration> arguments.
+Overrides are kept when the charm is refreshed, as long as the new charm still
+declares the schedule.
+
+The --reset flag reverts one or more schedules to those declared by the charm.
+`
+	hookSchedulesExamples = `
+To view the hook schedules of an application:
+
+    juju hook-schedules postgresql
+
+To run the nightly-backup schedule at 01:30, with up to 15 minutes of jitter:
+
+    juju hook-schedules postgresql nightly-backup.cron="30 1 * * *" nightly-backup.jitter=15m
+
+To revert the nightly-backup schedule to the one declared by the charm:
+
+    juju hook-schedules postgresql --reset nightly-backup
+`
+)
+
+const (
+	hookScheduleSourceCharm = "charm"
+	hookScheduleSourceUser  = "user"
+)
+
+// ApplicationHookSchedulesAPI is used to get and override the hook schedules
+// of applications.
+type ApplicationHookSchedulesAPI interface {
+	Close() error
+	HookSchedules(ctx context.Context, application string) ([]application.HookSchedule, error)
+	SetHookSchedules(ctx context.Context, application string, overrides map[string]application.HookScheduleOverride) error
+	UnsetHookSchedules(ctx context.Context, application string, scheduleNames []string) error
+}
+
+// NewHookSchedulesCommand returns a command used to get, set and reset the
+// hook schedules of an application.
+func NewHookSchedulesCommand() modelcmd.ModelCommand {
+	return modelcmd.Wrap(&hookSchedulesCommand{})
+}
+
+type hookSchedulesCommand struct {
+	modelcmd.ModelCommandBase
+	api ApplicationHookSchedulesAPI
+	out cmd.Output
+
+	applicationName string
+	overrides       map[string]application.HookScheduleOverride
+	resetList       string
+	reset           []string
+}
+
+// Info is part of the cmd.Command interface.
+func (c *hookSchedulesCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "hook-schedules",
+		Args:     "<application name> [--reset <schedule>[,<schedule>]] [<schedule>.cron=<expression>] [<schedule>.jitter=<duration>] ...",
+		Purpose:  hookSchedulesSummary,
+		Doc:      hookSchedulesDetails,
+		Examples: hookSchedulesExamples,
+		SeeAlso: []string{
+			"config",
+		},
+	})
+}
+
+// SetFlags is part of the cmd.Command interface.
+func (c *hookSchedulesCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatHookSchedulesTabular,
+	})
+	f.StringVar(&c.resetList, "reset", "", "Reset the provided comma delimited schedules to those declared by the charm")
+}
+
+// Init is part of the cmd.Command interface.
+func (c *hookSchedulesCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no application name specified")
+	}
+	if !names.IsValidApplication(args[0]) {
+		return errors.Errorf("invalid application name %q", args[0])
+	}
+	c.applicationName, args = args[0], args[1:]
+
+	c.reset = splitCommaDelimitedList(c.resetList)
+	c.overrides = make(map[string]application.HookScheduleOverride)
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return errors.Errorf("expected <schedule>.cron=<expression> or <schedule>.jitter=<duration>, got %q", arg)
+		}
+		name, field, ok := strings.Cut(key, ".")
+		if !ok || name == "" {
+			return errors.Errorf("expected <schedule>.cron or <schedule>.jitter, got %q", key)
+		}
+
+		override := c.overrides[name]
+		switch field {
+		case "cron":
+			override.Cron = &value
+		case "jitter":
+			jitter, err := time.ParseDuration(value)
+			if err != nil {
+				return errors.Errorf("invalid jitter for schedule %q: %v", name, err)
+			}
+			if jitter < 0 {
+				return errors.Errorf("invalid jitter for schedule %q: %v cannot be negative", name, jitter)
+			}
+			override.Jitter = &jitter
+		default:
+			return errors.Errorf("unknown schedule field %q, expected cron or jitter", field)
+		}
+		c.overrides[name] = override
+	}
+
+	for _, name := range c.reset {
+		if _, ok := c.overrides[name]; ok {
+			return errors.Errorf("cannot both set and reset schedule %q", name)
+		}
+	}
+	return nil
+}
+
+func (c *hookSchedulesCommand) getAPI(ctx context.Context) (ApplicationHookSchedulesAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return application.NewClient(root), nil
+}
+
+// Run is part of the cmd.Command interface.
+func (c *hookSchedulesCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	if len(c.overrides) == 0 && len(c.reset) == 0 {
+		return c.showHookSchedules(ctx, client)
+	}
+
+	if len(c.reset) > 0 {
+		err := client.UnsetHookSchedules(ctx, c.applicationName, c.reset)
+		if err != nil {
+			return errors.Trace(block.ProcessBlockedError(err, block.BlockChange))
+		}
+	}
+	if len(c.overrides) > 0 {
+		err := client.SetHookSchedules(ctx, c.applicationName, c.overrides)
+		if err != nil {
+			return errors.Trace(block.ProcessBlockedError(err, block.BlockChange))
+		}
+	}
+	return nil
+}
+
+// HookScheduleInfo is the serialised form of a hook schedule.
+type HookScheduleInfo struct {
+	Cron        string `json:"cron" yaml:"cron"`
+	Jitter      string `json:"jitter,omitempty" yaml:"jitter,omitempty"`
+	Source      string `json:"source" yaml:"source"`
+	CharmCron   string `json:"charm-cron,omitempty" yaml:"charm-cron,omitempty"`
+	CharmJitter string `json:"charm-jitter,omitempty" yaml:"charm-jitter,omitempty"`
+}
+
+func (c *hookSchedulesCommand) showHookSchedules(ctx *cmd.Context, client ApplicationHookSchedulesAPI) error {
+	schedules, err := client.HookSchedules(ctx, c.applicationName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	infos := make(map[string]HookScheduleInfo, len(schedules))
+	for _, s := range schedules {
+		info := HookScheduleInfo{
+			Cron:   s.Cron,
+			Jitter: formatJitter(s.Jitter),
+			Source: hookScheduleSourceCharm,
+		}
+		if s.Cron != s.CharmCron || s.Jitter != s.CharmJitter {
+			info.Source = hookScheduleSourceUser
+			info.CharmCron = s.CharmCron
+			info.CharmJitter = formatJitter(s.CharmJitter)
+		}
+		infos[s.Name] = info
+	}
+	return c.out.Write(ctx, infos)
+}
+
+func formatJitter(jitter time.Duration) string {
+	if jitter == 0 {
+		return ""
+	}
+	return jitter.String()
+}
+
+func formatHookSchedulesTabular(writer io.Writer, value interface{}) error {
+	schedules, ok := value.(map[string]HookScheduleInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", schedules, value)
+	}
+	if len(schedules) == 0 {
+		fmt.Fprintln(writer, "No hook schedules to display.")
+		return nil
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Schedule", "Cron", "Jitter", "Source")
+	for _, name := range slices.Sorted(maps.Keys(schedules)) {
+		s := schedules[name]
+		jitter := s.Jitter
+		if jitter == "" {
+			jitter = "-"
+		}
+		w.Println(name, s.Cron, jitter, s.Source)
+	}
+	return errors.Trace(tw.Flush())
+}
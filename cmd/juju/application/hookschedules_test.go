@@ -0,0 +1,182 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/cmd/juju/application/mocks"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+type HookSchedulesSuite struct {
+	testing.BaseSuite
+
+	api *mocks.MockApplicationHookSchedulesAPI
+}
+
+func TestHookSchedulesSuite(t *stdtesting.T) {
+	tc.Run(t, &HookSchedulesSuite{})
+}
+
+func (s *HookSchedulesSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.api = mocks.NewMockApplicationHookSchedulesAPI(ctrl)
+	return ctrl
+}
+
+func (s *HookSchedulesSuite) runHookSchedules(c *tc.C, args ...string) (*cmd.Context, error) {
+	command := &hookSchedulesCommand{api: s.api}
+	command.SetClientStore(jujuclienttesting.MinimalStore())
+	return cmdtesting.RunCommand(c, modelcmd.Wrap(command), args...)
+}
+
+func (s *HookSchedulesSuite) TestInit(c *tc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{},
+		err:  `no application name specified`,
+	}, {
+		args: []string{"postgresql/0"},
+		err:  `invalid application name "postgresql/0"`,
+	}, {
+		args: []string{"postgresql", "nightly-backup"},
+		err:  `expected <schedule>.cron=<expression> or <schedule>.jitter=<duration>, got "nightly-backup"`,
+	}, {
+		args: []string{"postgresql", "nightly-backup=@daily"},
+		err:  `expected <schedule>.cron or <schedule>.jitter, got "nightly-backup"`,
+	}, {
+		args: []string{"postgresql", "nightly-backup.interval=1h"},
+		err:  `unknown schedule field "interval", expected cron or jitter`,
+	}, {
+		args: []string{"postgresql", "nightly-backup.jitter=soon"},
+		err:  `invalid jitter for schedule "nightly-backup": .*`,
+	}, {
+		args: []string{"postgresql", "nightly-backup.jitter=-1m"},
+		err:  `invalid jitter for schedule "nightly-backup": -1m0s cannot be negative`,
+	}, {
+		args: []string{"postgresql", "nightly-backup.cron=@daily", "--reset", "nightly-backup"},
+		err:  `cannot both set and reset schedule "nightly-backup"`,
+	}, {
+		args: []string{"postgresql"},
+	}, {
+		args: []string{"postgresql", "nightly-backup.cron=30 1 * * *", "nightly-backup.jitter=15m", "--reset", "hourly-report"},
+	}} {
+		command := NewHookSchedulesCommand()
+		command.SetClientStore(jujuclienttesting.MinimalStore())
+		err := cmdtesting.InitCommand(command, test.args)
+		if test.err == "" {
+			c.Check(err, tc.ErrorIsNil)
+		} else {
+			c.Check(err, tc.ErrorMatches, test.err)
+		}
+	}
+}
+
+func (s *HookSchedulesSuite) TestShow(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().HookSchedules(gomock.Any(), "postgresql").Return([]application.HookSchedule{{
+		Name:      "hourly-report",
+		Cron:      "@hourly",
+		CharmCron: "@hourly",
+	}, {
+		Name:        "nightly-backup",
+		Cron:        "30 1 * * *",
+		Jitter:      15 * time.Minute,
+		CharmCron:   "0 3 * * *",
+		CharmJitter: 10 * time.Minute,
+	}}, nil)
+	s.api.EXPECT().Close()
+
+	ctx, err := s.runHookSchedules(c, "postgresql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Schedule        Cron        Jitter  Source
+hourly-report   @hourly     -       charm
+nightly-backup  30 1 * * *  15m0s   user
+`[1:])
+}
+
+func (s *HookSchedulesSuite) TestShowYAML(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().HookSchedules(gomock.Any(), "postgresql").Return([]application.HookSchedule{{
+		Name:        "nightly-backup",
+		Cron:        "30 1 * * *",
+		Jitter:      10 * time.Minute,
+		CharmCron:   "0 3 * * *",
+		CharmJitter: 10 * time.Minute,
+	}}, nil)
+	s.api.EXPECT().Close()
+
+	ctx, err := s.runHookSchedules(c, "postgresql", "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+nightly-backup:
+  cron: 30 1 * * *
+  jitter: 10m0s
+  source: user
+  charm-cron: 0 3 * * *
+  charm-jitter: 10m0s
+`[1:])
+}
+
+func (s *HookSchedulesSuite) TestShowNone(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().HookSchedules(gomock.Any(), "postgresql").Return(nil, nil)
+	s.api.EXPECT().Close()
+
+	ctx, err := s.runHookSchedules(c, "postgresql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "No hook schedules to display.\n")
+}
+
+func (s *HookSchedulesSuite) TestShowNotImplemented(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().HookSchedules(gomock.Any(), "postgresql").Return(nil, errors.NotImplementedf("hook schedules"))
+	s.api.EXPECT().Close()
+
+	_, err := s.runHookSchedules(c, "postgresql")
+	c.Assert(err, tc.ErrorMatches, "hook schedules not implemented")
+}
+
+func (s *HookSchedulesSuite) TestSetAndReset(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cron := "30 1 * * *"
+	jitter := 15 * time.Minute
+	s.api.EXPECT().UnsetHookSchedules(gomock.Any(), "postgresql", []string{"hourly-report"}).Return(nil)
+	s.api.EXPECT().SetHookSchedules(gomock.Any(), "postgresql", map[string]application.HookScheduleOverride{
+		"nightly-backup": {Cron: &cron, Jitter: &jitter},
+	}).Return(nil)
+	s.api.EXPECT().Close()
+
+	_, err := s.runHookSchedules(c, "postgresql", "nightly-backup.cron=30 1 * * *", "nightly-backup.jitter=15m", "--reset", "hourly-report")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *HookSchedulesSuite) TestSetError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().SetHookSchedules(gomock.Any(), "postgresql", gomock.Any()).Return(errors.NotFoundf("schedule %q", "weekly-cleanup"))
+	s.api.EXPECT().Close()
+
+	_, err := s.runHookSchedules(c, "postgresql", "weekly-cleanup.cron=@weekly")
+	c.Assert(err, tc.ErrorMatches, `schedule "weekly-cleanup" not found`)
+}
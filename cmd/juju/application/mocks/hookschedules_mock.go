@@ -0,0 +1,194 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/cmd/juju/application (interfaces: ApplicationHookSchedulesAPI)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package mocks -destination mocks/hookschedules_mock.go github.com/juju/juju/cmd/juju/application ApplicationHookSchedulesAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	application "github.com/juju/juju/api/client/application"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApplicationHookSchedulesAPI is a mock of ApplicationHookSchedulesAPI interface.
+type MockApplicationHookSchedulesAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationHookSchedulesAPIMockRecorder
+}
+
+// MockApplicationHookSchedulesAPIMockRecorder is the mock recorder for MockApplicationHookSchedulesAPI.
+type MockApplicationHookSchedulesAPIMockRecorder struct {
+	mock *MockApplicationHookSchedulesAPI
+}
+
+// NewMockApplicationHookSchedulesAPI creates a new mock instance.
+func NewMockApplicationHookSchedulesAPI(ctrl *gomock.Controller) *MockApplicationHookSchedulesAPI {
+	mock := &MockApplicationHookSchedulesAPI{ctrl: ctrl}
+	mock.recorder = &MockApplicationHookSchedulesAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationHookSchedulesAPI) EXPECT() *MockApplicationHookSchedulesAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockApplicationHookSchedulesAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockApplicationHookSchedulesAPIMockRecorder) Close() *MockApplicationHookSchedulesAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockApplicationHookSchedulesAPI)(nil).Close))
+	return &MockApplicationHookSchedulesAPICloseCall{Call: call}
+}
+
+// MockApplicationHookSchedulesAPICloseCall wrap *gomock.Call
+type MockApplicationHookSchedulesAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationHookSchedulesAPICloseCall) Return(arg0 error) *MockApplicationHookSchedulesAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationHookSchedulesAPICloseCall) Do(f func() error) *MockApplicationHookSchedulesAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationHookSchedulesAPICloseCall) DoAndReturn(f func() error) *MockApplicationHookSchedulesAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// HookSchedules mocks base method.
+func (m *MockApplicationHookSchedulesAPI) HookSchedules(arg0 context.Context, arg1 string) ([]application.HookSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HookSchedules", arg0, arg1)
+	ret0, _ := ret[0].([]application.HookSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HookSchedules indicates an expected call of HookSchedules.
+func (mr *MockApplicationHookSchedulesAPIMockRecorder) HookSchedules(arg0, arg1 any) *MockApplicationHookSchedulesAPIHookSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HookSchedules", reflect.TypeOf((*MockApplicationHookSchedulesAPI)(nil).HookSchedules), arg0, arg1)
+	return &MockApplicationHookSchedulesAPIHookSchedulesCall{Call: call}
+}
+
+// MockApplicationHookSchedulesAPIHookSchedulesCall wrap *gomock.Call
+type MockApplicationHookSchedulesAPIHookSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationHookSchedulesAPIHookSchedulesCall) Return(arg0 []application.HookSchedule, arg1 error) *MockApplicationHookSchedulesAPIHookSchedulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationHookSchedulesAPIHookSchedulesCall) Do(f func(context.Context, string) ([]application.HookSchedule, error)) *MockApplicationHookSchedulesAPIHookSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationHookSchedulesAPIHookSchedulesCall) DoAndReturn(f func(context.Context, string) ([]application.HookSchedule, error)) *MockApplicationHookSchedulesAPIHookSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetHookSchedules mocks base method.
+func (m *MockApplicationHookSchedulesAPI) SetHookSchedules(arg0 context.Context, arg1 string, arg2 map[string]application.HookScheduleOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHookSchedules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHookSchedules indicates an expected call of SetHookSchedules.
+func (mr *MockApplicationHookSchedulesAPIMockRecorder) SetHookSchedules(arg0, arg1, arg2 any) *MockApplicationHookSchedulesAPISetHookSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHookSchedules", reflect.TypeOf((*MockApplicationHookSchedulesAPI)(nil).SetHookSchedules), arg0, arg1, arg2)
+	return &MockApplicationHookSchedulesAPISetHookSchedulesCall{Call: call}
+}
+
+// MockApplicationHookSchedulesAPISetHookSchedulesCall wrap *gomock.Call
+type MockApplicationHookSchedulesAPISetHookSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationHookSchedulesAPISetHookSchedulesCall) Return(arg0 error) *MockApplicationHookSchedulesAPISetHookSchedulesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationHookSchedulesAPISetHookSchedulesCall) Do(f func(context.Context, string, map[string]application.HookScheduleOverride) error) *MockApplicationHookSchedulesAPISetHookSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationHookSchedulesAPISetHookSchedulesCall) DoAndReturn(f func(context.Context, string, map[string]application.HookScheduleOverride) error) *MockApplicationHookSchedulesAPISetHookSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UnsetHookSchedules mocks base method.
+func (m *MockApplicationHookSchedulesAPI) UnsetHookSchedules(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetHookSchedules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetHookSchedules indicates an expected call of UnsetHookSchedules.
+func (mr *MockApplicationHookSchedulesAPIMockRecorder) UnsetHookSchedules(arg0, arg1, arg2 any) *MockApplicationHookSchedulesAPIUnsetHookSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetHookSchedules", reflect.TypeOf((*MockApplicationHookSchedulesAPI)(nil).UnsetHookSchedules), arg0, arg1, arg2)
+	return &MockApplicationHookSchedulesAPIUnsetHookSchedulesCall{Call: call}
+}
+
+// MockApplicationHookSchedulesAPIUnsetHookSchedulesCall wrap *gomock.Call
+type MockApplicationHookSchedulesAPIUnsetHookSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationHookSchedulesAPIUnsetHookSchedulesCall) Return(arg0 error) *MockApplicationHookSchedulesAPIUnsetHookSchedulesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationHookSchedulesAPIUnsetHookSchedulesCall) Do(f func(context.Context, string, []string) error) *MockApplicationHookSchedulesAPIUnsetHookSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationHookSchedulesAPIUnsetHookSchedulesCall) DoAndReturn(f func(context.Context, string, []string) error) *MockApplicationHookSchedulesAPIUnsetHookSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
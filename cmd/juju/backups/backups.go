@@ -106,7 +106,8 @@ controller UUID:       {{.ControllerUUID}}{{if (gt .HANodes 1)}}
 controllers in HA:     {{.HANodes}}{{end}}
 model UUID:            {{.ModelUUID}} 
 machine ID:            {{.MachineID}} 
-created on host:       {{.Hostname}} 
+created on host:       {{.Hostname}} {{if .Databases}}
+databases:             {{len .Databases}} {{end}}
 
 checksum:              {{.Checksum}} 
 checksum format:       {{.ChecksumFormat}} 
@@ -134,6 +135,7 @@ type MetadataParams struct {
 	Hostname       string
 	JujuVersion    semversion.Number
 	Base           string
+	Databases      []string
 }
 
 func (c *CommandBase) metadata(result *params.BackupsMetadataResult) string {
@@ -153,6 +155,7 @@ func (c *CommandBase) metadata(result *params.BackupsMetadataResult) string {
 		result.Hostname,
 		result.Version,
 		result.Base,
+		result.Databases,
 	}
 	t := template.Must(template.New("template").Parse(backupMetadataTemplate))
 	content := bytes.Buffer{}
@@ -28,6 +28,11 @@ const createDoc = `
 This command requests that Juju creates a backup of its state.
 You may provide a note to associate with the backup.
 
+The backup contains a consistent snapshot of the controller database and of
+the database of every model, along with the controller's object store when
+it is held on the controller's disk. It must be created from the controller
+model.
+
 By default, the backup archive and associated metadata are downloaded.
 
 Use --no-download to avoid getting a local copy of the backup downloaded 
@@ -39,6 +44,9 @@ filesystem; the same path must exist on each controller if using HA.
 Use --verbose to see extra information about backup.
 
 To access remote backups stored on the controller, see 'juju download-backup'.
+
+To restore a backup onto a new controller machine, stop the controller agent
+and run 'jujud-controller restore-backup' on that machine.
 `
 
 const createExamples = `
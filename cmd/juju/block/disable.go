@@ -6,11 +6,15 @@ package block
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 
+	apiblock "github.com/juju/juju/api/client/block"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/internal/cmd"
 )
 
@@ -29,6 +33,24 @@ type disableCommand struct {
 	apiFunc func(context.Context, newAPIRoot) (blockClientAPI, error)
 	target  string
 	message string
+
+	expires      string
+	expiresIn    time.Duration
+	expiresAt    time.Time
+	applications []string
+	methods      []string
+	exemptUsers  []string
+	exemptRoles  []string
+}
+
+// SetFlags implements Command.
+func (c *disableCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.expires, "expires", "", "Enable the commands again after a duration (e.g. 2h) or at a time (RFC3339)")
+	f.Var(cmd.NewStringsValue(nil, &c.applications), "applications", "Only disable the commands for these applications")
+	f.Var(cmd.NewStringsValue(nil, &c.methods), "methods", "Only disable these API methods (Facade.Method or Facade.*)")
+	f.Var(cmd.NewStringsValue(nil, &c.exemptUsers), "exempt-users", "Users that are not subject to the disabled commands")
+	f.Var(cmd.NewStringsValue(nil, &c.exemptRoles), "exempt-roles", "Model access levels (read, write, admin) that are not subject to the disabled commands")
 }
 
 // Init implements Command.
@@ -43,6 +65,24 @@ func (c *disableCommand) Init(args []string) error {
 	}
 	c.target = target
 	c.message = strings.Join(args, " ")
+
+	if c.expires != "" {
+		if d, err := time.ParseDuration(c.expires); err == nil {
+			if d <= 0 {
+				return errors.Errorf("expiry duration %q must be positive", c.expires)
+			}
+			c.expiresIn = d
+		} else if t, err := time.Parse(time.RFC3339, c.expires); err == nil {
+			c.expiresAt = t
+		} else {
+			return errors.Errorf("expiry %q not valid, expected a duration or an RFC3339 time", c.expires)
+		}
+	}
+	for _, role := range c.exemptRoles {
+		if err := permission.ValidateModelAccess(permission.Access(role)); err != nil {
+			return errors.Errorf("exempt role %q not valid, valid options: read, write, admin", role)
+		}
+	}
 	return nil
 }
 
@@ -63,7 +103,7 @@ func (c *disableCommand) Info() *cmd.Info {
 
 type blockClientAPI interface {
 	Close() error
-	SwitchBlockOn(ctx context.Context, blockType, msg string) error
+	SwitchBlockOnWithArgs(ctx context.Context, args apiblock.BlockArgs) error
 }
 
 // Run implements Command.Run
@@ -74,7 +114,21 @@ func (c *disableCommand) Run(ctx *cmd.Context) error {
 	}
 	defer api.Close()
 
-	return api.SwitchBlockOn(ctx, c.target, c.message)
+	args := apiblock.BlockArgs{
+		Type:         c.target,
+		Message:      c.message,
+		Applications: c.applications,
+		Methods:      c.methods,
+		ExemptUsers:  c.exemptUsers,
+		ExemptRoles:  c.exemptRoles,
+	}
+	if c.expiresIn > 0 {
+		expiresAt := time.Now().Add(c.expiresIn)
+		args.ExpiresAt = &expiresAt
+	} else if !c.expiresAt.IsZero() {
+		args.ExpiresAt = &c.expiresAt
+	}
+	return api.SwitchBlockOnWithArgs(ctx, args)
 }
 
 var disableCommandDoc = `
@@ -82,7 +136,15 @@ Juju allows to safeguard deployed models from unintentional damage by preventing
 execution of operations that could alter model.
 
 This is done by disabling certain sets of commands from successful execution.
-Disabled commands must be manually enabled to proceed.
+Disabled commands must be manually enabled to proceed, unless an expiry is
+given with --expires, after which the commands are enabled again.
+
+The disabling can be limited to operations on particular applications with
+--applications, or to particular API methods with --methods. Operations that
+don't name the applications they act on, such as add-machine, are prevented by
+a disabling limited to applications, as they may affect any application. Users
+listed with --exempt-users, and users with at least one of the model access
+levels listed with --exempt-roles, are not affected.
 
 Some commands offer a --force option that can be used to bypass the disabling.
 ` + commandSets
@@ -99,4 +161,13 @@ To prevent the machines, applications, units and relations from being removed:
 To prevent changes to the model:
 
     juju disable-command all "Model locked down"
+
+To prevent changes to the model during a release window, except by model
+administrators:
+
+    juju disable-command all --expires 4h --exempt-roles admin "Release freeze"
+
+To prevent the removal of units of a single application:
+
+    juju disable-command remove-object --applications mysql
 `
@@ -6,10 +6,12 @@ package block_test
 import (
 	"context"
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 
+	apiblock "github.com/juju/juju/api/client/block"
 	"github.com/juju/juju/cmd/juju/block"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/internal/cmd/cmdtesting"
@@ -48,6 +50,21 @@ func (s *disableCommandSuite) TestInit(c *tc.C) {
 			args: []string{"remove-object"},
 		}, {
 			args: []string{"all", "lots", "of", "args"},
+		}, {
+			args: []string{"all", "--expires", "2h"},
+		}, {
+			args: []string{"all", "--expires", "2025-01-02T03:04:05Z"},
+		}, {
+			args: []string{"all", "--expires", "-2h"},
+			err:  `expiry duration "-2h" must be positive`,
+		}, {
+			args: []string{"all", "--expires", "tomorrow"},
+			err:  `expiry "tomorrow" not valid, expected a duration or an RFC3339 time`,
+		}, {
+			args: []string{"all", "--exempt-roles", "admin,write"},
+		}, {
+			args: []string{"all", "--exempt-roles", "superuser"},
+			err:  `exempt role "superuser" not valid, valid options: read, write, admin`,
 		},
 	} {
 		cmd := s.disableCommand(&mockBlockClient{}, nil)
@@ -88,11 +105,47 @@ func (s *disableCommandSuite) TestRun(c *tc.C) {
 		cmd := s.disableCommand(mockClient, nil)
 		_, err := cmdtesting.RunCommand(c, cmd, test.args...)
 		c.Check(err, tc.ErrorIsNil)
-		c.Check(mockClient.blockType, tc.Equals, test.type_)
-		c.Check(mockClient.message, tc.Equals, test.message)
+		c.Check(mockClient.args.Type, tc.Equals, test.type_)
+		c.Check(mockClient.args.Message, tc.Equals, test.message)
 	}
 }
 
+func (s *disableCommandSuite) TestRunScoped(c *tc.C) {
+	mockClient := &mockBlockClient{}
+	cmd := s.disableCommand(mockClient, nil)
+	_, err := cmdtesting.RunCommand(c, cmd, "remove-object",
+		"--applications", "mysql,wordpress",
+		"--methods", "Application.DestroyUnit",
+		"--exempt-users", "mary",
+		"--exempt-roles", "admin",
+		"--expires", "2025-01-02T03:04:05Z",
+		"protect the database")
+	c.Assert(err, tc.ErrorIsNil)
+
+	expires := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	c.Check(mockClient.args, tc.DeepEquals, apiblock.BlockArgs{
+		Type:         "BlockRemove",
+		Message:      "protect the database",
+		ExpiresAt:    &expires,
+		Applications: []string{"mysql", "wordpress"},
+		Methods:      []string{"Application.DestroyUnit"},
+		ExemptUsers:  []string{"mary"},
+		ExemptRoles:  []string{"admin"},
+	})
+}
+
+func (s *disableCommandSuite) TestRunExpiresIn(c *tc.C) {
+	mockClient := &mockBlockClient{}
+	cmd := s.disableCommand(mockClient, nil)
+	before := time.Now()
+	_, err := cmdtesting.RunCommand(c, cmd, "all", "--expires", "1h")
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(mockClient.args.ExpiresAt, tc.NotNil)
+	c.Check(mockClient.args.ExpiresAt.Before(before.Add(time.Hour)), tc.IsFalse)
+	c.Check(mockClient.args.ExpiresAt.After(time.Now().Add(time.Hour)), tc.IsFalse)
+}
+
 func (s *disableCommandSuite) TestRunError(c *tc.C) {
 	mockClient := &mockBlockClient{err: errors.New("boom")}
 	cmd := s.disableCommand(mockClient, nil)
@@ -101,17 +154,15 @@ func (s *disableCommandSuite) TestRunError(c *tc.C) {
 }
 
 type mockBlockClient struct {
-	blockType string
-	message   string
-	err       error
+	args apiblock.BlockArgs
+	err  error
 }
 
 func (c *mockBlockClient) Close() error {
 	return nil
 }
 
-func (c *mockBlockClient) SwitchBlockOn(ctx context.Context, blockType, message string) error {
-	c.blockType = blockType
-	c.message = message
+func (c *mockBlockClient) SwitchBlockOnWithArgs(ctx context.Context, args apiblock.BlockArgs) error {
+	c.args = args
 	return c.err
 }
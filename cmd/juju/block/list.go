@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
@@ -16,6 +18,7 @@ import (
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/api/controller/controller"
 	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/output"
 	"github.com/juju/juju/internal/cmd"
@@ -46,6 +49,7 @@ type listCommand struct {
 	apiFunc           func(context.Context, newAPIRoot) (blockListAPI, error)
 	controllerAPIFunc func(context.Context, newControllerAPIRoot) (controllerListAPI, error)
 	all               bool
+	utc               bool
 	out               cmd.Output
 }
 
@@ -72,6 +76,7 @@ func (c *listCommand) Info() *cmd.Info {
 func (c *listCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	f.BoolVar(&c.all, "all", false, "Lists for all models (administrative users only)")
+	f.BoolVar(&c.utc, "utc", false, "Display time as UTC in RFC3339 format")
 	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
 		"yaml":    cmd.FormatYaml,
 		"json":    cmd.FormatJson,
@@ -133,7 +138,7 @@ func (c *listCommand) formatter(writer io.Writer, value interface{}) error {
 	if c.all {
 		return FormatTabularBlockedModels(writer, value)
 	}
-	return formatBlocks(writer, value)
+	return c.formatBlocks(writer, value)
 }
 
 // blockListAPI defines the client API methods that block list command uses.
@@ -151,8 +156,15 @@ type controllerListAPI interface {
 
 // BlockInfo defines the serialization behaviour of the block information.
 type BlockInfo struct {
-	Commands string `yaml:"command-set" json:"command-set"`
-	Message  string `yaml:"message,omitempty" json:"message,omitempty"`
+	Commands     string     `yaml:"command-set" json:"command-set"`
+	Message      string     `yaml:"message,omitempty" json:"message,omitempty"`
+	CreatedBy    string     `yaml:"created-by,omitempty" json:"created-by,omitempty"`
+	CreatedAt    *time.Time `yaml:"created-at,omitempty" json:"created-at,omitempty"`
+	ExpiresAt    *time.Time `yaml:"expires-at,omitempty" json:"expires-at,omitempty"`
+	Applications []string   `yaml:"applications,omitempty" json:"applications,omitempty"`
+	Methods      []string   `yaml:"methods,omitempty" json:"methods,omitempty"`
+	ExemptUsers  []string   `yaml:"exempt-users,omitempty" json:"exempt-users,omitempty"`
+	ExemptRoles  []string   `yaml:"exempt-roles,omitempty" json:"exempt-roles,omitempty"`
 }
 
 // formatBlockInfo takes a set of Block and creates a
@@ -165,15 +177,22 @@ func formatBlockInfo(all []params.Block) []BlockInfo {
 			set = "<unknown>"
 		}
 		output[i] = BlockInfo{
-			Commands: set,
-			Message:  one.Message,
+			Commands:     set,
+			Message:      one.Message,
+			CreatedBy:    one.CreatedBy,
+			CreatedAt:    one.CreatedAt,
+			ExpiresAt:    one.ExpiresAt,
+			Applications: one.Applications,
+			Methods:      one.Methods,
+			ExemptUsers:  one.ExemptUsers,
+			ExemptRoles:  one.ExemptRoles,
 		}
 	}
 	return output
 }
 
 // formatBlocks writes block list representation.
-func formatBlocks(writer io.Writer, value interface{}) error {
+func (c *listCommand) formatBlocks(writer io.Writer, value interface{}) error {
 	blocks, ok := value.([]BlockInfo)
 	if !ok {
 		return errors.Errorf("expected value of type %T, got %T", blocks, value)
@@ -186,9 +205,17 @@ func formatBlocks(writer io.Writer, value interface{}) error {
 
 	tw := output.TabWriter(writer)
 	w := output.Wrapper{TabWriter: tw}
-	w.Println("Disabled commands", "Message")
+	w.Println("Disabled commands", "Scope", "Set by", "Expires", "Message")
 	for _, info := range blocks {
-		w.Println(info.Commands, info.Message)
+		scope := "model"
+		if targets := append(slices.Clone(info.Applications), info.Methods...); len(targets) > 0 {
+			scope = strings.Join(targets, ", ")
+		}
+		expires := "never"
+		if info.ExpiresAt != nil {
+			expires = common.FormatTime(info.ExpiresAt, c.utc)
+		}
+		w.Println(info.Commands, scope, info.CreatedBy, expires, info.Message)
 	}
 	tw.Flush()
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/tc"
 
@@ -53,14 +54,24 @@ func (s *listCommandSuite) TestListError(c *tc.C) {
 }
 
 func (s *listCommandSuite) mock() *mockListClient {
+	expires := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
 	return &mockListClient{
 		blocks: []params.Block{
 			{
 				Type:    "BlockDestroy",
 				Message: "Sysadmins in control.",
 			}, {
-				Type:    "BlockChange",
-				Message: "just temporary",
+				Type:      "BlockChange",
+				Message:   "just temporary",
+				CreatedBy: "mary",
+				ExpiresAt: &expires,
+			}, {
+				Type:         "BlockRemove",
+				Message:      "keep the data",
+				CreatedBy:    "bob",
+				Applications: []string{"mysql"},
+				Methods:      []string{"Application.DestroyUnit"},
+				ExemptRoles:  []string{"admin"},
 			},
 		},
 		modelBlocks: []params.ModelBlockInfo{
@@ -86,13 +97,14 @@ func (s *listCommandSuite) mock() *mockListClient {
 
 func (s *listCommandSuite) TestList(c *tc.C) {
 	cmd := s.listCommand(s.mock(), nil)
-	ctx, err := cmdtesting.RunCommand(c, cmd)
+	ctx, err := cmdtesting.RunCommand(c, cmd, "--utc")
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, "")
 	c.Assert(cmdtesting.Stdout(ctx), tc.Equals, ""+
-		"Disabled commands  Message\n"+
-		"destroy-model      Sysadmins in control.\n"+
-		"all                just temporary\n",
+		"Disabled commands  Scope                           Set by  Expires               Message\n"+
+		"destroy-model      model                                   never                 Sysadmins in control.\n"+
+		"all                model                           mary    2025-01-02 03:04:05Z  just temporary\n"+
+		"remove-object      mysql, Application.DestroyUnit  bob     never                 keep the data\n",
 	)
 }
 
@@ -104,7 +116,18 @@ func (s *listCommandSuite) TestListYAML(c *tc.C) {
 		"- command-set: destroy-model\n"+
 		"  message: Sysadmins in control.\n"+
 		"- command-set: all\n"+
-		"  message: just temporary\n",
+		"  message: just temporary\n"+
+		"  created-by: mary\n"+
+		"  expires-at: 2025-01-02T03:04:05Z\n"+
+		"- command-set: remove-object\n"+
+		"  message: keep the data\n"+
+		"  created-by: bob\n"+
+		"  applications:\n"+
+		"  - mysql\n"+
+		"  methods:\n"+
+		"  - Application.DestroyUnit\n"+
+		"  exempt-roles:\n"+
+		"  - admin\n",
 	)
 }
 
@@ -120,7 +143,9 @@ func (s *listCommandSuite) TestListJSON(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(cmdtesting.Stdout(ctx), tc.Equals, ""+
 		`[{"command-set":"destroy-model","message":"Sysadmins in control."},`+
-		`{"command-set":"all","message":"just temporary"}]`+"\n")
+		`{"command-set":"all","message":"just temporary","created-by":"mary","expires-at":"2025-01-02T03:04:05Z"},`+
+		`{"command-set":"remove-object","message":"keep the data","created-by":"bob",`+
+		`"applications":["mysql"],"methods":["Application.DestroyUnit"],"exempt-roles":["admin"]}]`+"\n")
 }
 
 func (s *listCommandSuite) TestListAll(c *tc.C) {
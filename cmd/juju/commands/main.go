@@ -29,6 +29,8 @@ import (
 	"github.com/juju/juju/cmd/juju/firewall"
 	"github.com/juju/juju/cmd/juju/machine"
 	"github.com/juju/juju/cmd/juju/model"
+	"github.com/juju/juju/cmd/juju/objectstore"
+	"github.com/juju/juju/cmd/juju/removal"
 	"github.com/juju/juju/cmd/juju/resource"
 	"github.com/juju/juju/cmd/juju/secretbackends"
 	"github.com/juju/juju/cmd/juju/secrets"
@@ -359,6 +361,8 @@ func registerCommands(r commandRegistry) {
 	r.Register(application.NewRemoveApplicationCommand())
 	r.Register(application.NewRemoveUnitCommand())
 	r.Register(application.NewRemoveSaasCommand())
+	r.Register(removal.NewListRemovalsCommand())
+	r.Register(removal.NewCancelRemovalCommand())
 
 	// Reporting commands.
 	r.Register(status.NewStatusCommand())
@@ -369,8 +373,11 @@ func registerCommands(r commandRegistry) {
 	r.Register(action.NewExecCommand(nil))
 	r.Register(ssh.NewSCPCommand(nil, ssh.DefaultSSHRetryStrategy, ssh.DefaultSSHPublicKeyRetryStrategy))
 	r.Register(ssh.NewSSHCommand(nil, nil, ssh.DefaultSSHRetryStrategy, ssh.DefaultSSHPublicKeyRetryStrategy))
+	r.Register(ssh.NewListSessionRecordingsCommand())
+	r.Register(ssh.NewShowSessionRecordingCommand())
 	r.Register(application.NewResolvedCommand())
 	r.Register(newDebugLogCommand(nil))
+	r.Register(newWatchChangesCommand())
 	r.Register(ssh.NewDebugHooksCommand(nil, ssh.DefaultSSHRetryStrategy, ssh.DefaultSSHPublicKeyRetryStrategy))
 	r.Register(ssh.NewDebugCodeCommand(nil, ssh.DefaultSSHRetryStrategy, ssh.DefaultSSHPublicKeyRetryStrategy))
 
@@ -427,6 +434,8 @@ func registerCommands(r commandRegistry) {
 
 	r.Register(newMigrateCommand())
 	r.Register(model.NewExportBundleCommand())
+	r.Register(model.NewExportDBCommand())
+	r.Register(model.NewImportDBCommand())
 
 	if featureflag.Enabled(featureflag.DeveloperMode) {
 		r.Register(model.NewDumpCommand())
@@ -456,6 +465,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(application.NewDiffBundleCommand())
 	r.Register(application.NewShowApplicationCommand())
 	r.Register(application.NewShowUnitCommand())
+	r.Register(application.NewHookSchedulesCommand())
 
 	// Operation protection commands
 	r.Register(block.NewDisableCommand())
@@ -473,6 +483,10 @@ func registerCommands(r commandRegistry) {
 	r.Register(storage.NewRemoveStorageCommandWithAPI())
 	r.Register(storage.NewDetachStorageCommandWithAPI())
 	r.Register(storage.NewAttachStorageCommandWithAPI())
+	r.Register(storage.NewResizeStorageCommandWithAPI())
+	r.Register(storage.NewSnapshotCommand())
+	r.Register(storage.NewSnapshotListCommand())
+	r.Register(storage.NewSnapshotRemoveCommand())
 	r.Register(storage.NewImportFilesystemCommand(storage.NewStorageImporter, nil))
 
 	// Manage spaces
@@ -498,6 +512,14 @@ func registerCommands(r commandRegistry) {
 	r.Register(controller.NewEnableDestroyControllerCommand())
 	r.Register(controller.NewShowControllerCommand())
 	r.Register(controller.NewConfigCommand())
+	r.Register(controller.NewAuditLogCommand())
+
+	// Manage the controller object store
+	r.Register(objectstore.NewListObjectsCommand())
+	r.Register(objectstore.NewShowObjectCommand())
+	r.Register(objectstore.NewVerifyObjectsCommand())
+	r.Register(objectstore.NewPruneObjectsCommand())
+	r.Register(objectstore.NewUsageCommand())
 
 	// Manage clouds and credentials
 	r.Register(cloud.NewUpdateCloudCommand(&cloudToCommandAdaptor{}))
@@ -548,6 +570,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(secrets.NewRemoveSecretCommand())
 	r.Register(secrets.NewGrantSecretCommand())
 	r.Register(secrets.NewRevokeSecretCommand())
+	r.Register(secrets.NewMigrateSecretsCommand())
 
 	// Secret backends.
 	r.Register(secretbackends.NewListSecretBackendsCommand())
@@ -214,9 +214,11 @@ var commandNames = []string{
 	"add-user",
 	"attach-resource",
 	"attach-storage",
+	"audit-log",
 	"autoload-credentials",
 	"bind",
 	"bootstrap",
+	"cancel-removal",
 	"cancel-task",
 	"change-user-password",
 	"charm-resources",
@@ -253,6 +255,7 @@ var commandNames = []string{
 	"enable-user",
 	"exec",
 	"export-bundle",
+	"export-model-db",
 	"expose",
 	"find-offers",
 	"find",
@@ -263,7 +266,9 @@ var commandNames = []string{
 	"help",
 	"help-action-commands",
 	"help-hook-commands",
+	"hook-schedules",
 	"import-filesystem",
+	"import-model-db",
 	"import-ssh-key",
 	"info",
 	"integrate",
@@ -277,15 +282,19 @@ var commandNames = []string{
 	"list-firewall-rules",
 	"list-machines",
 	"list-models",
+	"list-objects",
 	"list-offers",
 	"list-operations",
 	"list-regions",
+	"list-removals",
 	"list-resources",
 	"list-secret-backends",
 	"list-secrets",
 	"list-spaces",
 	"list-ssh-keys",
+	"list-ssh-recordings",
 	"list-storage-pools",
+	"list-storage-snapshots",
 	"list-storage",
 	"list-subnets",
 	"list-users",
@@ -293,6 +302,7 @@ var commandNames = []string{
 	"logout",
 	"machines",
 	"migrate",
+	"migrate-secrets",
 	"model-config",
 	"model-constraints",
 	"model-default",
@@ -300,14 +310,18 @@ var commandNames = []string{
 	"model-secret-backend",
 	"models",
 	"move-to-space",
+	"object-store-usage",
+	"objects",
 	"offer",
 	"offers",
 	"operations",
+	"prune-objects",
 	"refresh",
 	"regions",
 	"register",
 	"relate", // alias for integrate
 	"reload-spaces",
+	"removals",
 	"remove-application",
 	"remove-cloud",
 	"remove-credential",
@@ -321,10 +335,12 @@ var commandNames = []string{
 	"remove-space",
 	"remove-ssh-key",
 	"remove-storage-pool",
+	"remove-storage-snapshot",
 	"remove-storage",
 	"remove-unit",
 	"remove-user",
 	"rename-space",
+	"resize-storage",
 	"resolve",
 	"resolved",
 	"resources",
@@ -352,21 +368,26 @@ var commandNames = []string{
 	"show-credentials",
 	"show-machine",
 	"show-model",
+	"show-object",
 	"show-offer",
 	"show-operation",
 	"show-secret-backend",
 	"show-secret",
 	"show-space",
+	"show-ssh-recording",
 	"show-status-log",
 	"show-storage",
 	"show-task",
 	"show-unit",
 	"show-user",
+	"snapshot-storage",
 	"spaces",
 	"ssh-keys",
+	"ssh-recordings",
 	"ssh",
 	"status",
 	"storage-pools",
+	"storage-snapshots",
 	"storage",
 	"subnets",
 	"suspend-relation",
@@ -386,7 +407,9 @@ var commandNames = []string{
 	"upgrade-controller",
 	"upgrade-model",
 	"users",
+	"verify-objects",
 	"version",
+	"watch-changes",
 	"whoami",
 }
 
@@ -5,11 +5,15 @@ package commands
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"strings"
 
+	"github.com/dustin/go-humanize"
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/httpbakery"
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"github.com/juju/names/v6"
 	"gopkg.in/macaroon.v2"
 
@@ -19,6 +23,7 @@ import (
 	"github.com/juju/juju/api/controller/controller"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/jujuclient"
 	"github.com/juju/juju/rpc/params"
@@ -36,6 +41,8 @@ func newMigrateCommand() modelcmd.ModelCommand {
 type migrateCommand struct {
 	modelcmd.ModelCommandBase
 	targetController string
+	dryRun           bool
+	out              cmd.Output
 
 	// Overridden by tests
 	newAPIRoot func(context.Context, jujuclient.ClientStore, string, string) (api.Connection, error)
@@ -46,6 +53,7 @@ type migrateCommand struct {
 
 type migrateAPI interface {
 	InitiateMigration(ctx context.Context, spec controller.MigrationSpec) (string, error)
+	DryRunMigration(ctx context.Context, spec controller.MigrationSpec) (controller.MigrationDryRunReport, error)
 	IdentityProviderURL(ctx context.Context) (string, error)
 	Close() error
 }
@@ -82,15 +90,31 @@ If the migration fails for some reason, the model is returned to its
 original state where it is managed by the original
 controller.
 
+With the '--dry-run' option, no migration is started. Instead, every
+migration precheck is run on both controllers, including an import
+check of the exported model on the target controller, and a report is
+printed. The report lists the blockers that would prevent the
+migration, the warnings about transient conditions that would prevent
+a migration started now, and an estimate of the charms, resources and
+agent binaries that the migration transfers. The command fails if any
+blockers are found.
+
+`
+
+const migrateExamples = `
+    juju migrate mymodel target-controller
+    juju migrate mymodel target-controller --dry-run
+    juju migrate mymodel target-controller --dry-run --format yaml
 `
 
 // Info implements cmd.Command.
 func (c *migrateCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
-		Name:    "migrate",
-		Args:    "<model-name> <target-controller-name>",
-		Purpose: "Migrate a workload model to another controller.",
-		Doc:     migrateDoc,
+		Name:     "migrate",
+		Args:     "<model-name> <target-controller-name>",
+		Purpose:  "Migrate a workload model to another controller.",
+		Doc:      migrateDoc,
+		Examples: migrateExamples,
 		SeeAlso: []string{
 			"login",
 			"controllers",
@@ -99,6 +123,17 @@ func (c *migrateCommand) Info() *cmd.Info {
 	})
 }
 
+// SetFlags implements cmd.Command.
+func (c *migrateCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.dryRun, "dry-run", false, "Run every migration precheck and report the problems found, without starting the migration")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatMigrationDryRunTabular,
+	})
+}
+
 // Init implements cmd.Command.
 func (c *migrateCommand) Init(args []string) error {
 	if len(args) < 1 {
@@ -134,6 +169,9 @@ func (c *migrateCommand) Run(ctx *cmd.Context) error {
 		return errors.Trace(err)
 	}
 	spec.ModelUUID = uuids[0]
+	if c.dryRun {
+		return c.dryRunMigration(ctx, spec)
+	}
 	if err := c.checkMigrationFeasibility(ctx, spec); err != nil {
 		return errors.Trace(err)
 	}
@@ -154,6 +192,108 @@ func (c *migrateCommand) Run(ctx *cmd.Context) error {
 	return nil
 }
 
+// migrationDryRun is the report printed by a migration dry run.
+type migrationDryRun struct {
+	Blockers []string          `yaml:"blockers,omitempty" json:"blockers,omitempty"`
+	Warnings []string          `yaml:"warnings,omitempty" json:"warnings,omitempty"`
+	Transfer migrationTransfer `yaml:"transfer" json:"transfer"`
+}
+
+// migrationTransfer is the estimate of the binaries that a migration
+// sends to the target controller.
+type migrationTransfer struct {
+	Charms           int   `yaml:"charms" json:"charms"`
+	CharmBytes       int64 `yaml:"charm-bytes" json:"charm-bytes"`
+	Resources        int   `yaml:"resources" json:"resources"`
+	ResourceBytes    int64 `yaml:"resource-bytes" json:"resource-bytes"`
+	AgentBinaries    int   `yaml:"agent-binaries" json:"agent-binaries"`
+	AgentBinaryBytes int64 `yaml:"agent-binary-bytes" json:"agent-binary-bytes"`
+}
+
+// dryRunMigration runs every migration precheck without starting the
+// migration, and prints the problems found. Unlike a real migration,
+// failed client-side checks are reported along with the others instead
+// of stopping the run.
+func (c *migrateCommand) dryRunMigration(ctx *cmd.Context, spec *controller.MigrationSpec) error {
+	var result migrationDryRun
+	if err := c.checkMigrationFeasibility(ctx, spec); err != nil {
+		result.Blockers = append(result.Blockers, err.Error())
+	}
+
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return err
+	}
+	api, err := c.getMigrationAPI(ctx, controllerName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = api.Close() }()
+	report, err := api.DryRunMigration(ctx, *spec)
+	if errors.Is(err, errors.NotSupported) {
+		return errors.New("migration dry run not supported by this controller")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	result.Blockers = append(result.Blockers, report.Blockers...)
+	result.Warnings = report.Warnings
+	result.Transfer = migrationTransfer{
+		Charms:           report.Transfer.Charms,
+		CharmBytes:       report.Transfer.CharmBytes,
+		Resources:        report.Transfer.Resources,
+		ResourceBytes:    report.Transfer.ResourceBytes,
+		AgentBinaries:    report.Transfer.AgentBinaries,
+		AgentBinaryBytes: report.Transfer.AgentBinaryBytes,
+	}
+	if err := c.out.Write(ctx, result); err != nil {
+		return errors.Trace(err)
+	}
+	if len(result.Blockers) > 0 {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+func formatMigrationDryRunTabular(writer io.Writer, value interface{}) error {
+	result, ok := value.(migrationDryRun)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", result, value)
+	}
+
+	printProblems := func(heading string, problems []string) {
+		if len(problems) == 0 {
+			return
+		}
+		fmt.Fprintf(writer, "%s:\n", heading)
+		for _, problem := range problems {
+			fmt.Fprintf(writer, "  - %s\n", strings.ReplaceAll(problem, "\n", "\n    "))
+		}
+		fmt.Fprintln(writer)
+	}
+	printProblems("Blockers", result.Blockers)
+	printProblems("Warnings", result.Warnings)
+	if len(result.Blockers) == 0 {
+		fmt.Fprintln(writer, "No blockers found, the model can be migrated.")
+		fmt.Fprintln(writer)
+	}
+
+	tw := output.TabWriter(writer)
+	fmt.Fprintln(tw, "Transfer\tCount\tSize")
+	for _, row := range []struct {
+		name  string
+		count int
+		bytes int64
+	}{
+		{"charms", result.Transfer.Charms, result.Transfer.CharmBytes},
+		{"resources", result.Transfer.Resources, result.Transfer.ResourceBytes},
+		{"agent binaries", result.Transfer.AgentBinaries, result.Transfer.AgentBinaryBytes},
+	} {
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", row.name, row.count, humanize.IBytes(uint64(row.bytes)))
+	}
+	return tw.Flush()
+}
+
 func (c *migrateCommand) getMigrationSpec(ctx context.Context) (*controller.MigrationSpec, error) {
 	store := c.ClientStore()
 
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/httpbakery"
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 	"gopkg.in/macaroon.v2"
@@ -419,6 +420,70 @@ func (s *MigrateSuite) TestControllerDoesNotExist(c *tc.C) {
 	c.Check(s.api.specSeen, tc.IsNil) // API shouldn't have been called
 }
 
+func (s *MigrateSuite) TestDryRun(c *tc.C) {
+	s.api.dryRunReport = controller.MigrationDryRunReport{
+		Warnings: []string{"cleanup needed"},
+		Transfer: controller.MigrationTransferEstimate{
+			Charms:           2,
+			CharmBytes:       2048,
+			Resources:        1,
+			ResourceBytes:    3 * 1024 * 1024,
+			AgentBinaries:    1,
+			AgentBinaryBytes: 1024,
+		},
+	}
+
+	ctx, err := s.makeAndRun(c, "prod/model", "target", "--dry-run")
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Warnings:
+  - cleanup needed
+
+No blockers found, the model can be migrated.
+
+Transfer        Count  Size
+charms          2      2.0 KiB
+resources       1      3.0 MiB
+agent binaries  1      1.0 KiB
+`[1:])
+	c.Check(s.api.specSeen.ModelUUID, tc.Equals, modelUUID)
+}
+
+func (s *MigrateSuite) TestDryRunBlockers(c *tc.C) {
+	s.api.dryRunReport = controller.MigrationDryRunReport{
+		Blockers: []string{"model is dying"},
+	}
+
+	ctx, err := s.makeAndRun(c, "prod/model-with-extra-local-users", "target", "--dry-run", "--format", "yaml")
+	c.Assert(err, tc.Equals, cmd.ErrSilent)
+
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+blockers:
+- |-
+  cannot initiate migration as the users granted access to the model do not exist
+  on the destination controller. To resolve this issue you can add the following
+  users to the destination controller or remove them from the current model:
+    - foo
+- model is dying
+transfer:
+  charms: 0
+  charm-bytes: 0
+  resources: 0
+  resource-bytes: 0
+  agent-binaries: 0
+  agent-binary-bytes: 0
+`[1:])
+}
+
+func (s *MigrateSuite) TestDryRunNotSupported(c *tc.C) {
+	s.api.dryRunErr = errors.NotSupportedf("migration dry run")
+
+	_, err := s.makeAndRun(c, "prod/model", "target", "--dry-run")
+	c.Assert(err, tc.ErrorMatches, "migration dry run not supported by this controller")
+}
+
 func (s *MigrateSuite) makeAndRun(c *tc.C, args ...string) (*cmd.Context, error) {
 	return cmdtesting.RunCommand(c, s.makeCommand(), args...)
 }
@@ -442,8 +507,10 @@ func (s *MigrateSuite) makeCommand() modelcmd.ModelCommand {
 }
 
 type fakeMigrateAPI struct {
-	specSeen    *controller.MigrationSpec
-	identityURL string
+	specSeen     *controller.MigrationSpec
+	identityURL  string
+	dryRunReport controller.MigrationDryRunReport
+	dryRunErr    error
 }
 
 func (a *fakeMigrateAPI) InitiateMigration(ctx context.Context, spec controller.MigrationSpec) (string, error) {
@@ -451,6 +518,11 @@ func (a *fakeMigrateAPI) InitiateMigration(ctx context.Context, spec controller.
 	return "uuid:0", nil
 }
 
+func (a *fakeMigrateAPI) DryRunMigration(ctx context.Context, spec controller.MigrationSpec) (controller.MigrationDryRunReport, error) {
+	a.specSeen = &spec
+	return a.dryRunReport, a.dryRunErr
+}
+
 func (a *fakeMigrateAPI) IdentityProviderURL(context.Context) (string, error) {
 	return a.identityURL, nil
 }
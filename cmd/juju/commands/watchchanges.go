@@ -0,0 +1,181 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/changefeed"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/cmd"
+)
+
+var usageWatchChangesSummary = `
+Streams the changes made to a model.`[1:]
+
+const usageWatchChangesDetails = `
+Changes made to the model are printed as they happen, one JSON object per
+line, until the command is interrupted. Each change records the change log ID,
+the namespace the change belongs to, the value that changed and whether the
+change was a deletion.
+
+By default, changes to all namespaces are printed. The '--namespace' option
+restricts the output to the given namespaces, and can be repeated. The
+'--list-namespaces' option prints the namespaces that can be watched and exits.
+
+Users with read access to the model can only watch the namespaces describing
+the applications, units, machines and relations of the model. Model admins
+can watch every namespace, including those of secrets.
+
+The '--from' option replays all the changes after the given change log ID,
+before printing new changes. This allows a consumer that has been
+disconnected to resume from the ID of the last change it saw. If the change
+log has already been pruned past the given ID, the command fails and the
+consumer must re-read the state of the model.
+`
+
+const usageWatchChangesExamples = `
+    juju watch-changes
+    juju watch-changes --namespace application --namespace unit
+    juju watch-changes --from 1024
+    juju watch-changes --list-namespaces
+`
+
+// ChangeFeedAPI defines the client API methods that the watch-changes
+// command uses.
+type ChangeFeedAPI interface {
+	Namespaces(ctx context.Context) ([]string, error)
+	WatchChanges(ctx context.Context, namespaces ...string) (watcher.ChangesWatcher, error)
+	WatchChangesFrom(ctx context.Context, changeLogID int64, namespaces ...string) (watcher.ChangesWatcher, error)
+	Close() error
+}
+
+func newWatchChangesCommand() cmd.Command {
+	return modelcmd.Wrap(&watchChangesCommand{})
+}
+
+type watchChangesCommand struct {
+	modelcmd.ModelCommandBase
+
+	api ChangeFeedAPI
+
+	namespaces     []string
+	from           int64
+	listNamespaces bool
+}
+
+// Info implements Command.Info.
+func (c *watchChangesCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "watch-changes",
+		Purpose:  usageWatchChangesSummary,
+		Doc:      usageWatchChangesDetails,
+		Examples: usageWatchChangesExamples,
+		SeeAlso: []string{
+			"status",
+			"debug-log",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *watchChangesCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.Var(cmd.NewAppendStringsValue(&c.namespaces), "namespace", "Only show changes for these namespaces")
+	f.Int64Var(&c.from, "from", -1, "Replay the changes after this change log ID")
+	f.BoolVar(&c.listNamespaces, "list-namespaces", false, "List the namespaces that can be watched")
+}
+
+// Init implements Command.Init.
+func (c *watchChangesCommand) Init(args []string) error {
+	if c.listNamespaces && (len(c.namespaces) > 0 || c.from >= 0) {
+		return errors.New("--list-namespaces cannot be combined with --namespace or --from")
+	}
+	if c.from < -1 {
+		return errors.NotValidf("--from %d", c.from)
+	}
+	return cmd.CheckEmpty(args)
+}
+
+func (c *watchChangesCommand) getAPI(ctx context.Context) (ChangeFeedAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return changefeed.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *watchChangesCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if c.listNamespaces {
+		namespaces, err := api.Namespaces(ctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, namespace := range namespaces {
+			fmt.Fprintln(ctx.Stdout, namespace)
+		}
+		return nil
+	}
+
+	var w watcher.ChangesWatcher
+	if c.from >= 0 {
+		w, err = api.WatchChangesFrom(ctx, c.from, c.namespaces...)
+	} else {
+		w, err = api.WatchChanges(ctx, c.namespaces...)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		w.Kill()
+		_ = w.Wait()
+	}()
+
+	encoder := json.NewEncoder(ctx.Stdout)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case changes, ok := <-w.Changes():
+			if !ok {
+				return errors.Trace(w.Wait())
+			}
+			for _, change := range changes {
+				if err := encoder.Encode(changeLine{
+					ID:        change.ID,
+					Namespace: change.Namespace,
+					Changed:   change.Changed,
+					Deleted:   change.Deleted,
+				}); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
+	}
+}
+
+// changeLine is the JSON representation of a single change.
+type changeLine struct {
+	ID        int64  `json:"id"`
+	Namespace string `json:"namespace"`
+	Changed   string `json:"changed"`
+	Deleted   bool   `json:"deleted"`
+}
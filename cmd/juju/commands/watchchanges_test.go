@@ -0,0 +1,174 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"context"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+type WatchChangesSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+
+	api *fakeChangeFeedAPI
+}
+
+func TestWatchChangesSuite(t *stdtesting.T) {
+	tc.Run(t, &WatchChangesSuite{})
+}
+
+func (s *WatchChangesSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.api = &fakeChangeFeedAPI{
+		namespaces: []string{"application", "unit"},
+		changes: [][]watcher.ChangeEvent{
+			{},
+			{{ID: 1, Namespace: "application", Changed: "foo"}},
+			{{ID: 2, Namespace: "unit", Changed: "foo/0", Deleted: true}},
+		},
+	}
+}
+
+func (s *WatchChangesSuite) newCommand() cmd.Command {
+	command := &watchChangesCommand{api: s.api}
+	command.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(command)
+}
+
+func (s *WatchChangesSuite) TestWatchChanges(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, ""+
+		`{"id":1,"namespace":"application","changed":"foo","deleted":false}`+"\n"+
+		`{"id":2,"namespace":"unit","changed":"foo/0","deleted":true}`+"\n",
+	)
+	c.Check(s.api.from, tc.Equals, int64(-1))
+	c.Check(s.api.closed, tc.IsTrue)
+}
+
+func (s *WatchChangesSuite) TestWatchChangesNamespaces(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "--namespace", "application", "--namespace", "unit")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.watched, tc.DeepEquals, []string{"application", "unit"})
+}
+
+func (s *WatchChangesSuite) TestWatchChangesFrom(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "--from", "42", "--namespace", "unit")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.from, tc.Equals, int64(42))
+	c.Check(s.api.watched, tc.DeepEquals, []string{"unit"})
+}
+
+func (s *WatchChangesSuite) TestWatchChangesError(c *tc.C) {
+	s.api.err = errors.NotFoundf(`namespace "foo"`)
+
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "--namespace", "foo")
+	c.Assert(err, tc.ErrorMatches, `namespace "foo" not found`)
+}
+
+func (s *WatchChangesSuite) TestListNamespaces(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "--list-namespaces")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "application\nunit\n")
+}
+
+func (s *WatchChangesSuite) TestInitErrors(c *tc.C) {
+	for _, test := range []struct {
+		args     []string
+		errMatch string
+	}{{
+		args:     []string{"--list-namespaces", "--namespace", "unit"},
+		errMatch: "--list-namespaces cannot be combined with --namespace or --from",
+	}, {
+		args:     []string{"--list-namespaces", "--from", "1"},
+		errMatch: "--list-namespaces cannot be combined with --namespace or --from",
+	}, {
+		args:     []string{"--from", "-2"},
+		errMatch: "--from -2 not valid",
+	}, {
+		args:     []string{"foo"},
+		errMatch: `unrecognized args: \["foo"\]`,
+	}} {
+		c.Logf("args: %v", test.args)
+		_, err := cmdtesting.RunCommand(c, s.newCommand(), test.args...)
+		c.Check(err, tc.ErrorMatches, test.errMatch)
+	}
+}
+
+type fakeChangeFeedAPI struct {
+	namespaces []string
+	changes    [][]watcher.ChangeEvent
+	err        error
+
+	watched []string
+	from    int64
+	closed  bool
+}
+
+func (f *fakeChangeFeedAPI) Namespaces(ctx context.Context) ([]string, error) {
+	return f.namespaces, f.err
+}
+
+func (f *fakeChangeFeedAPI) WatchChanges(ctx context.Context, namespaces ...string) (watcher.ChangesWatcher, error) {
+	return f.WatchChangesFrom(ctx, -1, namespaces...)
+}
+
+func (f *fakeChangeFeedAPI) WatchChangesFrom(ctx context.Context, changeLogID int64, namespaces ...string) (watcher.ChangesWatcher, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.watched = namespaces
+	f.from = changeLogID
+	return newFakeChangesWatcher(f.changes), nil
+}
+
+func (f *fakeChangeFeedAPI) Close() error {
+	f.closed = true
+	return nil
+}
+
+// fakeChangesWatcher emits the given changes and then stops.
+type fakeChangesWatcher struct {
+	tomb tomb.Tomb
+	out  chan []watcher.ChangeEvent
+}
+
+func newFakeChangesWatcher(changes [][]watcher.ChangeEvent) *fakeChangesWatcher {
+	w := &fakeChangesWatcher{out: make(chan []watcher.ChangeEvent)}
+	w.tomb.Go(func() error {
+		defer close(w.out)
+		for _, change := range changes {
+			select {
+			case <-w.tomb.Dying():
+				return tomb.ErrDying
+			case w.out <- change:
+			}
+		}
+		return nil
+	})
+	return w
+}
+
+func (w *fakeChangesWatcher) Changes() <-chan []watcher.ChangeEvent {
+	return w.out
+}
+
+func (w *fakeChangesWatcher) Kill() {
+	w.tomb.Kill(nil)
+}
+
+func (w *fakeChangesWatcher) Wait() error {
+	return w.tomb.Wait()
+}
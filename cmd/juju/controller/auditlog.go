@@ -0,0 +1,387 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	apiauditlog "github.com/juju/juju/api/client/auditlog"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewAuditLogCommand returns a command that queries the audit log records
+// of every controller machine in a controller.
+func NewAuditLogCommand() cmd.Command {
+	command := &auditLogCommand{}
+	command.newAPIs = command.auditLogAPIs
+	return modelcmd.WrapController(command)
+}
+
+// AuditLogAPI defines the methods on the AuditLog facade used by the
+// audit-log command.
+type AuditLogAPI interface {
+	Close() error
+	Query(ctx context.Context, args params.AuditLogQueryArgs) (string, []params.AuditLogEntry, bool, error)
+}
+
+const auditLogDoc = `
+Shows the API requests recorded in the audit log of the controller.
+
+Each controller machine writes its own audit log, so in a highly available
+controller every controller machine is queried and the results are merged
+into a single list, ordered by time. Only controller superusers may read
+the audit log.
+
+The --from and --to options accept either a date in the format YYYY-MM-DD
+or a timestamp in RFC3339 format. A date given to --to includes the whole
+of that day. Facade and method names are matched without regard to case.
+
+The --limit option restricts the output to the most recent entries. Each
+controller machine returns at most 10000 entries; if more match, only the
+most recent are shown, and a warning is written to stderr.
+
+The audit log is read from the files written by the "file" audit log sink,
+so it can't be queried if that sink isn't enabled in the audit-log-sinks
+controller config.
+
+If any controller machine cannot be reached or queried the command fails,
+as its audit log would be missing from the results. Use --allow-partial to
+show the audit logs of the other controller machines instead; the controller
+machines which cannot be reached or queried are reported on stderr.
+`
+
+const auditLogExamples = `
+Show who removed an application from a model, and when:
+
+    juju audit-log --model-uuid 1f2c1a49-4b3c-4c8b-8b8c-1a0a0a0a0a0a --facade Application --method DestroyApplication
+
+Show the requests made by a user since a given date:
+
+    juju audit-log --user bob --from 2025-01-01
+
+Show the last 20 requests which failed, as JSON:
+
+    juju audit-log --errors-only -n 20 --format json
+`
+
+type auditLogCommand struct {
+	modelcmd.ControllerCommandBase
+	out cmd.Output
+
+	// newAPIs returns a client for every controller machine which can be
+	// reached, and the addresses of those which can't.
+	newAPIs func(ctx context.Context) ([]AuditLogAPI, []string, error)
+
+	user         string
+	modelUUID    string
+	facade       string
+	method       string
+	fromArg      string
+	toArg        string
+	errorsOnly   bool
+	limit        int
+	allowPartial bool
+
+	from time.Time
+	to   time.Time
+}
+
+// Info implements Command.Info.
+func (c *auditLogCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "audit-log",
+		Purpose:  "Query the audit log of the controller.",
+		Doc:      auditLogDoc,
+		Examples: auditLogExamples,
+		SeeAlso: []string{
+			"controller-config",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *auditLogCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.user, "user", "", "Only show requests made by the given user")
+	f.StringVar(&c.modelUUID, "model-uuid", "", "Only show requests made to the model with the given UUID")
+	f.StringVar(&c.facade, "facade", "", "Only show requests made to the given facade")
+	f.StringVar(&c.method, "method", "", "Only show requests made to the given facade method")
+	f.StringVar(&c.fromArg, "from", "", "Only show requests made at or after the given date or time")
+	f.StringVar(&c.toArg, "to", "", "Only show requests made at or before the given date or time")
+	f.BoolVar(&c.errorsOnly, "errors-only", false, "Only show requests which failed")
+	f.IntVar(&c.limit, "n", 0, "Only show the given number of most recent requests")
+	f.IntVar(&c.limit, "limit", 0, "")
+	f.BoolVar(&c.allowPartial, "allow-partial", false, "Show the audit logs of the other controllers if some cannot be reached or queried")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatAuditLogTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *auditLogCommand) Init(args []string) error {
+	var err error
+	if c.fromArg != "" {
+		if c.from, err = parseAuditLogTime(c.fromArg); err != nil {
+			return errors.Annotate(err, "invalid --from value")
+		}
+	}
+	if c.toArg != "" {
+		if c.to, err = parseAuditLogTime(c.toArg); err != nil {
+			return errors.Annotate(err, "invalid --to value")
+		}
+		// A date is parsed as the start of the day, but the requests made
+		// during the whole of that day are expected.
+		if isAuditLogDate(c.toArg) {
+			c.to = c.to.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+	}
+	if !c.from.IsZero() && !c.to.IsZero() && c.to.Before(c.from) {
+		return errors.New("--to cannot be before --from")
+	}
+	if c.limit < 0 {
+		return errors.New("--limit cannot be negative")
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// parseAuditLogTime parses either a RFC3339 timestamp or a date in the
+// format YYYY-MM-DD.
+func parseAuditLogTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, errors.Errorf("expected YYYY-MM-DD or RFC3339 time, got %q", value)
+	}
+	return t, nil
+}
+
+// isAuditLogDate returns true if the value is a date in the format
+// YYYY-MM-DD, rather than a timestamp.
+func isAuditLogDate(value string) bool {
+	_, err := time.Parse("2006-01-02", value)
+	return err == nil
+}
+
+// auditLogAPIs returns an AuditLog client for every controller machine
+// which can be reached, and the addresses of those which can't.
+func (c *auditLogCommand) auditLogAPIs(ctx context.Context) ([]AuditLogAPI, []string, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	servers := root.APIHostPorts()
+	if len(servers) <= 1 {
+		return []AuditLogAPI{apiauditlog.NewClient(root)}, nil, nil
+	}
+	_ = root.Close()
+
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	var (
+		apis        []AuditLogAPI
+		unreachable []string
+	)
+	for _, server := range servers {
+		hostPorts := server.HostPorts().Strings()
+		conn, err := c.NewAPIRootWithDialOpts(ctx, c.ClientStore(), controllerName, "", hostPorts, nil)
+		if err != nil {
+			addrs := strings.Join(hostPorts, ", ")
+			logger.Debugf(ctx, "cannot connect to controller at %s: %v", addrs, err)
+			unreachable = append(unreachable, addrs)
+			continue
+		}
+		apis = append(apis, apiauditlog.NewClient(conn))
+	}
+	if len(apis) == 0 {
+		return nil, nil, errors.New("cannot connect to any controller")
+	}
+	return apis, unreachable, nil
+}
+
+// Run implements Command.Run.
+func (c *auditLogCommand) Run(ctx *cmd.Context) error {
+	apis, unreachable, err := c.newAPIs(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		for _, api := range apis {
+			_ = api.Close()
+		}
+	}()
+	if len(unreachable) > 0 {
+		if !c.allowPartial {
+			return errors.Errorf(
+				"cannot connect to controller at %s, use --allow-partial to show the audit logs of the other controllers",
+				strings.Join(unreachable, "; "),
+			)
+		}
+		for _, addrs := range unreachable {
+			fmt.Fprintf(ctx.Stderr, "WARNING audit log results are partial: cannot connect to controller at %s\n", addrs)
+		}
+	}
+
+	args := params.AuditLogQueryArgs{
+		User:       c.user,
+		ModelUUID:  c.modelUUID,
+		Facade:     c.facade,
+		Method:     c.method,
+		ErrorsOnly: c.errorsOnly,
+		Limit:      c.limit,
+	}
+	if !c.from.IsZero() {
+		args.From = &c.from
+	}
+	if !c.to.IsZero() {
+		args.To = &c.to
+	}
+
+	var (
+		entries     []AuditLogEntry
+		queryErrors []error
+		seen        = make(map[string]bool)
+	)
+	for _, api := range apis {
+		controllerID, results, truncated, err := api.Query(ctx, args)
+		if err != nil {
+			err = errors.Annotatef(err, "querying audit log of controller %q", controllerID)
+			if !c.allowPartial {
+				return err
+			}
+			queryErrors = append(queryErrors, err)
+			continue
+		}
+		// Connections to different addresses may end up on the same
+		// controller machine, only count its entries once.
+		if seen[controllerID] {
+			continue
+		}
+		seen[controllerID] = true
+		// Results cut by --limit are expected, only warn when the
+		// controller returned fewer entries than were asked for.
+		if truncated && (c.limit == 0 || len(results) < c.limit) {
+			fmt.Fprintf(ctx.Stderr, "WARNING audit log results of controller %q are truncated to the %d most recent entries, use --from and --to to narrow the query\n", controllerID, len(results))
+		}
+		for _, e := range results {
+			entries = append(entries, newAuditLogEntry(controllerID, e))
+		}
+	}
+	if len(queryErrors) == len(apis) {
+		return errors.Trace(queryErrors[0])
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].When.Before(entries[j].When)
+	})
+	if c.limit > 0 && len(entries) > c.limit {
+		entries = entries[len(entries)-c.limit:]
+	}
+	if err := c.out.Write(ctx, entries); err != nil {
+		return errors.Trace(err)
+	}
+	for _, err := range queryErrors {
+		fmt.Fprintf(ctx.Stderr, "WARNING audit log results are partial: %v\n", err)
+	}
+	return nil
+}
+
+// AuditLogEntry is the serialised form of an audit log entry.
+type AuditLogEntry struct {
+	Controller     string          `json:"controller" yaml:"controller"`
+	When           time.Time       `json:"when" yaml:"when"`
+	User           string          `json:"user" yaml:"user"`
+	Command        string          `json:"command,omitempty" yaml:"command,omitempty"`
+	ModelName      string          `json:"model-name,omitempty" yaml:"model-name,omitempty"`
+	ModelUUID      string          `json:"model-uuid,omitempty" yaml:"model-uuid,omitempty"`
+	Facade         string          `json:"facade" yaml:"facade"`
+	Method         string          `json:"method" yaml:"method"`
+	Version        int             `json:"version" yaml:"version"`
+	Args           string          `json:"args,omitempty" yaml:"args,omitempty"`
+	Errors         []AuditLogError `json:"errors,omitempty" yaml:"errors,omitempty"`
+	ConversationID string          `json:"conversation-id" yaml:"conversation-id"`
+	ConnectionID   string          `json:"connection-id" yaml:"connection-id"`
+	RequestID      uint64          `json:"request-id" yaml:"request-id"`
+}
+
+// AuditLogError is the serialised form of an error returned by an audited
+// request.
+type AuditLogError struct {
+	Message string `json:"message" yaml:"message"`
+	Code    string `json:"code,omitempty" yaml:"code,omitempty"`
+}
+
+func newAuditLogEntry(controllerID string, e params.AuditLogEntry) AuditLogEntry {
+	entry := AuditLogEntry{
+		Controller:     controllerID,
+		When:           e.When,
+		User:           e.User,
+		Command:        e.Command,
+		ModelName:      e.ModelName,
+		ModelUUID:      e.ModelUUID,
+		Facade:         e.Facade,
+		Method:         e.Method,
+		Version:        e.Version,
+		Args:           e.Args,
+		ConversationID: e.ConversationID,
+		ConnectionID:   e.ConnectionID,
+		RequestID:      e.RequestID,
+	}
+	for _, err := range e.Errors {
+		entry.Errors = append(entry.Errors, AuditLogError{
+			Message: err.Message,
+			Code:    err.Code,
+		})
+	}
+	return entry
+}
+
+func formatAuditLogTabular(writer io.Writer, value interface{}) error {
+	entries, ok := value.([]AuditLogEntry)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", entries, value)
+	}
+	if len(entries) == 0 {
+		fmt.Fprintln(writer, "No matching audit log entries.")
+		return nil
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Time", "Controller", "User", "Model", "Request", "Error")
+	for _, e := range entries {
+		model := e.ModelName
+		if model == "" {
+			model = noValueDisplay
+		}
+		request := fmt.Sprintf("%s(%d).%s", e.Facade, e.Version, e.Method)
+		errMessage := noValueDisplay
+		if len(e.Errors) > 0 {
+			messages := make([]string, len(e.Errors))
+			for i, err := range e.Errors {
+				messages[i] = err.Message
+			}
+			errMessage = strings.Join(messages, "; ")
+		}
+		w.Println(e.When.UTC().Format(time.RFC3339), e.Controller, e.User, model, request, errMessage)
+	}
+	return tw.Flush()
+}
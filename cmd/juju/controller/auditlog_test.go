@@ -0,0 +1,312 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/controller"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type auditLogSuite struct {
+	baseControllerSuite
+}
+
+func TestAuditLogSuite(t *testing.T) {
+	tc.Run(t, &auditLogSuite{})
+}
+
+type fakeAuditLogAPI struct {
+	controllerID string
+	entries      []params.AuditLogEntry
+	truncated    bool
+	err          error
+
+	args   params.AuditLogQueryArgs
+	closed bool
+}
+
+func (f *fakeAuditLogAPI) Close() error {
+	f.closed = true
+	return nil
+}
+
+func (f *fakeAuditLogAPI) Query(_ context.Context, args params.AuditLogQueryArgs) (string, []params.AuditLogEntry, bool, error) {
+	f.args = args
+	return f.controllerID, f.entries, f.truncated, f.err
+}
+
+var auditLogBaseTime = time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+
+func auditLogEntry(offset time.Duration, user, method string) params.AuditLogEntry {
+	return params.AuditLogEntry{
+		ConversationID: "0123456789abcdef",
+		ConnectionID:   "C0",
+		RequestID:      1,
+		When:           auditLogBaseTime.Add(offset),
+		User:           user,
+		ModelName:      "prod",
+		ModelUUID:      "model-uuid",
+		Facade:         "Application",
+		Method:         method,
+		Version:        20,
+	}
+}
+
+func (s *auditLogSuite) run(c *tc.C, apis []controller.AuditLogAPI, args ...string) (string, error) {
+	s.createTestClientStore(c)
+	command := controller.NewAuditLogCommandForTest(s.store, apis...)
+	ctx, err := cmdtesting.RunCommand(c, command, args...)
+	if err != nil {
+		return "", err
+	}
+	return cmdtesting.Stdout(ctx), nil
+}
+
+func (s *auditLogSuite) TestInitErrors(c *tc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"--from", "yesterday"},
+		err:  `invalid --from value: expected YYYY-MM-DD or RFC3339 time, got "yesterday"`,
+	}, {
+		args: []string{"--to", "2025-13-01"},
+		err:  `invalid --to value: expected YYYY-MM-DD or RFC3339 time, got "2025-13-01"`,
+	}, {
+		args: []string{"--from", "2025-02-01", "--to", "2025-01-01"},
+		err:  "--to cannot be before --from",
+	}, {
+		args: []string{"-n", "-1"},
+		err:  "--limit cannot be negative",
+	}, {
+		args: []string{"extra"},
+		err:  `unrecognized args: \["extra"\]`,
+	}} {
+		_, err := s.run(c, nil, test.args...)
+		c.Check(err, tc.ErrorMatches, test.err, tc.Commentf("args %v", test.args))
+	}
+}
+
+func (s *auditLogSuite) TestQueryArgs(c *tc.C) {
+	api := &fakeAuditLogAPI{controllerID: "0"}
+	_, err := s.run(c, []controller.AuditLogAPI{api},
+		"--user", "bob",
+		"--model-uuid", "model-uuid",
+		"--facade", "application",
+		"--method", "destroyapplication",
+		"--from", "2025-01-01",
+		"--to", "2025-01-02T15:04:05Z",
+		"--errors-only",
+		"--limit", "10",
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	c.Check(api.args, tc.DeepEquals, params.AuditLogQueryArgs{
+		User:       "bob",
+		ModelUUID:  "model-uuid",
+		Facade:     "application",
+		Method:     "destroyapplication",
+		From:       &from,
+		To:         &to,
+		ErrorsOnly: true,
+		Limit:      10,
+	})
+	c.Check(api.closed, tc.IsTrue)
+}
+
+func (s *auditLogSuite) TestToDateIncludesWholeDay(c *tc.C) {
+	api := &fakeAuditLogAPI{controllerID: "0"}
+	_, err := s.run(c, []controller.AuditLogAPI{api}, "--from", "2025-01-02", "--to", "2025-01-02")
+	c.Assert(err, tc.ErrorIsNil)
+
+	from := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 2, 23, 59, 59, 999999999, time.UTC)
+	c.Check(api.args.From, tc.DeepEquals, &from)
+	c.Check(api.args.To, tc.DeepEquals, &to)
+}
+
+func (s *auditLogSuite) TestTruncatedResults(c *tc.C) {
+	s.createTestClientStore(c)
+	api := &fakeAuditLogAPI{
+		controllerID: "0",
+		entries:      []params.AuditLogEntry{auditLogEntry(0, "admin", "Deploy")},
+		truncated:    true,
+	}
+	command := controller.NewAuditLogCommandForTest(s.store, api)
+	ctx, err := cmdtesting.RunCommand(c, command, "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Contains, `"method":"Deploy"`)
+	c.Check(cmdtesting.Stderr(ctx), tc.Contains, `audit log results of controller "0" are truncated to the 1 most recent entries`)
+}
+
+func (s *auditLogSuite) TestTruncatedByLimit(c *tc.C) {
+	s.createTestClientStore(c)
+	api := &fakeAuditLogAPI{
+		controllerID: "0",
+		entries:      []params.AuditLogEntry{auditLogEntry(0, "admin", "Deploy")},
+		truncated:    true,
+	}
+	// The controller only cut the results to the requested limit, so
+	// there's nothing to warn about.
+	command := controller.NewAuditLogCommandForTest(s.store, api)
+	ctx, err := cmdtesting.RunCommand(c, command, "-n", "1", "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Contains, `"method":"Deploy"`)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "")
+}
+
+func (s *auditLogSuite) TestMergesControllers(c *tc.C) {
+	api0 := &fakeAuditLogAPI{
+		controllerID: "0",
+		entries: []params.AuditLogEntry{
+			auditLogEntry(0, "admin", "Deploy"),
+			auditLogEntry(2*time.Minute, "bob", "DestroyApplication"),
+		},
+	}
+	failed := auditLogEntry(time.Minute, "alice", "SetConfigs")
+	failed.Errors = []params.AuditLogError{{Message: "application not found", Code: "not found"}}
+	api1 := &fakeAuditLogAPI{
+		controllerID: "1",
+		entries:      []params.AuditLogEntry{failed},
+	}
+	// A second connection which landed on controller 1 again.
+	api1Again := &fakeAuditLogAPI{
+		controllerID: "1",
+		entries:      []params.AuditLogEntry{failed},
+	}
+
+	out, err := s.run(c, []controller.AuditLogAPI{api0, api1, api1Again})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(out, tc.Equals, `
+Time                  Controller  User   Model  Request                             Error
+2025-03-04T12:00:00Z  0           admin  prod   Application(20).Deploy              -
+2025-03-04T12:01:00Z  1           alice  prod   Application(20).SetConfigs          application not found
+2025-03-04T12:02:00Z  0           bob    prod   Application(20).DestroyApplication  -
+`[1:])
+}
+
+func (s *auditLogSuite) TestLimitKeepsMostRecent(c *tc.C) {
+	api0 := &fakeAuditLogAPI{
+		controllerID: "0",
+		entries: []params.AuditLogEntry{
+			auditLogEntry(0, "admin", "Deploy"),
+			auditLogEntry(2*time.Minute, "bob", "DestroyApplication"),
+		},
+	}
+	api1 := &fakeAuditLogAPI{
+		controllerID: "1",
+		entries: []params.AuditLogEntry{
+			auditLogEntry(time.Minute, "alice", "SetConfigs"),
+		},
+	}
+
+	out, err := s.run(c, []controller.AuditLogAPI{api0, api1}, "-n", "2", "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(out, tc.Equals, `
+- controller: "1"
+  when: 2025-03-04T12:01:00Z
+  user: alice
+  model-name: prod
+  model-uuid: model-uuid
+  facade: Application
+  method: SetConfigs
+  version: 20
+  conversation-id: 0123456789abcdef
+  connection-id: C0
+  request-id: 1
+- controller: "0"
+  when: 2025-03-04T12:02:00Z
+  user: bob
+  model-name: prod
+  model-uuid: model-uuid
+  facade: Application
+  method: DestroyApplication
+  version: 20
+  conversation-id: 0123456789abcdef
+  connection-id: C0
+  request-id: 1
+`[1:])
+}
+
+func (s *auditLogSuite) TestJSON(c *tc.C) {
+	api := &fakeAuditLogAPI{
+		controllerID: "0",
+		entries:      []params.AuditLogEntry{auditLogEntry(0, "admin", "Deploy")},
+	}
+	out, err := s.run(c, []controller.AuditLogAPI{api}, "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(out, tc.Equals, `[{"controller":"0","when":"2025-03-04T12:00:00Z","user":"admin","model-name":"prod","model-uuid":"model-uuid","facade":"Application","method":"Deploy","version":20,"conversation-id":"0123456789abcdef","connection-id":"C0","request-id":1}]`+"\n")
+}
+
+func (s *auditLogSuite) TestNoEntries(c *tc.C) {
+	api := &fakeAuditLogAPI{controllerID: "0"}
+	out, err := s.run(c, []controller.AuditLogAPI{api})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(out, tc.Equals, "No matching audit log entries.\n")
+}
+
+func (s *auditLogSuite) TestQueryError(c *tc.C) {
+	api := &fakeAuditLogAPI{controllerID: "2", err: errors.New("permission denied")}
+	_, err := s.run(c, []controller.AuditLogAPI{api})
+	c.Assert(err, tc.ErrorMatches, `querying audit log of controller "2": permission denied`)
+}
+
+func (s *auditLogSuite) TestUnreachableController(c *tc.C) {
+	s.createTestClientStore(c)
+	api := &fakeAuditLogAPI{
+		controllerID: "0",
+		entries:      []params.AuditLogEntry{auditLogEntry(0, "admin", "Deploy")},
+	}
+	command := controller.NewPartialAuditLogCommandForTest(s.store, []string{"10.0.0.2:17070"}, api)
+	_, err := cmdtesting.RunCommand(c, command)
+	c.Assert(err, tc.ErrorMatches, `cannot connect to controller at 10.0.0.2:17070, use --allow-partial to show the audit logs of the other controllers`)
+	c.Check(api.closed, tc.IsTrue)
+	c.Check(api.args, tc.DeepEquals, params.AuditLogQueryArgs{})
+}
+
+func (s *auditLogSuite) TestAllowPartial(c *tc.C) {
+	s.createTestClientStore(c)
+	api := &fakeAuditLogAPI{
+		controllerID: "0",
+		entries:      []params.AuditLogEntry{auditLogEntry(0, "admin", "Deploy")},
+	}
+	command := controller.NewPartialAuditLogCommandForTest(s.store, []string{"10.0.0.2:17070"}, api)
+	ctx, err := cmdtesting.RunCommand(c, command, "--allow-partial", "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Contains, `"method":"Deploy"`)
+	c.Check(cmdtesting.Stderr(ctx), tc.Contains, "audit log results are partial: cannot connect to controller at 10.0.0.2:17070")
+}
+
+func (s *auditLogSuite) TestAllowPartialQueryError(c *tc.C) {
+	s.createTestClientStore(c)
+	api0 := &fakeAuditLogAPI{
+		controllerID: "0",
+		entries:      []params.AuditLogEntry{auditLogEntry(0, "admin", "Deploy")},
+	}
+	api1 := &fakeAuditLogAPI{controllerID: "1", err: errors.New("audit log sink not enabled")}
+	command := controller.NewAuditLogCommandForTest(s.store, api0, api1)
+	ctx, err := cmdtesting.RunCommand(c, command, "--allow-partial", "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Contains, `"method":"Deploy"`)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals,
+		"WARNING audit log results are partial: querying audit log of controller \"1\": audit log sink not enabled\n")
+	c.Check(api0.closed, tc.IsTrue)
+	c.Check(api1.closed, tc.IsTrue)
+}
+
+func (s *auditLogSuite) TestAllowPartialAllQueriesFail(c *tc.C) {
+	api := &fakeAuditLogAPI{controllerID: "2", err: errors.New("permission denied")}
+	_, err := s.run(c, []controller.AuditLogAPI{api}, "--allow-partial")
+	c.Assert(err, tc.ErrorMatches, `querying audit log of controller "2": permission denied`)
+}
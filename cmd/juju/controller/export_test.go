@@ -188,3 +188,22 @@ func NewData(ctx context.Context, api destroyControllerAPI, ctrUUID string) (env
 var (
 	NoModelsMessage = noModelsMessage
 )
+
+// NewAuditLogCommandForTest returns an audit-log command which queries the
+// given APIs.
+func NewAuditLogCommandForTest(store jujuclient.ClientStore, apis ...AuditLogAPI) cmd.Command {
+	return NewPartialAuditLogCommandForTest(store, nil, apis...)
+}
+
+// NewPartialAuditLogCommandForTest returns an audit-log command which
+// queries the given APIs, and can't reach the controllers at the given
+// addresses.
+func NewPartialAuditLogCommandForTest(store jujuclient.ClientStore, unreachable []string, apis ...AuditLogAPI) cmd.Command {
+	c := &auditLogCommand{
+		newAPIs: func(context.Context) ([]AuditLogAPI, []string, error) {
+			return apis, unreachable, nil
+		},
+	}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
@@ -30,6 +30,9 @@ type dumpDBCommand struct {
 const dumpDBHelpDoc = `
 dump-db returns all that is stored in the database for the specified model.
 
+To take a snapshot of the model's database that can be imported into another
+model, use export-model-db.
+
 Examples:
 
     juju dump-db
@@ -37,13 +40,14 @@ Examples:
 
 See also:
     models
+    export-model-db
 `
 
 // Info implements Command.
 func (c *dumpDBCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:    "dump-db",
-		Purpose: "Displays the mongo documents for of the model.",
+		Purpose: "Displays the contents of the model's database.",
 		Doc:     dumpDBHelpDoc,
 	})
 }
@@ -72,6 +72,20 @@ func NewDumpDBCommandForTest(api DumpDBAPI, store jujuclient.ClientStore) cmd.Co
 	return modelcmd.Wrap(cmd)
 }
 
+// NewExportDBCommandForTest returns an ExportDBCommand with the api provided as specified.
+func NewExportDBCommandForTest(api ExportDBAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &exportDBCommand{api: api}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+// NewImportDBCommandForTest returns an ImportDBCommand with the api provided as specified.
+func NewImportDBCommandForTest(api ImportDBAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &importDBCommand{api: api}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
 // NewExportBundleCommandForTest returns a ExportBundleCommand with the api provided as specified.
 func NewExportBundleCommandForTest(bundleAPI ExportBundleAPI, store jujuclient.ClientStore) cmd.Command {
 	cmd := &exportBundleCommand{newAPIFunc: func(ctx context.Context) (ExportBundleAPI, error) {
@@ -0,0 +1,140 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/backups"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/jujuclient"
+)
+
+// NewExportDBCommand returns a fully constructed export-model-db command.
+func NewExportDBCommand() cmd.Command {
+	return modelcmd.Wrap(&exportDBCommand{})
+}
+
+type exportDBCommand struct {
+	modelcmd.ModelCommandBase
+	api ExportDBAPI
+
+	filename string
+}
+
+const exportDBHelpDoc = `
+Exports a point-in-time snapshot of the model's database, along with every
+object in the model's object store that the database references, such as
+charm archives and resources. The snapshot is taken in a single transaction,
+so it is consistent.
+
+The snapshot can be imported into a new, empty model on the same or another
+controller running the same version of Juju with import-model-db.
+
+If --filename is not used, the snapshot is written to a file named after the
+model and the current time in the current directory. The name of the file is
+printed to stdout.
+
+Exporting a model database requires superuser access to the controller.
+`
+
+const exportDBExamples = `
+    juju export-model-db
+    juju export-model-db -m mymodel --filename mymodel.tar.gz
+`
+
+// Info implements Command.
+func (c *exportDBCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "export-model-db",
+		Purpose:  "Exports a snapshot of the model's database.",
+		Doc:      exportDBHelpDoc,
+		Examples: exportDBExamples,
+		SeeAlso: []string{
+			"import-model-db",
+			"create-backup",
+		},
+	})
+}
+
+// SetFlags implements Command.
+func (c *exportDBCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.filename, "filename", "", "File to write the snapshot to")
+}
+
+// Init implements Command.
+func (c *exportDBCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// ExportDBAPI specifies the used function calls of the backups client.
+type ExportDBAPI interface {
+	Close() error
+	ExportModelDB(context.Context) (io.ReadCloser, error)
+}
+
+func (c *exportDBCommand) getAPI(ctx context.Context) (ExportDBAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return backups.NewClient(root), nil
+}
+
+// Run implements Command.
+func (c *exportDBCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	filename := c.filename
+	if filename == "" {
+		modelName, _, err := c.ModelDetails(ctx)
+		if err != nil {
+			return errors.Annotate(err, "getting model details")
+		}
+		if jujuclient.IsQualifiedModelName(modelName) {
+			if modelName, _, err = jujuclient.SplitFullyQualifiedModelName(modelName); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		filename = fmt.Sprintf("%s-db-%s.tar.gz", modelName, time.Now().UTC().Format("20060102-150405"))
+	}
+
+	snapshot, err := client.ExportModelDB(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = snapshot.Close() }()
+
+	f, err := c.Filesystem().Create(ctx.AbsPath(filename))
+	if err != nil {
+		return errors.Annotate(err, "creating snapshot file")
+	}
+	defer func() { _ = f.Close() }()
+
+	if _, err := io.Copy(f, snapshot); err != nil {
+		return errors.Annotate(err, "writing snapshot file")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Annotate(err, "writing snapshot file")
+	}
+
+	fmt.Fprintln(ctx.Stdout, filename)
+	return nil
+}
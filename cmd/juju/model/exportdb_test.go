@@ -0,0 +1,131 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/model"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/jujuclient"
+)
+
+type ModelDBCommandSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	fake  fakeModelDBClient
+	store *jujuclient.MemStore
+}
+
+func TestModelDBCommandSuite(t *stdtesting.T) {
+	tc.Run(t, &ModelDBCommandSuite{})
+}
+
+func (s *ModelDBCommandSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.fake = fakeModelDBClient{}
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "testing"
+	s.store.Controllers["testing"] = jujuclient.ControllerDetails{}
+	s.store.Accounts["testing"] = jujuclient.AccountDetails{
+		User: "admin",
+	}
+	err := s.store.UpdateModel("testing", "admin/mymodel", jujuclient.ModelDetails{
+		ModelUUID: testing.ModelTag.Id(),
+		ModelType: coremodel.IAAS,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	s.store.Models["testing"].CurrentModel = "admin/mymodel"
+}
+
+func (s *ModelDBCommandSuite) TestExport(c *tc.C) {
+	filename := filepath.Join(c.MkDir(), "snapshot.tar.gz")
+	ctx, err := cmdtesting.RunCommand(c, model.NewExportDBCommandForTest(&s.fake, s.store), "--filename", filename)
+	c.Assert(err, tc.ErrorIsNil)
+	s.fake.CheckCallNames(c, "ExportModelDB", "Close")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, filename+"\n")
+
+	data, err := os.ReadFile(filename)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "snapshot")
+}
+
+func (s *ModelDBCommandSuite) TestExportDefaultFilename(c *tc.C) {
+	dir := c.MkDir()
+	ctx, err := cmdtesting.RunCommandInDir(c, model.NewExportDBCommandForTest(&s.fake, s.store), nil, dir)
+	c.Assert(err, tc.ErrorIsNil)
+
+	filename := strings.TrimSpace(cmdtesting.Stdout(ctx))
+	c.Check(filename, tc.Matches, `mymodel-db-\d{8}-\d{6}\.tar\.gz`)
+	_, err = os.Stat(filepath.Join(dir, filename))
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *ModelDBCommandSuite) TestExportError(c *tc.C) {
+	s.fake.SetErrors(errors.New("boom"))
+	_, err := cmdtesting.RunCommand(c, model.NewExportDBCommandForTest(&s.fake, s.store), "--filename", filepath.Join(c.MkDir(), "x"))
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *ModelDBCommandSuite) TestImport(c *tc.C) {
+	filename := filepath.Join(c.MkDir(), "snapshot.tar.gz")
+	c.Assert(os.WriteFile(filename, []byte("snapshot"), 0600), tc.ErrorIsNil)
+
+	_, err := cmdtesting.RunCommand(c, model.NewImportDBCommandForTest(&s.fake, s.store), filename)
+	c.Assert(err, tc.ErrorIsNil)
+	s.fake.CheckCalls(c, []testhelpers.StubCall{
+		{"ImportModelDB", []interface{}{"snapshot"}},
+		{"Close", nil},
+	})
+}
+
+func (s *ModelDBCommandSuite) TestImportMissingFile(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, model.NewImportDBCommandForTest(&s.fake, s.store), filepath.Join(c.MkDir(), "missing"))
+	c.Assert(err, tc.ErrorMatches, "opening snapshot file: .*")
+	s.fake.CheckNoCalls(c)
+}
+
+func (s *ModelDBCommandSuite) TestImportInit(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, model.NewImportDBCommandForTest(&s.fake, s.store))
+	c.Assert(err, tc.ErrorMatches, "missing snapshot filename")
+
+	_, err = cmdtesting.RunCommand(c, model.NewImportDBCommandForTest(&s.fake, s.store), "a", "b")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["b"\]`)
+}
+
+type fakeModelDBClient struct {
+	testhelpers.Stub
+}
+
+func (f *fakeModelDBClient) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeModelDBClient) ExportModelDB(context.Context) (io.ReadCloser, error) {
+	f.MethodCall(f, "ExportModelDB")
+	if err := f.NextErr(); err != nil {
+		return nil, err
+	}
+	return io.NopCloser(strings.NewReader("snapshot")), nil
+}
+
+func (f *fakeModelDBClient) ImportModelDB(_ context.Context, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	f.MethodCall(f, "ImportModelDB", string(data))
+	return f.NextErr()
+}
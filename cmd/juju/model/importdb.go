@@ -0,0 +1,121 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"io"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/backups"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// NewImportDBCommand returns a fully constructed import-model-db command.
+func NewImportDBCommand() cmd.Command {
+	return modelcmd.Wrap(&importDBCommand{})
+}
+
+type importDBCommand struct {
+	modelcmd.ModelCommandBase
+	api ImportDBAPI
+
+	filename string
+}
+
+const importDBHelpDoc = `
+Imports a snapshot of a model's database, created with export-model-db, into
+the current model. The contents of the model's database are replaced with
+those of the snapshot, and the objects in the snapshot, such as charm
+archives and resources, are added to the model's object store.
+
+The model being imported into keeps its own name and UUID, so a snapshot can
+be imported into a new model on the same controller as the exported model,
+or on another controller. The model must be empty: it cannot have any
+applications or machines. The snapshot is rejected if it was taken with a
+different version of the model database schema.
+
+The imported model doesn't take over the cloud resources of the exported
+model: the instance IDs of its machines and the provider IDs of its volumes,
+filesystems, spaces, subnets and pods are cleared, so that new resources are
+provisioned for the imported model.
+
+Importing a model database requires superuser access to the controller.
+`
+
+const importDBExamples = `
+    juju add-model clone
+    juju import-model-db -m clone mymodel.tar.gz
+`
+
+// Info implements Command.
+func (c *importDBCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "import-model-db",
+		Args:     "<filename>",
+		Purpose:  "Imports a snapshot of a model's database into an empty model.",
+		Doc:      importDBHelpDoc,
+		Examples: importDBExamples,
+		SeeAlso: []string{
+			"export-model-db",
+			"add-model",
+		},
+	})
+}
+
+// SetFlags implements Command.
+func (c *importDBCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+}
+
+// Init implements Command.
+func (c *importDBCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing snapshot filename")
+	}
+	c.filename, args = args[0], args[1:]
+	return cmd.CheckEmpty(args)
+}
+
+// ImportDBAPI specifies the used function calls of the backups client.
+type ImportDBAPI interface {
+	Close() error
+	ImportModelDB(context.Context, io.Reader) error
+}
+
+func (c *importDBCommand) getAPI(ctx context.Context) (ImportDBAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return backups.NewClient(root), nil
+}
+
+// Run implements Command.
+func (c *importDBCommand) Run(ctx *cmd.Context) error {
+	f, err := c.Filesystem().Open(ctx.AbsPath(c.filename))
+	if err != nil {
+		return errors.Annotate(err, "opening snapshot file")
+	}
+	defer func() { _ = f.Close() }()
+
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	if err := client.ImportModelDB(ctx, f); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Imported model database from %s", c.filename)
+	return nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+const listObjectsDoc = `
+Lists the objects held in the object store for each model on the controller,
+along with their size, hashes and the charms, resources and agent binaries
+that reference them.
+
+If no models are named, the objects of every model are listed. Only
+controller superusers may inspect the object store.
+`
+
+const listObjectsExamples = `
+List the objects of every model:
+
+    juju objects
+
+List the objects of a single model, as YAML:
+
+    juju objects mymodel --format yaml
+`
+
+// NewListObjectsCommand returns a command that lists the objects in the
+// object stores of the models on a controller.
+func NewListObjectsCommand() cmd.Command {
+	command := &listObjectsCommand{}
+	command.newAPIFunc = command.objectStoreAPI
+	return modelcmd.WrapController(command)
+}
+
+type listObjectsCommand struct {
+	objectStoreCommandBase
+	out cmd.Output
+}
+
+// Info implements Command.Info.
+func (c *listObjectsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "objects",
+		Args:     "[<model name> ...]",
+		Purpose:  "Lists the objects in the object store of the controller.",
+		Doc:      listObjectsDoc,
+		Aliases:  []string{"list-objects"},
+		Examples: listObjectsExamples,
+		SeeAlso: []string{
+			"show-object",
+			"verify-objects",
+			"prune-objects",
+			"object-store-usage",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listObjectsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatObjectsTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *listObjectsCommand) Init(args []string) error {
+	c.modelNames = args
+	return nil
+}
+
+// ModelObjects is the serialised form of the objects in the object store of
+// a model.
+type ModelObjects struct {
+	Objects []Object `json:"objects" yaml:"objects"`
+	Error   string   `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Run implements Command.Run.
+func (c *listObjectsCommand) Run(ctx *cmd.Context) error {
+	modelUUIDs, err := c.modelUUIDs(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = api.Close() }()
+
+	results, err := api.ListObjects(ctx, modelUUIDs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	names := c.modelNamesByUUID()
+	models := make(map[string]ModelObjects)
+	for _, result := range results {
+		var model ModelObjects
+		if result.Error != nil {
+			model.Error = result.Error.Error()
+		}
+		for _, object := range result.Objects {
+			model.Objects = append(model.Objects, newObject(object))
+		}
+		models[displayName(names, result.ModelUUID)] = model
+	}
+	return c.out.Write(ctx, models)
+}
+
+func formatObjectsTabular(writer io.Writer, value interface{}) error {
+	models, ok := value.(map[string]ModelObjects)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", models, value)
+	}
+
+	var (
+		names   []string
+		objects int
+	)
+	for name, model := range models {
+		names = append(names, name)
+		objects += len(model.Objects)
+	}
+	sort.Strings(names)
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	if objects > 0 {
+		w.Println("Model", "SHA256", "Size", "Paths", "References")
+		for _, name := range names {
+			for _, object := range models[name].Objects {
+				w.Println(name, shortHash(object.SHA256), formatSize(object.Size), joinOrNone(object.Paths), joinOrNone(object.References))
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return errors.Trace(err)
+		}
+	} else {
+		fmt.Fprintln(writer, "No objects to display.")
+	}
+
+	for _, name := range names {
+		if models[name].Error != "" {
+			fmt.Fprintf(writer, "ERROR listing objects of %q: %s\n", name, models[name].Error)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/cmd/juju/objectstore"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type listSuite struct {
+	baseSuite
+}
+
+func TestListSuite(t *testing.T) {
+	tc.Run(t, &listSuite{})
+}
+
+func (s *listSuite) expectListObjects(modelUUIDs []string) {
+	s.api.EXPECT().ListObjects(gomock.Any(), modelUUIDs).Return([]params.ObjectStoreListResult{{
+		ModelUUID: modelUUID,
+		Objects: []params.ObjectStoreObject{{
+			SHA256: "6c7b5bd4e0f5a1b2c3d4",
+			SHA384: "sha384",
+			Size:   2048,
+			Paths:  []string{"charms/foo-1"},
+			References: []params.ObjectStoreReference{{
+				Kind: "charm",
+				Name: "foo-1",
+			}},
+		}},
+	}, {
+		ModelUUID: otherModelUUID,
+		Error:     &params.Error{Message: "boom"},
+	}}, nil)
+}
+
+func (s *listSuite) TestListTabular(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListObjects(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewListObjectsCommandForTest(s.store, s.api))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Model          SHA256        Size     Paths         References
+admin/mymodel  6c7b5bd4e0f5  2.0 KiB  charms/foo-1  charm:foo-1
+ERROR listing objects of "deadbeef-0bad-400d-8000-4b1d0d06f00e": boom
+`[1:])
+}
+
+func (s *listSuite) TestListNamedModelYAML(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListObjects([]string{modelUUID})
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewListObjectsCommandForTest(s.store, s.api), "admin/mymodel", "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+admin/mymodel:
+  objects:
+  - sha256: 6c7b5bd4e0f5a1b2c3d4
+    sha384: sha384
+    size: 2048
+    paths:
+    - charms/foo-1
+    references:
+    - charm:foo-1
+deadbeef-0bad-400d-8000-4b1d0d06f00e:
+  objects: []
+  error: boom
+`[1:])
+}
+
+func (s *listSuite) TestListNoObjects(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().ListObjects(gomock.Any(), nil).Return([]params.ObjectStoreListResult{{
+		ModelUUID: modelUUID,
+	}}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewListObjectsCommandForTest(s.store, s.api))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "No objects to display.\n")
+}
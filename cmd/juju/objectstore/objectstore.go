@@ -0,0 +1,132 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package objectstore provides the commands used by controller superusers to
+// inspect, verify and prune the object stores holding the charms, resources
+// and agent binaries of the models on a controller.
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/juju/errors"
+
+	apiobjectstore "github.com/juju/juju/api/client/objectstore"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ObjectStoreAPI defines the methods on the ObjectStore facade used by the
+// object store commands.
+type ObjectStoreAPI interface {
+	Close() error
+	ListObjects(ctx context.Context, modelUUIDs []string) ([]params.ObjectStoreListResult, error)
+	ShowObject(ctx context.Context, modelUUID, key string) (params.ObjectStoreObject, error)
+	VerifyObjects(ctx context.Context, modelUUIDs []string) ([]params.ObjectStoreVerifyResult, error)
+	PruneObjects(ctx context.Context, modelUUIDs []string, minAge time.Duration, dryRun bool) ([]params.ObjectStorePruneResult, error)
+	Usage(ctx context.Context, modelUUIDs []string) (string, []params.ObjectStoreUsageResult, error)
+}
+
+const noValueDisplay = "-"
+
+// objectStoreCommandBase holds the behaviour shared by the object store
+// commands, which operate on the object stores of the named models, or of
+// every model if none are named.
+type objectStoreCommandBase struct {
+	modelcmd.ControllerCommandBase
+
+	newAPIFunc func(ctx context.Context) (ObjectStoreAPI, error)
+
+	modelNames []string
+}
+
+func (c *objectStoreCommandBase) objectStoreAPI(ctx context.Context) (ObjectStoreAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apiobjectstore.NewClient(root), nil
+}
+
+// modelUUIDs returns the uuids of the named models. No uuids are returned if
+// no models were named, in which case every model is used.
+func (c *objectStoreCommandBase) modelUUIDs(ctx context.Context) ([]string, error) {
+	if len(c.modelNames) == 0 {
+		return nil, nil
+	}
+	return c.ModelUUIDs(ctx, c.modelNames)
+}
+
+// modelNamesByUUID returns the names of the models known locally, keyed by
+// their uuid. It's used to display models by name, falling back to the uuid
+// for models that aren't known locally.
+func (c *objectStoreCommandBase) modelNamesByUUID() map[string]string {
+	names := make(map[string]string)
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return names
+	}
+	models, err := c.ClientStore().AllModels(controllerName)
+	if err != nil {
+		return names
+	}
+	for name, details := range models {
+		names[details.ModelUUID] = name
+	}
+	return names
+}
+
+func displayName(names map[string]string, modelUUID string) string {
+	if name, ok := names[modelUUID]; ok {
+		return name
+	}
+	return modelUUID
+}
+
+// Object is the serialised form of an object in the object store.
+type Object struct {
+	SHA256     string   `json:"sha256" yaml:"sha256"`
+	SHA384     string   `json:"sha384" yaml:"sha384"`
+	Size       int64    `json:"size" yaml:"size"`
+	Paths      []string `json:"paths,omitempty" yaml:"paths,omitempty"`
+	References []string `json:"references,omitempty" yaml:"references,omitempty"`
+}
+
+func newObject(o params.ObjectStoreObject) Object {
+	object := Object{
+		SHA256: o.SHA256,
+		SHA384: o.SHA384,
+		Size:   o.Size,
+		Paths:  o.Paths,
+	}
+	for _, ref := range o.References {
+		object.References = append(object.References, fmt.Sprintf("%s:%s", ref.Kind, ref.Name))
+	}
+	sort.Strings(object.References)
+	return object
+}
+
+// shortHash returns the abbreviated form of a SHA256 hash shown in tabular
+// output, which is still accepted by show-object.
+func shortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
+
+func formatSize(size int64) string {
+	return humanize.IBytes(uint64(size))
+}
+
+func joinOrNone(values []string) string {
+	if len(values) == 0 {
+		return noValueDisplay
+	}
+	return strings.Join(values, ", ")
+}
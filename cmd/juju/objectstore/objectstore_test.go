@@ -0,0 +1,44 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore_test
+
+import (
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/cmd/juju/objectstore"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/jujuclient"
+)
+
+const (
+	modelUUID      = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+	otherModelUUID = "deadbeef-0bad-400d-8000-4b1d0d06f00e"
+)
+
+type baseSuite struct {
+	testhelpers.IsolationSuite
+
+	store *jujuclient.MemStore
+	api   *objectstore.MockObjectStoreAPI
+}
+
+func (s *baseSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.store = jujuclient.NewMemStore()
+	s.store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	s.store.CurrentControllerName = "mycontroller"
+	s.store.Models["mycontroller"] = &jujuclient.ControllerModels{
+		Models: map[string]jujuclient.ModelDetails{
+			"admin/mymodel": {ModelUUID: modelUUID},
+		},
+	}
+}
+
+func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.api = objectstore.NewMockObjectStoreAPI(ctrl)
+	s.api.EXPECT().Close().Return(nil).AnyTimes()
+	return ctrl
+}
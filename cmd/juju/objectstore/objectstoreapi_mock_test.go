@@ -0,0 +1,276 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/cmd/juju/objectstore (interfaces: ObjectStoreAPI)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package objectstore -destination objectstoreapi_mock_test.go github.com/juju/juju/cmd/juju/objectstore ObjectStoreAPI
+//
+
+// Package objectstore is a generated GoMock package.
+package objectstore
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	params "github.com/juju/juju/rpc/params"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockObjectStoreAPI is a mock of ObjectStoreAPI interface.
+type MockObjectStoreAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStoreAPIMockRecorder
+}
+
+// MockObjectStoreAPIMockRecorder is the mock recorder for MockObjectStoreAPI.
+type MockObjectStoreAPIMockRecorder struct {
+	mock *MockObjectStoreAPI
+}
+
+// NewMockObjectStoreAPI creates a new mock instance.
+func NewMockObjectStoreAPI(ctrl *gomock.Controller) *MockObjectStoreAPI {
+	mock := &MockObjectStoreAPI{ctrl: ctrl}
+	mock.recorder = &MockObjectStoreAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStoreAPI) EXPECT() *MockObjectStoreAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockObjectStoreAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockObjectStoreAPIMockRecorder) Close() *MockObjectStoreAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockObjectStoreAPI)(nil).Close))
+	return &MockObjectStoreAPICloseCall{Call: call}
+}
+
+// MockObjectStoreAPICloseCall wrap *gomock.Call
+type MockObjectStoreAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreAPICloseCall) Return(arg0 error) *MockObjectStoreAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreAPICloseCall) Do(f func() error) *MockObjectStoreAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreAPICloseCall) DoAndReturn(f func() error) *MockObjectStoreAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListObjects mocks base method.
+func (m *MockObjectStoreAPI) ListObjects(arg0 context.Context, arg1 []string) ([]params.ObjectStoreListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", arg0, arg1)
+	ret0, _ := ret[0].([]params.ObjectStoreListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockObjectStoreAPIMockRecorder) ListObjects(arg0, arg1 any) *MockObjectStoreAPIListObjectsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockObjectStoreAPI)(nil).ListObjects), arg0, arg1)
+	return &MockObjectStoreAPIListObjectsCall{Call: call}
+}
+
+// MockObjectStoreAPIListObjectsCall wrap *gomock.Call
+type MockObjectStoreAPIListObjectsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreAPIListObjectsCall) Return(arg0 []params.ObjectStoreListResult, arg1 error) *MockObjectStoreAPIListObjectsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreAPIListObjectsCall) Do(f func(context.Context, []string) ([]params.ObjectStoreListResult, error)) *MockObjectStoreAPIListObjectsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreAPIListObjectsCall) DoAndReturn(f func(context.Context, []string) ([]params.ObjectStoreListResult, error)) *MockObjectStoreAPIListObjectsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PruneObjects mocks base method.
+func (m *MockObjectStoreAPI) PruneObjects(arg0 context.Context, arg1 []string, arg2 time.Duration, arg3 bool) ([]params.ObjectStorePruneResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneObjects", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]params.ObjectStorePruneResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneObjects indicates an expected call of PruneObjects.
+func (mr *MockObjectStoreAPIMockRecorder) PruneObjects(arg0, arg1, arg2, arg3 any) *MockObjectStoreAPIPruneObjectsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneObjects", reflect.TypeOf((*MockObjectStoreAPI)(nil).PruneObjects), arg0, arg1, arg2, arg3)
+	return &MockObjectStoreAPIPruneObjectsCall{Call: call}
+}
+
+// MockObjectStoreAPIPruneObjectsCall wrap *gomock.Call
+type MockObjectStoreAPIPruneObjectsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreAPIPruneObjectsCall) Return(arg0 []params.ObjectStorePruneResult, arg1 error) *MockObjectStoreAPIPruneObjectsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreAPIPruneObjectsCall) Do(f func(context.Context, []string, time.Duration, bool) ([]params.ObjectStorePruneResult, error)) *MockObjectStoreAPIPruneObjectsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreAPIPruneObjectsCall) DoAndReturn(f func(context.Context, []string, time.Duration, bool) ([]params.ObjectStorePruneResult, error)) *MockObjectStoreAPIPruneObjectsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ShowObject mocks base method.
+func (m *MockObjectStoreAPI) ShowObject(arg0 context.Context, arg1, arg2 string) (params.ObjectStoreObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowObject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(params.ObjectStoreObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowObject indicates an expected call of ShowObject.
+func (mr *MockObjectStoreAPIMockRecorder) ShowObject(arg0, arg1, arg2 any) *MockObjectStoreAPIShowObjectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowObject", reflect.TypeOf((*MockObjectStoreAPI)(nil).ShowObject), arg0, arg1, arg2)
+	return &MockObjectStoreAPIShowObjectCall{Call: call}
+}
+
+// MockObjectStoreAPIShowObjectCall wrap *gomock.Call
+type MockObjectStoreAPIShowObjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreAPIShowObjectCall) Return(arg0 params.ObjectStoreObject, arg1 error) *MockObjectStoreAPIShowObjectCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreAPIShowObjectCall) Do(f func(context.Context, string, string) (params.ObjectStoreObject, error)) *MockObjectStoreAPIShowObjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreAPIShowObjectCall) DoAndReturn(f func(context.Context, string, string) (params.ObjectStoreObject, error)) *MockObjectStoreAPIShowObjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Usage mocks base method.
+func (m *MockObjectStoreAPI) Usage(arg0 context.Context, arg1 []string) (string, []params.ObjectStoreUsageResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Usage", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]params.ObjectStoreUsageResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Usage indicates an expected call of Usage.
+func (mr *MockObjectStoreAPIMockRecorder) Usage(arg0, arg1 any) *MockObjectStoreAPIUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Usage", reflect.TypeOf((*MockObjectStoreAPI)(nil).Usage), arg0, arg1)
+	return &MockObjectStoreAPIUsageCall{Call: call}
+}
+
+// MockObjectStoreAPIUsageCall wrap *gomock.Call
+type MockObjectStoreAPIUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreAPIUsageCall) Return(arg0 string, arg1 []params.ObjectStoreUsageResult, arg2 error) *MockObjectStoreAPIUsageCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreAPIUsageCall) Do(f func(context.Context, []string) (string, []params.ObjectStoreUsageResult, error)) *MockObjectStoreAPIUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreAPIUsageCall) DoAndReturn(f func(context.Context, []string) (string, []params.ObjectStoreUsageResult, error)) *MockObjectStoreAPIUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// VerifyObjects mocks base method.
+func (m *MockObjectStoreAPI) VerifyObjects(arg0 context.Context, arg1 []string) ([]params.ObjectStoreVerifyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyObjects", arg0, arg1)
+	ret0, _ := ret[0].([]params.ObjectStoreVerifyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyObjects indicates an expected call of VerifyObjects.
+func (mr *MockObjectStoreAPIMockRecorder) VerifyObjects(arg0, arg1 any) *MockObjectStoreAPIVerifyObjectsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyObjects", reflect.TypeOf((*MockObjectStoreAPI)(nil).VerifyObjects), arg0, arg1)
+	return &MockObjectStoreAPIVerifyObjectsCall{Call: call}
+}
+
+// MockObjectStoreAPIVerifyObjectsCall wrap *gomock.Call
+type MockObjectStoreAPIVerifyObjectsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreAPIVerifyObjectsCall) Return(arg0 []params.ObjectStoreVerifyResult, arg1 error) *MockObjectStoreAPIVerifyObjectsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreAPIVerifyObjectsCall) Do(f func(context.Context, []string) ([]params.ObjectStoreVerifyResult, error)) *MockObjectStoreAPIVerifyObjectsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreAPIVerifyObjectsCall) DoAndReturn(f func(context.Context, []string) ([]params.ObjectStoreVerifyResult, error)) *MockObjectStoreAPIVerifyObjectsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/jujuclient"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination objectstoreapi_mock_test.go github.com/juju/juju/cmd/juju/objectstore ObjectStoreAPI
+
+func newCommandBaseForTest(store jujuclient.ClientStore, api ObjectStoreAPI) objectStoreCommandBase {
+	c := objectStoreCommandBase{
+		newAPIFunc: func(ctx context.Context) (ObjectStoreAPI, error) { return api, nil },
+	}
+	c.SetClientStore(store)
+	return c
+}
+
+// NewListObjectsCommandForTest returns an objects command for testing.
+func NewListObjectsCommandForTest(store jujuclient.ClientStore, api ObjectStoreAPI) cmd.Command {
+	return modelcmd.WrapController(&listObjectsCommand{
+		objectStoreCommandBase: newCommandBaseForTest(store, api),
+	})
+}
+
+// NewShowObjectCommandForTest returns a show-object command for testing.
+func NewShowObjectCommandForTest(store jujuclient.ClientStore, api ObjectStoreAPI) cmd.Command {
+	return modelcmd.WrapController(&showObjectCommand{
+		objectStoreCommandBase: newCommandBaseForTest(store, api),
+	})
+}
+
+// NewVerifyObjectsCommandForTest returns a verify-objects command for testing.
+func NewVerifyObjectsCommandForTest(store jujuclient.ClientStore, api ObjectStoreAPI) cmd.Command {
+	return modelcmd.WrapController(&verifyObjectsCommand{
+		objectStoreCommandBase: newCommandBaseForTest(store, api),
+	})
+}
+
+// NewPruneObjectsCommandForTest returns a prune-objects command for testing.
+func NewPruneObjectsCommandForTest(store jujuclient.ClientStore, api ObjectStoreAPI) cmd.Command {
+	return modelcmd.WrapController(&pruneObjectsCommand{
+		objectStoreCommandBase: newCommandBaseForTest(store, api),
+	})
+}
+
+// NewUsageCommandForTest returns an object-store-usage command for testing.
+func NewUsageCommandForTest(store jujuclient.ClientStore, api ObjectStoreAPI) cmd.Command {
+	return modelcmd.WrapController(&usageCommand{
+		objectStoreCommandBase: newCommandBaseForTest(store, api),
+	})
+}
@@ -0,0 +1,235 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+const pruneObjectsDoc = `
+Removes the objects held in the object store for each model on the
+controller that aren't referenced by any charm, resource, agent binary or
+other entity.
+
+Objects are stored before the entity referencing them is added, so objects
+stored within the last hour are never removed. Use --min-age to change how
+long ago an object must have been stored before it can be removed.
+
+If no models are named, the object store of every model is pruned. The
+objects to remove are shown and confirmation is requested before they're
+removed, unless --no-prompt is supplied. Use --dry-run to show the objects
+that would be removed without removing them.
+`
+
+const pruneObjectsExamples = `
+Show the objects that would be removed from every model:
+
+    juju prune-objects --dry-run
+
+Remove the unreferenced objects of a model without prompting:
+
+    juju prune-objects mymodel --no-prompt
+`
+
+// NewPruneObjectsCommand returns a command that removes the unreferenced
+// objects from the object stores of the models on a controller.
+func NewPruneObjectsCommand() cmd.Command {
+	command := &pruneObjectsCommand{}
+	command.newAPIFunc = command.objectStoreAPI
+	return modelcmd.WrapController(command)
+}
+
+type pruneObjectsCommand struct {
+	objectStoreCommandBase
+	modelcmd.DestroyConfirmationCommandBase
+	out cmd.Output
+
+	dryRun bool
+	minAge time.Duration
+}
+
+// Info implements Command.Info.
+func (c *pruneObjectsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "prune-objects",
+		Args:     "[<model name> ...]",
+		Purpose:  "Removes unreferenced objects from the object store of the controller.",
+		Doc:      pruneObjectsDoc,
+		Examples: pruneObjectsExamples,
+		SeeAlso: []string{
+			"objects",
+			"object-store-usage",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *pruneObjectsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	c.DestroyConfirmationCommandBase.SetFlags(f)
+	f.BoolVar(&c.dryRun, "dry-run", false, "Show the objects that would be removed, without removing them")
+	f.DurationVar(&c.minAge, "min-age", 0, "Only remove objects stored at least this long ago (default 1h)")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatPruneTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *pruneObjectsCommand) Init(args []string) error {
+	if c.minAge < 0 {
+		return errors.New("--min-age cannot be negative")
+	}
+	c.modelNames = args
+	return nil
+}
+
+// ModelPrune is the serialised form of the outcome of pruning the object
+// store of a model.
+type ModelPrune struct {
+	Removed []Object `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Bytes   int64    `json:"bytes" yaml:"bytes"`
+	DryRun  bool     `json:"dry-run,omitempty" yaml:"dry-run,omitempty"`
+	Error   string   `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Run implements Command.Run.
+func (c *pruneObjectsCommand) Run(ctx *cmd.Context) error {
+	modelUUIDs, err := c.modelUUIDs(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = api.Close() }()
+
+	names := c.modelNamesByUUID()
+	if c.dryRun || c.NeedsConfirmation() {
+		results, err := api.PruneObjects(ctx, modelUUIDs, c.minAge, true)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		models, _ := c.gatherResults(names, results, true)
+		if c.dryRun {
+			return c.out.Write(ctx, models)
+		}
+
+		var objects int
+		for _, model := range models {
+			objects += len(model.Removed)
+		}
+		if objects == 0 {
+			ctx.Infof("No unreferenced objects to remove.")
+			return nil
+		}
+		if err := c.out.Write(ctx, models); err != nil {
+			return errors.Trace(err)
+		}
+		fmt.Fprintf(ctx.Stderr, "WARNING! This command will remove the %d objects above.\n", objects)
+		if err := jujucmd.UserConfirmYes(ctx); err != nil {
+			return errors.Annotate(err, "pruning objects")
+		}
+	}
+
+	results, err := api.PruneObjects(ctx, modelUUIDs, c.minAge, false)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	models, failed := c.gatherResults(names, results, false)
+	if err := c.out.Write(ctx, models); err != nil {
+		return errors.Trace(err)
+	}
+	if failed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+func (c *pruneObjectsCommand) gatherResults(names map[string]string, results []params.ObjectStorePruneResult, dryRun bool) (map[string]ModelPrune, bool) {
+	var failed bool
+	models := make(map[string]ModelPrune)
+	for _, result := range results {
+		model := ModelPrune{
+			Bytes:  result.Bytes,
+			DryRun: dryRun,
+		}
+		if result.Error != nil {
+			model.Error = result.Error.Error()
+			failed = true
+		}
+		for _, object := range result.Objects {
+			model.Removed = append(model.Removed, newObject(object))
+		}
+		models[displayName(names, result.ModelUUID)] = model
+	}
+	return models, failed
+}
+
+func formatPruneTabular(writer io.Writer, value interface{}) error {
+	models, ok := value.(map[string]ModelPrune)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", models, value)
+	}
+
+	var (
+		names   []string
+		objects int
+		dryRun  bool
+	)
+	for name, model := range models {
+		names = append(names, name)
+		objects += len(model.Removed)
+		dryRun = dryRun || model.DryRun
+	}
+	sort.Strings(names)
+
+	if objects > 0 {
+		tw := output.TabWriter(writer)
+		w := output.Wrapper{TabWriter: tw}
+		w.Println("Model", "SHA256", "Size", "Paths")
+		for _, name := range names {
+			for _, object := range models[name].Removed {
+				w.Println(name, shortHash(object.SHA256), formatSize(object.Size), joinOrNone(object.Paths))
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	for _, name := range names {
+		model := models[name]
+		if model.Error != "" {
+			fmt.Fprintf(writer, "ERROR pruning objects of %q: %s\n", name, model.Error)
+		}
+		if len(model.Removed) == 0 {
+			continue
+		}
+		verb := "Removed"
+		if dryRun {
+			verb = "Would remove"
+		}
+		fmt.Fprintf(writer, "%s %d objects (%s) from %q.\n", verb, len(model.Removed), formatSize(model.Bytes), name)
+	}
+	if objects == 0 {
+		fmt.Fprintln(writer, "No unreferenced objects to remove.")
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/cmd/juju/objectstore"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type pruneSuite struct {
+	baseSuite
+}
+
+func TestPruneSuite(t *testing.T) {
+	tc.Run(t, &pruneSuite{})
+}
+
+func (s *pruneSuite) pruneResults() []params.ObjectStorePruneResult {
+	return []params.ObjectStorePruneResult{{
+		ModelUUID: modelUUID,
+		Objects: []params.ObjectStoreObject{{
+			SHA256: "6c7b5bd4e0f5a1b2c3d4",
+			Size:   2048,
+			Paths:  []string{"charms/foo-1"},
+		}},
+		Bytes: 2048,
+	}}
+}
+
+func (s *pruneSuite) TestPruneDryRun(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().PruneObjects(gomock.Any(), nil, time.Duration(0), true).Return(s.pruneResults(), nil)
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewPruneObjectsCommandForTest(s.store, s.api), "--dry-run")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Model          SHA256        Size     Paths
+admin/mymodel  6c7b5bd4e0f5  2.0 KiB  charms/foo-1
+Would remove 1 objects (2.0 KiB) from "admin/mymodel".
+`[1:])
+}
+
+func (s *pruneSuite) TestPruneNoPrompt(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().PruneObjects(gomock.Any(), []string{modelUUID}, time.Duration(0), false).Return(s.pruneResults(), nil)
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewPruneObjectsCommandForTest(s.store, s.api), "admin/mymodel", "--no-prompt")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Contains, `Removed 1 objects (2.0 KiB) from "admin/mymodel".`)
+}
+
+func (s *pruneSuite) TestPruneConfirmed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	gomock.InOrder(
+		s.api.EXPECT().PruneObjects(gomock.Any(), nil, time.Duration(0), true).Return(s.pruneResults(), nil),
+		s.api.EXPECT().PruneObjects(gomock.Any(), nil, time.Duration(0), false).Return(s.pruneResults(), nil),
+	)
+
+	ctx := cmdtesting.Context(c)
+	ctx.Stdin = strings.NewReader("y\n")
+	command := objectstore.NewPruneObjectsCommandForTest(s.store, s.api)
+	c.Assert(cmdtesting.InitCommand(command, nil), tc.ErrorIsNil)
+	c.Assert(command.Run(ctx), tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Contains, "WARNING! This command will remove the 1 objects above.")
+	c.Check(cmdtesting.Stdout(ctx), tc.Contains, `Removed 1 objects (2.0 KiB) from "admin/mymodel".`)
+}
+
+func (s *pruneSuite) TestPruneAborted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().PruneObjects(gomock.Any(), nil, time.Duration(0), true).Return(s.pruneResults(), nil)
+
+	ctx := cmdtesting.Context(c)
+	ctx.Stdin = strings.NewReader("n\n")
+	command := objectstore.NewPruneObjectsCommandForTest(s.store, s.api)
+	c.Assert(cmdtesting.InitCommand(command, nil), tc.ErrorIsNil)
+	c.Assert(command.Run(ctx), tc.ErrorMatches, "pruning objects: aborted")
+}
+
+func (s *pruneSuite) TestPruneNothingToRemove(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().PruneObjects(gomock.Any(), nil, time.Duration(0), true).Return([]params.ObjectStorePruneResult{{
+		ModelUUID: modelUUID,
+	}}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewPruneObjectsCommandForTest(s.store, s.api))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No unreferenced objects to remove.\n")
+}
+
+func (s *pruneSuite) TestPruneMinAge(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().PruneObjects(gomock.Any(), nil, 10*time.Minute, true).Return(s.pruneResults(), nil)
+
+	_, err := cmdtesting.RunCommand(c, objectstore.NewPruneObjectsCommandForTest(s.store, s.api), "--dry-run", "--min-age", "10m")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *pruneSuite) TestPruneNegativeMinAge(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, objectstore.NewPruneObjectsCommandForTest(s.store, s.api), "--min-age", "-1m")
+	c.Assert(err, tc.ErrorMatches, "--min-age cannot be negative")
+}
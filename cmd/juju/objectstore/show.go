@@ -0,0 +1,118 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"io"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+const showObjectDoc = `
+Shows a single object held in the object store of a model, along with the
+charms, resources and agent binaries that reference it.
+
+The object is identified by one of its paths, its SHA256 hash or a unique
+prefix of at least 7 characters of its SHA256 hash, as shown by the objects
+command.
+`
+
+const showObjectExamples = `
+    juju show-object mymodel 6c7b5bd4e0f5
+    juju show-object mymodel charms/postgresql-42 --format json
+`
+
+// NewShowObjectCommand returns a command that shows a single object in the
+// object store of a model.
+func NewShowObjectCommand() cmd.Command {
+	command := &showObjectCommand{}
+	command.newAPIFunc = command.objectStoreAPI
+	return modelcmd.WrapController(command)
+}
+
+type showObjectCommand struct {
+	objectStoreCommandBase
+	out cmd.Output
+
+	key string
+}
+
+// Info implements Command.Info.
+func (c *showObjectCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "show-object",
+		Args:     "<model name> <path or hash>",
+		Purpose:  "Shows an object in the object store of the controller.",
+		Doc:      showObjectDoc,
+		Examples: showObjectExamples,
+		SeeAlso: []string{
+			"objects",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *showObjectCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatObjectTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *showObjectCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.New("no model specified")
+	case 1:
+		return errors.New("no object path or hash specified")
+	}
+	c.modelNames = args[:1]
+	c.key = args[1]
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Run implements Command.Run.
+func (c *showObjectCommand) Run(ctx *cmd.Context) error {
+	modelUUIDs, err := c.modelUUIDs(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = api.Close() }()
+
+	object, err := api.ShowObject(ctx, modelUUIDs[0], c.key)
+	if err != nil {
+		return errors.Annotatef(err, "showing object %q", c.key)
+	}
+	return c.out.Write(ctx, newObject(object))
+}
+
+func formatObjectTabular(writer io.Writer, value interface{}) error {
+	object, ok := value.(Object)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", object, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("SHA256", object.SHA256)
+	w.Println("SHA384", object.SHA384)
+	w.Println("Size", formatSize(object.Size))
+	w.Println("Paths", joinOrNone(object.Paths))
+	w.Println("References", joinOrNone(object.References))
+	return tw.Flush()
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/cmd/juju/objectstore"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type showSuite struct {
+	baseSuite
+}
+
+func TestShowSuite(t *testing.T) {
+	tc.Run(t, &showSuite{})
+}
+
+func (s *showSuite) TestInitErrors(c *tc.C) {
+	for i, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: nil,
+		err:  "no model specified",
+	}, {
+		args: []string{"admin/mymodel"},
+		err:  "no object path or hash specified",
+	}, {
+		args: []string{"admin/mymodel", "foo", "bar"},
+		err:  `unrecognized args: \["bar"\]`,
+	}} {
+		c.Logf("test %d", i)
+		_, err := cmdtesting.RunCommand(c, objectstore.NewShowObjectCommandForTest(s.store, nil), test.args...)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+func (s *showSuite) TestShow(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().ShowObject(gomock.Any(), modelUUID, "6c7b5bd").Return(params.ObjectStoreObject{
+		SHA256: "6c7b5bd4e0f5a1b2c3d4",
+		SHA384: "sha384",
+		Size:   42,
+		Paths:  []string{"resources/foo"},
+		References: []params.ObjectStoreReference{{
+			Kind: "resource",
+			Name: "app/blob",
+		}},
+	}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewShowObjectCommandForTest(s.store, s.api), "admin/mymodel", "6c7b5bd")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+sha256: 6c7b5bd4e0f5a1b2c3d4
+sha384: sha384
+size: 42
+paths:
+- resources/foo
+references:
+- resource:app/blob
+`[1:])
+}
+
+func (s *showSuite) TestShowNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().ShowObject(gomock.Any(), modelUUID, "foo").
+		Return(params.ObjectStoreObject{}, errors.New("path not found"))
+
+	_, err := cmdtesting.RunCommand(c, objectstore.NewShowObjectCommandForTest(s.store, s.api), "admin/mymodel", "foo")
+	c.Assert(err, tc.ErrorMatches, `showing object "foo": path not found`)
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+const usageDoc = `
+Shows the number and total size of the objects held in the object store for
+each model on the controller, along with the object store backend used by
+the controller, either file or s3.
+
+If no models are named, the usage of every model is shown.
+`
+
+const usageExamples = `
+    juju object-store-usage
+    juju object-store-usage mymodel --format yaml
+`
+
+// NewUsageCommand returns a command that shows the usage of the object
+// stores of the models on a controller.
+func NewUsageCommand() cmd.Command {
+	command := &usageCommand{}
+	command.newAPIFunc = command.objectStoreAPI
+	return modelcmd.WrapController(command)
+}
+
+type usageCommand struct {
+	objectStoreCommandBase
+	out cmd.Output
+}
+
+// Info implements Command.Info.
+func (c *usageCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "object-store-usage",
+		Args:     "[<model name> ...]",
+		Purpose:  "Shows the usage of the object store of the controller.",
+		Doc:      usageDoc,
+		Examples: usageExamples,
+		SeeAlso: []string{
+			"objects",
+			"prune-objects",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *usageCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatUsageTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *usageCommand) Init(args []string) error {
+	c.modelNames = args
+	return nil
+}
+
+// Usage is the serialised form of the usage of the object store.
+type Usage struct {
+	Backend string                `json:"backend" yaml:"backend"`
+	Models  map[string]ModelUsage `json:"models" yaml:"models"`
+}
+
+// ModelUsage is the serialised form of the usage of the object store of a
+// model.
+type ModelUsage struct {
+	Objects int    `json:"objects" yaml:"objects"`
+	Bytes   int64  `json:"bytes" yaml:"bytes"`
+	Error   string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Run implements Command.Run.
+func (c *usageCommand) Run(ctx *cmd.Context) error {
+	modelUUIDs, err := c.modelUUIDs(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = api.Close() }()
+
+	backend, results, err := api.Usage(ctx, modelUUIDs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	names := c.modelNamesByUUID()
+	usage := Usage{
+		Backend: backend,
+		Models:  make(map[string]ModelUsage),
+	}
+	for _, result := range results {
+		model := ModelUsage{
+			Objects: result.Objects,
+			Bytes:   result.Bytes,
+		}
+		if result.Error != nil {
+			model.Error = result.Error.Error()
+		}
+		usage.Models[displayName(names, result.ModelUUID)] = model
+	}
+	return c.out.Write(ctx, usage)
+}
+
+func formatUsageTabular(writer io.Writer, value interface{}) error {
+	usage, ok := value.(Usage)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", usage, value)
+	}
+
+	var names []string
+	for name := range usage.Models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(writer, "Backend: %s\n\n", usage.Backend)
+
+	var (
+		objects int
+		bytes   int64
+	)
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Model", "Objects", "Size")
+	for _, name := range names {
+		model := usage.Models[name]
+		if model.Error != "" {
+			w.Println(name, noValueDisplay, model.Error)
+			continue
+		}
+		w.Println(name, model.Objects, formatSize(model.Bytes))
+		objects += model.Objects
+		bytes += model.Bytes
+	}
+	if len(names) > 1 {
+		w.Println("Total", objects, formatSize(bytes))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/cmd/juju/objectstore"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type usageSuite struct {
+	baseSuite
+}
+
+func TestUsageSuite(t *testing.T) {
+	tc.Run(t, &usageSuite{})
+}
+
+func (s *usageSuite) expectUsage() {
+	s.api.EXPECT().Usage(gomock.Any(), nil).Return("s3", []params.ObjectStoreUsageResult{{
+		ModelUUID: modelUUID,
+		Objects:   2,
+		Bytes:     3 * 1024 * 1024,
+	}, {
+		ModelUUID: otherModelUUID,
+		Objects:   1,
+		Bytes:     1024 * 1024,
+	}}, nil)
+}
+
+func (s *usageSuite) TestUsageTabular(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUsage()
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewUsageCommandForTest(s.store, s.api))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Backend: s3
+
+Model                                 Objects  Size
+admin/mymodel                         2        3.0 MiB
+deadbeef-0bad-400d-8000-4b1d0d06f00e  1        1.0 MiB
+Total                                 3        4.0 MiB
+`[1:])
+}
+
+func (s *usageSuite) TestUsageJSON(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUsage()
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewUsageCommandForTest(s.store, s.api), "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `{"backend":"s3","models":{"admin/mymodel":{"objects":2,"bytes":3145728},"deadbeef-0bad-400d-8000-4b1d0d06f00e":{"objects":1,"bytes":1048576}}}`+"\n")
+}
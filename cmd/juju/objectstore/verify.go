@@ -0,0 +1,178 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+const verifyObjectsDoc = `
+Verifies the objects held in the object store for each model on the
+controller, reading every object and checking its size and hashes against
+its metadata.
+
+If no models are named, the objects of every model are verified. Verifying
+reads every object in the object store, so may take some time for large
+object stores. The command fails if any object doesn't match its metadata.
+`
+
+const verifyObjectsExamples = `
+    juju verify-objects
+    juju verify-objects mymodel
+`
+
+// NewVerifyObjectsCommand returns a command that verifies the objects in the
+// object stores of the models on a controller.
+func NewVerifyObjectsCommand() cmd.Command {
+	command := &verifyObjectsCommand{}
+	command.newAPIFunc = command.objectStoreAPI
+	return modelcmd.WrapController(command)
+}
+
+type verifyObjectsCommand struct {
+	objectStoreCommandBase
+	out cmd.Output
+}
+
+// Info implements Command.Info.
+func (c *verifyObjectsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "verify-objects",
+		Args:     "[<model name> ...]",
+		Purpose:  "Verifies the objects in the object store of the controller.",
+		Doc:      verifyObjectsDoc,
+		Examples: verifyObjectsExamples,
+		SeeAlso: []string{
+			"objects",
+			"show-object",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *verifyObjectsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatVerifyTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *verifyObjectsCommand) Init(args []string) error {
+	c.modelNames = args
+	return nil
+}
+
+// VerifyFailure is the serialised form of an object that doesn't match its
+// metadata.
+type VerifyFailure struct {
+	SHA256 string   `json:"sha256" yaml:"sha256"`
+	Paths  []string `json:"paths,omitempty" yaml:"paths,omitempty"`
+	Reason string   `json:"reason" yaml:"reason"`
+}
+
+// ModelVerification is the serialised form of the outcome of verifying the
+// object store of a model.
+type ModelVerification struct {
+	Checked  int             `json:"checked" yaml:"checked"`
+	Failures []VerifyFailure `json:"failures,omitempty" yaml:"failures,omitempty"`
+	Error    string          `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Run implements Command.Run.
+func (c *verifyObjectsCommand) Run(ctx *cmd.Context) error {
+	modelUUIDs, err := c.modelUUIDs(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = api.Close() }()
+
+	results, err := api.VerifyObjects(ctx, modelUUIDs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var failed bool
+	names := c.modelNamesByUUID()
+	models := make(map[string]ModelVerification)
+	for _, result := range results {
+		model := ModelVerification{
+			Checked: result.Checked,
+		}
+		if result.Error != nil {
+			model.Error = result.Error.Error()
+			failed = true
+		}
+		for _, failure := range result.Failures {
+			model.Failures = append(model.Failures, VerifyFailure{
+				SHA256: failure.SHA256,
+				Paths:  failure.Paths,
+				Reason: failure.Reason,
+			})
+			failed = true
+		}
+		models[displayName(names, result.ModelUUID)] = model
+	}
+	if err := c.out.Write(ctx, models); err != nil {
+		return errors.Trace(err)
+	}
+	if failed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+func formatVerifyTabular(writer io.Writer, value interface{}) error {
+	models, ok := value.(map[string]ModelVerification)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", models, value)
+	}
+
+	var names []string
+	for name := range models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Model", "Checked", "Failed", "Status")
+	for _, name := range names {
+		model := models[name]
+		status := "ok"
+		if model.Error != "" {
+			status = model.Error
+		} else if len(model.Failures) > 0 {
+			status = "corrupt"
+		}
+		w.Println(name, model.Checked, len(model.Failures), status)
+	}
+	if err := tw.Flush(); err != nil {
+		return errors.Trace(err)
+	}
+
+	for _, name := range names {
+		for _, failure := range models[name].Failures {
+			fmt.Fprintf(writer, "ERROR object %s in %q (%s): %s\n", shortHash(failure.SHA256), name, joinOrNone(failure.Paths), failure.Reason)
+		}
+	}
+	return nil
+}
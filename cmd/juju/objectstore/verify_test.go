@@ -0,0 +1,62 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/cmd/juju/objectstore"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type verifySuite struct {
+	baseSuite
+}
+
+func TestVerifySuite(t *testing.T) {
+	tc.Run(t, &verifySuite{})
+}
+
+func (s *verifySuite) TestVerifyHealthy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().VerifyObjects(gomock.Any(), nil).Return([]params.ObjectStoreVerifyResult{{
+		ModelUUID: modelUUID,
+		Checked:   3,
+	}}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewVerifyObjectsCommandForTest(s.store, s.api))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Model          Checked  Failed  Status
+admin/mymodel  3        0       ok
+`[1:])
+}
+
+func (s *verifySuite) TestVerifyCorrupt(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().VerifyObjects(gomock.Any(), []string{modelUUID}).Return([]params.ObjectStoreVerifyResult{{
+		ModelUUID: modelUUID,
+		Checked:   3,
+		Failures: []params.ObjectStoreVerifyFailure{{
+			SHA256: "6c7b5bd4e0f5a1b2c3d4",
+			Paths:  []string{"charms/foo-1"},
+			Reason: "size mismatch: expected 42, got 41",
+		}},
+	}}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, objectstore.NewVerifyObjectsCommandForTest(s.store, s.api), "admin/mymodel")
+	c.Assert(err, tc.Equals, cmd.ErrSilent)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Model          Checked  Failed  Status
+admin/mymodel  3        1       corrupt
+ERROR object 6c7b5bd4e0f5 in "admin/mymodel" (charms/foo-1): size mismatch: expected 42, got 41
+`[1:])
+}
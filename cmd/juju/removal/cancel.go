@@ -0,0 +1,159 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+const cancelRemovalDoc = `
+Cancels removals that are scheduled in the model, returning the entities
+being removed to their normal, alive state.
+
+Removals are identified by the ids shown by the removals command, or any
+unique prefix of them. A removal can only be cancelled if it hasn't been
+forced, and if the entity can safely be returned to life:
+
+  - a unit can be kept if neither its application nor its machine are
+    being removed, and its removal hasn't started: the removal hasn't
+    been attempted, the unit agent hasn't begun running its teardown
+    hooks, and none of its subordinates, storage or relations are being
+    removed.
+  - an application can be kept if it has no units.
+  - relations can't be kept once they're being removed, as their units may
+    have already left the relation.
+
+With --force, the removals are forced to complete straight away instead of
+being cancelled, without waiting for the entities to be cleanly removed.
+Confirmation is requested before forcing removals, unless --no-prompt is
+supplied.
+`
+
+const cancelRemovalExamples = `
+Cancel the removal of an application:
+
+    juju cancel-removal 3f1d2c4b
+
+Force a stuck removal to complete now:
+
+    juju cancel-removal 3f1d2c4b --force
+`
+
+// NewCancelRemovalCommand returns a command that cancels or forces removal
+// jobs scheduled in a model.
+func NewCancelRemovalCommand() cmd.Command {
+	command := &cancelRemovalCommand{}
+	command.newAPIFunc = command.removalAPI
+	return modelcmd.Wrap(command)
+}
+
+type cancelRemovalCommand struct {
+	removalCommandBase
+	modelcmd.DestroyConfirmationCommandBase
+
+	ids   []string
+	force bool
+}
+
+// Info implements Command.Info.
+func (c *cancelRemovalCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "cancel-removal",
+		Args:     "<removal id> [<removal id> ...]",
+		Purpose:  "Cancels or forces removals scheduled in the model.",
+		Doc:      cancelRemovalDoc,
+		Examples: cancelRemovalExamples,
+		SeeAlso: []string{
+			"removals",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *cancelRemovalCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.DestroyConfirmationCommandBase.SetFlags(f)
+	f.BoolVar(&c.force, "force", false, "Force the removals to complete now, instead of cancelling them")
+}
+
+// Init implements Command.Init.
+func (c *cancelRemovalCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no removals specified")
+	}
+	c.ids = args
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *cancelRemovalCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = api.Close() }()
+
+	all, err := api.ListRemovals(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	jobs, err := resolveJobs(all, c.ids)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	uuids := make([]string, len(jobs))
+	for i, job := range jobs {
+		uuids[i] = job.UUID
+	}
+
+	var (
+		errs []error
+		verb string
+	)
+	if c.force {
+		if c.NeedsConfirmation() {
+			fmt.Fprintln(ctx.Stderr, "WARNING! This command will force the removal of:")
+			for _, job := range jobs {
+				fmt.Fprintf(ctx.Stderr, "  - %s\n", entityDisplay(job))
+			}
+			if err := jujucmd.UserConfirmYes(ctx); err != nil {
+				return errors.Annotate(err, "forcing removals")
+			}
+		}
+		errs, err = api.ForceRemovals(ctx, uuids)
+		verb = "forcing"
+	} else {
+		errs, err = api.CancelRemovals(ctx, uuids)
+		verb = "cancelling"
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var failed bool
+	for i, job := range jobs {
+		if errs[i] != nil {
+			fmt.Fprintf(ctx.Stderr, "ERROR %s removal %s of %s: %v\n", verb, shortID(job.UUID), entityDisplay(job), errs[i])
+			failed = true
+			continue
+		}
+		if c.force {
+			ctx.Infof("Forced removal of %s.", entityDisplay(job))
+		} else {
+			ctx.Infof("Cancelled removal of %s.", entityDisplay(job))
+		}
+	}
+	if failed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
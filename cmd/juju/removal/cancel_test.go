@@ -0,0 +1,118 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/cmd/juju/removal"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+)
+
+type cancelSuite struct {
+	baseSuite
+}
+
+func TestCancelSuite(t *testing.T) {
+	tc.Run(t, &cancelSuite{})
+}
+
+func (s *cancelSuite) TestCancel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListRemovals()
+	s.api.EXPECT().CancelRemovals(gomock.Any(), []string{unitJobUUID}).Return([]error{nil}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, removal.NewCancelRemovalCommandForTest(s.store, s.api), "3f1d2c")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Cancelled removal of unit foo/0.\n")
+}
+
+func (s *cancelSuite) TestCancelFailure(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListRemovals()
+	s.api.EXPECT().CancelRemovals(gomock.Any(), []string{relationJobUUID, unitJobUUID}).Return([]error{
+		errors.New("removal of relation can not be reverted"),
+		nil,
+	}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, removal.NewCancelRemovalCommandForTest(s.store, s.api), relationJobUUID, "3f1d2c4b")
+	c.Assert(err, tc.Equals, cmd.ErrSilent)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+ERROR cancelling removal 3f1d9e8f of relation relation-uuid: removal of relation can not be reverted
+Cancelled removal of unit foo/0.
+`[1:])
+}
+
+func (s *cancelSuite) TestCancelAmbiguous(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListRemovals()
+
+	_, err := cmdtesting.RunCommand(c, removal.NewCancelRemovalCommandForTest(s.store, s.api), "3f1d")
+	c.Assert(err, tc.ErrorMatches, `removal id "3f1d" matches 2 removals`)
+}
+
+func (s *cancelSuite) TestCancelNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListRemovals()
+
+	_, err := cmdtesting.RunCommand(c, removal.NewCancelRemovalCommandForTest(s.store, s.api), "abcdef")
+	c.Assert(err, tc.ErrorMatches, `removal "abcdef" not found`)
+}
+
+func (s *cancelSuite) TestForce(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListRemovals()
+	s.api.EXPECT().ForceRemovals(gomock.Any(), []string{unitJobUUID}).Return([]error{nil}, nil)
+
+	ctx := cmdtesting.Context(c)
+	ctx.Stdin = strings.NewReader("y\n")
+	command := removal.NewCancelRemovalCommandForTest(s.store, s.api)
+	c.Assert(cmdtesting.InitCommand(command, []string{"3f1d2c", "--force"}), tc.ErrorIsNil)
+	err := command.Run(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Matches, `(?s)WARNING! This command will force the removal of:
+  - unit foo/0
+.*Forced removal of unit foo/0.
+`)
+}
+
+func (s *cancelSuite) TestForceAborted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListRemovals()
+
+	ctx := cmdtesting.Context(c)
+	ctx.Stdin = strings.NewReader("n\n")
+	command := removal.NewCancelRemovalCommandForTest(s.store, s.api)
+	c.Assert(cmdtesting.InitCommand(command, []string{"3f1d2c", "--force"}), tc.ErrorIsNil)
+	err := command.Run(ctx)
+	c.Assert(err, tc.ErrorMatches, "forcing removals: .*")
+}
+
+func (s *cancelSuite) TestForceNoPrompt(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListRemovals()
+	s.api.EXPECT().ForceRemovals(gomock.Any(), []string{unitJobUUID}).Return([]error{nil}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, removal.NewCancelRemovalCommandForTest(s.store, s.api), "3f1d2c", "--force", "--no-prompt")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Forced removal of unit foo/0.\n")
+}
+
+func (s *cancelSuite) TestNoArgs(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, removal.NewCancelRemovalCommandForTest(s.store, nil))
+	c.Assert(err, tc.ErrorMatches, "no removals specified")
+}
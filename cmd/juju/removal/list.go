@@ -0,0 +1,160 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal
+
+import (
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+const listRemovalsDoc = `
+Lists the removal jobs scheduled in the model.
+
+When an application, unit or relation is removed, it becomes dying and a
+removal job is scheduled to remove it from the model. Jobs for removals that
+are progressing normally are short lived; a job that remains listed shows the
+removal that is holding up the entity, along with the last error returned
+when it was attempted.
+
+Forced removals are executed once their wait duration has elapsed, which is
+shown as the time the job is scheduled for. Use cancel-removal to cancel a
+removal that's stuck, or to force it to complete straight away.
+`
+
+const listRemovalsExamples = `
+    juju removals
+    juju removals --format yaml
+`
+
+// NewListRemovalsCommand returns a command that lists the removal jobs
+// scheduled in a model.
+func NewListRemovalsCommand() cmd.Command {
+	command := &listRemovalsCommand{}
+	command.newAPIFunc = command.removalAPI
+	return modelcmd.Wrap(command)
+}
+
+type listRemovalsCommand struct {
+	removalCommandBase
+	out cmd.Output
+
+	utc bool
+}
+
+// Info implements Command.Info.
+func (c *listRemovalsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "removals",
+		Purpose:  "Lists the removal jobs scheduled in the model.",
+		Doc:      listRemovalsDoc,
+		Examples: listRemovalsExamples,
+		Aliases:  []string{"list-removals"},
+		SeeAlso: []string{
+			"cancel-removal",
+			"remove-application",
+			"remove-relation",
+			"remove-unit",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listRemovalsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.utc, "utc", false, "Show times in UTC")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": c.formatTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *listRemovalsCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Removal is the serialised form of a removal job.
+type Removal struct {
+	ID          string     `json:"id" yaml:"id"`
+	Entity      string     `json:"entity" yaml:"entity"`
+	EntityType  string     `json:"entity-type" yaml:"entity-type"`
+	EntityUUID  string     `json:"entity-uuid" yaml:"entity-uuid"`
+	Force       bool       `json:"force" yaml:"force"`
+	Scheduled   time.Time  `json:"scheduled" yaml:"scheduled"`
+	LastError   string     `json:"last-error,omitempty" yaml:"last-error,omitempty"`
+	LastErrorAt *time.Time `json:"last-error-at,omitempty" yaml:"last-error-at,omitempty"`
+}
+
+// Run implements Command.Run.
+func (c *listRemovalsCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = api.Close() }()
+
+	jobs, err := api.ListRemovals(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(jobs) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No removals are scheduled.")
+		return nil
+	}
+
+	removals := make([]Removal, len(jobs))
+	for i, job := range jobs {
+		removals[i] = Removal{
+			ID:          job.UUID,
+			Entity:      job.EntityName,
+			EntityType:  job.EntityType,
+			EntityUUID:  job.EntityUUID,
+			Force:       job.Force,
+			Scheduled:   job.ScheduledFor,
+			LastError:   job.LastError,
+			LastErrorAt: job.LastErrorAt,
+		}
+	}
+	return c.out.Write(ctx, removals)
+}
+
+func (c *listRemovalsCommand) formatTabular(writer io.Writer, value interface{}) error {
+	removals, ok := value.([]Removal)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", removals, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("ID", "Type", "Entity", "Force", "Scheduled", "Last error")
+	for _, removal := range removals {
+		entity := removal.Entity
+		if entity == "" {
+			entity = noValueDisplay
+		}
+		lastError := removal.LastError
+		if lastError == "" {
+			lastError = noValueDisplay
+		}
+		w.Println(
+			shortID(removal.ID),
+			removal.EntityType,
+			entity,
+			removal.Force,
+			common.FormatTime(&removal.Scheduled, c.utc),
+			lastError,
+		)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/cmd/juju/removal"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+)
+
+type listSuite struct {
+	baseSuite
+}
+
+func TestListSuite(t *testing.T) {
+	tc.Run(t, &listSuite{})
+}
+
+func (s *listSuite) TestListTabular(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListRemovals()
+
+	ctx, err := cmdtesting.RunCommand(c, removal.NewListRemovalsCommandForTest(s.store, s.api), "--utc")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+ID        Type      Entity  Force  Scheduled             Last error
+3f1d2c4b  unit      foo/0   false  2025-06-01 10:00:00Z  the front fell off
+3f1d9e8f  relation  -       true   2025-06-01 10:00:00Z  -
+`[1:])
+}
+
+func (s *listSuite) TestListYAML(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectListRemovals()
+
+	ctx, err := cmdtesting.RunCommand(c, removal.NewListRemovalsCommandForTest(s.store, s.api), "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+- id: 3f1d2c4b-0bad-400d-8000-4b1d0d06f00d
+  entity: foo/0
+  entity-type: unit
+  entity-uuid: unit-uuid
+  force: false
+  scheduled: 2025-06-01T10:00:00Z
+  last-error: the front fell off
+  last-error-at: 2025-06-01T10:05:00Z
+- id: 3f1d9e8f-0bad-400d-8000-4b1d0d06f00e
+  entity: ""
+  entity-type: relation
+  entity-uuid: relation-uuid
+  force: true
+  scheduled: 2025-06-01T10:00:00Z
+`[1:])
+}
+
+func (s *listSuite) TestListNone(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().ListRemovals(gomock.Any()).Return(nil, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, removal.NewListRemovalsCommandForTest(s.store, s.api))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No removals are scheduled.\n")
+}
+
+func (s *listSuite) TestListArgs(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, removal.NewListRemovalsCommandForTest(s.store, nil), "foo")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["foo"\]`)
+}
@@ -0,0 +1,36 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal
+
+import (
+	"context"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/jujuclient"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package removal -destination removalapi_mock_test.go github.com/juju/juju/cmd/juju/removal RemovalAPI
+
+func newCommandBaseForTest(store jujuclient.ClientStore, api RemovalAPI) removalCommandBase {
+	c := removalCommandBase{
+		newAPIFunc: func(ctx context.Context) (RemovalAPI, error) { return api, nil },
+	}
+	c.SetClientStore(store)
+	return c
+}
+
+// NewListRemovalsCommandForTest returns a removals command for testing.
+func NewListRemovalsCommandForTest(store jujuclient.ClientStore, api RemovalAPI) cmd.Command {
+	return modelcmd.Wrap(&listRemovalsCommand{
+		removalCommandBase: newCommandBaseForTest(store, api),
+	})
+}
+
+// NewCancelRemovalCommandForTest returns a cancel-removal command for testing.
+func NewCancelRemovalCommandForTest(store jujuclient.ClientStore, api RemovalAPI) cmd.Command {
+	return modelcmd.Wrap(&cancelRemovalCommand{
+		removalCommandBase: newCommandBaseForTest(store, api),
+	})
+}
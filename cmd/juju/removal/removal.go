@@ -0,0 +1,92 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package removal provides the commands used to inspect the removal jobs
+// scheduled in a model, and to cancel or force those that are stuck.
+package removal
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/errors"
+
+	apiremoval "github.com/juju/juju/api/client/removal"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// RemovalAPI defines the methods on the Removal facade used by the removal
+// commands.
+type RemovalAPI interface {
+	Close() error
+	ListRemovals(ctx context.Context) ([]params.RemovalJob, error)
+	CancelRemovals(ctx context.Context, jobUUIDs []string) ([]error, error)
+	ForceRemovals(ctx context.Context, jobUUIDs []string) ([]error, error)
+}
+
+// shortIDLength is the number of characters of a removal job UUID shown in
+// tabular output. Any unique prefix can be used to identify a job.
+const shortIDLength = 8
+
+const noValueDisplay = "-"
+
+// removalCommandBase holds the behaviour shared by the removal commands.
+type removalCommandBase struct {
+	modelcmd.ModelCommandBase
+
+	newAPIFunc func(ctx context.Context) (RemovalAPI, error)
+}
+
+func (c *removalCommandBase) removalAPI(ctx context.Context) (RemovalAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apiremoval.NewClient(root), nil
+}
+
+// resolveJobs returns the removal jobs identified by the given ids, each of
+// which is either the UUID of a job or a unique prefix of it.
+func resolveJobs(jobs []params.RemovalJob, ids []string) ([]params.RemovalJob, error) {
+	resolved := make([]params.RemovalJob, len(ids))
+	for i, id := range ids {
+		var matches []params.RemovalJob
+		for _, job := range jobs {
+			if job.UUID == id {
+				matches = []params.RemovalJob{job}
+				break
+			}
+			if strings.HasPrefix(job.UUID, id) {
+				matches = append(matches, job)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			return nil, errors.NotFoundf("removal %q", id)
+		case 1:
+			resolved[i] = matches[0]
+		default:
+			return nil, errors.Errorf("removal id %q matches %d removals", id, len(matches))
+		}
+	}
+	return resolved, nil
+}
+
+// shortID returns the abbreviated form of a removal job UUID.
+func shortID(uuid string) string {
+	if len(uuid) <= shortIDLength {
+		return uuid
+	}
+	return uuid[:shortIDLength]
+}
+
+// entityDisplay returns the description of the entity that a removal job is
+// removing, falling back to its UUID if it no longer exists.
+func entityDisplay(job params.RemovalJob) string {
+	name := job.EntityName
+	if name == "" {
+		name = job.EntityUUID
+	}
+	return job.EntityType + " " + name
+}
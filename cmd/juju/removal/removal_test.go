@@ -0,0 +1,71 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removal_test
+
+import (
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/cmd/juju/removal"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	unitJobUUID     = "3f1d2c4b-0bad-400d-8000-4b1d0d06f00d"
+	relationJobUUID = "3f1d9e8f-0bad-400d-8000-4b1d0d06f00e"
+)
+
+var (
+	scheduled   = time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	lastErrorAt = time.Date(2025, 6, 1, 10, 5, 0, 0, time.UTC)
+)
+
+type baseSuite struct {
+	testhelpers.IsolationSuite
+
+	store *jujuclient.MemStore
+	api   *removal.MockRemovalAPI
+}
+
+func (s *baseSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.store = jujuclient.NewMemStore()
+	s.store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	s.store.CurrentControllerName = "mycontroller"
+	s.store.Models["mycontroller"] = &jujuclient.ControllerModels{
+		Models: map[string]jujuclient.ModelDetails{
+			"admin/mymodel": {ModelUUID: "deadbeef-0bad-400d-8000-4b1d0d06f00d"},
+		},
+		CurrentModel: "admin/mymodel",
+	}
+}
+
+func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.api = removal.NewMockRemovalAPI(ctrl)
+	s.api.EXPECT().Close().Return(nil).AnyTimes()
+	return ctrl
+}
+
+func (s *baseSuite) expectListRemovals() {
+	s.api.EXPECT().ListRemovals(gomock.Any()).Return([]params.RemovalJob{{
+		UUID:         unitJobUUID,
+		EntityType:   "unit",
+		EntityUUID:   "unit-uuid",
+		EntityName:   "foo/0",
+		ScheduledFor: scheduled,
+		LastError:    "the front fell off",
+		LastErrorAt:  &lastErrorAt,
+	}, {
+		UUID:         relationJobUUID,
+		EntityType:   "relation",
+		EntityUUID:   "relation-uuid",
+		Force:        true,
+		ScheduledFor: scheduled,
+	}}, nil)
+}
@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/cmd/juju/removal (interfaces: RemovalAPI)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package removal -destination removalapi_mock_test.go github.com/juju/juju/cmd/juju/removal RemovalAPI
+//
+
+// Package removal is a generated GoMock package.
+package removal
+
+import (
+	context "context"
+	reflect "reflect"
+
+	params "github.com/juju/juju/rpc/params"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRemovalAPI is a mock of RemovalAPI interface.
+type MockRemovalAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemovalAPIMockRecorder
+}
+
+// MockRemovalAPIMockRecorder is the mock recorder for MockRemovalAPI.
+type MockRemovalAPIMockRecorder struct {
+	mock *MockRemovalAPI
+}
+
+// NewMockRemovalAPI creates a new mock instance.
+func NewMockRemovalAPI(ctrl *gomock.Controller) *MockRemovalAPI {
+	mock := &MockRemovalAPI{ctrl: ctrl}
+	mock.recorder = &MockRemovalAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemovalAPI) EXPECT() *MockRemovalAPIMockRecorder {
+	return m.recorder
+}
+
+// CancelRemovals mocks base method.
+func (m *MockRemovalAPI) CancelRemovals(arg0 context.Context, arg1 []string) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelRemovals", arg0, arg1)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelRemovals indicates an expected call of CancelRemovals.
+func (mr *MockRemovalAPIMockRecorder) CancelRemovals(arg0, arg1 any) *MockRemovalAPICancelRemovalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRemovals", reflect.TypeOf((*MockRemovalAPI)(nil).CancelRemovals), arg0, arg1)
+	return &MockRemovalAPICancelRemovalsCall{Call: call}
+}
+
+// MockRemovalAPICancelRemovalsCall wrap *gomock.Call
+type MockRemovalAPICancelRemovalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalAPICancelRemovalsCall) Return(arg0 []error, arg1 error) *MockRemovalAPICancelRemovalsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalAPICancelRemovalsCall) Do(f func(context.Context, []string) ([]error, error)) *MockRemovalAPICancelRemovalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalAPICancelRemovalsCall) DoAndReturn(f func(context.Context, []string) ([]error, error)) *MockRemovalAPICancelRemovalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Close mocks base method.
+func (m *MockRemovalAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockRemovalAPIMockRecorder) Close() *MockRemovalAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockRemovalAPI)(nil).Close))
+	return &MockRemovalAPICloseCall{Call: call}
+}
+
+// MockRemovalAPICloseCall wrap *gomock.Call
+type MockRemovalAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalAPICloseCall) Return(arg0 error) *MockRemovalAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalAPICloseCall) Do(f func() error) *MockRemovalAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalAPICloseCall) DoAndReturn(f func() error) *MockRemovalAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ForceRemovals mocks base method.
+func (m *MockRemovalAPI) ForceRemovals(arg0 context.Context, arg1 []string) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceRemovals", arg0, arg1)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceRemovals indicates an expected call of ForceRemovals.
+func (mr *MockRemovalAPIMockRecorder) ForceRemovals(arg0, arg1 any) *MockRemovalAPIForceRemovalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceRemovals", reflect.TypeOf((*MockRemovalAPI)(nil).ForceRemovals), arg0, arg1)
+	return &MockRemovalAPIForceRemovalsCall{Call: call}
+}
+
+// MockRemovalAPIForceRemovalsCall wrap *gomock.Call
+type MockRemovalAPIForceRemovalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalAPIForceRemovalsCall) Return(arg0 []error, arg1 error) *MockRemovalAPIForceRemovalsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalAPIForceRemovalsCall) Do(f func(context.Context, []string) ([]error, error)) *MockRemovalAPIForceRemovalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalAPIForceRemovalsCall) DoAndReturn(f func(context.Context, []string) ([]error, error)) *MockRemovalAPIForceRemovalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListRemovals mocks base method.
+func (m *MockRemovalAPI) ListRemovals(arg0 context.Context) ([]params.RemovalJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRemovals", arg0)
+	ret0, _ := ret[0].([]params.RemovalJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemovals indicates an expected call of ListRemovals.
+func (mr *MockRemovalAPIMockRecorder) ListRemovals(arg0 any) *MockRemovalAPIListRemovalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemovals", reflect.TypeOf((*MockRemovalAPI)(nil).ListRemovals), arg0)
+	return &MockRemovalAPIListRemovalsCall{Call: call}
+}
+
+// MockRemovalAPIListRemovalsCall wrap *gomock.Call
+type MockRemovalAPIListRemovalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalAPIListRemovalsCall) Return(arg0 []params.RemovalJob, arg1 error) *MockRemovalAPIListRemovalsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalAPIListRemovalsCall) Do(f func(context.Context) ([]params.RemovalJob, error)) *MockRemovalAPIListRemovalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalAPIListRemovalsCall) DoAndReturn(f func(context.Context) ([]params.RemovalJob, error)) *MockRemovalAPIListRemovalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
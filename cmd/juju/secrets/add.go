@@ -8,10 +8,12 @@ import (
 	"fmt"
 
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 
 	apisecrets "github.com/juju/juju/api/client/secrets"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/internal/cmd"
 )
 
@@ -19,13 +21,16 @@ type addSecretCommand struct {
 	modelcmd.ModelCommandBase
 
 	SecretUpsertContentCommand
+	SecretRotationCommand
 	name           string
 	secretsAPIFunc func(context.Context) (AddSecretsAPI, error)
 }
 
 // AddSecretsAPI is the secrets client API.
 type AddSecretsAPI interface {
-	CreateSecret(ctx context.Context, name, description string, data map[string]string) (string, error)
+	CreateSecret(
+		ctx context.Context, name, description string, data map[string]string, rotation apisecrets.RotationArgs,
+	) (string, error)
 	Close() error
 }
 
@@ -56,6 +61,13 @@ If a key has the '#file' suffix, the value is read from the corresponding file.
 
 A secret is owned by the model, meaning only the model admin
 can manage it, ie grant/revoke access, update, remove etc.
+
+Instead of supplying the secret content, the --generate option may be used to
+have Juju generate it. A generated secret may be a random password, an SSH key
+pair, or a self-signed TLS certificate and key. Use the --rotate option to have
+Juju generate new content on a schedule; consumers are notified of each new
+revision in the usual way. The --keep-revisions option sets how many generated
+revisions are kept once they are no longer being tracked by any consumers.
 `
 	addSecretExamples = `
     juju add-secret my-apitoken token=34ae35facd4
@@ -67,6 +79,11 @@ can manage it, ie grant/revoke access, update, remove etc.
     juju add-secret db-password \
         --info "my database password" \
         --file=/path/to/file
+    juju add-secret db-password --generate password,length=24 --rotate monthly
+    juju add-secret backup-key --generate ssh-key --rotate yearly --keep-revisions 2
+    juju add-secret db-cert \
+        --generate tls-cert,common-name=db.example.com,validity=2160h \
+        --rotate quarterly
 `
 )
 
@@ -91,12 +108,33 @@ func (c *addSecretCommand) Init(args []string) error {
 	if err := c.SecretUpsertContentCommand.Init(args); err != nil {
 		return err
 	}
+	if err := c.SecretRotationCommand.Init(); err != nil {
+		return err
+	}
+	if c.Generator != "" {
+		if len(c.Data) > 0 {
+			return errors.New("specify either secret content or --generate but not both")
+		}
+		return nil
+	}
 	if len(c.Data) == 0 {
 		return errors.New("missing secret value or filename")
 	}
+	if c.RotatePolicy != "" && secrets.RotatePolicy(c.RotatePolicy) != secrets.RotateNever {
+		return errors.New("--rotate requires --generate")
+	}
+	if c.KeepRevisions > 0 {
+		return errors.New("--keep-revisions requires --generate")
+	}
 	return nil
 }
 
+// SetFlags implements cmd.Command.
+func (c *addSecretCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.SecretUpsertContentCommand.SetFlags(f)
+	c.SecretRotationCommand.SetFlags(f)
+}
+
 // Run implements cmd.Command.
 func (c *addSecretCommand) Run(ctx *cmd.Context) error {
 	secretsAPI, err := c.secretsAPIFunc(ctx)
@@ -105,7 +143,7 @@ func (c *addSecretCommand) Run(ctx *cmd.Context) error {
 	}
 	defer secretsAPI.Close()
 
-	uri, err := secretsAPI.CreateSecret(ctx, c.name, c.Description, c.Data)
+	uri, err := secretsAPI.CreateSecret(ctx, c.name, c.Description, c.Data, c.RotationArgs())
 	if err != nil {
 		return err
 	}
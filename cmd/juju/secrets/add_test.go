@@ -11,6 +11,7 @@ import (
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
+	apisecrets "github.com/juju/juju/api/client/secrets"
 	"github.com/juju/juju/cmd/juju/secrets"
 	"github.com/juju/juju/cmd/juju/secrets/mocks"
 	coresecrets "github.com/juju/juju/core/secrets"
@@ -47,7 +48,7 @@ func (s *addSuite) TestAddDataFromArg(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretsAPI.EXPECT().CreateSecret(gomock.Any(), "my-secret", "this is a secret.", map[string]string{"foo": "YmFy"}).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().CreateSecret(gomock.Any(), "my-secret", "this is a secret.", map[string]string{"foo": "YmFy"}, apisecrets.RotationArgs{}).Return(uri.String(), nil)
 	s.secretsAPI.EXPECT().Close().Return(nil)
 
 	ctx, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "foo=bar", "--info", "this is a secret.")
@@ -60,7 +61,7 @@ func (s *addSuite) TestAddDataFromFile(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretsAPI.EXPECT().CreateSecret(gomock.Any(), "my-secret", "this is a secret.", map[string]string{"foo": "YmFy"}).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().CreateSecret(gomock.Any(), "my-secret", "this is a secret.", map[string]string{"foo": "YmFy"}, apisecrets.RotationArgs{}).Return(uri.String(), nil)
 	s.secretsAPI.EXPECT().Close().Return(nil)
 
 	dir := c.MkDir()
@@ -83,3 +84,49 @@ func (s *addSuite) TestAddEmptyData(c *tc.C) {
 	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "--info", "this is a secret.")
 	c.Assert(err, tc.ErrorMatches, `missing secret value or filename`)
 }
+
+func (s *addSuite) TestAddGenerated(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().CreateSecret(gomock.Any(), "my-secret", "", map[string]string{}, apisecrets.RotationArgs{
+		RotatePolicy:  ptr(coresecrets.RotateMonthly),
+		Generator:     "password,length=24",
+		KeepRevisions: ptr(2),
+	}).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret",
+		"--generate", "password,length=24", "--rotate", "monthly", "--keep-revisions", "2")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, uri.String()+"\n")
+}
+
+func (s *addSuite) TestAddGeneratedWithContent(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "foo=bar", "--generate", "ssh-key")
+	c.Assert(err, tc.ErrorMatches, `specify either secret content or --generate but not both`)
+}
+
+func (s *addSuite) TestAddInvalidGenerator(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "--generate", "token")
+	c.Assert(err, tc.ErrorMatches, `secret generator type "token" not valid`)
+}
+
+func (s *addSuite) TestAddRotateWithoutGenerator(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "foo=bar", "--rotate", "daily")
+	c.Assert(err, tc.ErrorMatches, `--rotate requires --generate`)
+}
+
+func (s *addSuite) TestAddInvalidRotatePolicy(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "--generate", "ssh-key", "--rotate", "fortnightly")
+	c.Assert(err, tc.ErrorMatches, `rotate policy "fortnightly" not valid`)
+}
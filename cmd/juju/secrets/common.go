@@ -7,6 +7,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
 
+	apisecrets "github.com/juju/juju/api/client/secrets"
 	"github.com/juju/juju/core/secrets"
 )
 
@@ -42,3 +43,49 @@ func (c *SecretUpsertContentCommand) Init(args []string) error {
 	}
 	return nil
 }
+
+// SecretRotationCommand is the helper base command to set up the
+// generation and rotation of the content of a secret by Juju.
+type SecretRotationCommand struct {
+	RotatePolicy  string
+	Generator     string
+	KeepRevisions int
+}
+
+// SetFlags implements cmd.Command.
+func (c *SecretRotationCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.RotatePolicy, "rotate", "", "how often to generate new secret content: hourly, daily, weekly, monthly, quarterly, yearly or never")
+	f.StringVar(&c.Generator, "generate", "", "generate the secret content: password[,length=N][,charset=C], ssh-key, or tls-cert[,common-name=N][,validity=D]")
+	f.IntVar(&c.KeepRevisions, "keep-revisions", 0, "the number of generated revisions to keep when old revisions are pruned")
+}
+
+// Init implements cmd.Command.
+func (c *SecretRotationCommand) Init() error {
+	if c.RotatePolicy != "" && !secrets.RotatePolicy(c.RotatePolicy).IsValid() {
+		return errors.NotValidf("rotate policy %q", c.RotatePolicy)
+	}
+	if c.Generator != "" {
+		if _, err := secrets.ParseGenerator(c.Generator); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if c.KeepRevisions < 0 {
+		return errors.NotValidf("keep revisions %d", c.KeepRevisions)
+	}
+	return nil
+}
+
+// RotationArgs returns the rotation settings to send to the controller.
+func (c *SecretRotationCommand) RotationArgs() apisecrets.RotationArgs {
+	var args apisecrets.RotationArgs
+	if c.RotatePolicy != "" {
+		policy := secrets.RotatePolicy(c.RotatePolicy)
+		args.RotatePolicy = &policy
+	}
+	args.Generator = c.Generator
+	if c.KeepRevisions > 0 {
+		keep := c.KeepRevisions
+		args.KeepRevisions = &keep
+	}
+	return args
+}
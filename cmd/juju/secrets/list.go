@@ -43,6 +43,7 @@ const listSecretsExamples = `
 // ListSecretsAPI is the secrets client API.
 type ListSecretsAPI interface {
 	ListSecrets(context.Context, bool, secrets.Filter) ([]apisecrets.SecretDetails, error)
+	SecretAccessLog(ctx context.Context, uri *secrets.URI, limit int) ([]secrets.AccessLogEntry, error)
 	Close() error
 }
 
@@ -124,6 +125,7 @@ type secretDisplayDetails struct {
 	Value                  *secretValueDetails     `json:"content,omitempty" yaml:"content,omitempty"`
 	Revisions              []secretRevisionDetails `json:"revisions,omitempty" yaml:"revisions,omitempty"`
 	Access                 []AccessInfo            `yaml:"access,omitempty" json:"access,omitempty"`
+	AccessLog              []AccessLogEntry        `yaml:"access-log,omitempty" json:"access-log,omitempty"`
 }
 
 // AccessInfo holds info about a secret access information.
@@ -133,6 +135,27 @@ type AccessInfo struct {
 	Role   secrets.SecretRole `json:"role" yaml:"role"`
 }
 
+// AccessLogEntry holds info about an attempt to read a secret revision.
+type AccessLogEntry struct {
+	Accessor string    `json:"accessor" yaml:"accessor"`
+	Revision int       `json:"revision" yaml:"revision"`
+	Time     time.Time `json:"time" yaml:"time"`
+	Denied   bool      `json:"denied,omitempty" yaml:"denied,omitempty"`
+}
+
+func toAccessLog(entries []secrets.AccessLogEntry) []AccessLogEntry {
+	result := make([]AccessLogEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = AccessLogEntry{
+			Accessor: entry.Accessor,
+			Revision: entry.Revision,
+			Time:     entry.Time,
+			Denied:   entry.Denied,
+		}
+	}
+	return result
+}
+
 func toGrantInfo(grants []secrets.AccessInfo) []AccessInfo {
 	result := make([]AccessInfo, len(grants))
 	for i, grant := range grants {
@@ -0,0 +1,200 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	apisecretsmigration "github.com/juju/juju/api/client/secretsmigration"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/cmd"
+)
+
+type migrateSecretsCommand struct {
+	modelcmd.ModelCommandBase
+
+	secretsAPIFunc func(ctx context.Context) (MigrateSecretsAPI, error)
+	interrupt      chan os.Signal
+
+	fromBackend string
+	toBackend   string
+}
+
+// MigrateSecretsAPI is the secrets migration client API.
+type MigrateSecretsAPI interface {
+	ListSecretRevisionsToMigrate(ctx context.Context, fromBackend, toBackend string) ([]apisecretsmigration.SecretRevisions, error)
+	MigrateSecretRevisions(
+		ctx context.Context, fromBackend, toBackend string, uri *secrets.URI, revisions ...int,
+	) ([]apisecretsmigration.RevisionResult, error)
+	Close() error
+}
+
+// NewMigrateSecretsCommand returns a command to migrate secrets between
+// secret backends.
+func NewMigrateSecretsCommand() cmd.Command {
+	c := &migrateSecretsCommand{}
+	c.secretsAPIFunc = c.secretsAPI
+	return modelcmd.Wrap(c)
+}
+
+func (c *migrateSecretsCommand) secretsAPI(ctx context.Context) (MigrateSecretsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apisecretsmigration.NewClient(root), nil
+}
+
+const (
+	migrateSecretsDoc = `
+Moves the content of the model's secrets from one secret backend to another.
+Both user secrets and secrets owned by charms are migrated.
+
+Each secret revision stored in the source backend is copied to the target
+backend, and the copy is verified against the checksum of the original
+content before the revision is updated to use the target backend. The
+content is then removed from the source backend.
+
+Progress is reported for each secret and revision as it is migrated,
+followed by a summary once the migration stops.
+
+Interrupting the command (for example with Ctrl-C) pauses the migration once
+the revision being migrated has been completed. Running the command again
+with the same backends resumes the migration; revisions which have already
+been migrated are not migrated again.
+
+Migrating secrets does not change the secret backend used by the model for
+new secrets; use the model-secret-backend command for that.
+`
+	migrateSecretsExamples = `
+    juju migrate-secrets --from myvault --to internal
+    juju migrate-secrets --from internal --to myvault
+`
+)
+
+// Info implements cmd.Command.
+func (c *migrateSecretsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "migrate-secrets",
+		Purpose:  "Migrate secrets from one secret backend to another.",
+		Doc:      migrateSecretsDoc,
+		Examples: migrateSecretsExamples,
+		SeeAlso: []string{
+			"secrets",
+			"secret-backends",
+			"model-secret-backend",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *migrateSecretsCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.fromBackend, "from", "", "the secret backend to migrate secrets from")
+	f.StringVar(&c.toBackend, "to", "", "the secret backend to migrate secrets to")
+}
+
+// Init implements cmd.Command.
+func (c *migrateSecretsCommand) Init(args []string) error {
+	if c.fromBackend == "" {
+		return errors.New("--from is required")
+	}
+	if c.toBackend == "" {
+		return errors.New("--to is required")
+	}
+	if c.fromBackend == c.toBackend {
+		return errors.New("--from and --to must be different secret backends")
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// migrationSummary counts the outcome of migrating secret revisions.
+type migrationSummary struct {
+	migrated, skipped, failed, remaining int
+}
+
+// Run implements cmd.Command.
+func (c *migrateSecretsCommand) Run(ctx *cmd.Context) error {
+	secretsAPI, err := c.secretsAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer secretsAPI.Close()
+
+	toMigrate, err := secretsAPI.ListSecretRevisionsToMigrate(ctx, c.fromBackend, c.toBackend)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var summary migrationSummary
+	for _, s := range toMigrate {
+		summary.remaining += len(s.Revisions)
+	}
+	if summary.remaining == 0 {
+		ctx.Infof("No secrets to migrate from %q to %q.", c.fromBackend, c.toBackend)
+		return nil
+	}
+	ctx.Infof("Migrating %d revision(s) of %d secret(s) from %q to %q.",
+		summary.remaining, len(toMigrate), c.fromBackend, c.toBackend)
+
+	interrupt := c.interrupt
+	if interrupt == nil {
+		interrupt = make(chan os.Signal, 1)
+	}
+	ctx.InterruptNotify(interrupt)
+	defer ctx.StopInterruptNotify(interrupt)
+
+	paused := false
+migrate:
+	for i, s := range toMigrate {
+		name := s.URI.String()
+		if s.Label != "" {
+			name = fmt.Sprintf("%s (%s)", name, s.Label)
+		}
+		ctx.Infof("secret %d/%d %s:", i+1, len(toMigrate), name)
+		for j, rev := range s.Revisions {
+			select {
+			case <-interrupt:
+				paused = true
+				break migrate
+			default:
+			}
+
+			progress := fmt.Sprintf("  revision %d (%d/%d)", rev, j+1, len(s.Revisions))
+			results, err := secretsAPI.MigrateSecretRevisions(ctx, c.fromBackend, c.toBackend, s.URI, rev)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			summary.remaining--
+			switch result := results[0]; {
+			case result.Error != nil:
+				summary.failed++
+				ctx.Infof("%s: failed: %v", progress, result.Error)
+			case result.Skipped:
+				summary.skipped++
+				ctx.Infof("%s: already migrated", progress)
+			default:
+				summary.migrated++
+				ctx.Infof("%s: migrated, verified checksum %s", progress, result.Checksum)
+			}
+		}
+	}
+
+	fmt.Fprintf(ctx.Stdout, "Migrated %d, skipped %d, failed %d secret revision(s).\n",
+		summary.migrated, summary.skipped, summary.failed)
+	if paused {
+		fmt.Fprintf(ctx.Stdout, "Migration paused with %d secret revision(s) remaining; run the command again to resume.\n",
+			summary.remaining)
+		return nil
+	}
+	if summary.failed > 0 {
+		return errors.Errorf("%d secret revision(s) could not be migrated", summary.failed)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apisecretsmigration "github.com/juju/juju/api/client/secretsmigration"
+	"github.com/juju/juju/cmd/juju/secrets"
+	"github.com/juju/juju/cmd/juju/secrets/mocks"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/jujuclient"
+)
+
+type migrateSuite struct {
+	testhelpers.IsolationSuite
+	store      *jujuclient.MemStore
+	secretsAPI *mocks.MockMigrateSecretsAPI
+}
+
+func TestMigrateSuite(t *testing.T) {
+	tc.Run(t, &migrateSuite{})
+}
+
+func (s *migrateSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *migrateSuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.secretsAPI = mocks.NewMockMigrateSecretsAPI(ctrl)
+	return ctrl
+}
+
+func (s *migrateSuite) TestInit(c *tc.C) {
+	for _, t := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"--to", "internal"},
+		err:  "--from is required",
+	}, {
+		args: []string{"--from", "myvault"},
+		err:  "--to is required",
+	}, {
+		args: []string{"--from", "myvault", "--to", "myvault"},
+		err:  "--from and --to must be different secret backends",
+	}, {
+		args: []string{"--from", "myvault", "--to", "internal", "extra"},
+		err:  `unrecognized args: \["extra"\]`,
+	}} {
+		_, err := cmdtesting.RunCommand(c, secrets.NewMigrateCommandForTest(s.store, nil, nil), t.args...)
+		c.Check(err, tc.ErrorMatches, t.err)
+	}
+}
+
+func (s *migrateSuite) TestMigrate(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri1 := coresecrets.NewURI()
+	uri2 := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().ListSecretRevisionsToMigrate(gomock.Any(), "myvault", "internal").Return(
+		[]apisecretsmigration.SecretRevisions{{
+			URI:       uri1,
+			Label:     "foo",
+			Revisions: []int{1, 2},
+		}, {
+			URI:       uri2,
+			Revisions: []int{3},
+		}}, nil)
+	s.secretsAPI.EXPECT().MigrateSecretRevisions(gomock.Any(), "myvault", "internal", uri1, 1).Return(
+		[]apisecretsmigration.RevisionResult{{Checksum: "deadbeef"}}, nil)
+	s.secretsAPI.EXPECT().MigrateSecretRevisions(gomock.Any(), "myvault", "internal", uri1, 2).Return(
+		[]apisecretsmigration.RevisionResult{{Skipped: true}}, nil)
+	s.secretsAPI.EXPECT().MigrateSecretRevisions(gomock.Any(), "myvault", "internal", uri2, 3).Return(
+		[]apisecretsmigration.RevisionResult{{Checksum: "cafebabe"}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewMigrateCommandForTest(s.store, s.secretsAPI, nil),
+		"--from", "myvault", "--to", "internal")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+Migrating 3 revision(s) of 2 secret(s) from "myvault" to "internal".
+secret 1/2 `[1:]+uri1.String()+` (foo):
+  revision 1 (1/2): migrated, verified checksum deadbeef
+  revision 2 (2/2): already migrated
+secret 2/2 `+uri2.String()+`:
+  revision 3 (1/1): migrated, verified checksum cafebabe
+`)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "Migrated 2, skipped 1, failed 0 secret revision(s).\n")
+}
+
+func (s *migrateSuite) TestMigrateNothingToDo(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.secretsAPI.EXPECT().ListSecretRevisionsToMigrate(gomock.Any(), "myvault", "internal").Return(nil, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewMigrateCommandForTest(s.store, s.secretsAPI, nil),
+		"--from", "myvault", "--to", "internal")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No secrets to migrate from \"myvault\" to \"internal\".\n")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "")
+}
+
+func (s *migrateSuite) TestMigrateFailures(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().ListSecretRevisionsToMigrate(gomock.Any(), "myvault", "internal").Return(
+		[]apisecretsmigration.SecretRevisions{{
+			URI:       uri,
+			Revisions: []int{1, 2},
+		}}, nil)
+	s.secretsAPI.EXPECT().MigrateSecretRevisions(gomock.Any(), "myvault", "internal", uri, 1).Return(
+		[]apisecretsmigration.RevisionResult{{Error: errors.New("checksum mismatch")}}, nil)
+	s.secretsAPI.EXPECT().MigrateSecretRevisions(gomock.Any(), "myvault", "internal", uri, 2).Return(
+		[]apisecretsmigration.RevisionResult{{Checksum: "deadbeef"}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewMigrateCommandForTest(s.store, s.secretsAPI, nil),
+		"--from", "myvault", "--to", "internal")
+	c.Assert(err, tc.ErrorMatches, `1 secret revision\(s\) could not be migrated`)
+	c.Check(cmdtesting.Stderr(ctx), tc.Contains, "  revision 1 (1/2): failed: checksum mismatch\n")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "Migrated 1, skipped 0, failed 1 secret revision(s).\n")
+}
+
+func (s *migrateSuite) TestMigratePaused(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	interrupt := make(chan os.Signal, 1)
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().ListSecretRevisionsToMigrate(gomock.Any(), "myvault", "internal").Return(
+		[]apisecretsmigration.SecretRevisions{{
+			URI:       uri,
+			Revisions: []int{1, 2, 3},
+		}}, nil)
+	s.secretsAPI.EXPECT().MigrateSecretRevisions(gomock.Any(), "myvault", "internal", uri, 1).DoAndReturn(
+		func(context.Context, string, string, *coresecrets.URI, ...int) ([]apisecretsmigration.RevisionResult, error) {
+			interrupt <- os.Interrupt
+			return []apisecretsmigration.RevisionResult{{Checksum: "deadbeef"}}, nil
+		})
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewMigrateCommandForTest(s.store, s.secretsAPI, interrupt),
+		"--from", "myvault", "--to", "internal")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Migrated 1, skipped 0, failed 0 secret revision(s).
+Migration paused with 2 secret revision(s) remaining; run the command again to resume.
+`[1:])
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI)
+// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,MigrateSecretsAPI)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,MigrateSecretsAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	secrets "github.com/juju/juju/api/client/secrets"
+	secretsmigration "github.com/juju/juju/api/client/secretsmigration"
 	secrets0 "github.com/juju/juju/core/secrets"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -118,6 +119,45 @@ func (c *MockListSecretsAPIListSecretsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// SecretAccessLog mocks base method.
+func (m *MockListSecretsAPI) SecretAccessLog(arg0 context.Context, arg1 *secrets0.URI, arg2 int) ([]secrets0.AccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretAccessLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secrets0.AccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretAccessLog indicates an expected call of SecretAccessLog.
+func (mr *MockListSecretsAPIMockRecorder) SecretAccessLog(arg0, arg1, arg2 any) *MockListSecretsAPISecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretAccessLog", reflect.TypeOf((*MockListSecretsAPI)(nil).SecretAccessLog), arg0, arg1, arg2)
+	return &MockListSecretsAPISecretAccessLogCall{Call: call}
+}
+
+// MockListSecretsAPISecretAccessLogCall wrap *gomock.Call
+type MockListSecretsAPISecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListSecretsAPISecretAccessLogCall) Return(arg0 []secrets0.AccessLogEntry, arg1 error) *MockListSecretsAPISecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListSecretsAPISecretAccessLogCall) Do(f func(context.Context, *secrets0.URI, int) ([]secrets0.AccessLogEntry, error)) *MockListSecretsAPISecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListSecretsAPISecretAccessLogCall) DoAndReturn(f func(context.Context, *secrets0.URI, int) ([]secrets0.AccessLogEntry, error)) *MockListSecretsAPISecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockAddSecretsAPI is a mock of AddSecretsAPI interface.
 type MockAddSecretsAPI struct {
 	ctrl     *gomock.Controller
@@ -180,18 +220,18 @@ func (c *MockAddSecretsAPICloseCall) DoAndReturn(f func() error) *MockAddSecrets
 }
 
 // CreateSecret mocks base method.
-func (m *MockAddSecretsAPI) CreateSecret(arg0 context.Context, arg1, arg2 string, arg3 map[string]string) (string, error) {
+func (m *MockAddSecretsAPI) CreateSecret(arg0 context.Context, arg1, arg2 string, arg3 map[string]string, arg4 secrets.RotationArgs) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecret", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "CreateSecret", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSecret indicates an expected call of CreateSecret.
-func (mr *MockAddSecretsAPIMockRecorder) CreateSecret(arg0, arg1, arg2, arg3 any) *MockAddSecretsAPICreateSecretCall {
+func (mr *MockAddSecretsAPIMockRecorder) CreateSecret(arg0, arg1, arg2, arg3, arg4 any) *MockAddSecretsAPICreateSecretCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockAddSecretsAPI)(nil).CreateSecret), arg0, arg1, arg2, arg3)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockAddSecretsAPI)(nil).CreateSecret), arg0, arg1, arg2, arg3, arg4)
 	return &MockAddSecretsAPICreateSecretCall{Call: call}
 }
 
@@ -207,13 +247,13 @@ func (c *MockAddSecretsAPICreateSecretCall) Return(arg0 string, arg1 error) *Moc
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockAddSecretsAPICreateSecretCall) Do(f func(context.Context, string, string, map[string]string) (string, error)) *MockAddSecretsAPICreateSecretCall {
+func (c *MockAddSecretsAPICreateSecretCall) Do(f func(context.Context, string, string, map[string]string, secrets.RotationArgs) (string, error)) *MockAddSecretsAPICreateSecretCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockAddSecretsAPICreateSecretCall) DoAndReturn(f func(context.Context, string, string, map[string]string) (string, error)) *MockAddSecretsAPICreateSecretCall {
+func (c *MockAddSecretsAPICreateSecretCall) DoAndReturn(f func(context.Context, string, string, map[string]string, secrets.RotationArgs) (string, error)) *MockAddSecretsAPICreateSecretCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -419,17 +459,17 @@ func (c *MockUpdateSecretsAPICloseCall) DoAndReturn(f func() error) *MockUpdateS
 }
 
 // UpdateSecret mocks base method.
-func (m *MockUpdateSecretsAPI) UpdateSecret(arg0 context.Context, arg1 *secrets0.URI, arg2 string, arg3 *bool, arg4, arg5 string, arg6 map[string]string) error {
+func (m *MockUpdateSecretsAPI) UpdateSecret(arg0 context.Context, arg1 *secrets0.URI, arg2 string, arg3 *bool, arg4, arg5 string, arg6 map[string]string, arg7 secrets.RotationArgs) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSecret", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret := m.ctrl.Call(m, "UpdateSecret", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateSecret indicates an expected call of UpdateSecret.
-func (mr *MockUpdateSecretsAPIMockRecorder) UpdateSecret(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *MockUpdateSecretsAPIUpdateSecretCall {
+func (mr *MockUpdateSecretsAPIMockRecorder) UpdateSecret(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 any) *MockUpdateSecretsAPIUpdateSecretCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MockUpdateSecretsAPI)(nil).UpdateSecret), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MockUpdateSecretsAPI)(nil).UpdateSecret), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	return &MockUpdateSecretsAPIUpdateSecretCall{Call: call}
 }
 
@@ -445,13 +485,13 @@ func (c *MockUpdateSecretsAPIUpdateSecretCall) Return(arg0 error) *MockUpdateSec
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockUpdateSecretsAPIUpdateSecretCall) Do(f func(context.Context, *secrets0.URI, string, *bool, string, string, map[string]string) error) *MockUpdateSecretsAPIUpdateSecretCall {
+func (c *MockUpdateSecretsAPIUpdateSecretCall) Do(f func(context.Context, *secrets0.URI, string, *bool, string, string, map[string]string, secrets.RotationArgs) error) *MockUpdateSecretsAPIUpdateSecretCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUpdateSecretsAPIUpdateSecretCall) DoAndReturn(f func(context.Context, *secrets0.URI, string, *bool, string, string, map[string]string) error) *MockUpdateSecretsAPIUpdateSecretCall {
+func (c *MockUpdateSecretsAPIUpdateSecretCall) DoAndReturn(f func(context.Context, *secrets0.URI, string, *bool, string, string, map[string]string, secrets.RotationArgs) error) *MockUpdateSecretsAPIUpdateSecretCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -554,3 +594,147 @@ func (c *MockRemoveSecretsAPIRemoveSecretCall) DoAndReturn(f func(context.Contex
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockMigrateSecretsAPI is a mock of MigrateSecretsAPI interface.
+type MockMigrateSecretsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMigrateSecretsAPIMockRecorder
+}
+
+// MockMigrateSecretsAPIMockRecorder is the mock recorder for MockMigrateSecretsAPI.
+type MockMigrateSecretsAPIMockRecorder struct {
+	mock *MockMigrateSecretsAPI
+}
+
+// NewMockMigrateSecretsAPI creates a new mock instance.
+func NewMockMigrateSecretsAPI(ctrl *gomock.Controller) *MockMigrateSecretsAPI {
+	mock := &MockMigrateSecretsAPI{ctrl: ctrl}
+	mock.recorder = &MockMigrateSecretsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMigrateSecretsAPI) EXPECT() *MockMigrateSecretsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockMigrateSecretsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockMigrateSecretsAPIMockRecorder) Close() *MockMigrateSecretsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMigrateSecretsAPI)(nil).Close))
+	return &MockMigrateSecretsAPICloseCall{Call: call}
+}
+
+// MockMigrateSecretsAPICloseCall wrap *gomock.Call
+type MockMigrateSecretsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMigrateSecretsAPICloseCall) Return(arg0 error) *MockMigrateSecretsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMigrateSecretsAPICloseCall) Do(f func() error) *MockMigrateSecretsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMigrateSecretsAPICloseCall) DoAndReturn(f func() error) *MockMigrateSecretsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSecretRevisionsToMigrate mocks base method.
+func (m *MockMigrateSecretsAPI) ListSecretRevisionsToMigrate(arg0 context.Context, arg1, arg2 string) ([]secretsmigration.SecretRevisions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretRevisionsToMigrate", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secretsmigration.SecretRevisions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretRevisionsToMigrate indicates an expected call of ListSecretRevisionsToMigrate.
+func (mr *MockMigrateSecretsAPIMockRecorder) ListSecretRevisionsToMigrate(arg0, arg1, arg2 any) *MockMigrateSecretsAPIListSecretRevisionsToMigrateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretRevisionsToMigrate", reflect.TypeOf((*MockMigrateSecretsAPI)(nil).ListSecretRevisionsToMigrate), arg0, arg1, arg2)
+	return &MockMigrateSecretsAPIListSecretRevisionsToMigrateCall{Call: call}
+}
+
+// MockMigrateSecretsAPIListSecretRevisionsToMigrateCall wrap *gomock.Call
+type MockMigrateSecretsAPIListSecretRevisionsToMigrateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMigrateSecretsAPIListSecretRevisionsToMigrateCall) Return(arg0 []secretsmigration.SecretRevisions, arg1 error) *MockMigrateSecretsAPIListSecretRevisionsToMigrateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMigrateSecretsAPIListSecretRevisionsToMigrateCall) Do(f func(context.Context, string, string) ([]secretsmigration.SecretRevisions, error)) *MockMigrateSecretsAPIListSecretRevisionsToMigrateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMigrateSecretsAPIListSecretRevisionsToMigrateCall) DoAndReturn(f func(context.Context, string, string) ([]secretsmigration.SecretRevisions, error)) *MockMigrateSecretsAPIListSecretRevisionsToMigrateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MigrateSecretRevisions mocks base method.
+func (m *MockMigrateSecretsAPI) MigrateSecretRevisions(arg0 context.Context, arg1, arg2 string, arg3 *secrets0.URI, arg4 ...int) ([]secretsmigration.RevisionResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateSecretRevisions", varargs...)
+	ret0, _ := ret[0].([]secretsmigration.RevisionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSecretRevisions indicates an expected call of MigrateSecretRevisions.
+func (mr *MockMigrateSecretsAPIMockRecorder) MigrateSecretRevisions(arg0, arg1, arg2, arg3 any, arg4 ...any) *MockMigrateSecretsAPIMigrateSecretRevisionsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2, arg3}, arg4...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSecretRevisions", reflect.TypeOf((*MockMigrateSecretsAPI)(nil).MigrateSecretRevisions), varargs...)
+	return &MockMigrateSecretsAPIMigrateSecretRevisionsCall{Call: call}
+}
+
+// MockMigrateSecretsAPIMigrateSecretRevisionsCall wrap *gomock.Call
+type MockMigrateSecretsAPIMigrateSecretRevisionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMigrateSecretsAPIMigrateSecretRevisionsCall) Return(arg0 []secretsmigration.RevisionResult, arg1 error) *MockMigrateSecretsAPIMigrateSecretRevisionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMigrateSecretsAPIMigrateSecretRevisionsCall) Do(f func(context.Context, string, string, *secrets0.URI, ...int) ([]secretsmigration.RevisionResult, error)) *MockMigrateSecretsAPIMigrateSecretRevisionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMigrateSecretsAPIMigrateSecretRevisionsCall) DoAndReturn(f func(context.Context, string, string, *secrets0.URI, ...int) ([]secretsmigration.RevisionResult, error)) *MockMigrateSecretsAPIMigrateSecretRevisionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -5,11 +5,12 @@ package secrets
 
 import (
 	"context"
+	"os"
 
 	"github.com/juju/juju/jujuclient"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,MigrateSecretsAPI
 
 // NewAddCommandForTest returns a secrets command for testing.
 func NewAddCommandForTest(store jujuclient.ClientStore, api AddSecretsAPI) *addSecretCommand {
@@ -73,3 +74,13 @@ func NewShowCommandForTest(store jujuclient.ClientStore, listSecretsAPI ListSecr
 	c.SetClientStore(store)
 	return c
 }
+
+// NewMigrateCommandForTest returns a migrate-secrets command for testing.
+func NewMigrateCommandForTest(store jujuclient.ClientStore, api MigrateSecretsAPI, interrupt chan os.Signal) *migrateSecretsCommand {
+	c := &migrateSecretsCommand{
+		secretsAPIFunc: func(ctx context.Context) (MigrateSecretsAPI, error) { return api, nil },
+		interrupt:      interrupt,
+	}
+	c.SetClientStore(store)
+	return c
+}
@@ -26,6 +26,7 @@ type showSecretsCommand struct {
 	revealSecrets      bool
 	revisions          bool
 	revision           int
+	accessLog          bool
 }
 
 var showSecretsDoc = `
@@ -36,6 +37,11 @@ with the '--reveal' option in json or yaml formats.
 
 Use --revision to inspect a particular revision, else latest is used.
 Use --revisions to see the metadata for each revision.
+
+Model admins can use --access-log to see the recorded attempts by units,
+applications and users to read the secret content, most recent first, including
+those which were denied. Entries older than the model's
+max-secret-access-log-age setting are removed.
 `
 
 const showSecretsExamples = `
@@ -45,6 +51,7 @@ const showSecretsExamples = `
     juju show-secret 9m4e2mr0ui3e8a215n4g --revision 2 --reveal
     juju show-secret 9m4e2mr0ui3e8a215n4g --revisions
     juju show-secret 9m4e2mr0ui3e8a215n4g --reveal
+    juju show-secret 9m4e2mr0ui3e8a215n4g --access-log
 `
 
 // NewShowSecretsCommand returns a command to list secrets metadata.
@@ -86,6 +93,7 @@ func (c *showSecretsCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.revisions, "revisions", false, "Show the secret revisions metadata")
 	f.IntVar(&c.revision, "revision", 0, "Show a specific revision (defaults to latest)")
 	f.IntVar(&c.revision, "r", 0, "")
+	f.BoolVar(&c.accessLog, "access-log", false, "Show the secret access log")
 	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
 		"yaml": cmd.FormatYaml,
 		"json": cmd.FormatJson,
@@ -149,6 +157,16 @@ func (c *showSecretsCommand) Run(ctxt *cmd.Context) error {
 		}
 		return errors.NotFoundf("secret %q", c.name)
 	}
+	if c.accessLog {
+		for id, info := range details {
+			entries, err := api.SecretAccessLog(ctxt, info.URI, 0)
+			if err != nil {
+				return errors.Annotatef(err, "reading access log for secret %q", id)
+			}
+			info.AccessLog = toAccessLog(entries)
+			details[id] = info
+		}
+	}
 
 	return c.out.Write(ctxt, details)
 }
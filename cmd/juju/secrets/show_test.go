@@ -228,3 +228,49 @@ func (s *ShowSuite) TestShowRevisions(c *tc.C) {
     updated: 0001-01-01T00:00:00Z
 `[1:], uri.ID))
 }
+
+func (s *ShowSuite) TestShowAccessLog(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	accessTime := testing.NonZeroTime().UTC()
+	s.secretsAPI.EXPECT().ListSecrets(gomock.Any(), false, coresecrets.Filter{
+		URI: uri,
+	}).Return(
+		[]apisecrets.SecretDetails{{
+			Metadata: coresecrets.SecretMetadata{
+				URI: uri, Version: 1, LatestRevision: 2,
+				Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
+			},
+		}}, nil)
+	s.secretsAPI.EXPECT().SecretAccessLog(gomock.Any(), uri, 0).Return([]coresecrets.AccessLogEntry{{
+		Accessor: "unit-gitlab-0",
+		Revision: 2,
+		Time:     accessTime,
+	}, {
+		Accessor: "unit-wordpress-0",
+		Revision: 1,
+		Time:     accessTime,
+		Denied:   true,
+	}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.ID, "--access-log")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, fmt.Sprintf(`
+%s:
+  revision: 2
+  owner: mysql
+  created: 0001-01-01T00:00:00Z
+  updated: 0001-01-01T00:00:00Z
+  access-log:
+  - accessor: unit-gitlab-0
+    revision: 2
+    time: 1970-01-01T00:00:00.000000001Z
+  - accessor: unit-wordpress-0
+    revision: 1
+    time: 1970-01-01T00:00:00.000000001Z
+    denied: true
+`[1:], uri.ID))
+}
@@ -21,6 +21,7 @@ type updateSecretCommand struct {
 	modelcmd.ModelCommandBase
 
 	SecretUpsertContentCommand
+	SecretRotationCommand
 	secretsAPIFunc func(ctx context.Context) (UpdateSecretsAPI, error)
 
 	secretURI *secrets.URI
@@ -36,6 +37,7 @@ type UpdateSecretsAPI interface {
 		ctx context.Context,
 		uri *secrets.URI, name string, autoPrune *bool,
 		newName, description string, data map[string]string,
+		rotation apisecrets.RotationArgs,
 	) error
 	Close() error
 }
@@ -66,6 +68,10 @@ which are no longer being tracked by any observers (see Rotation and Expiry).
 This is configured per revision. This feature is opt-in because Juju 
 automatically removing secret content might result in data loss.
 
+The --generate option replaces the content of the secret with content generated
+by Juju, and the generator is used for any subsequent rotation. The --rotate
+and --keep-revisions options may be used to change how often new content is
+generated and how many generated revisions are kept.
 `
 	updateSecretExamples = `
     juju update-secret secret:9m4e2mr0ui3e8a215n4g token=34ae35facd4
@@ -79,6 +85,8 @@ automatically removing secret content might result in data loss.
     juju update-secret secret:9m4e2mr0ui3e8a215n4g --name db-password \
         --info "my database password" \
         --file=/path/to/file
+    juju update-secret db-password --generate password,length=32,charset=printable
+    juju update-secret db-password --rotate weekly --keep-revisions 3
 `
 )
 
@@ -102,11 +110,21 @@ func (c *updateSecretCommand) Init(args []string) error {
 	if c.secretURI, err = secrets.ParseURI(args[0]); err != nil {
 		c.name = args[0]
 	}
-	return c.SecretUpsertContentCommand.Init(args[1:])
+	if err := c.SecretUpsertContentCommand.Init(args[1:]); err != nil {
+		return err
+	}
+	if err := c.SecretRotationCommand.Init(); err != nil {
+		return err
+	}
+	if c.Generator != "" && len(c.Data) > 0 {
+		return errors.New("specify either secret content or --generate but not both")
+	}
+	return nil
 }
 
 func (c *updateSecretCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.SecretUpsertContentCommand.SetFlags(f)
+	c.SecretRotationCommand.SetFlags(f)
 	f.StringVar(&c.newName, "name", "", "the new secret name")
 	f.Var(&c.autoPrune, "auto-prune", "used to allow Juju to automatically remove revisions which are no longer being tracked by any observers")
 }
@@ -118,5 +136,5 @@ func (c *updateSecretCommand) Run(ctx *cmd.Context) error {
 		return errors.Trace(err)
 	}
 	defer func() { _ = secretsAPI.Close() }()
-	return secretsAPI.UpdateSecret(ctx, c.secretURI, c.name, c.autoPrune.Get(), c.newName, c.Description, c.Data)
+	return secretsAPI.UpdateSecret(ctx, c.secretURI, c.name, c.autoPrune.Get(), c.newName, c.Description, c.Data, c.RotationArgs())
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
+	apisecrets "github.com/juju/juju/api/client/secrets"
 	"github.com/juju/juju/cmd/juju/secrets"
 	"github.com/juju/juju/cmd/juju/secrets/mocks"
 	coresecrets "github.com/juju/juju/core/secrets"
@@ -54,7 +55,7 @@ func (s *updateSuite) TestUpdateWithoutContent(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", ptr(true), "new-name", "this is a secret.", map[string]string{}).Return(nil)
+	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", ptr(true), "new-name", "this is a secret.", map[string]string{}, apisecrets.RotationArgs{}).Return(nil)
 	s.secretsAPI.EXPECT().Close().Return(nil)
 
 	_, err := cmdtesting.RunCommand(c, secrets.NewUpdateCommandForTest(
@@ -68,7 +69,7 @@ func (s *updateSuite) TestUpdateFromArg(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", ptr(true), "new-name", "this is a secret.", map[string]string{"foo": "YmFy"}).Return(nil)
+	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", ptr(true), "new-name", "this is a secret.", map[string]string{"foo": "YmFy"}, apisecrets.RotationArgs{}).Return(nil)
 	s.secretsAPI.EXPECT().Close().Return(nil)
 
 	_, err := cmdtesting.RunCommand(c, secrets.NewUpdateCommandForTest(
@@ -82,7 +83,7 @@ func (s *updateSuite) TestUpdateAutoPruneFalse(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", ptr(false), "", "", map[string]string{}).Return(nil)
+	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", ptr(false), "", "", map[string]string{}, apisecrets.RotationArgs{}).Return(nil)
 	s.secretsAPI.EXPECT().Close().Return(nil)
 
 	_, err := cmdtesting.RunCommand(c, secrets.NewUpdateCommandForTest(
@@ -95,7 +96,7 @@ func (s *updateSuite) TestUpdateAutoPruneNil(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", nil, "", "this is a secret.", map[string]string{}).Return(nil)
+	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", nil, "", "this is a secret.", map[string]string{}, apisecrets.RotationArgs{}).Return(nil)
 	s.secretsAPI.EXPECT().Close().Return(nil)
 
 	_, err := cmdtesting.RunCommand(c, secrets.NewUpdateCommandForTest(
@@ -108,7 +109,7 @@ func (s *updateSuite) TestUpdateFromFile(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", ptr(true), "new-name", "this is a secret.", map[string]string{"foo": "YmFy"}).Return(nil)
+	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", ptr(true), "new-name", "this is a secret.", map[string]string{"foo": "YmFy"}, apisecrets.RotationArgs{}).Return(nil)
 	s.secretsAPI.EXPECT().Close().Return(nil)
 
 	dir := c.MkDir()
@@ -124,3 +125,31 @@ foo: bar
 	)
 	c.Assert(err, tc.ErrorIsNil)
 }
+
+func (s *updateSuite) TestUpdateGenerated(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().UpdateSecret(gomock.Any(), uri, "", nil, "", "", map[string]string{}, apisecrets.RotationArgs{
+		RotatePolicy:  ptr(coresecrets.RotateWeekly),
+		Generator:     "tls-cert,common-name=db.example.com",
+		KeepRevisions: ptr(3),
+	}).Return(nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewUpdateCommandForTest(
+		s.store, s.secretsAPI), uri.String(), "--generate", "tls-cert,common-name=db.example.com",
+		"--rotate", "weekly", "--keep-revisions", "3",
+	)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *updateSuite) TestUpdateGeneratedWithContent(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	_, err := cmdtesting.RunCommand(c, secrets.NewUpdateCommandForTest(
+		s.store, s.secretsAPI), uri.String(), "foo=bar", "--generate", "ssh-key",
+	)
+	c.Assert(err, tc.ErrorMatches, `specify either secret content or --generate but not both`)
+}
@@ -9,8 +9,11 @@ import (
 
 	"github.com/juju/retry"
 
+	"github.com/juju/juju/cmd/modelcmd"
+
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/internal/cmd"
 	jujussh "github.com/juju/juju/internal/network/ssh"
 	k8sexec "github.com/juju/juju/internal/provider/kubernetes/exec"
 	"github.com/juju/juju/internal/uuid"
@@ -220,3 +223,23 @@ func NewDebugCodeCommandForTest(
 	c.SetClientStore(clientStore())
 	return c
 }
+
+func NewListSessionRecordingsCommandForTest(api SessionRecordingsAPI) cmd.Command {
+	c := &listSessionRecordingsCommand{
+		newAPIFunc: func(context.Context) (SessionRecordingsAPI, error) {
+			return api, nil
+		},
+	}
+	c.SetClientStore(clientStore())
+	return modelcmd.Wrap(c)
+}
+
+func NewShowSessionRecordingCommandForTest(api SessionRecordingsAPI) cmd.Command {
+	c := &showSessionRecordingCommand{
+		newAPIFunc: func(context.Context) (SessionRecordingsAPI, error) {
+			return api, nil
+		},
+	}
+	c.SetClientStore(clientStore())
+	return modelcmd.Wrap(c)
+}
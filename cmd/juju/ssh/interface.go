@@ -10,6 +10,7 @@ import (
 
 	"github.com/juju/juju/api/client/application"
 	"github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/api/client/sshclient"
 	apicharm "github.com/juju/juju/api/common/charm"
 	"github.com/juju/juju/api/common/charms"
 	jujucloud "github.com/juju/juju/cloud"
@@ -60,6 +61,7 @@ type coreSSHClient interface {
 type SSHClientAPI interface {
 	coreSSHClient
 	ModelCredentialForSSH(ctx context.Context) (cloudspec.CloudSpec, error)
+	UserCertificate(ctx context.Context, publicKey string) (sshclient.UserCertificate, error)
 }
 
 // SSHControllerAPI defines controller related APIs.
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/ssh (interfaces: Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand)
+// Source: github.com/juju/juju/cmd/juju/ssh (interfaces: Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand,SessionRecordingsAPI)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/package_mock.go github.com/juju/juju/cmd/juju/ssh Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand
+//	mockgen -typed -package mocks -destination mocks/package_mock.go github.com/juju/juju/cmd/juju/ssh Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand,SessionRecordingsAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -19,6 +19,7 @@ import (
 	api "github.com/juju/juju/api"
 	application "github.com/juju/juju/api/client/application"
 	client "github.com/juju/juju/api/client/client"
+	sshclient "github.com/juju/juju/api/client/sshclient"
 	charm "github.com/juju/juju/api/common/charm"
 	charms "github.com/juju/juju/api/common/charms"
 	cloud "github.com/juju/juju/cloud"
@@ -788,6 +789,45 @@ func (c *MockSSHClientAPIPublicKeysCall) DoAndReturn(f func(context.Context, str
 	return c
 }
 
+// UserCertificate mocks base method.
+func (m *MockSSHClientAPI) UserCertificate(arg0 context.Context, arg1 string) (sshclient.UserCertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserCertificate", arg0, arg1)
+	ret0, _ := ret[0].(sshclient.UserCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserCertificate indicates an expected call of UserCertificate.
+func (mr *MockSSHClientAPIMockRecorder) UserCertificate(arg0, arg1 any) *MockSSHClientAPIUserCertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserCertificate", reflect.TypeOf((*MockSSHClientAPI)(nil).UserCertificate), arg0, arg1)
+	return &MockSSHClientAPIUserCertificateCall{Call: call}
+}
+
+// MockSSHClientAPIUserCertificateCall wrap *gomock.Call
+type MockSSHClientAPIUserCertificateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHClientAPIUserCertificateCall) Return(arg0 sshclient.UserCertificate, arg1 error) *MockSSHClientAPIUserCertificateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHClientAPIUserCertificateCall) Do(f func(context.Context, string) (sshclient.UserCertificate, error)) *MockSSHClientAPIUserCertificateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHClientAPIUserCertificateCall) DoAndReturn(f func(context.Context, string) (sshclient.UserCertificate, error)) *MockSSHClientAPIUserCertificateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockSSHControllerAPI is a mock of SSHControllerAPI interface.
 type MockSSHControllerAPI struct {
 	ctrl     *gomock.Controller
@@ -1663,3 +1703,142 @@ func (c *MockModelCommandNewControllerAPIRootCall) DoAndReturn(f func(context.Co
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSessionRecordingsAPI is a mock of SessionRecordingsAPI interface.
+type MockSessionRecordingsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRecordingsAPIMockRecorder
+}
+
+// MockSessionRecordingsAPIMockRecorder is the mock recorder for MockSessionRecordingsAPI.
+type MockSessionRecordingsAPIMockRecorder struct {
+	mock *MockSessionRecordingsAPI
+}
+
+// NewMockSessionRecordingsAPI creates a new mock instance.
+func NewMockSessionRecordingsAPI(ctrl *gomock.Controller) *MockSessionRecordingsAPI {
+	mock := &MockSessionRecordingsAPI{ctrl: ctrl}
+	mock.recorder = &MockSessionRecordingsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRecordingsAPI) EXPECT() *MockSessionRecordingsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSessionRecordingsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSessionRecordingsAPIMockRecorder) Close() *MockSessionRecordingsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSessionRecordingsAPI)(nil).Close))
+	return &MockSessionRecordingsAPICloseCall{Call: call}
+}
+
+// MockSessionRecordingsAPICloseCall wrap *gomock.Call
+type MockSessionRecordingsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSessionRecordingsAPICloseCall) Return(arg0 error) *MockSessionRecordingsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSessionRecordingsAPICloseCall) Do(f func() error) *MockSessionRecordingsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSessionRecordingsAPICloseCall) DoAndReturn(f func() error) *MockSessionRecordingsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSessionRecordings mocks base method.
+func (m *MockSessionRecordingsAPI) ListSessionRecordings(arg0 context.Context, arg1, arg2 string) ([]sshclient.SessionRecording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessionRecordings", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]sshclient.SessionRecording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessionRecordings indicates an expected call of ListSessionRecordings.
+func (mr *MockSessionRecordingsAPIMockRecorder) ListSessionRecordings(arg0, arg1, arg2 any) *MockSessionRecordingsAPIListSessionRecordingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessionRecordings", reflect.TypeOf((*MockSessionRecordingsAPI)(nil).ListSessionRecordings), arg0, arg1, arg2)
+	return &MockSessionRecordingsAPIListSessionRecordingsCall{Call: call}
+}
+
+// MockSessionRecordingsAPIListSessionRecordingsCall wrap *gomock.Call
+type MockSessionRecordingsAPIListSessionRecordingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSessionRecordingsAPIListSessionRecordingsCall) Return(arg0 []sshclient.SessionRecording, arg1 error) *MockSessionRecordingsAPIListSessionRecordingsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSessionRecordingsAPIListSessionRecordingsCall) Do(f func(context.Context, string, string) ([]sshclient.SessionRecording, error)) *MockSessionRecordingsAPIListSessionRecordingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSessionRecordingsAPIListSessionRecordingsCall) DoAndReturn(f func(context.Context, string, string) ([]sshclient.SessionRecording, error)) *MockSessionRecordingsAPIListSessionRecordingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SessionRecording mocks base method.
+func (m *MockSessionRecordingsAPI) SessionRecording(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SessionRecording", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SessionRecording indicates an expected call of SessionRecording.
+func (mr *MockSessionRecordingsAPIMockRecorder) SessionRecording(arg0, arg1 any) *MockSessionRecordingsAPISessionRecordingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionRecording", reflect.TypeOf((*MockSessionRecordingsAPI)(nil).SessionRecording), arg0, arg1)
+	return &MockSessionRecordingsAPISessionRecordingCall{Call: call}
+}
+
+// MockSessionRecordingsAPISessionRecordingCall wrap *gomock.Call
+type MockSessionRecordingsAPISessionRecordingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSessionRecordingsAPISessionRecordingCall) Return(arg0 string, arg1 error) *MockSessionRecordingsAPISessionRecordingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSessionRecordingsAPISessionRecordingCall) Do(f func(context.Context, string) (string, error)) *MockSessionRecordingsAPISessionRecordingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSessionRecordingsAPISessionRecordingCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockSessionRecordingsAPISessionRecordingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,266 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/sshclient"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// SessionRecordingsAPI defines the APIs used to access the recordings of
+// interactive SSH sessions.
+type SessionRecordingsAPI interface {
+	ListSessionRecordings(ctx context.Context, user, target string) ([]sshclient.SessionRecording, error)
+	SessionRecording(ctx context.Context, path string) (string, error)
+	Close() error
+}
+
+// SessionRecordingInfo defines the serialization behaviour of the
+// information about a session recording.
+type SessionRecordingInfo struct {
+	Path    string `yaml:"path" json:"path"`
+	User    string `yaml:"user" json:"user"`
+	Target  string `yaml:"target" json:"target"`
+	Started string `yaml:"started" json:"started"`
+	Size    int64  `yaml:"size" json:"size"`
+}
+
+const (
+	listRecordingsDoc = `
+List the recordings of the interactive SSH sessions to the machines and
+units of the model, proxied through the controller.
+
+Sessions are only recorded when the "ssh-session-recording" controller
+config option is enabled. The recordings can be filtered by the user that
+started the session and by the target of the session.
+`
+	listRecordingsExamples = `
+List all the session recordings:
+
+    juju ssh-recordings
+
+List the recordings of the sessions started by bob to mysql/0:
+
+    juju ssh-recordings --user bob --target mysql/0
+`
+)
+
+// NewListSessionRecordingsCommand returns a command that lists the
+// recordings of interactive SSH sessions.
+func NewListSessionRecordingsCommand() cmd.Command {
+	c := &listSessionRecordingsCommand{}
+	c.newAPIFunc = func(ctx context.Context) (SessionRecordingsAPI, error) {
+		return newSessionRecordingsAPI(ctx, &c.ModelCommandBase)
+	}
+	return modelcmd.Wrap(c)
+}
+
+// listSessionRecordingsCommand lists the recordings of interactive SSH
+// sessions.
+type listSessionRecordingsCommand struct {
+	modelcmd.ModelCommandBase
+	newAPIFunc func(ctx context.Context) (SessionRecordingsAPI, error)
+
+	user   string
+	target string
+	out    cmd.Output
+}
+
+// Info implements Command.Info.
+func (c *listSessionRecordingsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "ssh-recordings",
+		Purpose:  "List the recordings of interactive SSH sessions.",
+		Doc:      listRecordingsDoc,
+		Aliases:  []string{"list-ssh-recordings"},
+		Examples: listRecordingsExamples,
+		SeeAlso: []string{
+			"show-ssh-recording",
+			"ssh",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listSessionRecordingsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.user, "user", "", "Only list the sessions started by this user")
+	f.StringVar(&c.target, "target", "", "Only list the sessions to this machine or unit")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSessionRecordingsTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *listSessionRecordingsCommand) Init(args []string) error {
+	if c.target != "" {
+		target, err := recordingTarget(c.target)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.target = target
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements Command.Run.
+func (c *listSessionRecordingsCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	recordings, err := api.ListSessionRecordings(ctx, c.user, c.target)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(recordings) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No SSH session recordings to display.")
+		return nil
+	}
+
+	infos := make([]SessionRecordingInfo, len(recordings))
+	for i, r := range recordings {
+		infos[i] = SessionRecordingInfo{
+			Path:    r.Path,
+			User:    r.User,
+			Target:  r.Target,
+			Started: common.FormatTime(&r.Started, true),
+			Size:    r.Size,
+		}
+	}
+	return c.out.Write(ctx, infos)
+}
+
+// formatSessionRecordingsTabular returns a tabular summary of session
+// recordings.
+func formatSessionRecordingsTabular(writer io.Writer, value interface{}) error {
+	recordings, ok := value.([]SessionRecordingInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", recordings, value)
+	}
+	tw := output.TabWriter(writer)
+	print := func(values ...string) {
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+
+	print("Started", "User", "Target", "Size", "Path")
+	for _, r := range recordings {
+		print(r.Started, r.User, r.Target, humanize.IBytes(uint64(r.Size)), r.Path)
+	}
+	return tw.Flush()
+}
+
+// recordingTarget returns the name of the target of a session as it's
+// recorded, from a machine ID or a unit name.
+func recordingTarget(target string) (string, error) {
+	switch {
+	case names.IsValidMachine(target):
+		return names.NewMachineTag(target).String(), nil
+	case names.IsValidUnit(target):
+		return names.NewUnitTag(target).String(), nil
+	default:
+		return "", errors.NotValidf("target %q", target)
+	}
+}
+
+const (
+	showRecordingDoc = `
+Show the recording of an interactive SSH session, in the asciicast v2
+format. The recording can be replayed with asciinema.
+
+The path of a recording is listed by the ssh-recordings command.
+`
+	showRecordingExamples = `
+Replay a session recording:
+
+    juju show-ssh-recording ssh-recordings/bob/unit-mysql-0/20250301T120000.000000000Z.cast > session.cast
+    asciinema play session.cast
+`
+)
+
+// NewShowSessionRecordingCommand returns a command that shows the recording
+// of an interactive SSH session.
+func NewShowSessionRecordingCommand() cmd.Command {
+	c := &showSessionRecordingCommand{}
+	c.newAPIFunc = func(ctx context.Context) (SessionRecordingsAPI, error) {
+		return newSessionRecordingsAPI(ctx, &c.ModelCommandBase)
+	}
+	return modelcmd.Wrap(c)
+}
+
+// showSessionRecordingCommand shows the recording of an interactive SSH
+// session.
+type showSessionRecordingCommand struct {
+	modelcmd.ModelCommandBase
+	newAPIFunc func(ctx context.Context) (SessionRecordingsAPI, error)
+
+	path string
+}
+
+// Info implements Command.Info.
+func (c *showSessionRecordingCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "show-ssh-recording",
+		Args:     "<path>",
+		Purpose:  "Show the recording of an interactive SSH session.",
+		Doc:      showRecordingDoc,
+		Examples: showRecordingExamples,
+		SeeAlso: []string{
+			"ssh-recordings",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *showSessionRecordingCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no recording path specified")
+	}
+	c.path = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *showSessionRecordingCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	content, err := api.SessionRecording(ctx, c.path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = io.WriteString(ctx.Stdout, content)
+	return errors.Trace(err)
+}
+
+// newSessionRecordingsAPI returns the ssh client API for the model of the
+// command.
+func newSessionRecordingsAPI(ctx context.Context, c *modelcmd.ModelCommandBase) (SessionRecordingsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sshclient.NewFacade(root), nil
+}
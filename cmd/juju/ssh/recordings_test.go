@@ -0,0 +1,123 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh_test
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/api/client/sshclient"
+	"github.com/juju/juju/cmd/juju/ssh"
+	"github.com/juju/juju/cmd/juju/ssh/mocks"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+)
+
+type sessionRecordingsSuite struct {
+	testing.BaseSuite
+
+	api *mocks.MockSessionRecordingsAPI
+}
+
+func TestSessionRecordingsSuite(t *stdtesting.T) {
+	tc.Run(t, &sessionRecordingsSuite{})
+}
+
+func (s *sessionRecordingsSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.api = mocks.NewMockSessionRecordingsAPI(ctrl)
+	s.api.EXPECT().Close().Return(nil).AnyTimes()
+	return ctrl
+}
+
+func (s *sessionRecordingsSuite) TestListRecordings(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().ListSessionRecordings(gomock.Any(), "bob", "unit-mysql-0").Return([]sshclient.SessionRecording{{
+		Path:    "ssh-recordings/bob/unit-mysql-0/20250301T120000.000000000Z.cast",
+		User:    "bob",
+		Target:  "unit-mysql-0",
+		Started: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		Size:    2048,
+	}}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewListSessionRecordingsCommandForTest(s.api),
+		"--user", "bob", "--target", "mysql/0", "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+- path: ssh-recordings/bob/unit-mysql-0/20250301T120000.000000000Z.cast
+  user: bob
+  target: unit-mysql-0
+  started: 2025-03-01 12:00:00Z
+  size: 2048
+`[1:])
+}
+
+func (s *sessionRecordingsSuite) TestListRecordingsTabular(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().ListSessionRecordings(gomock.Any(), "", "machine-0").Return([]sshclient.SessionRecording{{
+		Path:    "ssh-recordings/bob/machine-0/20250301T120000.000000000Z.cast",
+		User:    "bob",
+		Target:  "machine-0",
+		Started: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		Size:    2048,
+	}}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewListSessionRecordingsCommandForTest(s.api), "--target", "0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Started               User  Target     Size     Path
+2025-03-01 12:00:00Z  bob   machine-0  2.0 KiB  ssh-recordings/bob/machine-0/20250301T120000.000000000Z.cast
+`[1:])
+}
+
+func (s *sessionRecordingsSuite) TestListRecordingsNone(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().ListSessionRecordings(gomock.Any(), "", "").Return(nil, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewListSessionRecordingsCommandForTest(s.api))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No SSH session recordings to display.\n")
+}
+
+func (s *sessionRecordingsSuite) TestListRecordingsInvalidTarget(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, ssh.NewListSessionRecordingsCommandForTest(s.api), "--target", "not valid")
+	c.Assert(err, tc.ErrorMatches, `target "not valid" not valid`)
+}
+
+func (s *sessionRecordingsSuite) TestShowRecording(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := "ssh-recordings/bob/unit-mysql-0/20250301T120000.000000000Z.cast"
+	s.api.EXPECT().SessionRecording(gomock.Any(), path).Return("{\"version\":2}\n[0.1,\"o\",\"hi\"]\n", nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewShowSessionRecordingCommandForTest(s.api), path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "{\"version\":2}\n[0.1,\"o\",\"hi\"]\n")
+}
+
+func (s *sessionRecordingsSuite) TestShowRecordingError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().SessionRecording(gomock.Any(), "ssh-recordings/missing").Return("", errors.NotFoundf("session recording"))
+
+	_, err := cmdtesting.RunCommand(c, ssh.NewShowSessionRecordingCommandForTest(s.api), "ssh-recordings/missing")
+	c.Assert(err, tc.ErrorMatches, "session recording not found")
+}
+
+func (s *sessionRecordingsSuite) TestShowRecordingNoPath(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, ssh.NewShowSessionRecordingCommandForTest(s.api))
+	c.Assert(err, tc.ErrorMatches, "no recording path specified")
+}
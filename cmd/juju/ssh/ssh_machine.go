@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -45,6 +46,7 @@ type sshMachine struct {
 	args                   []string
 	apiAddr                *url.URL
 	knownHostsPath         string
+	certificateDir         string
 	retryStrategy          retry.CallArgs
 	publicKeyRetryStrategy retry.CallArgs
 
@@ -161,6 +163,10 @@ func (c *sshMachine) cleanupRun() {
 		_ = os.Remove(c.knownHostsPath)
 		c.knownHostsPath = ""
 	}
+	if c.certificateDir != "" {
+		_ = os.RemoveAll(c.certificateDir)
+		c.certificateDir = ""
+	}
 	if c.sshClient != nil {
 		_ = c.sshClient.Close()
 		c.sshClient = nil
@@ -206,6 +212,10 @@ func (c *sshMachine) getSSHOptions(ctx context.Context, enablePty bool, targets
 		options.EnablePTY()
 	}
 
+	if err := c.setUserCertificate(ctx, &options); err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	if c.proxy {
 		if err := c.setProxyCommand(&options, targets); err != nil {
 			return nil, err
@@ -215,6 +225,52 @@ func (c *sshMachine) getSSHOptions(ctx context.Context, enablePty bool, targets
 	return &options, nil
 }
 
+// setUserCertificate requests a short-lived user certificate for the juju
+// client key from the controller, and uses it as an identity. Machines that
+// trust the SSH certificate authority of the controller accept the
+// certificate, without the key being authorised on them. The key itself is
+// still offered if no certificate can be issued, but those machines don't
+// authorise the keys of the model's users so access is likely to be denied.
+func (c *sshMachine) setUserCertificate(ctx context.Context, options *ssh.Options) error {
+	privateKeys := ssh.PrivateKeyFiles()
+	if len(privateKeys) == 0 {
+		return nil
+	}
+	privateKey := privateKeys[0]
+	publicKey, err := os.ReadFile(privateKey + ".pub")
+	if err != nil {
+		logger.Debugf(ctx, "cannot read public key for ssh user certificate: %v", err)
+		return nil
+	}
+
+	cert, err := c.sshClient.UserCertificate(ctx, string(publicKey))
+	if errors.Is(err, errors.NotImplemented) {
+		return nil
+	} else if err != nil {
+		logger.Warningf(ctx, "cannot get ssh user certificate, access to the machine may be denied: %v", err)
+		return nil
+	}
+
+	// OpenSSH loads the certificate of an identity from the file next to it,
+	// so link the key into a directory of its own to keep the certificate
+	// private to this command.
+	dir, err := os.MkdirTemp("", "juju-ssh-certificate")
+	if err != nil {
+		return errors.Annotate(err, "creating ssh user certificate directory")
+	}
+	c.certificateDir = dir // Record for later deletion
+	identity := filepath.Join(dir, filepath.Base(privateKey))
+	if err := os.Symlink(privateKey, identity); err != nil {
+		return errors.Annotate(err, "linking ssh identity")
+	}
+	if err := os.WriteFile(identity+"-cert.pub", []byte(cert.Certificate+"\n"), 0600); err != nil {
+		return errors.Annotate(err, "writing ssh user certificate")
+	}
+	logger.Debugf(ctx, "using ssh user certificate for principals %v, valid until %v", cert.Principals, cert.ValidBefore)
+	options.SetIdentities(identity)
+	return nil
+}
+
 func (c *sshMachine) ssh(ctx Context, enablePty bool, target *resolvedTarget) error {
 	options, err := c.getSSHOptions(ctx, enablePty, target)
 	if err != nil {
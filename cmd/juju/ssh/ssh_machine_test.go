@@ -20,6 +20,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/api/client/sshclient"
 	"github.com/juju/juju/cmd/juju/ssh/mocks"
 	"github.com/juju/juju/core/network"
 	jujussh "github.com/juju/juju/internal/network/ssh"
@@ -259,6 +260,63 @@ func (s *SSHMachineSuite) TestMaybePopulateTargetViaFieldForContainerMachineTarg
 	c.Assert(target.via.host, tc.Equals, "10.0.0.1", tc.Commentf("expected target.via.host to be set to the container's host machine address"))
 }
 
+func (s *SSHMachineSuite) TestSetUserCertificate(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	keysDir := c.MkDir()
+	err := ssh.LoadClientKeys(keysDir)
+	c.Assert(err, tc.ErrorIsNil)
+	defer ssh.ClearClientKeys()
+	privateKey := ssh.PrivateKeyFiles()[0]
+	publicKey, err := os.ReadFile(privateKey + ".pub")
+	c.Assert(err, tc.ErrorIsNil)
+
+	sshClient := mocks.NewMockSSHClientAPI(ctrl)
+	sshClient.EXPECT().UserCertificate(gomock.Any(), string(publicKey)).Return(sshclient.UserCertificate{
+		Certificate: "ssh-ed25519-cert-v01@openssh.com AAAA",
+		Principals:  []string{"juju-admin@deadbeef"},
+	}, nil)
+	sshClient.EXPECT().Close().Return(nil)
+
+	m := &sshMachine{sshClient: sshClient}
+	err = m.setUserCertificate(c.Context(), &ssh.Options{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(m.certificateDir, tc.Not(tc.Equals), "")
+
+	// The certificate is next to a link to the key, where OpenSSH loads it
+	// from.
+	identity := filepath.Join(m.certificateDir, filepath.Base(privateKey))
+	target, err := os.Readlink(identity)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(target, tc.Equals, privateKey)
+	cert, err := os.ReadFile(identity + "-cert.pub")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(cert), tc.Equals, "ssh-ed25519-cert-v01@openssh.com AAAA\n")
+
+	dir := m.certificateDir
+	m.cleanupRun()
+	_, err = os.Stat(dir)
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+}
+
+func (s *SSHMachineSuite) TestSetUserCertificateNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	err := ssh.LoadClientKeys(c.MkDir())
+	c.Assert(err, tc.ErrorIsNil)
+	defer ssh.ClearClientKeys()
+
+	sshClient := mocks.NewMockSSHClientAPI(ctrl)
+	sshClient.EXPECT().UserCertificate(gomock.Any(), gomock.Any()).Return(sshclient.UserCertificate{}, errors.NotImplementedf("ssh user certificates"))
+
+	m := &sshMachine{sshClient: sshClient}
+	err = m.setUserCertificate(c.Context(), &ssh.Options{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(m.certificateDir, tc.Equals, "")
+}
+
 func (s *SSHMachineSuite) setHostChecker(hostChecker jujussh.ReachableChecker) {
 	s.hostChecker = hostChecker
 }
@@ -48,9 +48,11 @@ type statusHistoryCommand struct {
 	backlogSize     int
 	backlogSizeDays int
 	backlogDate     string
+	backlogToDate   string
 	isoTime         bool
 	entityName      string
 	date            time.Time
+	toDate          time.Time
 }
 
 var statusHistoryDoc = fmt.Sprintf(`
@@ -80,6 +82,10 @@ Show the status history for the specified unit with the logs for any date after
 
     juju show-status-log mysql/0 --from-date 2020-01-01
 
+Show the status history for the specified unit with the logs between 2020-01-01 and 2020-02-01:
+
+    juju show-status-log mysql/0 --from-date 2020-01-01 --to-date 2020-02-01
+
 Show the status history for the specified application:
 
     juju show-status-log -type application wordpress
@@ -133,6 +139,7 @@ func (c *statusHistoryCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.IntVar(&c.backlogSize, "n", 0, "Returns the last N logs (cannot be combined with --days or --date)")
 	f.IntVar(&c.backlogSizeDays, "days", 0, "Returns the logs for the past <days> days (cannot be combined with -n or --date)")
 	f.StringVar(&c.backlogDate, "from-date", "", "Returns logs for any date after the passed one, the expected date format is YYYY-MM-DD (cannot be combined with -n or --days)")
+	f.StringVar(&c.backlogToDate, "to-date", "", "Returns logs for any date up to and including the passed one, the expected date format is YYYY-MM-DD")
 	f.BoolVar(&c.isoTime, "utc", false, "Display time as UTC in RFC3339 format")
 
 	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
@@ -180,6 +187,19 @@ func (c *statusHistoryCommand) Init(args []string) error {
 			return errors.Annotate(err, "parsing backlog date")
 		}
 	}
+	if c.backlogToDate != "" {
+		var err error
+		c.toDate, err = time.Parse("2006-01-02", c.backlogToDate)
+		if err != nil {
+			return errors.Annotate(err, "parsing backlog to date")
+		}
+		// The date is parsed as the start of the day, but the logs for the
+		// whole of that day are expected.
+		c.toDate = c.toDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		if !c.date.IsZero() && c.toDate.Before(c.date) {
+			return errors.Errorf("backlog to date cannot be before backlog date")
+		}
+	}
 
 	kind := status.HistoryKind(c.outputContent)
 	if kind.Valid() {
@@ -228,6 +248,9 @@ func (c *statusHistoryCommand) Run(ctx *cmd.Context) error {
 	if !c.date.IsZero() {
 		filterArgs.FromDate = &c.date
 	}
+	if !c.toDate.IsZero() {
+		filterArgs.ToDate = &c.toDate
+	}
 	var tag names.Tag
 	switch kind {
 	case status.KindModel:
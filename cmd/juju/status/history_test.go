@@ -160,9 +160,37 @@ func (s *StatusHistorySuite) TestYaml(c *tc.C) {
 	c.Check(cmdtesting.Stdout(ctx), tc.Equals, expected)
 }
 
+func (s *StatusHistorySuite) TestDateRange(c *tc.C) {
+	api := s.api.(*fakeHistoryAPI)
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "missing/0", "--from-date", "2017-11-01", "--to-date", "2017-12-01")
+	c.Assert(err, tc.ErrorIsNil)
+
+	from := time.Date(2017, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2017, 12, 1, 23, 59, 59, 999999999, time.UTC)
+	c.Check(api.filter.FromDate, tc.DeepEquals, &from)
+	c.Check(api.filter.ToDate, tc.DeepEquals, &to)
+}
+
+func (s *StatusHistorySuite) TestDateRangeSameDay(c *tc.C) {
+	api := s.api.(*fakeHistoryAPI)
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "missing/0", "--from-date", "2017-11-01", "--to-date", "2017-11-01")
+	c.Assert(err, tc.ErrorIsNil)
+
+	from := time.Date(2017, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2017, 11, 1, 23, 59, 59, 999999999, time.UTC)
+	c.Check(api.filter.FromDate, tc.DeepEquals, &from)
+	c.Check(api.filter.ToDate, tc.DeepEquals, &to)
+}
+
+func (s *StatusHistorySuite) TestToDateBeforeFromDate(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "missing/0", "--from-date", "2017-12-01", "--to-date", "2017-11-01")
+	c.Assert(err, tc.ErrorMatches, "backlog to date cannot be before backlog date")
+}
+
 type fakeHistoryAPI struct {
 	err     error
 	history status.History
+	filter  status.StatusHistoryFilter
 }
 
 func (*fakeHistoryAPI) Close() error {
@@ -170,5 +198,6 @@ func (*fakeHistoryAPI) Close() error {
 }
 
 func (f *fakeHistoryAPI) StatusHistory(ctx context.Context, kind status.HistoryKind, tag names.Tag, filter status.StatusHistoryFilter) (status.History, error) {
+	f.filter = filter
 	return f.history, f.err
 }
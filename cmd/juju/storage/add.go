@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"github.com/juju/names/v6"
 
 	jujucmd "github.com/juju/juju/cmd"
@@ -69,6 +70,11 @@ by the charm, which can specify a maximum number of storage instances per unit.
 positive number, followed by a size suffix.  Valid suffixes include M, G, T,
 and P.  Defaults to "1024M", or the which can specify a minimum size required 
 by the charm.
+
+With --from-snapshot, the new storage instances are created from a snapshot
+taken with 'juju snapshot-storage', seeding them with the data of the
+snapshotted storage. The pool and size default to those of the snapshot;
+a larger size may be requested, but the pool must be the same.
 `
 
 	addCommandExamples = `
@@ -84,6 +90,10 @@ Add a storage instance from the (AWS-specific) ebs-ssd storage pool for "brick"
 
     juju add-storage gluster/0 brick=ebs-ssd
 
+Add a storage instance for "pgdata" storage to unit postgresql/1, seeded from a snapshot:
+
+    juju add-storage postgresql/1 pgdata --from-snapshot 1f5a0e4f-0d7e-4d4c-8e4b-1e2a9d6c3b7a
+
 
 Further reading:
 
@@ -102,9 +112,16 @@ type addCommand struct {
 	// storageDirectives is a map of storage directives, keyed on the storage name
 	// defined in charm storage metadata.
 	storageDirectives map[string]storage.Directive
+	fromSnapshot      string
 	newAPIFunc        func(ctx context.Context) (StorageAddAPI, error)
 }
 
+// SetFlags implements Command.SetFlags.
+func (c *addCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	f.StringVar(&c.fromSnapshot, "from-snapshot", "", "The UUID of a storage snapshot from which to create the storage")
+}
+
 // Init implements Command.Init.
 func (c *addCommand) Init(args []string) (err error) {
 	if len(args) < 2 {
@@ -118,7 +135,13 @@ func (c *addCommand) Init(args []string) (err error) {
 	c.unitTag = names.NewUnitTag(u)
 
 	c.storageDirectives, err = storage.ParseDirectivesMap(args[1:], false)
-	return
+	if err != nil {
+		return err
+	}
+	if c.fromSnapshot != "" && len(c.storageDirectives) != 1 {
+		return errors.New("--from-snapshot requires a single storage directive")
+	}
+	return nil
 }
 
 // Info implements Command.Info.
@@ -133,6 +156,7 @@ func (c *addCommand) Info() *cmd.Info {
 			"import-filesystem",
 			"storage",
 			"storage-pools",
+			"list-storage-snapshots",
 		},
 	})
 }
@@ -210,9 +234,10 @@ func (c *addCommand) createStorageAddParams() []params.StorageAddParams {
 			UnitTag:     c.unitTag.String(),
 			StorageName: one,
 			Directives: params.StorageDirectives{
-				Pool:  d.Pool,
-				Size:  &d.Size,
-				Count: &d.Count,
+				Pool:     d.Pool,
+				Size:     &d.Size,
+				Count:    &d.Count,
+				Snapshot: c.fromSnapshot,
 			},
 		})
 	}
@@ -120,6 +120,26 @@ added storage foo/1 to tst/123
 	}
 }
 
+func (s *addSuite) TestAddFromSnapshot(c *tc.C) {
+	var added []params.StorageAddParams
+	addToUnit := s.mockAPI.addToUnitFunc
+	s.mockAPI.addToUnitFunc = func(storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
+		added = storages
+		return addToUnit(storages)
+	}
+
+	_, err := s.runAdd(c, "tst/123", "data", "--from-snapshot", "deadbeef")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(added, tc.HasLen, 1)
+	c.Check(added[0].StorageName, tc.Equals, "data")
+	c.Check(added[0].Directives.Snapshot, tc.Equals, "deadbeef")
+}
+
+func (s *addSuite) TestAddFromSnapshotMultipleDirectives(c *tc.C) {
+	_, err := s.runAdd(c, "tst/123", "data", "logs", "--from-snapshot", "deadbeef")
+	c.Assert(err, tc.ErrorMatches, "--from-snapshot requires a single storage directive")
+}
+
 func (s *addSuite) TestAddOperationAborted(c *tc.C) {
 	s.args = []string{"tst/123", "data=676"}
 	s.mockAPI.addToUnitFunc = func(storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
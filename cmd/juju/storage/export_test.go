@@ -86,9 +86,40 @@ func NewAttachStorageCommandForTest(new NewEntityAttacherCloserFunc, store jujuc
 	return modelcmd.Wrap(cmd)
 }
 
+func NewResizeStorageCommandForTest(new NewStorageResizerCloserFunc, store jujuclient.ClientStore) cmd.Command {
+	cmd := &resizeStorageCommand{}
+	cmd.SetClientStore(store)
+	cmd.newStorageResizerCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
 func NewDetachStorageCommandForTest(new NewEntityDetacherCloserFunc, store jujuclient.ClientStore) cmd.Command {
 	cmd := &detachStorageCommand{}
 	cmd.SetClientStore(store)
 	cmd.newEntityDetacherCloser = new
 	return modelcmd.Wrap(cmd)
 }
+
+func NewSnapshotCommandForTest(api SnapshotAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &snapshotCommand{newAPIFunc: func(ctx context.Context) (SnapshotAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewSnapshotListCommandForTest(api SnapshotListAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &snapshotListCommand{newAPIFunc: func(ctx context.Context) (SnapshotListAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewSnapshotRemoveCommandForTest(api SnapshotRemoveAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &snapshotRemoveCommand{newAPIFunc: func(ctx context.Context) (SnapshotRemoveAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
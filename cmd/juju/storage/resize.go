@@ -0,0 +1,148 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/utils/v4"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewResizeStorageCommandWithAPI returns a command
+// used to grow existing storage.
+func NewResizeStorageCommandWithAPI() cmd.Command {
+	cmd := &resizeStorageCommand{}
+	cmd.newStorageResizerCloser = func(ctx context.Context) (StorageResizerCloser, error) {
+		return cmd.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// NewResizeStorageCommand returns a command used to
+// grow existing storage.
+func NewResizeStorageCommand(new NewStorageResizerCloserFunc) cmd.Command {
+	cmd := &resizeStorageCommand{}
+	cmd.newStorageResizerCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	resizeStorageCommandDoc = `
+Grow the volume backing existing storage to a new size.
+
+The new size must be larger than the current size; storage cannot
+be shrunk. Sizes are in MiB unless a suffix (M, G, T, P, E) is given.
+
+Once the volume has been resized, the "storage-resized" hook is run
+on the unit the storage is attached to, so that the charm can grow
+the filesystem on the volume.
+
+Storage provisioned by the model's cloud is resized immediately.
+Machine-scoped storage, such as loop devices, is resized by the
+machine agent, and the command returns before the volume has grown.
+`
+	resizeStorageCommandExamples = `
+    juju resize-storage pgdata/0 --size 200G
+
+`
+	resizeStorageCommandArgs = `<storage> --size <size>`
+)
+
+// resizeStorageCommand grows existing storage instances.
+type resizeStorageCommand struct {
+	StorageCommandBase
+	modelcmd.IAASOnlyCommand
+	newStorageResizerCloser NewStorageResizerCloserFunc
+	storageId               string
+	sizeArg                 string
+	size                    uint64
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *resizeStorageCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	f.StringVar(&c.sizeArg, "size", "", "The new size of the storage")
+}
+
+// Init implements Command.Init.
+func (c *resizeStorageCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.New("resize-storage requires a storage ID")
+	case 1:
+		c.storageId = args[0]
+	default:
+		return errors.New("resize-storage takes a single storage ID")
+	}
+	if c.sizeArg == "" {
+		return errors.New("--size must be specified")
+	}
+	size, err := utils.ParseSize(c.sizeArg)
+	if err != nil {
+		return errors.Annotate(err, "cannot parse --size")
+	}
+	if size == 0 {
+		return errors.New("--size must be greater than zero")
+	}
+	c.size = size
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *resizeStorageCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "resize-storage",
+		Purpose:  "Grows existing storage.",
+		Doc:      resizeStorageCommandDoc,
+		Args:     resizeStorageCommandArgs,
+		Examples: resizeStorageCommandExamples,
+		SeeAlso: []string{
+			"storage",
+			"show-storage",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *resizeStorageCommand) Run(ctx *cmd.Context) error {
+	resizer, err := c.newStorageResizerCloser(ctx)
+	if err != nil {
+		return err
+	}
+	defer resizer.Close()
+
+	if err := resizer.Resize(ctx, c.storageId, c.size); err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "resize storage")
+		}
+		return block.ProcessBlockedError(errors.Annotatef(err, "could not resize storage %s", c.storageId), block.BlockChange)
+	}
+	ctx.Infof("resized %s to %dMiB", c.storageId, c.size)
+	return nil
+}
+
+// NewStorageResizerCloserFunc is the type of a function that returns a
+// StorageResizerCloser.
+type NewStorageResizerCloserFunc func(ctx context.Context) (StorageResizerCloser, error)
+
+// StorageResizerCloser extends StorageResizer with a Closer method.
+type StorageResizerCloser interface {
+	StorageResizer
+	Close() error
+}
+
+// StorageResizer defines an interface for growing the storage
+// with the specified ID to a new size in MiB.
+type StorageResizer interface {
+	Resize(ctx context.Context, storageId string, size uint64) error
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type ResizeStorageSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestResizeStorageSuite(t *testing.T) {
+	tc.Run(t, &ResizeStorageSuite{})
+}
+
+func (s *ResizeStorageSuite) TestResize(c *tc.C) {
+	var fake fakeStorageResizer
+	cmd := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "pgdata/0", "--size", "2G")
+	c.Assert(err, tc.ErrorIsNil)
+	fake.CheckCallNames(c, "NewStorageResizerCloser", "Resize", "Close")
+	fake.CheckCall(c, 1, "Resize", "pgdata/0", uint64(2048))
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, "resized pgdata/0 to 2048MiB\n")
+}
+
+func (s *ResizeStorageSuite) TestResizeError(c *tc.C) {
+	var fake fakeStorageResizer
+	fake.SetErrors(nil, &params.Error{Code: params.CodeNotSupported, Message: "nope"})
+	cmd := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "pgdata/0", "--size", "2048")
+	c.Assert(err, tc.ErrorMatches, "could not resize storage pgdata/0: nope")
+}
+
+func (s *ResizeStorageSuite) TestResizeBlocked(c *tc.C) {
+	var fake fakeStorageResizer
+	fake.SetErrors(nil, &params.Error{Code: params.CodeOperationBlocked, Message: "nope"})
+	cmd := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "pgdata/0", "--size", "2048")
+	c.Assert(err.Error(), tc.Contains, `could not resize storage pgdata/0: nope`)
+	c.Assert(err.Error(), tc.Contains, `All operations that change model have been disabled for the current model.`)
+}
+
+func (s *ResizeStorageSuite) TestInitErrors(c *tc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: nil,
+		err:  "resize-storage requires a storage ID",
+	}, {
+		args: []string{"pgdata/0", "pgdata/1", "--size", "1G"},
+		err:  "resize-storage takes a single storage ID",
+	}, {
+		args: []string{"pgdata/0"},
+		err:  "--size must be specified",
+	}, {
+		args: []string{"pgdata/0", "--size", "big"},
+		err:  `cannot parse --size: .*`,
+	}, {
+		args: []string{"pgdata/0", "--size", "0"},
+		err:  "--size must be greater than zero",
+	}} {
+		var fake fakeStorageResizer
+		cmd := storage.NewResizeStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+		_, err := cmdtesting.RunCommand(c, cmd, test.args...)
+		c.Check(err, tc.ErrorMatches, test.err)
+		fake.CheckNoCalls(c)
+	}
+}
+
+type fakeStorageResizer struct {
+	testhelpers.Stub
+}
+
+func (f *fakeStorageResizer) new(ctx context.Context) (storage.StorageResizerCloser, error) {
+	f.MethodCall(f, "NewStorageResizerCloser")
+	return f, f.NextErr()
+}
+
+func (f *fakeStorageResizer) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeStorageResizer) Resize(ctx context.Context, storageId string, size uint64) error {
+	f.MethodCall(f, "Resize", storageId, size)
+	return f.NextErr()
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewSnapshotCommand returns a command used to
+// snapshot existing storage.
+func NewSnapshotCommand() cmd.Command {
+	cmd := &snapshotCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (SnapshotAPI, error) {
+		return cmd.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	snapshotCommandDoc = `
+Take a snapshot of the volume backing existing storage.
+
+The snapshot is recorded in the model, and can be used to seed new
+storage with the snapshotted data using 'juju add-storage --from-snapshot'.
+Snapshots are kept until removed with 'juju remove-storage-snapshot'.
+
+Only storage whose storage provider supports snapshots can be
+snapshotted. Snapshots of machine-scoped storage, such as loop devices,
+are taken asynchronously by the machine's storage provisioner; they are
+listed as pending until the snapshot has been taken.
+`
+	snapshotCommandExamples = `
+    juju snapshot-storage pgdata/0
+
+`
+)
+
+// snapshotCommand takes snapshots of storage instances.
+type snapshotCommand struct {
+	StorageCommandBase
+	modelcmd.IAASOnlyCommand
+	newAPIFunc func(ctx context.Context) (SnapshotAPI, error)
+	storageId  string
+}
+
+// Init implements Command.Init.
+func (c *snapshotCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.New("snapshot-storage requires a storage ID")
+	case 1:
+		c.storageId = args[0]
+	default:
+		return errors.New("snapshot-storage takes a single storage ID")
+	}
+	if !names.IsValidStorage(c.storageId) {
+		return errors.NotValidf("storage ID %q", c.storageId)
+	}
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *snapshotCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "snapshot-storage",
+		Purpose:  "Takes a snapshot of existing storage.",
+		Doc:      snapshotCommandDoc,
+		Args:     "<storage>",
+		Examples: snapshotCommandExamples,
+		SeeAlso: []string{
+			"storage-snapshots",
+			"remove-storage-snapshot",
+			"add-storage",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *snapshotCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	snapshot, err := api.Snapshot(ctx, c.storageId)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "snapshot storage")
+		}
+		return block.ProcessBlockedError(errors.Annotatef(err, "could not snapshot storage %s", c.storageId), block.BlockChange)
+	}
+	if snapshot.Status == "pending" {
+		ctx.Infof("snapshot %s of %s requested", snapshot.UUID, c.storageId)
+		return nil
+	}
+	ctx.Infof("snapshot %s of %s taken", snapshot.UUID, c.storageId)
+	return nil
+}
+
+// SnapshotAPI defines the API methods that the snapshot-storage
+// command uses.
+type SnapshotAPI interface {
+	Close() error
+	Snapshot(ctx context.Context, storageId string) (params.StorageSnapshotDetails, error)
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type SnapshotSuite struct {
+	SubStorageSuite
+	mockAPI *mockSnapshotAPI
+}
+
+func TestSnapshotSuite(t *testing.T) {
+	tc.Run(t, &SnapshotSuite{})
+}
+
+func (s *SnapshotSuite) SetUpTest(c *tc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+
+	s.mockAPI = &mockSnapshotAPI{}
+}
+
+func (s *SnapshotSuite) runSnapshot(c *tc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, storage.NewSnapshotCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *SnapshotSuite) TestSnapshot(c *tc.C) {
+	ctx, err := s.runSnapshot(c, "pgdata/0")
+	c.Assert(err, tc.ErrorIsNil)
+	s.mockAPI.CheckCallNames(c, "Snapshot", "Close")
+	s.mockAPI.CheckCall(c, 0, "Snapshot", "pgdata/0")
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, "snapshot deadbeef of pgdata/0 taken\n")
+}
+
+func (s *SnapshotSuite) TestSnapshotPending(c *tc.C) {
+	s.mockAPI.status = "pending"
+	ctx, err := s.runSnapshot(c, "pgdata/0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, "snapshot deadbeef of pgdata/0 requested\n")
+}
+
+func (s *SnapshotSuite) TestSnapshotError(c *tc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeNotSupported, Message: "nope"})
+	_, err := s.runSnapshot(c, "pgdata/0")
+	c.Assert(err, tc.ErrorMatches, "could not snapshot storage pgdata/0: nope")
+}
+
+func (s *SnapshotSuite) TestSnapshotBlocked(c *tc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeOperationBlocked, Message: "nope"})
+	_, err := s.runSnapshot(c, "pgdata/0")
+	c.Assert(err.Error(), tc.Contains, `could not snapshot storage pgdata/0: nope`)
+	c.Assert(err.Error(), tc.Contains, `All operations that change model have been disabled for the current model.`)
+}
+
+func (s *SnapshotSuite) TestInitErrors(c *tc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: nil,
+		err:  "snapshot-storage requires a storage ID",
+	}, {
+		args: []string{"pgdata/0", "pgdata/1"},
+		err:  "snapshot-storage takes a single storage ID",
+	}, {
+		args: []string{"pgdata"},
+		err:  `storage ID "pgdata" not valid`,
+	}} {
+		_, err := s.runSnapshot(c, test.args...)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+	s.mockAPI.CheckNoCalls(c)
+}
+
+type mockSnapshotAPI struct {
+	testhelpers.Stub
+	status string
+}
+
+func (m *mockSnapshotAPI) Snapshot(ctx context.Context, storageId string) (params.StorageSnapshotDetails, error) {
+	m.MethodCall(m, "Snapshot", storageId)
+	return params.StorageSnapshotDetails{
+		UUID:       "deadbeef",
+		StorageTag: "storage-" + storageId,
+		Status:     m.status,
+	}, m.NextErr()
+}
+
+func (m *mockSnapshotAPI) Close() error {
+	m.MethodCall(m, "Close")
+	return nil
+}
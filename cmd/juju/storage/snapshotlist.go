@@ -0,0 +1,159 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// SnapshotInfo defines the serialization behaviour of the storage
+// snapshot information.
+type SnapshotInfo struct {
+	Storage    string `yaml:"storage" json:"storage"`
+	Pool       string `yaml:"pool" json:"pool"`
+	ProviderId string `yaml:"provider-id,omitempty" json:"provider-id,omitempty"`
+	Size       uint64 `yaml:"size" json:"size"`
+	Life       string `yaml:"life,omitempty" json:"life,omitempty"`
+	Status     string `yaml:"status" json:"status"`
+	Message    string `yaml:"message,omitempty" json:"message,omitempty"`
+	Created    string `yaml:"created" json:"created"`
+}
+
+func formatSnapshotInfo(all []params.StorageSnapshotDetails) (map[string]SnapshotInfo, error) {
+	output := make(map[string]SnapshotInfo)
+	for _, one := range all {
+		storageTag, err := names.ParseStorageTag(one.StorageTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		output[one.UUID] = SnapshotInfo{
+			Storage:    storageTag.Id(),
+			Pool:       one.Pool,
+			ProviderId: one.ProviderId,
+			Size:       one.Size,
+			Life:       string(one.Life),
+			Status:     one.Status,
+			Message:    one.Message,
+			Created:    common.FormatTime(&one.Created, true),
+		}
+	}
+	return output, nil
+}
+
+const (
+	snapshotListCommandDoc = `
+List the snapshots taken of storage in the model.
+
+If a storage ID is specified, only the snapshots of that storage
+are listed.
+`
+	snapshotListCommandExamples = `
+List all storage snapshots:
+
+    juju storage-snapshots
+
+List the snapshots of pgdata/0:
+
+    juju storage-snapshots pgdata/0
+`
+)
+
+// NewSnapshotListCommand returns a command that lists storage snapshots.
+func NewSnapshotListCommand() cmd.Command {
+	cmd := &snapshotListCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (SnapshotListAPI, error) {
+		return cmd.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// snapshotListCommand lists storage snapshots.
+type snapshotListCommand struct {
+	StorageCommandBase
+	modelcmd.IAASOnlyCommand
+	newAPIFunc func(ctx context.Context) (SnapshotListAPI, error)
+	storageId  string
+	out        cmd.Output
+}
+
+// Init implements Command.Init.
+func (c *snapshotListCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+	case 1:
+		c.storageId = args[0]
+		if !names.IsValidStorage(c.storageId) {
+			return errors.NotValidf("storage ID %q", c.storageId)
+		}
+	default:
+		return errors.New("storage-snapshots takes at most one storage ID")
+	}
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *snapshotListCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "storage-snapshots",
+		Purpose:  "List storage snapshots.",
+		Doc:      snapshotListCommandDoc,
+		Args:     "[<storage>]",
+		Aliases:  []string{"list-storage-snapshots"},
+		Examples: snapshotListCommandExamples,
+		SeeAlso: []string{
+			"snapshot-storage",
+			"remove-storage-snapshot",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *snapshotListCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSnapshotListTabular,
+	})
+}
+
+// Run implements Command.Run.
+func (c *snapshotListCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	result, err := api.ListSnapshots(ctx, c.storageId)
+	if err != nil {
+		return err
+	}
+	if len(result) == 0 {
+		ctx.Infof("No storage snapshots to display.")
+		return nil
+	}
+	output, err := formatSnapshotInfo(result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return c.out.Write(ctx, output)
+}
+
+// SnapshotListAPI defines the API methods that the storage-snapshots
+// command uses.
+type SnapshotListAPI interface {
+	Close() error
+	ListSnapshots(ctx context.Context, storageId string) ([]params.StorageSnapshotDetails, error)
+}
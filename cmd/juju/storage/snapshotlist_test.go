@@ -0,0 +1,123 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type SnapshotListSuite struct {
+	SubStorageSuite
+	mockAPI *mockSnapshotListAPI
+}
+
+func TestSnapshotListSuite(t *testing.T) {
+	tc.Run(t, &SnapshotListSuite{})
+}
+
+func (s *SnapshotListSuite) SetUpTest(c *tc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+
+	created := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	s.mockAPI = &mockSnapshotListAPI{
+		snapshots: []params.StorageSnapshotDetails{{
+			UUID:       "deadbeef",
+			StorageTag: "storage-pgdata-0",
+			Pool:       "ebs",
+			ProviderId: "snap-1",
+			Size:       2048,
+			Life:       life.Alive,
+			Status:     "available",
+			Created:    created.Add(time.Hour),
+		}, {
+			UUID:       "cafebabe",
+			StorageTag: "storage-pgdata-0",
+			Pool:       "ebs",
+			ProviderId: "snap-0",
+			Size:       1024,
+			Life:       life.Alive,
+			Status:     "available",
+			Created:    created,
+		}, {
+			UUID:       "f00dface",
+			StorageTag: "storage-logs-0",
+			Pool:       "ebs",
+			Size:       1024,
+			Life:       life.Alive,
+			Status:     "error",
+			Message:    "quota exceeded",
+			Created:    created,
+		}},
+	}
+}
+
+func (s *SnapshotListSuite) runSnapshotList(c *tc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, storage.NewSnapshotListCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *SnapshotListSuite) TestListTabular(c *tc.C) {
+	ctx, err := s.runSnapshotList(c)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.mockAPI.storageId, tc.Equals, "")
+	c.Assert(cmdtesting.Stdout(ctx), tc.Equals, `
+Snapshot  Storage   Pool  Size     Status     Created               Message
+f00dface  logs/0    ebs   1.0 GiB  error      2025-06-01 12:00:00Z  quota exceeded
+cafebabe  pgdata/0  ebs   1.0 GiB  available  2025-06-01 12:00:00Z  
+deadbeef  pgdata/0  ebs   2.0 GiB  available  2025-06-01 13:00:00Z  
+`[1:])
+}
+
+func (s *SnapshotListSuite) TestListYAML(c *tc.C) {
+	s.mockAPI.snapshots = s.mockAPI.snapshots[1:2]
+	ctx, err := s.runSnapshotList(c, "pgdata/0", "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.mockAPI.storageId, tc.Equals, "pgdata/0")
+	c.Assert(cmdtesting.Stdout(ctx), tc.Equals, `
+cafebabe:
+  storage: pgdata/0
+  pool: ebs
+  provider-id: snap-0
+  size: 1024
+  life: alive
+  status: available
+  created: 2025-06-01 12:00:00Z
+`[1:])
+}
+
+func (s *SnapshotListSuite) TestListEmpty(c *tc.C) {
+	s.mockAPI.snapshots = nil
+	ctx, err := s.runSnapshotList(c)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, "No storage snapshots to display.\n")
+}
+
+func (s *SnapshotListSuite) TestListInvalidStorageId(c *tc.C) {
+	_, err := s.runSnapshotList(c, "pgdata")
+	c.Assert(err, tc.ErrorMatches, `storage ID "pgdata" not valid`)
+}
+
+type mockSnapshotListAPI struct {
+	snapshots []params.StorageSnapshotDetails
+	storageId string
+}
+
+func (m *mockSnapshotListAPI) ListSnapshots(ctx context.Context, storageId string) ([]params.StorageSnapshotDetails, error) {
+	m.storageId = storageId
+	return m.snapshots, nil
+}
+
+func (m *mockSnapshotListAPI) Close() error {
+	return nil
+}
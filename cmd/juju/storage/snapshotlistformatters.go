@@ -0,0 +1,56 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/output"
+)
+
+// formatSnapshotListTabular returns a tabular summary of storage snapshots
+// or errors out if parameter is not a map of SnapshotInfo.
+func formatSnapshotListTabular(writer io.Writer, value interface{}) error {
+	snapshots, ok := value.(map[string]SnapshotInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", snapshots, value)
+	}
+	tw := output.TabWriter(writer)
+	print := func(values ...string) {
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+
+	print("Snapshot", "Storage", "Pool", "Size", "Status", "Created", "Message")
+
+	// Order by storage, then by creation time.
+	uuids := make([]string, 0, len(snapshots))
+	for uuid := range snapshots {
+		uuids = append(uuids, uuid)
+	}
+	sort.Slice(uuids, func(i, j int) bool {
+		a, b := snapshots[uuids[i]], snapshots[uuids[j]]
+		if a.Storage != b.Storage {
+			return a.Storage < b.Storage
+		}
+		if a.Created != b.Created {
+			return a.Created < b.Created
+		}
+		return uuids[i] < uuids[j]
+	})
+	for _, uuid := range uuids {
+		snapshot := snapshots[uuid]
+		var size string
+		if snapshot.Size > 0 {
+			size = humanize.IBytes(snapshot.Size * humanize.MiByte)
+		}
+		print(uuid, snapshot.Storage, snapshot.Pool, size, snapshot.Status, snapshot.Created, snapshot.Message)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewSnapshotRemoveCommand returns a command used to
+// remove storage snapshots.
+func NewSnapshotRemoveCommand() cmd.Command {
+	cmd := &snapshotRemoveCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (SnapshotRemoveAPI, error) {
+		return cmd.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	snapshotRemoveCommandDoc = `
+Remove storage snapshots from the model's cloud and from the model.
+
+Storage previously created from a snapshot is not affected by the
+snapshot's removal.
+`
+	snapshotRemoveCommandExamples = `
+    juju remove-storage-snapshot 1f5a0e4f-0d7e-4d4c-8e4b-1e2a9d6c3b7a
+
+`
+)
+
+// snapshotRemoveCommand removes storage snapshots.
+type snapshotRemoveCommand struct {
+	StorageCommandBase
+	modelcmd.IAASOnlyCommand
+	newAPIFunc func(ctx context.Context) (SnapshotRemoveAPI, error)
+	uuids      []string
+}
+
+// Init implements Command.Init.
+func (c *snapshotRemoveCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("remove-storage-snapshot requires at least one snapshot UUID")
+	}
+	c.uuids = args
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *snapshotRemoveCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-storage-snapshot",
+		Purpose:  "Removes storage snapshots.",
+		Doc:      snapshotRemoveCommandDoc,
+		Args:     "<snapshot> [<snapshot> ...]",
+		Examples: snapshotRemoveCommandExamples,
+		SeeAlso: []string{
+			"snapshot-storage",
+			"storage-snapshots",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *snapshotRemoveCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	var anyFailed bool
+	for _, uuid := range c.uuids {
+		if err := api.RemoveSnapshot(ctx, uuid); err != nil {
+			if params.IsCodeUnauthorized(err) {
+				common.PermissionsMessage(ctx.Stderr, "remove storage snapshots")
+				return err
+			}
+			if params.IsCodeOperationBlocked(err) {
+				return block.ProcessBlockedError(err, block.BlockRemove)
+			}
+			ctx.Infof("failed to remove snapshot %s: %s", uuid, err)
+			anyFailed = true
+			continue
+		}
+		ctx.Infof("removed snapshot %s", uuid)
+	}
+	if anyFailed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// SnapshotRemoveAPI defines the API methods that the
+// remove-storage-snapshot command uses.
+type SnapshotRemoveAPI interface {
+	Close() error
+	RemoveSnapshot(ctx context.Context, uuid string) error
+}
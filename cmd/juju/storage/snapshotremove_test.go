@@ -0,0 +1,88 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type SnapshotRemoveSuite struct {
+	SubStorageSuite
+	mockAPI *mockSnapshotRemoveAPI
+}
+
+func TestSnapshotRemoveSuite(t *testing.T) {
+	tc.Run(t, &SnapshotRemoveSuite{})
+}
+
+func (s *SnapshotRemoveSuite) SetUpTest(c *tc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+
+	s.mockAPI = &mockSnapshotRemoveAPI{}
+}
+
+func (s *SnapshotRemoveSuite) runSnapshotRemove(c *tc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, storage.NewSnapshotRemoveCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *SnapshotRemoveSuite) TestRemove(c *tc.C) {
+	ctx, err := s.runSnapshotRemove(c, "deadbeef", "cafebabe")
+	c.Assert(err, tc.ErrorIsNil)
+	s.mockAPI.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "RemoveSnapshot", Args: []interface{}{"deadbeef"}},
+		{FuncName: "RemoveSnapshot", Args: []interface{}{"cafebabe"}},
+		{FuncName: "Close"},
+	})
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, `
+removed snapshot deadbeef
+removed snapshot cafebabe
+`[1:])
+}
+
+func (s *SnapshotRemoveSuite) TestRemoveNoArgs(c *tc.C) {
+	_, err := s.runSnapshotRemove(c)
+	c.Assert(err, tc.ErrorMatches, "remove-storage-snapshot requires at least one snapshot UUID")
+	s.mockAPI.CheckNoCalls(c)
+}
+
+func (s *SnapshotRemoveSuite) TestRemoveError(c *tc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeNotFound, Message: "storage snapshot not found"})
+	ctx, err := s.runSnapshotRemove(c, "deadbeef", "cafebabe")
+	c.Assert(errors.Cause(err), tc.Equals, cmd.ErrSilent)
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, `
+failed to remove snapshot deadbeef: storage snapshot not found
+removed snapshot cafebabe
+`[1:])
+}
+
+func (s *SnapshotRemoveSuite) TestRemoveBlocked(c *tc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeOperationBlocked, Message: "nope"})
+	_, err := s.runSnapshotRemove(c, "deadbeef")
+	c.Assert(err.Error(), tc.Contains, "nope")
+	c.Assert(err.Error(), tc.Contains, "All operations that remove")
+}
+
+type mockSnapshotRemoveAPI struct {
+	testhelpers.Stub
+}
+
+func (m *mockSnapshotRemoveAPI) RemoveSnapshot(ctx context.Context, uuid string) error {
+	m.MethodCall(m, "RemoveSnapshot", uuid)
+	return m.NextErr()
+}
+
+func (m *mockSnapshotRemoveAPI) Close() error {
+	m.MethodCall(m, "Close")
+	return nil
+}
@@ -52,6 +52,7 @@ var (
 		"undertaker",
 		"unit-assigner", // tertiary dependency: will be inactive because migration workers will be inactive
 		"user-secrets-drain-worker",
+		"user-secrets-rotate",
 	}
 	aliveModelWorkers = []string{
 		"application-scaler",
@@ -72,6 +73,7 @@ var (
 		"storage-provisioner",
 		"unit-assigner",
 		"user-secrets-drain-worker",
+		"user-secrets-rotate",
 	}
 	migratingModelWorkers = []string{
 		"provider-tracker",
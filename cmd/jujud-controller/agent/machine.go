@@ -802,20 +802,23 @@ func (a *MachineAgent) startModelWorkers(cfg modelworkermanager.NewModelConfig)
 	}
 
 	manifoldsCfg := model.ManifoldsConfig{
-		Agent:                       modelAgent,
-		AgentConfigChanged:          a.configChangedVal,
-		Authority:                   cfg.Authority,
-		Clock:                       clock.WallClock,
-		LoggingContext:              cfg.LoggerContext,
-		RunFlagDuration:             time.Minute,
-		CharmRevisionUpdateInterval: 24 * time.Hour,
-		NewEnvironFunc:              newEnvirons,
-		NewContainerBrokerFunc:      newCAASBroker,
-		NewMigrationMaster:          migrationmaster.NewWorker,
-		DomainServices:              cfg.DomainServices,
-		ProviderServicesGetter:      cfg.ProviderServicesGetter,
-		LeaseManager:                cfg.LeaseManager,
-		HTTPClientGetter:            cfg.HTTPClientGetter,
+		Agent:                         modelAgent,
+		AgentConfigChanged:            a.configChangedVal,
+		Authority:                     cfg.Authority,
+		Clock:                         clock.WallClock,
+		LoggingContext:                cfg.LoggerContext,
+		LogForwarderSetter:            cfg.LogForwarderSetter,
+		RunFlagDuration:               time.Minute,
+		CharmRevisionUpdateInterval:   24 * time.Hour,
+		StatusHistoryPrunerInterval:   5 * time.Minute,
+		SecretAccessLogPrunerInterval: time.Hour,
+		NewEnvironFunc:                newEnvirons,
+		NewContainerBrokerFunc:        newCAASBroker,
+		NewMigrationMaster:            migrationmaster.NewWorker,
+		DomainServices:                cfg.DomainServices,
+		ProviderServicesGetter:        cfg.ProviderServicesGetter,
+		LeaseManager:                  cfg.LeaseManager,
+		HTTPClientGetter:              cfg.HTTPClientGetter,
 	}
 	if wrench.IsActive("charmrevision", "shortinterval") {
 		interval := 10 * time.Second
@@ -745,7 +745,9 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 		// The ssh server worker runs on the controller machine.
 		sshServerName: ifController(sshserver.Manifold(sshserver.ManifoldConfig{
 			DomainServicesName:         domainServicesName,
+			ObjectStoreName:            objectStoreFacadeName,
 			Logger:                     internallogger.GetLogger("juju.worker.sshserver"),
+			Clock:                      config.Clock,
 			NewServerWrapperWorker:     sshserver.NewServerWrapperWorker,
 			NewServerWorker:            sshserver.NewServerWorker,
 			GetControllerConfigService: sshserver.GetControllerConfigService,
@@ -778,6 +780,8 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			GetControllerConfigService: objectstore.GetControllerConfigService,
 			GetMetadataService:         objectstore.GetMetadataService,
 			IsBootstrapController:      internalbootstrap.IsBootstrapController,
+			PrometheusRegisterer:       config.PrometheusRegisterer,
+			NewMetricsCollector:        objectstore.NewMetricsCollector,
 		})),
 
 		// The objectstore facade is a thin wrapper around the objectstore
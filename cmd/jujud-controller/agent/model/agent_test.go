@@ -80,6 +80,10 @@ func (mock *mockConfig) Controller() names.ControllerTag {
 	return names.NewControllerTag("mock-controller-uuid")
 }
 
+func (mock *mockConfig) DataDir() string {
+	return "/var/lib/juju"
+}
+
 func (mock *mockConfig) APIInfo() (*api.Info, bool) {
 	return &api.Info{
 		Addrs:    []string{"here", "there"},
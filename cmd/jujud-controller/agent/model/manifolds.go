@@ -5,6 +5,7 @@ package model
 
 import (
 	"context"
+	"path/filepath"
 	"time"
 
 	"github.com/juju/clock"
@@ -22,6 +23,7 @@ import (
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/environs"
+	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/internal/pki"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/worker/agent"
@@ -39,6 +41,7 @@ import (
 	"github.com/juju/juju/internal/worker/firewaller"
 	"github.com/juju/juju/internal/worker/fortress"
 	"github.com/juju/juju/internal/worker/instancepoller"
+	"github.com/juju/juju/internal/worker/logforwarder"
 	"github.com/juju/juju/internal/worker/logger"
 	"github.com/juju/juju/internal/worker/machineundertaker"
 	"github.com/juju/juju/internal/worker/migrationflag"
@@ -46,6 +49,7 @@ import (
 	"github.com/juju/juju/internal/worker/modellife"
 	"github.com/juju/juju/internal/worker/modelworkermanager"
 	"github.com/juju/juju/internal/worker/providertracker"
+	"github.com/juju/juju/internal/worker/pruner"
 	"github.com/juju/juju/internal/worker/remoterelations"
 	"github.com/juju/juju/internal/worker/removal"
 	"github.com/juju/juju/internal/worker/secretsdrainworker"
@@ -54,6 +58,7 @@ import (
 	"github.com/juju/juju/internal/worker/storageprovisioner"
 	"github.com/juju/juju/internal/worker/undertaker"
 	"github.com/juju/juju/internal/worker/unitassigner"
+	"github.com/juju/juju/internal/worker/usersecretsrotate"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -84,6 +89,10 @@ type ManifoldsConfig struct {
 	// written into the model's logging collection rather than the controller's.
 	LoggingContext corelogger.LoggerContext
 
+	// LogForwarderSetter is the model's log writer, which forwards the
+	// model's logs to the endpoints set in the model config.
+	LogForwarderSetter corelogger.LogForwarderSetter
+
 	// RunFlagDuration defines for how long this controller will ask
 	// for model administration rights; most of the workers controlled
 	// by this agent will only be started when the run flag is known
@@ -94,6 +103,14 @@ type ManifoldsConfig struct {
 	// revision worker will check for new revisions of known charms.
 	CharmRevisionUpdateInterval time.Duration
 
+	// StatusHistoryPrunerInterval determines how often the status
+	// history is pruned according to the model's retention settings.
+	StatusHistoryPrunerInterval time.Duration
+
+	// SecretAccessLogPrunerInterval determines how often the secret access
+	// log is pruned according to the model's retention settings.
+	SecretAccessLogPrunerInterval time.Duration
+
 	// NewEnvironFunc is a function opens a provider "environment"
 	// (typically environs.New).
 	NewEnvironFunc environs.NewEnvironFunc
@@ -182,6 +199,23 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Output: engine.ValueWorkerOutput,
 		},
 
+		// The log forwarder sends the model's logs written on this
+		// controller to the endpoints set in the model config. Each
+		// controller receives its own share of the model's logs, so
+		// unlike the workers that administer the model, it runs on
+		// every controller. It logs to the controller's logs, as it must
+		// not write to the logs it forwards.
+		logForwarderName: logforwarder.Manifold(logforwarder.ManifoldConfig{
+			DomainServicesName:    domainServicesName,
+			GetModelConfigService: logforwarder.GetModelConfigService,
+			LogForwarderSetter:    config.LogForwarderSetter,
+			SpoolDir:              filepath.Join(agentConfig.DataDir(), "log-forwarder", modelUUID.String()),
+			NewWorker:             logforwarder.NewWorker,
+			NewForwarder:          logforwarder.NewForwarder,
+			Clock:                 config.Clock,
+			Logger:                internallogger.GetLogger("juju.worker.logforwarder"),
+		}),
+
 		// The logging config updater listens for logging config updates
 		// for the model and configures the logging context appropriately.
 		loggingConfigUpdaterName: ifNotMigrating(logger.Manifold(logger.ManifoldConfig{
@@ -305,6 +339,22 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Clock:              config.Clock,
 			Logger:             config.LoggingContext.GetLogger("juju.worker.removal"),
 		})),
+		statusHistoryPrunerName: ifNotMigrating(pruner.Manifold(pruner.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			GetServices:        pruner.GetStatusHistoryServices,
+			NewWorker:          pruner.NewWorker,
+			PruneInterval:      config.StatusHistoryPrunerInterval,
+			Clock:              config.Clock,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.statushistorypruner"),
+		})),
+		secretAccessLogPrunerName: ifNotMigrating(pruner.Manifold(pruner.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			GetServices:        pruner.GetSecretAccessLogServices,
+			NewWorker:          pruner.NewWorker,
+			PruneInterval:      config.SecretAccessLogPrunerInterval,
+			Clock:              config.Clock,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.secretaccesslogpruner"),
+		})),
 		stateCleanerName: ifNotMigrating(cleaner.Manifold(cleaner.ManifoldConfig{
 			APICallerName: apiCallerName,
 			Clock:         config.Clock,
@@ -342,6 +392,14 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewUserSecretsFacade: secretspruner.NewUserSecretsFacade,
 			NewWorker:            secretspruner.NewWorker,
 		})),
+		userSecretsRotateName: ifNotMigrating(usersecretsrotate.Manifold(usersecretsrotate.ManifoldConfig{
+			APICallerName:        apiCallerName,
+			ModelTag:             modelTag,
+			Logger:               config.LoggingContext.GetLogger("juju.worker.usersecretsrotate"),
+			Clock:                config.Clock,
+			NewUserSecretsFacade: usersecretsrotate.NewUserSecretsFacade,
+			NewWorker:            usersecretsrotate.NewWorker,
+		})),
 		// The userSecretsDrainWorker is the worker that drains the user secrets from the inactive backend to the current active backend.
 		userSecretsDrainWorker: ifNotMigrating(secretsdrainworker.Manifold(secretsdrainworker.ManifoldConfig{
 			APICallerName:         apiCallerName,
@@ -617,12 +675,15 @@ const (
 	httpClientName               = "http-client"
 	instancePollerName           = "instance-poller"
 	leaseManagerName             = "lease-manager"
+	logForwarderName             = "log-forwarder"
 	loggingConfigUpdaterName     = "logging-config-updater"
 	machineUndertakerName        = "machine-undertaker"
 	providerServiceFactoriesName = "provider-service-factories"
 	remoteRelationsName          = "remote-relations"
 	removalName                  = "removal"
+	secretAccessLogPrunerName    = "secret-access-log-pruner"
 	stateCleanerName             = "state-cleaner"
+	statusHistoryPrunerName      = "status-history-pruner"
 	storageProvisionerName       = "storage-provisioner"
 	undertakerName               = "undertaker"
 	unitAssignerName             = "unit-assigner"
@@ -634,6 +695,7 @@ const (
 	caasStorageProvisionerName     = "caas-storage-provisioner"
 
 	secretsPrunerName      = "secrets-pruner"
+	userSecretsRotateName  = "user-secrets-rotate"
 	userSecretsDrainWorker = "user-secrets-drain-worker"
 
 	validCredentialFlagName = "valid-credential-flag"
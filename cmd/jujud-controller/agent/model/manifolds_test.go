@@ -50,6 +50,7 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"instance-poller",
 		"is-responsible-flag",
 		"lease-manager",
+		"log-forwarder",
 		"logging-config-updater",
 		"machine-undertaker",
 		"migration-fortress",
@@ -61,12 +62,15 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"provider-tracker",
 		"remote-relations",
 		"removal",
+		"secret-access-log-pruner",
 		"secrets-pruner",
 		"state-cleaner",
+		"status-history-pruner",
 		"storage-provisioner",
 		"undertaker",
 		"unit-assigner",
 		"user-secrets-drain-worker",
+		"user-secrets-rotate",
 		"valid-credential-flag",
 	})
 }
@@ -98,6 +102,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"http-client",
 		"is-responsible-flag",
 		"lease-manager",
+		"log-forwarder",
 		"logging-config-updater",
 		"migration-fortress",
 		"migration-inactive-flag",
@@ -108,10 +113,13 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"provider-tracker",
 		"remote-relations",
 		"removal",
+		"secret-access-log-pruner",
 		"secrets-pruner",
 		"state-cleaner",
+		"status-history-pruner",
 		"undertaker",
 		"user-secrets-drain-worker",
+		"user-secrets-rotate",
 		"valid-credential-flag",
 	})
 }
@@ -132,6 +140,9 @@ func (s *ManifoldsSuite) TestFlagDependencies(c *tc.C) {
 		"lease-manager",
 		"http-client",
 		"valid-credential-flag",
+		// The log forwarder forwards the logs written on each
+		// controller agent, so runs on all of them.
+		"log-forwarder",
 	)
 	manifolds := model.IAASManifolds(model.ManifoldsConfig{
 		Agent:          &mockAgent{},
@@ -217,6 +228,17 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"user-secrets-rotate": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"user-secrets-drain-worker": {
 		"agent",
 		"api-caller",
@@ -328,6 +350,10 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 
 	"lease-manager": {},
 
+	"log-forwarder": {
+		"domain-services",
+	},
+
 	"logging-config-updater": {
 		"agent",
 		"api-caller",
@@ -398,6 +424,28 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"status-history-pruner": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
+	"secret-access-log-pruner": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"domain-services": {},
 
 	"http-client": {},
@@ -438,6 +486,17 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"user-secrets-rotate": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"user-secrets-drain-worker": {
 		"agent",
 		"api-caller",
@@ -529,6 +588,10 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 
 	"lease-manager": {},
 
+	"log-forwarder": {
+		"domain-services",
+	},
+
 	"logging-config-updater": {
 		"agent",
 		"api-caller",
@@ -613,6 +676,28 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"status-history-pruner": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
+	"secret-access-log-pruner": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"domain-services": {},
 
 	"http-client": {},
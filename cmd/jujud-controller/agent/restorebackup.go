@@ -0,0 +1,189 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	agentconfig "github.com/juju/juju/agent/config"
+	jujucmd "github.com/juju/juju/cmd"
+	coredatabase "github.com/juju/juju/core/database"
+	corelogger "github.com/juju/juju/core/logger"
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/internal/backups"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/database"
+)
+
+// RestoreDqliteFunc restores the input database dumps into a new Dqlite node.
+type RestoreDqliteFunc func(
+	ctx context.Context,
+	mgr database.RestoreNodeManager,
+	controllerID string,
+	dumps []database.DatabaseDump,
+	logger corelogger.Logger,
+) error
+
+const restoreBackupDoc = `
+Restore a controller backup, created with "juju create-backup", onto this
+machine. The Dqlite databases of the controller and of every model are
+recreated from the backup, and the file backed object store is restored into
+the agent's data directory.
+
+The controller agent must be stopped before running this command, and
+started again once it completes. Any existing Dqlite data is moved aside
+rather than deleted.
+
+The agent configuration on this machine must be for the controller that the
+backup was taken from, and the agent must be running the same major and minor
+version of Juju. The restored controller has a single node; further nodes can
+be added with "juju enable-ha" once it is running.
+`
+
+// NewRestoreBackupCommand returns a command that restores a controller
+// backup into this machine's controller agent.
+func NewRestoreBackupCommand(config agentconfig.AgentConfigWriter, restoreDqlite RestoreDqliteFunc) cmd.Command {
+	return &restoreBackupCommand{
+		config:        config,
+		restoreDqlite: restoreDqlite,
+		clock:         clock.WallClock,
+	}
+}
+
+type restoreBackupCommand struct {
+	cmd.CommandBase
+
+	config        agentconfig.AgentConfigWriter
+	restoreDqlite RestoreDqliteFunc
+	clock         clock.Clock
+
+	agentTag names.Tag
+
+	// The following are set via command-line flags and arguments.
+	machineId    string
+	controllerId string
+	archive      string
+}
+
+// Info is part of cmd.Command.
+func (c *restoreBackupCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "restore-backup",
+		Args:    "<backup-file>",
+		Purpose: "restore a controller backup onto this machine",
+		Doc:     restoreBackupDoc,
+	})
+}
+
+// SetFlags is part of cmd.Command.
+func (c *restoreBackupCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.machineId, "machine-id", "", "id of the machine to restore")
+	f.StringVar(&c.controllerId, "controller-id", "", "id of the controller to restore")
+}
+
+// Init is part of cmd.Command.
+func (c *restoreBackupCommand) Init(args []string) error {
+	if c.machineId == "" && c.controllerId == "" {
+		return errors.New("either machine-id or controller-id must be set")
+	}
+	if c.machineId != "" && !names.IsValidMachine(c.machineId) {
+		return errors.Errorf("--machine-id option must be a non-negative integer")
+	}
+	if c.controllerId != "" && !names.IsValidControllerAgent(c.controllerId) {
+		return errors.Errorf("--controller-id option must be a non-negative integer")
+	}
+	if len(args) == 0 {
+		return errors.New("backup file argument is required")
+	}
+	c.archive, args = args[0], args[1:]
+	if err := cmd.CheckEmpty(args); err != nil {
+		return err
+	}
+
+	if c.machineId != "" {
+		c.agentTag = names.NewMachineTag(c.machineId)
+	} else {
+		c.agentTag = names.NewControllerAgentTag(c.controllerId)
+	}
+	if err := c.config.ReadConfig(c.agentTag.String()); err != nil {
+		return errors.Annotate(err, "cannot read agent configuration")
+	}
+	return nil
+}
+
+// Run is part of cmd.Command.
+func (c *restoreBackupCommand) Run(ctx *cmd.Context) error {
+	cfg := c.config.CurrentConfig()
+
+	f, err := os.Open(c.archive)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	archive, err := backups.OpenArchive(f)
+	if err != nil {
+		return errors.Annotatef(err, "opening backup %q", c.archive)
+	}
+	defer func() { _ = archive.Close() }()
+
+	meta := archive.Metadata()
+	if controllerUUID := cfg.Controller().Id(); meta.Controller.UUID != controllerUUID {
+		return errors.Errorf("backup is of controller %q, not %q", meta.Controller.UUID, controllerUUID)
+	}
+	if v := meta.Origin.Version; v.Major != jujuversion.Current.Major || v.Minor != jujuversion.Current.Minor {
+		return errors.Errorf("backup was created by Juju %s, which is not compatible with %s", v, jujuversion.Current)
+	}
+
+	restoreLogger := logger.Child("restore")
+	mgr := database.NewNodeManager(cfg, false, restoreLogger, coredatabase.NoopSlowQueryLogger{})
+	if err := c.moveExistingNode(ctx, mgr); err != nil {
+		return errors.Trace(err)
+	}
+
+	ctx.Infof("Restoring %d databases from backup %s", len(meta.Databases), meta.ID())
+	if err := c.restoreDqlite(ctx, mgr, c.agentTag.Id(), archive.DatabaseDumps(), restoreLogger); err != nil {
+		return errors.Annotate(err, "restoring databases")
+	}
+	if err := archive.RestoreFiles(cfg.DataDir()); err != nil {
+		return errors.Trace(err)
+	}
+
+	ctx.Infof("Backup restored; start the controller agent to resume operation")
+	return nil
+}
+
+// moveExistingNode renames any existing Dqlite data directory, so that the
+// restored databases are written to a new node. The old data is kept so that
+// it can be recovered if the restore is not what was intended.
+func (c *restoreBackupCommand) moveExistingNode(ctx *cmd.Context, mgr *database.NodeManager) error {
+	existing, err := mgr.IsExistingNode()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !existing {
+		return nil
+	}
+
+	dir, err := mgr.EnsureDataDir()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	target := fmt.Sprintf("%s.pre-restore-%s", dir, c.clock.Now().UTC().Format("20060102-150405"))
+	if err := os.Rename(dir, target); err != nil {
+		return errors.Annotate(err, "moving existing Dqlite data aside")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Annotate(err, "creating directory for Dqlite data")
+	}
+	ctx.Infof("Existing Dqlite data moved to %s", target)
+	return nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agent_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/agent"
+	agentconfig "github.com/juju/juju/agent/config"
+	agentcmd "github.com/juju/juju/cmd/jujud-controller/agent"
+	corebackups "github.com/juju/juju/core/backups"
+	corelogger "github.com/juju/juju/core/logger"
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/internal/backups"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type restoreBackupSuite struct {
+	testhelpers.IsolationSuite
+
+	dataDir string
+	conf    *restoreAgentConf
+}
+
+func TestRestoreBackupSuite(t *testing.T) {
+	tc.Run(t, &restoreBackupSuite{})
+}
+
+func (s *restoreBackupSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.dataDir = c.MkDir()
+	s.conf = &restoreAgentConf{
+		config: restoreAgentConfig{
+			dataDir:    s.dataDir,
+			controller: coretesting.ControllerTag,
+		},
+	}
+}
+
+func (s *restoreBackupSuite) TestInit(c *tc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"backup.tar.gz"},
+		err:  "either machine-id or controller-id must be set",
+	}, {
+		args: []string{"--machine-id", "foo", "backup.tar.gz"},
+		err:  "--machine-id option must be a non-negative integer",
+	}, {
+		args: []string{"--machine-id", "0"},
+		err:  "backup file argument is required",
+	}, {
+		args: []string{"--machine-id", "0", "backup.tar.gz", "extra"},
+		err:  `unrecognized args: \["extra"\]`,
+	}} {
+		command := agentcmd.NewRestoreBackupCommand(s.conf, nil)
+		err := cmdtesting.InitCommand(command, test.args)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+
+	command := agentcmd.NewRestoreBackupCommand(s.conf, nil)
+	err := cmdtesting.InitCommand(command, []string{"--controller-id", "1", "backup.tar.gz"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.conf.tag, tc.Equals, "controller-1")
+}
+
+func (s *restoreBackupSuite) createBackup(c *tc.C, controllerUUID string) string {
+	objectDir := filepath.Join(c.MkDir(), "objectstore", "model-uuid")
+	c.Assert(os.MkdirAll(objectDir, 0700), tc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(objectDir, "abc"), []byte("object"), 0600), tc.ErrorIsNil)
+
+	meta := corebackups.NewMetadata()
+	meta.Started = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	meta.Origin.Version = jujuversion.Current
+	meta.Controller.UUID = controllerUUID
+
+	backupDir := c.MkDir()
+	filename, err := backups.Create(c.Context(), backups.CreateArgs{
+		Metadata: meta,
+		Databases: []backups.Database{
+			{Namespace: "controller", Dumper: stringDumper("controller dump\n")},
+			{Namespace: "model-uuid", Dumper: stringDumper("model dump\n")},
+		},
+		DataDir:   filepath.Dir(filepath.Dir(objectDir)),
+		BackupDir: backupDir,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return filepath.Join(backupDir, filename)
+}
+
+func (s *restoreBackupSuite) TestRun(c *tc.C) {
+	archive := s.createBackup(c, coretesting.ControllerTag.Id())
+
+	// An existing node is moved aside.
+	dqliteDir := filepath.Join(s.dataDir, "dqlite")
+	c.Assert(os.MkdirAll(dqliteDir, 0700), tc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(dqliteDir, "info.yaml"), []byte("old"), 0600), tc.ErrorIsNil)
+
+	var (
+		controllerID string
+		namespaces   []string
+	)
+	restore := func(
+		_ context.Context, mgr database.RestoreNodeManager, id string, dumps []database.DatabaseDump, _ corelogger.Logger,
+	) error {
+		existing, err := mgr.IsExistingNode()
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(existing, tc.IsFalse)
+
+		controllerID = id
+		for _, dump := range dumps {
+			namespaces = append(namespaces, dump.Namespace)
+		}
+		return nil
+	}
+
+	command := agentcmd.NewRestoreBackupCommand(s.conf, restore)
+	_, err := cmdtesting.RunCommand(c, command, "--machine-id", "0", archive)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(controllerID, tc.Equals, "0")
+	c.Check(namespaces, tc.DeepEquals, []string{"controller", "model-uuid"})
+
+	matches, err := filepath.Glob(filepath.Join(s.dataDir, "dqlite.pre-restore-*", "info.yaml"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(matches, tc.HasLen, 1)
+
+	data, err := os.ReadFile(filepath.Join(s.dataDir, "objectstore", "model-uuid", "abc"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "object")
+}
+
+func (s *restoreBackupSuite) TestRunWrongController(c *tc.C) {
+	archive := s.createBackup(c, "other-controller")
+
+	restore := func(context.Context, database.RestoreNodeManager, string, []database.DatabaseDump, corelogger.Logger) error {
+		c.Fatalf("unexpected restore")
+		return nil
+	}
+
+	command := agentcmd.NewRestoreBackupCommand(s.conf, restore)
+	_, err := cmdtesting.RunCommand(c, command, "--machine-id", "0", archive)
+	c.Assert(err, tc.ErrorMatches, `backup is of controller "other-controller", not ".*"`)
+}
+
+func (s *restoreBackupSuite) TestRunRestoreError(c *tc.C) {
+	archive := s.createBackup(c, coretesting.ControllerTag.Id())
+
+	restore := func(context.Context, database.RestoreNodeManager, string, []database.DatabaseDump, corelogger.Logger) error {
+		return errors.New("boom")
+	}
+
+	command := agentcmd.NewRestoreBackupCommand(s.conf, restore)
+	_, err := cmdtesting.RunCommand(c, command, "--machine-id", "0", archive)
+	c.Assert(err, tc.ErrorMatches, `restoring databases: boom`)
+}
+
+type stringDumper string
+
+func (d stringDumper) DumpDatabase(_ context.Context, w io.Writer) error {
+	_, err := io.WriteString(w, string(d))
+	return err
+}
+
+type restoreAgentConf struct {
+	agentconfig.AgentConfigWriter
+	config restoreAgentConfig
+	tag    string
+}
+
+func (c *restoreAgentConf) ReadConfig(tag string) error {
+	c.tag = tag
+	return nil
+}
+
+func (c *restoreAgentConf) CurrentConfig() agent.Config {
+	return c.config
+}
+
+type restoreAgentConfig struct {
+	agent.Config
+	dataDir    string
+	controller names.ControllerTag
+}
+
+func (c restoreAgentConfig) DataDir() string {
+	return c.dataDir
+}
+
+func (c restoreAgentConfig) Controller() names.ControllerTag {
+	return c.controller
+}
+
+func (c restoreAgentConfig) DqlitePort() (int, bool) {
+	return 0, false
+}
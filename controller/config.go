@@ -18,6 +18,7 @@ import (
 	"github.com/juju/utils/v4"
 	"gopkg.in/yaml.v2"
 
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/internal/configschema"
@@ -81,6 +82,27 @@ const (
 	// interesting calls though.)
 	AuditLogExcludeMethods = "audit-log-exclude-methods"
 
+	// AuditLogSinks is a comma-delimited list of the sinks that audit
+	// records are written to, eg "file,syslog,webhook".
+	AuditLogSinks = "audit-log-sinks"
+
+	// AuditLogSyslogAddress is the address of the syslog server audit
+	// records are sent to when the syslog sink is enabled, eg
+	// "tls://syslog.example.com:6514".
+	AuditLogSyslogAddress = "audit-log-syslog-address"
+
+	// AuditLogSyslogCACert is the PEM encoded CA certificate used to
+	// verify the syslog server when sending audit records over tls.
+	AuditLogSyslogCACert = "audit-log-syslog-ca-cert"
+
+	// AuditLogWebhookURL is the URL that batches of audit records are
+	// posted to when the webhook sink is enabled.
+	AuditLogWebhookURL = "audit-log-webhook-url"
+
+	// AuditLogWebhookBatchSize is the maximum number of audit records
+	// posted to the webhook in a single request.
+	AuditLogWebhookBatchSize = "audit-log-webhook-batch-size"
+
 	// ReadOnlyMethodsWildcard is the special value that can be added
 	// to the exclude-methods list that represents all of the read
 	// only methods (see apiserver/observer/auditfilter.go). This
@@ -300,6 +322,10 @@ const (
 	// SSHMaxConcurrentConnections is the maximum number of concurrent SSH
 	// connections to the controller.
 	SSHMaxConcurrentConnections = "ssh-max-concurrent-connections"
+
+	// SSHSessionRecording indicates whether interactive sessions proxied
+	// through the controller's SSH server are recorded to the object store.
+	SSHSessionRecording = "ssh-session-recording"
 )
 
 // Attribute Defaults
@@ -311,6 +337,10 @@ const (
 	// DefaultSSHServerPort is the default port used for the embedded SSH server.
 	DefaultSSHServerPort = 17022
 
+	// DefaultSSHSessionRecording is the default for whether interactive
+	// SSH sessions are recorded.
+	DefaultSSHSessionRecording = false
+
 	// DefaultApplicationResourceDownloadLimit allows unlimited
 	// resource download requests initiated by a unit agent per application.
 	DefaultApplicationResourceDownloadLimit = 0
@@ -343,6 +373,14 @@ const (
 	// keep.
 	DefaultAuditLogMaxBackups = 10
 
+	// DefaultAuditLogSinks is the default list of sinks audit records
+	// are written to.
+	DefaultAuditLogSinks = auditlog.FileSink
+
+	// DefaultAuditLogWebhookBatchSize is the default maximum number of
+	// audit records posted to the webhook in a single request.
+	DefaultAuditLogWebhookBatchSize = auditlog.DefaultWebhookBatchSize
+
 	// DefaultNUMAControlPolicy should not be used by default.
 	// Only use numactl if user specifically requests it
 	DefaultNUMAControlPolicy = false
@@ -499,6 +537,11 @@ var (
 		AuditLogMaxSize,
 		AuditLogMaxBackups,
 		AuditLogExcludeMethods,
+		AuditLogSinks,
+		AuditLogSyslogAddress,
+		AuditLogSyslogCACert,
+		AuditLogWebhookURL,
+		AuditLogWebhookBatchSize,
 		CAASOperatorImagePath,
 		CAASImageRepo,
 		Features,
@@ -524,6 +567,7 @@ var (
 		JujudControllerSnapSource,
 		SSHMaxConcurrentConnections,
 		SSHServerPort,
+		SSHSessionRecording,
 	}
 
 	// For backwards compatibility, we must include "anything", "juju-apiserver"
@@ -552,6 +596,11 @@ var (
 		AuditLogExcludeMethods,
 		AuditLogMaxBackups,
 		AuditLogMaxSize,
+		AuditLogSinks,
+		AuditLogSyslogAddress,
+		AuditLogSyslogCACert,
+		AuditLogWebhookBatchSize,
+		AuditLogWebhookURL,
 		CAASImageRepo,
 		ControllerResourceDownloadLimit,
 		Features,
@@ -582,6 +631,7 @@ var (
 		ObjectStoreS3StaticSecret,
 		ObjectStoreS3StaticSession,
 		SSHMaxConcurrentConnections,
+		SSHSessionRecording,
 	)
 
 	methodNameRE = regexp.MustCompile(`[[:alpha:]][[:alnum:]]*\.[[:alpha:]][[:alnum:]]*`)
@@ -815,6 +865,45 @@ func (c Config) AuditLogExcludeMethods() set.Strings {
 	return set.NewStrings(strings.Split(v, ",")...)
 }
 
+// AuditLogSinks returns the names of the sinks that audit records are
+// written to.
+func (c Config) AuditLogSinks() []string {
+	v := c.asString(AuditLogSinks)
+	if v == "" {
+		v = DefaultAuditLogSinks
+	}
+	var sinks []string
+	for _, name := range strings.Split(v, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			sinks = append(sinks, name)
+		}
+	}
+	return sinks
+}
+
+// AuditLogSyslogAddress returns the address of the syslog server audit
+// records are sent to.
+func (c Config) AuditLogSyslogAddress() string {
+	return c.asString(AuditLogSyslogAddress)
+}
+
+// AuditLogSyslogCACert returns the CA certificate used to verify the
+// syslog server.
+func (c Config) AuditLogSyslogCACert() string {
+	return c.asString(AuditLogSyslogCACert)
+}
+
+// AuditLogWebhookURL returns the URL that audit records are posted to.
+func (c Config) AuditLogWebhookURL() string {
+	return c.asString(AuditLogWebhookURL)
+}
+
+// AuditLogWebhookBatchSize returns the maximum number of audit records
+// posted to the webhook in a single request.
+func (c Config) AuditLogWebhookBatchSize() int {
+	return c.intOrDefault(AuditLogWebhookBatchSize, DefaultAuditLogWebhookBatchSize)
+}
+
 // Features returns the controller config set features flags.
 func (c Config) Features() set.Strings {
 	v := c.asString(Features)
@@ -1112,6 +1201,12 @@ func (c Config) SSHMaxConcurrentConnections() int {
 	return c.intOrDefault(SSHMaxConcurrentConnections, DefaultSSHMaxConcurrentConnections)
 }
 
+// SSHSessionRecording returns whether interactive SSH sessions proxied
+// through the controller are recorded.
+func (c Config) SSHSessionRecording() bool {
+	return c.boolOrDefault(SSHSessionRecording, DefaultSSHSessionRecording)
+}
+
 // Validate ensures that config is a valid configuration.
 func Validate(c Config) error {
 	if v, ok := c[IdentityPublicKey].(string); ok {
@@ -1282,6 +1377,10 @@ func Validate(c Config) error {
 		}
 	}
 
+	if err := c.validateAuditLogSinks(); err != nil {
+		return errors.Trace(err)
+	}
+
 	if v, ok := c[ControllerName].(string); ok {
 		if !names.IsValidControllerName(v) {
 			return errors.Errorf("%s value must be a valid controller name (lowercase or digit with non-leading hyphen), got %q", ControllerName, v)
@@ -1497,3 +1596,39 @@ func HasCompleteS3Config(endpoint, staticKey, staticSecret string) error {
 	}
 	return nil
 }
+
+func (c Config) validateAuditLogSinks() error {
+	sinks := set.NewStrings()
+	for _, name := range c.AuditLogSinks() {
+		if !auditlog.IsValidSink(name) {
+			return errors.Errorf("invalid audit log sinks: unknown sink %q, expected one of %s",
+				name, strings.Join(auditlog.Sinks(), ", "))
+		}
+		sinks.Add(name)
+	}
+
+	if sinks.Contains(auditlog.SyslogSink) || c.AuditLogSyslogAddress() != "" {
+		if _, _, err := auditlog.ParseSyslogAddress(c.AuditLogSyslogAddress()); err != nil {
+			return errors.Annotate(err, "invalid audit log syslog address")
+		}
+	}
+	if v := c.AuditLogSyslogCACert(); v != "" {
+		if _, err := pki.IsPemCA([]byte(v)); err != nil {
+			return errors.Annotate(err, "invalid audit log syslog CA certificate")
+		}
+	}
+
+	if sinks.Contains(auditlog.WebhookSink) || c.AuditLogWebhookURL() != "" {
+		u, err := url.Parse(c.AuditLogWebhookURL())
+		if err != nil {
+			return errors.Annotate(err, "invalid audit log webhook URL")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+			return errors.Errorf("invalid audit log webhook URL: expected an http or https URL, got %q", c.AuditLogWebhookURL())
+		}
+	}
+	if v, ok := c[AuditLogWebhookBatchSize].(int); ok && v <= 0 {
+		return errors.Errorf("invalid audit log webhook batch size: should be a positive number, got %d", v)
+	}
+	return nil
+}
@@ -163,6 +163,36 @@ var newConfigTests = []struct {
 		controller.AuditLogExcludeMethods: "Dap.Kings,ReadOnlyMethods,Sharon Jones",
 	},
 	expectError: `invalid audit log exclude methods: should be a list of "Facade.Method" names \(or "ReadOnlyMethods"\), got "Sharon Jones" at position 3`,
+}, {
+	about: "unknown audit log sink",
+	config: controller.Config{
+		controller.AuditLogSinks: "file,carrier-pigeon",
+	},
+	expectError: `invalid audit log sinks: unknown sink "carrier-pigeon", expected one of .*`,
+}, {
+	about: "syslog sink without address",
+	config: controller.Config{
+		controller.AuditLogSinks: "syslog",
+	},
+	expectError: `invalid audit log syslog address: empty syslog address not valid`,
+}, {
+	about: "invalid audit log syslog address",
+	config: controller.Config{
+		controller.AuditLogSyslogAddress: "http://syslog.example.com:514",
+	},
+	expectError: `invalid audit log syslog address: syslog address "http://syslog.example.com:514": scheme must be one of tcp, udp or tls`,
+}, {
+	about: "webhook sink without URL",
+	config: controller.Config{
+		controller.AuditLogSinks: "webhook",
+	},
+	expectError: `invalid audit log webhook URL: expected an http or https URL, got ""`,
+}, {
+	about: "invalid audit log webhook batch size",
+	config: controller.Config{
+		controller.AuditLogWebhookBatchSize: 0,
+	},
+	expectError: `invalid audit log webhook batch size: should be a positive number, got 0`,
 }, {
 	about: "txn-prune-sleep-time not a duration",
 	config: controller.Config{
@@ -585,6 +615,35 @@ func (s *ConfigSuite) TestAuditLogValues(c *tc.C) {
 	))
 }
 
+func (s *ConfigSuite) TestAuditLogSinkDefaults(c *tc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.AuditLogSinks(), tc.DeepEquals, []string{"file"})
+	c.Check(cfg.AuditLogSyslogAddress(), tc.Equals, "")
+	c.Check(cfg.AuditLogWebhookURL(), tc.Equals, "")
+	c.Check(cfg.AuditLogWebhookBatchSize(), tc.Equals, 100)
+}
+
+func (s *ConfigSuite) TestAuditLogSinkValues(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"audit-log-sinks":              "file, syslog,webhook",
+			"audit-log-syslog-address":     "tls://syslog.example.com:6514",
+			"audit-log-syslog-ca-cert":     testing.CACert,
+			"audit-log-webhook-url":        "https://audit.example.com/records",
+			"audit-log-webhook-batch-size": 50,
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.AuditLogSinks(), tc.DeepEquals, []string{"file", "syslog", "webhook"})
+	c.Check(cfg.AuditLogSyslogAddress(), tc.Equals, "tls://syslog.example.com:6514")
+	c.Check(cfg.AuditLogSyslogCACert(), tc.Equals, testing.CACert)
+	c.Check(cfg.AuditLogWebhookURL(), tc.Equals, "https://audit.example.com/records")
+	c.Check(cfg.AuditLogWebhookBatchSize(), tc.Equals, 50)
+}
+
 func (s *ConfigSuite) TestAuditLogExcludeMethodsType(c *tc.C) {
 	_, err := controller.NewConfig(
 		testing.ControllerTag.Id(),
@@ -773,6 +832,7 @@ func (s *ConfigSuite) TestDefaults(c *tc.C) {
 	c.Assert(cfg.QueryTracingThreshold(), tc.Equals, controller.DefaultQueryTracingThreshold)
 	c.Assert(cfg.SSHServerPort(), tc.Equals, controller.DefaultSSHServerPort)
 	c.Assert(cfg.SSHMaxConcurrentConnections(), tc.Equals, controller.DefaultSSHMaxConcurrentConnections)
+	c.Assert(cfg.SSHSessionRecording(), tc.Equals, controller.DefaultSSHSessionRecording)
 }
 
 func (s *ConfigSuite) TestAgentLogfile(c *tc.C) {
@@ -1016,6 +1076,18 @@ func (s *ConfigSuite) TestSSHServerConcurrentConnections(c *tc.C) {
 	c.Assert(cfg.SSHMaxConcurrentConnections(), tc.Equals, 10)
 }
 
+func (s *ConfigSuite) TestSSHSessionRecording(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			controller.SSHSessionRecording: true,
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.SSHSessionRecording(), tc.IsTrue)
+}
+
 func (s *ConfigSuite) TestObjectStoreType(c *tc.C) {
 	backendType := "file"
 	cfg, err := controller.NewConfig(
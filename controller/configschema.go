@@ -19,6 +19,11 @@ var configChecker = schema.FieldMap(schema.Fields{
 	AuditLogMaxSize:                    schema.String(),
 	AuditLogMaxBackups:                 schema.ForceInt(),
 	AuditLogExcludeMethods:             schema.String(),
+	AuditLogSinks:                      schema.String(),
+	AuditLogSyslogAddress:              schema.String(),
+	AuditLogSyslogCACert:               schema.String(),
+	AuditLogWebhookURL:                 schema.String(),
+	AuditLogWebhookBatchSize:           schema.ForceInt(),
 	APIPort:                            schema.ForceInt(),
 	ControllerName:                     schema.NonEmptyString(ControllerName),
 	StatePort:                          schema.ForceInt(),
@@ -68,6 +73,7 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujudControllerSnapSource:          schema.String(),
 	SSHServerPort:                      schema.ForceInt(),
 	SSHMaxConcurrentConnections:        schema.ForceInt(),
+	SSHSessionRecording:                schema.Bool(),
 }, schema.Defaults{
 	AgentRateLimitMax:                  schema.Omit,
 	AgentRateLimitRate:                 schema.Omit,
@@ -78,6 +84,11 @@ var configChecker = schema.FieldMap(schema.Fields{
 	AuditLogMaxSize:                    fmt.Sprintf("%vM", DefaultAuditLogMaxSizeMB),
 	AuditLogMaxBackups:                 DefaultAuditLogMaxBackups,
 	AuditLogExcludeMethods:             DefaultAuditLogExcludeMethods,
+	AuditLogSinks:                      DefaultAuditLogSinks,
+	AuditLogSyslogAddress:              schema.Omit,
+	AuditLogSyslogCACert:               schema.Omit,
+	AuditLogWebhookURL:                 schema.Omit,
+	AuditLogWebhookBatchSize:           DefaultAuditLogWebhookBatchSize,
 	StatePort:                          DefaultStatePort,
 	LoginTokenRefreshURL:               schema.Omit,
 	IdentityURL:                        schema.Omit,
@@ -125,6 +136,7 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujudControllerSnapSource:          DefaultJujudControllerSnapSource,
 	SSHServerPort:                      DefaultSSHServerPort,
 	SSHMaxConcurrentConnections:        DefaultSSHMaxConcurrentConnections,
+	SSHSessionRecording:                DefaultSSHSessionRecording,
 })
 
 // ConfigSchema holds information on all the fields defined by
@@ -166,6 +178,26 @@ var ConfigSchema = configschema.Fields{
 		Type:        configschema.Tstring,
 		Description: "A comma-delimited list of Facade.Method names that aren't interesting for audit logging purposes.",
 	},
+	AuditLogSinks: {
+		Type:        configschema.Tstring,
+		Description: `A comma-delimited list of the sinks audit records are written to (file, syslog, webhook)`,
+	},
+	AuditLogSyslogAddress: {
+		Type:        configschema.Tstring,
+		Description: `The address of the syslog server audit records are sent to, eg tls://syslog.example.com:6514`,
+	},
+	AuditLogSyslogCACert: {
+		Type:        configschema.Tstring,
+		Description: `The CA certificate used to verify the syslog server when sending audit records over tls`,
+	},
+	AuditLogWebhookURL: {
+		Type:        configschema.Tstring,
+		Description: `The URL that batches of audit records are posted to`,
+	},
+	AuditLogWebhookBatchSize: {
+		Type:        configschema.Tint,
+		Description: `The maximum number of audit records posted to the webhook in a single request`,
+	},
 	APIPort: {
 		Type:        configschema.Tint,
 		Description: "The port used for api connections",
@@ -359,4 +391,8 @@ will be output if tracing is enabled.`,
 		Type:        configschema.Tint,
 		Description: `The maximum number of concurrent ssh connections to the controller`,
 	},
+	SSHSessionRecording: {
+		Type:        configschema.Tbool,
+		Description: `Whether interactive ssh sessions through the controller are recorded`,
+	},
 }
@@ -0,0 +1,8 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+// HookTimeoutConfigOptionName is the option name used to override the model's
+// hook timeout in application configuration.
+const HookTimeoutConfigOptionName = "hook-timeout"
@@ -4,6 +4,8 @@
 package auditlog
 
 import (
+	"slices"
+
 	"github.com/juju/collections/set"
 
 	coreerrors "github.com/juju/juju/core/errors"
@@ -31,6 +33,25 @@ type Config struct {
 	// consists of these method calls we won't log it.
 	ExcludeMethods set.Strings
 
+	// Sinks is the list of sink names that audit records are written
+	// to.
+	Sinks []string
+
+	// SyslogAddress is the address of the syslog server used by the
+	// syslog sink.
+	SyslogAddress string
+
+	// SyslogCACert is the CA certificate used to verify the syslog
+	// server.
+	SyslogCACert string
+
+	// WebhookURL is the URL that the webhook sink posts records to.
+	WebhookURL string
+
+	// WebhookBatchSize is the maximum number of records the webhook
+	// sink posts in a single request.
+	WebhookBatchSize int
+
 	// Target is the AuditLog entries should be written to.
 	Target AuditLog
 }
@@ -42,3 +63,27 @@ func (cfg Config) Validate() error {
 	}
 	return nil
 }
+
+// SinkConfig returns the config used to create the audit log sinks,
+// writing any files to the given log directory.
+func (cfg Config) SinkConfig(logDir string) SinkConfig {
+	return SinkConfig{
+		LogDir:           logDir,
+		MaxSizeMB:        cfg.MaxSizeMB,
+		MaxBackups:       cfg.MaxBackups,
+		SyslogAddress:    cfg.SyslogAddress,
+		SyslogCACert:     cfg.SyslogCACert,
+		WebhookURL:       cfg.WebhookURL,
+		WebhookBatchSize: cfg.WebhookBatchSize,
+	}
+}
+
+// SinksChanged returns true if the sinks, or their settings, differ
+// between the two configs.
+func (cfg Config) SinksChanged(other Config) bool {
+	return !slices.Equal(cfg.Sinks, other.Sinks) ||
+		cfg.SyslogAddress != other.SyslogAddress ||
+		cfg.SyslogCACert != other.SyslogCACert ||
+		cfg.WebhookURL != other.WebhookURL ||
+		cfg.WebhookBatchSize != other.WebhookBatchSize
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import "github.com/juju/clock"
+
+// UnregisterSink removes a previously registered sink.
+func UnregisterSink(name string) {
+	sinksMu.Lock()
+	defer sinksMu.Unlock()
+	delete(sinks, name)
+}
+
+// NewSyslogWithQueueSize returns a syslog audit log that holds at most
+// queueSize messages waiting to be sent.
+func NewSyslogWithQueueSize(address string, clock clock.Clock, queueSize int) (AuditLog, error) {
+	return newSyslog(address, "", clock, queueSize)
+}
+
+// SyslogDropped returns the number of messages the syslog audit log has
+// dropped since it last sent a message.
+func SyslogDropped(log AuditLog) int64 {
+	return log.(*syslogLog).dropped.Load()
+}
+
+// NewWebhookWithMaxPending returns a webhook audit log that holds at most
+// maxPending undelivered records in memory.
+func NewWebhookWithMaxPending(cfg WebhookConfig, maxPending int) (AuditLog, error) {
+	return newWebhook(cfg, maxPending)
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"github.com/juju/juju/internal/errors"
+)
+
+type fanout struct {
+	logs []AuditLog
+}
+
+// NewFanout returns an audit log that writes every record to all of the
+// given audit logs. A failure to write to one of the logs doesn't prevent
+// the record being written to the others; all the errors are returned
+// together.
+func NewFanout(logs ...AuditLog) AuditLog {
+	return &fanout{logs: logs}
+}
+
+// AddConversation implements AuditLog.
+func (f *fanout) AddConversation(c Conversation) error {
+	return f.each(func(log AuditLog) error {
+		return log.AddConversation(c)
+	})
+}
+
+// AddRequest implements AuditLog.
+func (f *fanout) AddRequest(r Request) error {
+	return f.each(func(log AuditLog) error {
+		return log.AddRequest(r)
+	})
+}
+
+// AddResponse implements AuditLog.
+func (f *fanout) AddResponse(r ResponseErrors) error {
+	return f.each(func(log AuditLog) error {
+		return log.AddResponse(r)
+	})
+}
+
+// Close implements AuditLog.
+func (f *fanout) Close() error {
+	return f.each(func(log AuditLog) error {
+		return log.Close()
+	})
+}
+
+func (f *fanout) each(fn func(AuditLog) error) error {
+	var errs []error
+	for _, log := range f.logs {
+		if err := fn(log); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,393 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// Entry is a single API request from the audit log, combined with the
+// details of the conversation it was part of and any errors returned in
+// response to it.
+type Entry struct {
+	ConversationID string
+	ConnectionID   string
+	RequestID      uint64
+	When           time.Time
+	Who            string
+	What           string
+	ModelName      string
+	ModelUUID      string
+	Facade         string
+	Method         string
+	Version        int
+	Args           string
+	Errors         []*Error
+}
+
+// Filter restricts the entries read from the audit log. Zero values match
+// everything.
+type Filter struct {
+	// Who matches the user that made the request.
+	Who string
+
+	// ModelUUID matches the model the request was made against.
+	ModelUUID string
+
+	// Facade matches the facade that was called.
+	Facade string
+
+	// Method matches the method that was called.
+	Method string
+
+	// From matches requests made at or after this time.
+	From time.Time
+
+	// To matches requests made at or before this time.
+	To time.Time
+
+	// ErrorsOnly matches requests that returned errors.
+	ErrorsOnly bool
+
+	// Limit is the maximum number of entries to return. The most recent
+	// entries are returned.
+	Limit int
+}
+
+func (f Filter) matchesConversation(c *Conversation) bool {
+	if f.Who != "" && c.Who != f.Who {
+		return false
+	}
+	if f.ModelUUID != "" && c.ModelUUID != f.ModelUUID {
+		return false
+	}
+	return true
+}
+
+func (f Filter) matchesRequest(r *Request, when time.Time) bool {
+	if f.Facade != "" && !strings.EqualFold(r.Facade, f.Facade) {
+		return false
+	}
+	if f.Method != "" && !strings.EqualFold(r.Method, f.Method) {
+		return false
+	}
+	if !f.From.IsZero() && when.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && when.After(f.To) {
+		return false
+	}
+	return true
+}
+
+type entryKey struct {
+	conversationID string
+	requestID      uint64
+}
+
+// ReadEntries reads the audit log files written by the file sink in the
+// given directory, including any rotated backups, and returns the entries
+// matching the filter, oldest first.
+//
+// The files are read newest first, a line at a time, so only the matching
+// entries are held in memory. If the filter has a limit, at most that many
+// entries are kept from each file, and older files aren't read once the
+// limit has been reached.
+func ReadEntries(logDir string, filter Filter) ([]Entry, error) {
+	files, err := auditLogFiles(logDir)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	r := &entryReader{
+		filter:    filter,
+		orphans:   make(map[string][]*Entry),
+		responses: make(map[entryKey][]*Error),
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		if r.done() {
+			break
+		}
+		if err := r.readFile(files[i]); err != nil {
+			return nil, errors.Errorf("reading audit log %q: %w", files[i], err)
+		}
+	}
+
+	entries := r.entries
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].When.Before(entries[j].When)
+	})
+	if filter.Limit > 0 && len(entries) > filter.Limit {
+		entries = entries[len(entries)-filter.Limit:]
+	}
+	return entries, nil
+}
+
+// auditLogFiles returns the audit log files in the directory, oldest first.
+// Rotated backups are named with the time of rotation, so sort in order.
+func auditLogFiles(logDir string) ([]string, error) {
+	backups, err := filepath.Glob(filepath.Join(logDir, "audit-*.log*"))
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	sort.Strings(backups)
+
+	current := filepath.Join(logDir, "audit.log")
+	if _, err := os.Stat(current); err == nil {
+		backups = append(backups, current)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Capture(err)
+	}
+	return backups, nil
+}
+
+// entryReader collects the entries matching a filter from the audit log
+// files, newest file first.
+type entryReader struct {
+	filter Filter
+
+	// entries are the matching entries read from the files so far.
+	entries []Entry
+
+	// orphans are the requests, keyed by conversation ID, whose
+	// conversation started in an older file than the one they were read
+	// from. They are matched once the conversation has been read.
+	orphans map[string][]*Entry
+
+	// responses are the errors, keyed by request, read from a newer file
+	// than the request they were returned for.
+	responses map[entryKey][]*Error
+}
+
+// done returns true once no more files need to be read: the limit has been
+// reached and no requests are waiting for their conversation.
+func (r *entryReader) done() bool {
+	return r.filter.Limit > 0 && len(r.entries) >= r.filter.Limit && len(r.orphans) == 0
+}
+
+// remaining returns the number of entries still needed to reach the limit,
+// or -1 if there is no limit.
+func (r *entryReader) remaining() int {
+	if r.filter.Limit <= 0 {
+		return -1
+	}
+	return max(r.filter.Limit-len(r.entries), 0)
+}
+
+func (r *entryReader) readFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer f.Close()
+
+	var in io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		defer gz.Close()
+		in = gz
+	}
+
+	fr := &fileEntryReader{
+		filter:        r.filter,
+		limit:         r.remaining(),
+		orphans:       r.orphans,
+		responses:     r.responses,
+		conversations: make(map[string]*Conversation),
+		unmatched:     make(map[string]bool),
+		requests:      make(map[entryKey]bool),
+		index:         make(map[entryKey]*Entry),
+		pending:       make(map[entryKey]*Entry),
+	}
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			logger.Debugf(context.TODO(), "skipping malformed audit record in %q: %v", path, err)
+			continue
+		}
+		fr.add(record)
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Capture(err)
+	}
+
+	fr.finish()
+
+	r.entries = append(r.entries, fr.resolved...)
+	for _, e := range fr.window {
+		if _, ok := fr.conversations[e.ConversationID]; !ok {
+			r.orphans[e.ConversationID] = append(r.orphans[e.ConversationID], e)
+			continue
+		}
+		r.entries = append(r.entries, *e)
+	}
+	return nil
+}
+
+// fileEntryReader reads the entries from a single audit log file, keeping
+// at most limit of the most recent matching entries.
+type fileEntryReader struct {
+	filter Filter
+	limit  int
+
+	// orphans are the requests from newer files waiting for their
+	// conversation; resolved are those whose conversation matched.
+	orphans  map[string][]*Entry
+	resolved []Entry
+
+	// responses are the errors from newer files waiting for their
+	// request. Responses in this file without a request are added.
+	responses map[entryKey][]*Error
+
+	// conversations are the matching conversations in the file, and
+	// unmatched the IDs of those that didn't match the filter.
+	conversations map[string]*Conversation
+	unmatched     map[string]bool
+
+	// requests are the requests read from the file, matching or not.
+	requests map[entryKey]bool
+
+	// window holds the most recent matching entries of the file, oldest
+	// first, with index keyed on their request.
+	window []*Entry
+	index  map[entryKey]*Entry
+
+	// pending holds the requests waiting for their response, when only
+	// requests that returned errors match.
+	pending map[entryKey]*Entry
+}
+
+func (r *fileEntryReader) add(record Record) {
+	switch {
+	case record.Conversation != nil:
+		c := record.Conversation
+		orphans := r.orphans[c.ConversationID]
+		delete(r.orphans, c.ConversationID)
+		if !r.filter.matchesConversation(c) {
+			r.unmatched[c.ConversationID] = true
+			return
+		}
+		r.conversations[c.ConversationID] = c
+		for _, e := range orphans {
+			setConversation(e, c)
+			r.resolved = append(r.resolved, *e)
+		}
+
+	case record.Request != nil:
+		req := record.Request
+		key := entryKey{req.ConversationID, req.RequestID}
+		r.requests[key] = true
+		if r.limit == 0 || r.unmatched[req.ConversationID] {
+			// Either the limit has been reached by newer files, so only
+			// the conversations of orphaned requests are of interest, or
+			// the conversation didn't match.
+			return
+		}
+		when, _ := time.Parse(time.RFC3339, req.When)
+		if !r.filter.matchesRequest(req, when) {
+			return
+		}
+		e := &Entry{
+			ConversationID: req.ConversationID,
+			ConnectionID:   req.ConnectionID,
+			RequestID:      req.RequestID,
+			When:           when,
+			Facade:         req.Facade,
+			Method:         req.Method,
+			Version:        req.Version,
+			Args:           req.Args,
+		}
+		// Without a conversation in this file, the conversation must
+		// have started in an older file.
+		if c, ok := r.conversations[req.ConversationID]; ok {
+			setConversation(e, c)
+		}
+		if errs, ok := r.responses[key]; ok {
+			e.Errors = errs
+			delete(r.responses, key)
+		}
+
+		if r.filter.ErrorsOnly && len(e.Errors) == 0 {
+			r.pending[key] = e
+			return
+		}
+		r.push(key, e)
+
+	case record.Errors != nil:
+		resp := record.Errors
+		key := entryKey{resp.ConversationID, resp.RequestID}
+		var errs []*Error
+		for _, err := range resp.Errors {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if !r.requests[key] {
+			// The request was written to an older file.
+			if len(errs) > 0 {
+				r.responses[key] = append(r.responses[key], errs...)
+			}
+			return
+		}
+		if e, ok := r.pending[key]; ok {
+			delete(r.pending, key)
+			if len(errs) > 0 {
+				e.Errors = errs
+				r.push(key, e)
+			}
+			return
+		}
+		if e, ok := r.index[key]; ok {
+			e.Errors = append(e.Errors, errs...)
+		}
+	}
+}
+
+// finish forgets the responses whose request was read from the file, but
+// didn't match, so they aren't held while reading older files.
+func (r *fileEntryReader) finish() {
+	for key := range r.responses {
+		if r.requests[key] {
+			delete(r.responses, key)
+		}
+	}
+}
+
+// push adds a matching entry to the window, dropping the oldest entry if
+// the window is full.
+func (r *fileEntryReader) push(key entryKey, e *Entry) {
+	r.window = append(r.window, e)
+	r.index[key] = e
+	if r.limit > 0 && len(r.window) > r.limit {
+		oldest := r.window[0]
+		delete(r.index, entryKey{oldest.ConversationID, oldest.RequestID})
+		r.window = r.window[1:]
+	}
+}
+
+func setConversation(e *Entry, c *Conversation) {
+	e.Who = c.Who
+	e.What = c.What
+	e.ModelName = c.ModelName
+	e.ModelUUID = c.ModelUUID
+}
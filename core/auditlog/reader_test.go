@@ -0,0 +1,208 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type readerSuite struct {
+	testhelpers.IsolationSuite
+
+	dir string
+}
+
+func TestReaderSuite(t *testing.T) {
+	tc.Run(t, &readerSuite{})
+}
+
+func (s *readerSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.dir = c.MkDir()
+
+	// The first conversation was rotated into a compressed backup.
+	s.writeBackup(c, "audit-2025-01-01T00-00-00.000.log.gz", []auditlog.Record{{
+		Conversation: &auditlog.Conversation{
+			Who:            "alice",
+			What:           "juju deploy postgresql",
+			ModelName:      "alice/prod",
+			ModelUUID:      "model-1",
+			ConversationID: "c1",
+			ConnectionID:   "A",
+		},
+	}, {
+		Request: &auditlog.Request{
+			ConversationID: "c1",
+			ConnectionID:   "A",
+			RequestID:      1,
+			When:           "2025-01-01T10:00:00Z",
+			Facade:         "Application",
+			Method:         "Deploy",
+			Version:        20,
+		},
+	}})
+
+	log := auditlog.NewLogFile(s.dir, 300, 10)
+	defer log.Close()
+	c.Assert(log.AddConversation(auditlog.Conversation{
+		Who:            "bob",
+		What:           "juju remove-application postgresql",
+		ModelName:      "alice/prod",
+		ModelUUID:      "model-1",
+		ConversationID: "c2",
+		ConnectionID:   "B",
+	}), tc.ErrorIsNil)
+	c.Assert(log.AddRequest(auditlog.Request{
+		ConversationID: "c2",
+		ConnectionID:   "B",
+		RequestID:      1,
+		When:           "2025-01-02T23:00:00Z",
+		Facade:         "Application",
+		Method:         "DestroyApplication",
+		Version:        20,
+	}), tc.ErrorIsNil)
+	c.Assert(log.AddResponse(auditlog.ResponseErrors{
+		ConversationID: "c2",
+		ConnectionID:   "B",
+		RequestID:      1,
+		When:           "2025-01-02T23:00:01Z",
+		Errors:         []*auditlog.Error{{Message: "permission denied", Code: "unauthorized access"}},
+	}), tc.ErrorIsNil)
+	c.Assert(log.AddConversation(auditlog.Conversation{
+		Who:            "carol",
+		What:           "juju add-model staging",
+		ModelUUID:      "controller",
+		ConversationID: "c3",
+		ConnectionID:   "C",
+	}), tc.ErrorIsNil)
+	c.Assert(log.AddRequest(auditlog.Request{
+		ConversationID: "c3",
+		ConnectionID:   "C",
+		RequestID:      1,
+		When:           "2025-01-03T08:00:00Z",
+		Facade:         "ModelManager",
+		Method:         "CreateModel",
+		Version:        10,
+	}), tc.ErrorIsNil)
+}
+
+func (s *readerSuite) writeBackup(c *tc.C, name string, records []auditlog.Record) {
+	f, err := os.Create(filepath.Join(s.dir, name))
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	enc := json.NewEncoder(gz)
+	for _, r := range records {
+		c.Assert(enc.Encode(r), tc.ErrorIsNil)
+	}
+	c.Assert(gz.Close(), tc.ErrorIsNil)
+}
+
+func (s *readerSuite) read(c *tc.C, filter auditlog.Filter) []string {
+	entries, err := auditlog.ReadEntries(s.dir, filter)
+	c.Assert(err, tc.ErrorIsNil)
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Who+" "+e.Facade+"."+e.Method)
+	}
+	return got
+}
+
+func (s *readerSuite) TestReadAll(c *tc.C) {
+	entries, err := auditlog.ReadEntries(s.dir, auditlog.Filter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(entries, tc.HasLen, 3)
+	c.Check(entries[1], tc.DeepEquals, auditlog.Entry{
+		ConversationID: "c2",
+		ConnectionID:   "B",
+		RequestID:      1,
+		When:           time.Date(2025, 1, 2, 23, 0, 0, 0, time.UTC),
+		Who:            "bob",
+		What:           "juju remove-application postgresql",
+		ModelName:      "alice/prod",
+		ModelUUID:      "model-1",
+		Facade:         "Application",
+		Method:         "DestroyApplication",
+		Version:        20,
+		Errors:         []*auditlog.Error{{Message: "permission denied", Code: "unauthorized access"}},
+	})
+}
+
+func (s *readerSuite) TestFilters(c *tc.C) {
+	c.Check(s.read(c, auditlog.Filter{Who: "bob"}), tc.DeepEquals, []string{"bob Application.DestroyApplication"})
+	c.Check(s.read(c, auditlog.Filter{ModelUUID: "model-1"}), tc.DeepEquals, []string{
+		"alice Application.Deploy",
+		"bob Application.DestroyApplication",
+	})
+	c.Check(s.read(c, auditlog.Filter{Facade: "application", Method: "deploy"}), tc.DeepEquals, []string{"alice Application.Deploy"})
+	c.Check(s.read(c, auditlog.Filter{
+		From: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+	}), tc.DeepEquals, []string{"bob Application.DestroyApplication"})
+	c.Check(s.read(c, auditlog.Filter{ErrorsOnly: true}), tc.DeepEquals, []string{"bob Application.DestroyApplication"})
+	c.Check(s.read(c, auditlog.Filter{Limit: 1}), tc.DeepEquals, []string{"carol ModelManager.CreateModel"})
+}
+
+func (s *readerSuite) TestNoAuditLog(c *tc.C) {
+	entries, err := auditlog.ReadEntries(c.MkDir(), auditlog.Filter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+func (s *readerSuite) TestConversationAcrossFiles(c *tc.C) {
+	// The request and its response were written after the backup holding
+	// the conversation was rotated.
+	s.writeBackup(c, "audit-2025-01-01T12-00-00.000.log.gz", []auditlog.Record{{
+		Request: &auditlog.Request{
+			ConversationID: "c1",
+			ConnectionID:   "A",
+			RequestID:      2,
+			When:           "2025-01-01T12:30:00Z",
+			Facade:         "Application",
+			Method:         "SetConfigs",
+			Version:        20,
+		},
+	}})
+	s.writeBackup(c, "audit-2025-01-01T18-00-00.000.log.gz", []auditlog.Record{{
+		Errors: &auditlog.ResponseErrors{
+			ConversationID: "c1",
+			ConnectionID:   "A",
+			RequestID:      2,
+			When:           "2025-01-01T18:30:00Z",
+			Errors:         []*auditlog.Error{{Message: "invalid config"}},
+		},
+	}})
+
+	entries, err := auditlog.ReadEntries(s.dir, auditlog.Filter{Who: "alice", ErrorsOnly: true})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(entries, tc.HasLen, 1)
+	c.Check(entries[0].Who, tc.Equals, "alice")
+	c.Check(entries[0].Method, tc.Equals, "SetConfigs")
+	c.Check(entries[0].Errors, tc.DeepEquals, []*auditlog.Error{{Message: "invalid config"}})
+}
+
+func (s *readerSuite) TestLimitStopsReading(c *tc.C) {
+	// Older backups aren't read once the limit has been reached, so a
+	// corrupt backup isn't noticed.
+	err := os.WriteFile(filepath.Join(s.dir, "audit-2024-12-31T00-00-00.000.log.gz"), []byte("not gzip"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(s.read(c, auditlog.Filter{Limit: 2}), tc.DeepEquals, []string{
+		"bob Application.DestroyApplication",
+		"carol ModelManager.CreateModel",
+	})
+
+	_, err = auditlog.ReadEntries(s.dir, auditlog.Filter{Limit: 4})
+	c.Check(err, tc.ErrorMatches, `reading audit log ".*audit-2024-12-31T00-00-00.000.log.gz": .*`)
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// FileSink is the name of the sink that writes audit records to a
+	// rotating audit.log file in the controller's log directory.
+	FileSink = "file"
+
+	// SyslogSink is the name of the sink that ships audit records to a
+	// remote syslog server using RFC5424 framing.
+	SyslogSink = "syslog"
+
+	// WebhookSink is the name of the sink that posts batches of audit
+	// records as JSON to a HTTP endpoint.
+	WebhookSink = "webhook"
+)
+
+// SinkConfig holds the parameters made available to sink factories when
+// creating an audit log sink. Each sink only uses the parameters that are
+// relevant to it.
+type SinkConfig struct {
+	// LogDir is the directory the file sink writes to, and where sinks
+	// that need to spool records to disk keep their spool files.
+	LogDir string
+
+	// MaxSizeMB is the maximum size of the audit log file before it is
+	// rotated.
+	MaxSizeMB int
+
+	// MaxBackups is the number of rotated audit log files to keep.
+	MaxBackups int
+
+	// SyslogAddress is the address of the syslog server, in the form
+	// <scheme>://<host>:<port>, where scheme is one of tcp, udp or tls.
+	SyslogAddress string
+
+	// SyslogCACert is an optional PEM encoded CA certificate used to
+	// verify the syslog server when using tls.
+	SyslogCACert string
+
+	// WebhookURL is the URL that batches of audit records are posted to.
+	WebhookURL string
+
+	// WebhookBatchSize is the maximum number of audit records sent in a
+	// single request to the webhook.
+	WebhookBatchSize int
+
+	// WebhookFlushInterval is the maximum amount of time records are held
+	// before being sent to the webhook.
+	WebhookFlushInterval time.Duration
+
+	// Clock is used for time stamping and scheduling by sinks.
+	Clock clock.Clock
+}
+
+// SinkFactory creates an audit log sink from the supplied config.
+type SinkFactory func(SinkConfig) (AuditLog, error)
+
+var (
+	sinksMu sync.Mutex
+	sinks   = map[string]SinkFactory{
+		FileSink:    newFileSink,
+		SyslogSink:  newSyslogSink,
+		WebhookSink: newWebhookSink,
+	}
+)
+
+// RegisterSink makes a sink factory available under the given name. It is
+// an error to register a name that is already in use.
+func RegisterSink(name string, factory SinkFactory) error {
+	if name == "" {
+		return errors.New("empty sink name not valid").Add(coreerrors.NotValid)
+	}
+	if factory == nil {
+		return errors.Errorf("nil factory for sink %q not valid", name).Add(coreerrors.NotValid)
+	}
+
+	sinksMu.Lock()
+	defer sinksMu.Unlock()
+	if _, ok := sinks[name]; ok {
+		return errors.Errorf("audit log sink %q", name).Add(coreerrors.AlreadyExists)
+	}
+	sinks[name] = factory
+	return nil
+}
+
+// IsValidSink returns true if a sink has been registered with the given name.
+func IsValidSink(name string) bool {
+	sinksMu.Lock()
+	defer sinksMu.Unlock()
+	_, ok := sinks[name]
+	return ok
+}
+
+// Sinks returns the sorted names of all the registered sinks.
+func Sinks() []string {
+	sinksMu.Lock()
+	defer sinksMu.Unlock()
+	names := make([]string, 0, len(sinks))
+	for name := range sinks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewSinks creates an audit log that writes to each of the named sinks. If
+// more than one sink is named, the resulting audit log fans records out to
+// all of them.
+func NewSinks(names []string, cfg SinkConfig) (AuditLog, error) {
+	if len(names) == 0 {
+		return nil, errors.New("no audit log sinks specified").Add(coreerrors.NotValid)
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = clock.WallClock
+	}
+
+	logs := make([]AuditLog, 0, len(names))
+	for _, name := range names {
+		sinksMu.Lock()
+		factory, ok := sinks[name]
+		sinksMu.Unlock()
+		if !ok {
+			closeAll(logs)
+			return nil, errors.Errorf("audit log sink %q", name).Add(coreerrors.NotFound)
+		}
+
+		log, err := factory(cfg)
+		if err != nil {
+			closeAll(logs)
+			return nil, errors.Errorf("creating audit log sink %q: %w", name, err)
+		}
+		logs = append(logs, log)
+	}
+
+	if len(logs) == 1 {
+		return logs[0], nil
+	}
+	return NewFanout(logs...), nil
+}
+
+func newFileSink(cfg SinkConfig) (AuditLog, error) {
+	return NewLogFile(cfg.LogDir, cfg.MaxSizeMB, cfg.MaxBackups), nil
+}
+
+func newSyslogSink(cfg SinkConfig) (AuditLog, error) {
+	return NewSyslog(cfg.SyslogAddress, cfg.SyslogCACert, cfg.Clock)
+}
+
+func newWebhookSink(cfg SinkConfig) (AuditLog, error) {
+	return NewWebhook(WebhookConfig{
+		URL:           cfg.WebhookURL,
+		BatchSize:     cfg.WebhookBatchSize,
+		FlushInterval: cfg.WebhookFlushInterval,
+		SpoolDir:      cfg.LogDir,
+		Clock:         cfg.Clock,
+	})
+}
+
+func closeAll(logs []AuditLog) {
+	for _, log := range logs {
+		_ = log.Close()
+	}
+}
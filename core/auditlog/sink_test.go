@@ -0,0 +1,144 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"testing"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/auditlog"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type sinkSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestSinkSuite(t *testing.T) {
+	tc.Run(t, &sinkSuite{})
+}
+
+func (s *sinkSuite) TestBuiltinSinks(c *tc.C) {
+	for _, name := range []string{auditlog.FileSink, auditlog.SyslogSink, auditlog.WebhookSink} {
+		c.Check(auditlog.IsValidSink(name), tc.IsTrue, tc.Commentf("sink %q", name))
+	}
+	c.Check(auditlog.IsValidSink("carrier-pigeon"), tc.IsFalse)
+}
+
+func (s *sinkSuite) TestRegisterSink(c *tc.C) {
+	s.AddCleanup(func(*tc.C) { auditlog.UnregisterSink("test-register") })
+	err := auditlog.RegisterSink("test-register", func(auditlog.SinkConfig) (auditlog.AuditLog, error) {
+		return &recordingLog{}, nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(auditlog.IsValidSink("test-register"), tc.IsTrue)
+	c.Check(set.NewStrings(auditlog.Sinks()...).Contains("test-register"), tc.IsTrue)
+
+	err = auditlog.RegisterSink("test-register", func(auditlog.SinkConfig) (auditlog.AuditLog, error) {
+		return &recordingLog{}, nil
+	})
+	c.Check(err, tc.ErrorIs, coreerrors.AlreadyExists)
+}
+
+func (s *sinkSuite) TestRegisterSinkInvalid(c *tc.C) {
+	err := auditlog.RegisterSink("", func(auditlog.SinkConfig) (auditlog.AuditLog, error) { return nil, nil })
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	err = auditlog.RegisterSink("test-nil", nil)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *sinkSuite) TestNewSinksSingle(c *tc.C) {
+	log, err := auditlog.NewSinks([]string{auditlog.FileSink}, auditlog.SinkConfig{
+		LogDir:     c.MkDir(),
+		MaxSizeMB:  300,
+		MaxBackups: 10,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(log.Close(), tc.ErrorIsNil)
+}
+
+func (s *sinkSuite) TestNewSinksFanout(c *tc.C) {
+	first := &recordingLog{}
+	second := &recordingLog{}
+	s.registerSink(c, "test-fanout-first", first)
+	s.registerSink(c, "test-fanout-second", second)
+
+	log, err := auditlog.NewSinks([]string{"test-fanout-first", "test-fanout-second"}, auditlog.SinkConfig{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(log.AddRequest(auditlog.Request{Method: "Deploy"}), tc.ErrorIsNil)
+	c.Assert(log.Close(), tc.ErrorIsNil)
+
+	for _, l := range []*recordingLog{first, second} {
+		c.Check(l.records, tc.DeepEquals, []auditlog.Record{{Request: &auditlog.Request{Method: "Deploy"}}})
+		c.Check(l.closed, tc.IsTrue)
+	}
+}
+
+func (s *sinkSuite) TestNewSinksNone(c *tc.C) {
+	_, err := auditlog.NewSinks(nil, auditlog.SinkConfig{})
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *sinkSuite) TestNewSinksUnknownClosesCreated(c *tc.C) {
+	created := &recordingLog{}
+	s.registerSink(c, "test-unknown-created", created)
+
+	_, err := auditlog.NewSinks([]string{"test-unknown-created", "carrier-pigeon"}, auditlog.SinkConfig{})
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+	c.Check(created.closed, tc.IsTrue)
+}
+
+func (s *sinkSuite) TestFanoutContinuesAfterError(c *tc.C) {
+	failing := &recordingLog{err: errors.New("boom")}
+	working := &recordingLog{}
+
+	log := auditlog.NewFanout(failing, working)
+	err := log.AddConversation(auditlog.Conversation{Who: "bob"})
+	c.Check(err, tc.ErrorMatches, "boom")
+	c.Check(working.records, tc.DeepEquals, []auditlog.Record{{Conversation: &auditlog.Conversation{Who: "bob"}}})
+}
+
+func (s *sinkSuite) registerSink(c *tc.C, name string, log auditlog.AuditLog) {
+	err := auditlog.RegisterSink(name, func(auditlog.SinkConfig) (auditlog.AuditLog, error) {
+		return log, nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	s.AddCleanup(func(*tc.C) { auditlog.UnregisterSink(name) })
+}
+
+type recordingLog struct {
+	err     error
+	records []auditlog.Record
+	closed  bool
+}
+
+func (l *recordingLog) AddConversation(c auditlog.Conversation) error {
+	return l.add(auditlog.Record{Conversation: &c})
+}
+
+func (l *recordingLog) AddRequest(r auditlog.Request) error {
+	return l.add(auditlog.Record{Request: &r})
+}
+
+func (l *recordingLog) AddResponse(r auditlog.ResponseErrors) error {
+	return l.add(auditlog.Record{Errors: &r})
+}
+
+func (l *recordingLog) Close() error {
+	l.closed = true
+	return nil
+}
+
+func (l *recordingLog) add(r auditlog.Record) error {
+	if l.err != nil {
+		return l.err
+	}
+	l.records = append(l.records, r)
+	return nil
+}
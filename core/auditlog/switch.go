@@ -0,0 +1,152 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// SwitchLog is an audit log that forwards records to an underlying audit
+// log which can be replaced while the switch log is in use. Recorders
+// created against a switch log keep working when the underlying log is
+// replaced, as the records are forwarded to the replacement.
+type SwitchLog struct {
+	current atomic.Pointer[switchTarget]
+	closed  atomic.Bool
+}
+
+// switchTarget is an underlying audit log of a switch log. Writes hold the
+// read lock for their duration, so that the target can be drained by
+// taking the write lock before it is closed.
+type switchTarget struct {
+	mu      sync.RWMutex
+	log     AuditLog
+	retired bool
+}
+
+// NewSwitchLog returns a switch log that forwards records to the given
+// audit log.
+func NewSwitchLog(log AuditLog) *SwitchLog {
+	s := &SwitchLog{}
+	s.current.Store(&switchTarget{log: log})
+	return s
+}
+
+// Target returns the audit log that records are currently forwarded to.
+func (s *SwitchLog) Target() AuditLog {
+	return s.current.Load().log
+}
+
+// Switch replaces the audit log that records are forwarded to. Records
+// being written to the previous log are allowed to complete before it is
+// closed, after which nothing references it.
+func (s *SwitchLog) Switch(log AuditLog) error {
+	if s.closed.Load() {
+		if log != nil {
+			_ = log.Close()
+		}
+		return errors.New("audit log closed")
+	}
+	old := s.current.Swap(&switchTarget{log: log})
+	return errors.Capture(old.retire())
+}
+
+// Replace closes the audit log that records are forwarded to, once the
+// records being written to it are complete, and then forwards records to the
+// audit log returned by newLog. The previous log is closed before its
+// replacement is created, as they may share resources such as a spool file.
+// Records written in the meantime wait for the replacement.
+//
+// Errors closing the previous log aren't returned, as nothing references it
+// any more. If the replacement can't be created, records are discarded until
+// the switch log is replaced again.
+func (s *SwitchLog) Replace(newLog func() (AuditLog, error)) error {
+	if s.closed.Load() {
+		return errors.New("audit log closed")
+	}
+
+	next := &switchTarget{}
+	next.mu.Lock()
+	defer next.mu.Unlock()
+
+	old := s.current.Swap(next)
+	_ = old.retire()
+
+	log, err := newLog()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	next.log = log
+	return nil
+}
+
+// AddConversation implements AuditLog.
+func (s *SwitchLog) AddConversation(c Conversation) error {
+	return s.forward(func(log AuditLog) error {
+		return log.AddConversation(c)
+	})
+}
+
+// AddRequest implements AuditLog.
+func (s *SwitchLog) AddRequest(r Request) error {
+	return s.forward(func(log AuditLog) error {
+		return log.AddRequest(r)
+	})
+}
+
+// AddResponse implements AuditLog.
+func (s *SwitchLog) AddResponse(r ResponseErrors) error {
+	return s.forward(func(log AuditLog) error {
+		return log.AddResponse(r)
+	})
+}
+
+// Close implements AuditLog. It closes the underlying audit log once any
+// records being written to it are complete.
+func (s *SwitchLog) Close() error {
+	if !s.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+	return errors.Capture(s.current.Load().retire())
+}
+
+// forward calls fn with the current underlying audit log. If the log is
+// replaced between loading and locking it, the replacement is used.
+func (s *SwitchLog) forward(fn func(AuditLog) error) error {
+	for {
+		target := s.current.Load()
+		target.mu.RLock()
+		if target.retired {
+			target.mu.RUnlock()
+			if s.closed.Load() {
+				return errors.New("audit log closed")
+			}
+			continue
+		}
+		var err error
+		if target.log != nil {
+			err = fn(target.log)
+		}
+		target.mu.RUnlock()
+		return errors.Capture(err)
+	}
+}
+
+// retire waits for the writes in progress to complete, and then closes the
+// underlying log.
+func (t *switchTarget) retire() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.retired {
+		return nil
+	}
+	t.retired = true
+	if t.log == nil {
+		return nil
+	}
+	return t.log.Close()
+}
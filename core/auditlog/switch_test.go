@@ -0,0 +1,144 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type switchSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestSwitchSuite(t *testing.T) {
+	tc.Run(t, &switchSuite{})
+}
+
+func (s *switchSuite) TestForwardsToReplacement(c *tc.C) {
+	old := &recordingLog{}
+	replacement := &recordingLog{}
+
+	log := auditlog.NewSwitchLog(old)
+	c.Assert(log.AddRequest(auditlog.Request{Method: "Deploy"}), tc.ErrorIsNil)
+	c.Assert(log.Switch(replacement), tc.ErrorIsNil)
+	c.Assert(log.AddRequest(auditlog.Request{Method: "Refresh"}), tc.ErrorIsNil)
+
+	c.Check(log.Target(), tc.Equals, replacement)
+	c.Check(old.records, tc.DeepEquals, []auditlog.Record{{Request: &auditlog.Request{Method: "Deploy"}}})
+	c.Check(old.closed, tc.IsTrue)
+	c.Check(replacement.records, tc.DeepEquals, []auditlog.Record{{Request: &auditlog.Request{Method: "Refresh"}}})
+	c.Check(replacement.closed, tc.IsFalse)
+}
+
+func (s *switchSuite) TestSwitchWaitsForWrites(c *tc.C) {
+	old := &blockingLog{
+		started: make(chan struct{}),
+		unblock: make(chan struct{}),
+	}
+	log := auditlog.NewSwitchLog(old)
+
+	written := make(chan error, 1)
+	go func() {
+		written <- log.AddConversation(auditlog.Conversation{Who: "bob"})
+	}()
+	select {
+	case <-old.started:
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for write")
+	}
+
+	switched := make(chan error, 1)
+	go func() {
+		switched <- log.Switch(&recordingLog{})
+	}()
+	select {
+	case <-switched:
+		c.Fatalf("switched before write completed")
+	case <-time.After(testhelpers.ShortWait):
+	}
+
+	close(old.unblock)
+	for _, ch := range []chan error{written, switched} {
+		select {
+		case err := <-ch:
+			c.Assert(err, tc.ErrorIsNil)
+		case <-time.After(testhelpers.LongWait):
+			c.Fatalf("timed out")
+		}
+	}
+	c.Check(old.closed, tc.IsTrue)
+}
+
+func (s *switchSuite) TestReplaceClosesBeforeCreating(c *tc.C) {
+	old := &recordingLog{}
+	replacement := &recordingLog{}
+
+	log := auditlog.NewSwitchLog(old)
+	err := log.Replace(func() (auditlog.AuditLog, error) {
+		c.Check(old.closed, tc.IsTrue)
+		return replacement, nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(log.AddRequest(auditlog.Request{Method: "Refresh"}), tc.ErrorIsNil)
+
+	c.Check(log.Target(), tc.Equals, replacement)
+	c.Check(old.records, tc.HasLen, 0)
+	c.Check(replacement.records, tc.DeepEquals, []auditlog.Record{{Request: &auditlog.Request{Method: "Refresh"}}})
+	c.Check(replacement.closed, tc.IsFalse)
+}
+
+func (s *switchSuite) TestReplaceError(c *tc.C) {
+	old := &recordingLog{}
+
+	log := auditlog.NewSwitchLog(old)
+	err := log.Replace(func() (auditlog.AuditLog, error) {
+		return nil, errors.New("boom")
+	})
+	c.Assert(err, tc.ErrorMatches, "boom")
+	c.Check(old.closed, tc.IsTrue)
+
+	// Records are discarded until the log is replaced again.
+	c.Assert(log.AddRequest(auditlog.Request{Method: "Refresh"}), tc.ErrorIsNil)
+	c.Check(old.records, tc.HasLen, 0)
+}
+
+func (s *switchSuite) TestNilTargetDropsRecords(c *tc.C) {
+	log := auditlog.NewSwitchLog(nil)
+	c.Assert(log.AddResponse(auditlog.ResponseErrors{RequestID: 1}), tc.ErrorIsNil)
+	c.Assert(log.Close(), tc.ErrorIsNil)
+}
+
+func (s *switchSuite) TestClosed(c *tc.C) {
+	target := &recordingLog{}
+	log := auditlog.NewSwitchLog(target)
+	c.Assert(log.Close(), tc.ErrorIsNil)
+	c.Check(target.closed, tc.IsTrue)
+
+	err := log.AddRequest(auditlog.Request{Method: "Deploy"})
+	c.Check(err, tc.ErrorMatches, "audit log closed")
+
+	replacement := &recordingLog{}
+	err = log.Switch(replacement)
+	c.Check(err, tc.ErrorMatches, "audit log closed")
+	c.Check(replacement.closed, tc.IsTrue)
+}
+
+type blockingLog struct {
+	recordingLog
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (l *blockingLog) AddConversation(c auditlog.Conversation) error {
+	close(l.started)
+	<-l.unblock
+	return l.recordingLog.AddConversation(c)
+}
@@ -0,0 +1,297 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/juju/clock"
+	"gopkg.in/tomb.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// syslogPriority is the RFC5424 PRI value for audit records: the
+	// "log audit" facility (13) with the "informational" severity (6).
+	syslogPriority = 13*8 + 6
+
+	// syslogAppName is the APP-NAME field of the syslog messages.
+	syslogAppName = "juju-audit"
+
+	// syslogTimestampFormat is the RFC5424 timestamp format, which allows
+	// at most microsecond precision.
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+	// syslogDialTimeout is how long to wait when connecting to the
+	// syslog server.
+	syslogDialTimeout = 10 * time.Second
+
+	// syslogWriteTimeout is how long to wait for a message to be written
+	// to the syslog server before the connection is considered broken.
+	syslogWriteTimeout = 10 * time.Second
+
+	// syslogRetryDelay is how long to wait before reconnecting to a syslog
+	// server that couldn't be reached.
+	syslogRetryDelay = 5 * time.Second
+
+	// syslogQueueSize is the number of messages held while waiting to be
+	// sent to the syslog server. Messages are dropped when it is full.
+	syslogQueueSize = 10000
+)
+
+type syslogLog struct {
+	tomb     tomb.Tomb
+	network  string
+	address  string
+	tls      *tls.Config
+	hostname string
+	clock    clock.Clock
+
+	// queue holds the messages waiting to be sent by the loop.
+	queue chan []byte
+
+	// dropped counts the messages dropped because the queue was full.
+	dropped atomic.Int64
+
+	// conn is only used by the loop.
+	conn net.Conn
+}
+
+// NewSyslog returns an audit log that sends each record to a syslog server
+// as an RFC5424 message. The address is of the form <scheme>://<host>:<port>
+// where scheme is one of tcp, udp or tls. Messages sent over tcp and tls use
+// octet-counting framing (RFC6587). If caCert is not empty it is used to
+// verify the server certificate when using tls, otherwise the system roots
+// are used.
+//
+// Records are queued and sent in the background, so that a slow or
+// unreachable syslog server never holds up the API server. If the queue
+// fills up while the server is unreachable, further records are dropped,
+// not spooled, and the number dropped is logged.
+func NewSyslog(address, caCert string, clock clock.Clock) (AuditLog, error) {
+	return newSyslog(address, caCert, clock, syslogQueueSize)
+}
+
+func newSyslog(address, caCert string, clock clock.Clock, queueSize int) (*syslogLog, error) {
+	network, host, err := ParseSyslogAddress(address)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	log := &syslogLog{
+		network: network,
+		address: host,
+		clock:   clock,
+		queue:   make(chan []byte, queueSize),
+	}
+	if network == "tls" {
+		serverName, _, err := net.SplitHostPort(host)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		log.tls = &tls.Config{
+			ServerName: serverName,
+			MinVersion: tls.VersionTLS12,
+		}
+		if caCert != "" {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM([]byte(caCert)) {
+				return nil, errors.New("invalid syslog CA certificate").Add(coreerrors.NotValid)
+			}
+			log.tls.RootCAs = pool
+		}
+	}
+
+	log.hostname, err = os.Hostname()
+	if err != nil || log.hostname == "" {
+		log.hostname = "-"
+	}
+
+	log.tomb.Go(log.loop)
+	return log, nil
+}
+
+// ParseSyslogAddress parses a syslog address of the form
+// <scheme>://<host>:<port>, returning the network and host:port to connect
+// to.
+func ParseSyslogAddress(address string) (string, string, error) {
+	if address == "" {
+		return "", "", errors.New("empty syslog address not valid").Add(coreerrors.NotValid)
+	}
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", "", errors.Errorf("parsing syslog address %q: %w", address, err).Add(coreerrors.NotValid)
+	}
+	switch u.Scheme {
+	case "tcp", "udp", "tls":
+	default:
+		return "", "", errors.Errorf("syslog address %q: scheme must be one of tcp, udp or tls", address).Add(coreerrors.NotValid)
+	}
+	if u.Hostname() == "" || u.Port() == "" {
+		return "", "", errors.Errorf("syslog address %q: expected <host>:<port>", address).Add(coreerrors.NotValid)
+	}
+	return u.Scheme, u.Host, nil
+}
+
+// AddConversation implements AuditLog.
+func (s *syslogLog) AddConversation(c Conversation) error {
+	return errors.Capture(s.send("conversation", Record{Conversation: &c}))
+}
+
+// AddRequest implements AuditLog.
+func (s *syslogLog) AddRequest(r Request) error {
+	return errors.Capture(s.send("request", Record{Request: &r}))
+}
+
+// AddResponse implements AuditLog.
+func (s *syslogLog) AddResponse(r ResponseErrors) error {
+	return errors.Capture(s.send("errors", Record{Errors: &r}))
+}
+
+// Close implements AuditLog. Messages that are already queued are sent
+// if the syslog server is reachable, otherwise they are dropped.
+func (s *syslogLog) Close() error {
+	s.tomb.Kill(nil)
+	return errors.Capture(s.tomb.Wait())
+}
+
+// send queues the record to be sent to the syslog server, dropping it if
+// the queue is full.
+func (s *syslogLog) send(msgID string, r Record) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	msg := s.format(msgID, body)
+
+	select {
+	case <-s.tomb.Dying():
+		return errors.New("syslog audit log closed")
+	default:
+	}
+	select {
+	case s.queue <- msg:
+	default:
+		if s.dropped.Add(1) == 1 {
+			logger.Warningf(context.TODO(), "syslog server %q is not keeping up, dropping audit records", s.address)
+		}
+	}
+	return nil
+}
+
+func (s *syslogLog) loop() error {
+	ctx := s.tomb.Context(context.Background())
+	defer func() {
+		if s.conn != nil {
+			_ = s.conn.Close()
+		}
+	}()
+
+	for {
+		var msg []byte
+		select {
+		case <-s.tomb.Dying():
+			s.drain(ctx)
+			return nil
+		case msg = <-s.queue:
+		}
+
+		for {
+			err := s.write(msg)
+			if err == nil {
+				break
+			}
+			logger.Warningf(ctx, "sending audit records to syslog server (retrying in %v): %v", syslogRetryDelay, err)
+			select {
+			case <-s.tomb.Dying():
+				return nil
+			case <-s.clock.After(syslogRetryDelay):
+			}
+		}
+
+		if dropped := s.dropped.Swap(0); dropped > 0 {
+			logger.Warningf(ctx, "dropped %d audit records while syslog server %q was not keeping up", dropped, s.address)
+		}
+	}
+}
+
+// drain makes a single attempt to send the messages still queued when the
+// log is closed.
+func (s *syslogLog) drain(ctx context.Context) {
+	for {
+		select {
+		case msg := <-s.queue:
+			if err := s.write(msg); err != nil {
+				logger.Warningf(ctx, "dropping %d audit records queued for syslog server %q: %v", len(s.queue)+1, s.address, err)
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
+// write sends the message to the syslog server. If the write fails on an
+// existing connection, the server may have gone away; it reconnects and
+// tries once more before giving up.
+func (s *syslogLog) write(msg []byte) error {
+	for attempt := 0; ; attempt++ {
+		if s.conn == nil {
+			var err error
+			if s.conn, err = s.dial(); err != nil {
+				return errors.Errorf("connecting to syslog server %q: %w", s.address, err)
+			}
+		}
+		// Network deadlines are always in wall clock time.
+		err := s.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+		if err == nil {
+			_, err = s.conn.Write(msg)
+		}
+		if err == nil {
+			return nil
+		}
+		_ = s.conn.Close()
+		s.conn = nil
+		if attempt > 0 {
+			return errors.Errorf("writing to syslog server %q: %w", s.address, err)
+		}
+	}
+}
+
+func (s *syslogLog) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	if s.network == "tls" {
+		return tls.DialWithDialer(dialer, "tcp", s.address, s.tls)
+	}
+	return dialer.Dial(s.network, s.address)
+}
+
+// format renders the record as an RFC5424 message, framed for the
+// transport in use.
+func (s *syslogLog) format(msgID string, body []byte) []byte {
+	msg := fmt.Sprintf("<%d>1 %s %s %s %d %s - %s",
+		syslogPriority,
+		s.clock.Now().UTC().Format(syslogTimestampFormat),
+		s.hostname,
+		syslogAppName,
+		os.Getpid(),
+		msgID,
+		body,
+	)
+	if s.network == "udp" {
+		return []byte(msg)
+	}
+	return []byte(fmt.Sprintf("%d %s", len(msg), msg))
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/auditlog"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type syslogSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestSyslogSuite(t *testing.T) {
+	tc.Run(t, &syslogSuite{})
+}
+
+func (s *syslogSuite) TestParseSyslogAddress(c *tc.C) {
+	network, host, err := auditlog.ParseSyslogAddress("tls://syslog.example.com:6514")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(network, tc.Equals, "tls")
+	c.Check(host, tc.Equals, "syslog.example.com:6514")
+
+	for _, address := range []string{
+		"",
+		"syslog.example.com:514",
+		"http://syslog.example.com:514",
+		"udp://syslog.example.com",
+	} {
+		_, _, err := auditlog.ParseSyslogAddress(address)
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid, tc.Commentf("address %q", address))
+	}
+}
+
+func (s *syslogSuite) TestInvalidCACert(c *tc.C) {
+	_, err := auditlog.NewSyslog("tls://localhost:6514", "not a cert", testclock.NewClock(time.Now()))
+	c.Check(err, tc.ErrorMatches, "invalid syslog CA certificate")
+}
+
+func (s *syslogSuite) TestTCP(c *tc.C) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// Read an octet-counted frame.
+		r := bufio.NewReader(conn)
+		length, err := r.ReadString(' ')
+		if err != nil {
+			return
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(length))
+		if err != nil {
+			return
+		}
+		buf := make([]byte, n)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return
+		}
+		received <- string(buf)
+	}()
+
+	now := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	log, err := auditlog.NewSyslog("tcp://"+listener.Addr().String(), "", testclock.NewClock(now))
+	c.Assert(err, tc.ErrorIsNil)
+	defer log.Close()
+
+	err = log.AddRequest(auditlog.Request{
+		ConversationID: "0123456789abcdef",
+		RequestID:      42,
+		Facade:         "Application",
+		Method:         "Deploy",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	select {
+	case msg := <-received:
+		c.Check(msg, tc.Matches, `<110>1 2025-03-04T05:06:07.000000Z \S+ juju-audit \d+ request - \{"request":\{.*"facade":"Application","method":"Deploy".*\}\}`)
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for syslog message")
+	}
+}
+
+func (s *syslogSuite) TestUDP(c *tc.C) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	defer conn.Close()
+
+	log, err := auditlog.NewSyslog("udp://"+conn.LocalAddr().String(), "", testclock.NewClock(time.Now()))
+	c.Assert(err, tc.ErrorIsNil)
+	defer log.Close()
+
+	err = log.AddConversation(auditlog.Conversation{Who: "bob", What: "juju deploy"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(conn.SetReadDeadline(time.Now().Add(testhelpers.LongWait)), tc.ErrorIsNil)
+	buf := make([]byte, 4096)
+	n, _, err := conn.ReadFrom(buf)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(buf[:n]), tc.Matches, `<110>1 \S+ \S+ juju-audit \d+ conversation - \{"conversation":\{"who":"bob","what":"juju deploy".*\}\}`)
+}
+
+func (s *syslogSuite) TestConnectionFailureDropsWhenQueueFull(c *tc.C) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	address := listener.Addr().String()
+	c.Assert(listener.Close(), tc.ErrorIsNil)
+
+	log, err := auditlog.NewSyslogWithQueueSize("tcp://"+address, testclock.NewClock(time.Now()), 1)
+	c.Assert(err, tc.ErrorIsNil)
+	defer log.Close()
+
+	// The server can't be reached, so records are queued until the queue
+	// is full and then dropped, without blocking the caller.
+	for i := 0; i < 3; i++ {
+		err = log.AddRequest(auditlog.Request{RequestID: uint64(i)})
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	c.Check(auditlog.SyslogDropped(log) > 0, tc.IsTrue)
+}
+
+func (s *syslogSuite) TestClosed(c *tc.C) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	defer conn.Close()
+
+	log, err := auditlog.NewSyslog("udp://"+conn.LocalAddr().String(), "", testclock.NewClock(time.Now()))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(log.Close(), tc.ErrorIsNil)
+
+	err = log.AddRequest(auditlog.Request{})
+	c.Check(err, tc.ErrorMatches, "syslog audit log closed")
+}
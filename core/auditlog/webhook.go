@@ -0,0 +1,528 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"gopkg.in/tomb.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// DefaultWebhookBatchSize is the default maximum number of records sent
+	// to the webhook in a single request.
+	DefaultWebhookBatchSize = 100
+
+	// DefaultWebhookFlushInterval is the default maximum amount of time
+	// records are held before being sent to the webhook.
+	DefaultWebhookFlushInterval = 5 * time.Second
+
+	// webhookSpoolFile is the name of the file in the spool directory that
+	// holds records that haven't yet been delivered to the webhook.
+	webhookSpoolFile = "audit-webhook-spool.jsonl"
+
+	// webhookOffsetFile is the name of the file in the spool directory that
+	// holds the offset into the spool of the first record that hasn't been
+	// acknowledged by the webhook.
+	webhookOffsetFile = "audit-webhook-spool.offset"
+
+	// webhookMaxPending is the maximum number of undelivered records held
+	// in memory. Any further records are only held in the spool, and are
+	// read back once the records in memory have been delivered.
+	webhookMaxPending = 10000
+
+	// webhookCompactSize is the number of bytes of acknowledged records
+	// the spool may hold, while records are still pending, before it is
+	// compacted.
+	webhookCompactSize = 16 * 1024 * 1024
+
+	// webhookMaxRetryDelay is the maximum time between attempts to deliver
+	// records to a failing webhook.
+	webhookMaxRetryDelay = 5 * time.Minute
+
+	// webhookRequestTimeout is the maximum time allowed for a single
+	// request to the webhook.
+	webhookRequestTimeout = 30 * time.Second
+)
+
+// HTTPClient is the subset of *http.Client used to call the webhook.
+type HTTPClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// WebhookConfig holds the parameters for an audit log that posts records to
+// a HTTP endpoint.
+type WebhookConfig struct {
+	// URL is the endpoint that batches of records are posted to.
+	URL string
+
+	// BatchSize is the maximum number of records sent in a single request.
+	BatchSize int
+
+	// FlushInterval is the maximum amount of time records are held before
+	// being sent.
+	FlushInterval time.Duration
+
+	// SpoolDir is the directory where undelivered records are kept, so
+	// that they survive a restart of the controller.
+	SpoolDir string
+
+	// Client is used to make the requests. If nil, a default client is
+	// used.
+	Client HTTPClient
+
+	// Clock is used to schedule flushes and retries.
+	Clock clock.Clock
+}
+
+// Validate checks the webhook config.
+func (cfg WebhookConfig) Validate() error {
+	if cfg.URL == "" {
+		return errors.New("empty webhook URL not valid").Add(coreerrors.NotValid)
+	}
+	if cfg.SpoolDir == "" {
+		return errors.New("empty webhook spool directory not valid").Add(coreerrors.NotValid)
+	}
+	if cfg.BatchSize < 0 {
+		return errors.Errorf("negative webhook batch size %d not valid", cfg.BatchSize).Add(coreerrors.NotValid)
+	}
+	if cfg.FlushInterval < 0 {
+		return errors.Errorf("negative webhook flush interval %v not valid", cfg.FlushInterval).Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+type webhookLog struct {
+	tomb tomb.Tomb
+	cfg  WebhookConfig
+
+	// full is signalled when a batch worth of records is pending.
+	full chan struct{}
+
+	mu      sync.Mutex
+	pending []spooledRecord
+	closed  bool
+
+	// spilled is true when the spool holds undelivered records beyond
+	// those that are pending, as more than maxPending records were
+	// undelivered.
+	spilled    bool
+	maxPending int
+
+	// spool is the append-only file that records are written to before
+	// they are acknowledged. Records before offset have been delivered.
+	spool      *os.File
+	spoolPath  string
+	offset     int64
+	offsetPath string
+}
+
+// spooledRecord is a record that hasn't been delivered, along with the size
+// of its line in the spool.
+type spooledRecord struct {
+	record Record
+	size   int64
+}
+
+// NewWebhook returns an audit log that posts batches of records, as a JSON
+// array, to the configured URL. Records are appended to a spool file before
+// being acknowledged, and are only removed from it once the webhook has
+// accepted them; if delivery fails it is retried with an increasing delay.
+// Any records left in the spool from a previous run are delivered first.
+//
+// Delivery is at least once: a record may be delivered again after a restart
+// if the controller stopped before its acknowledgement was recorded.
+func NewWebhook(cfg WebhookConfig) (AuditLog, error) {
+	return newWebhook(cfg, webhookMaxPending)
+}
+
+func newWebhook(cfg WebhookConfig, maxPending int) (AuditLog, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultWebhookBatchSize
+	}
+	if cfg.FlushInterval == 0 {
+		cfg.FlushInterval = DefaultWebhookFlushInterval
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: webhookRequestTimeout}
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = clock.WallClock
+	}
+
+	w := &webhookLog{
+		cfg:        cfg,
+		full:       make(chan struct{}, 1),
+		maxPending: maxPending,
+		spoolPath:  filepath.Join(cfg.SpoolDir, webhookSpoolFile),
+		offsetPath: filepath.Join(cfg.SpoolDir, webhookOffsetFile),
+	}
+
+	var err error
+	if w.offset, err = readSpoolOffset(w.offsetPath); err != nil {
+		return nil, errors.Errorf("reading webhook spool offset: %w", err)
+	}
+	if w.pending, w.offset, w.spilled, err = readSpool(w.spoolPath, w.offset, w.maxPending); err != nil {
+		return nil, errors.Errorf("reading webhook spool: %w", err)
+	}
+	if w.spool, err = os.OpenFile(w.spoolPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600); err != nil {
+		return nil, errors.Errorf("opening webhook spool: %w", err)
+	}
+
+	w.tomb.Go(w.loop)
+	return w, nil
+}
+
+// AddConversation implements AuditLog.
+func (w *webhookLog) AddConversation(c Conversation) error {
+	return errors.Capture(w.add(Record{Conversation: &c}))
+}
+
+// AddRequest implements AuditLog.
+func (w *webhookLog) AddRequest(r Request) error {
+	return errors.Capture(w.add(Record{Request: &r}))
+}
+
+// AddResponse implements AuditLog.
+func (w *webhookLog) AddResponse(r ResponseErrors) error {
+	return errors.Capture(w.add(Record{Errors: &r}))
+}
+
+// Close implements AuditLog. Records that haven't been delivered remain in
+// the spool and will be delivered the next time the webhook log is created.
+func (w *webhookLog) Close() error {
+	w.tomb.Kill(nil)
+	err := w.tomb.Wait()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.closed {
+		err = errors.Join(err, w.spool.Close())
+		w.closed = true
+	}
+	return errors.Capture(err)
+}
+
+func (w *webhookLog) add(r Record) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	line = append(line, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errors.New("webhook audit log closed")
+	}
+	if _, err := w.spool.Write(line); err != nil {
+		return errors.Errorf("writing to webhook spool: %w", err)
+	}
+	// Once too many records are pending, later records are only held in
+	// the spool, until the pending records have been delivered.
+	if w.spilled || len(w.pending) >= w.maxPending {
+		w.spilled = true
+	} else {
+		w.pending = append(w.pending, spooledRecord{record: r, size: int64(len(line))})
+	}
+
+	if len(w.pending) >= w.cfg.BatchSize {
+		select {
+		case w.full <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (w *webhookLog) loop() error {
+	ctx := w.tomb.Context(context.Background())
+
+	delay := w.cfg.FlushInterval
+	timer := w.cfg.Clock.NewTimer(delay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.tomb.Dying():
+			return tomb.ErrDying
+		case <-timer.Chan():
+		case <-w.full:
+		}
+
+		if err := w.flush(ctx); err != nil {
+			// Back off while the webhook is failing, but never wait
+			// longer than the maximum retry delay.
+			delay = min(delay*2, webhookMaxRetryDelay)
+			logger.Warningf(ctx, "delivering audit records to webhook (retrying in %v): %v", delay, err)
+		} else {
+			delay = w.cfg.FlushInterval
+		}
+		timer.Reset(delay)
+	}
+}
+
+// flush delivers all the pending records to the webhook, in batches,
+// reading back any records that were only held in the spool.
+func (w *webhookLog) flush(ctx context.Context) error {
+	for {
+		w.mu.Lock()
+		if err := w.readSpilled(); err != nil {
+			w.mu.Unlock()
+			return errors.Capture(err)
+		}
+		batch := make([]Record, min(len(w.pending), w.cfg.BatchSize))
+		for i := range batch {
+			batch[i] = w.pending[i].record
+		}
+		w.mu.Unlock()
+
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := w.post(ctx, batch); err != nil {
+			return errors.Capture(err)
+		}
+		w.acknowledge(ctx, len(batch))
+	}
+}
+
+func (w *webhookLog) post(ctx context.Context, batch []Record) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Capture(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.cfg.Client.Do(req)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("unexpected response status %q", resp.Status)
+	}
+	return nil
+}
+
+// readSpilled reads back the records that were only held in the spool, once
+// all the pending records have been delivered. The mutex must be held.
+func (w *webhookLog) readSpilled() error {
+	if len(w.pending) > 0 || !w.spilled {
+		return nil
+	}
+	var err error
+	if w.pending, w.offset, w.spilled, err = readSpool(w.spoolPath, w.offset, w.maxPending); err != nil {
+		return errors.Errorf("reading webhook spool: %w", err)
+	}
+	return nil
+}
+
+// acknowledge removes the first n pending records, which have been
+// delivered, by moving the spool offset past them. The spool is truncated
+// once every record in it has been delivered, and compacted if too many
+// delivered records build up while records are still pending.
+//
+// Failing to record the acknowledgement only means the records may be
+// delivered again after a restart, so errors are logged rather than
+// returned; the spool remains usable either way.
+func (w *webhookLog) acknowledge(ctx context.Context, n int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, r := range w.pending[:n] {
+		w.offset += r.size
+	}
+	w.pending = w.pending[n:]
+	if w.closed {
+		return
+	}
+
+	// Neither truncating nor compacting the spool is possible while it
+	// holds records that aren't pending, as they would be lost.
+	var err error
+	switch {
+	case len(w.pending) == 0 && !w.spilled:
+		err = w.truncateSpool()
+	case w.offset > webhookCompactSize && !w.spilled:
+		err = w.compactSpool()
+	default:
+		err = writeSpoolOffset(w.offsetPath, w.offset)
+	}
+	if err != nil {
+		logger.Warningf(ctx, "recording delivery of audit records to webhook: %v", err)
+	}
+}
+
+// truncateSpool empties the spool once all the records in it have been
+// delivered. The offset is reset first, so that stopping in between
+// redelivers records rather than losing them.
+func (w *webhookLog) truncateSpool() error {
+	if err := writeSpoolOffset(w.offsetPath, 0); err != nil {
+		return errors.Capture(err)
+	}
+	if err := w.spool.Truncate(0); err != nil {
+		// The offset still needs to be recorded, as the delivered
+		// records remain in the spool.
+		return errors.Join(err, writeSpoolOffset(w.offsetPath, w.offset))
+	}
+	w.offset = 0
+	return nil
+}
+
+// compactSpool replaces the spool with one holding only the pending
+// records. The existing spool remains in use if it can't be replaced.
+func (w *webhookLog) compactSpool() error {
+	tmpPath := w.spoolPath + ".tmp"
+	records := make([]Record, len(w.pending))
+	for i, r := range w.pending {
+		records[i] = r.record
+	}
+	if err := writeSpool(tmpPath, records); err != nil {
+		return errors.Join(err, writeSpoolOffset(w.offsetPath, w.offset))
+	}
+	spool, err := os.OpenFile(tmpPath, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return errors.Join(err, writeSpoolOffset(w.offsetPath, w.offset))
+	}
+	if err := writeSpoolOffset(w.offsetPath, 0); err != nil {
+		_ = spool.Close()
+		_ = os.Remove(tmpPath)
+		return errors.Capture(err)
+	}
+	if err := os.Rename(tmpPath, w.spoolPath); err != nil {
+		_ = spool.Close()
+		_ = os.Remove(tmpPath)
+		return errors.Join(err, writeSpoolOffset(w.offsetPath, w.offset))
+	}
+	_ = w.spool.Close()
+	w.spool = spool
+	w.offset = 0
+	return nil
+}
+
+// readSpool returns up to limit records in the spool from the given offset,
+// along with the offset to use and whether the spool holds more records. If
+// the spool is shorter than the offset, it was emptied without the offset
+// being recorded, so all of it is read.
+func readSpool(path string, offset int64, limit int) ([]spooledRecord, int64, bool, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, false, nil
+	} else if err != nil {
+		return nil, 0, false, errors.Capture(err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, false, errors.Capture(err)
+	}
+	if offset > info.Size() {
+		offset = 0
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, 0, false, errors.Capture(err)
+	}
+
+	var records []spooledRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(records) == limit {
+			// The remaining records are read once these have been
+			// delivered.
+			return records, offset, true, nil
+		}
+		size := int64(len(scanner.Bytes()) + 1)
+		if len(scanner.Bytes()) == 0 {
+			offset += size
+			continue
+		}
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			// A partially written record can only be at the end of
+			// the spool, if the controller stopped mid-write.
+			logger.Warningf(context.TODO(), "discarding malformed record on line %d of %q: %v", line, path, err)
+			if len(records) == 0 {
+				offset += size
+			} else {
+				records[len(records)-1].size += size
+			}
+			continue
+		}
+		records = append(records, spooledRecord{record: r, size: size})
+	}
+	return records, offset, false, errors.Capture(scanner.Err())
+}
+
+func readSpoolOffset(path string) (int64, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Capture(err)
+	}
+	offset, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil || offset < 0 {
+		// Redelivering the records is preferable to losing them.
+		logger.Warningf(context.TODO(), "ignoring invalid webhook spool offset in %q", path)
+		return 0, nil
+	}
+	return offset, nil
+}
+
+// writeSpoolOffset atomically replaces the recorded spool offset.
+func writeSpoolOffset(path string, offset int64) error {
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(strconv.FormatInt(offset, 10)), 0600); err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(os.Rename(tmpPath, path))
+}
+
+func writeSpool(path string, records []Record) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	buf := bufio.NewWriter(f)
+	enc := json.NewEncoder(buf)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			_ = f.Close()
+			return errors.Capture(err)
+		}
+	}
+	if err := buf.Flush(); err != nil {
+		_ = f.Close()
+		return errors.Capture(err)
+	}
+	return errors.Capture(f.Close())
+}
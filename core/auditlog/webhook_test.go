@@ -0,0 +1,314 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/auditlog"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type webhookSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestWebhookSuite(t *testing.T) {
+	tc.Run(t, &webhookSuite{})
+}
+
+func (s *webhookSuite) TestValidate(c *tc.C) {
+	cfg := auditlog.WebhookConfig{URL: "http://example.com", SpoolDir: c.MkDir()}
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	noURL := cfg
+	noURL.URL = ""
+	c.Check(noURL.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	noSpool := cfg
+	noSpool.SpoolDir = ""
+	c.Check(noSpool.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	badBatch := cfg
+	badBatch.BatchSize = -1
+	c.Check(badBatch.Validate(), tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *webhookSuite) TestFullBatchIsSent(c *tc.C) {
+	srv := newWebhookServer(0)
+	defer srv.Close()
+
+	dir := c.MkDir()
+	log, err := auditlog.NewWebhook(auditlog.WebhookConfig{
+		URL:       srv.URL,
+		BatchSize: 2,
+		SpoolDir:  dir,
+		Clock:     testclock.NewClock(time.Now()),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer log.Close()
+
+	c.Assert(log.AddConversation(auditlog.Conversation{Who: "bob"}), tc.ErrorIsNil)
+	c.Assert(log.AddRequest(auditlog.Request{Method: "Deploy"}), tc.ErrorIsNil)
+
+	batch := srv.nextBatch(c)
+	c.Check(batch, tc.DeepEquals, []auditlog.Record{
+		{Conversation: &auditlog.Conversation{Who: "bob"}},
+		{Request: &auditlog.Request{Method: "Deploy"}},
+	})
+
+	// Once delivered, the records are removed from the spool.
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if readSpool(c, dir) == "" {
+			return
+		}
+	}
+	c.Fatalf("records not removed from spool")
+}
+
+func (s *webhookSuite) TestRetryAfterFailure(c *tc.C) {
+	srv := newWebhookServer(1)
+	defer srv.Close()
+
+	clock := testclock.NewClock(time.Now())
+	log, err := auditlog.NewWebhook(auditlog.WebhookConfig{
+		URL:           srv.URL,
+		BatchSize:     1,
+		FlushInterval: time.Second,
+		SpoolDir:      c.MkDir(),
+		Clock:         clock,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer log.Close()
+
+	c.Assert(log.AddRequest(auditlog.Request{Method: "Deploy"}), tc.ErrorIsNil)
+
+	// The first delivery fails, so the next attempt is backed off.
+	srv.waitForAttempts(c, 1)
+	c.Assert(clock.WaitAdvance(2*time.Second, testhelpers.LongWait, 1), tc.ErrorIsNil)
+
+	batch := srv.nextBatch(c)
+	c.Check(batch, tc.DeepEquals, []auditlog.Record{
+		{Request: &auditlog.Request{Method: "Deploy"}},
+	})
+}
+
+func (s *webhookSuite) TestSpoolSurvivesRestart(c *tc.C) {
+	failing := newWebhookServer(1000)
+	defer failing.Close()
+
+	dir := c.MkDir()
+	log, err := auditlog.NewWebhook(auditlog.WebhookConfig{
+		URL:      failing.URL,
+		SpoolDir: dir,
+		Clock:    testclock.NewClock(time.Now()),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(log.AddResponse(auditlog.ResponseErrors{RequestID: 7}), tc.ErrorIsNil)
+	c.Assert(log.Close(), tc.ErrorIsNil)
+
+	c.Check(readSpool(c, dir), tc.Not(tc.Equals), "")
+
+	working := newWebhookServer(0)
+	defer working.Close()
+
+	clock := testclock.NewClock(time.Now())
+	log, err = auditlog.NewWebhook(auditlog.WebhookConfig{
+		URL:           working.URL,
+		FlushInterval: time.Second,
+		SpoolDir:      dir,
+		Clock:         clock,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer log.Close()
+
+	c.Assert(clock.WaitAdvance(time.Second, testhelpers.LongWait, 1), tc.ErrorIsNil)
+
+	batch := working.nextBatch(c)
+	c.Check(batch, tc.DeepEquals, []auditlog.Record{
+		{Errors: &auditlog.ResponseErrors{RequestID: 7}},
+	})
+}
+
+func (s *webhookSuite) TestSpoolOffsetSkipsDeliveredRecords(c *tc.C) {
+	dir := c.MkDir()
+	delivered := `{"request":{"conversation-id":"","connection-id":"","request-id":1,"when":"","facade":"","method":"Deploy","version":0}}` + "\n"
+	pending := `{"request":{"conversation-id":"","connection-id":"","request-id":2,"when":"","facade":"","method":"Refresh","version":0}}` + "\n"
+	err := os.WriteFile(filepath.Join(dir, "audit-webhook-spool.jsonl"), []byte(delivered+pending), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.WriteFile(filepath.Join(dir, "audit-webhook-spool.offset"), []byte(strconv.Itoa(len(delivered))), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	srv := newWebhookServer(0)
+	defer srv.Close()
+
+	clock := testclock.NewClock(time.Now())
+	log, err := auditlog.NewWebhook(auditlog.WebhookConfig{
+		URL:           srv.URL,
+		FlushInterval: time.Second,
+		SpoolDir:      dir,
+		Clock:         clock,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer log.Close()
+
+	c.Assert(clock.WaitAdvance(time.Second, testhelpers.LongWait, 1), tc.ErrorIsNil)
+
+	batch := srv.nextBatch(c)
+	c.Check(batch, tc.DeepEquals, []auditlog.Record{
+		{Request: &auditlog.Request{RequestID: 2, Method: "Refresh"}},
+	})
+}
+
+func (s *webhookSuite) TestAddAfterSpoolFailure(c *tc.C) {
+	srv := newWebhookServer(0)
+	defer srv.Close()
+
+	dir := c.MkDir()
+	log, err := auditlog.NewWebhook(auditlog.WebhookConfig{
+		URL:       srv.URL,
+		BatchSize: 1,
+		SpoolDir:  dir,
+		Clock:     testclock.NewClock(time.Now()),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer log.Close()
+
+	// Recording the acknowledgement fails, as the offset can't be
+	// written, but records can still be added afterwards.
+	c.Assert(os.Mkdir(filepath.Join(dir, "audit-webhook-spool.offset.tmp"), 0700), tc.ErrorIsNil)
+	c.Assert(log.AddRequest(auditlog.Request{Method: "Deploy"}), tc.ErrorIsNil)
+	srv.nextBatch(c)
+
+	c.Assert(log.AddRequest(auditlog.Request{Method: "Refresh"}), tc.ErrorIsNil)
+	batch := srv.nextBatch(c)
+	c.Check(batch, tc.DeepEquals, []auditlog.Record{
+		{Request: &auditlog.Request{Method: "Refresh"}},
+	})
+}
+
+func (s *webhookSuite) TestPendingRecordsAreCapped(c *tc.C) {
+	failing := newWebhookServer(1000)
+	defer failing.Close()
+
+	dir := c.MkDir()
+	log, err := auditlog.NewWebhookWithMaxPending(auditlog.WebhookConfig{
+		URL:      failing.URL,
+		SpoolDir: dir,
+		Clock:    testclock.NewClock(time.Now()),
+	}, 2)
+	c.Assert(err, tc.ErrorIsNil)
+	for i := 1; i <= 5; i++ {
+		c.Assert(log.AddResponse(auditlog.ResponseErrors{RequestID: uint64(i)}), tc.ErrorIsNil)
+	}
+	c.Assert(log.Close(), tc.ErrorIsNil)
+
+	working := newWebhookServer(0)
+	defer working.Close()
+
+	// Only two records are held in memory at a time, but every record is
+	// delivered, in order, from the spool.
+	clock := testclock.NewClock(time.Now())
+	log, err = auditlog.NewWebhookWithMaxPending(auditlog.WebhookConfig{
+		URL:           working.URL,
+		BatchSize:     10,
+		FlushInterval: time.Second,
+		SpoolDir:      dir,
+		Clock:         clock,
+	}, 2)
+	c.Assert(err, tc.ErrorIsNil)
+	defer log.Close()
+
+	c.Assert(clock.WaitAdvance(time.Second, testhelpers.LongWait, 1), tc.ErrorIsNil)
+
+	var ids []uint64
+	for len(ids) < 5 {
+		for _, r := range working.nextBatch(c) {
+			ids = append(ids, r.Errors.RequestID)
+		}
+	}
+	c.Check(ids, tc.DeepEquals, []uint64{1, 2, 3, 4, 5})
+}
+
+func readSpool(c *tc.C, dir string) string {
+	data, err := os.ReadFile(filepath.Join(dir, "audit-webhook-spool.jsonl"))
+	c.Assert(err, tc.ErrorIsNil)
+	return string(data)
+}
+
+type webhookServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	failures int
+	attempts int
+	attempt  chan struct{}
+	batches  chan []auditlog.Record
+}
+
+// newWebhookServer returns a server that fails the given number of requests
+// before accepting them.
+func newWebhookServer(failures int) *webhookServer {
+	srv := &webhookServer{
+		failures: failures,
+		attempt:  make(chan struct{}, 100),
+		batches:  make(chan []auditlog.Record, 100),
+	}
+	srv.Server = httptest.NewServer(http.HandlerFunc(srv.serveHTTP))
+	return srv
+}
+
+func (s *webhookServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.attempts++
+	fail := s.attempts <= s.failures
+	s.mu.Unlock()
+	s.attempt <- struct{}{}
+
+	if fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var batch []auditlog.Record
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.batches <- batch
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *webhookServer) waitForAttempts(c *tc.C, n int) {
+	for i := 0; i < n; i++ {
+		select {
+		case <-s.attempt:
+		case <-time.After(testhelpers.LongWait):
+			c.Fatalf("timed out waiting for webhook request")
+		}
+	}
+}
+
+func (s *webhookServer) nextBatch(c *tc.C) []auditlog.Record {
+	select {
+	case batch := <-s.batches:
+		return batch
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for webhook batch")
+	}
+	return nil
+}
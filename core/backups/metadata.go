@@ -39,6 +39,10 @@ type Paths struct {
 // generated with this version of juju.
 const checksumFormat = "SHA-1, base64 encoded"
 
+// ContentChecksumFormat identifies how to interpret the checksums of the
+// files contained in a backup archive.
+const ContentChecksumFormat = "SHA-256, hex encoded"
+
 // Origin identifies where a backup archive came from.  While it is
 // more about where and Metadata about what and when, that distinction
 // does not merit special consideration.  Instead, Origin exists
@@ -102,6 +106,14 @@ type Metadata struct {
 
 	// Controller contains metadata about the controller where the backup was taken.
 	Controller ControllerMetadata
+
+	// Databases lists the namespaces of the databases dumped into the
+	// archive.
+	Databases []string
+
+	// Checksums maps the path of each file in the archive to its checksum,
+	// in ContentChecksumFormat.
+	Checksums map[string]string
 }
 
 // ControllerMetadata contains controller specific metadata.
@@ -185,6 +197,12 @@ type flatMetadata struct {
 	HANodes                     int64
 	ControllerMachineID         string
 	ControllerMachineInstanceID string
+
+	// Dqlite backups. These are omitted for backups of
+	// controllers that predate Dqlite.
+
+	Databases []string          `json:",omitempty"`
+	Checksums map[string]string `json:",omitempty"`
 }
 
 func (m *Metadata) flat() flatMetadata {
@@ -205,6 +223,8 @@ func (m *Metadata) flat() flatMetadata {
 		ControllerMachineID:         m.Controller.MachineID,
 		ControllerMachineInstanceID: m.Controller.MachineInstanceID,
 		HANodes:                     m.Controller.HANodes,
+		Databases:                   m.Databases,
+		Checksums:                   m.Checksums,
 	}
 	stored := m.Stored()
 	if stored != nil {
@@ -250,6 +270,8 @@ func (flat *flatMetadata) inflate() (*Metadata, error) {
 		MachineInstanceID: flat.ControllerMachineInstanceID,
 		HANodes:           flat.HANodes,
 	}
+	meta.Databases = flat.Databases
+	meta.Checksums = flat.Checksums
 	return meta, nil
 }
 
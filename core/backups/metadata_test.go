@@ -185,6 +185,48 @@ func (s *metadataSuite) TestNewMetadataJSONReaderV1(c *tc.C) {
 	c.Check(meta.Controller.MachineID, tc.Equals, "10")
 }
 
+func (s *metadataSuite) TestAsJSONBufferWithDatabases(c *tc.C) {
+	meta := s.createTestMetadata(c)
+	meta.Controller = backups.ControllerMetadata{
+		UUID:      "controller-uuid",
+		MachineID: "0",
+		HANodes:   1,
+	}
+	meta.Databases = []string{"controller"}
+	meta.Checksums = map[string]string{
+		"juju-backup/dump/controller.jsonl": "abcd",
+	}
+	s.assertMetadata(c, meta, `{`+
+		`"ID":"20140909-115934.asdf-zxcv-qwe",`+
+		`"FormatVersion":1,`+
+		`"Checksum":"123af2cef",`+
+		`"ChecksumFormat":"SHA-1, base64 encoded",`+
+		`"Size":10,`+
+		`"Stored":"0001-01-01T00:00:00Z",`+
+		`"Started":"2014-09-09T11:59:34Z",`+
+		`"Finished":"2014-09-09T12:00:34Z",`+
+		`"Notes":"",`+
+		`"ModelUUID":"asdf-zxcv-qwe",`+
+		`"Machine":"0",`+
+		`"Hostname":"myhost",`+
+		`"Version":"1.21-alpha3",`+
+		`"Base":"ubuntu@22.04",`+
+		`"ControllerUUID":"controller-uuid",`+
+		`"HANodes":1,`+
+		`"ControllerMachineID":"0",`+
+		`"ControllerMachineInstanceID":"",`+
+		`"Databases":["controller"],`+
+		`"Checksums":{"juju-backup/dump/controller.jsonl":"abcd"}`+
+		`}`+"\n")
+
+	buf, err := meta.AsJSONBuffer()
+	c.Assert(err, tc.ErrorIsNil)
+	read, err := backups.NewMetadataJSONReader(buf)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(read.Databases, tc.DeepEquals, meta.Databases)
+	c.Check(read.Checksums, tc.DeepEquals, meta.Checksums)
+}
+
 func (s *metadataSuite) TestNewMetadataJSONReaderUnsupported(c *tc.C) {
 	file := bytes.NewBufferString(`{` +
 		`"ID":"20140909-115934.asdf-zxcv-qwe",` +
@@ -68,3 +68,13 @@ type Term interface {
 	// and the term should be considered incomplete and done.
 	Done(empty bool, abort <-chan struct{})
 }
+
+// IdentifiedChangeEvent is a ChangeEvent that carries the change log ID it was
+// read from. The ID can be persisted by a consumer and later used to resume a
+// subscription from that position.
+type IdentifiedChangeEvent interface {
+	ChangeEvent
+
+	// ID returns the change log ID of the change.
+	ID() int64
+}
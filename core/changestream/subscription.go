@@ -19,6 +19,17 @@ type Subscription interface {
 	Done() <-chan struct{}
 }
 
+// ResumableEventSource describes the ability to subscribe to the change
+// stream from a known change log position.
+type ResumableEventSource interface {
+	// SubscribeFrom returns a subscription that first replays all the changes
+	// after the given change log ID that match the subscription options,
+	// before delivering live events. If the change log has already been
+	// pruned past the given ID, then database.ErrChangeLogPruned is
+	// returned and the consumer must fall back to reading the initial state.
+	SubscribeFrom(changeLogID int64, opts ...SubscriptionOption) (Subscription, error)
+}
+
 // SubscriptionOption is an option that can be used to create a subscription.
 type SubscriptionOption struct {
 	namespace  string
@@ -19,4 +19,10 @@ const (
 	// This error indicates to consuming workers that their dependency has
 	// become unmet and a restart by the dependency engine is imminent.
 	ErrEventMultiplexerDying = errors.ConstError("event multiplexer worker is dying")
+
+	// ErrChangeLogPruned is returned when a subscription is requested from a
+	// change log position that has already been removed by the change log
+	// pruner. The changes between that position and the oldest change log
+	// entry are no longer available to be replayed.
+	ErrChangeLogPruned = errors.ConstError("change log has been pruned")
 )
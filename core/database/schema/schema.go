@@ -75,6 +75,18 @@ func (s *Schema) Len() int {
 	return len(s.patches)
 }
 
+// Hash returns the hash of the last patch in the schema, which is what is
+// recorded against the latest version in the schema table once all the
+// patches have been applied. An empty string is returned if there are no
+// patches.
+func (s *Schema) Hash() string {
+	hashes := computeHashes(s.patches)
+	if len(hashes) == 0 {
+		return ""
+	}
+	return hashes[len(hashes)-1]
+}
+
 // ChangeSet returns the schema changes for the schema when they're applied.
 type ChangeSet struct {
 	Current, Post int
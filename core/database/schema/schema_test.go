@@ -82,6 +82,27 @@ func (s *schemaSuite) TestSchemaAdd(c *tc.C) {
 	c.Check(schema.Len(), tc.Equals, 4)
 }
 
+func (s *schemaSuite) TestSchemaHash(c *tc.C) {
+	c.Check(New().Hash(), tc.Equals, "")
+
+	schema := New(
+		MakePatch("CREATE TEMP TABLE foo (id INTEGER PRIMARY KEY);"),
+		MakePatch("CREATE TEMP TABLE bar (id INTEGER PRIMARY KEY);"),
+	)
+	_, err := schema.Ensure(c.Context(), s.TxnRunner())
+	c.Assert(err, tc.ErrorIsNil)
+
+	var hash string
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT hash FROM schema WHERE version=2;").Scan(&hash)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(schema.Hash(), tc.Equals, hash)
+
+	schema.Add(MakePatch("CREATE TEMP TABLE baz (id INTEGER PRIMARY KEY);"))
+	c.Check(schema.Hash(), tc.Not(tc.Equals), hash)
+}
+
 func (s *schemaSuite) TestEnsureWithNoPatches(c *tc.C) {
 	schema := New()
 	current, err := schema.Ensure(c.Context(), s.TxnRunner())
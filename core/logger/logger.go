@@ -194,6 +194,14 @@ type LogWriter interface {
 	Log([]LogRecord) error
 }
 
+// LogForwarderSetter is implemented by the log writer of a model, which can
+// forward the records it writes to another log writer.
+type LogForwarderSetter interface {
+	// SetLogForwarder sets the log writer that the model's log records are
+	// forwarded to. A nil writer stops the forwarding.
+	SetLogForwarder(LogWriter)
+}
+
 // ModelLogger keeps track of all the log writers, which can be accessed
 // by a given model uuid.
 type ModelLogger interface {
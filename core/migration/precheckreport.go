@@ -0,0 +1,22 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration
+
+// PrecheckReport holds the outcome of running every migration precheck,
+// rather than stopping at the first failure.
+type PrecheckReport struct {
+	// Blockers are the problems that prevent the model from being
+	// migrated.
+	Blockers []string
+
+	// Warnings are the problems that would prevent a migration that
+	// was started now, but which are expected to resolve themselves.
+	Warnings []string
+}
+
+// Merge appends the blockers and warnings of other to the report.
+func (r *PrecheckReport) Merge(other PrecheckReport) {
+	r.Blockers = append(r.Blockers, other.Blockers...)
+	r.Warnings = append(r.Warnings, other.Warnings...)
+}
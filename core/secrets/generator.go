@@ -0,0 +1,159 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GeneratorType identifies how the content of a secret is generated.
+type GeneratorType string
+
+const (
+	// GeneratePassword generates a random password.
+	GeneratePassword = GeneratorType("password")
+	// GenerateSSHKey generates an SSH key pair.
+	GenerateSSHKey = GeneratorType("ssh-key")
+	// GenerateTLSCert generates a self-signed TLS certificate and key.
+	GenerateTLSCert = GeneratorType("tls-cert")
+)
+
+// IsValid returns true if t is a valid generator type.
+func (t GeneratorType) IsValid() bool {
+	switch t {
+	case GeneratePassword, GenerateSSHKey, GenerateTLSCert:
+		return true
+	}
+	return false
+}
+
+// Password character sets.
+const (
+	CharsetAlphanumeric = "alphanumeric"
+	CharsetAlpha        = "alpha"
+	CharsetNumeric      = "numeric"
+	CharsetHex          = "hex"
+	CharsetPrintable    = "printable"
+)
+
+// PasswordCharsets holds the characters used by each password character set.
+var PasswordCharsets = map[string]string{
+	CharsetAlphanumeric: "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+	CharsetAlpha:        "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+	CharsetNumeric:      "0123456789",
+	CharsetHex:          "0123456789abcdef",
+	CharsetPrintable:    "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789!#$%&()*+,-./:;<=>?@[]^_{|}~",
+}
+
+const (
+	// DefaultPasswordLength is the length of generated passwords
+	// if none is specified.
+	DefaultPasswordLength = 32
+
+	// MinPasswordLength and MaxPasswordLength bound the length
+	// of generated passwords.
+	MinPasswordLength = 8
+	MaxPasswordLength = 4096
+
+	// DefaultCertValidity is how long generated TLS certificates
+	// are valid for if not specified.
+	DefaultCertValidity = 365 * 24 * time.Hour
+)
+
+// Generator describes how new content is generated for a user secret,
+// both when it is created and each time it is rotated.
+type Generator struct {
+	Type GeneratorType
+
+	// Length and Charset apply to passwords.
+	Length  int
+	Charset string
+
+	// CommonName and Validity apply to TLS certificates.
+	CommonName string
+	Validity   time.Duration
+}
+
+// ParseGenerator parses a generator spec of the form
+// "type[,attr=value...]", for example "password,length=24,charset=hex".
+func ParseGenerator(spec string) (*Generator, error) {
+	parts := strings.Split(spec, ",")
+	g := &Generator{Type: GeneratorType(strings.TrimSpace(parts[0]))}
+	for _, part := range parts[1:] {
+		k, v, ok := strings.Cut(part, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if !ok || k == "" || v == "" {
+			return nil, errors.Errorf("secret generator attribute %q %w", part, coreerrors.NotValid)
+		}
+		var err error
+		switch k {
+		case "length":
+			g.Length, err = strconv.Atoi(v)
+		case "charset":
+			g.Charset = v
+		case "common-name":
+			g.CommonName = v
+		case "validity":
+			g.Validity, err = time.ParseDuration(v)
+		default:
+			return nil, errors.Errorf("secret generator attribute %q %w", k, coreerrors.NotValid)
+		}
+		if err != nil {
+			return nil, errors.Errorf("secret generator attribute %q value %q %w", k, v, coreerrors.NotValid)
+		}
+	}
+	if err := g.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return g, nil
+}
+
+// Validate returns an error if the generator is not valid.
+func (g Generator) Validate() error {
+	if !g.Type.IsValid() {
+		return errors.Errorf("secret generator type %q %w", g.Type, coreerrors.NotValid)
+	}
+	if g.Type != GeneratePassword && (g.Length != 0 || g.Charset != "") {
+		return errors.Errorf("length and charset only apply to password generators: %w", coreerrors.NotValid)
+	}
+	if g.Type != GenerateTLSCert && (g.CommonName != "" || g.Validity != 0) {
+		return errors.Errorf("common-name and validity only apply to tls-cert generators: %w", coreerrors.NotValid)
+	}
+	if g.Length != 0 && (g.Length < MinPasswordLength || g.Length > MaxPasswordLength) {
+		return errors.Errorf(
+			"password length %d must be between %d and %d: %w",
+			g.Length, MinPasswordLength, MaxPasswordLength, coreerrors.NotValid)
+	}
+	if _, ok := PasswordCharsets[g.Charset]; g.Charset != "" && !ok {
+		return errors.Errorf("password charset %q %w", g.Charset, coreerrors.NotValid)
+	}
+	if g.Validity < 0 {
+		return errors.Errorf("certificate validity %v %w", g.Validity, coreerrors.NotValid)
+	}
+	return nil
+}
+
+// String returns the generator spec, as parsed by ParseGenerator.
+func (g Generator) String() string {
+	parts := []string{string(g.Type)}
+	if g.Length != 0 {
+		parts = append(parts, fmt.Sprintf("length=%d", g.Length))
+	}
+	if g.Charset != "" {
+		parts = append(parts, "charset="+g.Charset)
+	}
+	if g.CommonName != "" {
+		parts = append(parts, "common-name="+g.CommonName)
+	}
+	if g.Validity != 0 {
+		parts = append(parts, "validity="+g.Validity.String())
+	}
+	return strings.Join(parts, ",")
+}
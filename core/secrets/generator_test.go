@@ -0,0 +1,92 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/secrets"
+)
+
+type GeneratorSuite struct{}
+
+func TestGeneratorSuite(t *testing.T) {
+	tc.Run(t, &GeneratorSuite{})
+}
+
+func (s *GeneratorSuite) TestParseGenerator(c *tc.C) {
+	for _, t := range []struct {
+		spec     string
+		expected *secrets.Generator
+		err      string
+	}{{
+		spec:     "password",
+		expected: &secrets.Generator{Type: secrets.GeneratePassword},
+	}, {
+		spec: "password, length=24, charset=hex",
+		expected: &secrets.Generator{
+			Type:    secrets.GeneratePassword,
+			Length:  24,
+			Charset: "hex",
+		},
+	}, {
+		spec:     "ssh-key",
+		expected: &secrets.Generator{Type: secrets.GenerateSSHKey},
+	}, {
+		spec: "tls-cert,common-name=db.example.com,validity=720h",
+		expected: &secrets.Generator{
+			Type:       secrets.GenerateTLSCert,
+			CommonName: "db.example.com",
+			Validity:   720 * time.Hour,
+		},
+	}, {
+		spec: "token",
+		err:  `secret generator type "token" not valid`,
+	}, {
+		spec: "password,length",
+		err:  `secret generator attribute "length" not valid`,
+	}, {
+		spec: "password,size=10",
+		err:  `secret generator attribute "size" not valid`,
+	}, {
+		spec: "password,length=ten",
+		err:  `secret generator attribute "length" value "ten" not valid`,
+	}, {
+		spec: "password,length=4",
+		err:  `password length 4 must be between 8 and 4096: not valid`,
+	}, {
+		spec: "password,charset=emoji",
+		err:  `password charset "emoji" not valid`,
+	}, {
+		spec: "ssh-key,length=10",
+		err:  `length and charset only apply to password generators: not valid`,
+	}, {
+		spec: "password,common-name=foo",
+		err:  `common-name and validity only apply to tls-cert generators: not valid`,
+	}} {
+		g, err := secrets.ParseGenerator(t.spec)
+		if t.err != "" {
+			c.Check(err, tc.ErrorMatches, t.err, tc.Commentf(t.spec))
+			continue
+		}
+		c.Check(err, tc.ErrorIsNil, tc.Commentf(t.spec))
+		c.Check(g, tc.DeepEquals, t.expected, tc.Commentf(t.spec))
+	}
+}
+
+func (s *GeneratorSuite) TestString(c *tc.C) {
+	for _, spec := range []string{
+		"password",
+		"password,length=24,charset=hex",
+		"ssh-key",
+		"tls-cert,common-name=db.example.com,validity=720h0m0s",
+	} {
+		g, err := secrets.ParseGenerator(spec)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(g.String(), tc.Equals, spec)
+	}
+}
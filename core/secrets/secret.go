@@ -214,6 +214,14 @@ type AccessInfo struct {
 	Role   SecretRole
 }
 
+// AccessLogEntry records an attempt to read the content of a secret revision.
+type AccessLogEntry struct {
+	Accessor string
+	Revision int
+	Time     time.Time
+	Denied   bool
+}
+
 // AccessorKind represents the kind of a secret accessor entity.
 type AccessorKind string
 
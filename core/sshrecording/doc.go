@@ -0,0 +1,8 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package sshrecording describes the recordings of interactive SSH sessions
+// proxied through the controller's SSH server. Recordings are stored in the
+// object store of the target's model, keyed by the user, the target and the
+// time the session started.
+package sshrecording
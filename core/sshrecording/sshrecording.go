@@ -0,0 +1,109 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshrecording
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/juju/names/v6"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// PathPrefix is the prefix of the object store path of every
+	// session recording.
+	PathPrefix = "ssh-recordings/"
+
+	// Extension is the file extension of a session recording, which is
+	// written in the asciicast v2 format.
+	Extension = ".cast"
+
+	// timeFormat is the format of the start time in a recording's path.
+	timeFormat = "20060102T150405.000000000Z"
+)
+
+// Key identifies the recording of a session.
+type Key struct {
+	// User is the name of the user that started the session.
+	User string
+
+	// Target is the name of the machine, unit or container the session
+	// was proxied to.
+	Target string
+
+	// Started is the time the session started.
+	Started time.Time
+}
+
+// NewKey returns the key of a recording of a session started by the user
+// at the given time, to the given destination.
+func NewKey(user string, destination virtualhostname.Info, started time.Time) Key {
+	return Key{
+		User:    user,
+		Target:  TargetName(destination),
+		Started: started.UTC(),
+	}
+}
+
+// TargetName returns the name of the target of a virtual hostname, as used
+// in the keys of session recordings.
+func TargetName(destination virtualhostname.Info) string {
+	if machine, ok := destination.Machine(); ok {
+		return names.NewMachineTag(fmt.Sprint(machine)).String()
+	}
+	unit, _ := destination.Unit()
+	target := names.NewUnitTag(unit).String()
+	if container, ok := destination.Container(); ok {
+		target += "." + container
+	}
+	return target
+}
+
+// Path returns the object store path of the recording.
+func (k Key) Path() string {
+	return PathPrefix +
+		url.PathEscape(k.User) + "/" +
+		url.PathEscape(k.Target) + "/" +
+		k.Started.UTC().Format(timeFormat) + Extension
+}
+
+// ParsePath returns the key of the recording stored at the given object
+// store path.
+func ParsePath(path string) (Key, error) {
+	rest, ok := strings.CutPrefix(path, PathPrefix)
+	if !ok {
+		return Key{}, errors.Errorf("recording path %q %w", path, coreerrors.NotValid)
+	}
+	rest, ok = strings.CutSuffix(rest, Extension)
+	if !ok {
+		return Key{}, errors.Errorf("recording path %q %w", path, coreerrors.NotValid)
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) != 3 {
+		return Key{}, errors.Errorf("recording path %q %w", path, coreerrors.NotValid)
+	}
+	user, err := url.PathUnescape(parts[0])
+	if err != nil {
+		return Key{}, errors.Errorf("recording user in %q %w", path, coreerrors.NotValid)
+	}
+	target, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return Key{}, errors.Errorf("recording target in %q %w", path, coreerrors.NotValid)
+	}
+	started, err := time.Parse(timeFormat, parts[2])
+	if err != nil {
+		return Key{}, errors.Errorf("recording time in %q %w", path, coreerrors.NotValid)
+	}
+	return Key{
+		User:    user,
+		Target:  target,
+		Started: started,
+	}, nil
+}
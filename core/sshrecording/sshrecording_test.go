@@ -0,0 +1,78 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshrecording
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/virtualhostname"
+)
+
+const modelUUID = "8419cd78-4993-4c3a-928e-c646226beeee"
+
+type RecordingSuite struct{}
+
+func TestRecordingSuite(t *testing.T) {
+	tc.Run(t, &RecordingSuite{})
+}
+
+func (s *RecordingSuite) TestTargetName(c *tc.C) {
+	machine, err := virtualhostname.NewInfoMachineTarget(modelUUID, "1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(TargetName(machine), tc.Equals, "machine-1")
+
+	unit, err := virtualhostname.NewInfoUnitTarget(modelUUID, "postgresql/1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(TargetName(unit), tc.Equals, "unit-postgresql-1")
+
+	container, err := virtualhostname.NewInfoContainerTarget(modelUUID, "postgresql/1", "charm")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(TargetName(container), tc.Equals, "unit-postgresql-1.charm")
+}
+
+func (s *RecordingSuite) TestPathRoundTrip(c *tc.C) {
+	unit, err := virtualhostname.NewInfoUnitTarget(modelUUID, "postgresql/1")
+	c.Assert(err, tc.ErrorIsNil)
+	started := time.Date(2025, 3, 14, 15, 9, 26, 535897932, time.FixedZone("x", 3600))
+
+	key := NewKey("bob@external", unit, started)
+	c.Check(key.Path(), tc.Equals, "ssh-recordings/bob@external/unit-postgresql-1/20250314T140926.535897932Z.cast")
+
+	parsed, err := ParsePath(key.Path())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(parsed.User, tc.Equals, "bob@external")
+	c.Check(parsed.Target, tc.Equals, "unit-postgresql-1")
+	c.Check(parsed.Started.Equal(started), tc.IsTrue)
+}
+
+func (s *RecordingSuite) TestPathEscapesUser(c *tc.C) {
+	key := Key{
+		User:    "evil/../user",
+		Target:  "machine-0",
+		Started: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	c.Check(key.Path(), tc.Equals, "ssh-recordings/evil%2F..%2Fuser/machine-0/20250101T000000.000000000Z.cast")
+
+	parsed, err := ParsePath(key.Path())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(parsed, tc.DeepEquals, key)
+}
+
+func (s *RecordingSuite) TestParsePathInvalid(c *tc.C) {
+	for _, path := range []string{
+		"charms/foo",
+		"ssh-recordings/bob/machine-0/20250101T000000.000000000Z",
+		"ssh-recordings/bob/20250101T000000.000000000Z.cast",
+		"ssh-recordings/bob/machine-0/yesterday.cast",
+		"ssh-recordings/bob%zz/machine-0/20250101T000000.000000000Z.cast",
+	} {
+		c.Logf("path %q", path)
+		_, err := ParsePath(path)
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	}
+}
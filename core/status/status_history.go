@@ -20,6 +20,8 @@ type StatusHistoryFilter struct {
 	FromDate *time.Time
 	// Delta indicates the age of the oldest log expected.
 	Delta *time.Duration
+	// ToDate indicates the latest date up to which logs are expected.
+	ToDate *time.Time
 	// Exclude indicates the status messages that should be excluded
 	// from the returned result.
 	Exclude set.Strings
@@ -40,6 +42,8 @@ func (f *StatusHistoryFilter) Validate() error {
 		return errors.Errorf("Size and Delta together %w", coreerrors.NotValid)
 	case t && d:
 		return errors.Errorf("Date and Delta together %w", coreerrors.NotValid)
+	case t && f.ToDate != nil && f.ToDate.Before(*f.FromDate):
+		return errors.Errorf("ToDate before FromDate %w", coreerrors.NotValid)
 	}
 	return nil
 }
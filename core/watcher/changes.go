@@ -0,0 +1,23 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package watcher
+
+// ChangeEvent describes a single change recorded against a namespace in the
+// change log.
+type ChangeEvent struct {
+	// ID is the change log ID of the change. It can be used to resume
+	// watching from this change. It is -1 if the ID is not known.
+	ID int64
+	// Namespace is the namespace the change was recorded against. This is
+	// normally the table name.
+	Namespace string
+	// Changed is the changed value, normally the primary key of the row
+	// that was changed.
+	Changed string
+	// Deleted is true if the change represents a deletion.
+	Deleted bool
+}
+
+// ChangesWatcher represents a watcher that returns a slice of ChangeEvent.
+type ChangesWatcher = Watcher[[]ChangeEvent]
@@ -0,0 +1,182 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package eventsource
+
+import (
+	"context"
+
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/core/changestream"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ChangesWatcher watches for events from a set of namespaces and emits the
+// change events themselves, rather than just the changed values. This allows
+// consumers to know which namespace a change belongs to and the type of the
+// change. Changes received whilst a dispatch is pending are accumulated and
+// emitted together.
+type ChangesWatcher struct {
+	*BaseWatcher
+
+	out        chan []watcher.ChangeEvent
+	filterOpts []changestream.SubscriptionOption
+
+	// resume indicates that the subscription should start from the
+	// changeLogID, replaying any changes after it.
+	resume      bool
+	changeLogID int64
+}
+
+// NewChangesWatcher returns a new watcher that emits the change events from
+// the input base watcher's db/queue that match the filters. A single filter
+// option is required, though additional filter options can be provided.
+func NewChangesWatcher(
+	base *BaseWatcher,
+	filterOption FilterOption, filterOptions ...FilterOption,
+) (*ChangesWatcher, error) {
+	opts, err := subscriptionOptions(append([]FilterOption{filterOption}, filterOptions...))
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	w := &ChangesWatcher{
+		BaseWatcher: base,
+		out:         make(chan []watcher.ChangeEvent),
+		filterOpts:  opts,
+	}
+
+	w.tomb.Go(w.loop)
+	return w, nil
+}
+
+// NewResumableChangesWatcher returns a new watcher that first emits the change
+// events after the given change log ID, before emitting live change events.
+// The base watcher's db/queue must support resumable subscriptions, otherwise
+// a [coreerrors.NotSupported] error is returned.
+func NewResumableChangesWatcher(
+	base *BaseWatcher, changeLogID int64,
+	filterOption FilterOption, filterOptions ...FilterOption,
+) (*ChangesWatcher, error) {
+	if _, ok := base.watchableDB.(changestream.ResumableEventSource); !ok {
+		return nil, errors.Errorf("resuming change subscriptions").Add(coreerrors.NotSupported)
+	}
+
+	opts, err := subscriptionOptions(append([]FilterOption{filterOption}, filterOptions...))
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	w := &ChangesWatcher{
+		BaseWatcher: base,
+		out:         make(chan []watcher.ChangeEvent),
+		filterOpts:  opts,
+		resume:      true,
+		changeLogID: changeLogID,
+	}
+
+	w.tomb.Go(w.loop)
+	return w, nil
+}
+
+// Changes returns the channel on which the change events are emitted.
+// The initial event is always empty.
+func (w *ChangesWatcher) Changes() <-chan []watcher.ChangeEvent {
+	return w.out
+}
+
+func (w *ChangesWatcher) loop() error {
+	ctx, cancel := w.scopedContext()
+	defer cancel()
+
+	defer close(w.out)
+
+	subscription, err := w.subscribe()
+	if err != nil {
+		return errors.Errorf("subscribing to namespaces: %w", err)
+	}
+	defer subscription.Kill()
+
+	// By reassigning the out channel, we effectively ticktock between
+	// read mode and dispatch mode. We begin in dispatch mode in order to
+	// send the initial empty event.
+	var changes []watcher.ChangeEvent
+	in := subscription.Changes()
+	out := w.out
+
+	for {
+		select {
+		case <-w.tomb.Dying():
+			return tomb.ErrDying
+		case <-subscription.Done():
+			return ErrSubscriptionClosed
+		case events, ok := <-in:
+			if !ok {
+				w.logger.Debugf(ctx, "change channel closed; terminating watcher")
+				return nil
+			}
+			if len(events) == 0 {
+				continue
+			}
+
+			for _, event := range events {
+				changes = append(changes, toChangeEvent(event))
+			}
+			out = w.out
+		case out <- changes:
+			changes = nil
+			out = nil
+		}
+	}
+}
+
+func (w *ChangesWatcher) subscribe() (changestream.Subscription, error) {
+	if !w.resume {
+		return w.watchableDB.Subscribe(w.filterOpts...)
+	}
+
+	source, ok := w.watchableDB.(changestream.ResumableEventSource)
+	if !ok {
+		return nil, errors.Errorf("resuming change subscriptions").Add(coreerrors.NotSupported)
+	}
+	return source.SubscribeFrom(w.changeLogID, w.filterOpts...)
+}
+
+func (w *ChangesWatcher) scopedContext() (context.Context, context.CancelFunc) {
+	return context.WithCancel(w.tomb.Context(context.Background()))
+}
+
+func toChangeEvent(event changestream.ChangeEvent) watcher.ChangeEvent {
+	id := int64(-1)
+	if identified, ok := event.(changestream.IdentifiedChangeEvent); ok {
+		id = identified.ID()
+	}
+	return watcher.ChangeEvent{
+		ID:        id,
+		Namespace: event.Namespace(),
+		Changed:   event.Changed(),
+		Deleted:   event.Type()&changestream.Deleted != 0,
+	}
+}
+
+func subscriptionOptions(filters []FilterOption) ([]changestream.SubscriptionOption, error) {
+	opts := make([]changestream.SubscriptionOption, len(filters))
+	for i, opt := range filters {
+		if opt == nil {
+			return nil, errors.Errorf("nil filter option provided at index %d", i)
+		}
+
+		predicate := opt.ChangePredicate()
+		if predicate == nil {
+			return nil, errors.Errorf("no change predicate provided for filter option %d", i)
+		}
+
+		opts[i] = changestream.FilteredNamespace(opt.Namespace(), opt.ChangeMask(), func(e changestream.ChangeEvent) bool {
+			return predicate(e.Changed())
+		})
+	}
+	return opts, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package eventsource
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/goleak"
+
+	"github.com/juju/juju/core/changestream"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/watcher"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+)
+
+type changesSuite struct {
+	baseSuite
+}
+
+var _ watcher.ChangesWatcher = &ChangesWatcher{}
+
+func TestChangesSuite(t *stdtesting.T) {
+	defer goleak.VerifyNone(t)
+	tc.Run(t, &changesSuite{})
+}
+
+func (s *changesSuite) TestChanges(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	subExp := s.sub.EXPECT()
+
+	done := make(chan struct{})
+	subExp.Done().Return(done).MinTimes(2)
+
+	deltas := make(chan []changestream.ChangeEvent)
+	subExp.Changes().Return(deltas)
+
+	subExp.Kill()
+
+	s.eventsource.EXPECT().Subscribe(
+		subscriptionOptionMatcher{opt: changestream.Namespace("random_namespace", changestream.All)},
+	).Return(s.sub, nil)
+
+	w, err := NewChangesWatcher(s.newBaseWatcher(c), NamespaceFilter("random_namespace", changestream.All))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	// Initial event.
+	select {
+	case changes := <-w.Changes():
+		c.Check(changes, tc.HasLen, 0)
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for initial watcher changes")
+	}
+
+	event := changeEvent{
+		changeType: changestream.Changed,
+		namespace:  "random_namespace",
+		changed:    "some-key-value",
+	}
+	select {
+	case deltas <- []changestream.ChangeEvent{event}:
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out dispatching change event")
+	}
+
+	select {
+	case changes := <-w.Changes():
+		c.Check(changes, tc.DeepEquals, []watcher.ChangeEvent{{
+			ID:        -1,
+			Namespace: "random_namespace",
+			Changed:   "some-key-value",
+		}})
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for watcher changes")
+	}
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *changesSuite) TestResumableChanges(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	watchableDB := resumableWatchableDBShim{
+		TxnRunner:            s.TxnRunner(),
+		EventSource:          s.eventsource,
+		ResumableEventSource: s.resumable,
+	}
+
+	subExp := s.sub.EXPECT()
+
+	done := make(chan struct{})
+	subExp.Done().Return(done).MinTimes(1)
+	subExp.Changes().Return(make(chan []changestream.ChangeEvent))
+	subExp.Kill()
+
+	s.resumable.EXPECT().SubscribeFrom(
+		int64(42),
+		subscriptionOptionMatcher{opt: changestream.Namespace("random_namespace", changestream.All)},
+	).Return(s.sub, nil)
+
+	base := NewBaseWatcher(watchableDB, loggertesting.WrapCheckLog(c))
+	w, err := NewResumableChangesWatcher(base, 42, NamespaceFilter("random_namespace", changestream.All))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	select {
+	case changes := <-w.Changes():
+		c.Check(changes, tc.HasLen, 0)
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for initial watcher changes")
+	}
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *changesSuite) TestResumableChangesNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := NewResumableChangesWatcher(s.newBaseWatcher(c), 42, NamespaceFilter("random_namespace", changestream.All))
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/core/changestream (interfaces: Subscription,WatchableDB,EventSource)
+// Source: github.com/juju/juju/core/changestream (interfaces: Subscription,WatchableDB,EventSource,ResumableEventSource)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package eventsource -destination changestream_mock_test.go github.com/juju/juju/core/changestream Subscription,WatchableDB,EventSource
+//	mockgen -typed -package eventsource -destination changestream_mock_test.go github.com/juju/juju/core/changestream Subscription,WatchableDB,EventSource,ResumableEventSource
 //
 
 // Package eventsource is a generated GoMock package.
@@ -399,3 +399,70 @@ func (c *MockEventSourceSubscribeCall) DoAndReturn(f func(...changestream.Subscr
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockResumableEventSource is a mock of ResumableEventSource interface.
+type MockResumableEventSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockResumableEventSourceMockRecorder
+}
+
+// MockResumableEventSourceMockRecorder is the mock recorder for MockResumableEventSource.
+type MockResumableEventSourceMockRecorder struct {
+	mock *MockResumableEventSource
+}
+
+// NewMockResumableEventSource creates a new mock instance.
+func NewMockResumableEventSource(ctrl *gomock.Controller) *MockResumableEventSource {
+	mock := &MockResumableEventSource{ctrl: ctrl}
+	mock.recorder = &MockResumableEventSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResumableEventSource) EXPECT() *MockResumableEventSourceMockRecorder {
+	return m.recorder
+}
+
+// SubscribeFrom mocks base method.
+func (m *MockResumableEventSource) SubscribeFrom(arg0 int64, arg1 ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeFrom", varargs...)
+	ret0, _ := ret[0].(changestream.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeFrom indicates an expected call of SubscribeFrom.
+func (mr *MockResumableEventSourceMockRecorder) SubscribeFrom(arg0 any, arg1 ...any) *MockResumableEventSourceSubscribeFromCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeFrom", reflect.TypeOf((*MockResumableEventSource)(nil).SubscribeFrom), varargs...)
+	return &MockResumableEventSourceSubscribeFromCall{Call: call}
+}
+
+// MockResumableEventSourceSubscribeFromCall wrap *gomock.Call
+type MockResumableEventSourceSubscribeFromCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResumableEventSourceSubscribeFromCall) Return(arg0 changestream.Subscription, arg1 error) *MockResumableEventSourceSubscribeFromCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResumableEventSourceSubscribeFromCall) Do(f func(int64, ...changestream.SubscriptionOption) (changestream.Subscription, error)) *MockResumableEventSourceSubscribeFromCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResumableEventSourceSubscribeFromCall) DoAndReturn(f func(int64, ...changestream.SubscriptionOption) (changestream.Subscription, error)) *MockResumableEventSourceSubscribeFromCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -18,7 +18,7 @@ import (
 	coretesting "github.com/juju/juju/internal/testing"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package eventsource -destination changestream_mock_test.go github.com/juju/juju/core/changestream Subscription,WatchableDB,EventSource
+//go:generate go run go.uber.org/mock/mockgen -typed -package eventsource -destination changestream_mock_test.go github.com/juju/juju/core/changestream Subscription,WatchableDB,EventSource,ResumableEventSource
 //go:generate go run go.uber.org/mock/mockgen -typed -package eventsource -destination watcher_mock_test.go -source=./consume.go
 
 type ImportTest struct{}
@@ -64,11 +64,18 @@ type watchableDBShim struct {
 	changestream.EventSource
 }
 
+type resumableWatchableDBShim struct {
+	database.TxnRunner
+	changestream.EventSource
+	changestream.ResumableEventSource
+}
+
 type baseSuite struct {
 	dbtesting.DqliteSuite
 
 	watchableDB watchableDBShim
 	eventsource *MockEventSource
+	resumable   *MockResumableEventSource
 	sub         *MockSubscription
 }
 
@@ -76,6 +83,7 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.eventsource = NewMockEventSource(ctrl)
+	s.resumable = NewMockResumableEventSource(ctrl)
 	s.watchableDB = watchableDBShim{
 		TxnRunner:   s.TxnRunner(),
 		EventSource: s.eventsource,
@@ -5,6 +5,7 @@ package charm
 
 import (
 	"io"
+	"time"
 
 	"github.com/juju/juju/core/arch"
 	"github.com/juju/juju/core/charm"
@@ -323,6 +324,7 @@ type Metadata struct {
 	Containers     map[string]Container
 	Assumes        []byte
 	RunAs          RunAs
+	Schedules      map[string]Schedule
 }
 
 // RunAs defines which user to run a certain process as.
@@ -372,6 +374,13 @@ type ExtraBinding struct {
 	Name string
 }
 
+// Schedule is a named schedule on which the scheduled hook is run.
+type Schedule struct {
+	Name   string
+	Cron   string
+	Jitter time.Duration
+}
+
 // StorageType defines a storage type.
 type StorageType string
 
@@ -222,6 +222,14 @@ const (
 	// config is not valid.
 	InvalidApplicationConfig = errors.ConstError("invalid application config")
 
+	// ScheduleNotFound describes an error that occurs when a schedule is not
+	// declared by the charm of an application.
+	ScheduleNotFound = errors.ConstError("schedule not found")
+
+	// InvalidSchedule describes an error that occurs when a schedule
+	// override is not valid.
+	InvalidSchedule = errors.ConstError("invalid schedule")
+
 	// ApplicationHasDifferentCharm describes an error that occurs when the
 	// application has a different charm.
 	ApplicationHasDifferentCharm = errors.ConstError("application has different charm")
@@ -79,6 +79,13 @@ type ExportService interface {
 	// is set for the application, an empty config is returned.
 	GetApplicationConfigAndSettings(ctx context.Context, name string) (config.ConfigAttributes, application.ApplicationSettings, error)
 
+	// GetApplicationSchedules returns the schedules declared by the charm of
+	// the specified application, along with any override of them.
+	//
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	GetApplicationSchedules(ctx context.Context, name string) ([]application.ApplicationSchedule, error)
+
 	// GetApplicationConstraints returns the application constraints for the
 	// specified application name.
 	// Empty constraints are returned if no constraints exist for the given
@@ -199,9 +206,23 @@ func (e *exportOperation) Execute(ctx context.Context, m description.Model) erro
 		// config is the application config overlaid from the charm config. The
 		// application config, is the application settings.
 		descriptionApp.SetCharmConfig(config)
-		descriptionApp.SetApplicationConfig(map[string]any{
+		appSettings := map[string]any{
 			coreapplication.TrustConfigOptionName: settings.Trust,
-		})
+		}
+		if settings.HookTimeout != nil {
+			appSettings[coreapplication.HookTimeoutConfigOptionName] = settings.HookTimeout.String()
+		}
+
+		schedules, err := e.service.GetApplicationSchedules(ctx, app.Name)
+		if err != nil {
+			return errors.Errorf("getting application schedules for %q: %w", app.Name, err)
+		}
+		if len(schedules) > 0 {
+			if appSettings[schedulesSettingName], err = encodeSchedules(schedules); err != nil {
+				return errors.Errorf("exporting application schedules for %q: %w", app.Name, err)
+			}
+		}
+		descriptionApp.SetApplicationConfig(appSettings)
 
 		charm, _, err := e.service.GetCharmByApplicationName(ctx, app.Name)
 		if err != nil {
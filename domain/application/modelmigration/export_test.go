@@ -5,6 +5,7 @@ package modelmigration
 
 import (
 	"testing"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/description/v10"
@@ -329,6 +330,56 @@ func (s *exportApplicationSuite) TestApplicationExportConstraints(c *tc.C) {
 	c.Check(app.Constraints().Zones(), tc.DeepEquals, []string{"zone0", "zone1"})
 }
 
+func (s *exportApplicationSuite) TestApplicationExportSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectApplication(c)
+	s.expectMinimalCharm()
+	s.expectApplicationUnits()
+	s.expectApplicationConstraints(constraints.Value{})
+	s.exportService.EXPECT().IsApplicationExposed(gomock.Any(), "prometheus").Return(false, nil)
+	s.exportService.EXPECT().GetApplicationConfigAndSettings(gomock.Any(), "prometheus").Return(
+		nil, application.ApplicationSettings{Trust: true}, nil,
+	)
+	s.exportService.EXPECT().GetApplicationSchedules(gomock.Any(), "prometheus").Return([]application.ApplicationSchedule{{
+		Name:      "hourly-report",
+		Cron:      "@hourly",
+		CharmCron: "@hourly",
+		Jitter:    time.Minute,
+		Override: application.ScheduleOverride{
+			Jitter: ptr(time.Minute),
+		},
+	}, {
+		Name:        "nightly-backup",
+		Cron:        "30 1 * * *",
+		CharmCron:   "0 3 * * *",
+		Jitter:      10 * time.Minute,
+		CharmJitter: 10 * time.Minute,
+		Override: application.ScheduleOverride{
+			Cron: ptr("30 1 * * *"),
+		},
+	}}, nil)
+
+	exportOp := s.newExportOperation()
+
+	model := description.NewModel(description.ModelArgs{
+		Type: "iaas",
+	})
+
+	err := exportOp.Execute(c.Context(), model)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(model.Applications(), tc.HasLen, 1)
+
+	// Both the schedules declared by the charm and the overrides of them
+	// are exported.
+	app := model.Applications()[0]
+	c.Check(app.ApplicationConfig(), tc.DeepEquals, map[string]any{
+		"trust": true,
+		"schedules": `{"hourly-report":{"cron":"@hourly","override-jitter":"1m0s"},` +
+			`"nightly-backup":{"cron":"0 3 * * *","jitter":"10m0s","override-cron":"30 1 * * *"}}`,
+	})
+}
+
 func (s *exportApplicationSuite) TestExportScalingState(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
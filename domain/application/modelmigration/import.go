@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/juju/clock"
 	"github.com/juju/collections/set"
@@ -146,11 +147,17 @@ func (i *importOperation) Execute(ctx context.Context, model description.Model)
 			return errors.Errorf("parsing charm URL %q: %w", app.CharmURL(), err)
 		}
 
+		schedules, scheduleOverrides, err := i.importSchedules(app)
+		if err != nil {
+			return errors.Errorf("importing application schedules: %w", err)
+		}
+
 		charm, err := i.importCharm(ctx, charmData{
-			Metadata: app.CharmMetadata(),
-			Manifest: app.CharmManifest(),
-			Actions:  app.CharmActions(),
-			Config:   app.CharmConfigs(),
+			Metadata:  app.CharmMetadata(),
+			Manifest:  app.CharmManifest(),
+			Actions:   app.CharmActions(),
+			Config:    app.CharmConfigs(),
+			Schedules: schedules,
 		})
 		if err != nil {
 			return errors.Errorf("importing model application %q charm: %w", app.Name(), err)
@@ -205,6 +212,7 @@ func (i *importOperation) Execute(ctx context.Context, model description.Model)
 			Units:                  unitArgs,
 			ApplicationConfig:      applicationConfig,
 			ApplicationSettings:    applicationSettings,
+			ScheduleOverrides:      scheduleOverrides,
 			ApplicationConstraints: i.importApplicationConstraints(app),
 			ScaleState:             scaleState,
 			EndpointBindings:       endpointBindings,
@@ -292,8 +300,15 @@ func (i *importOperation) importApplicationSettings(app description.Application)
 	appSettings := app.ApplicationConfig()
 	if len(appSettings) == 0 {
 		return application.ApplicationSettings{}, nil
-	} else if len(appSettings) > 1 {
-		return application.ApplicationSettings{}, errors.Errorf("application %q has multiple settings, expected only one", app.Name())
+	}
+	for key := range appSettings {
+		switch key {
+		case coreapplication.TrustConfigOptionName,
+			coreapplication.HookTimeoutConfigOptionName,
+			schedulesSettingName:
+		default:
+			return application.ApplicationSettings{}, errors.Errorf("application %q has unexpected setting %q", app.Name(), key)
+		}
 	}
 
 	var trust bool
@@ -307,15 +322,46 @@ func (i *importOperation) importApplicationSettings(app description.Application)
 		}
 	case bool:
 		trust = t
+	case nil:
+		// Only other settings were set.
 	default:
 		return application.ApplicationSettings{}, errors.Errorf("trust value %q is not a boolean", trustValue)
 	}
 
+	var hookTimeout *time.Duration
+	if value, ok := appSettings[coreapplication.HookTimeoutConfigOptionName]; ok {
+		t, ok := value.(string)
+		if !ok {
+			return application.ApplicationSettings{}, errors.Errorf("hook timeout value %q is not a string", value)
+		}
+		timeout, err := time.ParseDuration(t)
+		if err != nil {
+			return application.ApplicationSettings{}, errors.Errorf("parsing hook timeout value %q: %w", t, err)
+		}
+		hookTimeout = &timeout
+	}
+
 	return application.ApplicationSettings{
-		Trust: trust,
+		Trust:       trust,
+		HookTimeout: hookTimeout,
 	}, nil
 }
 
+// importSchedules returns the schedules declared by the charm of the
+// application, and the overrides of them, from the schedules application
+// setting.
+func (i *importOperation) importSchedules(app description.Application) (map[string]internalcharm.Schedule, map[string]application.ScheduleOverride, error) {
+	value, ok := app.ApplicationConfig()[schedulesSettingName]
+	if !ok {
+		return nil, nil, nil
+	}
+	schedules, overrides, err := decodeSchedules(value)
+	if err != nil {
+		return nil, nil, errors.Errorf("application %q: %w", app.Name(), err)
+	}
+	return schedules, overrides, nil
+}
+
 func (i *importOperation) importApplicationConstraints(app description.Application) constraints.Value {
 	result := constraints.Value{}
 
@@ -467,6 +513,10 @@ type charmData struct {
 	Manifest description.CharmManifest
 	Actions  description.CharmActions
 	Config   description.CharmConfigs
+
+	// Schedules are the schedules declared by the charm, which aren't
+	// described by the charm metadata.
+	Schedules map[string]internalcharm.Schedule
 }
 
 // Import the application charm description from the migrating model into
@@ -488,6 +538,7 @@ func (i *importOperation) importCharm(ctx context.Context, data charmData) (inte
 	if err != nil {
 		return nil, errors.Errorf("import charm metadata: %w", err)
 	}
+	metadata.Schedules = data.Schedules
 
 	manifest, err := i.importCharmManifest(data.Manifest)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/description/v10"
@@ -327,6 +328,110 @@ func (s *importSuite) TestApplicationImportWithApplicationConfigAndSettings(c *t
 	})
 }
 
+func (s *importSuite) TestApplicationImportWithSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	model := description.NewModel(description.ModelArgs{
+		Type: coremodel.IAAS.String(),
+	})
+
+	appArgs := description.ApplicationArgs{
+		Name:     "prometheus",
+		CharmURL: "ch:prometheus-1",
+		ApplicationConfig: map[string]interface{}{
+			"trust": true,
+			"schedules": `{"hourly-report":{"cron":"@hourly","override-jitter":"1m0s"},` +
+				`"nightly-backup":{"cron":"0 3 * * *","jitter":"10m0s","override-cron":"30 1 * * *"}}`,
+		},
+	}
+	app := model.AddApplication(appArgs)
+	app.SetCharmMetadata(description.CharmMetadataArgs{
+		Name: "prometheus",
+	})
+	app.SetCharmManifest(description.CharmManifestArgs{
+		Bases: []description.CharmManifestBase{baseType{
+			name:          "ubuntu",
+			channel:       "24.04",
+			architectures: []string{"amd64"},
+		}},
+	})
+	app.SetCharmOrigin(description.CharmOriginArgs{
+		Source:   "charm-hub",
+		ID:       "1234",
+		Hash:     "deadbeef",
+		Revision: 1,
+		Channel:  "666/stable",
+		Platform: "arm64/ubuntu/24.04",
+	})
+
+	var importArgs service.ImportApplicationArgs
+	s.importService.EXPECT().ImportIAASApplication(
+		gomock.Any(),
+		"prometheus",
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, args service.ImportApplicationArgs) error {
+		importArgs = args
+		return nil
+	})
+
+	importOp := importOperation{
+		service: s.importService,
+		logger:  loggertesting.WrapCheckLog(c),
+	}
+
+	err := importOp.Execute(c.Context(), model)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The schedules declared by the charm are imported with the charm, and
+	// the overrides of them with the application.
+	c.Check(importArgs.Charm.Meta().Schedules, tc.DeepEquals, map[string]internalcharm.Schedule{
+		"hourly-report": {
+			Name: "hourly-report",
+			Cron: "@hourly",
+		},
+		"nightly-backup": {
+			Name:   "nightly-backup",
+			Cron:   "0 3 * * *",
+			Jitter: 10 * time.Minute,
+		},
+	})
+	c.Check(importArgs.ScheduleOverrides, tc.DeepEquals, map[string]application.ScheduleOverride{
+		"hourly-report": {
+			Jitter: ptr(time.Minute),
+		},
+		"nightly-backup": {
+			Cron: ptr("30 1 * * *"),
+		},
+	})
+	c.Check(importArgs.ApplicationSettings, tc.DeepEquals, application.ApplicationSettings{
+		Trust: true,
+	})
+}
+
+func (s *importSuite) TestApplicationImportWithInvalidSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	model := description.NewModel(description.ModelArgs{
+		Type: coremodel.IAAS.String(),
+	})
+
+	model.AddApplication(description.ApplicationArgs{
+		Name:     "prometheus",
+		CharmURL: "ch:prometheus-1",
+		ApplicationConfig: map[string]interface{}{
+			"schedules": `{"nightly-backup":{"cron":"0 3 * * *","jitter":"soon"}}`,
+		},
+	})
+
+	importOp := importOperation{
+		service: s.importService,
+		logger:  loggertesting.WrapCheckLog(c),
+	}
+
+	err := importOp.Execute(c.Context(), model)
+	c.Assert(err, tc.ErrorMatches, `.*parsing jitter of schedule "nightly-backup".*`)
+}
+
 func (s *importSuite) TestApplicationImportWithConstraints(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
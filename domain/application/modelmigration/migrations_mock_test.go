@@ -381,6 +381,45 @@ func (c *MockExportServiceGetApplicationScaleStateCall) DoAndReturn(f func(conte
 	return c
 }
 
+// GetApplicationSchedules mocks base method.
+func (m *MockExportService) GetApplicationSchedules(arg0 context.Context, arg1 string) ([]application.ApplicationSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationSchedules", arg0, arg1)
+	ret0, _ := ret[0].([]application.ApplicationSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationSchedules indicates an expected call of GetApplicationSchedules.
+func (mr *MockExportServiceMockRecorder) GetApplicationSchedules(arg0, arg1 any) *MockExportServiceGetApplicationSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationSchedules", reflect.TypeOf((*MockExportService)(nil).GetApplicationSchedules), arg0, arg1)
+	return &MockExportServiceGetApplicationSchedulesCall{Call: call}
+}
+
+// MockExportServiceGetApplicationSchedulesCall wrap *gomock.Call
+type MockExportServiceGetApplicationSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportServiceGetApplicationSchedulesCall) Return(arg0 []application.ApplicationSchedule, arg1 error) *MockExportServiceGetApplicationSchedulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportServiceGetApplicationSchedulesCall) Do(f func(context.Context, string) ([]application.ApplicationSchedule, error)) *MockExportServiceGetApplicationSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportServiceGetApplicationSchedulesCall) DoAndReturn(f func(context.Context, string) ([]application.ApplicationSchedule, error)) *MockExportServiceGetApplicationSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationUnits mocks base method.
 func (m *MockExportService) GetApplicationUnits(arg0 context.Context, arg1 string) ([]application.ExportUnit, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +119,7 @@ func (s *exportSuite) expectApplicationConfigFor(name string) {
 		Trust: true,
 	}
 	s.exportService.EXPECT().GetApplicationConfigAndSettings(gomock.Any(), name).Return(config, settings, nil)
+	s.exportService.EXPECT().GetApplicationSchedules(gomock.Any(), name).Return(nil, nil)
 }
 
 func (s *exportSuite) expectGetApplicationScaleStateFor(name string, scaleState application.ScaleState) {
@@ -0,0 +1,108 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmigration
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/juju/juju/domain/application"
+	internalcharm "github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/errors"
+)
+
+// schedulesSettingName is the application setting which holds the schedules
+// declared by the charm of an application, along with their overrides. The
+// description package doesn't describe schedules, so they are migrated as a
+// JSON encoded application setting instead.
+const schedulesSettingName = "schedules"
+
+// migratedSchedule is a schedule declared by the charm of an application, as
+// it's encoded in the schedules application setting.
+type migratedSchedule struct {
+	// Cron is the cron expression declared by the charm.
+	Cron string `json:"cron"`
+
+	// Jitter is the jitter declared by the charm.
+	Jitter string `json:"jitter,omitempty"`
+
+	// OverrideCron is the cron expression set by the operator, if any.
+	OverrideCron *string `json:"override-cron,omitempty"`
+
+	// OverrideJitter is the jitter set by the operator, if any.
+	OverrideJitter *string `json:"override-jitter,omitempty"`
+}
+
+// encodeSchedules encodes the schedules of an application for the schedules
+// application setting.
+func encodeSchedules(schedules []application.ApplicationSchedule) (string, error) {
+	result := make(map[string]migratedSchedule, len(schedules))
+	for _, s := range schedules {
+		schedule := migratedSchedule{
+			Cron:         s.CharmCron,
+			OverrideCron: s.Override.Cron,
+		}
+		if s.CharmJitter != 0 {
+			schedule.Jitter = s.CharmJitter.String()
+		}
+		if s.Override.Jitter != nil {
+			jitter := s.Override.Jitter.String()
+			schedule.OverrideJitter = &jitter
+		}
+		result[s.Name] = schedule
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", errors.Errorf("encoding schedules: %w", err)
+	}
+	return string(data), nil
+}
+
+// decodeSchedules decodes the schedules application setting into the
+// schedules declared by the charm, and the overrides of them.
+func decodeSchedules(value any) (map[string]internalcharm.Schedule, map[string]application.ScheduleOverride, error) {
+	data, ok := value.(string)
+	if !ok {
+		return nil, nil, errors.Errorf("schedules value %q is not a string", value)
+	}
+
+	var migrated map[string]migratedSchedule
+	if err := json.Unmarshal([]byte(data), &migrated); err != nil {
+		return nil, nil, errors.Errorf("decoding schedules: %w", err)
+	}
+
+	schedules := make(map[string]internalcharm.Schedule, len(migrated))
+	overrides := make(map[string]application.ScheduleOverride)
+	for name, s := range migrated {
+		schedule := internalcharm.Schedule{
+			Name: name,
+			Cron: s.Cron,
+		}
+		if s.Jitter != "" {
+			jitter, err := time.ParseDuration(s.Jitter)
+			if err != nil {
+				return nil, nil, errors.Errorf("parsing jitter of schedule %q: %w", name, err)
+			}
+			schedule.Jitter = jitter
+		}
+		schedules[name] = schedule
+
+		if s.OverrideCron == nil && s.OverrideJitter == nil {
+			continue
+		}
+		override := application.ScheduleOverride{
+			Cron: s.OverrideCron,
+		}
+		if s.OverrideJitter != nil {
+			jitter, err := time.ParseDuration(*s.OverrideJitter)
+			if err != nil {
+				return nil, nil, errors.Errorf("parsing jitter override of schedule %q: %w", name, err)
+			}
+			override.Jitter = &jitter
+		}
+		overrides[name] = override
+	}
+	return schedules, overrides, nil
+}
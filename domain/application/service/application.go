@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/collections/transform"
@@ -239,6 +240,34 @@ type ApplicationState interface {
 	// [applicationerrors.ApplicationNotFound] is returned.
 	GetApplicationTrustSetting(ctx context.Context, appID coreapplication.ID) (bool, error)
 
+	// GetApplicationHookTimeout returns the application hook timeout
+	// setting. A nil duration is returned if the application does not
+	// override the model hook timeout.
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	GetApplicationHookTimeout(ctx context.Context, appID coreapplication.ID) (*time.Duration, error)
+
+	// GetApplicationSchedules returns the schedules declared by the charm of
+	// the application, ordered by name, along with any override of them.
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	GetApplicationSchedules(ctx context.Context, appID coreapplication.ID) ([]application.ApplicationSchedule, error)
+
+	// SetApplicationScheduleOverrides overrides the cron expression or
+	// jitter of schedules declared by the charm of the application.
+	// The following errors may be returned:
+	//   - [applicationerrors.ApplicationNotFound] if the application doesn't
+	//     exist
+	//   - [applicationerrors.ScheduleNotFound] if a schedule is not declared
+	//     by the charm of the application
+	SetApplicationScheduleOverrides(ctx context.Context, appID coreapplication.ID, overrides map[string]application.ScheduleOverride) error
+
+	// UnsetApplicationScheduleOverrides removes the overrides of the named
+	// schedules.
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	UnsetApplicationScheduleOverrides(ctx context.Context, appID coreapplication.ID, names []string) error
+
 	// UpdateApplicationConfigAndSettings sets the application config attributes
 	// using the configuration, and sets the trust setting as part of the
 	// application.
@@ -338,6 +367,10 @@ type ApplicationState interface {
 	// for application setting changes.
 	NamespaceForWatchApplicationSetting() string
 
+	// NamespaceForWatchApplicationSchedule returns the namespace identifier
+	// for changes to the schedule overrides of applications.
+	NamespaceForWatchApplicationSchedule() string
+
 	// NamespaceForWatchApplicationScale returns the namespace identifier
 	// for application scale change watchers.
 	NamespaceForWatchApplicationScale() string
@@ -1246,6 +1279,12 @@ func (s *Service) GetApplicationConfig(ctx context.Context, appID coreapplicatio
 	// Always return the trust setting, as it's a special case.
 	result[coreapplication.TrustConfigOptionName] = settings.Trust
 
+	// The hook timeout is only returned if the application overrides the
+	// model default.
+	if settings.HookTimeout != nil {
+		result[coreapplication.HookTimeoutConfigOptionName] = settings.HookTimeout.String()
+	}
+
 	return result, nil
 }
 
@@ -1291,6 +1330,23 @@ func (s *Service) GetApplicationTrustSetting(ctx context.Context, appName string
 	return s.st.GetApplicationTrustSetting(ctx, appID)
 }
 
+// GetApplicationHookTimeout returns the application hook timeout setting. A
+// nil duration is returned if the application does not override the model
+// hook timeout.
+// The following errors may be returned:
+// - [applicationerrors.ApplicationNotFound] if the application doesn't exist
+func (s *Service) GetApplicationHookTimeout(ctx context.Context, appName string) (*time.Duration, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return s.st.GetApplicationHookTimeout(ctx, appID)
+}
+
 // GetApplicationCharmOrigin returns the charm origin for the specified
 // application name. If the application does not exist, an error satisfying
 // [applicationerrors.ApplicationNotFound] is returned.
@@ -1358,6 +1414,7 @@ func (s *Service) GetApplicationAndCharmConfig(ctx context.Context, appID coreap
 		CharmConfig:       decodedCharmConfig,
 		ApplicationConfig: result,
 		Trust:             settings.Trust,
+		HookTimeout:       settings.HookTimeout,
 		Principal:         !subordinate,
 	}, nil
 }
@@ -1415,11 +1472,16 @@ func (s *Service) UpdateApplicationConfig(ctx context.Context, appID coreapplica
 		return errors.Capture(err)
 	}
 
-	// Grab the application settings, which is currently just the trust setting.
+	// Grab the application settings, which are the trust and hook timeout
+	// settings.
 	trust, err := getTrustSettingFromConfig(newConfig)
 	if err != nil {
 		return errors.Capture(err)
 	}
+	hookTimeout, err := getHookTimeoutSettingFromConfig(newConfig)
+	if err != nil {
+		return errors.Capture(err)
+	}
 
 	// Everything else from the newConfig is just application config. Treat it
 	// as such.
@@ -1459,7 +1521,8 @@ func (s *Service) UpdateApplicationConfig(ctx context.Context, appID coreapplica
 	}
 
 	return s.st.UpdateApplicationConfigAndSettings(ctx, appID, encodedConfig, application.UpdateApplicationSettingsArg{
-		Trust: trust,
+		Trust:       trust,
+		HookTimeout: hookTimeout,
 	})
 }
 
@@ -1614,6 +1677,24 @@ func getTrustSettingFromConfig(cfg map[string]string) (*bool, error) {
 	return &b, nil
 }
 
+func getHookTimeoutSettingFromConfig(cfg map[string]string) (*time.Duration, error) {
+	value, ok := cfg[coreapplication.HookTimeoutConfigOptionName]
+	if !ok {
+		// hook-timeout is not included, so we should not update it.
+		return nil, nil
+	}
+
+	delete(cfg, coreapplication.HookTimeoutConfigOptionName)
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, errors.Errorf("%w: parsing hook timeout: %w", applicationerrors.InvalidApplicationConfig, err)
+	} else if timeout < 0 {
+		return nil, errors.Errorf("%w: hook timeout %v cannot be negative", applicationerrors.InvalidApplicationConfig, timeout)
+	}
+	return &timeout, nil
+}
+
 func encodeApplicationConfig(cfg config.ConfigAttributes, charmConfig charm.Config) (map[string]application.ApplicationConfig, error) {
 	// If there is no config, then we can just return nil.
 	if len(cfg) == 0 {
@@ -569,6 +569,24 @@ func (s *applicationServiceSuite) TestGetApplicationConfigNoConfigWithTrust(c *t
 	})
 }
 
+func (s *applicationServiceSuite) TestGetApplicationConfigWithHookTimeout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetApplicationConfigAndSettings(gomock.Any(), appUUID).
+		Return(map[string]application.ApplicationConfig{}, application.ApplicationSettings{
+			HookTimeout: ptr(90 * time.Second),
+		}, nil)
+
+	results, err := s.service.GetApplicationConfig(c.Context(), appUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, config.ConfigAttributes{
+		"trust":        false,
+		"hook-timeout": "1m30s",
+	})
+}
+
 func (s *applicationServiceSuite) TestGetApplicationConfigInvalidApplicationID(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -598,6 +616,28 @@ func (s *applicationServiceSuite) TestGetApplicationTrustSettingNotFound(c *tc.C
 	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
 }
 
+func (s *applicationServiceSuite) TestGetApplicationHookTimeout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().GetApplicationHookTimeout(gomock.Any(), appUUID).Return(ptr(time.Minute), nil)
+
+	result, err := s.service.GetApplicationHookTimeout(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, ptr(time.Minute))
+}
+
+func (s *applicationServiceSuite) TestGetApplicationHookTimeoutNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return("", applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.GetApplicationHookTimeout(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
 func (s *applicationServiceSuite) TestGetApplicationCharmOrigin(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -720,6 +760,53 @@ func (s *applicationServiceSuite) TestUpdateApplicationConfigRemoveTrust(c *tc.C
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *applicationServiceSuite) TestUpdateApplicationConfigHookTimeout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetCharmConfigByApplicationID(gomock.Any(), appUUID).Return("", applicationcharm.Config{
+		Options: map[string]applicationcharm.Option{
+			"foo": {
+				Type:    applicationcharm.OptionString,
+				Default: "baz",
+			},
+		},
+	}, nil)
+	s.state.EXPECT().UpdateApplicationConfigAndSettings(gomock.Any(), appUUID, map[string]application.ApplicationConfig{
+		"foo": {
+			Type:  applicationcharm.OptionString,
+			Value: "bar",
+		},
+	}, application.UpdateApplicationSettingsArg{
+		HookTimeout: ptr(10 * time.Minute),
+	}).Return(nil)
+
+	err := s.service.UpdateApplicationConfig(c.Context(), appUUID, map[string]string{
+		"hook-timeout": "10m",
+		"foo":          "bar",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationServiceSuite) TestUpdateApplicationConfigInvalidHookTimeout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetCharmConfigByApplicationID(gomock.Any(), appUUID).Return("", applicationcharm.Config{}, nil).Times(2)
+
+	err := s.service.UpdateApplicationConfig(c.Context(), appUUID, map[string]string{
+		"hook-timeout": "soon",
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidApplicationConfig)
+
+	err = s.service.UpdateApplicationConfig(c.Context(), appUUID, map[string]string{
+		"hook-timeout": "-1m",
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidApplicationConfig)
+}
+
 func (s *applicationServiceSuite) TestUpdateApplicationConfigNoTrust(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -102,6 +102,11 @@ type CharmState interface {
 	// If the charm does not exist, a [errors.CharmNotFound] error is returned.
 	GetCharmArchiveMetadata(context.Context, corecharm.ID) (archivePath string, hash string, err error)
 
+	// GetCharmArchiveSize returns the size in bytes of the charm's stored
+	// archive. If the charm does not exist, or its archive has not been
+	// stored, a [applicationerrors.CharmNotFound] error is returned.
+	GetCharmArchiveSize(context.Context, corecharm.ID) (int64, error)
+
 	// IsCharmAvailable returns whether the charm is available for use. If the
 	// charm does not exist, a [applicationerrors.CharmNotFound] error is
 	// returned.
@@ -631,6 +636,28 @@ func (s *Service) GetCharmArchive(ctx context.Context, locator charm.CharmLocato
 	return reader, hash, nil
 }
 
+// GetCharmArchiveSize returns the size in bytes of the archive for the charm
+// using the charm name, source and revision. The size is that recorded in
+// the object store metadata, so the archive is not read.
+//
+// If the charm does not exist, or its archive has not been stored, a
+// [applicationerrors.CharmNotFound] error is returned.
+func (s *Service) GetCharmArchiveSize(ctx context.Context, locator charm.CharmLocator) (int64, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	args := argsFromLocator(locator)
+	id, err := s.getCharmID(ctx, args)
+	if err != nil {
+		return -1, errors.Errorf("charm id: %w", err)
+	}
+	size, err := s.st.GetCharmArchiveSize(ctx, id)
+	if err != nil {
+		return -1, errors.Errorf("getting charm archive size: %w", err)
+	}
+	return size, nil
+}
+
 // GetCharmArchiveBySHA256Prefix returns a ReadCloser stream for the charm
 // archive who's SHA256 hash starts with the provided prefix.
 //
@@ -680,6 +680,24 @@ func (s *charmServiceSuite) TestGetCharmArchive(c *tc.C) {
 	c.Check(string(content), tc.Equals, "archive-content")
 }
 
+func (s *charmServiceSuite) TestGetCharmArchiveSize(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := charmtesting.GenCharmID(c)
+
+	locator := charm.CharmLocator{
+		Name:     "foo",
+		Revision: 42,
+		Source:   charm.CharmHubSource,
+	}
+	s.state.EXPECT().GetCharmID(gomock.Any(), locator.Name, locator.Revision, locator.Source).Return(id, nil)
+	s.state.EXPECT().GetCharmArchiveSize(gomock.Any(), id).Return(int64(1024), nil)
+
+	size, err := s.service.GetCharmArchiveSize(c.Context(), locator)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(size, tc.Equals, int64(1024))
+}
+
 func (s *charmServiceSuite) TestGetCharmArchiveBySHA256Prefix(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
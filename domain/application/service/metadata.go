@@ -88,9 +88,26 @@ func decodeMetadata(metadata charm.Metadata) (internalcharm.Meta, error) {
 		Containers:     containers,
 		Assumes:        assumes,
 		CharmUser:      charmUser,
+		Schedules:      decodeMetadataSchedules(metadata.Schedules),
 	}, nil
 }
 
+func decodeMetadataSchedules(schedules map[string]charm.Schedule) map[string]internalcharm.Schedule {
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	result := make(map[string]internalcharm.Schedule, len(schedules))
+	for k, v := range schedules {
+		result[k] = internalcharm.Schedule{
+			Name:   v.Name,
+			Cron:   v.Cron,
+			Jitter: v.Jitter,
+		}
+	}
+	return result
+}
+
 func decodeMetadataRelation(relations map[string]charm.Relation) (map[string]internalcharm.Relation, error) {
 	if len(relations) == 0 {
 		return nil, nil
@@ -395,6 +412,7 @@ func encodeMetadata(metadata *internalcharm.Meta) (charm.Metadata, error) {
 		Containers:     containers,
 		Assumes:        assumes,
 		RunAs:          charmUser,
+		Schedules:      encodeMetadataSchedules(metadata.Schedules),
 	}, nil
 }
 
@@ -735,6 +753,22 @@ func encodeMetadataRunAs(charmUser internalcharm.RunAs) (charm.RunAs, error) {
 	}
 }
 
+func encodeMetadataSchedules(schedules map[string]internalcharm.Schedule) map[string]charm.Schedule {
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	result := make(map[string]charm.Schedule, len(schedules))
+	for k, v := range schedules {
+		result[k] = charm.Schedule{
+			Name:   v.Name,
+			Cron:   v.Cron,
+			Jitter: v.Jitter,
+		}
+	}
+	return result
+}
+
 func encodeMetadataExtraBindings(bindings map[string]internalcharm.ExtraBinding) map[string]charm.ExtraBinding {
 	if len(bindings) == 0 {
 		return nil
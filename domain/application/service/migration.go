@@ -250,6 +250,27 @@ func (s *MigrationService) GetApplicationConfigAndSettings(ctx context.Context,
 	return result, settings, nil
 }
 
+// GetApplicationSchedules returns the schedules declared by the charm of the
+// specified application, ordered by name, along with any override of them.
+//
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *MigrationService) GetApplicationSchedules(ctx context.Context, name string) ([]application.ApplicationSchedule, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !isValidApplicationName(name) {
+		return nil, applicationerrors.ApplicationNameNotValid
+	}
+
+	appID, err := s.st.GetApplicationIDByName(ctx, name)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return s.st.GetApplicationSchedules(ctx, appID)
+}
+
 // GetApplicationConstraints returns the application constraints for the
 // specified application name.
 // Empty constraints are returned if no constraints exist for the given
@@ -368,6 +389,10 @@ func (s *MigrationService) importApplication(
 		return "", "", errors.Errorf("invalid application args: %w", err)
 	}
 
+	if err := validateScheduleOverrides(args.ScheduleOverrides); err != nil {
+		return "", "", errors.Errorf("invalid application args: %w", err)
+	}
+
 	appArg, err := makeInsertApplicationArg(args)
 	if err != nil {
 		return "", "", errors.Errorf("creating application args: %w", err)
@@ -391,6 +416,11 @@ func (s *MigrationService) importApplication(
 	if err := s.st.SetApplicationConstraints(ctx, appID, constraints.DecodeConstraints(args.ApplicationConstraints)); err != nil {
 		return "", "", errors.Errorf("setting application constraints for application %q: %w", name, err)
 	}
+	if len(args.ScheduleOverrides) > 0 {
+		if err := s.st.SetApplicationScheduleOverrides(ctx, appID, args.ScheduleOverrides); err != nil {
+			return "", "", errors.Errorf("setting schedule overrides for application %q: %w", name, err)
+		}
+	}
 
 	return appID, charmUUID, nil
 }
@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/tc"
@@ -336,6 +337,36 @@ func (s *migrationServiceSuite) TestGetApplicationConfigAndSettings(c *tc.C) {
 	})
 }
 
+func (s *migrationServiceSuite) TestGetApplicationSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	schedules := []application.ApplicationSchedule{{
+		Name:        "nightly-backup",
+		Cron:        "30 1 * * *",
+		Jitter:      10 * time.Minute,
+		CharmCron:   "0 3 * * *",
+		CharmJitter: 10 * time.Minute,
+		Override: application.ScheduleOverride{
+			Cron: ptr("30 1 * * *"),
+		},
+	}}
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().GetApplicationSchedules(gomock.Any(), appUUID).Return(schedules, nil)
+
+	result, err := s.service.GetApplicationSchedules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, schedules)
+}
+
+func (s *migrationServiceSuite) TestGetApplicationSchedulesInvalidApplicationName(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.GetApplicationSchedules(c.Context(), "!!!")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNameNotValid)
+}
+
 func (s *migrationServiceSuite) TestGetApplicationConfigWithNameError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -411,6 +442,35 @@ func (s *migrationServiceSuite) TestImportCAASApplication(c *tc.C) {
 	s.assertImportApplication(c, coremodel.CAAS)
 }
 
+func (s *migrationServiceSuite) TestImportApplicationInvalidScheduleOverride(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charm.EXPECT().Meta().Return(&charm.Meta{
+		Name: "ubuntu",
+	}).AnyTimes()
+	s.charm.EXPECT().Manifest().Return(&charm.Manifest{
+		Bases: []charm.Base{{
+			Name:          "ubuntu",
+			Channel:       charm.Channel{Risk: charm.Stable},
+			Architectures: []string{"amd64"},
+		}},
+	}).AnyTimes()
+
+	err := s.service.ImportIAASApplication(c.Context(), "ubuntu", ImportApplicationArgs{
+		Charm: s.charm,
+		CharmOrigin: corecharm.Origin{
+			Source:   corecharm.CharmHub,
+			Platform: corecharm.MustParsePlatform("arm64/ubuntu/24.04"),
+			Revision: ptr(42),
+		},
+		ReferenceName: "ubuntu",
+		ScheduleOverrides: map[string]application.ScheduleOverride{
+			"nightly-backup": {Cron: ptr("not a cron expression")},
+		},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidSchedule)
+}
+
 func (s *migrationServiceSuite) assertImportApplication(c *tc.C, modelType coremodel.ModelType) {
 	defer s.setupMocks(c).Finish()
 
@@ -512,6 +572,9 @@ func (s *migrationServiceSuite) assertImportApplication(c *tc.C, modelType corem
 	}
 
 	s.state.EXPECT().SetApplicationConstraints(gomock.Any(), id, domainconstraints.DecodeConstraints(cons)).Return(nil)
+	s.state.EXPECT().SetApplicationScheduleOverrides(gomock.Any(), id, map[string]application.ScheduleOverride{
+		"nightly-backup": {Cron: ptr("30 1 * * *")},
+	}).Return(nil)
 
 	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "ubuntu").Return(charmUUID, nil)
 	s.state.EXPECT().MergeExposeSettings(gomock.Any(), id, map[string]application.ExposedEndpoint{
@@ -546,6 +609,9 @@ func (s *migrationServiceSuite) assertImportApplication(c *tc.C, modelType corem
 		ApplicationSettings: application.ApplicationSettings{
 			Trust: true,
 		},
+		ScheduleOverrides: map[string]application.ScheduleOverride{
+			"nightly-backup": {Cron: ptr("30 1 * * *")},
+		},
 		Units: []ImportUnitArg{
 			unitArg,
 		},
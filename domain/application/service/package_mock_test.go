@@ -12,6 +12,7 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	set "github.com/juju/collections/set"
 	application "github.com/juju/juju/core/application"
@@ -1041,6 +1042,45 @@ func (c *MockStateGetApplicationEndpointNamesCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// GetApplicationHookTimeout mocks base method.
+func (m *MockState) GetApplicationHookTimeout(ctx context.Context, appID application.ID) (*time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationHookTimeout", ctx, appID)
+	ret0, _ := ret[0].(*time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationHookTimeout indicates an expected call of GetApplicationHookTimeout.
+func (mr *MockStateMockRecorder) GetApplicationHookTimeout(ctx, appID any) *MockStateGetApplicationHookTimeoutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationHookTimeout", reflect.TypeOf((*MockState)(nil).GetApplicationHookTimeout), ctx, appID)
+	return &MockStateGetApplicationHookTimeoutCall{Call: call}
+}
+
+// MockStateGetApplicationHookTimeoutCall wrap *gomock.Call
+type MockStateGetApplicationHookTimeoutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationHookTimeoutCall) Return(arg0 *time.Duration, arg1 error) *MockStateGetApplicationHookTimeoutCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationHookTimeoutCall) Do(f func(context.Context, application.ID) (*time.Duration, error)) *MockStateGetApplicationHookTimeoutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationHookTimeoutCall) DoAndReturn(f func(context.Context, application.ID) (*time.Duration, error)) *MockStateGetApplicationHookTimeoutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationIDAndNameByUnitName mocks base method.
 func (m *MockState) GetApplicationIDAndNameByUnitName(ctx context.Context, name unit.Name) (application.ID, string, error) {
 	m.ctrl.T.Helper()
@@ -1316,6 +1356,45 @@ func (c *MockStateGetApplicationScaleStateCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// GetApplicationSchedules mocks base method.
+func (m *MockState) GetApplicationSchedules(ctx context.Context, appID application.ID) ([]application0.ApplicationSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationSchedules", ctx, appID)
+	ret0, _ := ret[0].([]application0.ApplicationSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationSchedules indicates an expected call of GetApplicationSchedules.
+func (mr *MockStateMockRecorder) GetApplicationSchedules(ctx, appID any) *MockStateGetApplicationSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationSchedules", reflect.TypeOf((*MockState)(nil).GetApplicationSchedules), ctx, appID)
+	return &MockStateGetApplicationSchedulesCall{Call: call}
+}
+
+// MockStateGetApplicationSchedulesCall wrap *gomock.Call
+type MockStateGetApplicationSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationSchedulesCall) Return(arg0 []application0.ApplicationSchedule, arg1 error) *MockStateGetApplicationSchedulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationSchedulesCall) Do(f func(context.Context, application.ID) ([]application0.ApplicationSchedule, error)) *MockStateGetApplicationSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationSchedulesCall) DoAndReturn(f func(context.Context, application.ID) ([]application0.ApplicationSchedule, error)) *MockStateGetApplicationSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationTrustSetting mocks base method.
 func (m *MockState) GetApplicationTrustSetting(ctx context.Context, appID application.ID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1830,6 +1909,45 @@ func (c *MockStateGetCharmArchivePathCall) DoAndReturn(f func(context.Context, c
 	return c
 }
 
+// GetCharmArchiveSize mocks base method.
+func (m *MockState) GetCharmArchiveSize(arg0 context.Context, arg1 charm.ID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharmArchiveSize", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharmArchiveSize indicates an expected call of GetCharmArchiveSize.
+func (mr *MockStateMockRecorder) GetCharmArchiveSize(arg0, arg1 any) *MockStateGetCharmArchiveSizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharmArchiveSize", reflect.TypeOf((*MockState)(nil).GetCharmArchiveSize), arg0, arg1)
+	return &MockStateGetCharmArchiveSizeCall{Call: call}
+}
+
+// MockStateGetCharmArchiveSizeCall wrap *gomock.Call
+type MockStateGetCharmArchiveSizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetCharmArchiveSizeCall) Return(arg0 int64, arg1 error) *MockStateGetCharmArchiveSizeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetCharmArchiveSizeCall) Do(f func(context.Context, charm.ID) (int64, error)) *MockStateGetCharmArchiveSizeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetCharmArchiveSizeCall) DoAndReturn(f func(context.Context, charm.ID) (int64, error)) *MockStateGetCharmArchiveSizeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetCharmByApplicationID mocks base method.
 func (m *MockState) GetCharmByApplicationID(arg0 context.Context, arg1 application.ID) (charm0.Charm, error) {
 	m.ctrl.T.Helper()
@@ -4177,6 +4295,44 @@ func (c *MockStateNamespaceForWatchApplicationScaleCall) DoAndReturn(f func() st
 	return c
 }
 
+// NamespaceForWatchApplicationSchedule mocks base method.
+func (m *MockState) NamespaceForWatchApplicationSchedule() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceForWatchApplicationSchedule")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NamespaceForWatchApplicationSchedule indicates an expected call of NamespaceForWatchApplicationSchedule.
+func (mr *MockStateMockRecorder) NamespaceForWatchApplicationSchedule() *MockStateNamespaceForWatchApplicationScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceForWatchApplicationSchedule", reflect.TypeOf((*MockState)(nil).NamespaceForWatchApplicationSchedule))
+	return &MockStateNamespaceForWatchApplicationScheduleCall{Call: call}
+}
+
+// MockStateNamespaceForWatchApplicationScheduleCall wrap *gomock.Call
+type MockStateNamespaceForWatchApplicationScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateNamespaceForWatchApplicationScheduleCall) Return(arg0 string) *MockStateNamespaceForWatchApplicationScheduleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateNamespaceForWatchApplicationScheduleCall) Do(f func() string) *MockStateNamespaceForWatchApplicationScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateNamespaceForWatchApplicationScheduleCall) DoAndReturn(f func() string) *MockStateNamespaceForWatchApplicationScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NamespaceForWatchApplicationSetting mocks base method.
 func (m *MockState) NamespaceForWatchApplicationSetting() string {
 	m.ctrl.T.Helper()
@@ -4598,6 +4754,44 @@ func (c *MockStateSetApplicationScalingStateCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// SetApplicationScheduleOverrides mocks base method.
+func (m *MockState) SetApplicationScheduleOverrides(ctx context.Context, appID application.ID, overrides map[string]application0.ScheduleOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationScheduleOverrides", ctx, appID, overrides)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationScheduleOverrides indicates an expected call of SetApplicationScheduleOverrides.
+func (mr *MockStateMockRecorder) SetApplicationScheduleOverrides(ctx, appID, overrides any) *MockStateSetApplicationScheduleOverridesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationScheduleOverrides", reflect.TypeOf((*MockState)(nil).SetApplicationScheduleOverrides), ctx, appID, overrides)
+	return &MockStateSetApplicationScheduleOverridesCall{Call: call}
+}
+
+// MockStateSetApplicationScheduleOverridesCall wrap *gomock.Call
+type MockStateSetApplicationScheduleOverridesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetApplicationScheduleOverridesCall) Return(arg0 error) *MockStateSetApplicationScheduleOverridesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetApplicationScheduleOverridesCall) Do(f func(context.Context, application.ID, map[string]application0.ScheduleOverride) error) *MockStateSetApplicationScheduleOverridesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetApplicationScheduleOverridesCall) DoAndReturn(f func(context.Context, application.ID, map[string]application0.ScheduleOverride) error) *MockStateSetApplicationScheduleOverridesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetCharm mocks base method.
 func (m *MockState) SetCharm(ctx context.Context, ch charm0.Charm, downloadInfo *charm0.DownloadInfo, requiresSequencing bool) (charm.ID, charm0.CharmLocator, error) {
 	m.ctrl.T.Helper()
@@ -5021,6 +5215,44 @@ func (c *MockStateUnsetApplicationConfigKeysCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// UnsetApplicationScheduleOverrides mocks base method.
+func (m *MockState) UnsetApplicationScheduleOverrides(ctx context.Context, appID application.ID, names []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetApplicationScheduleOverrides", ctx, appID, names)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetApplicationScheduleOverrides indicates an expected call of UnsetApplicationScheduleOverrides.
+func (mr *MockStateMockRecorder) UnsetApplicationScheduleOverrides(ctx, appID, names any) *MockStateUnsetApplicationScheduleOverridesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetApplicationScheduleOverrides", reflect.TypeOf((*MockState)(nil).UnsetApplicationScheduleOverrides), ctx, appID, names)
+	return &MockStateUnsetApplicationScheduleOverridesCall{Call: call}
+}
+
+// MockStateUnsetApplicationScheduleOverridesCall wrap *gomock.Call
+type MockStateUnsetApplicationScheduleOverridesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateUnsetApplicationScheduleOverridesCall) Return(arg0 error) *MockStateUnsetApplicationScheduleOverridesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateUnsetApplicationScheduleOverridesCall) Do(f func(context.Context, application.ID, []string) error) *MockStateUnsetApplicationScheduleOverridesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateUnsetApplicationScheduleOverridesCall) DoAndReturn(f func(context.Context, application.ID, []string) error) *MockStateUnsetApplicationScheduleOverridesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UnsetExposeSettings mocks base method.
 func (m *MockState) UnsetExposeSettings(ctx context.Context, appID application.ID, exposedEndpoints set.Strings) error {
 	m.ctrl.T.Helper()
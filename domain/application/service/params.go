@@ -4,6 +4,8 @@
 package service
 
 import (
+	"time"
+
 	corecharm "github.com/juju/juju/core/charm"
 	"github.com/juju/juju/core/config"
 	"github.com/juju/juju/core/constraints"
@@ -211,6 +213,10 @@ type ImportApplicationArgs struct {
 	// ApplicationSettings contains the application settings.
 	ApplicationSettings application.ApplicationSettings
 
+	// ScheduleOverrides contains the overrides of the schedules declared by
+	// the charm.
+	ScheduleOverrides map[string]application.ScheduleOverride
+
 	// ResolvedResources contains a list of ResolvedResource instances,
 	// TODO (stickupkid): This isn't currently wired up.
 	ResolvedResources ResolvedResources
@@ -246,6 +252,7 @@ type ApplicationConfig struct {
 	CharmConfig       internalcharm.Config
 	ApplicationConfig config.ConfigAttributes
 	Trust             bool
+	HookTimeout       *time.Duration
 	CharmName         string
 	Principal         bool
 }
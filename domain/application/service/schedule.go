@@ -0,0 +1,124 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/cron"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetApplicationSchedules returns the schedules declared by the charm of the
+// application, ordered by name. The cron expression and jitter of each
+// schedule take any override set by the operator into account.
+//
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *Service) GetApplicationSchedules(ctx context.Context, appName string) ([]application.ApplicationSchedule, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return s.st.GetApplicationSchedules(ctx, appID)
+}
+
+// SetApplicationScheduleOverrides overrides the cron expression or jitter of
+// schedules declared by the charm of the application.
+//
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNotFound] if the application doesn't exist
+//   - [applicationerrors.ScheduleNotFound] if a schedule is not declared by
+//     the charm of the application
+//   - [applicationerrors.InvalidSchedule] if a cron expression or jitter is
+//     not valid
+func (s *Service) SetApplicationScheduleOverrides(ctx context.Context, appName string, overrides map[string]application.ScheduleOverride) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := validateScheduleOverrides(overrides); err != nil {
+		return errors.Capture(err)
+	}
+
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return s.st.SetApplicationScheduleOverrides(ctx, appID, overrides)
+}
+
+// validateScheduleOverrides returns an error satisfying
+// [applicationerrors.InvalidSchedule] if a cron expression or jitter of the
+// overrides is not valid.
+func validateScheduleOverrides(overrides map[string]application.ScheduleOverride) error {
+	for name, override := range overrides {
+		if override.Cron != nil {
+			if _, err := cron.Parse(*override.Cron); err != nil {
+				return errors.Errorf("%w: schedule %q: %w", applicationerrors.InvalidSchedule, name, err)
+			}
+		}
+		if override.Jitter != nil && *override.Jitter < 0 {
+			return errors.Errorf("%w: schedule %q: jitter %v cannot be negative", applicationerrors.InvalidSchedule, name, *override.Jitter)
+		}
+	}
+	return nil
+}
+
+// UnsetApplicationScheduleOverrides removes the overrides of the named
+// schedules, so that the schedules declared by the charm of the application
+// apply.
+//
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *Service) UnsetApplicationScheduleOverrides(ctx context.Context, appName string, names []string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return s.st.UnsetApplicationScheduleOverrides(ctx, appID, names)
+}
+
+// WatchApplicationSchedules watches for changes to the overrides of the
+// schedules of the specified application. Changes to the schedules declared
+// by the charm are only made when the charm of the application changes, so
+// are not reported by the watcher.
+//
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *WatchableService) WatchApplicationSchedules(ctx context.Context, appName string) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	uuid, err := s.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return nil, errors.Errorf("getting ID of application %s: %w", appName, err)
+	}
+
+	return s.watcherFactory.NewNotifyWatcher(
+		eventsource.PredicateFilter(
+			s.st.NamespaceForWatchApplicationSchedule(),
+			changestream.All,
+			eventsource.EqualsPredicate(uuid.String()),
+		),
+	)
+}
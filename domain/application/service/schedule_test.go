@@ -0,0 +1,107 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreapplication "github.com/juju/juju/core/application"
+	applicationtesting "github.com/juju/juju/core/application/testing"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+)
+
+type scheduleServiceSuite struct {
+	baseSuite
+}
+
+func TestScheduleServiceSuite(t *testing.T) {
+	tc.Run(t, &scheduleServiceSuite{})
+}
+
+func (s *scheduleServiceSuite) TestGetApplicationSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	expected := []application.ApplicationSchedule{{
+		Name:        "nightly-backup",
+		Cron:        "30 1 * * *",
+		Jitter:      time.Minute,
+		CharmCron:   "0 3 * * *",
+		CharmJitter: time.Minute,
+	}}
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().GetApplicationSchedules(gomock.Any(), appUUID).Return(expected, nil)
+
+	schedules, err := s.service.GetApplicationSchedules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(schedules, tc.DeepEquals, expected)
+}
+
+func (s *scheduleServiceSuite) TestGetApplicationSchedulesNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(coreapplication.ID(""), applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.GetApplicationSchedules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *scheduleServiceSuite) TestSetApplicationScheduleOverrides(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	overrides := map[string]application.ScheduleOverride{
+		"nightly-backup": {
+			Cron:   ptr("30 1 * * *"),
+			Jitter: ptr(time.Hour),
+		},
+	}
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().SetApplicationScheduleOverrides(gomock.Any(), appUUID, overrides).Return(nil)
+
+	err := s.service.SetApplicationScheduleOverrides(c.Context(), "foo", overrides)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *scheduleServiceSuite) TestSetApplicationScheduleOverridesInvalidCron(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetApplicationScheduleOverrides(c.Context(), "foo", map[string]application.ScheduleOverride{
+		"nightly-backup": {Cron: ptr("0 3 * *")},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidSchedule)
+	c.Check(err, tc.ErrorMatches, `invalid schedule: schedule "nightly-backup": invalid cron expression .*`)
+}
+
+func (s *scheduleServiceSuite) TestSetApplicationScheduleOverridesNegativeJitter(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetApplicationScheduleOverrides(c.Context(), "foo", map[string]application.ScheduleOverride{
+		"nightly-backup": {Jitter: ptr(-time.Minute)},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidSchedule)
+}
+
+func (s *scheduleServiceSuite) TestUnsetApplicationScheduleOverrides(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().UnsetApplicationScheduleOverrides(gomock.Any(), appUUID, []string{"nightly-backup"}).Return(nil)
+
+	err := s.service.UnsetApplicationScheduleOverrides(c.Context(), "foo", []string{"nightly-backup"})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *scheduleServiceSuite) TestUnsetApplicationScheduleOverridesNoNames(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.UnsetApplicationScheduleOverrides(c.Context(), "foo", nil)
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -15,6 +15,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/canonical/sqlair"
 	"github.com/juju/collections/set"
@@ -595,6 +596,7 @@ func (st *State) deleteSimpleApplicationReferences(ctx context.Context, tx *sqla
 		"application_config_hash",
 		"application_constraint",
 		"application_setting",
+		"application_schedule",
 		"application_exposed_endpoint_space",
 		"application_exposed_endpoint_cidr",
 		"application_endpoint",
@@ -2248,9 +2250,7 @@ func (st *State) GetApplicationConfigAndSettings(ctx context.Context, appID core
 			Value: c.Value,
 		}
 	}
-	return result, application.ApplicationSettings{
-		Trust: settings.Trust,
-	}, nil
+	return result, decodeApplicationSettings(settings), nil
 }
 
 // GetApplicationConfigWithDefaults returns the application config attributes
@@ -2300,6 +2300,47 @@ func (st *State) GetApplicationConfigWithDefaults(ctx context.Context, appID cor
 	return result, nil
 }
 
+// GetApplicationHookTimeout returns the hook timeout set for the
+// application, or nil if the model's hook timeout applies.
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (st *State) GetApplicationHookTimeout(ctx context.Context, appID coreapplication.ID) (*time.Duration, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := applicationID{ID: appID}
+
+	settingsQuery := `
+SELECT hook_timeout AS &applicationSettings.hook_timeout
+FROM application_setting
+WHERE application_uuid = $applicationID.uuid;`
+
+	settingsStmt, err := st.Prepare(settingsQuery, applicationSettings{}, ident)
+	if err != nil {
+		return nil, errors.Errorf("preparing query for application hook timeout: %w", err)
+	}
+
+	var settings applicationSettings
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
+			return errors.Capture(err)
+		}
+
+		if err := tx.Query(ctx, settingsStmt, ident).Get(&settings); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying application settings: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying application hook timeout: %w", err)
+	}
+
+	return decodeApplicationSettings(settings).HookTimeout, nil
+}
+
 // GetApplicationTrustSetting returns the application trust setting.
 // If no application is found, an error satisfying
 // [applicationerrors.ApplicationNotFound] is returned.
@@ -2370,6 +2411,13 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 	trust = excluded.trust;
 	`
 
+	upsertHookTimeoutQuery := `
+INSERT INTO application_setting (application_uuid, hook_timeout)
+VALUES ($setApplicationHookTimeout.*)
+ON CONFLICT(application_uuid) DO UPDATE SET
+	hook_timeout = excluded.hook_timeout;
+	`
+
 	upsertStmt, err := st.Prepare(upsertQuery, setApplicationConfig{})
 	if err != nil {
 		return errors.Errorf("preparing upsert query: %w", err)
@@ -2378,6 +2426,10 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 	if err != nil {
 		return errors.Errorf("preparing upsert settings query: %w", err)
 	}
+	upsertHookTimeoutStmt, err := st.Prepare(upsertHookTimeoutQuery, setApplicationHookTimeout{})
+	if err != nil {
+		return errors.Errorf("preparing upsert hook timeout query: %w", err)
+	}
 
 	upserts := make([]setApplicationConfig, 0, len(config))
 	for k, cfgVal := range config {
@@ -2413,6 +2465,15 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 			}
 		}
 
+		if settings.HookTimeout != nil {
+			if err := tx.Query(ctx, upsertHookTimeoutStmt, setApplicationHookTimeout{
+				ApplicationUUID: appID,
+				HookTimeout:     internaldatabase.NewNullDuration(*settings.HookTimeout),
+			}).Run(); err != nil {
+				return errors.Errorf("upserting hook timeout: %w", err)
+			}
+		}
+
 		if err := st.updateConfigHash(ctx, tx, ident); err != nil {
 			return errors.Errorf("refreshing config hash: %w", err)
 		}
@@ -2465,16 +2526,27 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 	if err != nil {
 		return errors.Errorf("preparing query for application config: %w", err)
 	}
+	hookTimeoutQuery := `
+UPDATE application_setting
+SET hook_timeout = NULL
+WHERE application_uuid = $applicationID.uuid;
+`
+
 	settingsStmt, err := st.Prepare(settingsQuery, setApplicationSettings{})
 	if err != nil {
 		return errors.Errorf("preparing query for application config: %w", err)
 	}
+	hookTimeoutStmt, err := st.Prepare(hookTimeoutQuery, ident)
+	if err != nil {
+		return errors.Errorf("preparing query for application hook timeout: %w", err)
+	}
 
 	removals := make(sqlair.S, len(keys))
 	for i, k := range keys {
 		removals[i] = k
 	}
-	removeTrust := slices.Contains(keys, "trust")
+	removeTrust := slices.Contains(keys, coreapplication.TrustConfigOptionName)
+	removeHookTimeout := slices.Contains(keys, coreapplication.HookTimeoutConfigOptionName)
 
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		if err := tx.Query(ctx, appStmt, ident).Get(&ident); errors.Is(err, sqlair.ErrNoRows) {
@@ -2489,15 +2561,19 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 			return errors.Errorf("deleting config: %w", err)
 		}
 
-		if !removeTrust {
-			return nil
+		if removeTrust {
+			if err := tx.Query(ctx, settingsStmt, setApplicationSettings{
+				ApplicationUUID: ident.ID,
+				Trust:           false,
+			}).Run(); err != nil {
+				return errors.Errorf("deleting setting: %w", err)
+			}
 		}
 
-		if err := tx.Query(ctx, settingsStmt, setApplicationSettings{
-			ApplicationUUID: ident.ID,
-			Trust:           false,
-		}).Run(); err != nil {
-			return errors.Errorf("deleting setting: %w", err)
+		if removeHookTimeout {
+			if err := tx.Query(ctx, hookTimeoutStmt, ident).Run(); err != nil {
+				return errors.Errorf("deleting hook timeout setting: %w", err)
+			}
 		}
 
 		return nil
@@ -3495,9 +3571,14 @@ VALUES ($setApplicationSettings.*);
 		return errors.Errorf("preparing insert query: %w", err)
 	}
 
+	var hookTimeout internaldatabase.NullDuration
+	if settings.HookTimeout != nil {
+		hookTimeout = internaldatabase.NewNullDuration(*settings.HookTimeout)
+	}
 	if err := tx.Query(ctx, insertStmt, setApplicationSettings{
 		ApplicationUUID: appID,
 		Trust:           settings.Trust,
+		HookTimeout:     hookTimeout,
 	}).Run(); err != nil {
 		return errors.Errorf("inserting settings: %w", err)
 	}
@@ -3505,6 +3586,17 @@ VALUES ($setApplicationSettings.*);
 	return nil
 }
 
+func decodeApplicationSettings(settings applicationSettings) application.ApplicationSettings {
+	result := application.ApplicationSettings{
+		Trust: settings.Trust,
+	}
+	if settings.HookTimeout.Valid {
+		hookTimeout := settings.HookTimeout.Duration
+		result.HookTimeout = &hookTimeout
+	}
+	return result
+}
+
 func (st *State) insertApplicationStatus(
 	ctx context.Context,
 	tx *sqlair.TX,
@@ -2970,6 +2970,71 @@ func (s *applicationStateSuite) TestUpdateApplicationConfigAndSettingsupdatesTru
 	c.Check(settings, tc.DeepEquals, application.ApplicationSettings{Trust: true})
 }
 
+func (s *applicationStateSuite) TestUpdateApplicationConfigAndSettingsUpdatesHookTimeout(c *tc.C) {
+	id := s.createIAASApplication(c, "foo", life.Alive)
+
+	timeout, err := s.state.GetApplicationHookTimeout(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(timeout, tc.IsNil)
+
+	err = s.state.UpdateApplicationConfigAndSettings(c.Context(), id, map[string]application.ApplicationConfig{},
+		application.UpdateApplicationSettingsArg{
+			HookTimeout: ptr(10 * time.Minute),
+		})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, settings, err := s.state.GetApplicationConfigAndSettings(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(settings, tc.DeepEquals, application.ApplicationSettings{HookTimeout: ptr(10 * time.Minute)})
+
+	timeout, err = s.state.GetApplicationHookTimeout(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(timeout, tc.DeepEquals, ptr(10*time.Minute))
+
+	// Updating the trust setting does not change the hook timeout.
+
+	err = s.state.UpdateApplicationConfigAndSettings(c.Context(), id, map[string]application.ApplicationConfig{},
+		application.UpdateApplicationSettingsArg{
+			Trust: ptr(true),
+		})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, settings, err = s.state.GetApplicationConfigAndSettings(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(settings, tc.DeepEquals, application.ApplicationSettings{
+		Trust:       true,
+		HookTimeout: ptr(10 * time.Minute),
+	})
+}
+
+func (s *applicationStateSuite) TestGetApplicationHookTimeoutNoApplication(c *tc.C) {
+	id := applicationtesting.GenApplicationUUID(c)
+	_, err := s.state.GetApplicationHookTimeout(c.Context(), id)
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *applicationStateSuite) TestUnsetApplicationConfigKeysIncludingHookTimeout(c *tc.C) {
+	id := s.createIAASApplication(c, "foo", life.Alive)
+
+	err := s.state.UpdateApplicationConfigAndSettings(c.Context(), id,
+		map[string]application.ApplicationConfig{},
+		application.UpdateApplicationSettingsArg{
+			Trust:       ptr(true),
+			HookTimeout: ptr(time.Minute),
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.UnsetApplicationConfigKeys(c.Context(), id, []string{"hook-timeout"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, settings, err := s.state.GetApplicationConfigAndSettings(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(settings, tc.DeepEquals, application.ApplicationSettings{
+		Trust: true,
+	})
+}
+
 func (s *applicationStateSuite) TestUnsetApplicationConfigKeys(c *tc.C) {
 	id := s.createIAASApplication(c, "foo", life.Alive)
 
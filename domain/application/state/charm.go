@@ -360,6 +360,44 @@ WHERE charm.uuid = $charmID.uuid;
 	return archivePathAndHashes[0].ArchivePath, archivePathAndHashes[0].Hash, nil
 }
 
+// GetCharmArchiveSize returns the size in bytes of the charm's archive, as
+// recorded in the object store metadata when the archive was stored.
+// If the charm does not exist, or its archive has not been stored, a
+// [errors.CharmNotFound] error is returned.
+func (s *State) GetCharmArchiveSize(ctx context.Context, id corecharm.ID) (int64, error) {
+	db, err := s.DB()
+	if err != nil {
+		return -1, errors.Capture(err)
+	}
+
+	var size charmArchiveSize
+	ident := charmID{UUID: id}
+
+	query := `
+SELECT osm.size AS &charmArchiveSize.size
+FROM charm
+JOIN object_store_metadata AS osm ON charm.object_store_uuid = osm.uuid
+WHERE charm.uuid = $charmID.uuid;
+`
+
+	stmt, err := s.Prepare(query, size, ident)
+	if err != nil {
+		return -1, errors.Errorf("preparing query: %w", err)
+	}
+
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, ident).Get(&size); errors.Is(err, sqlair.ErrNoRows) {
+			return applicationerrors.CharmNotFound
+		} else if err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return -1, errors.Errorf("getting charm archive size: %w", err)
+	}
+	return size.Size, nil
+}
+
 // GetCharmMetadata returns the metadata for the charm using the charm ID.
 // If the charm does not exist, a [errors.CharmNotFound] error is returned.
 func (s *State) GetCharmMetadata(ctx context.Context, id corecharm.ID) (charm.Metadata, error) {
@@ -1052,6 +1090,7 @@ var tablesToDeleteFrom = []string{
 	"charm_manifest_base",
 	"charm_relation",
 	"charm_resource",
+	"charm_schedule",
 	"charm_metadata",
 	"charm_storage_property",
 	"charm_storage",
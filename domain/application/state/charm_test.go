@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/canonical/sqlair"
 	"github.com/juju/clock"
@@ -2094,6 +2095,55 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithExtraBindings(c *t
 	assertTableEmpty(c, s.TxnRunner(), "charm_extra_binding")
 }
 
+func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithSchedules(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	expected := charm.Metadata{
+		Name:           "ubuntu",
+		Summary:        "summary",
+		Description:    "description",
+		RunAs:          charm.RunAsRoot,
+		MinJujuVersion: semversion.MustParse("4.0.0"),
+		Assumes:        []byte("null"),
+		Schedules: map[string]charm.Schedule{
+			"nightly-backup": {
+				Name:   "nightly-backup",
+				Cron:   "0 3 * * *",
+				Jitter: 15 * time.Minute,
+			},
+			"hourly-cleanup": {
+				Name: "hourly-cleanup",
+				Cron: "@hourly",
+			},
+		},
+	}
+
+	id, _, err := st.SetCharm(c.Context(), charm.Charm{
+		Metadata:      expected,
+		Manifest:      s.minimalManifest(c),
+		Source:        charm.LocalSource,
+		Revision:      42,
+		ReferenceName: "ubuntu",
+		Hash:          "hash",
+		ArchivePath:   "archive",
+		Version:       "deadbeef",
+	}, nil, false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Add the implicit juju-info relation inserted with the charm.
+	expected.Provides = jujuInfoRelation()
+
+	got, err := st.GetCharmMetadata(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, expected)
+
+	err = st.DeleteCharm(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+
+	assertTableEmpty(c, s.TxnRunner(), "charm")
+	assertTableEmpty(c, s.TxnRunner(), "charm_schedule")
+}
+
 func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithStorageWithNoProperties(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
 
@@ -3073,6 +3123,58 @@ func (s *charmStateSuite) TestGetCharmArchiveMetadataCharmNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, applicationerrors.CharmNotFound)
 }
 
+func (s *charmStateSuite) TestGetCharmArchiveSize(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO object_store_metadata (uuid, sha_256, sha_384, size) VALUES (?, 'foo', 'bar', 42)
+`, objectStoreUUID.String())
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	id, _, err := st.SetCharm(c.Context(), charm.Charm{
+		Metadata: charm.Metadata{
+			Name: "ubuntu",
+		},
+		Manifest:        s.minimalManifest(c),
+		Source:          charm.LocalSource,
+		Revision:        42,
+		ReferenceName:   "ubuntu",
+		Hash:            "hash",
+		ArchivePath:     "archive",
+		Version:         "deadbeef",
+		ObjectStoreUUID: objectStoreUUID,
+	}, nil, false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	size, err := st.GetCharmArchiveSize(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(size, tc.Equals, int64(42))
+}
+
+func (s *charmStateSuite) TestGetCharmArchiveSizeNotStored(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	id, _, err := st.SetCharm(c.Context(), charm.Charm{
+		Metadata: charm.Metadata{
+			Name: "ubuntu",
+		},
+		Manifest:      s.minimalManifest(c),
+		Source:        charm.LocalSource,
+		Revision:      42,
+		ReferenceName: "ubuntu",
+		Hash:          "hash",
+		Version:       "deadbeef",
+	}, nil, false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = st.GetCharmArchiveSize(c.Context(), id)
+	c.Assert(err, tc.ErrorIs, applicationerrors.CharmNotFound)
+}
+
 func (s *charmStateSuite) TestListCharmLocatorsWithNoEntries(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
 
@@ -4,6 +4,8 @@
 package state
 
 import (
+	"time"
+
 	corecharm "github.com/juju/juju/core/charm"
 	corerelation "github.com/juju/juju/core/relation"
 	"github.com/juju/juju/core/semversion"
@@ -22,6 +24,7 @@ type decodeMetadataArgs struct {
 	devices       []charmDevice
 	resources     []charmResource
 	containers    []charmContainer
+	schedules     []charmSchedule
 }
 
 func decodeMetadata(metadata charmMetadata, args decodeMetadataArgs) (charm.Metadata, error) {
@@ -80,6 +83,7 @@ func decodeMetadata(metadata charmMetadata, args decodeMetadataArgs) (charm.Meta
 		Devices:        decodeDevices(args.devices),
 		Resources:      resources,
 		Containers:     containers,
+		Schedules:      decodeSchedules(args.schedules),
 	}, nil
 }
 
@@ -199,6 +203,22 @@ func decodeRelationScope(scope string) (charm.RelationScope, error) {
 	}
 }
 
+func decodeSchedules(schedules []charmSchedule) map[string]charm.Schedule {
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	result := make(map[string]charm.Schedule)
+	for _, schedule := range schedules {
+		result[schedule.Name] = charm.Schedule{
+			Name:   schedule.Name,
+			Cron:   schedule.Cron,
+			Jitter: time.Duration(schedule.Jitter),
+		}
+	}
+	return result
+}
+
 func decodeExtraBindings(bindings []charmExtraBinding) map[string]charm.ExtraBinding {
 	if len(bindings) == 0 {
 		return nil
@@ -556,6 +576,19 @@ func encodeExtraBindings(id corecharm.ID, extraBindings map[string]charm.ExtraBi
 	return result, nil
 }
 
+func encodeSchedules(id corecharm.ID, schedules map[string]charm.Schedule) []charmSchedule {
+	result := make([]charmSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		result = append(result, charmSchedule{
+			CharmUUID: id.String(),
+			Name:      schedule.Name,
+			Cron:      schedule.Cron,
+			Jitter:    schedule.Jitter.Nanoseconds(),
+		})
+	}
+	return result
+}
+
 func encodeStorage(id corecharm.ID, storage map[string]charm.Storage) ([]setCharmStorage, []setCharmStorageProperty, error) {
 	var (
 		storages   []setCharmStorage
@@ -0,0 +1,203 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/collections/set"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetApplicationSchedules returns the schedules declared by the charm of the
+// application, ordered by name, along with any override of them.
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (st *State) GetApplicationSchedules(ctx context.Context, appID coreapplication.ID) ([]application.ApplicationSchedule, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := applicationID{ID: appID}
+
+	var schedules []applicationSchedule
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
+			return errors.Capture(err)
+		}
+
+		schedules, err = st.getApplicationSchedules(ctx, tx, ident)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying application schedules: %w", err)
+	}
+
+	result := make([]application.ApplicationSchedule, len(schedules))
+	for i, s := range schedules {
+		result[i] = application.ApplicationSchedule{
+			Name:        s.Name,
+			Cron:        s.CharmCron,
+			Jitter:      time.Duration(s.CharmJitter),
+			CharmCron:   s.CharmCron,
+			CharmJitter: time.Duration(s.CharmJitter),
+		}
+		if s.Cron.Valid {
+			result[i].Cron = s.Cron.V
+			result[i].Override.Cron = &s.Cron.V
+		}
+		if s.Jitter.Valid {
+			jitter := time.Duration(s.Jitter.V)
+			result[i].Jitter = jitter
+			result[i].Override.Jitter = &jitter
+		}
+	}
+	return result, nil
+}
+
+// SetApplicationScheduleOverrides overrides the cron expression or jitter of
+// schedules declared by the charm of the application. Values which aren't set
+// in an override leave any existing override of that value in place.
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNotFound] if the application doesn't exist
+//   - [applicationerrors.ScheduleNotFound] if a schedule is not declared by
+//     the charm of the application
+func (st *State) SetApplicationScheduleOverrides(ctx context.Context, appID coreapplication.ID, overrides map[string]application.ScheduleOverride) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ident := applicationID{ID: appID}
+
+	upsertQuery := `
+INSERT INTO application_schedule (*)
+VALUES ($setApplicationSchedule.*)
+ON CONFLICT(application_uuid, name) DO UPDATE SET
+	cron = COALESCE(excluded.cron, cron),
+	jitter = COALESCE(excluded.jitter, jitter);
+`
+	upsertStmt, err := st.Prepare(upsertQuery, setApplicationSchedule{})
+	if err != nil {
+		return errors.Errorf("preparing upsert schedule query: %w", err)
+	}
+
+	upserts := make([]setApplicationSchedule, 0, len(overrides))
+	for name, override := range overrides {
+		upsert := setApplicationSchedule{
+			ApplicationUUID: appID,
+			Name:            name,
+		}
+		if override.Cron != nil {
+			upsert.Cron = sql.Null[string]{V: *override.Cron, Valid: true}
+		}
+		if override.Jitter != nil {
+			upsert.Jitter = sql.Null[int64]{V: override.Jitter.Nanoseconds(), Valid: true}
+		}
+		upserts = append(upserts, upsert)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
+			return errors.Capture(err)
+		}
+
+		schedules, err := st.getApplicationSchedules(ctx, tx, ident)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		declared := set.NewStrings()
+		for _, s := range schedules {
+			declared.Add(s.Name)
+		}
+		for _, upsert := range upserts {
+			if !declared.Contains(upsert.Name) {
+				return errors.Errorf("schedule %q: %w", upsert.Name, applicationerrors.ScheduleNotFound)
+			}
+		}
+
+		if len(upserts) == 0 {
+			return nil
+		}
+		if err := tx.Query(ctx, upsertStmt, upserts).Run(); err != nil {
+			return errors.Errorf("upserting application schedules: %w", err)
+		}
+		return nil
+	})
+}
+
+// UnsetApplicationScheduleOverrides removes the overrides of the named
+// schedules, so that the schedules declared by the charm of the application
+// apply.
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (st *State) UnsetApplicationScheduleOverrides(ctx context.Context, appID coreapplication.ID, names []string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ident := applicationID{ID: appID}
+	scheduleNames := scheduleNames(names)
+
+	deleteQuery := `
+DELETE FROM application_schedule
+WHERE application_uuid = $applicationID.uuid
+AND name IN ($scheduleNames[:]);
+`
+	deleteStmt, err := st.Prepare(deleteQuery, ident, scheduleNames)
+	if err != nil {
+		return errors.Errorf("preparing delete schedule query: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
+			return errors.Capture(err)
+		}
+
+		if err := tx.Query(ctx, deleteStmt, ident, scheduleNames).Run(); err != nil {
+			return errors.Errorf("deleting application schedules: %w", err)
+		}
+		return nil
+	})
+}
+
+// NamespaceForWatchApplicationSchedule returns the namespace identifier for
+// changes to the schedule overrides of applications.
+func (*State) NamespaceForWatchApplicationSchedule() string {
+	return "application_schedule"
+}
+
+func (st *State) getApplicationSchedules(ctx context.Context, tx *sqlair.TX, ident applicationID) ([]applicationSchedule, error) {
+	query := `
+SELECT cs.name AS &applicationSchedule.name,
+       cs.cron AS &applicationSchedule.charm_cron,
+       cs.jitter AS &applicationSchedule.charm_jitter,
+       aps.cron AS &applicationSchedule.cron,
+       aps.jitter AS &applicationSchedule.jitter
+FROM application AS a
+JOIN charm_schedule AS cs ON cs.charm_uuid = a.charm_uuid
+LEFT JOIN application_schedule AS aps ON aps.application_uuid = a.uuid AND aps.name = cs.name
+WHERE a.uuid = $applicationID.uuid
+ORDER BY cs.name;
+`
+	stmt, err := st.Prepare(query, applicationSchedule{}, ident)
+	if err != nil {
+		return nil, errors.Errorf("preparing query for application schedules: %w", err)
+	}
+
+	var result []applicationSchedule
+	if err := tx.Query(ctx, stmt, ident).GetAll(&result); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("querying application schedules: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+
+	coreapplication "github.com/juju/juju/core/application"
+	applicationtesting "github.com/juju/juju/core/application/testing"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/architecture"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/life"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type scheduleStateSuite struct {
+	baseSuite
+
+	state *State
+}
+
+func TestScheduleStateSuite(t *testing.T) {
+	tc.Run(t, &scheduleStateSuite{})
+}
+
+func (s *scheduleStateSuite) SetUpTest(c *tc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	s.state = NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+}
+
+func (s *scheduleStateSuite) TestGetApplicationSchedules(c *tc.C) {
+	id := s.createApplicationWithSchedules(c)
+
+	schedules, err := s.state.GetApplicationSchedules(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(schedules, tc.DeepEquals, []application.ApplicationSchedule{{
+		Name:        "hourly-report",
+		Cron:        "@hourly",
+		CharmCron:   "@hourly",
+		Jitter:      0,
+		CharmJitter: 0,
+	}, {
+		Name:        "nightly-backup",
+		Cron:        "0 3 * * *",
+		CharmCron:   "0 3 * * *",
+		Jitter:      10 * time.Minute,
+		CharmJitter: 10 * time.Minute,
+	}})
+}
+
+func (s *scheduleStateSuite) TestGetApplicationSchedulesNone(c *tc.C) {
+	id := s.createIAASApplication(c, "foo", life.Alive)
+
+	schedules, err := s.state.GetApplicationSchedules(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(schedules, tc.HasLen, 0)
+}
+
+func (s *scheduleStateSuite) TestGetApplicationSchedulesNoApplication(c *tc.C) {
+	id := applicationtesting.GenApplicationUUID(c)
+
+	_, err := s.state.GetApplicationSchedules(c.Context(), id)
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *scheduleStateSuite) TestSetApplicationScheduleOverrides(c *tc.C) {
+	id := s.createApplicationWithSchedules(c)
+
+	err := s.state.SetApplicationScheduleOverrides(c.Context(), id, map[string]application.ScheduleOverride{
+		"nightly-backup": {Cron: ptr("30 1 * * *")},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Setting only the jitter leaves the overridden cron expression in place.
+	err = s.state.SetApplicationScheduleOverrides(c.Context(), id, map[string]application.ScheduleOverride{
+		"nightly-backup": {Jitter: ptr(time.Hour)},
+		"hourly-report":  {Jitter: ptr(time.Minute)},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	schedules, err := s.state.GetApplicationSchedules(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(schedules, tc.DeepEquals, []application.ApplicationSchedule{{
+		Name:        "hourly-report",
+		Cron:        "@hourly",
+		CharmCron:   "@hourly",
+		Jitter:      time.Minute,
+		CharmJitter: 0,
+		Override: application.ScheduleOverride{
+			Jitter: ptr(time.Minute),
+		},
+	}, {
+		Name:        "nightly-backup",
+		Cron:        "30 1 * * *",
+		CharmCron:   "0 3 * * *",
+		Jitter:      time.Hour,
+		CharmJitter: 10 * time.Minute,
+		Override: application.ScheduleOverride{
+			Cron:   ptr("30 1 * * *"),
+			Jitter: ptr(time.Hour),
+		},
+	}})
+}
+
+func (s *scheduleStateSuite) TestSetApplicationScheduleOverridesNotDeclared(c *tc.C) {
+	id := s.createApplicationWithSchedules(c)
+
+	err := s.state.SetApplicationScheduleOverrides(c.Context(), id, map[string]application.ScheduleOverride{
+		"weekly-cleanup": {Cron: ptr("@weekly")},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ScheduleNotFound)
+}
+
+func (s *scheduleStateSuite) TestSetApplicationScheduleOverridesNoApplication(c *tc.C) {
+	id := applicationtesting.GenApplicationUUID(c)
+
+	err := s.state.SetApplicationScheduleOverrides(c.Context(), id, map[string]application.ScheduleOverride{
+		"nightly-backup": {Cron: ptr("@daily")},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *scheduleStateSuite) TestUnsetApplicationScheduleOverrides(c *tc.C) {
+	id := s.createApplicationWithSchedules(c)
+
+	err := s.state.SetApplicationScheduleOverrides(c.Context(), id, map[string]application.ScheduleOverride{
+		"nightly-backup": {Cron: ptr("30 1 * * *")},
+		"hourly-report":  {Cron: ptr("*/30 * * * *")},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.UnsetApplicationScheduleOverrides(c.Context(), id, []string{"nightly-backup"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	schedules, err := s.state.GetApplicationSchedules(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(schedules, tc.HasLen, 2)
+	c.Check(schedules[0].Cron, tc.Equals, "*/30 * * * *")
+	c.Check(schedules[1].Cron, tc.Equals, "0 3 * * *")
+}
+
+func (s *scheduleStateSuite) TestUnsetApplicationScheduleOverridesNoApplication(c *tc.C) {
+	id := applicationtesting.GenApplicationUUID(c)
+
+	err := s.state.UnsetApplicationScheduleOverrides(c.Context(), id, []string{"nightly-backup"})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *scheduleStateSuite) createApplicationWithSchedules(c *tc.C) coreapplication.ID {
+	metadata := s.minimalMetadata(c, "foo")
+	metadata.Schedules = map[string]charm.Schedule{
+		"nightly-backup": {
+			Name:   "nightly-backup",
+			Cron:   "0 3 * * *",
+			Jitter: 10 * time.Minute,
+		},
+		"hourly-report": {
+			Name: "hourly-report",
+			Cron: "@hourly",
+		},
+	}
+
+	id, _, err := s.state.CreateIAASApplication(c.Context(), "foo", application.AddIAASApplicationArg{
+		BaseAddApplicationArg: application.BaseAddApplicationArg{
+			Platform: deployment.Platform{
+				Channel:      "22.04/stable",
+				OSType:       deployment.Ubuntu,
+				Architecture: architecture.AMD64,
+			},
+			Charm: charm.Charm{
+				Metadata:      metadata,
+				Manifest:      s.minimalManifest(c),
+				Source:        charm.CharmHubSource,
+				ReferenceName: "foo",
+				Revision:      42,
+				Architecture:  architecture.AMD64,
+			},
+			CharmDownloadInfo: &charm.DownloadInfo{
+				Provenance:         charm.ProvenanceDownload,
+				CharmhubIdentifier: "ident",
+				DownloadURL:        "https://example.com",
+				DownloadSize:       42,
+			},
+		},
+	}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	return id
+}
@@ -141,6 +141,10 @@ func (s *State) setCharm(ctx context.Context, tx *sqlair.TX, uuid corecharm.ID,
 		return errors.Capture(err)
 	}
 
+	if err := s.setCharmSchedules(ctx, tx, uuid, ch.Metadata.Schedules); err != nil {
+		return errors.Capture(err)
+	}
+
 	if err := s.setCharmActions(ctx, tx, uuid, ch.Actions); err != nil {
 		return errors.Capture(err)
 	}
@@ -413,6 +417,25 @@ func (s *State) setCharmExtraBindings(ctx context.Context, tx *sqlair.TX, id cor
 	return nil
 }
 
+func (s *State) setCharmSchedules(ctx context.Context, tx *sqlair.TX, id corecharm.ID, schedules map[string]charm.Schedule) error {
+	// If there are no schedules, we don't need to do anything.
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO charm_schedule (*) VALUES ($charmSchedule.*);`
+	stmt, err := s.Prepare(query, charmSchedule{})
+	if err != nil {
+		return errors.Errorf("preparing query: %w", err)
+	}
+
+	if err := tx.Query(ctx, stmt, encodeSchedules(id, schedules)).Run(); err != nil {
+		return errors.Errorf("inserting charm schedules: %w", err)
+	}
+
+	return nil
+}
+
 func (s *State) setCharmStorage(ctx context.Context, tx *sqlair.TX, id corecharm.ID, storage map[string]charm.Storage) error {
 	// If there is no storage, we don't need to do anything.
 	if len(storage) == 0 {
@@ -744,6 +767,7 @@ func (s *State) getMetadata(ctx context.Context, tx *sqlair.TX, ident charmID) (
 		devices       []charmDevice
 		resources     []charmResource
 		containers    []charmContainer
+		schedules     []charmSchedule
 	)
 
 	var err error
@@ -787,6 +811,10 @@ func (s *State) getMetadata(ctx context.Context, tx *sqlair.TX, ident charmID) (
 		return charm.Metadata{}, errors.Capture(err)
 	}
 
+	if schedules, err = s.getCharmSchedules(ctx, tx, ident); err != nil {
+		return charm.Metadata{}, errors.Capture(err)
+	}
+
 	return decodeMetadata(metadata, decodeMetadataArgs{
 		tags:          tags,
 		categories:    categories,
@@ -797,6 +825,7 @@ func (s *State) getMetadata(ctx context.Context, tx *sqlair.TX, ident charmID) (
 		devices:       devices,
 		resources:     resources,
 		containers:    containers,
+		schedules:     schedules,
 	})
 }
 
@@ -1140,6 +1169,32 @@ WHERE charm_uuid = $charmID.uuid;
 	return result, nil
 }
 
+// getCharmSchedules returns the schedules for the charm using the charm ID.
+// If the charm does not exist, no error is returned. It is expected that
+// the caller will handle this case.
+func (s *State) getCharmSchedules(ctx context.Context, tx *sqlair.TX, ident charmID) ([]charmSchedule, error) {
+	query := `
+SELECT &charmSchedule.*
+FROM charm_schedule
+WHERE charm_uuid = $charmID.uuid;
+`
+
+	stmt, err := s.Prepare(query, charmSchedule{}, ident)
+	if err != nil {
+		return nil, errors.Errorf("preparing query: %w", err)
+	}
+
+	var result []charmSchedule
+	if err := tx.Query(ctx, stmt, ident).GetAll(&result); err != nil {
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return result, nil
+		}
+		return nil, errors.Errorf("failed to select charm schedules: %w", err)
+	}
+
+	return result, nil
+}
+
 // getCharmStorage returns the storage for the charm using the charm ID.
 // If the charm does not exist, no error is returned. It is expected that
 // the caller will handle this case.
@@ -17,6 +17,7 @@ import (
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/constraints"
 	"github.com/juju/juju/domain/life"
+	"github.com/juju/juju/internal/database"
 )
 
 // These structs represent the persistent block device entity schema in the database.
@@ -455,6 +456,36 @@ type setCharmExtraBinding struct {
 	Name      string `db:"name"`
 }
 
+// charmSchedule is used to get and set the schedules of a charm.
+type charmSchedule struct {
+	CharmUUID string `db:"charm_uuid"`
+	Name      string `db:"name"`
+	Cron      string `db:"cron"`
+	Jitter    int64  `db:"jitter"`
+}
+
+// applicationSchedule is used to get a schedule declared by the charm of an
+// application, along with any override of it.
+type applicationSchedule struct {
+	Name        string           `db:"name"`
+	CharmCron   string           `db:"charm_cron"`
+	CharmJitter int64            `db:"charm_jitter"`
+	Cron        sql.Null[string] `db:"cron"`
+	Jitter      sql.Null[int64]  `db:"jitter"`
+}
+
+// setApplicationSchedule is used to override a schedule declared by the
+// charm of an application.
+type setApplicationSchedule struct {
+	ApplicationUUID coreapplication.ID `db:"application_uuid"`
+	Name            string             `db:"name"`
+	Cron            sql.Null[string]   `db:"cron"`
+	Jitter          sql.Null[int64]    `db:"jitter"`
+}
+
+// scheduleNames is used to select schedules by name.
+type scheduleNames []string
+
 // charmStorage is used to get the storage of a charm.
 // This is a row based struct that is normalised form of an array of strings
 // for the property field.
@@ -652,6 +683,11 @@ type charmArchivePathAndHash struct {
 	Hash        string `db:"hash"`
 }
 
+// charmArchiveSize is used to get the size of a charm's stored archive.
+type charmArchiveSize struct {
+	Size int64 `db:"size"`
+}
+
 // charmArchiveHash is used to get the hash of a charm.
 type charmArchiveHash struct {
 	Available bool   `db:"available"`
@@ -747,12 +783,19 @@ type setApplicationConfig struct {
 }
 
 type applicationSettings struct {
-	Trust bool `db:"trust"`
+	Trust       bool                  `db:"trust"`
+	HookTimeout database.NullDuration `db:"hook_timeout"`
 }
 
 type setApplicationSettings struct {
-	ApplicationUUID coreapplication.ID `db:"application_uuid"`
-	Trust           bool               `db:"trust"`
+	ApplicationUUID coreapplication.ID    `db:"application_uuid"`
+	Trust           bool                  `db:"trust"`
+	HookTimeout     database.NullDuration `db:"hook_timeout"`
+}
+
+type setApplicationHookTimeout struct {
+	ApplicationUUID coreapplication.ID    `db:"application_uuid"`
+	HookTimeout     database.NullDuration `db:"hook_timeout"`
 }
 
 type applicationConfigHash struct {
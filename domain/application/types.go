@@ -4,6 +4,8 @@
 package application
 
 import (
+	"time"
+
 	"github.com/juju/collections/set"
 
 	"github.com/juju/juju/core/application"
@@ -350,12 +352,49 @@ type ApplicationConfig struct {
 // ApplicationSettings contains the settings for an application.
 type ApplicationSettings struct {
 	Trust bool
+
+	// HookTimeout overrides the model's hook timeout for the units of the
+	// application. If nil, the model's hook timeout applies.
+	HookTimeout *time.Duration
 }
 
 // UpdateApplicationSettingsArg is the argument used to update an application's
 // settings
 type UpdateApplicationSettingsArg struct {
-	Trust *bool
+	Trust       *bool
+	HookTimeout *time.Duration
+}
+
+// ApplicationSchedule is a schedule declared by the charm of an application,
+// on which the scheduled hook is run for each of its units.
+type ApplicationSchedule struct {
+	// Name is the name of the schedule.
+	Name string
+
+	// Cron is the effective cron expression of the schedule, taking any
+	// override into account.
+	Cron string
+
+	// Jitter is the effective jitter of the schedule, taking any override
+	// into account.
+	Jitter time.Duration
+
+	// CharmCron is the cron expression declared by the charm.
+	CharmCron string
+
+	// CharmJitter is the jitter declared by the charm.
+	CharmJitter time.Duration
+
+	// Override is the override of the schedule set by the operator, if any.
+	Override ScheduleOverride
+}
+
+// ScheduleOverride overrides a schedule declared by the charm of an
+// application. A nil value leaves the current value of the schedule
+// unchanged.
+type ScheduleOverride struct {
+	Cron   *string
+	Jitter *time.Duration
 }
 
 // ExposedEndpoint encapsulates the expose-related details of a particular
@@ -823,6 +823,55 @@ func (s *watcherSuite) TestWatchApplicationSettingsBadName(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
 }
 
+func (s *watcherSuite) TestWatchApplicationSchedules(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "application_schedule")
+	svc := s.setupService(c, factory)
+
+	appName := "foo"
+	s.createIAASApplicationWithCharmAndStoragePath(c, svc, appName, &stubCharm{
+		schedules: map[string]internalcharm.Schedule{
+			"nightly-backup": {
+				Name: "nightly-backup",
+				Cron: "0 3 * * *",
+			},
+		},
+	}, "")
+
+	ctx := c.Context()
+	watcher, err := svc.WatchApplicationSchedules(ctx, appName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	// Assert that overriding a schedule triggers the watcher.
+	harness.AddTest(func(c *tc.C) {
+		err := svc.SetApplicationScheduleOverrides(ctx, appName, map[string]application.ScheduleOverride{
+			"nightly-backup": {Cron: ptr("30 1 * * *")},
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that removing the override triggers the watcher.
+	harness.AddTest(func(c *tc.C) {
+		err := svc.UnsetApplicationScheduleOverrides(ctx, appName, []string{"nightly-backup"})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	harness.Run(c, struct{}{})
+}
+
+func (s *watcherSuite) TestWatchApplicationSchedulesBadName(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "application_schedule")
+	svc := s.setupService(c, factory)
+
+	_, err := svc.WatchApplicationSchedules(c.Context(), "bad-name")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
 func (s *watcherSuite) TestWatchUnitAddressesHashEmptyInitial(c *tc.C) {
 	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "unit_addresses_hash")
 	svc := s.setupService(c, factory)
@@ -1708,6 +1757,7 @@ func (s *watcherSuite) createSubnetForCAASModel(c *tc.C) {
 type stubCharm struct {
 	name        string
 	subordinate bool
+	schedules   map[string]internalcharm.Schedule
 }
 
 func (s *stubCharm) Meta() *internalcharm.Meta {
@@ -1718,6 +1768,7 @@ func (s *stubCharm) Meta() *internalcharm.Meta {
 	return &internalcharm.Meta{
 		Name:        name,
 		Subordinate: s.subordinate,
+		Schedules:   s.schedules,
 	}
 }
 
@@ -0,0 +1,8 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package backup provides a service for taking consistent logical dumps of
+// the controller and model databases, for inclusion in controller backups.
+// It also exports and imports snapshots of a single model database, so that
+// a model can be cloned into a new model on the same or another controller.
+package backup
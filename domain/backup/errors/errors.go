@@ -0,0 +1,16 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// IncompatibleSchema describes an error that occurs when a model snapshot
+	// was taken with a different model schema to the one in use.
+	IncompatibleSchema = errors.ConstError("incompatible model schema")
+
+	// ModelNotEmpty describes an error that occurs when a model snapshot is
+	// imported into a model that already has applications or machines.
+	ModelNotEmpty = errors.ConstError("model not empty")
+)
@@ -0,0 +1,131 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/backup"
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	"github.com/juju/juju/domain/schema"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State describes retrieval methods for backups.
+type State interface {
+	// DumpDatabase writes a logical dump of the whole database to the
+	// writer.
+	DumpDatabase(ctx context.Context, w io.Writer) error
+
+	// ExportModel writes a dump of the model database to the writer, and
+	// returns a description of the snapshot.
+	ExportModel(ctx context.Context, w io.Writer) (backup.ModelSnapshot, error)
+
+	// ImportModel replaces the contents of the model database with the
+	// model snapshot in the dump, rewriting the snapshot's object UUIDs to
+	// those in the input map.
+	ImportModel(
+		ctx context.Context,
+		snapshot backup.ModelSnapshot,
+		dump io.ReadSeeker,
+		objectUUIDs map[objectstore.UUID]objectstore.UUID,
+	) error
+}
+
+// Service provides the API for backing up a database.
+type Service struct {
+	st State
+}
+
+// NewService returns a new service reference wrapping the input state.
+func NewService(st State) *Service {
+	return &Service{
+		st: st,
+	}
+}
+
+// DumpDatabase writes a consistent logical dump of the database to the
+// writer. The dump is loaded into an empty database when restoring a backup.
+func (s *Service) DumpDatabase(ctx context.Context, w io.Writer) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := s.st.DumpDatabase(ctx, w); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+// ExportModel writes a consistent dump of the model database to the writer,
+// and returns a description of the snapshot, including the objectstore blobs
+// that the model references.
+func (s *Service) ExportModel(ctx context.Context, w io.Writer) (backup.ModelSnapshot, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	snapshot, err := s.st.ExportModel(ctx, w)
+	if err != nil {
+		return backup.ModelSnapshot{}, errors.Capture(err)
+	}
+	return snapshot, nil
+}
+
+// CheckModelImport checks that the model snapshot was taken with the model
+// schema in use by this controller.
+// The following errors may be returned:
+// - [backuperrors.IncompatibleSchema] if the snapshot was taken with a
+// different model schema.
+func (s *Service) CheckModelImport(ctx context.Context, snapshot backup.ModelSnapshot) error {
+	_, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	ddl := schema.ModelDDL()
+	if snapshot.SchemaVersion != ddl.Len() {
+		return errors.Errorf(
+			"snapshot has schema version %d, expected %d", snapshot.SchemaVersion, ddl.Len(),
+		).Add(backuperrors.IncompatibleSchema)
+	}
+	if snapshot.SchemaHash != ddl.Hash() {
+		return errors.Errorf(
+			"snapshot schema hash does not match schema version %d", ddl.Len(),
+		).Add(backuperrors.IncompatibleSchema)
+	}
+	return nil
+}
+
+// ImportModel replaces the contents of the model database with the model
+// snapshot in the dump. The snapshot's objects must already have been stored
+// in the model's objectstore, with the UUIDs they were stored under given in
+// the input map, keyed by their UUIDs in the snapshot.
+// The following errors may be returned:
+// - [backuperrors.IncompatibleSchema] if the snapshot was taken with a
+// different model schema.
+// - [backuperrors.ModelNotEmpty] if the model has applications, machines or
+// units.
+func (s *Service) ImportModel(
+	ctx context.Context,
+	snapshot backup.ModelSnapshot,
+	dump io.ReadSeeker,
+	objectUUIDs map[objectstore.UUID]objectstore.UUID,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := s.CheckModelImport(ctx, snapshot); err != nil {
+		return errors.Capture(err)
+	}
+	for _, obj := range snapshot.Objects {
+		if _, ok := objectUUIDs[obj.UUID]; !ok {
+			return errors.Errorf("object %q at %q has not been stored", obj.UUID, obj.Path)
+		}
+	}
+
+	if err := s.st.ImportModel(ctx, snapshot, dump, objectUUIDs); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
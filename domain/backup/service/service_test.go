@@ -0,0 +1,136 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/backup"
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	"github.com/juju/juju/domain/schema"
+	"github.com/juju/juju/internal/errors"
+)
+
+type serviceSuite struct {
+	state *MockState
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	return ctrl
+}
+
+func (s *serviceSuite) TestDumpDatabase(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DumpDatabase(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, "\"CREATE TABLE foo (id INT);\"\n")
+		return err
+	})
+
+	var buf bytes.Buffer
+	err := NewService(s.state).DumpDatabase(c.Context(), &buf)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(buf.String(), tc.Equals, "\"CREATE TABLE foo (id INT);\"\n")
+}
+
+func (s *serviceSuite) TestDumpDatabaseError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DumpDatabase(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	err := NewService(s.state).DumpDatabase(c.Context(), io.Discard)
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *serviceSuite) snapshot() backup.ModelSnapshot {
+	ddl := schema.ModelDDL()
+	return backup.ModelSnapshot{
+		ModelUUID:     "model-uuid",
+		SchemaVersion: ddl.Len(),
+		SchemaHash:    ddl.Hash(),
+		Objects: []backup.Object{{
+			UUID: "object-uuid",
+			Path: "charms/foo",
+		}},
+	}
+}
+
+func (s *serviceSuite) TestExportModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ExportModel(gomock.Any(), gomock.Any()).Return(s.snapshot(), nil)
+
+	snapshot, err := NewService(s.state).ExportModel(c.Context(), io.Discard)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(snapshot, tc.DeepEquals, s.snapshot())
+}
+
+func (s *serviceSuite) TestCheckModelImport(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.state).CheckModelImport(c.Context(), s.snapshot())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCheckModelImportSchemaVersion(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	snapshot := s.snapshot()
+	snapshot.SchemaVersion++
+
+	err := NewService(s.state).CheckModelImport(c.Context(), snapshot)
+	c.Assert(err, tc.ErrorIs, backuperrors.IncompatibleSchema)
+}
+
+func (s *serviceSuite) TestCheckModelImportSchemaHash(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	snapshot := s.snapshot()
+	snapshot.SchemaHash = "blah"
+
+	err := NewService(s.state).CheckModelImport(c.Context(), snapshot)
+	c.Assert(err, tc.ErrorIs, backuperrors.IncompatibleSchema)
+}
+
+func (s *serviceSuite) TestImportModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dump := strings.NewReader("dump")
+	objects := map[objectstore.UUID]objectstore.UUID{"object-uuid": "new-object-uuid"}
+	s.state.EXPECT().ImportModel(gomock.Any(), s.snapshot(), dump, objects).Return(nil)
+
+	err := NewService(s.state).ImportModel(c.Context(), s.snapshot(), dump, objects)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestImportModelIncompatibleSchema(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	snapshot := s.snapshot()
+	snapshot.SchemaVersion--
+
+	err := NewService(s.state).ImportModel(c.Context(), snapshot, strings.NewReader("dump"), nil)
+	c.Assert(err, tc.ErrorIs, backuperrors.IncompatibleSchema)
+}
+
+func (s *serviceSuite) TestImportModelMissingObject(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.state).ImportModel(c.Context(), s.snapshot(), strings.NewReader("dump"), nil)
+	c.Assert(err, tc.ErrorMatches, `object "object-uuid" at "charms/foo" has not been stored`)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/backup/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/backup/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	objectstore "github.com/juju/juju/core/objectstore"
+	backup "github.com/juju/juju/domain/backup"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// DumpDatabase mocks base method.
+func (m *MockState) DumpDatabase(arg0 context.Context, arg1 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpDatabase", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DumpDatabase indicates an expected call of DumpDatabase.
+func (mr *MockStateMockRecorder) DumpDatabase(arg0, arg1 any) *MockStateDumpDatabaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpDatabase", reflect.TypeOf((*MockState)(nil).DumpDatabase), arg0, arg1)
+	return &MockStateDumpDatabaseCall{Call: call}
+}
+
+// MockStateDumpDatabaseCall wrap *gomock.Call
+type MockStateDumpDatabaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDumpDatabaseCall) Return(arg0 error) *MockStateDumpDatabaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDumpDatabaseCall) Do(f func(context.Context, io.Writer) error) *MockStateDumpDatabaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDumpDatabaseCall) DoAndReturn(f func(context.Context, io.Writer) error) *MockStateDumpDatabaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportModel mocks base method.
+func (m *MockState) ExportModel(arg0 context.Context, arg1 io.Writer) (backup.ModelSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportModel", arg0, arg1)
+	ret0, _ := ret[0].(backup.ModelSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportModel indicates an expected call of ExportModel.
+func (mr *MockStateMockRecorder) ExportModel(arg0, arg1 any) *MockStateExportModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportModel", reflect.TypeOf((*MockState)(nil).ExportModel), arg0, arg1)
+	return &MockStateExportModelCall{Call: call}
+}
+
+// MockStateExportModelCall wrap *gomock.Call
+type MockStateExportModelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateExportModelCall) Return(arg0 backup.ModelSnapshot, arg1 error) *MockStateExportModelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateExportModelCall) Do(f func(context.Context, io.Writer) (backup.ModelSnapshot, error)) *MockStateExportModelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateExportModelCall) DoAndReturn(f func(context.Context, io.Writer) (backup.ModelSnapshot, error)) *MockStateExportModelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportModel mocks base method.
+func (m *MockState) ImportModel(arg0 context.Context, arg1 backup.ModelSnapshot, arg2 io.ReadSeeker, arg3 map[objectstore.UUID]objectstore.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportModel", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportModel indicates an expected call of ImportModel.
+func (mr *MockStateMockRecorder) ImportModel(arg0, arg1, arg2, arg3 any) *MockStateImportModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportModel", reflect.TypeOf((*MockState)(nil).ImportModel), arg0, arg1, arg2, arg3)
+	return &MockStateImportModelCall{Call: call}
+}
+
+// MockStateImportModelCall wrap *gomock.Call
+type MockStateImportModelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateImportModelCall) Return(arg0 error) *MockStateImportModelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateImportModelCall) Do(f func(context.Context, backup.ModelSnapshot, io.ReadSeeker, map[objectstore.UUID]objectstore.UUID) error) *MockStateImportModelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateImportModelCall) DoAndReturn(f func(context.Context, backup.ModelSnapshot, io.ReadSeeker, map[objectstore.UUID]objectstore.UUID) error) *MockStateImportModelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
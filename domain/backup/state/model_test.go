@@ -0,0 +1,296 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+
+	coredb "github.com/juju/juju/core/database"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/backup"
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	"github.com/juju/juju/domain/schema"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type modelStateSuite struct {
+	schematesting.ModelSuite
+}
+
+func TestModelStateSuite(t *testing.T) {
+	tc.Run(t, &modelStateSuite{})
+}
+
+const (
+	sourceModelUUID = "aaaaaaaa-aaaa-4aaa-8aaa-aaaaaaaaaaaa"
+	targetModelUUID = "bbbbbbbb-bbbb-4bbb-8bbb-bbbbbbbbbbbb"
+	sourceObject    = "cccccccc-cccc-4ccc-8ccc-cccccccccccc"
+	targetObject    = "dddddddd-dddd-4ddd-8ddd-dddddddddddd"
+)
+
+func (s *modelStateSuite) exec(c *tc.C, runner coredb.TxnRunner, stmt string, args ...any) {
+	err := runner.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, stmt, args...)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *modelStateSuite) seedModel(c *tc.C, runner coredb.TxnRunner, modelUUID, name, objectUUID string) {
+	s.exec(c, runner, `
+INSERT INTO model (uuid, controller_uuid, name, qualifier, type, cloud, cloud_type)
+VALUES (?, 'controller-uuid', ?, 'prod', 'iaas', 'aws', 'ec2')`, modelUUID, name)
+	s.exec(c, runner, `
+INSERT INTO model_config ("key", value)
+VALUES ('name', ?), ('uuid', ?), ('type', 'ec2')`, name, modelUUID)
+	s.exec(c, runner, `
+INSERT INTO object_store_metadata (uuid, sha_256, sha_384, size)
+VALUES (?, 'sha256', 'sha384', 42)`, objectUUID)
+	s.exec(c, runner, `
+INSERT INTO object_store_metadata_path (path, metadata_uuid)
+VALUES ('charms/foo', ?)`, objectUUID)
+}
+
+func (s *modelStateSuite) newTarget(c *tc.C) (coredb.TxnRunner, *State) {
+	runner, _ := s.OpenDBForNamespace(c, "target", true)
+	s.ApplyDDLForRunner(c, &schematesting.SchemaApplier{
+		Schema: schema.ModelDDL(),
+	}, runner)
+	return runner, NewState(func() (coredb.TxnRunner, error) {
+		return runner, nil
+	})
+}
+
+func (s *modelStateSuite) export(c *tc.C) (backup.ModelSnapshot, []byte) {
+	s.seedModel(c, s.TxnRunner(), sourceModelUUID, "source", sourceObject)
+	s.exec(c, s.TxnRunner(), `
+INSERT INTO model_config ("key", value) VALUES ('logging-config', '<root>=DEBUG')`)
+	s.exec(c, s.TxnRunner(), `
+INSERT INTO charm (uuid, object_store_uuid, reference_name) VALUES ('charm-uuid', ?, 'foo')`, sourceObject)
+	s.exec(c, s.TxnRunner(), `INSERT INTO "constraint" (uuid, mem) VALUES ('constraint-uuid', 1024)`)
+	s.exec(c, s.TxnRunner(), `
+INSERT INTO model_constraint (model_uuid, constraint_uuid) VALUES (?, 'constraint-uuid')`, sourceModelUUID)
+
+	var buf bytes.Buffer
+	snapshot, err := NewState(s.TxnRunnerFactory()).ExportModel(c.Context(), &buf)
+	c.Assert(err, tc.ErrorIsNil)
+	return snapshot, buf.Bytes()
+}
+
+func (s *modelStateSuite) TestExportModel(c *tc.C) {
+	snapshot, dump := s.export(c)
+
+	c.Check(snapshot, tc.DeepEquals, backup.ModelSnapshot{
+		ModelUUID:     coremodel.UUID(sourceModelUUID),
+		SchemaVersion: schema.ModelDDL().Len(),
+		SchemaHash:    schema.ModelDDL().Hash(),
+		Objects: []backup.Object{{
+			UUID:   objectstore.UUID(sourceObject),
+			Path:   "charms/foo",
+			SHA256: "sha256",
+			SHA384: "sha384",
+			Size:   42,
+		}},
+	})
+	c.Check(strings.Contains(string(dump), `'charm-uuid'`), tc.IsTrue)
+}
+
+func (s *modelStateSuite) TestExportModelWriteError(c *tc.C) {
+	s.seedModel(c, s.TxnRunner(), sourceModelUUID, "source", sourceObject)
+
+	_, err := NewState(s.TxnRunnerFactory()).ExportModel(c.Context(), failingWriter{})
+	c.Assert(err, tc.ErrorMatches, `exporting model database: .*disk full`)
+}
+
+func (s *modelStateSuite) TestGetSchemaVersion(c *tc.C) {
+	version, hash, err := NewState(s.TxnRunnerFactory()).GetSchemaVersion(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(version, tc.Equals, schema.ModelDDL().Len())
+	c.Check(hash, tc.Equals, schema.ModelDDL().Hash())
+}
+
+func (s *modelStateSuite) TestImportModel(c *tc.C) {
+	snapshot, dump := s.export(c)
+
+	target, st := s.newTarget(c)
+	s.seedModel(c, target, targetModelUUID, "target", targetObject)
+
+	err := st.ImportModel(c.Context(), snapshot, bytes.NewReader(dump), map[objectstore.UUID]objectstore.UUID{
+		sourceObject: targetObject,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = target.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		var name, uuid string
+		if err := tx.QueryRowContext(ctx, "SELECT uuid, name FROM model").Scan(&uuid, &name); err != nil {
+			return err
+		}
+		c.Check(uuid, tc.Equals, targetModelUUID)
+		c.Check(name, tc.Equals, "target")
+
+		config := make(map[string]string)
+		rows, err := tx.QueryContext(ctx, `SELECT "key", value FROM model_config`)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var key, value string
+			if err := rows.Scan(&key, &value); err != nil {
+				return err
+			}
+			config[key] = value
+		}
+		c.Check(config, tc.DeepEquals, map[string]string{
+			"name":           "target",
+			"uuid":           targetModelUUID,
+			"type":           "ec2",
+			"logging-config": "<root>=DEBUG",
+		})
+
+		var objectUUID string
+		if err := tx.QueryRowContext(ctx, "SELECT object_store_uuid FROM charm WHERE uuid = 'charm-uuid'").Scan(&objectUUID); err != nil {
+			return err
+		}
+		c.Check(objectUUID, tc.Equals, targetObject)
+
+		var modelUUID string
+		if err := tx.QueryRowContext(ctx, "SELECT model_uuid FROM model_constraint").Scan(&modelUUID); err != nil {
+			return err
+		}
+		c.Check(modelUUID, tc.Equals, targetModelUUID)
+
+		// The triggers are recreated once the model is imported.
+		var count int
+		if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'trigger'").Scan(&count); err != nil {
+			return err
+		}
+		c.Check(count, tc.Not(tc.Equals), 0)
+		return nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *modelStateSuite) TestImportModelClearsProviderIDs(c *tc.C) {
+	s.exec(c, s.TxnRunner(), `INSERT INTO net_node (uuid) VALUES ('net-node-uuid')`)
+	s.exec(c, s.TxnRunner(), `
+INSERT INTO machine (uuid, net_node_uuid, name, life_id) VALUES ('machine-uuid', 'net-node-uuid', '0', 0)`)
+	s.exec(c, s.TxnRunner(), `
+INSERT INTO machine_cloud_instance (machine_uuid, instance_id, display_name, arch)
+VALUES ('machine-uuid', 'i-0123456789', 'juju-source-0', 'amd64')`)
+	s.exec(c, s.TxnRunner(), `INSERT INTO space (uuid, name) VALUES ('space-uuid', 'db')`)
+	s.exec(c, s.TxnRunner(), `INSERT INTO provider_space (provider_id, space_uuid) VALUES ('sp-0123', 'space-uuid')`)
+	snapshot, dump := s.export(c)
+
+	target, st := s.newTarget(c)
+	s.seedModel(c, target, targetModelUUID, "target", targetObject)
+
+	err := st.ImportModel(c.Context(), snapshot, bytes.NewReader(dump), map[objectstore.UUID]objectstore.UUID{
+		sourceObject: targetObject,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = target.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		var (
+			instanceID, displayName sql.NullString
+			arch                    string
+		)
+		if err := tx.QueryRowContext(ctx, `
+SELECT instance_id, display_name, arch
+FROM   machine_cloud_instance
+WHERE  machine_uuid = 'machine-uuid'`).Scan(&instanceID, &displayName, &arch); err != nil {
+			return err
+		}
+		c.Check(instanceID.Valid, tc.IsFalse)
+		c.Check(displayName.Valid, tc.IsFalse)
+		c.Check(arch, tc.Equals, "amd64")
+
+		var spaces, providerSpaces int
+		if err := tx.QueryRowContext(ctx, `
+SELECT (SELECT COUNT(*) FROM space WHERE uuid = 'space-uuid'),
+       (SELECT COUNT(*) FROM provider_space)`).Scan(&spaces, &providerSpaces); err != nil {
+			return err
+		}
+		c.Check(spaces, tc.Equals, 1)
+		c.Check(providerSpaces, tc.Equals, 0)
+		return nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *modelStateSuite) TestImportModelRecordsChanges(c *tc.C) {
+	snapshot, dump := s.export(c)
+
+	target, st := s.newTarget(c)
+	s.seedModel(c, target, targetModelUUID, "target", targetObject)
+
+	var charmChanges int
+	countChanges := func() {
+		err := target.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+			return tx.QueryRowContext(ctx, `
+SELECT COUNT(*)
+FROM   change_log
+WHERE  changed = 'charm-uuid'`).Scan(&charmChanges)
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	countChanges()
+	c.Assert(charmChanges, tc.Equals, 0)
+
+	err := st.ImportModel(c.Context(), snapshot, bytes.NewReader(dump), map[objectstore.UUID]objectstore.UUID{
+		sourceObject: targetObject,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The imported charm is seen by the charm watchers.
+	countChanges()
+	c.Check(charmChanges, tc.Not(tc.Equals), 0)
+}
+
+func (s *modelStateSuite) TestProviderIDsAreCleared(c *tc.C) {
+	// Every column holding an ID given by the cloud must be cleared when a
+	// snapshot is imported.
+	cleared := make(map[string]bool)
+	for table := range providerIDColumns {
+		cleared[table] = true
+	}
+	for _, table := range providerTables {
+		cleared[table] = true
+	}
+
+	rows, err := s.DB().QueryContext(c.Context(), `
+SELECT m.name, p.name
+FROM   sqlite_master AS m
+JOIN   pragma_table_info(m.name) AS p
+WHERE  m.type = 'table'
+AND    p.name IN ('provider_id', 'instance_id', 'provider_network_id')`)
+	c.Assert(err, tc.ErrorIsNil)
+	defer rows.Close()
+	for rows.Next() {
+		var table, column string
+		c.Assert(rows.Scan(&table, &column), tc.ErrorIsNil)
+		c.Check(cleared[table], tc.IsTrue, tc.Commentf("%s.%s is not cleared on import", table, column))
+	}
+	c.Assert(rows.Err(), tc.ErrorIsNil)
+}
+
+func (s *modelStateSuite) TestImportModelNotEmpty(c *tc.C) {
+	snapshot, dump := s.export(c)
+
+	target, st := s.newTarget(c)
+	s.seedModel(c, target, targetModelUUID, "target", targetObject)
+	s.exec(c, target, `INSERT INTO net_node (uuid) VALUES ('net-node-uuid')`)
+	s.exec(c, target, `
+INSERT INTO machine (uuid, net_node_uuid, name, life_id) VALUES ('machine-uuid', 'net-node-uuid', '0', 0)`)
+
+	err := st.ImportModel(c.Context(), snapshot, bytes.NewReader(dump), nil)
+	c.Assert(err, tc.ErrorIs, backuperrors.ModelNotEmpty)
+}
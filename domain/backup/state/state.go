@@ -0,0 +1,432 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	coredb "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/backup"
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	"github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State provides access to the database for backups.
+// Dumping a database requires the standard library transaction, which the
+// domain state base does not expose, so the state uses the runner factory
+// directly.
+type State struct {
+	getDB coredb.TxnRunnerFactory
+}
+
+// NewState returns a new state reference.
+func NewState(factory coredb.TxnRunnerFactory) *State {
+	return &State{
+		getDB: factory,
+	}
+}
+
+// DumpDatabase writes a logical dump of the whole database to the writer.
+// The dump is taken in a single transaction, so it is a consistent snapshot
+// of the database. The dump is streamed to the writer as it is taken, so the
+// transaction is not retried once any of it has been written.
+func (s *State) DumpDatabase(ctx context.Context, w io.Writer) error {
+	db, err := s.getDB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	cw := &countingWriter{w: w}
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if cw.n > 0 {
+			return errors.Errorf("cannot retry dump after writing %d bytes", cw.n)
+		}
+		return database.DumpDB(ctx, tx, cw)
+	})
+	if err != nil {
+		return errors.Errorf("dumping database: %w", err)
+	}
+	return nil
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+// Write is part of the [io.Writer] interface.
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+// preservedModelTables are the model database tables that are kept when a
+// model snapshot is imported. They describe the model being imported into,
+// rather than its contents, or they are populated as the snapshot's objects
+// are stored.
+var preservedModelTables = map[string]bool{
+	"schema":                     true,
+	"model":                      true,
+	"model_agent":                true,
+	"agent_version":              true,
+	"change_log":                 true,
+	"change_log_witness":         true,
+	"object_store_metadata":      true,
+	"object_store_metadata_path": true,
+}
+
+// preservedModelConfig are the model config keys that identify the model,
+// which are kept when a model snapshot is imported.
+var preservedModelConfig = []string{"name", "uuid", "type"}
+
+// providerIDColumns are the columns holding the IDs that the cloud gave to
+// the instances, volumes and other resources of the model a snapshot was
+// taken from. They are cleared when a snapshot is imported, so that the
+// imported model doesn't claim the resources of the exported model.
+var providerIDColumns = map[string][]string{
+	"machine_cloud_instance":  {"instance_id", "display_name"},
+	"storage_volume":          {"provider_id"},
+	"storage_filesystem":      {"provider_id"},
+	"storage_volume_snapshot": {"provider_id"},
+}
+
+// providerTables are the tables that map the cloud's resources to the
+// entities of the model a snapshot was taken from. Their rows are deleted
+// when a snapshot is imported, and are added again as the imported model's
+// own resources are discovered. Referencing tables come first.
+var providerTables = []string{
+	"provider_space",
+	"provider_subnet",
+	"provider_network_subnet",
+	"provider_network",
+	"provider_link_layer_device",
+	"provider_ip_address",
+	"k8s_service",
+	"k8s_pod_port",
+	"k8s_pod",
+}
+
+// ExportModel writes a dump of the model database to the writer, and returns
+// a description of the snapshot. The dump and the description are taken in
+// a single transaction, so that they are consistent. The dump is streamed to
+// the writer as it is taken, so the transaction is not retried once any of
+// it has been written.
+func (s *State) ExportModel(ctx context.Context, w io.Writer) (backup.ModelSnapshot, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return backup.ModelSnapshot{}, errors.Capture(err)
+	}
+
+	var snapshot backup.ModelSnapshot
+	cw := &countingWriter{w: w}
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if cw.n > 0 {
+			return errors.Errorf("cannot retry export after writing %d bytes", cw.n)
+		}
+		snapshot = backup.ModelSnapshot{}
+
+		if err := tx.QueryRowContext(ctx, "SELECT uuid FROM model").Scan(&snapshot.ModelUUID); err != nil {
+			return errors.Errorf("reading model uuid: %w", err)
+		}
+
+		var err error
+		snapshot.SchemaVersion, snapshot.SchemaHash, err = schemaVersion(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		if snapshot.Objects, err = objects(ctx, tx); err != nil {
+			return errors.Capture(err)
+		}
+
+		return database.DumpDB(ctx, tx, cw)
+	})
+	if err != nil {
+		return backup.ModelSnapshot{}, errors.Errorf("exporting model database: %w", err)
+	}
+	return snapshot, nil
+}
+
+// GetSchemaVersion returns the version of the model schema, and the hash
+// recorded against it.
+func (s *State) GetSchemaVersion(ctx context.Context) (int, string, error) {
+	db, err := s.getDB()
+	if err != nil {
+		return -1, "", errors.Capture(err)
+	}
+
+	var (
+		version int
+		hash    string
+	)
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		version, hash, err = schemaVersion(ctx, tx)
+		return err
+	})
+	return version, hash, errors.Capture(err)
+}
+
+// ImportModel replaces the contents of the model database with the model
+// snapshot in the dump. The model must not have any applications, machines
+// or units. The identity of the model, its agent and the objectstore
+// metadata are kept, and references to the snapshot's model UUID and object
+// UUIDs are rewritten to the UUIDs in the input map. The IDs of the exported
+// model's cloud resources are cleared, and the replaced rows are recorded in
+// the change log so that watchers see the imported model.
+// The following errors may be returned:
+// - [backuperrors.ModelNotEmpty] if the model has applications, machines or
+// units.
+func (s *State) ImportModel(
+	ctx context.Context,
+	snapshot backup.ModelSnapshot,
+	dump io.ReadSeeker,
+	objectUUIDs map[objectstore.UUID]objectstore.UUID,
+) error {
+	db, err := s.getDB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := dump.Seek(0, io.SeekStart); err != nil {
+			return errors.Capture(err)
+		}
+
+		var count int
+		if err := tx.QueryRowContext(ctx, `
+SELECT application_count + machine_count + unit_count
+FROM   v_model_metrics`).Scan(&count); err != nil {
+			return errors.Errorf("reading model metrics: %w", err)
+		} else if count > 0 {
+			return backuperrors.ModelNotEmpty
+		}
+
+		var modelUUID string
+		if err := tx.QueryRowContext(ctx, "SELECT uuid FROM model").Scan(&modelUUID); err != nil {
+			return errors.Errorf("reading model uuid: %w", err)
+		}
+
+		config, err := identityConfig(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		// Foreign key checks are deferred until the transaction is
+		// committed, as the rows referenced by the preserved tables are
+		// deleted and then loaded again from the dump.
+		if _, err := tx.ExecContext(ctx, "PRAGMA defer_foreign_keys = ON;"); err != nil {
+			return errors.Errorf("deferring foreign key checks: %w", err)
+		}
+
+		// The triggers guarding immutable rows are dropped while the
+		// contents are replaced, so that the rows can be removed. The change
+		// log triggers are kept, so that the removed and imported rows are
+		// seen by watchers.
+		triggers, err := dropGuardTriggers(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if err := deleteContents(ctx, tx); err != nil {
+			return errors.Capture(err)
+		}
+
+		replacements := []string{snapshot.ModelUUID.String(), modelUUID}
+		for from, to := range objectUUIDs {
+			replacements = append(replacements, from.String(), to.String())
+		}
+		replacer := strings.NewReplacer(replacements...)
+
+		// Only the rows are loaded from the dump; the schema is already
+		// in place.
+		err = database.LoadDumpWithFilter(ctx, tx, dump, func(stmt string) (string, error) {
+			table, ok := database.InsertTable(stmt)
+			if !ok || preservedModelTables[table] {
+				return "", nil
+			}
+			return replacer.Replace(stmt), nil
+		})
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		if err := clearProviderIDs(ctx, tx); err != nil {
+			return errors.Capture(err)
+		}
+
+		for key, value := range config {
+			if _, err := tx.ExecContext(ctx, `
+INSERT INTO model_config ("key", value) VALUES (?, ?)
+ON CONFLICT ("key") DO UPDATE SET value = excluded.value`, key, value); err != nil {
+				return errors.Errorf("restoring model config %q: %w", key, err)
+			}
+		}
+
+		for _, trigger := range triggers {
+			if _, err := tx.ExecContext(ctx, trigger); err != nil {
+				return errors.Errorf("recreating trigger: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Errorf("importing model database: %w", err)
+	}
+	return nil
+}
+
+func schemaVersion(ctx context.Context, tx *sql.Tx) (int, string, error) {
+	var (
+		version int
+		hash    string
+	)
+	err := tx.QueryRowContext(ctx, `
+SELECT version, hash
+FROM   schema
+ORDER BY version DESC
+LIMIT 1`).Scan(&version, &hash)
+	if err != nil {
+		return -1, "", errors.Errorf("reading schema version: %w", err)
+	}
+	return version, hash, nil
+}
+
+func objects(ctx context.Context, tx *sql.Tx) ([]backup.Object, error) {
+	rows, err := tx.QueryContext(ctx, `
+SELECT uuid, path, sha_256, sha_384, size
+FROM   v_object_store_metadata
+WHERE  path IS NOT NULL
+ORDER BY path`)
+	if err != nil {
+		return nil, errors.Errorf("reading objectstore metadata: %w", err)
+	}
+	defer rows.Close()
+
+	var result []backup.Object
+	for rows.Next() {
+		var obj backup.Object
+		if err := rows.Scan(&obj.UUID, &obj.Path, &obj.SHA256, &obj.SHA384, &obj.Size); err != nil {
+			return nil, errors.Capture(err)
+		}
+		result = append(result, obj)
+	}
+	return result, errors.Capture(rows.Err())
+}
+
+func identityConfig(ctx context.Context, tx *sql.Tx) (map[string]string, error) {
+	config := make(map[string]string)
+	for _, key := range preservedModelConfig {
+		var value string
+		err := tx.QueryRowContext(ctx, `SELECT value FROM model_config WHERE "key" = ?`, key).Scan(&value)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		} else if err != nil {
+			return nil, errors.Errorf("reading model config %q: %w", key, err)
+		}
+		config[key] = value
+	}
+	return config, nil
+}
+
+// dropGuardTriggers drops the triggers in the database that raise an error
+// to guard rows from being changed, returning the statements that recreate
+// them.
+func dropGuardTriggers(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+SELECT name, sql
+FROM   sqlite_master
+WHERE  type = 'trigger'
+AND    name NOT LIKE 'sqlite_%'
+AND    sql LIKE '%RAISE(%'
+ORDER BY rowid`)
+	if err != nil {
+		return nil, errors.Errorf("reading triggers: %w", err)
+	}
+	defer rows.Close()
+
+	var names, triggers []string
+	for rows.Next() {
+		var name, stmt string
+		if err := rows.Scan(&name, &stmt); err != nil {
+			return nil, errors.Capture(err)
+		}
+		names = append(names, name)
+		triggers = append(triggers, stmt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	for _, name := range names {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP TRIGGER %q", name)); err != nil {
+			return nil, errors.Errorf("dropping trigger %q: %w", name, err)
+		}
+	}
+	return triggers, nil
+}
+
+// deleteContents deletes the rows from all the tables that are not
+// preserved when a model snapshot is imported.
+func deleteContents(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, `
+SELECT name
+FROM   sqlite_master
+WHERE  type = 'table'
+AND    name NOT LIKE 'sqlite_%'`)
+	if err != nil {
+		return errors.Errorf("reading tables: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return errors.Capture(err)
+		}
+		if !preservedModelTables[name] {
+			tables = append(tables, name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Capture(err)
+	}
+
+	for _, table := range tables {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %q", table)); err != nil {
+			return errors.Errorf("deleting from %q: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// clearProviderIDs clears the IDs of the cloud resources of the model that
+// a snapshot was taken from.
+func clearProviderIDs(ctx context.Context, tx *sql.Tx) error {
+	for table, columns := range providerIDColumns {
+		assignments := make([]string, len(columns))
+		for i, column := range columns {
+			assignments[i] = fmt.Sprintf("%q = NULL", column)
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+			"UPDATE %q SET %s", table, strings.Join(assignments, ", "),
+		)); err != nil {
+			return errors.Errorf("clearing provider IDs in %q: %w", table, err)
+		}
+	}
+	for _, table := range providerTables {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %q", table)); err != nil {
+			return errors.Errorf("deleting from %q: %w", table, err)
+		}
+	}
+	return nil
+}
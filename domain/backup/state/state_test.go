@@ -0,0 +1,52 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/errors"
+)
+
+type stateSuite struct {
+	schematesting.ControllerSuite
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) TestDumpDatabase(c *tc.C) {
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "INSERT INTO flag (name, value, description) VALUES ('foo', true, 'bar')")
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var buf bytes.Buffer
+	err = NewState(s.TxnRunnerFactory()).DumpDatabase(c.Context(), &buf)
+	c.Assert(err, tc.ErrorIsNil)
+
+	dump := buf.String()
+	c.Check(strings.Contains(dump, `"CREATE TABLE flag (`), tc.IsTrue)
+	c.Check(strings.Contains(dump, `"INSERT INTO \"flag\" (\"name\", \"value\", \"description\") VALUES ('foo', 1, 'bar');"`), tc.IsTrue)
+}
+
+func (s *stateSuite) TestDumpDatabaseWriteError(c *tc.C) {
+	err := NewState(s.TxnRunnerFactory()).DumpDatabase(c.Context(), failingWriter{})
+	c.Assert(err, tc.ErrorMatches, `dumping database: .*disk full`)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backup
+
+import (
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+)
+
+// ModelSnapshot describes a dump of a model database, taken so that the
+// model can be imported into another model.
+type ModelSnapshot struct {
+	// ModelUUID is the UUID of the model that the snapshot was taken from.
+	ModelUUID coremodel.UUID
+
+	// SchemaVersion is the version of the model schema at the time the
+	// snapshot was taken.
+	SchemaVersion int
+
+	// SchemaHash is the hash recorded against the schema version.
+	SchemaHash string
+
+	// Objects are the objectstore blobs referenced by the model database.
+	Objects []Object
+}
+
+// Object describes an objectstore blob referenced by a model database.
+type Object struct {
+	// UUID is the UUID of the object's metadata in the model database.
+	UUID objectstore.UUID
+
+	// Path is the path that the object is stored under.
+	Path string
+
+	// SHA256 is the SHA256 hash of the object.
+	SHA256 string
+
+	// SHA384 is the SHA384 hash of the object.
+	SHA384 string
+
+	// Size is the size of the object in bytes.
+	Size int64
+}
@@ -6,6 +6,7 @@ package modelmigration
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/description/v10"
 
 	"github.com/juju/juju/core/logger"
@@ -17,8 +18,9 @@ import (
 )
 
 // RegisterExport registers the export operations with the given coordinator.
-func RegisterExport(coordinator Coordinator, logger logger.Logger) {
+func RegisterExport(coordinator Coordinator, clock clock.Clock, logger logger.Logger) {
 	coordinator.Add(&exportOperation{
+		clock:  clock,
 		logger: logger,
 	})
 }
@@ -34,6 +36,7 @@ type ExportService interface {
 type exportOperation struct {
 	modelmigration.BaseOperation
 
+	clock   clock.Clock
 	logger  logger.Logger
 	service ExportService
 }
@@ -48,7 +51,7 @@ func (e *exportOperation) Setup(scope modelmigration.Scope) error {
 	// We must not use a watcher during migration, so it's safe to pass a
 	// nil watcher factory.
 	e.service = service.NewService(
-		state.NewState(scope.ModelDB()), e.logger)
+		state.NewState(scope.ModelDB()), e.clock, e.logger)
 	return nil
 }
 
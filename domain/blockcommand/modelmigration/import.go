@@ -6,6 +6,7 @@ package modelmigration
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/description/v10"
 
 	"github.com/juju/juju/core/logger"
@@ -23,8 +24,9 @@ type Coordinator interface {
 }
 
 // RegisterImport registers the import operations with the given coordinator.
-func RegisterImport(coordinator Coordinator, logger logger.Logger) {
+func RegisterImport(coordinator Coordinator, clock clock.Clock, logger logger.Logger) {
 	coordinator.Add(&importOperation{
+		clock:  clock,
 		logger: logger,
 	})
 }
@@ -38,6 +40,7 @@ type ImportService interface {
 type importOperation struct {
 	modelmigration.BaseOperation
 
+	clock   clock.Clock
 	logger  logger.Logger
 	service ImportService
 }
@@ -52,7 +55,7 @@ func (i *importOperation) Setup(scope modelmigration.Scope) error {
 	// We must not use a watcher during migration, so it's safe to pass a
 	// nil watcher factory.
 	i.service = service.NewService(
-		state.NewState(scope.ModelDB()), i.logger)
+		state.NewState(scope.ModelDB()), i.clock, i.logger)
 	return nil
 }
 
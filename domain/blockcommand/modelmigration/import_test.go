@@ -6,6 +6,7 @@ package modelmigration
 import (
 	"testing"
 
+	"github.com/juju/clock"
 	"github.com/juju/description/v10"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
@@ -43,7 +44,7 @@ func (s *importSuite) TestRegisterImport(c *tc.C) {
 
 	s.coordinator.EXPECT().Add(gomock.Any())
 
-	RegisterImport(s.coordinator, loggertesting.WrapCheckLog(c))
+	RegisterImport(s.coordinator, clock.WallClock, loggertesting.WrapCheckLog(c))
 }
 
 func (s *importSuite) TestImport(c *tc.C) {
@@ -5,6 +5,9 @@ package service
 
 import (
 	"context"
+	"time"
+
+	"github.com/juju/clock"
 
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/domain/blockcommand"
@@ -14,9 +17,9 @@ import (
 
 // State defines an interface for interacting with the underlying state.
 type State interface {
-	// SetBlock switches on a command block for a given type with an optional
-	// message.
-	SetBlock(ctx context.Context, t blockcommand.BlockType, message string) error
+	// SetBlock switches on a command block for a given type with the given
+	// arguments. Any expired block of the same type is replaced.
+	SetBlock(ctx context.Context, t blockcommand.BlockType, args blockcommand.BlockArgs, now time.Time) error
 
 	// RemoveBlock disables block of specified type for the current model.
 	RemoveBlock(ctx context.Context, t blockcommand.BlockType) error
@@ -24,50 +27,83 @@ type State interface {
 	// RemoveAllBlocks removes all the blocks for the current model.
 	RemoveAllBlocks(ctx context.Context) error
 
-	// GetBlocks returns all the blocks for the current model.
+	// GetBlocks returns all the blocks for the current model, including any
+	// that have expired.
 	GetBlocks(ctx context.Context) ([]blockcommand.Block, error)
 
-	// GetBlockMessage returns the optional block message if it is switched on.
-	GetBlockMessage(ctx context.Context, t blockcommand.BlockType) (string, error)
+	// GetBlock returns the block of the given type, including any that has
+	// expired.
+	GetBlock(ctx context.Context, t blockcommand.BlockType) (blockcommand.Block, error)
 }
 
 // Service defines a service for interacting with the underlying state.
 type Service struct {
 	st     State
+	clock  clock.Clock
 	logger logger.Logger
 }
 
 // NewService returns a new Service for interacting with the underlying state.
-func NewService(st State, logger logger.Logger) *Service {
+func NewService(st State, clock clock.Clock, logger logger.Logger) *Service {
 	return &Service{
 		st:     st,
+		clock:  clock,
 		logger: logger,
 	}
 }
 
 // GetBlockSwitchedOn returns the optional block message if it is switched on
-// for the given type.
+// for the given type. A block that has expired, or that is scoped so that it
+// doesn't apply to the operation described by the context, is not
+// considered to be switched on.
 // Returns an error [errors.NotFound] if the block does not exist.
 func (s *Service) GetBlockSwitchedOn(ctx context.Context, t blockcommand.BlockType) (string, error) {
 	if err := t.Validate(); err != nil {
 		return "", err
 	}
 
-	return s.st.GetBlockMessage(ctx, t)
+	block, err := s.st.GetBlock(ctx, t)
+	if err != nil {
+		return "", err
+	}
+
+	op, _ := blockcommand.OperationFromContext(ctx)
+	if !block.AppliesTo(ctx, op, s.clock.Now()) {
+		return "", blockcommanderrors.NotFound
+	}
+	return block.Message, nil
 }
 
 // SwitchBlockOn switches on a command block for a given type and message.
 // Returns an error [errors.AlreadyExists] if the block already exists.
 func (s *Service) SwitchBlockOn(ctx context.Context, t blockcommand.BlockType, message string) error {
+	return s.SwitchBlockOnWithArgs(ctx, t, blockcommand.BlockArgs{
+		Message: message,
+	})
+}
+
+// SwitchBlockOnWithArgs switches on a command block for a given type, which
+// may expire or be limited to particular applications, methods or users.
+// If a block of the given type is already switched on, it is left in place.
+func (s *Service) SwitchBlockOnWithArgs(ctx context.Context, t blockcommand.BlockType, args blockcommand.BlockArgs) error {
 	if err := t.Validate(); err != nil {
 		return err
 	}
 
-	if len(message) > blockcommand.DefaultMaxMessageLength {
-		return errors.Errorf("message length exceeds maximum allowed length of %d", blockcommand.DefaultMaxMessageLength)
+	if err := args.Validate(); err != nil {
+		return err
+	}
+
+	now := s.clock.Now().UTC()
+	if args.ExpiresAt != nil {
+		if !args.ExpiresAt.After(now) {
+			return errors.Errorf("expiry time %s is not in the future", args.ExpiresAt.UTC().Format(time.RFC3339))
+		}
+		expiresAt := args.ExpiresAt.UTC()
+		args.ExpiresAt = &expiresAt
 	}
 
-	if err := s.st.SetBlock(ctx, t, message); errors.Is(err, blockcommanderrors.AlreadyExists) {
+	if err := s.st.SetBlock(ctx, t, args, now); errors.Is(err, blockcommanderrors.AlreadyExists) {
 		s.logger.Debugf(ctx, "block already exists for type %q", t)
 		return nil
 	} else if err != nil {
@@ -76,9 +112,23 @@ func (s *Service) SwitchBlockOn(ctx context.Context, t blockcommand.BlockType, m
 	return nil
 }
 
-// GetBlocks returns all the blocks for the current model.
+// GetBlocks returns all the blocks for the current model that have not
+// expired.
 func (s *Service) GetBlocks(ctx context.Context) ([]blockcommand.Block, error) {
-	return s.st.GetBlocks(ctx)
+	blocks, err := s.st.GetBlocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	var results []blockcommand.Block
+	for _, block := range blocks {
+		if block.Expired(now) {
+			continue
+		}
+		results = append(results, block)
+	}
+	return results, nil
 }
 
 // SwitchBlockOff disables block of specified type for the current model.
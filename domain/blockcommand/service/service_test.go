@@ -4,12 +4,16 @@
 package service
 
 import (
+	"context"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/domain/blockcommand"
 	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
@@ -20,6 +24,7 @@ type serviceSuite struct {
 	testhelpers.IsolationSuite
 
 	state *MockState
+	clock *testclock.Clock
 }
 
 func TestServiceSuite(t *testing.T) {
@@ -30,12 +35,60 @@ func (s *serviceSuite) TestSwitchOnBlock(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
 
-	s.state.EXPECT().SetBlock(gomock.Any(), blockcommand.RemoveBlock, "block-message").Return(nil)
+	s.state.EXPECT().SetBlock(gomock.Any(), blockcommand.RemoveBlock, blockcommand.BlockArgs{
+		Message: "block-message",
+	}, s.clock.Now().UTC()).Return(nil)
 
 	err := s.service(c).SwitchBlockOn(c.Context(), blockcommand.RemoveBlock, "block-message")
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestSwitchOnBlockWithArgs(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	expiresAt := s.clock.Now().Add(time.Hour).UTC()
+	args := blockcommand.BlockArgs{
+		Message:      "release freeze",
+		CreatedBy:    "fred",
+		ExpiresAt:    &expiresAt,
+		Applications: []string{"foo"},
+		Methods:      []string{"Application.SetCharm", "Application.*"},
+		ExemptUsers:  []string{"mary"},
+		ExemptRoles:  []permission.Access{permission.AdminAccess},
+	}
+	s.state.EXPECT().SetBlock(gomock.Any(), blockcommand.ChangeBlock, args, s.clock.Now().UTC()).Return(nil)
+
+	err := s.service(c).SwitchBlockOnWithArgs(c.Context(), blockcommand.ChangeBlock, args)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSwitchOnBlockWithArgsExpiryInPast(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	expiresAt := s.clock.Now().Add(-time.Hour)
+	err := s.service(c).SwitchBlockOnWithArgs(c.Context(), blockcommand.ChangeBlock, blockcommand.BlockArgs{
+		ExpiresAt: &expiresAt,
+	})
+	c.Assert(err, tc.ErrorMatches, `expiry time .* is not in the future`)
+}
+
+func (s *serviceSuite) TestSwitchOnBlockWithArgsInvalid(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	err := s.service(c).SwitchBlockOnWithArgs(c.Context(), blockcommand.ChangeBlock, blockcommand.BlockArgs{
+		Methods: []string{"deploy"},
+	})
+	c.Assert(err, tc.ErrorMatches, `method "deploy" not valid, expected Facade.Method or Facade.\*`)
+
+	err = s.service(c).SwitchBlockOnWithArgs(c.Context(), blockcommand.ChangeBlock, blockcommand.BlockArgs{
+		ExemptRoles: []permission.Access{permission.SuperuserAccess},
+	})
+	c.Assert(err, tc.ErrorMatches, `exempt role: "superuser" model access not valid`)
+}
+
 func (s *serviceSuite) TestSwitchOnBlockWithTooLargeMessage(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
@@ -48,7 +101,9 @@ func (s *serviceSuite) TestSwitchOnBlockAlreadyExists(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
 
-	s.state.EXPECT().SetBlock(gomock.Any(), blockcommand.RemoveBlock, "block-message").Return(blockcommanderrors.AlreadyExists)
+	s.state.EXPECT().SetBlock(gomock.Any(), blockcommand.RemoveBlock, blockcommand.BlockArgs{
+		Message: "block-message",
+	}, s.clock.Now().UTC()).Return(blockcommanderrors.AlreadyExists)
 
 	err := s.service(c).SwitchBlockOn(c.Context(), blockcommand.RemoveBlock, "block-message")
 	c.Assert(err, tc.ErrorIsNil)
@@ -80,17 +135,123 @@ func (s *serviceSuite) TestGetBlocks(c *tc.C) {
 	})
 }
 
+func (s *serviceSuite) TestGetBlocksOmitsExpired(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	expired := s.clock.Now().Add(-time.Minute)
+	expires := s.clock.Now().Add(time.Minute)
+	s.state.EXPECT().GetBlocks(gomock.Any()).Return([]blockcommand.Block{
+		{Type: blockcommand.RemoveBlock, ExpiresAt: &expired},
+		{Type: blockcommand.ChangeBlock, ExpiresAt: &expires},
+	}, nil)
+
+	blocks, err := s.service(c).GetBlocks(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(blocks, tc.DeepEquals, []blockcommand.Block{
+		{Type: blockcommand.ChangeBlock, ExpiresAt: &expires},
+	})
+}
+
 func (s *serviceSuite) TestGetBlockMessage(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
 
-	s.state.EXPECT().GetBlockMessage(gomock.Any(), blockcommand.RemoveBlock).Return("foo", nil)
+	s.state.EXPECT().GetBlock(gomock.Any(), blockcommand.RemoveBlock).Return(blockcommand.Block{
+		Type:    blockcommand.RemoveBlock,
+		Message: "foo",
+	}, nil)
 
 	message, err := s.service(c).GetBlockSwitchedOn(c.Context(), blockcommand.RemoveBlock)
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(message, tc.Equals, "foo")
 }
 
+func (s *serviceSuite) TestGetBlockMessageNotFound(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.state.EXPECT().GetBlock(gomock.Any(), blockcommand.RemoveBlock).Return(blockcommand.Block{}, blockcommanderrors.NotFound)
+
+	_, err := s.service(c).GetBlockSwitchedOn(c.Context(), blockcommand.RemoveBlock)
+	c.Assert(err, tc.ErrorIs, blockcommanderrors.NotFound)
+}
+
+func (s *serviceSuite) TestGetBlockMessageExpired(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	expired := s.clock.Now().Add(-time.Minute)
+	s.state.EXPECT().GetBlock(gomock.Any(), blockcommand.RemoveBlock).Return(blockcommand.Block{
+		Type:      blockcommand.RemoveBlock,
+		Message:   "foo",
+		ExpiresAt: &expired,
+	}, nil)
+
+	_, err := s.service(c).GetBlockSwitchedOn(c.Context(), blockcommand.RemoveBlock)
+	c.Assert(err, tc.ErrorIs, blockcommanderrors.NotFound)
+}
+
+func (s *serviceSuite) TestGetBlockMessageScopedToMethod(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.state.EXPECT().GetBlock(gomock.Any(), blockcommand.ChangeBlock).Return(blockcommand.Block{
+		Type:    blockcommand.ChangeBlock,
+		Message: "foo",
+		Methods: []string{"Application.SetCharm"},
+	}, nil).Times(3)
+
+	svc := s.service(c)
+
+	// Without an operation in the context, a scoped block doesn't apply.
+	_, err := svc.GetBlockSwitchedOn(c.Context(), blockcommand.ChangeBlock)
+	c.Assert(err, tc.ErrorIs, blockcommanderrors.NotFound)
+
+	ctx := blockcommand.WithOperation(c.Context(), blockcommand.Operation{Method: "Application.Deploy"})
+	_, err = svc.GetBlockSwitchedOn(ctx, blockcommand.ChangeBlock)
+	c.Assert(err, tc.ErrorIs, blockcommanderrors.NotFound)
+
+	ctx = blockcommand.WithOperation(c.Context(), blockcommand.Operation{Method: "Application.SetCharm"})
+	message, err := svc.GetBlockSwitchedOn(ctx, blockcommand.ChangeBlock)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(message, tc.Equals, "foo")
+}
+
+func (s *serviceSuite) TestGetBlockMessageExemptRole(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.state.EXPECT().GetBlock(gomock.Any(), blockcommand.ChangeBlock).Return(blockcommand.Block{
+		Type:        blockcommand.ChangeBlock,
+		Message:     "foo",
+		ExemptRoles: []permission.Access{permission.AdminAccess},
+	}, nil).Times(2)
+
+	svc := s.service(c)
+
+	hasAccess := func(access permission.Access) func(context.Context, permission.Access) bool {
+		return func(_ context.Context, required permission.Access) bool {
+			return access.EqualOrGreaterModelAccessThan(required)
+		}
+	}
+
+	ctx := blockcommand.WithOperation(c.Context(), blockcommand.Operation{
+		User:      "fred",
+		HasAccess: hasAccess(permission.WriteAccess),
+	})
+	message, err := svc.GetBlockSwitchedOn(ctx, blockcommand.ChangeBlock)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(message, tc.Equals, "foo")
+
+	ctx = blockcommand.WithOperation(c.Context(), blockcommand.Operation{
+		User:      "mary",
+		HasAccess: hasAccess(permission.AdminAccess),
+	})
+	_, err = svc.GetBlockSwitchedOn(ctx, blockcommand.ChangeBlock)
+	c.Assert(err, tc.ErrorIs, blockcommanderrors.NotFound)
+}
+
 func (s *serviceSuite) TestRemoveAllBlocks(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
@@ -102,13 +263,14 @@ func (s *serviceSuite) TestRemoveAllBlocks(c *tc.C) {
 }
 
 func (s *serviceSuite) service(c *tc.C) *Service {
-	return NewService(s.state, loggertesting.WrapCheckLog(c))
+	return NewService(s.state, s.clock, loggertesting.WrapCheckLog(c))
 }
 
 func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Now())
 
 	return ctrl
 }
@@ -12,6 +12,7 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	blockcommand "github.com/juju/juju/domain/blockcommand"
 	gomock "go.uber.org/mock/gomock"
@@ -40,41 +41,41 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
-// GetBlockMessage mocks base method.
-func (m *MockState) GetBlockMessage(arg0 context.Context, arg1 blockcommand.BlockType) (string, error) {
+// GetBlock mocks base method.
+func (m *MockState) GetBlock(arg0 context.Context, arg1 blockcommand.BlockType) (blockcommand.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockMessage", arg0, arg1)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetBlock", arg0, arg1)
+	ret0, _ := ret[0].(blockcommand.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBlockMessage indicates an expected call of GetBlockMessage.
-func (mr *MockStateMockRecorder) GetBlockMessage(arg0, arg1 any) *MockStateGetBlockMessageCall {
+// GetBlock indicates an expected call of GetBlock.
+func (mr *MockStateMockRecorder) GetBlock(arg0, arg1 any) *MockStateGetBlockCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockMessage", reflect.TypeOf((*MockState)(nil).GetBlockMessage), arg0, arg1)
-	return &MockStateGetBlockMessageCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockState)(nil).GetBlock), arg0, arg1)
+	return &MockStateGetBlockCall{Call: call}
 }
 
-// MockStateGetBlockMessageCall wrap *gomock.Call
-type MockStateGetBlockMessageCall struct {
+// MockStateGetBlockCall wrap *gomock.Call
+type MockStateGetBlockCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetBlockMessageCall) Return(arg0 string, arg1 error) *MockStateGetBlockMessageCall {
+func (c *MockStateGetBlockCall) Return(arg0 blockcommand.Block, arg1 error) *MockStateGetBlockCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetBlockMessageCall) Do(f func(context.Context, blockcommand.BlockType) (string, error)) *MockStateGetBlockMessageCall {
+func (c *MockStateGetBlockCall) Do(f func(context.Context, blockcommand.BlockType) (blockcommand.Block, error)) *MockStateGetBlockCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetBlockMessageCall) DoAndReturn(f func(context.Context, blockcommand.BlockType) (string, error)) *MockStateGetBlockMessageCall {
+func (c *MockStateGetBlockCall) DoAndReturn(f func(context.Context, blockcommand.BlockType) (blockcommand.Block, error)) *MockStateGetBlockCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -195,17 +196,17 @@ func (c *MockStateRemoveBlockCall) DoAndReturn(f func(context.Context, blockcomm
 }
 
 // SetBlock mocks base method.
-func (m *MockState) SetBlock(arg0 context.Context, arg1 blockcommand.BlockType, arg2 string) error {
+func (m *MockState) SetBlock(arg0 context.Context, arg1 blockcommand.BlockType, arg2 blockcommand.BlockArgs, arg3 time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBlock", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SetBlock", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetBlock indicates an expected call of SetBlock.
-func (mr *MockStateMockRecorder) SetBlock(arg0, arg1, arg2 any) *MockStateSetBlockCall {
+func (mr *MockStateMockRecorder) SetBlock(arg0, arg1, arg2, arg3 any) *MockStateSetBlockCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlock", reflect.TypeOf((*MockState)(nil).SetBlock), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlock", reflect.TypeOf((*MockState)(nil).SetBlock), arg0, arg1, arg2, arg3)
 	return &MockStateSetBlockCall{Call: call}
 }
 
@@ -221,13 +222,13 @@ func (c *MockStateSetBlockCall) Return(arg0 error) *MockStateSetBlockCall {
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateSetBlockCall) Do(f func(context.Context, blockcommand.BlockType, string) error) *MockStateSetBlockCall {
+func (c *MockStateSetBlockCall) Do(f func(context.Context, blockcommand.BlockType, blockcommand.BlockArgs, time.Time) error) *MockStateSetBlockCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateSetBlockCall) DoAndReturn(f func(context.Context, blockcommand.BlockType, string) error) *MockStateSetBlockCall {
+func (c *MockStateSetBlockCall) DoAndReturn(f func(context.Context, blockcommand.BlockType, blockcommand.BlockArgs, time.Time) error) *MockStateSetBlockCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
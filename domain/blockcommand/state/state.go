@@ -6,10 +6,12 @@ package state
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/canonical/sqlair"
 
 	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/domain"
 	"github.com/juju/juju/domain/blockcommand"
 	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
@@ -31,10 +33,10 @@ func NewState(factory coredatabase.TxnRunnerFactory) *State {
 	}
 }
 
-// SetBlock switches on a command block for a given type with an optional
-// message.
+// SetBlock switches on a command block for a given type with the given
+// arguments. Any expired block of the same type is replaced.
 // Returns an error [errors.BlockAlreadyExists].
-func (s *State) SetBlock(ctx context.Context, t blockcommand.BlockType, message string) error {
+func (s *State) SetBlock(ctx context.Context, t blockcommand.BlockType, args blockcommand.BlockArgs, now time.Time) error {
 	db, err := s.DB()
 	if err != nil {
 		return err
@@ -53,7 +55,20 @@ func (s *State) SetBlock(ctx context.Context, t blockcommand.BlockType, message
 	bc := blockCommand{
 		UUID:      uuid.String(),
 		BlockType: bcType,
-		Message:   message,
+		Message:   args.Message,
+		CreatedBy: sql.NullString{String: args.CreatedBy, Valid: args.CreatedBy != ""},
+		CreatedAt: sql.NullTime{Time: now, Valid: true},
+	}
+	if args.ExpiresAt != nil {
+		bc.ExpiresAt = sql.NullTime{Time: *args.ExpiresAt, Valid: true}
+	}
+
+	existingStmt, err := s.Prepare(`
+SELECT &blockCommand.*
+FROM block_command
+WHERE block_command_type_id = $blockType.id`, bc, blockType{})
+	if err != nil {
+		return errors.Errorf("preparing existing block command statement: %w", err)
 	}
 
 	stmt, err := s.Prepare("INSERT INTO block_command (*) VALUES ($blockCommand.*)", bc)
@@ -62,6 +77,17 @@ func (s *State) SetBlock(ctx context.Context, t blockcommand.BlockType, message
 	}
 
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var existing blockCommand
+		if err := tx.Query(ctx, existingStmt, blockType{ID: bcType}).Get(&existing); err == nil {
+			if existing.ExpiresAt.Valid && !now.Before(existing.ExpiresAt.Time) {
+				if err := s.deleteBlock(ctx, tx, existing.UUID); err != nil {
+					return errors.Errorf("deleting expired block command: %w", err)
+				}
+			}
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("getting existing block command: %w", err)
+		}
+
 		var outcome sqlair.Outcome
 		if err := tx.Query(ctx, stmt, bc).Get(&outcome); database.IsErrConstraintPrimaryKey(err) || database.IsErrConstraintUnique(err) {
 			return blockcommanderrors.AlreadyExists
@@ -75,7 +101,7 @@ func (s *State) SetBlock(ctx context.Context, t blockcommand.BlockType, message
 			return errors.Errorf("expected 1 row affected, got %d", affected)
 		}
 
-		return nil
+		return s.insertScope(ctx, tx, bc.UUID, args)
 	}); err != nil {
 		return errors.Errorf("executing block command: %w", err)
 	}
@@ -83,6 +109,68 @@ func (s *State) SetBlock(ctx context.Context, t blockcommand.BlockType, message
 	return nil
 }
 
+// insertScope records the applications, methods and exemptions that limit
+// the block with the given UUID.
+func (s *State) insertScope(ctx context.Context, tx *sqlair.TX, blockUUID string, args blockcommand.BlockArgs) error {
+	if len(args.Applications) > 0 {
+		apps := make([]blockApplication, len(args.Applications))
+		for i, app := range args.Applications {
+			apps[i] = blockApplication{BlockUUID: blockUUID, ApplicationName: app}
+		}
+		stmt, err := s.Prepare("INSERT INTO block_command_application (*) VALUES ($blockApplication.*)", blockApplication{})
+		if err != nil {
+			return errors.Errorf("preparing block command application statement: %w", err)
+		}
+		if err := tx.Query(ctx, stmt, apps).Run(); err != nil {
+			return errors.Errorf("inserting block command applications: %w", err)
+		}
+	}
+
+	if len(args.Methods) > 0 {
+		methods := make([]blockMethod, len(args.Methods))
+		for i, method := range args.Methods {
+			methods[i] = blockMethod{BlockUUID: blockUUID, Method: method}
+		}
+		stmt, err := s.Prepare("INSERT INTO block_command_method (*) VALUES ($blockMethod.*)", blockMethod{})
+		if err != nil {
+			return errors.Errorf("preparing block command method statement: %w", err)
+		}
+		if err := tx.Query(ctx, stmt, methods).Run(); err != nil {
+			return errors.Errorf("inserting block command methods: %w", err)
+		}
+	}
+
+	if len(args.ExemptUsers) > 0 {
+		users := make([]blockExemptUser, len(args.ExemptUsers))
+		for i, name := range args.ExemptUsers {
+			users[i] = blockExemptUser{BlockUUID: blockUUID, UserName: name}
+		}
+		stmt, err := s.Prepare("INSERT INTO block_command_exempt_user (*) VALUES ($blockExemptUser.*)", blockExemptUser{})
+		if err != nil {
+			return errors.Errorf("preparing block command exempt user statement: %w", err)
+		}
+		if err := tx.Query(ctx, stmt, users).Run(); err != nil {
+			return errors.Errorf("inserting block command exempt users: %w", err)
+		}
+	}
+
+	if len(args.ExemptRoles) > 0 {
+		roles := make([]blockExemptRole, len(args.ExemptRoles))
+		for i, role := range args.ExemptRoles {
+			roles[i] = blockExemptRole{BlockUUID: blockUUID, Access: string(role)}
+		}
+		stmt, err := s.Prepare("INSERT INTO block_command_exempt_role (*) VALUES ($blockExemptRole.*)", blockExemptRole{})
+		if err != nil {
+			return errors.Errorf("preparing block command exempt role statement: %w", err)
+		}
+		if err := tx.Query(ctx, stmt, roles).Run(); err != nil {
+			return errors.Errorf("inserting block command exempt roles: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // RemoveBlock disables block of specified type for the current model.
 // Returns an error [errors.BlockNotFound].
 func (s *State) RemoveBlock(ctx context.Context, t blockcommand.BlockType) error {
@@ -98,24 +186,20 @@ func (s *State) RemoveBlock(ctx context.Context, t blockcommand.BlockType) error
 
 	bc := blockType{ID: bcType}
 
-	stmt, err := s.Prepare("DELETE FROM block_command WHERE block_command_type_id = $blockType.id", bc)
+	stmt, err := s.Prepare("SELECT &blockCommandUUID.* FROM block_command WHERE block_command_type_id = $blockType.id", blockCommandUUID{}, bc)
 	if err != nil {
 		return errors.Errorf("preparing block command statement: %w", err)
 	}
 
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		var outcome sqlair.Outcome
-		if err := tx.Query(ctx, stmt, bc).Get(&outcome); err != nil {
-			return errors.Errorf("deleting block command: %w", err)
-		}
-
-		if affected, err := outcome.Result().RowsAffected(); err != nil {
-			return errors.Errorf("getting rows affected: %w", err)
-		} else if affected == 0 {
+		var bcUUID blockCommandUUID
+		if err := tx.Query(ctx, stmt, bc).Get(&bcUUID); errors.Is(err, sql.ErrNoRows) {
 			return blockcommanderrors.NotFound
+		} else if err != nil {
+			return errors.Errorf("getting block command: %w", err)
 		}
 
-		return nil
+		return s.deleteBlock(ctx, tx, bcUUID.UUID)
 	}); err != nil {
 		return errors.Errorf("executing block command: %w", err)
 	}
@@ -123,6 +207,43 @@ func (s *State) RemoveBlock(ctx context.Context, t blockcommand.BlockType) error
 	return nil
 }
 
+// deleteBlock deletes the block with the given UUID along with its scope.
+func (s *State) deleteBlock(ctx context.Context, tx *sqlair.TX, blockUUID string) error {
+	bcUUID := blockCommandUUID{UUID: blockUUID}
+	for _, table := range []string{
+		"block_command_application",
+		"block_command_method",
+		"block_command_exempt_user",
+		"block_command_exempt_role",
+	} {
+		stmt, err := s.Prepare("DELETE FROM "+table+" WHERE block_command_uuid = $blockCommandUUID.uuid", bcUUID)
+		if err != nil {
+			return errors.Errorf("preparing %s statement: %w", table, err)
+		}
+		if err := tx.Query(ctx, stmt, bcUUID).Run(); err != nil {
+			return errors.Errorf("deleting from %s: %w", table, err)
+		}
+	}
+
+	stmt, err := s.Prepare("DELETE FROM block_command WHERE uuid = $blockCommandUUID.uuid", bcUUID)
+	if err != nil {
+		return errors.Errorf("preparing block command statement: %w", err)
+	}
+
+	var outcome sqlair.Outcome
+	if err := tx.Query(ctx, stmt, bcUUID).Get(&outcome); err != nil {
+		return errors.Errorf("deleting block command: %w", err)
+	}
+
+	if affected, err := outcome.Result().RowsAffected(); err != nil {
+		return errors.Errorf("getting rows affected: %w", err)
+	} else if affected == 0 {
+		return blockcommanderrors.NotFound
+	}
+
+	return nil
+}
+
 // RemoveAllBlocks removes all blocks for the current model. If no blocks are
 // found, returns nil.
 func (s *State) RemoveAllBlocks(ctx context.Context) error {
@@ -131,14 +252,26 @@ func (s *State) RemoveAllBlocks(ctx context.Context) error {
 		return err
 	}
 
-	stmt, err := s.Prepare("DELETE FROM block_command")
-	if err != nil {
-		return errors.Errorf("preparing block command statement: %w", err)
+	var stmts []*sqlair.Statement
+	for _, table := range []string{
+		"block_command_application",
+		"block_command_method",
+		"block_command_exempt_user",
+		"block_command_exempt_role",
+		"block_command",
+	} {
+		stmt, err := s.Prepare("DELETE FROM " + table)
+		if err != nil {
+			return errors.Errorf("preparing %s statement: %w", table, err)
+		}
+		stmts = append(stmts, stmt)
 	}
 
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		if err := tx.Query(ctx, stmt).Run(); err != nil {
-			return errors.Errorf("deleting block command: %w", err)
+		for _, stmt := range stmts {
+			if err := tx.Query(ctx, stmt).Run(); err != nil {
+				return errors.Errorf("deleting block command: %w", err)
+			}
 		}
 		return nil
 	}); err != nil {
@@ -148,7 +281,8 @@ func (s *State) RemoveAllBlocks(ctx context.Context) error {
 	return nil
 }
 
-// GetBlocks returns all the blocks for the current model.
+// GetBlocks returns all the blocks for the current model, including any that
+// have expired.
 func (s *State) GetBlocks(ctx context.Context) ([]blockcommand.Block, error) {
 	db, err := s.DB()
 	if err != nil {
@@ -161,69 +295,141 @@ func (s *State) GetBlocks(ctx context.Context) ([]blockcommand.Block, error) {
 		return nil, errors.Errorf("preparing block command statement: %w", err)
 	}
 
-	var blocks []blockCommand
+	var results []blockcommand.Block
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var blocks []blockCommand
 		if err := tx.Query(ctx, stmt).GetAll(&blocks); errors.Is(err, sql.ErrNoRows) {
 			return nil
 		} else if err != nil {
 			return errors.Errorf("getting block commands: %w", err)
 		}
 
+		results = make([]blockcommand.Block, len(blocks))
+		for i, b := range blocks {
+			if results[i], err = s.decodeBlock(ctx, tx, b); err != nil {
+				return err
+			}
+		}
 		return nil
 	}); err != nil {
 		return nil, errors.Errorf("executing block command: %w", err)
 	}
 
-	var results []blockcommand.Block
-	for _, b := range blocks {
-		bt, err := decodeBlockType(b.BlockType)
-		if err != nil {
-			return nil, err
-		}
-
-		results = append(results, blockcommand.Block{
-			UUID:    b.UUID,
-			Type:    bt,
-			Message: b.Message,
-		})
-	}
-
 	return results, nil
 }
 
-func (s *State) GetBlockMessage(ctx context.Context, t blockcommand.BlockType) (string, error) {
+// GetBlock returns the block of the given type, including its scope. The
+// block is returned even if it has expired.
+// Returns an error [errors.NotFound] if the block does not exist.
+func (s *State) GetBlock(ctx context.Context, t blockcommand.BlockType) (blockcommand.Block, error) {
 	db, err := s.DB()
 	if err != nil {
-		return "", err
+		return blockcommand.Block{}, err
 	}
 
 	bcType, err := encodeBlockType(t)
 	if err != nil {
-		return "", err
+		return blockcommand.Block{}, err
 	}
 
 	bc := blockType{ID: bcType}
 
-	var message blockCommandMessage
-
-	stmt, err := s.Prepare("SELECT &blockCommandMessage.* FROM block_command WHERE block_command_type_id = $blockType.id", message, bc)
+	stmt, err := s.Prepare("SELECT &blockCommand.* FROM block_command WHERE block_command_type_id = $blockType.id", blockCommand{}, bc)
 	if err != nil {
-		return "", errors.Errorf("preparing block command statement: %w", err)
+		return blockcommand.Block{}, errors.Errorf("preparing block command statement: %w", err)
 	}
 
+	var result blockcommand.Block
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		if err := tx.Query(ctx, stmt, bc).Get(&message); errors.Is(err, sql.ErrNoRows) {
+		var block blockCommand
+		if err := tx.Query(ctx, stmt, bc).Get(&block); errors.Is(err, sql.ErrNoRows) {
 			return blockcommanderrors.NotFound
 		} else if err != nil {
 			return errors.Errorf("getting block command: %w", err)
 		}
 
-		return nil
+		result, err = s.decodeBlock(ctx, tx, block)
+		return err
 	}); err != nil {
-		return "", errors.Errorf("executing block command: %w", err)
+		return blockcommand.Block{}, errors.Errorf("executing block command: %w", err)
+	}
+
+	return result, nil
+}
+
+// decodeBlock converts the block command row into a block, reading the
+// scope of the block.
+func (s *State) decodeBlock(ctx context.Context, tx *sqlair.TX, b blockCommand) (blockcommand.Block, error) {
+	bt, err := decodeBlockType(b.BlockType)
+	if err != nil {
+		return blockcommand.Block{}, err
+	}
+
+	result := blockcommand.Block{
+		UUID:      b.UUID,
+		Type:      bt,
+		Message:   b.Message,
+		CreatedBy: b.CreatedBy.String,
+	}
+	if b.CreatedAt.Valid {
+		result.CreatedAt = b.CreatedAt.Time
+	}
+	if b.ExpiresAt.Valid {
+		expiresAt := b.ExpiresAt.Time
+		result.ExpiresAt = &expiresAt
+	}
+
+	bcUUID := blockCommandUUID{UUID: b.UUID}
+
+	appStmt, err := s.Prepare("SELECT &blockApplication.* FROM block_command_application WHERE block_command_uuid = $blockCommandUUID.uuid ORDER BY application_name", blockApplication{}, bcUUID)
+	if err != nil {
+		return blockcommand.Block{}, errors.Errorf("preparing block command application statement: %w", err)
+	}
+	var apps []blockApplication
+	if err := tx.Query(ctx, appStmt, bcUUID).GetAll(&apps); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return blockcommand.Block{}, errors.Errorf("getting block command applications: %w", err)
+	}
+	for _, app := range apps {
+		result.Applications = append(result.Applications, app.ApplicationName)
+	}
+
+	methodStmt, err := s.Prepare("SELECT &blockMethod.* FROM block_command_method WHERE block_command_uuid = $blockCommandUUID.uuid ORDER BY method", blockMethod{}, bcUUID)
+	if err != nil {
+		return blockcommand.Block{}, errors.Errorf("preparing block command method statement: %w", err)
+	}
+	var methods []blockMethod
+	if err := tx.Query(ctx, methodStmt, bcUUID).GetAll(&methods); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return blockcommand.Block{}, errors.Errorf("getting block command methods: %w", err)
+	}
+	for _, method := range methods {
+		result.Methods = append(result.Methods, method.Method)
+	}
+
+	userStmt, err := s.Prepare("SELECT &blockExemptUser.* FROM block_command_exempt_user WHERE block_command_uuid = $blockCommandUUID.uuid ORDER BY user_name", blockExemptUser{}, bcUUID)
+	if err != nil {
+		return blockcommand.Block{}, errors.Errorf("preparing block command exempt user statement: %w", err)
+	}
+	var users []blockExemptUser
+	if err := tx.Query(ctx, userStmt, bcUUID).GetAll(&users); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return blockcommand.Block{}, errors.Errorf("getting block command exempt users: %w", err)
+	}
+	for _, user := range users {
+		result.ExemptUsers = append(result.ExemptUsers, user.UserName)
+	}
+
+	roleStmt, err := s.Prepare("SELECT &blockExemptRole.* FROM block_command_exempt_role WHERE block_command_uuid = $blockCommandUUID.uuid ORDER BY access", blockExemptRole{}, bcUUID)
+	if err != nil {
+		return blockcommand.Block{}, errors.Errorf("preparing block command exempt role statement: %w", err)
+	}
+	var roles []blockExemptRole
+	if err := tx.Query(ctx, roleStmt, bcUUID).GetAll(&roles); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return blockcommand.Block{}, errors.Errorf("getting block command exempt roles: %w", err)
+	}
+	for _, role := range roles {
+		result.ExemptRoles = append(result.ExemptRoles, permission.Access(role.Access))
 	}
 
-	return message.Message, nil
+	return result, nil
 }
 
 func encodeBlockType(t blockcommand.BlockType) (int8, error) {
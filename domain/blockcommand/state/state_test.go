@@ -5,9 +5,11 @@ package state
 
 import (
 	"testing"
+	"time"
 
 	"github.com/juju/tc"
 
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/domain/blockcommand"
 	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
 	schematesting "github.com/juju/juju/domain/schema/testing"
@@ -23,22 +25,22 @@ func TestStateSuite(t *testing.T) {
 
 func (s *stateSuite) TestSetBlock(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
-	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, "block-message")
+	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, blockcommand.BlockArgs{Message: "block-message"}, time.Now())
 
 	c.Assert(err, tc.ErrorIsNil)
 }
 
 func (s *stateSuite) TestSetBlockForSameTypeTwice(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
-	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, "block-message")
+	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, blockcommand.BlockArgs{Message: "block-message"}, time.Now())
 	c.Assert(err, tc.ErrorIsNil)
-	err = st.SetBlock(c.Context(), blockcommand.DestroyBlock, "block-message")
+	err = st.SetBlock(c.Context(), blockcommand.DestroyBlock, blockcommand.BlockArgs{Message: "block-message"}, time.Now())
 	c.Assert(err, tc.ErrorIs, blockcommanderrors.AlreadyExists)
 }
 
 func (s *stateSuite) TestSetBlockWithNoMessage(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
-	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, "")
+	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, blockcommand.BlockArgs{}, time.Now())
 	c.Assert(err, tc.ErrorIsNil)
 }
 
@@ -51,7 +53,7 @@ func (s *stateSuite) TestRemoveBlockWithNoExistingBlock(c *tc.C) {
 
 func (s *stateSuite) TestRemoveBlockWithExistingBlock(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
-	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, "")
+	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, blockcommand.BlockArgs{}, time.Now())
 	c.Assert(err, tc.ErrorIsNil)
 
 	err = st.RemoveBlock(c.Context(), blockcommand.DestroyBlock)
@@ -69,9 +71,9 @@ func (s *stateSuite) TestGetBlocksWithNoExistingBlock(c *tc.C) {
 func (s *stateSuite) TestGetBlocks(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
 
-	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, "")
+	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, blockcommand.BlockArgs{}, time.Now())
 	c.Assert(err, tc.ErrorIsNil)
-	err = st.SetBlock(c.Context(), blockcommand.ChangeBlock, "change me")
+	err = st.SetBlock(c.Context(), blockcommand.ChangeBlock, blockcommand.BlockArgs{Message: "change me"}, time.Now())
 	c.Assert(err, tc.ErrorIsNil)
 
 	blocks, err := st.GetBlocks(c.Context())
@@ -84,23 +86,101 @@ func (s *stateSuite) TestGetBlocks(c *tc.C) {
 	c.Check(blocks[1].Message, tc.Equals, "change me")
 }
 
-func (s *stateSuite) TestGetBlockMessageWithNoExistingBlock(c *tc.C) {
+func (s *stateSuite) TestGetBlockWithNoExistingBlock(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
-	message, err := st.GetBlockMessage(c.Context(), blockcommand.DestroyBlock)
+	_, err := st.GetBlock(c.Context(), blockcommand.DestroyBlock)
 
 	c.Assert(err, tc.ErrorIs, blockcommanderrors.NotFound)
-	c.Assert(message, tc.Equals, "")
 }
 
-func (s *stateSuite) TestGetBlockMessage(c *tc.C) {
+func (s *stateSuite) TestGetBlock(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
-	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, "destroy me")
+	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, blockcommand.BlockArgs{Message: "destroy me"}, time.Now())
 	c.Assert(err, tc.ErrorIsNil)
 
-	message, err := st.GetBlockMessage(c.Context(), blockcommand.DestroyBlock)
+	block, err := st.GetBlock(c.Context(), blockcommand.DestroyBlock)
 
 	c.Assert(err, tc.ErrorIsNil)
-	c.Assert(message, tc.Equals, "destroy me")
+	c.Check(block.Type, tc.Equals, blockcommand.DestroyBlock)
+	c.Check(block.Message, tc.Equals, "destroy me")
+	c.Check(block.ExpiresAt, tc.IsNil)
+}
+
+func (s *stateSuite) TestGetBlockWithScope(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	now := time.Now().UTC().Truncate(time.Second)
+	expiresAt := now.Add(time.Hour)
+	err := st.SetBlock(c.Context(), blockcommand.ChangeBlock, blockcommand.BlockArgs{
+		Message:      "release freeze",
+		CreatedBy:    "fred",
+		ExpiresAt:    &expiresAt,
+		Applications: []string{"foo", "bar"},
+		Methods:      []string{"Application.SetCharm"},
+		ExemptUsers:  []string{"mary"},
+		ExemptRoles:  []permission.Access{permission.AdminAccess},
+	}, now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	block, err := st.GetBlock(c.Context(), blockcommand.ChangeBlock)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(block.Message, tc.Equals, "release freeze")
+	c.Check(block.CreatedBy, tc.Equals, "fred")
+	c.Check(block.CreatedAt.Equal(now), tc.IsTrue)
+	c.Assert(block.ExpiresAt, tc.NotNil)
+	c.Check(block.ExpiresAt.Equal(expiresAt), tc.IsTrue)
+	c.Check(block.Applications, tc.DeepEquals, []string{"bar", "foo"})
+	c.Check(block.Methods, tc.DeepEquals, []string{"Application.SetCharm"})
+	c.Check(block.ExemptUsers, tc.DeepEquals, []string{"mary"})
+	c.Check(block.ExemptRoles, tc.DeepEquals, []permission.Access{permission.AdminAccess})
+
+	blocks, err := st.GetBlocks(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(blocks, tc.HasLen, 1)
+	c.Check(blocks[0], tc.DeepEquals, block)
+}
+
+func (s *stateSuite) TestSetBlockReplacesExpiredBlock(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Minute)
+	err := st.SetBlock(c.Context(), blockcommand.ChangeBlock, blockcommand.BlockArgs{
+		Message:      "old",
+		ExpiresAt:    &expiresAt,
+		Applications: []string{"foo"},
+	}, now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The block hasn't expired yet, so it can't be replaced.
+	err = st.SetBlock(c.Context(), blockcommand.ChangeBlock, blockcommand.BlockArgs{Message: "new"}, now)
+	c.Assert(err, tc.ErrorIs, blockcommanderrors.AlreadyExists)
+
+	err = st.SetBlock(c.Context(), blockcommand.ChangeBlock, blockcommand.BlockArgs{Message: "new"}, expiresAt)
+	c.Assert(err, tc.ErrorIsNil)
+
+	block, err := st.GetBlock(c.Context(), blockcommand.ChangeBlock)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(block.Message, tc.Equals, "new")
+	c.Check(block.ExpiresAt, tc.IsNil)
+	c.Check(block.Applications, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestRemoveBlockWithScope(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	err := st.SetBlock(c.Context(), blockcommand.RemoveBlock, blockcommand.BlockArgs{
+		Applications: []string{"foo"},
+		Methods:      []string{"Application.*"},
+		ExemptUsers:  []string{"mary"},
+		ExemptRoles:  []permission.Access{permission.AdminAccess},
+	}, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.RemoveBlock(c.Context(), blockcommand.RemoveBlock)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = st.GetBlock(c.Context(), blockcommand.RemoveBlock)
+	c.Assert(err, tc.ErrorIs, blockcommanderrors.NotFound)
 }
 
 func (s *stateSuite) TestRemoveAllBlocksWithNoExistingBlock(c *tc.C) {
@@ -112,9 +192,18 @@ func (s *stateSuite) TestRemoveAllBlocksWithNoExistingBlock(c *tc.C) {
 
 func (s *stateSuite) TestRemoveAllBlocksWithExistingBlock(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
-	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, "")
+	err := st.SetBlock(c.Context(), blockcommand.DestroyBlock, blockcommand.BlockArgs{}, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.SetBlock(c.Context(), blockcommand.ChangeBlock, blockcommand.BlockArgs{
+		Applications: []string{"foo"},
+		ExemptUsers:  []string{"mary"},
+	}, time.Now())
 	c.Assert(err, tc.ErrorIsNil)
 
 	err = st.RemoveAllBlocks(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
+
+	blocks, err := st.GetBlocks(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(blocks, tc.HasLen, 0)
 }
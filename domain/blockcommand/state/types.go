@@ -3,16 +3,43 @@
 
 package state
 
+import (
+	"database/sql"
+)
+
 type blockCommand struct {
-	UUID      string `db:"uuid"`
-	BlockType int8   `db:"block_command_type_id"`
-	Message   string `db:"message"`
+	UUID      string         `db:"uuid"`
+	BlockType int8           `db:"block_command_type_id"`
+	Message   string         `db:"message"`
+	CreatedBy sql.NullString `db:"created_by"`
+	CreatedAt sql.NullTime   `db:"created_at"`
+	ExpiresAt sql.NullTime   `db:"expires_at"`
 }
 
-type blockCommandMessage struct {
-	Message string `db:"message"`
+type blockCommandUUID struct {
+	UUID string `db:"uuid"`
 }
 
 type blockType struct {
 	ID int8 `db:"id"`
 }
+
+type blockApplication struct {
+	BlockUUID       string `db:"block_command_uuid"`
+	ApplicationName string `db:"application_name"`
+}
+
+type blockMethod struct {
+	BlockUUID string `db:"block_command_uuid"`
+	Method    string `db:"method"`
+}
+
+type blockExemptUser struct {
+	BlockUUID string `db:"block_command_uuid"`
+	UserName  string `db:"user_name"`
+}
+
+type blockExemptRole struct {
+	BlockUUID string `db:"block_command_uuid"`
+	Access    string `db:"access"`
+}
@@ -3,7 +3,17 @@
 
 package blockcommand
 
-import "github.com/juju/juju/internal/errors"
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/internal/errors"
+)
 
 const (
 	// DefaultMaxMessageLength is the default maximum length of a block message.
@@ -52,4 +62,160 @@ type Block struct {
 	UUID    string
 	Type    BlockType
 	Message string
+
+	// CreatedBy is the name of the user that switched the block on.
+	CreatedBy string
+	// CreatedAt is the time the block was switched on.
+	CreatedAt time.Time
+	// ExpiresAt is the time at which the block stops applying. If nil, the
+	// block applies until it is switched off.
+	ExpiresAt *time.Time
+
+	// Applications, if not empty, limits the block to operations affecting
+	// the named applications.
+	Applications []string
+	// Methods, if not empty, limits the block to the named facade methods.
+	// Each method is either of the form "Facade.Method" or "Facade.*".
+	Methods []string
+	// ExemptUsers lists the users that are not subject to the block.
+	ExemptUsers []string
+	// ExemptRoles lists the model access levels that are not subject to the
+	// block. A user with at least one of the levels on the model is exempt.
+	ExemptRoles []permission.Access
+}
+
+// Expired returns true if the block has an expiry time that is not after
+// the given time.
+func (b Block) Expired(now time.Time) bool {
+	return b.ExpiresAt != nil && !now.Before(*b.ExpiresAt)
+}
+
+// AppliesTo returns true if the block prevents the given operation at the
+// given time. An expired block never applies, and a block scoped to
+// applications or methods only applies to operations that match its scope.
+// An operation that doesn't name the applications it affects may affect any
+// of them, so a block scoped to applications applies to it.
+func (b Block) AppliesTo(ctx context.Context, op Operation, now time.Time) bool {
+	if b.Expired(now) {
+		return false
+	}
+	if len(b.Methods) > 0 && !slices.ContainsFunc(b.Methods, func(m string) bool {
+		return methodMatches(m, op.Method)
+	}) {
+		return false
+	}
+	if len(b.Applications) > 0 && len(op.Applications) > 0 && !slices.ContainsFunc(op.Applications, func(app string) bool {
+		return slices.Contains(b.Applications, app)
+	}) {
+		return false
+	}
+	if op.User != "" && slices.Contains(b.ExemptUsers, op.User) {
+		return false
+	}
+	if op.HasAccess != nil {
+		for _, role := range b.ExemptRoles {
+			if op.HasAccess(ctx, role) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// methodMatches returns true if the method pattern, either "Facade.Method"
+// or "Facade.*", matches the given method.
+func methodMatches(pattern, method string) bool {
+	if facade, ok := strings.CutSuffix(pattern, ".*"); ok {
+		return strings.HasPrefix(method, facade+".")
+	}
+	return pattern == method
+}
+
+var validMethod = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*\.(\*|[A-Z][A-Za-z0-9]*)$`)
+
+// BlockArgs holds the arguments for switching on a command block.
+type BlockArgs struct {
+	// Message is an optional message explaining the block.
+	Message string
+	// CreatedBy is the name of the user switching the block on.
+	CreatedBy string
+	// ExpiresAt is the optional time at which the block stops applying.
+	ExpiresAt *time.Time
+	// Applications optionally limits the block to the named applications.
+	Applications []string
+	// Methods optionally limits the block to the named facade methods.
+	Methods []string
+	// ExemptUsers lists the users that are not subject to the block.
+	ExemptUsers []string
+	// ExemptRoles lists the model access levels that are not subject to the
+	// block.
+	ExemptRoles []permission.Access
+}
+
+// Validate checks that the block arguments are valid.
+func (a BlockArgs) Validate() error {
+	if len(a.Message) > DefaultMaxMessageLength {
+		return errors.Errorf("message length exceeds maximum allowed length of %d", DefaultMaxMessageLength)
+	}
+	for _, app := range a.Applications {
+		if app == "" {
+			return errors.New("empty application name not valid")
+		}
+	}
+	for _, method := range a.Methods {
+		if !validMethod.MatchString(method) {
+			return errors.Errorf("method %q not valid, expected Facade.Method or Facade.*", method)
+		}
+	}
+	for _, name := range a.ExemptUsers {
+		if !user.IsValidName(name) {
+			return errors.Errorf("exempt user %q not valid", name)
+		}
+	}
+	for _, role := range a.ExemptRoles {
+		if err := permission.ValidateModelAccess(role); err != nil {
+			return errors.Errorf("exempt role: %w", err)
+		}
+	}
+	return nil
+}
+
+// Operation describes an operation that is checked against the command
+// blocks in place.
+type Operation struct {
+	// Method is the facade method performing the operation, in the form
+	// "Facade.Method".
+	Method string
+	// User is the name of the user performing the operation, if any.
+	User string
+	// Applications holds the names of the applications that the operation
+	// affects, if known.
+	Applications []string
+	// HasAccess reports whether the user performing the operation has at
+	// least the given access on the model.
+	HasAccess func(context.Context, permission.Access) bool
+}
+
+type contextKey string
+
+const operationContextKey contextKey = "block-operation"
+
+// WithOperation returns a new context describing the operation being
+// performed, so that it can be checked against scoped command blocks.
+func WithOperation(ctx context.Context, op Operation) context.Context {
+	return context.WithValue(ctx, operationContextKey, op)
+}
+
+// OperationFromContext returns the operation described by the context.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	op, ok := ctx.Value(operationContextKey).(Operation)
+	return op, ok
+}
+
+// WithApplications returns a new context whose operation also affects the
+// given applications.
+func WithApplications(ctx context.Context, applications ...string) context.Context {
+	op, _ := OperationFromContext(ctx)
+	op.Applications = append(slices.Clone(op.Applications), applications...)
+	return WithOperation(ctx, op)
 }
@@ -0,0 +1,76 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package blockcommand
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+)
+
+type typesSuite struct{}
+
+func TestTypesSuite(t *testing.T) {
+	tc.Run(t, &typesSuite{})
+}
+
+func (s *typesSuite) TestAppliesToModelWide(c *tc.C) {
+	b := Block{Type: ChangeBlock}
+	c.Check(b.AppliesTo(c.Context(), Operation{}, time.Now()), tc.IsTrue)
+	c.Check(b.AppliesTo(c.Context(), Operation{Method: "Application.Deploy"}, time.Now()), tc.IsTrue)
+}
+
+func (s *typesSuite) TestAppliesToExpired(c *tc.C) {
+	now := time.Now()
+	b := Block{Type: ChangeBlock, ExpiresAt: &now}
+	c.Check(b.AppliesTo(c.Context(), Operation{}, now.Add(-time.Second)), tc.IsTrue)
+	c.Check(b.AppliesTo(c.Context(), Operation{}, now), tc.IsFalse)
+}
+
+func (s *typesSuite) TestAppliesToMethods(c *tc.C) {
+	b := Block{Type: ChangeBlock, Methods: []string{"Application.SetCharm", "Action.*"}}
+	c.Check(b.AppliesTo(c.Context(), Operation{}, time.Now()), tc.IsFalse)
+	c.Check(b.AppliesTo(c.Context(), Operation{Method: "Application.SetCharm"}, time.Now()), tc.IsTrue)
+	c.Check(b.AppliesTo(c.Context(), Operation{Method: "Application.Deploy"}, time.Now()), tc.IsFalse)
+	c.Check(b.AppliesTo(c.Context(), Operation{Method: "Action.EnqueueOperation"}, time.Now()), tc.IsTrue)
+	c.Check(b.AppliesTo(c.Context(), Operation{Method: "ActionPruner.Prune"}, time.Now()), tc.IsFalse)
+}
+
+func (s *typesSuite) TestAppliesToApplications(c *tc.C) {
+	b := Block{Type: RemoveBlock, Applications: []string{"foo"}}
+	c.Check(b.AppliesTo(c.Context(), Operation{}, time.Now()), tc.IsTrue)
+	c.Check(b.AppliesTo(c.Context(), Operation{Applications: []string{"bar"}}, time.Now()), tc.IsFalse)
+	c.Check(b.AppliesTo(c.Context(), Operation{Applications: []string{"bar", "foo"}}, time.Now()), tc.IsTrue)
+}
+
+func (s *typesSuite) TestAppliesToExemptUser(c *tc.C) {
+	b := Block{Type: ChangeBlock, ExemptUsers: []string{"mary"}}
+	c.Check(b.AppliesTo(c.Context(), Operation{User: "fred"}, time.Now()), tc.IsTrue)
+	c.Check(b.AppliesTo(c.Context(), Operation{User: "mary"}, time.Now()), tc.IsFalse)
+}
+
+func (s *typesSuite) TestWithApplications(c *tc.C) {
+	ctx := WithOperation(c.Context(), Operation{Method: "Application.DestroyUnit", User: "fred"})
+	ctx = WithApplications(ctx, "foo", "bar")
+
+	op, ok := OperationFromContext(ctx)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(op, tc.DeepEquals, Operation{
+		Method:       "Application.DestroyUnit",
+		User:         "fred",
+		Applications: []string{"foo", "bar"},
+	})
+}
+
+func (s *typesSuite) TestBlockArgsValidate(c *tc.C) {
+	c.Check(BlockArgs{
+		Applications: []string{"foo"},
+		Methods:      []string{"Application.Deploy", "Application.*"},
+		ExemptUsers:  []string{"mary", "bob@external"},
+	}.Validate(), tc.ErrorIsNil)
+	c.Check(BlockArgs{Applications: []string{""}}.Validate(), tc.ErrorMatches, `empty application name not valid`)
+	c.Check(BlockArgs{Methods: []string{"Application"}}.Validate(), tc.ErrorMatches, `method "Application" not valid.*`)
+	c.Check(BlockArgs{ExemptUsers: []string{"not valid!"}}.Validate(), tc.ErrorMatches, `exempt user "not valid!" not valid`)
+}
@@ -0,0 +1,8 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package changefeed provides the domain for exposing the model's change
+// stream to clients. A change feed is a namespace-filtered view of the change
+// log, allowing external tooling to react to changes made to applications,
+// units, relations, secrets and the like, without polling the status.
+package changefeed
@@ -0,0 +1,16 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// NamespaceNotFound describes an error that occurs when a requested
+	// change feed namespace is not known to the model.
+	NamespaceNotFound = errors.ConstError("change feed namespace not found")
+
+	// NamespaceNotPermitted describes an error that occurs when a requested
+	// change feed namespace can't be watched with the caller's access.
+	NamespaceNotPermitted = errors.ConstError("change feed namespace not permitted")
+)
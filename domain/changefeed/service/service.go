@@ -0,0 +1,172 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	"github.com/juju/juju/domain/changefeed"
+	changefeederrors "github.com/juju/juju/domain/changefeed/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State describes retrieval methods for the change feed.
+type State interface {
+	// GetNamespaces returns the names of all the namespaces that changes are
+	// recorded against in the change log.
+	GetNamespaces(ctx context.Context) ([]string, error)
+}
+
+// WatcherFactory describes methods for creating watchers.
+type WatcherFactory interface {
+	// NewChangesWatcher returns a new watcher that emits the change events
+	// that match the filters.
+	NewChangesWatcher(
+		filter eventsource.FilterOption,
+		filterOpts ...eventsource.FilterOption,
+	) (watcher.ChangesWatcher, error)
+
+	// NewResumableChangesWatcher returns a new watcher that first emits all
+	// the change events after the given change log ID that match the filters,
+	// before emitting live change events.
+	NewResumableChangesWatcher(
+		changeLogID int64,
+		filter eventsource.FilterOption,
+		filterOpts ...eventsource.FilterOption,
+	) (watcher.ChangesWatcher, error)
+}
+
+// Service provides the API for working with the change feed.
+type Service struct {
+	st     State
+	logger logger.Logger
+}
+
+// GetNamespaces returns the names of all the namespaces that can be watched
+// in the change feed within the given scope.
+func (s *Service) GetNamespaces(ctx context.Context, scope changefeed.Scope) ([]string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	namespaces, err := s.st.GetNamespaces(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return slices.DeleteFunc(namespaces, func(namespace string) bool {
+		return !scope.Permits(namespace)
+	}), nil
+}
+
+// WatchableService provides the API for working with the change feed,
+// including the ability to create watchers.
+type WatchableService struct {
+	Service
+	watcherFactory WatcherFactory
+}
+
+// NewWatchableService creates a new WatchableService
+// for working with the change feed.
+func NewWatchableService(
+	st State,
+	watcherFactory WatcherFactory,
+	logger logger.Logger,
+) *WatchableService {
+	return &WatchableService{
+		Service: Service{
+			st:     st,
+			logger: logger,
+		},
+		watcherFactory: watcherFactory,
+	}
+}
+
+// WatchChanges returns a watcher that emits the change events for the
+// requested namespaces. If no namespaces are requested, then changes for all
+// the namespaces within the scope are emitted.
+// The following errors may be returned:
+//   - [changefeederrors.NamespaceNotFound] if a requested namespace does not
+//     exist.
+//   - [changefeederrors.NamespaceNotPermitted] if a requested namespace can't
+//     be watched within the scope.
+func (s *WatchableService) WatchChanges(
+	ctx context.Context, scope changefeed.Scope, namespaces ...string,
+) (watcher.ChangesWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	filter, filterOpts, err := s.filtersForNamespaces(ctx, scope, namespaces)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	w, err := s.watcherFactory.NewChangesWatcher(filter, filterOpts...)
+	if err != nil {
+		return nil, errors.Errorf("creating change feed watcher: %w", err)
+	}
+	return w, nil
+}
+
+// WatchChangesFrom returns a watcher that first emits all the change events
+// after the given change log ID for the requested namespaces, before emitting
+// live change events. If no namespaces are requested, then changes for all
+// the namespaces within the scope are emitted.
+// The following errors may be returned:
+//   - [changefeederrors.NamespaceNotFound] if a requested namespace does not
+//     exist.
+//   - [changefeederrors.NamespaceNotPermitted] if a requested namespace can't
+//     be watched within the scope.
+func (s *WatchableService) WatchChangesFrom(
+	ctx context.Context, scope changefeed.Scope, changeLogID int64, namespaces ...string,
+) (watcher.ChangesWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	filter, filterOpts, err := s.filtersForNamespaces(ctx, scope, namespaces)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	w, err := s.watcherFactory.NewResumableChangesWatcher(changeLogID, filter, filterOpts...)
+	if err != nil {
+		return nil, errors.Errorf("creating change feed watcher from %d: %w", changeLogID, err)
+	}
+	return w, nil
+}
+
+func (s *WatchableService) filtersForNamespaces(
+	ctx context.Context, scope changefeed.Scope, requested []string,
+) (eventsource.FilterOption, []eventsource.FilterOption, error) {
+	known, err := s.st.GetNamespaces(ctx)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+
+	namespaces := requested
+	if len(namespaces) == 0 {
+		namespaces = slices.DeleteFunc(slices.Clone(known), func(namespace string) bool {
+			return !scope.Permits(namespace)
+		})
+	}
+	if len(namespaces) == 0 {
+		return nil, nil, errors.Errorf("no namespaces to watch").Add(changefeederrors.NamespaceNotFound)
+	}
+
+	filters := make([]eventsource.FilterOption, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		if !slices.Contains(known, namespace) {
+			return nil, nil, errors.Errorf("namespace %q", namespace).Add(changefeederrors.NamespaceNotFound)
+		}
+		if !scope.Permits(namespace) {
+			return nil, nil, errors.Errorf("namespace %q", namespace).Add(changefeederrors.NamespaceNotPermitted)
+		}
+		filters = append(filters, eventsource.NamespaceFilter(namespace, changestream.All))
+	}
+	return filters[0], filters[1:], nil
+}
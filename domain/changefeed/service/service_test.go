@@ -0,0 +1,137 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	"github.com/juju/juju/domain/changefeed"
+	changefeederrors "github.com/juju/juju/domain/changefeed/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type serviceSuite struct {
+	state          *MockState
+	watcherFactory *MockWatcherFactory
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) TestGetNamespaces(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetNamespaces(gomock.Any()).Return([]string{"application", "unit"}, nil)
+
+	namespaces, err := s.service(c).GetNamespaces(c.Context(), changefeed.ScopeAll)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(namespaces, tc.DeepEquals, []string{"application", "unit"})
+}
+
+func (s *serviceSuite) TestGetNamespacesReadScope(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetNamespaces(gomock.Any()).Return([]string{"application", "secret_metadata", "unit"}, nil)
+
+	namespaces, err := s.service(c).GetNamespaces(c.Context(), changefeed.ScopeRead)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(namespaces, tc.DeepEquals, []string{"application", "unit"})
+}
+
+func (s *serviceSuite) TestWatchChanges(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetNamespaces(gomock.Any()).Return([]string{"application", "relation", "unit"}, nil)
+	s.watcherFactory.EXPECT().NewChangesWatcher(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(filter eventsource.FilterOption, filterOpts ...eventsource.FilterOption) (_ watcher.ChangesWatcher, _ error) {
+			c.Check(filter.Namespace(), tc.Equals, "application")
+			c.Assert(filterOpts, tc.HasLen, 1)
+			c.Check(filterOpts[0].Namespace(), tc.Equals, "unit")
+			return nil, nil
+		},
+	)
+
+	_, err := s.service(c).WatchChanges(c.Context(), changefeed.ScopeAll, "application", "unit")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestWatchChangesAllNamespaces(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetNamespaces(gomock.Any()).Return([]string{"application", "relation", "unit"}, nil)
+	s.watcherFactory.EXPECT().NewChangesWatcher(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(filter eventsource.FilterOption, filterOpts ...eventsource.FilterOption) (_ watcher.ChangesWatcher, _ error) {
+			c.Check(filter.Namespace(), tc.Equals, "application")
+			c.Check(filterOpts, tc.HasLen, 2)
+			return nil, nil
+		},
+	)
+
+	_, err := s.service(c).WatchChanges(c.Context(), changefeed.ScopeAll)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestWatchChangesNamespaceNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetNamespaces(gomock.Any()).Return([]string{"application"}, nil)
+
+	_, err := s.service(c).WatchChanges(c.Context(), changefeed.ScopeAll, "foo")
+	c.Assert(err, tc.ErrorIs, changefeederrors.NamespaceNotFound)
+}
+
+func (s *serviceSuite) TestWatchChangesNamespaceNotPermitted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetNamespaces(gomock.Any()).Return([]string{"application", "secret_metadata"}, nil)
+
+	_, err := s.service(c).WatchChanges(c.Context(), changefeed.ScopeRead, "secret_metadata")
+	c.Assert(err, tc.ErrorIs, changefeederrors.NamespaceNotPermitted)
+}
+
+func (s *serviceSuite) TestWatchChangesAllNamespacesReadScope(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetNamespaces(gomock.Any()).Return([]string{"application", "secret_metadata", "unit"}, nil)
+	s.watcherFactory.EXPECT().NewChangesWatcher(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(filter eventsource.FilterOption, filterOpts ...eventsource.FilterOption) (_ watcher.ChangesWatcher, _ error) {
+			c.Check(filter.Namespace(), tc.Equals, "application")
+			c.Assert(filterOpts, tc.HasLen, 1)
+			c.Check(filterOpts[0].Namespace(), tc.Equals, "unit")
+			return nil, nil
+		},
+	)
+
+	_, err := s.service(c).WatchChanges(c.Context(), changefeed.ScopeRead)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestWatchChangesFrom(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetNamespaces(gomock.Any()).Return([]string{"application", "unit"}, nil)
+	s.watcherFactory.EXPECT().NewResumableChangesWatcher(int64(42), gomock.Any()).Return(nil, nil)
+
+	_, err := s.service(c).WatchChangesFrom(c.Context(), changefeed.ScopeAll, 42, "unit")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockState(ctrl)
+	s.watcherFactory = NewMockWatcherFactory(ctrl)
+
+	return ctrl
+}
+
+func (s *serviceSuite) service(c *tc.C) *WatchableService {
+	return NewWatchableService(s.state, s.watcherFactory, loggertesting.WrapCheckLog(c))
+}
@@ -0,0 +1,192 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go -source=./service.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	watcher "github.com/juju/juju/core/watcher"
+	eventsource "github.com/juju/juju/core/watcher/eventsource"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// GetNamespaces mocks base method.
+func (m *MockState) GetNamespaces(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaces", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaces indicates an expected call of GetNamespaces.
+func (mr *MockStateMockRecorder) GetNamespaces(ctx any) *MockStateGetNamespacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaces", reflect.TypeOf((*MockState)(nil).GetNamespaces), ctx)
+	return &MockStateGetNamespacesCall{Call: call}
+}
+
+// MockStateGetNamespacesCall wrap *gomock.Call
+type MockStateGetNamespacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetNamespacesCall) Return(arg0 []string, arg1 error) *MockStateGetNamespacesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetNamespacesCall) Do(f func(context.Context) ([]string, error)) *MockStateGetNamespacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetNamespacesCall) DoAndReturn(f func(context.Context) ([]string, error)) *MockStateGetNamespacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockWatcherFactory is a mock of WatcherFactory interface.
+type MockWatcherFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherFactoryMockRecorder
+}
+
+// MockWatcherFactoryMockRecorder is the mock recorder for MockWatcherFactory.
+type MockWatcherFactoryMockRecorder struct {
+	mock *MockWatcherFactory
+}
+
+// NewMockWatcherFactory creates a new mock instance.
+func NewMockWatcherFactory(ctrl *gomock.Controller) *MockWatcherFactory {
+	mock := &MockWatcherFactory{ctrl: ctrl}
+	mock.recorder = &MockWatcherFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcherFactory) EXPECT() *MockWatcherFactoryMockRecorder {
+	return m.recorder
+}
+
+// NewChangesWatcher mocks base method.
+func (m *MockWatcherFactory) NewChangesWatcher(filter eventsource.FilterOption, filterOpts ...eventsource.FilterOption) (watcher.ChangesWatcher, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{filter}
+	for _, a := range filterOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewChangesWatcher", varargs...)
+	ret0, _ := ret[0].(watcher.ChangesWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewChangesWatcher indicates an expected call of NewChangesWatcher.
+func (mr *MockWatcherFactoryMockRecorder) NewChangesWatcher(filter any, filterOpts ...any) *MockWatcherFactoryNewChangesWatcherCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{filter}, filterOpts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangesWatcher", reflect.TypeOf((*MockWatcherFactory)(nil).NewChangesWatcher), varargs...)
+	return &MockWatcherFactoryNewChangesWatcherCall{Call: call}
+}
+
+// MockWatcherFactoryNewChangesWatcherCall wrap *gomock.Call
+type MockWatcherFactoryNewChangesWatcherCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWatcherFactoryNewChangesWatcherCall) Return(arg0 watcher.ChangesWatcher, arg1 error) *MockWatcherFactoryNewChangesWatcherCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWatcherFactoryNewChangesWatcherCall) Do(f func(eventsource.FilterOption, ...eventsource.FilterOption) (watcher.ChangesWatcher, error)) *MockWatcherFactoryNewChangesWatcherCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWatcherFactoryNewChangesWatcherCall) DoAndReturn(f func(eventsource.FilterOption, ...eventsource.FilterOption) (watcher.ChangesWatcher, error)) *MockWatcherFactoryNewChangesWatcherCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NewResumableChangesWatcher mocks base method.
+func (m *MockWatcherFactory) NewResumableChangesWatcher(changeLogID int64, filter eventsource.FilterOption, filterOpts ...eventsource.FilterOption) (watcher.ChangesWatcher, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{changeLogID, filter}
+	for _, a := range filterOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewResumableChangesWatcher", varargs...)
+	ret0, _ := ret[0].(watcher.ChangesWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewResumableChangesWatcher indicates an expected call of NewResumableChangesWatcher.
+func (mr *MockWatcherFactoryMockRecorder) NewResumableChangesWatcher(changeLogID, filter any, filterOpts ...any) *MockWatcherFactoryNewResumableChangesWatcherCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{changeLogID, filter}, filterOpts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewResumableChangesWatcher", reflect.TypeOf((*MockWatcherFactory)(nil).NewResumableChangesWatcher), varargs...)
+	return &MockWatcherFactoryNewResumableChangesWatcherCall{Call: call}
+}
+
+// MockWatcherFactoryNewResumableChangesWatcherCall wrap *gomock.Call
+type MockWatcherFactoryNewResumableChangesWatcherCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWatcherFactoryNewResumableChangesWatcherCall) Return(arg0 watcher.ChangesWatcher, arg1 error) *MockWatcherFactoryNewResumableChangesWatcherCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWatcherFactoryNewResumableChangesWatcherCall) Do(f func(int64, eventsource.FilterOption, ...eventsource.FilterOption) (watcher.ChangesWatcher, error)) *MockWatcherFactoryNewResumableChangesWatcherCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWatcherFactoryNewResumableChangesWatcherCall) DoAndReturn(f func(int64, eventsource.FilterOption, ...eventsource.FilterOption) (watcher.ChangesWatcher, error)) *MockWatcherFactoryNewResumableChangesWatcherCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State represents database interactions dealing with the change feed.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new change feed state
+// based on the input database factory method.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// GetNamespaces returns the names of all the namespaces that changes are
+// recorded against in the change log.
+func (s *State) GetNamespaces(ctx context.Context) ([]string, error) {
+	db, err := s.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := s.Prepare(`
+SELECT &changeLogNamespace.*
+FROM change_log_namespace
+ORDER BY namespace`, changeLogNamespace{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select namespaces statement: %w", err)
+	}
+
+	var namespaces []changeLogNamespace
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt).GetAll(&namespaces); errors.Is(err, sql.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("getting change log namespaces: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	results := make([]string, len(namespaces))
+	for i, ns := range namespaces {
+		results[i] = ns.Namespace
+	}
+	return results, nil
+}
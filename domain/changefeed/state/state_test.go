@@ -0,0 +1,33 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/juju/tc"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type stateSuite struct {
+	schematesting.ModelSuite
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) TestGetNamespaces(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	namespaces, err := st.GetNamespaces(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(slices.Contains(namespaces, "application"), tc.IsTrue)
+	c.Check(slices.Contains(namespaces, "unit"), tc.IsTrue)
+	c.Check(slices.Contains(namespaces, "relation"), tc.IsTrue)
+	c.Check(slices.Contains(namespaces, "secret_metadata"), tc.IsTrue)
+	c.Check(slices.IsSorted(namespaces), tc.IsTrue)
+}
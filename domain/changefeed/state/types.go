@@ -0,0 +1,9 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+// changeLogNamespace represents a row in the change_log_namespace table.
+type changeLogNamespace struct {
+	Namespace string `db:"namespace"`
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changefeed
+
+import "github.com/juju/collections/set"
+
+// Scope determines which namespaces of the change feed can be watched.
+type Scope int
+
+const (
+	// ScopeAll allows every namespace to be watched. It is for model
+	// administrators.
+	ScopeAll Scope = iota
+
+	// ScopeRead only allows the namespaces describing the topology of the
+	// model to be watched. It is for users with read access to the model,
+	// who mustn't learn about secrets, credentials or controller internals.
+	ScopeRead
+)
+
+// readNamespaces are the namespaces that can be watched with ScopeRead.
+var readNamespaces = set.NewStrings(
+	"application",
+	"application_endpoint",
+	"application_exposed_endpoint_cidr",
+	"application_exposed_endpoint_space",
+	"application_scale",
+	"block_device",
+	"charm",
+	"ip_address",
+	"machine",
+	"machine_cloud_instance",
+	"machine_lxd_profile",
+	"machine_requires_reboot",
+	"model",
+	"port_range",
+	"relation",
+	"relation_status",
+	"relation_unit",
+	"removal",
+	"subnet",
+	"unit",
+	"unit_insert_delete",
+	"unit_principal",
+	"unit_resolved",
+)
+
+// Permits returns true if the namespace can be watched within the scope.
+func (s Scope) Permits(namespace string) bool {
+	switch s {
+	case ScopeAll:
+		return true
+	case ScopeRead:
+		return readNamespaces.Contains(namespace)
+	default:
+		return false
+	}
+}
@@ -131,22 +131,8 @@ func (s *Service) GetAuthorisedKeysForMachine(
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
-	if err := machineName.Validate(); err != nil {
-		return nil, errors.Errorf(
-			"validating machine name when getting authorized keys for machine: %w",
-			err,
-		)
-	}
-
-	if err := s.st.CheckMachineExists(ctx, machineName); errors.Is(err, machineerrors.MachineNotFound) {
-		return nil, errors.Errorf(
-			"machine %q does not exist", machineName,
-		).Add(machineerrors.MachineNotFound)
-	} else if err != nil {
-		return nil, errors.Errorf(
-			"determining if machine %q exists when getting authorized keys for machine: %w",
-			machineName, err,
-		)
+	if err := s.checkMachine(ctx, machineName); err != nil {
+		return nil, errors.Capture(err)
 	}
 
 	modelId, err := s.st.GetModelUUID(ctx)
@@ -176,6 +162,63 @@ func (s *Service) GetAuthorisedKeysForMachine(
 	return append(userKeys, controllerKeys...), nil
 }
 
+// GetControllerAuthorisedKeysForMachine is responsible for fetching the
+// controller wide authorised keys that should be available on a machine,
+// without the keys of the model's users. It is used for machines that trust
+// the controller's SSH certificate authority, which users log into with
+// short-lived certificates instead, so that revoking a user's access to the
+// model also revokes their access to its machines. The following errors can
+// be expected:
+// - [github.com/juju/juju/core/errors.NotValid] if the machine id is not valid.
+// - [github.com/juju/juju/domain/machine/errors.NotFound] if the machine does
+// not exist.
+func (s *Service) GetControllerAuthorisedKeysForMachine(
+	ctx context.Context,
+	machineName coremachine.Name,
+) ([]string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := s.checkMachine(ctx, machineName); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	controllerKeys, err := s.controllerKeyProvider.ControllerAuthorisedKeys(ctx)
+	if err != nil {
+		return nil, errors.Errorf(
+			"getting controller authorised keys for machine %q: %w",
+			machineName, err,
+		)
+	}
+	return controllerKeys, nil
+}
+
+// checkMachine checks that the machine name is valid and that the machine
+// exists. The following errors can be expected:
+// - [github.com/juju/juju/core/errors.NotValid] if the machine id is not valid.
+// - [github.com/juju/juju/domain/machine/errors.NotFound] if the machine does
+// not exist.
+func (s *Service) checkMachine(ctx context.Context, machineName coremachine.Name) error {
+	if err := machineName.Validate(); err != nil {
+		return errors.Errorf(
+			"validating machine name when getting authorized keys for machine: %w",
+			err,
+		)
+	}
+
+	if err := s.st.CheckMachineExists(ctx, machineName); errors.Is(err, machineerrors.MachineNotFound) {
+		return errors.Errorf(
+			"machine %q does not exist", machineName,
+		).Add(machineerrors.MachineNotFound)
+	} else if err != nil {
+		return errors.Errorf(
+			"determining if machine %q exists when getting authorized keys for machine: %w",
+			machineName, err,
+		)
+	}
+	return nil
+}
+
 // WatchAuthorisedKeysForMachine will watch for authorised key changes for a
 // give machine name. The following errors can be expected:
 // - [github.com/juju/juju/core/errors.NotValid] if the machine id is not valid.
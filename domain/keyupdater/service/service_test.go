@@ -117,6 +117,37 @@ func (s *serviceSuite) TestAuthorisedKeysForMachineNotFound(c *tc.C) {
 	c.Check(err, tc.ErrorIs, machineerrors.MachineNotFound)
 }
 
+// TestControllerAuthorisedKeysForMachine is asserting that only the
+// controller keys are returned, without the keys of the model's users.
+func (s *serviceSuite) TestControllerAuthorisedKeysForMachine(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerKeyProvider.EXPECT().ControllerAuthorisedKeys(gomock.Any()).Return(controllerKeys, nil)
+	s.state.EXPECT().CheckMachineExists(gomock.Any(), coremachine.Name("0")).Return(nil)
+
+	keys, err := NewService(s.controllerKeyProvider, s.controllerState, s.state).GetControllerAuthorisedKeysForMachine(
+		c.Context(),
+		coremachine.Name("0"),
+	)
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(keys, tc.DeepEquals, controllerKeys)
+}
+
+// TestControllerAuthorisedKeysForMachineNotFound is asserting that if we ask
+// for the controller authorised keys for a machine that doesn't exist we get
+// back a [machineerrors.MachineNotFound] error.
+func (s *serviceSuite) TestControllerAuthorisedKeysForMachineNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().CheckMachineExists(gomock.Any(), coremachine.Name("0")).Return(machineerrors.MachineNotFound)
+
+	_, err := NewService(s.controllerKeyProvider, s.controllerState, s.state).GetControllerAuthorisedKeysForMachine(
+		c.Context(),
+		coremachine.Name("0"),
+	)
+	c.Check(err, tc.ErrorIs, machineerrors.MachineNotFound)
+}
+
 // TestGetInitialAuthorisedKeysForContainerSuccess tests the happy path for
 // Service.GetInitialAuthorisedKeysForContainer.
 func (s *serviceSuite) TestGetInitialAuthorisedKeysForContainerSuccess(c *tc.C) {
@@ -68,7 +68,7 @@ func NewExporter(
 func (e *Exporter) ExportOperations(registry corestorage.ModelStorageRegistryGetter) {
 	model.RegisterExport(e.coordinator, e.logger.Child("model"))
 	sequence.RegisterExport(e.coordinator)
-	blockcommand.RegisterExport(e.coordinator, e.logger.Child("blockcommand"))
+	blockcommand.RegisterExport(e.coordinator, e.clock, e.logger.Child("blockcommand"))
 	modelconfig.RegisterExport(e.coordinator)
 	access.RegisterExport(e.coordinator, e.logger.Child("access"))
 	keymanager.RegisterExport(e.coordinator)
@@ -94,5 +94,5 @@ func ImportOperations(
 	// Block command is probably best processed last, is that will prevent
 	// any block commands from being executed before all the other operations
 	// have been completed.
-	blockcommand.RegisterImport(coordinator, logger.Child("blockcommand"))
+	blockcommand.RegisterImport(coordinator, clock, logger.Child("blockcommand"))
 }
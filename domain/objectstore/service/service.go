@@ -6,12 +6,15 @@ package service
 import (
 	"context"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/trace"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/eventsource"
+	domainobjectstore "github.com/juju/juju/domain/objectstore"
 	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
 	"github.com/juju/juju/internal/errors"
 )
@@ -55,6 +58,11 @@ type State interface {
 	// RemoveMetadata removes the specified path for the persistence metadata.
 	RemoveMetadata(ctx context.Context, path string) error
 
+	// ListObjects returns every object in the object store, along with the
+	// paths it's stored at and the charms, resources and agent binaries that
+	// reference it. This is only supported by the model object store.
+	ListObjects(ctx context.Context) ([]domainobjectstore.Object, error)
+
 	// InitialWatchStatement returns the table and the initial watch statement
 	// for the persistence metadata.
 	InitialWatchStatement() (string, string)
@@ -233,6 +241,56 @@ func (s *Service) RemoveMetadata(ctx context.Context, path string) error {
 	return nil
 }
 
+// ListObjects returns every object in the object store, along with the paths
+// it's stored at and the charms, resources and agent binaries that reference
+// it. This is only supported by the model object store.
+func (s *Service) ListObjects(ctx context.Context) ([]domainobjectstore.Object, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	objects, err := s.st.ListObjects(ctx)
+	if err != nil {
+		return nil, errors.Errorf("listing objects: %w", err)
+	}
+	return objects, nil
+}
+
+// GetObject returns the object identified by the key, which is either a path
+// of the object, its SHA256 hash or a unique prefix of its SHA256 hash. If no
+// object matches the key, a [objectstoreerrors.ErrNotFound] error is
+// returned.
+func (s *Service) GetObject(ctx context.Context, key string) (domainobjectstore.Object, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if key == "" {
+		return domainobjectstore.Object{}, errors.Errorf("empty key: %w", objectstoreerrors.ErrNotFound)
+	}
+
+	objects, err := s.st.ListObjects(ctx)
+	if err != nil {
+		return domainobjectstore.Object{}, errors.Errorf("listing objects: %w", err)
+	}
+
+	var matches []domainobjectstore.Object
+	for _, object := range objects {
+		if object.SHA256 == key || slices.Contains(object.Paths, key) {
+			return object, nil
+		}
+		if len(key) >= minHashPrefixLength && strings.HasPrefix(object.SHA256, key) {
+			matches = append(matches, object)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return domainobjectstore.Object{}, errors.Errorf("object %q: %w", key, objectstoreerrors.ErrNotFound)
+	case 1:
+		return matches[0], nil
+	default:
+		return domainobjectstore.Object{}, errors.Errorf("hash prefix %q matches %d objects", key, len(matches))
+	}
+}
+
 // WatchableService provides the API for working with the objectstore
 // and the ability to create watchers.
 type WatchableService struct {
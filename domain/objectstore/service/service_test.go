@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	objectstoretesting "github.com/juju/juju/core/objectstore/testing"
 	"github.com/juju/juju/core/watcher/watchertest"
+	domainobjectstore "github.com/juju/juju/domain/objectstore"
 	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/testhelpers"
@@ -242,6 +243,84 @@ func (s *serviceSuite) TestPutMetadataMissingSHA256(c *tc.C) {
 }
 
 // Test watch returns a watcher that watches the specified path.
+func (s *serviceSuite) TestListObjects(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	objects := []domainobjectstore.Object{{
+		SHA256: "sha256",
+		SHA384: "sha384",
+		Size:   42,
+		Paths:  []string{"foo"},
+		References: []domainobjectstore.ObjectReference{{
+			Kind: domainobjectstore.CharmReference,
+			Name: "foo-1",
+		}},
+	}}
+	s.state.EXPECT().ListObjects(gomock.Any()).Return(objects, nil)
+
+	result, err := NewService(s.state).ListObjects(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, objects)
+}
+
+func (s *serviceSuite) TestGetObjectByPath(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ListObjects(gomock.Any()).Return(s.objects(), nil)
+
+	result, err := NewService(s.state).GetObject(c.Context(), "bar")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.SHA256, tc.Equals, "a1b2c3d4e5f60001")
+}
+
+func (s *serviceSuite) TestGetObjectBySHA256(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ListObjects(gomock.Any()).Return(s.objects(), nil)
+
+	result, err := NewService(s.state).GetObject(c.Context(), "a1b2c3d4ffff0002")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Paths, tc.DeepEquals, []string{"baz"})
+}
+
+func (s *serviceSuite) TestGetObjectBySHA256Prefix(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ListObjects(gomock.Any()).Return(s.objects(), nil)
+
+	result, err := NewService(s.state).GetObject(c.Context(), "a1b2c3d4ff")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Paths, tc.DeepEquals, []string{"baz"})
+}
+
+func (s *serviceSuite) TestGetObjectAmbiguousSHA256Prefix(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ListObjects(gomock.Any()).Return(s.objects(), nil)
+
+	_, err := NewService(s.state).GetObject(c.Context(), "a1b2c3d4")
+	c.Assert(err, tc.ErrorMatches, `hash prefix "a1b2c3d4" matches 2 objects`)
+}
+
+func (s *serviceSuite) TestGetObjectNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ListObjects(gomock.Any()).Return(s.objects(), nil)
+
+	_, err := NewService(s.state).GetObject(c.Context(), "nope")
+	c.Assert(err, tc.ErrorIs, objectstoreerrors.ErrNotFound)
+}
+
+func (s *serviceSuite) objects() []domainobjectstore.Object {
+	return []domainobjectstore.Object{{
+		SHA256: "a1b2c3d4e5f60001",
+		Paths:  []string{"foo", "bar"},
+	}, {
+		SHA256: "a1b2c3d4ffff0002",
+		Paths:  []string{"baz"},
+	}}
+}
+
 func (s *serviceSuite) TestWatch(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -16,6 +16,7 @@ import (
 	objectstore "github.com/juju/juju/core/objectstore"
 	watcher "github.com/juju/juju/core/watcher"
 	eventsource "github.com/juju/juju/core/watcher/eventsource"
+	objectstore0 "github.com/juju/juju/domain/objectstore"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -237,6 +238,45 @@ func (c *MockStateListMetadataCall) DoAndReturn(f func(context.Context) ([]objec
 	return c
 }
 
+// ListObjects mocks base method.
+func (m *MockState) ListObjects(arg0 context.Context) ([]objectstore0.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", arg0)
+	ret0, _ := ret[0].([]objectstore0.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockStateMockRecorder) ListObjects(arg0 any) *MockStateListObjectsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockState)(nil).ListObjects), arg0)
+	return &MockStateListObjectsCall{Call: call}
+}
+
+// MockStateListObjectsCall wrap *gomock.Call
+type MockStateListObjectsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListObjectsCall) Return(arg0 []objectstore0.Object, arg1 error) *MockStateListObjectsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListObjectsCall) Do(f func(context.Context) ([]objectstore0.Object, error)) *MockStateListObjectsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListObjectsCall) DoAndReturn(f func(context.Context) ([]objectstore0.Object, error)) *MockStateListObjectsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // PutMetadata mocks base method.
 func (m *MockState) PutMetadata(arg0 context.Context, arg1 objectstore.Metadata) (objectstore.UUID, error) {
 	m.ctrl.T.Helper()
@@ -610,6 +650,45 @@ func (c *MockDrainingStateListMetadataCall) DoAndReturn(f func(context.Context)
 	return c
 }
 
+// ListObjects mocks base method.
+func (m *MockDrainingState) ListObjects(arg0 context.Context) ([]objectstore0.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", arg0)
+	ret0, _ := ret[0].([]objectstore0.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockDrainingStateMockRecorder) ListObjects(arg0 any) *MockDrainingStateListObjectsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockDrainingState)(nil).ListObjects), arg0)
+	return &MockDrainingStateListObjectsCall{Call: call}
+}
+
+// MockDrainingStateListObjectsCall wrap *gomock.Call
+type MockDrainingStateListObjectsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDrainingStateListObjectsCall) Return(arg0 []objectstore0.Object, arg1 error) *MockDrainingStateListObjectsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDrainingStateListObjectsCall) Do(f func(context.Context) ([]objectstore0.Object, error)) *MockDrainingStateListObjectsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDrainingStateListObjectsCall) DoAndReturn(f func(context.Context) ([]objectstore0.Object, error)) *MockDrainingStateListObjectsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // PutMetadata mocks base method.
 func (m *MockDrainingState) PutMetadata(arg0 context.Context, arg1 objectstore.Metadata) (objectstore.UUID, error) {
 	m.ctrl.T.Helper()
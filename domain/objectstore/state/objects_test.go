@@ -0,0 +1,281 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	domainobjectstore "github.com/juju/juju/domain/objectstore"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type modelStateSuite struct {
+	schematesting.ModelSuite
+}
+
+func TestModelStateSuite(t *testing.T) {
+	tc.Run(t, &modelStateSuite{})
+}
+
+func (s *modelStateSuite) TestListObjectsEmpty(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	objects, err := st.ListObjects(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(objects, tc.HasLen, 0)
+}
+
+func (s *modelStateSuite) TestListObjectsUnreferenced(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.PutMetadata(c.Context(), coreobjectstore.Metadata{
+		SHA256: "sha256-b",
+		SHA384: "sha384-b",
+		Path:   "foo",
+		Size:   42,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.PutMetadata(c.Context(), coreobjectstore.Metadata{
+		SHA256: "sha256-a",
+		SHA384: "sha384-a",
+		Path:   "bar",
+		Size:   666,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	objects, err := st.ListObjects(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	clearAdded(c, objects)
+	c.Check(objects, tc.DeepEquals, []domainobjectstore.Object{{
+		SHA256: "sha256-a",
+		SHA384: "sha384-a",
+		Size:   666,
+		Paths:  []string{"bar"},
+	}, {
+		SHA256: "sha256-b",
+		SHA384: "sha384-b",
+		Size:   42,
+		Paths:  []string{"foo"},
+	}})
+}
+
+func (s *modelStateSuite) TestListObjectsMultiplePaths(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	metadata := coreobjectstore.Metadata{
+		SHA256: "sha256",
+		SHA384: "sha384",
+		Path:   "foo",
+		Size:   42,
+	}
+	_, err := st.PutMetadata(c.Context(), metadata)
+	c.Assert(err, tc.ErrorIsNil)
+
+	metadata.Path = "bar"
+	_, err = st.PutMetadata(c.Context(), metadata)
+	c.Assert(err, tc.ErrorIsNil)
+
+	objects, err := st.ListObjects(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	clearAdded(c, objects)
+	c.Check(objects, tc.DeepEquals, []domainobjectstore.Object{{
+		SHA256: "sha256",
+		SHA384: "sha384",
+		Size:   42,
+		Paths:  []string{"bar", "foo"},
+	}})
+}
+
+func (s *modelStateSuite) TestListObjectsReferences(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	charmUUID, err := st.PutMetadata(c.Context(), coreobjectstore.Metadata{
+		SHA256: "sha256-charm",
+		SHA384: "sha384-charm",
+		Path:   "charms/foo",
+		Size:   1,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	resourceUUID, err := st.PutMetadata(c.Context(), coreobjectstore.Metadata{
+		SHA256: "sha256-resource",
+		SHA384: "sha384-resource",
+		Path:   "resources/foo",
+		Size:   2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	agentUUID, err := st.PutMetadata(c.Context(), coreobjectstore.Metadata{
+		SHA256: "sha256-agent",
+		SHA384: "sha384-agent",
+		Path:   "tools/foo",
+		Size:   3,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO charm (uuid, object_store_uuid, reference_name, revision)
+VALUES ('charm-uuid', ?, 'foo', 7)`, charmUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO charm_resource (charm_uuid, name, kind_id)
+VALUES ('charm-uuid', 'blob', 0)`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO resource (uuid, charm_uuid, charm_resource_name, origin_type_id, state_id, created_at)
+VALUES ('resource-uuid', 'charm-uuid', 'blob', 0, 0, DATETIME('now'))`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO resource_file_store (resource_uuid, store_uuid)
+VALUES ('resource-uuid', ?)`, resourceUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO agent_binary_store (version, architecture_id, object_store_uuid)
+VALUES ('4.0.0', 0, ?)`, agentUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	objects, err := st.ListObjects(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	clearAdded(c, objects)
+	c.Check(objects, tc.DeepEquals, []domainobjectstore.Object{{
+		SHA256: "sha256-agent",
+		SHA384: "sha384-agent",
+		Size:   3,
+		Paths:  []string{"tools/foo"},
+		References: []domainobjectstore.ObjectReference{{
+			Kind: domainobjectstore.AgentBinaryReference,
+			Name: "4.0.0-amd64",
+		}},
+	}, {
+		SHA256: "sha256-charm",
+		SHA384: "sha384-charm",
+		Size:   1,
+		Paths:  []string{"charms/foo"},
+		References: []domainobjectstore.ObjectReference{{
+			Kind: domainobjectstore.CharmReference,
+			Name: "foo-7",
+		}},
+	}, {
+		SHA256: "sha256-resource",
+		SHA384: "sha384-resource",
+		Size:   2,
+		Paths:  []string{"resources/foo"},
+		References: []domainobjectstore.ObjectReference{{
+			Kind: domainobjectstore.ResourceReference,
+			Name: "blob",
+		}},
+	}})
+}
+
+func (s *modelStateSuite) TestListObjectsSSHRecordings(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.PutMetadata(c.Context(), coreobjectstore.Metadata{
+		SHA256: "sha256-recording",
+		SHA384: "sha384-recording",
+		Path:   "ssh-recordings/admin/machine-0/20250101T000000.000000000Z.cast",
+		Size:   1,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.PutMetadata(c.Context(), coreobjectstore.Metadata{
+		SHA256: "sha256-other",
+		SHA384: "sha384-other",
+		Path:   "other/ssh-recordings/foo",
+		Size:   2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Recordings are referenced by their path, so they are never
+	// pruned; other objects are not.
+	objects, err := st.ListObjects(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	clearAdded(c, objects)
+	c.Check(objects, tc.DeepEquals, []domainobjectstore.Object{{
+		SHA256: "sha256-other",
+		SHA384: "sha384-other",
+		Size:   2,
+		Paths:  []string{"other/ssh-recordings/foo"},
+	}, {
+		SHA256: "sha256-recording",
+		SHA384: "sha384-recording",
+		Size:   1,
+		Paths:  []string{"ssh-recordings/admin/machine-0/20250101T000000.000000000Z.cast"},
+		References: []domainobjectstore.ObjectReference{{
+			Kind: domainobjectstore.SSHRecordingReference,
+			Name: "admin/machine-0/20250101T000000.000000000Z.cast",
+		}},
+	}})
+}
+
+func (s *modelStateSuite) TestListObjectsUnregisteredReference(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	uuid, err := st.PutMetadata(c.Context(), coreobjectstore.Metadata{
+		SHA256: "sha256",
+		SHA384: "sha384",
+		Path:   "foo",
+		Size:   1,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A table referencing the object without a view registering the
+	// references still counts as a reference.
+	_, err = s.DB().ExecContext(c.Context(), `
+CREATE TABLE widget (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    object_store_uuid TEXT,
+    CONSTRAINT fk_widget_object_store_metadata
+    FOREIGN KEY (object_store_uuid)
+    REFERENCES object_store_metadata (uuid)
+)`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO widget (uuid, object_store_uuid) VALUES ('widget-uuid', ?)`, uuid)
+	c.Assert(err, tc.ErrorIsNil)
+
+	objects, err := st.ListObjects(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(objects, tc.HasLen, 1)
+	c.Check(objects[0].References, tc.DeepEquals, []domainobjectstore.ObjectReference{{
+		Kind: "widget",
+	}})
+}
+
+func (s *modelStateSuite) TestListObjectsAddedIsMostRecentPath(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	metadata := coreobjectstore.Metadata{
+		SHA256: "sha256",
+		SHA384: "sha384",
+		Path:   "foo",
+		Size:   42,
+	}
+	_, err := st.PutMetadata(c.Context(), metadata)
+	c.Assert(err, tc.ErrorIsNil)
+	metadata.Path = "bar"
+	_, err = st.PutMetadata(c.Context(), metadata)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.DB().ExecContext(c.Context(), `
+UPDATE object_store_metadata_path
+SET created_at = CASE path WHEN 'foo' THEN '2025-01-01 00:00:00' ELSE '2025-02-01 00:00:00' END`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	objects, err := st.ListObjects(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(objects, tc.HasLen, 1)
+	c.Check(objects[0].Added.Equal(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)), tc.IsTrue,
+		tc.Commentf("added at %v", objects[0].Added))
+}
+
+// clearAdded checks that the time each object was added is set, then clears
+// it so the objects can be compared.
+func clearAdded(c *tc.C, objects []domainobjectstore.Object) {
+	for i := range objects {
+		c.Check(objects[i].Added.IsZero(), tc.IsFalse)
+		objects[i].Added = time.Time{}
+	}
+}
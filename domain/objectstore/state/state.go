@@ -5,6 +5,7 @@ package state
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/canonical/sqlair"
 	"github.com/juju/collections/transform"
@@ -12,6 +13,7 @@ import (
 	coredatabase "github.com/juju/juju/core/database"
 	coreobjectstore "github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/domain"
+	domainobjectstore "github.com/juju/juju/domain/objectstore"
 	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
 	"github.com/juju/juju/internal/database"
 	"github.com/juju/juju/internal/errors"
@@ -196,8 +198,8 @@ ON CONFLICT (sha_384) DO NOTHING`, dbMetadata)
 	}
 
 	pathStmt, err := s.Prepare(`
-INSERT INTO object_store_metadata_path (path, metadata_uuid)
-VALUES ($dbMetadataPath.*)`, dbMetadataPath)
+INSERT INTO object_store_metadata_path (path, metadata_uuid, created_at)
+VALUES ($dbMetadataPath.path, $dbMetadataPath.metadata_uuid, STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc'))`, dbMetadataPath)
 	if err != nil {
 		return "", errors.Errorf("preparing insert metadata path statement: %w", err)
 	}
@@ -327,6 +329,168 @@ AND NOT EXISTS (
 	return nil
 }
 
+// ListObjects returns every object in the model's object store, along with
+// the paths it's stored at and the entities that reference it. The objects
+// are ordered by their SHA256 hash.
+//
+// The references are registered by each domain with a view named
+// v_object_store_reference_<kind>. Any table with a foreign key to the object
+// store metadata that isn't covered by a view is also treated as a reference,
+// named after the table, so that objects are never reported as unreferenced
+// just because a domain didn't register its references.
+//
+// This requires the model schema, it can't be used against the controller
+// database.
+func (s *State) ListObjects(ctx context.Context) ([]domainobjectstore.Object, error) {
+	db, err := s.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	pathStmt, err := s.Prepare(`
+SELECT osm.uuid AS &dbObjectPath.uuid,
+       osm.sha_256 AS &dbObjectPath.sha_256,
+       osm.sha_384 AS &dbObjectPath.sha_384,
+       osm.size AS &dbObjectPath.size,
+       osmp.path AS &dbObjectPath.path,
+       osmp.created_at AS &dbObjectPath.created_at
+FROM object_store_metadata AS osm
+LEFT JOIN object_store_metadata_path AS osmp ON osm.uuid = osmp.metadata_uuid
+ORDER BY osm.sha_256, osmp.path`, dbObjectPath{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select metadata statement: %w", err)
+	}
+	viewStmt, err := s.Prepare(`
+SELECT name AS &dbReferenceSource.name
+FROM sqlite_master
+WHERE type = 'view'
+AND name LIKE 'v_object_store_reference_%'
+ORDER BY name`, dbReferenceSource{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select reference views statement: %w", err)
+	}
+	tableStmt, err := s.Prepare(`
+SELECT m.name AS &dbReferenceSource.name,
+       fk."from" AS &dbReferenceSource.column_name
+FROM sqlite_master AS m
+JOIN pragma_foreign_key_list(m.name) AS fk
+WHERE m.type = 'table'
+AND fk."table" = 'object_store_metadata'
+AND m.name != 'object_store_metadata_path'
+ORDER BY m.name`, dbReferenceSource{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select referencing tables statement: %w", err)
+	}
+
+	var (
+		paths      []dbObjectPath
+		references []dbObjectReference
+		referenced = make(map[string]bool)
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, pathStmt).GetAll(&paths)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("retrieving metadata: %w", err)
+		}
+
+		var views []dbReferenceSource
+		err = tx.Query(ctx, viewStmt).GetAll(&views)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("retrieving reference views: %w", err)
+		}
+		for _, view := range views {
+			refs, err := s.getReferences(ctx, tx, fmt.Sprintf(`
+SELECT &dbObjectReference.*
+FROM %s
+WHERE object_store_uuid IS NOT NULL`, view.Name))
+			if err != nil {
+				return errors.Errorf("retrieving references from %q: %w", view.Name, err)
+			}
+			for _, ref := range refs {
+				referenced[ref.ObjectStoreUUID] = true
+			}
+			references = append(references, refs...)
+		}
+
+		var tables []dbReferenceSource
+		err = tx.Query(ctx, tableStmt).GetAll(&tables)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("retrieving referencing tables: %w", err)
+		}
+		for _, table := range tables {
+			// The names come from the schema, so are safe to use in the
+			// query.
+			refs, err := s.getReferences(ctx, tx, fmt.Sprintf(`
+SELECT DISTINCT %[2]s AS &dbObjectReference.object_store_uuid
+FROM %[1]s
+WHERE %[2]s IS NOT NULL`, table.Name, table.ColumnName))
+			if err != nil {
+				return errors.Errorf("retrieving references from %q: %w", table.Name, err)
+			}
+			// Only add the references that aren't already registered by
+			// a view.
+			for _, ref := range refs {
+				if !referenced[ref.ObjectStoreUUID] {
+					ref.Kind = table.Name
+					references = append(references, ref)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	objectReferences := make(map[string][]domainobjectstore.ObjectReference)
+	for _, ref := range references {
+		objectReferences[ref.ObjectStoreUUID] = append(objectReferences[ref.ObjectStoreUUID], domainobjectstore.ObjectReference{
+			Kind: domainobjectstore.ReferenceKind(ref.Kind),
+			Name: ref.Name,
+		})
+	}
+
+	// The metadata is returned as a row per path, fold them into a single
+	// object per metadata uuid.
+	var (
+		objects []domainobjectstore.Object
+		indexes = make(map[string]int)
+	)
+	for _, p := range paths {
+		index, ok := indexes[p.UUID]
+		if !ok {
+			index = len(objects)
+			indexes[p.UUID] = index
+			objects = append(objects, domainobjectstore.Object{
+				SHA256:     p.SHA256,
+				SHA384:     p.SHA384,
+				Size:       p.Size,
+				References: objectReferences[p.UUID],
+			})
+		}
+		if p.Path.Valid {
+			objects[index].Paths = append(objects[index].Paths, p.Path.String)
+		}
+		if p.CreatedAt.Valid && p.CreatedAt.Time.After(objects[index].Added) {
+			objects[index].Added = p.CreatedAt.Time
+		}
+	}
+	return objects, nil
+}
+
+// getReferences runs a query against a source of object references.
+func (s *State) getReferences(ctx context.Context, tx *sqlair.TX, query string) ([]dbObjectReference, error) {
+	stmt, err := s.Prepare(query, dbObjectReference{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var refs []dbObjectReference
+	if err := tx.Query(ctx, stmt).GetAll(&refs); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Capture(err)
+	}
+	return refs, nil
+}
+
 // SetDrainingPhase sets the phase of the object store to draining.
 func (s *State) SetDrainingPhase(ctx context.Context, uuid string, phase coreobjectstore.Phase) error {
 	db, err := s.DB()
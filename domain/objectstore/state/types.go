@@ -4,6 +4,8 @@
 package state
 
 import (
+	"database/sql"
+
 	coreobjectstore "github.com/juju/juju/core/objectstore"
 )
 
@@ -40,6 +42,41 @@ type dbMetadataPath struct {
 	Path string `db:"path"`
 }
 
+// dbObjectPath represents a path of an object, with the object's metadata.
+type dbObjectPath struct {
+	// UUID is the uuid for the metadata.
+	UUID string `db:"uuid"`
+	// SHA256 is the 256 hash of the object.
+	SHA256 string `db:"sha_256"`
+	// SHA384 is the 512-384 hash of the object.
+	SHA384 string `db:"sha_384"`
+	// Size is the size of the object.
+	Size int64 `db:"size"`
+	// Path is the path to the object, if it has any.
+	Path sql.NullString `db:"path"`
+	// CreatedAt is the time the path was added.
+	CreatedAt sql.NullTime `db:"created_at"`
+}
+
+// dbReferenceSource represents a view or table holding references to objects.
+type dbReferenceSource struct {
+	// Name is the name of the view or table.
+	Name string `db:"name"`
+	// ColumnName is the column of the table referencing the object's
+	// metadata.
+	ColumnName string `db:"column_name"`
+}
+
+// dbObjectReference represents an entity that references an object.
+type dbObjectReference struct {
+	// ObjectStoreUUID is the uuid of the object's metadata.
+	ObjectStoreUUID string `db:"object_store_uuid"`
+	// Kind is the kind of the entity.
+	Kind string `db:"kind"`
+	// Name identifies the entity.
+	Name string `db:"name"`
+}
+
 // ToCoreObjectStoreMetadata transforms de-serialised data from the database to
 // object metadata.
 func decodeDbMetadata(m dbMetadata) coreobjectstore.Metadata {
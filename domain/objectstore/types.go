@@ -3,6 +3,8 @@
 
 package objectstore
 
+import "time"
+
 // Metadata represents the metadata for an object.
 type Metadata struct {
 	// UUID is the uuid for the metadata.
@@ -14,3 +16,45 @@ type Metadata struct {
 	// Size is the size of the object.
 	Size int64
 }
+
+// Object describes an object in the object store, along with the paths it's
+// stored at and the entities that reference it.
+type Object struct {
+	// SHA256 is the SHA256 hash of the object.
+	SHA256 string
+	// SHA384 is the SHA384 hash of the object.
+	SHA384 string
+	// Size is the size of the object.
+	Size int64
+	// Paths are the paths the object is stored at.
+	Paths []string
+	// References are the entities that reference the object.
+	References []ObjectReference
+	// Added is the time the most recent path of the object was added.
+	Added time.Time
+}
+
+// ReferenceKind is the kind of entity that references an object. Kinds
+// are registered by the domains holding the references, any other kind is
+// the name of a table referencing the object.
+type ReferenceKind string
+
+const (
+	// CharmReference is a charm archive.
+	CharmReference ReferenceKind = "charm"
+	// ResourceReference is a file resource of an application.
+	ResourceReference ReferenceKind = "resource"
+	// AgentBinaryReference is an agent binary.
+	AgentBinaryReference ReferenceKind = "agent-binary"
+	// SSHRecordingReference is the recording of an interactive SSH session.
+	SSHRecordingReference ReferenceKind = "ssh-recording"
+)
+
+// ObjectReference is an entity that references an object in the object
+// store.
+type ObjectReference struct {
+	// Kind is the kind of the entity.
+	Kind ReferenceKind
+	// Name identifies the entity.
+	Name string
+}
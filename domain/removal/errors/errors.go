@@ -26,4 +26,12 @@ const (
 	// UnitsStillInScope indicates that a relation can not be deleted from
 	// the database because it has associated relation_unit records.
 	UnitsStillInScope = errors.ConstError("units still in relation scope")
+
+	// RemovalJobNotFound indicates that a removal job does not exist.
+	RemovalJobNotFound = errors.ConstError("removal job not found")
+
+	// RemovalJobNotCancellable indicates that a removal job can not be
+	// cancelled, because the life of the entity being removed can not be
+	// safely reverted.
+	RemovalJobNotCancellable = errors.ConstError("removal job not cancellable")
 )
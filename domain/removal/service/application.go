@@ -43,6 +43,11 @@ type ApplicationState interface {
 
 	// DeleteApplication removes a application from the database completely.
 	DeleteApplication(ctx context.Context, appUUID string) error
+
+	// CancelApplicationRemoval reverts the life of the dying application
+	// with the input UUID to alive, and deletes all of the removal jobs
+	// scheduled for it.
+	CancelApplicationRemoval(ctx context.Context, appUUID string) error
 }
 
 // RemoveApplication checks if a application with the input application UUID
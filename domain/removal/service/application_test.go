@@ -176,6 +176,8 @@ func (s *applicationSuite) TestExecuteJobForApplicationError(c *tc.C) {
 	exp := s.state.EXPECT()
 	exp.GetApplicationLife(gomock.Any(), j.EntityUUID).Return(-1, errors.Errorf("the front fell off"))
 
+	s.expectJobError(j)
+
 	err := s.newService(c).ExecuteJob(c.Context(), j)
 	c.Assert(err, tc.ErrorMatches, ".*the front fell off")
 }
@@ -188,6 +190,8 @@ func (s *applicationSuite) TestExecuteJobForApplicationStillAlive(c *tc.C) {
 	exp := s.state.EXPECT()
 	exp.GetApplicationLife(gomock.Any(), j.EntityUUID).Return(life.Alive, nil)
 
+	s.expectJobError(j)
+
 	err := s.newService(c).ExecuteJob(c.Context(), j)
 	c.Assert(err, tc.ErrorIs, removalerrors.EntityStillAlive)
 }
@@ -215,6 +219,8 @@ func (s *applicationSuite) TestExecuteJobForApplicationDyingDeleteApplicationErr
 	exp.GetApplicationLife(gomock.Any(), j.EntityUUID).Return(life.Dying, nil)
 	exp.DeleteApplication(gomock.Any(), j.EntityUUID).Return(errors.Errorf("the front fell off"))
 
+	s.expectJobError(j)
+
 	err := s.newService(c).ExecuteJob(c.Context(), j)
 	c.Assert(err, tc.ErrorMatches, ".*the front fell off")
 }
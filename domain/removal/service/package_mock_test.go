@@ -119,6 +119,82 @@ func (c *MockStateApplicationScheduleRemovalCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// CancelApplicationRemoval mocks base method.
+func (m *MockState) CancelApplicationRemoval(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelApplicationRemoval", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelApplicationRemoval indicates an expected call of CancelApplicationRemoval.
+func (mr *MockStateMockRecorder) CancelApplicationRemoval(arg0, arg1 any) *MockStateCancelApplicationRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelApplicationRemoval", reflect.TypeOf((*MockState)(nil).CancelApplicationRemoval), arg0, arg1)
+	return &MockStateCancelApplicationRemovalCall{Call: call}
+}
+
+// MockStateCancelApplicationRemovalCall wrap *gomock.Call
+type MockStateCancelApplicationRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateCancelApplicationRemovalCall) Return(arg0 error) *MockStateCancelApplicationRemovalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateCancelApplicationRemovalCall) Do(f func(context.Context, string) error) *MockStateCancelApplicationRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateCancelApplicationRemovalCall) DoAndReturn(f func(context.Context, string) error) *MockStateCancelApplicationRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CancelUnitRemoval mocks base method.
+func (m *MockState) CancelUnitRemoval(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUnitRemoval", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelUnitRemoval indicates an expected call of CancelUnitRemoval.
+func (mr *MockStateMockRecorder) CancelUnitRemoval(arg0, arg1 any) *MockStateCancelUnitRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUnitRemoval", reflect.TypeOf((*MockState)(nil).CancelUnitRemoval), arg0, arg1)
+	return &MockStateCancelUnitRemovalCall{Call: call}
+}
+
+// MockStateCancelUnitRemovalCall wrap *gomock.Call
+type MockStateCancelUnitRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateCancelUnitRemovalCall) Return(arg0 error) *MockStateCancelUnitRemovalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateCancelUnitRemovalCall) Do(f func(context.Context, string) error) *MockStateCancelUnitRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateCancelUnitRemovalCall) DoAndReturn(f func(context.Context, string) error) *MockStateCancelUnitRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteApplication mocks base method.
 func (m *MockState) DeleteApplication(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -426,6 +502,83 @@ func (c *MockStateEnsureUnitNotAliveCascadeCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// ForceJob mocks base method.
+func (m *MockState) ForceJob(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceJob indicates an expected call of ForceJob.
+func (mr *MockStateMockRecorder) ForceJob(arg0, arg1, arg2 any) *MockStateForceJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceJob", reflect.TypeOf((*MockState)(nil).ForceJob), arg0, arg1, arg2)
+	return &MockStateForceJobCall{Call: call}
+}
+
+// MockStateForceJobCall wrap *gomock.Call
+type MockStateForceJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateForceJobCall) Return(arg0 error) *MockStateForceJobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateForceJobCall) Do(f func(context.Context, string, time.Time) error) *MockStateForceJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateForceJobCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MockStateForceJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllJobDetails mocks base method.
+func (m *MockState) GetAllJobDetails(arg0 context.Context) ([]removal.JobDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllJobDetails", arg0)
+	ret0, _ := ret[0].([]removal.JobDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllJobDetails indicates an expected call of GetAllJobDetails.
+func (mr *MockStateMockRecorder) GetAllJobDetails(arg0 any) *MockStateGetAllJobDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllJobDetails", reflect.TypeOf((*MockState)(nil).GetAllJobDetails), arg0)
+	return &MockStateGetAllJobDetailsCall{Call: call}
+}
+
+// MockStateGetAllJobDetailsCall wrap *gomock.Call
+type MockStateGetAllJobDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetAllJobDetailsCall) Return(arg0 []removal.JobDetail, arg1 error) *MockStateGetAllJobDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetAllJobDetailsCall) Do(f func(context.Context) ([]removal.JobDetail, error)) *MockStateGetAllJobDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetAllJobDetailsCall) DoAndReturn(f func(context.Context) ([]removal.JobDetail, error)) *MockStateGetAllJobDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetAllJobs mocks base method.
 func (m *MockState) GetAllJobs(arg0 context.Context) ([]removal.Job, error) {
 	m.ctrl.T.Helper()
@@ -544,6 +697,45 @@ func (c *MockStateGetApplicationNameAndUnitNameByUnitUUIDCall) DoAndReturn(f fun
 	return c
 }
 
+// GetJob mocks base method.
+func (m *MockState) GetJob(arg0 context.Context, arg1 string) (removal.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", arg0, arg1)
+	ret0, _ := ret[0].(removal.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockStateMockRecorder) GetJob(arg0, arg1 any) *MockStateGetJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockState)(nil).GetJob), arg0, arg1)
+	return &MockStateGetJobCall{Call: call}
+}
+
+// MockStateGetJobCall wrap *gomock.Call
+type MockStateGetJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetJobCall) Return(arg0 removal.Job, arg1 error) *MockStateGetJobCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetJobCall) Do(f func(context.Context, string) (removal.Job, error)) *MockStateGetJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetJobCall) DoAndReturn(f func(context.Context, string) (removal.Job, error)) *MockStateGetJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRelationLife mocks base method.
 func (m *MockState) GetRelationLife(arg0 context.Context, arg1 string) (life.Life, error) {
 	m.ctrl.T.Helper()
@@ -814,6 +1006,44 @@ func (c *MockStateRelationScheduleRemovalCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// SetJobError mocks base method.
+func (m *MockState) SetJobError(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetJobError", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetJobError indicates an expected call of SetJobError.
+func (mr *MockStateMockRecorder) SetJobError(arg0, arg1, arg2, arg3 any) *MockStateSetJobErrorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJobError", reflect.TypeOf((*MockState)(nil).SetJobError), arg0, arg1, arg2, arg3)
+	return &MockStateSetJobErrorCall{Call: call}
+}
+
+// MockStateSetJobErrorCall wrap *gomock.Call
+type MockStateSetJobErrorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetJobErrorCall) Return(arg0 error) *MockStateSetJobErrorCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetJobErrorCall) Do(f func(context.Context, string, string, time.Time) error) *MockStateSetJobErrorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetJobErrorCall) DoAndReturn(f func(context.Context, string, string, time.Time) error) *MockStateSetJobErrorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UnitExists mocks base method.
 func (m *MockState) UnitExists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
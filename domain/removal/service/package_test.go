@@ -4,9 +4,12 @@
 package service
 
 import (
+	"time"
+
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
+	"github.com/juju/juju/domain/removal"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testhelpers"
 )
@@ -32,6 +35,14 @@ func (s *baseSuite) newService(c *tc.C) *Service {
 	}
 }
 
+// expectJobError sets up the expectation that an error
+// is recorded against the input job when it is executed.
+func (s *baseSuite) expectJobError(j removal.Job) {
+	now := time.Now().UTC()
+	s.clock.EXPECT().Now().Return(now)
+	s.state.EXPECT().SetJobError(gomock.Any(), j.UUID.String(), gomock.Any(), now).Return(nil)
+}
+
 func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
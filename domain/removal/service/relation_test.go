@@ -126,6 +126,8 @@ func (s *relationSuite) TestExecuteJobForRelationStillAlive(c *tc.C) {
 
 	s.state.EXPECT().GetRelationLife(gomock.Any(), j.EntityUUID).Return(life.Alive, nil)
 
+	s.expectJobError(j)
+
 	err := s.newService(c).ExecuteJob(c.Context(), j)
 	c.Assert(err, tc.ErrorIs, removalerrors.EntityStillAlive)
 }
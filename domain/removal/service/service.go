@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/clock"
 
@@ -28,6 +29,21 @@ type State interface {
 	// GetAllJobs returns all removal jobs.
 	GetAllJobs(ctx context.Context) ([]removal.Job, error)
 
+	// GetAllJobDetails returns all removal jobs, along with the names of
+	// the entities being removed and the last error recorded for each job.
+	GetAllJobDetails(ctx context.Context) ([]removal.JobDetail, error)
+
+	// GetJob returns the removal job with the input UUID.
+	GetJob(ctx context.Context, jUUID string) (removal.Job, error)
+
+	// SetJobError records the input error message as the last error
+	// returned when executing the removal job with the input UUID.
+	SetJobError(ctx context.Context, jUUID, message string, at time.Time) error
+
+	// ForceJob qualifies the removal job with the input UUID with force,
+	// and reschedules it to be executed at the input time.
+	ForceJob(ctx context.Context, jUUID string, when time.Time) error
+
 	// DeleteJob deletes a removal record under the assumption
 	// that it was executed successfully.
 	DeleteJob(ctx context.Context, jUUID string) error
@@ -61,9 +77,89 @@ func (s *Service) GetAllJobs(ctx context.Context) ([]removal.Job, error) {
 	return jobs, nil
 }
 
+// GetAllJobDetails returns all removal jobs, along with the names of the
+// entities being removed and the last error recorded for each job.
+func (s *Service) GetAllJobDetails(ctx context.Context) ([]removal.JobDetail, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	jobs, err := s.st.GetAllJobDetails(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return jobs, nil
+}
+
+// CancelJob cancels the removal job with the input UUID, along with any other
+// jobs scheduled for the same entity, and reverts the life of the entity to
+// alive. Only removals that have not been forced can be cancelled, and only
+// for entities whose life can safely be reverted:
+//   - Units can be cancelled if their application and machine are alive.
+//   - Applications can be cancelled if they have no units.
+//   - Relations can not be cancelled, as units may have already left scope.
+//
+// [removalerrors.RemovalJobNotFound] is returned if no such job exists, and
+// [removalerrors.RemovalJobNotCancellable] is returned if the job can not be
+// cancelled.
+func (s *Service) CancelJob(ctx context.Context, jobUUID removal.UUID) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := jobUUID.Validate(); err != nil {
+		return errors.Errorf("validating removal job UUID: %w", err)
+	}
+
+	job, err := s.st.GetJob(ctx, jobUUID.String())
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if job.Force {
+		return errors.Errorf("removal job %q is forced", jobUUID).Add(removalerrors.RemovalJobNotCancellable)
+	}
+
+	switch job.RemovalType {
+	case removal.UnitJob:
+		err = s.st.CancelUnitRemoval(ctx, job.EntityUUID)
+	case removal.ApplicationJob:
+		err = s.st.CancelApplicationRemoval(ctx, job.EntityUUID)
+	default:
+		return errors.Errorf("removal of %s %q can not be reverted", job.RemovalType, job.EntityUUID).Add(
+			removalerrors.RemovalJobNotCancellable)
+	}
+	if err != nil {
+		return errors.Errorf("cancelling removal job %q: %w", jobUUID, err)
+	}
+
+	s.logger.Infof(ctx, "cancelled removal job %q for %s %q", jobUUID, job.RemovalType, job.EntityUUID)
+	return nil
+}
+
+// ForceJob qualifies the removal job with the input UUID with force and
+// reschedules it to be executed immediately, ignoring any remaining wait
+// duration.
+// [removalerrors.RemovalJobNotFound] is returned if no such job exists.
+func (s *Service) ForceJob(ctx context.Context, jobUUID removal.UUID) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := jobUUID.Validate(); err != nil {
+		return errors.Errorf("validating removal job UUID: %w", err)
+	}
+
+	if err := s.st.ForceJob(ctx, jobUUID.String(), s.clock.Now().UTC()); err != nil {
+		return errors.Capture(err)
+	}
+
+	s.logger.Infof(ctx, "forced removal job %q", jobUUID)
+	return nil
+}
+
 // ExecuteJob runs the appropriate removal logic for the input job.
 // If the job is determined to have run successfully, we ensure that
 // no removal job with the same UUID exists in the database.
+// If the job fails, the error is recorded against the job so that
+// it can be reported to users.
 func (s *Service) ExecuteJob(ctx context.Context, job removal.Job) error {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
@@ -88,6 +184,9 @@ func (s *Service) ExecuteJob(ctx context.Context, job removal.Job) error {
 		return nil
 	}
 	if err != nil {
+		if setErr := s.st.SetJobError(ctx, job.UUID.String(), err.Error(), s.clock.Now().UTC()); setErr != nil {
+			s.logger.Errorf(ctx, "recording error for removal job %q: %v", job.UUID, setErr)
+		}
 		return errors.Capture(err)
 	}
 
@@ -81,12 +81,180 @@ func (s *serviceSuite) TestGetAllJobsError(c *tc.C) {
 }
 
 func (s *serviceSuite) TestExecuteJobUnsupportedType(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
 	var unsupportedJobType removal.JobType = 500
 
 	job := removal.Job{
+		UUID:        "job-1",
 		RemovalType: unsupportedJobType,
 	}
 
+	now := time.Now().UTC()
+	s.clock.EXPECT().Now().Return(now)
+	s.state.EXPECT().SetJobError(gomock.Any(), "job-1", `removal job type "unknown" not supported`, now).Return(nil)
+
 	err := s.newService(c).ExecuteJob(c.Context(), job)
 	c.Check(err, tc.ErrorIs, removalerrors.RemovalJobTypeNotSupported)
 }
+
+func (s *serviceSuite) TestExecuteJobRecordsErrorFailure(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	job := removal.Job{
+		UUID:        "job-1",
+		RemovalType: 500,
+	}
+
+	now := time.Now().UTC()
+	s.clock.EXPECT().Now().Return(now)
+	s.state.EXPECT().SetJobError(gomock.Any(), "job-1", gomock.Any(), now).Return(errors.New("the front fell off"))
+
+	// The failure to record the error is logged, but
+	// the original error is returned.
+	err := s.newService(c).ExecuteJob(c.Context(), job)
+	c.Check(err, tc.ErrorIs, removalerrors.RemovalJobTypeNotSupported)
+}
+
+func (s *serviceSuite) TestGetAllJobDetails(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	details := []removal.JobDetail{{
+		Job: removal.Job{
+			UUID:         "job-1",
+			RemovalType:  removal.UnitJob,
+			EntityUUID:   "unit-1",
+			ScheduledFor: time.Now().UTC(),
+		},
+		EntityName:  "foo/0",
+		LastError:   "the front fell off",
+		LastErrorAt: time.Now().UTC(),
+	}}
+
+	s.state.EXPECT().GetAllJobDetails(gomock.Any()).Return(details, nil)
+
+	jobs, err := s.newService(c).GetAllJobDetails(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(jobs, tc.DeepEquals, details)
+}
+
+func (s *serviceSuite) TestCancelJobUnit(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	jUUID := newJobUUID(c)
+	s.state.EXPECT().GetJob(gomock.Any(), jUUID.String()).Return(removal.Job{
+		UUID:        jUUID,
+		RemovalType: removal.UnitJob,
+		EntityUUID:  "unit-1",
+	}, nil)
+	s.state.EXPECT().CancelUnitRemoval(gomock.Any(), "unit-1").Return(nil)
+
+	err := s.newService(c).CancelJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCancelJobApplication(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	jUUID := newJobUUID(c)
+	s.state.EXPECT().GetJob(gomock.Any(), jUUID.String()).Return(removal.Job{
+		UUID:        jUUID,
+		RemovalType: removal.ApplicationJob,
+		EntityUUID:  "app-1",
+	}, nil)
+	s.state.EXPECT().CancelApplicationRemoval(gomock.Any(), "app-1").Return(nil)
+
+	err := s.newService(c).CancelJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCancelJobApplicationNotCancellable(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	jUUID := newJobUUID(c)
+	s.state.EXPECT().GetJob(gomock.Any(), jUUID.String()).Return(removal.Job{
+		UUID:        jUUID,
+		RemovalType: removal.ApplicationJob,
+		EntityUUID:  "app-1",
+	}, nil)
+	s.state.EXPECT().CancelApplicationRemoval(gomock.Any(), "app-1").Return(removalerrors.RemovalJobNotCancellable)
+
+	err := s.newService(c).CancelJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotCancellable)
+}
+
+func (s *serviceSuite) TestCancelJobRelation(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	jUUID := newJobUUID(c)
+	s.state.EXPECT().GetJob(gomock.Any(), jUUID.String()).Return(removal.Job{
+		UUID:        jUUID,
+		RemovalType: removal.RelationJob,
+		EntityUUID:  "rel-1",
+	}, nil)
+
+	err := s.newService(c).CancelJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotCancellable)
+}
+
+func (s *serviceSuite) TestCancelJobForced(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	jUUID := newJobUUID(c)
+	s.state.EXPECT().GetJob(gomock.Any(), jUUID.String()).Return(removal.Job{
+		UUID:        jUUID,
+		RemovalType: removal.UnitJob,
+		EntityUUID:  "unit-1",
+		Force:       true,
+	}, nil)
+
+	err := s.newService(c).CancelJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotCancellable)
+}
+
+func (s *serviceSuite) TestCancelJobNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	jUUID := newJobUUID(c)
+	s.state.EXPECT().GetJob(gomock.Any(), jUUID.String()).Return(removal.Job{}, removalerrors.RemovalJobNotFound)
+
+	err := s.newService(c).CancelJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotFound)
+}
+
+func (s *serviceSuite) TestCancelJobInvalidUUID(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.newService(c).CancelJob(c.Context(), "not-a-uuid")
+	c.Assert(err, tc.ErrorMatches, `validating removal job UUID: invalid uuid "not-a-uuid"`)
+}
+
+func (s *serviceSuite) TestForceJob(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	jUUID := newJobUUID(c)
+	now := time.Now().UTC()
+	s.clock.EXPECT().Now().Return(now)
+	s.state.EXPECT().ForceJob(gomock.Any(), jUUID.String(), now).Return(nil)
+
+	err := s.newService(c).ForceJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestForceJobNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	jUUID := newJobUUID(c)
+	now := time.Now().UTC()
+	s.clock.EXPECT().Now().Return(now)
+	s.state.EXPECT().ForceJob(gomock.Any(), jUUID.String(), now).Return(removalerrors.RemovalJobNotFound)
+
+	err := s.newService(c).ForceJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotFound)
+}
+
+func newJobUUID(c *tc.C) removal.UUID {
+	jUUID, err := removal.NewUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	return jUUID
+}
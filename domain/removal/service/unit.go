@@ -46,6 +46,10 @@ type UnitState interface {
 
 	// MarkUnitAsDead marks the unit with the input UUID as dead.
 	MarkUnitAsDead(ctx context.Context, unitUUID string) error
+
+	// CancelUnitRemoval reverts the life of the dying unit with the input
+	// UUID to alive, and deletes all of the removal jobs scheduled for it.
+	CancelUnitRemoval(ctx context.Context, unitUUID string) error
 }
 
 // RemoveUnit checks if a unit with the input name exists.
@@ -135,6 +135,8 @@ func (s *unitSuite) TestExecuteJobForUnitError(c *tc.C) {
 	exp := s.state.EXPECT()
 	exp.GetUnitLife(gomock.Any(), j.EntityUUID).Return(-1, errors.Errorf("the front fell off"))
 
+	s.expectJobError(j)
+
 	err := s.newService(c).ExecuteJob(c.Context(), j)
 	c.Assert(err, tc.ErrorMatches, ".*the front fell off")
 }
@@ -147,6 +149,8 @@ func (s *unitSuite) TestExecuteJobForUnitStillAlive(c *tc.C) {
 	exp := s.state.EXPECT()
 	exp.GetUnitLife(gomock.Any(), j.EntityUUID).Return(life.Alive, nil)
 
+	s.expectJobError(j)
+
 	err := s.newService(c).ExecuteJob(c.Context(), j)
 	c.Assert(err, tc.ErrorIs, removalerrors.EntityStillAlive)
 }
@@ -180,6 +184,8 @@ func (s *unitSuite) TestExecuteJobForUnitDyingDeleteUnitError(c *tc.C) {
 
 	s.revoker.EXPECT().RevokeLeadership("foo", unit.Name("foo/0")).Return(nil)
 
+	s.expectJobError(j)
+
 	err := s.newService(c).ExecuteJob(c.Context(), j)
 	c.Assert(err, tc.ErrorMatches, ".*the front fell off")
 }
@@ -195,6 +201,8 @@ func (s *unitSuite) TestExecuteJobForUnitRevokingUnitError(c *tc.C) {
 
 	s.revoker.EXPECT().RevokeLeadership("foo", unit.Name("foo/0")).Return(errors.Errorf("the front fell off"))
 
+	s.expectJobError(j)
+
 	err := s.newService(c).ExecuteJob(c.Context(), j)
 	c.Assert(err, tc.ErrorMatches, ".*the front fell off")
 }
@@ -177,6 +177,68 @@ func (st *State) ApplicationScheduleRemoval(
 	}))
 }
 
+// CancelApplicationRemoval reverts the life of the dying application with the
+// input UUID to alive, and deletes all of the removal jobs scheduled for it.
+// The removal can only be cancelled if none of the jobs are forced, and if the
+// application has no units, as the removal of any units can not be reverted.
+// [removalerrors.RemovalJobNotCancellable] is returned if this is not the case.
+func (st *State) CancelApplicationRemoval(ctx context.Context, aUUID string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	applicationUUID := entityUUID{UUID: aUUID}
+	summaryStmt, err := st.Prepare(`
+SELECT    a.life_id AS &applicationRemovalSummary.life_id,
+          COUNT(u.uuid) AS &applicationRemovalSummary.unit_count
+FROM      application AS a
+LEFT JOIN unit AS u ON u.application_uuid = a.uuid
+WHERE     a.uuid = $entityUUID.uuid
+GROUP BY  a.uuid`, applicationRemovalSummary{}, applicationUUID)
+	if err != nil {
+		return errors.Errorf("preparing application summary query: %w", err)
+	}
+
+	updateStmt, err := st.Prepare(`
+UPDATE application
+SET    life_id = 0
+WHERE  uuid = $entityUUID.uuid
+AND    life_id = 1`, applicationUUID)
+	if err != nil {
+		return errors.Errorf("preparing application life update: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var summary applicationRemovalSummary
+		err := tx.Query(ctx, summaryStmt, applicationUUID).Get(&summary)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return applicationerrors.ApplicationNotFound
+		} else if err != nil {
+			return errors.Errorf("running application summary query: %w", err)
+		}
+
+		if summary.Life != life.Dying {
+			return errors.Errorf("application %q is not dying", aUUID).Add(removalerrors.RemovalJobNotCancellable)
+		} else if summary.UnitCount > 0 {
+			return errors.Errorf("application %q has units being removed", aUUID).Add(removalerrors.RemovalJobNotCancellable)
+		}
+
+		forced, err := st.countForcedEntityJobs(ctx, tx, aUUID)
+		if err != nil {
+			return errors.Capture(err)
+		} else if forced > 0 {
+			return errors.Errorf("removal of application %q is forced", aUUID).Add(removalerrors.RemovalJobNotCancellable)
+		}
+
+		if err := tx.Query(ctx, updateStmt, applicationUUID).Run(); err != nil {
+			return errors.Errorf("reverting application life: %w", err)
+		}
+
+		return errors.Capture(st.deleteEntityJobs(ctx, tx, aUUID))
+	}))
+}
+
 // GetApplicationLife returns the life of the application with the input UUID.
 func (st *State) GetApplicationLife(ctx context.Context, aUUID string) (life.Life, error) {
 	db, err := st.DB()
@@ -310,6 +372,7 @@ func (st *State) deleteSimpleApplicationReferences(ctx context.Context, tx *sqla
 		"application_config_hash",
 		"application_constraint",
 		"application_setting",
+		"application_schedule",
 		"application_exposed_endpoint_space",
 		"application_exposed_endpoint_cidr",
 		"application_endpoint",
@@ -471,6 +534,7 @@ func (st *State) deleteCharm(ctx context.Context, tx *sqlair.TX, cUUID string) e
 		"charm_container",
 		"charm_term",
 		"charm_resource",
+		"charm_schedule",
 		"charm_device",
 		"charm_storage_property",
 		"charm_storage",
@@ -556,3 +556,54 @@ func removeDuplicates[T comparable](uuids []T) []T {
 	}
 	return result
 }
+
+func (s *applicationSuite) TestCancelApplicationRemoval(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "pelican")
+	svc := s.setupService(c, factory)
+	appUUID := s.createIAASApplication(c, svc, "some-app")
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	_, _, err := st.EnsureApplicationNotAliveCascade(c.Context(), appUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.ApplicationScheduleRemoval(c.Context(), "removal-uuid", appUUID.String(), false, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CancelApplicationRemoval(c.Context(), appUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	l, err := st.GetApplicationLife(c.Context(), appUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(l, tc.Equals, life.Alive)
+
+	jobs, err := st.GetAllJobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(jobs, tc.HasLen, 0)
+}
+
+func (s *applicationSuite) TestCancelApplicationRemovalWithUnits(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "pelican")
+	svc := s.setupService(c, factory)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	_, _, err := st.EnsureApplicationNotAliveCascade(c.Context(), appUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.ApplicationScheduleRemoval(c.Context(), "removal-uuid", appUUID.String(), false, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CancelApplicationRemoval(c.Context(), appUUID.String())
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotCancellable)
+
+	l, err := st.GetApplicationLife(c.Context(), appUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(l, tc.Equals, life.Dying)
+}
+
+func (s *applicationSuite) TestCancelApplicationRemovalNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.CancelApplicationRemoval(c.Context(), "some-application-uuid")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
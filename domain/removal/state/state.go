@@ -5,14 +5,19 @@ package state
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/canonical/sqlair"
 
 	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/logger"
+	corerelation "github.com/juju/juju/core/relation"
 	"github.com/juju/juju/domain"
 	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -61,11 +66,9 @@ func (st *State) GetAllJobs(ctx context.Context) ([]removal.Job, error) {
 
 	jobs := make([]removal.Job, len(dbJobs))
 	for i, job := range dbJobs {
-		var arg map[string]any
-		if job.Arg.Valid && job.Arg.String != "" {
-			if err := json.Unmarshal([]byte(job.Arg.String), &arg); err != nil {
-				return nil, errors.Errorf("decoding job arg: %w", err)
-			}
+		arg, err := decodeJobArg(job.Arg)
+		if err != nil {
+			return nil, errors.Capture(err)
 		}
 
 		jobs[i] = removal.Job{
@@ -81,6 +84,252 @@ func (st *State) GetAllJobs(ctx context.Context) ([]removal.Job, error) {
 	return jobs, err
 }
 
+// GetJob returns the removal job with the input UUID.
+// [removalerrors.RemovalJobNotFound] is returned if no such job exists.
+func (st *State) GetJob(ctx context.Context, jUUID string) (removal.Job, error) {
+	db, err := st.DB()
+	if err != nil {
+		return removal.Job{}, errors.Capture(err)
+	}
+
+	jobUUID := entityUUID{UUID: jUUID}
+
+	stmt, err := st.Prepare(`
+SELECT &removalJob.*
+FROM   removal
+WHERE  uuid = $entityUUID.uuid`, removalJob{}, jobUUID)
+	if err != nil {
+		return removal.Job{}, errors.Errorf("preparing select job query: %w", err)
+	}
+
+	var job removalJob
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, jobUUID).Get(&job)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("removal job %q", jUUID).Add(removalerrors.RemovalJobNotFound)
+		} else if err != nil {
+			return errors.Errorf("running select job query: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return removal.Job{}, errors.Capture(err)
+	}
+
+	arg, err := decodeJobArg(job.Arg)
+	if err != nil {
+		return removal.Job{}, errors.Capture(err)
+	}
+
+	return removal.Job{
+		UUID:         removal.UUID(job.UUID),
+		RemovalType:  removal.JobType(job.RemovalTypeID),
+		EntityUUID:   job.EntityUUID,
+		Force:        job.Force,
+		ScheduledFor: job.ScheduledFor,
+		Arg:          arg,
+	}, nil
+}
+
+// GetAllJobDetails returns all scheduled removal jobs, along with the names
+// of the entities being removed and the last error recorded for each job.
+// Jobs are returned in the order in which they are scheduled.
+func (st *State) GetAllJobDetails(ctx context.Context) ([]removal.JobDetail, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	jobsStmt, err := st.Prepare(`
+SELECT    r.uuid AS &removalJobDetail.uuid,
+          r.removal_type_id AS &removalJobDetail.removal_type_id,
+          r.entity_uuid AS &removalJobDetail.entity_uuid,
+          r.force AS &removalJobDetail.force,
+          r.scheduled_for AS &removalJobDetail.scheduled_for,
+          r.arg AS &removalJobDetail.arg,
+          COALESCE(u.name, a.name) AS &removalJobDetail.entity_name,
+          re.error AS &removalJobDetail.last_error,
+          re.updated_at AS &removalJobDetail.last_error_at
+FROM      removal AS r
+LEFT JOIN unit AS u ON r.removal_type_id = 1 AND u.uuid = r.entity_uuid
+LEFT JOIN application AS a ON r.removal_type_id = 2 AND a.uuid = r.entity_uuid
+LEFT JOIN removal_error AS re ON re.removal_uuid = r.uuid
+ORDER BY  r.scheduled_for, r.uuid`, removalJobDetail{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select job details query: %w", err)
+	}
+
+	endpointsStmt, err := st.Prepare(`
+SELECT &relationEndpoint.*
+FROM   v_relation_endpoint
+WHERE  relation_uuid IN (
+    SELECT entity_uuid
+    FROM   removal
+    WHERE  removal_type_id = 0
+)`, relationEndpoint{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select relation endpoints query: %w", err)
+	}
+
+	var (
+		dbJobs      []removalJobDetail
+		dbEndpoints []relationEndpoint
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, jobsStmt).GetAll(&dbJobs)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("running select job details query: %w", err)
+		}
+
+		err = tx.Query(ctx, endpointsStmt).GetAll(&dbEndpoints)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("running select relation endpoints query: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	if len(dbJobs) == 0 {
+		return nil, nil
+	}
+
+	endpoints := make(map[string][]corerelation.EndpointIdentifier)
+	for _, ep := range dbEndpoints {
+		endpoints[ep.RelationUUID] = append(endpoints[ep.RelationUUID], corerelation.EndpointIdentifier{
+			ApplicationName: ep.ApplicationName,
+			EndpointName:    ep.EndpointName,
+			Role:            charm.RelationRole(ep.Role),
+		})
+	}
+
+	jobs := make([]removal.JobDetail, len(dbJobs))
+	for i, job := range dbJobs {
+		arg, err := decodeJobArg(job.Arg)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+
+		entityName := job.EntityName.String
+		if removal.JobType(job.RemovalTypeID) == removal.RelationJob {
+			if eps, ok := endpoints[job.EntityUUID]; ok {
+				key, err := corerelation.NewKey(eps)
+				if err != nil {
+					return nil, errors.Errorf("generating key for relation %q: %w", job.EntityUUID, err)
+				}
+				entityName = key.String()
+			}
+		}
+
+		jobs[i] = removal.JobDetail{
+			Job: removal.Job{
+				UUID:         removal.UUID(job.UUID),
+				RemovalType:  removal.JobType(job.RemovalTypeID),
+				EntityUUID:   job.EntityUUID,
+				Force:        job.Force,
+				ScheduledFor: job.ScheduledFor,
+				Arg:          arg,
+			},
+			EntityName:  entityName,
+			LastError:   job.LastError.String,
+			LastErrorAt: job.LastErrorAt.Time,
+		}
+	}
+	return jobs, nil
+}
+
+// SetJobError records the input error message as the last error returned
+// when executing the removal job with the input UUID. If the job no longer
+// exists, no error is recorded.
+func (st *State) SetJobError(ctx context.Context, jUUID, message string, at time.Time) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	jobUUID := entityUUID{UUID: jUUID}
+	jobErr := removalError{
+		RemovalUUID: jUUID,
+		Error:       message,
+		UpdatedAt:   at,
+	}
+
+	existsStmt, err := st.Prepare(`
+SELECT uuid AS &entityUUID.uuid
+FROM   removal
+WHERE  uuid = $entityUUID.uuid`, jobUUID)
+	if err != nil {
+		return errors.Errorf("preparing job exists query: %w", err)
+	}
+
+	upsertStmt, err := st.Prepare(`
+INSERT INTO removal_error (*) VALUES ($removalError.*)
+ON CONFLICT (removal_uuid) DO UPDATE SET
+    error = excluded.error,
+    updated_at = excluded.updated_at`, jobErr)
+	if err != nil {
+		return errors.Errorf("preparing job error upsert: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, existsStmt, jobUUID).Get(&jobUUID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			// The job has been completed or cancelled in the meantime.
+			return nil
+		} else if err != nil {
+			return errors.Errorf("running job exists query: %w", err)
+		}
+
+		if err := tx.Query(ctx, upsertStmt, jobErr).Run(); err != nil {
+			return errors.Errorf("recording job error: %w", err)
+		}
+		return nil
+	}))
+}
+
+// ForceJob qualifies the removal job with the input UUID with force, and
+// reschedules it to be executed at the input time.
+// [removalerrors.RemovalJobNotFound] is returned if no such job exists.
+func (st *State) ForceJob(ctx context.Context, jUUID string, when time.Time) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	schedule := removalSchedule{
+		UUID:         jUUID,
+		Force:        true,
+		ScheduledFor: when,
+	}
+
+	stmt, err := st.Prepare(`
+UPDATE removal
+SET    force = $removalSchedule.force,
+       scheduled_for = $removalSchedule.scheduled_for
+WHERE  uuid = $removalSchedule.uuid`, schedule)
+	if err != nil {
+		return errors.Errorf("preparing job force update: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, schedule).Get(&outcome); err != nil {
+			return errors.Errorf("forcing removal job: %w", err)
+		}
+
+		affected, err := outcome.Result().RowsAffected()
+		if err != nil {
+			return errors.Errorf("forcing removal job: %w", err)
+		} else if affected == 0 {
+			return errors.Errorf("removal job %q", jUUID).Add(removalerrors.RemovalJobNotFound)
+		}
+		return nil
+	}))
+}
+
 // DeleteJob ensures that a job with the input
 // UUID is not present in the removal table.
 func (st *State) DeleteJob(ctx context.Context, jUUID string) error {
@@ -91,12 +340,20 @@ func (st *State) DeleteJob(ctx context.Context, jUUID string) error {
 
 	jobUUID := entityUUID{UUID: jUUID}
 
+	errStmt, err := st.Prepare("DELETE FROM removal_error WHERE removal_uuid=$entityUUID.uuid", jobUUID)
+	if err != nil {
+		return errors.Errorf("preparing job error deletion: %w", err)
+	}
+
 	stmt, err := st.Prepare("DELETE FROM removal WHERE uuid=$entityUUID.uuid", jobUUID)
 	if err != nil {
 		return errors.Errorf("preparing job deletion: %w", err)
 	}
 
 	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, errStmt, jobUUID).Run(); err != nil {
+			return errors.Errorf("deleting removal error row: %w", err)
+		}
 		if err := tx.Query(ctx, stmt, jobUUID).Run(); err != nil {
 			return errors.Errorf("deleting removal row: %w", err)
 		}
@@ -104,6 +361,69 @@ func (st *State) DeleteJob(ctx context.Context, jUUID string) error {
 	}))
 }
 
+// deleteEntityJobs deletes all of the removal jobs for the entity with the
+// input UUID, along with any errors recorded for them.
+func (st *State) deleteEntityJobs(ctx context.Context, tx *sqlair.TX, eUUID string) error {
+	entityID := entityUUID{UUID: eUUID}
+
+	errStmt, err := st.Prepare(`
+DELETE FROM removal_error
+WHERE  removal_uuid IN (
+    SELECT uuid
+    FROM   removal
+    WHERE  entity_uuid = $entityUUID.uuid
+)`, entityID)
+	if err != nil {
+		return errors.Errorf("preparing job error deletion: %w", err)
+	}
+
+	stmt, err := st.Prepare("DELETE FROM removal WHERE entity_uuid = $entityUUID.uuid", entityID)
+	if err != nil {
+		return errors.Errorf("preparing job deletion: %w", err)
+	}
+
+	if err := tx.Query(ctx, errStmt, entityID).Run(); err != nil {
+		return errors.Errorf("deleting removal error rows: %w", err)
+	}
+	if err := tx.Query(ctx, stmt, entityID).Run(); err != nil {
+		return errors.Errorf("deleting removal rows: %w", err)
+	}
+	return nil
+}
+
+// countForcedEntityJobs returns the number of forced removal jobs for the
+// entity with the input UUID.
+func (st *State) countForcedEntityJobs(ctx context.Context, tx *sqlair.TX, eUUID string) (int, error) {
+	entityID := entityUUID{UUID: eUUID}
+
+	stmt, err := st.Prepare(`
+SELECT COUNT(*) AS &entityCount.count
+FROM   removal
+WHERE  entity_uuid = $entityUUID.uuid
+AND    force = 1`, entityCount{}, entityID)
+	if err != nil {
+		return 0, errors.Errorf("preparing forced job count query: %w", err)
+	}
+
+	var count entityCount
+	if err := tx.Query(ctx, stmt, entityID).Get(&count); err != nil {
+		return 0, errors.Errorf("running forced job count query: %w", err)
+	}
+	return count.Count, nil
+}
+
+func decodeJobArg(arg sql.NullString) (map[string]any, error) {
+	if !arg.Valid || arg.String == "" {
+		return nil, nil
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(arg.String), &decoded); err != nil {
+		return nil, errors.Errorf("decoding job arg: %w", err)
+	}
+	return decoded, nil
+}
+
 // NamespaceForWatchRemovals returns the table name whose UUIDs we
 // are watching in order to be notified of new removal jobs.
 func (st *State) NamespaceForWatchRemovals() string {
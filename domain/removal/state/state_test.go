@@ -27,6 +27,7 @@ import (
 	applicationstate "github.com/juju/juju/domain/application/state"
 	"github.com/juju/juju/domain/life"
 	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 	domaintesting "github.com/juju/juju/domain/testing"
 	"github.com/juju/juju/environs"
@@ -121,6 +122,144 @@ VALUES (?, ?, ?, ?, ?, ?)`
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *stateSuite) TestDeleteJobWithError(c *tc.C) {
+	jID1, _ := removal.NewUUID()
+	s.insertJob(c, jID1, 0, "rel-1", false, time.Now().UTC())
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.SetJobError(c.Context(), jID1.String(), "the front fell off", time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.DeleteJob(c.Context(), jID1.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	row := s.DB().QueryRow("SELECT count(*) FROM removal_error where removal_uuid = ?", jID1)
+	var count int
+	err = row.Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
+
+func (s *stateSuite) TestGetJob(c *tc.C) {
+	jID1, _ := removal.NewUUID()
+	now := time.Now().UTC()
+	s.insertJob(c, jID1, 1, "unit-1", true, now)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	job, err := st.GetJob(c.Context(), jID1.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(job, tc.DeepEquals, removal.Job{
+		UUID:         jID1,
+		RemovalType:  removal.UnitJob,
+		EntityUUID:   "unit-1",
+		Force:        true,
+		ScheduledFor: now,
+	})
+}
+
+func (s *stateSuite) TestGetJobNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	_, err := st.GetJob(c.Context(), "some-job-uuid")
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotFound)
+}
+
+func (s *stateSuite) TestGetAllJobDetailsNoRows(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	jobs, err := st.GetAllJobDetails(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(jobs, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetAllJobDetailsWithErrors(c *tc.C) {
+	jID1, _ := removal.NewUUID()
+	jID2, _ := removal.NewUUID()
+	now := time.Now().UTC()
+	s.insertJob(c, jID1, 0, "rel-1", false, now)
+	s.insertJob(c, jID2, 1, "unit-1", true, now.Add(time.Minute))
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	errAt := now.Add(time.Second)
+	err := st.SetJobError(c.Context(), jID1.String(), "first error", errAt)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A later error replaces the earlier one.
+	err = st.SetJobError(c.Context(), jID1.String(), "the front fell off", errAt.Add(time.Second))
+	c.Assert(err, tc.ErrorIsNil)
+
+	jobs, err := st.GetAllJobDetails(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(jobs, tc.HasLen, 2)
+
+	// The entities don't exist, so have no names.
+	c.Check(jobs[0], tc.DeepEquals, removal.JobDetail{
+		Job: removal.Job{
+			UUID:         jID1,
+			RemovalType:  removal.RelationJob,
+			EntityUUID:   "rel-1",
+			ScheduledFor: now,
+		},
+		LastError:   "the front fell off",
+		LastErrorAt: errAt.Add(time.Second),
+	})
+	c.Check(jobs[1], tc.DeepEquals, removal.JobDetail{
+		Job: removal.Job{
+			UUID:         jID2,
+			RemovalType:  removal.UnitJob,
+			EntityUUID:   "unit-1",
+			Force:        true,
+			ScheduledFor: now.Add(time.Minute),
+		},
+	})
+}
+
+func (s *stateSuite) TestSetJobErrorJobNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.SetJobError(c.Context(), "some-job-uuid", "the front fell off", time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	row := s.DB().QueryRow("SELECT count(*) FROM removal_error")
+	var count int
+	err = row.Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
+
+func (s *stateSuite) TestForceJob(c *tc.C) {
+	jID1, _ := removal.NewUUID()
+	now := time.Now().UTC()
+	s.insertJob(c, jID1, 0, "rel-1", false, now.Add(time.Hour))
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.ForceJob(c.Context(), jID1.String(), now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	job, err := st.GetJob(c.Context(), jID1.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(job.Force, tc.IsTrue)
+	c.Check(job.ScheduledFor, tc.Equals, now)
+}
+
+func (s *stateSuite) TestForceJobNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.ForceJob(c.Context(), "some-job-uuid", time.Now().UTC())
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotFound)
+}
+
+func (s *stateSuite) insertJob(c *tc.C, jUUID removal.UUID, removalType int, entityUUID string, force bool, when time.Time) {
+	_, err := s.DB().Exec(`
+INSERT INTO removal (uuid, removal_type_id, entity_uuid, force, scheduled_for)
+VALUES (?, ?, ?, ?, ?)`, jUUID, removalType, entityUUID, force, when)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 type baseSuite struct {
 	changestreamtesting.ModelSuite
 }
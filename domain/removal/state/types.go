@@ -27,6 +27,62 @@ type removalJob struct {
 	Arg sql.NullString `db:"arg"`
 }
 
+// removalJobDetail represents a record in the removal table, along with the
+// name of the entity being removed and the last error recorded for the job.
+type removalJobDetail struct {
+	// UUID uniquely identifies this removal job.
+	UUID string `db:"uuid"`
+	// RemovalTypeID indicates the type of entity that this removal job is for.
+	RemovalTypeID uint64 `db:"removal_type_id"`
+	// UUID uniquely identifies the domain entity being removed.
+	EntityUUID string `db:"entity_uuid"`
+	// Force indicates whether this removal was qualified with the --force flag.
+	Force bool `db:"force"`
+	// ScheduledFor indicates the earliest date that this job should be executed.
+	ScheduledFor time.Time `db:"scheduled_for"`
+	// Arg is a JSON string representing free-form job argumentation.
+	Arg sql.NullString `db:"arg"`
+	// EntityName is the name of the unit or application being removed.
+	EntityName sql.NullString `db:"entity_name"`
+	// LastError is the last error recorded for the job.
+	LastError sql.NullString `db:"last_error"`
+	// LastErrorAt is the time at which the last error was recorded.
+	LastErrorAt sql.NullTime `db:"last_error_at"`
+}
+
+// removalError represents a record in the removal_error table.
+type removalError struct {
+	// RemovalUUID identifies the removal job that the error is for.
+	RemovalUUID string `db:"removal_uuid"`
+	// Error is the error message.
+	Error string `db:"error"`
+	// UpdatedAt is the time at which the error was recorded.
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// removalSchedule holds the force qualification and the scheduled time of a
+// removal job.
+type removalSchedule struct {
+	// UUID uniquely identifies the removal job.
+	UUID string `db:"uuid"`
+	// Force indicates whether the removal is forced.
+	Force bool `db:"force"`
+	// ScheduledFor indicates the earliest date that the job should be executed.
+	ScheduledFor time.Time `db:"scheduled_for"`
+}
+
+// relationEndpoint identifies an endpoint of a relation.
+type relationEndpoint struct {
+	// RelationUUID uniquely identifies the relation.
+	RelationUUID string `db:"relation_uuid"`
+	// ApplicationName is the name of the application the endpoint belongs to.
+	ApplicationName string `db:"application_name"`
+	// EndpointName is the name of the endpoint.
+	EndpointName string `db:"endpoint_name"`
+	// Role is the role of the endpoint in the relation.
+	Role string `db:"role"`
+}
+
 // entityUUID holds a UUID in string form.
 type entityUUID struct {
 	// UUID uniquely identifies a domain entity.
@@ -57,11 +113,65 @@ type unitMachineLifeSummary struct {
 	MachineParentCount int `db:"machine_parent_count"`
 }
 
+// entityCount holds a count of entities.
+type entityCount struct {
+	// Count counts the number of entities.
+	Count int `db:"count"`
+}
+
 // entityLife holds an entity's life in integer
 type entityLife struct {
 	Life life.Life `db:"life_id"`
 }
 
+// unitRemovalLives holds the lives of a unit, its application and the
+// machine that it is on, if any.
+type unitRemovalLives struct {
+	// UnitLife is the life of the unit.
+	UnitLife life.Life `db:"unit_life_id"`
+	// ApplicationLife is the life of the unit's application.
+	ApplicationLife life.Life `db:"application_life_id"`
+	// MachineLife is the life of the unit's machine. It is not valid
+	// if the unit is not on a machine.
+	MachineLife sql.NullInt64 `db:"machine_life_id"`
+}
+
+// unitTeardown counts the parts of a dying unit's teardown that have
+// already begun.
+type unitTeardown struct {
+	// AttemptedJobs is the number of the unit's removal jobs that have been
+	// executed and failed.
+	AttemptedJobs int `db:"attempted_jobs"`
+	// DyingSubordinates is the number of the unit's subordinates that are
+	// no longer alive.
+	DyingSubordinates int `db:"dying_subordinates"`
+	// DyingStorageAttachments is the number of the unit's storage
+	// attachments that are no longer alive.
+	DyingStorageAttachments int `db:"dying_storage_attachments"`
+	// DepartingRelations is the number of relation scopes that the unit is
+	// departing.
+	DepartingRelations int `db:"departing_relations"`
+}
+
+// unitRemovalAgentStatus holds when the first removal job for a unit was
+// scheduled, and when the unit's agent last reported its status.
+type unitRemovalAgentStatus struct {
+	// ScheduledFor is when the unit's first removal job was scheduled.
+	ScheduledFor time.Time `db:"scheduled_for"`
+	// AgentStatusUpdatedAt is when the unit's agent last reported its
+	// status. It is not valid if the agent has never reported a status.
+	AgentStatusUpdatedAt sql.NullTime `db:"updated_at"`
+}
+
+// applicationRemovalSummary holds the life of an application and the number
+// of units that it has.
+type applicationRemovalSummary struct {
+	// Life is the life of the application.
+	Life life.Life `db:"life_id"`
+	// UnitCount is the number of units of the application.
+	UnitCount int `db:"unit_count"`
+}
+
 // unitUUID holds a unit UUID in string form.
 type unitUUID struct {
 	// UUID uniquely identifies a unit.
@@ -294,6 +294,162 @@ AND    life_id = 1`, unitUUID)
 	}))
 }
 
+// CancelUnitRemoval reverts the life of the dying unit with the input UUID to
+// alive, and deletes all of the removal jobs scheduled for it. The removal can
+// only be cancelled if none of the jobs are forced, if neither the unit's
+// application nor its machine are being removed along with it, and if the
+// removal is still only scheduled: none of the jobs may have been executed,
+// the unit agent may not have acted on the unit dying, and none of the unit's
+// subordinates, storage attachments or relation scopes may be being torn down.
+// [removalerrors.RemovalJobNotCancellable] is returned if this is not the case.
+func (st *State) CancelUnitRemoval(ctx context.Context, uUUID string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	unitUUID := entityUUID{UUID: uUUID}
+	livesStmt, err := st.Prepare(`
+SELECT    u.life_id AS &unitRemovalLives.unit_life_id,
+          a.life_id AS &unitRemovalLives.application_life_id,
+          m.life_id AS &unitRemovalLives.machine_life_id
+FROM      unit AS u
+JOIN      application AS a ON a.uuid = u.application_uuid
+LEFT JOIN machine AS m ON m.net_node_uuid = u.net_node_uuid
+WHERE     u.uuid = $entityUUID.uuid`, unitRemovalLives{}, unitUUID)
+	if err != nil {
+		return errors.Errorf("preparing unit lives query: %w", err)
+	}
+
+	updateStmt, err := st.Prepare(`
+UPDATE unit
+SET    life_id = 0
+WHERE  uuid = $entityUUID.uuid
+AND    life_id = 1`, unitUUID)
+	if err != nil {
+		return errors.Errorf("preparing unit life update: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var lives unitRemovalLives
+		err := tx.Query(ctx, livesStmt, unitUUID).Get(&lives)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return applicationerrors.UnitNotFound
+		} else if err != nil {
+			return errors.Errorf("running unit lives query: %w", err)
+		}
+
+		switch {
+		case lives.UnitLife != life.Dying:
+			return errors.Errorf("unit %q is not dying", uUUID).Add(removalerrors.RemovalJobNotCancellable)
+		case lives.ApplicationLife != life.Alive:
+			return errors.Errorf("application of unit %q is being removed", uUUID).Add(removalerrors.RemovalJobNotCancellable)
+		case lives.MachineLife.Valid && life.Life(lives.MachineLife.Int64) != life.Alive:
+			return errors.Errorf("machine of unit %q is being removed", uUUID).Add(removalerrors.RemovalJobNotCancellable)
+		}
+
+		forced, err := st.countForcedEntityJobs(ctx, tx, uUUID)
+		if err != nil {
+			return errors.Capture(err)
+		} else if forced > 0 {
+			return errors.Errorf("removal of unit %q is forced", uUUID).Add(removalerrors.RemovalJobNotCancellable)
+		}
+
+		if err := st.checkUnitTeardownNotStarted(ctx, tx, uUUID); err != nil {
+			return errors.Capture(err)
+		}
+
+		if err := tx.Query(ctx, updateStmt, unitUUID).Run(); err != nil {
+			return errors.Errorf("reverting unit life: %w", err)
+		}
+
+		return errors.Capture(st.deleteEntityJobs(ctx, tx, uUUID))
+	}))
+}
+
+// checkUnitTeardownNotStarted returns an error satisfying
+// [removalerrors.RemovalJobNotCancellable] if the teardown of the dying unit
+// with the input UUID has begun, either by the removal worker or by the unit
+// agent. Any of these steps can't be undone by reverting the unit to alive.
+func (st *State) checkUnitTeardownNotStarted(ctx context.Context, tx *sqlair.TX, uUUID string) error {
+	unitUUID := entityUUID{UUID: uUUID}
+
+	teardownStmt, err := st.Prepare(`
+WITH attempted AS (
+    SELECT COUNT(*) AS count
+    FROM   removal AS r
+    JOIN   removal_error AS re ON re.removal_uuid = r.uuid
+    WHERE  r.entity_uuid = $entityUUID.uuid
+), subordinates AS (
+    SELECT COUNT(*) AS count
+    FROM   unit_principal AS up
+    JOIN   unit AS u ON u.uuid = up.unit_uuid
+    WHERE  up.principal_uuid = $entityUUID.uuid
+    AND    u.life_id != 0
+), storage AS (
+    SELECT COUNT(*) AS count
+    FROM   storage_attachment
+    WHERE  unit_uuid = $entityUUID.uuid
+    AND    life_id != 0
+), relations AS (
+    SELECT COUNT(*) AS count
+    FROM   relation_unit
+    WHERE  unit_uuid = $entityUUID.uuid
+    AND    departing = TRUE
+)
+SELECT attempted.count AS &unitTeardown.attempted_jobs,
+       subordinates.count AS &unitTeardown.dying_subordinates,
+       storage.count AS &unitTeardown.dying_storage_attachments,
+       relations.count AS &unitTeardown.departing_relations
+FROM   attempted, subordinates, storage, relations`, unitTeardown{}, unitUUID)
+	if err != nil {
+		return errors.Errorf("preparing unit teardown query: %w", err)
+	}
+
+	agentStmt, err := st.Prepare(`
+SELECT    r.scheduled_for AS &unitRemovalAgentStatus.scheduled_for,
+          uas.updated_at AS &unitRemovalAgentStatus.updated_at
+FROM      removal AS r
+LEFT JOIN unit_agent_status AS uas ON uas.unit_uuid = r.entity_uuid
+WHERE     r.entity_uuid = $entityUUID.uuid
+ORDER BY  r.scheduled_for
+LIMIT     1`, unitRemovalAgentStatus{}, unitUUID)
+	if err != nil {
+		return errors.Errorf("preparing unit agent status query: %w", err)
+	}
+
+	var teardown unitTeardown
+	if err := tx.Query(ctx, teardownStmt, unitUUID).Get(&teardown); err != nil {
+		return errors.Errorf("running unit teardown query: %w", err)
+	}
+
+	switch {
+	case teardown.AttemptedJobs > 0:
+		return errors.Errorf("removal of unit %q has already been attempted", uUUID).Add(removalerrors.RemovalJobNotCancellable)
+	case teardown.DyingSubordinates > 0:
+		return errors.Errorf("subordinates of unit %q are being removed", uUUID).Add(removalerrors.RemovalJobNotCancellable)
+	case teardown.DyingStorageAttachments > 0:
+		return errors.Errorf("storage of unit %q is being detached", uUUID).Add(removalerrors.RemovalJobNotCancellable)
+	case teardown.DepartingRelations > 0:
+		return errors.Errorf("unit %q is departing its relations", uUUID).Add(removalerrors.RemovalJobNotCancellable)
+	}
+
+	// The unit agent reports its status as it runs the hooks for the unit
+	// dying, so any status reported since the removal was scheduled means
+	// that the agent may have started tearing the unit down.
+	var agent unitRemovalAgentStatus
+	err = tx.Query(ctx, agentStmt, unitUUID).Get(&agent)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return errors.Errorf("running unit agent status query: %w", err)
+	}
+	if agent.AgentStatusUpdatedAt.Valid && !agent.AgentStatusUpdatedAt.Time.Before(agent.ScheduledFor) {
+		return errors.Errorf("agent of unit %q has acknowledged the unit dying", uUUID).Add(removalerrors.RemovalJobNotCancellable)
+	}
+	return nil
+}
+
 // DeleteUnit removes a unit from the database completely.
 func (st *State) DeleteUnit(ctx context.Context, unitUUID string) error {
 	db, err := st.DB()
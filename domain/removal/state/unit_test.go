@@ -542,6 +542,220 @@ func (s *unitSuite) TestGetApplicationNameAndUnitNameByUnitUUIDNotFound(c *tc.C)
 	c.Assert(err, tc.ErrorIs, applicationerrors.UnitNotFound)
 }
 
+func (s *unitSuite) TestCancelUnitRemoval(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "pelican")
+	svc := s.setupService(c, factory)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(len(unitUUIDs), tc.Equals, 1)
+	unitUUID := unitUUIDs[0]
+
+	s.advanceUnitLife(c, unitUUID, life.Dying)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.UnitScheduleRemoval(c.Context(), "removal-uuid", unitUUID.String(), false, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CancelUnitRemoval(c.Context(), unitUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	l, err := st.GetUnitLife(c.Context(), unitUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(l, tc.Equals, life.Alive)
+
+	jobs, err := st.GetAllJobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(jobs, tc.HasLen, 0)
+}
+
+func (s *unitSuite) TestCancelUnitRemovalMachineDying(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "pelican")
+	svc := s.setupService(c, factory)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(len(unitUUIDs), tc.Equals, 1)
+	unitUUID := unitUUIDs[0]
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	// This is the last unit on the machine, so the machine is also
+	// set to dying.
+	_, err := st.EnsureUnitNotAliveCascade(c.Context(), unitUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.UnitScheduleRemoval(c.Context(), "removal-uuid", unitUUID.String(), false, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CancelUnitRemoval(c.Context(), unitUUID.String())
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotCancellable)
+
+	l, err := st.GetUnitLife(c.Context(), unitUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(l, tc.Equals, life.Dying)
+}
+
+func (s *unitSuite) TestCancelUnitRemovalForced(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "pelican")
+	svc := s.setupService(c, factory)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(len(unitUUIDs), tc.Equals, 1)
+	unitUUID := unitUUIDs[0]
+
+	s.advanceUnitLife(c, unitUUID, life.Dying)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.UnitScheduleRemoval(c.Context(), "removal-uuid", unitUUID.String(), false, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.UnitScheduleRemoval(c.Context(), "forced-removal-uuid", unitUUID.String(), true, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CancelUnitRemoval(c.Context(), unitUUID.String())
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotCancellable)
+}
+
+func (s *unitSuite) TestCancelUnitRemovalAttempted(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "pelican")
+	svc := s.setupService(c, factory)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(len(unitUUIDs), tc.Equals, 1)
+	unitUUID := unitUUIDs[0]
+
+	s.advanceUnitLife(c, unitUUID, life.Dying)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.UnitScheduleRemoval(c.Context(), "removal-uuid", unitUUID.String(), false, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.SetJobError(c.Context(), "removal-uuid", "the front fell off", time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CancelUnitRemoval(c.Context(), unitUUID.String())
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotCancellable)
+
+	l, err := st.GetUnitLife(c.Context(), unitUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(l, tc.Equals, life.Dying)
+}
+
+func (s *unitSuite) TestCancelUnitRemovalAgentAcknowledged(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "pelican")
+	svc := s.setupService(c, factory)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(len(unitUUIDs), tc.Equals, 1)
+	unitUUID := unitUUIDs[0]
+
+	s.advanceUnitLife(c, unitUUID, life.Dying)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	scheduled := time.Now().UTC()
+	err := st.UnitScheduleRemoval(c.Context(), "removal-uuid", unitUUID.String(), false, scheduled)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The agent reports that it's running the hooks for the unit dying.
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO unit_agent_status (unit_uuid, status_id, message, updated_at)
+VALUES (?, 1, 'running stop hook', ?)
+ON CONFLICT (unit_uuid) DO UPDATE SET
+    status_id = excluded.status_id,
+    message = excluded.message,
+    updated_at = excluded.updated_at`, unitUUID.String(), scheduled.Add(time.Second))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CancelUnitRemoval(c.Context(), unitUUID.String())
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotCancellable)
+}
+
+func (s *unitSuite) TestCancelUnitRemovalDepartingRelation(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "pelican")
+	svc := s.setupService(c, factory)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(len(unitUUIDs), tc.Equals, 1)
+	unitUUID := unitUUIDs[0]
+
+	s.advanceUnitLife(c, unitUUID, life.Dying)
+
+	// Foreign keys are not enforced against the relation endpoint here, as
+	// only the unit's scope matters.
+	_, err := s.DB().ExecContext(c.Context(), `PRAGMA foreign_keys = OFF`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO relation_unit (uuid, relation_endpoint_uuid, unit_uuid, departing)
+VALUES ('relation-unit-uuid', 'relation-endpoint-uuid', ?, TRUE)`, unitUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().ExecContext(c.Context(), `PRAGMA foreign_keys = ON`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err = st.UnitScheduleRemoval(c.Context(), "removal-uuid", unitUUID.String(), false, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CancelUnitRemoval(c.Context(), unitUUID.String())
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotCancellable)
+}
+
+func (s *unitSuite) TestCancelUnitRemovalNotDying(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "pelican")
+	svc := s.setupService(c, factory)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(len(unitUUIDs), tc.Equals, 1)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.CancelUnitRemoval(c.Context(), unitUUIDs[0].String())
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotCancellable)
+}
+
+func (s *unitSuite) TestCancelUnitRemovalNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.CancelUnitRemoval(c.Context(), "some-unit-uuid")
+	c.Assert(err, tc.ErrorIs, applicationerrors.UnitNotFound)
+}
+
+func (s *unitSuite) TestGetAllJobDetailsUnit(c *tc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "pelican")
+	svc := s.setupService(c, factory)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(len(unitUUIDs), tc.Equals, 1)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.UnitScheduleRemoval(c.Context(), "removal-uuid", unitUUIDs[0].String(), false, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.ApplicationScheduleRemoval(c.Context(), "app-removal-uuid", appUUID.String(), false, time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	jobs, err := st.GetAllJobDetails(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(jobs, tc.HasLen, 2)
+
+	names := map[string]string{}
+	for _, job := range jobs {
+		names[job.UUID.String()] = job.EntityName
+	}
+	c.Check(names, tc.DeepEquals, map[string]string{
+		"removal-uuid":     "some-app/0",
+		"app-removal-uuid": "some-app",
+	})
+}
+
 func (s *unitSuite) expectK8sPodCount(c *tc.C, unitUUID unit.UUID, expected int) {
 	var count int
 	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
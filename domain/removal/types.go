@@ -17,6 +17,21 @@ const (
 	ApplicationJob
 )
 
+// String returns the name of the type of entity that the job is for,
+// matching the names in the removal_type table.
+func (t JobType) String() string {
+	switch t {
+	case RelationJob:
+		return "relation"
+	case UnitJob:
+		return "unit"
+	case ApplicationJob:
+		return "application"
+	default:
+		return "unknown"
+	}
+}
+
 // Job is a removal job for a single entity.
 type Job struct {
 	// UUID uniquely identifies this removal job.
@@ -32,3 +47,17 @@ type Job struct {
 	// Arg is free form job configuration.
 	Arg map[string]any
 }
+
+// JobDetail describes a removal job along with the entity that it is
+// removing and the outcome of its last execution.
+type JobDetail struct {
+	Job
+	// EntityName is the human readable identifier of the entity being
+	// removed. It is empty if the entity no longer exists.
+	EntityName string
+	// LastError is the error returned by the last failed execution of the
+	// job. It is empty if the job has not failed.
+	LastError string
+	// LastErrorAt is the time at which the last error was recorded.
+	LastErrorAt time.Time
+}
@@ -0,0 +1,4 @@
+-- The file secret backend stores envelope encrypted secret content in a
+-- directory, with the key encryption keys held by the controllers.
+INSERT INTO secret_backend_type VALUES
+(3, 'file', 'the encrypted file secret backend');
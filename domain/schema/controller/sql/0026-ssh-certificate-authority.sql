@@ -0,0 +1,12 @@
+-- The SSH certificate authority of the controller signs the short-lived
+-- user certificates that grant access to the machines of a model. The
+-- machines trust the public key of the authority.
+CREATE TABLE ssh_certificate_authority (
+    private_key TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+-- A unique constraint over a constant index ensures only 1 entry matching the
+-- condition can exist.
+CREATE UNIQUE INDEX idx_singleton_ssh_certificate_authority ON ssh_certificate_authority ((1));
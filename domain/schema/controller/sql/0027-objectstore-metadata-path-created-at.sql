@@ -0,0 +1,5 @@
+-- created_at is the time the path was added, so that objects which have only
+-- just been stored aren't considered unreferenced before the entity
+-- referencing them has been added. Paths added before the column existed
+-- have no creation time.
+ALTER TABLE object_store_metadata_path ADD COLUMN created_at DATETIME;
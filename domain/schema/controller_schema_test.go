@@ -131,6 +131,9 @@ func (s *controllerSchemaSuite) TestControllerTables(c *tc.C) {
 		"bakery_config",
 		"macaroon_root_key",
 
+		// SSH certificate authority
+		"ssh_certificate_authority",
+
 		// cloud image metadata
 		"architecture",
 		"cloud_image_metadata",
@@ -0,0 +1,26 @@
+-- status_history is an append-only, indexed record of the status changes
+-- made to the entities of the model. Records are removed by age and by total
+-- size by the status history pruner.
+CREATE TABLE status_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    -- kind is the status history kind, i.e. unit, workload, juju-unit,
+    -- application, machine, machine-instance, etc.
+    kind TEXT NOT NULL,
+    -- namespace_id identifies the entity within the kind, e.g. a unit name.
+    namespace_id TEXT NOT NULL,
+    status TEXT NOT NULL,
+    message TEXT,
+    -- JSON encoded status data.
+    data TEXT,
+    -- The time the status was set, rather than the time it was recorded.
+    created_at DATETIME NOT NULL,
+    -- size is the approximate size of the record in bytes, used to enforce
+    -- the size based retention of the status history.
+    size INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_status_history_kind_namespace_created_at
+ON status_history (kind, namespace_id, created_at);
+
+CREATE INDEX idx_status_history_created_at
+ON status_history (created_at);
@@ -0,0 +1,12 @@
+-- removal_error records the last error returned when executing a removal
+-- job, so that removals that aren't progressing can be diagnosed. It is
+-- kept apart from the removal table so that recording an error doesn't
+-- cause the job to be rescheduled by the removal worker.
+CREATE TABLE removal_error (
+    removal_uuid TEXT NOT NULL PRIMARY KEY,
+    error TEXT NOT NULL,
+    updated_at DATETIME NOT NULL,
+    CONSTRAINT fk_removal_error_removal
+    FOREIGN KEY (removal_uuid)
+    REFERENCES removal (uuid)
+);
@@ -0,0 +1,53 @@
+-- Blocks record who created them and when, and may optionally expire. An
+-- expired block no longer prevents any operation and is replaced when a new
+-- block of the same type is switched on.
+ALTER TABLE block_command ADD COLUMN created_by TEXT;
+ALTER TABLE block_command ADD COLUMN created_at DATETIME;
+ALTER TABLE block_command ADD COLUMN expires_at DATETIME;
+
+-- block_command_application scopes a block to the named applications. A
+-- block without any applications applies to the whole model. Applications
+-- are referenced by name so that a block can be put in place before the
+-- application is deployed.
+CREATE TABLE block_command_application (
+    block_command_uuid TEXT NOT NULL,
+    application_name TEXT NOT NULL,
+    CONSTRAINT fk_block_command_application_block_command
+    FOREIGN KEY (block_command_uuid)
+    REFERENCES block_command (uuid),
+    PRIMARY KEY (block_command_uuid, application_name)
+);
+
+-- block_command_method scopes a block to the named facade methods, in the
+-- form "Facade.Method" or "Facade.*". A block without any methods applies to
+-- every operation of its type.
+CREATE TABLE block_command_method (
+    block_command_uuid TEXT NOT NULL,
+    method TEXT NOT NULL,
+    CONSTRAINT fk_block_command_method_block_command
+    FOREIGN KEY (block_command_uuid)
+    REFERENCES block_command (uuid),
+    PRIMARY KEY (block_command_uuid, method)
+);
+
+-- block_command_exempt_user lists the users that are not subject to a block.
+CREATE TABLE block_command_exempt_user (
+    block_command_uuid TEXT NOT NULL,
+    user_name TEXT NOT NULL,
+    CONSTRAINT fk_block_command_exempt_user_block_command
+    FOREIGN KEY (block_command_uuid)
+    REFERENCES block_command (uuid),
+    PRIMARY KEY (block_command_uuid, user_name)
+);
+
+-- block_command_exempt_role lists the model access levels that are not
+-- subject to a block. A user with at least the given access on the model is
+-- exempt.
+CREATE TABLE block_command_exempt_role (
+    block_command_uuid TEXT NOT NULL,
+    access TEXT NOT NULL,
+    CONSTRAINT fk_block_command_exempt_role_block_command
+    FOREIGN KEY (block_command_uuid)
+    REFERENCES block_command (uuid),
+    PRIMARY KEY (block_command_uuid, access)
+);
@@ -0,0 +1,38 @@
+-- secret_generator records how new content is generated for a user secret
+-- each time it is rotated by the controller. The generator attributes which
+-- apply depend on the generator type: length and charset for passwords,
+-- common_name and validity (in seconds) for TLS certificates.
+-- keep_revisions, if set, is how many of the latest revisions are retained
+-- once older revisions are no longer being tracked by any consumer.
+CREATE TABLE secret_generator_type (
+    id INT PRIMARY KEY,
+    type TEXT NOT NULL,
+    CONSTRAINT chk_empty_type
+    CHECK (type != '')
+);
+
+CREATE UNIQUE INDEX idx_secret_generator_type_type
+ON secret_generator_type (type);
+
+INSERT INTO secret_generator_type VALUES
+(0, 'password'),
+(1, 'ssh-key'),
+(2, 'tls-cert');
+
+CREATE TABLE secret_generator (
+    secret_id TEXT NOT NULL PRIMARY KEY,
+    generator_type_id INT NOT NULL,
+    length INT,
+    charset TEXT,
+    common_name TEXT,
+    validity INT,
+    keep_revisions INT,
+    CONSTRAINT fk_secret_generator_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id),
+    CONSTRAINT fk_secret_generator_type
+    FOREIGN KEY (generator_type_id)
+    REFERENCES secret_generator_type (id),
+    CONSTRAINT chk_keep_revisions
+    CHECK (keep_revisions IS NULL OR keep_revisions > 0)
+);
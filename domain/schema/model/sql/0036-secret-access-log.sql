@@ -0,0 +1,41 @@
+-- secret_accessor_type is the kind of entity which attempted to read a secret.
+CREATE TABLE secret_accessor_type (
+    id INT PRIMARY KEY,
+    type TEXT NOT NULL,
+    CONSTRAINT chk_empty_type
+    CHECK (type != '')
+);
+
+CREATE UNIQUE INDEX idx_secret_accessor_type_type
+ON secret_accessor_type (type);
+
+INSERT INTO secret_accessor_type VALUES
+(0, 'unit'),
+(1, 'application'),
+(2, 'model'),
+(3, 'remote-application'),
+(4, 'user');
+
+-- secret_access_log is an append-only record of the attempts made to read
+-- the content of a secret revision, whether or not access was granted. It
+-- has no foreign key to the secret tables so that the history of a secret
+-- remains available after the secret is deleted. Records are removed by age
+-- by the secret access log pruner.
+CREATE TABLE secret_access_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    secret_id TEXT NOT NULL,
+    revision INT NOT NULL,
+    accessor_type_id INT NOT NULL,
+    accessor_id TEXT NOT NULL,
+    accessed_at DATETIME NOT NULL,
+    denied BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT fk_secret_access_log_accessor_type
+    FOREIGN KEY (accessor_type_id)
+    REFERENCES secret_accessor_type (id)
+);
+
+CREATE INDEX idx_secret_access_log_secret_id_accessed_at
+ON secret_access_log (secret_id, accessed_at);
+
+CREATE INDEX idx_secret_access_log_accessed_at
+ON secret_access_log (accessed_at);
@@ -0,0 +1,65 @@
+CREATE TABLE storage_volume_snapshot_status_value (
+    id INT PRIMARY KEY,
+    status TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_storage_volume_snapshot_status_value
+ON storage_volume_snapshot_status_value (status);
+
+INSERT INTO storage_volume_snapshot_status_value VALUES
+(0, 'pending'),
+(1, 'error'),
+(2, 'available'),
+(3, 'destroying');
+
+-- storage_volume_snapshot records a point-in-time copy of the volume backing
+-- a storage instance, taken by the storage provider. The storage instance and
+-- volume are recorded by their ids rather than by foreign key, so that a
+-- snapshot outlives the storage it was taken from and can be used to seed
+-- new storage after the original has been removed.
+CREATE TABLE storage_volume_snapshot (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    storage_id TEXT NOT NULL,
+    volume_id TEXT NOT NULL,
+    volume_tag TEXT NOT NULL,
+    -- machine_id is set for snapshots of machine-scoped volumes, which
+    -- are taken, stored and removed by the storage provisioner running
+    -- on that machine rather than by the controller.
+    machine_id TEXT,
+    -- The pool is recorded by name, as with storage directives the
+    -- snapshot may have been taken from storage in a provider's
+    -- default pool, which has no storage_pool row.
+    storage_pool TEXT NOT NULL,
+    life_id INT NOT NULL,
+    -- provider_id is the storage provider's unique ID for the snapshot,
+    -- set once the snapshot has been taken.
+    provider_id TEXT,
+    size_mib INT NOT NULL,
+    created_at DATETIME NOT NULL,
+    CONSTRAINT chk_storage_volume_snapshot_storage_id_not_empty
+    CHECK (storage_id <> ''),
+    CONSTRAINT chk_storage_volume_snapshot_storage_pool_not_empty
+    CHECK (storage_pool <> ''),
+    CONSTRAINT fk_storage_volume_snapshot_life
+    FOREIGN KEY (life_id)
+    REFERENCES life (id)
+);
+
+CREATE INDEX idx_storage_volume_snapshot_storage_id
+ON storage_volume_snapshot (storage_id);
+
+CREATE INDEX idx_storage_volume_snapshot_machine_id
+ON storage_volume_snapshot (machine_id);
+
+CREATE TABLE storage_volume_snapshot_status (
+    snapshot_uuid TEXT NOT NULL PRIMARY KEY,
+    status_id INT NOT NULL,
+    message TEXT,
+    updated_at DATETIME,
+    CONSTRAINT fk_storage_volume_snapshot_status_snapshot
+    FOREIGN KEY (snapshot_uuid)
+    REFERENCES storage_volume_snapshot (uuid),
+    CONSTRAINT fk_storage_volume_snapshot_status_status
+    FOREIGN KEY (status_id)
+    REFERENCES storage_volume_snapshot_status_value (id)
+);
@@ -0,0 +1,4 @@
+-- hook_timeout overrides the model's hook-timeout config for the units of
+-- the application. It is stored in nanoseconds; a NULL value means that the
+-- model's hook timeout applies and zero means that hooks are not timed out.
+ALTER TABLE application_setting ADD COLUMN hook_timeout INT;
@@ -0,0 +1,28 @@
+-- charm_schedule holds the named schedules declared by a charm. The
+-- scheduled hook is run for each of the units of an application using the
+-- charm, whenever the cron expression of a schedule is activated. Up to the
+-- jitter, stored in nanoseconds, is randomly added to each activation.
+CREATE TABLE charm_schedule (
+    charm_uuid TEXT NOT NULL,
+    name TEXT NOT NULL,
+    cron TEXT NOT NULL,
+    jitter INT NOT NULL DEFAULT 0,
+    CONSTRAINT fk_charm_schedule_charm
+    FOREIGN KEY (charm_uuid)
+    REFERENCES charm (uuid),
+    PRIMARY KEY (charm_uuid, name)
+);
+
+-- application_schedule holds the operator overrides of the schedules
+-- declared by the charm of an application. A NULL cron or jitter means that
+-- the value declared by the charm applies.
+CREATE TABLE application_schedule (
+    application_uuid TEXT NOT NULL,
+    name TEXT NOT NULL,
+    cron TEXT,
+    jitter INT,
+    CONSTRAINT fk_application_schedule_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid),
+    PRIMARY KEY (application_uuid, name)
+);
@@ -0,0 +1,44 @@
+-- created_at is the time the path was added, so that objects which have only
+-- just been stored aren't considered unreferenced before the entity
+-- referencing them has been added. Paths added before the column existed
+-- have no creation time.
+ALTER TABLE object_store_metadata_path ADD COLUMN created_at DATETIME;
+
+-- Each domain which references objects in the object store registers them
+-- with a view named v_object_store_reference_<kind>, with the columns
+-- object_store_uuid, kind and name. Objects that aren't referenced by any of
+-- these views, or by any table with a foreign key to object_store_metadata,
+-- can be pruned.
+
+-- v_object_store_reference_charm registers the charm archives held in the
+-- object store as references.
+CREATE VIEW v_object_store_reference_charm AS
+SELECT
+    c.object_store_uuid,
+    'charm' AS kind,
+    c.reference_name || '-' || c.revision AS name
+FROM charm AS c
+WHERE c.object_store_uuid IS NOT NULL;
+
+-- v_object_store_reference_resource registers the file resources held in
+-- the object store as references. Resources that aren't in use by an
+-- application yet are only known by their name.
+CREATE VIEW v_object_store_reference_resource AS
+SELECT
+    rfs.store_uuid AS object_store_uuid,
+    'resource' AS kind,
+    COALESCE(a.name || '/', '') || r.charm_resource_name AS name
+FROM resource_file_store AS rfs
+JOIN resource AS r ON rfs.resource_uuid = r.uuid
+LEFT JOIN application_resource AS ar ON r.uuid = ar.resource_uuid
+LEFT JOIN application AS a ON ar.application_uuid = a.uuid;
+
+-- v_object_store_reference_agent_binary registers the agent binaries held in
+-- the object store as references.
+CREATE VIEW v_object_store_reference_agent_binary AS
+SELECT
+    abs.object_store_uuid,
+    'agent-binary' AS kind,
+    abs.version || '-' || a.name AS name
+FROM agent_binary_store AS abs
+JOIN architecture AS a ON abs.architecture_id = a.id;
@@ -0,0 +1,11 @@
+-- v_object_store_reference_ssh_recording registers the recordings of
+-- interactive SSH sessions held in the object store as references. The
+-- recordings aren't referenced by any entity, so they are identified by
+-- their path, named without the ssh-recordings/ prefix.
+CREATE VIEW v_object_store_reference_ssh_recording AS
+SELECT
+    osmp.metadata_uuid AS object_store_uuid,
+    'ssh-recording' AS kind,
+    SUBSTR(osmp.path, LENGTH('ssh-recordings/') + 1) AS name
+FROM object_store_metadata_path AS osmp
+WHERE osmp.path LIKE 'ssh-recordings/%';
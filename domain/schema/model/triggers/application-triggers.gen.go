@@ -198,6 +198,44 @@ END;`, columnName, namespaceID))
 	}
 }
 
+// ChangeLogTriggersForApplicationSchedule generates the triggers for the
+// application_schedule table.
+func ChangeLogTriggersForApplicationSchedule(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for ApplicationSchedule
+INSERT INTO change_log_namespace VALUES (%[2]d, 'application_schedule', 'ApplicationSchedule changes based on %[1]s');
+
+-- insert trigger for ApplicationSchedule
+CREATE TRIGGER trg_log_application_schedule_insert
+AFTER INSERT ON application_schedule FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now'));
+END;
+
+-- update trigger for ApplicationSchedule
+CREATE TRIGGER trg_log_application_schedule_update
+AFTER UPDATE ON application_schedule FOR EACH ROW
+WHEN 
+	NEW.application_uuid != OLD.application_uuid OR
+	NEW.name != OLD.name OR
+	(NEW.cron != OLD.cron OR (NEW.cron IS NOT NULL AND OLD.cron IS NULL) OR (NEW.cron IS NULL AND OLD.cron IS NOT NULL)) OR
+	(NEW.jitter != OLD.jitter OR (NEW.jitter IS NOT NULL AND OLD.jitter IS NULL) OR (NEW.jitter IS NULL AND OLD.jitter IS NOT NULL)) 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;
+-- delete trigger for ApplicationSchedule
+CREATE TRIGGER trg_log_application_schedule_delete
+AFTER DELETE ON application_schedule FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;`, columnName, namespaceID))
+	}
+}
+
 // ChangeLogTriggersForApplicationSetting generates the triggers for the
 // application_setting table.
 func ChangeLogTriggersForApplicationSetting(columnName string, namespaceID int) func() schema.Patch {
@@ -219,7 +257,8 @@ CREATE TRIGGER trg_log_application_setting_update
 AFTER UPDATE ON application_setting FOR EACH ROW
 WHEN 
 	NEW.application_uuid != OLD.application_uuid OR
-	(NEW.trust != OLD.trust OR (NEW.trust IS NOT NULL AND OLD.trust IS NULL) OR (NEW.trust IS NULL AND OLD.trust IS NOT NULL)) 
+	(NEW.trust != OLD.trust OR (NEW.trust IS NOT NULL AND OLD.trust IS NULL) OR (NEW.trust IS NULL AND OLD.trust IS NOT NULL)) OR
+	(NEW.hook_timeout != OLD.hook_timeout OR (NEW.hook_timeout IS NOT NULL AND OLD.hook_timeout IS NULL) OR (NEW.hook_timeout IS NULL AND OLD.hook_timeout IS NOT NULL)) 
 BEGIN
     INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
     VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
@@ -30,7 +30,8 @@ CREATE TRIGGER trg_log_object_store_metadata_path_update
 AFTER UPDATE ON object_store_metadata_path FOR EACH ROW
 WHEN 
 	NEW.path != OLD.path OR
-	NEW.metadata_uuid != OLD.metadata_uuid 
+	NEW.metadata_uuid != OLD.metadata_uuid OR
+	NEW.created_at != OLD.created_at 
 BEGIN
     INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
     VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
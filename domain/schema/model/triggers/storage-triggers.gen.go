@@ -0,0 +1,55 @@
+// Code generated by triggergen. DO NOT EDIT.
+
+package triggers
+
+import (
+	"fmt"
+
+	"github.com/juju/juju/core/database/schema"
+)
+
+
+// ChangeLogTriggersForStorageVolumeSnapshot generates the triggers for the
+// storage_volume_snapshot table.
+func ChangeLogTriggersForStorageVolumeSnapshot(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for StorageVolumeSnapshot
+INSERT INTO change_log_namespace VALUES (%[2]d, 'storage_volume_snapshot', 'StorageVolumeSnapshot changes based on %[1]s');
+
+-- insert trigger for StorageVolumeSnapshot
+CREATE TRIGGER trg_log_storage_volume_snapshot_insert
+AFTER INSERT ON storage_volume_snapshot FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now'));
+END;
+
+-- update trigger for StorageVolumeSnapshot
+CREATE TRIGGER trg_log_storage_volume_snapshot_update
+AFTER UPDATE ON storage_volume_snapshot FOR EACH ROW
+WHEN 
+	NEW.uuid != OLD.uuid OR
+	NEW.storage_id != OLD.storage_id OR
+	NEW.volume_id != OLD.volume_id OR
+	NEW.volume_tag != OLD.volume_tag OR
+	(NEW.machine_id != OLD.machine_id OR (NEW.machine_id IS NOT NULL AND OLD.machine_id IS NULL) OR (NEW.machine_id IS NULL AND OLD.machine_id IS NOT NULL)) OR
+	NEW.storage_pool != OLD.storage_pool OR
+	NEW.life_id != OLD.life_id OR
+	(NEW.provider_id != OLD.provider_id OR (NEW.provider_id IS NOT NULL AND OLD.provider_id IS NULL) OR (NEW.provider_id IS NULL AND OLD.provider_id IS NOT NULL)) OR
+	NEW.size_mib != OLD.size_mib OR
+	NEW.created_at != OLD.created_at 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;
+-- delete trigger for StorageVolumeSnapshot
+CREATE TRIGGER trg_log_storage_volume_snapshot_delete
+AFTER DELETE ON storage_volume_snapshot FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;`, columnName, namespaceID))
+	}
+}
+
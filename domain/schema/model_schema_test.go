@@ -38,6 +38,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"application_exposed_endpoint_space",
 		"application_platform",
 		"application_scale",
+		"application_schedule",
 		"application_setting",
 		"application_status",
 		"application_workload_version",
@@ -58,6 +59,10 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 
 		// Block commands
 		"block_command",
+		"block_command_application",
+		"block_command_exempt_role",
+		"block_command_exempt_user",
+		"block_command_method",
 		"block_command_type",
 
 		// Life
@@ -186,6 +191,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"charm_resource_kind",
 		"charm_resource",
 		"charm_run_as_kind",
+		"charm_schedule",
 		"charm_source",
 		"charm_storage_kind",
 		"charm_storage_property",
@@ -246,6 +252,9 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"storage_volume_attachment",
 		"storage_volume_device_type",
 		"storage_volume",
+		"storage_volume_snapshot",
+		"storage_volume_snapshot_status",
+		"storage_volume_snapshot_status_value",
 		"storage_volume_status",
 		"storage_volume_status_value",
 		"unit_storage_directive",
@@ -271,6 +280,10 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"secret_role",
 		"secret_grant_subject_type",
 		"secret_grant_scope_type",
+		"secret_generator_type",
+		"secret_generator",
+		"secret_accessor_type",
+		"secret_access_log",
 
 		// Opened Ports
 		"protocol",
@@ -292,12 +305,16 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		// Cleanup
 		"removal_type",
 		"removal",
+		"removal_error",
 
 		// Sequence
 		"sequence",
 
 		// Agent binary store.
 		"agent_binary_store",
+
+		// Status history
+		"status_history",
 	)
 	got := readEntityNames(c, s.DB(), "table")
 	wanted := expected.Union(internalTableNames)
@@ -352,6 +369,10 @@ func (s *modelSchemaSuite) TestModelViews(c *tc.C) {
 		"v_model_constraint",
 		"v_model_metrics",
 		"v_object_store_metadata",
+		"v_object_store_reference_agent_binary",
+		"v_object_store_reference_charm",
+		"v_object_store_reference_resource",
+		"v_object_store_reference_ssh_recording",
 		"v_port_range",
 		"v_relation_endpoint",
 		"v_relation_endpoint_identifier",
@@ -401,6 +422,9 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_log_application_config_hash_insert",
 		"trg_log_application_config_hash_update",
 
+		"trg_log_application_schedule_delete",
+		"trg_log_application_schedule_insert",
+		"trg_log_application_schedule_update",
 		"trg_log_application_setting_delete",
 		"trg_log_application_setting_insert",
 		"trg_log_application_setting_update",
@@ -525,6 +549,10 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_log_storage_volume_attachment_plan_update_life_machine_provisioning",
 		"trg_log_storage_volume_attachment_plan_delete_life_machine_provisioning",
 
+		"trg_log_storage_volume_snapshot_delete",
+		"trg_log_storage_volume_snapshot_insert",
+		"trg_log_storage_volume_snapshot_update",
+
 		"trg_log_subnet_delete",
 		"trg_log_subnet_insert",
 		"trg_log_subnet_update",
@@ -590,6 +618,7 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_charm_metadata_immutable_update",
 		"trg_charm_relation_immutable_update",
 		"trg_charm_resource_immutable_update",
+		"trg_charm_schedule_immutable_update",
 		"trg_charm_storage_immutable_update",
 		"trg_charm_term_immutable_update",
 
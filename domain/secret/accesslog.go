@@ -0,0 +1,49 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import (
+	"time"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+)
+
+// AccessorType represents the type of an entity which attempted to read
+// a secret as recorded in the secret_accessor_type lookup table.
+type AccessorType int
+
+const (
+	AccessorUnit AccessorType = iota
+	AccessorApplication
+	AccessorModel
+	AccessorRemoteApplication
+	AccessorUser
+)
+
+// String implements fmt.Stringer.
+func (t AccessorType) String() string {
+	switch t {
+	case AccessorUnit:
+		return "unit"
+	case AccessorApplication:
+		return "application"
+	case AccessorModel:
+		return "model"
+	case AccessorRemoteApplication:
+		return "remote-application"
+	case AccessorUser:
+		return "user"
+	}
+	return ""
+}
+
+// AccessLogEntry records an attempt to read the content of a secret revision.
+type AccessLogEntry struct {
+	URI            *coresecrets.URI
+	Revision       int
+	AccessorTypeID AccessorType
+	AccessorID     string
+	AccessTime     time.Time
+	Denied         bool
+}
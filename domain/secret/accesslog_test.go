@@ -0,0 +1,50 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type accessorTypeSuite struct {
+	schematesting.ModelSuite
+}
+
+func TestAccessorTypeSuite(t *testing.T) {
+	tc.Run(t, &accessorTypeSuite{})
+}
+
+// TestAccessorTypeDBValues ensures there's no skew between what's in the
+// database table for accessor types and the typed consts used in the state packages.
+func (s *accessorTypeSuite) TestAccessorTypeDBValues(c *tc.C) {
+	db := s.DB()
+	rows, err := db.Query("SELECT id, type FROM secret_accessor_type")
+	c.Assert(err, tc.ErrorIsNil)
+	defer rows.Close()
+
+	dbValues := make(map[AccessorType]string)
+	for rows.Next() {
+		var (
+			id    int
+			value string
+		)
+		err := rows.Scan(&id, &value)
+		c.Assert(err, tc.ErrorIsNil)
+		dbValues[AccessorType(id)] = value
+	}
+	c.Assert(dbValues, tc.DeepEquals, map[AccessorType]string{
+		AccessorUnit:              "unit",
+		AccessorApplication:       "application",
+		AccessorModel:             "model",
+		AccessorRemoteApplication: "remote-application",
+		AccessorUser:              "user",
+	})
+	for id, value := range dbValues {
+		c.Assert(id.String(), tc.Equals, value)
+	}
+}
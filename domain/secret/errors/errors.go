@@ -41,4 +41,16 @@ const (
 
 	// MissingSecretBackendID describes an error that occurs when importing a secret and the backend doesn't exist.
 	MissingSecretBackendID = errors.ConstError("missing secret backend id")
+
+	// SecretChecksumMismatch describes an error that occurs when secret content
+	// copied to a backend does not match the original content.
+	SecretChecksumMismatch = errors.ConstError("secret content checksum mismatch")
+
+	// SecretGeneratorNotFound describes an error that occurs when a user secret
+	// has no generator with which to create new content.
+	SecretGeneratorNotFound = errors.ConstError("secret generator not found")
+
+	// SecretRevisionBackendChanged describes an error that occurs when the
+	// backend of a secret revision is changed whilst it is being migrated.
+	SecretRevisionBackendChanged = errors.ConstError("secret revision backend changed")
 )
@@ -0,0 +1,38 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import coresecrets "github.com/juju/juju/core/secrets"
+
+// GeneratorType represents the type of a user secret content generator
+// as recorded in the secret_generator_type lookup table.
+type GeneratorType int
+
+const (
+	GeneratePassword GeneratorType = iota
+	GenerateSSHKey
+	GenerateTLSCert
+)
+
+// MarshallGeneratorType converts a secret generator type to a db generator type id.
+func MarshallGeneratorType(t coresecrets.GeneratorType) GeneratorType {
+	switch t {
+	case coresecrets.GenerateSSHKey:
+		return GenerateSSHKey
+	case coresecrets.GenerateTLSCert:
+		return GenerateTLSCert
+	}
+	return GeneratePassword
+}
+
+// UnmarshallGeneratorType converts a db generator type id to a secret generator type.
+func UnmarshallGeneratorType(t GeneratorType) coresecrets.GeneratorType {
+	switch t {
+	case GenerateSSHKey:
+		return coresecrets.GenerateSSHKey
+	case GenerateTLSCert:
+		return coresecrets.GenerateTLSCert
+	}
+	return coresecrets.GeneratePassword
+}
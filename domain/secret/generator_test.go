@@ -0,0 +1,52 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type generatorTypeSuite struct {
+	schematesting.ModelSuite
+}
+
+func TestGeneratorTypeSuite(t *testing.T) {
+	tc.Run(t, &generatorTypeSuite{})
+}
+
+// TestGeneratorTypeDBValues ensures there's no skew between what's in the
+// database table for generator types and the typed consts used in the state packages.
+func (s *generatorTypeSuite) TestGeneratorTypeDBValues(c *tc.C) {
+	db := s.DB()
+	rows, err := db.Query("SELECT id, type FROM secret_generator_type")
+	c.Assert(err, tc.ErrorIsNil)
+	defer rows.Close()
+
+	dbValues := make(map[GeneratorType]string)
+	for rows.Next() {
+		var (
+			id    int
+			value string
+		)
+		err := rows.Scan(&id, &value)
+		c.Assert(err, tc.ErrorIsNil)
+		dbValues[GeneratorType(id)] = value
+	}
+	c.Assert(dbValues, tc.DeepEquals, map[GeneratorType]string{
+		GeneratePassword: "password",
+		GenerateSSHKey:   "ssh-key",
+		GenerateTLSCert:  "tls-cert",
+	})
+	// Also check the core generator types match.
+	for id, t := range dbValues {
+		c.Assert(coresecrets.GeneratorType(t).IsValid(), tc.IsTrue)
+		c.Assert(UnmarshallGeneratorType(id), tc.Equals, coresecrets.GeneratorType(t))
+		c.Assert(MarshallGeneratorType(coresecrets.GeneratorType(t)), tc.Equals, id)
+	}
+}
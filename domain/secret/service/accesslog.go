@@ -0,0 +1,104 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetSecretAccessLog returns the recorded attempts to read the content of the
+// specified secret, ordered from the most recent to the oldest. If limit is
+// positive, at most that many entries are returned.
+func (s *SecretService) GetSecretAccessLog(ctx context.Context, uri *secrets.URI, limit int) ([]SecretAccessLogEntry, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	entries, err := s.secretState.GetSecretAccessLog(ctx, uri, limit)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	result := make([]SecretAccessLogEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = SecretAccessLogEntry{
+			Accessor: SecretAccessor{
+				ID: entry.AccessorID,
+			},
+			Revision:   entry.Revision,
+			AccessTime: entry.AccessTime,
+			Denied:     entry.Denied,
+		}
+		switch entry.AccessorTypeID {
+		case domainsecret.AccessorUnit:
+			result[i].Accessor.Kind = UnitAccessor
+		case domainsecret.AccessorApplication:
+			result[i].Accessor.Kind = ApplicationAccessor
+		case domainsecret.AccessorModel:
+			result[i].Accessor.Kind = ModelAccessor
+		case domainsecret.AccessorRemoteApplication:
+			result[i].Accessor.Kind = RemoteApplicationAccessor
+		case domainsecret.AccessorUser:
+			result[i].Accessor.Kind = UserAccessor
+		default:
+			// Should never happen.
+			return nil, errors.Errorf("unexpected secret accessor type: %#v", entry.AccessorTypeID)
+		}
+	}
+	return result, nil
+}
+
+// PruneSecretAccessLog removes the secret access log entries recorded more
+// than maxAge ago. A zero maxAge disables pruning. The number of removed
+// entries is returned.
+func (s *SecretService) PruneSecretAccessLog(ctx context.Context, maxAge time.Duration) (int64, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if maxAge <= 0 {
+		return 0, nil
+	}
+	deleted, err := s.secretState.DeleteSecretAccessLogBefore(ctx, s.clock.Now().Add(-maxAge))
+	if err != nil {
+		return 0, errors.Errorf("pruning secret access log: %w", err)
+	}
+	return deleted, nil
+}
+
+// recordAccess adds an entry to the secret access log for an attempt by the
+// accessor to read the content of the specified secret revision. Recording
+// is best effort: a failure is logged rather than failing the read.
+func (s *SecretService) recordAccess(
+	ctx context.Context, uri *secrets.URI, rev int, accessor SecretAccessor, denied bool,
+) {
+	entry := domainsecret.AccessLogEntry{
+		URI:        uri,
+		Revision:   rev,
+		AccessorID: accessor.ID,
+		AccessTime: s.clock.Now(),
+		Denied:     denied,
+	}
+	switch accessor.Kind {
+	case UnitAccessor:
+		entry.AccessorTypeID = domainsecret.AccessorUnit
+	case ApplicationAccessor:
+		entry.AccessorTypeID = domainsecret.AccessorApplication
+	case ModelAccessor:
+		entry.AccessorTypeID = domainsecret.AccessorModel
+	case RemoteApplicationAccessor:
+		entry.AccessorTypeID = domainsecret.AccessorRemoteApplication
+	case UserAccessor:
+		entry.AccessorTypeID = domainsecret.AccessorUser
+	default:
+		s.logger.Warningf(ctx, "not recording access to secret %q by unexpected accessor kind %q", uri.ID, accessor.Kind)
+		return
+	}
+	if err := s.secretState.AddSecretAccessLogEntry(ctx, entry); err != nil {
+		s.logger.Warningf(ctx, "recording access to secret %q by %s %q: %v", uri.ID, accessor.Kind, accessor.ID, err)
+	}
+}
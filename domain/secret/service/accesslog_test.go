@@ -0,0 +1,90 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+)
+
+func (s *serviceSuite) TestGetSecretAccessLog(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	now := time.Now()
+
+	s.state.EXPECT().GetSecretAccessLog(gomock.Any(), uri, 10).Return([]domainsecret.AccessLogEntry{{
+		URI:            uri,
+		Revision:       2,
+		AccessorTypeID: domainsecret.AccessorUser,
+		AccessorID:     "fred",
+		AccessTime:     now,
+	}, {
+		URI:            uri,
+		Revision:       1,
+		AccessorTypeID: domainsecret.AccessorRemoteApplication,
+		AccessorID:     "remote-deadbeef",
+		AccessTime:     now.Add(-time.Hour),
+		Denied:         true,
+	}}, nil)
+
+	result, err := s.service.GetSecretAccessLog(c.Context(), uri, 10)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, []SecretAccessLogEntry{{
+		Accessor:   SecretAccessor{Kind: UserAccessor, ID: "fred"},
+		Revision:   2,
+		AccessTime: now,
+	}, {
+		Accessor:   SecretAccessor{Kind: RemoteApplicationAccessor, ID: "remote-deadbeef"},
+		Revision:   1,
+		AccessTime: now.Add(-time.Hour),
+		Denied:     true,
+	}})
+}
+
+func (s *serviceSuite) TestPruneSecretAccessLog(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DeleteSecretAccessLogBefore(gomock.Any(), s.clock.Now().Add(-time.Hour)).Return(int64(3), nil)
+
+	pruned, err := s.service.PruneSecretAccessLog(c.Context(), time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(pruned, tc.Equals, int64(3))
+}
+
+func (s *serviceSuite) TestPruneSecretAccessLogDisabled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	pruned, err := s.service.PruneSecretAccessLog(c.Context(), 0)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(pruned, tc.Equals, int64(0))
+}
+
+func (s *serviceSuite) TestGetSecretContentFromBackendIsRecorded(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.service.activeBackendID = "backend-id"
+
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), domainsecret.AccessLogEntry{
+		URI:            uri,
+		Revision:       1,
+		AccessorTypeID: domainsecret.AccessorUser,
+		AccessorID:     "admin",
+		AccessTime:     s.clock.Now(),
+	}).Return(nil)
+
+	val, err := s.service.GetSecretContentFromBackend(c.Context(), uri, 1, SecretAccessor{
+		Kind: UserAccessor,
+		ID:   "admin",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(val, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+}
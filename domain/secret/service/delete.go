@@ -12,7 +12,8 @@ import (
 	"github.com/juju/juju/internal/errors"
 )
 
-// DeleteObsoleteUserSecretRevisions deletes any obsolete user secret revisions that are marked as auto-prune.
+// DeleteObsoleteUserSecretRevisions deletes any obsolete user secret revisions that are marked as auto-prune,
+// or which are older than the number of revisions to keep for secrets rotated with a generator.
 func (s *SecretService) DeleteObsoleteUserSecretRevisions(ctx context.Context) error {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
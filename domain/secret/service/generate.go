@@ -0,0 +1,151 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// defaultCertCommonName is the common name used for generated
+	// TLS certificates if none is specified.
+	defaultCertCommonName = "juju-generated"
+)
+
+// generateContent returns new secret content created by the specified
+// generator, along with its checksum. The values are base64 encoded as
+// expected for secret data.
+func generateContent(g secrets.Generator, now time.Time) (secrets.SecretData, string, error) {
+	if err := g.Validate(); err != nil {
+		return nil, "", errors.Capture(err)
+	}
+	var (
+		content map[string]string
+		err     error
+	)
+	switch g.Type {
+	case secrets.GeneratePassword:
+		content, err = generatePassword(g)
+	case secrets.GenerateSSHKey:
+		content, err = generateSSHKey()
+	case secrets.GenerateTLSCert:
+		content, err = generateTLSCert(g, now)
+	default:
+		return nil, "", errors.Errorf("secret generator type %q %w", g.Type, coreerrors.NotValid)
+	}
+	if err != nil {
+		return nil, "", errors.Errorf("generating %s: %w", g.Type, err)
+	}
+
+	data := make(secrets.SecretData)
+	for k, v := range content {
+		data[k] = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+	checksum, err := secrets.NewSecretValue(data).Checksum()
+	if err != nil {
+		return nil, "", errors.Errorf("calculating secret checksum: %w", err)
+	}
+	return data, checksum, nil
+}
+
+func generatePassword(g secrets.Generator) (map[string]string, error) {
+	length := g.Length
+	if length == 0 {
+		length = secrets.DefaultPasswordLength
+	}
+	charset := g.Charset
+	if charset == "" {
+		charset = secrets.CharsetAlphanumeric
+	}
+	chars := secrets.PasswordCharsets[charset]
+
+	max := big.NewInt(int64(len(chars)))
+	var password strings.Builder
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		password.WriteByte(chars[n.Int64()])
+	}
+	return map[string]string{"password": password.String()}, nil
+}
+
+func generateSSHKey() (map[string]string, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	privBlock, err := ssh.MarshalPrivateKey(priv, "")
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return map[string]string{
+		"private-key": string(pem.EncodeToMemory(privBlock)),
+		"public-key":  strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub))),
+	}, nil
+}
+
+func generateTLSCert(g secrets.Generator, now time.Time) (map[string]string, error) {
+	commonName := g.CommonName
+	if commonName == "" {
+		commonName = defaultCertCommonName
+	}
+	validity := g.Validity
+	if validity == 0 {
+		validity = secrets.DefaultCertValidity
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		// Allow for a little clock skew.
+		NotBefore:             now.Add(-5 * time.Minute).UTC(),
+		NotAfter:              now.Add(validity).UTC(),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return map[string]string{
+		"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		"private-key": string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})),
+	}, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"golang.org/x/crypto/ssh"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coresecrets "github.com/juju/juju/core/secrets"
+)
+
+type generateSuite struct{}
+
+func TestGenerateSuite(t *testing.T) {
+	tc.Run(t, &generateSuite{})
+}
+
+func (s *generateSuite) value(c *tc.C, data coresecrets.SecretData, key string) string {
+	v, err := coresecrets.NewSecretValue(data).KeyValue(key)
+	c.Assert(err, tc.ErrorIsNil)
+	return v
+}
+
+func (s *generateSuite) TestGeneratePasswordDefaults(c *tc.C) {
+	data, checksum, err := generateContent(coresecrets.Generator{Type: coresecrets.GeneratePassword}, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(data, tc.HasLen, 1)
+	password := s.value(c, data, "password")
+	c.Assert(password, tc.Matches, "[a-zA-Z0-9]{32}")
+
+	expected, err := coresecrets.NewSecretValue(data).Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(checksum, tc.Equals, expected)
+}
+
+func (s *generateSuite) TestGeneratePassword(c *tc.C) {
+	data, _, err := generateContent(coresecrets.Generator{
+		Type:    coresecrets.GeneratePassword,
+		Length:  12,
+		Charset: coresecrets.CharsetHex,
+	}, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.value(c, data, "password"), tc.Matches, "[0-9a-f]{12}")
+}
+
+func (s *generateSuite) TestGeneratePasswordUnique(c *tc.C) {
+	g := coresecrets.Generator{Type: coresecrets.GeneratePassword}
+	_, checksum1, err := generateContent(g, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	_, checksum2, err := generateContent(g, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(checksum1, tc.Not(tc.Equals), checksum2)
+}
+
+func (s *generateSuite) TestGenerateSSHKey(c *tc.C) {
+	data, _, err := generateContent(coresecrets.Generator{Type: coresecrets.GenerateSSHKey}, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	signer, err := ssh.ParsePrivateKey([]byte(s.value(c, data, "private-key")))
+	c.Assert(err, tc.ErrorIsNil)
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(s.value(c, data, "public-key")))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(pub.Marshal(), tc.DeepEquals, signer.PublicKey().Marshal())
+}
+
+func (s *generateSuite) TestGenerateTLSCert(c *tc.C) {
+	now := time.Now()
+	data, _, err := generateContent(coresecrets.Generator{
+		Type:       coresecrets.GenerateTLSCert,
+		CommonName: "db.example.com",
+		Validity:   24 * time.Hour,
+	}, now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	block, _ := pem.Decode([]byte(s.value(c, data, "certificate")))
+	c.Assert(block, tc.NotNil)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cert.Subject.CommonName, tc.Equals, "db.example.com")
+	c.Check(cert.DNSNames, tc.DeepEquals, []string{"db.example.com"})
+	c.Check(cert.NotAfter, tc.Equals, now.Add(24*time.Hour).UTC().Truncate(time.Second))
+
+	block, _ = pem.Decode([]byte(s.value(c, data, "private-key")))
+	c.Assert(block, tc.NotNil)
+	_, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *generateSuite) TestGenerateInvalid(c *tc.C) {
+	_, _, err := generateContent(coresecrets.Generator{Type: "token"}, time.Now())
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
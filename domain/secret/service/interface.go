@@ -83,11 +83,15 @@ type State interface {
 	ListUserSecretsToDrain(ctx context.Context) ([]*secrets.SecretMetadataForDrain, error)
 	SecretRotated(ctx context.Context, uri *secrets.URI, next time.Time) error
 	GetRotatePolicy(ctx context.Context, uri *secrets.URI) (secrets.RotatePolicy, error)
+	GetSecretGenerator(ctx context.Context, uri *secrets.URI) (*secrets.Generator, error)
 	GetRotationExpiryInfo(ctx context.Context, uri *secrets.URI) (*domainsecret.RotationExpiryInfo, error)
 	GetSecretRevisionID(ctx context.Context, uri *secrets.URI, revision int) (string, error)
 	ChangeSecretBackend(
 		ctx context.Context, revisionID uuid.UUID, valueRef *secrets.ValueRef, data secrets.SecretData,
 	) error
+	MigrateSecretBackend(
+		ctx context.Context, revisionID uuid.UUID, from, valueRef *secrets.ValueRef, data secrets.SecretData,
+	) error
 	GetOwnedSecretIDs(
 		ctx context.Context, appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners,
 	) ([]string, error)
@@ -100,6 +104,11 @@ type State interface {
 		ctx context.Context, appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners, revisionUUIDs ...string,
 	) (map[string]string, error)
 
+	// For the secret access log.
+	AddSecretAccessLogEntry(ctx context.Context, entry domainsecret.AccessLogEntry) error
+	GetSecretAccessLog(ctx context.Context, uri *secrets.URI, limit int) ([]domainsecret.AccessLogEntry, error)
+	DeleteSecretAccessLogBefore(ctx context.Context, before time.Time) (int64, error)
+
 	// For watching obsolete user secret revisions to prune.
 	GetObsoleteUserSecretRevisionsReadyToPrune(ctx context.Context) ([]string, error)
 
@@ -123,6 +132,10 @@ type State interface {
 		ctx context.Context, appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners, secretIDs ...string,
 	) ([]domainsecret.RotationInfo, error)
 
+	// For watching user secret rotation changes.
+	InitialWatchStatementForUserSecretsRotationChanges() (string, eventsource.NamespaceQuery)
+	GetUserSecretsRotationChanges(ctx context.Context, secretIDs ...string) ([]domainsecret.RotationInfo, error)
+
 	// For watching secret revision expiry changes.
 	InitialWatchStatementForSecretsRevisionExpiryChanges(
 		appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners,
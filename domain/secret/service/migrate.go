@@ -0,0 +1,300 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+	"github.com/juju/juju/internal/secrets/provider/juju"
+	"github.com/juju/juju/internal/secrets/provider/kubernetes"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// migrationBackends holds the details of the backends involved in a secret
+// migration.
+type migrationBackends struct {
+	modelUUID    coremodel.UUID
+	modelBackend secretbackend.ModelSecretBackend
+	from, to     *secretbackend.SecretBackend
+}
+
+// holds returns true if a revision with the specified value reference is
+// stored in the specified backend.
+func holds(b *secretbackend.SecretBackend, ref *secrets.ValueRef) bool {
+	if ref == nil {
+		return b.BackendType == juju.BackendType
+	}
+	return ref.BackendID == b.ID
+}
+
+// getMigrationBackends looks up the backends named in the migration params.
+// The model's builtin kubernetes backend may be referred to by the name it
+// is displayed with.
+// It returns an error satisfying [backenderrors.NotFound] if either backend
+// does not exist, or [coreerrors.NotValid] if they are the same backend.
+func (s *SecretService) getMigrationBackends(ctx context.Context, params MigrateSecretsParams) (migrationBackends, error) {
+	modelUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return migrationBackends{}, errors.Errorf("getting model UUID: %w", err)
+	}
+	modelBackend, err := s.secretBackendState.GetModelSecretBackendDetails(ctx, modelUUID)
+	if err != nil {
+		return migrationBackends{}, errors.Errorf("getting model secret backend: %w", err)
+	}
+	backends, err := s.secretBackendState.ListSecretBackendsForModel(ctx, modelUUID, true)
+	if err != nil {
+		return migrationBackends{}, errors.Errorf("listing secret backends: %w", err)
+	}
+
+	find := func(name string) (*secretbackend.SecretBackend, error) {
+		for _, b := range backends {
+			if b.Name == name {
+				return b, nil
+			}
+			if b.Name == kubernetes.BackendName && name == kubernetes.BuiltInName(modelBackend.ModelName) {
+				return b, nil
+			}
+		}
+		return nil, errors.Errorf("secret backend %q not found", name).Add(backenderrors.NotFound)
+	}
+	result := migrationBackends{
+		modelUUID:    modelUUID,
+		modelBackend: modelBackend,
+	}
+	if result.from, err = find(params.FromBackend); err != nil {
+		return migrationBackends{}, errors.Capture(err)
+	}
+	if result.to, err = find(params.ToBackend); err != nil {
+		return migrationBackends{}, errors.Capture(err)
+	}
+	if result.from.ID == result.to.ID {
+		return migrationBackends{}, errors.Errorf(
+			"cannot migrate secrets from secret backend %q to itself", params.FromBackend).Add(coreerrors.NotValid)
+	}
+	return result, nil
+}
+
+// backendClient returns a client for the specified backend.
+func (s *SecretService) backendClient(b migrationBackends, backend *secretbackend.SecretBackend) (provider.SecretsBackend, error) {
+	client, err := s.getBackend(&provider.ModelBackendConfig{
+		ControllerUUID: b.modelBackend.ControllerUUID,
+		ModelUUID:      b.modelUUID.String(),
+		ModelName:      b.modelBackend.ModelName,
+		BackendConfig: provider.BackendConfig{
+			BackendType: backend.BackendType,
+			Config:      backend.Config,
+		},
+	})
+	if err != nil {
+		return nil, errors.Errorf("acquiring secret backend %q: %w", backend.Name, err)
+	}
+	return client, nil
+}
+
+// ListSecretRevisionsToMigrate returns the secrets with revisions stored in the
+// backend being migrated from. Both user and charm secrets are included.
+// It returns an error satisfying [backenderrors.NotFound] if either backend
+// does not exist.
+func (s *SecretService) ListSecretRevisionsToMigrate(
+	ctx context.Context, params MigrateSecretsParams,
+) ([]SecretRevisionsToMigrate, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	backends, err := s.getMigrationBackends(ctx, params)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	metadata, revisions, err := s.secretState.ListSecrets(ctx, nil, nil, domainsecret.NilLabels)
+	if err != nil {
+		return nil, errors.Errorf("listing secrets: %w", err)
+	}
+
+	var result []SecretRevisionsToMigrate
+	for i, md := range metadata {
+		var toMigrate []int
+		for _, rev := range revisions[i] {
+			if holds(backends.from, rev.ValueRef) {
+				toMigrate = append(toMigrate, rev.Revision)
+			}
+		}
+		if len(toMigrate) == 0 {
+			continue
+		}
+		result = append(result, SecretRevisionsToMigrate{
+			URI:       md.URI,
+			Label:     md.Label,
+			Owner:     md.Owner,
+			Revisions: toMigrate,
+		})
+	}
+	return result, nil
+}
+
+// MigrateSecretRevision copies the content of the specified secret revision
+// from one backend to another, verifies the copy against the checksum of the
+// original content, points the revision at the new backend and finally removes
+// the content from the old backend. A revision already stored in the target
+// backend is skipped, so an interrupted migration can be resumed by migrating
+// the remaining revisions again.
+// It returns an error satisfying [secreterrors.SecretRevisionNotFound] if the
+// revision does not exist, [backenderrors.NotFound] if either backend does not
+// exist, [secreterrors.SecretChecksumMismatch] if the copied content could
+// not be verified, or [secreterrors.SecretRevisionBackendChanged] if the
+// revision's backend was changed whilst it was being migrated.
+func (s *SecretService) MigrateSecretRevision(
+	ctx context.Context, uri *secrets.URI, revision int, params MigrateSecretsParams,
+) (MigrateSecretRevisionResult, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	backends, err := s.getMigrationBackends(ctx, params)
+	if err != nil {
+		return MigrateSecretRevisionResult{}, errors.Capture(err)
+	}
+
+	data, ref, err := s.secretState.GetSecretValue(ctx, uri, revision)
+	if errors.IsOneOf(err, secreterrors.SecretNotFound, secreterrors.SecretRevisionNotFound) {
+		return MigrateSecretRevisionResult{}, errors.Errorf(
+			"secret %s revision %d not found", uri.ID, revision).Add(secreterrors.SecretRevisionNotFound)
+	} else if err != nil {
+		return MigrateSecretRevisionResult{}, errors.Capture(err)
+	}
+	if holds(backends.to, ref) {
+		return MigrateSecretRevisionResult{Skipped: true}, nil
+	}
+	if !holds(backends.from, ref) {
+		return MigrateSecretRevisionResult{}, errors.Errorf(
+			"secret %s revision %d is not stored in secret backend %q", uri.ID, revision, params.FromBackend,
+		).Add(coreerrors.NotValid)
+	}
+
+	revisionIDStr, err := s.secretState.GetSecretRevisionID(ctx, uri, revision)
+	if err != nil {
+		return MigrateSecretRevisionResult{}, errors.Capture(err)
+	}
+	revisionID, err := uuid.UUIDFromString(revisionIDStr)
+	if err != nil {
+		return MigrateSecretRevisionResult{}, errors.Capture(err)
+	}
+
+	fromClient, err := s.backendClient(backends, backends.from)
+	if err != nil {
+		return MigrateSecretRevisionResult{}, errors.Capture(err)
+	}
+	toClient, err := s.backendClient(backends, backends.to)
+	if err != nil {
+		return MigrateSecretRevisionResult{}, errors.Capture(err)
+	}
+
+	val := secrets.NewSecretValue(data)
+	if ref != nil {
+		val, err = fromClient.GetContent(ctx, ref.RevisionID)
+		if err != nil {
+			return MigrateSecretRevisionResult{}, errors.Errorf(
+				"reading secret %s revision %d from %q: %w", uri.ID, revision, params.FromBackend, err)
+		}
+	}
+	checksum, err := val.Checksum()
+	if err != nil {
+		return MigrateSecretRevisionResult{}, errors.Capture(err)
+	}
+
+	// Copy the content to the target backend and verify it before the
+	// revision is pointed at it, so that a bad copy leaves the secret as is.
+	var newRef *secrets.ValueRef
+	newData := val.EncodedValues()
+	newRevisionID, err := toClient.SaveContent(ctx, uri, revision, val)
+	if err != nil && !errors.Is(err, coreerrors.NotSupported) {
+		return MigrateSecretRevisionResult{}, errors.Errorf(
+			"saving secret %s revision %d to %q: %w", uri.ID, revision, params.ToBackend, err)
+	}
+	if err == nil {
+		newRef = &secrets.ValueRef{
+			BackendID:  backends.to.ID,
+			RevisionID: newRevisionID,
+		}
+		newData = nil
+		if err := s.verifyMigratedContent(ctx, toClient, newRef.RevisionID, checksum); err != nil {
+			s.deleteMigratedContent(ctx, toClient, newRef.RevisionID)
+			return MigrateSecretRevisionResult{}, errors.Errorf(
+				"verifying secret %s revision %d in %q: %w", uri.ID, revision, params.ToBackend, err)
+		}
+	}
+
+	err = s.migrateSecretRevisionBackend(ctx, backends.modelUUID, revisionID, ref, newRef, newData)
+	if err != nil {
+		if newRef != nil {
+			s.deleteMigratedContent(ctx, toClient, newRef.RevisionID)
+		}
+		return MigrateSecretRevisionResult{}, errors.Capture(err)
+	}
+
+	// Content saved to the Juju database can only be read back once the
+	// revision has been updated.
+	if newRef == nil {
+		got, _, err := s.secretState.GetSecretValue(ctx, uri, revision)
+		if err == nil {
+			err = compareChecksum(secrets.NewSecretValue(got), checksum)
+		}
+		if err != nil {
+			if rbErr := s.migrateSecretRevisionBackend(ctx, backends.modelUUID, revisionID, nil, ref, nil); rbErr != nil {
+				s.logger.Warningf(ctx, "failed to restore backend of secret %s revision %d: %v", uri.ID, revision, rbErr)
+			}
+			return MigrateSecretRevisionResult{}, errors.Errorf(
+				"verifying secret %s revision %d in %q: %w", uri.ID, revision, params.ToBackend, err)
+		}
+	}
+
+	if ref != nil {
+		err := fromClient.DeleteContent(ctx, ref.RevisionID)
+		if err != nil && !errors.IsOneOf(err, coreerrors.NotFound, secreterrors.SecretRevisionNotFound) {
+			// The secret has been migrated, so only the old content is left behind.
+			s.logger.Warningf(ctx, "failed to delete secret %s revision %d from %q: %v",
+				uri.ID, revision, params.FromBackend, err)
+		}
+	}
+	return MigrateSecretRevisionResult{Checksum: checksum}, nil
+}
+
+// verifyMigratedContent reads back the content saved in a backend and
+// compares it with the expected checksum.
+func (s *SecretService) verifyMigratedContent(
+	ctx context.Context, client provider.SecretsBackend, revisionID, checksum string,
+) error {
+	val, err := client.GetContent(ctx, revisionID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return compareChecksum(val, checksum)
+}
+
+// deleteMigratedContent removes content copied to a backend which is not
+// going to be used.
+func (s *SecretService) deleteMigratedContent(ctx context.Context, client provider.SecretsBackend, revisionID string) {
+	if err := client.DeleteContent(ctx, revisionID); err != nil {
+		s.logger.Warningf(ctx, "failed to delete migrated secret content %q: %v", revisionID, err)
+	}
+}
+
+func compareChecksum(val secrets.SecretValue, expected string) error {
+	checksum, err := val.Checksum()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if checksum != expected {
+		return errors.Errorf("expected %q, got %q", expected, checksum).Add(secreterrors.SecretChecksumMismatch)
+	}
+	return nil
+}
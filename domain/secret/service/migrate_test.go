@@ -0,0 +1,327 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+	"github.com/juju/juju/internal/secrets/provider/juju"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type migrateSuite struct {
+	serviceSuite
+
+	targetBackend *MockSecretsBackend
+}
+
+func TestMigrateSuite(t *testing.T) {
+	tc.Run(t, &migrateSuite{})
+}
+
+func (s *migrateSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := s.serviceSuite.setupMocks(c)
+	s.targetBackend = NewMockSecretsBackend(ctrl)
+	return ctrl
+}
+
+func (s *migrateSuite) expectBackends() {
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	s.secretBackendState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), s.modelID).Return(secretbackend.ModelSecretBackend{
+		ControllerUUID:  coretesting.ControllerTag.Id(),
+		ModelID:         s.modelID,
+		ModelName:       "fred",
+		SecretBackendID: "internal-id",
+	}, nil)
+	s.secretBackendState.EXPECT().ListSecretBackendsForModel(gomock.Any(), s.modelID, true).Return([]*secretbackend.SecretBackend{{
+		ID:          "internal-id",
+		Name:        juju.BackendName,
+		BackendType: juju.BackendType,
+	}, {
+		ID:          "vault-id",
+		Name:        "myvault",
+		BackendType: "vault",
+		Config:      map[string]any{"endpoint": "http://vault"},
+	}, {
+		ID:          "other-id",
+		Name:        "othervault",
+		BackendType: "vault",
+		Config:      map[string]any{"endpoint": "http://other"},
+	}}, nil)
+}
+
+func (s *migrateSuite) expectBackendClients() {
+	s.secretsBackendProvider.EXPECT().NewBackend(gomock.Any()).DoAndReturn(func(cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+		if cfg.Config["endpoint"] == "http://vault" {
+			return s.secretsBackend, nil
+		}
+		return s.targetBackend, nil
+	}).Times(2)
+}
+
+func (s *migrateSuite) TestListSecretRevisionsToMigrate(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri1 := coresecrets.NewURI()
+	uri2 := coresecrets.NewURI()
+	s.expectBackends()
+	s.state.EXPECT().ListSecrets(gomock.Any(), nil, nil, domainsecret.NilLabels).Return(
+		[]*coresecrets.SecretMetadata{{
+			URI:   uri1,
+			Label: "foo",
+			Owner: coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: s.modelID.String()},
+		}, {
+			URI:   uri2,
+			Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mariadb"},
+		}},
+		[][]*coresecrets.SecretRevisionMetadata{{{
+			Revision: 1,
+		}, {
+			Revision: 2,
+			ValueRef: &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-2"},
+		}, {
+			Revision: 3,
+			ValueRef: &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-3"},
+		}}, {{
+			Revision: 1,
+			ValueRef: &coresecrets.ValueRef{BackendID: "other-id", RevisionID: "rev-1"},
+		}}}, nil)
+
+	result, err := s.service.ListSecretRevisionsToMigrate(c.Context(), MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   juju.BackendName,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, []SecretRevisionsToMigrate{{
+		URI:       uri1,
+		Label:     "foo",
+		Owner:     coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: s.modelID.String()},
+		Revisions: []int{2, 3},
+	}})
+}
+
+func (s *migrateSuite) TestListSecretRevisionsToMigrateBackendNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends()
+
+	_, err := s.service.ListSecretRevisionsToMigrate(c.Context(), MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "missing",
+	})
+	c.Assert(err, tc.ErrorIs, backenderrors.NotFound)
+}
+
+func (s *migrateSuite) TestListSecretRevisionsToMigrateSameBackend(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends()
+
+	_, err := s.service.ListSecretRevisionsToMigrate(c.Context(), MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "myvault",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *migrateSuite) TestMigrateSecretRevisionToExternalBackend(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	oldRef := &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-old"}
+	newRef := &coresecrets.ValueRef{BackendID: "other-id", RevisionID: "rev-new"}
+	val := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	checksum, err := val.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.expectBackends()
+	s.expectBackendClients()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, oldRef, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.secretsBackend.EXPECT().GetContent(gomock.Any(), "rev-old").Return(val, nil)
+	s.targetBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, val).Return("rev-new", nil)
+	s.targetBackend.EXPECT().GetContent(gomock.Any(), "rev-new").Return(val, nil)
+	s.secretBackendState.EXPECT().UpdateSecretBackendReference(gomock.Any(), newRef, s.modelID, s.fakeUUID.String()).Return(func() error { return nil }, nil)
+	s.state.EXPECT().MigrateSecretBackend(gomock.Any(), s.fakeUUID, oldRef, newRef, nil).Return(nil)
+	s.secretsBackend.EXPECT().DeleteContent(gomock.Any(), "rev-old").Return(nil)
+
+	result, err := s.service.MigrateSecretRevision(c.Context(), uri, 1, MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "othervault",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, MigrateSecretRevisionResult{Checksum: checksum})
+}
+
+func (s *migrateSuite) TestMigrateSecretRevisionToInternalBackend(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	oldRef := &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-old"}
+	data := coresecrets.SecretData{"foo": "YmFy"}
+	val := coresecrets.NewSecretValue(data)
+	checksum, err := val.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.expectBackends()
+	s.expectBackendClients()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, oldRef, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.secretsBackend.EXPECT().GetContent(gomock.Any(), "rev-old").Return(val, nil)
+	s.targetBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, val).Return("", coreerrors.NotSupported)
+	s.secretBackendState.EXPECT().UpdateSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String()).Return(func() error { return nil }, nil)
+	s.state.EXPECT().MigrateSecretBackend(gomock.Any(), s.fakeUUID, oldRef, nil, data).Return(nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(data, nil, nil)
+	s.secretsBackend.EXPECT().DeleteContent(gomock.Any(), "rev-old").Return(nil)
+
+	result, err := s.service.MigrateSecretRevision(c.Context(), uri, 1, MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   juju.BackendName,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, MigrateSecretRevisionResult{Checksum: checksum})
+}
+
+func (s *migrateSuite) TestMigrateSecretRevisionAlreadyMigrated(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.expectBackends()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, &coresecrets.ValueRef{
+		BackendID: "other-id", RevisionID: "rev-new",
+	}, nil)
+
+	result, err := s.service.MigrateSecretRevision(c.Context(), uri, 1, MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "othervault",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, MigrateSecretRevisionResult{Skipped: true})
+}
+
+func (s *migrateSuite) TestMigrateSecretRevisionNotInSourceBackend(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.expectBackends()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "YmFy"}, nil, nil)
+
+	_, err := s.service.MigrateSecretRevision(c.Context(), uri, 1, MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "othervault",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *migrateSuite) TestMigrateSecretRevisionNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.expectBackends()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, nil, secreterrors.SecretNotFound)
+
+	_, err := s.service.MigrateSecretRevision(c.Context(), uri, 1, MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "othervault",
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *migrateSuite) TestMigrateSecretRevisionChecksumMismatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	oldRef := &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-old"}
+	val := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+
+	s.expectBackends()
+	s.expectBackendClients()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, oldRef, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.secretsBackend.EXPECT().GetContent(gomock.Any(), "rev-old").Return(val, nil)
+	s.targetBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, val).Return("rev-new", nil)
+	s.targetBackend.EXPECT().GetContent(gomock.Any(), "rev-new").Return(
+		coresecrets.NewSecretValue(map[string]string{"foo": "YmF6"}), nil)
+	s.targetBackend.EXPECT().DeleteContent(gomock.Any(), "rev-new").Return(nil)
+
+	_, err := s.service.MigrateSecretRevision(c.Context(), uri, 1, MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "othervault",
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretChecksumMismatch)
+}
+
+func (s *migrateSuite) TestMigrateSecretRevisionUpdateFailed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	oldRef := &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-old"}
+	newRef := &coresecrets.ValueRef{BackendID: "other-id", RevisionID: "rev-new"}
+	val := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+
+	s.expectBackends()
+	s.expectBackendClients()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, oldRef, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.secretsBackend.EXPECT().GetContent(gomock.Any(), "rev-old").Return(val, nil)
+	s.targetBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, val).Return("rev-new", nil)
+	s.targetBackend.EXPECT().GetContent(gomock.Any(), "rev-new").Return(val, nil)
+	rollbackCalled := false
+	s.secretBackendState.EXPECT().UpdateSecretBackendReference(gomock.Any(), newRef, s.modelID, s.fakeUUID.String()).Return(func() error {
+		rollbackCalled = true
+		return nil
+	}, nil)
+	s.state.EXPECT().MigrateSecretBackend(gomock.Any(), s.fakeUUID, oldRef, newRef, nil).Return(errors.New("boom"))
+	s.targetBackend.EXPECT().DeleteContent(gomock.Any(), "rev-new").Return(nil)
+
+	_, err := s.service.MigrateSecretRevision(c.Context(), uri, 1, MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "othervault",
+	})
+	c.Assert(err, tc.ErrorMatches, "boom")
+	c.Assert(rollbackCalled, tc.IsTrue)
+}
+
+func (s *migrateSuite) TestMigrateSecretRevisionBackendChanged(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	oldRef := &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-old"}
+	newRef := &coresecrets.ValueRef{BackendID: "other-id", RevisionID: "rev-new"}
+	val := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+
+	s.expectBackends()
+	s.expectBackendClients()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, oldRef, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.secretsBackend.EXPECT().GetContent(gomock.Any(), "rev-old").Return(val, nil)
+	s.targetBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, val).Return("rev-new", nil)
+	s.targetBackend.EXPECT().GetContent(gomock.Any(), "rev-new").Return(val, nil)
+	rollbackCalled := false
+	s.secretBackendState.EXPECT().UpdateSecretBackendReference(gomock.Any(), newRef, s.modelID, s.fakeUUID.String()).Return(func() error {
+		rollbackCalled = true
+		return nil
+	}, nil)
+	s.state.EXPECT().MigrateSecretBackend(gomock.Any(), s.fakeUUID, oldRef, newRef, nil).Return(secreterrors.SecretRevisionBackendChanged)
+	// Only the copy is removed, the content in the old backend is kept.
+	s.targetBackend.EXPECT().DeleteContent(gomock.Any(), "rev-new").Return(nil)
+
+	_, err := s.service.MigrateSecretRevision(c.Context(), uri, 1, MigrateSecretsParams{
+		FromBackend: "myvault",
+		ToBackend:   "othervault",
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionBackendChanged)
+	c.Assert(rollbackCalled, tc.IsTrue)
+}
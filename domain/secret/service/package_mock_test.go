@@ -51,6 +51,44 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// AddSecretAccessLogEntry mocks base method.
+func (m *MockState) AddSecretAccessLogEntry(arg0 context.Context, arg1 secret.AccessLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecretAccessLogEntry", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecretAccessLogEntry indicates an expected call of AddSecretAccessLogEntry.
+func (mr *MockStateMockRecorder) AddSecretAccessLogEntry(arg0, arg1 any) *MockStateAddSecretAccessLogEntryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretAccessLogEntry", reflect.TypeOf((*MockState)(nil).AddSecretAccessLogEntry), arg0, arg1)
+	return &MockStateAddSecretAccessLogEntryCall{Call: call}
+}
+
+// MockStateAddSecretAccessLogEntryCall wrap *gomock.Call
+type MockStateAddSecretAccessLogEntryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddSecretAccessLogEntryCall) Return(arg0 error) *MockStateAddSecretAccessLogEntryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddSecretAccessLogEntryCall) Do(f func(context.Context, secret.AccessLogEntry) error) *MockStateAddSecretAccessLogEntryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddSecretAccessLogEntryCall) DoAndReturn(f func(context.Context, secret.AccessLogEntry) error) *MockStateAddSecretAccessLogEntryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AllRemoteSecrets mocks base method.
 func (m *MockState) AllRemoteSecrets(arg0 context.Context) ([]secret.RemoteSecretInfo, error) {
 	m.ctrl.T.Helper()
@@ -553,6 +591,45 @@ func (c *MockStateDeleteSecretCall) DoAndReturn(f func(domain.AtomicContext, *se
 	return c
 }
 
+// DeleteSecretAccessLogBefore mocks base method.
+func (m *MockState) DeleteSecretAccessLogBefore(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecretAccessLogBefore", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecretAccessLogBefore indicates an expected call of DeleteSecretAccessLogBefore.
+func (mr *MockStateMockRecorder) DeleteSecretAccessLogBefore(arg0, arg1 any) *MockStateDeleteSecretAccessLogBeforeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecretAccessLogBefore", reflect.TypeOf((*MockState)(nil).DeleteSecretAccessLogBefore), arg0, arg1)
+	return &MockStateDeleteSecretAccessLogBeforeCall{Call: call}
+}
+
+// MockStateDeleteSecretAccessLogBeforeCall wrap *gomock.Call
+type MockStateDeleteSecretAccessLogBeforeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeleteSecretAccessLogBeforeCall) Return(arg0 int64, arg1 error) *MockStateDeleteSecretAccessLogBeforeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeleteSecretAccessLogBeforeCall) Do(f func(context.Context, time.Time) (int64, error)) *MockStateDeleteSecretAccessLogBeforeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeleteSecretAccessLogBeforeCall) DoAndReturn(f func(context.Context, time.Time) (int64, error)) *MockStateDeleteSecretAccessLogBeforeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationUUID mocks base method.
 func (m *MockState) GetApplicationUUID(arg0 domain.AtomicContext, arg1 string) (application.ID, error) {
 	m.ctrl.T.Helper()
@@ -1080,6 +1157,45 @@ func (c *MockStateGetSecretAccessCall) DoAndReturn(f func(context.Context, *secr
 	return c
 }
 
+// GetSecretAccessLog mocks base method.
+func (m *MockState) GetSecretAccessLog(arg0 context.Context, arg1 *secrets.URI, arg2 int) ([]secret.AccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretAccessLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secret.AccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretAccessLog indicates an expected call of GetSecretAccessLog.
+func (mr *MockStateMockRecorder) GetSecretAccessLog(arg0, arg1, arg2 any) *MockStateGetSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretAccessLog", reflect.TypeOf((*MockState)(nil).GetSecretAccessLog), arg0, arg1, arg2)
+	return &MockStateGetSecretAccessLogCall{Call: call}
+}
+
+// MockStateGetSecretAccessLogCall wrap *gomock.Call
+type MockStateGetSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretAccessLogCall) Return(arg0 []secret.AccessLogEntry, arg1 error) *MockStateGetSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretAccessLogCall) Do(f func(context.Context, *secrets.URI, int) ([]secret.AccessLogEntry, error)) *MockStateGetSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretAccessLogCall) DoAndReturn(f func(context.Context, *secrets.URI, int) ([]secret.AccessLogEntry, error)) *MockStateGetSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretAccessScope mocks base method.
 func (m *MockState) GetSecretAccessScope(arg0 context.Context, arg1 *secrets.URI, arg2 secret.AccessParams) (*secret.AccessScope, error) {
 	m.ctrl.T.Helper()
@@ -1159,6 +1275,45 @@ func (c *MockStateGetSecretConsumerCall) DoAndReturn(f func(context.Context, *se
 	return c
 }
 
+// GetSecretGenerator mocks base method.
+func (m *MockState) GetSecretGenerator(arg0 context.Context, arg1 *secrets.URI) (*secrets.Generator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretGenerator", arg0, arg1)
+	ret0, _ := ret[0].(*secrets.Generator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretGenerator indicates an expected call of GetSecretGenerator.
+func (mr *MockStateMockRecorder) GetSecretGenerator(arg0, arg1 any) *MockStateGetSecretGeneratorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretGenerator", reflect.TypeOf((*MockState)(nil).GetSecretGenerator), arg0, arg1)
+	return &MockStateGetSecretGeneratorCall{Call: call}
+}
+
+// MockStateGetSecretGeneratorCall wrap *gomock.Call
+type MockStateGetSecretGeneratorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretGeneratorCall) Return(arg0 *secrets.Generator, arg1 error) *MockStateGetSecretGeneratorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretGeneratorCall) Do(f func(context.Context, *secrets.URI) (*secrets.Generator, error)) *MockStateGetSecretGeneratorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretGeneratorCall) DoAndReturn(f func(context.Context, *secrets.URI) (*secrets.Generator, error)) *MockStateGetSecretGeneratorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretGrants mocks base method.
 func (m *MockState) GetSecretGrants(arg0 context.Context, arg1 *secrets.URI, arg2 secrets.SecretRole) ([]secret.GrantParams, error) {
 	m.ctrl.T.Helper()
@@ -1561,6 +1716,50 @@ func (c *MockStateGetUserSecretURIByLabelCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// GetUserSecretsRotationChanges mocks base method.
+func (m *MockState) GetUserSecretsRotationChanges(arg0 context.Context, arg1 ...string) ([]secret.RotationInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserSecretsRotationChanges", varargs...)
+	ret0, _ := ret[0].([]secret.RotationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSecretsRotationChanges indicates an expected call of GetUserSecretsRotationChanges.
+func (mr *MockStateMockRecorder) GetUserSecretsRotationChanges(arg0 any, arg1 ...any) *MockStateGetUserSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSecretsRotationChanges", reflect.TypeOf((*MockState)(nil).GetUserSecretsRotationChanges), varargs...)
+	return &MockStateGetUserSecretsRotationChangesCall{Call: call}
+}
+
+// MockStateGetUserSecretsRotationChangesCall wrap *gomock.Call
+type MockStateGetUserSecretsRotationChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetUserSecretsRotationChangesCall) Return(arg0 []secret.RotationInfo, arg1 error) *MockStateGetUserSecretsRotationChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetUserSecretsRotationChangesCall) Do(f func(context.Context, ...string) ([]secret.RotationInfo, error)) *MockStateGetUserSecretsRotationChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetUserSecretsRotationChangesCall) DoAndReturn(f func(context.Context, ...string) ([]secret.RotationInfo, error)) *MockStateGetUserSecretsRotationChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GrantAccess mocks base method.
 func (m *MockState) GrantAccess(arg0 context.Context, arg1 *secrets.URI, arg2 secret.GrantParams) error {
 	m.ctrl.T.Helper()
@@ -1872,6 +2071,45 @@ func (c *MockStateInitialWatchStatementForSecretsRotationChangesCall) DoAndRetur
 	return c
 }
 
+// InitialWatchStatementForUserSecretsRotationChanges mocks base method.
+func (m *MockState) InitialWatchStatementForUserSecretsRotationChanges() (string, eventsource.NamespaceQuery) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitialWatchStatementForUserSecretsRotationChanges")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(eventsource.NamespaceQuery)
+	return ret0, ret1
+}
+
+// InitialWatchStatementForUserSecretsRotationChanges indicates an expected call of InitialWatchStatementForUserSecretsRotationChanges.
+func (mr *MockStateMockRecorder) InitialWatchStatementForUserSecretsRotationChanges() *MockStateInitialWatchStatementForUserSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialWatchStatementForUserSecretsRotationChanges", reflect.TypeOf((*MockState)(nil).InitialWatchStatementForUserSecretsRotationChanges))
+	return &MockStateInitialWatchStatementForUserSecretsRotationChangesCall{Call: call}
+}
+
+// MockStateInitialWatchStatementForUserSecretsRotationChangesCall wrap *gomock.Call
+type MockStateInitialWatchStatementForUserSecretsRotationChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInitialWatchStatementForUserSecretsRotationChangesCall) Return(arg0 string, arg1 eventsource.NamespaceQuery) *MockStateInitialWatchStatementForUserSecretsRotationChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInitialWatchStatementForUserSecretsRotationChangesCall) Do(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementForUserSecretsRotationChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInitialWatchStatementForUserSecretsRotationChangesCall) DoAndReturn(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementForUserSecretsRotationChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListCharmSecrets mocks base method.
 func (m *MockState) ListCharmSecrets(arg0 context.Context, arg1 secret.ApplicationOwners, arg2 secret.UnitOwners) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
@@ -2069,6 +2307,44 @@ func (c *MockStateListUserSecretsToDrainCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// MigrateSecretBackend mocks base method.
+func (m *MockState) MigrateSecretBackend(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 *secrets.ValueRef, arg4 secrets.SecretData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSecretBackend", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateSecretBackend indicates an expected call of MigrateSecretBackend.
+func (mr *MockStateMockRecorder) MigrateSecretBackend(arg0, arg1, arg2, arg3, arg4 any) *MockStateMigrateSecretBackendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSecretBackend", reflect.TypeOf((*MockState)(nil).MigrateSecretBackend), arg0, arg1, arg2, arg3, arg4)
+	return &MockStateMigrateSecretBackendCall{Call: call}
+}
+
+// MockStateMigrateSecretBackendCall wrap *gomock.Call
+type MockStateMigrateSecretBackendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateMigrateSecretBackendCall) Return(arg0 error) *MockStateMigrateSecretBackendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateMigrateSecretBackendCall) Do(f func(context.Context, uuid.UUID, *secrets.ValueRef, *secrets.ValueRef, secrets.SecretData) error) *MockStateMigrateSecretBackendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateMigrateSecretBackendCall) DoAndReturn(f func(context.Context, uuid.UUID, *secrets.ValueRef, *secrets.ValueRef, secrets.SecretData) error) *MockStateMigrateSecretBackendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NamespaceForWatchSecretMetadata mocks base method.
 func (m *MockState) NamespaceForWatchSecretMetadata() string {
 	m.ctrl.T.Helper()
@@ -48,6 +48,12 @@ type UpdateUserSecretParams struct {
 	Data        secrets.SecretData
 	Checksum    string
 	AutoPrune   *bool
+
+	// RotatePolicy, Generator and KeepRevisions are used for
+	// secrets whose content is generated and rotated by Juju.
+	RotatePolicy  *secrets.RotatePolicy
+	Generator     *secrets.Generator
+	KeepRevisions *int
 }
 
 // DeleteSecretParams are used to delete a secret.
@@ -91,6 +97,7 @@ const (
 	RemoteApplicationAccessor SecretAccessorKind = "remote-application"
 	UnitAccessor              SecretAccessorKind = "unit"
 	ModelAccessor             SecretAccessorKind = "model"
+	UserAccessor              SecretAccessorKind = "user"
 )
 
 // GrantedSecretsGetter returns the revisions on the given backend for which
@@ -178,3 +185,40 @@ type RemoteSecret struct {
 	LatestRevision  int
 	Accessor        SecretAccessor
 }
+
+// MigrateSecretsParams identifies the secret backends between which
+// secret content is migrated.
+type MigrateSecretsParams struct {
+	// FromBackend is the name of the backend the content is moved out of.
+	FromBackend string
+	// ToBackend is the name of the backend the content is moved into.
+	ToBackend string
+}
+
+// SecretRevisionsToMigrate holds the revisions of a secret which are stored in
+// the backend being migrated from.
+type SecretRevisionsToMigrate struct {
+	URI       *secrets.URI
+	Label     string
+	Owner     secrets.Owner
+	Revisions []int
+}
+
+// MigrateSecretRevisionResult holds the outcome of migrating a single secret
+// revision to another backend.
+type MigrateSecretRevisionResult struct {
+	// Skipped is true if the revision was already stored in the target backend.
+	Skipped bool
+	// Checksum is the checksum of the revision content, verified against
+	// the content read back from the target backend.
+	Checksum string
+}
+
+// SecretAccessLogEntry describes an attempt to read the
+// content of a secret revision.
+type SecretAccessLogEntry struct {
+	Accessor   SecretAccessor
+	Revision   int
+	AccessTime time.Time
+	Denied     bool
+}
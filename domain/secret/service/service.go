@@ -13,6 +13,7 @@ import (
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/logger"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/trace"
 	coreunit "github.com/juju/juju/core/unit"
@@ -207,15 +208,38 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 		span.End()
 	}()
 
+	if params.Generator != nil {
+		if len(params.Data) > 0 {
+			return errors.Errorf("must specify either content or a generator but not both: %w", coreerrors.NotValid)
+		}
+		data, checksum, err := generateContent(*params.Generator, s.clock.Now())
+		if err != nil {
+			return errors.Capture(err)
+		}
+		params.Data = data
+		params.Checksum = checksum
+	}
 	if len(params.Data) == 0 {
 		return errors.Errorf("empty secret value %w", coreerrors.NotValid)
 	}
+	if err := validateGeneratorParams(params.UpdateUserSecretParams, params.Generator != nil); err != nil {
+		return errors.Capture(err)
+	}
 
 	p := domainsecret.UpsertSecretParams{
-		Description: params.Description,
-		Label:       params.Label,
-		AutoPrune:   params.AutoPrune,
-		Checksum:    params.Checksum,
+		Description:   params.Description,
+		Label:         params.Label,
+		AutoPrune:     params.AutoPrune,
+		Checksum:      params.Checksum,
+		Generator:     params.Generator,
+		KeepRevisions: params.KeepRevisions,
+	}
+	if params.RotatePolicy != nil {
+		rotatePolicy := domainsecret.MarshallRotatePolicy(params.RotatePolicy)
+		p.RotatePolicy = &rotatePolicy
+		if params.RotatePolicy.WillRotate() {
+			p.NextRotateTime = params.RotatePolicy.NextRotateTime(s.clock.Now())
+		}
 	}
 	// Take a copy as we may set it to nil below
 	// if the content is saved to a backend.
@@ -281,6 +305,27 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 	return nil
 }
 
+// validateGeneratorParams checks that the rotation parameters of a user
+// secret are only used for secrets with a generator.
+func validateGeneratorParams(params UpdateUserSecretParams, hasGenerator bool) error {
+	if params.RotatePolicy != nil && !params.RotatePolicy.IsValid() {
+		return errors.Errorf("rotate policy %q %w", *params.RotatePolicy, coreerrors.NotValid)
+	}
+	if hasGenerator {
+		if params.KeepRevisions != nil && *params.KeepRevisions < 1 {
+			return errors.Errorf("keep revisions %d must be at least 1: %w", *params.KeepRevisions, coreerrors.NotValid)
+		}
+		return nil
+	}
+	if params.RotatePolicy.WillRotate() {
+		return errors.Errorf("rotating a user secret requires a generator: %w", coreerrors.NotValid)
+	}
+	if params.KeepRevisions != nil {
+		return errors.Errorf("keeping revisions of a user secret requires a generator: %w", coreerrors.NotValid)
+	}
+	return nil
+}
+
 func ptr[T any](s T) *T {
 	return &s
 }
@@ -376,16 +421,57 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
+	if params.Generator != nil && len(params.Data) > 0 {
+		return errors.Errorf("must specify either content or a generator but not both: %w", coreerrors.NotValid)
+	}
+
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
 		return errors.Capture(err)
 	}
 
+	hasGenerator := params.Generator != nil
+	if !hasGenerator && (params.RotatePolicy.WillRotate() || params.KeepRevisions != nil) {
+		_, err := s.secretState.GetSecretGenerator(ctx, uri)
+		if err != nil && !errors.Is(err, secreterrors.SecretGeneratorNotFound) {
+			return errors.Capture(err)
+		}
+		hasGenerator = err == nil
+	}
+	if err := validateGeneratorParams(params, hasGenerator); err != nil {
+		return errors.Capture(err)
+	}
+
 	p := domainsecret.UpsertSecretParams{
-		Description: params.Description,
-		Label:       params.Label,
-		AutoPrune:   params.AutoPrune,
-		Checksum:    params.Checksum,
+		Description:   params.Description,
+		Label:         params.Label,
+		AutoPrune:     params.AutoPrune,
+		Checksum:      params.Checksum,
+		Generator:     params.Generator,
+		KeepRevisions: params.KeepRevisions,
+	}
+	if params.RotatePolicy != nil {
+		rotatePolicy := domainsecret.MarshallRotatePolicy(params.RotatePolicy)
+		p.RotatePolicy = &rotatePolicy
+		if params.RotatePolicy.WillRotate() {
+			policy, err := s.secretState.GetRotatePolicy(ctx, uri)
+			if err != nil {
+				return errors.Capture(err)
+			}
+			if !policy.WillRotate() {
+				p.NextRotateTime = params.RotatePolicy.NextRotateTime(s.clock.Now())
+			}
+		}
+	}
+	if params.Generator != nil {
+		// A new generator produces a new revision straight away.
+		data, checksum, err := generateContent(*params.Generator, s.clock.Now())
+		if err != nil {
+			return errors.Capture(err)
+		}
+		params.Data = data
+		params.Checksum = checksum
+		p.Checksum = checksum
 	}
 
 	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
@@ -719,24 +805,61 @@ func (s *SecretService) ListUserSecretsToDrain(ctx context.Context) ([]*secrets.
 
 // GetSecretValue returns the value of the specified secret revision.
 // If returns [secreterrors.SecretRevisionNotFound] is there's no such secret revision.
+// The attempt to read the secret is recorded in the secret access log,
+// including when the accessor is denied.
 func (s *SecretService) GetSecretValue(ctx context.Context, uri *secrets.URI, rev int, accessor SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error) {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
 	if err := s.canRead(ctx, uri, accessor); err != nil {
+		if errors.Is(err, secreterrors.PermissionDenied) {
+			s.recordAccess(ctx, uri, rev, accessor, true)
+		}
 		return nil, nil, errors.Capture(err)
 	}
 	data, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
-	return secrets.NewSecretValue(data), ref, errors.Capture(err)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	s.recordAccess(ctx, uri, rev, accessor, false)
+	return secrets.NewSecretValue(data), ref, nil
+}
+
+// GetSecretValueToDrain returns the value of the specified secret revision
+// so it can be moved to another backend.
+// If returns [secreterrors.SecretRevisionNotFound] is there's no such secret revision.
+// Draining isn't a read of the content by a consumer so it isn't recorded in
+// the secret access log.
+func (s *SecretService) GetSecretValueToDrain(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, *secrets.ValueRef, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	data, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	return secrets.NewSecretValue(data), ref, nil
 }
 
 // GetSecretContentFromBackend retrieves the content for the specified secret revision.
 // If the content is not found, it may be that the secret has been drained so it tries
-// again using the new active backend.
-func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error) {
+// again using the new active backend. The read by the accessor is recorded in the
+// secret access log.
+func (s *SecretService) GetSecretContentFromBackend(
+	ctx context.Context, uri *secrets.URI, rev int, accessor SecretAccessor,
+) (secrets.SecretValue, error) {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
+	val, err := s.getSecretContentFromBackend(ctx, uri, rev)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	s.recordAccess(ctx, uri, rev, accessor, false)
+	return val, nil
+}
+
+func (s *SecretService) getSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error) {
 	if s.activeBackendID == "" {
 		err := s.loadBackendInfo(ctx, false)
 		if err != nil {
@@ -947,29 +1070,63 @@ func (s *SecretService) ChangeSecretBackend(
 		return errors.Errorf("getting model uuid: %w", err)
 	}
 
-	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
-		rollBack, err := s.secretBackendState.UpdateSecretBackendReference(
-			innerCtx, params.ValueRef, modelID, revisionID.String())
-		if err != nil {
-			return errors.Capture(err)
-		}
+	return withCaveat(ctx, func(innerCtx context.Context) error {
+		return s.updateSecretRevisionBackend(innerCtx, modelID, revisionID, params.ValueRef, params.Data)
+	})
+}
 
-		defer func() {
-			if errOut != nil {
-				if err := rollBack(); err != nil {
-					s.logger.Warningf(ctx, "failed to roll back secret reference count: %v", err)
-				}
-			}
-		}()
+// updateSecretRevisionBackend records that the specified secret revision is
+// now stored in the backend referenced by valueRef, or in the Juju database
+// if valueRef is nil.
+func (s *SecretService) updateSecretRevisionBackend(
+	ctx context.Context, modelID coremodel.UUID, revisionID uuid.UUID, valueRef *secrets.ValueRef, data secrets.SecretData,
+) error {
+	return s.changeSecretRevisionBackend(ctx, modelID, revisionID, valueRef, func(ctx context.Context) error {
+		return s.secretState.ChangeSecretBackend(ctx, revisionID, valueRef, data)
+	})
+}
 
-		err = s.secretState.ChangeSecretBackend(innerCtx, revisionID, params.ValueRef, params.Data)
-		if err != nil {
-			return errors.Capture(err)
-		}
-		return nil
+// migrateSecretRevisionBackend is like updateSecretRevisionBackend, but the
+// revision is only changed if it is still stored in the backend referenced by
+// from, or in the Juju database if from is nil. This ensures that a change
+// made whilst the revision was being migrated isn't overwritten.
+func (s *SecretService) migrateSecretRevisionBackend(
+	ctx context.Context, modelID coremodel.UUID, revisionID uuid.UUID,
+	from, valueRef *secrets.ValueRef, data secrets.SecretData,
+) error {
+	return s.changeSecretRevisionBackend(ctx, modelID, revisionID, valueRef, func(ctx context.Context) error {
+		return s.secretState.MigrateSecretBackend(ctx, revisionID, from, valueRef, data)
 	})
 }
 
+// changeSecretRevisionBackend updates the reference count of the backend
+// referenced by valueRef, and then changes the backend of the secret revision
+// with the change function. The reference count is rolled back if the change
+// fails.
+func (s *SecretService) changeSecretRevisionBackend(
+	ctx context.Context, modelID coremodel.UUID, revisionID uuid.UUID, valueRef *secrets.ValueRef,
+	change func(context.Context) error,
+) (errOut error) {
+	rollBack, err := s.secretBackendState.UpdateSecretBackendReference(
+		ctx, valueRef, modelID, revisionID.String())
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	defer func() {
+		if errOut != nil {
+			if err := rollBack(); err != nil {
+				s.logger.Warningf(ctx, "failed to roll back secret reference count: %v", err)
+			}
+		}
+	}()
+
+	if err := change(ctx); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
 // SecretRotated rotates the secret with the specified URI.
 func (s *SecretService) SecretRotated(ctx context.Context, uri *secrets.URI, params SecretRotatedParams) error {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
@@ -1014,3 +1171,51 @@ func (s *SecretService) SecretRotated(ctx context.Context, uri *secrets.URI, par
 		return s.secretState.SecretRotated(innerCtx, uri, nextRotateTime)
 	})
 }
+
+// RotateUserSecret creates a new revision of the specified user secret using
+// its generator, and records that the secret has been rotated so that the next
+// rotation is scheduled. Consumers are notified of the new revision in the
+// same way as for any other update. It returns an error satisfying
+// [secreterrors.SecretGeneratorNotFound] if the secret has no generator.
+func (s *SecretService) RotateUserSecret(ctx context.Context, uri *secrets.URI) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	modelUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return errors.Errorf("getting model uuid: %w", err)
+	}
+	accessor := SecretAccessor{Kind: ModelAccessor, ID: modelUUID.String()}
+
+	generator, err := s.secretState.GetSecretGenerator(ctx, uri)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	latestRevision, err := s.secretState.GetLatestRevision(ctx, uri)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	// If generating the new content fails, the secret is still marked as
+	// rotated so that the rotation is retried after a short delay.
+	data, checksum, genErr := generateContent(*generator, s.clock.Now())
+	if genErr == nil {
+		genErr = s.UpdateUserSecret(ctx, uri, UpdateUserSecretParams{
+			Accessor: accessor,
+			Data:     data,
+			Checksum: checksum,
+		})
+	}
+	if genErr != nil {
+		s.logger.Warningf(ctx, "generating new revision for secret %q: %v", uri.ID, genErr)
+	}
+
+	err = s.SecretRotated(ctx, uri, SecretRotatedParams{
+		Accessor:         accessor,
+		OriginalRevision: latestRevision,
+	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(genErr)
+}
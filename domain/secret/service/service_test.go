@@ -885,6 +885,13 @@ func (s *serviceSuite) TestGetSecretValue(c *tc.C) {
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
 	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), domainsecret.AccessLogEntry{
+		URI:            uri,
+		Revision:       666,
+		AccessorTypeID: domainsecret.AccessorUnit,
+		AccessorID:     "mariadb/0",
+		AccessTime:     s.clock.Now(),
+	}).Return(nil)
 
 	data, ref, err := s.service.GetSecretValue(c.Context(), uri, 666, SecretAccessor{
 		Kind: UnitAccessor,
@@ -895,6 +902,71 @@ func (s *serviceSuite) TestGetSecretValue(c *tc.C) {
 	c.Assert(data, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
 }
 
+func (s *serviceSuite) TestGetSecretValueDeniedIsRecorded(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("none", nil)
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mariadb",
+	}).Return("none", nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), domainsecret.AccessLogEntry{
+		URI:            uri,
+		Revision:       666,
+		AccessorTypeID: domainsecret.AccessorUnit,
+		AccessorID:     "mariadb/0",
+		AccessTime:     s.clock.Now(),
+		Denied:         true,
+	}).Return(nil)
+
+	_, _, err := s.service.GetSecretValue(c.Context(), uri, 666, SecretAccessor{
+		Kind: UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.PermissionDenied)
+}
+
+func (s *serviceSuite) TestGetSecretValueRecordAccessFailed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     "model-uuid",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().AddSecretAccessLogEntry(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	// The read succeeds even though the access couldn't be recorded.
+	data, ref, err := s.service.GetSecretValue(c.Context(), uri, 1, SecretAccessor{
+		Kind: ModelAccessor,
+		ID:   "model-uuid",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ref, tc.IsNil)
+	c.Assert(data, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+}
+
+func (s *serviceSuite) TestGetSecretValueToDrain(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	ref := &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "rev-id"}
+
+	// No access check is made and no access is recorded.
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, ref, nil)
+
+	_, gotRef, err := s.service.GetSecretValueToDrain(c.Context(), uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(gotRef, tc.DeepEquals, ref)
+}
+
 func (s *serviceSuite) TestGetSecretConsumer(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -2561,3 +2633,129 @@ func (s *serviceSuite) TestWatchSecretRevisionsExpiryChanges(c *tc.C) {
 	)
 	wC.AssertNoChange()
 }
+
+func (s *serviceSuite) TestCreateUserSecretGeneratorAndContent(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateUserSecret(c.Context(), coresecrets.NewURI(), CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor:  SecretAccessor{Kind: ModelAccessor, ID: s.modelID.String()},
+			Data:      map[string]string{"foo": "bar"},
+			Generator: &coresecrets.Generator{Type: coresecrets.GeneratePassword},
+		},
+		Version: 1,
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, tc.ErrorMatches, "must specify either content or a generator but not both.*")
+}
+
+func (s *serviceSuite) TestCreateUserSecretRotateWithoutGenerator(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateUserSecret(c.Context(), coresecrets.NewURI(), CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor:     SecretAccessor{Kind: ModelAccessor, ID: s.modelID.String()},
+			Data:         map[string]string{"foo": "bar"},
+			RotatePolicy: ptr(coresecrets.RotateDaily),
+		},
+		Version: 1,
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, tc.ErrorMatches, "rotating a user secret requires a generator.*")
+}
+
+func (s *serviceSuite) TestCreateUserSecretInvalidKeepRevisions(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateUserSecret(c.Context(), coresecrets.NewURI(), CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor:      SecretAccessor{Kind: ModelAccessor, ID: s.modelID.String()},
+			Generator:     &coresecrets.Generator{Type: coresecrets.GeneratePassword},
+			KeepRevisions: ptr(0),
+		},
+		Version: 1,
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, tc.ErrorMatches, "keep revisions 0 must be at least 1.*")
+}
+
+func (s *serviceSuite) TestUpdateUserSecretKeepRevisionsWithoutGenerator(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretGenerator(gomock.Any(), uri).Return(nil, secreterrors.SecretGeneratorNotFound)
+
+	err := s.service.UpdateUserSecret(c.Context(), uri, UpdateUserSecretParams{
+		Accessor:      SecretAccessor{Kind: ModelAccessor, ID: s.modelID.String()},
+		KeepRevisions: ptr(3),
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, tc.ErrorMatches, "keeping revisions of a user secret requires a generator.*")
+}
+
+func (s *serviceSuite) TestUpdateUserSecretRotateWithExistingGenerator(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretGenerator(gomock.Any(), uri).Return(&coresecrets.Generator{Type: coresecrets.GenerateSSHKey}, nil)
+	s.state.EXPECT().GetRotatePolicy(gomock.Any(), uri).Return(coresecrets.RotateNever, nil)
+	s.state.EXPECT().UpdateSecret(gomock.Any(), uri, domainsecret.UpsertSecretParams{
+		RotatePolicy:   ptr(domainsecret.RotateDaily),
+		NextRotateTime: ptr(s.clock.Now().AddDate(0, 0, 1)),
+		KeepRevisions:  ptr(2),
+	}).Return(nil)
+
+	err := s.service.UpdateUserSecret(c.Context(), uri, UpdateUserSecretParams{
+		Accessor:      SecretAccessor{Kind: ModelAccessor, ID: s.modelID.String()},
+		RotatePolicy:  ptr(coresecrets.RotateDaily),
+		KeepRevisions: ptr(2),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRotateUserSecretNoGenerator(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	s.state.EXPECT().GetSecretGenerator(gomock.Any(), uri).Return(nil, secreterrors.SecretGeneratorNotFound)
+
+	err := s.service.RotateUserSecret(c.Context(), uri)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretGeneratorNotFound)
+}
+
+func (s *serviceSuite) TestRotateUserSecretUpdateFailedStillRotated(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	s.state.EXPECT().GetSecretGenerator(gomock.Any(), uri).Return(&coresecrets.Generator{Type: coresecrets.GeneratePassword}, nil)
+	s.state.EXPECT().GetLatestRevision(gomock.Any(), uri).Return(666, nil)
+	accessParams := domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}
+	// Updating the secret fails on the permission check.
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, accessParams).Return("view", nil)
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, accessParams).Return("manage", nil)
+	s.state.EXPECT().GetRotationExpiryInfo(gomock.Any(), uri).Return(&domainsecret.RotationExpiryInfo{
+		RotatePolicy:   coresecrets.RotateHourly,
+		LatestRevision: 666,
+	}, nil)
+	s.state.EXPECT().SecretRotated(gomock.Any(), uri, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, uri *coresecrets.URI, next time.Time) error {
+			c.Check(next, tc.Almost, s.clock.Now().Add(coresecrets.RotateRetryDelay))
+			return nil
+		})
+
+	err := s.service.RotateUserSecret(c.Context(), uri)
+	c.Assert(err, tc.ErrorIs, secreterrors.PermissionDenied)
+}
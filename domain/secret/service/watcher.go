@@ -401,6 +401,38 @@ func (s *WatchableService) WatchSecretsRotationChanges(ctx context.Context, owne
 	return newSecretStringWatcher(w, s.logger, processChanges)
 }
 
+// WatchUserSecretsRotationChanges returns a watcher that notifies when the
+// rotation time of a user secret with a generator changes.
+func (s *WatchableService) WatchUserSecretsRotationChanges(ctx context.Context) (watcher.SecretTriggerWatcher, error) {
+	_, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	table, query := s.secretState.InitialWatchStatementForUserSecretsRotationChanges()
+	w, err := s.watcherFactory.NewNamespaceWatcher(
+		query,
+		eventsource.NamespaceFilter(table, changestream.All),
+	)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	processChanges := func(ctx context.Context, secretIDs ...string) ([]watcher.SecretTriggerChange, error) {
+		result, err := s.secretState.GetUserSecretsRotationChanges(ctx, secretIDs...)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		changes := make([]watcher.SecretTriggerChange, len(result))
+		for i, r := range result {
+			changes[i] = watcher.SecretTriggerChange{
+				URI:             r.URI,
+				Revision:        r.Revision,
+				NextTriggerTime: r.NextTriggerTime,
+			}
+		}
+		return changes, nil
+	}
+	return newSecretStringWatcher(w, s.logger, processChanges)
+}
+
 // WatchObsoleteUserSecretsToPrune returns a watcher that notifies when a user secret revision is obsolete and ready to be pruned.
 func (s *WatchableService) WatchObsoleteUserSecretsToPrune(ctx context.Context) (watcher.NotifyWatcher, error) {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
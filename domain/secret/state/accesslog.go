@@ -0,0 +1,117 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// AddSecretAccessLogEntry records an attempt to read the content of a
+// secret revision.
+func (st State) AddSecretAccessLogEntry(ctx context.Context, entry domainsecret.AccessLogEntry) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := secretAccessLogEntry{
+		SecretID:       entry.URI.ID,
+		Revision:       entry.Revision,
+		AccessorTypeID: int(entry.AccessorTypeID),
+		AccessorID:     entry.AccessorID,
+		AccessedAt:     entry.AccessTime.UTC(),
+		Denied:         entry.Denied,
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO secret_access_log (secret_id, revision, accessor_type_id, accessor_id, accessed_at, denied)
+VALUES ($secretAccessLogEntry.*)`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, row).Run(); err != nil {
+			return errors.Errorf("recording access to secret %q: %w", entry.URI.ID, err)
+		}
+		return nil
+	})
+}
+
+// GetSecretAccessLog returns the recorded attempts to read the content of
+// the specified secret, ordered from the most recent to the oldest. If limit
+// is positive, at most that many entries are returned.
+func (st State) GetSecretAccessLog(ctx context.Context, uri *coresecrets.URI, limit int) ([]domainsecret.AccessLogEntry, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	id := secretID{ID: uri.ID}
+	args := []any{id}
+	query := `
+SELECT &secretAccessLogEntry.*
+FROM   secret_access_log
+WHERE  secret_id = $secretID.id
+ORDER BY accessed_at DESC, id DESC`
+	if limit > 0 {
+		query += `
+LIMIT $accessLogLimit.limit`
+		args = append(args, accessLogLimit{Limit: limit})
+	}
+	stmt, err := st.Prepare(query, append(args, secretAccessLogEntry{})...)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows secretAccessLogEntries
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, args...).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("reading access log for secret %q: %w", uri.ID, err)
+	}
+	return rows.toAccessLog(uri), nil
+}
+
+// DeleteSecretAccessLogBefore deletes all the secret access log entries
+// recorded before the given time. The number of deleted entries is returned.
+func (st State) DeleteSecretAccessLogBefore(ctx context.Context, before time.Time) (int64, error) {
+	db, err := st.DB()
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	cutoff := accessLogCutoff{Before: before.UTC()}
+	stmt, err := st.Prepare(`
+DELETE FROM secret_access_log
+WHERE accessed_at < $accessLogCutoff.before`, cutoff)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var deleted int64
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, cutoff).Get(&outcome); err != nil {
+			return errors.Capture(err)
+		}
+		deleted, err = outcome.Result().RowsAffected()
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return 0, errors.Errorf("deleting secret access log: %w", err)
+	}
+	return deleted, nil
+}
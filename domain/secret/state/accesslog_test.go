@@ -0,0 +1,91 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/tc"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+)
+
+func (s *stateSuite) TestSecretAccessLog(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	ctx := c.Context()
+	uri := coresecrets.NewURI()
+	other := coresecrets.NewURI()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	entries := []domainsecret.AccessLogEntry{{
+		URI:            uri,
+		Revision:       1,
+		AccessorTypeID: domainsecret.AccessorUnit,
+		AccessorID:     "mysql/0",
+		AccessTime:     now.Add(-2 * time.Hour),
+	}, {
+		URI:            other,
+		Revision:       1,
+		AccessorTypeID: domainsecret.AccessorApplication,
+		AccessorID:     "mysql",
+		AccessTime:     now.Add(-time.Hour),
+	}, {
+		URI:            uri,
+		Revision:       2,
+		AccessorTypeID: domainsecret.AccessorUser,
+		AccessorID:     "fred",
+		AccessTime:     now,
+		Denied:         true,
+	}}
+	for _, entry := range entries {
+		err := st.AddSecretAccessLogEntry(ctx, entry)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	result, err := st.GetSecretAccessLog(ctx, uri, 0)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, []domainsecret.AccessLogEntry{entries[2], entries[0]})
+
+	result, err = st.GetSecretAccessLog(ctx, uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, []domainsecret.AccessLogEntry{entries[2]})
+}
+
+func (s *stateSuite) TestGetSecretAccessLogEmpty(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	result, err := st.GetSecretAccessLog(c.Context(), coresecrets.NewURI(), 0)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestDeleteSecretAccessLogBefore(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	ctx := c.Context()
+	uri := coresecrets.NewURI()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for i := range 3 {
+		err := st.AddSecretAccessLogEntry(ctx, domainsecret.AccessLogEntry{
+			URI:            uri,
+			Revision:       1,
+			AccessorTypeID: domainsecret.AccessorUnit,
+			AccessorID:     "mysql/0",
+			AccessTime:     now.Add(-time.Duration(i) * time.Hour),
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	deleted, err := st.DeleteSecretAccessLogBefore(ctx, now.Add(-90*time.Minute))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(deleted, tc.Equals, int64(1))
+
+	result, err := st.GetSecretAccessLog(ctx, uri, 0)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 2)
+	c.Assert(result[1].AccessTime, tc.Equals, now.Add(-time.Hour))
+}
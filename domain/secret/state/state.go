@@ -441,6 +441,10 @@ VALUES ($secretID.id)`
 		}
 	}
 
+	if err := st.upsertSecretGeneratorFromParams(ctx, tx, uri, secret); err != nil {
+		return errors.Errorf("inserting generator for secret %q: %w", uri, err)
+	}
+
 	if len(secret.Data) > 0 {
 		if err := st.updateSecretContent(ctx, tx, dbRevision.ID, secret.Data); err != nil {
 			return errors.Errorf("updating content for secret %q: %w", uri, err)
@@ -607,6 +611,10 @@ GROUP BY sm.secret_id`
 		}
 	}
 
+	if err := st.upsertSecretGeneratorFromParams(ctx, tx, uri, secret); err != nil {
+		return errors.Errorf("updating generator for secret %q: %w", uri, err)
+	}
+
 	var dbRevision *secretRevision
 	shouldCreateNewRevision := (len(secret.Data) > 0 || secret.ValueRef != nil) && (secret.Checksum != existing.LatestRevisionChecksum ||
 		// migrated charm-owned secrets from old models.
@@ -888,6 +896,90 @@ ON CONFLICT(secret_id) DO UPDATE SET
 	return nil
 }
 
+// upsertSecretGeneratorFromParams records the generator and the number
+// of revisions to keep for a user secret, if either is specified.
+func (st State) upsertSecretGeneratorFromParams(
+	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI, secret domainsecret.UpsertSecretParams,
+) error {
+	if secret.Generator != nil {
+		generator := newSecretGenerator(uri.ID, *secret.Generator)
+		upsertStmt, err := st.Prepare(`
+INSERT INTO secret_generator (secret_id, generator_type_id, length, charset, common_name, validity)
+VALUES ($secretGenerator.secret_id,
+        $secretGenerator.generator_type_id,
+        $secretGenerator.length,
+        $secretGenerator.charset,
+        $secretGenerator.common_name,
+        $secretGenerator.validity)
+ON CONFLICT(secret_id) DO UPDATE SET
+    generator_type_id=excluded.generator_type_id,
+    length=excluded.length,
+    charset=excluded.charset,
+    common_name=excluded.common_name,
+    validity=excluded.validity`, generator)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, upsertStmt, generator).Run(); err != nil {
+			return errors.Capture(err)
+		}
+	}
+	if secret.KeepRevisions == nil {
+		return nil
+	}
+
+	keep := secretKeepRevisions{SecretID: uri.ID, KeepRevisions: *secret.KeepRevisions}
+	updateStmt, err := st.Prepare(`
+UPDATE secret_generator
+SET    keep_revisions = $secretKeepRevisions.keep_revisions
+WHERE  secret_id = $secretKeepRevisions.secret_id`, keep)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	var outcome sqlair.Outcome
+	if err := tx.Query(ctx, updateStmt, keep).Get(&outcome); err != nil {
+		return errors.Capture(err)
+	}
+	if n, err := outcome.Result().RowsAffected(); err != nil {
+		return errors.Capture(err)
+	} else if n == 0 {
+		return errors.Errorf("keeping revisions of secret %q: %w", uri, secreterrors.SecretGeneratorNotFound)
+	}
+	return nil
+}
+
+// GetSecretGenerator returns the generator used to create new content for the
+// specified user secret, returning an error satisfying
+// [secreterrors.SecretGeneratorNotFound] if the secret has no generator.
+func (st State) GetSecretGenerator(ctx context.Context, uri *coresecrets.URI) (*coresecrets.Generator, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	input := secretID{ID: uri.ID}
+	stmt, err := st.Prepare(`
+SELECT &secretGenerator.*
+FROM   secret_generator
+WHERE  secret_id = $secretID.id`, input, secretGenerator{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var result secretGenerator
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, input).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("secret %q: %w", uri, secreterrors.SecretGeneratorNotFound)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return result.toGenerator(), nil
+}
+
 func (st State) upsertSecretRevision(
 	ctx context.Context, tx *sqlair.TX, dbRevision *secretRevision,
 ) error {
@@ -3414,8 +3506,9 @@ func (st State) DeleteSecret(ctx domain.AtomicContext, uri *coresecrets.URI, rev
 	return nil
 }
 
-// DeleteObsoleteUserSecretRevisions deletes the obsolete user secret revisions.
-// It returns the string format UUID of the deleted revisions.
+// DeleteObsoleteUserSecretRevisions deletes the obsolete user secret revisions
+// which are either marked as auto-prune, or are older than the number of
+// revisions to keep for secrets with a generator. It returns the string format UUID of the deleted revisions.
 func (st State) DeleteObsoleteUserSecretRevisions(ctx context.Context) ([]string, error) {
 	db, err := st.DB()
 	if err != nil {
@@ -3429,7 +3522,16 @@ FROM   secret_model_owner smo
        JOIN secret_metadata sm ON sm.secret_id = smo.secret_id
        JOIN secret_revision sr ON sr.secret_id = smo.secret_id
        LEFT JOIN secret_revision_obsolete sro ON sro.revision_uuid = sr.uuid
-WHERE  sm.auto_prune = true AND sro.obsolete = true`
+       LEFT JOIN secret_generator sg ON sg.secret_id = smo.secret_id
+WHERE  sro.obsolete = true
+AND    (
+    sm.auto_prune = true
+    OR sr.revision <= (
+        SELECT MAX(latest.revision) - sg.keep_revisions
+        FROM   secret_revision latest
+        WHERE  latest.secret_id = sr.secret_id
+    )
+)`
 
 	stmt, err := st.Prepare(q, secretID{}, secretExternalRevision{})
 	if err != nil {
@@ -3593,6 +3695,8 @@ DELETE FROM secret_remote_unit_consumer WHERE secret_id = $secretID.id`
 DELETE FROM secret_reference WHERE secret_id = $secretID.id`
 	deleteSecretPermission := `
 DELETE FROM secret_permission WHERE secret_id = $secretID.id`
+	deleteSecretGenerator := `
+DELETE FROM secret_generator WHERE secret_id = $secretID.id`
 	deleteSecretMetadata := `
 DELETE FROM secret_metadata WHERE secret_id = $secretID.id`
 	deleteSecret := `
@@ -3607,6 +3711,7 @@ DELETE FROM secret WHERE id = $secretID.id`
 		deleteSecretRemoteUnitConsumer,
 		deleteSecretRef,
 		deleteSecretPermission,
+		deleteSecretGenerator,
 		deleteSecretMetadata,
 		deleteSecret,
 	}
@@ -3728,6 +3833,10 @@ GROUP BY sro.secret_id`
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
+	return toRotationInfo(data)
+}
+
+func toRotationInfo(data []secretRotationChange) ([]domainsecret.RotationInfo, error) {
 	result := make([]domainsecret.RotationInfo, len(data))
 	for i, d := range data {
 		result[i] = domainsecret.RotationInfo{
@@ -3743,10 +3852,96 @@ GROUP BY sro.secret_id`
 	return result, nil
 }
 
+func (st State) getUserSecretsRotationChanges(
+	ctx context.Context, runner domain.TxnRunner, secretIDs ...string,
+) ([]domainsecret.RotationInfo, error) {
+	q := `
+SELECT
+       sro.secret_id AS &secretRotationChange.secret_id,
+       sro.next_rotation_time AS &secretRotationChange.next_rotation_time,
+       MAX(sr.revision) AS &secretRotationChange.revision
+FROM   secret_rotation sro
+       JOIN secret_revision sr ON sr.secret_id = sro.secret_id
+       JOIN secret_model_owner smo ON smo.secret_id = sro.secret_id
+       JOIN secret_generator sg ON sg.secret_id = sro.secret_id`
+
+	var queryParams []any
+	if len(secretIDs) > 0 {
+		queryParams = append(queryParams, dbSecretIDs(secretIDs))
+		q += "\nWHERE sro.secret_id IN ($dbSecretIDs[:])"
+	}
+	q += `
+GROUP BY sro.secret_id`
+
+	stmt, err := st.Prepare(q, append(queryParams, secretRotationChange{})...)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var data []secretRotationChange
+	err = runner.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, queryParams...).GetAll(&data)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			// It's ok because the secret or the rotation was just deleted.
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return toRotationInfo(data)
+}
+
 // ChangeSecretBackend changes the secret backend for the specified secret.
 func (st State) ChangeSecretBackend(
 	ctx context.Context, revisionID uuid.UUID,
 	valueRef *coresecrets.ValueRef, data coresecrets.SecretData,
+) error {
+	return st.changeSecretBackend(ctx, revisionID, nil, valueRef, data)
+}
+
+// MigrateSecretBackend changes the secret backend for the specified secret
+// revision, as long as the revision is still stored in the backend referenced
+// by from, or in the Juju database if from is nil.
+// It returns an error satisfying [secreterrors.SecretRevisionBackendChanged]
+// if the revision is stored elsewhere.
+func (st State) MigrateSecretBackend(
+	ctx context.Context, revisionID uuid.UUID,
+	from, valueRef *coresecrets.ValueRef, data coresecrets.SecretData,
+) error {
+	currentQ, err := st.Prepare(`
+SELECT &secretValueRef.*
+FROM   secret_value_ref
+WHERE  revision_uuid = $revisionUUID.uuid`, secretValueRef{}, revisionUUID{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	check := func(ctx context.Context, tx *sqlair.TX, input revisionUUID) error {
+		var current secretValueRef
+		err := tx.Query(ctx, currentQ, input).Get(&current)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			if from == nil {
+				return nil
+			}
+		} else if err != nil {
+			return errors.Capture(err)
+		} else if from != nil && current.BackendUUID == from.BackendID && current.RevisionID == from.RevisionID {
+			return nil
+		}
+		return errors.Errorf("secret revision %q is no longer stored where it was read from", input.UUID).
+			Add(secreterrors.SecretRevisionBackendChanged)
+	}
+	return st.changeSecretBackend(ctx, revisionID, check, valueRef, data)
+}
+
+// changeSecretBackend changes the secret backend for the specified secret
+// revision, once the optional check function has passed in the same
+// transaction.
+func (st State) changeSecretBackend(
+	ctx context.Context, revisionID uuid.UUID,
+	check func(context.Context, *sqlair.TX, revisionUUID) error,
+	valueRef *coresecrets.ValueRef, data coresecrets.SecretData,
 ) (err error) {
 	if valueRef != nil && len(data) > 0 {
 		return errors.New("both valueRef and data cannot be set")
@@ -3776,6 +3971,11 @@ WHERE revision_uuid = $revisionUUID.uuid`, input)
 		return errors.Capture(err)
 	}
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if check != nil {
+			if err := check(ctx, tx, input); err != nil {
+				return errors.Capture(err)
+			}
+		}
 		if valueRef != nil {
 			if err := st.upsertSecretValueRef(ctx, tx, input.UUID, valueRef); err != nil {
 				return errors.Capture(err)
@@ -3832,6 +4032,36 @@ func (st State) GetSecretsRotationChanges(
 	return st.getSecretsRotationChanges(ctx, db, appOwners, unitOwners, secretIDs...)
 }
 
+// InitialWatchStatementForUserSecretsRotationChanges returns the initial watch
+// statement and the table name for watching the rotation of user secrets which
+// have a generator.
+func (st State) InitialWatchStatementForUserSecretsRotationChanges() (string, eventsource.NamespaceQuery) {
+	queryFunc := func(ctx context.Context, runner coredatabase.TxnRunner) ([]string, error) {
+		result, err := st.getUserSecretsRotationChanges(ctx, runner)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		secretIDs := make([]string, len(result))
+		for i, d := range result {
+			secretIDs[i] = d.URI.ID
+		}
+		return secretIDs, nil
+	}
+	return "secret_rotation", queryFunc
+}
+
+// GetUserSecretsRotationChanges returns the rotation changes for user secrets
+// which have a generator.
+func (st State) GetUserSecretsRotationChanges(
+	ctx context.Context, secretIDs ...string,
+) ([]domainsecret.RotationInfo, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return st.getUserSecretsRotationChanges(ctx, db, secretIDs...)
+}
+
 func (st State) getSecretsRevisionExpiryChanges(
 	ctx context.Context, runner domain.TxnRunner,
 	appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners,
@@ -3972,7 +4202,16 @@ FROM   secret_model_owner smo
        JOIN secret_metadata sm ON sm.secret_id = smo.secret_id
        JOIN secret_revision sr ON sr.secret_id = smo.secret_id
        LEFT JOIN secret_revision_obsolete sro ON sro.revision_uuid = sr.uuid
-WHERE  sm.auto_prune = true AND sro.obsolete = true`
+       LEFT JOIN secret_generator sg ON sg.secret_id = smo.secret_id
+WHERE  sro.obsolete = true
+AND    (
+    sm.auto_prune = true
+    OR sr.revision <= (
+        SELECT MAX(latest.revision) - sg.keep_revisions
+        FROM   secret_revision latest
+        WHERE  latest.secret_id = sr.secret_id
+    )
+)`
 	stmt, err := st.Prepare(q, obsoleteRevisionRow{})
 	if err != nil {
 		return nil, errors.Capture(err)
@@ -4260,6 +4260,157 @@ func (s *stateSuite) TestGetObsoleteUserSecretRevisionsReadyToPrune(c *tc.C) {
 	c.Assert(result, tc.SameContents, []string{uri.ID + "/1"})
 }
 
+func (s *stateSuite) TestGetSecretGenerator(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	ctx := c.Context()
+	uri := coresecrets.NewURI()
+	generator := &coresecrets.Generator{
+		Type:       coresecrets.GenerateTLSCert,
+		CommonName: "db.example.com",
+		Validity:   24 * time.Hour,
+	}
+	err := createUserSecret(ctx, st, 1, uri, domainsecret.UpsertSecretParams{
+		RevisionID:    ptr(uuid.MustNewUUID().String()),
+		Data:          coresecrets.SecretData{"foo": "bar"},
+		Generator:     generator,
+		KeepRevisions: ptr(2),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := st.GetSecretGenerator(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, generator)
+
+	generator = &coresecrets.Generator{
+		Type:    coresecrets.GeneratePassword,
+		Length:  16,
+		Charset: coresecrets.CharsetHex,
+	}
+	err = updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{
+		Generator: generator,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err = st.GetSecretGenerator(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, generator)
+}
+
+func (s *stateSuite) TestGetSecretGeneratorNotFound(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	ctx := c.Context()
+	uri := coresecrets.NewURI()
+	err := createUserSecret(ctx, st, 1, uri, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = st.GetSecretGenerator(ctx, uri)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretGeneratorNotFound)
+}
+
+func (s *stateSuite) TestKeepRevisionsWithoutGenerator(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	ctx := c.Context()
+	uri := coresecrets.NewURI()
+	err := createUserSecret(ctx, st, 1, uri, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{
+		KeepRevisions: ptr(2),
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretGeneratorNotFound)
+}
+
+func (s *stateSuite) TestDeleteObsoleteUserSecretRevisionsKeepRevisions(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	ctx := c.Context()
+	uri := coresecrets.NewURI()
+	err := createUserSecret(ctx, st, 1, uri, domainsecret.UpsertSecretParams{
+		RevisionID:    ptr(uuid.MustNewUUID().String()),
+		Data:          coresecrets.SecretData{"foo": "bar1"},
+		Generator:     &coresecrets.Generator{Type: coresecrets.GeneratePassword},
+		KeepRevisions: ptr(2),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	for i := 2; i <= 4; i++ {
+		err = updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{
+			RevisionID: ptr(uuid.MustNewUUID().String()),
+			Data:       coresecrets.SecretData{"foo": fmt.Sprintf("bar%d", i)},
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	result, err := st.GetObsoleteUserSecretRevisionsReadyToPrune(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.SameContents, []string{uri.ID + "/1", uri.ID + "/2"})
+
+	expectedToBeDeleted := []string{
+		getRevUUID(c, s.DB(), uri, 1),
+		getRevUUID(c, s.DB(), uri, 2),
+	}
+	deletedRevisionIDs, err := st.DeleteObsoleteUserSecretRevisions(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(deletedRevisionIDs, tc.SameContents, expectedToBeDeleted)
+
+	assertRevision(c, s.DB(), uri, 1, false)
+	assertRevision(c, s.DB(), uri, 2, false)
+	assertRevision(c, s.DB(), uri, 3, true)
+	assertRevision(c, s.DB(), uri, 4, true)
+}
+
+func (s *stateSuite) TestGetUserSecretsRotationChanges(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	ctx := c.Context()
+	now := time.Now()
+	uri1 := coresecrets.NewURI()
+	err := createUserSecret(ctx, st, 1, uri1, domainsecret.UpsertSecretParams{
+		RevisionID:     ptr(uuid.MustNewUUID().String()),
+		Data:           coresecrets.SecretData{"foo": "bar"},
+		Generator:      &coresecrets.Generator{Type: coresecrets.GenerateSSHKey},
+		RotatePolicy:   ptr(domainsecret.RotateDaily),
+		NextRotateTime: ptr(now.Add(time.Hour)),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	// A user secret without a generator is never rotated.
+	uri2 := coresecrets.NewURI()
+	err = createUserSecret(ctx, st, 1, uri2, domainsecret.UpsertSecretParams{
+		RevisionID:     ptr(uuid.MustNewUUID().String()),
+		Data:           coresecrets.SecretData{"foo": "bar"},
+		RotatePolicy:   ptr(domainsecret.RotateDaily),
+		NextRotateTime: ptr(now.Add(time.Hour)),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	expected := []domainsecret.RotationInfo{{
+		URI:             uri1,
+		Revision:        1,
+		NextTriggerTime: now.Add(time.Hour).UTC(),
+	}}
+	result, err := st.GetUserSecretsRotationChanges(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, expected)
+
+	result, err = st.GetUserSecretsRotationChanges(ctx, uri1.ID, uri2.ID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, expected)
+
+	table, query := st.InitialWatchStatementForUserSecretsRotationChanges()
+	c.Assert(table, tc.Equals, "secret_rotation")
+	ids, err := query(ctx, s.TxnRunner())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ids, tc.DeepEquals, []string{uri1.ID})
+}
+
 func (s *stateSuite) TestChangeSecretBackend(c *tc.C) {
 	st := newSecretState(c, s.TxnRunnerFactory())
 	ctx := c.Context()
@@ -4327,6 +4478,60 @@ func (s *stateSuite) TestChangeSecretBackend(c *tc.C) {
 	c.Assert(valueRef, tc.IsNil)
 }
 
+func (s *stateSuite) TestMigrateSecretBackend(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := c.Context()
+
+	s.setupUnits(c, "mysql")
+	uri := coresecrets.NewURI()
+
+	dataInput := coresecrets.SecretData{"foo": "bar", "hello": "world"}
+	valueRef := &coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "revision-id",
+	}
+	otherRef := &coresecrets.ValueRef{
+		BackendID:  "other-backend-id",
+		RevisionID: "other-revision-id",
+	}
+
+	err := createCharmApplicationSecret(ctx, st, 1, uri, "mysql", domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       dataInput,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	revisionID := parseUUID(c, getRevUUID(c, s.DB(), uri, 1))
+
+	// The content is in the internal backend, so it can't be moved from
+	// an external one.
+	err = st.MigrateSecretBackend(ctx, revisionID, otherRef, valueRef, nil)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionBackendChanged)
+
+	err = st.MigrateSecretBackend(ctx, revisionID, nil, valueRef, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	data, gotRef, err := st.GetSecretValue(ctx, uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(data, tc.IsNil)
+	c.Assert(gotRef, tc.DeepEquals, valueRef)
+
+	// The reference has moved on, so neither a stale external reference
+	// nor the internal backend match.
+	err = st.MigrateSecretBackend(ctx, revisionID, otherRef, nil, dataInput)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionBackendChanged)
+	err = st.MigrateSecretBackend(ctx, revisionID, nil, otherRef, nil)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionBackendChanged)
+	_, gotRef, err = st.GetSecretValue(ctx, uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(gotRef, tc.DeepEquals, valueRef)
+
+	err = st.MigrateSecretBackend(ctx, revisionID, valueRef, nil, dataInput)
+	c.Assert(err, tc.ErrorIsNil)
+	data, gotRef, err = st.GetSecretValue(ctx, uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(data, tc.DeepEquals, dataInput)
+	c.Assert(gotRef, tc.IsNil)
+}
+
 func (s *stateSuite) TestChangeSecretBackendFailed(c *tc.C) {
 	st := newSecretState(c, s.TxnRunnerFactory())
 	ctx := c.Context()
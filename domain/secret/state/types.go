@@ -4,6 +4,7 @@
 package state
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -112,6 +113,51 @@ type secretRotate struct {
 	NextRotateTime time.Time `db:"next_rotation_time"`
 }
 
+type secretGenerator struct {
+	SecretID      string         `db:"secret_id"`
+	TypeID        int            `db:"generator_type_id"`
+	Length        sql.NullInt64  `db:"length"`
+	Charset       sql.NullString `db:"charset"`
+	CommonName    sql.NullString `db:"common_name"`
+	Validity      sql.NullInt64  `db:"validity"`
+	KeepRevisions sql.NullInt64  `db:"keep_revisions"`
+}
+
+func newSecretGenerator(secretID string, g coresecrets.Generator) secretGenerator {
+	result := secretGenerator{
+		SecretID: secretID,
+		TypeID:   int(domainsecret.MarshallGeneratorType(g.Type)),
+	}
+	if g.Length > 0 {
+		result.Length = sql.NullInt64{Int64: int64(g.Length), Valid: true}
+	}
+	if g.Charset != "" {
+		result.Charset = sql.NullString{String: g.Charset, Valid: true}
+	}
+	if g.CommonName != "" {
+		result.CommonName = sql.NullString{String: g.CommonName, Valid: true}
+	}
+	if g.Validity > 0 {
+		result.Validity = sql.NullInt64{Int64: int64(g.Validity / time.Second), Valid: true}
+	}
+	return result
+}
+
+func (g secretGenerator) toGenerator() *coresecrets.Generator {
+	return &coresecrets.Generator{
+		Type:       domainsecret.UnmarshallGeneratorType(domainsecret.GeneratorType(g.TypeID)),
+		Length:     int(g.Length.Int64),
+		Charset:    g.Charset.String,
+		CommonName: g.CommonName.String,
+		Validity:   time.Duration(g.Validity.Int64) * time.Second,
+	}
+}
+
+type secretKeepRevisions struct {
+	SecretID      string `db:"secret_id"`
+	KeepRevisions int    `db:"keep_revisions"`
+}
+
 type secretRotationChange struct {
 	SecretID       string    `db:"secret_id"`
 	Revision       int       `db:"revision"`
@@ -502,3 +548,37 @@ type count struct {
 func getRevisionID(secretID string, revision int) string {
 	return fmt.Sprintf("%s/%d", secretID, revision)
 }
+
+type secretAccessLogEntry struct {
+	SecretID       string    `db:"secret_id"`
+	Revision       int       `db:"revision"`
+	AccessorTypeID int       `db:"accessor_type_id"`
+	AccessorID     string    `db:"accessor_id"`
+	AccessedAt     time.Time `db:"accessed_at"`
+	Denied         bool      `db:"denied"`
+}
+
+type secretAccessLogEntries []secretAccessLogEntry
+
+func (rows secretAccessLogEntries) toAccessLog(uri *coresecrets.URI) []domainsecret.AccessLogEntry {
+	result := make([]domainsecret.AccessLogEntry, len(rows))
+	for i, row := range rows {
+		result[i] = domainsecret.AccessLogEntry{
+			URI:            uri,
+			Revision:       row.Revision,
+			AccessorTypeID: domainsecret.AccessorType(row.AccessorTypeID),
+			AccessorID:     row.AccessorID,
+			AccessTime:     row.AccessedAt,
+			Denied:         row.Denied,
+		}
+	}
+	return result
+}
+
+type accessLogLimit struct {
+	Limit int `db:"limit"`
+}
+
+type accessLogCutoff struct {
+	Before time.Time `db:"before"`
+}
@@ -48,6 +48,8 @@ type UpsertSecretParams struct {
 	Description    *string
 	Label          *string
 	AutoPrune      *bool
+	Generator      *secrets.Generator
+	KeepRevisions  *int
 
 	Data     secrets.SecretData
 	ValueRef *secrets.ValueRef
@@ -63,7 +65,9 @@ func (u *UpsertSecretParams) HasUpdate() bool {
 		u.ExpireTime != nil ||
 		len(u.Data) > 0 ||
 		u.ValueRef != nil ||
-		u.AutoPrune != nil
+		u.AutoPrune != nil ||
+		u.Generator != nil ||
+		u.KeepRevisions != nil
 }
 
 // GrantParams are used when granting access to a secret.
@@ -9,6 +9,7 @@ import (
 	"github.com/juju/tc"
 
 	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/secrets/provider/file"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/secrets/provider/vault"
@@ -44,5 +45,6 @@ func (s *backendtypeSuite) TestBackendTypeDBValues(c *tc.C) {
 		BackendTypeController: juju.BackendType,
 		BackendTypeKubernetes: kubernetes.BackendType,
 		BackendTypeVault:      vault.BackendType,
+		BackendTypeFile:       file.BackendType,
 	})
 }
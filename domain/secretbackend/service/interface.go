@@ -24,6 +24,7 @@ type State interface {
 	ListSecretBackends(ctx context.Context) ([]*secretbackend.SecretBackend, error)
 	ListSecretBackendIDs(ctx context.Context) ([]string, error)
 	SecretBackendRotated(ctx context.Context, backendID string, next time.Time) error
+	CountControllerNodes(ctx context.Context) (int, error)
 	SetModelSecretBackend(ctx context.Context, modelUUID coremodel.UUID, secretBackendName string) error
 
 	ListSecretBackendsForModel(ctx context.Context, modelUUID coremodel.UUID, includeEmpty bool) ([]*secretbackend.SecretBackend, error)
@@ -600,6 +600,26 @@ func (s *Service) RotateBackendToken(ctx context.Context, backendID string) erro
 		return nil
 	}
 
+	// The auth of some providers is held on the disk of the controller, so
+	// refreshing it on one controller would leave the others unable to
+	// use the backend.
+	if provider.HasControllerLocalAuth(p) {
+		count, err := s.st.CountControllerNodes(ctx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if count > 1 {
+			s.logger.Warningf(ctx,
+				"not rotating token for secret backend %q as its keys are held on each of the %d controllers",
+				backendInfo.Name, count)
+			next, err := coresecrets.NextBackendRotateTime(s.clock.Now(), *backendInfo.TokenRotateInterval)
+			if err != nil {
+				return errors.Capture(err)
+			}
+			return errors.Capture(s.st.SecretBackendRotated(ctx, backendID, *next))
+		}
+	}
+
 	s.logger.Debugf(ctx, "refresh token for backend %v", backendInfo.Name)
 	cfg := provider.BackendConfig{
 		BackendType: backendInfo.BackendType,
@@ -1347,6 +1347,88 @@ func (s *serviceSuite) TestRotateBackendToken(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+// providerWithLocalAuth is a provider whose auth is held on the disk of
+// each controller.
+type providerWithLocalAuth struct {
+	providerWithConfig
+}
+
+func (providerWithLocalAuth) ControllerLocalAuth() {}
+
+func (s *serviceSuite) TestRotateBackendTokenControllerLocalAuth(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return providerWithLocalAuth{providerWithConfig{
+				SecretBackendProvider: s.mockRegistry,
+			}}, nil
+		},
+	)
+
+	s.mockState.EXPECT().GetSecretBackend(gomock.Any(), secretbackend.BackendIdentifier{ID: "backend-uuid"}).Return(&secretbackend.SecretBackend{
+		ID:                  "backend-uuid",
+		Name:                "myfile",
+		BackendType:         "file",
+		TokenRotateInterval: ptr(200 * time.Minute),
+		Config: map[string]any{
+			"path": "/srv/secrets",
+		},
+	}, nil)
+	s.mockState.EXPECT().CountControllerNodes(gomock.Any()).Return(1, nil)
+	s.mockState.EXPECT().UpdateSecretBackend(gomock.Any(), secretbackend.UpdateSecretBackendParams{
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID: "backend-uuid",
+		},
+		Config: map[string]string{
+			"path":  "/srv/secrets",
+			"token": "3h20m0s",
+		},
+	}).Return("", nil)
+
+	now := s.clock.Now()
+	nextRotateTime := now.Add(150 * time.Minute)
+	s.mockState.EXPECT().SecretBackendRotated(gomock.Any(), "backend-uuid", nextRotateTime).Return(nil)
+
+	err := svc.RotateBackendToken(c.Context(), "backend-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRotateBackendTokenControllerLocalAuthHA(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return providerWithLocalAuth{providerWithConfig{
+				SecretBackendProvider: s.mockRegistry,
+			}}, nil
+		},
+	)
+
+	s.mockState.EXPECT().GetSecretBackend(gomock.Any(), secretbackend.BackendIdentifier{ID: "backend-uuid"}).Return(&secretbackend.SecretBackend{
+		ID:                  "backend-uuid",
+		Name:                "myfile",
+		BackendType:         "file",
+		TokenRotateInterval: ptr(200 * time.Minute),
+		Config: map[string]any{
+			"path": "/srv/secrets",
+		},
+	}, nil)
+	s.mockState.EXPECT().CountControllerNodes(gomock.Any()).Return(3, nil)
+
+	// The token isn't refreshed, and the rotation is rescheduled.
+	now := s.clock.Now()
+	nextRotateTime := now.Add(150 * time.Minute)
+	s.mockState.EXPECT().SecretBackendRotated(gomock.Any(), "backend-uuid", nextRotateTime).Return(nil)
+
+	err := svc.RotateBackendToken(c.Context(), "backend-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *serviceSuite) TestRotateBackendTokenRetry(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
@@ -43,6 +43,45 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// CountControllerNodes mocks base method.
+func (m *MockState) CountControllerNodes(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountControllerNodes", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountControllerNodes indicates an expected call of CountControllerNodes.
+func (mr *MockStateMockRecorder) CountControllerNodes(arg0 any) *MockStateCountControllerNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountControllerNodes", reflect.TypeOf((*MockState)(nil).CountControllerNodes), arg0)
+	return &MockStateCountControllerNodesCall{Call: call}
+}
+
+// MockStateCountControllerNodesCall wrap *gomock.Call
+type MockStateCountControllerNodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateCountControllerNodesCall) Return(arg0 int, arg1 error) *MockStateCountControllerNodesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateCountControllerNodesCall) Do(f func(context.Context) (int, error)) *MockStateCountControllerNodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateCountControllerNodesCall) DoAndReturn(f func(context.Context) (int, error)) *MockStateCountControllerNodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateSecretBackend mocks base method.
 func (m *MockState) CreateSecretBackend(arg0 context.Context, arg1 secretbackend.CreateSecretBackendParams) (string, error) {
 	m.ctrl.T.Helper()
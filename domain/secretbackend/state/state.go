@@ -950,6 +950,29 @@ WHERE  secret_backend_uuid = $SecretBackendReference.secret_backend_uuid`, input
 	return result.Num, nil
 }
 
+// CountControllerNodes returns the number of controller nodes.
+func (s *State) CountControllerNodes(ctx context.Context) (int, error) {
+	db, err := s.DB()
+	if err != nil {
+		return -1, errors.Capture(err)
+	}
+	result := Count{}
+	stmt, err := s.Prepare(`
+SELECT COUNT(*) AS &Count.num
+FROM   controller_node`, result)
+	if err != nil {
+		return -1, errors.Capture(err)
+	}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).Get(&result)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return -1, errors.Errorf("cannot count controller nodes: %w", err)
+	}
+	return result.Num, nil
+}
+
 // AddSecretBackendReference adds a reference to the secret backend for the given secret revision, returning an error
 // satisfying [secretbackenderrors.NotFound] if the secret backend does not exist,
 // or [modelerrors.NotFound] if the model does not exist,
@@ -80,6 +80,19 @@ func (s *stateSuite) TestGetModelSecretBackendDetails(c *tc.C) {
 	})
 }
 
+func (s *stateSuite) TestCountControllerNodes(c *tc.C) {
+	_, err := s.DB().ExecContext(c.Context(), `DELETE FROM controller_node`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO controller_node (controller_id, dqlite_node_id, dqlite_bind_address)
+VALUES ('0', '1', '10.0.0.1'), ('1', '2', '10.0.0.2'), ('2', '3', '10.0.0.3')`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	count, err := s.state.CountControllerNodes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 3)
+}
+
 func (s *stateSuite) TestGetModelTypeIAAS(c *tc.C) {
 	modelUUID := s.createModel(c, coremodel.IAAS)
 
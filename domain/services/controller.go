@@ -16,6 +16,8 @@ import (
 	agentbinarystate "github.com/juju/juju/domain/agentbinary/state"
 	autocertcacheservice "github.com/juju/juju/domain/autocert/service"
 	autocertcachestate "github.com/juju/juju/domain/autocert/state"
+	backupservice "github.com/juju/juju/domain/backup/service"
+	backupstate "github.com/juju/juju/domain/backup/state"
 	cloudservice "github.com/juju/juju/domain/cloud/service"
 	cloudstate "github.com/juju/juju/domain/cloud/state"
 	controllerservice "github.com/juju/juju/domain/controller/service"
@@ -38,6 +40,8 @@ import (
 	modeldefaultsstate "github.com/juju/juju/domain/modeldefaults/state"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
+	sshcaservice "github.com/juju/juju/domain/sshca/service"
+	sshcastate "github.com/juju/juju/domain/sshca/state"
 	upgradeservice "github.com/juju/juju/domain/upgrade/service"
 	upgradestate "github.com/juju/juju/domain/upgrade/state"
 )
@@ -185,6 +189,24 @@ func (s *ControllerServices) Macaroon() *macaroonservice.Service {
 	)
 }
 
+// ControllerBackup returns the service for dumping the controller database
+// as part of a backup.
+func (s *ControllerServices) ControllerBackup() *backupservice.Service {
+	return backupservice.NewService(
+		backupstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+	)
+}
+
+// SSHCertificateAuthority returns the service for the SSH certificate
+// authority of the controller, which signs user certificates for logging into
+// the machines of a model.
+func (s *ControllerServices) SSHCertificateAuthority() *sshcaservice.Service {
+	return sshcaservice.NewService(
+		sshcastate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.clock,
+	)
+}
+
 // ControllerAgentBinaryStore returns the [agentbinaryservice.AgentBinaryStore]
 // for the entire controller. This should be used when wanting to cache agent
 // binaries controller wide.
@@ -30,10 +30,14 @@ import (
 	charmstore "github.com/juju/juju/domain/application/charm/store"
 	applicationservice "github.com/juju/juju/domain/application/service"
 	applicationstate "github.com/juju/juju/domain/application/state"
+	backupservice "github.com/juju/juju/domain/backup/service"
+	backupstate "github.com/juju/juju/domain/backup/state"
 	blockcommandservice "github.com/juju/juju/domain/blockcommand/service"
 	blockcommandstate "github.com/juju/juju/domain/blockcommand/state"
 	blockdeviceservice "github.com/juju/juju/domain/blockdevice/service"
 	blockdevicestate "github.com/juju/juju/domain/blockdevice/state"
+	changefeedservice "github.com/juju/juju/domain/changefeed/service"
+	changefeedstate "github.com/juju/juju/domain/changefeed/state"
 	cloudimagemetadataservice "github.com/juju/juju/domain/cloudimagemetadata/service"
 	cloudimagemetadatastate "github.com/juju/juju/domain/cloudimagemetadata/state"
 	containerimageresourcestoreservice "github.com/juju/juju/domain/containerimageresourcestore/service"
@@ -58,6 +62,8 @@ import (
 	modelproviderstate "github.com/juju/juju/domain/modelprovider/state"
 	networkservice "github.com/juju/juju/domain/network/service"
 	networkstate "github.com/juju/juju/domain/network/state"
+	objectstoreservice "github.com/juju/juju/domain/objectstore/service"
+	objectstorestate "github.com/juju/juju/domain/objectstore/state"
 	portservice "github.com/juju/juju/domain/port/service"
 	portstate "github.com/juju/juju/domain/port/state"
 	proxy "github.com/juju/juju/domain/proxy/service"
@@ -75,6 +81,8 @@ import (
 	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
 	statusservice "github.com/juju/juju/domain/status/service"
 	statusstate "github.com/juju/juju/domain/status/state"
+	statushistoryservice "github.com/juju/juju/domain/statushistory/service"
+	statushistorystate "github.com/juju/juju/domain/statushistory/state"
 	storageservice "github.com/juju/juju/domain/storage/service"
 	storagestate "github.com/juju/juju/domain/storage/state"
 	stubservice "github.com/juju/juju/domain/stub"
@@ -203,7 +211,7 @@ func (s *ModelServices) Machine() *machineservice.WatchableService {
 		machinestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), s.clock, logger),
 		s.modelWatcherFactory("machine"),
 		providertracker.ProviderRunner[machineservice.Provider](s.providerFactory, s.modelUUID.String()),
-		domain.NewStatusHistory(logger, s.clock),
+		domain.NewStatusHistory(logger, s.clock, s.StatusHistory()),
 		s.clock,
 		logger,
 	)
@@ -232,7 +240,7 @@ func (s *ModelServices) Application() *applicationservice.WatchableService {
 		providertracker.ProviderRunner[applicationservice.Provider](s.providerFactory, s.modelUUID.String()),
 		providertracker.ProviderRunner[applicationservice.CAASProvider](s.providerFactory, s.modelUUID.String()),
 		charmstore.NewCharmStore(s.modelObjectStoreGetter, logger.Child("charmstore")),
-		domain.NewStatusHistory(logger, s.clock),
+		domain.NewStatusHistory(logger, s.clock, s.StatusHistory()),
 		s.clock,
 		logger,
 	)
@@ -246,16 +254,28 @@ func (s *ModelServices) Status() *statusservice.LeadershipService {
 		statusstate.NewControllerState(changestream.NewTxnRunnerFactory(s.controllerDB), s.modelUUID),
 		domain.NewLeaseService(s.leaseManager),
 		s.modelUUID,
-		domain.NewStatusHistory(logger, s.clock),
+		domain.NewStatusHistory(logger, s.clock, s.StatusHistory()),
 		func() (statusservice.StatusHistoryReader, error) {
 			logsink := filepath.Join(s.logDir, "logsink.log")
 			return domain.NewStatusHistoryReader(logsink, s.modelUUID)
 		},
+		s.StatusHistory(),
 		s.clock,
 		logger,
 	)
 }
 
+// StatusHistory returns the service for recording and querying the indexed
+// status history of the model.
+func (s *ModelServices) StatusHistory() *statushistoryservice.Service {
+	return statushistoryservice.NewService(
+		statushistorystate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.modelUUID,
+		s.clock,
+		s.logger.Child("statushistory"),
+	)
+}
+
 // Resolve returns the resolve service.
 func (s *ModelServices) Resolve() *resolveservice.WatchableService {
 	return resolveservice.NewWatchableService(
@@ -318,12 +338,21 @@ func (s *ModelServices) Annotation() *annotationService.Service {
 	)
 }
 
+// ObjectStore returns the model's object store service.
+func (s *ModelServices) ObjectStore() *objectstoreservice.Service {
+	return objectstoreservice.NewService(
+		objectstorestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+	)
+}
+
 // Storage returns the model's storage service.
-func (s *ModelServices) Storage() *storageservice.Service {
-	return storageservice.NewService(
+func (s *ModelServices) Storage() *storageservice.WatchableService {
+	return storageservice.NewWatchableService(
 		storagestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.modelWatcherFactory("storage"),
 		s.logger.Child("storage"),
 		s.storageRegistry,
+		s.clock,
 	)
 }
 
@@ -421,10 +450,28 @@ func (s *ModelServices) Port() *portservice.WatchableService {
 func (s *ModelServices) BlockCommand() *blockcommandservice.Service {
 	return blockcommandservice.NewService(
 		blockcommandstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.clock,
 		s.logger.Child("blockcommand"),
 	)
 }
 
+// ChangeFeed returns the service for watching the model's change feed.
+func (s *ModelServices) ChangeFeed() *changefeedservice.WatchableService {
+	return changefeedservice.NewWatchableService(
+		changefeedstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.modelWatcherFactory("changefeed"),
+		s.logger.Child("changefeed"),
+	)
+}
+
+// Backup returns the service for dumping the model database as part of a
+// controller backup.
+func (s *ModelServices) Backup() *backupservice.Service {
+	return backupservice.NewService(
+		backupstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+	)
+}
+
 // Resource returns the service for persisting and retrieving application
 // resources for the current model.
 func (s *ModelServices) Resource() *resourceservice.Service {
@@ -0,0 +1,13 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package sshca provides the SSH certificate authority of the controller.
+//
+// Instead of copying long-lived public keys onto every machine, the
+// controller signs short-lived user certificates for the users that are
+// allowed to log into the machines of a model. The machines trust the
+// certificate authority, and only accept the principals of their own model.
+// Revoking a user's access to a model stops the controller from issuing
+// them new certificates, so their SSH access lapses once the last one
+// expires.
+package sshca
@@ -0,0 +1,21 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// CertificateAuthorityNotFound describes an error that occurs when the
+	// SSH certificate authority of the controller hasn't been created yet.
+	CertificateAuthorityNotFound = errors.ConstError("ssh certificate authority not found")
+
+	// CertificateAuthorityAlreadyExists describes an error that occurs when
+	// the SSH certificate authority of the controller is created more than
+	// once.
+	CertificateAuthorityAlreadyExists = errors.ConstError("ssh certificate authority already exists")
+
+	// NoPrincipals describes an error that occurs when a certificate is
+	// requested without any principals to log in as.
+	NoPrincipals = errors.ConstError("no principals")
+)
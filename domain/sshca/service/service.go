@@ -0,0 +1,209 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"golang.org/x/crypto/ssh"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// CertificateValidity is how long a user certificate is valid for.
+	// Certificates are issued for every login, so this only has to cover
+	// the time it takes to connect. It bounds how long a user keeps access
+	// after their access to a model is revoked.
+	CertificateValidity = 5 * time.Minute
+
+	// clockSkew is how far into the past a user certificate is valid from,
+	// so that it's accepted by machines whose clocks are slightly behind.
+	clockSkew = time.Minute
+)
+
+// State describes the persistence methods for the SSH certificate
+// authority.
+type State interface {
+	// GetCertificateAuthority returns the SSH certificate authority of the
+	// controller.
+	//
+	// The following errors may be returned:
+	//   - [sshcaerrors.CertificateAuthorityNotFound] if the certificate
+	//     authority hasn't been created.
+	GetCertificateAuthority(ctx context.Context) (sshca.CertificateAuthority, error)
+
+	// CreateCertificateAuthority stores the SSH certificate authority of the
+	// controller.
+	//
+	// The following errors may be returned:
+	//   - [sshcaerrors.CertificateAuthorityAlreadyExists] if a certificate
+	//     authority has already been created.
+	CreateCertificateAuthority(ctx context.Context, ca sshca.CertificateAuthority) error
+}
+
+// Service provides the API for the SSH certificate authority of the
+// controller.
+type Service struct {
+	st    State
+	clock clock.Clock
+}
+
+// NewService returns a new service reference wrapping the input state.
+func NewService(st State, clock clock.Clock) *Service {
+	return &Service{
+		st:    st,
+		clock: clock,
+	}
+}
+
+// CertificateAuthorityPublicKey returns the public key of the controller's
+// SSH certificate authority, in the authorized_keys format. The certificate
+// authority is created if it doesn't exist yet.
+func (s *Service) CertificateAuthorityPublicKey(ctx context.Context) (string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	ca, err := s.certificateAuthority(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return ca.PublicKey, nil
+}
+
+// IssueUserCertificate signs a short-lived user certificate for the public
+// key, which is in the authorized_keys format. The certificate is only valid
+// for the given principals, and the key ID identifies the user in the logs of
+// the machines.
+//
+// The following errors may be returned:
+//   - [coreerrors.NotValid] if the public key can't be parsed.
+//   - [sshcaerrors.NoPrincipals] if no principals are given.
+func (s *Service) IssueUserCertificate(
+	ctx context.Context,
+	publicKey string,
+	keyID string,
+	principals []string,
+) (sshca.UserCertificate, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if len(principals) == 0 {
+		return sshca.UserCertificate{}, sshcaerrors.NoPrincipals
+	}
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return sshca.UserCertificate{}, errors.Errorf("parsing public key: %w", err).Add(coreerrors.NotValid)
+	}
+	if _, ok := key.(*ssh.Certificate); ok {
+		return sshca.UserCertificate{}, errors.New("public key is a certificate").Add(coreerrors.NotValid)
+	}
+
+	ca, err := s.certificateAuthority(ctx)
+	if err != nil {
+		return sshca.UserCertificate{}, errors.Capture(err)
+	}
+	signer, err := ssh.ParsePrivateKey([]byte(ca.PrivateKey))
+	if err != nil {
+		return sshca.UserCertificate{}, errors.Errorf("parsing certificate authority private key: %w", err)
+	}
+
+	var serial [8]byte
+	if _, err := rand.Read(serial[:]); err != nil {
+		return sshca.UserCertificate{}, errors.Errorf("generating certificate serial: %w", err)
+	}
+
+	now := s.clock.Now()
+	validBefore := now.Add(CertificateValidity).Truncate(time.Second)
+	cert := &ssh.Certificate{
+		Key:             key,
+		Serial:          binary.BigEndian.Uint64(serial[:]),
+		CertType:        ssh.UserCert,
+		KeyId:           keyID,
+		ValidPrincipals: principals,
+		ValidAfter:      uint64(now.Add(-clockSkew).Unix()),
+		ValidBefore:     uint64(validBefore.Unix()),
+		Permissions: ssh.Permissions{
+			// These are the extensions that ssh-keygen grants by default,
+			// without them a user can't even get a terminal.
+			Extensions: map[string]string{
+				"permit-X11-forwarding":   "",
+				"permit-agent-forwarding": "",
+				"permit-port-forwarding":  "",
+				"permit-pty":              "",
+				"permit-user-rc":          "",
+			},
+		},
+	}
+	if err := cert.SignCert(rand.Reader, signer); err != nil {
+		return sshca.UserCertificate{}, errors.Errorf("signing user certificate: %w", err)
+	}
+
+	return sshca.UserCertificate{
+		Certificate: strings.TrimSpace(string(ssh.MarshalAuthorizedKey(cert))),
+		Principals:  principals,
+		ValidBefore: validBefore,
+	}, nil
+}
+
+// certificateAuthority returns the SSH certificate authority of the
+// controller, creating it if it doesn't exist yet. The authority is created
+// lazily so that controllers upgraded from before it was introduced get one
+// too.
+func (s *Service) certificateAuthority(ctx context.Context) (sshca.CertificateAuthority, error) {
+	ca, err := s.st.GetCertificateAuthority(ctx)
+	if err == nil {
+		return ca, nil
+	} else if !errors.Is(err, sshcaerrors.CertificateAuthorityNotFound) {
+		return sshca.CertificateAuthority{}, errors.Errorf("getting certificate authority: %w", err)
+	}
+
+	ca, err = newCertificateAuthority(s.clock.Now())
+	if err != nil {
+		return sshca.CertificateAuthority{}, errors.Capture(err)
+	}
+	err = s.st.CreateCertificateAuthority(ctx, ca)
+	if errors.Is(err, sshcaerrors.CertificateAuthorityAlreadyExists) {
+		// Another controller created the certificate authority first, so
+		// use that one instead.
+		ca, err = s.st.GetCertificateAuthority(ctx)
+	}
+	if err != nil {
+		return sshca.CertificateAuthority{}, errors.Errorf("creating certificate authority: %w", err)
+	}
+	return ca, nil
+}
+
+// newCertificateAuthority generates the key pair of a new SSH certificate
+// authority.
+func newCertificateAuthority(now time.Time) (sshca.CertificateAuthority, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return sshca.CertificateAuthority{}, errors.Errorf("generating certificate authority key: %w", err)
+	}
+	block, err := ssh.MarshalPrivateKey(private, "juju ssh certificate authority")
+	if err != nil {
+		return sshca.CertificateAuthority{}, errors.Errorf("marshalling certificate authority key: %w", err)
+	}
+	publicKey, err := ssh.NewPublicKey(public)
+	if err != nil {
+		return sshca.CertificateAuthority{}, errors.Errorf("marshalling certificate authority public key: %w", err)
+	}
+	return sshca.CertificateAuthority{
+		PrivateKey: string(pem.EncodeToMemory(block)),
+		PublicKey:  strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey))),
+		CreatedAt:  now.UTC(),
+	}, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/ssh"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+)
+
+type serviceSuite struct {
+	state *MockState
+	clock *testclock.Clock
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC))
+	return ctrl
+}
+
+func (s *serviceSuite) TestCertificateAuthorityPublicKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ca := newTestCertificateAuthority(c)
+	s.state.EXPECT().GetCertificateAuthority(gomock.Any()).Return(ca, nil)
+
+	publicKey, err := NewService(s.state, s.clock).CertificateAuthorityPublicKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(publicKey, tc.Equals, ca.PublicKey)
+}
+
+func (s *serviceSuite) TestCertificateAuthorityCreatedOnFirstUse(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var created sshca.CertificateAuthority
+	s.state.EXPECT().GetCertificateAuthority(gomock.Any()).Return(sshca.CertificateAuthority{}, sshcaerrors.CertificateAuthorityNotFound)
+	s.state.EXPECT().CreateCertificateAuthority(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, ca sshca.CertificateAuthority) error {
+			created = ca
+			return nil
+		},
+	)
+
+	publicKey, err := NewService(s.state, s.clock).CertificateAuthorityPublicKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(publicKey, tc.Equals, created.PublicKey)
+	c.Check(created.CreatedAt, tc.Equals, s.clock.Now())
+
+	signer, err := ssh.ParsePrivateKey([]byte(created.PrivateKey))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))), tc.Equals, publicKey)
+}
+
+func (s *serviceSuite) TestCertificateAuthorityCreatedConcurrently(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ca := newTestCertificateAuthority(c)
+	gomock.InOrder(
+		s.state.EXPECT().GetCertificateAuthority(gomock.Any()).Return(sshca.CertificateAuthority{}, sshcaerrors.CertificateAuthorityNotFound),
+		s.state.EXPECT().CreateCertificateAuthority(gomock.Any(), gomock.Any()).Return(sshcaerrors.CertificateAuthorityAlreadyExists),
+		s.state.EXPECT().GetCertificateAuthority(gomock.Any()).Return(ca, nil),
+	)
+
+	publicKey, err := NewService(s.state, s.clock).CertificateAuthorityPublicKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(publicKey, tc.Equals, ca.PublicKey)
+}
+
+func (s *serviceSuite) TestIssueUserCertificate(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ca := newTestCertificateAuthority(c)
+	s.state.EXPECT().GetCertificateAuthority(gomock.Any()).Return(ca, nil)
+
+	userKey := newTestPublicKey(c)
+	principals := []string{"juju-admin@8419cd78-4993-4c3a-928e-c646226beeee"}
+	result, err := NewService(s.state, s.clock).IssueUserCertificate(c.Context(), userKey, "bob", principals)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Principals, tc.DeepEquals, principals)
+	c.Check(result.ValidBefore, tc.Equals, s.clock.Now().Add(CertificateValidity))
+
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(result.Certificate))
+	c.Assert(err, tc.ErrorIsNil)
+	cert, ok := parsed.(*ssh.Certificate)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(cert.CertType, tc.Equals, uint32(ssh.UserCert))
+	c.Check(cert.KeyId, tc.Equals, "bob")
+	c.Check(cert.ValidPrincipals, tc.DeepEquals, principals)
+	c.Check(cert.ValidAfter, tc.Equals, uint64(s.clock.Now().Add(-time.Minute).Unix()))
+	c.Check(cert.ValidBefore, tc.Equals, uint64(s.clock.Now().Add(CertificateValidity).Unix()))
+	c.Check(cert.Permissions.Extensions, tc.HasLen, 5)
+	_, ok = cert.Permissions.Extensions["permit-pty"]
+	c.Check(ok, tc.IsTrue)
+	c.Check(strings.TrimSpace(string(ssh.MarshalAuthorizedKey(cert.Key))), tc.Equals, userKey)
+
+	// The certificate is only accepted for the principal it was issued for,
+	// when signed by the controller's certificate authority.
+	caKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(ca.PublicKey))
+	c.Assert(err, tc.ErrorIsNil)
+	checker := ssh.CertChecker{
+		Clock: s.clock.Now,
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return string(auth.Marshal()) == string(caKey.Marshal())
+		},
+	}
+	c.Check(checker.CheckCert(principals[0], cert), tc.ErrorIsNil)
+	c.Check(checker.CheckCert("juju-admin@another-model", cert), tc.NotNil)
+}
+
+func (s *serviceSuite) TestIssueUserCertificateNoPrincipals(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := NewService(s.state, s.clock).IssueUserCertificate(c.Context(), newTestPublicKey(c), "bob", nil)
+	c.Assert(err, tc.ErrorIs, sshcaerrors.NoPrincipals)
+}
+
+func (s *serviceSuite) TestIssueUserCertificateInvalidPublicKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := NewService(s.state, s.clock).IssueUserCertificate(c.Context(), "not a key", "bob", []string{"juju-admin@foo"})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func newTestCertificateAuthority(c *tc.C) sshca.CertificateAuthority {
+	ca, err := newCertificateAuthority(time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	return ca
+}
+
+func newTestPublicKey(c *tc.C) string {
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	c.Assert(err, tc.ErrorIsNil)
+	key, err := ssh.NewPublicKey(public)
+	c.Assert(err, tc.ErrorIsNil)
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+}
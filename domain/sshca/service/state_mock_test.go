@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/sshca/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/sshca/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	sshca "github.com/juju/juju/domain/sshca"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// CreateCertificateAuthority mocks base method.
+func (m *MockState) CreateCertificateAuthority(arg0 context.Context, arg1 sshca.CertificateAuthority) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCertificateAuthority", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCertificateAuthority indicates an expected call of CreateCertificateAuthority.
+func (mr *MockStateMockRecorder) CreateCertificateAuthority(arg0, arg1 any) *MockStateCreateCertificateAuthorityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCertificateAuthority", reflect.TypeOf((*MockState)(nil).CreateCertificateAuthority), arg0, arg1)
+	return &MockStateCreateCertificateAuthorityCall{Call: call}
+}
+
+// MockStateCreateCertificateAuthorityCall wrap *gomock.Call
+type MockStateCreateCertificateAuthorityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateCreateCertificateAuthorityCall) Return(arg0 error) *MockStateCreateCertificateAuthorityCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateCreateCertificateAuthorityCall) Do(f func(context.Context, sshca.CertificateAuthority) error) *MockStateCreateCertificateAuthorityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateCreateCertificateAuthorityCall) DoAndReturn(f func(context.Context, sshca.CertificateAuthority) error) *MockStateCreateCertificateAuthorityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCertificateAuthority mocks base method.
+func (m *MockState) GetCertificateAuthority(arg0 context.Context) (sshca.CertificateAuthority, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateAuthority", arg0)
+	ret0, _ := ret[0].(sshca.CertificateAuthority)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificateAuthority indicates an expected call of GetCertificateAuthority.
+func (mr *MockStateMockRecorder) GetCertificateAuthority(arg0 any) *MockStateGetCertificateAuthorityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateAuthority", reflect.TypeOf((*MockState)(nil).GetCertificateAuthority), arg0)
+	return &MockStateGetCertificateAuthorityCall{Call: call}
+}
+
+// MockStateGetCertificateAuthorityCall wrap *gomock.Call
+type MockStateGetCertificateAuthorityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetCertificateAuthorityCall) Return(arg0 sshca.CertificateAuthority, arg1 error) *MockStateGetCertificateAuthorityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetCertificateAuthorityCall) Do(f func(context.Context) (sshca.CertificateAuthority, error)) *MockStateGetCertificateAuthorityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetCertificateAuthorityCall) DoAndReturn(f func(context.Context) (sshca.CertificateAuthority, error)) *MockStateGetCertificateAuthorityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+	internaldatabase "github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State describes the persistence layer for the SSH certificate authority
+// of the controller.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new state reference.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// GetCertificateAuthority returns the SSH certificate authority of the
+// controller.
+//
+// The following errors may be returned:
+//   - [sshcaerrors.CertificateAuthorityNotFound] if the certificate authority
+//     hasn't been created.
+func (st *State) GetCertificateAuthority(ctx context.Context) (sshca.CertificateAuthority, error) {
+	db, err := st.DB()
+	if err != nil {
+		return sshca.CertificateAuthority{}, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare("SELECT &certificateAuthority.* FROM ssh_certificate_authority", certificateAuthority{})
+	if err != nil {
+		return sshca.CertificateAuthority{}, errors.Errorf("preparing certificate authority statement: %w", err)
+	}
+
+	var ca certificateAuthority
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).Get(&ca)
+		if errors.Is(err, sql.ErrNoRows) {
+			return sshcaerrors.CertificateAuthorityNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return sshca.CertificateAuthority{}, errors.Capture(err)
+	}
+
+	return sshca.CertificateAuthority{
+		PrivateKey: ca.PrivateKey,
+		PublicKey:  ca.PublicKey,
+		CreatedAt:  ca.CreatedAt,
+	}, nil
+}
+
+// CreateCertificateAuthority stores the SSH certificate authority of the
+// controller.
+//
+// The following errors may be returned:
+//   - [sshcaerrors.CertificateAuthorityAlreadyExists] if a certificate
+//     authority has already been created.
+func (st *State) CreateCertificateAuthority(ctx context.Context, ca sshca.CertificateAuthority) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare("INSERT INTO ssh_certificate_authority (*) VALUES ($certificateAuthority.*)", certificateAuthority{})
+	if err != nil {
+		return errors.Errorf("preparing create certificate authority statement: %w", err)
+	}
+
+	row := certificateAuthority{
+		PrivateKey: ca.PrivateKey,
+		PublicKey:  ca.PublicKey,
+		CreatedAt:  ca.CreatedAt,
+	}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, row).Run()
+		if internaldatabase.IsErrConstraintUnique(err) {
+			return sshcaerrors.CertificateAuthorityAlreadyExists
+		}
+		return err
+	})
+	return errors.Capture(err)
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+)
+
+type stateSuite struct {
+	schematesting.ControllerSuite
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) TestGetCertificateAuthorityNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	_, err := st.GetCertificateAuthority(c.Context())
+	c.Assert(err, tc.ErrorIs, sshcaerrors.CertificateAuthorityNotFound)
+}
+
+func (s *stateSuite) TestCreateCertificateAuthority(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ca := sshca.CertificateAuthority{
+		PrivateKey: "private",
+		PublicKey:  "public",
+		CreatedAt:  time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+	err := st.CreateCertificateAuthority(c.Context(), ca)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := st.GetCertificateAuthority(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, ca)
+}
+
+func (s *stateSuite) TestCreateCertificateAuthorityTwiceFails(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ca := sshca.CertificateAuthority{
+		PrivateKey: "private",
+		PublicKey:  "public",
+		CreatedAt:  time.Now().UTC(),
+	}
+	err := st.CreateCertificateAuthority(c.Context(), ca)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CreateCertificateAuthority(c.Context(), ca)
+	c.Assert(err, tc.ErrorIs, sshcaerrors.CertificateAuthorityAlreadyExists)
+}
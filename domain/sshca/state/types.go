@@ -0,0 +1,14 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import "time"
+
+// certificateAuthority represents a row of the ssh_certificate_authority
+// table.
+type certificateAuthority struct {
+	PrivateKey string    `db:"private_key"`
+	PublicKey  string    `db:"public_key"`
+	CreatedAt  time.Time `db:"created_at"`
+}
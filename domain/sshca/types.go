@@ -0,0 +1,50 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshca
+
+import (
+	"time"
+
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+)
+
+// CertificateAuthority holds the key pair of the controller's SSH
+// certificate authority.
+type CertificateAuthority struct {
+	// PrivateKey is the PEM encoded private key of the authority.
+	PrivateKey string
+	// PublicKey is the public key of the authority, in the authorized_keys
+	// format.
+	PublicKey string
+	// CreatedAt is when the authority was created.
+	CreatedAt time.Time
+}
+
+// UserCertificate is a short-lived SSH user certificate signed by the
+// controller's certificate authority.
+type UserCertificate struct {
+	// Certificate is the signed certificate, in the authorized_keys format.
+	Certificate string
+	// Principals are the principals the certificate is valid for.
+	Principals []string
+	// ValidBefore is when the certificate expires.
+	ValidBefore time.Time
+}
+
+// ModelAdminPrincipal returns the principal that a model's machines accept
+// for logging in as the default user.
+func ModelAdminPrincipal(modelUUID model.UUID) string {
+	return "juju-admin@" + modelUUID.String()
+}
+
+// Principals returns the principals of the certificate issued to a user with
+// the given access to a model. Only model admins are allowed to log into the
+// machines of a model, so no principals are returned for any other access.
+func Principals(modelUUID model.UUID, access permission.Access) []string {
+	if !access.EqualOrGreaterModelAccessThan(permission.AdminAccess) {
+		return nil
+	}
+	return []string{ModelAdminPrincipal(modelUUID)}
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshca
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+)
+
+type typesSuite struct{}
+
+func TestTypesSuite(t *testing.T) {
+	tc.Run(t, &typesSuite{})
+}
+
+func (s *typesSuite) TestPrincipals(c *tc.C) {
+	modelUUID := model.UUID("8419cd78-4993-4c3a-928e-c646226beeee")
+
+	c.Check(Principals(modelUUID, permission.AdminAccess), tc.DeepEquals, []string{
+		"juju-admin@8419cd78-4993-4c3a-928e-c646226beeee",
+	})
+	c.Check(Principals(modelUUID, permission.WriteAccess), tc.HasLen, 0)
+	c.Check(Principals(modelUUID, permission.ReadAccess), tc.HasLen, 0)
+	c.Check(Principals(modelUUID, permission.NoAccess), tc.HasLen, 0)
+}
@@ -168,6 +168,7 @@ func (s *leadershipSuite) setupService(c *tc.C) *service.LeadershipService {
 		func() (service.StatusHistoryReader, error) {
 			return nil, errors.Errorf("status history reader not available")
 		},
+		nil,
 		clock.WallClock,
 		loggertesting.WrapCheckLog(c),
 	)
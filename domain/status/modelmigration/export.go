@@ -77,6 +77,7 @@ func (e *exportOperation) Setup(scope modelmigration.Scope) error {
 			func() (service.StatusHistoryReader, error) {
 				return nil, errors.Errorf("status history reader not available")
 			},
+			nil,
 			e.clock,
 			e.logger,
 		)
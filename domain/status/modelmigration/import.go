@@ -91,6 +91,7 @@ func (i *importOperation) Setup(scope modelmigration.Scope) error {
 			func() (service.StatusHistoryReader, error) {
 				return nil, errors.Errorf("status history reader not available")
 			},
+			nil,
 			i.clock,
 			i.logger,
 		)
@@ -37,6 +37,7 @@ func NewLeadershipService(
 	modelUUID model.UUID,
 	statusHistory StatusHistory,
 	statusHistoryReaderFn StatusHistoryReaderFunc,
+	statusHistoryQuerier StatusHistoryQuerier,
 	clock clock.Clock,
 	logger logger.Logger,
 ) *LeadershipService {
@@ -46,6 +47,7 @@ func NewLeadershipService(
 			controllerState,
 			statusHistory,
 			statusHistoryReaderFn,
+			statusHistoryQuerier,
 			clock,
 			logger,
 		),
@@ -396,6 +396,7 @@ func (s *leaderServiceSuite) setupMocks(c *tc.C) *gomock.Controller {
 		func() (StatusHistoryReader, error) {
 			return nil, errors.Errorf("status history reader not available")
 		},
+		nil,
 		clock.WallClock,
 		loggertesting.WrapCheckLog(c),
 	)
@@ -229,16 +229,20 @@ type Service struct {
 	controllerState       ControllerState
 	statusHistory         StatusHistory
 	statusHistoryReaderFn StatusHistoryReaderFunc
+	statusHistoryQuerier  StatusHistoryQuerier
 	logger                logger.Logger
 	clock                 clock.Clock
 }
 
 // NewService returns a new service reference wrapping the input state.
+// If the status history querier is nil, the status history is read by
+// walking the records returned by the status history reader.
 func NewService(
 	modelState ModelState,
 	controllerState ControllerState,
 	statusHistory StatusHistory,
 	statusHistoryReaderFn StatusHistoryReaderFunc,
+	statusHistoryQuerier StatusHistoryQuerier,
 	clock clock.Clock,
 	logger logger.Logger,
 ) *Service {
@@ -247,6 +251,7 @@ func NewService(
 		controllerState:       controllerState,
 		statusHistory:         statusHistory,
 		statusHistoryReaderFn: statusHistoryReaderFn,
+		statusHistoryQuerier:  statusHistoryQuerier,
 		logger:                logger,
 		clock:                 clock,
 	}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/status/service (interfaces: StatusHistory,StatusHistoryReader)
+// Source: github.com/juju/juju/domain/status/service (interfaces: StatusHistory,StatusHistoryReader,StatusHistoryQuerier)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination service_mock_test.go github.com/juju/juju/domain/status/service StatusHistory,StatusHistoryReader
+//	mockgen -typed -package service -destination service_mock_test.go github.com/juju/juju/domain/status/service StatusHistory,StatusHistoryReader,StatusHistoryQuerier
 //
 
 // Package service is a generated GoMock package.
@@ -177,3 +177,65 @@ func (c *MockStatusHistoryReaderWalkCall) DoAndReturn(f func(func(statushistory.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockStatusHistoryQuerier is a mock of StatusHistoryQuerier interface.
+type MockStatusHistoryQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusHistoryQuerierMockRecorder
+}
+
+// MockStatusHistoryQuerierMockRecorder is the mock recorder for MockStatusHistoryQuerier.
+type MockStatusHistoryQuerierMockRecorder struct {
+	mock *MockStatusHistoryQuerier
+}
+
+// NewMockStatusHistoryQuerier creates a new mock instance.
+func NewMockStatusHistoryQuerier(ctrl *gomock.Controller) *MockStatusHistoryQuerier {
+	mock := &MockStatusHistoryQuerier{ctrl: ctrl}
+	mock.recorder = &MockStatusHistoryQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusHistoryQuerier) EXPECT() *MockStatusHistoryQuerierMockRecorder {
+	return m.recorder
+}
+
+// GetStatusHistory mocks base method.
+func (m *MockStatusHistoryQuerier) GetStatusHistory(arg0 context.Context, arg1 statushistory.Query) ([]statushistory.HistoryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistory", arg0, arg1)
+	ret0, _ := ret[0].([]statushistory.HistoryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockStatusHistoryQuerierMockRecorder) GetStatusHistory(arg0, arg1 any) *MockStatusHistoryQuerierGetStatusHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockStatusHistoryQuerier)(nil).GetStatusHistory), arg0, arg1)
+	return &MockStatusHistoryQuerierGetStatusHistoryCall{Call: call}
+}
+
+// MockStatusHistoryQuerierGetStatusHistoryCall wrap *gomock.Call
+type MockStatusHistoryQuerierGetStatusHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusHistoryQuerierGetStatusHistoryCall) Return(arg0 []statushistory.HistoryRecord, arg1 error) *MockStatusHistoryQuerierGetStatusHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusHistoryQuerierGetStatusHistoryCall) Do(f func(context.Context, statushistory.Query) ([]statushistory.HistoryRecord, error)) *MockStatusHistoryQuerierGetStatusHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusHistoryQuerierGetStatusHistoryCall) DoAndReturn(f func(context.Context, statushistory.Query) ([]statushistory.HistoryRecord, error)) *MockStatusHistoryQuerierGetStatusHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
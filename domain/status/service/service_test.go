@@ -1923,6 +1923,7 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 		func() (StatusHistoryReader, error) {
 			return nil, errors.Errorf("status history reader not available")
 		},
+		nil,
 		clock.WallClock,
 		loggertesting.WrapCheckLog(c),
 	)
@@ -35,6 +35,13 @@ type StatusHistoryReader interface {
 // StatusHistoryReaderFunc is a function that returns a StatusHistoryReader.
 type StatusHistoryReaderFunc func() (StatusHistoryReader, error)
 
+// StatusHistoryQuerier queries an indexed status history store.
+type StatusHistoryQuerier interface {
+	// GetStatusHistory returns the status history records matching the given
+	// query, ordered from the most recent to the oldest.
+	GetStatusHistory(context.Context, statushistory.Query) ([]statushistory.HistoryRecord, error)
+}
+
 // encodeK8sPodStatusType converts a core status to a db cloud container
 // status id.
 func encodeK8sPodStatusType(s corestatus.Status) (status.K8sPodStatusType, error) {
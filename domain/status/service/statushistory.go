@@ -18,6 +18,10 @@ func (s *Service) GetStatusHistory(ctx context.Context, request StatusHistoryReq
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
+	if s.statusHistoryQuerier != nil {
+		return s.queryStatusHistory(ctx, request)
+	}
+
 	reader, err := s.statusHistoryReaderFn()
 	if err != nil {
 		return nil, errors.Errorf("reading status history: %v", err)
@@ -57,6 +61,58 @@ func (s *Service) GetStatusHistory(ctx context.Context, request StatusHistoryReq
 	return results, nil
 }
 
+// queryStatusHistory returns the status history from the indexed status
+// history store, pushing the request filters down to the store.
+func (s *Service) queryStatusHistory(ctx context.Context, request StatusHistoryRequest) ([]status.DetailedStatus, error) {
+	kinds, err := requestKinds(request.Kind)
+	if err != nil {
+		return nil, errors.Errorf("reading status history: %w", err)
+	}
+
+	query := statushistory.Query{
+		Kinds: kinds,
+		ID:    request.Tag,
+		Limit: request.Filter.Size,
+	}
+	if date := request.Filter.Date; date != nil {
+		query.From = *date
+	}
+	if delta := request.Filter.Delta; delta != nil {
+		if from := s.clock.Now().Add(-(*delta)); from.After(query.From) {
+			query.From = from
+		}
+	}
+	if to := request.Filter.To; to != nil {
+		query.To = *to
+	}
+
+	records, err := s.statusHistoryQuerier.GetStatusHistory(ctx, query)
+	if err != nil {
+		return nil, errors.Errorf("reading status history: %w", err)
+	}
+
+	results := make([]status.DetailedStatus, len(records))
+	for i, record := range records {
+		results[i] = record.Status
+	}
+	return results, nil
+}
+
+// requestKinds returns the status history kinds that match the requested
+// kind.
+func requestKinds(kind status.HistoryKind) ([]status.HistoryKind, error) {
+	switch kind {
+	case status.KindUnit:
+		return []status.HistoryKind{status.KindUnit, status.KindUnitAgent, status.KindWorkload}, nil
+	case status.KindApplication, status.KindWorkload, status.KindUnitAgent,
+		status.KindMachine, status.KindMachineInstance:
+		return []status.HistoryKind{kind}, nil
+	default:
+		// TODO: support other kinds.
+		return nil, errors.Errorf("%q", kind)
+	}
+}
+
 func matchesUnit(hr statushistory.HistoryRecord, req StatusHistoryRequest) bool {
 	switch req.Kind {
 	case status.KindUnit:
@@ -117,5 +173,11 @@ func matches(hr statushistory.HistoryRecord, req StatusHistoryRequest, now time.
 		return false, nil
 	}
 
+	// If the end of the range is set on the filter, check that the record's
+	// date is not after it.
+	if filter.To != nil && hr.Status.Since != nil && hr.Status.Since.After(*filter.To) {
+		return false, nil
+	}
+
 	return true, nil
 }
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
@@ -546,3 +547,139 @@ func (s *statusHistorySuite) setupMocks(c *tc.C) *gomock.Controller {
 
 	return ctrl
 }
+
+func (s *statusHistorySuite) TestMatchesTo(c *tc.C) {
+	record := statushistory.HistoryRecord{
+		Kind: status.KindUnit,
+		Status: status.DetailedStatus{
+			Kind:  status.KindUnit,
+			Since: ptr(s.now),
+		},
+	}
+
+	result, err := matches(record, StatusHistoryRequest{
+		Kind: status.KindUnit,
+		Filter: StatusHistoryFilter{
+			To: ptr(s.now),
+		},
+	}, s.now)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.IsTrue)
+
+	result, err = matches(record, StatusHistoryRequest{
+		Kind: status.KindUnit,
+		Filter: StatusHistoryFilter{
+			To: ptr(s.now.Add(-time.Second)),
+		},
+	}, s.now)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.IsFalse)
+}
+
+type statusHistoryQuerierSuite struct {
+	testhelpers.IsolationSuite
+
+	querier *MockStatusHistoryQuerier
+	clock   *testclock.Clock
+}
+
+func TestStatusHistoryQuerierSuite(t *testing.T) {
+	tc.Run(t, &statusHistoryQuerierSuite{})
+}
+
+func (s *statusHistoryQuerierSuite) TestGetStatusHistory(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	from := now.Add(-48 * time.Hour)
+	to := now.Add(-time.Hour)
+
+	s.querier.EXPECT().GetStatusHistory(gomock.Any(), statushistory.Query{
+		Kinds: []status.HistoryKind{status.KindUnit, status.KindUnitAgent, status.KindWorkload},
+		ID:    "foo/0",
+		From:  from,
+		To:    to,
+		Limit: 10,
+	}).Return([]statushistory.HistoryRecord{{
+		Kind: status.KindWorkload,
+		Tag:  "foo/0",
+		Status: status.DetailedStatus{
+			Kind:   status.KindWorkload,
+			Status: status.Active,
+			Since:  &to,
+		},
+	}}, nil)
+
+	results, err := s.newService().GetStatusHistory(c.Context(), StatusHistoryRequest{
+		Kind: status.KindUnit,
+		Tag:  "foo/0",
+		Filter: StatusHistoryFilter{
+			Size: 10,
+			Date: &from,
+			To:   &to,
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, []status.DetailedStatus{{
+		Kind:   status.KindWorkload,
+		Status: status.Active,
+		Since:  &to,
+	}})
+}
+
+func (s *statusHistoryQuerierSuite) TestGetStatusHistoryDelta(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.querier.EXPECT().GetStatusHistory(gomock.Any(), statushistory.Query{
+		Kinds: []status.HistoryKind{status.KindApplication},
+		ID:    "foo",
+		From:  s.clock.Now().Add(-time.Hour),
+	}).Return(nil, nil)
+
+	results, err := s.newService().GetStatusHistory(c.Context(), StatusHistoryRequest{
+		Kind: status.KindApplication,
+		Tag:  "foo",
+		Filter: StatusHistoryFilter{
+			Delta: ptr(time.Hour),
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.HasLen, 0)
+}
+
+func (s *statusHistoryQuerierSuite) TestGetStatusHistoryUnsupportedKind(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.newService().GetStatusHistory(c.Context(), StatusHistoryRequest{
+		Kind: status.KindModel,
+	})
+	c.Assert(err, tc.ErrorMatches, `reading status history: "model"`)
+}
+
+func (s *statusHistoryQuerierSuite) TestGetStatusHistoryError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.querier.EXPECT().GetStatusHistory(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("foo"))
+
+	_, err := s.newService().GetStatusHistory(c.Context(), StatusHistoryRequest{
+		Kind: status.KindMachine,
+		Tag:  "0",
+	})
+	c.Assert(err, tc.ErrorMatches, "reading status history: foo")
+}
+
+func (s *statusHistoryQuerierSuite) newService() *Service {
+	return &Service{
+		statusHistoryQuerier: s.querier,
+		clock:                s.clock,
+	}
+}
+
+func (s *statusHistoryQuerierSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.querier = NewMockStatusHistoryQuerier(ctrl)
+	s.clock = testclock.NewClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	return ctrl
+}
@@ -206,6 +206,7 @@ func (s *storageServiceSuite) setupMocks(c *tc.C) *gomock.Controller {
 		func() (StatusHistoryReader, error) {
 			return nil, errors.Errorf("status history reader not available")
 		},
+		nil,
 		clock.WallClock,
 		loggertesting.WrapCheckLog(c),
 	)
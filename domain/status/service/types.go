@@ -57,6 +57,9 @@ type StatusHistoryFilter struct {
 	Size  int
 	Date  *time.Time
 	Delta *time.Duration
+	// To restricts the history to the statuses set at or before the given
+	// time.
+	To *time.Time
 }
 
 // StatusHistoryRequest holds the parameters to filter a status history query.
@@ -12,9 +12,14 @@ import (
 )
 
 // NewStatusHistory creates a new StatusHistory using the logger as the
-// recorder.
-func NewStatusHistory(logger logger.Logger, clock clock.Clock) *statushistory.StatusHistory {
-	return statushistory.NewStatusHistory(statushistory.NewLogRecorder(logger), clock)
+// recorder. Any additional recorders, such as the indexed status history
+// store, are recorded to alongside the logger.
+func NewStatusHistory(logger logger.Logger, clock clock.Clock, recorders ...statushistory.Recorder) *statushistory.StatusHistory {
+	recorder := statushistory.NewLogRecorder(logger)
+	if len(recorders) > 0 {
+		recorder = statushistory.NewMultiRecorder(append([]statushistory.Recorder{recorder}, recorders...)...)
+	}
+	return statushistory.NewStatusHistory(recorder, clock)
 }
 
 // NewStatusHistoryReader creates a new StatusHistoryReader using the given
@@ -0,0 +1,14 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package statushistory implements the indexed status history store of a
+// model. Every status change recorded through
+// [github.com/juju/juju/internal/statushistory.StatusHistory] is written to
+// the status_history table of the model database, which is indexed by kind,
+// entity and time. This allows the status history of an entity to be queried
+// without scanning the log files.
+//
+// The store is pruned by both the age of the records and the total size of
+// the records, as configured by the max-status-history-age and
+// max-status-history-size model config keys.
+package statushistory
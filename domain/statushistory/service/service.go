@@ -0,0 +1,143 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
+	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/statushistory"
+	"github.com/juju/juju/internal/errors"
+	internalstatushistory "github.com/juju/juju/internal/statushistory"
+)
+
+// State describes retrieval and persistence methods for the status history.
+type State interface {
+	// AddRecord adds the given record to the status history.
+	AddRecord(ctx context.Context, record statushistory.Record) error
+
+	// GetRecords returns the status history records matching the given
+	// query, ordered from the most recent to the oldest.
+	GetRecords(ctx context.Context, query internalstatushistory.Query) ([]statushistory.Record, error)
+
+	// DeleteRecordsBefore deletes all the status history records that were
+	// created before the given time. The number of deleted records is
+	// returned.
+	DeleteRecordsBefore(ctx context.Context, before time.Time) (int64, error)
+
+	// DeleteRecordsExceedingSize deletes the oldest status history records,
+	// until the total size of the remaining records is no larger than the
+	// given size in bytes. The number of deleted records is returned.
+	DeleteRecordsExceedingSize(ctx context.Context, size int64) (int64, error)
+}
+
+// Service provides the API for working with the indexed status history.
+type Service struct {
+	st        State
+	modelUUID model.UUID
+	clock     clock.Clock
+	logger    logger.Logger
+}
+
+// NewService returns a new service reference wrapping the input state.
+func NewService(st State, modelUUID model.UUID, clock clock.Clock, logger logger.Logger) *Service {
+	return &Service{
+		st:        st,
+		modelUUID: modelUUID,
+		clock:     clock,
+		logger:    logger,
+	}
+}
+
+// Record records the given status information into the indexed status
+// history. It implements [internalstatushistory.Recorder].
+func (s *Service) Record(ctx context.Context, record internalstatushistory.Record) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	since, err := time.Parse(time.RFC3339, record.Time)
+	if err != nil {
+		s.logger.Debugf(ctx, "invalid status history time %q, using current time", record.Time)
+		since = s.clock.Now()
+	}
+
+	if err := s.st.AddRecord(ctx, statushistory.Record{
+		Kind:    record.Kind,
+		ID:      record.ID,
+		Status:  record.Status,
+		Message: record.Message,
+		Data:    record.Data,
+		Since:   since,
+	}); err != nil {
+		return errors.Errorf("recording status history for %s %q: %w", record.Kind, record.ID, err)
+	}
+	return nil
+}
+
+// GetStatusHistory returns the status history records matching the given
+// query, ordered from the most recent to the oldest.
+func (s *Service) GetStatusHistory(ctx context.Context, query internalstatushistory.Query) ([]internalstatushistory.HistoryRecord, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !query.From.IsZero() && !query.To.IsZero() && query.To.Before(query.From) {
+		return nil, errors.Errorf("status history query to %v is before from %v", query.To, query.From)
+	}
+
+	records, err := s.st.GetRecords(ctx, query)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	results := make([]internalstatushistory.HistoryRecord, len(records))
+	for i, record := range records {
+		since := record.Since
+		results[i] = internalstatushistory.HistoryRecord{
+			ModelUUID: s.modelUUID,
+			Kind:      record.Kind,
+			Tag:       record.ID,
+			Status: corestatus.DetailedStatus{
+				Kind:   record.Kind,
+				Status: corestatus.Status(record.Status),
+				Info:   record.Message,
+				Data:   record.Data,
+				Since:  &since,
+			},
+		}
+	}
+	return results, nil
+}
+
+// PruneStatusHistory removes the status history records that are older than
+// the given age, then removes the oldest records until the total size of the
+// status history is no larger than the given size in bytes. A zero age or
+// size disables the respective pruning. The number of removed records is
+// returned.
+func (s *Service) PruneStatusHistory(ctx context.Context, maxAge time.Duration, maxSize int64) (int64, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	var pruned int64
+	if maxAge > 0 {
+		deleted, err := s.st.DeleteRecordsBefore(ctx, s.clock.Now().Add(-maxAge))
+		if err != nil {
+			return pruned, errors.Errorf("pruning status history by age: %w", err)
+		}
+		pruned += deleted
+	}
+	if maxSize > 0 {
+		deleted, err := s.st.DeleteRecordsExceedingSize(ctx, maxSize)
+		if err != nil {
+			return pruned, errors.Errorf("pruning status history by size: %w", err)
+		}
+		pruned += deleted
+	}
+	return pruned, nil
+}
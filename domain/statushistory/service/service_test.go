@@ -0,0 +1,163 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/model"
+	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/juju/domain/statushistory"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	internalstatushistory "github.com/juju/juju/internal/statushistory"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type serviceSuite struct {
+	testhelpers.IsolationSuite
+
+	state *MockState
+	clock *testclock.Clock
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) TestRecord(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	s.state.EXPECT().AddRecord(gomock.Any(), statushistory.Record{
+		Kind:    corestatus.KindWorkload,
+		ID:      "foo/0",
+		Status:  "active",
+		Message: "ready",
+		Data:    map[string]any{"foo": "bar"},
+		Since:   now,
+	}).Return(nil)
+
+	err := s.service(c).Record(c.Context(), internalstatushistory.Record{
+		Kind:    corestatus.KindWorkload,
+		ID:      "foo/0",
+		Status:  "active",
+		Message: "ready",
+		Data:    map[string]any{"foo": "bar"},
+		Time:    now.Format(time.RFC3339),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRecordInvalidTime(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddRecord(gomock.Any(), statushistory.Record{
+		Kind:   corestatus.KindWorkload,
+		ID:     "foo/0",
+		Status: "active",
+		Since:  s.clock.Now(),
+	}).Return(nil)
+
+	err := s.service(c).Record(c.Context(), internalstatushistory.Record{
+		Kind:   corestatus.KindWorkload,
+		ID:     "foo/0",
+		Status: "active",
+		Time:   "invalid",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRecordError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddRecord(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	err := s.service(c).Record(c.Context(), internalstatushistory.Record{
+		Kind: corestatus.KindWorkload,
+		ID:   "foo/0",
+		Time: s.clock.Now().Format(time.RFC3339),
+	})
+	c.Assert(err, tc.ErrorMatches, `recording status history for workload "foo/0": boom`)
+}
+
+func (s *serviceSuite) TestGetStatusHistory(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	query := internalstatushistory.Query{
+		Kinds: []corestatus.HistoryKind{corestatus.KindWorkload},
+		ID:    "foo/0",
+		Limit: 10,
+	}
+	s.state.EXPECT().GetRecords(gomock.Any(), query).Return([]statushistory.Record{{
+		Kind:    corestatus.KindWorkload,
+		ID:      "foo/0",
+		Status:  "active",
+		Message: "ready",
+		Since:   now,
+	}}, nil)
+
+	records, err := s.service(c).GetStatusHistory(c.Context(), query)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(records, tc.DeepEquals, []internalstatushistory.HistoryRecord{{
+		ModelUUID: model.UUID("model-uuid"),
+		Kind:      corestatus.KindWorkload,
+		Tag:       "foo/0",
+		Status: corestatus.DetailedStatus{
+			Kind:   corestatus.KindWorkload,
+			Status: corestatus.Active,
+			Info:   "ready",
+			Since:  &now,
+		},
+	}})
+}
+
+func (s *serviceSuite) TestGetStatusHistoryInvalidRange(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	_, err := s.service(c).GetStatusHistory(c.Context(), internalstatushistory.Query{
+		From: now,
+		To:   now.Add(-time.Hour),
+	})
+	c.Assert(err, tc.ErrorMatches, `status history query to .* is before from .*`)
+}
+
+func (s *serviceSuite) TestPruneStatusHistory(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DeleteRecordsBefore(gomock.Any(), s.clock.Now().Add(-time.Hour)).Return(int64(2), nil)
+	s.state.EXPECT().DeleteRecordsExceedingSize(gomock.Any(), int64(1024)).Return(int64(3), nil)
+
+	pruned, err := s.service(c).PruneStatusHistory(c.Context(), time.Hour, 1024)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.Equals, int64(5))
+}
+
+func (s *serviceSuite) TestPruneStatusHistoryDisabled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	pruned, err := s.service(c).PruneStatusHistory(c.Context(), 0, 0)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.Equals, int64(0))
+}
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	return ctrl
+}
+
+func (s *serviceSuite) service(c *tc.C) *Service {
+	return NewService(s.state, model.UUID("model-uuid"), s.clock, loggertesting.WrapCheckLog(c))
+}
@@ -0,0 +1,198 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/statushistory/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/statushistory/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	statushistory "github.com/juju/juju/domain/statushistory"
+	statushistory0 "github.com/juju/juju/internal/statushistory"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// AddRecord mocks base method.
+func (m *MockState) AddRecord(arg0 context.Context, arg1 statushistory.Record) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRecord indicates an expected call of AddRecord.
+func (mr *MockStateMockRecorder) AddRecord(arg0, arg1 any) *MockStateAddRecordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecord", reflect.TypeOf((*MockState)(nil).AddRecord), arg0, arg1)
+	return &MockStateAddRecordCall{Call: call}
+}
+
+// MockStateAddRecordCall wrap *gomock.Call
+type MockStateAddRecordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddRecordCall) Return(arg0 error) *MockStateAddRecordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddRecordCall) Do(f func(context.Context, statushistory.Record) error) *MockStateAddRecordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddRecordCall) DoAndReturn(f func(context.Context, statushistory.Record) error) *MockStateAddRecordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteRecordsBefore mocks base method.
+func (m *MockState) DeleteRecordsBefore(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecordsBefore", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecordsBefore indicates an expected call of DeleteRecordsBefore.
+func (mr *MockStateMockRecorder) DeleteRecordsBefore(arg0, arg1 any) *MockStateDeleteRecordsBeforeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecordsBefore", reflect.TypeOf((*MockState)(nil).DeleteRecordsBefore), arg0, arg1)
+	return &MockStateDeleteRecordsBeforeCall{Call: call}
+}
+
+// MockStateDeleteRecordsBeforeCall wrap *gomock.Call
+type MockStateDeleteRecordsBeforeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeleteRecordsBeforeCall) Return(arg0 int64, arg1 error) *MockStateDeleteRecordsBeforeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeleteRecordsBeforeCall) Do(f func(context.Context, time.Time) (int64, error)) *MockStateDeleteRecordsBeforeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeleteRecordsBeforeCall) DoAndReturn(f func(context.Context, time.Time) (int64, error)) *MockStateDeleteRecordsBeforeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteRecordsExceedingSize mocks base method.
+func (m *MockState) DeleteRecordsExceedingSize(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecordsExceedingSize", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecordsExceedingSize indicates an expected call of DeleteRecordsExceedingSize.
+func (mr *MockStateMockRecorder) DeleteRecordsExceedingSize(arg0, arg1 any) *MockStateDeleteRecordsExceedingSizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecordsExceedingSize", reflect.TypeOf((*MockState)(nil).DeleteRecordsExceedingSize), arg0, arg1)
+	return &MockStateDeleteRecordsExceedingSizeCall{Call: call}
+}
+
+// MockStateDeleteRecordsExceedingSizeCall wrap *gomock.Call
+type MockStateDeleteRecordsExceedingSizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeleteRecordsExceedingSizeCall) Return(arg0 int64, arg1 error) *MockStateDeleteRecordsExceedingSizeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeleteRecordsExceedingSizeCall) Do(f func(context.Context, int64) (int64, error)) *MockStateDeleteRecordsExceedingSizeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeleteRecordsExceedingSizeCall) DoAndReturn(f func(context.Context, int64) (int64, error)) *MockStateDeleteRecordsExceedingSizeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRecords mocks base method.
+func (m *MockState) GetRecords(arg0 context.Context, arg1 statushistory0.Query) ([]statushistory.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecords", arg0, arg1)
+	ret0, _ := ret[0].([]statushistory.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecords indicates an expected call of GetRecords.
+func (mr *MockStateMockRecorder) GetRecords(arg0, arg1 any) *MockStateGetRecordsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecords", reflect.TypeOf((*MockState)(nil).GetRecords), arg0, arg1)
+	return &MockStateGetRecordsCall{Call: call}
+}
+
+// MockStateGetRecordsCall wrap *gomock.Call
+type MockStateGetRecordsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetRecordsCall) Return(arg0 []statushistory.Record, arg1 error) *MockStateGetRecordsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetRecordsCall) Do(f func(context.Context, statushistory0.Query) ([]statushistory.Record, error)) *MockStateGetRecordsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetRecordsCall) DoAndReturn(f func(context.Context, statushistory0.Query) ([]statushistory.Record, error)) *MockStateGetRecordsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
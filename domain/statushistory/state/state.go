@@ -0,0 +1,229 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/statushistory"
+	"github.com/juju/juju/internal/errors"
+	internalstatushistory "github.com/juju/juju/internal/statushistory"
+)
+
+// recordOverhead is the approximate number of bytes used by a status history
+// record, in addition to the size of its values.
+const recordOverhead = 64
+
+// State represents database interactions dealing with the status history.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new status history state based on the input database
+// factory method.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// AddRecord adds the given record to the status history.
+func (s *State) AddRecord(ctx context.Context, record statushistory.Record) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := statusHistory{
+		Kind:        record.Kind.String(),
+		NamespaceID: record.ID,
+		Status:      record.Status,
+		Message:     sql.NullString{String: record.Message, Valid: record.Message != ""},
+		CreatedAt:   record.Since.UTC(),
+	}
+	if len(record.Data) > 0 {
+		data, err := json.Marshal(record.Data)
+		if err != nil {
+			return errors.Errorf("marshalling status data: %w", err)
+		}
+		row.Data = sql.NullString{String: string(data), Valid: true}
+	}
+	row.Size = int64(recordOverhead + len(row.Kind) + len(row.NamespaceID) +
+		len(row.Status) + len(row.Message.String) + len(row.Data.String))
+
+	stmt, err := s.Prepare(`
+INSERT INTO status_history (kind, namespace_id, status, message, data, created_at, size)
+VALUES ($statusHistory.*)`, row)
+	if err != nil {
+		return errors.Errorf("preparing insert status history statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, row).Run(); err != nil {
+			return errors.Errorf("inserting status history: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetRecords returns the status history records matching the given query,
+// ordered from the most recent to the oldest.
+func (s *State) GetRecords(ctx context.Context, query internalstatushistory.Query) ([]statushistory.Record, error) {
+	db, err := s.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var (
+		conditions []string
+		args       []any
+	)
+	if len(query.Kinds) > 0 {
+		kinds := make(historyKinds, len(query.Kinds))
+		for i, kind := range query.Kinds {
+			kinds[i] = kind.String()
+		}
+		conditions = append(conditions, "kind IN ($historyKinds[:])")
+		args = append(args, kinds)
+	}
+	if query.ID != "" {
+		conditions = append(conditions, "namespace_id = $namespaceID.namespace_id")
+		args = append(args, namespaceID{NamespaceID: query.ID})
+	}
+	var window timeRange
+	if !query.From.IsZero() {
+		window.From = query.From.UTC()
+		conditions = append(conditions, "created_at > $timeRange.from")
+	}
+	if !query.To.IsZero() {
+		window.To = query.To.UTC()
+		conditions = append(conditions, "created_at <= $timeRange.to")
+	}
+	if !query.From.IsZero() || !query.To.IsZero() {
+		args = append(args, window)
+	}
+
+	stmtStr := "SELECT &statusHistory.* FROM status_history"
+	if len(conditions) > 0 {
+		stmtStr += "\nWHERE " + strings.Join(conditions, "\nAND ")
+	}
+	stmtStr += "\nORDER BY created_at DESC, id DESC"
+	if query.Limit > 0 {
+		stmtStr += "\nLIMIT $limit.limit"
+		args = append(args, limit{Limit: query.Limit})
+	}
+
+	stmt, err := s.Prepare(stmtStr, append([]any{statusHistory{}}, args...)...)
+	if err != nil {
+		return nil, errors.Errorf("preparing select status history statement: %w", err)
+	}
+
+	var rows []statusHistory
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, args...).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	}); err != nil {
+		return nil, errors.Errorf("querying status history: %w", err)
+	}
+
+	records := make([]statushistory.Record, len(rows))
+	for i, row := range rows {
+		var data map[string]any
+		if row.Data.Valid && row.Data.String != "" {
+			if err := json.Unmarshal([]byte(row.Data.String), &data); err != nil {
+				return nil, errors.Errorf("unmarshalling status data: %w", err)
+			}
+		}
+		records[i] = statushistory.Record{
+			Kind:    status.HistoryKind(row.Kind),
+			ID:      row.NamespaceID,
+			Status:  row.Status,
+			Message: row.Message.String,
+			Data:    data,
+			Since:   row.CreatedAt,
+		}
+	}
+	return records, nil
+}
+
+// DeleteRecordsBefore deletes all the status history records that were
+// created before the given time. The number of deleted records is returned.
+func (s *State) DeleteRecordsBefore(ctx context.Context, before time.Time) (int64, error) {
+	db, err := s.DB()
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	window := timeRange{To: before.UTC()}
+	stmt, err := s.Prepare(`
+DELETE FROM status_history
+WHERE created_at < $timeRange.to`, window)
+	if err != nil {
+		return 0, errors.Errorf("preparing delete status history statement: %w", err)
+	}
+
+	var deleted int64
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, window).Get(&outcome); err != nil {
+			return errors.Errorf("deleting status history: %w", err)
+		}
+		deleted, err = outcome.Result().RowsAffected()
+		return errors.Capture(err)
+	}); err != nil {
+		return 0, errors.Capture(err)
+	}
+	return deleted, nil
+}
+
+// DeleteRecordsExceedingSize deletes the oldest status history records,
+// until the total size of the remaining records is no larger than the given
+// size in bytes. The number of deleted records is returned.
+func (s *State) DeleteRecordsExceedingSize(ctx context.Context, size int64) (int64, error) {
+	db, err := s.DB()
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	threshold := maxSize{Size: size}
+	stmt, err := s.Prepare(`
+DELETE FROM status_history
+WHERE id <= (
+    SELECT id FROM (
+        SELECT id, SUM(size) OVER (ORDER BY id DESC) AS total
+        FROM status_history
+    )
+    WHERE total > $maxSize.size
+    ORDER BY id DESC
+    LIMIT 1
+)`, threshold)
+	if err != nil {
+		return 0, errors.Errorf("preparing delete status history statement: %w", err)
+	}
+
+	var deleted int64
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, threshold).Get(&outcome); err != nil {
+			return errors.Errorf("deleting status history: %w", err)
+		}
+		deleted, err = outcome.Result().RowsAffected()
+		return errors.Capture(err)
+	}); err != nil {
+		return 0, errors.Capture(err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/status"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/domain/statushistory"
+	internalstatushistory "github.com/juju/juju/internal/statushistory"
+)
+
+type stateSuite struct {
+	schematesting.ModelSuite
+
+	now time.Time
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) SetUpTest(c *tc.C) {
+	s.ModelSuite.SetUpTest(c)
+	s.now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *stateSuite) TestAddRecordAndGetRecords(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	record := statushistory.Record{
+		Kind:    status.KindWorkload,
+		ID:      "foo/0",
+		Status:  "active",
+		Message: "ready",
+		Data:    map[string]any{"foo": "bar"},
+		Since:   s.now,
+	}
+	err := st.AddRecord(c.Context(), record)
+	c.Assert(err, tc.ErrorIsNil)
+
+	records, err := st.GetRecords(c.Context(), internalstatushistory.Query{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(records, tc.HasLen, 1)
+	c.Check(records[0].Since.Equal(s.now), tc.IsTrue)
+	records[0].Since = s.now
+	c.Check(records[0], tc.DeepEquals, record)
+}
+
+func (s *stateSuite) TestGetRecordsNoRecords(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	records, err := st.GetRecords(c.Context(), internalstatushistory.Query{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(records, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetRecordsFilters(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.addRecord(c, st, status.KindWorkload, "foo/0", "waiting", s.now.Add(-3*time.Hour))
+	s.addRecord(c, st, status.KindUnitAgent, "foo/0", "idle", s.now.Add(-2*time.Hour))
+	s.addRecord(c, st, status.KindWorkload, "foo/1", "active", s.now.Add(-2*time.Hour))
+	s.addRecord(c, st, status.KindWorkload, "foo/0", "active", s.now.Add(-time.Hour))
+	s.addRecord(c, st, status.KindApplication, "foo", "active", s.now)
+
+	// Filter by kind and ID, ordered most recent first.
+	records, err := st.GetRecords(c.Context(), internalstatushistory.Query{
+		Kinds: []status.HistoryKind{status.KindWorkload},
+		ID:    "foo/0",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses(records), tc.DeepEquals, []string{"active", "waiting"})
+
+	// Filter by many kinds.
+	records, err = st.GetRecords(c.Context(), internalstatushistory.Query{
+		Kinds: []status.HistoryKind{status.KindWorkload, status.KindUnitAgent},
+		ID:    "foo/0",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses(records), tc.DeepEquals, []string{"active", "idle", "waiting"})
+
+	// Filter by time range.
+	records, err = st.GetRecords(c.Context(), internalstatushistory.Query{
+		ID:   "foo/0",
+		From: s.now.Add(-3 * time.Hour),
+		To:   s.now.Add(-time.Hour),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses(records), tc.DeepEquals, []string{"active", "idle"})
+
+	// Limit the number of records.
+	records, err = st.GetRecords(c.Context(), internalstatushistory.Query{
+		Limit: 2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(records, tc.HasLen, 2)
+	c.Check(records[0].Kind, tc.Equals, status.KindApplication)
+	c.Check(records[1].Status, tc.Equals, "active")
+}
+
+func (s *stateSuite) TestDeleteRecordsBefore(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.addRecord(c, st, status.KindWorkload, "foo/0", "waiting", s.now.Add(-3*time.Hour))
+	s.addRecord(c, st, status.KindWorkload, "foo/0", "maintenance", s.now.Add(-2*time.Hour))
+	s.addRecord(c, st, status.KindWorkload, "foo/0", "active", s.now)
+
+	deleted, err := st.DeleteRecordsBefore(c.Context(), s.now.Add(-time.Hour))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.Equals, int64(2))
+
+	records, err := st.GetRecords(c.Context(), internalstatushistory.Query{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses(records), tc.DeepEquals, []string{"active"})
+}
+
+func (s *stateSuite) TestDeleteRecordsExceedingSize(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	for i := range 10 {
+		s.addRecord(c, st, status.KindWorkload, "foo/0", "active", s.now.Add(time.Duration(i)*time.Minute))
+	}
+
+	// Each record is the overhead plus the size of the kind, ID and status.
+	size := int64(recordOverhead + len("workload") + len("foo/0") + len("active"))
+
+	deleted, err := st.DeleteRecordsExceedingSize(c.Context(), 3*size)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.Equals, int64(7))
+
+	records, err := st.GetRecords(c.Context(), internalstatushistory.Query{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(records, tc.HasLen, 3)
+	c.Check(records[2].Since.Equal(s.now.Add(7*time.Minute)), tc.IsTrue)
+
+	// Nothing more to delete.
+	deleted, err = st.DeleteRecordsExceedingSize(c.Context(), 3*size)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.Equals, int64(0))
+}
+
+func (s *stateSuite) addRecord(c *tc.C, st *State, kind status.HistoryKind, id, value string, since time.Time) {
+	err := st.AddRecord(c.Context(), statushistory.Record{
+		Kind:   kind,
+		ID:     id,
+		Status: value,
+		Since:  since,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func statuses(records []statushistory.Record) []string {
+	result := make([]string, len(records))
+	for i, record := range records {
+		result[i] = record.Status
+	}
+	return result
+}
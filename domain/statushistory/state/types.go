@@ -0,0 +1,38 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+)
+
+type statusHistory struct {
+	Kind        string         `db:"kind"`
+	NamespaceID string         `db:"namespace_id"`
+	Status      string         `db:"status"`
+	Message     sql.NullString `db:"message"`
+	Data        sql.NullString `db:"data"`
+	CreatedAt   time.Time      `db:"created_at"`
+	Size        int64          `db:"size"`
+}
+
+type historyKinds []string
+
+type namespaceID struct {
+	NamespaceID string `db:"namespace_id"`
+}
+
+type timeRange struct {
+	From time.Time `db:"from"`
+	To   time.Time `db:"to"`
+}
+
+type limit struct {
+	Limit int `db:"limit"`
+}
+
+type maxSize struct {
+	Size int64 `db:"size"`
+}
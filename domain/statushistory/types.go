@@ -0,0 +1,26 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package statushistory
+
+import (
+	"time"
+
+	"github.com/juju/juju/core/status"
+)
+
+// Record is a single status history record in the indexed store.
+type Record struct {
+	// Kind is the status history kind of the entity.
+	Kind status.HistoryKind
+	// ID identifies the entity within the kind.
+	ID string
+	// Status is the status value.
+	Status string
+	// Message is the status message.
+	Message string
+	// Data is the status data.
+	Data map[string]any
+	// Since is the time the status was set.
+	Since time.Time
+}
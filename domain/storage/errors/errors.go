@@ -40,3 +40,15 @@ const (
 	// on does not exist.
 	VolumeNotFound = errors.ConstError("volume not found")
 )
+
+// These errors are used for storage snapshot operations.
+const (
+	// SnapshotNotFound describes an error that occurs when the storage
+	// snapshot being operated on does not exist.
+	SnapshotNotFound = errors.ConstError("storage snapshot not found")
+
+	// SnapshotNotAvailable describes an error that occurs when a storage
+	// snapshot is used before it has been taken, or after it has started
+	// being removed.
+	SnapshotNotAvailable = errors.ConstError("storage snapshot not available")
+)
@@ -6,6 +6,7 @@ package modelmigration
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/description/v10"
 
 	"github.com/juju/juju/core/logger"
@@ -49,7 +50,7 @@ func (e *exportOperation) Name() string {
 // Setup implements Operation.
 func (e *exportOperation) Setup(scope modelmigration.Scope) error {
 	e.service = service.NewService(
-		state.NewState(scope.ModelDB()), e.logger, e.storageRegistryGetter)
+		state.NewState(scope.ModelDB()), e.logger, e.storageRegistryGetter, clock.WallClock)
 	return nil
 }
 
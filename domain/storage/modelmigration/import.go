@@ -6,6 +6,7 @@ package modelmigration
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/description/v10"
 
 	"github.com/juju/juju/core/logger"
@@ -53,7 +54,7 @@ func (i *importOperation) Name() string {
 // Setup implements Operation.
 func (i *importOperation) Setup(scope modelmigration.Scope) error {
 	i.service = service.NewService(
-		state.NewState(scope.ModelDB()), i.logger, i.storageRegistryGetter)
+		state.NewState(scope.ModelDB()), i.logger, i.storageRegistryGetter, clock.WallClock)
 	return nil
 }
 
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/storage (interfaces: ProviderRegistry,Provider,VolumeSource,VolumeImporter,FilesystemSource,FilesystemImporter)
+// Source: github.com/juju/juju/internal/storage (interfaces: ProviderRegistry,Provider,VolumeSource,VolumeImporter,VolumeSnapshotter,FilesystemSource,FilesystemImporter)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination internal_storage_mock_test.go github.com/juju/juju/internal/storage ProviderRegistry,Provider,VolumeSource,VolumeImporter,FilesystemSource,FilesystemImporter
+//	mockgen -typed -package service -destination internal_storage_mock_test.go github.com/juju/juju/internal/storage ProviderRegistry,Provider,VolumeSource,VolumeImporter,VolumeSnapshotter,FilesystemSource,FilesystemImporter
 //
 
 // Package service is a generated GoMock package.
@@ -881,6 +881,107 @@ func (c *MockVolumeImporterImportVolumeCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// MockVolumeSnapshotter is a mock of VolumeSnapshotter interface.
+type MockVolumeSnapshotter struct {
+	ctrl     *gomock.Controller
+	recorder *MockVolumeSnapshotterMockRecorder
+}
+
+// MockVolumeSnapshotterMockRecorder is the mock recorder for MockVolumeSnapshotter.
+type MockVolumeSnapshotterMockRecorder struct {
+	mock *MockVolumeSnapshotter
+}
+
+// NewMockVolumeSnapshotter creates a new mock instance.
+func NewMockVolumeSnapshotter(ctrl *gomock.Controller) *MockVolumeSnapshotter {
+	mock := &MockVolumeSnapshotter{ctrl: ctrl}
+	mock.recorder = &MockVolumeSnapshotterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVolumeSnapshotter) EXPECT() *MockVolumeSnapshotterMockRecorder {
+	return m.recorder
+}
+
+// CreateVolumeSnapshots mocks base method.
+func (m *MockVolumeSnapshotter) CreateVolumeSnapshots(arg0 context.Context, arg1 []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]storage.CreateVolumeSnapshotsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeSnapshots indicates an expected call of CreateVolumeSnapshots.
+func (mr *MockVolumeSnapshotterMockRecorder) CreateVolumeSnapshots(arg0, arg1 any) *MockVolumeSnapshotterCreateVolumeSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeSnapshots", reflect.TypeOf((*MockVolumeSnapshotter)(nil).CreateVolumeSnapshots), arg0, arg1)
+	return &MockVolumeSnapshotterCreateVolumeSnapshotsCall{Call: call}
+}
+
+// MockVolumeSnapshotterCreateVolumeSnapshotsCall wrap *gomock.Call
+type MockVolumeSnapshotterCreateVolumeSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeSnapshotterCreateVolumeSnapshotsCall) Return(arg0 []storage.CreateVolumeSnapshotsResult, arg1 error) *MockVolumeSnapshotterCreateVolumeSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeSnapshotterCreateVolumeSnapshotsCall) Do(f func(context.Context, []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error)) *MockVolumeSnapshotterCreateVolumeSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeSnapshotterCreateVolumeSnapshotsCall) DoAndReturn(f func(context.Context, []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error)) *MockVolumeSnapshotterCreateVolumeSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DestroyVolumeSnapshots mocks base method.
+func (m *MockVolumeSnapshotter) DestroyVolumeSnapshots(arg0 context.Context, arg1 []string) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyVolumeSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyVolumeSnapshots indicates an expected call of DestroyVolumeSnapshots.
+func (mr *MockVolumeSnapshotterMockRecorder) DestroyVolumeSnapshots(arg0, arg1 any) *MockVolumeSnapshotterDestroyVolumeSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVolumeSnapshots", reflect.TypeOf((*MockVolumeSnapshotter)(nil).DestroyVolumeSnapshots), arg0, arg1)
+	return &MockVolumeSnapshotterDestroyVolumeSnapshotsCall{Call: call}
+}
+
+// MockVolumeSnapshotterDestroyVolumeSnapshotsCall wrap *gomock.Call
+type MockVolumeSnapshotterDestroyVolumeSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeSnapshotterDestroyVolumeSnapshotsCall) Return(arg0 []error, arg1 error) *MockVolumeSnapshotterDestroyVolumeSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeSnapshotterDestroyVolumeSnapshotsCall) Do(f func(context.Context, []string) ([]error, error)) *MockVolumeSnapshotterDestroyVolumeSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeSnapshotterDestroyVolumeSnapshotsCall) DoAndReturn(f func(context.Context, []string) ([]error, error)) *MockVolumeSnapshotterDestroyVolumeSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockFilesystemSource is a mock of FilesystemSource interface.
 type MockFilesystemSource struct {
 	ctrl     *gomock.Controller
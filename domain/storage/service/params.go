@@ -4,6 +4,8 @@
 package service
 
 import (
+	"github.com/juju/names/v6"
+
 	"github.com/juju/juju/core/storage"
 	internalstorage "github.com/juju/juju/internal/storage"
 )
@@ -24,3 +26,26 @@ type ImportStorageParams struct {
 	// StorageName is the name to assign to the imported storage.
 	StorageName storage.Name
 }
+
+// SnapshotStorageParams contains the parameters for taking a snapshot of
+// the volume backing a storage instance.
+type SnapshotStorageParams struct {
+	// StorageID is the ID of the storage instance to snapshot.
+	StorageID string
+
+	// VolumeTag is the tag of the volume backing the storage instance.
+	VolumeTag names.VolumeTag
+
+	// VolumeID is the storage provider's unique ID for the volume.
+	VolumeID string
+
+	// Pool is the name of the storage pool of the volume.
+	Pool string
+
+	// Size is the size of the volume in MiB.
+	Size uint64
+
+	// ResourceTags is a set of tags to set on the snapshot, if the
+	// storage provider supports tags.
+	ResourceTags map[string]string
+}
@@ -6,9 +6,13 @@ package service
 import (
 	"context"
 
+	"github.com/juju/clock"
+
 	"github.com/juju/juju/core/logger"
 	corestorage "github.com/juju/juju/core/storage"
 	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/internal/errors"
 	internalstorage "github.com/juju/juju/internal/storage"
 )
@@ -17,6 +21,20 @@ import (
 type State interface {
 	StoragePoolState
 	StorageState
+	StorageSnapshotState
+}
+
+// WatcherFactory describes methods for creating watchers.
+type WatcherFactory interface {
+	// NewNotifyMapperWatcher returns a new watcher that receives changes from
+	// the input base watcher's db/queue. A single filter option is required,
+	// though additional filter options can be provided. Filtering of values
+	// is done first by the filter, and then subsequently by the mapper.
+	NewNotifyMapperWatcher(
+		mapper eventsource.Mapper,
+		filter eventsource.FilterOption,
+		filterOpts ...eventsource.FilterOption,
+	) (watcher.NotifyWatcher, error)
 }
 
 // Service defines a service for interacting with the underlying state.
@@ -26,7 +44,12 @@ type Service struct {
 }
 
 // NewService returns a new Service for interacting with the underlying state.
-func NewService(st State, logger logger.Logger, registryGetter corestorage.ModelStorageRegistryGetter) *Service {
+func NewService(
+	st State,
+	logger logger.Logger,
+	registryGetter corestorage.ModelStorageRegistryGetter,
+	clock clock.Clock,
+) *Service {
 	return &Service{
 		StoragePoolService: &StoragePoolService{
 			st:             st,
@@ -37,10 +60,33 @@ func NewService(st State, logger logger.Logger, registryGetter corestorage.Model
 			st:             st,
 			logger:         logger,
 			registryGetter: registryGetter,
+			clock:          clock,
 		},
 	}
 }
 
+// WatchableService defines a service for interacting with the underlying
+// state and the ability to create watchers.
+type WatchableService struct {
+	Service
+	watcherFactory WatcherFactory
+}
+
+// NewWatchableService returns a new WatchableService for interacting with
+// the underlying state and the ability to create watchers.
+func NewWatchableService(
+	st State,
+	watcherFactory WatcherFactory,
+	logger logger.Logger,
+	registryGetter corestorage.ModelStorageRegistryGetter,
+	clock clock.Clock,
+) *WatchableService {
+	return &WatchableService{
+		Service:        *NewService(st, logger, registryGetter, clock),
+		watcherFactory: watcherFactory,
+	}
+}
+
 // GetStorageRegistry returns the storage registry for the model.
 //
 // Deprecated: This method will be removed once the storage registry is fully
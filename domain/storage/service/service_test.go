@@ -6,6 +6,7 @@ package service
 import (
 	"testing"
 
+	"github.com/juju/clock"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
@@ -54,7 +55,7 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.storageRegistryGetter = NewMockModelStorageRegistryGetter(ctrl)
 	s.storageRegistry = NewMockProviderRegistry(ctrl)
 
-	s.service = NewService(s.state, logtesting.WrapCheckLog(c), s.storageRegistryGetter)
+	s.service = NewService(s.state, logtesting.WrapCheckLog(c), s.storageRegistryGetter, clock.WallClock)
 
 	return ctrl
 }
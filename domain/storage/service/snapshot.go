@@ -0,0 +1,355 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/core/changestream"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	"github.com/juju/juju/domain/life"
+	"github.com/juju/juju/domain/storage"
+	"github.com/juju/juju/internal/errors"
+	internalstorage "github.com/juju/juju/internal/storage"
+)
+
+// StorageSnapshotState defines an interface for interacting with the
+// persisted storage snapshots.
+type StorageSnapshotState interface {
+	// CreateStorageSnapshot records a new storage snapshot, which is
+	// alive and pending until the storage provider has taken it.
+	CreateStorageSnapshot(ctx context.Context, snapshot storage.StorageSnapshot) error
+
+	// SetStorageSnapshotProvisioned records the provider ID and size of
+	// a snapshot that has been taken by the storage provider, and marks
+	// it as available.
+	SetStorageSnapshotProvisioned(ctx context.Context, uuid storage.SnapshotUUID, providerID string, size uint64) error
+
+	// SetStorageSnapshotStatus sets the status of the specified snapshot.
+	SetStorageSnapshotStatus(ctx context.Context, uuid storage.SnapshotUUID, status storage.SnapshotStatus, message string) error
+
+	// EnsureStorageSnapshotDying marks the specified snapshot as dying,
+	// and its status as destroying.
+	EnsureStorageSnapshotDying(ctx context.Context, uuid storage.SnapshotUUID) error
+
+	// DeleteStorageSnapshot removes the record of the specified snapshot.
+	DeleteStorageSnapshot(ctx context.Context, uuid storage.SnapshotUUID) error
+
+	// GetStorageSnapshot returns the specified snapshot.
+	GetStorageSnapshot(ctx context.Context, uuid storage.SnapshotUUID) (storage.StorageSnapshot, error)
+
+	// ListStorageSnapshots returns the snapshots taken of the specified
+	// storage instance, or of all storage instances if storageID is empty.
+	ListStorageSnapshots(ctx context.Context, storageID string) ([]storage.StorageSnapshot, error)
+
+	// GetMachineStorageSnapshots returns the snapshots of volumes scoped
+	// to the specified machine.
+	GetMachineStorageSnapshots(ctx context.Context, machineID string) ([]storage.StorageSnapshot, error)
+
+	// NamespaceForWatchStorageSnapshots returns the namespace of the
+	// storage snapshots table, for watching changes to snapshots.
+	NamespaceForWatchStorageSnapshots() string
+}
+
+// SnapshotStorage takes a snapshot of the volume backing a storage instance
+// and records it in the model. The returned snapshot is available to seed
+// new storage unless an error is returned, except for snapshots of
+// machine-scoped volumes: these are returned pending, to be taken by the
+// storage provisioner on the machine.
+// The following error types can be expected:
+// - [coreerrors.NotValid]: when the supplied parameters are not valid.
+// - [coreerrors.NotSupported]: when the pool's storage provider does not
+// support snapshots.
+func (s *StorageService) SnapshotStorage(ctx context.Context, arg SnapshotStorageParams) (storage.StorageSnapshot, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if arg.StorageID == "" {
+		return storage.StorageSnapshot{}, errors.New("empty storage ID").Add(coreerrors.NotValid)
+	}
+	if arg.VolumeID == "" {
+		return storage.StorageSnapshot{}, errors.Errorf(
+			"storage %q has no provisioned volume", arg.StorageID,
+		).Add(coreerrors.NotValid)
+	}
+
+	provider, cfg, err := s.GetStoragePoolProvider(ctx, arg.Pool)
+	if err != nil {
+		return storage.StorageSnapshot{}, errors.Capture(err)
+	}
+
+	uuid, err := storage.NewSnapshotUUID()
+	if err != nil {
+		return storage.StorageSnapshot{}, errors.Capture(err)
+	}
+	snapshot := storage.StorageSnapshot{
+		UUID:      uuid,
+		StorageID: arg.StorageID,
+		VolumeID:  arg.VolumeID,
+		VolumeTag: arg.VolumeTag.String(),
+		Pool:      arg.Pool,
+		Size:      arg.Size,
+		CreatedAt: s.clock.Now().UTC(),
+	}
+
+	if provider.Scope() != internalstorage.ScopeEnviron {
+		// Machine-scoped volumes can only be reached from the machine
+		// they are on, so the snapshot is left pending for the storage
+		// provisioner on the machine to take.
+		machineTag, ok := names.VolumeMachine(arg.VolumeTag)
+		if !ok {
+			return storage.StorageSnapshot{}, errors.Errorf(
+				"volume %q of machine-scoped storage %q has no machine", arg.VolumeTag.Id(), arg.StorageID,
+			).Add(coreerrors.NotValid)
+		}
+		snapshot.MachineID = machineTag.Id()
+		if err := s.st.CreateStorageSnapshot(ctx, snapshot); err != nil {
+			return storage.StorageSnapshot{}, errors.Capture(err)
+		}
+		return s.st.GetStorageSnapshot(ctx, uuid)
+	}
+
+	snapshotter, err := volumeSnapshotter(provider, cfg)
+	if err != nil {
+		return storage.StorageSnapshot{}, errors.Capture(err)
+	}
+	if err := s.st.CreateStorageSnapshot(ctx, snapshot); err != nil {
+		return storage.StorageSnapshot{}, errors.Capture(err)
+	}
+
+	results, err := snapshotter.CreateVolumeSnapshots(ctx, []internalstorage.VolumeSnapshotParams{{
+		Tag:          arg.VolumeTag,
+		VolumeId:     arg.VolumeID,
+		ResourceTags: arg.ResourceTags,
+	}})
+	if err == nil && len(results) != 1 {
+		err = errors.Errorf("expected 1 result, got %d", len(results))
+	} else if err == nil {
+		err = results[0].Error
+	}
+	if err != nil {
+		if statusErr := s.st.SetStorageSnapshotStatus(
+			ctx, uuid, storage.SnapshotStatusError, err.Error(),
+		); statusErr != nil {
+			s.logger.Errorf(ctx, "setting status of snapshot %q: %v", uuid, statusErr)
+		}
+		return storage.StorageSnapshot{}, errors.Errorf("snapshotting storage %q: %w", arg.StorageID, err)
+	}
+
+	taken := results[0].Snapshot
+	if err := s.st.SetStorageSnapshotProvisioned(ctx, uuid, taken.SnapshotId, taken.Size); err != nil {
+		return storage.StorageSnapshot{}, errors.Capture(err)
+	}
+	return s.st.GetStorageSnapshot(ctx, uuid)
+}
+
+// GetStorageSnapshot returns the specified storage snapshot.
+// The following error types can be expected:
+// - [coreerrors.NotValid]: when the supplied UUID is not valid.
+// - [storageerrors.SnapshotNotFound]: when the snapshot does not exist.
+func (s *StorageService) GetStorageSnapshot(ctx context.Context, uuid storage.SnapshotUUID) (storage.StorageSnapshot, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return storage.StorageSnapshot{}, errors.Errorf("snapshot uuid: %w", err).Add(coreerrors.NotValid)
+	}
+	return s.st.GetStorageSnapshot(ctx, uuid)
+}
+
+// ListStorageSnapshots returns the snapshots taken of the specified storage
+// instance, or of all storage instances in the model if storageID is empty.
+func (s *StorageService) ListStorageSnapshots(ctx context.Context, storageID string) ([]storage.StorageSnapshot, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.st.ListStorageSnapshots(ctx, storageID)
+}
+
+// RemoveStorageSnapshot removes the specified storage snapshot from the
+// storage provider, and then from the model. Snapshots of machine-scoped
+// volumes are only marked as dying, to be removed by the storage provisioner
+// on the machine.
+// The following error types can be expected:
+// - [coreerrors.NotValid]: when the supplied UUID is not valid.
+// - [storageerrors.SnapshotNotFound]: when the snapshot does not exist.
+func (s *StorageService) RemoveStorageSnapshot(ctx context.Context, uuid storage.SnapshotUUID) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return errors.Errorf("snapshot uuid: %w", err).Add(coreerrors.NotValid)
+	}
+	snapshot, err := s.st.GetStorageSnapshot(ctx, uuid)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := s.st.EnsureStorageSnapshotDying(ctx, uuid); err != nil {
+		return errors.Capture(err)
+	}
+	if snapshot.MachineID != "" {
+		return nil
+	}
+
+	// A snapshot without a provider ID was never taken,
+	// so there is nothing to remove from the provider.
+	if snapshot.ProviderID != "" {
+		if err := s.destroyProviderSnapshot(ctx, snapshot); err != nil {
+			if statusErr := s.st.SetStorageSnapshotStatus(
+				ctx, uuid, storage.SnapshotStatusError, err.Error(),
+			); statusErr != nil {
+				s.logger.Errorf(ctx, "setting status of snapshot %q: %v", uuid, statusErr)
+			}
+			return errors.Errorf("removing snapshot %q: %w", uuid, err)
+		}
+	}
+	return s.st.DeleteStorageSnapshot(ctx, uuid)
+}
+
+// GetMachineStorageSnapshots returns the snapshots of volumes scoped to the
+// specified machine, which are taken and removed by the machine's storage
+// provisioner.
+func (s *StorageService) GetMachineStorageSnapshots(ctx context.Context, machineID string) ([]storage.StorageSnapshot, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if machineID == "" {
+		return nil, errors.New("empty machine ID").Add(coreerrors.NotValid)
+	}
+	return s.st.GetMachineStorageSnapshots(ctx, machineID)
+}
+
+// SetMachineStorageSnapshotTaken records that the storage provisioner on
+// the snapshot's machine has taken the specified snapshot, which is now
+// available.
+// The following error types can be expected:
+// - [coreerrors.NotValid]: when the supplied UUID is not valid.
+// - [storageerrors.SnapshotNotFound]: when the snapshot does not exist.
+func (s *StorageService) SetMachineStorageSnapshotTaken(
+	ctx context.Context, uuid storage.SnapshotUUID, providerID string, size uint64,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return errors.Errorf("snapshot uuid: %w", err).Add(coreerrors.NotValid)
+	}
+	if providerID == "" {
+		return errors.New("empty snapshot provider ID").Add(coreerrors.NotValid)
+	}
+	return s.st.SetStorageSnapshotProvisioned(ctx, uuid, providerID, size)
+}
+
+// SetMachineStorageSnapshotFailed records that the storage provisioner on
+// the snapshot's machine failed to take the specified snapshot.
+// The following error types can be expected:
+// - [coreerrors.NotValid]: when the supplied UUID is not valid.
+// - [storageerrors.SnapshotNotFound]: when the snapshot does not exist.
+func (s *StorageService) SetMachineStorageSnapshotFailed(ctx context.Context, uuid storage.SnapshotUUID, message string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return errors.Errorf("snapshot uuid: %w", err).Add(coreerrors.NotValid)
+	}
+	return s.st.SetStorageSnapshotStatus(ctx, uuid, storage.SnapshotStatusError, message)
+}
+
+// DeleteMachineStorageSnapshot removes the record of the specified dying
+// snapshot, once the storage provisioner on its machine has removed it
+// from the storage provider.
+// The following error types can be expected:
+// - [coreerrors.NotValid]: when the supplied UUID is not valid, or the
+// snapshot is still alive.
+// - [storageerrors.SnapshotNotFound]: when the snapshot does not exist.
+func (s *StorageService) DeleteMachineStorageSnapshot(ctx context.Context, uuid storage.SnapshotUUID) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := uuid.Validate(); err != nil {
+		return errors.Errorf("snapshot uuid: %w", err).Add(coreerrors.NotValid)
+	}
+	snapshot, err := s.st.GetStorageSnapshot(ctx, uuid)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if snapshot.Life == life.Alive {
+		return errors.Errorf("snapshot %q is still alive", uuid).Add(coreerrors.NotValid)
+	}
+	return s.st.DeleteStorageSnapshot(ctx, uuid)
+}
+
+// WatchMachineStorageSnapshots returns a watcher that notifies when the
+// snapshots of volumes scoped to the specified machine are added or change.
+func (s *WatchableService) WatchMachineStorageSnapshots(ctx context.Context, machineID string) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if machineID == "" {
+		return nil, errors.New("empty machine ID").Add(coreerrors.NotValid)
+	}
+	mapper := func(ctx context.Context, changes []changestream.ChangeEvent) ([]string, error) {
+		snapshots, err := s.StorageService.st.GetMachineStorageSnapshots(ctx, machineID)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		machineSnapshots := set.NewStrings()
+		for _, snapshot := range snapshots {
+			machineSnapshots.Add(snapshot.UUID.String())
+		}
+		var result []string
+		for _, change := range changes {
+			if machineSnapshots.Contains(change.Changed()) {
+				result = append(result, change.Changed())
+			}
+		}
+		return result, nil
+	}
+	return s.watcherFactory.NewNotifyMapperWatcher(
+		mapper,
+		eventsource.NamespaceFilter(s.StorageService.st.NamespaceForWatchStorageSnapshots(), changestream.Changed),
+	)
+}
+
+func (s *StorageService) destroyProviderSnapshot(ctx context.Context, snapshot storage.StorageSnapshot) error {
+	provider, cfg, err := s.GetStoragePoolProvider(ctx, snapshot.Pool)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	snapshotter, err := volumeSnapshotter(provider, cfg)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	results, err := snapshotter.DestroyVolumeSnapshots(ctx, []string{snapshot.ProviderID})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if len(results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(results))
+	}
+	return errors.Capture(results[0])
+}
+
+// volumeSnapshotter returns the VolumeSnapshotter of the model-scoped
+// storage provider with the specified configuration.
+func volumeSnapshotter(provider internalstorage.Provider, cfg *internalstorage.Config) (internalstorage.VolumeSnapshotter, error) {
+	volumeSource, err := provider.VolumeSource(cfg)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	snapshotter, ok := volumeSource.(internalstorage.VolumeSnapshotter)
+	if !ok {
+		return nil, errors.Errorf(
+			"snapshotting volumes with storage provider %q not supported",
+			cfg.Provider(),
+		).Add(coreerrors.NotSupported)
+	}
+	return snapshotter, nil
+}
@@ -0,0 +1,343 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/life"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/storage"
+)
+
+type snapshotSuite struct {
+	storageSuite
+
+	volumeSnapshotter *MockVolumeSnapshotter
+}
+
+func TestSnapshotSuite(t *testing.T) {
+	tc.Run(t, &snapshotSuite{})
+}
+
+type snapshottingVolumeSource struct {
+	*MockVolumeSource
+	*MockVolumeSnapshotter
+}
+
+func (s *snapshotSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := s.storageSuite.setupMocks(c)
+	s.volumeSnapshotter = NewMockVolumeSnapshotter(ctrl)
+	return ctrl
+}
+
+func (s *snapshotSuite) expectSnapshotter(c *tc.C) {
+	s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "ebs").Return(domainstorage.StoragePool{}, storageerrors.PoolNotFoundError)
+	cfg, err := storage.NewConfig("ebs", "ebs", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	s.provider.EXPECT().VolumeSource(cfg).Return(snapshottingVolumeSource{
+		MockVolumeSource:      s.volumeSource,
+		MockVolumeSnapshotter: s.volumeSnapshotter,
+	}, nil)
+}
+
+func (s *snapshotSuite) snapshotParams() SnapshotStorageParams {
+	return SnapshotStorageParams{
+		StorageID:    "pgdata/0",
+		VolumeTag:    names.NewVolumeTag("0"),
+		VolumeID:     "vol-0",
+		Pool:         "ebs",
+		Size:         1024,
+		ResourceTags: map[string]string{"juju-model-uuid": "deadbeef"},
+	}
+}
+
+func (s *snapshotSuite) TestSnapshotStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSnapshotter(c)
+	s.provider.EXPECT().Scope().Return(storage.ScopeEnviron)
+	var uuid domainstorage.SnapshotUUID
+	s.state.EXPECT().CreateStorageSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, snapshot domainstorage.StorageSnapshot) error {
+			c.Check(snapshot.UUID.Validate(), tc.ErrorIsNil)
+			c.Check(snapshot.StorageID, tc.Equals, "pgdata/0")
+			c.Check(snapshot.VolumeID, tc.Equals, "vol-0")
+			c.Check(snapshot.Pool, tc.Equals, "ebs")
+			c.Check(snapshot.Size, tc.Equals, uint64(1024))
+			c.Check(snapshot.VolumeTag, tc.Equals, "volume-0")
+			c.Check(snapshot.MachineID, tc.Equals, "")
+			c.Check(snapshot.CreatedAt, tc.Equals, s.clock.Now())
+			uuid = snapshot.UUID
+			return nil
+		})
+	s.volumeSnapshotter.EXPECT().CreateVolumeSnapshots(gomock.Any(), []storage.VolumeSnapshotParams{{
+		Tag:          names.NewVolumeTag("0"),
+		VolumeId:     "vol-0",
+		ResourceTags: map[string]string{"juju-model-uuid": "deadbeef"},
+	}}).Return([]storage.CreateVolumeSnapshotsResult{{
+		Snapshot: &storage.VolumeSnapshot{SnapshotId: "snap-0", Size: 1024},
+	}}, nil)
+	s.state.EXPECT().SetStorageSnapshotProvisioned(gomock.Any(), gomock.Any(), "snap-0", uint64(1024)).Return(nil)
+	s.state.EXPECT().GetStorageSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, got domainstorage.SnapshotUUID) (domainstorage.StorageSnapshot, error) {
+			c.Check(got, tc.Equals, uuid)
+			return domainstorage.StorageSnapshot{
+				UUID:       got,
+				StorageID:  "pgdata/0",
+				ProviderID: "snap-0",
+				Status:     domainstorage.SnapshotStatusAvailable,
+			}, nil
+		})
+
+	result, err := s.service(c).SnapshotStorage(c.Context(), s.snapshotParams())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.UUID, tc.Equals, uuid)
+	c.Check(result.ProviderID, tc.Equals, "snap-0")
+	c.Check(result.Status, tc.Equals, domainstorage.SnapshotStatusAvailable)
+}
+
+func (s *snapshotSuite) TestSnapshotStorageProviderError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSnapshotter(c)
+	s.provider.EXPECT().Scope().Return(storage.ScopeEnviron)
+	s.state.EXPECT().CreateStorageSnapshot(gomock.Any(), gomock.Any()).Return(nil)
+	s.volumeSnapshotter.EXPECT().CreateVolumeSnapshots(gomock.Any(), gomock.Any()).Return([]storage.CreateVolumeSnapshotsResult{{
+		Error: errors.New("boom"),
+	}}, nil)
+	s.state.EXPECT().SetStorageSnapshotStatus(gomock.Any(), gomock.Any(), domainstorage.SnapshotStatusError, "boom").Return(nil)
+
+	_, err := s.service(c).SnapshotStorage(c.Context(), s.snapshotParams())
+	c.Assert(err, tc.ErrorMatches, `snapshotting storage "pgdata/0": boom`)
+}
+
+func (s *snapshotSuite) TestSnapshotStorageNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "ebs").Return(domainstorage.StoragePool{}, storageerrors.PoolNotFoundError)
+	s.provider.EXPECT().Scope().Return(storage.ScopeEnviron)
+	cfg, err := storage.NewConfig("ebs", "ebs", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	s.provider.EXPECT().VolumeSource(cfg).Return(s.volumeSource, nil)
+
+	_, err = s.service(c).SnapshotStorage(c.Context(), s.snapshotParams())
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+	c.Assert(err, tc.ErrorMatches, `snapshotting volumes with storage provider "ebs" not supported`)
+}
+
+func (s *snapshotSuite) TestSnapshotStorageMachineScoped(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The snapshot is left pending for the machine's storage
+	// provisioner to take, rather than taken by the controller.
+	s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "ebs").Return(domainstorage.StoragePool{}, storageerrors.PoolNotFoundError)
+	s.provider.EXPECT().Scope().Return(storage.ScopeMachine)
+	var uuid domainstorage.SnapshotUUID
+	s.state.EXPECT().CreateStorageSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, snapshot domainstorage.StorageSnapshot) error {
+			c.Check(snapshot.VolumeTag, tc.Equals, "volume-2-1")
+			c.Check(snapshot.MachineID, tc.Equals, "2")
+			c.Check(snapshot.CreatedAt, tc.Equals, s.clock.Now())
+			uuid = snapshot.UUID
+			return nil
+		})
+	s.state.EXPECT().GetStorageSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, got domainstorage.SnapshotUUID) (domainstorage.StorageSnapshot, error) {
+			c.Check(got, tc.Equals, uuid)
+			return domainstorage.StorageSnapshot{
+				UUID:      uuid,
+				MachineID: "2",
+				Status:    domainstorage.SnapshotStatusPending,
+			}, nil
+		})
+
+	params := s.snapshotParams()
+	params.VolumeTag = names.NewVolumeTag("2/1")
+	result, err := s.service(c).SnapshotStorage(c.Context(), params)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Status, tc.Equals, domainstorage.SnapshotStatusPending)
+}
+
+func (s *snapshotSuite) TestSnapshotStorageMachineScopedNoMachine(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "ebs").Return(domainstorage.StoragePool{}, storageerrors.PoolNotFoundError)
+	s.provider.EXPECT().Scope().Return(storage.ScopeMachine)
+
+	_, err := s.service(c).SnapshotStorage(c.Context(), s.snapshotParams())
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *snapshotSuite) TestSnapshotStorageNoVolume(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	params := s.snapshotParams()
+	params.VolumeID = ""
+
+	_, err := s.service(c).SnapshotStorage(c.Context(), params)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *snapshotSuite) TestGetStorageSnapshotInvalidUUID(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service(c).GetStorageSnapshot(c.Context(), "invalid")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *snapshotSuite) TestRemoveStorageSnapshot(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetStorageSnapshot(gomock.Any(), uuid).Return(domainstorage.StorageSnapshot{
+		UUID:       uuid,
+		Pool:       "ebs",
+		ProviderID: "snap-0",
+		Life:       life.Alive,
+	}, nil)
+	s.state.EXPECT().EnsureStorageSnapshotDying(gomock.Any(), uuid).Return(nil)
+	s.expectSnapshotter(c)
+	s.volumeSnapshotter.EXPECT().DestroyVolumeSnapshots(gomock.Any(), []string{"snap-0"}).Return([]error{nil}, nil)
+	s.state.EXPECT().DeleteStorageSnapshot(gomock.Any(), uuid).Return(nil)
+
+	err = s.service(c).RemoveStorageSnapshot(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *snapshotSuite) TestRemoveStorageSnapshotNotTaken(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetStorageSnapshot(gomock.Any(), uuid).Return(domainstorage.StorageSnapshot{
+		UUID: uuid,
+		Pool: "ebs",
+	}, nil)
+	s.state.EXPECT().EnsureStorageSnapshotDying(gomock.Any(), uuid).Return(nil)
+	s.state.EXPECT().DeleteStorageSnapshot(gomock.Any(), uuid).Return(nil)
+
+	err = s.service(c).RemoveStorageSnapshot(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *snapshotSuite) TestRemoveStorageSnapshotProviderError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetStorageSnapshot(gomock.Any(), uuid).Return(domainstorage.StorageSnapshot{
+		UUID:       uuid,
+		Pool:       "ebs",
+		ProviderID: "snap-0",
+	}, nil)
+	s.state.EXPECT().EnsureStorageSnapshotDying(gomock.Any(), uuid).Return(nil)
+	s.expectSnapshotter(c)
+	s.volumeSnapshotter.EXPECT().DestroyVolumeSnapshots(gomock.Any(), []string{"snap-0"}).Return([]error{errors.New("boom")}, nil)
+	s.state.EXPECT().SetStorageSnapshotStatus(gomock.Any(), uuid, domainstorage.SnapshotStatusError, "boom").Return(nil)
+
+	err = s.service(c).RemoveStorageSnapshot(c.Context(), uuid)
+	c.Assert(err, tc.ErrorMatches, `removing snapshot ".*": boom`)
+}
+
+func (s *snapshotSuite) TestRemoveStorageSnapshotNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetStorageSnapshot(gomock.Any(), uuid).Return(domainstorage.StorageSnapshot{}, storageerrors.SnapshotNotFound)
+
+	err = s.service(c).RemoveStorageSnapshot(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIs, storageerrors.SnapshotNotFound)
+}
+
+func (s *snapshotSuite) TestRemoveStorageSnapshotMachineScoped(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The snapshot is only marked as dying; the machine's storage
+	// provisioner removes it from the provider and then its record.
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetStorageSnapshot(gomock.Any(), uuid).Return(domainstorage.StorageSnapshot{
+		UUID:       uuid,
+		Pool:       "loop",
+		ProviderID: "snap-0",
+		MachineID:  "2",
+		Life:       life.Alive,
+	}, nil)
+	s.state.EXPECT().EnsureStorageSnapshotDying(gomock.Any(), uuid).Return(nil)
+
+	err = s.service(c).RemoveStorageSnapshot(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *snapshotSuite) TestSetMachineStorageSnapshotTaken(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().SetStorageSnapshotProvisioned(gomock.Any(), uuid, "snap-0", uint64(1024)).Return(nil)
+
+	err = s.service(c).SetMachineStorageSnapshotTaken(c.Context(), uuid, "snap-0", 1024)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *snapshotSuite) TestSetMachineStorageSnapshotTakenNoProviderID(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.service(c).SetMachineStorageSnapshotTaken(c.Context(), uuid, "", 1024)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *snapshotSuite) TestSetMachineStorageSnapshotFailed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().SetStorageSnapshotStatus(gomock.Any(), uuid, domainstorage.SnapshotStatusError, "boom").Return(nil)
+
+	err = s.service(c).SetMachineStorageSnapshotFailed(c.Context(), uuid, "boom")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *snapshotSuite) TestDeleteMachineStorageSnapshot(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetStorageSnapshot(gomock.Any(), uuid).Return(domainstorage.StorageSnapshot{
+		UUID: uuid,
+		Life: life.Dying,
+	}, nil)
+	s.state.EXPECT().DeleteStorageSnapshot(gomock.Any(), uuid).Return(nil)
+
+	err = s.service(c).DeleteMachineStorageSnapshot(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *snapshotSuite) TestDeleteMachineStorageSnapshotAlive(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetStorageSnapshot(gomock.Any(), uuid).Return(domainstorage.StorageSnapshot{
+		UUID: uuid,
+		Life: life.Alive,
+	}, nil)
+
+	err = s.service(c).DeleteMachineStorageSnapshot(c.Context(), uuid)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
@@ -79,6 +79,44 @@ func (c *MockStateCreateStoragePoolCall) DoAndReturn(f func(context.Context, sto
 	return c
 }
 
+// CreateStorageSnapshot mocks base method.
+func (m *MockState) CreateStorageSnapshot(arg0 context.Context, arg1 storage0.StorageSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStorageSnapshot indicates an expected call of CreateStorageSnapshot.
+func (mr *MockStateMockRecorder) CreateStorageSnapshot(arg0, arg1 any) *MockStateCreateStorageSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageSnapshot", reflect.TypeOf((*MockState)(nil).CreateStorageSnapshot), arg0, arg1)
+	return &MockStateCreateStorageSnapshotCall{Call: call}
+}
+
+// MockStateCreateStorageSnapshotCall wrap *gomock.Call
+type MockStateCreateStorageSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateCreateStorageSnapshotCall) Return(arg0 error) *MockStateCreateStorageSnapshotCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateCreateStorageSnapshotCall) Do(f func(context.Context, storage0.StorageSnapshot) error) *MockStateCreateStorageSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateCreateStorageSnapshotCall) DoAndReturn(f func(context.Context, storage0.StorageSnapshot) error) *MockStateCreateStorageSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteStoragePool mocks base method.
 func (m *MockState) DeleteStoragePool(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -117,6 +155,121 @@ func (c *MockStateDeleteStoragePoolCall) DoAndReturn(f func(context.Context, str
 	return c
 }
 
+// DeleteStorageSnapshot mocks base method.
+func (m *MockState) DeleteStorageSnapshot(arg0 context.Context, arg1 storage0.SnapshotUUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStorageSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStorageSnapshot indicates an expected call of DeleteStorageSnapshot.
+func (mr *MockStateMockRecorder) DeleteStorageSnapshot(arg0, arg1 any) *MockStateDeleteStorageSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageSnapshot", reflect.TypeOf((*MockState)(nil).DeleteStorageSnapshot), arg0, arg1)
+	return &MockStateDeleteStorageSnapshotCall{Call: call}
+}
+
+// MockStateDeleteStorageSnapshotCall wrap *gomock.Call
+type MockStateDeleteStorageSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeleteStorageSnapshotCall) Return(arg0 error) *MockStateDeleteStorageSnapshotCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeleteStorageSnapshotCall) Do(f func(context.Context, storage0.SnapshotUUID) error) *MockStateDeleteStorageSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeleteStorageSnapshotCall) DoAndReturn(f func(context.Context, storage0.SnapshotUUID) error) *MockStateDeleteStorageSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EnsureStorageSnapshotDying mocks base method.
+func (m *MockState) EnsureStorageSnapshotDying(arg0 context.Context, arg1 storage0.SnapshotUUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureStorageSnapshotDying", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureStorageSnapshotDying indicates an expected call of EnsureStorageSnapshotDying.
+func (mr *MockStateMockRecorder) EnsureStorageSnapshotDying(arg0, arg1 any) *MockStateEnsureStorageSnapshotDyingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureStorageSnapshotDying", reflect.TypeOf((*MockState)(nil).EnsureStorageSnapshotDying), arg0, arg1)
+	return &MockStateEnsureStorageSnapshotDyingCall{Call: call}
+}
+
+// MockStateEnsureStorageSnapshotDyingCall wrap *gomock.Call
+type MockStateEnsureStorageSnapshotDyingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateEnsureStorageSnapshotDyingCall) Return(arg0 error) *MockStateEnsureStorageSnapshotDyingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateEnsureStorageSnapshotDyingCall) Do(f func(context.Context, storage0.SnapshotUUID) error) *MockStateEnsureStorageSnapshotDyingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateEnsureStorageSnapshotDyingCall) DoAndReturn(f func(context.Context, storage0.SnapshotUUID) error) *MockStateEnsureStorageSnapshotDyingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMachineStorageSnapshots mocks base method.
+func (m *MockState) GetMachineStorageSnapshots(arg0 context.Context, arg1 string) ([]storage0.StorageSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineStorageSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]storage0.StorageSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineStorageSnapshots indicates an expected call of GetMachineStorageSnapshots.
+func (mr *MockStateMockRecorder) GetMachineStorageSnapshots(arg0, arg1 any) *MockStateGetMachineStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineStorageSnapshots", reflect.TypeOf((*MockState)(nil).GetMachineStorageSnapshots), arg0, arg1)
+	return &MockStateGetMachineStorageSnapshotsCall{Call: call}
+}
+
+// MockStateGetMachineStorageSnapshotsCall wrap *gomock.Call
+type MockStateGetMachineStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetMachineStorageSnapshotsCall) Return(arg0 []storage0.StorageSnapshot, arg1 error) *MockStateGetMachineStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetMachineStorageSnapshotsCall) Do(f func(context.Context, string) ([]storage0.StorageSnapshot, error)) *MockStateGetMachineStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetMachineStorageSnapshotsCall) DoAndReturn(f func(context.Context, string) ([]storage0.StorageSnapshot, error)) *MockStateGetMachineStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetModelDetails mocks base method.
 func (m *MockState) GetModelDetails() (storage0.ModelDetails, error) {
 	m.ctrl.T.Helper()
@@ -195,6 +348,45 @@ func (c *MockStateGetStoragePoolByNameCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetStorageSnapshot mocks base method.
+func (m *MockState) GetStorageSnapshot(arg0 context.Context, arg1 storage0.SnapshotUUID) (storage0.StorageSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(storage0.StorageSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSnapshot indicates an expected call of GetStorageSnapshot.
+func (mr *MockStateMockRecorder) GetStorageSnapshot(arg0, arg1 any) *MockStateGetStorageSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSnapshot", reflect.TypeOf((*MockState)(nil).GetStorageSnapshot), arg0, arg1)
+	return &MockStateGetStorageSnapshotCall{Call: call}
+}
+
+// MockStateGetStorageSnapshotCall wrap *gomock.Call
+type MockStateGetStorageSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStorageSnapshotCall) Return(arg0 storage0.StorageSnapshot, arg1 error) *MockStateGetStorageSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStorageSnapshotCall) Do(f func(context.Context, storage0.SnapshotUUID) (storage0.StorageSnapshot, error)) *MockStateGetStorageSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStorageSnapshotCall) DoAndReturn(f func(context.Context, storage0.SnapshotUUID) (storage0.StorageSnapshot, error)) *MockStateGetStorageSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ImportFilesystem mocks base method.
 func (m *MockState) ImportFilesystem(arg0 context.Context, arg1 storage.Name, arg2 storage0.FilesystemInfo) (storage.ID, error) {
 	m.ctrl.T.Helper()
@@ -429,6 +621,83 @@ func (c *MockStateListStoragePoolsWithoutBuiltinsCall) DoAndReturn(f func(contex
 	return c
 }
 
+// ListStorageSnapshots mocks base method.
+func (m *MockState) ListStorageSnapshots(arg0 context.Context, arg1 string) ([]storage0.StorageSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]storage0.StorageSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageSnapshots indicates an expected call of ListStorageSnapshots.
+func (mr *MockStateMockRecorder) ListStorageSnapshots(arg0, arg1 any) *MockStateListStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageSnapshots", reflect.TypeOf((*MockState)(nil).ListStorageSnapshots), arg0, arg1)
+	return &MockStateListStorageSnapshotsCall{Call: call}
+}
+
+// MockStateListStorageSnapshotsCall wrap *gomock.Call
+type MockStateListStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListStorageSnapshotsCall) Return(arg0 []storage0.StorageSnapshot, arg1 error) *MockStateListStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListStorageSnapshotsCall) Do(f func(context.Context, string) ([]storage0.StorageSnapshot, error)) *MockStateListStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListStorageSnapshotsCall) DoAndReturn(f func(context.Context, string) ([]storage0.StorageSnapshot, error)) *MockStateListStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NamespaceForWatchStorageSnapshots mocks base method.
+func (m *MockState) NamespaceForWatchStorageSnapshots() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceForWatchStorageSnapshots")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NamespaceForWatchStorageSnapshots indicates an expected call of NamespaceForWatchStorageSnapshots.
+func (mr *MockStateMockRecorder) NamespaceForWatchStorageSnapshots() *MockStateNamespaceForWatchStorageSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceForWatchStorageSnapshots", reflect.TypeOf((*MockState)(nil).NamespaceForWatchStorageSnapshots))
+	return &MockStateNamespaceForWatchStorageSnapshotsCall{Call: call}
+}
+
+// MockStateNamespaceForWatchStorageSnapshotsCall wrap *gomock.Call
+type MockStateNamespaceForWatchStorageSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateNamespaceForWatchStorageSnapshotsCall) Return(arg0 string) *MockStateNamespaceForWatchStorageSnapshotsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateNamespaceForWatchStorageSnapshotsCall) Do(f func() string) *MockStateNamespaceForWatchStorageSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateNamespaceForWatchStorageSnapshotsCall) DoAndReturn(f func() string) *MockStateNamespaceForWatchStorageSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReplaceStoragePool mocks base method.
 func (m *MockState) ReplaceStoragePool(arg0 context.Context, arg1 storage0.StoragePool) error {
 	m.ctrl.T.Helper()
@@ -466,3 +735,79 @@ func (c *MockStateReplaceStoragePoolCall) DoAndReturn(f func(context.Context, st
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// SetStorageSnapshotProvisioned mocks base method.
+func (m *MockState) SetStorageSnapshotProvisioned(arg0 context.Context, arg1 storage0.SnapshotUUID, arg2 string, arg3 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageSnapshotProvisioned", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStorageSnapshotProvisioned indicates an expected call of SetStorageSnapshotProvisioned.
+func (mr *MockStateMockRecorder) SetStorageSnapshotProvisioned(arg0, arg1, arg2, arg3 any) *MockStateSetStorageSnapshotProvisionedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageSnapshotProvisioned", reflect.TypeOf((*MockState)(nil).SetStorageSnapshotProvisioned), arg0, arg1, arg2, arg3)
+	return &MockStateSetStorageSnapshotProvisionedCall{Call: call}
+}
+
+// MockStateSetStorageSnapshotProvisionedCall wrap *gomock.Call
+type MockStateSetStorageSnapshotProvisionedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetStorageSnapshotProvisionedCall) Return(arg0 error) *MockStateSetStorageSnapshotProvisionedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetStorageSnapshotProvisionedCall) Do(f func(context.Context, storage0.SnapshotUUID, string, uint64) error) *MockStateSetStorageSnapshotProvisionedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetStorageSnapshotProvisionedCall) DoAndReturn(f func(context.Context, storage0.SnapshotUUID, string, uint64) error) *MockStateSetStorageSnapshotProvisionedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetStorageSnapshotStatus mocks base method.
+func (m *MockState) SetStorageSnapshotStatus(arg0 context.Context, arg1 storage0.SnapshotUUID, arg2 storage0.SnapshotStatus, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageSnapshotStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStorageSnapshotStatus indicates an expected call of SetStorageSnapshotStatus.
+func (mr *MockStateMockRecorder) SetStorageSnapshotStatus(arg0, arg1, arg2, arg3 any) *MockStateSetStorageSnapshotStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageSnapshotStatus", reflect.TypeOf((*MockState)(nil).SetStorageSnapshotStatus), arg0, arg1, arg2, arg3)
+	return &MockStateSetStorageSnapshotStatusCall{Call: call}
+}
+
+// MockStateSetStorageSnapshotStatusCall wrap *gomock.Call
+type MockStateSetStorageSnapshotStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetStorageSnapshotStatusCall) Return(arg0 error) *MockStateSetStorageSnapshotStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetStorageSnapshotStatusCall) Do(f func(context.Context, storage0.SnapshotUUID, storage0.SnapshotStatus, string) error) *MockStateSetStorageSnapshotStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetStorageSnapshotStatusCall) DoAndReturn(f func(context.Context, storage0.SnapshotUUID, storage0.SnapshotStatus, string) error) *MockStateSetStorageSnapshotStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/collections/transform"
 
 	coreerrors "github.com/juju/juju/core/errors"
@@ -34,6 +35,7 @@ type StorageService struct {
 	st             State
 	logger         logger.Logger
 	registryGetter corestorage.ModelStorageRegistryGetter
+	clock          clock.Clock
 }
 
 // ImportFilesystem associates a filesystem (either native or volume backed) hosted by a cloud provider
@@ -176,3 +178,43 @@ func (s *StorageService) importVolumeFromProvider(
 	}
 	return filesystemInfo, nil
 }
+
+// GetStoragePoolProvider returns the storage provider of the named storage
+// pool, along with the configuration with which to create the provider's
+// volume or filesystem sources. If no pool exists with the name, the name is
+// treated as a storage provider type with an empty configuration.
+func (s *StorageService) GetStoragePoolProvider(
+	ctx context.Context, poolName string,
+) (internalstorage.Provider, *internalstorage.Config, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	poolDetails, err := s.st.GetStoragePoolByName(ctx, poolName)
+	if errors.Is(err, storageerrors.PoolNotFoundError) {
+		poolDetails = storage.StoragePool{
+			Name:     poolName,
+			Provider: poolName,
+		}
+	} else if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+
+	var attr map[string]any
+	if len(poolDetails.Attrs) > 0 {
+		attr = transform.Map(poolDetails.Attrs, func(k, v string) (string, any) { return k, v })
+	}
+	cfg, err := internalstorage.NewConfig(poolDetails.Name, internalstorage.ProviderType(poolDetails.Provider), attr)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+
+	registry, err := s.registryGetter.GetStorageRegistry(ctx)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	provider, err := registry.StorageProvider(cfg.Provider())
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	return provider, cfg, nil
+}
@@ -5,7 +5,9 @@ package service
 
 import (
 	"testing"
+	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
@@ -31,6 +33,7 @@ type storageSuite struct {
 	volumeImporter     *MockVolumeImporter
 	filesystemSource   *MockFilesystemSource
 	filesystemImporter *MockFilesystemImporter
+	clock              *testclock.Clock
 }
 
 func TestStorageSuite(t *testing.T) {
@@ -56,6 +59,7 @@ func (s *storageSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.filesystemSource = NewMockFilesystemSource(ctrl)
 	s.filesystemImporter = NewMockFilesystemImporter(ctrl)
 	s.provider = NewMockProvider(ctrl)
+	s.clock = testclock.NewClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
 
 	registry := NewMockProviderRegistry(ctrl)
 	registry.EXPECT().StorageProvider(storage.ProviderType("ebs")).Return(s.provider, nil).AnyTimes()
@@ -69,7 +73,7 @@ func (s *storageSuite) setupMocks(c *tc.C) *gomock.Controller {
 func (s *storageSuite) service(c *tc.C) *Service {
 	return NewService(s.state, loggertesting.WrapCheckLog(c), modelStorageRegistryGetter(func() storage.ProviderRegistry {
 		return s.registry
-	}))
+	}), s.clock)
 }
 
 func (s *storageSuite) TestImportFilesystemValidate(c *tc.C) {
@@ -287,3 +291,32 @@ func (s *storageSuite) TestImportFilesystemVolumeBackedNotSupported(c *tc.C) {
 	})
 	c.Assert(err, tc.ErrorIs, errors.NotSupported)
 }
+
+func (s *storageSuite) TestGetStoragePoolProvider(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "fast-elastic").Return(domainstorage.StoragePool{
+		Name:     "fast-elastic",
+		Provider: "elastic",
+		Attrs:    map[string]string{"iops": "1000"},
+	}, nil)
+
+	p, cfg, err := s.service(c).GetStoragePoolProvider(c.Context(), "fast-elastic")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(p, tc.Equals, storage.Provider(s.provider))
+	c.Check(cfg.Name(), tc.Equals, "fast-elastic")
+	c.Check(cfg.Provider(), tc.Equals, storage.ProviderType("elastic"))
+	c.Check(cfg.Attrs(), tc.DeepEquals, storage.Attrs{"iops": "1000"})
+}
+
+func (s *storageSuite) TestGetStoragePoolProviderUsingProviderType(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "ebs").Return(domainstorage.StoragePool{}, storageerrors.PoolNotFoundError)
+
+	p, cfg, err := s.service(c).GetStoragePoolProvider(c.Context(), "ebs")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(p, tc.Equals, storage.Provider(s.provider))
+	c.Check(cfg.Name(), tc.Equals, "ebs")
+	c.Check(cfg.Provider(), tc.Equals, storage.ProviderType("ebs"))
+}
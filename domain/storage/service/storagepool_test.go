@@ -6,6 +6,7 @@ package service
 import (
 	"testing"
 
+	"github.com/juju/clock"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
@@ -57,7 +58,7 @@ func (s *storagePoolServiceSuite) setupMocks(c *tc.C) *gomock.Controller {
 func (s *storagePoolServiceSuite) service(c *tc.C) *Service {
 	return NewService(s.state, loggertesting.WrapCheckLog(c), modelStorageRegistryGetter(func() storage.ProviderRegistry {
 		return s.registry
-	}))
+	}), clock.WallClock)
 }
 
 func (s *storagePoolServiceSuite) TestCreateStoragePool(c *tc.C) {
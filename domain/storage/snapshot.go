@@ -0,0 +1,81 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"time"
+
+	"github.com/juju/juju/domain/life"
+)
+
+// SnapshotStatus represents the status of a storage snapshot
+// as recorded in the storage_volume_snapshot_status_value
+// lookup table.
+type SnapshotStatus int
+
+const (
+	SnapshotStatusPending SnapshotStatus = iota
+	SnapshotStatusError
+	SnapshotStatusAvailable
+	SnapshotStatusDestroying
+)
+
+// String returns the status value as stored in the database.
+func (s SnapshotStatus) String() string {
+	switch s {
+	case SnapshotStatusPending:
+		return "pending"
+	case SnapshotStatusError:
+		return "error"
+	case SnapshotStatusAvailable:
+		return "available"
+	case SnapshotStatusDestroying:
+		return "destroying"
+	}
+	return ""
+}
+
+// StorageSnapshot describes a point-in-time copy of the volume
+// backing a storage instance.
+type StorageSnapshot struct {
+	// UUID uniquely identifies the snapshot within the model.
+	UUID SnapshotUUID
+
+	// StorageID is the ID of the storage instance the snapshot
+	// was taken from.
+	StorageID string
+
+	// VolumeID is the ID of the volume the snapshot was taken from.
+	VolumeID string
+
+	// VolumeTag is the tag of the volume the snapshot was taken from.
+	VolumeTag string
+
+	// MachineID is the ID of the machine whose storage provisioner takes,
+	// stores and removes the snapshot, if the volume is machine-scoped.
+	// It is empty for snapshots taken by the controller.
+	MachineID string
+
+	// Pool is the name of the storage pool of the snapshotted volume.
+	Pool string
+
+	// ProviderID is the storage provider's unique ID for the snapshot.
+	// It is empty until the snapshot has been taken.
+	ProviderID string
+
+	// Size is the size of the snapshotted volume, in MiB.
+	Size uint64
+
+	// Life is the life of the snapshot.
+	Life life.Life
+
+	// Status is the status of the snapshot.
+	Status SnapshotStatus
+
+	// Message is the message associated with the status, if any.
+	Message string
+
+	// CreatedAt is when the snapshot was requested.
+	CreatedAt time.Time
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type snapshotSuite struct {
+	schematesting.ModelSuite
+}
+
+func TestSnapshotSuite(t *testing.T) {
+	tc.Run(t, &snapshotSuite{})
+}
+
+// TestSnapshotStatusDBValues ensures there's no skew between what's in the
+// database table for snapshot status and the typed consts used in the
+// storage package.
+func (s *snapshotSuite) TestSnapshotStatusDBValues(c *tc.C) {
+	db := s.DB()
+	rows, err := db.Query("SELECT id, status FROM storage_volume_snapshot_status_value")
+	c.Assert(err, tc.ErrorIsNil)
+	defer rows.Close()
+
+	dbValues := make(map[SnapshotStatus]string)
+	for rows.Next() {
+		var (
+			id    int
+			value string
+		)
+		err := rows.Scan(&id, &value)
+		c.Assert(err, tc.ErrorIsNil)
+		dbValues[SnapshotStatus(id)] = value
+	}
+	c.Assert(dbValues, tc.DeepEquals, map[SnapshotStatus]string{
+		SnapshotStatusPending:    "pending",
+		SnapshotStatusError:      "error",
+		SnapshotStatusAvailable:  "available",
+		SnapshotStatusDestroying: "destroying",
+	})
+	for id, value := range dbValues {
+		c.Assert(id.String(), tc.Equals, value)
+	}
+}
@@ -0,0 +1,371 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/domain/life"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// CreateStorageSnapshot records a new storage snapshot, which is alive and
+// pending until the storage provider has taken it.
+func (st State) CreateStorageSnapshot(ctx context.Context, snapshot domainstorage.StorageSnapshot) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := storageSnapshot{
+		UUID:        snapshot.UUID.String(),
+		StorageID:   snapshot.StorageID,
+		VolumeID:    snapshot.VolumeID,
+		VolumeTag:   snapshot.VolumeTag,
+		MachineID:   sql.NullString{String: snapshot.MachineID, Valid: snapshot.MachineID != ""},
+		StoragePool: snapshot.Pool,
+		LifeID:      int(life.Alive),
+		SizeMiB:     snapshot.Size,
+		CreatedAt:   snapshot.CreatedAt.UTC(),
+	}
+	status := storageSnapshotStatus{
+		SnapshotUUID: row.UUID,
+		StatusID:     int(domainstorage.SnapshotStatusPending),
+		UpdatedAt:    row.CreatedAt,
+	}
+
+	insertSnapshotStmt, err := st.Prepare(`
+INSERT INTO storage_volume_snapshot (*) VALUES ($storageSnapshot.*)`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertStatusStmt, err := st.Prepare(`
+INSERT INTO storage_volume_snapshot_status (*) VALUES ($storageSnapshotStatus.*)`, status)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, insertSnapshotStmt, row).Run(); err != nil {
+			return errors.Capture(err)
+		}
+		return errors.Capture(tx.Query(ctx, insertStatusStmt, status).Run())
+	})
+	if err != nil {
+		return errors.Errorf("creating snapshot of storage %q: %w", snapshot.StorageID, err)
+	}
+	return nil
+}
+
+// SetStorageSnapshotProvisioned records the provider ID and size of a
+// snapshot that has been taken by the storage provider, and marks it as
+// available.
+// The following errors can be expected:
+// - [storageerrors.SnapshotNotFound] if the snapshot does not exist.
+func (st State) SetStorageSnapshotProvisioned(
+	ctx context.Context, uuid domainstorage.SnapshotUUID, providerID string, size uint64,
+) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := storageSnapshot{
+		UUID:       uuid.String(),
+		ProviderID: sql.NullString{String: providerID, Valid: true},
+		SizeMiB:    size,
+	}
+	updateStmt, err := st.Prepare(`
+UPDATE storage_volume_snapshot
+SET    provider_id = $storageSnapshot.provider_id,
+       size_mib = $storageSnapshot.size_mib
+WHERE  uuid = $storageSnapshot.uuid`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, updateStmt, row).Get(&outcome); err != nil {
+			return errors.Capture(err)
+		}
+		if err := checkSnapshotAffected(outcome, uuid); err != nil {
+			return errors.Capture(err)
+		}
+		return st.setStorageSnapshotStatus(ctx, tx, uuid, domainstorage.SnapshotStatusAvailable, "")
+	})
+}
+
+// SetStorageSnapshotStatus sets the status of the specified snapshot.
+// The following errors can be expected:
+// - [storageerrors.SnapshotNotFound] if the snapshot does not exist.
+func (st State) SetStorageSnapshotStatus(
+	ctx context.Context, uuid domainstorage.SnapshotUUID, status domainstorage.SnapshotStatus, message string,
+) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return st.setStorageSnapshotStatus(ctx, tx, uuid, status, message)
+	})
+}
+
+// EnsureStorageSnapshotDying marks the specified snapshot as dying, and
+// its status as destroying.
+// The following errors can be expected:
+// - [storageerrors.SnapshotNotFound] if the snapshot does not exist.
+func (st State) EnsureStorageSnapshotDying(ctx context.Context, uuid domainstorage.SnapshotUUID) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := storageSnapshot{
+		UUID:   uuid.String(),
+		LifeID: int(life.Dying),
+	}
+	updateStmt, err := st.Prepare(`
+UPDATE storage_volume_snapshot
+SET    life_id = $storageSnapshot.life_id
+WHERE  uuid = $storageSnapshot.uuid
+AND    life_id = 0`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if _, err := st.getStorageSnapshot(ctx, tx, uuid); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, updateStmt, row).Run(); err != nil {
+			return errors.Capture(err)
+		}
+		return st.setStorageSnapshotStatus(ctx, tx, uuid, domainstorage.SnapshotStatusDestroying, "")
+	})
+}
+
+// DeleteStorageSnapshot removes the record of the specified snapshot.
+// The following errors can be expected:
+// - [storageerrors.SnapshotNotFound] if the snapshot does not exist.
+func (st State) DeleteStorageSnapshot(ctx context.Context, uuid domainstorage.SnapshotUUID) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	id := storageSnapshotUUID{UUID: uuid.String()}
+	deleteStatusStmt, err := st.Prepare(`
+DELETE FROM storage_volume_snapshot_status
+WHERE  snapshot_uuid = $storageSnapshotUUID.uuid`, id)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	deleteSnapshotStmt, err := st.Prepare(`
+DELETE FROM storage_volume_snapshot
+WHERE  uuid = $storageSnapshotUUID.uuid`, id)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, deleteStatusStmt, id).Run(); err != nil {
+			return errors.Capture(err)
+		}
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, deleteSnapshotStmt, id).Get(&outcome); err != nil {
+			return errors.Capture(err)
+		}
+		return checkSnapshotAffected(outcome, uuid)
+	})
+}
+
+// GetStorageSnapshot returns the specified snapshot.
+// The following errors can be expected:
+// - [storageerrors.SnapshotNotFound] if the snapshot does not exist.
+func (st State) GetStorageSnapshot(
+	ctx context.Context, uuid domainstorage.SnapshotUUID,
+) (domainstorage.StorageSnapshot, error) {
+	db, err := st.DB()
+	if err != nil {
+		return domainstorage.StorageSnapshot{}, errors.Capture(err)
+	}
+
+	var snapshot domainstorage.StorageSnapshot
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		snapshot, err = st.getStorageSnapshot(ctx, tx, uuid)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return domainstorage.StorageSnapshot{}, errors.Capture(err)
+	}
+	return snapshot, nil
+}
+
+// ListStorageSnapshots returns the snapshots taken of the specified storage
+// instance, or of all storage instances if storageID is empty, ordered by
+// creation time.
+func (st State) ListStorageSnapshots(ctx context.Context, storageID string) ([]domainstorage.StorageSnapshot, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	query := `
+SELECT &storageSnapshotDetails.*
+FROM (
+    SELECT s.*, ss.status_id, ss.message
+    FROM   storage_volume_snapshot AS s
+    JOIN   storage_volume_snapshot_status AS ss ON ss.snapshot_uuid = s.uuid
+)`
+	var args []any
+	if storageID != "" {
+		query += `
+WHERE storage_id = $storageSnapshotStorageID.storage_id`
+		args = append(args, storageSnapshotStorageID{StorageID: storageID})
+	}
+	query += `
+ORDER BY created_at, uuid`
+	stmt, err := st.Prepare(query, append(args, storageSnapshotDetails{})...)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []storageSnapshotDetails
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, args...).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing storage snapshots: %w", err)
+	}
+
+	result := make([]domainstorage.StorageSnapshot, len(rows))
+	for i, row := range rows {
+		result[i] = row.toStorageSnapshot()
+	}
+	return result, nil
+}
+
+// GetMachineStorageSnapshots returns the snapshots of volumes scoped to the
+// specified machine, which are taken and removed by the machine's storage
+// provisioner, ordered by creation time.
+func (st State) GetMachineStorageSnapshots(ctx context.Context, machineID string) ([]domainstorage.StorageSnapshot, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	id := storageSnapshotMachineID{MachineID: machineID}
+	stmt, err := st.Prepare(`
+SELECT &storageSnapshotDetails.*
+FROM (
+    SELECT s.*, ss.status_id, ss.message
+    FROM   storage_volume_snapshot AS s
+    JOIN   storage_volume_snapshot_status AS ss ON ss.snapshot_uuid = s.uuid
+)
+WHERE machine_id = $storageSnapshotMachineID.machine_id
+ORDER BY created_at, uuid`, id, storageSnapshotDetails{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []storageSnapshotDetails
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, id).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing storage snapshots on machine %q: %w", machineID, err)
+	}
+
+	result := make([]domainstorage.StorageSnapshot, len(rows))
+	for i, row := range rows {
+		result[i] = row.toStorageSnapshot()
+	}
+	return result, nil
+}
+
+// NamespaceForWatchStorageSnapshots returns the namespace of the storage
+// snapshots table, for watching changes to snapshots.
+func (st State) NamespaceForWatchStorageSnapshots() string {
+	return "storage_volume_snapshot"
+}
+
+func (st State) getStorageSnapshot(
+	ctx context.Context, tx *sqlair.TX, uuid domainstorage.SnapshotUUID,
+) (domainstorage.StorageSnapshot, error) {
+	id := storageSnapshotUUID{UUID: uuid.String()}
+	stmt, err := st.Prepare(`
+SELECT &storageSnapshotDetails.*
+FROM (
+    SELECT s.*, ss.status_id, ss.message
+    FROM   storage_volume_snapshot AS s
+    JOIN   storage_volume_snapshot_status AS ss ON ss.snapshot_uuid = s.uuid
+)
+WHERE uuid = $storageSnapshotUUID.uuid`, id, storageSnapshotDetails{})
+	if err != nil {
+		return domainstorage.StorageSnapshot{}, errors.Capture(err)
+	}
+
+	var row storageSnapshotDetails
+	err = tx.Query(ctx, stmt, id).Get(&row)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return domainstorage.StorageSnapshot{}, errors.Errorf("snapshot %q %w", uuid, storageerrors.SnapshotNotFound)
+	} else if err != nil {
+		return domainstorage.StorageSnapshot{}, errors.Capture(err)
+	}
+	return row.toStorageSnapshot(), nil
+}
+
+func (st State) setStorageSnapshotStatus(
+	ctx context.Context, tx *sqlair.TX,
+	uuid domainstorage.SnapshotUUID, status domainstorage.SnapshotStatus, message string,
+) error {
+	row := storageSnapshotStatus{
+		SnapshotUUID: uuid.String(),
+		StatusID:     int(status),
+		Message:      sql.NullString{String: message, Valid: message != ""},
+		UpdatedAt:    time.Now().UTC(),
+	}
+	stmt, err := st.Prepare(`
+UPDATE storage_volume_snapshot_status
+SET    status_id = $storageSnapshotStatus.status_id,
+       message = $storageSnapshotStatus.message,
+       updated_at = $storageSnapshotStatus.updated_at
+WHERE  snapshot_uuid = $storageSnapshotStatus.snapshot_uuid`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var outcome sqlair.Outcome
+	if err := tx.Query(ctx, stmt, row).Get(&outcome); err != nil {
+		return errors.Capture(err)
+	}
+	return checkSnapshotAffected(outcome, uuid)
+}
+
+func checkSnapshotAffected(outcome sqlair.Outcome, uuid domainstorage.SnapshotUUID) error {
+	affected, err := outcome.Result().RowsAffected()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if affected == 0 {
+		return errors.Errorf("snapshot %q %w", uuid, storageerrors.SnapshotNotFound)
+	}
+	return nil
+}
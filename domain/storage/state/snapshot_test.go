@@ -0,0 +1,192 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/domain/life"
+	"github.com/juju/juju/domain/schema/testing"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+)
+
+type snapshotStateSuite struct {
+	testing.ModelSuite
+}
+
+func TestSnapshotStateSuite(t *stdtesting.T) {
+	tc.Run(t, &snapshotStateSuite{})
+}
+
+func (s *snapshotStateSuite) newSnapshot(c *tc.C, storageID string, createdAt time.Time) domainstorage.StorageSnapshot {
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	return domainstorage.StorageSnapshot{
+		UUID:      uuid,
+		StorageID: storageID,
+		VolumeID:  "0",
+		VolumeTag: "volume-0",
+		Pool:      "ebs",
+		Size:      1024,
+		CreatedAt: createdAt,
+	}
+}
+
+func (s *snapshotStateSuite) TestCreateStorageSnapshot(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	now := time.Now().UTC().Truncate(time.Second)
+	snapshot := s.newSnapshot(c, "pgdata/0", now)
+	err := st.CreateStorageSnapshot(c.Context(), snapshot)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := st.GetStorageSnapshot(c.Context(), snapshot.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+	snapshot.Life = life.Alive
+	snapshot.Status = domainstorage.SnapshotStatusPending
+	c.Assert(result, tc.DeepEquals, snapshot)
+}
+
+func (s *snapshotStateSuite) TestSetStorageSnapshotProvisioned(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	snapshot := s.newSnapshot(c, "pgdata/0", time.Now().UTC().Truncate(time.Second))
+	err := st.CreateStorageSnapshot(c.Context(), snapshot)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.SetStorageSnapshotProvisioned(c.Context(), snapshot.UUID, "snap-0", 2048)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := st.GetStorageSnapshot(c.Context(), snapshot.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.ProviderID, tc.Equals, "snap-0")
+	c.Check(result.Size, tc.Equals, uint64(2048))
+	c.Check(result.Status, tc.Equals, domainstorage.SnapshotStatusAvailable)
+}
+
+func (s *snapshotStateSuite) TestSetStorageSnapshotStatus(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	snapshot := s.newSnapshot(c, "pgdata/0", time.Now().UTC().Truncate(time.Second))
+	err := st.CreateStorageSnapshot(c.Context(), snapshot)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.SetStorageSnapshotStatus(c.Context(), snapshot.UUID, domainstorage.SnapshotStatusError, "boom")
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := st.GetStorageSnapshot(c.Context(), snapshot.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Status, tc.Equals, domainstorage.SnapshotStatusError)
+	c.Check(result.Message, tc.Equals, "boom")
+}
+
+func (s *snapshotStateSuite) TestEnsureStorageSnapshotDying(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	snapshot := s.newSnapshot(c, "pgdata/0", time.Now().UTC().Truncate(time.Second))
+	err := st.CreateStorageSnapshot(c.Context(), snapshot)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.EnsureStorageSnapshotDying(c.Context(), snapshot.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+	// Idempotent.
+	err = st.EnsureStorageSnapshotDying(c.Context(), snapshot.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := st.GetStorageSnapshot(c.Context(), snapshot.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Life, tc.Equals, life.Dying)
+	c.Check(result.Status, tc.Equals, domainstorage.SnapshotStatusDestroying)
+}
+
+func (s *snapshotStateSuite) TestDeleteStorageSnapshot(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	snapshot := s.newSnapshot(c, "pgdata/0", time.Now().UTC().Truncate(time.Second))
+	err := st.CreateStorageSnapshot(c.Context(), snapshot)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.DeleteStorageSnapshot(c.Context(), snapshot.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = st.GetStorageSnapshot(c.Context(), snapshot.UUID)
+	c.Assert(err, tc.ErrorIs, storageerrors.SnapshotNotFound)
+	err = st.DeleteStorageSnapshot(c.Context(), snapshot.UUID)
+	c.Assert(err, tc.ErrorIs, storageerrors.SnapshotNotFound)
+}
+
+func (s *snapshotStateSuite) TestSnapshotNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	uuid, err := domainstorage.NewSnapshotUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = st.GetStorageSnapshot(c.Context(), uuid)
+	c.Check(err, tc.ErrorIs, storageerrors.SnapshotNotFound)
+	err = st.SetStorageSnapshotProvisioned(c.Context(), uuid, "snap-0", 1024)
+	c.Check(err, tc.ErrorIs, storageerrors.SnapshotNotFound)
+	err = st.SetStorageSnapshotStatus(c.Context(), uuid, domainstorage.SnapshotStatusError, "")
+	c.Check(err, tc.ErrorIs, storageerrors.SnapshotNotFound)
+	err = st.EnsureStorageSnapshotDying(c.Context(), uuid)
+	c.Check(err, tc.ErrorIs, storageerrors.SnapshotNotFound)
+}
+
+func (s *snapshotStateSuite) TestListStorageSnapshots(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	now := time.Now().UTC().Truncate(time.Second)
+	snapshots := []domainstorage.StorageSnapshot{
+		s.newSnapshot(c, "pgdata/0", now.Add(-2*time.Hour)),
+		s.newSnapshot(c, "pgdata/1", now.Add(-time.Hour)),
+		s.newSnapshot(c, "pgdata/0", now),
+	}
+	for i, snapshot := range snapshots {
+		err := st.CreateStorageSnapshot(c.Context(), snapshot)
+		c.Assert(err, tc.ErrorIsNil)
+		snapshots[i].Status = domainstorage.SnapshotStatusPending
+	}
+
+	result, err := st.ListStorageSnapshots(c.Context(), "")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, snapshots)
+
+	result, err = st.ListStorageSnapshots(c.Context(), "pgdata/0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, []domainstorage.StorageSnapshot{snapshots[0], snapshots[2]})
+
+	result, err = st.ListStorageSnapshots(c.Context(), "pgdata/2")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.HasLen, 0)
+}
+
+func (s *snapshotStateSuite) TestGetMachineStorageSnapshots(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	now := time.Now().UTC().Truncate(time.Second)
+	snapshot0 := s.newSnapshot(c, "pgdata/0", now)
+	snapshot0.VolumeTag = "volume-2-0"
+	snapshot0.MachineID = "2"
+	snapshot1 := s.newSnapshot(c, "pgdata/1", now.Add(time.Second))
+	snapshot1.VolumeTag = "volume-3-0"
+	snapshot1.MachineID = "3"
+	snapshot2 := s.newSnapshot(c, "pgdata/2", now.Add(2*time.Second))
+	for _, snapshot := range []domainstorage.StorageSnapshot{snapshot0, snapshot1, snapshot2} {
+		err := st.CreateStorageSnapshot(c.Context(), snapshot)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	result, err := st.GetMachineStorageSnapshots(c.Context(), "2")
+	c.Assert(err, tc.ErrorIsNil)
+	snapshot0.Life = life.Alive
+	snapshot0.Status = domainstorage.SnapshotStatusPending
+	c.Assert(result, tc.DeepEquals, []domainstorage.StorageSnapshot{snapshot0})
+
+	result, err = st.GetMachineStorageSnapshots(c.Context(), "4")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 0)
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/juju/juju/domain/life"
+	domainstorage "github.com/juju/juju/domain/storage"
+)
+
+// These structs represent the persistent storage snapshot entity schema
+// in the database.
+
+type storageSnapshot struct {
+	UUID        string         `db:"uuid"`
+	StorageID   string         `db:"storage_id"`
+	VolumeID    string         `db:"volume_id"`
+	VolumeTag   string         `db:"volume_tag"`
+	MachineID   sql.NullString `db:"machine_id"`
+	StoragePool string         `db:"storage_pool"`
+	LifeID      int            `db:"life_id"`
+	ProviderID  sql.NullString `db:"provider_id"`
+	SizeMiB     uint64         `db:"size_mib"`
+	CreatedAt   time.Time      `db:"created_at"`
+}
+
+type storageSnapshotStatus struct {
+	SnapshotUUID string         `db:"snapshot_uuid"`
+	StatusID     int            `db:"status_id"`
+	Message      sql.NullString `db:"message"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+// storageSnapshotDetails is a storage snapshot joined with its status.
+type storageSnapshotDetails struct {
+	UUID        string         `db:"uuid"`
+	StorageID   string         `db:"storage_id"`
+	VolumeID    string         `db:"volume_id"`
+	VolumeTag   string         `db:"volume_tag"`
+	MachineID   sql.NullString `db:"machine_id"`
+	StoragePool string         `db:"storage_pool"`
+	LifeID      int            `db:"life_id"`
+	ProviderID  sql.NullString `db:"provider_id"`
+	SizeMiB     uint64         `db:"size_mib"`
+	CreatedAt   time.Time      `db:"created_at"`
+	StatusID    int            `db:"status_id"`
+	Message     sql.NullString `db:"message"`
+}
+
+func (row storageSnapshotDetails) toStorageSnapshot() domainstorage.StorageSnapshot {
+	return domainstorage.StorageSnapshot{
+		UUID:       domainstorage.SnapshotUUID(row.UUID),
+		StorageID:  row.StorageID,
+		VolumeID:   row.VolumeID,
+		VolumeTag:  row.VolumeTag,
+		MachineID:  row.MachineID.String,
+		Pool:       row.StoragePool,
+		ProviderID: row.ProviderID.String,
+		Size:       row.SizeMiB,
+		Life:       life.Life(row.LifeID),
+		Status:     domainstorage.SnapshotStatus(row.StatusID),
+		Message:    row.Message.String,
+		CreatedAt:  row.CreatedAt,
+	}
+}
+
+type storageSnapshotUUID struct {
+	UUID string `db:"uuid"`
+}
+
+type storageSnapshotStorageID struct {
+	StorageID string `db:"storage_id"`
+}
+
+type storageSnapshotMachineID struct {
+	MachineID string `db:"machine_id"`
+}
@@ -48,3 +48,22 @@ func (u StoragePoolUUID) Validate() error {
 func (u StoragePoolUUID) String() string {
 	return string(u)
 }
+
+// SnapshotUUID uniquely identifies a storage snapshot.
+type SnapshotUUID uuid
+
+// NewSnapshotUUID creates a new, valid storage snapshot identifier.
+func NewSnapshotUUID() (SnapshotUUID, error) {
+	u, err := newUUID()
+	return SnapshotUUID(u), err
+}
+
+// Validate returns an error if the receiver is not a valid UUID.
+func (u SnapshotUUID) Validate() error {
+	return uuid(u).validate()
+}
+
+// String returns the identifier in string form.
+func (u SnapshotUUID) String() string {
+	return string(u)
+}
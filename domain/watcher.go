@@ -116,6 +116,38 @@ func (f *WatcherFactory) NewNotifyMapperWatcher(
 	return eventsource.NewNotifyMapperWatcher(base, mapper, filter, filterOpts...)
 }
 
+// NewChangesWatcher returns a new watcher that emits the change events that
+// match the filters, rather than just the changed values. A single filter
+// option is required, though additional filter options can be provided.
+func (f *WatcherFactory) NewChangesWatcher(
+	filter eventsource.FilterOption,
+	filterOpts ...eventsource.FilterOption,
+) (watcher.ChangesWatcher, error) {
+	base, err := f.newBaseWatcher()
+	if err != nil {
+		return nil, errors.Errorf("creating base watcher: %w", err)
+	}
+
+	return eventsource.NewChangesWatcher(base, filter, filterOpts...)
+}
+
+// NewResumableChangesWatcher returns a new watcher that first emits all the
+// change events after the given change log ID that match the filters, before
+// emitting live change events. A single filter option is required, though
+// additional filter options can be provided.
+func (f *WatcherFactory) NewResumableChangesWatcher(
+	changeLogID int64,
+	filter eventsource.FilterOption,
+	filterOpts ...eventsource.FilterOption,
+) (watcher.ChangesWatcher, error) {
+	base, err := f.newBaseWatcher()
+	if err != nil {
+		return nil, errors.Errorf("creating base watcher: %w", err)
+	}
+
+	return eventsource.NewResumableChangesWatcher(base, changeLogID, filter, filterOpts...)
+}
+
 func (f *WatcherFactory) newBaseWatcher() (*eventsource.BaseWatcher, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
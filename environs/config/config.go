@@ -244,9 +244,25 @@ const (
 	// grow to before it is pruned, eg "5M"
 	MaxActionResultsSize = "max-action-results-size"
 
+	// MaxStatusHistoryAge is the maximum age of status history entries to
+	// keep when pruning, eg "72h"
+	MaxStatusHistoryAge = "max-status-history-age"
+
+	// MaxStatusHistorySize is the maximum size the status history can grow
+	// to before it is pruned, eg "5M"
+	MaxStatusHistorySize = "max-status-history-size"
+
+	// MaxSecretAccessLogAge is the maximum age of secret access log entries
+	// to keep when pruning, eg "2160h"
+	MaxSecretAccessLogAge = "max-secret-access-log-age"
+
 	// UpdateStatusHookInterval is how often to run the update-status hook.
 	UpdateStatusHookInterval = "update-status-hook-interval"
 
+	// HookTimeout is how long a charm hook may run before it is killed,
+	// eg "30m". A value of zero lets hooks run for as long as they need.
+	HookTimeout = "hook-timeout"
+
 	// EgressSubnets are the source addresses from which traffic from this model
 	// originates if the model is deployed such that NAT or similar is in use.
 	EgressSubnets = "egress-subnets"
@@ -284,6 +300,12 @@ const (
 	// this model will accept connections to the SSH service
 	SSHAllowKey = "ssh-allow"
 
+	// SSHUserCertificatesOnlyKey is the key for determining whether users
+	// log into the machines in this model only with certificates signed by
+	// the controller's SSH certificate authority, rather than with the ssh
+	// keys added to the model.
+	SSHUserCertificatesOnlyKey = "ssh-user-certificates-only"
+
 	// SAASIngressAllowKey is a comma separated list of CIDRs
 	// specifying what ingress can be applied to offers in this model
 	SAASIngressAllowKey = "saas-ingress-allow"
@@ -311,8 +333,21 @@ const (
 
 	// LoggingConfigKey is used to specify the logging backend configuration.
 	LoggingConfigKey = "logging-config"
+
+	// LoggingForwardLokiURLKey is the Loki push endpoint that the model's
+	// logs are forwarded to, if set.
+	LoggingForwardLokiURLKey = "logging-forward-loki-url"
+
+	// LoggingForwardOTLPURLKey is the OTLP/HTTP logs endpoint that the
+	// model's logs are forwarded to, if set.
+	LoggingForwardOTLPURLKey = "logging-forward-otlp-url"
 )
 
+// LoggingForwardKeys holds the keys of the endpoints that the controller
+// forwards a model's logs to. As they make the controller send requests to
+// arbitrary endpoints, only controller admins may set them.
+var LoggingForwardKeys = []string{LoggingForwardLokiURLKey, LoggingForwardOTLPURLKey}
+
 // ParseHarvestMode parses description of harvesting method and
 // returns the representation.
 func ParseHarvestMode(description string) (HarvestMode, error) {
@@ -495,6 +530,10 @@ const (
 	// UpdateStatusHookInterval
 	DefaultUpdateStatusHookInterval = "5m"
 
+	// DefaultHookTimeout is the default value for HookTimeout. Hooks are
+	// not timed out unless a timeout is configured.
+	DefaultHookTimeout = "0s"
+
 	// DefaultActionResultsAge is the default for the age of the results for an
 	// action.
 	DefaultActionResultsAge = "336h" // 2 weeks
@@ -502,6 +541,17 @@ const (
 	// DefaultActionResultsSize is the default size of the action results.
 	DefaultActionResultsSize = "5G"
 
+	// DefaultStatusHistoryAge is the default for the age of the status
+	// history entries.
+	DefaultStatusHistoryAge = "336h" // 2 weeks
+
+	// DefaultStatusHistorySize is the default size of the status history.
+	DefaultStatusHistorySize = "5G"
+
+	// DefaultSecretAccessLogAge is the default for the age of the secret
+	// access log entries.
+	DefaultSecretAccessLogAge = "2160h" // 90 days
+
 	// DefaultLxdSnapChannel is the default lxd snap channel to install on host vms.
 	DefaultLxdSnapChannel = "5.0/stable"
 
@@ -553,6 +603,7 @@ var defaultConfigValues = map[string]any{
 	DisableTelemetryKey:             false,
 	TransmitVendorMetricsKey:        true,
 	UpdateStatusHookInterval:        DefaultUpdateStatusHookInterval,
+	HookTimeout:                     DefaultHookTimeout,
 	EgressSubnets:                   "",
 	CloudInitUserDataKey:            "",
 	ContainerInheritPropertiesKey:   "",
@@ -595,10 +646,18 @@ var defaultConfigValues = map[string]any{
 	// Status history settings
 	MaxActionResultsAge:  DefaultActionResultsAge,
 	MaxActionResultsSize: DefaultActionResultsSize,
+	MaxStatusHistoryAge:  DefaultStatusHistoryAge,
+	MaxStatusHistorySize: DefaultStatusHistorySize,
+
+	// Secret access log settings
+	MaxSecretAccessLogAge: DefaultSecretAccessLogAge,
 
 	// Model firewall settings
 	SSHAllowKey:         "0.0.0.0/0,::/0",
 	SAASIngressAllowKey: "0.0.0.0/0,::/0",
+
+	// SSH access settings
+	SSHUserCertificatesOnlyKey: false,
 }
 
 // defaultLoggingConfig is the default value for logging-config if it is otherwise not set.
@@ -733,6 +792,14 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		}
 	}
 
+	for _, key := range LoggingForwardKeys {
+		if v, ok := cfg.defined[key].(string); ok && v != "" {
+			if err := validateLogForwardURL(v); err != nil {
+				return errors.Annotatef(err, "invalid %s in model configuration", key)
+			}
+		}
+	}
+
 	if uuid := cfg.UUID(); !utils.IsValidUUIDString(uuid) {
 		return errors.Errorf("uuid: expected UUID, got string(%q)", uuid)
 	}
@@ -754,6 +821,24 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[MaxStatusHistoryAge].(string); ok {
+		if _, err := time.ParseDuration(v); err != nil {
+			return errors.Annotate(err, "invalid max status history age in model configuration")
+		}
+	}
+
+	if v, ok := cfg.defined[MaxStatusHistorySize].(string); ok {
+		if _, err := utils.ParseSize(v); err != nil {
+			return errors.Annotate(err, "invalid max status history size in model configuration")
+		}
+	}
+
+	if v, ok := cfg.defined[MaxSecretAccessLogAge].(string); ok {
+		if _, err := time.ParseDuration(v); err != nil {
+			return errors.Annotate(err, "invalid max secret access log age in model configuration")
+		}
+	}
+
 	if v, ok := cfg.defined[UpdateStatusHookInterval].(string); ok {
 		duration, err := time.ParseDuration(v)
 		if err != nil {
@@ -767,6 +852,16 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[HookTimeout].(string); ok {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid hook timeout in model configuration")
+		}
+		if duration < 0 {
+			return errors.Errorf("hook timeout %v cannot be negative", duration)
+		}
+	}
+
 	if v, ok := cfg.defined[EgressSubnets].(string); ok && v != "" {
 		cidrs := strings.Split(v, ",")
 		for _, cidr := range cidrs {
@@ -1343,6 +1438,33 @@ func (c *Config) LoggingConfig() string {
 	return c.asString(LoggingConfigKey)
 }
 
+// LoggingForwardLokiURL returns the Loki push endpoint that the model's logs
+// are forwarded to. An empty string means the logs are not forwarded to Loki.
+func (c *Config) LoggingForwardLokiURL() string {
+	return c.asString(LoggingForwardLokiURLKey)
+}
+
+// LoggingForwardOTLPURL returns the OTLP/HTTP logs endpoint that the model's
+// logs are forwarded to. An empty string means the logs are not forwarded
+// to an OTLP endpoint.
+func (c *Config) LoggingForwardOTLPURL() string {
+	return c.asString(LoggingForwardOTLPURLKey)
+}
+
+func validateLogForwardURL(v string) error {
+	u, err := url.Parse(v)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.NotValidf("URL %q scheme (expected http or https)", v)
+	}
+	if u.Host == "" {
+		return errors.NotValidf("URL %q without host", v)
+	}
+	return nil
+}
+
 // BackupDir returns the configuration string for the temporary files
 // backup.
 func (c *Config) BackupDir() string {
@@ -1536,6 +1658,14 @@ func (c *Config) SSHAllow() []string {
 	return strings.Split(allowList, ",")
 }
 
+// SSHUserCertificatesOnly returns whether users log into the machines in
+// this model only with certificates signed by the controller's SSH
+// certificate authority, rather than with the ssh keys added to the model.
+func (c *Config) SSHUserCertificatesOnly() bool {
+	value, _ := c.defined[SSHUserCertificatesOnlyKey].(bool)
+	return value
+}
+
 // SAASIngressAllow returns a slice of CIDRs specifying what
 // ingress can be applied to offers in this model
 func (c *Config) SAASIngressAllow() []string {
@@ -1626,6 +1756,30 @@ func (c *Config) MaxActionResultsSizeMB() uint {
 	return uint(val)
 }
 
+// MaxStatusHistoryAge is the maximum age of the status history entries
+// before they are pruned.
+func (c *Config) MaxStatusHistoryAge() time.Duration {
+	// Value has already been validated.
+	val, _ := time.ParseDuration(c.mustString(MaxStatusHistoryAge))
+	return val
+}
+
+// MaxStatusHistorySizeMB is the maximum size of the status history, in MB,
+// before the oldest entries are pruned.
+func (c *Config) MaxStatusHistorySizeMB() uint {
+	// Value has already been validated.
+	val, _ := utils.ParseSize(c.mustString(MaxStatusHistorySize))
+	return uint(val)
+}
+
+// MaxSecretAccessLogAge is the maximum age of the secret access log
+// entries before they are pruned.
+func (c *Config) MaxSecretAccessLogAge() time.Duration {
+	// Value has already been validated.
+	val, _ := time.ParseDuration(c.mustString(MaxSecretAccessLogAge))
+	return val
+}
+
 // UpdateStatusHookInterval is how often to run the charm
 // update-status hook.
 func (c *Config) UpdateStatusHookInterval() time.Duration {
@@ -1634,6 +1788,14 @@ func (c *Config) UpdateStatusHookInterval() time.Duration {
 	return val
 }
 
+// HookTimeout is how long a charm hook may run before it is killed.
+// Zero means that hooks are not timed out.
+func (c *Config) HookTimeout() time.Duration {
+	// Value has already been validated.
+	val, _ := time.ParseDuration(c.asString(HookTimeout))
+	return val
+}
+
 // EgressSubnets are the source addresses from which traffic from this model
 // originates if the model is deployed such that NAT or similar is in use.
 func (c *Config) EgressSubnets() []string {
@@ -1749,11 +1911,14 @@ var alwaysOptional = schema.Defaults{
 	StorageDefaultBlockSourceKey:      schema.Omit,
 	StorageDefaultFilesystemSourceKey: schema.Omit,
 
-	"firewall-mode":     schema.Omit,
-	SSHAllowKey:         schema.Omit,
-	SAASIngressAllowKey: schema.Omit,
+	"firewall-mode":            schema.Omit,
+	SSHAllowKey:                schema.Omit,
+	SAASIngressAllowKey:        schema.Omit,
+	SSHUserCertificatesOnlyKey: schema.Omit,
 
 	"logging-config":                schema.Omit,
+	LoggingForwardLokiURLKey:        schema.Omit,
+	LoggingForwardOTLPURLKey:        schema.Omit,
 	ProvisionerHarvestModeKey:       schema.Omit,
 	NumProvisionWorkersKey:          schema.Omit,
 	NumContainerProvisionWorkersKey: schema.Omit,
@@ -1795,7 +1960,11 @@ var alwaysOptional = schema.Defaults{
 	ContainerNetworkingMethodKey:    schema.Omit,
 	MaxActionResultsAge:             schema.Omit,
 	MaxActionResultsSize:            schema.Omit,
+	MaxStatusHistoryAge:             schema.Omit,
+	MaxStatusHistorySize:            schema.Omit,
+	MaxSecretAccessLogAge:           schema.Omit,
 	UpdateStatusHookInterval:        schema.Omit,
+	HookTimeout:                     schema.Omit,
 	EgressSubnets:                   schema.Omit,
 	CloudInitUserDataKey:            schema.Omit,
 	ContainerInheritPropertiesKey:   schema.Omit,
@@ -1239,6 +1239,33 @@ func (s *ConfigSuite) TestCharmHubURLSettingValue(c *tc.C) {
 	c.Assert(chURL, tc.Equals, url)
 }
 
+func (s *ConfigSuite) TestLoggingForwardURLs(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Check(cfg.LoggingForwardLokiURL(), tc.Equals, "")
+	c.Check(cfg.LoggingForwardOTLPURL(), tc.Equals, "")
+
+	cfg = newTestConfig(c, testing.Attrs{
+		config.LoggingForwardLokiURLKey: "http://loki:3100/loki/api/v1/push",
+		config.LoggingForwardOTLPURLKey: "https://collector:4318/v1/logs",
+	})
+	c.Check(cfg.LoggingForwardLokiURL(), tc.Equals, "http://loki:3100/loki/api/v1/push")
+	c.Check(cfg.LoggingForwardOTLPURL(), tc.Equals, "https://collector:4318/v1/logs")
+}
+
+func (s *ConfigSuite) TestLoggingForwardURLsInvalid(c *tc.C) {
+	attrs := testing.FakeConfig().Merge(testing.Attrs{
+		config.LoggingForwardLokiURLKey: "ftp://loki/push",
+	})
+	_, err := config.New(config.UseDefaults, attrs)
+	c.Check(err, tc.ErrorMatches, `invalid logging-forward-loki-url in model configuration: URL "ftp://loki/push" scheme \(expected http or https\) not valid`)
+
+	attrs = testing.FakeConfig().Merge(testing.Attrs{
+		config.LoggingForwardOTLPURLKey: "http:///v1/logs",
+	})
+	_, err = config.New(config.UseDefaults, attrs)
+	c.Check(err, tc.ErrorMatches, `invalid logging-forward-otlp-url in model configuration: URL "http:///v1/logs" without host not valid`)
+}
+
 func (s *ConfigSuite) TestNoBothProxy(c *tc.C) {
 	config := newTestConfig(c, testing.Attrs{
 		"http-proxy":  "http://user@10.0.0.1",
@@ -1428,6 +1455,30 @@ func (s *ConfigSuite) TestUpdateStatusHookIntervalConfigValue(c *tc.C) {
 	c.Assert(cfg.UpdateStatusHookInterval(), tc.Equals, 30*time.Minute)
 }
 
+func (s *ConfigSuite) TestHookTimeoutConfigDefault(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.HookTimeout(), tc.Equals, time.Duration(0))
+}
+
+func (s *ConfigSuite) TestHookTimeoutConfigValue(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"hook-timeout": "15m",
+	})
+	c.Assert(cfg.HookTimeout(), tc.Equals, 15*time.Minute)
+}
+
+func (s *ConfigSuite) TestHookTimeoutConfigInvalid(c *tc.C) {
+	_, err := config.New(config.UseDefaults, sampleConfig.Merge(testing.Attrs{
+		"hook-timeout": "soon",
+	}))
+	c.Assert(err, tc.ErrorMatches, `invalid hook timeout in model configuration: time: invalid duration "soon"`)
+
+	_, err = config.New(config.UseDefaults, sampleConfig.Merge(testing.Attrs{
+		"hook-timeout": "-5m",
+	}))
+	c.Assert(err, tc.ErrorMatches, `hook timeout -5m0s cannot be negative`)
+}
+
 func (s *ConfigSuite) TestEgressSubnets(c *tc.C) {
 	cfg := newTestConfig(c, testing.Attrs{
 		"egress-subnets": "10.0.0.1/32, 192.168.1.1/16",
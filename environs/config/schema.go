@@ -403,6 +403,16 @@ upgrades.
 		Type:  configschema.Tstring,
 		Group: configschema.EnvironGroup,
 	},
+	LoggingForwardLokiURLKey: {
+		Description: "The Loki push endpoint to forward the model's logs to, e.g. http://loki:3100/loki/api/v1/push",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
+	LoggingForwardOTLPURLKey: {
+		Description: "The OTLP/HTTP logs endpoint to forward the model's logs to, e.g. http://collector:4318/v1/logs",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
 	NameKey: {
 		Description: "The name of the current model",
 		Type:        configschema.Tstring,
@@ -505,6 +515,14 @@ Currently only the aws & openstack providers support ssh-allow`,
 		Type:  configschema.Tstring,
 		Group: configschema.EnvironGroup,
 	},
+	SSHUserCertificatesOnlyKey: {
+		Description: `Whether users log into the machines in this model only with
+certificates signed by the controller's SSH certificate authority. When true,
+the ssh keys added to the model are no longer authorised on its machines.
+(default false)`,
+		Type:  configschema.Tbool,
+		Group: configschema.EnvironGroup,
+	},
 	SAASIngressAllowKey: {
 		Description: `Application-offer ingress allowlist is a comma-separated list of
 CIDRs specifying what ingress can be applied to offers in this model.`,
@@ -573,11 +591,31 @@ manually using:
 		Type:        configschema.Tstring,
 		Group:       configschema.EnvironGroup,
 	},
+	MaxStatusHistoryAge: {
+		Description: "The maximum age for status history entries before they are pruned, in human-readable time format",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
+	MaxStatusHistorySize: {
+		Description: "The maximum size for the status history, in human-readable memory format",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
+	MaxSecretAccessLogAge: {
+		Description: "The maximum age for secret access log entries before they are pruned, in human-readable time format",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
 	UpdateStatusHookInterval: {
 		Description: "How often to run the charm update-status hook, in human-readable time format (default 5m, range 1-60m)",
 		Type:        configschema.Tstring,
 		Group:       configschema.EnvironGroup,
 	},
+	HookTimeout: {
+		Description: "How long a charm hook may run before it is killed and the unit is put into an error state, in human-readable time format (default 0s, no timeout). A warning is shown in the unit's agent status when a hook has run for half of this time",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
 	EgressSubnets: {
 		Description: "Source address(es) for traffic originating from this model",
 		Type:        configschema.Tstring,
@@ -0,0 +1,240 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	corebackups "github.com/juju/juju/core/backups"
+	bt "github.com/juju/juju/core/backups/testing"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+type backupsSuite struct{}
+
+func TestBackupsSuite(t *testing.T) {
+	tc.Run(t, &backupsSuite{})
+}
+
+type fakeDumper string
+
+func (d fakeDumper) DumpDatabase(_ context.Context, w io.Writer) error {
+	_, err := io.WriteString(w, string(d))
+	return err
+}
+
+type failingDumper struct{}
+
+func (failingDumper) DumpDatabase(context.Context, io.Writer) error {
+	return errors.New("boom")
+}
+
+func (s *backupsSuite) create(c *tc.C, dataDir, backupDir string) (*corebackups.Metadata, string) {
+	meta := corebackups.NewMetadata()
+	meta.Started = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	meta.Controller.UUID = "controller-uuid"
+
+	filename, err := Create(c.Context(), CreateArgs{
+		Metadata: meta,
+		Databases: []Database{
+			{Namespace: "controller", Dumper: fakeDumper("controller dump\n")},
+			{Namespace: "model-uuid", Dumper: fakeDumper("model dump\n")},
+		},
+		DataDir:   dataDir,
+		BackupDir: backupDir,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return meta, filename
+}
+
+func (s *backupsSuite) TestCreateAndOpen(c *tc.C) {
+	dataDir := c.MkDir()
+	objectDir := filepath.Join(dataDir, "objectstore", "model-uuid")
+	c.Assert(os.MkdirAll(objectDir, 0700), tc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(objectDir, "abc"), []byte("object"), 0600), tc.ErrorIsNil)
+
+	backupDir := c.MkDir()
+	meta, filename := s.create(c, dataDir, backupDir)
+	c.Check(filename, tc.Equals, "juju-backup-20250102-030405.tar.gz")
+	c.Check(meta.Databases, tc.DeepEquals, []string{"controller", "model-uuid"})
+	c.Check(meta.Checksums, tc.HasLen, 3)
+	c.Check(meta.Finished, tc.NotNil)
+
+	fi, err := os.Stat(filepath.Join(backupDir, filename))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(meta.Size(), tc.Equals, fi.Size())
+
+	// The workspace is removed once the archive is written.
+	entries, err := os.ReadDir(backupDir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 1)
+
+	f, err := os.Open(filepath.Join(backupDir, filename))
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = f.Close() }()
+
+	archive, err := OpenArchive(f)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = archive.Close() }()
+
+	c.Check(archive.Metadata().Controller.UUID, tc.Equals, "controller-uuid")
+	c.Check(archive.Metadata().Checksums, tc.DeepEquals, meta.Checksums)
+
+	dumps := archive.DatabaseDumps()
+	c.Assert(dumps, tc.HasLen, 2)
+	c.Check(dumps[0].Namespace, tc.Equals, "controller")
+	r, err := dumps[1].Open()
+	c.Assert(err, tc.ErrorIsNil)
+	data, err := io.ReadAll(r)
+	_ = r.Close()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "model dump\n")
+
+	target := c.MkDir()
+	c.Assert(archive.RestoreFiles(target), tc.ErrorIsNil)
+	data, err = os.ReadFile(filepath.Join(target, "objectstore", "model-uuid", "abc"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "object")
+}
+
+// changingDumper changes the object store whilst the database is dumped.
+type changingDumper struct {
+	change func() error
+}
+
+func (d changingDumper) DumpDatabase(_ context.Context, w io.Writer) error {
+	if err := d.change(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "model dump\n")
+	return err
+}
+
+func (s *backupsSuite) TestCreateSnapshotsObjectStoreBeforeDumps(c *tc.C) {
+	dataDir := c.MkDir()
+	objectDir := filepath.Join(dataDir, "objectstore", "model-uuid")
+	c.Assert(os.MkdirAll(filepath.Join(objectDir, "tmp"), 0700), tc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(objectDir, "abc"), []byte("removed"), 0600), tc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(objectDir, "tmp", "tmp123"), []byte("partial"), 0600), tc.ErrorIsNil)
+
+	// The object store is changed after the snapshot is taken, whilst the
+	// database is dumped.
+	dumper := changingDumper{change: func() error {
+		if err := os.Remove(filepath.Join(objectDir, "abc")); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(objectDir, "def"), []byte("added"), 0600)
+	}}
+
+	backupDir := c.MkDir()
+	_, err := Create(c.Context(), CreateArgs{
+		Metadata: corebackups.NewMetadata(),
+		Databases: []Database{
+			{Namespace: "model-uuid", Dumper: dumper},
+		},
+		DataDir:   dataDir,
+		BackupDir: backupDir,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	entries, err := os.ReadDir(backupDir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(entries, tc.HasLen, 1)
+	f, err := os.Open(filepath.Join(backupDir, entries[0].Name()))
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = f.Close() }()
+
+	archive, err := OpenArchive(f)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = archive.Close() }()
+
+	target := c.MkDir()
+	c.Assert(archive.RestoreFiles(target), tc.ErrorIsNil)
+	restoredDir := filepath.Join(target, "objectstore", "model-uuid")
+	data, err := os.ReadFile(filepath.Join(restoredDir, "abc"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "removed")
+	data, err = os.ReadFile(filepath.Join(restoredDir, "def"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "added")
+	_, err = os.Stat(filepath.Join(restoredDir, "tmp"))
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+
+	// The object store itself is untouched.
+	_, err = os.Stat(filepath.Join(objectDir, "tmp", "tmp123"))
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *backupsSuite) TestCreateWithoutObjectStore(c *tc.C) {
+	backupDir := c.MkDir()
+	_, filename := s.create(c, c.MkDir(), backupDir)
+
+	f, err := os.Open(filepath.Join(backupDir, filename))
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = f.Close() }()
+
+	archive, err := OpenArchive(f)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(archive.Close(), tc.ErrorIsNil)
+}
+
+func (s *backupsSuite) TestCreateDumpError(c *tc.C) {
+	backupDir := c.MkDir()
+	_, err := Create(c.Context(), CreateArgs{
+		Metadata: corebackups.NewMetadata(),
+		Databases: []Database{
+			{Namespace: "controller", Dumper: failingDumper{}},
+		},
+		DataDir:   c.MkDir(),
+		BackupDir: backupDir,
+	})
+	c.Assert(err, tc.ErrorMatches, `dumping database "controller": boom`)
+
+	entries, err := os.ReadDir(backupDir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+func (s *backupsSuite) TestOpenArchiveChecksumMismatch(c *tc.C) {
+	meta := corebackups.NewMetadata()
+	meta.Databases = []string{"controller"}
+	meta.Checksums = map[string]string{
+		"juju-backup/dump/controller.jsonl": "bad",
+		"juju-backup/root.tar":              "bad",
+	}
+	archive, err := bt.NewArchive(meta, nil, []bt.File{
+		{Name: "controller.jsonl", Content: "controller dump\n"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = OpenArchive(archive)
+	c.Assert(err, tc.ErrorMatches, `checksum mismatch for "juju-backup/.*": expected bad, got .*`)
+}
+
+func (s *backupsSuite) TestOpenArchiveMissingDatabaseChecksum(c *tc.C) {
+	meta := corebackups.NewMetadata()
+	meta.Databases = []string{"controller"}
+	meta.Checksums = map[string]string{}
+	archive, err := bt.NewArchive(meta, nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = OpenArchive(archive)
+	c.Assert(err, tc.ErrorMatches, `missing checksum for database "controller"`)
+}
+
+func (s *backupsSuite) TestOpenArchiveWithoutDatabases(c *tc.C) {
+	archive, err := bt.NewArchive(corebackups.NewMetadata(), nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = OpenArchive(archive)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
@@ -0,0 +1,361 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// dumpExtension is the extension of the database dump files in the
+	// archive.
+	dumpExtension = ".jsonl"
+
+	// objectStoreDir is the name of the directory containing the file
+	// backed object store, relative to the data directory. It is the
+	// top-level directory of the archived files bundle.
+	objectStoreDir = "objectstore"
+
+	// objectStoreTempDir is the name of the directory in each object store
+	// namespace holding the objects being written, which are not part of the
+	// backup.
+	objectStoreTempDir = "tmp"
+)
+
+// BackupDir returns the directory in which backup archives are created, given
+// the backup-dir model config value. Archives are created in the temporary
+// directory if no backup directory is configured.
+func BackupDir(configured string) string {
+	if configured != "" {
+		return configured
+	}
+	return os.TempDir()
+}
+
+// DatabaseDumper writes a consistent dump of a database.
+type DatabaseDumper interface {
+	// DumpDatabase writes a logical dump of the whole database to the
+	// writer.
+	DumpDatabase(ctx context.Context, w io.Writer) error
+}
+
+// Database identifies a database to include in a backup.
+type Database struct {
+	// Namespace is the namespace of the database.
+	Namespace string
+
+	// Dumper writes the dump of the database.
+	Dumper DatabaseDumper
+}
+
+// CreateArgs holds the arguments for creating a backup archive.
+type CreateArgs struct {
+	// Metadata is the metadata of the backup. It is completed and written
+	// into the archive.
+	Metadata *corebackups.Metadata
+
+	// Databases are the databases to dump into the archive.
+	Databases []Database
+
+	// DataDir is the agent data directory. If it contains a file backed
+	// object store, the object store is included in the archive. If empty,
+	// no files are included.
+	DataDir string
+
+	// BackupDir is the directory in which the archive is created.
+	BackupDir string
+}
+
+// Create writes a backup archive containing the dump of each database and
+// the file backed object store to the backup directory. It returns the name
+// of the archive file. The metadata is completed with the checksum of every
+// file in the archive, and of the archive itself.
+func Create(ctx context.Context, args CreateArgs) (string, error) {
+	meta := args.Metadata
+	if meta == nil {
+		return "", errors.New("missing metadata")
+	}
+
+	if err := os.MkdirAll(args.BackupDir, 0700); err != nil {
+		return "", errors.Errorf("creating backup directory: %w", err)
+	}
+	workDir, err := os.MkdirTemp(args.BackupDir, "juju-backup-work-")
+	if err != nil {
+		return "", errors.Errorf("creating backup workspace: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(workDir) }()
+
+	paths := corebackups.NewCanonicalArchivePaths()
+	ws := corebackups.NewNonCanonicalArchivePaths(workDir)
+	if err := os.MkdirAll(ws.DBDumpDir, 0700); err != nil {
+		return "", errors.Capture(err)
+	}
+
+	// Objects are immutable and named after their hash, so linking them into
+	// the workspace before the databases are dumped keeps every object that
+	// the dumps may reference, even if it is removed from the object store
+	// before the files bundle is written. Objects stored whilst the
+	// databases are dumped are linked once the dumps are complete.
+	snapshotDir := filepath.Join(workDir, "snapshot")
+	if err := snapshotObjectStore(args.DataDir, snapshotDir); err != nil {
+		return "", errors.Errorf("snapshotting object store: %w", err)
+	}
+
+	meta.Databases = nil
+	meta.Checksums = make(map[string]string)
+	for _, db := range args.Databases {
+		name := db.Namespace + dumpExtension
+		checksum, err := writeFile(filepath.Join(ws.DBDumpDir, name), func(w io.Writer) error {
+			return db.Dumper.DumpDatabase(ctx, w)
+		})
+		if err != nil {
+			return "", errors.Errorf("dumping database %q: %w", db.Namespace, err)
+		}
+		meta.Databases = append(meta.Databases, db.Namespace)
+		meta.Checksums[path.Join(paths.DBDumpDir, name)] = checksum
+	}
+
+	if err := snapshotObjectStore(args.DataDir, snapshotDir); err != nil {
+		return "", errors.Errorf("snapshotting object store: %w", err)
+	}
+	checksum, err := writeFile(ws.FilesBundle, func(w io.Writer) error {
+		return writeFilesBundle(w, snapshotDir)
+	})
+	if err != nil {
+		return "", errors.Errorf("archiving object store: %w", err)
+	}
+	meta.Checksums[paths.FilesBundle] = checksum
+
+	metaFile, err := meta.AsJSONBuffer()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	if _, err := writeFile(ws.MetadataFile, func(w io.Writer) error {
+		_, err := io.Copy(w, metaFile)
+		return err
+	}); err != nil {
+		return "", errors.Capture(err)
+	}
+
+	filename := meta.Started.Format(corebackups.FilenameTemplate)
+	archivePath := filepath.Join(args.BackupDir, filename)
+	archive, err := os.OpenFile(archivePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", errors.Errorf("creating archive: %w", err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	hasher := sha1.New()
+	counter := &countingWriter{}
+	if err := writeArchive(io.MultiWriter(archive, hasher, counter), workDir, paths.ContentDir); err != nil {
+		_ = os.Remove(archivePath)
+		return "", errors.Errorf("writing archive: %w", err)
+	}
+	if err := archive.Close(); err != nil {
+		_ = os.Remove(archivePath)
+		return "", errors.Errorf("writing archive: %w", err)
+	}
+
+	checksum = base64.StdEncoding.EncodeToString(hasher.Sum(nil))
+	if err := meta.MarkComplete(counter.n, checksum); err != nil {
+		return "", errors.Capture(err)
+	}
+	return filename, nil
+}
+
+// writeFile creates the file, writes to it with the input function and
+// returns the checksum of the written content.
+func writeFile(name string, write func(io.Writer) error) (string, error) {
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	hasher := sha256.New()
+	if err := write(io.MultiWriter(f, hasher)); err != nil {
+		return "", errors.Capture(err)
+	}
+	if err := f.Close(); err != nil {
+		return "", errors.Capture(err)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// writeArchive writes the compressed archive of the content directory in the
+// workspace.
+func writeArchive(w io.Writer, workDir, contentDir string) error {
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	if err := addTree(tw, workDir, contentDir); err != nil {
+		return errors.Capture(err)
+	}
+	if err := tw.Close(); err != nil {
+		return errors.Capture(err)
+	}
+	return gzw.Close()
+}
+
+// snapshotObjectStore links the objects of the file backed object store under
+// the data directory into the snapshot directory, keeping the same layout.
+// Objects already in the snapshot are skipped, as are the objects still being
+// written. An object is copied if it can't be linked, such as when the
+// snapshot is on another file system.
+func snapshotObjectStore(dataDir, snapshotDir string) error {
+	if dataDir == "" {
+		return nil
+	}
+	root := filepath.Join(dataDir, objectStoreDir)
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			// There is no object store, or the object was removed whilst
+			// the object store was walked.
+			return nil
+		} else if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dataDir, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(snapshotDir, rel)
+		if info.IsDir() {
+			if p != root && info.Name() == objectStoreTempDir {
+				return filepath.SkipDir
+			}
+			if err := os.MkdirAll(target, 0700); err != nil {
+				return err
+			}
+			return os.Chmod(target, info.Mode().Perm())
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		if _, err := os.Lstat(target); err == nil {
+			return nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		if err := os.Link(p, target); err == nil || errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err := copyFile(p, target, info.Mode().Perm()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	})
+	return errors.Capture(err)
+}
+
+// copyFile copies the source file to a new file at the destination.
+func copyFile(src, dst string, perm fs.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// writeFilesBundle writes a tar file containing the file backed object store
+// under the root directory, if there is one.
+func writeFilesBundle(w io.Writer, root string) error {
+	tw := tar.NewWriter(w)
+	dir := filepath.Join(root, objectStoreDir)
+	if _, err := os.Stat(dir); err == nil {
+		if err := addTree(tw, root, objectStoreDir); err != nil {
+			return errors.Capture(err)
+		}
+	} else if !os.IsNotExist(err) {
+		return errors.Capture(err)
+	}
+	return tw.Close()
+}
+
+// addTree adds the directory at root/name, and everything below it, to the
+// tar writer using paths relative to the root.
+func addTree(tw *tar.Writer, root, name string) error {
+	err := filepath.WalkDir(filepath.Join(root, name), func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsDir() && !info.Mode().IsRegular() {
+			// Object store staging sockets, links and the like are not
+			// part of the backup.
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	return errors.Capture(err)
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
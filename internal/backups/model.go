@@ -0,0 +1,350 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/clock"
+
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/domain/backup"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// modelSnapshotFormat is the version of the model snapshot archive
+	// format.
+	modelSnapshotFormat = 1
+
+	modelMetadataFile = "metadata.json"
+	modelDumpFile     = "model" + dumpExtension
+	modelObjectsDir   = "objects"
+)
+
+// ModelExporter exports a snapshot of a model database.
+type ModelExporter interface {
+	// ExportModel writes a dump of the model database to the writer, and
+	// returns a description of the snapshot.
+	ExportModel(ctx context.Context, w io.Writer) (backup.ModelSnapshot, error)
+}
+
+// ModelImporter imports a snapshot of a model database.
+type ModelImporter interface {
+	// CheckModelImport checks that the model snapshot can be imported.
+	CheckModelImport(ctx context.Context, snapshot backup.ModelSnapshot) error
+
+	// ImportModel replaces the contents of the model database with the
+	// model snapshot in the dump, rewriting the snapshot's object UUIDs to
+	// those in the input map.
+	ImportModel(
+		ctx context.Context,
+		snapshot backup.ModelSnapshot,
+		dump io.ReadSeeker,
+		objectUUIDs map[objectstore.UUID]objectstore.UUID,
+	) error
+}
+
+// modelMetadata is the metadata file of a model snapshot archive.
+type modelMetadata struct {
+	FormatVersion int                   `json:"format-version"`
+	Created       time.Time             `json:"created"`
+	JujuVersion   string                `json:"juju-version"`
+	ModelUUID     string                `json:"model-uuid"`
+	SchemaVersion int                   `json:"schema-version"`
+	SchemaHash    string                `json:"schema-hash"`
+	DumpChecksum  string                `json:"dump-checksum"`
+	Objects       []modelMetadataObject `json:"objects,omitempty"`
+}
+
+type modelMetadataObject struct {
+	UUID   string `json:"uuid"`
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+	SHA384 string `json:"sha384"`
+	Size   int64  `json:"size"`
+}
+
+func (m modelMetadata) snapshot() backup.ModelSnapshot {
+	snapshot := backup.ModelSnapshot{
+		ModelUUID:     coremodel.UUID(m.ModelUUID),
+		SchemaVersion: m.SchemaVersion,
+		SchemaHash:    m.SchemaHash,
+	}
+	for _, obj := range m.Objects {
+		snapshot.Objects = append(snapshot.Objects, backup.Object{
+			UUID:   objectstore.UUID(obj.UUID),
+			Path:   obj.Path,
+			SHA256: obj.SHA256,
+			SHA384: obj.SHA384,
+			Size:   obj.Size,
+		})
+	}
+	return snapshot
+}
+
+// ExportModel writes a compressed archive holding a consistent snapshot of
+// the model database, along with every objectstore blob that the database
+// references, to the writer.
+func ExportModel(
+	ctx context.Context,
+	exporter ModelExporter,
+	store objectstore.ReadObjectStore,
+	clock clock.Clock,
+	w io.Writer,
+) error {
+	// The dump is written to a temporary file, as the archive holds the
+	// dump's checksum and size ahead of the dump itself.
+	dump, err := os.CreateTemp("", "juju-model-export-")
+	if err != nil {
+		return errors.Errorf("creating model database dump: %w", err)
+	}
+	defer func() {
+		_ = dump.Close()
+		_ = os.Remove(dump.Name())
+	}()
+
+	hasher := sha256.New()
+	counter := &countingWriter{}
+	snapshot, err := exporter.ExportModel(ctx, io.MultiWriter(dump, hasher, counter))
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if _, err := dump.Seek(0, io.SeekStart); err != nil {
+		return errors.Capture(err)
+	}
+
+	now := clock.Now().UTC()
+	meta := modelMetadata{
+		FormatVersion: modelSnapshotFormat,
+		Created:       now,
+		JujuVersion:   jujuversion.Current.String(),
+		ModelUUID:     snapshot.ModelUUID.String(),
+		SchemaVersion: snapshot.SchemaVersion,
+		SchemaHash:    snapshot.SchemaHash,
+		DumpChecksum:  hex.EncodeToString(hasher.Sum(nil)),
+	}
+	for _, obj := range snapshot.Objects {
+		meta.Objects = append(meta.Objects, modelMetadataObject{
+			UUID:   obj.UUID.String(),
+			Path:   obj.Path,
+			SHA256: obj.SHA256,
+			SHA384: obj.SHA384,
+			Size:   obj.Size,
+		})
+	}
+	metaData, err := json.Marshal(meta)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	if err := addFile(tw, modelMetadataFile, int64(len(metaData)), now, bytes.NewReader(metaData)); err != nil {
+		return errors.Capture(err)
+	}
+	if err := addFile(tw, modelDumpFile, counter.n, now, dump); err != nil {
+		return errors.Capture(err)
+	}
+	// An object stored under several paths is listed once for each path,
+	// but its contents are only added once.
+	added := make(map[string]bool)
+	for _, obj := range snapshot.Objects {
+		if added[obj.SHA384] {
+			continue
+		}
+		if err := addObject(ctx, tw, store, obj, now); err != nil {
+			return errors.Errorf("adding object %q: %w", obj.Path, err)
+		}
+		added[obj.SHA384] = true
+	}
+	if err := tw.Close(); err != nil {
+		return errors.Capture(err)
+	}
+	return gzw.Close()
+}
+
+func addObject(ctx context.Context, tw *tar.Writer, store objectstore.ReadObjectStore, obj backup.Object, modTime time.Time) error {
+	r, size, err := store.Get(ctx, obj.Path)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = r.Close() }()
+
+	if size != obj.Size {
+		return errors.Errorf("expected %d bytes, got %d", obj.Size, size)
+	}
+	return addFile(tw, path.Join(modelObjectsDir, obj.SHA384), size, modTime, r)
+}
+
+func addFile(tw *tar.Writer, name string, size int64, modTime time.Time, r io.Reader) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0600,
+		ModTime:  modTime,
+	}); err != nil {
+		return errors.Capture(err)
+	}
+	_, err := io.Copy(tw, r)
+	return errors.Capture(err)
+}
+
+// ImportModel reads a model snapshot archive written by ExportModel from the
+// reader, stores its objectstore blobs and replaces the contents of the
+// model database with the snapshot. The snapshot is checked before anything
+// is stored, so that incompatible snapshots are rejected early. If the import
+// fails, the objects it stored are removed again.
+func ImportModel(ctx context.Context, importer ModelImporter, store objectstore.WriteObjectStore, r io.Reader) (err error) {
+	workDir, err := os.MkdirTemp("", "juju-model-import-")
+	if err != nil {
+		return errors.Errorf("creating import workspace: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(workDir) }()
+
+	meta, err := unpackModelArchive(ctx, importer, r, workDir)
+	if err != nil {
+		return errors.Errorf("unpacking model snapshot: %w", err)
+	}
+	snapshot := meta.snapshot()
+
+	dumpPath := filepath.Join(workDir, modelDumpFile)
+	if checksum, err := checksumFile(dumpPath); err != nil {
+		return errors.Errorf("verifying model database dump: %w", err)
+	} else if checksum != meta.DumpChecksum {
+		return errors.Errorf("checksum mismatch for model database dump: expected %s, got %s", meta.DumpChecksum, checksum)
+	}
+
+	// Every path of an object is stored, so that the object can be found
+	// under each of them, but the object keeps the UUID it was first stored
+	// with.
+	objectUUIDs := make(map[objectstore.UUID]objectstore.UUID)
+	var stored []string
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, p := range stored {
+			if removeErr := store.Remove(ctx, p); removeErr != nil {
+				err = errors.Join(err, errors.Errorf("removing object %q: %w", p, removeErr))
+			}
+		}
+	}()
+	for _, obj := range snapshot.Objects {
+		uuid, err := putObject(ctx, store, filepath.Join(workDir, modelObjectsDir, obj.SHA384), obj)
+		if err != nil {
+			return errors.Errorf("storing object %q: %w", obj.Path, err)
+		}
+		stored = append(stored, obj.Path)
+		if _, ok := objectUUIDs[obj.UUID]; !ok {
+			objectUUIDs[obj.UUID] = uuid
+		}
+	}
+
+	dump, err := os.Open(dumpPath)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = dump.Close() }()
+
+	if err := importer.ImportModel(ctx, snapshot, dump, objectUUIDs); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+// unpackModelArchive writes the files of the model snapshot archive into the
+// work directory, checking the snapshot as soon as its metadata is read.
+func unpackModelArchive(ctx context.Context, importer ModelImporter, r io.Reader, workDir string) (*modelMetadata, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	defer func() { _ = gzr.Close() }()
+
+	if err := os.Mkdir(filepath.Join(workDir, modelObjectsDir), 0700); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var meta *modelMetadata
+	extracted := make(map[string]bool)
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Capture(err)
+		}
+
+		switch dir, name := path.Split(hdr.Name); {
+		case hdr.Name == modelMetadataFile:
+			meta = &modelMetadata{}
+			if err := json.NewDecoder(tr).Decode(meta); err != nil {
+				return nil, errors.Errorf("reading metadata: %w", err)
+			}
+			if meta.FormatVersion != modelSnapshotFormat {
+				return nil, errors.Errorf("unsupported model snapshot format %d", meta.FormatVersion)
+			}
+			if err := importer.CheckModelImport(ctx, meta.snapshot()); err != nil {
+				return nil, errors.Capture(err)
+			}
+		case meta == nil:
+			return nil, errors.Errorf("expected %q at the start of the archive, got %q", modelMetadataFile, hdr.Name)
+		case extracted[hdr.Name] && dir == modelObjectsDir+"/":
+			// Objects are named by their hash, so a repeated object has
+			// the same contents as the one already extracted.
+			continue
+		case hdr.Name == modelDumpFile,
+			dir == modelObjectsDir+"/" && name != "" && name != "." && name != "..":
+			if err := extractFile(filepath.Join(workDir, filepath.FromSlash(hdr.Name)), tr); err != nil {
+				return nil, errors.Capture(err)
+			}
+			extracted[hdr.Name] = true
+		default:
+			return nil, errors.Errorf("unexpected file %q", hdr.Name)
+		}
+	}
+	if meta == nil {
+		return nil, errors.Errorf("missing %q", modelMetadataFile)
+	}
+	return meta, nil
+}
+
+func extractFile(name string, r io.Reader) error {
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return errors.Capture(err)
+	}
+	return f.Close()
+}
+
+func putObject(ctx context.Context, store objectstore.WriteObjectStore, name string, obj backup.Object) (objectstore.UUID, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	return store.PutAndCheckHash(ctx, obj.Path, f, obj.Size, obj.SHA384)
+}
@@ -0,0 +1,254 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/backup"
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+type modelSuite struct{}
+
+func TestModelSuite(t *testing.T) {
+	tc.Run(t, &modelSuite{})
+}
+
+type fakeModelExporter struct {
+	snapshot backup.ModelSnapshot
+	dump     string
+}
+
+func (e fakeModelExporter) ExportModel(_ context.Context, w io.Writer) (backup.ModelSnapshot, error) {
+	_, err := io.WriteString(w, e.dump)
+	return e.snapshot, err
+}
+
+var modelExportTime = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+type fakeModelImporter struct {
+	checkErr  error
+	importErr error
+
+	snapshot    backup.ModelSnapshot
+	dump        string
+	objectUUIDs map[objectstore.UUID]objectstore.UUID
+}
+
+func (i *fakeModelImporter) CheckModelImport(context.Context, backup.ModelSnapshot) error {
+	return i.checkErr
+}
+
+func (i *fakeModelImporter) ImportModel(
+	_ context.Context, snapshot backup.ModelSnapshot, dump io.ReadSeeker, objectUUIDs map[objectstore.UUID]objectstore.UUID,
+) error {
+	data, err := io.ReadAll(dump)
+	if err != nil {
+		return err
+	}
+	i.snapshot = snapshot
+	i.dump = string(data)
+	i.objectUUIDs = objectUUIDs
+	return i.importErr
+}
+
+type fakeObjectStore struct {
+	objectstore.ObjectStore
+	objects map[string][]byte
+}
+
+func (s *fakeObjectStore) Get(_ context.Context, path string) (io.ReadCloser, int64, error) {
+	data, ok := s.objects[path]
+	if !ok {
+		return nil, -1, coreerrors.NotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+func (s *fakeObjectStore) PutAndCheckHash(_ context.Context, path string, r io.Reader, size int64, sha384 string) (objectstore.UUID, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	if int64(len(data)) != size {
+		return "", errors.Errorf("size mismatch")
+	}
+	if sum := sha512.Sum384(data); hex.EncodeToString(sum[:]) != sha384 {
+		return "", errors.Errorf("hash mismatch")
+	}
+	s.objects[path] = data
+	return objectstore.UUID("new-" + path), nil
+}
+
+func (s *fakeObjectStore) Remove(_ context.Context, path string) error {
+	delete(s.objects, path)
+	return nil
+}
+
+func (s *modelSuite) export(c *tc.C) ([]byte, backup.ModelSnapshot) {
+	object := []byte("charm archive")
+	sum256 := sha256.Sum256(object)
+	sum384 := sha512.Sum384(object)
+
+	snapshot := backup.ModelSnapshot{
+		ModelUUID:     "model-uuid",
+		SchemaVersion: 42,
+		SchemaHash:    "hash",
+		Objects: []backup.Object{{
+			UUID:   "object-uuid",
+			Path:   "charms/foo",
+			SHA256: hex.EncodeToString(sum256[:]),
+			SHA384: hex.EncodeToString(sum384[:]),
+			Size:   int64(len(object)),
+		}},
+	}
+	store := &fakeObjectStore{objects: map[string][]byte{"charms/foo": object}}
+
+	var buf bytes.Buffer
+	err := ExportModel(c.Context(), fakeModelExporter{snapshot: snapshot, dump: "model dump\n"}, store, testclock.NewClock(modelExportTime), &buf)
+	c.Assert(err, tc.ErrorIsNil)
+	return buf.Bytes(), snapshot
+}
+
+func (s *modelSuite) TestExportAndImport(c *tc.C) {
+	archive, snapshot := s.export(c)
+
+	importer := &fakeModelImporter{}
+	store := &fakeObjectStore{objects: make(map[string][]byte)}
+	err := ImportModel(c.Context(), importer, store, bytes.NewReader(archive))
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(importer.snapshot, tc.DeepEquals, snapshot)
+	c.Check(importer.dump, tc.Equals, "model dump\n")
+	c.Check(importer.objectUUIDs, tc.DeepEquals, map[objectstore.UUID]objectstore.UUID{
+		"object-uuid": "new-charms/foo",
+	})
+	c.Check(string(store.objects["charms/foo"]), tc.Equals, "charm archive")
+}
+
+func (s *modelSuite) TestExportAndImportObjectWithManyPaths(c *tc.C) {
+	object := []byte("resource blob")
+	sum256 := sha256.Sum256(object)
+	sum384 := sha512.Sum384(object)
+	obj := backup.Object{
+		UUID:   "object-uuid",
+		Path:   "resources/bar",
+		SHA256: hex.EncodeToString(sum256[:]),
+		SHA384: hex.EncodeToString(sum384[:]),
+		Size:   int64(len(object)),
+	}
+	other := obj
+	other.Path = "resources/foo"
+	snapshot := backup.ModelSnapshot{
+		ModelUUID: "model-uuid",
+		Objects:   []backup.Object{obj, other},
+	}
+	store := &fakeObjectStore{objects: map[string][]byte{
+		"resources/bar": object,
+		"resources/foo": object,
+	}}
+
+	var buf bytes.Buffer
+	err := ExportModel(c.Context(), fakeModelExporter{snapshot: snapshot, dump: "model dump\n"}, store, testclock.NewClock(modelExportTime), &buf)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The object's contents are only in the archive once.
+	gzr, err := gzip.NewReader(bytes.NewReader(buf.Bytes()))
+	c.Assert(err, tc.ErrorIsNil)
+	var names []string
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, tc.ErrorIsNil)
+		names = append(names, hdr.Name)
+	}
+	c.Check(names, tc.DeepEquals, []string{modelMetadataFile, modelDumpFile, modelObjectsDir + "/" + obj.SHA384})
+
+	importer := &fakeModelImporter{}
+	store = &fakeObjectStore{objects: make(map[string][]byte)}
+	err = ImportModel(c.Context(), importer, store, bytes.NewReader(buf.Bytes()))
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(importer.objectUUIDs, tc.DeepEquals, map[objectstore.UUID]objectstore.UUID{
+		"object-uuid": "new-resources/bar",
+	})
+	c.Check(string(store.objects["resources/bar"]), tc.Equals, "resource blob")
+	c.Check(string(store.objects["resources/foo"]), tc.Equals, "resource blob")
+}
+
+func (s *modelSuite) TestImportIncompatible(c *tc.C) {
+	archive, _ := s.export(c)
+
+	importer := &fakeModelImporter{checkErr: backuperrors.IncompatibleSchema}
+	store := &fakeObjectStore{objects: make(map[string][]byte)}
+	err := ImportModel(c.Context(), importer, store, bytes.NewReader(archive))
+	c.Assert(err, tc.ErrorIs, backuperrors.IncompatibleSchema)
+	c.Check(store.objects, tc.HasLen, 0)
+}
+
+func (s *modelSuite) TestImportFailureRemovesObjects(c *tc.C) {
+	archive, _ := s.export(c)
+
+	importer := &fakeModelImporter{importErr: errors.New("boom")}
+	store := &fakeObjectStore{objects: map[string][]byte{"charms/bar": []byte("existing")}}
+	err := ImportModel(c.Context(), importer, store, bytes.NewReader(archive))
+	c.Assert(err, tc.ErrorMatches, "boom")
+	c.Check(store.objects, tc.DeepEquals, map[string][]byte{"charms/bar": []byte("existing")})
+}
+
+func (s *modelSuite) TestExportUsesClock(c *tc.C) {
+	archive, _ := s.export(c)
+
+	gzr, err := gzip.NewReader(bytes.NewReader(archive))
+	c.Assert(err, tc.ErrorIsNil)
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(hdr.ModTime.Equal(modelExportTime), tc.IsTrue, tc.Commentf("%s modified at %v", hdr.Name, hdr.ModTime))
+		if hdr.Name == modelMetadataFile {
+			var meta modelMetadata
+			c.Assert(json.NewDecoder(tr).Decode(&meta), tc.ErrorIsNil)
+			c.Check(meta.Created, tc.Equals, modelExportTime)
+		}
+	}
+}
+
+func (s *modelSuite) TestExportMissingObject(c *tc.C) {
+	snapshot := backup.ModelSnapshot{
+		Objects: []backup.Object{{Path: "charms/foo"}},
+	}
+	store := &fakeObjectStore{objects: make(map[string][]byte)}
+	err := ExportModel(c.Context(), fakeModelExporter{snapshot: snapshot}, store, clock.WallClock, io.Discard)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *modelSuite) TestImportNotArchive(c *tc.C) {
+	err := ImportModel(c.Context(), &fakeModelImporter{}, &fakeObjectStore{}, bytes.NewReader([]byte("not an archive")))
+	c.Assert(err, tc.ErrorMatches, `unpacking model snapshot: .*`)
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	corebackups "github.com/juju/juju/core/backups"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// Archive is a backup archive unpacked into a temporary workspace, ready to
+// be restored.
+type Archive struct {
+	ws   *corebackups.ArchiveWorkspace
+	meta *corebackups.Metadata
+}
+
+// OpenArchive unpacks the backup archive read from the reader and verifies
+// the checksum of every file in it. The returned archive must be closed to
+// remove the workspace.
+func OpenArchive(r io.Reader) (_ *Archive, err error) {
+	ws, err := corebackups.NewArchiveWorkspaceReader(r)
+	if ws != nil {
+		defer func() {
+			if err != nil {
+				_ = ws.Close()
+			}
+		}()
+	}
+	if err != nil {
+		return nil, errors.Errorf("unpacking backup archive: %w", err)
+	}
+
+	meta, err := ws.Metadata()
+	if err != nil {
+		return nil, errors.Errorf("reading backup metadata: %w", err)
+	}
+	if len(meta.Databases) == 0 {
+		return nil, errors.Errorf("backup without Dqlite databases %w", coreerrors.NotSupported)
+	}
+
+	archive := &Archive{ws: ws, meta: meta}
+	if err := archive.verify(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return archive, nil
+}
+
+// verify checks that every database dump is present in the archive, and that
+// the checksum of each file in the archive matches the metadata.
+func (a *Archive) verify() error {
+	paths := corebackups.NewCanonicalArchivePaths()
+	for _, namespace := range a.meta.Databases {
+		name := path.Join(paths.DBDumpDir, namespace+dumpExtension)
+		if _, ok := a.meta.Checksums[name]; !ok {
+			return errors.Errorf("missing checksum for database %q", namespace)
+		}
+	}
+	if _, ok := a.meta.Checksums[paths.FilesBundle]; !ok {
+		return errors.Errorf("missing checksum for %q", paths.FilesBundle)
+	}
+
+	for name, expected := range a.meta.Checksums {
+		if !strings.HasPrefix(name, paths.ContentDir+"/") || strings.Contains(name, "..") {
+			return errors.Errorf("unexpected file %q in backup metadata", name)
+		}
+		actual, err := checksumFile(filepath.Join(a.ws.RootDir, filepath.FromSlash(name)))
+		if err != nil {
+			return errors.Errorf("verifying %q: %w", name, err)
+		}
+		if actual != expected {
+			return errors.Errorf("checksum mismatch for %q: expected %s, got %s", name, expected, actual)
+		}
+	}
+	return nil
+}
+
+func checksumFile(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return "", errors.Capture(err)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// Metadata returns the metadata of the backup.
+func (a *Archive) Metadata() *corebackups.Metadata {
+	return a.meta
+}
+
+// DatabaseDumps returns the dump of each database in the archive.
+func (a *Archive) DatabaseDumps() []database.DatabaseDump {
+	dumps := make([]database.DatabaseDump, len(a.meta.Databases))
+	for i, namespace := range a.meta.Databases {
+		name := filepath.Join(a.ws.DBDumpDir, namespace+dumpExtension)
+		dumps[i] = database.DatabaseDump{
+			Namespace: namespace,
+			Open: func() (io.ReadCloser, error) {
+				return os.Open(name)
+			},
+		}
+	}
+	return dumps
+}
+
+// RestoreFiles unpacks the archived object store into the data directory.
+func (a *Archive) RestoreFiles(dataDir string) error {
+	if err := a.ws.UnpackFilesBundle(dataDir); err != nil {
+		return errors.Errorf("restoring object store: %w", err)
+	}
+	return nil
+}
+
+// Close removes the workspace into which the archive was unpacked.
+func (a *Archive) Close() error {
+	return a.ws.Close()
+}
@@ -4,6 +4,7 @@
 package eventmultiplexer
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -234,6 +235,14 @@ func (s stream) Dying() <-chan struct{} {
 	return s.dying
 }
 
+func (s stream) ReplayChanges(context.Context, int64, int64) ([]changestream.ChangeEvent, error) {
+	return nil, nil
+}
+
+func (s stream) LatestChangeLogID(context.Context) (int64, error) {
+	return -1, nil
+}
+
 type term struct {
 	changes ChangeSet
 }
@@ -31,6 +31,15 @@ type Stream interface {
 
 	// Dying returns a channel that is closed when the stream is dying.
 	Dying() <-chan struct{}
+
+	// ReplayChanges returns all the changes after the from change log ID, up
+	// to and including the to change log ID. If the change log has been
+	// pruned beyond the from ID, then ErrChangeLogPruned is returned.
+	ReplayChanges(ctx context.Context, from, to int64) ([]changestream.ChangeEvent, error)
+
+	// LatestChangeLogID returns the highest change log ID in the change
+	// log, or -1 if the change log is empty.
+	LatestChangeLogID(ctx context.Context) (int64, error)
 }
 
 // MetricsCollector represents the metrics methods called.
@@ -71,6 +80,11 @@ type EventMultiplexer struct {
 	subscriptionsCount uint64
 	dispatchErrorCount int
 
+	// lastChangeLogID is the highest change log ID that has been seen in a
+	// term. Resumed subscriptions replay changes up to this ID, after which
+	// the live terms take over.
+	lastChangeLogID int64
+
 	// subscriptionCh is a channel used to request new subscriptions.
 	// This is used to sync subscription additions into the loop.
 	subscriptionCh chan requestSubscription
@@ -90,6 +104,7 @@ func New(stream Stream, clock clock.Clock, metrics MetricsCollector, logger logg
 		subscriptionsAll:   make(map[uint64]struct{}),
 		subscriptionsCount: 0,
 		dispatchErrorCount: 0,
+		lastChangeLogID:    -1,
 
 		subscriptionCh: make(chan requestSubscription),
 
@@ -128,6 +143,54 @@ func (e *EventMultiplexer) Subscribe(opts ...changestream.SubscriptionOption) (c
 	}
 }
 
+// SubscribeFrom creates a new subscription to the event queue, that first
+// replays all the changes after the given change log ID, before receiving
+// live events. Options can be provided to allow filter during both the replay
+// and the dispatching phase. If the change log has been pruned beyond the
+// given ID, then database.ErrChangeLogPruned is returned.
+//
+// The replayed changes are read on the caller's goroutine and dispatched on
+// the subscription's own goroutine, so that a replay never stalls the live
+// dispatching of other subscriptions.
+func (e *EventMultiplexer) SubscribeFrom(changeLogID int64, opts ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	result := make(chan requestSubscriptionResult)
+	select {
+	case <-e.catacomb.Dying():
+		return nil, database.ErrEventMultiplexerDying
+	case e.subscriptionCh <- requestSubscription{
+		opts:   opts,
+		resume: true,
+		from:   changeLogID,
+		result: result,
+	}:
+	}
+
+	var res requestSubscriptionResult
+	select {
+	case <-e.catacomb.Dying():
+		return nil, database.ErrEventMultiplexerDying
+	case res = <-result:
+		if res.err != nil {
+			return nil, errors.Trace(res.err)
+		}
+	}
+
+	ctx, cancel := e.scopedContext()
+	defer cancel()
+
+	// Any live changes dispatched to the subscription whilst the replay is
+	// being read are held by the subscription, until the replay has been
+	// delivered.
+	replay, err := e.replayChanges(ctx, changeLogID, res.upTo, opts)
+	if err != nil {
+		res.sub.Kill()
+		return nil, errors.Trace(err)
+	}
+	res.sub.replay(replay)
+
+	return res.sub, nil
+}
+
 // Kill stops the event queue.
 func (e *EventMultiplexer) Kill() {
 	e.catacomb.Kill(nil)
@@ -186,6 +249,15 @@ func (e *EventMultiplexer) loop() error {
 		e.subscriptionsAll = nil
 	}()
 
+	// Seed the last change log ID from the change log, so that subscriptions
+	// resumed after a restart replay the changes they missed, before the
+	// first live term has been witnessed.
+	lastChangeLogID, err := e.stream.LatestChangeLogID(ctx)
+	if err != nil {
+		return errors.Annotate(err, "getting latest change log ID")
+	}
+	e.lastChangeLogID = max(e.lastChangeLogID, lastChangeLogID)
+
 	for {
 		e.cleanupDeadSubscriptions(ctx)
 
@@ -210,12 +282,19 @@ func (e *EventMultiplexer) loop() error {
 
 			changeSet := make(map[*subscription]ChangeSet)
 			for _, change := range term.Changes() {
+				e.recordChangeLogID(change)
+
 				subs := e.gatherSubscriptions(ctx, change)
 				if len(subs) == 0 {
 					continue
 				}
 
 				for _, sub := range subs {
+					// Skip any changes that a resumed subscription has
+					// already witnessed.
+					if sub.witnessed(change) {
+						continue
+					}
 					changeSet[sub] = append(changeSet[sub], change)
 				}
 			}
@@ -244,6 +323,15 @@ func (e *EventMultiplexer) loop() error {
 		case request := <-e.subscriptionCh:
 			sub := newSubscription(atomic.AddUint64(&e.subscriptionsCount, 1))
 
+			// Resumed subscriptions replay everything up to the last change
+			// log ID, so the stream re-reading the change log after a
+			// restart doesn't dispatch those changes again. Live changes
+			// are held by the subscription until the replay is delivered.
+			upTo := e.lastChangeLogID
+			if request.resume {
+				sub.resume(max(request.from, upTo))
+			}
+
 			if err := e.catacomb.Add(sub); err != nil {
 				sub.Kill()
 
@@ -285,7 +373,7 @@ func (e *EventMultiplexer) loop() error {
 			select {
 			case <-e.catacomb.Dying():
 				return e.catacomb.ErrDying()
-			case request.result <- requestSubscriptionResult{sub: sub}:
+			case request.result <- requestSubscriptionResult{sub: sub, upTo: upTo}:
 			}
 
 		case r := <-e.reportsCh:
@@ -303,6 +391,52 @@ func (e *EventMultiplexer) loop() error {
 	}
 }
 
+// recordChangeLogID records the highest change log ID witnessed, if the change
+// carries one.
+func (e *EventMultiplexer) recordChangeLogID(change changestream.ChangeEvent) {
+	identified, ok := change.(changestream.IdentifiedChangeEvent)
+	if !ok {
+		return
+	}
+	if id := identified.ID(); id > e.lastChangeLogID {
+		e.lastChangeLogID = id
+	}
+}
+
+// replayChanges returns the changes after the from change log ID, up to the
+// last change log ID that had been witnessed by the event multiplexer when the
+// subscription was created, filtered by the subscription options. Any changes
+// after that will be dispatched as part of the live terms.
+func (e *EventMultiplexer) replayChanges(ctx context.Context, from, upTo int64, opts []changestream.SubscriptionOption) (ChangeSet, error) {
+	if from >= upTo {
+		return nil, nil
+	}
+
+	changes, err := e.stream.ReplayChanges(ctx, from, upTo)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// No options were supplied, so every change is replayed.
+	if len(opts) == 0 {
+		return changes, nil
+	}
+
+	var replay ChangeSet
+	for _, change := range changes {
+		for _, opt := range opts {
+			if opt.Namespace() != change.Namespace() ||
+				(change.Type()&opt.ChangeMask()) == 0 ||
+				!opt.Filter()(change) {
+				continue
+			}
+			replay = append(replay, change)
+			break
+		}
+	}
+	return replay, nil
+}
+
 type reporter interface {
 	Report() map[string]interface{}
 }
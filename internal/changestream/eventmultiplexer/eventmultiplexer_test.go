@@ -45,6 +45,7 @@ func (s *eventMultiplexerSuite) TestSubscribe(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).AnyTimes()
+	s.expectLatestChangeLogID(-1)
 
 	s.metrics.EXPECT().SubscriptionsInc()
 
@@ -70,6 +71,7 @@ func (s *eventMultiplexerSuite) TestDispatch(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
@@ -144,6 +146,7 @@ func (s *eventMultiplexerSuite) testMultipleDispatch(c *tc.C, opts ...changestre
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	s.metrics.EXPECT().SubscriptionsInc().Times(10)
 	// There is a race between loop select completion and worker Kill.
@@ -214,6 +217,7 @@ func (s *eventMultiplexerSuite) TestTopicDoesNotMatch(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	s.metrics.EXPECT().SubscriptionsInc()
 
@@ -252,6 +256,7 @@ func (s *eventMultiplexerSuite) TestTopicMatchesOne(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	s.metrics.EXPECT().SubscriptionsInc().Times(2)
 	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), false)
@@ -302,6 +307,7 @@ func (s *eventMultiplexerSuite) TestSubscriptionDoneWhenEventQueueKilled(c *tc.C
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	s.metrics.EXPECT().SubscriptionsInc()
 	s.metrics.EXPECT().SubscriptionsDec()
@@ -350,6 +356,7 @@ func (s *eventMultiplexerSuite) TestUnsubscribeOfOtherSubscription(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	s.metrics.EXPECT().SubscriptionsInc().Times(2)
 	s.metrics.EXPECT().SubscriptionsDec().Times(2)
@@ -421,6 +428,7 @@ func (s *eventMultiplexerSuite) TestUnsubscribeOfOtherSubscriptionInAnotherGorou
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	s.metrics.EXPECT().SubscriptionsInc().Times(2)
 	s.metrics.EXPECT().SubscriptionsDec().Times(2)
@@ -495,6 +503,7 @@ func (s *eventMultiplexerSuite) TestUnsubscribeOnDispatchTimeout(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	s.metrics.EXPECT().SubscriptionsInc()
 
@@ -543,6 +552,7 @@ func (s *eventMultiplexerSuite) TestStreamDying(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	s.metrics.EXPECT().SubscriptionsInc().Times(2)
 	s.clock.EXPECT().Now().MinTimes(2)
@@ -613,6 +623,7 @@ func (s *eventMultiplexerSuite) TestStreamDyingWhilstDispatching(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	s.metrics.EXPECT().SubscriptionsInc().Times(2)
 	s.clock.EXPECT().Now().MinTimes(1)
@@ -694,6 +705,7 @@ func (s *eventMultiplexerSuite) TestStreamDyingOnStartup(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
@@ -712,6 +724,7 @@ func (s *eventMultiplexerSuite) TestStreamDyingOnSubscribe(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 
 	// We don't care for the metrics recording here, as we might not
 	// have recorded the metrics in time before dying.
@@ -734,6 +747,190 @@ func (s *eventMultiplexerSuite) TestStreamDyingOnSubscribe(c *tc.C) {
 	c.Check(sub, tc.IsNil)
 }
 
+func (s *eventMultiplexerSuite) TestSubscribeFromReplaysChanges(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
+
+	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	// Witness a term without any subscriptions, so that the multiplexer
+	// knows the last change log ID.
+	s.expectEmptyTerm(c, identifiedChangeEvent{
+		changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "topic", changed: "1"},
+		id:          1,
+	}, identifiedChangeEvent{
+		changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "other", changed: "2"},
+		id:          2,
+	}, identifiedChangeEvent{
+		changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "topic", changed: "3"},
+		id:          3,
+	})
+	<-s.dispatchTerm(c, terms)
+
+	s.stream.EXPECT().ReplayChanges(gomock.Any(), int64(1), int64(3)).Return([]changestream.ChangeEvent{
+		identifiedChangeEvent{
+			changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "other", changed: "2"},
+			id:          2,
+		},
+		identifiedChangeEvent{
+			changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "topic", changed: "3"},
+			id:          3,
+		},
+	}, nil)
+	s.metrics.EXPECT().SubscriptionsInc()
+	s.metrics.EXPECT().SubscriptionsDec().MaxTimes(1)
+
+	sub, err := queue.SubscribeFrom(1, changestream.Namespace("topic", changestreamtesting.Create))
+	c.Assert(err, tc.ErrorIsNil)
+
+	var changes []changestream.ChangeEvent
+	select {
+	case changes = <-sub.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for event")
+	}
+
+	c.Assert(changes, tc.HasLen, 1)
+	c.Check(changes[0].Namespace(), tc.Equals, "topic")
+	c.Check(changes[0].Changed(), tc.Equals, "3")
+}
+
+func (s *eventMultiplexerSuite) TestSubscribeFromSkipsWitnessedChanges(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
+
+	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	s.metrics.EXPECT().SubscriptionsInc()
+	s.metrics.EXPECT().SubscriptionsDec().MaxTimes(1)
+	s.clock.EXPECT().Now().MinTimes(1)
+	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), false)
+
+	// The multiplexer hasn't witnessed any changes yet, so there is nothing
+	// to replay. The live term will contain the changes instead.
+	sub, err := queue.SubscribeFrom(1, changestream.Namespace("topic", changestreamtesting.Create))
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.expectTerm(c, identifiedChangeEvent{
+		changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "topic", changed: "1"},
+		id:          1,
+	}, identifiedChangeEvent{
+		changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "topic", changed: "2"},
+		id:          2,
+	})
+	s.dispatchTerm(c, terms)
+
+	var changes []changestream.ChangeEvent
+	select {
+	case changes = <-sub.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for event")
+	}
+
+	c.Assert(changes, tc.HasLen, 1)
+	c.Check(changes[0].Changed(), tc.Equals, "2")
+}
+
+func (s *eventMultiplexerSuite) TestSubscribeFromAfterRestart(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(3)
+
+	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	// No terms have been witnessed since the restart, but the changes up to
+	// the latest change log ID are still replayed.
+	s.stream.EXPECT().ReplayChanges(gomock.Any(), int64(1), int64(3)).Return([]changestream.ChangeEvent{
+		identifiedChangeEvent{
+			changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "topic", changed: "3"},
+			id:          3,
+		},
+	}, nil)
+	s.metrics.EXPECT().SubscriptionsInc()
+	s.metrics.EXPECT().SubscriptionsDec().MaxTimes(1)
+	s.clock.EXPECT().Now().MinTimes(1)
+	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), false)
+
+	sub, err := queue.SubscribeFrom(1, changestream.Namespace("topic", changestreamtesting.Create))
+	c.Assert(err, tc.ErrorIsNil)
+
+	var changes []changestream.ChangeEvent
+	select {
+	case changes = <-sub.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for event")
+	}
+	c.Assert(changes, tc.HasLen, 1)
+	c.Check(changes[0].Changed(), tc.Equals, "3")
+
+	// The stream reads the change log from the start after a restart, so
+	// the replayed change isn't dispatched again.
+	s.expectTerm(c, identifiedChangeEvent{
+		changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "topic", changed: "3"},
+		id:          3,
+	}, identifiedChangeEvent{
+		changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "topic", changed: "4"},
+		id:          4,
+	})
+	s.dispatchTerm(c, terms)
+
+	select {
+	case changes = <-sub.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for event")
+	}
+	c.Assert(changes, tc.HasLen, 1)
+	c.Check(changes[0].Changed(), tc.Equals, "4")
+}
+
+func (s *eventMultiplexerSuite) TestSubscribeFromPruned(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
+
+	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	s.expectEmptyTerm(c, identifiedChangeEvent{
+		changeEvent: changeEvent{ctype: changestreamtesting.Create, ns: "topic", changed: "1"},
+		id:          10,
+	})
+	<-s.dispatchTerm(c, terms)
+
+	s.stream.EXPECT().ReplayChanges(gomock.Any(), int64(1), int64(10)).Return(nil, database.ErrChangeLogPruned)
+	s.metrics.EXPECT().SubscriptionsInc()
+	s.metrics.EXPECT().SubscriptionsDec().MaxTimes(1)
+
+	sub, err := queue.SubscribeFrom(1, changestream.Namespace("topic", changestreamtesting.Create))
+	c.Assert(err, tc.ErrorIs, database.ErrChangeLogPruned)
+	c.Check(sub, tc.IsNil)
+}
+
 func (s *eventMultiplexerSuite) TestReportWithAllSubscriptions(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -742,6 +939,7 @@ func (s *eventMultiplexerSuite) TestReportWithAllSubscriptions(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 	s.clock.EXPECT().Now().AnyTimes()
 
 	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), gomock.Any()).AnyTimes()
@@ -791,6 +989,7 @@ func (s *eventMultiplexerSuite) TestReportWithTopicSubscriptions(c *tc.C) {
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 	s.clock.EXPECT().Now().AnyTimes()
 
 	s.metrics.EXPECT().SubscriptionsInc().Times(10)
@@ -825,6 +1024,7 @@ func (s *eventMultiplexerSuite) TestReportWithMultipleTopicSubscriptions(c *tc.C
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 	s.clock.EXPECT().Now().AnyTimes()
 
 	s.metrics.EXPECT().SubscriptionsInc().Times(10)
@@ -862,6 +1062,7 @@ func (s *eventMultiplexerSuite) TestReportWithDuplicateTopicSubscriptions(c *tc.
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 	s.clock.EXPECT().Now().AnyTimes()
 
 	s.metrics.EXPECT().SubscriptionsInc().Times(10)
@@ -899,6 +1100,7 @@ func (s *eventMultiplexerSuite) TestReportWithMultipleDuplicateTopicSubscription
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 	s.clock.EXPECT().Now().AnyTimes()
 
 	s.metrics.EXPECT().SubscriptionsInc().Times(10)
@@ -936,6 +1138,7 @@ func (s *eventMultiplexerSuite) TestReportWithTopicRemovalAfterUnsubscribe(c *tc
 
 	terms := make(chan changestream.Term)
 	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+	s.expectLatestChangeLogID(-1)
 	s.clock.EXPECT().Now().AnyTimes()
 
 	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), gomock.Any()).AnyTimes()
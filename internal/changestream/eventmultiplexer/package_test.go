@@ -44,6 +44,10 @@ func (s *baseSuite) expectStreamDying(ch <-chan struct{}) {
 	s.stream.EXPECT().Dying().Return(ch).AnyTimes()
 }
 
+func (s *baseSuite) expectLatestChangeLogID(id int64) {
+	s.stream.EXPECT().LatestChangeLogID(gomock.Any()).Return(id, nil)
+}
+
 func (s *baseSuite) expectAfter() {
 	s.clock.EXPECT().After(gomock.Any()).AnyTimes()
 }
@@ -102,6 +106,16 @@ func (c changeEvent) Changed() string {
 	return c.changed
 }
 
+type identifiedChangeEvent struct {
+	changeEvent
+	id int64
+}
+
+// ID returns the change log ID of the change.
+func (c identifiedChangeEvent) ID() int64 {
+	return c.id
+}
+
 type waitGroup struct {
 	ch            chan struct{}
 	state, amount uint64
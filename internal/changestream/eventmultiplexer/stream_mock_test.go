@@ -10,6 +10,7 @@
 package eventmultiplexer
 
 import (
+	context "context"
 	reflect "reflect"
 
 	changestream "github.com/juju/juju/core/changestream"
@@ -77,6 +78,84 @@ func (c *MockStreamDyingCall) DoAndReturn(f func() <-chan struct{}) *MockStreamD
 	return c
 }
 
+// LatestChangeLogID mocks base method.
+func (m *MockStream) LatestChangeLogID(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestChangeLogID", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestChangeLogID indicates an expected call of LatestChangeLogID.
+func (mr *MockStreamMockRecorder) LatestChangeLogID(arg0 any) *MockStreamLatestChangeLogIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestChangeLogID", reflect.TypeOf((*MockStream)(nil).LatestChangeLogID), arg0)
+	return &MockStreamLatestChangeLogIDCall{Call: call}
+}
+
+// MockStreamLatestChangeLogIDCall wrap *gomock.Call
+type MockStreamLatestChangeLogIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStreamLatestChangeLogIDCall) Return(arg0 int64, arg1 error) *MockStreamLatestChangeLogIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStreamLatestChangeLogIDCall) Do(f func(context.Context) (int64, error)) *MockStreamLatestChangeLogIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStreamLatestChangeLogIDCall) DoAndReturn(f func(context.Context) (int64, error)) *MockStreamLatestChangeLogIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReplayChanges mocks base method.
+func (m *MockStream) ReplayChanges(arg0 context.Context, arg1, arg2 int64) ([]changestream.ChangeEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayChanges", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]changestream.ChangeEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayChanges indicates an expected call of ReplayChanges.
+func (mr *MockStreamMockRecorder) ReplayChanges(arg0, arg1, arg2 any) *MockStreamReplayChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayChanges", reflect.TypeOf((*MockStream)(nil).ReplayChanges), arg0, arg1, arg2)
+	return &MockStreamReplayChangesCall{Call: call}
+}
+
+// MockStreamReplayChangesCall wrap *gomock.Call
+type MockStreamReplayChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStreamReplayChangesCall) Return(arg0 []changestream.ChangeEvent, arg1 error) *MockStreamReplayChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStreamReplayChangesCall) Do(f func(context.Context, int64, int64) ([]changestream.ChangeEvent, error)) *MockStreamReplayChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStreamReplayChangesCall) DoAndReturn(f func(context.Context, int64, int64) ([]changestream.ChangeEvent, error)) *MockStreamReplayChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Terms mocks base method.
 func (m *MockStream) Terms() <-chan changestream.Term {
 	m.ctrl.T.Helper()
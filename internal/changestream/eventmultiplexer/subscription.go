@@ -5,8 +5,10 @@ package eventmultiplexer
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	"github.com/juju/errors"
 	"gopkg.in/tomb.v2"
 
 	"github.com/juju/juju/core/changestream"
@@ -18,16 +20,31 @@ const (
 	// Failure to consume the changes within this time will result in the
 	// subscriber being unsubscribed.
 	DefaultSignalTimeout = time.Second * 10
+
+	// maxPendingReplaySets is the maximum number of live change sets that
+	// are held by a subscription whilst its replay is being delivered. A
+	// subscription that falls further behind than this is killed.
+	maxPendingReplaySets = 1024
 )
 
 type requestSubscription struct {
-	opts   []changestream.SubscriptionOption
+	opts []changestream.SubscriptionOption
+
+	// resume indicates that the subscription should replay all the changes
+	// after the from change log ID.
+	resume bool
+	from   int64
+
 	result chan requestSubscriptionResult
 }
 
 type requestSubscriptionResult struct {
 	sub *subscription
 	err error
+
+	// upTo is the change log ID that a resumed subscription must replay up
+	// to, after which the live changes take over.
+	upTo int64
 }
 
 // subscription represents a subscriber in the event queue. It holds a tomb,
@@ -39,6 +56,21 @@ type subscription struct {
 	topics  map[string]struct{}
 	changes chan ChangeSet
 
+	// from is the change log ID that a resumed subscription was started
+	// from. Any change at or below this ID has already been witnessed.
+	from int64
+
+	// replays receives the replayed changes of a resumed subscription,
+	// once they have been read.
+	replays chan ChangeSet
+
+	// mutex guards replaying and pending, which hold the live changes that
+	// are dispatched to a resumed subscription before its replay has been
+	// delivered.
+	mutex     sync.Mutex
+	replaying bool
+	pending   []ChangeSet
+
 	dispatchTimeout time.Duration
 }
 
@@ -47,6 +79,8 @@ func newSubscription(id uint64) *subscription {
 		id:              id,
 		changes:         make(chan ChangeSet),
 		topics:          make(map[string]struct{}),
+		replays:         make(chan ChangeSet, 1),
+		from:            -1,
 		dispatchTimeout: DefaultSignalTimeout,
 	}
 
@@ -77,12 +111,112 @@ func (s *subscription) Wait() error {
 	return s.tomb.Wait()
 }
 
+// witnessed returns true if the change has already been witnessed by a
+// resumed subscription.
+func (s *subscription) witnessed(change changestream.ChangeEvent) bool {
+	identified, ok := change.(changestream.IdentifiedChangeEvent)
+	if !ok {
+		return false
+	}
+	return identified.ID() <= s.from
+}
+
 func (s *subscription) loop() error {
 	<-s.tomb.Dying()
 	return tomb.ErrDying
 }
 
+// resume starts the delivery of the replay of a subscription resumed from
+// the given change log ID. Live changes dispatched to the subscription are
+// held until the replay has been delivered. It must be called before the
+// subscription is handed out, whilst its tomb is known to be alive.
+func (s *subscription) resume(from int64) {
+	s.from = from
+
+	s.mutex.Lock()
+	s.replaying = true
+	s.mutex.Unlock()
+
+	s.tomb.Go(s.replayLoop)
+}
+
+// replay hands the replayed changes to the subscription, to be delivered on
+// its own goroutine. If the subscription is already dying, the replay is
+// dropped.
+func (s *subscription) replay(changes ChangeSet) {
+	select {
+	case s.replays <- changes:
+	case <-s.tomb.Dying():
+	}
+}
+
+// replayLoop delivers the replayed changes, followed by any live changes that
+// were held whilst the replay was being read. If the changes can't be
+// delivered, the subscription is killed with the error, which is reported to
+// the subscriber by Wait.
+func (s *subscription) replayLoop() error {
+	ctx := s.tomb.Context(context.Background())
+
+	var changes ChangeSet
+	select {
+	case <-s.tomb.Dying():
+		return tomb.ErrDying
+	case changes = <-s.replays:
+	}
+
+	if len(changes) > 0 {
+		if err := s.send(ctx, changes); err != nil {
+			return replayError(err, "dispatching replay")
+		}
+	}
+
+	for {
+		s.mutex.Lock()
+		if len(s.pending) == 0 {
+			s.replaying = false
+			s.mutex.Unlock()
+			return nil
+		}
+		next := s.pending[0]
+		s.pending = s.pending[1:]
+		s.mutex.Unlock()
+
+		if err := s.send(ctx, next); err != nil {
+			return replayError(err, "dispatching changes held during replay")
+		}
+	}
+}
+
+// replayError returns the error that kills a subscription that failed to
+// deliver its replay. Only a subscriber that didn't consume the changes in
+// time is reported, as any other failure means the subscription is already
+// dying.
+func replayError(err error, msg string) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errors.Annotate(err, msg)
+	}
+	return tomb.ErrDying
+}
+
 func (s *subscription) dispatch(ctx context.Context, changes ChangeSet) error {
+	s.mutex.Lock()
+	if s.replaying {
+		defer s.mutex.Unlock()
+
+		if len(s.pending) >= maxPendingReplaySets {
+			err := errors.Errorf("subscription %d fell behind whilst replaying", s.id)
+			s.tomb.Kill(err)
+			return err
+		}
+		s.pending = append(s.pending, changes)
+		return nil
+	}
+	s.mutex.Unlock()
+
+	return s.send(ctx, changes)
+}
+
+func (s *subscription) send(ctx context.Context, changes ChangeSet) error {
 	ctx, cancel := context.WithTimeout(ctx, s.dispatchTimeout)
 	defer cancel()
 
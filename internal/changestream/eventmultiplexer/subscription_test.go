@@ -184,3 +184,114 @@ func (s *subscriptionSuite) TestSubscriptionDoesNotWitnessChangesWithDying(c *tc
 
 	workertest.CleanKill(c, sub)
 }
+
+func (s *subscriptionSuite) TestSubscriptionHoldsChangesWhilstReplaying(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sub := newSubscription(0)
+	sub.resume(-1)
+	defer workertest.DirtyKill(c, sub)
+
+	live := ChangeSet{changeEvent{
+		ctype:   changestreamtesting.Create,
+		ns:      "foo",
+		changed: "2",
+	}}
+	replay := ChangeSet{changeEvent{
+		ctype:   changestreamtesting.Create,
+		ns:      "foo",
+		changed: "1",
+	}}
+
+	// The live changes are held, so the dispatch doesn't block.
+	err := sub.dispatch(c.Context(), live)
+	c.Assert(err, tc.ErrorIsNil)
+
+	sub.replay(replay)
+
+	for _, expected := range []ChangeSet{replay, live} {
+		select {
+		case got := <-sub.Changes():
+			c.Check(got, tc.DeepEquals, expected)
+		case <-time.After(testing.LongWait):
+			c.Fatal("timed out waiting for changes")
+		}
+	}
+
+	workertest.CleanKill(c, sub)
+}
+
+func (s *subscriptionSuite) TestSubscriptionReplayTimeout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sub := newSubscription(0)
+	sub.resume(-1)
+	sub.dispatchTimeout = testing.ShortWait
+	defer workertest.DirtyKill(c, sub)
+
+	// The replay is never read, so the subscription is killed with the
+	// error.
+	sub.replay(ChangeSet{changeEvent{
+		ctype:   changestreamtesting.Create,
+		ns:      "foo",
+		changed: "1",
+	}})
+
+	err := workertest.CheckKilled(c, sub)
+	c.Check(err, tc.ErrorIs, context.DeadlineExceeded)
+}
+
+func (s *subscriptionSuite) TestSubscriptionKilledBeforeReplay(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sub := newSubscription(0)
+	sub.resume(-1)
+	defer workertest.DirtyKill(c, sub)
+
+	// The subscription is killed whilst the replay is being read, so the
+	// replay is dropped.
+	workertest.CleanKill(c, sub)
+
+	sub.replay(ChangeSet{changeEvent{
+		ctype:   changestreamtesting.Create,
+		ns:      "foo",
+		changed: "1",
+	}})
+
+	select {
+	case <-sub.Changes():
+		c.Fatalf("unexpected changes witnessed")
+	case <-time.After(testing.ShortWait):
+	}
+}
+
+func (s *subscriptionSuite) TestSubscriptionFellBehindBeforeReplay(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sub := newSubscription(0)
+	sub.resume(-1)
+	defer workertest.DirtyKill(c, sub)
+
+	// Too many live changes are held whilst the replay is being read, so
+	// the subscription is killed before the replay is delivered.
+	live := ChangeSet{changeEvent{
+		ctype:   changestreamtesting.Create,
+		ns:      "foo",
+		changed: "2",
+	}}
+	for range maxPendingReplaySets {
+		err := sub.dispatch(c.Context(), live)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	err := sub.dispatch(c.Context(), live)
+	c.Assert(err, tc.ErrorMatches, "subscription 0 fell behind whilst replaying")
+
+	sub.replay(ChangeSet{changeEvent{
+		ctype:   changestreamtesting.Create,
+		ns:      "foo",
+		changed: "1",
+	}})
+
+	err = workertest.CheckKilled(c, sub)
+	c.Check(err, tc.ErrorMatches, "subscription 0 fell behind whilst replaying")
+}
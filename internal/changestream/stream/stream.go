@@ -469,7 +469,7 @@ SELECT MAX(c.id), c.edit_type_id, n.namespace, changed, created_at
 		JOIN change_log_namespace n ON c.namespace_id = n.id
 	WHERE c.id > ?
 	GROUP BY c.namespace_id, c.changed
-	ORDER BY c.id;
+	ORDER BY MAX(c.id);
 `
 )
 
@@ -484,6 +484,12 @@ type changeEvent struct {
 	createdAt  string
 }
 
+// ID returns the change log ID of the change. When changes are coalesced,
+// this is the highest ID of the coalesced set.
+func (e changeEvent) ID() int64 {
+	return e.id
+}
+
 // Type returns the type of change (create, update, delete).
 func (e changeEvent) Type() changestream.ChangeType {
 	return changestream.ChangeType(e.changeType)
@@ -536,6 +542,87 @@ func (s *Stream) readChanges() ([]changeEvent, error) {
 	return changes, errors.Trace(err)
 }
 
+const (
+	// Select all the changes within a bounded window of change log IDs. The
+	// changes are coalesced in the same way as the live changes are.
+	replayQuery = `
+SELECT MAX(c.id), c.edit_type_id, n.namespace, changed, created_at
+	FROM change_log c
+		JOIN change_log_edit_type t ON c.edit_type_id = t.id
+		JOIN change_log_namespace n ON c.namespace_id = n.id
+	WHERE c.id > ? AND c.id <= ?
+	GROUP BY c.namespace_id, c.changed
+	ORDER BY MAX(c.id);
+`
+
+	// Select the lowest change log ID that is still available. If the change
+	// log is empty, then the next ID that will be allocated is used instead.
+	// As the change log uses AUTOINCREMENT, the sequence will never reuse an
+	// ID, even if the rows have been pruned.
+	lowestChangeLogIDQuery = `
+SELECT IFNULL(
+	(SELECT MIN(id) FROM change_log),
+	IFNULL((SELECT seq FROM sqlite_sequence WHERE name = 'change_log'), 0) + 1
+);
+`
+)
+
+// ReplayChanges returns all the changes after the from change log ID, up to
+// and including the to change log ID. If the change log has been pruned
+// beyond the from ID, then ErrChangeLogPruned is returned, as the changes
+// can no longer be replayed in their entirety.
+func (s *Stream) ReplayChanges(ctx context.Context, from, to int64) ([]changestream.ChangeEvent, error) {
+	if from >= to {
+		return nil, nil
+	}
+
+	var changes []changeEvent
+	err := s.db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		changes = nil
+
+		var lowest int64
+		row := tx.QueryRowContext(ctx, lowestChangeLogIDQuery)
+		if err := row.Scan(&lowest); err != nil {
+			return errors.Annotate(err, "getting lowest change log ID")
+		}
+		// The change with ID from+1 must still exist for the replay to be
+		// complete.
+		if from+1 < lowest {
+			return errors.Annotatef(coredatabase.ErrChangeLogPruned, "change log ID %d", from)
+		}
+
+		rows, err := tx.QueryContext(ctx, replayQuery, from, to)
+		if err != nil {
+			return errors.Annotate(err, "querying for replay changes")
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var change changeEvent
+			if err := rows.Scan(
+				&change.id,
+				&change.changeType,
+				&change.namespace,
+				&change.changed,
+				&change.createdAt,
+			); err != nil {
+				return errors.Annotate(err, "scanning replay change")
+			}
+			changes = append(changes, change)
+		}
+		return errors.Trace(rows.Err())
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	results := make([]changestream.ChangeEvent, len(changes))
+	for i, change := range changes {
+		results[i] = change
+	}
+	return results, nil
+}
+
 const (
 	watermarkCreateQuery = `
 INSERT INTO change_log_witness
@@ -748,6 +835,12 @@ func (s *Stream) latestChangeLogID() (int64, error) {
 	ctx, cancel := s.scopedContext()
 	defer cancel()
 
+	return s.LatestChangeLogID(ctx)
+}
+
+// LatestChangeLogID returns the highest change log ID in the change log, or
+// -1 if the change log is empty.
+func (s *Stream) LatestChangeLogID(ctx context.Context) (int64, error) {
 	var id int64
 	err := s.db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
 		row := tx.QueryRowContext(ctx, "SELECT IFNULL(MAX(id), -1) FROM change_log")
@@ -21,6 +21,7 @@ import (
 
 	"github.com/juju/juju/core/changestream"
 	changestreamtesting "github.com/juju/juju/core/changestream/testing"
+	coredatabase "github.com/juju/juju/core/database"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/uuid"
@@ -1394,6 +1395,80 @@ func (s *streamSuite) TestReadChangesWithMultipleChangesInterweavedGroupsCorrect
 	}
 }
 
+func (s *streamSuite) TestReplayChanges(c *tc.C) {
+	stream := s.newStream()
+
+	s.insertNamespace(c, 1000, "foo")
+
+	changes := make([]change, 5)
+	for i := range changes {
+		ch := change{
+			id:   1000,
+			uuid: uuid.MustNewUUID().String(),
+		}
+		s.insertChange(c, ch)
+		changes[i] = ch
+	}
+
+	results, err := stream.ReplayChanges(c.Context(), 2, 4)
+	c.Assert(err, tc.ErrorIsNil)
+
+	expectChanges(c, changes[2:4], results)
+	c.Check(results[0].(changestream.IdentifiedChangeEvent).ID(), tc.Equals, int64(3))
+	c.Check(results[1].(changestream.IdentifiedChangeEvent).ID(), tc.Equals, int64(4))
+}
+
+func (s *streamSuite) TestReplayChangesWithNoWindow(c *tc.C) {
+	stream := s.newStream()
+
+	results, err := stream.ReplayChanges(c.Context(), 4, 4)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.HasLen, 0)
+}
+
+func (s *streamSuite) TestReplayChangesPruned(c *tc.C) {
+	stream := s.newStream()
+
+	s.insertNamespace(c, 1000, "foo")
+
+	for i := 0; i < 5; i++ {
+		s.insertChange(c, change{
+			id:   1000,
+			uuid: uuid.MustNewUUID().String(),
+		})
+	}
+
+	_, err := s.DB().Exec("DELETE FROM change_log WHERE id <= 3")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = stream.ReplayChanges(c.Context(), 1, 5)
+	c.Assert(err, tc.ErrorIs, coredatabase.ErrChangeLogPruned)
+
+	// Replaying from the last pruned ID is still complete.
+	results, err := stream.ReplayChanges(c.Context(), 3, 5)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.HasLen, 2)
+}
+
+func (s *streamSuite) TestReplayChangesPrunedEmptyChangeLog(c *tc.C) {
+	stream := s.newStream()
+
+	s.insertNamespace(c, 1000, "foo")
+
+	for i := 0; i < 3; i++ {
+		s.insertChange(c, change{
+			id:   1000,
+			uuid: uuid.MustNewUUID().String(),
+		})
+	}
+
+	_, err := s.DB().Exec("DELETE FROM change_log")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = stream.ReplayChanges(c.Context(), 1, 3)
+	c.Assert(err, tc.ErrorIs, coredatabase.ErrChangeLogPruned)
+}
+
 func (s *streamSuite) TestProcessWatermark(c *tc.C) {
 	stream := s.newStream()
 
@@ -102,6 +102,12 @@ func (w *TestWatchableDB) Subscribe(opts ...changestream.SubscriptionOption) (ch
 	return w.mux.Subscribe(opts...)
 }
 
+// SubscribeFrom returns a subscription for the input options, that first
+// replays all the changes after the given change log ID.
+func (w *TestWatchableDB) SubscribeFrom(changeLogID int64, opts ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	return w.mux.SubscribeFrom(changeLogID, opts...)
+}
+
 // Kill stops the test change stream.
 func (h *TestWatchableDB) Kill() {
 	h.catacomb.Kill(nil)
@@ -46,6 +46,10 @@ const (
 
 	UpdateStatus Kind = "update-status"
 
+	// Scheduled is run on each of the schedules declared by the charm. The
+	// name of the schedule is passed to the hook in its environment.
+	Scheduled Kind = "scheduled"
+
 	// These hooks require an associated secret.
 	SecretChanged Kind = "secret-changed"
 	SecretExpired Kind = "secret-expired"
@@ -94,6 +98,11 @@ const (
 	StorageAttached  Kind = "storage-attached"
 	StorageDetaching Kind = "storage-detaching"
 
+	// StorageResized is run when the attached storage has grown, so that
+	// the charm can grow any filesystem it created on the storage. Like the
+	// other storage hooks, its file name is prefixed by the storage name.
+	StorageResized Kind = "storage-resized"
+
 	// These hooks require an associated workload/container, and the name of the workload/container
 	// whose change triggered the hook. The hook file names that these
 	// kinds represent will be prefixed by the workload/container name; for example,
@@ -141,6 +150,7 @@ func RelationHooks() []Kind {
 var storageHooks = []Kind{
 	StorageAttached,
 	StorageDetaching,
+	StorageResized,
 }
 
 // StorageHooks returns all known storage hook kinds.
@@ -176,7 +186,7 @@ func (kind Kind) IsRelation() bool {
 // IsStorage returns whether the Kind represents a storage hook.
 func (kind Kind) IsStorage() bool {
 	switch kind {
-	case StorageAttached, StorageDetaching:
+	case StorageAttached, StorageDetaching, StorageResized:
 		return true
 	}
 	return false
@@ -233,6 +233,8 @@ type Meta struct {
 	Containers map[string]Container    `json:"containers,omitempty" yaml:"containers,omitempty"`
 	Assumes    *assumes.ExpressionTree `json:"assumes,omitempty" yaml:"assumes,omitempty"`
 	CharmUser  RunAs                   `json:"charm-user,omitempty" yaml:"charm-user,omitempty"`
+
+	Schedules map[string]Schedule `json:"schedules,omitempty" yaml:"schedules,omitempty"`
 }
 
 // Container specifies the possible systems it supports and mounts it wants.
@@ -297,6 +299,9 @@ func (m Meta) Hooks() map[string]bool {
 	for containerName := range m.Containers {
 		generateContainerHooks(containerName, allHooks)
 	}
+	if len(m.Schedules) > 0 {
+		allHooks[string(hooks.Scheduled)] = true
+	}
 	return allHooks
 }
 
@@ -533,6 +538,7 @@ func parseMeta(m map[string]interface{}) (*Meta, error) {
 	if err != nil {
 		return nil, errors.Annotatef(err, "parsing charm-user")
 	}
+	meta.Schedules = parseMetaSchedules(m["schedules"])
 	return &meta, nil
 }
 
@@ -563,6 +569,7 @@ func (m Meta) MarshalYAML() (interface{}, error) {
 		Resources      map[string]marshaledResourceMeta `yaml:"resources,omitempty"`
 		Containers     map[string]marshaledContainer    `yaml:"containers,omitempty"`
 		Assumes        *assumes.ExpressionTree          `yaml:"assumes,omitempty"`
+		Schedules      map[string]marshaledSchedule     `yaml:"schedules,omitempty"`
 	}{
 		Name:           m.Name,
 		Summary:        m.Summary,
@@ -581,6 +588,7 @@ func (m Meta) MarshalYAML() (interface{}, error) {
 		Resources:      marshaledResources(m.Resources),
 		Containers:     marshaledContainers(m.Containers),
 		Assumes:        m.Assumes,
+		Schedules:      marshaledSchedules(m.Schedules),
 	}, nil
 }
 
@@ -697,6 +705,10 @@ func (m Meta) Check(format Format, reasons ...FormatSelectionReason) error {
 		return errors.Errorf("charm %q has invalid extra bindings: %v", m.Name, err)
 	}
 
+	if err := validateMetaSchedules(m); err != nil {
+		return errors.Errorf("charm %q has invalid schedules: %v", m.Name, err)
+	}
+
 	// Subordinate charms must have at least one relation that
 	// has container scope, otherwise they can't relate to the
 	// principal.
@@ -1223,6 +1235,7 @@ var charmSchema = schema.FieldMap(
 		"assumes":          schema.List(schema.Any()),
 		"containers":       schema.StringMap(containerSchema),
 		"charm-user":       schema.String(),
+		"schedules":        schema.StringMap(scheduleSchema),
 	},
 	schema.Defaults{
 		"provides":         schema.Omit,
@@ -1242,6 +1255,7 @@ var charmSchema = schema.FieldMap(
 		"assumes":          schema.Omit,
 		"containers":       schema.Omit,
 		"charm-user":       schema.Omit,
+		"schedules":        schema.Omit,
 	},
 )
 
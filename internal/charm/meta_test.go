@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/tc"
@@ -529,6 +530,85 @@ func (s *MetaSuite) TestCheckEmptyNameKeyOrEmptyExtraBindingName(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, expectedError)
 }
 
+func (s *MetaSuite) TestSchedules(c *tc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(dummyMetadata + `
+schedules:
+    nightly-backup:
+        cron: "0 3 * * *"
+        jitter: 15m
+    hourly-cleanup:
+        cron: "@hourly"
+`))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(meta.Schedules, tc.DeepEquals, map[string]charm.Schedule{
+		"nightly-backup": {
+			Name:   "nightly-backup",
+			Cron:   "0 3 * * *",
+			Jitter: 15 * time.Minute,
+		},
+		"hourly-cleanup": {
+			Name: "hourly-cleanup",
+			Cron: "@hourly",
+		},
+	})
+	c.Check(meta.Hooks()["scheduled"], tc.IsTrue)
+
+	err = meta.Check(charm.FormatV2, charm.SelectionManifest)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *MetaSuite) TestNoSchedules(c *tc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(dummyMetadata))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(meta.Schedules, tc.IsNil)
+	c.Check(meta.Hooks()["scheduled"], tc.IsFalse)
+}
+
+func (s *MetaSuite) TestSchedulesMissingCron(c *tc.C) {
+	_, err := charm.ReadMeta(strings.NewReader(dummyMetadata + `
+schedules:
+    nightly-backup:
+        jitter: 15m
+`))
+	c.Assert(err, tc.ErrorMatches, `metadata: schedules.nightly-backup.cron: expected string, got nothing`)
+}
+
+func (s *MetaSuite) TestCheckInvalidSchedules(c *tc.C) {
+	tests := []struct {
+		schedule charm.Schedule
+		err      string
+	}{{
+		schedule: charm.Schedule{Name: "Nightly", Cron: "0 3 * * *"},
+		err:      `charm "foo" has invalid schedules: invalid schedule name "Nightly"`,
+	}, {
+		schedule: charm.Schedule{Name: "nightly", Cron: "0 25 * * *"},
+		err:      `charm "foo" has invalid schedules: schedule "nightly": invalid cron expression "0 25 \* \* \*": value 25 out of range \[0-23\] in hour field`,
+	}, {
+		schedule: charm.Schedule{Name: "nightly", Cron: "0 3 * * *", Jitter: -time.Minute},
+		err:      `charm "foo" has invalid schedules: schedule "nightly": jitter -1m0s cannot be negative`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %v", i, test.schedule)
+		meta := charm.Meta{
+			Name: "foo",
+			Schedules: map[string]charm.Schedule{
+				test.schedule.Name: test.schedule,
+			},
+		}
+		err := meta.Check(charm.FormatV2, charm.SelectionManifest)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+
+	meta := charm.Meta{
+		Name: "foo",
+		Schedules: map[string]charm.Schedule{
+			"nightly": {Name: "daily", Cron: "@daily"},
+		},
+	}
+	err := meta.Check(charm.FormatV2, charm.SelectionManifest)
+	c.Check(err, tc.ErrorMatches, `charm "foo" has invalid schedules: mismatched schedule name: got "daily", expected "nightly"`)
+}
+
 // Test rewriting of a given interface specification into long form.
 //
 // InterfaceExpander uses `coerce` to do one of two things:
@@ -839,6 +919,19 @@ name: minimal
 description: d
 summary: s
 `,
+}, {
+	about: "charm with schedules",
+	yaml: `
+name: scheduled
+description: d
+summary: s
+schedules:
+    nightly-backup:
+        cron: "0 3 * * *"
+        jitter: 15m
+    hourly-cleanup:
+        cron: "@hourly"
+`,
 }, {
 	about: "charm with lots of stuff",
 	yaml: `
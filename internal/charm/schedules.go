@@ -0,0 +1,124 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package charm
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/internal/cron"
+)
+
+// Schedule is a named schedule on which the scheduled hook is run.
+type Schedule struct {
+	// Name is the name of the schedule, passed to the scheduled hook.
+	Name string `json:"Name"`
+
+	// Cron is the cron expression which determines when the hook is run.
+	Cron string `json:"Cron"`
+
+	// Jitter is the upper bound of a random delay added to each run of
+	// the hook, so that the units of an application don't all run the
+	// hook at once.
+	Jitter time.Duration `json:"Jitter,omitempty"`
+}
+
+// When specified, the "schedules" section in the metadata.yaml should have
+// the following format:
+//
+// schedules:
+//
+//	"<schedule-name>":
+//	  cron: "<cron expression>"
+//	  jitter: <duration>
+//	...
+//
+// Schedule names are lower case and may contain hyphens. The cron expression
+// is a standard five field expression, evaluated in UTC. The jitter is
+// optional.
+var scheduleSchema = schema.FieldMap(
+	schema.Fields{
+		"cron":   schema.String(),
+		"jitter": schema.TimeDuration(),
+	}, schema.Defaults{
+		"jitter": schema.Omit,
+	},
+)
+
+var validScheduleName = regexp.MustCompile(`^[a-z](?:[a-z0-9-]*[a-z0-9])?$`)
+
+// ValidateScheduleName returns an error if the name is not a valid schedule
+// name.
+func ValidateScheduleName(name string) error {
+	if !validScheduleName.MatchString(name) {
+		return fmt.Errorf("invalid schedule name %q", name)
+	}
+	return nil
+}
+
+// Validate returns an error if the schedule is not valid.
+func (s Schedule) Validate() error {
+	if err := ValidateScheduleName(s.Name); err != nil {
+		return err
+	}
+	if _, err := cron.Parse(s.Cron); err != nil {
+		return fmt.Errorf("schedule %q: %w", s.Name, err)
+	}
+	if s.Jitter < 0 {
+		return fmt.Errorf("schedule %q: jitter %v cannot be negative", s.Name, s.Jitter)
+	}
+	return nil
+}
+
+func parseMetaSchedules(data interface{}) map[string]Schedule {
+	if data == nil {
+		return nil
+	}
+
+	result := make(map[string]Schedule)
+	for name, value := range data.(map[string]interface{}) {
+		attrs := value.(map[string]interface{})
+		schedule := Schedule{
+			Name: name,
+			Cron: attrs["cron"].(string),
+		}
+		if jitter, ok := attrs["jitter"]; ok {
+			schedule.Jitter = jitter.(time.Duration)
+		}
+		result[name] = schedule
+	}
+	return result
+}
+
+func validateMetaSchedules(meta Meta) error {
+	for name, schedule := range meta.Schedules {
+		if schedule.Name != name {
+			return fmt.Errorf("mismatched schedule name: got %q, expected %q", schedule.Name, name)
+		}
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type marshaledSchedule struct {
+	Cron   string `yaml:"cron"`
+	Jitter string `yaml:"jitter,omitempty"`
+}
+
+func marshaledSchedules(schedules map[string]Schedule) map[string]marshaledSchedule {
+	marshaled := make(map[string]marshaledSchedule)
+	for name, schedule := range schedules {
+		ms := marshaledSchedule{Cron: schedule.Cron}
+		if schedule.Jitter != 0 {
+			ms.Jitter = schedule.Jitter.String()
+		}
+		marshaled[name] = ms
+	}
+	return marshaled
+}
@@ -0,0 +1,229 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package cron parses cron expressions, as used to schedule charm hooks, and
+// computes the times at which they are activated.
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// InvalidExpression is returned when a cron expression can not be parsed.
+const InvalidExpression = errors.ConstError("invalid cron expression")
+
+// maxSearchYears bounds the search for the next activation of a schedule,
+// so that an expression that can never match, such as the 31st of February,
+// does not search forever.
+const maxSearchYears = 5
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	expr string
+
+	minute, hour, dom, month, dow uint64
+
+	// domRestricted and dowRestricted record whether the day of month and
+	// day of week fields were restricted, rather than starting with "*".
+	// When both are restricted, a day matches if either field matches.
+	domRestricted, dowRestricted bool
+}
+
+// field describes the range and names of a field in a cron expression.
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 are Sunday.
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a standard five field cron expression: minute, hour, day of
+// month, month and day of week. Each field may be "*", a value, a range
+// ("1-5") or a list of these ("1,15,30"), and "*" or a range may have a step
+// ("*/15", "9-17/2"). Months and days of the week may also be given by their
+// three letter names. The macros @yearly, @annually, @monthly, @weekly,
+// @daily, @midnight and @hourly are also accepted.
+//
+// If the expression is not valid, an error satisfying [InvalidExpression] is
+// returned.
+func Parse(expr string) (*Schedule, error) {
+	spec := strings.TrimSpace(expr)
+	if strings.HasPrefix(spec, "@") {
+		macro, ok := macros[strings.ToLower(spec)]
+		if !ok {
+			return nil, errors.Errorf("%w %q: unknown macro %q", InvalidExpression, expr, spec)
+		}
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, errors.Errorf("%w %q: expected 5 fields, got %d", InvalidExpression, expr, len(fields))
+	}
+
+	s := &Schedule{
+		expr:          expr,
+		domRestricted: !strings.HasPrefix(fields[2], "*"),
+		dowRestricted: !strings.HasPrefix(fields[4], "*"),
+	}
+	var err error
+	if s.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, errors.Errorf("%w %q: %w", InvalidExpression, expr, err)
+	}
+	if s.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, errors.Errorf("%w %q: %w", InvalidExpression, expr, err)
+	}
+	if s.dom, err = domField.parse(fields[2]); err != nil {
+		return nil, errors.Errorf("%w %q: %w", InvalidExpression, expr, err)
+	}
+	if s.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, errors.Errorf("%w %q: %w", InvalidExpression, expr, err)
+	}
+	if s.dow, err = dowField.parse(fields[4]); err != nil {
+		return nil, errors.Errorf("%w %q: %w", InvalidExpression, expr, err)
+	}
+	// Fold Sunday as 7 onto Sunday as 0.
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	return s, nil
+}
+
+// String returns the expression the schedule was parsed from.
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+// Next returns the first activation of the schedule strictly after t, in the
+// location of t. The zero time is returned if the schedule can't be
+// activated, for example an expression for the 30th of February.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	limit := t.Year() + maxSearchYears
+	for t.Year() <= limit {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+// parse returns the bit set of values matched by the field expression.
+func (f field) parse(expr string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		b, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func (f field) parsePart(part string) (uint64, error) {
+	rangeExpr, stepExpr, hasStep := strings.Cut(part, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepExpr)
+		if err != nil || step <= 0 {
+			return 0, errors.Errorf("invalid step %q in %s field", stepExpr, f.name)
+		}
+	}
+
+	var low, high int
+	switch {
+	case rangeExpr == "*":
+		low, high = f.min, f.max
+	case strings.Contains(rangeExpr, "-"):
+		lowExpr, highExpr, _ := strings.Cut(rangeExpr, "-")
+		var err error
+		if low, err = f.value(lowExpr); err != nil {
+			return 0, err
+		}
+		if high, err = f.value(highExpr); err != nil {
+			return 0, err
+		}
+		if low > high {
+			return 0, errors.Errorf("invalid range %q in %s field", rangeExpr, f.name)
+		}
+	default:
+		if hasStep {
+			return 0, errors.Errorf("step %q in %s field requires a range or *", part, f.name)
+		}
+		v, err := f.value(rangeExpr)
+		if err != nil {
+			return 0, err
+		}
+		low, high = v, v
+	}
+
+	var bits uint64
+	for v := low; v <= high; v += step {
+		bits |= 1 << uint(v)
+	}
+	return bits, nil
+}
+
+func (f field) value(expr string) (int, error) {
+	if v, ok := f.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(expr)
+	if err != nil {
+		return 0, errors.Errorf("invalid value %q in %s field", expr, f.name)
+	}
+	if v < f.min || v > f.max {
+		return 0, errors.Errorf("value %d out of range [%d-%d] in %s field", v, f.min, f.max, f.name)
+	}
+	return v, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/cron"
+)
+
+type cronSuite struct{}
+
+func TestCronSuite(t *testing.T) {
+	tc.Run(t, &cronSuite{})
+}
+
+func (s *cronSuite) TestNext(c *tc.C) {
+	// A Wednesday.
+	now := time.Date(2025, time.March, 5, 10, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		expr     string
+		expected time.Time
+	}{{
+		expr:     "* * * * *",
+		expected: time.Date(2025, time.March, 5, 10, 31, 0, 0, time.UTC),
+	}, {
+		expr:     "*/15 * * * *",
+		expected: time.Date(2025, time.March, 5, 10, 45, 0, 0, time.UTC),
+	}, {
+		expr:     "30 10 * * *",
+		expected: time.Date(2025, time.March, 6, 10, 30, 0, 0, time.UTC),
+	}, {
+		expr:     "0 3 * * *",
+		expected: time.Date(2025, time.March, 6, 3, 0, 0, 0, time.UTC),
+	}, {
+		expr:     "0 9-17/4 * * mon-fri",
+		expected: time.Date(2025, time.March, 5, 13, 0, 0, 0, time.UTC),
+	}, {
+		expr:     "0 0 * * sun",
+		expected: time.Date(2025, time.March, 9, 0, 0, 0, 0, time.UTC),
+	}, {
+		expr:     "0 0 * * 7",
+		expected: time.Date(2025, time.March, 9, 0, 0, 0, 0, time.UTC),
+	}, {
+		expr:     "0 0 1,15 * *",
+		expected: time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+	}, {
+		// Day of month and day of week both restricted match either.
+		expr:     "0 0 20 * fri",
+		expected: time.Date(2025, time.March, 7, 0, 0, 0, 0, time.UTC),
+	}, {
+		expr:     "0 0 29 feb *",
+		expected: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+	}, {
+		expr:     "@monthly",
+		expected: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+	}, {
+		expr:     "@yearly",
+		expected: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}, {
+		expr:     "@hourly",
+		expected: time.Date(2025, time.March, 5, 11, 0, 0, 0, time.UTC),
+	}, {
+		expr:     "0 0 30 2 *",
+		expected: time.Time{},
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.expr)
+		schedule, err := cron.Parse(test.expr)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(schedule.Next(now), tc.Equals, test.expected)
+	}
+}
+
+func (s *cronSuite) TestNextIsStrictlyAfter(c *tc.C) {
+	schedule, err := cron.Parse("0 3 * * *")
+	c.Assert(err, tc.ErrorIsNil)
+
+	now := time.Date(2025, time.March, 5, 3, 0, 0, 0, time.UTC)
+	c.Check(schedule.Next(now), tc.Equals, time.Date(2025, time.March, 6, 3, 0, 0, 0, time.UTC))
+}
+
+func (s *cronSuite) TestNextKeepsLocation(c *tc.C) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	schedule, err := cron.Parse("0 3 * * *")
+	c.Assert(err, tc.ErrorIsNil)
+
+	now := time.Date(2025, time.March, 5, 10, 0, 0, 0, loc)
+	c.Check(schedule.Next(now), tc.Equals, time.Date(2025, time.March, 6, 3, 0, 0, 0, loc))
+}
+
+func (s *cronSuite) TestParseInvalid(c *tc.C) {
+	tests := []struct {
+		expr string
+		err  string
+	}{{
+		expr: "",
+		err:  `invalid cron expression "": expected 5 fields, got 0`,
+	}, {
+		expr: "* * * *",
+		err:  `invalid cron expression "\* \* \* \*": expected 5 fields, got 4`,
+	}, {
+		expr: "@fortnightly",
+		err:  `invalid cron expression "@fortnightly": unknown macro "@fortnightly"`,
+	}, {
+		expr: "60 * * * *",
+		err:  `invalid cron expression .*: value 60 out of range \[0-59\] in minute field`,
+	}, {
+		expr: "* 24 * * *",
+		err:  `invalid cron expression .*: value 24 out of range \[0-23\] in hour field`,
+	}, {
+		expr: "* * 0 * *",
+		err:  `invalid cron expression .*: value 0 out of range \[1-31\] in day of month field`,
+	}, {
+		expr: "* * * foo *",
+		err:  `invalid cron expression .*: invalid value "foo" in month field`,
+	}, {
+		expr: "*/0 * * * *",
+		err:  `invalid cron expression .*: invalid step "0" in minute field`,
+	}, {
+		expr: "5/10 * * * *",
+		err:  `invalid cron expression .*: step "5/10" in minute field requires a range or \*`,
+	}, {
+		expr: "* * * * fri-mon",
+		err:  `invalid cron expression .*: invalid range "fri-mon" in day of week field`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %q", i, test.expr)
+		_, err := cron.Parse(test.expr)
+		c.Check(err, tc.ErrorIs, cron.InvalidExpression)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// schemaObject is an entry in the sqlite_master table.
+type schemaObject struct {
+	Type string
+	Name string
+	SQL  string
+}
+
+// DumpDB writes a logical dump of the database read through the input
+// transaction to the writer. Running the dump in a single transaction ensures
+// that it is a consistent snapshot of the database.
+//
+// The dump is a sequence of SQL statements, one JSON encoded string per line.
+// The schema is created first, followed by the contents of the tables, and
+// then the triggers, so that triggers do not fire when the dump is loaded.
+func DumpDB(ctx context.Context, tx *sql.Tx, w io.Writer) error {
+	// We should ignore any name that starts with sqlite_ as they are internal
+	// tables, indexes and triggers. Indexes created implicitly for constraints
+	// have no SQL and are recreated along with their table.
+	rows, err := tx.QueryContext(ctx, `
+SELECT type, name, sql
+FROM   sqlite_master
+WHERE  name NOT LIKE 'sqlite_%'
+AND    sql IS NOT NULL
+ORDER BY rowid;`)
+	if err != nil {
+		return errors.Annotate(err, "reading schema")
+	}
+	defer rows.Close()
+
+	var schema, triggers []schemaObject
+	for rows.Next() {
+		var obj schemaObject
+		if err := rows.Scan(&obj.Type, &obj.Name, &obj.SQL); err != nil {
+			return errors.Trace(err)
+		}
+		if obj.Type == "trigger" {
+			triggers = append(triggers, obj)
+		} else {
+			schema = append(schema, obj)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Trace(err)
+	}
+
+	enc := json.NewEncoder(w)
+	for _, obj := range schema {
+		if err := enc.Encode(obj.SQL + ";"); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for _, obj := range schema {
+		if obj.Type != "table" {
+			continue
+		}
+		if err := dumpTable(ctx, tx, enc, obj.Name); err != nil {
+			return errors.Annotatef(err, "dumping table %q", obj.Name)
+		}
+	}
+	for _, obj := range triggers {
+		if err := enc.Encode(obj.SQL + ";"); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// dumpTable writes an insert statement for each row in the table. The values
+// are rendered by SQLite's quote function, so that they are reproduced
+// exactly as stored, regardless of the declared column types.
+func dumpTable(ctx context.Context, tx *sql.Tx, enc *json.Encoder, table string) error {
+	columns, err := tableColumns(ctx, tx, table)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	quoted := make([]string, len(columns))
+	selects := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = fmt.Sprintf("%q", column)
+		selects[i] = fmt.Sprintf("quote(%q)", column)
+	}
+	insert := fmt.Sprintf("INSERT INTO %q (%s) VALUES (", table, strings.Join(quoted, ", "))
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %q;", strings.Join(selects, ", "), table))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer rows.Close()
+
+	values := make([]string, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return errors.Trace(err)
+		}
+		if err := enc.Encode(insert + strings.Join(values, ", ") + ");"); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(rows.Err())
+}
+
+func tableColumns(ctx context.Context, tx *sql.Tx, table string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT name FROM pragma_table_info(?);", table)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, errors.Trace(err)
+		}
+		columns = append(columns, name)
+	}
+	return columns, errors.Trace(rows.Err())
+}
+
+// InsertTable returns the name of the table that an insert statement written
+// by DumpDB inserts into. False is returned if the statement is not such an
+// insert statement.
+func InsertTable(stmt string) (string, bool) {
+	rest, ok := strings.CutPrefix(stmt, "INSERT INTO ")
+	if !ok {
+		return "", false
+	}
+	end := strings.Index(rest, `" (`)
+	if end == -1 {
+		return "", false
+	}
+	table, err := strconv.Unquote(rest[:end+1])
+	if err != nil {
+		return "", false
+	}
+	return table, true
+}
+
+// StatementFilter is called with each statement read from a dump, and
+// returns the statement that should be executed in its place. An empty
+// statement is not executed.
+type StatementFilter func(stmt string) (string, error)
+
+// LoadDump replays a dump written by DumpDB into the database using the input
+// transaction. The database is expected to be empty.
+// Foreign key checks are deferred until the transaction is committed, so the
+// order in which the rows were dumped does not matter.
+func LoadDump(ctx context.Context, tx *sql.Tx, r io.Reader) error {
+	return LoadDumpWithFilter(ctx, tx, r, nil)
+}
+
+// LoadDumpWithFilter replays a dump written by DumpDB into the database using
+// the input transaction, passing each statement through the filter before it
+// is executed. A nil filter executes every statement as it was dumped.
+func LoadDumpWithFilter(ctx context.Context, tx *sql.Tx, r io.Reader, filter StatementFilter) error {
+	if _, err := tx.ExecContext(ctx, "PRAGMA defer_foreign_keys = ON;"); err != nil {
+		return errors.Annotate(err, "deferring foreign key checks")
+	}
+
+	reader := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err == io.EOF && len(data) == 0 {
+			return nil
+		} else if err != nil && err != io.EOF {
+			return errors.Trace(err)
+		}
+
+		var stmt string
+		if err := json.Unmarshal(data, &stmt); err != nil {
+			return errors.Annotatef(err, "decoding statement on line %d", line)
+		}
+		if filter != nil {
+			if stmt, err = filter(stmt); err != nil {
+				return errors.Annotatef(err, "filtering statement on line %d", line)
+			}
+			if stmt == "" {
+				continue
+			}
+		}
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return errors.Annotatef(err, "executing statement on line %d", line)
+		}
+	}
+}
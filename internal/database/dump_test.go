@@ -0,0 +1,173 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/database/testing"
+)
+
+type dumpSuite struct {
+	testing.DqliteSuite
+}
+
+func TestDumpSuite(t *stdtesting.T) {
+	tc.Run(t, &dumpSuite{})
+}
+
+func (s *dumpSuite) TestDumpAndLoad(c *tc.C) {
+	runner, _ := s.OpenDBForNamespace(c, "source", true)
+	err := runner.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+CREATE TABLE band (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    formed DATETIME,
+    rating REAL,
+    logo BLOB
+);
+
+CREATE TABLE album (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    band_uuid TEXT NOT NULL,
+    name TEXT NOT NULL,
+    CONSTRAINT fk_album_band
+    FOREIGN KEY (band_uuid)
+    REFERENCES band (uuid)
+);
+
+CREATE INDEX idx_album_name ON album (name);
+
+CREATE TABLE album_log (
+    name TEXT NOT NULL
+);
+
+CREATE TRIGGER trg_album_insert
+AFTER INSERT ON album FOR EACH ROW
+BEGIN
+    INSERT INTO album_log VALUES (NEW.name);
+END;
+
+INSERT INTO band VALUES ('b1', 'Blood Incantation', '2011-01-02 03:04:05', 4.5, X'CAFE');
+INSERT INTO band VALUES ('b2', 'Line one
+line two; ''quoted''', NULL, NULL, NULL);
+INSERT INTO album VALUES ('a1', 'b1', 'Hidden History of the Human Race');
+`)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var dump bytes.Buffer
+	err = runner.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return DumpDB(ctx, tx, &dump)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	target, _ := s.OpenDBForNamespace(c, "target", true)
+	err = target.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDump(ctx, tx, bytes.NewReader(dump.Bytes()))
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Dumping the restored database must produce the same dump.
+	var restored bytes.Buffer
+	err = target.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return DumpDB(ctx, tx, &restored)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(restored.String(), tc.Equals, dump.String())
+
+	// The trigger must not fire while the rows are loaded, but must be in
+	// place afterwards.
+	err = target.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		var count int
+		if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM album_log").Scan(&count); err != nil {
+			return err
+		}
+		c.Check(count, tc.Equals, 1)
+
+		_, err := tx.ExecContext(ctx, "INSERT INTO album VALUES ('a2', 'b1', 'Starspawn')")
+		if err != nil {
+			return err
+		}
+		if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM album_log").Scan(&count); err != nil {
+			return err
+		}
+		c.Check(count, tc.Equals, 2)
+		return nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *dumpSuite) TestLoadDumpForeignKeyViolation(c *tc.C) {
+	runner, _ := s.OpenDBForNamespace(c, "target", true)
+	dump := `"CREATE TABLE band (uuid TEXT NOT NULL PRIMARY KEY);"
+"CREATE TABLE album (uuid TEXT NOT NULL PRIMARY KEY, band_uuid TEXT NOT NULL REFERENCES band (uuid));"
+"INSERT INTO \"album\" (\"uuid\", \"band_uuid\") VALUES ('a1', 'b1');"
+`
+	err := runner.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDump(ctx, tx, bytes.NewBufferString(dump))
+	})
+	c.Assert(err, tc.ErrorMatches, `.*FOREIGN KEY constraint failed.*`)
+}
+
+func (s *dumpSuite) TestLoadDumpInvalidStatement(c *tc.C) {
+	runner, _ := s.OpenDBForNamespace(c, "target", true)
+	err := runner.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDump(ctx, tx, bytes.NewBufferString("\"CREATE TABLE band (uuid TEXT);\"\nnot json\n"))
+	})
+	c.Assert(err, tc.ErrorMatches, `decoding statement on line 2: .*`)
+}
+
+func (s *dumpSuite) TestLoadDumpWithFilter(c *tc.C) {
+	runner, _ := s.OpenDBForNamespace(c, "target", true)
+	dump := `"CREATE TABLE band (uuid TEXT NOT NULL PRIMARY KEY, name TEXT);"
+"INSERT INTO \"band\" (\"uuid\", \"name\") VALUES ('b1', 'Blood Incantation');"
+"INSERT INTO \"band\" (\"uuid\", \"name\") VALUES ('b2', 'Tomb Mold');"
+`
+	err := runner.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDumpWithFilter(ctx, tx, bytes.NewBufferString(dump), func(stmt string) (string, error) {
+			if strings.Contains(stmt, "'b2'") {
+				return "", nil
+			}
+			return strings.ReplaceAll(stmt, "'b1'", "'b3'"), nil
+		})
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var uuids []string
+	err = runner.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT uuid FROM band")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var uuid string
+			if err := rows.Scan(&uuid); err != nil {
+				return err
+			}
+			uuids = append(uuids, uuid)
+		}
+		return rows.Err()
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(uuids, tc.DeepEquals, []string{"b3"})
+}
+
+func (s *dumpSuite) TestInsertTable(c *tc.C) {
+	table, ok := InsertTable(`INSERT INTO "band" ("uuid") VALUES ('b1');`)
+	c.Check(ok, tc.IsTrue)
+	c.Check(table, tc.Equals, "band")
+
+	_, ok = InsertTable(`CREATE TABLE band (uuid TEXT);`)
+	c.Check(ok, tc.IsFalse)
+}
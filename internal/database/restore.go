@@ -0,0 +1,159 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"io"
+
+	"github.com/juju/errors"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/database/app"
+	"github.com/juju/juju/internal/database/pragma"
+)
+
+// RestoreNodeManager is an interface for managing the restoration of a Dqlite
+// node from a backup.
+type RestoreNodeManager interface {
+	// IsExistingNode returns true if this machine or container has
+	// ever started a Dqlite `App` before.
+	IsExistingNode() (bool, error)
+
+	// EnsureDataDir ensures that a directory for Dqlite data exists at
+	// a path determined by the agent config, then returns that path.
+	EnsureDataDir() (string, error)
+
+	// WithLoopbackAddressOption returns a Dqlite application
+	// Option that will bind Dqlite to the loopback IP.
+	WithLoopbackAddressOption() app.Option
+
+	// WithLogFuncOption returns a Dqlite application Option
+	// that will proxy Dqlite log output via this factory's
+	// logger where the level is recognised.
+	WithLogFuncOption() app.Option
+}
+
+// DatabaseDump identifies the dump of a single database, as written by
+// DumpDB.
+type DatabaseDump struct {
+	// Namespace is the namespace of the database to restore.
+	Namespace string
+
+	// Open returns a reader for the dump. It may be called more than once
+	// if loading the dump needs to be retried.
+	Open func() (io.ReadCloser, error)
+}
+
+// RestoreDqlite starts a new Dqlite node and loads each of the input
+// database dumps into it. The Dqlite data directory must be empty.
+//
+// Like a newly bootstrapped node, the restored node is bound to the loopback
+// address. The controller node with the input ID is recorded as the only
+// Dqlite member of the cluster; it is rebound to a cloud-local address when
+// the controller agent starts.
+func RestoreDqlite(
+	ctx context.Context,
+	mgr RestoreNodeManager,
+	controllerID string,
+	dumps []DatabaseDump,
+	logger logger.Logger,
+) error {
+	extant, err := mgr.IsExistingNode()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if extant {
+		return errors.AlreadyExistsf("Dqlite node data")
+	}
+
+	var foundController bool
+	for _, dump := range dumps {
+		if dump.Namespace == coredatabase.ControllerNS {
+			foundController = true
+			break
+		}
+	}
+	if !foundController {
+		return errors.NotFoundf("controller database dump")
+	}
+
+	dir, err := mgr.EnsureDataDir()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	dqlite, err := app.New(dir, mgr.WithLogFuncOption(), mgr.WithLoopbackAddressOption())
+	if err != nil {
+		return errors.Annotate(err, "creating Dqlite app")
+	}
+	defer func() {
+		if err := dqlite.Close(); err != nil {
+			logger.Errorf(ctx, "closing Dqlite: %v", err)
+		}
+	}()
+
+	if err := dqlite.Ready(ctx); err != nil {
+		return errors.Annotatef(err, "waiting for Dqlite readiness")
+	}
+
+	for _, dump := range dumps {
+		logger.Infof(ctx, "restoring database %q", dump.Namespace)
+
+		db, err := dqlite.Open(ctx, dump.Namespace)
+		if err != nil {
+			return errors.Annotatef(err, "opening database for namespace %q", dump.Namespace)
+		}
+		if err := pragma.SetPragma(ctx, db, pragma.ForeignKeysPragma, true); err != nil {
+			return errors.Annotatef(err, "setting foreign keys pragma for namespace %q", dump.Namespace)
+		}
+
+		if err := StdTxn(ctx, db, func(ctx context.Context, tx *sql.Tx) error {
+			r, err := dump.Open()
+			if err != nil {
+				return errors.Trace(err)
+			}
+			defer func() { _ = r.Close() }()
+
+			if err := LoadDump(ctx, tx, r); err != nil {
+				return errors.Trace(err)
+			}
+			if dump.Namespace != coredatabase.ControllerNS {
+				return nil
+			}
+			return errors.Trace(setRestoredControllerNode(ctx, tx, controllerID, dqlite.ID()))
+		}); err != nil {
+			return errors.Annotatef(err, "restoring database for namespace %q", dump.Namespace)
+		}
+	}
+
+	return nil
+}
+
+// setRestoredControllerNode records the restored Dqlite node against the
+// controller with the input ID. Any other controller nodes are no longer
+// members of the Dqlite cluster.
+func setRestoredControllerNode(ctx context.Context, tx *sql.Tx, controllerID string, nodeID uint64) error {
+	q := `
+UPDATE controller_node
+SET    dqlite_node_id = NULL,
+       dqlite_bind_address = NULL
+WHERE  controller_id != ?;`
+	if _, err := tx.ExecContext(ctx, q, controllerID); err != nil {
+		return errors.Annotate(err, "removing other controller nodes")
+	}
+
+	q = `
+INSERT INTO controller_node (controller_id, dqlite_node_id, dqlite_bind_address)
+VALUES (?, ?, '127.0.0.1')
+ON CONFLICT (controller_id) DO UPDATE SET
+    dqlite_node_id = excluded.dqlite_node_id,
+    dqlite_bind_address = excluded.dqlite_bind_address;`
+	if _, err := tx.ExecContext(ctx, q, controllerID, nodeID); err != nil {
+		return errors.Annotate(err, "setting restored controller node")
+	}
+	return nil
+}
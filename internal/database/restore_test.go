@@ -0,0 +1,128 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/database"
+	modeltesting "github.com/juju/juju/core/model/testing"
+	"github.com/juju/juju/internal/database/app"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type restoreSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestRestoreSuite(t *testing.T) {
+	tc.Run(t, &restoreSuite{})
+}
+
+func (s *restoreSuite) TestRestoreSuccess(c *tc.C) {
+	modelUUID := modeltesting.GenModelUUID(c)
+
+	// Bootstrap a node to dump the databases from.
+	var controllerDump, modelDump bytes.Buffer
+	dump := func(ctx context.Context, controller, model database.TxnRunner) error {
+		if err := controller.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			return DumpDB(ctx, tx, &controllerDump)
+		}); err != nil {
+			return err
+		}
+		return model.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			return DumpDB(ctx, tx, &modelDump)
+		})
+	}
+	err := BootstrapDqlite(c.Context(), &testNodeManager{c: c}, modelUUID, loggertesting.WrapCheckLog(c), dump)
+	c.Assert(err, tc.ErrorIsNil)
+
+	mgr := &testNodeManager{c: c}
+	err = RestoreDqlite(c.Context(), mgr, "0", []DatabaseDump{
+		bufferDump(database.ControllerNS, controllerDump),
+		bufferDump(modelUUID.String(), modelDump),
+	}, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Start the restored node and check the contents of the databases.
+	dqlite, err := app.New(mgr.dataDir, mgr.WithLoopbackAddressOption())
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = dqlite.Close() }()
+	c.Assert(dqlite.Ready(c.Context()), tc.ErrorIsNil)
+
+	db, err := dqlite.Open(c.Context(), database.ControllerNS)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var count int
+	err = db.QueryRowContext(c.Context(), "SELECT COUNT(*) FROM lease_type").Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 2)
+
+	var nodeID uint64
+	var bindAddress string
+	err = db.QueryRowContext(c.Context(),
+		"SELECT dqlite_node_id, dqlite_bind_address FROM controller_node WHERE controller_id = '0'").
+		Scan(&nodeID, &bindAddress)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(nodeID, tc.Equals, dqlite.ID())
+	c.Check(bindAddress, tc.Equals, "127.0.0.1")
+
+	db, err = dqlite.Open(c.Context(), modelUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	err = db.QueryRowContext(c.Context(), "SELECT COUNT(*) FROM life").Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 3)
+}
+
+func (s *restoreSuite) TestRestoreExistingNode(c *tc.C) {
+	mgr := &testNodeManager{c: c}
+	dir, err := mgr.EnsureDataDir()
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.WriteFile(dir+"/info.yaml", []byte("node"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = RestoreDqlite(c.Context(), mgr, "0", []DatabaseDump{
+		bufferDump(database.ControllerNS, bytes.Buffer{}),
+	}, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.Satisfies, errors.IsAlreadyExists)
+}
+
+func (s *restoreSuite) TestRestoreMissingController(c *tc.C) {
+	mgr := &testNodeManager{c: c}
+	err := RestoreDqlite(c.Context(), mgr, "0", []DatabaseDump{
+		bufferDump(modeltesting.GenModelUUID(c).String(), bytes.Buffer{}),
+	}, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.Satisfies, errors.IsNotFound)
+}
+
+func bufferDump(namespace string, buf bytes.Buffer) DatabaseDump {
+	data := buf.Bytes()
+	return DatabaseDump{
+		Namespace: namespace,
+		Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		},
+	}
+}
+
+func (f *testNodeManager) IsExistingNode() (bool, error) {
+	dir, err := f.EnsureDataDir()
+	if err != nil {
+		return false, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+	return len(entries) > 0, nil
+}
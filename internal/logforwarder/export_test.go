@@ -0,0 +1,10 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+// Overflowed returns the number of records dropped by Log as the queue was
+// full, which haven't been reported yet.
+func Overflowed(f *Forwarder) int64 {
+	return f.overflowed.Load()
+}
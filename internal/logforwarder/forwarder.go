@@ -0,0 +1,421 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/juju/clock"
+	"gopkg.in/tomb.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// DefaultBatchSize is the default maximum number of records sent to a
+	// target in a single request.
+	DefaultBatchSize = 500
+
+	// DefaultFlushInterval is the default maximum amount of time records
+	// are held before being sent to a target.
+	DefaultFlushInterval = 5 * time.Second
+
+	// DefaultQueueSize is the default number of calls to Log that can be
+	// queued before records are dropped.
+	DefaultQueueSize = 1024
+
+	// DefaultMaxSpoolSize is the default maximum size, in bytes, of the
+	// spool of records that couldn't be delivered to a target.
+	DefaultMaxSpoolSize = 100 * 1024 * 1024
+
+	// maxRetryDelay is the maximum time between attempts to deliver records
+	// to a failing target.
+	maxRetryDelay = 5 * time.Minute
+)
+
+// Config holds the parameters for a Forwarder.
+type Config struct {
+	// Target is the endpoint the records are forwarded to.
+	Target Target
+
+	// SpoolDir is the directory where the records that couldn't be
+	// delivered are kept until the target is available again.
+	SpoolDir string
+
+	// BatchSize is the maximum number of records sent in a single request.
+	BatchSize int
+
+	// FlushInterval is the maximum amount of time records are held before
+	// being sent.
+	FlushInterval time.Duration
+
+	// QueueSize is the number of calls to Log that are buffered. Once the
+	// queue is full, the records passed to Log are dropped rather than
+	// holding up the writers of the logs.
+	QueueSize int
+
+	// MaxSpoolSize is the maximum size of the spool, in bytes. Records that
+	// would grow the spool beyond this size are dropped.
+	MaxSpoolSize int64
+
+	// Clock is used to schedule flushes and retries.
+	Clock clock.Clock
+
+	// Logger is used to report problems delivering the records. It must not
+	// write to the logs that are being forwarded.
+	Logger logger.Logger
+}
+
+// Validate checks the forwarder config.
+func (cfg Config) Validate() error {
+	if cfg.Target == nil {
+		return errors.New("nil Target not valid").Add(coreerrors.NotValid)
+	}
+	if cfg.SpoolDir == "" {
+		return errors.New("empty SpoolDir not valid").Add(coreerrors.NotValid)
+	}
+	if cfg.BatchSize < 0 {
+		return errors.Errorf("negative BatchSize %d not valid", cfg.BatchSize).Add(coreerrors.NotValid)
+	}
+	if cfg.FlushInterval < 0 {
+		return errors.Errorf("negative FlushInterval %v not valid", cfg.FlushInterval).Add(coreerrors.NotValid)
+	}
+	if cfg.QueueSize < 0 {
+		return errors.Errorf("negative QueueSize %d not valid", cfg.QueueSize).Add(coreerrors.NotValid)
+	}
+	if cfg.MaxSpoolSize < 0 {
+		return errors.Errorf("negative MaxSpoolSize %d not valid", cfg.MaxSpoolSize).Add(coreerrors.NotValid)
+	}
+	if cfg.Clock == nil {
+		return errors.New("nil Clock not valid").Add(coreerrors.NotValid)
+	}
+	if cfg.Logger == nil {
+		return errors.New("nil Logger not valid").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// Forwarder is a log writer that forwards batches of log records to a
+// target. Whilst the target is unavailable, records are appended to a spool
+// on disk, which is delivered once the target is available again.
+type Forwarder struct {
+	tomb tomb.Tomb
+	cfg  Config
+
+	in chan []logger.LogRecord
+
+	spoolPath string
+	spoolSize int64
+	dropped   int
+
+	// overflowed is the number of records dropped by Log as the queue was
+	// full, since it was last reported.
+	overflowed atomic.Int64
+}
+
+// NewForwarder returns a new Forwarder, which starts delivering any records
+// left in the spool by a previous forwarder for the same target.
+func NewForwarder(cfg Config) (*Forwarder, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.FlushInterval == 0 {
+		cfg.FlushInterval = DefaultFlushInterval
+	}
+	if cfg.QueueSize == 0 {
+		cfg.QueueSize = DefaultQueueSize
+	}
+	if cfg.MaxSpoolSize == 0 {
+		cfg.MaxSpoolSize = DefaultMaxSpoolSize
+	}
+
+	if err := os.MkdirAll(cfg.SpoolDir, 0700); err != nil {
+		return nil, errors.Errorf("creating spool directory: %w", err)
+	}
+	f := &Forwarder{
+		cfg:       cfg,
+		in:        make(chan []logger.LogRecord, cfg.QueueSize),
+		spoolPath: filepath.Join(cfg.SpoolDir, cfg.Target.Name()+"-spool.jsonl"),
+	}
+	if info, err := os.Stat(f.spoolPath); err == nil {
+		f.spoolSize = info.Size()
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Errorf("reading spool: %w", err)
+	}
+
+	f.tomb.Go(f.loop)
+	return f, nil
+}
+
+// Log queues the records to be forwarded. Log never blocks: if the queue
+// is full, the records are dropped and counted, and the count is reported
+// by the forwarder.
+func (f *Forwarder) Log(records []logger.LogRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	select {
+	case <-f.tomb.Dying():
+		return tomb.ErrDying
+	default:
+	}
+	select {
+	case f.in <- records:
+	default:
+		f.overflowed.Add(int64(len(records)))
+	}
+	return nil
+}
+
+// Kill stops the forwarder.
+func (f *Forwarder) Kill() {
+	f.tomb.Kill(nil)
+}
+
+// Wait blocks until the forwarder has stopped.
+func (f *Forwarder) Wait() error {
+	return f.tomb.Wait()
+}
+
+// Close stops the forwarder. Any records which haven't been delivered are
+// kept in the spool.
+func (f *Forwarder) Close() error {
+	f.Kill()
+	return f.Wait()
+}
+
+func (f *Forwarder) loop() error {
+	ctx := f.tomb.Context(context.Background())
+
+	delay := f.cfg.FlushInterval
+	timer := f.cfg.Clock.NewTimer(delay)
+	defer timer.Stop()
+
+	var (
+		queue   []logger.LogRecord
+		failing bool
+	)
+	for {
+		select {
+		case <-f.tomb.Dying():
+			// Keep everything that hasn't been delivered, so that it is
+			// sent by the next forwarder for the target.
+			queue = append(queue, f.drainQueued()...)
+			if err := f.appendSpool(queue); err != nil {
+				return errors.Errorf("spooling %d log records: %w", len(queue), err)
+			}
+			return tomb.ErrDying
+
+		case records := <-f.in:
+			queue = append(queue, records...)
+			if len(queue) < f.cfg.BatchSize {
+				continue
+			}
+			if failing {
+				// Whilst the target is unavailable move the records to the
+				// spool, so that they don't hold up the writers of the logs.
+				if err := f.appendSpool(queue); err != nil {
+					return errors.Errorf("spooling %d log records: %w", len(queue), err)
+				}
+				queue = nil
+				continue
+			}
+
+		case <-timer.Chan():
+		}
+
+		if n := f.overflowed.Swap(0); n > 0 {
+			f.cfg.Logger.Warningf(ctx, "dropped %d log records for %s as the queue was full", n, f.cfg.Target.Name())
+		}
+
+		var err error
+		if queue, err = f.flush(ctx, queue); err != nil {
+			if !failing {
+				f.cfg.Logger.Warningf(ctx, "forwarding logs to %s, spooling until it is available: %v", f.cfg.Target.Name(), err)
+			}
+			if err := f.appendSpool(queue); err != nil {
+				return errors.Errorf("spooling %d log records: %w", len(queue), err)
+			}
+			queue = nil
+			failing = true
+
+			// Back off while the target is failing, but never wait
+			// longer than the maximum retry delay.
+			delay = min(delay*2, maxRetryDelay)
+		} else {
+			if failing {
+				f.cfg.Logger.Infof(ctx, "forwarding logs to %s resumed", f.cfg.Target.Name())
+			}
+			failing = false
+			delay = f.cfg.FlushInterval
+		}
+		timer.Reset(delay)
+	}
+}
+
+// drainQueued returns the records queued by Log that haven't been read yet.
+func (f *Forwarder) drainQueued() []logger.LogRecord {
+	var queued []logger.LogRecord
+	for {
+		select {
+		case records := <-f.in:
+			queued = append(queued, records...)
+		default:
+			return queued
+		}
+	}
+}
+
+// flush delivers the spool followed by the queue to the target, in batches.
+// The records which couldn't be delivered are returned.
+func (f *Forwarder) flush(ctx context.Context, queue []logger.LogRecord) ([]logger.LogRecord, error) {
+	if f.spoolSize > 0 {
+		if err := f.flushSpool(ctx); err != nil {
+			return queue, errors.Capture(err)
+		}
+	}
+	for len(queue) > 0 {
+		batch := queue[:min(len(queue), f.cfg.BatchSize)]
+		if err := f.cfg.Target.Send(ctx, batch); err != nil {
+			return queue, errors.Capture(err)
+		}
+		queue = queue[len(batch):]
+	}
+	return nil, nil
+}
+
+// flushSpool delivers the records in the spool to the target, reading them
+// a batch at a time so that the spool is never held in memory. If delivery
+// fails part way through, the records which were delivered are removed from
+// the spool.
+func (f *Forwarder) flushSpool(ctx context.Context) error {
+	file, err := os.Open(f.spoolPath)
+	if errors.Is(err, os.ErrNotExist) {
+		f.spoolSize = 0
+		return nil
+	} else if err != nil {
+		return errors.Errorf("reading spool: %w", err)
+	}
+	defer file.Close()
+
+	var (
+		reader = bufio.NewReader(file)
+		batch  []logger.LogRecord
+		// read is the offset of the end of the records read, and sent the
+		// offset of the end of the records delivered.
+		read, sent int64
+	)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return errors.Errorf("reading spool: %w", err)
+		}
+		eof := err != nil
+		read += int64(len(line))
+
+		// A partially written record can only be at the end of the
+		// spool, if the controller stopped mid-write.
+		var r logger.LogRecord
+		if len(bytes.TrimSpace(line)) > 0 && json.Unmarshal(line, &r) == nil {
+			batch = append(batch, r)
+		}
+
+		if len(batch) > 0 && (len(batch) == f.cfg.BatchSize || eof) {
+			if err := f.cfg.Target.Send(ctx, batch); err != nil {
+				if sent > 0 {
+					if rerr := f.trimSpool(file, sent); rerr != nil {
+						return errors.Errorf("trimming spool: %w", rerr)
+					}
+				}
+				return errors.Capture(err)
+			}
+			batch = nil
+			sent = read
+		}
+		if eof {
+			break
+		}
+	}
+
+	if err := os.Remove(f.spoolPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Errorf("removing spool: %w", err)
+	}
+	f.spoolSize = 0
+	if f.dropped > 0 {
+		f.cfg.Logger.Warningf(ctx, "dropped %d log records for %s as the spool was full", f.dropped, f.cfg.Target.Name())
+		f.dropped = 0
+	}
+	return nil
+}
+
+// appendSpool appends the records to the spool, dropping any records which
+// would grow the spool beyond its maximum size.
+func (f *Forwarder) appendSpool(records []logger.LogRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	file, err := os.OpenFile(f.spoolPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	buf := bufio.NewWriter(file)
+	for i, r := range records {
+		line, err := json.Marshal(r)
+		if err != nil {
+			continue
+		}
+		if f.spoolSize+int64(len(line))+1 > f.cfg.MaxSpoolSize {
+			f.dropped += len(records) - i
+			break
+		}
+		_, _ = buf.Write(line)
+		_ = buf.WriteByte('\n')
+		f.spoolSize += int64(len(line)) + 1
+	}
+	if err := buf.Flush(); err != nil {
+		_ = file.Close()
+		return errors.Capture(err)
+	}
+	return errors.Capture(file.Close())
+}
+
+// trimSpool removes the records before offset, which have been delivered,
+// from the spool. The remaining records are copied as they are, without
+// being decoded.
+func (f *Forwarder) trimSpool(spool *os.File, offset int64) error {
+	if _, err := spool.Seek(offset, io.SeekStart); err != nil {
+		return errors.Capture(err)
+	}
+	tmpPath := f.spoolPath + ".tmp"
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	size, err := io.Copy(file, spool)
+	if err != nil {
+		_ = file.Close()
+		return errors.Capture(err)
+	}
+	if err := file.Close(); err != nil {
+		return errors.Capture(err)
+	}
+	if err := os.Rename(tmpPath, f.spoolPath); err != nil {
+		return errors.Capture(err)
+	}
+	f.spoolSize = size
+	return nil
+}
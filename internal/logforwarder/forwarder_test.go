@@ -0,0 +1,354 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/logforwarder"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type forwarderSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestForwarderSuite(t *testing.T) {
+	tc.Run(t, &forwarderSuite{})
+}
+
+type fakeTarget struct {
+	mu       sync.Mutex
+	failures int
+	block    chan struct{}
+
+	attempts chan struct{}
+	sent     chan []logger.LogRecord
+}
+
+func newFakeTarget(failures int) *fakeTarget {
+	return &fakeTarget{
+		failures: failures,
+		attempts: make(chan struct{}, 100),
+		sent:     make(chan []logger.LogRecord, 100),
+	}
+}
+
+func (t *fakeTarget) Name() string {
+	return "fake"
+}
+
+func (t *fakeTarget) Send(ctx context.Context, records []logger.LogRecord) error {
+	t.attempts <- struct{}{}
+	if t.block != nil {
+		select {
+		case <-t.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.failures > 0 {
+		t.failures--
+		return errors.New("target unavailable")
+	}
+	t.sent <- append([]logger.LogRecord(nil), records...)
+	return nil
+}
+
+func (t *fakeTarget) waitForAttempt(c *tc.C) {
+	select {
+	case <-t.attempts:
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for send attempt")
+	}
+}
+
+func (t *fakeTarget) nextBatch(c *tc.C) []logger.LogRecord {
+	select {
+	case batch := <-t.sent:
+		return batch
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for batch")
+	}
+	return nil
+}
+
+func record(msg string) logger.LogRecord {
+	return logger.LogRecord{
+		Time:      recordTime,
+		ModelUUID: "model-uuid",
+		Entity:    "machine-0",
+		Level:     logger.INFO,
+		Module:    "juju.test",
+		Message:   msg,
+	}
+}
+
+func (s *forwarderSuite) config(c *tc.C, target logforwarder.Target, dir string) logforwarder.Config {
+	return logforwarder.Config{
+		Target:        target,
+		SpoolDir:      dir,
+		BatchSize:     2,
+		FlushInterval: time.Second,
+		Clock:         testclock.NewClock(time.Now()),
+		Logger:        loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *forwarderSuite) TestValidate(c *tc.C) {
+	cfg := s.config(c, newFakeTarget(0), c.MkDir())
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	noTarget := cfg
+	noTarget.Target = nil
+	c.Check(noTarget.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	noSpool := cfg
+	noSpool.SpoolDir = ""
+	c.Check(noSpool.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	badQueue := cfg
+	badQueue.QueueSize = -1
+	c.Check(badQueue.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	badSpool := cfg
+	badSpool.MaxSpoolSize = -1
+	c.Check(badSpool.Validate(), tc.ErrorIs, coreerrors.NotValid)
+
+	noClock := cfg
+	noClock.Clock = nil
+	c.Check(noClock.Validate(), tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *forwarderSuite) TestFullBatchIsSent(c *tc.C) {
+	target := newFakeTarget(0)
+	f, err := logforwarder.NewForwarder(s.config(c, target, c.MkDir()))
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+
+	c.Assert(f.Log([]logger.LogRecord{record("one")}), tc.ErrorIsNil)
+	c.Assert(f.Log([]logger.LogRecord{record("two")}), tc.ErrorIsNil)
+
+	c.Check(target.nextBatch(c), tc.DeepEquals, []logger.LogRecord{record("one"), record("two")})
+}
+
+func (s *forwarderSuite) TestPartialBatchIsSentOnFlush(c *tc.C) {
+	target := newFakeTarget(0)
+	cfg := s.config(c, target, c.MkDir())
+	clock := cfg.Clock.(*testclock.Clock)
+	f, err := logforwarder.NewForwarder(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+
+	c.Assert(f.Log([]logger.LogRecord{record("one")}), tc.ErrorIsNil)
+	c.Assert(clock.WaitAdvance(time.Second, testhelpers.LongWait, 1), tc.ErrorIsNil)
+
+	c.Check(target.nextBatch(c), tc.DeepEquals, []logger.LogRecord{record("one")})
+}
+
+func (s *forwarderSuite) TestSpoolsWhileTargetUnavailable(c *tc.C) {
+	target := newFakeTarget(1)
+	dir := c.MkDir()
+	cfg := s.config(c, target, dir)
+	clock := cfg.Clock.(*testclock.Clock)
+	f, err := logforwarder.NewForwarder(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+
+	// The first delivery fails, so the records are spooled.
+	c.Assert(f.Log([]logger.LogRecord{record("one"), record("two")}), tc.ErrorIsNil)
+	target.waitForAttempt(c)
+
+	// Whilst the target is unavailable, full batches go straight to the
+	// spool.
+	c.Assert(f.Log([]logger.LogRecord{record("three"), record("four")}), tc.ErrorIsNil)
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if spoolLines(c, dir) == 4 {
+			break
+		}
+	}
+	c.Assert(spoolLines(c, dir), tc.Equals, 4)
+
+	// Once the target is available again, the spool is delivered in
+	// order, and removed.
+	c.Assert(clock.WaitAdvance(2*time.Second, testhelpers.LongWait, 1), tc.ErrorIsNil)
+	c.Check(target.nextBatch(c), tc.DeepEquals, []logger.LogRecord{record("one"), record("two")})
+	c.Check(target.nextBatch(c), tc.DeepEquals, []logger.LogRecord{record("three"), record("four")})
+
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if spoolLines(c, dir) == 0 {
+			return
+		}
+	}
+	c.Fatalf("spool not removed")
+}
+
+func (s *forwarderSuite) TestSpoolSurvivesRestart(c *tc.C) {
+	dir := c.MkDir()
+
+	// Records that haven't been delivered when the forwarder is stopped
+	// are spooled.
+	f, err := logforwarder.NewForwarder(s.config(c, newFakeTarget(0), dir))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(f.Log([]logger.LogRecord{record("one")}), tc.ErrorIsNil)
+	c.Assert(f.Close(), tc.ErrorIsNil)
+	c.Assert(spoolLines(c, dir), tc.Equals, 1)
+
+	target := newFakeTarget(0)
+	cfg := s.config(c, target, dir)
+	clock := cfg.Clock.(*testclock.Clock)
+	f, err = logforwarder.NewForwarder(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+
+	c.Assert(clock.WaitAdvance(time.Second, testhelpers.LongWait, 1), tc.ErrorIsNil)
+	c.Check(target.nextBatch(c), tc.DeepEquals, []logger.LogRecord{record("one")})
+}
+
+// failSecondTarget fails the second send, and delivers all others.
+type failSecondTarget struct {
+	*fakeTarget
+	sends int
+}
+
+func (t *failSecondTarget) Send(ctx context.Context, records []logger.LogRecord) error {
+	t.sends++
+	if t.sends == 2 {
+		t.attempts <- struct{}{}
+		return errors.New("target unavailable")
+	}
+	return t.fakeTarget.Send(ctx, records)
+}
+
+func (s *forwarderSuite) TestSpoolPartiallyDelivered(c *tc.C) {
+	dir := c.MkDir()
+	writeSpool(c, dir, record("one"), record("two"), record("three"), record("four"))
+
+	target := &failSecondTarget{fakeTarget: newFakeTarget(0)}
+	cfg := s.config(c, target, dir)
+	clock := cfg.Clock.(*testclock.Clock)
+	f, err := logforwarder.NewForwarder(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+
+	// The first batch of the spool is delivered, but the second isn't, so
+	// only the first is removed from the spool.
+	c.Assert(clock.WaitAdvance(time.Second, testhelpers.LongWait, 1), tc.ErrorIsNil)
+	c.Check(target.nextBatch(c), tc.DeepEquals, []logger.LogRecord{record("one"), record("two")})
+	target.waitForAttempt(c)
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if spoolLines(c, dir) == 2 {
+			break
+		}
+	}
+	c.Assert(spoolLines(c, dir), tc.Equals, 2)
+
+	// The rest of the spool is delivered on the next attempt.
+	c.Assert(clock.WaitAdvance(2*time.Second, testhelpers.LongWait, 1), tc.ErrorIsNil)
+	c.Check(target.nextBatch(c), tc.DeepEquals, []logger.LogRecord{record("three"), record("four")})
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if spoolLines(c, dir) == 0 {
+			return
+		}
+	}
+	c.Fatalf("spool not removed")
+}
+
+func (s *forwarderSuite) TestSpoolIsBounded(c *tc.C) {
+	dir := c.MkDir()
+	cfg := s.config(c, newFakeTarget(0), dir)
+	cfg.MaxSpoolSize = 300
+	f, err := logforwarder.NewForwarder(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(f.Log([]logger.LogRecord{record("one")}), tc.ErrorIsNil)
+	c.Assert(f.Log([]logger.LogRecord{record("two")}), tc.ErrorIsNil)
+	c.Assert(f.Log([]logger.LogRecord{record("three")}), tc.ErrorIsNil)
+	c.Assert(f.Close(), tc.ErrorIsNil)
+
+	// Each record is larger than 100 bytes, so not all of them fit.
+	lines := spoolLines(c, dir)
+	c.Check(lines > 0 && lines < 3, tc.IsTrue, tc.Commentf("%d records spooled", lines))
+}
+
+func (s *forwarderSuite) TestFullQueueDrops(c *tc.C) {
+	target := newFakeTarget(0)
+	target.block = make(chan struct{})
+	cfg := s.config(c, target, c.MkDir())
+	cfg.BatchSize = 1
+	cfg.QueueSize = 1
+	f, err := logforwarder.NewForwarder(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+
+	// The first record is being sent, and the second fills the queue.
+	c.Assert(f.Log([]logger.LogRecord{record("one")}), tc.ErrorIsNil)
+	target.waitForAttempt(c)
+	c.Assert(f.Log([]logger.LogRecord{record("two")}), tc.ErrorIsNil)
+
+	// The queue is full, so the third record is dropped without blocking
+	// the writer.
+	logged := make(chan error, 1)
+	go func() {
+		logged <- f.Log([]logger.LogRecord{record("three")})
+	}()
+	select {
+	case err := <-logged:
+		c.Assert(err, tc.ErrorIsNil)
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("Log blocked with a full queue")
+	}
+	c.Check(logforwarder.Overflowed(f), tc.Equals, int64(1))
+
+	close(target.block)
+	c.Check(target.nextBatch(c), tc.DeepEquals, []logger.LogRecord{record("one")})
+	c.Check(target.nextBatch(c), tc.DeepEquals, []logger.LogRecord{record("two")})
+	select {
+	case batch := <-target.sent:
+		c.Fatalf("unexpected batch %v", batch)
+	case <-time.After(testhelpers.ShortWait):
+	}
+}
+
+func spoolLines(c *tc.C, dir string) int {
+	data, err := os.ReadFile(filepath.Join(dir, "fake-spool.jsonl"))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0
+	}
+	c.Assert(err, tc.ErrorIsNil)
+	var lines int
+	for _, b := range data {
+		if b == '\n' {
+			lines++
+		}
+	}
+	return lines
+}
+
+func writeSpool(c *tc.C, dir string, records ...logger.LogRecord) {
+	file, err := os.Create(filepath.Join(dir, "fake-spool.jsonl"))
+	c.Assert(err, tc.ErrorIsNil)
+	defer file.Close()
+	enc := json.NewEncoder(file)
+	for _, r := range records {
+		c.Assert(enc.Encode(r), tc.ErrorIsNil)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+// LokiTarget forwards log records to the push API of a Loki server.
+type LokiTarget struct {
+	url    string
+	client HTTPClient
+}
+
+// NewLokiTarget returns a target that pushes log records to the given Loki
+// push endpoint, e.g. http://loki:3100/loki/api/v1/push. If client is nil, a
+// default client is used.
+func NewLokiTarget(url string, client HTTPClient) *LokiTarget {
+	return &LokiTarget{
+		url:    url,
+		client: defaultHTTPClient(client),
+	}
+}
+
+// Name implements Target.
+func (t *LokiTarget) Name() string {
+	return "loki"
+}
+
+// Send implements Target. Records are grouped into streams labelled with
+// the model UUID, entity, module and level of the records.
+func (t *LokiTarget) Send(ctx context.Context, records []logger.LogRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if err := postJSON(ctx, t.client, t.url, lokiPush(records)); err != nil {
+		return errors.Errorf("pushing logs to loki: %w", err)
+	}
+	return nil
+}
+
+type lokiPushRequest struct {
+	Streams []*lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiStreamKey struct {
+	modelUUID, entity, module string
+	level                     logger.Level
+}
+
+func lokiPush(records []logger.LogRecord) lokiPushRequest {
+	var req lokiPushRequest
+	streams := make(map[lokiStreamKey]*lokiStream)
+	for _, r := range records {
+		key := lokiStreamKey{
+			modelUUID: r.ModelUUID,
+			entity:    r.Entity,
+			module:    r.Module,
+			level:     r.Level,
+		}
+		stream, ok := streams[key]
+		if !ok {
+			stream = &lokiStream{
+				Stream: map[string]string{
+					"job":        "juju",
+					"model_uuid": r.ModelUUID,
+					"entity":     r.Entity,
+					"module":     r.Module,
+					"level":      strings.ToLower(r.Level.String()),
+				},
+			}
+			streams[key] = stream
+			req.Streams = append(req.Streams, stream)
+		}
+		line := r.Message
+		if r.Location != "" {
+			line = r.Location + " " + line
+		}
+		stream.Values = append(stream.Values, [2]string{
+			strconv.FormatInt(r.Time.UnixNano(), 10),
+			line,
+		})
+	}
+	return req
+}
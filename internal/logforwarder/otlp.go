@@ -0,0 +1,158 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+// OTLPTarget forwards log records to an OpenTelemetry collector, using the
+// JSON encoding of the OTLP/HTTP logs protocol.
+type OTLPTarget struct {
+	url    string
+	client HTTPClient
+}
+
+// NewOTLPTarget returns a target that exports log records to the given
+// OTLP/HTTP logs endpoint, e.g. http://collector:4318/v1/logs. If client is
+// nil, a default client is used.
+func NewOTLPTarget(url string, client HTTPClient) *OTLPTarget {
+	return &OTLPTarget{
+		url:    url,
+		client: defaultHTTPClient(client),
+	}
+}
+
+// Name implements Target.
+func (t *OTLPTarget) Name() string {
+	return "otlp"
+}
+
+// Send implements Target. Records are grouped into resources identified by
+// the model UUID and entity, and into scopes named after the module.
+func (t *OTLPTarget) Send(ctx context.Context, records []logger.LogRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if err := postJSON(ctx, t.client, t.url, otlpExport(records)); err != nil {
+		return errors.Errorf("exporting logs to otlp endpoint: %w", err)
+	}
+	return nil
+}
+
+type otlpExportRequest struct {
+	ResourceLogs []*otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource     `json:"resource"`
+	ScopeLogs []*otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string         `json:"timeUnixNano"`
+	SeverityNumber int            `json:"severityNumber"`
+	SeverityText   string         `json:"severityText"`
+	Body           otlpAnyValue   `json:"body"`
+	Attributes     []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// otlpSeverity maps the juju log levels onto the OpenTelemetry severity
+// numbers.
+var otlpSeverity = map[logger.Level]int{
+	logger.TRACE:    1,
+	logger.DEBUG:    5,
+	logger.INFO:     9,
+	logger.WARNING:  13,
+	logger.ERROR:    17,
+	logger.CRITICAL: 21,
+}
+
+type otlpResourceKey struct {
+	modelUUID, entity string
+}
+
+func otlpExport(records []logger.LogRecord) otlpExportRequest {
+	var req otlpExportRequest
+	resources := make(map[otlpResourceKey]*otlpResourceLogs)
+	scopes := make(map[otlpResourceKey]map[string]*otlpScopeLogs)
+	for _, r := range records {
+		key := otlpResourceKey{modelUUID: r.ModelUUID, entity: r.Entity}
+		resource, ok := resources[key]
+		if !ok {
+			resource = &otlpResourceLogs{
+				Resource: otlpResource{
+					Attributes: []otlpKeyValue{
+						stringKeyValue("service.name", "juju"),
+						stringKeyValue("juju.model.uuid", r.ModelUUID),
+						stringKeyValue("juju.entity", r.Entity),
+					},
+				},
+			}
+			resources[key] = resource
+			scopes[key] = make(map[string]*otlpScopeLogs)
+			req.ResourceLogs = append(req.ResourceLogs, resource)
+		}
+		scope, ok := scopes[key][r.Module]
+		if !ok {
+			scope = &otlpScopeLogs{Scope: otlpScope{Name: r.Module}}
+			scopes[key][r.Module] = scope
+			resource.ScopeLogs = append(resource.ScopeLogs, scope)
+		}
+		scope.LogRecords = append(scope.LogRecords, otlpRecord(r))
+	}
+	return req
+}
+
+func otlpRecord(r logger.LogRecord) otlpLogRecord {
+	record := otlpLogRecord{
+		TimeUnixNano:   strconv.FormatInt(r.Time.UnixNano(), 10),
+		SeverityNumber: otlpSeverity[r.Level],
+		SeverityText:   r.Level.String(),
+		Body:           otlpAnyValue{StringValue: r.Message},
+	}
+	if r.Location != "" {
+		record.Attributes = append(record.Attributes, stringKeyValue("code.location", r.Location))
+	}
+	labels := make([]string, 0, len(r.Labels))
+	for k := range r.Labels {
+		labels = append(labels, k)
+	}
+	sort.Strings(labels)
+	for _, k := range labels {
+		record.Attributes = append(record.Attributes, stringKeyValue("juju.label."+k, r.Labels[k]))
+	}
+	return record
+}
+
+func stringKeyValue(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: value}}
+}
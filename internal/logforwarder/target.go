@@ -0,0 +1,67 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+// requestTimeout is the maximum time allowed for a single request to a
+// target endpoint.
+const requestTimeout = 30 * time.Second
+
+// Target is an endpoint that log records are forwarded to.
+type Target interface {
+	// Name returns a short name for the target, which is used to identify
+	// the spool for the target.
+	Name() string
+
+	// Send delivers the records to the endpoint. An error is returned if
+	// the endpoint didn't accept the records.
+	Send(ctx context.Context, records []logger.LogRecord) error
+}
+
+// HTTPClient is the subset of *http.Client used to call the endpoints.
+type HTTPClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+func defaultHTTPClient(client HTTPClient) HTTPClient {
+	if client != nil {
+		return client
+	}
+	return &http.Client{Timeout: requestTimeout}
+}
+
+func postJSON(ctx context.Context, client HTTPClient, url string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Capture(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("unexpected response status %q", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/logforwarder"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type targetSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestTargetSuite(t *testing.T) {
+	tc.Run(t, &targetSuite{})
+}
+
+var recordTime = time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+
+func testRecords() []logger.LogRecord {
+	return []logger.LogRecord{{
+		Time:      recordTime,
+		ModelUUID: "model-uuid",
+		Entity:    "unit-mysql-0",
+		Level:     logger.INFO,
+		Module:    "juju.worker.uniter",
+		Location:  "uniter.go:42",
+		Message:   "hook started",
+	}, {
+		Time:      recordTime.Add(time.Second),
+		ModelUUID: "model-uuid",
+		Entity:    "unit-mysql-0",
+		Level:     logger.INFO,
+		Module:    "juju.worker.uniter",
+		Message:   "hook finished",
+		Labels:    map[string]string{"hook": "install"},
+	}, {
+		Time:      recordTime.Add(2 * time.Second),
+		ModelUUID: "model-uuid",
+		Entity:    "machine-0",
+		Level:     logger.ERROR,
+		Module:    "juju.worker.provisioner",
+		Message:   "boom",
+	}}
+}
+
+// standIn records the requests made to it.
+type standIn struct {
+	*httptest.Server
+	bodies chan []byte
+	status int
+}
+
+func newStandIn(status int) *standIn {
+	s := &standIn{
+		bodies: make(chan []byte, 10),
+		status: status,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		s.bodies <- body
+		w.WriteHeader(s.status)
+	}))
+	return s
+}
+
+func (s *standIn) body(c *tc.C) map[string]any {
+	select {
+	case body := <-s.bodies:
+		var payload map[string]any
+		c.Assert(json.Unmarshal(body, &payload), tc.ErrorIsNil)
+		return payload
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for request")
+	}
+	return nil
+}
+
+func (s *targetSuite) TestLoki(c *tc.C) {
+	srv := newStandIn(http.StatusNoContent)
+	defer srv.Close()
+
+	target := logforwarder.NewLokiTarget(srv.URL+"/loki/api/v1/push", nil)
+	c.Check(target.Name(), tc.Equals, "loki")
+	err := target.Send(c.Context(), testRecords())
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(srv.body(c), tc.DeepEquals, map[string]any{
+		"streams": []any{
+			map[string]any{
+				"stream": map[string]any{
+					"job":        "juju",
+					"model_uuid": "model-uuid",
+					"entity":     "unit-mysql-0",
+					"module":     "juju.worker.uniter",
+					"level":      "info",
+				},
+				"values": []any{
+					[]any{"1741089600000000000", "uniter.go:42 hook started"},
+					[]any{"1741089601000000000", "hook finished"},
+				},
+			},
+			map[string]any{
+				"stream": map[string]any{
+					"job":        "juju",
+					"model_uuid": "model-uuid",
+					"entity":     "machine-0",
+					"module":     "juju.worker.provisioner",
+					"level":      "error",
+				},
+				"values": []any{
+					[]any{"1741089602000000000", "boom"},
+				},
+			},
+		},
+	})
+}
+
+func (s *targetSuite) TestLokiError(c *tc.C) {
+	srv := newStandIn(http.StatusBadRequest)
+	defer srv.Close()
+
+	target := logforwarder.NewLokiTarget(srv.URL, nil)
+	err := target.Send(c.Context(), testRecords())
+	c.Assert(err, tc.ErrorMatches, `pushing logs to loki: unexpected response status "400 Bad Request"`)
+}
+
+func (s *targetSuite) TestOTLP(c *tc.C) {
+	srv := newStandIn(http.StatusOK)
+	defer srv.Close()
+
+	target := logforwarder.NewOTLPTarget(srv.URL+"/v1/logs", nil)
+	c.Check(target.Name(), tc.Equals, "otlp")
+	err := target.Send(c.Context(), testRecords())
+	c.Assert(err, tc.ErrorIsNil)
+
+	str := func(k, v string) map[string]any {
+		return map[string]any{"key": k, "value": map[string]any{"stringValue": v}}
+	}
+	c.Check(srv.body(c), tc.DeepEquals, map[string]any{
+		"resourceLogs": []any{
+			map[string]any{
+				"resource": map[string]any{
+					"attributes": []any{
+						str("service.name", "juju"),
+						str("juju.model.uuid", "model-uuid"),
+						str("juju.entity", "unit-mysql-0"),
+					},
+				},
+				"scopeLogs": []any{
+					map[string]any{
+						"scope": map[string]any{"name": "juju.worker.uniter"},
+						"logRecords": []any{
+							map[string]any{
+								"timeUnixNano":   "1741089600000000000",
+								"severityNumber": float64(9),
+								"severityText":   "INFO",
+								"body":           map[string]any{"stringValue": "hook started"},
+								"attributes": []any{
+									str("code.location", "uniter.go:42"),
+								},
+							},
+							map[string]any{
+								"timeUnixNano":   "1741089601000000000",
+								"severityNumber": float64(9),
+								"severityText":   "INFO",
+								"body":           map[string]any{"stringValue": "hook finished"},
+								"attributes": []any{
+									str("juju.label.hook", "install"),
+								},
+							},
+						},
+					},
+				},
+			},
+			map[string]any{
+				"resource": map[string]any{
+					"attributes": []any{
+						str("service.name", "juju"),
+						str("juju.model.uuid", "model-uuid"),
+						str("juju.entity", "machine-0"),
+					},
+				},
+				"scopeLogs": []any{
+					map[string]any{
+						"scope": map[string]any{"name": "juju.worker.provisioner"},
+						"logRecords": []any{
+							map[string]any{
+								"timeUnixNano":   "1741089602000000000",
+								"severityNumber": float64(17),
+								"severityText":   "ERROR",
+								"body":           map[string]any{"stringValue": "boom"},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func (s *targetSuite) TestOTLPError(c *tc.C) {
+	srv := newStandIn(http.StatusServiceUnavailable)
+	defer srv.Close()
+
+	target := logforwarder.NewOTLPTarget(srv.URL, nil)
+	err := target.Send(c.Context(), testRecords())
+	c.Assert(err, tc.ErrorMatches, `exporting logs to otlp endpoint: unexpected response status "503 Service Unavailable"`)
+}
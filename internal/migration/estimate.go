@@ -0,0 +1,126 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration
+
+import (
+	"context"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/description/v10"
+
+	domaincharm "github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/internal/charm"
+	internalerrors "github.com/juju/juju/internal/errors"
+)
+
+// CharmSizer returns the size in bytes of the archive of the charm with
+// the given URL.
+type CharmSizer func(ctx context.Context, charmURL string) (int64, error)
+
+// CharmArchiveSizeGetter provides the sizes of stored charm archives.
+type CharmArchiveSizeGetter interface {
+	// GetCharmArchiveSize returns the size in bytes of the archive for the
+	// charm using the charm name, source and revision.
+	GetCharmArchiveSize(context.Context, domaincharm.CharmLocator) (int64, error)
+}
+
+// CharmArchiveSizer returns a CharmSizer that reports the sizes of the
+// archives recorded in the object store metadata, without reading them.
+func CharmArchiveSizer(charmService CharmArchiveSizeGetter) CharmSizer {
+	return func(ctx context.Context, charmURL string) (int64, error) {
+		curl, err := charm.ParseURL(charmURL)
+		if err != nil {
+			return 0, internalerrors.Errorf("bad charm URL: %w", err)
+		}
+		charmSource, err := domaincharm.ParseCharmSchema(charm.Schema(curl.Schema))
+		if err != nil {
+			return 0, internalerrors.Errorf("bad charm URL schema: %w", err)
+		}
+		size, err := charmService.GetCharmArchiveSize(ctx, domaincharm.CharmLocator{
+			Name:     curl.Name,
+			Revision: curl.Revision,
+			Source:   charmSource,
+		})
+		if err != nil {
+			return 0, internalerrors.Errorf("getting charm archive size: %w", err)
+		}
+		return size, nil
+	}
+}
+
+// TransferEstimate holds an estimate of the binaries that a migration
+// sends from the source controller to the target controller.
+type TransferEstimate struct {
+	// Charms is the number of charm archives to transfer.
+	Charms int
+	// CharmBytes is the total size of the charm archives.
+	CharmBytes int64
+
+	// Resources is the number of resource blobs to transfer.
+	Resources int
+	// ResourceBytes is the total size of the resource blobs.
+	ResourceBytes int64
+
+	// AgentBinaries is the number of agent binaries to transfer.
+	AgentBinaries int
+	// AgentBinaryBytes is the total size of the agent binaries.
+	AgentBinaryBytes int64
+}
+
+// EstimateTransfer estimates the binaries that a migration of the
+// described model sends to the target controller. It considers the same
+// charms, resources and agent binaries that are uploaded by
+// UploadBinaries.
+func EstimateTransfer(ctx context.Context, model description.Model, charmSize CharmSizer) (TransferEstimate, error) {
+	var estimate TransferEstimate
+
+	charmURLs := set.NewStrings()
+	for _, app := range model.Applications() {
+		charmURLs.Add(app.CharmURL())
+	}
+	for _, charmURL := range charmURLs.SortedValues() {
+		size, err := charmSize(ctx, charmURL)
+		if err != nil {
+			return TransferEstimate{}, internalerrors.Errorf("getting size of charm %q: %w", charmURL, err)
+		}
+		estimate.Charms++
+		estimate.CharmBytes += size
+	}
+
+	for _, app := range model.Applications() {
+		for _, res := range app.Resources() {
+			rev := res.ApplicationRevision()
+			// A resource without a fingerprint has no blob to upload.
+			if rev == nil || rev.SHA384() == "" {
+				continue
+			}
+			estimate.Resources++
+			estimate.ResourceBytes += rev.Size()
+		}
+	}
+
+	// Agent binaries are uploaded once for each distinct binary.
+	agentBinaries := set.NewStrings()
+	addTools := func(tools description.AgentTools) {
+		if tools == nil || agentBinaries.Contains(tools.SHA256()) {
+			return
+		}
+		agentBinaries.Add(tools.SHA256())
+		estimate.AgentBinaries++
+		estimate.AgentBinaryBytes += tools.Size()
+	}
+	for _, machine := range model.Machines() {
+		addTools(machine.Tools())
+		for _, container := range machine.Containers() {
+			addTools(container.Tools())
+		}
+	}
+	for _, app := range model.Applications() {
+		for _, unit := range app.Units() {
+			addTools(unit.Tools())
+		}
+	}
+
+	return estimate, nil
+}
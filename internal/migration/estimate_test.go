@@ -0,0 +1,120 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration_test
+
+import (
+	"context"
+	stdtesting "testing"
+
+	"github.com/juju/description/v10"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	domaincharm "github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/internal/migration"
+)
+
+type EstimateSuite struct{}
+
+func TestEstimateSuite(t *stdtesting.T) {
+	tc.Run(t, &EstimateSuite{})
+}
+
+func (s *EstimateSuite) TestEstimateTransferEmpty(c *tc.C) {
+	model := description.NewModel(description.ModelArgs{})
+	estimate, err := migration.EstimateTransfer(c.Context(), model, func(context.Context, string) (int64, error) {
+		c.Fatalf("unexpected charm size request")
+		return 0, nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(estimate, tc.DeepEquals, migration.TransferEstimate{})
+}
+
+func (s *EstimateSuite) TestEstimateTransfer(c *tc.C) {
+	model := description.NewModel(description.ModelArgs{})
+
+	tools := description.AgentToolsArgs{
+		Version: "4.0.0-ubuntu-amd64",
+		SHA256:  "abc",
+		Size:    100,
+	}
+	machine := model.AddMachine(description.MachineArgs{Id: "0"})
+	machine.SetTools(tools)
+	container := machine.AddContainer(description.MachineArgs{Id: "0/lxd/0"})
+	container.SetTools(description.AgentToolsArgs{
+		Version: "4.0.0-ubuntu-arm64",
+		SHA256:  "def",
+		Size:    200,
+	})
+
+	app := model.AddApplication(description.ApplicationArgs{
+		Name:     "postgresql",
+		CharmURL: "ch:postgresql-42",
+	})
+	app.AddUnit(description.UnitArgs{Name: "postgresql/0"}).SetTools(tools)
+	app.AddResource(description.ResourceArgs{Name: "image"}).SetApplicationRevision(description.ResourceRevisionArgs{
+		SHA384: "ghi",
+		Size:   1000,
+	})
+	// A resource without a fingerprint has no blob to transfer.
+	app.AddResource(description.ResourceArgs{Name: "empty"}).SetApplicationRevision(description.ResourceRevisionArgs{})
+	model.AddApplication(description.ApplicationArgs{
+		Name:     "postgresql-replica",
+		CharmURL: "ch:postgresql-42",
+	})
+	model.AddApplication(description.ApplicationArgs{
+		Name:     "pgbouncer",
+		CharmURL: "ch:pgbouncer-7",
+	})
+
+	sizes := map[string]int64{
+		"ch:postgresql-42": 5000,
+		"ch:pgbouncer-7":   3000,
+	}
+	var requested []string
+	estimate, err := migration.EstimateTransfer(c.Context(), model, func(_ context.Context, charmURL string) (int64, error) {
+		requested = append(requested, charmURL)
+		return sizes[charmURL], nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(requested, tc.DeepEquals, []string{"ch:pgbouncer-7", "ch:postgresql-42"})
+	c.Check(estimate, tc.DeepEquals, migration.TransferEstimate{
+		Charms:           2,
+		CharmBytes:       8000,
+		Resources:        1,
+		ResourceBytes:    1000,
+		AgentBinaries:    2,
+		AgentBinaryBytes: 300,
+	})
+}
+
+func (s *EstimateSuite) TestEstimateTransferCharmError(c *tc.C) {
+	model := description.NewModel(description.ModelArgs{})
+	model.AddApplication(description.ApplicationArgs{
+		Name:     "postgresql",
+		CharmURL: "ch:postgresql-42",
+	})
+
+	_, err := migration.EstimateTransfer(c.Context(), model, func(context.Context, string) (int64, error) {
+		return 0, errors.New("boom")
+	})
+	c.Assert(err, tc.ErrorMatches, `getting size of charm "ch:postgresql-42": boom`)
+}
+
+func (s *EstimateSuite) TestCharmArchiveSizer(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	charmService := NewMockCharmArchiveSizeGetter(ctrl)
+	charmService.EXPECT().GetCharmArchiveSize(gomock.Any(), domaincharm.CharmLocator{
+		Name:     "postgresql",
+		Revision: 42,
+		Source:   domaincharm.CharmHubSource,
+	}).Return(int64(1024), nil)
+
+	size, err := migration.CharmArchiveSizer(charmService)(c.Context(), "ch:postgresql-42")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(size, tc.Equals, int64(1024))
+}
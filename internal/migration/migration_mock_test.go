@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/migration (interfaces: AgentBinaryStore,ControllerConfigService,UpgradeService,ApplicationService,RelationService,StatusService,OperationExporter,Coordinator,ModelAgentService,CharmService)
+// Source: github.com/juju/juju/internal/migration (interfaces: AgentBinaryStore,ControllerConfigService,UpgradeService,ApplicationService,RelationService,StatusService,OperationExporter,Coordinator,ModelAgentService,CharmService,CharmArchiveSizeGetter)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package migration_test -destination migration_mock_test.go github.com/juju/juju/internal/migration AgentBinaryStore,ControllerConfigService,UpgradeService,ApplicationService,RelationService,StatusService,OperationExporter,Coordinator,ModelAgentService,CharmService
+//	mockgen -typed -package migration_test -destination migration_mock_test.go github.com/juju/juju/internal/migration AgentBinaryStore,ControllerConfigService,UpgradeService,ApplicationService,RelationService,StatusService,OperationExporter,Coordinator,ModelAgentService,CharmService,CharmArchiveSizeGetter
 //
 
 // Package migration_test is a generated GoMock package.
@@ -871,3 +871,65 @@ func (c *MockCharmServiceGetCharmArchiveCall) DoAndReturn(f func(context.Context
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockCharmArchiveSizeGetter is a mock of CharmArchiveSizeGetter interface.
+type MockCharmArchiveSizeGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockCharmArchiveSizeGetterMockRecorder
+}
+
+// MockCharmArchiveSizeGetterMockRecorder is the mock recorder for MockCharmArchiveSizeGetter.
+type MockCharmArchiveSizeGetterMockRecorder struct {
+	mock *MockCharmArchiveSizeGetter
+}
+
+// NewMockCharmArchiveSizeGetter creates a new mock instance.
+func NewMockCharmArchiveSizeGetter(ctrl *gomock.Controller) *MockCharmArchiveSizeGetter {
+	mock := &MockCharmArchiveSizeGetter{ctrl: ctrl}
+	mock.recorder = &MockCharmArchiveSizeGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCharmArchiveSizeGetter) EXPECT() *MockCharmArchiveSizeGetterMockRecorder {
+	return m.recorder
+}
+
+// GetCharmArchiveSize mocks base method.
+func (m *MockCharmArchiveSizeGetter) GetCharmArchiveSize(arg0 context.Context, arg1 charm.CharmLocator) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharmArchiveSize", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharmArchiveSize indicates an expected call of GetCharmArchiveSize.
+func (mr *MockCharmArchiveSizeGetterMockRecorder) GetCharmArchiveSize(arg0, arg1 any) *MockCharmArchiveSizeGetterGetCharmArchiveSizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharmArchiveSize", reflect.TypeOf((*MockCharmArchiveSizeGetter)(nil).GetCharmArchiveSize), arg0, arg1)
+	return &MockCharmArchiveSizeGetterGetCharmArchiveSizeCall{Call: call}
+}
+
+// MockCharmArchiveSizeGetterGetCharmArchiveSizeCall wrap *gomock.Call
+type MockCharmArchiveSizeGetterGetCharmArchiveSizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCharmArchiveSizeGetterGetCharmArchiveSizeCall) Return(arg0 int64, arg1 error) *MockCharmArchiveSizeGetterGetCharmArchiveSizeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCharmArchiveSizeGetterGetCharmArchiveSizeCall) Do(f func(context.Context, charm.CharmLocator) (int64, error)) *MockCharmArchiveSizeGetterGetCharmArchiveSizeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCharmArchiveSizeGetterGetCharmArchiveSizeCall) DoAndReturn(f func(context.Context, charm.CharmLocator) (int64, error)) *MockCharmArchiveSizeGetterGetCharmArchiveSizeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -26,6 +26,42 @@ import (
 	"github.com/juju/juju/state"
 )
 
+// precheckStep is a single check run as part of the migration prechecks.
+type precheckStep struct {
+	check func(context.Context) error
+
+	// transient indicates that a failure of the check is expected to
+	// clear by itself, so it is reported as a warning.
+	transient bool
+}
+
+// runPrechecks runs the steps in order, returning the first failure.
+func runPrechecks(ctx context.Context, steps []precheckStep) error {
+	for _, step := range steps {
+		if err := step.check(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reportPrechecks runs every step, collecting the failures into a report.
+func reportPrechecks(ctx context.Context, steps []precheckStep) coremigration.PrecheckReport {
+	var report coremigration.PrecheckReport
+	for _, step := range steps {
+		err := step.check(ctx)
+		if err == nil {
+			continue
+		}
+		if step.transient {
+			report.Warnings = append(report.Warnings, err.Error())
+		} else {
+			report.Blockers = append(report.Blockers, err.Error())
+		}
+	}
+	return report
+}
+
 // SourcePrecheck checks the state of the source controller to make
 // sure that the preconditions for model migration are met. The
 // backend provided must be for the model to be migrated.
@@ -41,76 +77,116 @@ func SourcePrecheck(
 	statusServiceGetter func(context.Context, coremodel.UUID) (StatusService, error),
 	modelAgentServiceGetter func(context.Context, coremodel.UUID) (ModelAgentService, error),
 ) error {
+	steps, err := sourcePrechecks(
+		ctx, backend, modelUUID, controllerModelUUID,
+		credentialServiceGetter, upgradeServiceGetter, applicationServiceGetter,
+		relationServiceGetter, statusServiceGetter, modelAgentServiceGetter,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(runPrechecks(ctx, steps))
+}
+
+// SourcePrecheckReport runs the same checks as SourcePrecheck, but
+// doesn't stop at the first failure. Every problem found is recorded in
+// the returned report. An error is only returned if the checks could
+// not be run.
+func SourcePrecheckReport(
+	ctx context.Context,
+	backend PrecheckBackend,
+	modelUUID coremodel.UUID,
+	controllerModelUUID coremodel.UUID,
+	credentialServiceGetter func(context.Context, coremodel.UUID) (CredentialService, error),
+	upgradeServiceGetter func(context.Context, coremodel.UUID) (UpgradeService, error),
+	applicationServiceGetter func(context.Context, coremodel.UUID) (ApplicationService, error),
+	relationServiceGetter func(context.Context, coremodel.UUID) (RelationService, error),
+	statusServiceGetter func(context.Context, coremodel.UUID) (StatusService, error),
+	modelAgentServiceGetter func(context.Context, coremodel.UUID) (ModelAgentService, error),
+) (coremigration.PrecheckReport, error) {
+	steps, err := sourcePrechecks(
+		ctx, backend, modelUUID, controllerModelUUID,
+		credentialServiceGetter, upgradeServiceGetter, applicationServiceGetter,
+		relationServiceGetter, statusServiceGetter, modelAgentServiceGetter,
+	)
+	if err != nil {
+		return coremigration.PrecheckReport{}, errors.Trace(err)
+	}
+	return reportPrechecks(ctx, steps), nil
+}
 
+func sourcePrechecks(
+	ctx context.Context,
+	backend PrecheckBackend,
+	modelUUID coremodel.UUID,
+	controllerModelUUID coremodel.UUID,
+	credentialServiceGetter func(context.Context, coremodel.UUID) (CredentialService, error),
+	upgradeServiceGetter func(context.Context, coremodel.UUID) (UpgradeService, error),
+	applicationServiceGetter func(context.Context, coremodel.UUID) (ApplicationService, error),
+	relationServiceGetter func(context.Context, coremodel.UUID) (RelationService, error),
+	statusServiceGetter func(context.Context, coremodel.UUID) (StatusService, error),
+	modelAgentServiceGetter func(context.Context, coremodel.UUID) (ModelAgentService, error),
+) ([]precheckStep, error) {
 	modelCredentialService, err := credentialServiceGetter(ctx, modelUUID)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 	modelApplicationService, err := applicationServiceGetter(ctx, modelUUID)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 	modelRelationService, err := relationServiceGetter(ctx, modelUUID)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 	modelStatusService, err := statusServiceGetter(ctx, modelUUID)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	} else if modelStatusService == nil {
-		return errors.Errorf("status service for model %q not found", modelUUID)
+		return nil, errors.Errorf("status service for model %q not found", modelUUID)
 	}
 	modelModelAgentService, err := modelAgentServiceGetter(ctx, modelUUID)
 	if err != nil {
-		return errors.Trace(err)
-	}
-
-	c := newPrecheckModel(backend, modelCredentialService, modelApplicationService, modelRelationService, modelStatusService, modelModelAgentService)
-	if err := c.checkModel(ctx); err != nil {
-		return errors.Trace(err)
-	}
-
-	if err := c.checkMachines(ctx); err != nil {
-		return errors.Trace(err)
-	}
-
-	if err := c.checkApplications(ctx); err != nil {
-		return errors.Trace(err)
-	}
-
-	if err := c.checkRelations(ctx); err != nil {
-		return errors.Trace(err)
-	}
-
-	if cleanupNeeded, err := backend.NeedsCleanup(); err != nil {
-		return errors.Annotate(err, "checking cleanups")
-	} else if cleanupNeeded {
-		return errors.New("cleanup needed")
+		return nil, errors.Trace(err)
 	}
 
 	// Check the source controller.
 	controllerBackend, err := backend.ControllerBackend()
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 	controllerUpgradeService, err := upgradeServiceGetter(ctx, controllerModelUUID)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 	controllerStatusService, err := statusServiceGetter(ctx, controllerModelUUID)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 	controllerModelAgentService, err := modelAgentServiceGetter(ctx, controllerModelUUID)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 
+	c := newPrecheckModel(backend, modelCredentialService, modelApplicationService, modelRelationService, modelStatusService, modelModelAgentService)
 	controllerCtx := newPrecheckController(controllerBackend, controllerUpgradeService, controllerStatusService, controllerModelAgentService)
-	if err := controllerCtx.checkController(ctx); err != nil {
-		return errors.Annotate(err, "controller")
-	}
-	return nil
+	return []precheckStep{
+		{check: c.checkModel},
+		{check: c.checkMachines},
+		{check: c.checkApplications},
+		{check: c.checkRelations},
+		{check: func(context.Context) error {
+			if cleanupNeeded, err := backend.NeedsCleanup(); err != nil {
+				return errors.Annotate(err, "checking cleanups")
+			} else if cleanupNeeded {
+				return errors.New("cleanup needed")
+			}
+			return nil
+		}, transient: true},
+		{check: func(ctx context.Context) error {
+			return errors.Annotate(controllerCtx.checkController(ctx), "controller")
+		}},
+	}, nil
 }
 
 // ImportDescriptionPrecheck checks the data being imported to make sure
@@ -120,16 +196,33 @@ func ImportDescriptionPrecheck(
 	ctx context.Context,
 	model description.Model,
 ) error {
-	err := checkForCharmsWithNoManifest(model)
-	if err != nil {
-		return internalerrors.Errorf("checking model for charms without manifest.yaml: %w", err)
-	}
+	return runPrechecks(ctx, importDescriptionPrechecks(model))
+}
 
-	if err := checkNoFanConfig(model.Config()); err != nil {
-		return internalerrors.Errorf("checking model config for fan config: %w", err)
-	}
+// ImportDescriptionPrecheckReport runs the same checks as
+// ImportDescriptionPrecheck, but doesn't stop at the first failure.
+func ImportDescriptionPrecheckReport(
+	ctx context.Context,
+	model description.Model,
+) coremigration.PrecheckReport {
+	return reportPrechecks(ctx, importDescriptionPrechecks(model))
+}
 
-	return nil
+func importDescriptionPrechecks(model description.Model) []precheckStep {
+	return []precheckStep{
+		{check: func(context.Context) error {
+			if err := checkForCharmsWithNoManifest(model); err != nil {
+				return internalerrors.Errorf("checking model for charms without manifest.yaml: %w", err)
+			}
+			return nil
+		}},
+		{check: func(context.Context) error {
+			if err := checkNoFanConfig(model.Config()); err != nil {
+				return internalerrors.Errorf("checking model config for fan config: %w", err)
+			}
+			return nil
+		}},
+	}
 }
 
 // TargetPrecheck checks the state of the target controller to make
@@ -147,41 +240,82 @@ func TargetPrecheck(
 	if err := modelInfo.Validate(); err != nil {
 		return errors.Trace(err)
 	}
+	steps := targetPrechecks(backend, pool, modelInfo, upgradeService, statusService, modelAgentService)
+	return errors.Trace(runPrechecks(ctx, steps))
+}
 
-	// This check is necessary because there is a window between the
-	// REAP phase and then end of the DONE phase where a model's
-	// documents have been deleted but the migration isn't quite done
-	// yet. Migrating a model back into the controller during this
-	// window can upset the migrationmaster worker.
-	//
-	// See also https://lpad.tv/1611391
-	if migrating, err := backend.IsMigrationActive(modelInfo.UUID); err != nil {
-		return errors.Annotate(err, "checking for active migration")
-	} else if migrating {
-		return errors.New("model is being migrated out of target controller")
-	}
-
-	controllerVersion, err := modelAgentService.GetModelTargetAgentVersion(ctx)
-	if err != nil {
-		return errors.Annotate(err, "retrieving model version")
+// TargetPrecheckReport runs the same checks as TargetPrecheck, but
+// doesn't stop at the first failure. Every problem found is recorded in
+// the returned report. An error is only returned if the model info is
+// not valid.
+func TargetPrecheckReport(
+	ctx context.Context,
+	backend PrecheckBackend,
+	pool Pool,
+	modelInfo coremigration.ModelInfo,
+	upgradeService UpgradeService,
+	statusService StatusService,
+	modelAgentService ModelAgentService,
+) (coremigration.PrecheckReport, error) {
+	if err := modelInfo.Validate(); err != nil {
+		return coremigration.PrecheckReport{}, errors.Trace(err)
 	}
+	steps := targetPrechecks(backend, pool, modelInfo, upgradeService, statusService, modelAgentService)
+	return reportPrechecks(ctx, steps), nil
+}
 
-	if controllerVersion.Compare(modelInfo.AgentVersion) < 0 {
-		return errors.Errorf("model has higher version than target controller (%s > %s)",
-			modelInfo.AgentVersion, controllerVersion)
-	}
+func targetPrechecks(
+	backend PrecheckBackend,
+	pool Pool,
+	modelInfo coremigration.ModelInfo,
+	upgradeService UpgradeService,
+	statusService StatusService,
+	modelAgentService ModelAgentService,
+) []precheckStep {
+	controllerCtx := newPrecheckController(backend, upgradeService, statusService, modelAgentService)
+	return []precheckStep{
+		{check: func(context.Context) error {
+			// This check is necessary because there is a window between the
+			// REAP phase and then end of the DONE phase where a model's
+			// documents have been deleted but the migration isn't quite done
+			// yet. Migrating a model back into the controller during this
+			// window can upset the migrationmaster worker.
+			//
+			// See also https://lpad.tv/1611391
+			if migrating, err := backend.IsMigrationActive(modelInfo.UUID); err != nil {
+				return errors.Annotate(err, "checking for active migration")
+			} else if migrating {
+				return errors.New("model is being migrated out of target controller")
+			}
+			return nil
+		}, transient: true},
+		{check: func(ctx context.Context) error {
+			controllerVersion, err := modelAgentService.GetModelTargetAgentVersion(ctx)
+			if err != nil {
+				return errors.Annotate(err, "retrieving model version")
+			}
 
-	if !controllerVersionCompatible(modelInfo.ControllerAgentVersion, controllerVersion) {
-		return errors.Errorf("source controller has higher version than target controller (%s > %s)",
-			modelInfo.ControllerAgentVersion, controllerVersion)
-	}
+			if controllerVersion.Compare(modelInfo.AgentVersion) < 0 {
+				return errors.Errorf("model has higher version than target controller (%s > %s)",
+					modelInfo.AgentVersion, controllerVersion)
+			}
 
-	controllerCtx := newPrecheckController(backend, upgradeService, statusService, modelAgentService)
-	if err := controllerCtx.checkController(ctx); err != nil {
-		return errors.Trace(err)
+			if !controllerVersionCompatible(modelInfo.ControllerAgentVersion, controllerVersion) {
+				return errors.Errorf("source controller has higher version than target controller (%s > %s)",
+					modelInfo.ControllerAgentVersion, controllerVersion)
+			}
+			return nil
+		}},
+		{check: controllerCtx.checkController},
+		{check: func(context.Context) error {
+			return checkModelConflicts(backend, pool, modelInfo)
+		}},
 	}
+}
 
-	// Check for conflicts with existing models
+// checkModelConflicts checks for conflicts between the model being
+// migrated and the existing models in the target controller.
+func checkModelConflicts(backend PrecheckBackend, pool Pool, modelInfo coremigration.ModelInfo) error {
 	modelUUIDs, err := backend.AllModelUUIDs()
 	if err != nil {
 		return errors.Annotate(err, "retrieving models")
@@ -209,7 +343,6 @@ func TargetPrecheck(
 			return errors.Errorf("model named %q already exists", model.Name())
 		}
 	}
-
 	return nil
 }
 
@@ -295,6 +295,45 @@ func (s *SourcePrecheckSuite) TestIsUpgrading(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "controller: upgrade in progress")
 }
 
+func (s *SourcePrecheckSuite) TestReport(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerUpgradeService.EXPECT().IsUpgrading(gomock.Any()).Return(true, nil)
+	s.expectAllAppsAndUnitsAlive()
+	s.expectCheckUnitStatuses(nil)
+	s.expectCheckRelation(fakeRelation{})
+
+	s.statusService.EXPECT().CheckMachineStatusesReadyForMigration(gomock.Any()).Return(nil)
+
+	s.agentService.EXPECT().GetMachinesNotAtTargetAgentVersion(gomock.Any()).Return(nil, nil)
+	s.agentService.EXPECT().GetUnitsNotAtTargetAgentVersion(gomock.Any()).Return(nil, nil)
+
+	backend := newFakeBackend()
+	backend.model.life = state.Dying
+	backend.cleanupNeeded = true
+	report, err := migration.SourcePrecheckReport(c.Context(), backend, s.modelUUID, s.controllerModelUUID, s.credentialServiceGetter,
+		s.upgradeServiceGetter, s.applicationServiceGetter, s.relationServiceGetter, s.statusServiceGetter, s.modelAgentServiceGetter)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report, tc.DeepEquals, coremigration.PrecheckReport{
+		Blockers: []string{
+			"model is dying",
+			"controller: upgrade in progress",
+		},
+		Warnings: []string{"cleanup needed"},
+	})
+}
+
+func (s *SourcePrecheckSuite) TestReportServiceError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.credentialServiceGetter = func(context.Context, coremodel.UUID) (migration.CredentialService, error) {
+		return nil, errors.New("boom")
+	}
+	_, err := migration.SourcePrecheckReport(c.Context(), newFakeBackend(), s.modelUUID, s.controllerModelUUID, s.credentialServiceGetter,
+		s.upgradeServiceGetter, s.applicationServiceGetter, s.relationServiceGetter, s.statusServiceGetter, s.modelAgentServiceGetter)
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
 func (s *SourcePrecheckSuite) TestMachineRequiresReboot(c *tc.C) {
 	// TODO(gfouillet): Restore this once machine fully migrated to dqlite
 	c.Skip("Machine reboot have been moved to dqlite, this precheck has been temporarily disabled")
@@ -631,6 +670,21 @@ func (s *ImportPrecheckSuite) TestContainerNetworkingFan(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, ".*fan networking not supported, remove container-networking-method \"fan\" from migrating model config")
 }
 
+func (s *ImportPrecheckSuite) TestReport(c *tc.C) {
+	model := description.NewModel(description.ModelArgs{
+		Config: testing.FakeConfig().Merge(testing.Attrs{"container-networking-method": "fan"}),
+	})
+	model.AddApplication(description.ApplicationArgs{
+		Name: "nil-bases-app",
+	}).SetCharmManifest(description.CharmManifestArgs{})
+
+	report := migration.ImportDescriptionPrecheckReport(c.Context(), model)
+	c.Assert(report.Blockers, tc.HasLen, 2)
+	c.Check(report.Blockers[0], tc.Matches, ".*this model hosts charm\\(s\\) with no manifest.yaml file: nil-bases-app")
+	c.Check(report.Blockers[1], tc.Matches, ".*fan networking not supported, .*")
+	c.Check(report.Warnings, tc.HasLen, 0)
+}
+
 type baseType struct {
 	name          string
 	channel       string
@@ -844,6 +898,29 @@ func (s *TargetPrecheckSuite) TestIsMigrationActive(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "model is being migrated out of target controller")
 }
 
+func (s *TargetPrecheckSuite) TestReport(c *tc.C) {
+	defer s.setupMocksWithDefaultAgentVersion(c).Finish()
+
+	s.expectIsUpgrade(true)
+
+	backend := newFakeBackend()
+	backend.migrationActive = true
+	report, err := migration.TargetPrecheckReport(c.Context(), backend, nil, s.modelInfo, s.upgradeService, s.statusService, s.agentService)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report, tc.DeepEquals, coremigration.PrecheckReport{
+		Blockers: []string{"upgrade in progress"},
+		Warnings: []string{"model is being migrated out of target controller"},
+	})
+}
+
+func (s *TargetPrecheckSuite) TestReportInvalidModelInfo(c *tc.C) {
+	defer s.setupMocksWithDefaultAgentVersion(c).Finish()
+
+	s.modelInfo.UUID = ""
+	_, err := migration.TargetPrecheckReport(c.Context(), newFakeBackend(), nil, s.modelInfo, s.upgradeService, s.statusService, s.agentService)
+	c.Assert(err, tc.ErrorMatches, "empty UUID not valid")
+}
+
 func (s *TargetPrecheckSuite) TestModelNameAlreadyInUse(c *tc.C) {
 	defer s.setupMocksWithDefaultAgentVersion(c).Finish()
 
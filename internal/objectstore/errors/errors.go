@@ -12,4 +12,8 @@ const (
 	// ObjectAlreadyExists is returned an object is placed in the store, but
 	// that object already exists.
 	ObjectAlreadyExists = errors.ConstError("object already exists")
+
+	// ObjectCorrupt is returned when the contents of an object in the store
+	// don't match its metadata.
+	ObjectCorrupt = errors.ConstError("object corrupt")
 )
@@ -113,6 +113,15 @@ func WithNewBlobsClient(clientFn remote.NewBlobsClientFunc) Option {
 	}
 }
 
+// WithScrubMetrics is the option to set the metrics that record the outcome
+// of each scrub of the object store.
+// This is for file based object stores.
+func WithScrubMetrics(metrics ScrubMetrics) Option {
+	return func(o *options) {
+		o.scrubMetrics = metrics
+	}
+}
+
 type options struct {
 	rootDir         string
 	claimer         Claimer
@@ -128,12 +137,14 @@ type options struct {
 	// File base options
 	apiRemoteCallers   apiremotecaller.APIRemoteCallers
 	newFileBlobsClient remote.NewBlobsClientFunc
+	scrubMetrics       ScrubMetrics
 }
 
 func newOptions() *options {
 	return &options{
 		newFileBlobsClient: remote.NewBlobsClient,
 		apiRemoteCallers:   noopAPIRemoteCallers{},
+		scrubMetrics:       noopScrubMetrics{},
 		logger:             internallogger.GetLogger("juju.objectstore", logger.OBJECTSTORE),
 		clock:              clock.WallClock,
 	}
@@ -172,6 +183,7 @@ func ObjectStoreFactory(ctx context.Context, backendType objectstore.BackendType
 			Logger:          opts.logger,
 			Clock:           opts.clock,
 			RemoteRetriever: blobRetriever,
+			ScrubMetrics:    opts.scrubMetrics,
 		})
 		if err != nil {
 			return nil, errors.Errorf("creating file based objectstore: %w", err)
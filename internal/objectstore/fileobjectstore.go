@@ -12,6 +12,8 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/juju/clock"
 	jujuerrors "github.com/juju/errors"
@@ -67,6 +69,12 @@ type FileObjectStoreConfig struct {
 	Logger logger.Logger
 	// Clock is the clock for the file object store.
 	Clock clock.Clock
+	// ScrubMetrics records the outcome of each scrub of the file object
+	// store. If nil, the outcome is only logged.
+	ScrubMetrics ScrubMetrics
+	// ScrubInterval is the interval between scrubs of the file object store.
+	// If zero, the default interval is used.
+	ScrubInterval time.Duration
 }
 
 type fileObjectStore struct {
@@ -75,6 +83,11 @@ type fileObjectStore struct {
 	remoteRetriever RemoteRetriever
 	namespace       string
 	requests        chan request
+
+	scrubMetrics  ScrubMetrics
+	scrubInterval time.Duration
+	scrubMutex    sync.Mutex
+	lastScrub     ScrubReport
 }
 
 // NewFileObjectStore returns a new object store worker based on the file
@@ -82,6 +95,15 @@ type fileObjectStore struct {
 func NewFileObjectStore(cfg FileObjectStoreConfig) (TrackedObjectStore, error) {
 	path := basePath(cfg.RootDir, cfg.Namespace)
 
+	scrubMetrics := cfg.ScrubMetrics
+	if scrubMetrics == nil {
+		scrubMetrics = noopScrubMetrics{}
+	}
+	scrubInterval := cfg.ScrubInterval
+	if scrubInterval <= 0 {
+		scrubInterval = defaultScrubInterval
+	}
+
 	s := &fileObjectStore{
 		baseObjectStore: baseObjectStore{
 			path:            path,
@@ -95,9 +117,13 @@ func NewFileObjectStore(cfg FileObjectStoreConfig) (TrackedObjectStore, error) {
 		namespace:       cfg.Namespace,
 
 		requests: make(chan request),
+
+		scrubMetrics:  scrubMetrics,
+		scrubInterval: scrubInterval,
 	}
 
 	s.tomb.Go(s.loop)
+	s.tomb.Go(s.scrubLoop)
 
 	return s, nil
 }
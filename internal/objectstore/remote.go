@@ -95,6 +95,14 @@ func (c *remoteFileObjectStore) Remove(ctx context.Context, path string) error {
 	return c.objectStore.Remove(ctx, path)
 }
 
+// Report provides information for the engine report.
+func (c *remoteFileObjectStore) Report() map[string]any {
+	if r, ok := c.objectStore.(worker.Reporter); ok {
+		return r.Report()
+	}
+	return nil
+}
+
 func (c *remoteFileObjectStore) loop() error {
 	select {
 	case <-c.catacomb.Dying():
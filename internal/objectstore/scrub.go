@@ -0,0 +1,289 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"os"
+	"time"
+
+	jujuerrors "github.com/juju/errors"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/core/objectstore"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+const (
+	// defaultScrubInterval is the interval between scrubs of the object
+	// store. Scrubbing reads every object in the store, so it's run far less
+	// often than pruning.
+	defaultScrubInterval = time.Hour * 24
+)
+
+// ScrubReport is the outcome of a single scrub of an object store.
+type ScrubReport struct {
+	// Started is the time the scrub started.
+	Started time.Time
+	// Duration is the time taken by the scrub.
+	Duration time.Duration
+	// Checked is the number of objects that were verified.
+	Checked int
+	// Corrupt is the number of objects whose contents didn't match the size
+	// or hashes in the metadata.
+	Corrupt int
+	// Missing is the number of objects that have metadata, but no contents.
+	Missing int
+	// Repaired is the number of corrupt or missing objects that were restored
+	// from another controller.
+	Repaired int
+	// Unrepaired is the number of corrupt or missing objects that couldn't be
+	// restored from another controller.
+	Unrepaired int
+}
+
+// ScrubMetrics records the outcome of the scrubs of the object stores.
+type ScrubMetrics interface {
+	// RecordScrub records the report of a completed scrub of the object store
+	// for the given namespace.
+	RecordScrub(namespace string, report ScrubReport)
+}
+
+type noopScrubMetrics struct{}
+
+// RecordScrub is a no-op.
+func (noopScrubMetrics) RecordScrub(string, ScrubReport) {}
+
+// Report provides information about the last scrub for the engine report.
+func (t *fileObjectStore) Report() map[string]any {
+	t.scrubMutex.Lock()
+	defer t.scrubMutex.Unlock()
+
+	if t.lastScrub.Started.IsZero() {
+		return map[string]any{
+			"last-scrub": "never",
+		}
+	}
+	return map[string]any{
+		"last-scrub":          t.lastScrub.Started.UTC().Format(time.RFC3339),
+		"last-scrub-duration": t.lastScrub.Duration.String(),
+		"checked":             t.lastScrub.Checked,
+		"corrupt":             t.lastScrub.Corrupt,
+		"missing":             t.lastScrub.Missing,
+		"repaired":            t.lastScrub.Repaired,
+		"unrepaired":          t.lastScrub.Unrepaired,
+	}
+}
+
+// scrubLoop periodically verifies the objects in the store. It's run
+// separately from the request loop, as hashing every object in the store
+// can take a long time, and requests shouldn't wait for it.
+func (t *fileObjectStore) scrubLoop() error {
+	ctx, cancel := t.scopedContext()
+	defer cancel()
+
+	timer := t.clock.NewTimer(jitter(t.scrubInterval))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-t.tomb.Dying():
+			return tomb.ErrDying
+
+		case <-timer.Chan():
+			timer.Reset(t.scrubInterval)
+
+			if _, err := t.scrub(ctx); errors.Is(err, context.Canceled) {
+				return tomb.ErrDying
+			} else if err != nil {
+				t.logger.Errorf(ctx, "scrub: %v", err)
+			}
+		}
+	}
+}
+
+// scrub verifies the contents of every object in the store against the
+// size and hashes held in the metadata. Any corrupt or missing objects are
+// restored from another controller if possible. The outcome is recorded for
+// the engine report and the metrics.
+func (t *fileObjectStore) scrub(ctx context.Context) (ScrubReport, error) {
+	t.logger.Debugf(ctx, "scrubbing objects in file storage")
+
+	report := ScrubReport{
+		Started: t.clock.Now(),
+	}
+
+	metadata, err := t.metadataService.ListMetadata(ctx)
+	if err != nil {
+		return report, errors.Errorf("list metadata: %w", err)
+	}
+
+	// Many paths can refer to the same object, only verify it once.
+	seen := make(map[string]struct{})
+	for _, m := range metadata {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		hash := selectFileHash(m)
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+
+		// Lock the file with the given hash, so that it can't be written or
+		// removed while it's verified and repaired. Otherwise a partially
+		// removed object would be reported as missing, and then restored
+		// from another controller.
+		var (
+			removed              bool
+			verifyErr, repairErr error
+		)
+		err := t.withLock(ctx, hash, func(ctx context.Context) error {
+			// The object may have been removed since the metadata was
+			// listed, in which case there's nothing to verify.
+			_, err := t.metadataService.GetMetadataBySHA256(ctx, m.SHA256)
+			if errors.Is(err, domainobjectstoreerrors.ErrNotFound) {
+				removed = true
+				return nil
+			} else if err != nil {
+				return errors.Errorf("get metadata: %w", err)
+			}
+
+			verifyErr = t.verifyObject(m)
+			if errors.Is(verifyErr, objectstoreerrors.ObjectNotFound) ||
+				errors.Is(verifyErr, objectstoreerrors.ObjectCorrupt) {
+				repairErr = t.repairObject(ctx, m)
+			}
+			return nil
+		})
+		if errors.Is(err, context.Canceled) {
+			return report, err
+		} else if err != nil {
+			// The object is being written or removed, or we couldn't
+			// check its metadata. Try again on the next scrub.
+			t.logger.Infof(ctx, "unable to verify object %q: %v, will try again later", m.Path, err)
+			continue
+		} else if removed {
+			continue
+		}
+
+		report.Checked++
+
+		switch {
+		case verifyErr == nil:
+			continue
+		case errors.Is(verifyErr, objectstoreerrors.ObjectNotFound):
+			t.logger.Warningf(ctx, "object %q encoded as %q is missing", m.Path, hash)
+			report.Missing++
+		case errors.Is(verifyErr, objectstoreerrors.ObjectCorrupt):
+			t.logger.Errorf(ctx, "object %q is corrupt: %v", m.Path, verifyErr)
+			report.Corrupt++
+		default:
+			// We couldn't read the object, so we don't know if it's
+			// corrupt. Try again on the next scrub.
+			t.logger.Infof(ctx, "unable to verify object %q: %v, will try again later", m.Path, verifyErr)
+			continue
+		}
+
+		if repairErr != nil {
+			t.logger.Errorf(ctx, "unable to repair object %q: %v", m.Path, repairErr)
+			report.Unrepaired++
+			continue
+		}
+		t.logger.Infof(ctx, "repaired object %q from another controller", m.Path)
+		report.Repaired++
+	}
+
+	report.Duration = t.clock.Now().Sub(report.Started)
+
+	t.logger.Debugf(ctx, "scrubbed %d objects: %d corrupt, %d missing, %d repaired",
+		report.Checked, report.Corrupt, report.Missing, report.Repaired)
+
+	t.scrubMutex.Lock()
+	t.lastScrub = report
+	t.scrubMutex.Unlock()
+
+	t.scrubMetrics.RecordScrub(t.namespace, report)
+
+	return report, nil
+}
+
+// verifyObject checks that the object on disk matches the size and hashes in
+// the metadata. The lock for the object's hash must be held. An [objectstoreerrors.ObjectNotFound] error is returned if
+// the object doesn't exist, and an [objectstoreerrors.ObjectCorrupt] error
+// if it doesn't match.
+func (t *fileObjectStore) verifyObject(metadata objectstore.Metadata) error {
+	hash := selectFileHash(metadata)
+
+	file, err := t.fs.Open(hash)
+	if errors.Is(err, os.ErrNotExist) {
+		return objectstoreerrors.ObjectNotFound
+	} else if err != nil {
+		return errors.Errorf("opening file %q encoded as %q: %w", metadata.Path, hash, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	hash384 := sha512.New384()
+	hash256 := sha256.New()
+	size, err := io.Copy(io.MultiWriter(hash384, hash256), file)
+	if err != nil {
+		return errors.Errorf("reading file %q encoded as %q: %w", metadata.Path, hash, err)
+	}
+
+	if size != metadata.Size {
+		return errors.Errorf("size mismatch: expected %d, got %d: %w", metadata.Size, size, objectstoreerrors.ObjectCorrupt)
+	}
+	if encoded := hex.EncodeToString(hash384.Sum(nil)); encoded != metadata.SHA384 {
+		return errors.Errorf("SHA384 mismatch: expected %q, got %q: %w", metadata.SHA384, encoded, objectstoreerrors.ObjectCorrupt)
+	}
+	if encoded := hex.EncodeToString(hash256.Sum(nil)); encoded != metadata.SHA256 {
+		return errors.Errorf("SHA256 mismatch: expected %q, got %q: %w", metadata.SHA256, encoded, objectstoreerrors.ObjectCorrupt)
+	}
+	return nil
+}
+
+// repairObject replaces the object on disk with a healthy copy retrieved from
+// another controller. The local copy is only replaced once the retrieved copy
+// has been verified. The lock for the object's hash must be held.
+func (t *fileObjectStore) repairObject(ctx context.Context, metadata objectstore.Metadata) error {
+	reader, size, err := t.remoteRetriever.Retrieve(ctx, metadata.SHA256)
+	if errors.Is(err, jujuerrors.NotFound) {
+		return errors.Errorf("no other controller has the object: %w", objectstoreerrors.ObjectNotFound)
+	} else if err != nil {
+		return errors.Errorf("remote get: %w", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	if size != metadata.Size {
+		return errors.Errorf("size mismatch for remote copy: expected %d, got %d", metadata.Size, size)
+	}
+
+	hash384 := sha512.New384()
+	tmpFileName, tmpFileCleanup, err := t.writeToTmpFile(t.path, io.TeeReader(reader, hash384), size)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	// Ensure that we remove the temporary file if we fail to persist it.
+	defer func() { _ = tmpFileCleanup() }()
+
+	encoded384 := hex.EncodeToString(hash384.Sum(nil))
+	if encoded384 != metadata.SHA384 {
+		return errors.Errorf("hash mismatch for remote copy: expected %q, got %q: %w", metadata.SHA384, encoded384, objectstore.ErrHashMismatch)
+	}
+
+	// The rename atomically replaces any corrupt copy, readers that already
+	// have the corrupt copy open are unaffected.
+	if err := os.Rename(tmpFileName, t.filePath(encoded384)); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
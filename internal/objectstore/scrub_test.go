@@ -0,0 +1,286 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/clock"
+	jujuerrors "github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/lease"
+	"github.com/juju/juju/core/objectstore"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+func (s *fileObjectStoreSuite) TestScrubHealthyObjects(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	store, metrics := s.newScrubbingFileObjectStore(c, path)
+
+	size, hash384, hash256 := s.createFile(c, s.filePath(path, "inferi"), "foo", "some content")
+
+	// Both paths refer to the same object, so it's only verified once.
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}, {
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "bar",
+		Size:   size,
+	}}, nil)
+	s.expectScrubLock(hash384, hash256)
+
+	report, err := store.scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report.Checked, tc.Equals, 1)
+	c.Check(report.Corrupt, tc.Equals, 0)
+	c.Check(report.Missing, tc.Equals, 0)
+	c.Check(report.Repaired, tc.Equals, 0)
+	c.Check(report.Unrepaired, tc.Equals, 0)
+
+	c.Check(metrics.reports, tc.HasLen, 1)
+	c.Check(store.Report()["checked"], tc.Equals, 1)
+}
+
+func (s *fileObjectStoreSuite) TestScrubRepairsCorruptObject(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	store, metrics := s.newScrubbingFileObjectStore(c, path)
+
+	size, hash384, hash256 := s.createFile(c, s.filePath(path, "inferi"), "foo", "some content")
+
+	// Corrupt the object, without changing its size.
+	filePath := filepath.Join(s.filePath(path, "inferi"), hash384)
+	err := os.WriteFile(filePath, []byte("some c0ntent"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}}, nil)
+	s.expectScrubLock(hash384, hash256)
+	s.remote.EXPECT().Retrieve(gomock.Any(), hash256).
+		Return(io.NopCloser(strings.NewReader("some content")), size, nil)
+
+	report, err := store.scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report.Checked, tc.Equals, 1)
+	c.Check(report.Corrupt, tc.Equals, 1)
+	c.Check(report.Repaired, tc.Equals, 1)
+	c.Check(report.Unrepaired, tc.Equals, 0)
+
+	content, err := os.ReadFile(filePath)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(content), tc.Equals, "some content")
+
+	c.Check(metrics.reports, tc.DeepEquals, []ScrubReport{report})
+	c.Check(store.Report()["repaired"], tc.Equals, 1)
+}
+
+func (s *fileObjectStoreSuite) TestScrubRepairsMissingObject(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	store, _ := s.newScrubbingFileObjectStore(c, path)
+
+	hash384 := s.calculateHexSHA384(c, "some content")
+	hash256 := s.calculateHexSHA256(c, "some content")
+
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   12,
+	}}, nil)
+	s.expectScrubLock(hash384, hash256)
+	s.remote.EXPECT().Retrieve(gomock.Any(), hash256).
+		Return(io.NopCloser(strings.NewReader("some content")), int64(12), nil)
+
+	report, err := store.scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report.Missing, tc.Equals, 1)
+	c.Check(report.Repaired, tc.Equals, 1)
+
+	s.expectFileDoesExist(c, path, hash384)
+}
+
+func (s *fileObjectStoreSuite) TestScrubCorruptObjectNotOnPeers(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	store, _ := s.newScrubbingFileObjectStore(c, path)
+
+	size, hash384, hash256 := s.createFile(c, s.filePath(path, "inferi"), "foo", "some content")
+
+	filePath := filepath.Join(s.filePath(path, "inferi"), hash384)
+	err := os.WriteFile(filePath, []byte("some c0ntent"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}}, nil)
+	s.expectScrubLock(hash384, hash256)
+	s.remote.EXPECT().Retrieve(gomock.Any(), hash256).
+		Return(nil, -1, jujuerrors.NotFoundf("foo"))
+
+	report, err := store.scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report.Corrupt, tc.Equals, 1)
+	c.Check(report.Repaired, tc.Equals, 0)
+	c.Check(report.Unrepaired, tc.Equals, 1)
+}
+
+func (s *fileObjectStoreSuite) TestScrubRejectsCorruptRemoteCopy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	store, _ := s.newScrubbingFileObjectStore(c, path)
+
+	size, hash384, hash256 := s.createFile(c, s.filePath(path, "inferi"), "foo", "some content")
+
+	filePath := filepath.Join(s.filePath(path, "inferi"), hash384)
+	err := os.WriteFile(filePath, []byte("some c0ntent"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}}, nil)
+	s.expectScrubLock(hash384, hash256)
+	s.remote.EXPECT().Retrieve(gomock.Any(), hash256).
+		Return(io.NopCloser(strings.NewReader("s0me content")), size, nil)
+
+	report, err := store.scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report.Corrupt, tc.Equals, 1)
+	c.Check(report.Unrepaired, tc.Equals, 1)
+
+	// The local copy is left in place.
+	content, err := os.ReadFile(filePath)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(content), tc.Equals, "some c0ntent")
+}
+
+func (s *fileObjectStoreSuite) TestScrubSkipsRemovedObject(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	store, _ := s.newScrubbingFileObjectStore(c, path)
+
+	hash384 := s.calculateHexSHA384(c, "some content")
+	hash256 := s.calculateHexSHA256(c, "some content")
+
+	// The object was removed after the metadata was listed, so it mustn't
+	// be reported as missing and restored from another controller.
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   12,
+	}}, nil)
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.service.EXPECT().GetMetadataBySHA256(gomock.Any(), hash256).
+		Return(objectstore.Metadata{}, domainobjectstoreerrors.ErrNotFound)
+
+	report, err := store.scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report.Checked, tc.Equals, 0)
+	c.Check(report.Missing, tc.Equals, 0)
+
+	s.expectFileDoesNotExist(c, path, hash384)
+}
+
+func (s *fileObjectStoreSuite) TestScrubSkipsLockedObject(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	store, _ := s.newScrubbingFileObjectStore(c, path)
+
+	size, hash384, hash256 := s.createFile(c, s.filePath(path, "inferi"), "foo", "some content")
+
+	// The object is being written or removed, so it's verified on the
+	// next scrub.
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}}, nil)
+	s.claimer.EXPECT().Claim(gomock.Any(), hash384).Return(nil, lease.ErrClaimDenied)
+
+	report, err := store.scrub(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(report.Checked, tc.Equals, 0)
+}
+
+func (s *fileObjectStoreSuite) TestReportNeverScrubbed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	store, _ := s.newScrubbingFileObjectStore(c, c.MkDir())
+	c.Check(store.Report(), tc.DeepEquals, map[string]any{
+		"last-scrub": "never",
+	})
+}
+
+// newScrubbingFileObjectStore returns a file object store that isn't running,
+// so that scrubs can be run without racing the start up of the store.
+func (s *fileObjectStoreSuite) newScrubbingFileObjectStore(c *tc.C, path string) (*fileObjectStore, *recordingScrubMetrics) {
+	metrics := &recordingScrubMetrics{}
+	storePath := basePath(path, "inferi")
+	store := &fileObjectStore{
+		baseObjectStore: baseObjectStore{
+			path:            storePath,
+			claimer:         s.claimer,
+			metadataService: s.service,
+			logger:          loggertesting.WrapCheckLog(c),
+			clock:           clock.WallClock,
+		},
+		fs:              os.DirFS(storePath),
+		remoteRetriever: s.remote,
+		namespace:       "inferi",
+		scrubMetrics:    metrics,
+		scrubInterval:   defaultScrubInterval,
+	}
+	c.Assert(store.ensureDirectories(), tc.ErrorIsNil)
+	return store, metrics
+}
+
+// expectScrubLock expects the object to be locked while it's scrubbed, and
+// its metadata to be checked.
+func (s *fileObjectStoreSuite) expectScrubLock(hash384, hash256 string) {
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.service.EXPECT().GetMetadataBySHA256(gomock.Any(), hash256).Return(objectstore.Metadata{
+		SHA384: hash384,
+		SHA256: hash256,
+	}, nil)
+}
+
+type recordingScrubMetrics struct {
+	reports []ScrubReport
+}
+
+func (m *recordingScrubMetrics) RecordScrub(namespace string, report ScrubReport) {
+	m.reports = append(m.reports, report)
+}
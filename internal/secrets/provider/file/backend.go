@@ -0,0 +1,403 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	internalsecrets "github.com/juju/juju/internal/secrets"
+)
+
+const (
+	// keysDir is the directory within a secret's directory
+	// holding its wrapped data keys.
+	keysDir = "keys"
+
+	// dataKeySize is the size of the AES-256 data keys.
+	dataKeySize = 32
+)
+
+// dataKeyDoc is the on disk representation of a wrapped data key.
+type dataKeyDoc struct {
+	KEKID string `json:"kek-id"`
+	Key   []byte `json:"key"`
+}
+
+// revisionDoc is the on disk representation of encrypted
+// secret revision content.
+type revisionDoc struct {
+	DataKeyID string `json:"data-key-id"`
+	Nonce     []byte `json:"nonce"`
+	Data      []byte `json:"data"`
+}
+
+type fileBackend struct {
+	path     string
+	modelDir string
+
+	// keyring is only set for backends using the admin config.
+	keyring   *keyring
+	publicKey *rsa.PublicKey
+
+	mu sync.Mutex
+	// dataKeys holds the unwrapped data keys, keyed on
+	// secret id and then data key id.
+	dataKeys map[string]map[string][]byte
+}
+
+func (k *fileBackend) secretDir(secretID string) string {
+	return filepath.Join(k.modelDir, secretID)
+}
+
+func (k *fileBackend) dataKeyPath(secretID, dataKeyID string) string {
+	return filepath.Join(k.modelDir, secretID, keysDir, dataKeyID)
+}
+
+func (k *fileBackend) revisionPath(revisionId string) (string, string, error) {
+	secretID, _, ok := strings.Cut(revisionId, "-")
+	if !ok || secretID == "" || strings.ContainsAny(revisionId, `/\`) {
+		return "", "", errors.NotValidf("secret revision id %q", revisionId)
+	}
+	return secretID, filepath.Join(k.modelDir, secretID, revisionId), nil
+}
+
+func revisionNotFound(revisionId string) error {
+	return fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
+}
+
+// GetContent implements SecretsBackend.
+func (k *fileBackend) GetContent(ctx context.Context, revisionId string) (secrets.SecretValue, error) {
+	secretID, path, err := k.revisionPath(revisionId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	doc, err := readRevision(path)
+	if os.IsNotExist(err) {
+		return nil, revisionNotFound(revisionId)
+	} else if err != nil {
+		return nil, errors.Annotatef(err, "getting secret %q", revisionId)
+	}
+	dataKey, err := k.dataKey(secretID, doc.DataKeyID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	val, err := decrypt(revisionId, dataKey, doc)
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting secret %q", revisionId)
+	}
+	return secrets.NewSecretValue(val), nil
+}
+
+// DeleteContent implements SecretsBackend.
+func (k *fileBackend) DeleteContent(ctx context.Context, revisionId string) error {
+	secretID, path, err := k.revisionPath(revisionId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return revisionNotFound(revisionId)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	// Only those able to read a secret may delete its content.
+	if k.keyring == nil && !k.hasDataKey(secretID) {
+		return errors.WithType(
+			errors.Errorf("deleting secret %q: no access to secret %q", revisionId, secretID),
+			internalsecrets.PermissionDenied)
+	}
+	return errors.Trace(os.Remove(path))
+}
+
+// SaveContent implements SecretsBackend.
+func (k *fileBackend) SaveContent(ctx context.Context, uri *secrets.URI, revision int, value secrets.SecretValue) (string, error) {
+	path := uri.Name(revision)
+	dataKeyID, dataKey, err := k.dataKeyForWrite(uri.ID)
+	if err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", path)
+	}
+	doc, err := encrypt(path, dataKeyID, dataKey, value.EncodedValues())
+	if err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", path)
+	}
+	if err := writeRevision(filepath.Join(k.secretDir(uri.ID), path), doc); err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", path)
+	}
+	return path, nil
+}
+
+// Ping implements SecretsBackend.
+func (k *fileBackend) Ping() error {
+	info, err := os.Stat(k.path)
+	if err != nil {
+		return errors.Annotate(err, "backend not reachable")
+	}
+	if !info.IsDir() {
+		return errors.Errorf("backend path %q is not a directory", k.path)
+	}
+	return nil
+}
+
+func (k *fileBackend) hasDataKey(secretID string) bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return len(k.dataKeys[secretID]) > 0
+}
+
+func (k *fileBackend) cacheDataKey(secretID, dataKeyID string, dataKey []byte) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.dataKeys[secretID] == nil {
+		k.dataKeys[secretID] = make(map[string][]byte)
+	}
+	k.dataKeys[secretID][dataKeyID] = dataKey
+}
+
+// dataKey returns the specified unwrapped data key for a secret.
+// Only backends using the admin config can unwrap data keys; agents
+// are limited to the data keys included in their restricted config.
+func (k *fileBackend) dataKey(secretID, dataKeyID string) ([]byte, error) {
+	k.mu.Lock()
+	dataKey, ok := k.dataKeys[secretID][dataKeyID]
+	k.mu.Unlock()
+	if ok {
+		return dataKey, nil
+	}
+	if k.keyring == nil {
+		return nil, errors.WithType(
+			errors.Errorf("no access to data key %q for secret %q", dataKeyID, secretID),
+			internalsecrets.PermissionDenied)
+	}
+	doc, err := readDataKey(k.dataKeyPath(secretID, dataKeyID))
+	if err != nil {
+		return nil, errors.Annotatef(err, "reading data key %q for secret %q", dataKeyID, secretID)
+	}
+	dataKey, err = k.keyring.unwrap(doc.KEKID, secretID, doc.Key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	k.cacheDataKey(secretID, dataKeyID, dataKey)
+	return dataKey, nil
+}
+
+// allDataKeys returns all the unwrapped data keys for a secret.
+func (k *fileBackend) allDataKeys(secretID string) (map[string][]byte, error) {
+	ids, err := k.dataKeyIDs(secretID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make(map[string][]byte)
+	for _, id := range ids {
+		dataKey, err := k.dataKey(secretID, id)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result[id] = dataKey
+	}
+	return result, nil
+}
+
+// dataKeyForWrite returns a data key with which to encrypt new content for
+// a secret. An existing data key is used if it is still in use and is
+// wrapped with the key known to this backend, otherwise a new one is added.
+func (k *fileBackend) dataKeyForWrite(secretID string) (string, []byte, error) {
+	ids, err := k.dataKeyIDs(secretID)
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	kekID, err := keyID(k.publicKey)
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	for _, id := range ids {
+		k.mu.Lock()
+		dataKey, ok := k.dataKeys[secretID][id]
+		k.mu.Unlock()
+		if ok {
+			return id, dataKey, nil
+		}
+		if k.keyring == nil {
+			continue
+		}
+		doc, err := readDataKey(k.dataKeyPath(secretID, id))
+		if err != nil {
+			return "", nil, errors.Trace(err)
+		}
+		if doc.KEKID != kekID {
+			continue
+		}
+		dataKey, err = k.dataKey(secretID, id)
+		if err != nil {
+			return "", nil, errors.Trace(err)
+		}
+		return id, dataKey, nil
+	}
+	return k.newDataKey(secretID)
+}
+
+// newDataKey generates a data key for a secret and stores it
+// wrapped with the key encryption key known to this backend.
+func (k *fileBackend) newDataKey(secretID string) (string, []byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	idBytes := make([]byte, 8)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	dataKeyID := hex.EncodeToString(idBytes)
+
+	kekID, err := keyID(k.publicKey)
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	wrapped, err := wrapKey(k.publicKey, secretID, dataKey)
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	data, err := json.Marshal(dataKeyDoc{KEKID: kekID, Key: wrapped})
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	path := k.dataKeyPath(secretID, dataKeyID)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	if err := utils.AtomicWriteFile(path, data, 0600); err != nil {
+		return "", nil, errors.Annotatef(err, "writing data key for secret %q", secretID)
+	}
+	k.cacheDataKey(secretID, dataKeyID, dataKey)
+	return dataKeyID, dataKey, nil
+}
+
+// secretIDs returns the ids of the secrets with content in the model.
+func (k *fileBackend) secretIDs() ([]string, error) {
+	return listDir(k.modelDir, true)
+}
+
+// dataKeyIDs returns the ids of the data keys for a secret.
+func (k *fileBackend) dataKeyIDs(secretID string) ([]string, error) {
+	return listDir(filepath.Join(k.secretDir(secretID), keysDir), false)
+}
+
+// revisionIDs returns the ids of the revisions stored for a secret.
+func (k *fileBackend) revisionIDs(secretID string) ([]string, error) {
+	return listDir(k.secretDir(secretID), false)
+}
+
+// listDir returns the sorted names of the directories, or the
+// regular files, in the specified directory.
+func listDir(dir string, dirs bool) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var result []string
+	for _, e := range entries {
+		if e.IsDir() != dirs || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		result = append(result, e.Name())
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func readDataKey(path string) (dataKeyDoc, error) {
+	var doc dataKeyDoc
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return doc, errors.Trace(err)
+	}
+	err = json.Unmarshal(data, &doc)
+	return doc, errors.Trace(err)
+}
+
+func readRevision(path string) (revisionDoc, error) {
+	var doc revisionDoc
+	data, err := os.ReadFile(path)
+	if err != nil {
+		// Not traced so callers can check for a missing file.
+		return doc, err
+	}
+	err = json.Unmarshal(data, &doc)
+	return doc, errors.Trace(err)
+}
+
+func writeRevision(path string, doc revisionDoc) error {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(utils.AtomicWriteFile(path, data, 0600))
+}
+
+// encrypt encrypts secret content with the data key, binding
+// the ciphertext to the revision id.
+func encrypt(revisionId, dataKeyID string, dataKey []byte, content map[string]string) (revisionDoc, error) {
+	plaintext, err := json.Marshal(content)
+	if err != nil {
+		return revisionDoc{}, errors.Trace(err)
+	}
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return revisionDoc{}, errors.Trace(err)
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return revisionDoc{}, errors.Trace(err)
+	}
+	return revisionDoc{
+		DataKeyID: dataKeyID,
+		Nonce:     nonce,
+		Data:      gcm.Seal(nil, nonce, plaintext, []byte(revisionId)),
+	}, nil
+}
+
+// decrypt decrypts secret content encrypted with encrypt.
+func decrypt(revisionId string, dataKey []byte, doc revisionDoc) (map[string]string, error) {
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	plaintext, err := gcm.Open(nil, doc.Nonce, doc.Data, []byte(revisionId))
+	if err != nil {
+		return nil, errors.Annotate(err, "decrypting content")
+	}
+	var content map[string]string
+	if err := json.Unmarshal(plaintext, &content); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return content, nil
+}
+
+func newGCM(dataKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return cipher.NewGCM(block)
+}
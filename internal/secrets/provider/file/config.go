@@ -0,0 +1,137 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	coreconfig "github.com/juju/juju/core/config"
+	"github.com/juju/juju/internal/configschema"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+const (
+	PathKey    = "path"
+	KeyFileKey = "key-file"
+
+	PublicKeyKey = "public-key"
+	DataKeysKey  = "data-keys"
+)
+
+var configSchema = configschema.Fields{
+	PathKey: {
+		Description: "The directory in which to store encrypted secret content.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+		Mandatory:   true,
+	},
+	KeyFileKey: {
+		Description: "The controller file holding the key encryption keys.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+	},
+}
+
+// restrictedConfigSchema additionally holds the fields which are only set
+// in the restricted config handed out to agents.
+var restrictedConfigSchema = configschema.Fields{
+	PathKey: configSchema[PathKey],
+	PublicKeyKey: {
+		Description: "The public key used to wrap new data keys.",
+		Type:        configschema.Tstring,
+	},
+	DataKeysKey: {
+		Description: "The data keys for the secrets the agent can access.",
+		Type:        configschema.Tstring,
+		Secret:      true,
+	},
+}
+
+var configDefaults = schema.Defaults{}
+
+type backendConfig struct {
+	validAttrs map[string]interface{}
+}
+
+func (c *backendConfig) path() string {
+	return c.validAttrs[PathKey].(string)
+}
+
+func (c *backendConfig) keyFile() string {
+	v, _ := c.validAttrs[KeyFileKey].(string)
+	return v
+}
+
+func (c *backendConfig) publicKey() string {
+	v, _ := c.validAttrs[PublicKeyKey].(string)
+	return v
+}
+
+func (c *backendConfig) dataKeys() string {
+	v, _ := c.validAttrs[DataKeysKey].(string)
+	return v
+}
+
+// ConfigSchema implements SecretBackendProvider.
+func (p fileProvider) ConfigSchema() configschema.Fields {
+	return configSchema
+}
+
+// ConfigDefaults implements SecretBackendProvider.
+func (p fileProvider) ConfigDefaults() schema.Defaults {
+	return schema.Defaults{}
+}
+
+// ValidateConfig implements SecretBackendProvider.
+// When a new backend is validated, its key file is created if it doesn't
+// already exist.
+func (p fileProvider) ValidateConfig(oldCfg, newCfg provider.ConfigAttrs, tokenRotateInterval *time.Duration) error {
+	newValidCfg, err := newConfig(newCfg, configSchema)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !filepath.IsAbs(newValidCfg.path()) {
+		return errors.NotValidf("file config path %q not absolute", newValidCfg.path())
+	}
+	keyFile := newValidCfg.keyFile()
+	if keyFile == "" {
+		return errors.NotValidf("file config missing key file")
+	}
+	if !filepath.IsAbs(keyFile) {
+		return errors.NotValidf("file config key file %q not absolute", keyFile)
+	}
+
+	if oldCfg == nil {
+		// The key file is created when the backend is added, and must then
+		// be copied to every other controller.
+		return errors.Annotatef(createKeyring(keyFile), "creating key file %q", keyFile)
+	}
+	oldValidCfg, err := newConfig(oldCfg, configSchema)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for n, field := range configSchema {
+		if !field.Immutable {
+			continue
+		}
+		oldV := oldValidCfg.validAttrs[n]
+		newV := newValidCfg.validAttrs[n]
+		if oldV != newV {
+			return errors.Errorf("cannot change immutable field %q", n)
+		}
+	}
+	return nil
+}
+
+func newConfig(attrs map[string]interface{}, fields configschema.Fields) (*backendConfig, error) {
+	cfg, err := coreconfig.NewConfig(attrs, fields, configDefaults)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &backendConfig{cfg.Attributes()}, nil
+}
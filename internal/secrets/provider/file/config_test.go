@@ -0,0 +1,67 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/secrets/provider"
+	_ "github.com/juju/juju/internal/secrets/provider/all"
+	"github.com/juju/juju/internal/secrets/provider/file"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type configSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestConfigSuite(t *testing.T) {
+	tc.Run(t, &configSuite{})
+}
+
+func (s *configSuite) TestValidateConfig(c *tc.C) {
+	p, err := provider.Provider(file.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	configValidator, ok := p.(provider.ProviderConfig)
+	c.Assert(ok, tc.IsTrue)
+	for _, t := range []struct {
+		cfg    map[string]interface{}
+		oldCfg map[string]interface{}
+		err    string
+	}{{
+		cfg: map[string]interface{}{},
+		err: "path: expected string, got nothing",
+	}, {
+		cfg: map[string]interface{}{"path": "secrets", "key-file": "/etc/juju/kek"},
+		err: `file config path "secrets" not absolute not valid`,
+	}, {
+		cfg: map[string]interface{}{"path": "/srv/secrets"},
+		err: `file config missing key file not valid`,
+	}, {
+		cfg: map[string]interface{}{"path": "/srv/secrets", "key-file": "kek"},
+		err: `file config key file "kek" not absolute not valid`,
+	}, {
+		cfg: map[string]interface{}{"path": "/srv/secrets", "key-file": "/etc/juju/kek", "public-key": "foo"},
+		err: `unknown key "public-key" \(value "foo"\)`,
+	}, {
+		cfg:    map[string]interface{}{"path": "/srv/new", "key-file": "/etc/juju/kek"},
+		oldCfg: map[string]interface{}{"path": "/srv/old", "key-file": "/etc/juju/kek"},
+		err:    `cannot change immutable field "path"`,
+	}} {
+		err = configValidator.ValidateConfig(t.oldCfg, t.cfg, nil)
+		c.Check(err, tc.ErrorMatches, t.err)
+	}
+}
+
+func (s *configSuite) TestValidateConfigValid(c *tc.C) {
+	p, err := provider.Provider(file.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	configValidator, ok := p.(provider.ProviderConfig)
+	c.Assert(ok, tc.IsTrue)
+	cfg := map[string]interface{}{"path": "/srv/secrets", "key-file": "/etc/juju/kek"}
+	err = configValidator.ValidateConfig(cfg, cfg, nil)
+	c.Assert(err, tc.ErrorIsNil)
+}
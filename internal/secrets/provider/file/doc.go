@@ -0,0 +1,31 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package file provides a secrets backend which stores secret content
+// as encrypted files in a directory.
+//
+// Secret content is envelope encrypted. Each secret has one or more data
+// encryption keys which are used to encrypt the content of its revisions
+// with AES-256-GCM. Data keys are stored alongside the content, wrapped
+// with an RSA key encryption key held by the controller in the key file
+// named by the backend config. The key file is kept out of the database
+// and the content directory. It is created on the controller where the
+// backend is added, and must be copied to every other controller; a
+// controller without it fails to use the backend rather than creating a
+// new key.
+//
+// The content directory must be reachable by the controllers and by any
+// agents using the backend, for example by using shared storage. Agents
+// are never given the key file; their restricted config carries only the
+// public half of the key encryption key, so they can create new secrets,
+// and the unwrapped data keys of the secrets they own or can read.
+//
+// Rotating the backend token rotates the key encryption key: a new key is
+// made active, each secret gets a new data key wrapped with it, and every
+// revision is re-encrypted with the new data key. The previous key
+// encryption key, and the data keys wrapped with it, are retained until
+// the next rotation so agents holding them can still create and update
+// secrets. As the new key is only written to
+// the key file of the controller doing the rotation, keys are not rotated
+// while there is more than one controller.
+package file
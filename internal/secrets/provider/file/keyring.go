@@ -0,0 +1,221 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v4"
+)
+
+// kekBits is the size of the RSA key encryption keys.
+const kekBits = 2048
+
+// keyringDoc is the on disk representation of a keyring.
+type keyringDoc struct {
+	ActiveKeyID string            `json:"active-key-id"`
+	Keys        map[string]string `json:"keys"`
+}
+
+// keyring holds the key encryption keys for a file backend.
+// New data keys are wrapped with the active key; the other
+// keys are retained so existing data keys can be unwrapped.
+type keyring struct {
+	activeKeyID string
+	keys        map[string]*rsa.PrivateKey
+}
+
+// keyID returns the id of the specified public key,
+// derived from its fingerprint.
+func keyID(pub *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// encodePublicKey returns the base64 encoded PKIX form of the public key.
+func encodePublicKey(pub *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return base64.StdEncoding.EncodeToString(der), nil
+}
+
+// decodePublicKey parses a public key encoded with encodePublicKey.
+func decodePublicKey(encoded string) (*rsa.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Annotate(err, "decoding public key")
+	}
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, errors.Annotate(err, "parsing public key")
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.NotValidf("public key type %T", pub)
+	}
+	return rsaPub, nil
+}
+
+// createKeyring creates the keyring in the specified file with a new active
+// key, unless the file already exists.
+func createKeyring(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		_, err := loadKeyring(path)
+		return errors.Trace(err)
+	} else if !os.IsNotExist(err) {
+		return errors.Annotate(err, "reading key file")
+	}
+	kr := &keyring{keys: make(map[string]*rsa.PrivateKey)}
+	if _, err := kr.addKey(); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(kr.save(path))
+}
+
+// loadKeyring reads the keyring from the specified file. A missing key file
+// is an error rather than a reason to create a new key, as the secrets
+// already stored can only be read with the keys in the original file.
+func loadKeyring(path string) (*keyring, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, errors.NewNotFound(nil, fmt.Sprintf(
+			"key file %q not found, copy it from the controller where the secret backend was added", path))
+	} else if err != nil {
+		return nil, errors.Annotate(err, "reading key file")
+	}
+
+	var doc keyringDoc
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, errors.Annotatef(err, "parsing key file %q", path)
+	}
+	kr := &keyring{
+		activeKeyID: doc.ActiveKeyID,
+		keys:        make(map[string]*rsa.PrivateKey),
+	}
+	for id, encoded := range doc.Keys {
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Annotatef(err, "decoding key %q", id)
+		}
+		key, err := x509.ParsePKCS8PrivateKey(der)
+		if err != nil {
+			return nil, errors.Annotatef(err, "parsing key %q", id)
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, errors.NotValidf("key %q of type %T", id, key)
+		}
+		kr.keys[id] = rsaKey
+	}
+	if _, ok := kr.keys[kr.activeKeyID]; !ok {
+		return nil, errors.NotFoundf("active key %q in key file %q", kr.activeKeyID, path)
+	}
+	return kr, nil
+}
+
+// save atomically writes the keyring to the specified file.
+func (kr *keyring) save(path string) error {
+	doc := keyringDoc{
+		ActiveKeyID: kr.activeKeyID,
+		Keys:        make(map[string]string),
+	}
+	for id, key := range kr.keys {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		doc.Keys[id] = base64.StdEncoding.EncodeToString(der)
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Annotate(utils.AtomicWriteFile(path, data, 0600), "writing key file")
+}
+
+// addKey generates a new key and makes it the active key.
+func (kr *keyring) addKey() (string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, kekBits)
+	if err != nil {
+		return "", errors.Annotate(err, "generating key encryption key")
+	}
+	id, err := keyID(&key.PublicKey)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	kr.keys[id] = key
+	kr.activeKeyID = id
+	return id, nil
+}
+
+// retainOnly removes all keys other than those specified.
+func (kr *keyring) retainOnly(ids ...string) {
+	keep := make(map[string]bool)
+	for _, id := range ids {
+		keep[id] = true
+	}
+	for id := range kr.keys {
+		if !keep[id] {
+			delete(kr.keys, id)
+		}
+	}
+}
+
+// activeKey returns the public key of the active key.
+func (kr *keyring) activeKey() *rsa.PublicKey {
+	return &kr.keys[kr.activeKeyID].PublicKey
+}
+
+// keyIDs returns the ids of the keys in the keyring.
+func (kr *keyring) keyIDs() []string {
+	var ids []string
+	for id := range kr.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// unwrap decrypts a data key wrapped for the specified secret.
+func (kr *keyring) unwrap(kekID, secretID string, wrapped []byte) ([]byte, error) {
+	key, ok := kr.keys[kekID]
+	if !ok {
+		return nil, errors.NotFoundf("key encryption key %q", kekID)
+	}
+	dek, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, key, wrapped, []byte(secretID))
+	if err != nil {
+		return nil, errors.Annotatef(err, "unwrapping data key for secret %q", secretID)
+	}
+	return dek, nil
+}
+
+// wrapKey encrypts a data key for the specified secret
+// with the key encryption public key.
+func wrapKey(pub *rsa.PublicKey, secretID string, dek []byte) ([]byte, error) {
+	wrapped, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, pub, dek, []byte(secretID))
+	if err != nil {
+		return nil, errors.Annotatef(err, "wrapping data key for secret %q", secretID)
+	}
+	return wrapped, nil
+}
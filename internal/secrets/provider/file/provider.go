@@ -0,0 +1,185 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+var logger = internallogger.GetLogger("juju.secrets.file")
+
+const (
+	// BackendType is the type of the file secrets backend.
+	BackendType = "file"
+)
+
+// NewProvider returns a file secrets provider.
+func NewProvider() provider.SecretBackendProvider {
+	return fileProvider{}
+}
+
+type fileProvider struct {
+}
+
+func (p fileProvider) Type() string {
+	return BackendType
+}
+
+// Initialise sets up the directory holding the model's secrets.
+func (p fileProvider) Initialise(cfg *provider.ModelBackendConfig) error {
+	backend, err := p.newBackend(cfg.ModelUUID, &cfg.BackendConfig)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.MkdirAll(backend.modelDir, 0700))
+}
+
+// CleanupModel deletes all secrets associated with the model.
+func (p fileProvider) CleanupModel(ctx context.Context, cfg *provider.ModelBackendConfig) error {
+	backend, err := p.newBackend(cfg.ModelUUID, &cfg.BackendConfig)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.RemoveAll(backend.modelDir))
+}
+
+// CleanupSecrets removes the data keys of removed secrets
+// which no longer have any content.
+func (p fileProvider) CleanupSecrets(ctx context.Context, cfg *provider.ModelBackendConfig, _ secrets.Accessor, removed provider.SecretRevisions) error {
+	backend, err := p.newBackend(cfg.ModelUUID, &cfg.BackendConfig)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for id := range removed {
+		revs, err := backend.revisionIDs(id)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(revs) > 0 {
+			continue
+		}
+		if err := os.RemoveAll(backend.secretDir(id)); err != nil {
+			return errors.Annotatef(err, "removing data keys for secret %q", id)
+		}
+	}
+	return nil
+}
+
+// RestrictedConfig returns the config needed to create a
+// secrets backend client restricted to manage the specified
+// owned secrets and read shared secrets for the given accessor.
+// The key file is never included; instead the config holds the
+// public key used to wrap data keys for new secrets and the data
+// keys for the secrets which may be accessed.
+func (p fileProvider) RestrictedConfig(
+	ctx context.Context, adminCfg *provider.ModelBackendConfig, _, forDrain bool, accessor secrets.Accessor, owned provider.SecretRevisions, read provider.SecretRevisions,
+) (*provider.BackendConfig, error) {
+	backend, err := p.newBackend(adminCfg.ModelUUID, &adminCfg.BackendConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if backend.keyring == nil {
+		return nil, errors.NotValidf("file config missing key file")
+	}
+
+	var secretIDs []string
+	if forDrain || accessor.Kind == secrets.ModelAccessor {
+		// Drain workers and admin users can access all secrets in the model.
+		if secretIDs, err = backend.secretIDs(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	} else {
+		for id := range owned {
+			secretIDs = append(secretIDs, id)
+		}
+		for id := range read {
+			if _, ok := owned[id]; !ok {
+				secretIDs = append(secretIDs, id)
+			}
+		}
+	}
+	logger.Debugf(ctx, "data keys for secrets: %v", secretIDs)
+
+	dataKeys := make(map[string]map[string][]byte)
+	for _, id := range secretIDs {
+		keys, err := backend.allDataKeys(id)
+		if err != nil {
+			return nil, errors.Annotatef(err, "getting data keys for secret %q", id)
+		}
+		if len(keys) > 0 {
+			dataKeys[id] = keys
+		}
+	}
+	dataKeysJSON, err := json.Marshal(dataKeys)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	publicKey, err := encodePublicKey(backend.publicKey)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	cfg := provider.BackendConfig{
+		BackendType: adminCfg.BackendType,
+		Config: provider.ConfigAttrs{
+			PathKey:      backend.path,
+			PublicKeyKey: publicKey,
+			DataKeysKey:  string(dataKeysJSON),
+		},
+	}
+	return &cfg, nil
+}
+
+// NewBackend returns a file backed secrets backend client.
+func (p fileProvider) NewBackend(cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+	return p.newBackend(cfg.ModelUUID, &cfg.BackendConfig)
+}
+
+func (p fileProvider) newBackend(modelUUID string, cfg *provider.BackendConfig) (*fileBackend, error) {
+	fields := configSchema
+	if _, ok := cfg.Config[KeyFileKey]; !ok {
+		fields = restrictedConfigSchema
+	}
+	validCfg, err := newConfig(cfg.Config, fields)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid file config")
+	}
+	backend := &fileBackend{
+		path:     validCfg.path(),
+		modelDir: filepath.Join(validCfg.path(), modelUUID),
+		dataKeys: make(map[string]map[string][]byte),
+	}
+
+	if keyFile := validCfg.keyFile(); keyFile != "" {
+		backend.keyring, err = loadKeyring(keyFile)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		backend.publicKey = backend.keyring.activeKey()
+		return backend, nil
+	}
+
+	if validCfg.publicKey() == "" {
+		return nil, errors.NotValidf("file config missing public key")
+	}
+	backend.publicKey, err = decodePublicKey(validCfg.publicKey())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if dataKeys := validCfg.dataKeys(); dataKeys != "" {
+		if err := json.Unmarshal([]byte(dataKeys), &backend.dataKeys); err != nil {
+			return nil, errors.Annotate(err, "parsing data keys")
+		}
+	}
+	return backend, nil
+}
@@ -0,0 +1,377 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	internalsecrets "github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/secrets/provider"
+	_ "github.com/juju/juju/internal/secrets/provider/all"
+	"github.com/juju/juju/internal/secrets/provider/file"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type providerSuite struct {
+	testhelpers.IsolationSuite
+
+	path    string
+	keyFile string
+}
+
+func TestProviderSuite(t *testing.T) {
+	tc.Run(t, &providerSuite{})
+}
+
+func (s *providerSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.path = c.MkDir()
+	s.keyFile = filepath.Join(c.MkDir(), "kek.json")
+
+	// The key file is created when the backend is added.
+	err := s.provider(c).(provider.ProviderConfig).ValidateConfig(nil, s.adminConfig().Config, nil)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerSuite) provider(c *tc.C) provider.SecretBackendProvider {
+	p, err := provider.Provider(file.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	return p
+}
+
+func (s *providerSuite) adminConfig() *provider.ModelBackendConfig {
+	return &provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      coretesting.ModelTag.Id(),
+		ModelName:      "fred",
+		BackendConfig: provider.BackendConfig{
+			BackendType: file.BackendType,
+			Config: map[string]interface{}{
+				"path":     s.path,
+				"key-file": s.keyFile,
+			},
+		},
+	}
+}
+
+func (s *providerSuite) modelConfig(cfg *provider.BackendConfig) *provider.ModelBackendConfig {
+	return &provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      coretesting.ModelTag.Id(),
+		ModelName:      "fred",
+		BackendConfig:  *cfg,
+	}
+}
+
+func (s *providerSuite) newBackend(c *tc.C, cfg *provider.ModelBackendConfig) provider.SecretsBackend {
+	b, err := s.provider(c).NewBackend(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	return b
+}
+
+func (s *providerSuite) readKeyFile(c *tc.C) (string, []string) {
+	data, err := os.ReadFile(s.keyFile)
+	c.Assert(err, tc.ErrorIsNil)
+	var doc struct {
+		ActiveKeyID string            `json:"active-key-id"`
+		Keys        map[string]string `json:"keys"`
+	}
+	err = json.Unmarshal(data, &doc)
+	c.Assert(err, tc.ErrorIsNil)
+	var ids []string
+	for id := range doc.Keys {
+		ids = append(ids, id)
+	}
+	return doc.ActiveKeyID, ids
+}
+
+func (s *providerSuite) dataKeyIDs(c *tc.C, uri *secrets.URI) []string {
+	entries, err := os.ReadDir(filepath.Join(s.path, coretesting.ModelTag.Id(), uri.ID, "keys"))
+	c.Assert(err, tc.ErrorIsNil)
+	var ids []string
+	for _, e := range entries {
+		ids = append(ids, e.Name())
+	}
+	return ids
+}
+
+func (s *providerSuite) TestInitialise(c *tc.C) {
+	err := s.provider(c).Initialise(s.adminConfig())
+	c.Assert(err, tc.ErrorIsNil)
+
+	info, err := os.Stat(filepath.Join(s.path, coretesting.ModelTag.Id()))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(info.IsDir(), tc.IsTrue)
+
+	info, err = os.Stat(s.keyFile)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(info.Mode().Perm(), tc.Equals, os.FileMode(0600))
+	active, ids := s.readKeyFile(c)
+	c.Assert(ids, tc.DeepEquals, []string{active})
+}
+
+func (s *providerSuite) TestValidateConfigKeepsKeyFile(c *tc.C) {
+	active, _ := s.readKeyFile(c)
+
+	// Adding another backend with the same key file doesn't replace it.
+	err := s.provider(c).(provider.ProviderConfig).ValidateConfig(nil, s.adminConfig().Config, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	again, ids := s.readKeyFile(c)
+	c.Check(again, tc.Equals, active)
+	c.Check(ids, tc.DeepEquals, []string{active})
+}
+
+func (s *providerSuite) TestMissingKeyFile(c *tc.C) {
+	err := os.Remove(s.keyFile)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.provider(c).NewBackend(s.adminConfig())
+	c.Assert(err, tc.ErrorIs, coreerrors.NotFound)
+	c.Assert(err, tc.ErrorMatches, `key file ".*kek.json" not found, copy it from the controller where the secret backend was added`)
+
+	// A new key isn't created in place of the missing one.
+	_, err = os.Stat(s.keyFile)
+	c.Assert(os.IsNotExist(err), tc.IsTrue)
+}
+
+func (s *providerSuite) TestContent(c *tc.C) {
+	b := s.newBackend(c, s.adminConfig())
+
+	uri := secrets.NewURI()
+	value := secrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	revisionId, err := b.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(revisionId, tc.Equals, uri.Name(1))
+
+	// The content is not stored in the clear.
+	data, err := os.ReadFile(filepath.Join(s.path, coretesting.ModelTag.Id(), uri.ID, revisionId))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(string(data), tc.Not(tc.Contains), "YmFy")
+
+	// A new backend reads the content using the key file.
+	result, err := s.newBackend(c, s.adminConfig()).GetContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmFy"})
+
+	err = b.DeleteContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = b.GetContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+	err = b.DeleteContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *providerSuite) TestContentTampered(c *tc.C) {
+	b := s.newBackend(c, s.adminConfig())
+
+	uri := secrets.NewURI()
+	value := secrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	_, err := b.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Content moved to another revision fails authentication.
+	dir := filepath.Join(s.path, coretesting.ModelTag.Id(), uri.ID)
+	err = os.Rename(filepath.Join(dir, uri.Name(1)), filepath.Join(dir, uri.Name(2)))
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = b.GetContent(c.Context(), uri.Name(2))
+	c.Assert(err, tc.ErrorMatches, `getting secret ".*-2": decrypting content: .*`)
+}
+
+func (s *providerSuite) TestRestrictedConfig(c *tc.C) {
+	p := s.provider(c)
+	adminCfg := s.adminConfig()
+	admin := s.newBackend(c, adminCfg)
+
+	owned := secrets.NewURI()
+	read := secrets.NewURI()
+	other := secrets.NewURI()
+	value := secrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	for _, uri := range []*secrets.URI{owned, read, other} {
+		_, err := admin.SaveContent(c.Context(), uri, 1, value)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	ownedRevs := provider.SecretRevisions{}
+	ownedRevs.Add(owned, owned.Name(1))
+	readRevs := provider.SecretRevisions{}
+	readRevs.Add(read, read.Name(1))
+	accessor := secrets.Accessor{Kind: secrets.UnitAccessor, ID: "gitlab/0"}
+	cfg, err := p.RestrictedConfig(c.Context(), adminCfg, true, false, accessor, ownedRevs, readRevs)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.Config, tc.Not(tc.Contains), "key-file")
+	c.Assert(cfg.Config["path"], tc.Equals, s.path)
+	c.Assert(adminCfg.Config["key-file"], tc.Equals, s.keyFile)
+
+	agent := s.newBackend(c, s.modelConfig(cfg))
+	for _, uri := range []*secrets.URI{owned, read} {
+		result, err := agent.GetContent(c.Context(), uri.Name(1))
+		c.Assert(err, tc.ErrorIsNil)
+		c.Assert(result.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmFy"})
+	}
+	_, err = agent.GetContent(c.Context(), other.Name(1))
+	c.Assert(err, tc.ErrorIs, internalsecrets.PermissionDenied)
+	err = agent.DeleteContent(c.Context(), other.Name(1))
+	c.Assert(err, tc.ErrorIs, internalsecrets.PermissionDenied)
+
+	// Agents can create new secrets which the controller can read.
+	created := secrets.NewURI()
+	_, err = agent.SaveContent(c.Context(), created, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+	result, err := admin.GetContent(c.Context(), created.Name(1))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmFy"})
+}
+
+func (s *providerSuite) TestRestrictedConfigModelAccessor(c *tc.C) {
+	p := s.provider(c)
+	adminCfg := s.adminConfig()
+	admin := s.newBackend(c, adminCfg)
+
+	uri := secrets.NewURI()
+	value := secrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	_, err := admin.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+
+	accessor := secrets.Accessor{Kind: secrets.ModelAccessor, ID: coretesting.ModelTag.Id()}
+	cfg, err := p.RestrictedConfig(c.Context(), adminCfg, true, false, accessor, nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := s.newBackend(c, s.modelConfig(cfg)).GetContent(c.Context(), uri.Name(1))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmFy"})
+}
+
+func (s *providerSuite) TestRefreshAuthRotatesKeys(c *tc.C) {
+	p := s.provider(c)
+	adminCfg := s.adminConfig()
+	admin := s.newBackend(c, adminCfg)
+
+	uri := secrets.NewURI()
+	for rev, v := range []string{"YmFy", "YmF6"} {
+		value := secrets.NewSecretValue(map[string]string{"foo": v})
+		_, err := admin.SaveContent(c.Context(), uri, rev+1, value)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	originalKeyID, _ := s.readKeyFile(c)
+	originalDataKeys := s.dataKeyIDs(c, uri)
+	c.Assert(originalDataKeys, tc.HasLen, 1)
+
+	ownedRevs := provider.SecretRevisions{}
+	ownedRevs.Add(uri, uri.Name(1), uri.Name(2))
+	accessor := secrets.Accessor{Kind: secrets.UnitAccessor, ID: "gitlab/0"}
+	agentCfg, err := p.RestrictedConfig(c.Context(), adminCfg, true, false, accessor, ownedRevs, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	refresher, ok := p.(provider.SupportAuthRefresh)
+	c.Assert(ok, tc.IsTrue)
+	cfg, err := refresher.RefreshAuth(c.Context(), adminCfg.BackendConfig, 0)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.Config, tc.DeepEquals, adminCfg.Config)
+
+	// A new key is active and the previous one retained.
+	activeKeyID, keyIDs := s.readKeyFile(c)
+	c.Assert(activeKeyID, tc.Not(tc.Equals), originalKeyID)
+	c.Assert(keyIDs, tc.SameContents, []string{originalKeyID, activeKeyID})
+
+	// The content is re-encrypted with a new data key, and the
+	// original data key is retained.
+	dataKeys := s.dataKeyIDs(c, uri)
+	c.Assert(dataKeys, tc.HasLen, 2)
+	c.Assert(slices.Contains(dataKeys, originalDataKeys[0]), tc.IsTrue)
+	newAdmin := s.newBackend(c, adminCfg)
+	for rev, v := range []string{"YmFy", "YmF6"} {
+		result, err := newAdmin.GetContent(c.Context(), uri.Name(rev+1))
+		c.Assert(err, tc.ErrorIsNil)
+		c.Assert(result.EncodedValues(), tc.DeepEquals, map[string]string{"foo": v})
+	}
+
+	// Agents need a new restricted config to read the content.
+	staleAgent := s.newBackend(c, s.modelConfig(agentCfg))
+	_, err = staleAgent.GetContent(c.Context(), uri.Name(1))
+	c.Assert(err, tc.ErrorIs, internalsecrets.PermissionDenied)
+	agentCfg, err = p.RestrictedConfig(c.Context(), adminCfg, true, false, accessor, ownedRevs, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	result, err := s.newBackend(c, s.modelConfig(agentCfg)).GetContent(c.Context(), uri.Name(1))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmFy"})
+
+	// Agents which haven't yet refreshed their config can still
+	// write content with the original data key.
+	value := secrets.NewSecretValue(map[string]string{"foo": "cXV4"})
+	_, err = staleAgent.SaveContent(c.Context(), uri, 3, value)
+	c.Assert(err, tc.ErrorIsNil)
+	result, err = s.newBackend(c, adminCfg).GetContent(c.Context(), uri.Name(3))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "cXV4"})
+
+	// Rotating again drops the original keys, and the content
+	// written with them is re-encrypted.
+	_, err = refresher.RefreshAuth(c.Context(), adminCfg.BackendConfig, 0)
+	c.Assert(err, tc.ErrorIsNil)
+	latestKeyID, keyIDs := s.readKeyFile(c)
+	c.Assert(keyIDs, tc.SameContents, []string{activeKeyID, latestKeyID})
+	latestDataKeys := s.dataKeyIDs(c, uri)
+	c.Assert(latestDataKeys, tc.HasLen, 2)
+	c.Assert(slices.Contains(latestDataKeys, originalDataKeys[0]), tc.IsFalse)
+	latestAdmin := s.newBackend(c, adminCfg)
+	for rev, v := range []string{"YmFy", "YmF6", "cXV4"} {
+		result, err := latestAdmin.GetContent(c.Context(), uri.Name(rev+1))
+		c.Assert(err, tc.ErrorIsNil)
+		c.Assert(result.EncodedValues(), tc.DeepEquals, map[string]string{"foo": v})
+	}
+}
+
+func (s *providerSuite) TestCleanupSecrets(c *tc.C) {
+	p := s.provider(c)
+	adminCfg := s.adminConfig()
+	admin := s.newBackend(c, adminCfg)
+
+	uri := secrets.NewURI()
+	value := secrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	_, err := admin.SaveContent(c.Context(), uri, 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+	err = admin.DeleteContent(c.Context(), uri.Name(1))
+	c.Assert(err, tc.ErrorIsNil)
+
+	removed := provider.SecretRevisions{}
+	removed.Add(uri, uri.Name(1))
+	err = p.CleanupSecrets(c.Context(), adminCfg, secrets.Accessor{}, removed)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = os.Stat(filepath.Join(s.path, coretesting.ModelTag.Id(), uri.ID))
+	c.Assert(os.IsNotExist(err), tc.IsTrue)
+}
+
+func (s *providerSuite) TestCleanupModel(c *tc.C) {
+	p := s.provider(c)
+	adminCfg := s.adminConfig()
+	admin := s.newBackend(c, adminCfg)
+
+	value := secrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	_, err := admin.SaveContent(c.Context(), secrets.NewURI(), 1, value)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = p.CleanupModel(c.Context(), adminCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = os.Stat(filepath.Join(s.path, coretesting.ModelTag.Id()))
+	c.Assert(os.IsNotExist(err), tc.IsTrue)
+}
+
+func (s *providerSuite) TestPing(c *tc.C) {
+	b := s.newBackend(c, s.adminConfig())
+	c.Assert(b.Ping(), tc.ErrorIsNil)
+
+	err := os.RemoveAll(s.path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(b.Ping(), tc.ErrorMatches, "backend not reachable: .*")
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+// ControllerLocalAuth implements SupportControllerLocalAuth.
+// The key file is held on each controller, and rotating the key encryption
+// key only changes the key file on the controller doing the rotation, so
+// keys are not rotated when there is more than one controller.
+func (p fileProvider) ControllerLocalAuth() {}
+
+// RefreshAuth implements SupportAuthRefresh.
+// It rotates the key encryption key: a new key is made active and the
+// content of every secret is re-encrypted with a new data key wrapped
+// with it. The previous key, and the data keys wrapped with it, are
+// retained until the next rotation so that agents holding them in their
+// restricted config can still create and update secrets. The admin
+// config itself is unchanged.
+func (p fileProvider) RefreshAuth(ctx context.Context, adminCfg provider.BackendConfig, _ time.Duration) (*provider.BackendConfig, error) {
+	validCfg, err := newConfig(adminCfg.Config, configSchema)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid file config")
+	}
+	keyFile := validCfg.keyFile()
+	if keyFile == "" {
+		return nil, errors.NotValidf("file config missing key file")
+	}
+	kr, err := loadKeyring(keyFile)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	previousKeyID := kr.activeKeyID
+	activeKeyID, err := kr.addKey()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := kr.save(keyFile); err != nil {
+		return nil, errors.Trace(err)
+	}
+	logger.Debugf(ctx, "rotated key encryption key %q to %q", previousKeyID, activeKeyID)
+
+	modelUUIDs, err := listDir(validCfg.path(), true)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, modelUUID := range modelUUIDs {
+		backend := &fileBackend{
+			path:      validCfg.path(),
+			modelDir:  filepath.Join(validCfg.path(), modelUUID),
+			keyring:   kr,
+			publicKey: kr.activeKey(),
+			dataKeys:  make(map[string]map[string][]byte),
+		}
+		secretIDs, err := backend.secretIDs()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, id := range secretIDs {
+			if err := backend.rotateDataKey(id); err != nil {
+				return nil, errors.Annotatef(err, "re-encrypting secret %q in model %q", id, modelUUID)
+			}
+			if err := backend.removeDataKeys(id, previousKeyID, activeKeyID); err != nil {
+				return nil, errors.Annotatef(err, "removing data keys for secret %q in model %q", id, modelUUID)
+			}
+		}
+	}
+
+	// All content is now encrypted with data keys wrapped with the
+	// active key so any keys older than the previous one are no
+	// longer needed.
+	kr.retainOnly(previousKeyID, activeKeyID)
+	if err := kr.save(keyFile); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &adminCfg, nil
+}
+
+// rotateDataKey re-encrypts the content of each revision of a secret
+// with a new data key. The secret's old data keys are left in place as
+// agents may still be using them to write new content.
+func (k *fileBackend) rotateDataKey(secretID string) error {
+	dataKeyID, dataKey, err := k.newDataKey(secretID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	revisionIDs, err := k.revisionIDs(secretID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, revisionId := range revisionIDs {
+		path := filepath.Join(k.secretDir(secretID), revisionId)
+		doc, err := readRevision(path)
+		if err != nil {
+			return errors.Annotatef(err, "reading secret %q", revisionId)
+		}
+		oldDataKey, err := k.dataKey(secretID, doc.DataKeyID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		content, err := decrypt(revisionId, oldDataKey, doc)
+		if err != nil {
+			return errors.Annotatef(err, "getting secret %q", revisionId)
+		}
+		doc, err = encrypt(revisionId, dataKeyID, dataKey, content)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := writeRevision(path, doc); err != nil {
+			return errors.Annotatef(err, "writing secret %q", revisionId)
+		}
+	}
+	return nil
+}
+
+// removeDataKeys removes the data keys for a secret which are not
+// wrapped with one of the specified key encryption keys. Any content
+// written with such a data key since the previous rotation has been
+// re-encrypted by rotateDataKey while its key encryption key was
+// still available.
+func (k *fileBackend) removeDataKeys(secretID string, kekIDs ...string) error {
+	ids, err := k.dataKeyIDs(secretID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, id := range ids {
+		path := k.dataKeyPath(secretID, id)
+		doc, err := readDataKey(path)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return errors.Annotatef(err, "reading data key %q", id)
+		}
+		if slices.Contains(kekIDs, doc.KEKID) {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Annotatef(err, "removing data key %q", id)
+		}
+	}
+	return nil
+}
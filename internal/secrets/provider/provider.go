@@ -100,3 +100,17 @@ func HasAuthRefresh(p SecretBackendProvider) bool {
 	_, ok := p.(SupportAuthRefresh)
 	return ok
 }
+
+// SupportControllerLocalAuth is implemented by providers whose auth is held
+// on the local disk of each controller. Refreshing the auth only changes it
+// on the controller doing the refresh.
+type SupportControllerLocalAuth interface {
+	ControllerLocalAuth()
+}
+
+// HasControllerLocalAuth returns true if the provider's auth is held on the
+// local disk of each controller.
+func HasControllerLocalAuth(p SecretBackendProvider) bool {
+	_, ok := p.(SupportControllerLocalAuth)
+	return ok
+}
@@ -14,8 +14,10 @@ import (
 	annotationService "github.com/juju/juju/domain/annotation/service"
 	applicationservice "github.com/juju/juju/domain/application/service"
 	autocertcacheservice "github.com/juju/juju/domain/autocert/service"
+	backupservice "github.com/juju/juju/domain/backup/service"
 	blockcommandservice "github.com/juju/juju/domain/blockcommand/service"
 	blockdeviceservice "github.com/juju/juju/domain/blockdevice/service"
+	changefeedservice "github.com/juju/juju/domain/changefeed/service"
 	cloudservice "github.com/juju/juju/domain/cloud/service"
 	cloudimagemetadataservice "github.com/juju/juju/domain/cloudimagemetadata/service"
 	controllerservice "github.com/juju/juju/domain/controller/service"
@@ -44,7 +46,9 @@ import (
 	resourceservice "github.com/juju/juju/domain/resource/service"
 	secretservice "github.com/juju/juju/domain/secret/service"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
+	sshcaservice "github.com/juju/juju/domain/sshca/service"
 	statusservice "github.com/juju/juju/domain/status/service"
+	statushistoryservice "github.com/juju/juju/domain/statushistory/service"
 	storageservice "github.com/juju/juju/domain/storage/service"
 	stubservice "github.com/juju/juju/domain/stub"
 	unitstateservice "github.com/juju/juju/domain/unitstate/service"
@@ -86,6 +90,12 @@ type ControllerDomainServices interface {
 	SecretBackend() *secretbackendservice.WatchableService
 	// Macaroon returns the macaroon bakery backend service
 	Macaroon() *macaroonservice.Service
+	// ControllerBackup returns the backup service for the controller
+	// database.
+	ControllerBackup() *backupservice.Service
+	// SSHCertificateAuthority returns the service for the SSH certificate
+	// authority of the controller.
+	SSHCertificateAuthority() *sshcaservice.Service
 }
 
 // ModelDomainServices provides access to the services required by the
@@ -123,7 +133,7 @@ type ModelDomainServices interface {
 	// Network returns the space service.
 	Network() *networkservice.WatchableService
 	// Storage returns the storage service.
-	Storage() *storageservice.Service
+	Storage() *storageservice.WatchableService
 	// Secret returns the secret service.
 	Secret() *secretservice.WatchableService
 	// ModelInfo returns the model service for the model.
@@ -149,12 +159,22 @@ type ModelDomainServices interface {
 	Port() *portservice.WatchableService
 	// BlockCommand returns the service for blocking commands.
 	BlockCommand() *blockcommandservice.Service
+	// ObjectStore returns the service for inspecting the model's object
+	// store.
+	ObjectStore() *objectstoreservice.Service
+	// ChangeFeed returns the service for watching the model's change feed.
+	ChangeFeed() *changefeedservice.WatchableService
+	// Backup returns the backup service for the model database.
+	Backup() *backupservice.Service
 	// Relation returns the service for managing relations.
 	Relation() *relationservice.WatchableService
 	// Resource returns the service for managing resources.
 	Resource() *resourceservice.Service
 	// Removal returns the service for managing entity removal.
 	Removal() *removalservice.WatchableService
+	// StatusHistory returns the service for querying and pruning the
+	// model's status history.
+	StatusHistory() *statushistoryservice.Service
 	// AgentPassword returns the service for managing agent passwords.
 	AgentPassword() *agentpasswordservice.Service
 	// ModelProvider returns a service for accessing info relevant to the
@@ -237,3 +237,41 @@ func (s scannerCloser) Close() error {
 	}
 	return nil
 }
+
+// Query describes a query against an indexed status history store. Zero
+// values are treated as unbounded.
+type Query struct {
+	// Kinds restricts the records to the given kinds. If empty, records of
+	// all kinds are returned.
+	Kinds []status.HistoryKind
+	// ID restricts the records to the given namespace ID.
+	ID string
+	// From restricts the records to those after the given time.
+	From time.Time
+	// To restricts the records to those before the given time.
+	To time.Time
+	// Limit is the maximum number of records to return.
+	Limit int
+}
+
+type multiRecorder struct {
+	recorders []Recorder
+}
+
+// NewMultiRecorder returns a Recorder that records the status information to
+// all of the given recorders. All the recorders are called, even if one of
+// them returns an error. The first error encountered is returned.
+func NewMultiRecorder(recorders ...Recorder) Recorder {
+	return &multiRecorder{recorders: recorders}
+}
+
+// Record implements Recorder.Record.
+func (r *multiRecorder) Record(ctx context.Context, record Record) error {
+	var err error
+	for _, recorder := range r.recorders {
+		if rErr := recorder.Record(ctx, record); rErr != nil && err == nil {
+			err = rErr
+		}
+	}
+	return err
+}
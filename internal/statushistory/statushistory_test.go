@@ -178,6 +178,24 @@ func (s *statusHistorySuite) TestRecordStatusNoSince(c *tc.C) {
 	c.Check(record.Time, tc.Not(tc.Equals), "")
 }
 
+func (s *statusHistorySuite) TestMultiRecorder(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	other := NewMockRecorder(ctrl)
+
+	record := Record{
+		Kind:   "foo",
+		ID:     "123",
+		Status: "active",
+	}
+	s.recorder.EXPECT().Record(gomock.Any(), record).Return(errors.New("boom"))
+	other.EXPECT().Record(gomock.Any(), record).Return(nil)
+
+	err := NewMultiRecorder(s.recorder, other).Record(c.Context(), record)
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
 func (s *statusHistorySuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
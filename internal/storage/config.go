@@ -19,6 +19,16 @@ const (
 	// should not be relied upon until a storage source is
 	// constructed.
 	ConfigStorageDir = "storage-dir"
+
+	// ConfigModelUUID is the UUID of the model on whose behalf a
+	// machine-scoped storage source manages storage. Storage
+	// sources may use it to distinguish their own artifacts from
+	// those of other models sharing the same machine resources.
+	//
+	// ConfigModelUUID is set by the storage provisioner, so
+	// should not be relied upon until a storage source is
+	// constructed.
+	ConfigModelUUID = "model-uuid"
 )
 
 // Attrs defines storage attributes.
@@ -221,6 +221,30 @@ type VolumeImporter interface {
 	) (VolumeInfo, error)
 }
 
+// VolumeResizer provides an interface for growing existing volumes. It is
+// implemented by the VolumeSources of providers that support resizing.
+type VolumeResizer interface {
+	// ResizeVolumes grows the volumes with the specified provider
+	// volume IDs to at least the corresponding requested size.
+	// Shrinking a volume is not supported.
+	ResizeVolumes(ctx context.Context, params []ResizeVolumeParams) ([]ResizeVolumesResult, error)
+}
+
+// VolumeSnapshotter provides an interface for taking point-in-time
+// snapshots of existing volumes, and for removing them. It is implemented
+// by the VolumeSources of providers that support snapshots. Providers
+// that implement VolumeSnapshotter must also support creating volumes
+// from a snapshot, as specified by VolumeParams.SnapshotId.
+type VolumeSnapshotter interface {
+	// CreateVolumeSnapshots takes a snapshot of each of the volumes
+	// with the specified provider volume IDs.
+	CreateVolumeSnapshots(ctx context.Context, params []VolumeSnapshotParams) ([]CreateVolumeSnapshotsResult, error)
+
+	// DestroyVolumeSnapshots removes the snapshots with the specified
+	// provider snapshot IDs.
+	DestroyVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error)
+}
+
 // VolumeParams is a fully specified set of parameters for volume creation,
 // derived from one or more of user-specified storage directives, a
 // storage pool definition, and charm storage metadata.
@@ -235,6 +259,10 @@ type VolumeParams struct {
 	// create the volume.
 	Provider ProviderType
 
+	// Pool is the name of the storage pool from which the volume is
+	// allocated, or empty if it's allocated directly from the provider.
+	Pool string
+
 	// Attributes is the set of provider-specific attributes to pass to
 	// the storage provider when creating the volume. Attributes is derived
 	// from the storage pool configuration.
@@ -255,6 +283,10 @@ type VolumeParams struct {
 	// once the instance is created there are still unprovisioned volumes,
 	// the dynamic storage provisioner will take care of creating them.
 	Attachment *VolumeAttachmentParams
+
+	// SnapshotId, if non-empty, is the provider-supplied ID of a
+	// snapshot from which the volume's initial content is copied.
+	SnapshotId string
 }
 
 // VolumeAttachmentParams is a set of parameters for volume attachment or
@@ -269,6 +301,14 @@ type VolumeAttachmentParams struct {
 	// VolumeId is the unique provider-supplied ID for the volume that
 	// should be attached/detached.
 	VolumeId string
+
+	// Pool is the name of the storage pool from which the volume was
+	// allocated, or empty if it was allocated directly from the provider.
+	Pool string
+
+	// Attributes is the configuration of the storage pool from which
+	// the volume was allocated.
+	Attributes map[string]interface{}
 }
 
 // AttachmentParams describes the parameters for attaching a volume or
@@ -360,6 +400,56 @@ type DescribeVolumesResult struct {
 	Error      error
 }
 
+// ResizeVolumeParams is a set of parameters for growing a volume.
+type ResizeVolumeParams struct {
+	// Tag is the unique tag assigned by Juju for the volume.
+	Tag names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume.
+	VolumeId string
+
+	// Size is the requested minimum size of the volume in MiB.
+	Size uint64
+}
+
+// VolumeSnapshotParams is a set of parameters for taking a volume snapshot.
+type VolumeSnapshotParams struct {
+	// Tag is the unique tag assigned by Juju for the volume.
+	Tag names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume.
+	VolumeId string
+
+	// ResourceTags is a set of tags to set on the created snapshot,
+	// if the storage provider supports tags.
+	ResourceTags map[string]string
+}
+
+// VolumeSnapshot describes a snapshot taken of a volume.
+type VolumeSnapshot struct {
+	// SnapshotId is the unique provider-supplied ID for the snapshot.
+	SnapshotId string
+
+	// Size is the size of the snapshotted volume in MiB.
+	Size uint64
+}
+
+// CreateVolumeSnapshotsResult contains the result of a
+// VolumeSnapshotter.CreateVolumeSnapshots call for one volume.
+// Snapshot should only be used if Error is nil.
+type CreateVolumeSnapshotsResult struct {
+	Snapshot *VolumeSnapshot
+	Error    error
+}
+
+// ResizeVolumesResult contains the result of a VolumeResizer.ResizeVolumes
+// call for one volume. Size should only be used if Error is nil.
+type ResizeVolumesResult struct {
+	// Size is the size of the volume in MiB after resizing.
+	Size  uint64
+	Error error
+}
+
 // AttachVolumesResult contains the result of a VolumeSource.AttachVolumes call
 // for one volume. VolumeAttachment should only be used if Error is nil.
 type AttachVolumesResult struct {
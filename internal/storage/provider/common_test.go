@@ -34,6 +34,7 @@ func (s *providerCommonSuite) TestCommonProvidersExported(c *tc.C) {
 	}
 	c.Assert(common, tc.SameContents, []storage.ProviderType{
 		provider.LoopProviderType,
+		provider.LVMProviderType,
 		provider.RootfsProviderType,
 		provider.TmpfsProviderType,
 	})
@@ -26,6 +26,10 @@ type VolumeSource struct {
 	ValidateVolumeParamsFunc func(storage.VolumeParams) error
 	AttachVolumesFunc        func(context.Context, []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error)
 	DetachVolumesFunc        func(context.Context, []storage.VolumeAttachmentParams) ([]error, error)
+	ResizeVolumesFunc        func(context.Context, []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error)
+
+	CreateVolumeSnapshotsFunc  func(context.Context, []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error)
+	DestroyVolumeSnapshotsFunc func(context.Context, []string) ([]error, error)
 }
 
 // CreateVolumes is defined on storage.VolumeSource.
@@ -99,3 +103,30 @@ func (s *VolumeSource) DetachVolumes(ctx context.Context, params []storage.Volum
 	}
 	return nil, errors.NotImplementedf("DetachVolumes")
 }
+
+// ResizeVolumes is defined on storage.VolumeResizer.
+func (s *VolumeSource) ResizeVolumes(ctx context.Context, params []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+	s.MethodCall(s, "ResizeVolumes", ctx, params)
+	if s.ResizeVolumesFunc != nil {
+		return s.ResizeVolumesFunc(ctx, params)
+	}
+	return nil, errors.NotImplementedf("ResizeVolumes")
+}
+
+// CreateVolumeSnapshots is defined on storage.VolumeSnapshotter.
+func (s *VolumeSource) CreateVolumeSnapshots(ctx context.Context, params []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+	s.MethodCall(s, "CreateVolumeSnapshots", ctx, params)
+	if s.CreateVolumeSnapshotsFunc != nil {
+		return s.CreateVolumeSnapshotsFunc(ctx, params)
+	}
+	return nil, errors.NotImplementedf("CreateVolumeSnapshots")
+}
+
+// DestroyVolumeSnapshots is defined on storage.VolumeSnapshotter.
+func (s *VolumeSource) DestroyVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	s.MethodCall(s, "DestroyVolumeSnapshots", ctx, snapshotIds)
+	if s.DestroyVolumeSnapshotsFunc != nil {
+		return s.DestroyVolumeSnapshotsFunc(ctx, snapshotIds)
+	}
+	return nil, errors.NotImplementedf("DestroyVolumeSnapshots")
+}
@@ -114,6 +114,10 @@ func (m *MockDirFuncs) fileCount(name string) (int, error) {
 	return 0, nil
 }
 
+func LVMProvider(run func(string, ...string) (string, error)) storage.Provider {
+	return &lvmProvider{run}
+}
+
 func RootfsFilesystemSource(etcDir, storageDir string, run func(string, ...string) (string, error), fakeMountInfo ...string) (storage.FilesystemSource, *MockDirFuncs) {
 	rdr := strings.NewReader(strings.Join(fakeMountInfo, "\n"))
 	d := &MockDirFuncs{
@@ -13,8 +13,10 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
+	"github.com/juju/utils/v4"
 
 	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/uuid"
 )
 
 const (
@@ -107,7 +109,11 @@ type loopVolumeSource struct {
 	storageDir string
 }
 
-var _ storage.VolumeSource = (*loopVolumeSource)(nil)
+var (
+	_ storage.VolumeSource      = (*loopVolumeSource)(nil)
+	_ storage.VolumeResizer     = (*loopVolumeSource)(nil)
+	_ storage.VolumeSnapshotter = (*loopVolumeSource)(nil)
+)
 
 // CreateVolumes is defined on the VolumeSource interface.
 func (lvs *loopVolumeSource) CreateVolumes(ctx context.Context, args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
@@ -128,6 +134,17 @@ func (lvs *loopVolumeSource) createVolume(params storage.VolumeParams) (storage.
 	if err := ensureDir(lvs.dirFuncs, filepath.Dir(loopFilePath)); err != nil {
 		return storage.Volume{}, errors.Trace(err)
 	}
+	if params.SnapshotId != "" {
+		// Seed the volume with the content of the snapshot; the
+		// block file is then grown to the requested size below.
+		snapshotFilePath, err := lvs.snapshotFilePath(params.SnapshotId)
+		if err != nil {
+			return storage.Volume{}, errors.Trace(err)
+		}
+		if err := copyBlockFile(lvs.run, snapshotFilePath, loopFilePath); err != nil {
+			return storage.Volume{}, errors.Annotate(err, "could not copy snapshot")
+		}
+	}
 	if err := createBlockFile(lvs.run, loopFilePath, params.Size); err != nil {
 		return storage.Volume{}, errors.Annotate(err, "could not create block file")
 	}
@@ -144,6 +161,13 @@ func (lvs *loopVolumeSource) volumeFilePath(tag names.VolumeTag) string {
 	return filepath.Join(lvs.storageDir, tag.String())
 }
 
+func (lvs *loopVolumeSource) snapshotFilePath(snapshotId string) (string, error) {
+	if !utils.IsValidUUIDString(snapshotId) {
+		return "", errors.NotValidf("loop snapshot ID %q", snapshotId)
+	}
+	return filepath.Join(lvs.storageDir, "snapshots", snapshotId), nil
+}
+
 // ListVolumes is defined on the VolumeSource interface.
 func (lvs *loopVolumeSource) ListVolumes(ctx context.Context) ([]string, error) {
 	// TODO(axw) implement this when we need it.
@@ -252,6 +276,119 @@ func (lvs *loopVolumeSource) detachVolume(tag names.VolumeTag) error {
 	return nil
 }
 
+// ResizeVolumes is defined on the VolumeResizer interface.
+func (lvs *loopVolumeSource) ResizeVolumes(ctx context.Context, args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(args))
+	for i, arg := range args {
+		if err := lvs.resizeVolume(arg); err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %s", arg.Tag.Id())
+			continue
+		}
+		results[i].Size = arg.Size
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) resizeVolume(arg storage.ResizeVolumeParams) error {
+	loopFilePath := lvs.volumeFilePath(arg.Tag)
+	info, err := os.Stat(loopFilePath)
+	if err != nil {
+		return errors.Annotate(err, "reading loop backing file")
+	}
+	sizeInMiB := uint64(info.Size()) / (1024 * 1024)
+	if arg.Size < sizeInMiB {
+		return errors.NotSupportedf("shrinking loop volume from %dMiB to %dMiB", sizeInMiB, arg.Size)
+	}
+	if arg.Size == sizeInMiB {
+		return nil
+	}
+	if err := createBlockFile(lvs.run, loopFilePath, arg.Size); err != nil {
+		return errors.Annotate(err, "could not grow block file")
+	}
+	// Any loop devices attached to the file must be told
+	// to pick up the new size of the backing file.
+	deviceNames, err := associatedLoopDevices(lvs.run, loopFilePath)
+	if err != nil {
+		return errors.Annotate(err, "locating loop device")
+	}
+	for _, deviceName := range deviceNames {
+		if _, err := lvs.run("losetup", "-c", path.Join("/dev", deviceName)); err != nil {
+			return errors.Annotatef(err, "updating size of loop device %q", deviceName)
+		}
+	}
+	return nil
+}
+
+// CreateVolumeSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) CreateVolumeSnapshots(ctx context.Context, args []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+	results := make([]storage.CreateVolumeSnapshotsResult, len(args))
+	for i, arg := range args {
+		snapshot, err := lvs.createVolumeSnapshot(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "snapshotting volume %s", arg.Tag.Id())
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) createVolumeSnapshot(arg storage.VolumeSnapshotParams) (*storage.VolumeSnapshot, error) {
+	loopFilePath := lvs.volumeFilePath(arg.Tag)
+	info, err := os.Stat(loopFilePath)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading loop backing file")
+	}
+	snapshotId := uuid.MustNewUUID().String()
+	snapshotFilePath, err := lvs.snapshotFilePath(snapshotId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := ensureDir(lvs.dirFuncs, filepath.Dir(snapshotFilePath)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := copyBlockFile(lvs.run, loopFilePath, snapshotFilePath); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &storage.VolumeSnapshot{
+		SnapshotId: snapshotId,
+		Size:       uint64(info.Size()) / (1024 * 1024),
+	}, nil
+}
+
+// DestroyVolumeSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) DestroyVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		if err := lvs.destroyVolumeSnapshot(snapshotId); err != nil {
+			results[i] = errors.Annotatef(err, "destroying snapshot %q", snapshotId)
+		}
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) destroyVolumeSnapshot(snapshotId string) error {
+	snapshotFilePath, err := lvs.snapshotFilePath(snapshotId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = os.Remove(snapshotFilePath)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Annotate(err, "removing loop snapshot file")
+	}
+	return nil
+}
+
+// copyBlockFile copies the loop backing file at the source path to the
+// destination path, preserving any holes in the file.
+func copyBlockFile(run runCommandFunc, srcPath, dstPath string) error {
+	_, err := run("cp", "--sparse=always", srcPath, dstPath)
+	if err != nil {
+		return errors.Annotatef(err, "copying loop backing file %q", srcPath)
+	}
+	return nil
+}
+
 // createBlockFile creates a file at the specified path, with the
 // given size in mebibytes.
 func createBlockFile(run runCommandFunc, filePath string, sizeInMiB uint64) error {
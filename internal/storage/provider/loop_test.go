@@ -272,3 +272,124 @@ func (s *loopSuite) TestDetachVolumesDetachFails(c *tc.C) {
 	_, err = os.Stat(fileName)
 	c.Assert(err, tc.ErrorIsNil)
 }
+
+func (s *loopSuite) TestResizeVolumes(c *tc.C) {
+	source, _ := s.loopVolumeSource(c)
+	for _, name := range []string{"volume-0", "volume-1", "volume-2"} {
+		err := os.WriteFile(filepath.Join(s.storageDir, name), nil, 0644)
+		c.Assert(err, tc.ErrorIsNil)
+		err = os.Truncate(filepath.Join(s.storageDir, name), 2*1024*1024)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	s.commands.expect("fallocate", "-l", "4MiB", fileName)
+	cmd := s.commands.expect("losetup", "-j", fileName)
+	cmd.respond("/dev/loop0: foo\n", nil)
+	s.commands.expect("losetup", "-c", "/dev/loop0")
+
+	resizer, ok := source.(storage.VolumeResizer)
+	c.Assert(ok, tc.IsTrue)
+	results, err := resizer.ResizeVolumes(c.Context(), []storage.ResizeVolumeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+		Size:     4,
+	}, {
+		Tag:      names.NewVolumeTag("1"),
+		VolumeId: "volume-1",
+		Size:     2,
+	}, {
+		Tag:      names.NewVolumeTag("2"),
+		VolumeId: "volume-2",
+		Size:     1,
+	}, {
+		Tag:      names.NewVolumeTag("3"),
+		VolumeId: "volume-3",
+		Size:     1,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 4)
+	c.Check(results[0], tc.DeepEquals, storage.ResizeVolumesResult{Size: 4})
+	c.Check(results[1], tc.DeepEquals, storage.ResizeVolumesResult{Size: 2})
+	c.Check(results[2].Error, tc.ErrorIs, errors.NotSupported)
+	c.Check(results[3].Error, tc.ErrorMatches, `resizing volume 3: reading loop backing file: .*`)
+}
+
+func (s *loopSuite) TestCreateVolumesFromSnapshot(c *tc.C) {
+	source, _ := s.loopVolumeSource(c)
+	snapshotId := "4f4f2bbd-8f5a-4a4b-8b0c-2c5d2d5a9f3e"
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	s.commands.expect("cp", "--sparse=always", filepath.Join(s.storageDir, "snapshots", snapshotId), fileName)
+	s.commands.expect("fallocate", "-l", "4MiB", fileName)
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("0"),
+		Size:       4,
+		SnapshotId: snapshotId,
+	}, {
+		Tag:        names.NewVolumeTag("1"),
+		Size:       4,
+		SnapshotId: "../volume-0",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Assert(results[0].Volume.VolumeInfo, tc.DeepEquals, storage.VolumeInfo{
+		VolumeId: "volume-0",
+		Size:     4,
+	})
+	c.Assert(results[1].Error, tc.ErrorIs, errors.NotValid)
+}
+
+func (s *loopSuite) TestCreateVolumeSnapshots(c *tc.C) {
+	var calls [][]string
+	run := func(cmd string, args ...string) (string, error) {
+		calls = append(calls, append([]string{cmd}, args...))
+		return "", nil
+	}
+	source, dirFuncs := provider.LoopVolumeSource(c.MkDir(), s.storageDir, run)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	err := os.WriteFile(fileName, nil, 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.Truncate(fileName, 2*1024*1024)
+	c.Assert(err, tc.ErrorIsNil)
+
+	snapshotter, ok := source.(storage.VolumeSnapshotter)
+	c.Assert(ok, tc.IsTrue)
+	results, err := snapshotter.CreateVolumeSnapshots(c.Context(), []storage.VolumeSnapshotParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+	}, {
+		Tag:      names.NewVolumeTag("1"),
+		VolumeId: "volume-1",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	snapshot := results[0].Snapshot
+	c.Check(snapshot.Size, tc.Equals, uint64(2))
+	c.Check(dirFuncs.Dirs.Contains(filepath.Join(s.storageDir, "snapshots")), tc.IsTrue)
+	c.Check(calls, tc.DeepEquals, [][]string{{
+		"cp", "--sparse=always", fileName, filepath.Join(s.storageDir, "snapshots", snapshot.SnapshotId),
+	}})
+	c.Check(results[1].Error, tc.ErrorMatches, `snapshotting volume 1: reading loop backing file: .*`)
+}
+
+func (s *loopSuite) TestDestroyVolumeSnapshots(c *tc.C) {
+	source, _ := s.loopVolumeSource(c)
+	snapshotId := "4f4f2bbd-8f5a-4a4b-8b0c-2c5d2d5a9f3e"
+	fileName := filepath.Join(s.storageDir, "snapshots", snapshotId)
+	err := os.MkdirAll(filepath.Dir(fileName), 0755)
+	c.Assert(err, tc.ErrorIsNil)
+	err = os.WriteFile(fileName, nil, 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	snapshotter := source.(storage.VolumeSnapshotter)
+	errs, err := snapshotter.DestroyVolumeSnapshots(c.Context(), []string{snapshotId, "volume-0"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errs, tc.HasLen, 2)
+	c.Assert(errs[0], tc.ErrorIsNil)
+	c.Assert(errs[1], tc.ErrorIs, errors.NotValid)
+
+	_, err = os.Stat(fileName)
+	c.Assert(err, tc.Satisfies, os.IsNotExist)
+}
@@ -0,0 +1,558 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/internal/storage"
+)
+
+const (
+	// LVMProviderType is the storage provider type for volumes carved
+	// out of a local LVM volume group.
+	LVMProviderType = storage.ProviderType("lvm")
+
+	// LVMVolumeGroup is the name of the existing volume group in which
+	// logical volumes are created. It must be specified.
+	LVMVolumeGroup = "volume-group"
+
+	// LVMThinPool is the name of a thin pool in the volume group. If
+	// specified, volumes are created as thinly provisioned logical
+	// volumes in the pool.
+	LVMThinPool = "thin-pool"
+
+	// LVMThinPoolSize is the size, in MiB, of the thin pool to create
+	// if it does not already exist in the volume group. If it is not
+	// specified, the thin pool must already exist.
+	LVMThinPoolSize = "thin-pool-size"
+
+	// lvmModelTagPrefix is prepended to the model UUID to form the
+	// tag added to each logical volume created for the model.
+	lvmModelTagPrefix = "juju-model-"
+)
+
+// lvmNameRE matches the names LVM permits for volume groups and
+// logical volumes.
+var lvmNameRE = regexp.MustCompile(`^[a-zA-Z0-9+_.][a-zA-Z0-9+_.-]*$`)
+
+// lvmProvider creates volume sources which allocate logical volumes
+// from a volume group on the local machine.
+type lvmProvider struct {
+	// run is a function used for running commands on the local machine.
+	run runCommandFunc
+}
+
+var _ storage.Provider = (*lvmProvider)(nil)
+
+// lvmConfig holds the validated configuration of an lvm storage pool.
+type lvmConfig struct {
+	volumeGroup  string
+	thinPool     string
+	thinPoolSize uint64
+}
+
+func newLVMConfig(cfg *storage.Config) (*lvmConfig, error) {
+	attrs := cfg.Attrs()
+	var result lvmConfig
+	for key, dest := range map[string]*string{
+		LVMVolumeGroup: &result.volumeGroup,
+		LVMThinPool:    &result.thinPool,
+	} {
+		value, ok := attrs[key]
+		if !ok {
+			continue
+		}
+		s, ok := value.(string)
+		if !ok {
+			return nil, errors.NotValidf("%s %v (not a string)", key, value)
+		}
+		if s != "" && !lvmNameRE.MatchString(s) {
+			return nil, errors.NotValidf("%s %q", key, s)
+		}
+		*dest = s
+	}
+	if result.volumeGroup == "" {
+		return nil, errors.NotValidf("missing %s", LVMVolumeGroup)
+	}
+	if value, ok := attrs[LVMThinPoolSize]; ok {
+		size, err := parseThinPoolSize(value)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if result.thinPool == "" {
+			return nil, errors.NotValidf("%s without %s", LVMThinPoolSize, LVMThinPool)
+		}
+		result.thinPoolSize = size
+	}
+	return &result, nil
+}
+
+func parseThinPoolSize(value any) (uint64, error) {
+	var size uint64
+	switch v := value.(type) {
+	case int:
+		if v < 0 {
+			return 0, errors.NotValidf("%s %d", LVMThinPoolSize, v)
+		}
+		size = uint64(v)
+	case uint64:
+		size = v
+	case string:
+		var err error
+		if size, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return 0, errors.NotValidf("%s %q", LVMThinPoolSize, v)
+		}
+	default:
+		return 0, errors.NotValidf("%s %v", LVMThinPoolSize, value)
+	}
+	if size == 0 {
+		return 0, errors.NotValidf("%s 0", LVMThinPoolSize)
+	}
+	return size, nil
+}
+
+func (*lvmProvider) ValidateForK8s(map[string]any) error {
+	return errors.NotValidf("storage provider type %q", LVMProviderType)
+}
+
+// ValidateConfig is defined on the Provider interface.
+func (*lvmProvider) ValidateConfig(cfg *storage.Config) error {
+	_, err := newLVMConfig(cfg)
+	return errors.Trace(err)
+}
+
+// VolumeSource is defined on the Provider interface.
+func (p *lvmProvider) VolumeSource(sourceConfig *storage.Config) (storage.VolumeSource, error) {
+	cfg, err := newLVMConfig(sourceConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// The volume group may be shared by several models, so each
+	// volume source only manages the logical volumes tagged with
+	// its own model.
+	modelUUID, _ := sourceConfig.ValueString(storage.ConfigModelUUID)
+	if modelUUID == "" {
+		return nil, errors.NotValidf("missing %s", storage.ConfigModelUUID)
+	}
+	return &lvmVolumeSource{
+		run:        p.run,
+		config:     *cfg,
+		modelTag:   lvmModelTagPrefix + modelUUID,
+		namePrefix: modelUUID + "-",
+	}, nil
+}
+
+// FilesystemSource is defined on the Provider interface.
+func (*lvmProvider) FilesystemSource(*storage.Config) (storage.FilesystemSource, error) {
+	return nil, errors.NotSupportedf("filesystems")
+}
+
+// Supports is defined on the Provider interface.
+func (*lvmProvider) Supports(k storage.StorageKind) bool {
+	return k == storage.StorageKindBlock
+}
+
+// Scope is defined on the Provider interface.
+func (*lvmProvider) Scope() storage.Scope {
+	return storage.ScopeMachine
+}
+
+// Dynamic is defined on the Provider interface.
+func (*lvmProvider) Dynamic() bool {
+	return true
+}
+
+// Releasable is defined on the Provider interface.
+func (*lvmProvider) Releasable() bool {
+	return false
+}
+
+// DefaultPools is defined on the Provider interface.
+func (*lvmProvider) DefaultPools() []*storage.Config {
+	// There is no default volume group, so a pool must be
+	// created by the user.
+	return nil
+}
+
+// lvmVolumeSource manages logical volumes in a single volume group.
+// Volumes are named after their Juju volume tags, prefixed with the
+// model UUID so that volumes of different models sharing the volume
+// group don't collide, and the name is also used as the provider
+// volume ID. Each logical volume is tagged with the UUID of the model
+// that created it, and only logical volumes carrying the tag are
+// listed, described, resized or destroyed.
+type lvmVolumeSource struct {
+	run        runCommandFunc
+	config     lvmConfig
+	modelTag   string
+	namePrefix string
+}
+
+var (
+	_ storage.VolumeSource  = (*lvmVolumeSource)(nil)
+	_ storage.VolumeResizer = (*lvmVolumeSource)(nil)
+)
+
+// logicalVolume describes a logical volume reported by lvs.
+type logicalVolume struct {
+	name string
+	attr string
+	size uint64
+	tags []string
+}
+
+// hasTag reports whether the logical volume carries the specified tag.
+func (lv logicalVolume) hasTag(tag string) bool {
+	return slices.Contains(lv.tags, tag)
+}
+
+// isModelVolume reports whether the logical volume is one created
+// by Juju for the model of the volume source.
+func (s *lvmVolumeSource) isModelVolume(lv logicalVolume) bool {
+	return s.isVolumeName(lv.name) && lv.hasTag(s.modelTag)
+}
+
+// isVolumeName reports whether the name is one given by Juju to
+// logical volumes created for the model of the volume source.
+func (s *lvmVolumeSource) isVolumeName(name string) bool {
+	tag, ok := strings.CutPrefix(name, s.namePrefix)
+	if !ok {
+		return false
+	}
+	_, err := names.ParseVolumeTag(tag)
+	return err == nil
+}
+
+// isThinPool reports whether the logical volume is a thin pool.
+func (lv logicalVolume) isThinPool() bool {
+	return strings.HasPrefix(lv.attr, "t")
+}
+
+// CreateVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) CreateVolumes(ctx context.Context, args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	results := make([]storage.CreateVolumesResult, len(args))
+	if s.config.thinPool != "" {
+		if err := s.ensureThinPool(); err != nil {
+			for i := range results {
+				results[i].Error = errors.Annotate(err, "creating volume")
+			}
+			return results, nil
+		}
+	}
+	for i, arg := range args {
+		volume, err := s.createVolume(arg)
+		if err != nil {
+			results[i].Error = errors.Annotate(err, "creating volume")
+			continue
+		}
+		results[i].Volume = &volume
+	}
+	return results, nil
+}
+
+func (s *lvmVolumeSource) createVolume(params storage.VolumeParams) (storage.Volume, error) {
+	volumeId := s.namePrefix + params.Tag.String()
+	size := fmt.Sprintf("%dm", params.Size)
+	args := []string{"--yes", "--name", volumeId, "--addtag", s.modelTag}
+	if s.config.thinPool != "" {
+		args = append(args, "--virtualsize", size, "--thinpool", s.config.thinPool)
+	} else {
+		args = append(args, "--size", size)
+	}
+	args = append(args, s.config.volumeGroup)
+	if _, err := s.run("lvcreate", args...); err != nil {
+		return storage.Volume{}, errors.Annotatef(err, "creating logical volume %q", volumeId)
+	}
+	return storage.Volume{
+		Tag: params.Tag,
+		VolumeInfo: storage.VolumeInfo{
+			VolumeId: volumeId,
+			Size:     params.Size,
+		},
+	}, nil
+}
+
+// ensureThinPool checks that the configured thin pool exists in the
+// volume group, creating it if a thin pool size has been configured.
+func (s *lvmVolumeSource) ensureThinPool() error {
+	lvs, err := s.logicalVolumes()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, lv := range lvs {
+		if lv.name != s.config.thinPool {
+			continue
+		}
+		if !lv.isThinPool() {
+			return errors.Errorf(
+				"logical volume %q in volume group %q is not a thin pool",
+				s.config.thinPool, s.config.volumeGroup,
+			)
+		}
+		return nil
+	}
+	if s.config.thinPoolSize == 0 {
+		return errors.NotFoundf("thin pool %q in volume group %q", s.config.thinPool, s.config.volumeGroup)
+	}
+	if _, err := s.run(
+		"lvcreate", "--yes",
+		"--type", "thin-pool",
+		"--name", s.config.thinPool,
+		"--size", fmt.Sprintf("%dm", s.config.thinPoolSize),
+		s.config.volumeGroup,
+	); err != nil {
+		return errors.Annotatef(err, "creating thin pool %q", s.config.thinPool)
+	}
+	return nil
+}
+
+// ListVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) ListVolumes(ctx context.Context) ([]string, error) {
+	lvs, err := s.logicalVolumes()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var volumeIds []string
+	for _, lv := range lvs {
+		// Only report the logical volumes created by Juju for
+		// this model.
+		if !s.isModelVolume(lv) {
+			continue
+		}
+		volumeIds = append(volumeIds, lv.name)
+	}
+	return volumeIds, nil
+}
+
+// modelVolumes returns the logical volumes created by Juju for the
+// model of the volume source, keyed on name.
+func (s *lvmVolumeSource) modelVolumes() (map[string]logicalVolume, error) {
+	lvs, err := s.logicalVolumes()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make(map[string]logicalVolume, len(lvs))
+	for _, lv := range lvs {
+		if s.isModelVolume(lv) {
+			result[lv.name] = lv
+		}
+	}
+	return result, nil
+}
+
+// DescribeVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) DescribeVolumes(ctx context.Context, volumeIds []string) ([]storage.DescribeVolumesResult, error) {
+	byName, err := s.modelVolumes()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]storage.DescribeVolumesResult, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		lv, ok := byName[volumeId]
+		if !ok {
+			results[i].Error = errors.NotFoundf("volume %q", volumeId)
+			continue
+		}
+		results[i].VolumeInfo = &storage.VolumeInfo{
+			VolumeId: volumeId,
+			Size:     lv.size,
+		}
+	}
+	return results, nil
+}
+
+// DestroyVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) DestroyVolumes(ctx context.Context, volumeIds []string) ([]error, error) {
+	lvs, err := s.logicalVolumes()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	byName := make(map[string]logicalVolume, len(lvs))
+	for _, lv := range lvs {
+		byName[lv.name] = lv
+	}
+	results := make([]error, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		lv, ok := byName[volumeId]
+		if !ok {
+			// A logical volume that is already gone has been
+			// destroyed, as long as it's one of the model's.
+			if !s.isVolumeName(volumeId) {
+				results[i] = errors.Errorf("destroying %q: invalid lvm volume ID %q", volumeId, volumeId)
+			}
+			continue
+		}
+		if err := s.destroyVolume(lv); err != nil {
+			results[i] = errors.Annotatef(err, "destroying %q", volumeId)
+		}
+	}
+	return results, nil
+}
+
+func (s *lvmVolumeSource) destroyVolume(lv logicalVolume) error {
+	if !s.isVolumeName(lv.name) {
+		return errors.Errorf("invalid lvm volume ID %q", lv.name)
+	}
+	if !lv.hasTag(s.modelTag) {
+		return errors.Errorf("logical volume %q does not belong to this model", lv.name)
+	}
+	if _, err := s.run("lvremove", "--yes", s.volumePath(lv.name)); err != nil {
+		return errors.Annotate(err, "removing logical volume")
+	}
+	return nil
+}
+
+// ReleaseVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) ReleaseVolumes(ctx context.Context, volumeIds []string) ([]error, error) {
+	return make([]error, len(volumeIds)), nil
+}
+
+// ValidateVolumeParams is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) ValidateVolumeParams(params storage.VolumeParams) error {
+	// ValidateVolumeParams may be called on a machine other than the
+	// machine hosting the volume group, so we cannot check the free
+	// space until we get to CreateVolumes.
+	return nil
+}
+
+// AttachVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) AttachVolumes(ctx context.Context, args []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error) {
+	results := make([]storage.AttachVolumesResult, len(args))
+	for i, arg := range args {
+		attachment, err := s.attachVolume(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "attaching volume %v", arg.Volume.Id())
+			continue
+		}
+		results[i].VolumeAttachment = attachment
+	}
+	return results, nil
+}
+
+func (s *lvmVolumeSource) attachVolume(arg storage.VolumeAttachmentParams) (*storage.VolumeAttachment, error) {
+	if arg.ReadOnly {
+		return nil, errors.NotSupportedf("read-only lvm volumes")
+	}
+	volumePath := s.volumePath(arg.VolumeId)
+	if _, err := s.run("lvchange", "--activate", "y", volumePath); err != nil {
+		return nil, errors.Annotate(err, "activating logical volume")
+	}
+	// The device-mapper name of the logical volume is not stable,
+	// so we identify the block device by its volume group link.
+	return &storage.VolumeAttachment{
+		Volume:  arg.Volume,
+		Machine: arg.Machine,
+		VolumeAttachmentInfo: storage.VolumeAttachmentInfo{
+			DeviceLink: "/dev/" + volumePath,
+		},
+	}, nil
+}
+
+// DetachVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) DetachVolumes(ctx context.Context, args []storage.VolumeAttachmentParams) ([]error, error) {
+	results := make([]error, len(args))
+	for i, arg := range args {
+		if _, err := s.run("lvchange", "--activate", "n", s.volumePath(arg.VolumeId)); err != nil {
+			results[i] = errors.Annotatef(err, "detaching volume %s", arg.Volume.Id())
+		}
+	}
+	return results, nil
+}
+
+// ResizeVolumes is defined on the VolumeResizer interface.
+func (s *lvmVolumeSource) ResizeVolumes(ctx context.Context, args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+	byName, err := s.modelVolumes()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]storage.ResizeVolumesResult, len(args))
+	for i, arg := range args {
+		lv, ok := byName[arg.VolumeId]
+		size := lv.size
+		switch {
+		case !ok:
+			results[i].Error = errors.NotFoundf("volume %q", arg.VolumeId)
+			continue
+		case arg.Size < size:
+			results[i].Error = errors.NotSupportedf(
+				"shrinking volume %q from %dMiB to %dMiB", arg.VolumeId, size, arg.Size,
+			)
+			continue
+		case arg.Size == size:
+			results[i].Size = size
+			continue
+		}
+		if _, err := s.run(
+			"lvextend", "--yes",
+			"--size", fmt.Sprintf("%dm", arg.Size),
+			s.volumePath(arg.VolumeId),
+		); err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %q", arg.VolumeId)
+			continue
+		}
+		results[i].Size = arg.Size
+	}
+	return results, nil
+}
+
+// volumePath returns the "<vg>/<lv>" path used by the LVM tools to
+// identify the logical volume with the specified ID.
+func (s *lvmVolumeSource) volumePath(volumeId string) string {
+	return s.config.volumeGroup + "/" + volumeId
+}
+
+// logicalVolumes returns the logical volumes in the volume group.
+func (s *lvmVolumeSource) logicalVolumes() ([]logicalVolume, error) {
+	stdout, err := s.run(
+		"lvs", "--noheadings", "--nosuffix",
+		"--units", "m",
+		"--separator", ":",
+		"--options", "lv_name,lv_attr,lv_size,lv_tags",
+		s.config.volumeGroup,
+	)
+	if err != nil {
+		return nil, errors.Annotatef(err, "listing logical volumes in %q", s.config.volumeGroup)
+	}
+	// The output will be zero or more lines with the format:
+	//    "  <uuid>-volume-0:-wi-a-----:1024.00:juju-model-<uuid>"
+	// where the last field holds a comma-separated list of tags.
+	var result []logicalVolume
+	for _, line := range strings.Split(stdout, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		// Tags may themselves contain the separator, so they
+		// are left intact in the last field.
+		fields := strings.SplitN(line, ":", 4)
+		if len(fields) != 4 {
+			return nil, errors.Errorf("unexpected lvs output %q", line)
+		}
+		size, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return nil, errors.Annotatef(err, "parsing size of logical volume %q", fields[0])
+		}
+		var tags []string
+		if fields[3] != "" {
+			tags = strings.Split(fields[3], ",")
+		}
+		result = append(result, logicalVolume{
+			name: fields[0],
+			attr: fields[1],
+			size: uint64(math.Ceil(size)),
+			tags: tags,
+		})
+	}
+	return result, nil
+}
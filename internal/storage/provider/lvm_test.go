@@ -0,0 +1,542 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/storage/provider"
+	"github.com/juju/juju/internal/testing"
+)
+
+func TestLVMSuite(t *stdtesting.T) {
+	tc.Run(t, &lvmSuite{})
+}
+
+type lvmSuite struct {
+	testing.BaseSuite
+	commands *mockRunCommand
+}
+
+func (s *lvmSuite) TearDownTest(c *tc.C) {
+	if s.commands != nil {
+		s.commands.assertDrained()
+	}
+	s.BaseSuite.TearDownTest(c)
+}
+
+func (s *lvmSuite) lvmProvider(c *tc.C) storage.Provider {
+	s.commands = &mockRunCommand{c: c}
+	return provider.LVMProvider(s.commands.run)
+}
+
+func (s *lvmSuite) lvmVolumeSource(c *tc.C, attrs map[string]any) storage.VolumeSource {
+	p := s.lvmProvider(c)
+	attrs[storage.ConfigModelUUID] = testing.ModelTag.Id()
+	cfg, err := storage.NewConfig("name", provider.LVMProviderType, attrs)
+	c.Assert(err, tc.ErrorIsNil)
+	source, err := p.VolumeSource(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	return source
+}
+
+func (s *lvmSuite) expectLVS(output string) *mockCommand {
+	cmd := s.commands.expect(
+		"lvs", "--noheadings", "--nosuffix",
+		"--units", "m",
+		"--separator", ":",
+		"--options", "lv_name,lv_attr,lv_size,lv_tags",
+		"vg0",
+	)
+	cmd.respond(output, nil)
+	return cmd
+}
+
+const (
+	lvmModelTag = "juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d"
+
+	// lvmPrefix is the prefix of the names of the logical volumes
+	// created for the model.
+	lvmPrefix = "deadbeef-0bad-400d-8000-4b1d0d06f00d-"
+)
+
+func (s *lvmSuite) TestValidateConfig(c *tc.C) {
+	p := s.lvmProvider(c)
+	for i, test := range []struct {
+		attrs map[string]any
+		err   string
+	}{{
+		attrs: map[string]any{},
+		err:   "missing volume-group not valid",
+	}, {
+		attrs: map[string]any{"volume-group": 123},
+		err:   "volume-group 123 \\(not a string\\) not valid",
+	}, {
+		attrs: map[string]any{"volume-group": "-vg"},
+		err:   `volume-group "-vg" not valid`,
+	}, {
+		attrs: map[string]any{"volume-group": "vg0", "thin-pool": "pool/0"},
+		err:   `thin-pool "pool/0" not valid`,
+	}, {
+		attrs: map[string]any{"volume-group": "vg0", "thin-pool-size": 1024},
+		err:   "thin-pool-size without thin-pool not valid",
+	}, {
+		attrs: map[string]any{"volume-group": "vg0", "thin-pool": "pool", "thin-pool-size": "lots"},
+		err:   `thin-pool-size "lots" not valid`,
+	}, {
+		attrs: map[string]any{"volume-group": "vg0", "thin-pool": "pool", "thin-pool-size": 0},
+		err:   "thin-pool-size 0 not valid",
+	}, {
+		attrs: map[string]any{"volume-group": "vg0"},
+	}, {
+		attrs: map[string]any{"volume-group": "vg0", "thin-pool": "pool", "thin-pool-size": "1024"},
+	}} {
+		c.Logf("test %d: %v", i, test.attrs)
+		cfg, err := storage.NewConfig("name", provider.LVMProviderType, test.attrs)
+		c.Assert(err, tc.ErrorIsNil)
+		err = p.ValidateConfig(cfg)
+		if test.err == "" {
+			c.Check(err, tc.ErrorIsNil)
+		} else {
+			c.Check(err, tc.ErrorMatches, test.err)
+			c.Check(err, tc.ErrorIs, errors.NotValid)
+		}
+	}
+}
+
+func (s *lvmSuite) TestSupports(c *tc.C) {
+	p := s.lvmProvider(c)
+	c.Assert(p.Supports(storage.StorageKindBlock), tc.IsTrue)
+	c.Assert(p.Supports(storage.StorageKindFilesystem), tc.IsFalse)
+}
+
+func (s *lvmSuite) TestScope(c *tc.C) {
+	p := s.lvmProvider(c)
+	c.Assert(p.Scope(), tc.Equals, storage.ScopeMachine)
+}
+
+func (s *lvmSuite) TestCreateVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0"})
+	s.commands.expect("lvcreate", "--yes", "--name", lvmPrefix+"volume-0", "--addtag", lvmModelTag, "--size", "2m", "vg0")
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 2,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Volume, tc.DeepEquals, &storage.Volume{
+		Tag: names.NewVolumeTag("0"),
+		VolumeInfo: storage.VolumeInfo{
+			VolumeId: lvmPrefix + "volume-0",
+			Size:     2,
+		},
+	})
+}
+
+func (s *lvmSuite) TestCreateVolumesThinPool(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0", "thin-pool": "pool"})
+	s.expectLVS("  pool:twi-aotz--:4096.00:\n")
+	s.commands.expect(
+		"lvcreate", "--yes", "--name", lvmPrefix+"volume-0", "--addtag", lvmModelTag,
+		"--virtualsize", "8192m", "--thinpool", "pool", "vg0",
+	)
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 8192,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Volume.VolumeId, tc.Equals, lvmPrefix+"volume-0")
+}
+
+func (s *lvmSuite) TestCreateVolumesCreatesThinPool(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{
+		"volume-group":   "vg0",
+		"thin-pool":      "pool",
+		"thin-pool-size": 4096,
+	})
+	s.expectLVS("")
+	s.commands.expect(
+		"lvcreate", "--yes", "--type", "thin-pool",
+		"--name", "pool", "--size", "4096m", "vg0",
+	)
+	s.commands.expect(
+		"lvcreate", "--yes", "--name", lvmPrefix+"volume-0", "--addtag", lvmModelTag,
+		"--virtualsize", "1024m", "--thinpool", "pool", "vg0",
+	)
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 1024,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+}
+
+func (s *lvmSuite) TestCreateVolumesThinPoolNotFound(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0", "thin-pool": "pool"})
+	s.expectLVS("  other:-wi-a-----:4096.00:\n")
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 1024,
+	}, {
+		Tag:  names.NewVolumeTag("1"),
+		Size: 1024,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	for _, result := range results {
+		c.Check(result.Error, tc.ErrorMatches, `creating volume: thin pool "pool" in volume group "vg0" not found`)
+		c.Check(result.Volume, tc.IsNil)
+	}
+}
+
+func (s *lvmSuite) TestCreateVolumesNotThinPool(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0", "thin-pool": "pool"})
+	s.expectLVS("  pool:-wi-a-----:4096.00:\n")
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 1024,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Check(results[0].Error, tc.ErrorMatches, `creating volume: logical volume "pool" in volume group "vg0" is not a thin pool`)
+}
+
+func (s *lvmSuite) TestCreateVolumesError(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0"})
+	cmd := s.commands.expect("lvcreate", "--yes", "--name", lvmPrefix+"volume-0", "--addtag", lvmModelTag, "--size", "2m", "vg0")
+	cmd.respond("", errors.New("insufficient free space"))
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 2,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Check(results[0].Error, tc.ErrorMatches, `creating volume: creating logical volume "`+lvmPrefix+`volume-0": insufficient free space`)
+	c.Check(results[0].Volume, tc.IsNil)
+}
+
+func (s *lvmSuite) TestListVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0"})
+	s.expectLVS(`
+  pool:twi-aotz--:4096.00:
+  deadbeef-0bad-400d-8000-4b1d0d06f00d-volume-0:-wi-a-----:1024.00:juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+  deadbeef-0bad-400d-8000-4b1d0d06f00d-volume-1-2:Vwi-a-tz--:2048.00:backup,juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+  ffffffff-0bad-400d-8000-4b1d0d06f00d-volume-3:-wi-a-----:1024.00:juju-model-ffffffff-0bad-400d-8000-4b1d0d06f00d
+  deadbeef-0bad-400d-8000-4b1d0d06f00d-volume-4:-wi-a-----:1024.00:
+  volume-5:-wi-a-----:1024.00:juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+  home:-wi-ao----:10240.00:
+`)
+
+	volumeIds, err := source.ListVolumes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(volumeIds, tc.SameContents, []string{lvmPrefix + "volume-0", lvmPrefix + "volume-1-2"})
+}
+
+func (s *lvmSuite) TestVolumeSourceMissingModelUUID(c *tc.C) {
+	p := s.lvmProvider(c)
+	cfg, err := storage.NewConfig("name", provider.LVMProviderType, map[string]any{"volume-group": "vg0"})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = p.VolumeSource(cfg)
+	c.Assert(err, tc.ErrorMatches, `missing model-uuid not valid`)
+}
+
+func (s *lvmSuite) TestListVolumesBadOutput(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0"})
+	s.expectLVS("  volume-0\n")
+
+	_, err := source.ListVolumes(c.Context())
+	c.Assert(err, tc.ErrorMatches, `unexpected lvs output "volume-0"`)
+}
+
+func (s *lvmSuite) TestDescribeVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0"})
+	s.expectLVS(`
+  ` + lvmPrefix + `volume-0:-wi-a-----:1024.50:juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+  ` + lvmPrefix + `volume-1:-wi-a-----:1024.00:juju-model-ffffffff-0bad-400d-8000-4b1d0d06f00d
+  home:-wi-ao----:10240.00:juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+`)
+
+	results, err := source.DescribeVolumes(c.Context(), []string{
+		lvmPrefix + "volume-0", lvmPrefix + "volume-1", lvmPrefix + "volume-2", "home",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 4)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].VolumeInfo, tc.DeepEquals, &storage.VolumeInfo{
+		VolumeId: lvmPrefix + "volume-0",
+		Size:     1025,
+	})
+	// Logical volumes not created by Juju for this model are not found.
+	c.Check(results[1].Error, tc.ErrorIs, errors.NotFound)
+	c.Check(results[2].Error, tc.ErrorIs, errors.NotFound)
+	c.Check(results[3].Error, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *lvmSuite) TestDestroyVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0"})
+	s.expectLVS(`
+  ` + lvmPrefix + `volume-0:-wi-a-----:1024.00:juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+  ` + lvmPrefix + `volume-1:-wi-a-----:1024.00:juju-model-ffffffff-0bad-400d-8000-4b1d0d06f00d
+  volume-3:-wi-a-----:1024.00:juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+  home:-wi-ao----:10240.00:
+`)
+	s.commands.expect("lvremove", "--yes", "vg0/"+lvmPrefix+"volume-0")
+
+	errs, err := source.DestroyVolumes(c.Context(), []string{
+		lvmPrefix + "volume-0", lvmPrefix + "volume-1", lvmPrefix + "volume-2", "volume-3", "home", "missing",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errs, tc.HasLen, 6)
+	c.Check(errs[0], tc.ErrorIsNil)
+	c.Check(errs[1], tc.ErrorMatches, `destroying ".*volume-1": logical volume ".*volume-1" does not belong to this model`)
+	// The logical volume has already been removed, so destroying it
+	// succeeds.
+	c.Check(errs[2], tc.ErrorIsNil)
+	c.Check(errs[3], tc.ErrorMatches, `destroying "volume-3": invalid lvm volume ID "volume-3"`)
+	c.Check(errs[4], tc.ErrorMatches, `destroying "home": invalid lvm volume ID "home"`)
+	c.Check(errs[5], tc.ErrorMatches, `destroying "missing": invalid lvm volume ID "missing"`)
+}
+
+func (s *lvmSuite) TestAttachVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0"})
+	s.commands.expect("lvchange", "--activate", "y", "vg0/"+lvmPrefix+"volume-0")
+
+	results, err := source.AttachVolumes(c.Context(), []storage.VolumeAttachmentParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: lvmPrefix + "volume-0",
+		AttachmentParams: storage.AttachmentParams{
+			Machine:    names.NewMachineTag("0"),
+			InstanceId: "inst-id",
+		},
+	}, {
+		Volume:   names.NewVolumeTag("1"),
+		VolumeId: lvmPrefix + "volume-1",
+		AttachmentParams: storage.AttachmentParams{
+			Machine:    names.NewMachineTag("0"),
+			InstanceId: "inst-id",
+			ReadOnly:   true,
+		},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].VolumeAttachment, tc.DeepEquals, &storage.VolumeAttachment{
+		Volume:  names.NewVolumeTag("0"),
+		Machine: names.NewMachineTag("0"),
+		VolumeAttachmentInfo: storage.VolumeAttachmentInfo{
+			DeviceLink: "/dev/vg0/" + lvmPrefix + "volume-0",
+		},
+	})
+	c.Check(results[1].Error, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *lvmSuite) TestDetachVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0"})
+	s.commands.expect("lvchange", "--activate", "n", "vg0/"+lvmPrefix+"volume-0")
+
+	errs, err := source.DetachVolumes(c.Context(), []storage.VolumeAttachmentParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: lvmPrefix + "volume-0",
+		AttachmentParams: storage.AttachmentParams{
+			Machine:    names.NewMachineTag("0"),
+			InstanceId: "inst-id",
+		},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errs, tc.DeepEquals, []error{nil})
+}
+
+func (s *lvmSuite) TestResizeVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c, map[string]any{"volume-group": "vg0"})
+	s.expectLVS(`
+  deadbeef-0bad-400d-8000-4b1d0d06f00d-volume-0:-wi-a-----:1024.00:juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+  deadbeef-0bad-400d-8000-4b1d0d06f00d-volume-1:-wi-a-----:2048.00:juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+  deadbeef-0bad-400d-8000-4b1d0d06f00d-volume-2:-wi-a-----:2048.00:juju-model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+`)
+	s.commands.expect("lvextend", "--yes", "--size", "4096m", "vg0/"+lvmPrefix+"volume-0")
+
+	resizer, ok := source.(storage.VolumeResizer)
+	c.Assert(ok, tc.IsTrue)
+	results, err := resizer.ResizeVolumes(c.Context(), []storage.ResizeVolumeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: lvmPrefix + "volume-0",
+		Size:     4096,
+	}, {
+		Tag:      names.NewVolumeTag("1"),
+		VolumeId: lvmPrefix + "volume-1",
+		Size:     1024,
+	}, {
+		Tag:      names.NewVolumeTag("2"),
+		VolumeId: lvmPrefix + "volume-2",
+		Size:     2048,
+	}, {
+		Tag:      names.NewVolumeTag("3"),
+		VolumeId: lvmPrefix + "volume-3",
+		Size:     2048,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 4)
+	c.Check(results[0], tc.DeepEquals, storage.ResizeVolumesResult{Size: 4096})
+	c.Check(results[1].Error, tc.ErrorIs, errors.NotSupported)
+	c.Check(results[2], tc.DeepEquals, storage.ResizeVolumesResult{Size: 2048})
+	c.Check(results[3].Error, tc.ErrorIs, errors.NotFound)
+}
+
+func TestLVMLoopSuite(t *stdtesting.T) {
+	tc.Run(t, &lvmLoopSuite{})
+}
+
+// lvmLoopSuite runs the lvm provider against a volume group backed by a
+// loop device. It needs root and the lvm tools, and is skipped otherwise.
+type lvmLoopSuite struct {
+	testing.BaseSuite
+	volumeGroup string
+}
+
+func (s *lvmLoopSuite) SetUpTest(c *tc.C) {
+	s.BaseSuite.SetUpTest(c)
+	if os.Geteuid() != 0 {
+		c.Skip("loop-backed volume groups need root")
+	}
+	for _, cmd := range []string{"losetup", "vgcreate", "lvcreate"} {
+		if _, err := exec.LookPath(cmd); err != nil {
+			c.Skip(fmt.Sprintf("%s not found", cmd))
+		}
+	}
+
+	backingFile := filepath.Join(c.MkDir(), "pv.img")
+	f, err := os.Create(backingFile)
+	c.Assert(err, tc.ErrorIsNil)
+	err = f.Truncate(256 * 1024 * 1024)
+	_ = f.Close()
+	c.Assert(err, tc.ErrorIsNil)
+
+	out, err := runLVMCommand("losetup", "--find", "--show", backingFile)
+	if err != nil {
+		c.Skip(fmt.Sprintf("cannot attach loop device: %v", err))
+	}
+	loopDevice := strings.TrimSpace(out)
+	s.AddCleanup(func(*tc.C) {
+		_, _ = runLVMCommand("losetup", "--detach", loopDevice)
+	})
+
+	s.volumeGroup = "juju-test-" + filepath.Base(loopDevice)
+	_, err = runLVMCommand("vgcreate", s.volumeGroup, loopDevice)
+	c.Assert(err, tc.ErrorIsNil)
+	s.AddCleanup(func(*tc.C) {
+		_, _ = runLVMCommand("vgremove", "--force", s.volumeGroup)
+	})
+}
+
+func (s *lvmLoopSuite) volumeSource(c *tc.C, attrs map[string]any) storage.VolumeSource {
+	attrs[provider.LVMVolumeGroup] = s.volumeGroup
+	attrs[storage.ConfigModelUUID] = testing.ModelTag.Id()
+	cfg, err := storage.NewConfig("lvm", provider.LVMProviderType, attrs)
+	c.Assert(err, tc.ErrorIsNil)
+	source, err := provider.LVMProvider(runLVMCommand).VolumeSource(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	return source
+}
+
+func (s *lvmLoopSuite) TestVolumeLifecycle(c *tc.C) {
+	s.assertVolumeLifecycle(c, s.volumeSource(c, map[string]any{}))
+}
+
+func (s *lvmLoopSuite) TestVolumeLifecycleThinPool(c *tc.C) {
+	s.assertVolumeLifecycle(c, s.volumeSource(c, map[string]any{
+		provider.LVMThinPool:     "thin",
+		provider.LVMThinPoolSize: 64,
+	}))
+}
+
+func (s *lvmLoopSuite) assertVolumeLifecycle(c *tc.C, source storage.VolumeSource) {
+	tag := names.NewVolumeTag("0")
+	volumeId := lvmPrefix + tag.String()
+
+	created, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:  tag,
+		Size: 8,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(created, tc.HasLen, 1)
+	c.Assert(created[0].Error, tc.ErrorIsNil)
+	c.Check(created[0].Volume.VolumeId, tc.Equals, volumeId)
+
+	volumeIds, err := source.ListVolumes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(volumeIds, tc.DeepEquals, []string{volumeId})
+
+	attached, err := source.AttachVolumes(c.Context(), []storage.VolumeAttachmentParams{{
+		Volume:   tag,
+		VolumeId: volumeId,
+		AttachmentParams: storage.AttachmentParams{
+			Machine: names.NewMachineTag("0"),
+		},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(attached, tc.HasLen, 1)
+	c.Assert(attached[0].Error, tc.ErrorIsNil)
+	_, err = os.Stat(attached[0].VolumeAttachment.DeviceLink)
+	c.Check(err, tc.ErrorIsNil)
+
+	resizer, ok := source.(storage.VolumeResizer)
+	c.Assert(ok, tc.IsTrue)
+	resized, err := resizer.ResizeVolumes(c.Context(), []storage.ResizeVolumeParams{{
+		Tag:      tag,
+		VolumeId: volumeId,
+		Size:     16,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(resized, tc.HasLen, 1)
+	c.Assert(resized[0].Error, tc.ErrorIsNil)
+
+	described, err := source.DescribeVolumes(c.Context(), []string{volumeId})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(described, tc.HasLen, 1)
+	c.Assert(described[0].Error, tc.ErrorIsNil)
+	c.Check(described[0].VolumeInfo.Size, tc.Equals, uint64(16))
+
+	errs, err := source.DestroyVolumes(c.Context(), []string{volumeId})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(errs, tc.DeepEquals, []error{nil})
+
+	volumeIds, err = source.ListVolumes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(volumeIds, tc.HasLen, 0)
+
+	// Destroying the volume again succeeds, as it's already gone.
+	errs, err = source.DestroyVolumes(c.Context(), []string{volumeId})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(errs, tc.DeepEquals, []error{nil})
+}
+
+// runLVMCommand runs a command on the local machine, as the lvm provider
+// does outside of tests.
+func runLVMCommand(cmd string, args ...string) (string, error) {
+	output, err := exec.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		return "", errors.Annotate(err, strings.TrimSpace(string(output)))
+	}
+	return string(output), nil
+}
@@ -82,15 +82,17 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 
 	logDir := agent.CurrentConfig().LogDir()
 
-	logFactory := func(cfg auditlog.Config) auditlog.AuditLog {
-		return auditlog.NewLogFile(logDir, cfg.MaxSizeMB, cfg.MaxBackups)
+	logFactory := func(cfg auditlog.Config) (auditlog.AuditLog, error) {
+		return auditlog.NewSinks(cfg.Sinks, cfg.SinkConfig(logDir))
 	}
 	auditConfig, err := initialConfig(controllerConfig)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	if auditConfig.Enabled {
-		auditConfig.Target = logFactory(auditConfig)
+		if auditConfig.Target, err = logFactory(auditConfig); err != nil {
+			return nil, errors.Annotate(err, "creating audit log")
+		}
 	}
 
 	w, err := config.NewWorker(controllerConfigService, auditConfig, logFactory)
@@ -127,6 +129,12 @@ func initialConfig(cfg controller.Config) (auditlog.Config, error) {
 		MaxSizeMB:      cfg.AuditLogMaxSizeMB(),
 		MaxBackups:     cfg.AuditLogMaxBackups(),
 		ExcludeMethods: cfg.AuditLogExcludeMethods(),
+
+		Sinks:            cfg.AuditLogSinks(),
+		SyslogAddress:    cfg.AuditLogSyslogAddress(),
+		SyslogCACert:     cfg.AuditLogSyslogCACert(),
+		WebhookURL:       cfg.AuditLogWebhookURL(),
+		WebhookBatchSize: cfg.AuditLogWebhookBatchSize(),
 	}
 	return result, nil
 }
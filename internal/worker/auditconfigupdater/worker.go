@@ -35,7 +35,7 @@ type ControllerConfigService interface {
 
 // AuditLogFactory is a function that will return an audit log given
 // config.
-type AuditLogFactory func(auditlog.Config) auditlog.AuditLog
+type AuditLogFactory func(auditlog.Config) (auditlog.AuditLog, error)
 
 type updater struct {
 	internalStates          chan string
@@ -45,6 +45,16 @@ type updater struct {
 	mu         sync.Mutex
 	current    auditlog.Config
 	logFactory AuditLogFactory
+
+	// target forwards records to the sinks in use. It is handed out as
+	// the target of the config, so recorders holding it write to the
+	// replacement sinks once the sinks change.
+	target *auditlog.SwitchLog
+
+	// sinks is the config that the sinks backing the target were created
+	// from. It can differ from the current config while auditing is
+	// disabled, as the sinks are only replaced once auditing is enabled.
+	sinks auditlog.Config
 }
 
 // NewWorker returns a worker that will keep an up-to-date audit log config.
@@ -64,6 +74,11 @@ func newWorker(
 		current:                 initial,
 		logFactory:              logFactory,
 	}
+	if initial.Target != nil {
+		u.target = auditlog.NewSwitchLog(initial.Target)
+		u.current.Target = u.target
+		u.sinks = initial
+	}
 	err := catacomb.Invoke(catacomb.Plan{
 		Name: "audit-config-updater",
 		Site: &u.catacomb,
@@ -106,46 +121,74 @@ func (u *updater) loop() error {
 			if !ok {
 				return errors.Errorf("watcher channel closed")
 			}
-			newConfig, err := u.newConfig(ctx)
+			newConfig, replace, err := u.newConfig(ctx)
 			if err != nil {
 				return errors.Annotatef(err, "getting new config")
 			}
-			u.update(newConfig)
+			if err := u.update(newConfig, replace); err != nil {
+				return errors.Trace(err)
+			}
 		}
 	}
 }
 
-func (u *updater) newConfig(ctx context.Context) (auditlog.Config, error) {
+// newConfig returns the updated audit config, along with whether the sinks
+// backing the target should be replaced.
+func (u *updater) newConfig(ctx context.Context) (auditlog.Config, bool, error) {
 	cfg, err := u.controllerConfigService.ControllerConfig(ctx)
 	if err != nil {
-		return auditlog.Config{}, errors.Trace(err)
+		return auditlog.Config{}, false, errors.Trace(err)
 	}
-	result := auditlog.Config{
-		Enabled:        cfg.AuditingEnabled(),
-		CaptureAPIArgs: cfg.AuditLogCaptureArgs(),
-		MaxSizeMB:      cfg.AuditLogMaxSizeMB(),
-		MaxBackups:     cfg.AuditLogMaxBackups(),
-		ExcludeMethods: cfg.AuditLogExcludeMethods(),
+	result, err := initialConfig(cfg)
+	if err != nil {
+		return auditlog.Config{}, false, errors.Trace(err)
 	}
 
-	if result.Enabled && u.current.Target == nil {
-		result.Target = u.logFactory(result)
-	} else {
-		// Keep the existing target to avoid file handle leaks from
-		// disabling and enabling auditing - we'll still stop logging
-		// because enabled is false.
-		result.Target = u.current.Target
+	var replace bool
+	switch {
+	case result.Enabled && u.target == nil:
+		target, err := u.logFactory(result)
+		if err != nil {
+			return auditlog.Config{}, false, errors.Annotate(err, "creating audit log")
+		}
+		u.target = auditlog.NewSwitchLog(target)
+		u.sinks = result
+	case result.Enabled && u.sinks.SinksChanged(result):
+		// The sinks have changed, possibly while auditing was disabled,
+		// so the existing target is switched over to the new sinks.
+		replace = true
 	}
-	return result, nil
+	// Keep the existing target to avoid file handle leaks from disabling
+	// and enabling auditing - we'll still stop logging because enabled is
+	// false.
+	if u.target != nil {
+		result.Target = u.target
+	}
+	return result, replace, nil
 }
 
-func (u *updater) update(newConfig auditlog.Config) {
+func (u *updater) update(newConfig auditlog.Config, replace bool) error {
+	if replace {
+		// The old sinks are closed, once the records being written to
+		// them are complete, before the new sinks are created, as they
+		// may share files such as the webhook spool. Records written in
+		// the meantime wait for the new sinks.
+		err := u.target.Replace(func() (auditlog.AuditLog, error) {
+			return u.logFactory(newConfig)
+		})
+		if err != nil {
+			return errors.Annotate(err, "creating audit log")
+		}
+		u.sinks = newConfig
+	}
+
 	u.mu.Lock()
-	defer u.mu.Unlock()
 	u.current = newConfig
+	u.mu.Unlock()
 
 	// Report the initial started state.
 	u.reportInternalState(stateChanged)
+	return nil
 }
 
 // CurrentConfig returns the updater's up-to-date audit config.
@@ -59,8 +59,8 @@ func (s *workerSuite) TestNewWorkerUpdatedCurrentConfig(c *tc.C) {
 	controllerConfig[controller.AuditLogExcludeMethods] = "foo,bar"
 	s.expectControllerConfigWithConfig(controllerConfig)
 
-	worker, err := s.newWorker(cfg, func(c auditlog.Config) auditlog.AuditLog {
-		return nil
+	worker, err := s.newWorker(cfg, func(c auditlog.Config) (auditlog.AuditLog, error) {
+		return nil, nil
 	})
 	c.Assert(err, tc.ErrorIsNil)
 	defer workertest.DirtyKill(c, worker)
@@ -76,17 +76,161 @@ func (s *workerSuite) TestNewWorkerUpdatedCurrentConfig(c *tc.C) {
 	s.ensureChanged(c)
 
 	current := worker.CurrentConfig()
+	c.Assert(current.Target, tc.NotNil)
+	current.Target = nil
 	c.Assert(current, tc.DeepEquals, auditlog.Config{
 		Enabled:        true,
 		CaptureAPIArgs: true,
 		MaxSizeMB:      10,
 		MaxBackups:     5,
 		ExcludeMethods: set.NewStrings("foo", "bar"),
+
+		Sinks:            []string{"file"},
+		WebhookBatchSize: 100,
+	})
+
+	workertest.CleanKill(c, worker)
+}
+
+func (s *workerSuite) TestSinksChangedReplacesTarget(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	oldTarget := &fakeAuditLog{}
+	cfg := auditlog.Config{
+		Enabled:          true,
+		Sinks:            []string{"file"},
+		WebhookBatchSize: 100,
+		Target:           oldTarget,
+	}
+
+	ch := s.expectControllerConfigWatcher(c)
+
+	controllerConfig := testing.FakeControllerConfig()
+	controllerConfig[controller.AuditingEnabled] = true
+	controllerConfig[controller.AuditLogSinks] = "file,webhook"
+	controllerConfig[controller.AuditLogWebhookURL] = "https://audit.example.com"
+	s.expectControllerConfigWithConfig(controllerConfig)
+
+	newTarget := &fakeAuditLog{}
+	var (
+		created     auditlog.Config
+		closedFirst bool
+	)
+	worker, err := s.newWorker(cfg, func(c auditlog.Config) (auditlog.AuditLog, error) {
+		created = c
+		closedFirst = oldTarget.closed
+		return newTarget, nil
 	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, worker)
+
+	s.ensureStartup(c)
+
+	// Recorders hold on to the target handed out before the change.
+	target := worker.CurrentConfig().Target
+
+	select {
+	case ch <- []string{}:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out seeding initial event")
+	}
+
+	s.ensureChanged(c)
+
+	c.Check(created.Sinks, tc.DeepEquals, []string{"file", "webhook"})
+	c.Check(created.WebhookURL, tc.Equals, "https://audit.example.com")
+	c.Check(worker.CurrentConfig().Target, tc.Equals, target)
+	c.Check(oldTarget.closed, tc.IsTrue)
+	c.Check(closedFirst, tc.IsTrue)
+	c.Check(newTarget.closed, tc.IsFalse)
+
+	err = target.AddRequest(auditlog.Request{RequestID: 1})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(oldTarget.requests, tc.HasLen, 0)
+	c.Check(newTarget.requests, tc.HasLen, 1)
 
 	workertest.CleanKill(c, worker)
 }
 
+func (s *workerSuite) TestSinksChangedWhileDisabled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	oldTarget := &fakeAuditLog{}
+	cfg := auditlog.Config{
+		Enabled:          true,
+		Sinks:            []string{"file"},
+		WebhookBatchSize: 100,
+		Target:           oldTarget,
+	}
+
+	ch := s.expectControllerConfigWatcher(c)
+
+	// The sinks are changed while auditing is disabled, and auditing is
+	// then enabled again without changing them any further.
+	disabled := testing.FakeControllerConfig()
+	disabled[controller.AuditingEnabled] = false
+	disabled[controller.AuditLogSinks] = "file,webhook"
+	disabled[controller.AuditLogWebhookURL] = "https://audit.example.com"
+	enabled := testing.FakeControllerConfig()
+	enabled[controller.AuditingEnabled] = true
+	enabled[controller.AuditLogSinks] = "file,webhook"
+	enabled[controller.AuditLogWebhookURL] = "https://audit.example.com"
+	gomock.InOrder(
+		s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(disabled, nil),
+		s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(enabled, nil),
+	)
+
+	newTarget := &fakeAuditLog{}
+	var created []auditlog.Config
+	worker, err := s.newWorker(cfg, func(c auditlog.Config) (auditlog.AuditLog, error) {
+		created = append(created, c)
+		return newTarget, nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, worker)
+
+	s.ensureStartup(c)
+
+	target := worker.CurrentConfig().Target
+
+	for i := 0; i < 2; i++ {
+		select {
+		case ch <- []string{}:
+		case <-time.After(testing.LongWait):
+			c.Fatalf("timed out sending change")
+		}
+		s.ensureChanged(c)
+	}
+
+	// The sinks are only replaced once auditing is enabled again.
+	c.Assert(created, tc.HasLen, 1)
+	c.Check(created[0].Sinks, tc.DeepEquals, []string{"file", "webhook"})
+	c.Check(oldTarget.closed, tc.IsTrue)
+
+	err = target.AddRequest(auditlog.Request{RequestID: 1})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(oldTarget.requests, tc.HasLen, 0)
+	c.Check(newTarget.requests, tc.HasLen, 1)
+
+	workertest.CleanKill(c, worker)
+}
+
+type fakeAuditLog struct {
+	auditlog.AuditLog
+	requests []auditlog.Request
+	closed   bool
+}
+
+func (l *fakeAuditLog) AddRequest(r auditlog.Request) error {
+	l.requests = append(l.requests, r)
+	return nil
+}
+
+func (l *fakeAuditLog) Close() error {
+	l.closed = true
+	return nil
+}
+
 func (s *workerSuite) newWorker(initial auditlog.Config, logFactory AuditLogFactory) (*updater, error) {
 	return newWorker(s.controllerConfigService, initial, logFactory, s.states)
 }
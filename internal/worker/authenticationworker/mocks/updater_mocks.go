@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	keyupdater "github.com/juju/juju/api/agent/keyupdater"
 	watcher "github.com/juju/juju/core/watcher"
 	names "github.com/juju/names/v6"
 	gomock "go.uber.org/mock/gomock"
@@ -80,6 +81,45 @@ func (c *MockClientAuthorisedKeysCall) DoAndReturn(f func(context.Context, names
 	return c
 }
 
+// SSHCertificateAuthority mocks base method.
+func (m *MockClient) SSHCertificateAuthority(arg0 context.Context, arg1 names.MachineTag) (keyupdater.SSHCertificateAuthority, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHCertificateAuthority", arg0, arg1)
+	ret0, _ := ret[0].(keyupdater.SSHCertificateAuthority)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SSHCertificateAuthority indicates an expected call of SSHCertificateAuthority.
+func (mr *MockClientMockRecorder) SSHCertificateAuthority(arg0, arg1 any) *MockClientSSHCertificateAuthorityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHCertificateAuthority", reflect.TypeOf((*MockClient)(nil).SSHCertificateAuthority), arg0, arg1)
+	return &MockClientSSHCertificateAuthorityCall{Call: call}
+}
+
+// MockClientSSHCertificateAuthorityCall wrap *gomock.Call
+type MockClientSSHCertificateAuthorityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientSSHCertificateAuthorityCall) Return(arg0 keyupdater.SSHCertificateAuthority, arg1 error) *MockClientSSHCertificateAuthorityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientSSHCertificateAuthorityCall) Do(f func(context.Context, names.MachineTag) (keyupdater.SSHCertificateAuthority, error)) *MockClientSSHCertificateAuthorityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientSSHCertificateAuthorityCall) DoAndReturn(f func(context.Context, names.MachineTag) (keyupdater.SSHCertificateAuthority, error)) *MockClientSSHCertificateAuthorityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchAuthorisedKeys mocks base method.
 func (m *MockClient) WatchAuthorisedKeys(arg0 context.Context, arg1 names.MachineTag) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,171 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authenticationworker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/api/agent/keyupdater"
+)
+
+const (
+	// trustedUserCAKeysFile holds the public keys of the certificate
+	// authorities trusted to sign user certificates.
+	trustedUserCAKeysFile = "juju_trusted_user_ca_keys"
+
+	// authorizedPrincipalsFile holds the principals accepted in user
+	// certificates for logging in as the SSH user.
+	authorizedPrincipalsFile = "juju_authorized_principals"
+
+	// sshdConfigFile is the sshd configuration drop-in that enables the
+	// user certificates.
+	sshdConfigFile = "sshd_config.d/60-juju-ssh-certificates.conf"
+)
+
+// The directory holding the configuration of the ssh daemon.
+// Override for testing.
+var SSHConfigDir = "/etc/ssh"
+
+// ReloadSSHD reloads the configuration of the ssh daemon.
+// Override for testing.
+var ReloadSSHD = func(ctx context.Context) error {
+	out, err := exec.CommandContext(ctx, "systemctl", "try-reload-or-restart", "ssh").CombinedOutput()
+	if err != nil {
+		return errors.Annotatef(err, "reloading ssh daemon: %s", strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// ensureCertificateAuthority configures the ssh daemon to trust the SSH
+// certificate authority of the controller, so that users can log in with
+// the short-lived certificates issued by the controller. Failing to
+// configure the ssh daemon isn't fatal, users can still log in with the
+// ssh keys added to the model.
+func (kw *keyupdaterWorker) ensureCertificateAuthority(ctx context.Context) error {
+	ca, err := kw.client.SSHCertificateAuthority(ctx, kw.tag)
+	if errors.Is(err, errors.NotImplemented) {
+		logger.Debugf(ctx, "controller does not issue ssh certificates")
+		return nil
+	} else if err != nil {
+		return errors.Annotatef(err, "reading ssh certificate authority for %q", kw.tag)
+	}
+
+	if err := configureSSHD(ctx, ca); err != nil {
+		logger.Warningf(ctx, "cannot trust ssh certificate authority, users must log in with ssh keys: %v", err)
+	}
+	return nil
+}
+
+// configureSSHD writes the sshd configuration trusting the certificate
+// authority. The daemon is reloaded if its configuration changed.
+func configureSSHD(ctx context.Context, ca keyupdater.SSHCertificateAuthority) error {
+	included, err := sshdIncludesDropIns()
+	if err != nil {
+		return errors.Trace(err)
+	} else if !included {
+		return errors.NotSupportedf("sshd configuration without %q included", filepath.Dir(sshdConfigFile))
+	}
+
+	caKeysPath := filepath.Join(SSHConfigDir, trustedUserCAKeysFile)
+	principalsPath := filepath.Join(SSHConfigDir, authorizedPrincipalsFile)
+	files := []struct {
+		path    string
+		content string
+	}{{
+		path:    caKeysPath,
+		content: strings.Join(ca.PublicKeys, "\n") + "\n",
+	}, {
+		path:    principalsPath,
+		content: strings.Join(ca.Principals, "\n") + "\n",
+	}, {
+		path:    filepath.Join(SSHConfigDir, sshdConfigFile),
+		content: sshdConfig(ca, caKeysPath, principalsPath),
+	}}
+
+	var changed bool
+	for _, f := range files {
+		written, err := writeFileIfChanged(f.path, f.content)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		changed = changed || written
+	}
+	if !changed {
+		return nil
+	}
+
+	logger.Infof(ctx, "trusting ssh certificate authority for principals %v", ca.Principals)
+	if err := ReloadSSHD(ctx); err != nil {
+		// Remove the drop-in, so the daemon is reloaded the next time
+		// the certificate authority is trusted.
+		_ = os.Remove(filepath.Join(SSHConfigDir, sshdConfigFile))
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// sshdIncludesDropIns reports whether the main sshd configuration includes
+// the drop-in directory holding sshdConfigFile.
+func sshdIncludesDropIns() (bool, error) {
+	path := filepath.Join(SSHConfigDir, "sshd_config")
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Annotatef(err, "reading %q", path)
+	}
+	dropIns := filepath.Dir(sshdConfigFile)
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "Include") {
+			continue
+		}
+		for _, pattern := range fields[1:] {
+			if strings.HasPrefix(pattern, dropIns+"/") || strings.Contains(pattern, "/"+dropIns+"/") {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// sshdConfig returns the sshd configuration that trusts the certificate
+// authority. The principals are only accepted for the SSH user, the
+// certificates can't be used to log in as any other user.
+func sshdConfig(ca keyupdater.SSHCertificateAuthority, caKeysPath, principalsPath string) string {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "# Generated by Juju, do not edit.")
+	fmt.Fprintf(&buf, "TrustedUserCAKeys %s\n", caKeysPath)
+	fmt.Fprintf(&buf, "Match User %s\n", SSHUser)
+	fmt.Fprintf(&buf, "    AuthorizedPrincipalsFile %s\n", principalsPath)
+	return buf.String()
+}
+
+// writeFileIfChanged writes the content to the file, unless the file already
+// has that content. It reports whether the file was written.
+func writeFileIfChanged(path, content string) (bool, error) {
+	existing, err := os.ReadFile(path)
+	if err == nil && string(existing) == content {
+		return false, nil
+	} else if err != nil && !os.IsNotExist(err) {
+		return false, errors.Annotatef(err, "reading %q", path)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return false, errors.Annotatef(err, "creating directory for %q", path)
+	}
+	if err := utils.AtomicWriteFile(path, []byte(content), 0644); err != nil {
+		return false, errors.Annotatef(err, "writing %q", path)
+	}
+	return true, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/juju/worker/v4"
 
 	"github.com/juju/juju/agent"
+	"github.com/juju/juju/api/agent/keyupdater"
 	"github.com/juju/juju/core/watcher"
 	internallogger "github.com/juju/juju/internal/logger"
 )
@@ -28,6 +29,7 @@ var logger = internallogger.GetLogger("juju.worker.authenticationworker")
 type Client interface {
 	AuthorisedKeys(ctx context.Context, tag names.MachineTag) ([]string, error)
 	WatchAuthorisedKeys(ctx context.Context, tag names.MachineTag) (watcher.NotifyWatcher, error)
+	SSHCertificateAuthority(ctx context.Context, tag names.MachineTag) (keyupdater.SSHCertificateAuthority, error)
 }
 
 type keyupdaterWorker struct {
@@ -42,7 +44,9 @@ type keyupdaterWorker struct {
 
 // NewWorker returns a worker that keeps track of
 // the machine's authorised ssh keys and ensures the
-// ~/.ssh/authorized_keys file is up to date.
+// ~/.ssh/authorized_keys file is up to date. It also
+// configures the ssh daemon to accept the user
+// certificates issued by the controller.
 func NewWorker(client Client, agentConfig agent.Config) (worker.Worker, error) {
 	machineTag, ok := agentConfig.Tag().(names.MachineTag)
 	if !ok {
@@ -86,6 +90,16 @@ func (kw *keyupdaterWorker) SetUp(ctx context.Context) (watcher.NotifyWatcher, e
 			kw.nonJujuKeys = append(kw.nonJujuKeys, key)
 		}
 	}
+	// Trust the certificate authority of the controller before writing out
+	// the keys, as models configured for certificates only no longer report
+	// the keys of the model's users and those keys are removed below. The
+	// certificate authority never changes so there's no need to watch it.
+	if err := kw.ensureCertificateAuthority(ctx); err != nil {
+		err = errors.Annotate(err, "trusting ssh certificate authority")
+		logger.Infof(ctx, err.Error())
+		return nil, err
+	}
+
 	// Write out the ssh authorised keys file to match the current state of the world.
 	if err := kw.writeSSHKeys(jujuKeys); err != nil {
 		err = errors.Annotate(err, "adding current Juju keys to ssh authorised keys")
@@ -4,10 +4,14 @@
 package authenticationworker_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 	"github.com/juju/utils/v4/ssh"
@@ -16,6 +20,8 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/juju/juju/agent"
+	"github.com/juju/juju/api/agent/keyupdater"
+	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/watchertest"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/authenticationworker"
@@ -28,6 +34,9 @@ type workerSuite struct {
 	existingEnvKey    string
 	existingModelKeys []string
 	existingKeys      []string
+
+	sshConfigDir string
+	reloads      int
 }
 
 func TestWorkerSuite(t *testing.T) {
@@ -52,6 +61,23 @@ func (s *workerSuite) SetUpTest(c *tc.C) {
 	s.existingKeys = []string{sshtesting.ValidKeyTwo.Key + " existinguser@host"}
 	err := ssh.AddKeys(authenticationworker.SSHUser, s.existingKeys...)
 	c.Assert(err, tc.ErrorIsNil)
+
+	s.sshConfigDir = c.MkDir()
+	s.PatchValue(&authenticationworker.SSHConfigDir, s.sshConfigDir)
+	err = os.WriteFile(filepath.Join(s.sshConfigDir, "sshd_config"),
+		[]byte("Include /etc/ssh/sshd_config.d/*.conf\nKbdInteractiveAuthentication no\n"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	s.reloads = 0
+	s.PatchValue(&authenticationworker.ReloadSSHD, func(context.Context) error {
+		s.reloads++
+		return nil
+	})
+}
+
+func (s *workerSuite) expectNoCertificateAuthority(client *mocks.MockClient, tag names.MachineTag) {
+	client.EXPECT().SSHCertificateAuthority(gomock.Any(), tag).Return(
+		keyupdater.SSHCertificateAuthority{}, errors.NotImplemented,
+	).AnyTimes()
 }
 
 type mockConfig struct {
@@ -96,6 +122,7 @@ func (s *workerSuite) TestKeyUpdateRetainsExisting(c *tc.C) {
 
 	tag := names.NewMachineTag("666")
 	client := mocks.NewMockClient(ctrl)
+	s.expectNoCertificateAuthority(client, tag)
 	client.EXPECT().AuthorisedKeys(gomock.Any(), tag).Return(s.existingModelKeys, nil)
 	client.EXPECT().WatchAuthorisedKeys(gomock.Any(), tag).Return(watch, nil)
 
@@ -122,6 +149,7 @@ func (s *workerSuite) TestNewKeysInJujuAreSavedOnStartup(c *tc.C) {
 
 	tag := names.NewMachineTag("666")
 	client := mocks.NewMockClient(ctrl)
+	s.expectNoCertificateAuthority(client, tag)
 	client.EXPECT().AuthorisedKeys(gomock.Any(), tag).Return([]string{existingKey}, nil)
 	client.EXPECT().WatchAuthorisedKeys(gomock.Any(), tag).Return(watch, nil)
 
@@ -149,6 +177,7 @@ func (s *workerSuite) TestDeleteKey(c *tc.C) {
 
 	tag := names.NewMachineTag("666")
 	client := mocks.NewMockClient(ctrl)
+	s.expectNoCertificateAuthority(client, tag)
 	client.EXPECT().AuthorisedKeys(gomock.Any(), tag).Return(append(s.existingModelKeys, anotherKey), nil).Times(2)
 	client.EXPECT().WatchAuthorisedKeys(gomock.Any(), tag).Return(watch, nil)
 
@@ -177,6 +206,7 @@ func (s *workerSuite) TestMultipleChanges(c *tc.C) {
 
 	tag := names.NewMachineTag("666")
 	client := mocks.NewMockClient(ctrl)
+	s.expectNoCertificateAuthority(client, tag)
 	client.EXPECT().AuthorisedKeys(gomock.Any(), tag).Return(s.existingModelKeys, nil)
 	client.EXPECT().WatchAuthorisedKeys(gomock.Any(), tag).Return(watch, nil)
 
@@ -204,6 +234,7 @@ func (s *workerSuite) TestWorkerRestart(c *tc.C) {
 
 	tag := names.NewMachineTag("666")
 	client := mocks.NewMockClient(ctrl)
+	s.expectNoCertificateAuthority(client, tag)
 
 	client.EXPECT().WatchAuthorisedKeys(gomock.Any(), tag).Return(watch, nil).MinTimes(1)
 
@@ -232,3 +263,175 @@ func (s *workerSuite) TestWorkerRestart(c *tc.C) {
 
 	workertest.CleanKill(c, authWorker)
 }
+
+func (s *workerSuite) TestCertificateAuthorityIsTrusted(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	tag := names.NewMachineTag("666")
+	client := mocks.NewMockClient(ctrl)
+	client.EXPECT().AuthorisedKeys(gomock.Any(), tag).Return(s.existingModelKeys, nil).Times(2)
+	client.EXPECT().SSHCertificateAuthority(gomock.Any(), tag).Return(keyupdater.SSHCertificateAuthority{
+		PublicKeys: []string{"ssh-ed25519 AAAA"},
+		Principals: []string{"juju-admin@deadbeef"},
+	}, nil).Times(2)
+
+	started := make(chan struct{}, 2)
+	client.EXPECT().WatchAuthorisedKeys(gomock.Any(), tag).DoAndReturn(
+		func(context.Context, names.MachineTag) (watcher.NotifyWatcher, error) {
+			started <- struct{}{}
+			return watchertest.NewMockNotifyWatcher(make(chan struct{})), nil
+		},
+	).Times(2)
+
+	startWorker := func() {
+		authWorker, err := authenticationworker.NewWorker(client, agentConfig(c, tag))
+		c.Assert(err, tc.ErrorIsNil)
+		defer workertest.CleanKill(c, authWorker)
+
+		select {
+		case <-started:
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("timed out waiting for worker to start")
+		}
+	}
+
+	startWorker()
+	c.Check(s.reloads, tc.Equals, 1)
+
+	caKeys, err := os.ReadFile(filepath.Join(s.sshConfigDir, "juju_trusted_user_ca_keys"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(caKeys), tc.Equals, "ssh-ed25519 AAAA\n")
+
+	principals, err := os.ReadFile(filepath.Join(s.sshConfigDir, "juju_authorized_principals"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(principals), tc.Equals, "juju-admin@deadbeef\n")
+
+	config, err := os.ReadFile(filepath.Join(s.sshConfigDir, "sshd_config.d", "60-juju-ssh-certificates.conf"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(config), tc.Contains, "TrustedUserCAKeys "+filepath.Join(s.sshConfigDir, "juju_trusted_user_ca_keys")+"\n")
+	c.Check(string(config), tc.Contains, "    AuthorizedPrincipalsFile "+filepath.Join(s.sshConfigDir, "juju_authorized_principals")+"\n")
+
+	// Nothing has changed when the worker restarts, so the ssh daemon isn't
+	// reloaded again.
+	startWorker()
+	c.Check(s.reloads, tc.Equals, 1)
+}
+
+func (s *workerSuite) TestUserKeysRemovedOnceCertificateAuthorityTrusted(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	// A user's key was distributed to the machine before the controller
+	// issued certificates.
+	userKey := sshtesting.ValidKeyThree.Key + " Juju:user@host"
+	err := ssh.AddKeys(authenticationworker.SSHUser, userKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The ssh daemon trusts the certificate authority before the user's key
+	// is removed, so the user isn't locked out in between.
+	s.PatchValue(&authenticationworker.ReloadSSHD, func(context.Context) error {
+		keys, err := ssh.ListKeys(authenticationworker.SSHUser, ssh.FullKeys)
+		c.Check(err, tc.ErrorIsNil)
+		c.Check(strings.Join(keys, "\n"), tc.Contains, userKey)
+		s.reloads++
+		return nil
+	})
+
+	// Models configured for certificates only report the controller keys,
+	// so revoking the user's access to the model revokes their access to
+	// the machine.
+	tag := names.NewMachineTag("666")
+	client := mocks.NewMockClient(ctrl)
+	client.EXPECT().AuthorisedKeys(gomock.Any(), tag).Return(s.existingModelKeys, nil)
+	client.EXPECT().SSHCertificateAuthority(gomock.Any(), tag).Return(keyupdater.SSHCertificateAuthority{
+		PublicKeys: []string{"ssh-ed25519 AAAA"},
+		Principals: []string{"juju-user@deadbeef"},
+	}, nil)
+	client.EXPECT().WatchAuthorisedKeys(gomock.Any(), tag).Return(
+		watchertest.NewMockNotifyWatcher(make(chan struct{})), nil,
+	)
+
+	authWorker, err := authenticationworker.NewWorker(client, agentConfig(c, tag))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, authWorker)
+
+	s.waitSSHKeys(c, append(s.existingKeys, s.existingEnvKey))
+	c.Check(s.reloads, tc.Equals, 1)
+}
+
+func (s *workerSuite) TestCertificateAuthorityNotTrustedWithoutDropIns(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	// The ssh daemon doesn't read the drop-in directory, so it can't be
+	// configured to trust the certificate authority. The keys are still
+	// written out, so users can log in with them.
+	err := os.WriteFile(filepath.Join(s.sshConfigDir, "sshd_config"), []byte("UsePAM yes\n"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	tag := names.NewMachineTag("666")
+	client := mocks.NewMockClient(ctrl)
+	client.EXPECT().AuthorisedKeys(gomock.Any(), tag).Return(s.existingModelKeys, nil)
+	client.EXPECT().SSHCertificateAuthority(gomock.Any(), tag).Return(keyupdater.SSHCertificateAuthority{
+		PublicKeys: []string{"ssh-ed25519 AAAA"},
+		Principals: []string{"juju-admin@deadbeef"},
+	}, nil)
+	client.EXPECT().WatchAuthorisedKeys(gomock.Any(), tag).Return(
+		watchertest.NewMockNotifyWatcher(make(chan struct{})), nil,
+	)
+
+	authWorker, err := authenticationworker.NewWorker(client, agentConfig(c, tag))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, authWorker)
+
+	s.waitSSHKeys(c, append(s.existingKeys, s.existingEnvKey))
+	c.Check(s.reloads, tc.Equals, 0)
+	_, err = os.Stat(filepath.Join(s.sshConfigDir, "sshd_config.d", "60-juju-ssh-certificates.conf"))
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+}
+
+func (s *workerSuite) TestReloadSSHDFailureIsNotFatal(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	s.PatchValue(&authenticationworker.ReloadSSHD, func(context.Context) error {
+		s.reloads++
+		return errors.New("systemctl: command not found")
+	})
+
+	tag := names.NewMachineTag("666")
+	client := mocks.NewMockClient(ctrl)
+	client.EXPECT().AuthorisedKeys(gomock.Any(), tag).Return(s.existingModelKeys, nil).Times(2)
+	client.EXPECT().SSHCertificateAuthority(gomock.Any(), tag).Return(keyupdater.SSHCertificateAuthority{
+		PublicKeys: []string{"ssh-ed25519 AAAA"},
+		Principals: []string{"juju-admin@deadbeef"},
+	}, nil).Times(2)
+
+	started := make(chan struct{}, 2)
+	client.EXPECT().WatchAuthorisedKeys(gomock.Any(), tag).DoAndReturn(
+		func(context.Context, names.MachineTag) (watcher.NotifyWatcher, error) {
+			started <- struct{}{}
+			return watchertest.NewMockNotifyWatcher(make(chan struct{})), nil
+		},
+	).Times(2)
+
+	startWorker := func() {
+		authWorker, err := authenticationworker.NewWorker(client, agentConfig(c, tag))
+		c.Assert(err, tc.ErrorIsNil)
+		defer workertest.CleanKill(c, authWorker)
+
+		select {
+		case <-started:
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("timed out waiting for worker to start")
+		}
+	}
+
+	// The worker starts even though the ssh daemon can't be reloaded, and
+	// the reload is tried again when the worker restarts.
+	startWorker()
+	c.Check(s.reloads, tc.Equals, 1)
+	startWorker()
+	c.Check(s.reloads, tc.Equals, 2)
+}
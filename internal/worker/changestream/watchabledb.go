@@ -102,6 +102,12 @@ func (w *WatchableDB) Subscribe(opts ...changestream.SubscriptionOption) (change
 	return w.mux.Subscribe(opts...)
 }
 
+// SubscribeFrom returns a subscription for the input options, that first
+// replays all the changes after the given change log ID.
+func (w *WatchableDB) SubscribeFrom(changeLogID int64, opts ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	return w.mux.SubscribeFrom(changeLogID, opts...)
+}
+
 func (w *WatchableDB) loop() error {
 	<-w.catacomb.Dying()
 	return w.catacomb.ErrDying()
@@ -11,6 +11,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/utils/v4/voyeur"
 	"github.com/juju/worker/v4/dependency"
+	"github.com/prometheus/client_golang/prometheus"
 
 	coreagent "github.com/juju/juju/agent"
 	"github.com/juju/juju/agent/engine"
@@ -76,6 +77,10 @@ type UnitManifoldsConfig struct {
 
 	// Clock supplies timekeeping services to various workers.
 	Clock clock.Clock
+
+	// PrometheusRegisterer is a prometheus.Registerer that may be used
+	// by workers to register Prometheus metric collectors.
+	PrometheusRegisterer prometheus.Registerer
 }
 
 // UnitManifolds returns a set of co-configured manifolds covering the various
@@ -239,6 +244,7 @@ func UnitManifolds(config UnitManifoldsConfig) dependency.Manifolds {
 			HookRetryStrategyName: hookRetryStrategyName,
 			TranslateResolverErr:  uniter.TranslateFortressErrors,
 			Logger:                config.LoggerContext.GetLogger("juju.worker.uniter"),
+			PrometheusRegisterer:  config.PrometheusRegisterer,
 		})),
 
 		traceName: trace.Manifold(trace.ManifoldConfig{
@@ -29,6 +29,7 @@ const (
 
 	typeDisk = "disk"
 	typeLoop = "loop"
+	typeLVM  = "lvm"
 	typePart = "part"
 )
 
@@ -98,11 +99,14 @@ func listBlockDevices(ctx context.Context) ([]blockdevice.BlockDevice, error) {
 			}
 		}
 
-		// We may later want to expand this, e.g. to handle lvm,
-		// dmraid, crypt, etc., but this is enough to cover bases
-		// for now.
+		// We may later want to expand this, e.g. to handle dmraid,
+		// crypt, etc., but this is enough to cover bases for now.
 		switch deviceType {
 		case typeLoop:
+		case typeLVM:
+			// Logical volumes are reported so that volumes created
+			// by the lvm storage provider can be matched by their
+			// device links.
 		case typePart:
 		case typeDisk:
 			// Floppy disks, which have major device number 2,
@@ -271,5 +271,8 @@ EOF`)
 	}, {
 		DeviceName: "loop0",
 		SizeMiB:    243,
+	}, {
+		DeviceName: "whatever",
+		SizeMiB:    243,
 	}})
 }
@@ -0,0 +1,123 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	coredependency "github.com/juju/juju/core/dependency"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/services"
+)
+
+// ManifoldConfig contains the configuration passed to this
+// worker's manifold when run by the dependency engine.
+type ManifoldConfig struct {
+	// DomainServicesName is the name of the domain service factory dependency.
+	DomainServicesName string
+
+	// GetModelConfigService is used to extract the model config service
+	// from the domain service dependency.
+	GetModelConfigService func(getter dependency.Getter, name string) (ModelConfigService, error)
+
+	// LogForwarderSetter is the model's log writer, which forwards the
+	// model's logs.
+	LogForwarderSetter logger.LogForwarderSetter
+
+	// SpoolDir is the directory where records are kept whilst an endpoint
+	// is unavailable.
+	SpoolDir string
+
+	// NewWorker creates and returns a log forwarder worker.
+	NewWorker func(Config) (worker.Worker, error)
+
+	// NewForwarder creates the forwarder for an endpoint.
+	NewForwarder NewForwarderFunc
+
+	// Clock is used by the forwarders to schedule deliveries.
+	Clock clock.Clock
+
+	// Logger is used to report problems forwarding the logs. It must not
+	// write to the model's logs.
+	Logger logger.Logger
+}
+
+// Validate ensures that the configuration is
+// correctly populated for manifold operation.
+func (config ManifoldConfig) Validate() error {
+	if config.DomainServicesName == "" {
+		return errors.New("empty DomainServicesName not valid").Add(coreerrors.NotValid)
+	}
+	if config.GetModelConfigService == nil {
+		return errors.New("nil GetModelConfigService not valid").Add(coreerrors.NotValid)
+	}
+	if config.LogForwarderSetter == nil {
+		return errors.New("nil LogForwarderSetter not valid").Add(coreerrors.NotValid)
+	}
+	if config.SpoolDir == "" {
+		return errors.New("empty SpoolDir not valid").Add(coreerrors.NotValid)
+	}
+	if config.NewWorker == nil {
+		return errors.New("nil NewWorker not valid").Add(coreerrors.NotValid)
+	}
+	if config.NewForwarder == nil {
+		return errors.New("nil NewForwarder not valid").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.New("nil Clock not valid").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.New("nil Logger not valid").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// Manifold returns a dependency.Manifold that will run the log forwarder
+// worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.DomainServicesName,
+		},
+		Start: config.start,
+	}
+}
+
+func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	modelConfigService, err := config.GetModelConfigService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	w, err := config.NewWorker(Config{
+		ModelConfigService: modelConfigService,
+		LogForwarderSetter: config.LogForwarderSetter,
+		SpoolDir:           config.SpoolDir,
+		NewForwarder:       config.NewForwarder,
+		Clock:              config.Clock,
+		Logger:             config.Logger,
+	})
+	if err != nil {
+		return nil, errors.Errorf("creating log forwarder worker: %w", err)
+	}
+	return w, nil
+}
+
+// GetModelConfigService extracts the model service factory from the input
+// dependency getter, then returns the model config service from it.
+func GetModelConfigService(getter dependency.Getter, name string) (ModelConfigService, error) {
+	return coredependency.GetDependencyByName(getter, name, func(factory services.ModelDomainServices) ModelConfigService {
+		return factory.Config()
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"testing"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	"github.com/juju/juju/core/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type manifoldConfigSuite struct {
+	testhelpers.IsolationSuite
+
+	config ManifoldConfig
+}
+
+func TestManifoldConfigSuite(t *testing.T) {
+	tc.Run(t, &manifoldConfigSuite{})
+}
+
+func (s *manifoldConfigSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.config = validConfig(c)
+}
+
+func (s *manifoldConfigSuite) TestValid(c *tc.C) {
+	c.Check(s.config.Validate(), tc.ErrorIsNil)
+}
+
+func (s *manifoldConfigSuite) TestMissingDomainServicesName(c *tc.C) {
+	s.config.DomainServicesName = ""
+	s.checkNotValid(c, "empty DomainServicesName not valid")
+}
+
+func (s *manifoldConfigSuite) TestMissingGetModelConfigService(c *tc.C) {
+	s.config.GetModelConfigService = nil
+	s.checkNotValid(c, "nil GetModelConfigService not valid")
+}
+
+func (s *manifoldConfigSuite) TestMissingLogForwarderSetter(c *tc.C) {
+	s.config.LogForwarderSetter = nil
+	s.checkNotValid(c, "nil LogForwarderSetter not valid")
+}
+
+func (s *manifoldConfigSuite) TestMissingSpoolDir(c *tc.C) {
+	s.config.SpoolDir = ""
+	s.checkNotValid(c, "empty SpoolDir not valid")
+}
+
+func (s *manifoldConfigSuite) TestMissingNewWorker(c *tc.C) {
+	s.config.NewWorker = nil
+	s.checkNotValid(c, "nil NewWorker not valid")
+}
+
+func (s *manifoldConfigSuite) TestMissingNewForwarder(c *tc.C) {
+	s.config.NewForwarder = nil
+	s.checkNotValid(c, "nil NewForwarder not valid")
+}
+
+func (s *manifoldConfigSuite) TestMissingClock(c *tc.C) {
+	s.config.Clock = nil
+	s.checkNotValid(c, "nil Clock not valid")
+}
+
+func (s *manifoldConfigSuite) TestMissingLogger(c *tc.C) {
+	s.config.Logger = nil
+	s.checkNotValid(c, "nil Logger not valid")
+}
+
+func validConfig(c *tc.C) ManifoldConfig {
+	return ManifoldConfig{
+		DomainServicesName:    "domain-services",
+		GetModelConfigService: GetModelConfigService,
+		LogForwarderSetter:    &fakeSetter{},
+		SpoolDir:              c.MkDir(),
+		NewWorker:             func(Config) (worker.Worker, error) { return noWorker{}, nil },
+		NewForwarder:          NewForwarder,
+		Clock:                 clock.WallClock,
+		Logger:                loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *manifoldConfigSuite) checkNotValid(c *tc.C, expect string) {
+	err := s.config.Validate()
+	c.Check(err, tc.ErrorMatches, expect)
+	c.Check(err, tc.ErrorIs, errors.NotValid)
+}
+
+type manifoldSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestManifoldSuite(t *testing.T) {
+	tc.Run(t, &manifoldSuite{})
+}
+
+func (s *manifoldSuite) TestStartSuccess(c *tc.C) {
+	cfg := validConfig(c)
+	cfg.GetModelConfigService = func(dependency.Getter, string) (ModelConfigService, error) {
+		return noModelConfigService{}, nil
+	}
+	cfg.NewWorker = func(cfg Config) (worker.Worker, error) {
+		if err := cfg.Validate(); err != nil {
+			return nil, err
+		}
+		return noWorker{}, nil
+	}
+
+	w, err := Manifold(cfg).Start(c.Context(), noGetter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(w, tc.NotNil)
+}
+
+type noGetter struct {
+	dependency.Getter
+}
+
+type noModelConfigService struct {
+	ModelConfigService
+}
+
+type noWorker struct {
+	worker.Worker
+}
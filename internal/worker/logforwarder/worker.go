@@ -0,0 +1,271 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/logforwarder"
+)
+
+// ModelConfigService provides access to the model configuration.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(context.Context) (*config.Config, error)
+
+	// Watch returns a watcher that notifies of changes to the model config.
+	Watch() (watcher.StringsWatcher, error)
+}
+
+// Forwarder is a log writer that forwards log records to an endpoint.
+type Forwarder interface {
+	logger.LogWriter
+
+	// Close stops the forwarder.
+	Close() error
+}
+
+// NewForwarderFunc creates a forwarder for the given config.
+type NewForwarderFunc func(logforwarder.Config) (Forwarder, error)
+
+// NewForwarder creates a logforwarder.Forwarder for the given config.
+func NewForwarder(cfg logforwarder.Config) (Forwarder, error) {
+	return logforwarder.NewForwarder(cfg)
+}
+
+// Config holds configuration required to run the log forwarder worker.
+type Config struct {
+	// ModelConfigService is used to read and watch the forwarding
+	// endpoints configured for the model.
+	ModelConfigService ModelConfigService
+
+	// LogForwarderSetter is the model's log writer, which forwards the
+	// model's logs.
+	LogForwarderSetter logger.LogForwarderSetter
+
+	// SpoolDir is the directory where records are kept whilst an endpoint
+	// is unavailable.
+	SpoolDir string
+
+	// NewForwarder creates the forwarder for an endpoint.
+	NewForwarder NewForwarderFunc
+
+	// Clock is used by the forwarders to schedule deliveries.
+	Clock clock.Clock
+
+	// Logger is used to report problems forwarding the logs. It must not
+	// write to the model's logs.
+	Logger logger.Logger
+}
+
+// Validate ensures that the configuration is
+// correctly populated for worker operation.
+func (config Config) Validate() error {
+	if config.ModelConfigService == nil {
+		return errors.New("nil ModelConfigService not valid").Add(coreerrors.NotValid)
+	}
+	if config.LogForwarderSetter == nil {
+		return errors.New("nil LogForwarderSetter not valid").Add(coreerrors.NotValid)
+	}
+	if config.SpoolDir == "" {
+		return errors.New("empty SpoolDir not valid").Add(coreerrors.NotValid)
+	}
+	if config.NewForwarder == nil {
+		return errors.New("nil NewForwarder not valid").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.New("nil Clock not valid").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.New("nil Logger not valid").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// endpoints holds the forwarding endpoints configured for a model.
+type endpoints struct {
+	loki string
+	otlp string
+}
+
+// forwarders fans log records out to a set of forwarders.
+type forwarders []Forwarder
+
+// Log implements logger.LogWriter.
+func (f forwarders) Log(records []logger.LogRecord) error {
+	var errs []error
+	for _, forwarder := range f {
+		if err := forwarder.Log(records); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type forwarderWorker struct {
+	catacomb catacomb.Catacomb
+
+	cfg Config
+
+	current    endpoints
+	forwarders forwarders
+}
+
+// NewWorker starts a new log forwarder worker, which forwards the model's
+// logs to the Loki and OTLP endpoints set in the model config.
+func NewWorker(cfg Config) (worker.Worker, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	w := &forwarderWorker{
+		cfg: cfg,
+	}
+
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "log-forwarder",
+		Site: &w.catacomb,
+		Work: w.loop,
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return w, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *forwarderWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *forwarderWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *forwarderWorker) loop() (err error) {
+	ctx := w.catacomb.Context(context.Background())
+
+	// Stop forwarding when the worker stops. Records that haven't been
+	// delivered are left in the spool for the next forwarder.
+	defer func() {
+		err = errors.Join(err, w.replaceForwarders(nil))
+	}()
+
+	configWatcher, err := w.cfg.ModelConfigService.Watch()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := w.catacomb.Add(configWatcher); err != nil {
+		return errors.Capture(err)
+	}
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+
+		case _, ok := <-configWatcher.Changes():
+			if !ok {
+				return errors.New("model config watcher closed")
+			}
+
+			modelConfig, err := w.cfg.ModelConfigService.ModelConfig(ctx)
+			if err != nil {
+				return errors.Capture(err)
+			}
+			next := endpoints{
+				loki: modelConfig.LoggingForwardLokiURL(),
+				otlp: modelConfig.LoggingForwardOTLPURL(),
+			}
+			if next == w.current {
+				continue
+			}
+			if err := w.updateForwarders(next); err != nil {
+				return errors.Capture(err)
+			}
+		}
+	}
+}
+
+// updateForwarders replaces the running forwarders with ones for the given
+// endpoints.
+func (w *forwarderWorker) updateForwarders(next endpoints) error {
+	var created forwarders
+	add := func(target logforwarder.Target) error {
+		forwarder, err := w.cfg.NewForwarder(logforwarder.Config{
+			Target:   target,
+			SpoolDir: w.cfg.SpoolDir,
+			Clock:    w.cfg.Clock,
+			Logger:   w.cfg.Logger,
+		})
+		if err != nil {
+			return errors.Errorf("creating %s log forwarder: %w", target.Name(), err)
+		}
+		created = append(created, forwarder)
+		return nil
+	}
+
+	// The forwarders for the old endpoints are closed before the new ones
+	// are created, so that the spool for each kind of target is only ever
+	// used by one forwarder.
+	if len(w.forwarders) > 0 {
+		if err := w.replaceForwarders(nil); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	if next.loki != "" {
+		if err := add(logforwarder.NewLokiTarget(next.loki, nil)); err != nil {
+			_ = created.close()
+			return errors.Capture(err)
+		}
+	}
+	if next.otlp != "" {
+		if err := add(logforwarder.NewOTLPTarget(next.otlp, nil)); err != nil {
+			_ = created.close()
+			return errors.Capture(err)
+		}
+	}
+
+	ctx := w.catacomb.Context(context.Background())
+	if len(created) == 0 {
+		w.cfg.Logger.Infof(ctx, "stopped forwarding model logs")
+	} else {
+		w.cfg.Logger.Infof(ctx, "forwarding model logs to loki endpoint %q and otlp endpoint %q", next.loki, next.otlp)
+	}
+	w.current = next
+	return w.replaceForwarders(created)
+}
+
+// replaceForwarders sets the forwarders the model's logs are sent to, and
+// closes the previous forwarders.
+func (w *forwarderWorker) replaceForwarders(next forwarders) error {
+	if len(next) == 0 {
+		w.cfg.LogForwarderSetter.SetLogForwarder(nil)
+	} else {
+		w.cfg.LogForwarderSetter.SetLogForwarder(next)
+	}
+	previous := w.forwarders
+	w.forwarders = next
+	return previous.close()
+}
+
+func (f forwarders) close() error {
+	var errs []error
+	for _, forwarder := range f {
+		if err := forwarder.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
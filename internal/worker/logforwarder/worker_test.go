@@ -0,0 +1,202 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v4/workertest"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/environs/config"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type workerSuite struct {
+	testhelpers.IsolationSuite
+
+	modelConfigService *MockModelConfigService
+	setter             *fakeSetter
+	changes            chan []string
+}
+
+func TestWorkerSuite(t *testing.T) {
+	tc.Run(t, &workerSuite{})
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	s.setter = &fakeSetter{set: make(chan logger.LogWriter, 10)}
+	s.changes = make(chan []string, 1)
+	s.modelConfigService.EXPECT().Watch().Return(watchertest.NewMockStringsWatcher(s.changes), nil)
+	return ctrl
+}
+
+func (s *workerSuite) newWorker(c *tc.C) *forwarderWorker {
+	w, err := NewWorker(Config{
+		ModelConfigService: s.modelConfigService,
+		LogForwarderSetter: s.setter,
+		SpoolDir:           c.MkDir(),
+		NewForwarder:       NewForwarder,
+		Clock:              clock.WallClock,
+		Logger:             loggertesting.WrapCheckLog(c),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return w.(*forwarderWorker)
+}
+
+func (s *workerSuite) modelConfig(c *tc.C, attrs coretesting.Attrs) *config.Config {
+	return coretesting.CustomModelConfig(c, attrs)
+}
+
+func (s *workerSuite) TestNotConfigured(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	done := make(chan struct{})
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).DoAndReturn(func(context.Context) (*config.Config, error) {
+		defer close(done)
+		return s.modelConfig(c, nil), nil
+	})
+
+	w := s.newWorker(c)
+	s.changes <- []string{"name"}
+	select {
+	case <-done:
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for model config")
+	}
+	workertest.CleanKill(c, w)
+
+	// Nothing is forwarded, and forwarding is cleared on exit.
+	c.Check(s.setter.next(c), tc.IsNil)
+}
+
+func (s *workerSuite) TestForwardsToEndpoints(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	loki := newStandIn()
+	defer loki.Close()
+	otlp := newStandIn()
+	defer otlp.Close()
+
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig(c, coretesting.Attrs{
+		config.LoggingForwardLokiURLKey: loki.URL + "/loki/api/v1/push",
+		config.LoggingForwardOTLPURLKey: otlp.URL + "/v1/logs",
+	}), nil)
+
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+	s.changes <- []string{config.LoggingForwardLokiURLKey, config.LoggingForwardOTLPURLKey}
+
+	forwarder := s.setter.next(c)
+	c.Assert(forwarder, tc.NotNil)
+
+	// Fill a batch, so it is sent straight away.
+	records := make([]logger.LogRecord, 500)
+	for i := range records {
+		records[i] = logger.LogRecord{
+			Time:      time.Now(),
+			ModelUUID: "model-uuid",
+			Entity:    "machine-0",
+			Level:     logger.INFO,
+			Module:    "juju.test",
+			Message:   "hello",
+		}
+	}
+	c.Assert(forwarder.Log(records), tc.ErrorIsNil)
+
+	c.Check(loki.request(c).URL.Path, tc.Equals, "/loki/api/v1/push")
+	c.Check(otlp.request(c).URL.Path, tc.Equals, "/v1/logs")
+}
+
+func (s *workerSuite) TestEndpointRemoved(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	loki := newStandIn()
+	defer loki.Close()
+
+	gomock.InOrder(
+		s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig(c, coretesting.Attrs{
+			config.LoggingForwardLokiURLKey: loki.URL,
+		}), nil),
+		s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig(c, nil), nil),
+	)
+
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.changes <- []string{config.LoggingForwardLokiURLKey}
+	c.Check(s.setter.next(c), tc.NotNil)
+
+	s.changes <- []string{config.LoggingForwardLokiURLKey}
+	c.Check(s.setter.next(c), tc.IsNil)
+}
+
+type fakeSetter struct {
+	mu  sync.Mutex
+	set chan logger.LogWriter
+}
+
+func (s *fakeSetter) SetLogForwarder(w logger.LogWriter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.set != nil {
+		s.set <- w
+	}
+}
+
+func (s *fakeSetter) next(c *tc.C) logger.LogWriter {
+	select {
+	case w := <-s.set:
+		return w
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for log forwarder to be set")
+	}
+	return nil
+}
+
+// standIn is a local HTTP stand-in for a log endpoint.
+type standIn struct {
+	*httptest.Server
+	requests chan *http.Request
+}
+
+func newStandIn() *standIn {
+	s := &standIn{requests: make(chan *http.Request, 10)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.requests <- r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	return s
+}
+
+func (s *standIn) request(c *tc.C) *http.Request {
+	select {
+	case r := <-s.requests:
+		return r
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for request")
+	}
+	return nil
+}
@@ -4,6 +4,8 @@
 package logsink
 
 import (
+	"sync"
+
 	"github.com/juju/errors"
 	"github.com/juju/loggo/v2"
 	"github.com/juju/names/v6"
@@ -20,33 +22,60 @@ type modelLogger struct {
 
 	logSink       corelogger.LogSink
 	loggerContext corelogger.LoggerContext
+
+	mu        sync.RWMutex
+	forwarder corelogger.LogWriter
 }
 
 // NewModelLogger returns a new model logger instance.
 func NewModelLogger(logSink corelogger.LogSink, modelUUID model.UUID, agentTag names.Tag) (worker.Worker, error) {
-	// Assign the log sink to the model logger. This redirects the loggo
-	// writer to the underlying log sink.
+	w := &modelLogger{
+		logSink: logSink,
+	}
+
+	// Assign the model logger to the loggo context. This redirects the
+	// loggo writer to the underlying log sink, and any log forwarder.
 	loggerContext := loggo.NewContext(loggo.INFO)
 	if err := loggerContext.AddWriter("model-sink", corelogger.NewTaggedRedirectWriter(
-		logSink,
+		w,
 		agentTag.String(),
 		modelUUID.String(),
 	)); err != nil {
 		return nil, errors.Annotatef(err, "adding model-sink writer")
 	}
+	w.loggerContext = internallogger.WrapLoggoContext(loggerContext)
 
-	w := &modelLogger{
-		logSink:       logSink,
-		loggerContext: internallogger.WrapLoggoContext(loggerContext),
-	}
 	w.tomb.Go(w.loop)
 
 	return w, nil
 }
 
-// Log writes the given log records to the logger's storage.
+// Log writes the given log records to the logger's storage, and to the
+// log forwarder if one is set.
 func (d *modelLogger) Log(records []corelogger.LogRecord) error {
-	return d.logSink.Log(records)
+	if err := d.logSink.Log(records); err != nil {
+		return err
+	}
+
+	d.mu.RLock()
+	forwarder := d.forwarder
+	d.mu.RUnlock()
+	if forwarder == nil {
+		return nil
+	}
+	// The records have been stored, so failing to forward them isn't
+	// reported to the writer. The forwarder is responsible for dealing
+	// with an unavailable endpoint.
+	_ = forwarder.Log(records)
+	return nil
+}
+
+// SetLogForwarder sets the log writer that the model's log records are
+// forwarded to. A nil writer stops the forwarding.
+func (d *modelLogger) SetLogForwarder(forwarder corelogger.LogWriter) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.forwarder = forwarder
 }
 
 // GetLogger returns a logger with the given name and tags.
@@ -28,7 +28,10 @@ func TestLoggersSuite(t *testing.T) {
 	tc.Run(t, &LoggersSuite{})
 }
 
-var _ LogSinkWriter = (*modelLogger)(nil)
+var (
+	_ LogSinkWriter                 = (*modelLogger)(nil)
+	_ corelogger.LogForwarderSetter = (*modelLogger)(nil)
+)
 
 func (s *LoggersSuite) TestLoggers(c *tc.C) {
 	defer s.setupMocks(c).Finish()
@@ -111,6 +114,35 @@ func (s *LoggersSuite) TestLoggerConfigureLoggers(c *tc.C) {
 	c.Check(logs[0].ModelUUID, tc.Equals, s.modelUUID)
 }
 
+func (s *LoggersSuite) TestLoggerForwardsLogs(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.logWriter.EXPECT().Log(gomock.Any()).Return(nil).Times(3)
+
+	logger := s.newModelLogger(c)
+
+	forwarder := &recordingWriter{}
+	logger.SetLogForwarder(forwarder)
+
+	err := logger.Log([]corelogger.LogRecord{{Message: "foo"}})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Logs written through the logger context are forwarded too.
+	logger.GetLogger("bar").Infof(c.Context(), "message me")
+
+	// Once cleared, nothing more is forwarded.
+	logger.SetLogForwarder(nil)
+	err = logger.Log([]corelogger.LogRecord{{Message: "baz"}})
+	c.Assert(err, tc.ErrorIsNil)
+
+	workertest.CheckKill(c, logger)
+
+	c.Assert(forwarder.records, tc.HasLen, 2)
+	c.Check(forwarder.records[0].Message, tc.Equals, "foo")
+	c.Check(forwarder.records[1].Message, tc.Equals, "message me")
+	c.Check(forwarder.records[1].ModelUUID, tc.Equals, s.modelUUID)
+}
+
 func (s *LoggersSuite) newModelLogger(c *tc.C) *modelLogger {
 	s.modelUUID = uuid.MustNewUUID().String()
 
@@ -127,3 +159,12 @@ func (s *LoggersSuite) setupMocks(c *tc.C) *gomock.Controller {
 
 	return ctrl
 }
+
+type recordingWriter struct {
+	records []corelogger.LogRecord
+}
+
+func (w *recordingWriter) Log(records []corelogger.LogRecord) error {
+	w.records = append(w.records, records...)
+	return nil
+}
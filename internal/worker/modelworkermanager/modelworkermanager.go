@@ -62,6 +62,7 @@ type NewModelConfig struct {
 	ModelType              model.ModelType
 	ModelMetrics           MetricSink
 	LoggerContext          corelogger.LoggerContext
+	LogForwarderSetter     corelogger.LogForwarderSetter
 	ControllerConfig       controller.Config
 	ProviderServicesGetter ProviderServicesGetter
 	DomainServices         services.DomainServices
@@ -290,6 +291,10 @@ func (m *modelWorkerManager) newWorkerFuncFromConfig(ctx context.Context, cfg Ne
 		return nil, errors.Trace(err)
 	}
 
+	// The model's log writer can also forward the model's logs, if the
+	// model config asks for them to be forwarded.
+	cfg.LogForwarderSetter, _ = cfg.LoggerContext.(corelogger.LogForwarderSetter)
+
 	return func(ctx context.Context) (worker.Worker, error) {
 		m.config.Logger.Debugf(ctx, "starting workers for model %s", absoluteModelName)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/juju/juju/agent"
 	"github.com/juju/juju/controller"
@@ -22,6 +23,7 @@ import (
 	coreobjectstore "github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/internal/objectstore"
 	"github.com/juju/juju/internal/services"
+	"github.com/juju/juju/internal/worker/common"
 	"github.com/juju/juju/internal/worker/trace"
 )
 
@@ -57,6 +59,10 @@ type GetControllerConfigServiceFunc func(getter dependency.Getter, name string)
 // the manifold.
 type GetMetadataServiceFunc func(getter dependency.Getter, name string) (MetadataService, error)
 
+// MetricsCollectorFn is an alias function that allows the creation of
+// a metrics collector.
+type MetricsCollectorFn = func() *Collector
+
 // IsBootstrapControllerFunc is a helper function that checks if the controller
 // is the initial bootstrap controller.
 type IsBootstrapControllerFunc func(dataDir string) bool
@@ -75,6 +81,8 @@ type ManifoldConfig struct {
 	GetControllerConfigService GetControllerConfigServiceFunc
 	GetMetadataService         GetMetadataServiceFunc
 	IsBootstrapController      IsBootstrapControllerFunc
+	PrometheusRegisterer       prometheus.Registerer
+	NewMetricsCollector        MetricsCollectorFn
 }
 
 // Validate validates the manifold configuration.
@@ -112,6 +120,12 @@ func (cfg ManifoldConfig) Validate() error {
 	if cfg.NewObjectStoreWorker == nil {
 		return errors.NotValidf("nil NewObjectStoreWorker")
 	}
+	if cfg.PrometheusRegisterer == nil {
+		return errors.NotValidf("nil PrometheusRegisterer")
+	}
+	if cfg.NewMetricsCollector == nil {
+		return errors.NotValidf("nil NewMetricsCollector")
+	}
 	return nil
 }
 
@@ -176,6 +190,12 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 
 			dataDir := a.CurrentConfig().DataDir()
 
+			// Register the metrics collector against the prometheus register.
+			metricsCollector := config.NewMetricsCollector()
+			if err := config.PrometheusRegisterer.Register(metricsCollector); err != nil {
+				return nil, errors.Trace(err)
+			}
+
 			w, err := NewWorker(WorkerConfig{
 				TracerGetter:               tracerGetter,
 				RootDir:                    dataDir,
@@ -189,13 +209,25 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				ModelMetadataServiceGetter: modelMetadataServiceGetter{servicesGetter: objectStoreServicesGetter},
 				ModelClaimGetter:           modelClaimGetter{manager: leaseManager},
 				AllowDraining:              AllowDraining(controllerConfig, config.IsBootstrapController(dataDir)),
+				ScrubMetrics:               metricsCollector,
 			})
-			return w, errors.Trace(err)
+			if err != nil {
+				config.PrometheusRegisterer.Unregister(metricsCollector)
+				return nil, errors.Trace(err)
+			}
+			return common.NewCleanupWorker(w, func() {
+				// Clean up the metrics for the worker, so the next time a
+				// worker is created we can safely register the metrics again.
+				config.PrometheusRegisterer.Unregister(metricsCollector)
+			}), nil
 		},
 	}
 }
 
 func output(in worker.Worker, out any) error {
+	if w, ok := in.(*common.CleanupWorker); ok {
+		in = w.Worker
+	}
 	w, ok := in.(*objectStoreWorker)
 	if !ok {
 		return errors.Errorf("expected input of objectStoreWorker, got %T", in)
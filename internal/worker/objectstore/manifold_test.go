@@ -12,6 +12,7 @@ import (
 	"github.com/juju/worker/v4/dependency"
 	dependencytesting "github.com/juju/worker/v4/dependency/testing"
 	"github.com/juju/worker/v4/workertest"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 
@@ -26,6 +27,8 @@ import (
 
 type manifoldSuite struct {
 	baseSuite
+
+	registry *prometheus.Registry
 }
 
 func TestManifoldSuite(t *stdtesting.T) {
@@ -70,6 +73,14 @@ func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
 	cfg = s.getConfig()
 	cfg.NewObjectStoreWorker = nil
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig()
+	cfg.PrometheusRegisterer = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig()
+	cfg.NewMetricsCollector = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 }
 
 func (s *manifoldSuite) getConfig() ManifoldConfig {
@@ -93,6 +104,8 @@ func (s *manifoldSuite) getConfig() ManifoldConfig {
 		IsBootstrapController: func(dataDir string) bool {
 			return false
 		},
+		PrometheusRegisterer: s.registry,
+		NewMetricsCollector:  NewMetricsCollector,
 	}
 }
 
@@ -124,6 +137,35 @@ func (s *manifoldSuite) TestStart(c *tc.C) {
 	workertest.CleanKill(c, w)
 }
 
+func (s *manifoldSuite) TestStartRegistersMetrics(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAgentConfig(c)
+	s.expectControllerConfig()
+
+	w, err := Manifold(s.getConfig()).Start(c.Context(), s.newGetter())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The collector is registered while the worker is running, so another
+	// can't be registered.
+	err = s.registry.Register(NewMetricsCollector())
+	c.Check(err, tc.NotNil)
+
+	workertest.CleanKill(c, w)
+
+	// Once the worker has stopped, the collector is unregistered.
+	err = s.registry.Register(NewMetricsCollector())
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *manifoldSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := s.baseSuite.setupMocks(c)
+
+	s.registry = prometheus.NewRegistry()
+
+	return ctrl
+}
+
 func (s *manifoldSuite) expectAgentConfig(c *tc.C) {
 	s.agentConfig.EXPECT().DataDir().Return(c.MkDir())
 	s.agent.EXPECT().CurrentConfig().Return(s.agentConfig)
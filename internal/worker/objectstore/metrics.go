@@ -0,0 +1,114 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	internalobjectstore "github.com/juju/juju/internal/objectstore"
+)
+
+const (
+	objectStoreMetricsNamespace   = "juju"
+	objectStoreSubsystemNamespace = "objectstore"
+)
+
+var (
+	labelNames = []string{"namespace"}
+)
+
+// Collector defines a prometheus collector for the outcome of the object
+// store scrubs. Each metric holds the outcome of the last scrub of the object
+// store for a namespace.
+type Collector struct {
+	ScrubTimestamp  *prometheus.GaugeVec
+	ScrubDuration   *prometheus.GaugeVec
+	ScrubChecked    *prometheus.GaugeVec
+	ScrubCorrupt    *prometheus.GaugeVec
+	ScrubMissing    *prometheus.GaugeVec
+	ScrubRepaired   *prometheus.GaugeVec
+	ScrubUnrepaired *prometheus.GaugeVec
+}
+
+// NewMetricsCollector returns a new Collector.
+func NewMetricsCollector() *Collector {
+	return &Collector{
+		ScrubTimestamp: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: objectStoreMetricsNamespace,
+			Subsystem: objectStoreSubsystemNamespace,
+			Name:      "scrub_timestamp_seconds",
+			Help:      "The time the last scrub of the object store started.",
+		}, labelNames),
+		ScrubDuration: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: objectStoreMetricsNamespace,
+			Subsystem: objectStoreSubsystemNamespace,
+			Name:      "scrub_duration_seconds",
+			Help:      "The time taken by the last scrub of the object store.",
+		}, labelNames),
+		ScrubChecked: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: objectStoreMetricsNamespace,
+			Subsystem: objectStoreSubsystemNamespace,
+			Name:      "scrub_checked_objects",
+			Help:      "The number of objects verified by the last scrub of the object store.",
+		}, labelNames),
+		ScrubCorrupt: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: objectStoreMetricsNamespace,
+			Subsystem: objectStoreSubsystemNamespace,
+			Name:      "scrub_corrupt_objects",
+			Help:      "The number of objects found corrupt by the last scrub of the object store.",
+		}, labelNames),
+		ScrubMissing: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: objectStoreMetricsNamespace,
+			Subsystem: objectStoreSubsystemNamespace,
+			Name:      "scrub_missing_objects",
+			Help:      "The number of objects found missing by the last scrub of the object store.",
+		}, labelNames),
+		ScrubRepaired: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: objectStoreMetricsNamespace,
+			Subsystem: objectStoreSubsystemNamespace,
+			Name:      "scrub_repaired_objects",
+			Help:      "The number of corrupt or missing objects repaired by the last scrub of the object store.",
+		}, labelNames),
+		ScrubUnrepaired: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: objectStoreMetricsNamespace,
+			Subsystem: objectStoreSubsystemNamespace,
+			Name:      "scrub_unrepaired_objects",
+			Help:      "The number of corrupt or missing objects the last scrub of the object store couldn't repair.",
+		}, labelNames),
+	}
+}
+
+// RecordScrub records the report of a completed scrub of the object store
+// for the given namespace.
+func (c *Collector) RecordScrub(namespace string, report internalobjectstore.ScrubReport) {
+	c.ScrubTimestamp.WithLabelValues(namespace).Set(float64(report.Started.Unix()))
+	c.ScrubDuration.WithLabelValues(namespace).Set(report.Duration.Seconds())
+	c.ScrubChecked.WithLabelValues(namespace).Set(float64(report.Checked))
+	c.ScrubCorrupt.WithLabelValues(namespace).Set(float64(report.Corrupt))
+	c.ScrubMissing.WithLabelValues(namespace).Set(float64(report.Missing))
+	c.ScrubRepaired.WithLabelValues(namespace).Set(float64(report.Repaired))
+	c.ScrubUnrepaired.WithLabelValues(namespace).Set(float64(report.Unrepaired))
+}
+
+// Describe is part of the prometheus.Collector interface.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.ScrubTimestamp.Describe(ch)
+	c.ScrubDuration.Describe(ch)
+	c.ScrubChecked.Describe(ch)
+	c.ScrubCorrupt.Describe(ch)
+	c.ScrubMissing.Describe(ch)
+	c.ScrubRepaired.Describe(ch)
+	c.ScrubUnrepaired.Describe(ch)
+}
+
+// Collect is part of the prometheus.Collector interface.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.ScrubTimestamp.Collect(ch)
+	c.ScrubDuration.Collect(ch)
+	c.ScrubChecked.Collect(ch)
+	c.ScrubCorrupt.Collect(ch)
+	c.ScrubMissing.Collect(ch)
+	c.ScrubRepaired.Collect(ch)
+	c.ScrubUnrepaired.Collect(ch)
+}
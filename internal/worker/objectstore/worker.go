@@ -50,6 +50,7 @@ type WorkerConfig struct {
 	ModelMetadataServiceGetter MetadataServiceGetter
 	ModelClaimGetter           ModelClaimGetter
 	AllowDraining              bool
+	ScrubMetrics               internalobjectstore.ScrubMetrics
 }
 
 // Validate ensures that the config values are valid.
@@ -84,6 +85,9 @@ func (c *WorkerConfig) Validate() error {
 	if c.ModelClaimGetter == nil {
 		return errors.NotValidf("nil ModelClaimGetter")
 	}
+	if c.ScrubMetrics == nil {
+		return errors.NotValidf("nil ScrubMetrics")
+	}
 	return nil
 }
 
@@ -187,6 +191,12 @@ func (w *objectStoreWorker) Wait() error {
 	return w.catacomb.Wait()
 }
 
+// Report provides information for the engine report, including the outcome
+// of the last scrub of each object store.
+func (w *objectStoreWorker) Report() map[string]any {
+	return w.runner.Report()
+}
+
 // GetObjectStore returns a objectStore for the given namespace.
 func (w *objectStoreWorker) GetObjectStore(ctx context.Context, namespace string) (objectstore.ObjectStore, error) {
 	// First check if we've already got the objectStore worker already running.
@@ -295,6 +305,7 @@ func (w *objectStoreWorker) initObjectStore(ctx context.Context, namespace strin
 			internalobjectstore.WithClaimer(claimer),
 			internalobjectstore.WithLogger(w.cfg.Logger),
 			internalobjectstore.WithAllowDraining(w.cfg.AllowDraining),
+			internalobjectstore.WithScrubMetrics(w.cfg.ScrubMetrics),
 		)
 		if err != nil {
 			return nil, errors.Trace(err)
@@ -334,6 +345,14 @@ type tracedWorker struct {
 	tracer coretrace.Tracer
 }
 
+// Report provides information for the engine report.
+func (t *tracedWorker) Report() map[string]any {
+	if r, ok := t.TrackedObjectStore.(worker.Reporter); ok {
+		return r.Report()
+	}
+	return nil
+}
+
 // Get returns an io.ReadCloser for data at path, namespaced to the
 // model.
 func (t *tracedWorker) Get(ctx context.Context, path string) (_ io.ReadCloser, _ int64, err error) {
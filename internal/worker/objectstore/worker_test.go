@@ -200,6 +200,7 @@ func (s *workerSuite) newWorker(c *tc.C) worker.Worker {
 		ModelClaimGetter:           s.modelClaimGetter,
 		RootDir:                    c.MkDir(),
 		RootBucket:                 uuid.MustNewUUID().String(),
+		ScrubMetrics:               NewMetricsCollector(),
 	}, s.states)
 	c.Assert(err, tc.ErrorIsNil)
 	return w
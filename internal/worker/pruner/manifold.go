@@ -0,0 +1,153 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	coredependency "github.com/juju/juju/core/dependency"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/services"
+)
+
+// ModelConfigService describes the ability to read the model config.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(ctx context.Context) (*config.Config, error)
+}
+
+// Clock describes the ability get the current time and create timers.
+type Clock interface {
+	// Now gets the current clock time.
+	Now() time.Time
+
+	// NewTimer returns a new timer that will fire after the input duration.
+	NewTimer(d time.Duration) clock.Timer
+}
+
+// ManifoldConfig contains the configuration passed to this
+// worker's manifold when run by the dependency engine.
+type ManifoldConfig struct {
+	// DomainServicesName is the name of the domain service factory dependency.
+	DomainServicesName string
+
+	// GetServices is used to extract the pruner and model config service
+	// from domain service dependency.
+	GetServices func(getter dependency.Getter, name string) (Pruner, ModelConfigService, error)
+
+	// NewWorker creates and returns a pruner worker.
+	NewWorker func(Config) (worker.Worker, error)
+
+	// PruneInterval is the time between pruning runs.
+	PruneInterval time.Duration
+
+	// Clock is used by the worker to create timers.
+	Clock Clock
+
+	// Logger logs stuff.
+	Logger logger.Logger
+}
+
+// Validate ensures that the configuration is
+// correctly populated for manifold operation.
+func (config ManifoldConfig) Validate() error {
+	if config.DomainServicesName == "" {
+		return errors.New("empty DomainServicesName not valid").Add(coreerrors.NotValid)
+	}
+	if config.GetServices == nil {
+		return errors.New("nil GetServices not valid").Add(coreerrors.NotValid)
+	}
+	if config.NewWorker == nil {
+		return errors.New("nil NewWorker not valid").Add(coreerrors.NotValid)
+	}
+	if config.PruneInterval <= 0 {
+		return errors.New("non-positive PruneInterval not valid").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.New("nil Clock not valid").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.New("nil Logger not valid").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// Manifold returns a dependency.Manifold that will run the pruner worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.DomainServicesName,
+		},
+		Start: config.start,
+	}
+}
+
+func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	pruner, modelConfigService, err := config.GetServices(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	w, err := config.NewWorker(Config{
+		Pruner:             pruner,
+		ModelConfigService: modelConfigService,
+		PruneInterval:      config.PruneInterval,
+		Clock:              config.Clock,
+		Logger:             config.Logger,
+	})
+	if err != nil {
+		return nil, errors.Errorf("creating pruner worker: %w", err)
+	}
+	return w, nil
+}
+
+type prunerServices struct {
+	pruner      Pruner
+	modelConfig ModelConfigService
+}
+
+// GetStatusHistoryServices extracts the model service factory from the input
+// dependency getter, then returns a status history pruner and the model config
+// service from it.
+func GetStatusHistoryServices(getter dependency.Getter, name string) (Pruner, ModelConfigService, error) {
+	return getServices(getter, name, func(factory services.ModelDomainServices) Pruner {
+		return NewStatusHistoryPruner(factory.StatusHistory())
+	})
+}
+
+// GetSecretAccessLogServices extracts the model service factory from the input
+// dependency getter, then returns a secret access log pruner and the model
+// config service from it.
+func GetSecretAccessLogServices(getter dependency.Getter, name string) (Pruner, ModelConfigService, error) {
+	return getServices(getter, name, func(factory services.ModelDomainServices) Pruner {
+		return NewSecretAccessLogPruner(factory.Secret())
+	})
+}
+
+func getServices(
+	getter dependency.Getter, name string, newPruner func(services.ModelDomainServices) Pruner,
+) (Pruner, ModelConfigService, error) {
+	svcs, err := coredependency.GetDependencyByName(getter, name, func(factory services.ModelDomainServices) prunerServices {
+		return prunerServices{
+			pruner:      newPruner(factory),
+			modelConfig: factory.Config(),
+		}
+	})
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	return svcs.pruner, svcs.modelConfig, nil
+}
@@ -0,0 +1,367 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/pruner (interfaces: Pruner,ModelConfigService,Clock,StatusHistoryService,SecretAccessLogService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package pruner -destination package_mocks_test.go github.com/juju/juju/internal/worker/pruner Pruner,ModelConfigService,Clock,StatusHistoryService,SecretAccessLogService
+//
+
+// Package pruner is a generated GoMock package.
+package pruner
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	clock "github.com/juju/clock"
+	config "github.com/juju/juju/environs/config"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPruner is a mock of Pruner interface.
+type MockPruner struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrunerMockRecorder
+}
+
+// MockPrunerMockRecorder is the mock recorder for MockPruner.
+type MockPrunerMockRecorder struct {
+	mock *MockPruner
+}
+
+// NewMockPruner creates a new mock instance.
+func NewMockPruner(ctrl *gomock.Controller) *MockPruner {
+	mock := &MockPruner{ctrl: ctrl}
+	mock.recorder = &MockPrunerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPruner) EXPECT() *MockPrunerMockRecorder {
+	return m.recorder
+}
+
+// Prune mocks base method.
+func (m *MockPruner) Prune(arg0 context.Context, arg1 *config.Config) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prune", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockPrunerMockRecorder) Prune(arg0, arg1 any) *MockPrunerPruneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockPruner)(nil).Prune), arg0, arg1)
+	return &MockPrunerPruneCall{Call: call}
+}
+
+// MockPrunerPruneCall wrap *gomock.Call
+type MockPrunerPruneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPrunerPruneCall) Return(arg0 int64, arg1 error) *MockPrunerPruneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPrunerPruneCall) Do(f func(context.Context, *config.Config) (int64, error)) *MockPrunerPruneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPrunerPruneCall) DoAndReturn(f func(context.Context, *config.Config) (int64, error)) *MockPrunerPruneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock *MockModelConfigService
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(arg0 context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(arg0 any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockModelConfigService)(nil).ModelConfig), arg0)
+	return &MockModelConfigServiceModelConfigCall{Call: call}
+}
+
+// MockModelConfigServiceModelConfigCall wrap *gomock.Call
+type MockModelConfigServiceModelConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceModelConfigCall) Return(arg0 *config.Config, arg1 error) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceModelConfigCall) Do(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceModelConfigCall) DoAndReturn(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockClock is a mock of Clock interface.
+type MockClock struct {
+	ctrl     *gomock.Controller
+	recorder *MockClockMockRecorder
+}
+
+// MockClockMockRecorder is the mock recorder for MockClock.
+type MockClockMockRecorder struct {
+	mock *MockClock
+}
+
+// NewMockClock creates a new mock instance.
+func NewMockClock(ctrl *gomock.Controller) *MockClock {
+	mock := &MockClock{ctrl: ctrl}
+	mock.recorder = &MockClockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClock) EXPECT() *MockClockMockRecorder {
+	return m.recorder
+}
+
+// NewTimer mocks base method.
+func (m *MockClock) NewTimer(arg0 time.Duration) clock.Timer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTimer", arg0)
+	ret0, _ := ret[0].(clock.Timer)
+	return ret0
+}
+
+// NewTimer indicates an expected call of NewTimer.
+func (mr *MockClockMockRecorder) NewTimer(arg0 any) *MockClockNewTimerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTimer", reflect.TypeOf((*MockClock)(nil).NewTimer), arg0)
+	return &MockClockNewTimerCall{Call: call}
+}
+
+// MockClockNewTimerCall wrap *gomock.Call
+type MockClockNewTimerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClockNewTimerCall) Return(arg0 clock.Timer) *MockClockNewTimerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClockNewTimerCall) Do(f func(time.Duration) clock.Timer) *MockClockNewTimerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClockNewTimerCall) DoAndReturn(f func(time.Duration) clock.Timer) *MockClockNewTimerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Now mocks base method.
+func (m *MockClock) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockClockMockRecorder) Now() *MockClockNowCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockClock)(nil).Now))
+	return &MockClockNowCall{Call: call}
+}
+
+// MockClockNowCall wrap *gomock.Call
+type MockClockNowCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClockNowCall) Return(arg0 time.Time) *MockClockNowCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClockNowCall) Do(f func() time.Time) *MockClockNowCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClockNowCall) DoAndReturn(f func() time.Time) *MockClockNowCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStatusHistoryService is a mock of StatusHistoryService interface.
+type MockStatusHistoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusHistoryServiceMockRecorder
+}
+
+// MockStatusHistoryServiceMockRecorder is the mock recorder for MockStatusHistoryService.
+type MockStatusHistoryServiceMockRecorder struct {
+	mock *MockStatusHistoryService
+}
+
+// NewMockStatusHistoryService creates a new mock instance.
+func NewMockStatusHistoryService(ctrl *gomock.Controller) *MockStatusHistoryService {
+	mock := &MockStatusHistoryService{ctrl: ctrl}
+	mock.recorder = &MockStatusHistoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusHistoryService) EXPECT() *MockStatusHistoryServiceMockRecorder {
+	return m.recorder
+}
+
+// PruneStatusHistory mocks base method.
+func (m *MockStatusHistoryService) PruneStatusHistory(arg0 context.Context, arg1 time.Duration, arg2 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneStatusHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneStatusHistory indicates an expected call of PruneStatusHistory.
+func (mr *MockStatusHistoryServiceMockRecorder) PruneStatusHistory(arg0, arg1, arg2 any) *MockStatusHistoryServicePruneStatusHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneStatusHistory", reflect.TypeOf((*MockStatusHistoryService)(nil).PruneStatusHistory), arg0, arg1, arg2)
+	return &MockStatusHistoryServicePruneStatusHistoryCall{Call: call}
+}
+
+// MockStatusHistoryServicePruneStatusHistoryCall wrap *gomock.Call
+type MockStatusHistoryServicePruneStatusHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusHistoryServicePruneStatusHistoryCall) Return(arg0 int64, arg1 error) *MockStatusHistoryServicePruneStatusHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusHistoryServicePruneStatusHistoryCall) Do(f func(context.Context, time.Duration, int64) (int64, error)) *MockStatusHistoryServicePruneStatusHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusHistoryServicePruneStatusHistoryCall) DoAndReturn(f func(context.Context, time.Duration, int64) (int64, error)) *MockStatusHistoryServicePruneStatusHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSecretAccessLogService is a mock of SecretAccessLogService interface.
+type MockSecretAccessLogService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretAccessLogServiceMockRecorder
+}
+
+// MockSecretAccessLogServiceMockRecorder is the mock recorder for MockSecretAccessLogService.
+type MockSecretAccessLogServiceMockRecorder struct {
+	mock *MockSecretAccessLogService
+}
+
+// NewMockSecretAccessLogService creates a new mock instance.
+func NewMockSecretAccessLogService(ctrl *gomock.Controller) *MockSecretAccessLogService {
+	mock := &MockSecretAccessLogService{ctrl: ctrl}
+	mock.recorder = &MockSecretAccessLogServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretAccessLogService) EXPECT() *MockSecretAccessLogServiceMockRecorder {
+	return m.recorder
+}
+
+// PruneSecretAccessLog mocks base method.
+func (m *MockSecretAccessLogService) PruneSecretAccessLog(arg0 context.Context, arg1 time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneSecretAccessLog", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneSecretAccessLog indicates an expected call of PruneSecretAccessLog.
+func (mr *MockSecretAccessLogServiceMockRecorder) PruneSecretAccessLog(arg0, arg1 any) *MockSecretAccessLogServicePruneSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneSecretAccessLog", reflect.TypeOf((*MockSecretAccessLogService)(nil).PruneSecretAccessLog), arg0, arg1)
+	return &MockSecretAccessLogServicePruneSecretAccessLogCall{Call: call}
+}
+
+// MockSecretAccessLogServicePruneSecretAccessLogCall wrap *gomock.Call
+type MockSecretAccessLogServicePruneSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretAccessLogServicePruneSecretAccessLogCall) Return(arg0 int64, arg1 error) *MockSecretAccessLogServicePruneSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAccessLogServicePruneSecretAccessLogCall) Do(f func(context.Context, time.Duration) (int64, error)) *MockSecretAccessLogServicePruneSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAccessLogServicePruneSecretAccessLogCall) DoAndReturn(f func(context.Context, time.Duration) (int64, error)) *MockSecretAccessLogServicePruneSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
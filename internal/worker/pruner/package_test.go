@@ -0,0 +1,7 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pruner
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package pruner -destination package_mocks_test.go github.com/juju/juju/internal/worker/pruner Pruner,ModelConfigService,Clock,StatusHistoryService,SecretAccessLogService
+//go:generate go run go.uber.org/mock/mockgen -typed -package pruner -destination timer_mocks_test.go github.com/juju/clock Timer
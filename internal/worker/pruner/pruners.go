@@ -0,0 +1,71 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+)
+
+// StatusHistoryService describes the ability to prune the status history
+// of a model.
+type StatusHistoryService interface {
+	// PruneStatusHistory removes status history records older than maxAge,
+	// then removes the oldest records until the history is no bigger than
+	// maxSize bytes. The number of records removed is returned.
+	PruneStatusHistory(ctx context.Context, maxAge time.Duration, maxSize int64) (int64, error)
+}
+
+// SecretAccessLogService describes the ability to prune the secret access log
+// of a model.
+type SecretAccessLogService interface {
+	// PruneSecretAccessLog removes secret access log entries older than
+	// maxAge. The number of entries removed is returned.
+	PruneSecretAccessLog(ctx context.Context, maxAge time.Duration) (int64, error)
+}
+
+// NewStatusHistoryPruner returns a Pruner that prunes the status history
+// of a model to the max-status-history-age and max-status-history-size
+// settings.
+func NewStatusHistoryPruner(service StatusHistoryService) Pruner {
+	return statusHistoryPruner{service: service}
+}
+
+type statusHistoryPruner struct {
+	service StatusHistoryService
+}
+
+// Prune is part of the Pruner interface.
+func (p statusHistoryPruner) Prune(ctx context.Context, cfg *config.Config) (int64, error) {
+	maxAge := cfg.MaxStatusHistoryAge()
+	maxSize := int64(cfg.MaxStatusHistorySizeMB()) * 1024 * 1024
+
+	removed, err := p.service.PruneStatusHistory(ctx, maxAge, maxSize)
+	if err != nil {
+		return 0, errors.Errorf("pruning status history: %w", err)
+	}
+	return removed, nil
+}
+
+// NewSecretAccessLogPruner returns a Pruner that prunes the secret access
+// log of a model to the max-secret-access-log-age setting.
+func NewSecretAccessLogPruner(service SecretAccessLogService) Pruner {
+	return secretAccessLogPruner{service: service}
+}
+
+type secretAccessLogPruner struct {
+	service SecretAccessLogService
+}
+
+// Prune is part of the Pruner interface.
+func (p secretAccessLogPruner) Prune(ctx context.Context, cfg *config.Config) (int64, error) {
+	removed, err := p.service.PruneSecretAccessLog(ctx, cfg.MaxSecretAccessLogAge())
+	if err != nil {
+		return 0, errors.Errorf("pruning secret access log: %w", err)
+	}
+	return removed, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pruner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type prunersSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestPrunersSuite(t *testing.T) {
+	tc.Run(t, &prunersSuite{})
+}
+
+func (s *prunersSuite) TestStatusHistoryPruner(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		config.MaxStatusHistoryAge:  "24h",
+		config.MaxStatusHistorySize: "2M",
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	service := NewMockStatusHistoryService(ctrl)
+	service.EXPECT().PruneStatusHistory(gomock.Any(), 24*time.Hour, int64(2*1024*1024)).Return(3, nil)
+
+	removed, err := NewStatusHistoryPruner(service).Prune(c.Context(), cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(removed, tc.Equals, int64(3))
+}
+
+func (s *prunersSuite) TestStatusHistoryPrunerError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig())
+	c.Assert(err, tc.ErrorIsNil)
+
+	service := NewMockStatusHistoryService(ctrl)
+	service.EXPECT().PruneStatusHistory(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, errors.New("boom"))
+
+	_, err = NewStatusHistoryPruner(service).Prune(c.Context(), cfg)
+	c.Check(err, tc.ErrorMatches, "pruning status history: boom")
+}
+
+func (s *prunersSuite) TestSecretAccessLogPruner(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		config.MaxSecretAccessLogAge: "24h",
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	service := NewMockSecretAccessLogService(ctrl)
+	service.EXPECT().PruneSecretAccessLog(gomock.Any(), 24*time.Hour).Return(3, nil)
+
+	removed, err := NewSecretAccessLogPruner(service).Prune(c.Context(), cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(removed, tc.Equals, int64(3))
+}
+
+func (s *prunersSuite) TestSecretAccessLogPrunerError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig())
+	c.Assert(err, tc.ErrorIsNil)
+
+	service := NewMockSecretAccessLogService(ctrl)
+	service.EXPECT().PruneSecretAccessLog(gomock.Any(), gomock.Any()).Return(0, errors.New("boom"))
+
+	_, err = NewSecretAccessLogPruner(service).Prune(c.Context(), cfg)
+	c.Check(err, tc.ErrorMatches, "pruning secret access log: boom")
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+)
+
+// Pruner describes the ability to prune the records of a model.
+type Pruner interface {
+	// Prune removes the records that fall outside of the retention settings
+	// in the model config. The number of records removed is returned.
+	Prune(ctx context.Context, cfg *config.Config) (int64, error)
+}
+
+// Config holds configuration required to run the pruner worker.
+type Config struct {
+	// Pruner is used to prune the records.
+	Pruner Pruner
+
+	// ModelConfigService is used to read the retention settings.
+	ModelConfigService ModelConfigService
+
+	// PruneInterval is the time between pruning runs.
+	PruneInterval time.Duration
+
+	// Clock is used by the worker to create timers.
+	Clock Clock
+
+	// Logger logs stuff.
+	Logger logger.Logger
+}
+
+// Validate ensures that the configuration is
+// correctly populated for worker operation.
+func (config Config) Validate() error {
+	if config.Pruner == nil {
+		return errors.New("nil Pruner not valid").Add(coreerrors.NotValid)
+	}
+	if config.ModelConfigService == nil {
+		return errors.New("nil ModelConfigService not valid").Add(coreerrors.NotValid)
+	}
+	if config.PruneInterval <= 0 {
+		return errors.New("non-positive PruneInterval not valid").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.New("nil Clock not valid").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.New("nil Logger not valid").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+type pruneWorker struct {
+	catacomb catacomb.Catacomb
+
+	cfg Config
+}
+
+// NewWorker starts a new pruner worker based on the input configuration and
+// returns it.
+func NewWorker(cfg Config) (worker.Worker, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	w := &pruneWorker{
+		cfg: cfg,
+	}
+
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "pruner",
+		Site: &w.catacomb,
+		Work: w.loop,
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return w, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *pruneWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *pruneWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *pruneWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	timer := w.cfg.Clock.NewTimer(w.cfg.PruneInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			if err := w.prune(ctx); err != nil {
+				return errors.Capture(err)
+			}
+			timer.Reset(w.cfg.PruneInterval)
+		}
+	}
+}
+
+// prune reads the current retention settings from the model config and
+// removes any records that fall outside of them.
+func (w *pruneWorker) prune(ctx context.Context) error {
+	cfg, err := w.cfg.ModelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return errors.Errorf("getting model config: %w", err)
+	}
+
+	removed, err := w.cfg.Pruner.Prune(ctx, cfg)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if removed > 0 {
+		w.cfg.Logger.Debugf(ctx, "pruned %d records", removed)
+	}
+	return nil
+}
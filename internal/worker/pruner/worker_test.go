@@ -0,0 +1,132 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pruner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/goleak"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type workerSuite struct {
+	testhelpers.IsolationSuite
+
+	pruner             *MockPruner
+	modelConfigService *MockModelConfigService
+	clock              *MockClock
+	timer              *MockTimer
+}
+
+func TestWorkerSuite(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	tc.Run(t, &workerSuite{})
+}
+
+func (s *workerSuite) TestPrunesOnTimer(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	timerCh := make(chan time.Time, 1)
+	s.timer.EXPECT().Chan().Return(timerCh).AnyTimes()
+	s.timer.EXPECT().Reset(time.Minute).Return(true).AnyTimes()
+	s.timer.EXPECT().Stop().Return(true)
+	s.clock.EXPECT().NewTimer(time.Minute).Return(s.timer)
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig())
+	c.Assert(err, tc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+
+	done := make(chan struct{})
+	s.pruner.EXPECT().Prune(gomock.Any(), cfg).
+		DoAndReturn(func(context.Context, *config.Config) (int64, error) {
+			close(done)
+			return 3, nil
+		})
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	timerCh <- time.Now()
+
+	select {
+	case <-done:
+	case <-time.After(testhelpers.LongWait):
+		c.Fatalf("timed out waiting for prune")
+	}
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *workerSuite) TestPruneErrorKillsWorker(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	timerCh := make(chan time.Time, 1)
+	s.timer.EXPECT().Chan().Return(timerCh).AnyTimes()
+	s.timer.EXPECT().Stop().Return(true)
+	s.clock.EXPECT().NewTimer(time.Minute).Return(s.timer)
+
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(nil, context.DeadlineExceeded)
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	timerCh <- time.Now()
+
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, tc.ErrorMatches, "getting model config: .*")
+}
+
+func (s *workerSuite) TestPrunerErrorKillsWorker(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	timerCh := make(chan time.Time, 1)
+	s.timer.EXPECT().Chan().Return(timerCh).AnyTimes()
+	s.timer.EXPECT().Stop().Return(true)
+	s.clock.EXPECT().NewTimer(time.Minute).Return(s.timer)
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig())
+	c.Assert(err, tc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+	s.pruner.EXPECT().Prune(gomock.Any(), cfg).Return(0, errors.New("pruning status history: boom"))
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	timerCh <- time.Now()
+
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, tc.ErrorMatches, "pruning status history: boom")
+}
+
+func (s *workerSuite) newConfig(c *tc.C) Config {
+	return Config{
+		Pruner:             s.pruner,
+		ModelConfigService: s.modelConfigService,
+		PruneInterval:      time.Minute,
+		Clock:              s.clock,
+		Logger:             loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.pruner = NewMockPruner(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	s.clock = NewMockClock(ctrl)
+	s.timer = NewMockTimer(ctrl)
+
+	return ctrl
+}
@@ -6,12 +6,14 @@ package sshserver
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
 
 	coredependency "github.com/juju/juju/core/dependency"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/internal/featureflag"
 	"github.com/juju/juju/internal/services"
 )
@@ -33,6 +35,9 @@ func GetControllerConfigService(getter dependency.Getter, name string) (Controll
 type ManifoldConfig struct {
 	// DomainServicesName is the name of the domain services worker.
 	DomainServicesName string
+	// ObjectStoreName is the name of the object store worker, used to store
+	// session recordings.
+	ObjectStoreName string
 	// NewServerWrapperWorker is the function that creates the embedded SSH server worker.
 	NewServerWrapperWorker func(ServerWrapperWorkerConfig) (worker.Worker, error)
 	// NewServerWorker is the function that creates a worker that has a catacomb
@@ -42,6 +47,8 @@ type ManifoldConfig struct {
 	GetControllerConfigService GetControllerConfigServiceFunc
 	// Logger is the logger to use for the worker.
 	Logger logger.Logger
+	// Clock is the clock used to time session recordings.
+	Clock clock.Clock
 }
 
 // Validate validates the manifold configuration.
@@ -49,6 +56,9 @@ func (config ManifoldConfig) Validate() error {
 	if config.DomainServicesName == "" {
 		return errors.NotValidf("empty DomainServicesName")
 	}
+	if config.ObjectStoreName == "" {
+		return errors.NotValidf("empty ObjectStoreName")
+	}
 	if config.NewServerWrapperWorker == nil {
 		return errors.NotValidf("nil NewServerWrapperWorker")
 	}
@@ -61,6 +71,9 @@ func (config ManifoldConfig) Validate() error {
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
 	return nil
 }
 
@@ -70,6 +83,7 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 	return dependency.Manifold{
 		Inputs: []string{
 			config.DomainServicesName,
+			config.ObjectStoreName,
 		},
 		Start: config.startWrapperWorker,
 	}
@@ -92,10 +106,17 @@ func (config ManifoldConfig) startWrapperWorker(_ context.Context, getter depend
 		return nil, errors.Trace(err)
 	}
 
+	var objectStoreGetter objectstore.ObjectStoreGetter
+	if err := getter.Get(config.ObjectStoreName, &objectStoreGetter); err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	return config.NewServerWrapperWorker(ServerWrapperWorkerConfig{
 		ControllerConfigService: controllerConfigService,
 		NewServerWorker:         config.NewServerWorker,
 		Logger:                  config.Logger,
+		Clock:                   config.Clock,
 		SessionHandler:          &stubSessionHandler{},
+		ObjectStoreGetter:       objectStoreGetter,
 	})
 }
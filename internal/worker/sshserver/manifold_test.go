@@ -8,6 +8,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v4"
@@ -29,6 +30,7 @@ type manifoldSuite struct {
 	testhelpers.IsolationSuite
 
 	controllerConfigService *MockControllerConfigService
+	objectStoreGetter       *MockObjectStoreGetter
 }
 
 func TestManifoldSuite(t *testing.T) {
@@ -53,6 +55,7 @@ func (s *manifoldSuite) TestConfigValidate(c *tc.C) {
 	// Entirely missing.
 	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
 		cfg.DomainServicesName = ""
+		cfg.ObjectStoreName = ""
 		cfg.NewServerWrapperWorker = nil
 		cfg.NewServerWorker = nil
 		cfg.GetControllerConfigService = nil
@@ -66,6 +69,12 @@ func (s *manifoldSuite) TestConfigValidate(c *tc.C) {
 	})
 	c.Check(errors.Is(cfg.Validate(), errors.NotValid), tc.IsTrue)
 
+	// Missing object store name.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.ObjectStoreName = ""
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), tc.IsTrue)
+
 	// Missing NewServerWrapperWorker.
 	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
 		cfg.NewServerWrapperWorker = nil
@@ -90,6 +99,12 @@ func (s *manifoldSuite) TestConfigValidate(c *tc.C) {
 	})
 	c.Check(errors.Is(cfg.Validate(), errors.NotValid), tc.IsTrue)
 
+	// Missing Clock.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.Clock = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), tc.IsTrue)
+
 }
 
 func (s *manifoldSuite) TestManifoldStart(c *tc.C) {
@@ -98,6 +113,7 @@ func (s *manifoldSuite) TestManifoldStart(c *tc.C) {
 	// Setup the manifold
 	manifold := Manifold(ManifoldConfig{
 		DomainServicesName:     "domain-services",
+		ObjectStoreName:        "object-store",
 		NewServerWrapperWorker: NewServerWrapperWorker,
 		NewServerWorker: func(ServerWorkerConfig) (worker.Worker, error) {
 			return workertest.NewErrorWorker(nil), nil
@@ -106,15 +122,18 @@ func (s *manifoldSuite) TestManifoldStart(c *tc.C) {
 			return s.controllerConfigService, nil
 		},
 		Logger: loggertesting.WrapCheckLog(c),
+		Clock:  clock.WallClock,
 	})
 
 	// Check the inputs are as expected
-	c.Assert(manifold.Inputs, tc.DeepEquals, []string{"domain-services"})
+	c.Assert(manifold.Inputs, tc.DeepEquals, []string{"domain-services", "object-store"})
 
 	// Start the worker
 	result, err := manifold.Start(
 		c.Context(),
-		dt.StubGetter(map[string]interface{}{}),
+		dt.StubGetter(map[string]interface{}{
+			"object-store": s.objectStoreGetter,
+		}),
 	)
 	c.Assert(err, tc.ErrorIsNil)
 	defer workertest.DirtyKill(c, result)
@@ -127,6 +146,7 @@ func (s *manifoldSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.objectStoreGetter = NewMockObjectStoreGetter(ctrl)
 
 	s.controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).DoAndReturn(func(context.Context) (watcher.Watcher[[]string], error) {
 		return watchertest.NewMockStringsWatcher(make(<-chan []string)), nil
@@ -137,6 +157,7 @@ func (s *manifoldSuite) setupMocks(c *tc.C) *gomock.Controller {
 func (s *manifoldSuite) newManifoldConfig(c *tc.C, modifier func(cfg *ManifoldConfig)) *ManifoldConfig {
 	cfg := &ManifoldConfig{
 		DomainServicesName: "domain-services",
+		ObjectStoreName:    "object-store",
 		NewServerWrapperWorker: func(ServerWrapperWorkerConfig) (worker.Worker, error) {
 			return nil, nil
 		},
@@ -147,6 +168,7 @@ func (s *manifoldSuite) newManifoldConfig(c *tc.C, modifier func(cfg *ManifoldCo
 			return s.controllerConfigService, nil
 		},
 		Logger: loggertesting.WrapCheckLog(c),
+		Clock:  clock.WallClock,
 	}
 
 	modifier(cfg)
@@ -164,6 +186,7 @@ func (s *manifoldSuite) TestManifoldUninstall(c *tc.C) {
 	// Setup the manifold
 	manifold := Manifold(ManifoldConfig{
 		DomainServicesName:     "domain-services",
+		ObjectStoreName:        "object-store",
 		NewServerWrapperWorker: NewServerWrapperWorker,
 		NewServerWorker: func(ServerWorkerConfig) (worker.Worker, error) {
 			return workertest.NewErrorWorker(nil), nil
@@ -172,10 +195,11 @@ func (s *manifoldSuite) TestManifoldUninstall(c *tc.C) {
 			return s.controllerConfigService, nil
 		},
 		Logger: loggertesting.WrapCheckLog(c),
+		Clock:  clock.WallClock,
 	})
 
 	// Check the inputs are as expected
-	c.Assert(manifold.Inputs, tc.DeepEquals, []string{"domain-services"})
+	c.Assert(manifold.Inputs, tc.DeepEquals, []string{"domain-services", "object-store"})
 
 	// Start the worker
 	_, err := manifold.Start(
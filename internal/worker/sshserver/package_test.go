@@ -3,6 +3,7 @@
 
 package sshserver
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package sshserver -destination service_mock_test.go github.com/juju/juju/internal/worker/sshserver ControllerConfigService,SessionHandler
+//go:generate go run go.uber.org/mock/mockgen -typed -package sshserver -destination service_mock_test.go github.com/juju/juju/internal/worker/sshserver ControllerConfigService,SessionHandler,SessionRecordingStore
 //go:generate go run go.uber.org/mock/mockgen -package sshserver -destination listener_mock_test.go net Listener
 //go:generate go run go.uber.org/mock/mockgen -typed -package sshserver -destination session_mock_test.go github.com/juju/juju/internal/worker/sshserver SSHConnector
+//go:generate go run go.uber.org/mock/mockgen -typed -package sshserver -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStoreGetter,ObjectStore
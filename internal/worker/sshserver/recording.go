@@ -0,0 +1,264 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/sshrecording"
+	"github.com/juju/juju/core/virtualhostname"
+)
+
+// maxRecordingSize is the maximum size of a session recording. Output past
+// this size isn't recorded, so that a long running session can't exhaust
+// the disk of the controller.
+const maxRecordingSize = 32 * 1024 * 1024
+
+// truncatedMarkerSpace is the space reserved at the end of a recording for
+// the marker recording that it was truncated.
+const truncatedMarkerSpace = 128
+
+// truncatedMarker is the label of the marker event written to a recording
+// when it reaches the maximum size.
+const truncatedMarker = "recording truncated"
+
+// SessionRecordingStore stores the recordings of interactive sessions.
+type SessionRecordingStore interface {
+	// StoreRecording stores the recording of a session to the destination.
+	StoreRecording(ctx context.Context, destination virtualhostname.Info, key sshrecording.Key, r io.Reader, size int64) error
+}
+
+// NewObjectStoreRecordingStore returns a SessionRecordingStore that stores
+// recordings in the object store of the destination's model.
+func NewObjectStoreRecordingStore(objectStoreGetter objectstore.ObjectStoreGetter) SessionRecordingStore {
+	return &objectStoreRecordingStore{
+		objectStoreGetter: objectStoreGetter,
+	}
+}
+
+type objectStoreRecordingStore struct {
+	objectStoreGetter objectstore.ObjectStoreGetter
+}
+
+// StoreRecording stores the recording under the key's path, in the object
+// store of the destination's model.
+func (s *objectStoreRecordingStore) StoreRecording(ctx context.Context, destination virtualhostname.Info, key sshrecording.Key, r io.Reader, size int64) error {
+	store, err := s.objectStoreGetter.GetObjectStore(ctx, destination.ModelUUID())
+	if err != nil {
+		return errors.Annotatef(err, "getting object store for model %q", destination.ModelUUID())
+	}
+	if _, err := store.Put(ctx, key.Path(), r, size); err != nil {
+		return errors.Annotatef(err, "storing recording %q", key.Path())
+	}
+	return nil
+}
+
+// asciicastHeader is the first line of a recording in the asciicast v2
+// format, see https://docs.asciinema.org/manual/asciicast/v2/.
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// asciicastRecorder records the output of an interactive session in the
+// asciicast v2 format. The recording is spooled to a temporary file rather
+// than held in memory until the session ends.
+type asciicastRecorder struct {
+	clock   clock.Clock
+	started time.Time
+
+	mu        sync.Mutex
+	file      *os.File
+	size      int64
+	truncated bool
+	err       error
+}
+
+// newAsciicastRecorder returns a recorder, started now, for a session with
+// the given pty. The recording is spooled to a temporary file in dir, or in
+// the default directory for temporary files if dir is empty. The recorder
+// must be closed to remove the file.
+func newAsciicastRecorder(clock clock.Clock, pty ssh.Pty, dir string) (*asciicastRecorder, error) {
+	file, err := os.CreateTemp(dir, "ssh-recording-*"+sshrecording.Extension)
+	if err != nil {
+		return nil, errors.Annotate(err, "creating recording file")
+	}
+	r := &asciicastRecorder{
+		clock:   clock,
+		started: clock.Now(),
+		file:    file,
+	}
+
+	header := asciicastHeader{
+		Version:   2,
+		Width:     pty.Window.Width,
+		Height:    pty.Window.Height,
+		Timestamp: r.started.Unix(),
+	}
+	if pty.Term != "" {
+		header.Env = map[string]string{"TERM": pty.Term}
+	}
+	data, err := json.Marshal(header)
+	if err != nil {
+		_ = r.close()
+		return nil, errors.Trace(err)
+	}
+	if err := r.writeLine(data); err != nil {
+		_ = r.close()
+		return nil, errors.Trace(err)
+	}
+	return r, nil
+}
+
+// recordOutput records data written to the user's terminal.
+func (r *asciicastRecorder) recordOutput(p []byte) {
+	r.recordEvent("o", string(p))
+}
+
+// recordResize records a change to the size of the user's terminal.
+func (r *asciicastRecorder) recordResize(w ssh.Window) {
+	r.recordEvent("r", fmt.Sprintf("%dx%d", w.Width, w.Height))
+}
+
+// recordEvent records an event, unless the recording has reached its
+// maximum size. The first event that doesn't fit is replaced by a marker
+// event, so that the recording shows where it was truncated.
+func (r *asciicastRecorder) recordEvent(code, data string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.truncated || r.err != nil {
+		return
+	}
+
+	elapsed := r.clock.Now().Sub(r.started).Seconds()
+	event, err := json.Marshal([]any{elapsed, code, data})
+	if err != nil {
+		return
+	}
+	if r.size+int64(len(event))+1 > maxRecordingSize-truncatedMarkerSpace {
+		r.truncated = true
+		event, err = json.Marshal([]any{elapsed, "m", truncatedMarker})
+		if err != nil {
+			return
+		}
+	}
+	r.err = r.writeLine(event)
+}
+
+// writeLine appends a line to the recording file.
+func (r *asciicastRecorder) writeLine(line []byte) error {
+	n, err := r.file.Write(append(line, '\n'))
+	r.size += int64(n)
+	return errors.Annotate(err, "writing recording")
+}
+
+// recording returns a reader of the recording so far, or an error if the
+// recording couldn't be written.
+func (r *asciicastRecorder) recording() (*io.SectionReader, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return nil, r.err
+	}
+	return io.NewSectionReader(r.file, 0, r.size), nil
+}
+
+// close closes and removes the recording file.
+func (r *asciicastRecorder) close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	closeErr := r.file.Close()
+	if err := os.Remove(r.file.Name()); err != nil {
+		return errors.Annotate(err, "removing recording file")
+	}
+	return errors.Annotate(closeErr, "closing recording file")
+}
+
+// recordedSession is a session that records all the output written to the
+// user, along with any changes to the size of their terminal.
+type recordedSession struct {
+	ssh.Session
+	recorder *asciicastRecorder
+
+	ptyOnce   sync.Once
+	ptyWindow <-chan ssh.Window
+}
+
+func newRecordedSession(session ssh.Session, recorder *asciicastRecorder) *recordedSession {
+	return &recordedSession{
+		Session:  session,
+		recorder: recorder,
+	}
+}
+
+// Write writes to the user's stdout, recording the output.
+func (s *recordedSession) Write(p []byte) (int, error) {
+	n, err := s.Session.Write(p)
+	if n > 0 {
+		// The session normalizes \n to \r\n on stdout when a pty is accepted,
+		// the recording must match what the user's terminal received.
+		out := bytes.ReplaceAll(p[:n], []byte{'\n'}, []byte{'\r', '\n'})
+		out = bytes.ReplaceAll(out, []byte{'\r', '\r', '\n'}, []byte{'\r', '\n'})
+		s.recorder.recordOutput(out)
+	}
+	return n, err
+}
+
+// Stderr returns the user's stderr, recording the output.
+func (s *recordedSession) Stderr() io.ReadWriter {
+	return &recordedReadWriter{
+		ReadWriter: s.Session.Stderr(),
+		recorder:   s.recorder,
+	}
+}
+
+// Pty returns the pty of the session, recording any window changes.
+func (s *recordedSession) Pty() (ssh.Pty, <-chan ssh.Window, bool) {
+	pty, windows, isPty := s.Session.Pty()
+	s.ptyOnce.Do(func() {
+		recorded := make(chan ssh.Window)
+		go func() {
+			defer close(recorded)
+			for w := range windows {
+				s.recorder.recordResize(w)
+				select {
+				case recorded <- w:
+				case <-s.Context().Done():
+					return
+				}
+			}
+		}()
+		s.ptyWindow = recorded
+	})
+	return pty, s.ptyWindow, isPty
+}
+
+type recordedReadWriter struct {
+	io.ReadWriter
+	recorder *asciicastRecorder
+}
+
+func (rw *recordedReadWriter) Write(p []byte) (int, error) {
+	n, err := rw.ReadWriter.Write(p)
+	if n > 0 {
+		rw.recorder.recordOutput(p[:n])
+	}
+	return n, err
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"go.uber.org/goleak"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/sshrecording"
+	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type recordingSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestRecordingSuite(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	tc.Run(t, &recordingSuite{})
+}
+
+func (s *recordingSuite) TestAsciicastRecorder(c *tc.C) {
+	clock := testclock.NewClock(time.Unix(1700000000, 0))
+	recorder, err := newAsciicastRecorder(clock, ssh.Pty{
+		Term:   "xterm",
+		Window: ssh.Window{Width: 80, Height: 24},
+	}, c.MkDir())
+	c.Assert(err, tc.ErrorIsNil)
+	defer recorder.close()
+
+	clock.Advance(500 * time.Millisecond)
+	recorder.recordOutput([]byte("hello\r\n"))
+	clock.Advance(time.Second)
+	recorder.recordResize(ssh.Window{Width: 120, Height: 40})
+
+	c.Check(readRecording(c, recorder), tc.Equals, `
+{"version":2,"width":80,"height":24,"timestamp":1700000000,"env":{"TERM":"xterm"}}
+[0.5,"o","hello\r\n"]
+[1.5,"r","120x40"]
+`[1:])
+}
+
+func (s *recordingSuite) TestAsciicastRecorderSpoolsToFile(c *tc.C) {
+	dir := c.MkDir()
+	recorder, err := newAsciicastRecorder(testclock.NewClock(time.Now()), ssh.Pty{}, dir)
+	c.Assert(err, tc.ErrorIsNil)
+
+	entries, err := os.ReadDir(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 1)
+
+	// Closing the recorder removes the file.
+	err = recorder.close()
+	c.Assert(err, tc.ErrorIsNil)
+	entries, err = os.ReadDir(dir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+func (s *recordingSuite) TestAsciicastRecorderTruncates(c *tc.C) {
+	clock := testclock.NewClock(time.Unix(1700000000, 0))
+	recorder, err := newAsciicastRecorder(clock, ssh.Pty{}, c.MkDir())
+	c.Assert(err, tc.ErrorIsNil)
+	defer recorder.close()
+
+	recorder.recordOutput([]byte("a"))
+	clock.Advance(time.Second)
+	recorder.recordOutput(bytes.Repeat([]byte("b"), maxRecordingSize))
+	recorder.recordOutput([]byte("c"))
+
+	// The output that doesn't fit is replaced by a marker,
+	// and nothing is recorded after it.
+	c.Check(readRecording(c, recorder), tc.Equals, `
+{"version":2,"width":0,"height":0,"timestamp":1700000000}
+[0,"o","a"]
+[1,"m","recording truncated"]
+`[1:])
+}
+
+func (s *recordingSuite) TestAsciicastRecorderTruncatesAtMaximumSize(c *tc.C) {
+	recorder, err := newAsciicastRecorder(testclock.NewClock(time.Now()), ssh.Pty{}, c.MkDir())
+	c.Assert(err, tc.ErrorIsNil)
+	defer recorder.close()
+
+	// Fill the recording with events until it is truncated.
+	chunk := strings.Repeat("a", 1024*1024)
+	for i := 0; i < maxRecordingSize/len(chunk)+1; i++ {
+		recorder.recordOutput([]byte(chunk))
+	}
+
+	recording, err := recorder.recording()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(recording.Size() <= maxRecordingSize, tc.IsTrue)
+	data, err := io.ReadAll(recording)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(strings.HasSuffix(string(data), `"m","recording truncated"]`+"\n"), tc.IsTrue)
+}
+
+func (s *recordingSuite) TestRecordedSession(c *tc.C) {
+	clock := testclock.NewClock(time.Unix(1700000000, 0))
+	recorder, err := newAsciicastRecorder(clock, ssh.Pty{}, c.MkDir())
+	c.Assert(err, tc.ErrorIsNil)
+	defer recorder.close()
+
+	userSession := &userSession{}
+	session := newRecordedSession(userSession, recorder)
+
+	_, err = io.WriteString(session, "out\n")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = io.WriteString(session.Stderr(), "err")
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(userSession.stdout.String(), tc.Equals, "out\n")
+	c.Check(userSession.stderr.String(), tc.Equals, "err")
+	c.Check(readRecording(c, recorder), tc.Equals, `
+{"version":2,"width":0,"height":0,"timestamp":1700000000}
+[0,"o","out\r\n"]
+[0,"o","err"]
+`[1:])
+}
+
+func (s *recordingSuite) TestObjectStoreRecordingStore(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	destination, err := virtualhostname.Parse("1.postgresql.8419cd78-4993-4c3a-928e-c646226beeee.juju.local")
+	c.Assert(err, tc.ErrorIsNil)
+	key := sshrecording.NewKey("bob", destination, time.Unix(1700000000, 0))
+
+	objectStore := NewMockObjectStore(ctrl)
+	objectStore.EXPECT().Put(gomock.Any(), key.Path(), gomock.Any(), int64(9)).DoAndReturn(
+		func(_ context.Context, _ string, r io.Reader, _ int64) (objectstore.UUID, error) {
+			data, err := io.ReadAll(r)
+			c.Check(err, tc.ErrorIsNil)
+			c.Check(string(data), tc.Equals, "recording")
+			return "", nil
+		},
+	)
+	objectStoreGetter := NewMockObjectStoreGetter(ctrl)
+	objectStoreGetter.EXPECT().GetObjectStore(gomock.Any(), "8419cd78-4993-4c3a-928e-c646226beeee").Return(objectStore, nil)
+
+	store := NewObjectStoreRecordingStore(objectStoreGetter)
+	err = store.StoreRecording(c.Context(), destination, key, strings.NewReader("recording"), 9)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// readRecording returns the recording so far.
+func readRecording(c *tc.C, recorder *asciicastRecorder) string {
+	recording, err := recorder.recording()
+	c.Assert(err, tc.ErrorIsNil)
+	data, err := io.ReadAll(recording)
+	c.Assert(err, tc.ErrorIsNil)
+	return string(data)
+}
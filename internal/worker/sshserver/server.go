@@ -13,12 +13,14 @@ import (
 	"time"
 
 	"github.com/gliderlabs/ssh"
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 	gossh "golang.org/x/crypto/ssh"
 	"gopkg.in/tomb.v2"
 
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/sshrecording"
 	"github.com/juju/juju/core/virtualhostname"
 )
 
@@ -33,6 +35,8 @@ type SessionHandler interface {
 type ServerWorkerConfig struct {
 	// Logger holds the logger for the server.
 	Logger logger.Logger
+	// Clock holds the clock used to time session recordings.
+	Clock clock.Clock
 	// Listener holds a listener to provide the server. Should you wish to run
 	// the server on a pre-existing listener, you can provide it here.
 	// Otherwise, leave this value nil and a listener will be spawned.
@@ -54,6 +58,10 @@ type ServerWorkerConfig struct {
 
 	// SessionHandler handles proxying SSH sessions to the target machine.
 	SessionHandler SessionHandler
+
+	// SessionRecordingStore, if set, stores recordings of the interactive
+	// sessions proxied by the server. Sessions aren't recorded otherwise.
+	SessionRecordingStore SessionRecordingStore
 }
 
 // Validate validates the workers configuration is as expected.
@@ -61,6 +69,9 @@ func (c ServerWorkerConfig) Validate() error {
 	if c.Logger == nil {
 		return errors.NotValidf("missing Logger")
 	}
+	if c.Clock == nil {
+		return errors.NotValidf("missing Clock")
+	}
 	if c.JumpHostKey == "" {
 		return errors.NotValidf("empty JumpHostKey")
 	}
@@ -273,6 +284,9 @@ func (s *ServerWorker) connCallback() ssh.ConnCallback {
 
 // newEmbeddedSSHServer creates a new embedded SSH server for the given context and model info.
 func (s *ServerWorker) newEmbeddedSSHServer(ctx ssh.Context, info virtualhostname.Info) (*ssh.Server, error) {
+	// The user of the jump server connection is the user that is recorded,
+	// not the user logging into the target machine.
+	user := ctx.User()
 
 	forwardHandler := &ssh.ForwardedTCPHandler{}
 	server := &ssh.Server{
@@ -295,7 +309,12 @@ func (s *ServerWorker) newEmbeddedSSHServer(ctx ssh.Context, info virtualhostnam
 			"cancel-tcpip-forward": forwardHandler.HandleSSHRequest,
 		},
 		Handler: func(session ssh.Session) {
-			s.config.SessionHandler.Handle(session, info)
+			s.handleSession(session, user, info)
+		},
+		SubsystemHandlers: map[string]ssh.SubsystemHandler{
+			"sftp": func(session ssh.Session) {
+				s.config.SessionHandler.Handle(session, info)
+			},
 		},
 	}
 
@@ -307,6 +326,42 @@ func (s *ServerWorker) newEmbeddedSSHServer(ctx ssh.Context, info virtualhostnam
 	return server, nil
 }
 
+// handleSession proxies the session to the destination, recording it if it's
+// an interactive session and a recording store is configured.
+func (s *ServerWorker) handleSession(session ssh.Session, user string, destination virtualhostname.Info) {
+	pty, _, isPty := session.Pty()
+	if s.config.SessionRecordingStore == nil || !isPty {
+		s.config.SessionHandler.Handle(session, destination)
+		return
+	}
+
+	recorder, err := newAsciicastRecorder(s.config.Clock, pty, "")
+	if err != nil {
+		s.config.Logger.Errorf(session.Context(), "failed to start recording session to %q: %v", destination, err)
+		s.config.SessionHandler.Handle(session, destination)
+		return
+	}
+	defer func() {
+		if err := recorder.close(); err != nil {
+			s.config.Logger.Warningf(session.Context(), "failed to clean up recording of session to %q: %v", destination, err)
+		}
+	}()
+	s.config.SessionHandler.Handle(newRecordedSession(session, recorder), destination)
+
+	// The session context is cancelled as soon as the connection is closed,
+	// the recording must be stored regardless.
+	ctx := context.WithoutCancel(session.Context())
+	recording, err := recorder.recording()
+	if err != nil {
+		s.config.Logger.Errorf(ctx, "failed to record session to %q: %v", destination, err)
+		return
+	}
+	key := sshrecording.NewKey(user, destination, recorder.started)
+	if err := s.config.SessionRecordingStore.StoreRecording(ctx, destination, key, recording, recording.Size()); err != nil {
+		s.config.Logger.Errorf(ctx, "failed to store recording of session to %q: %v", destination, err)
+	}
+}
+
 // Report returns a map of metrics from the server worker.
 func (s *ServerWorker) Report() map[string]any {
 	return map[string]any{
@@ -4,14 +4,18 @@
 package sshserver
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"fmt"
+	"io"
 	net "net"
 	"testing"
 	"time"
 
 	"github.com/gliderlabs/ssh"
+	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v4/workertest"
@@ -20,6 +24,7 @@ import (
 	gossh "golang.org/x/crypto/ssh"
 
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/sshrecording"
 	virtualhostname "github.com/juju/juju/core/virtualhostname"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testhelpers"
@@ -72,6 +77,7 @@ func newServerWorkerConfig(
 ) *ServerWorkerConfig {
 	cfg := &ServerWorkerConfig{
 		Logger:      l,
+		Clock:       clock.WallClock,
 		JumpHostKey: j,
 	}
 
@@ -92,6 +98,12 @@ func (s *sshServerSuite) TestValidate(c *tc.C) {
 	})
 	c.Assert(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 
+	// Test no Clock.
+	cfg = newServerWorkerConfig(l, "jumpHostKey", func(cfg *ServerWorkerConfig) {
+		cfg.Clock = nil
+	})
+	c.Assert(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
 	// Test no JumpHostKey.
 	cfg = newServerWorkerConfig(l, "jumpHostKey", func(cfg *ServerWorkerConfig) {
 		cfg.JumpHostKey = ""
@@ -110,6 +122,7 @@ func (s *sshServerSuite) TestSSHServer(c *tc.C) {
 
 	server, err := NewServerWorker(ServerWorkerConfig{
 		Logger:                   loggertesting.WrapCheckLog(c),
+		Clock:                    clock.WallClock,
 		Listener:                 listener,
 		JumpHostKey:              jujutesting.SSHServerHostKey,
 		MaxConcurrentConnections: maxConcurrentConnections,
@@ -186,6 +199,7 @@ func (s *sshServerSuite) TestSSHServerMaxConnections(c *tc.C) {
 
 	worker, err := NewServerWorker(ServerWorkerConfig{
 		Logger:                   loggertesting.WrapCheckLog(c),
+		Clock:                    clock.WallClock,
 		Listener:                 listener,
 		MaxConcurrentConnections: maxConcurrentConnections,
 		JumpHostKey:              jujutesting.SSHServerHostKey,
@@ -273,6 +287,7 @@ func (s *sshServerSuite) TestSSHWorkerReport(c *tc.C) {
 
 	worker, err := NewServerWorker(ServerWorkerConfig{
 		Logger:                   loggertesting.WrapCheckLog(c),
+		Clock:                    clock.WallClock,
 		Listener:                 listener,
 		MaxConcurrentConnections: maxConcurrentConnections,
 		JumpHostKey:              jujutesting.SSHServerHostKey,
@@ -303,3 +318,149 @@ func (s *sshServerSuite) TestSSHWorkerReport(c *tc.C) {
 		"concurrent_connections": int32(1),
 	})
 }
+
+func (s *sshServerSuite) TestSSHServerRecordsInteractiveSession(c *tc.C) {
+	ctrl := s.SetUpMocks(c)
+	defer ctrl.Finish()
+
+	recordingStore := NewMockSessionRecordingStore(ctrl)
+
+	endpoint := "@" + uuid.MustNewUUID().String()
+	listener, err := net.Listen("unix", endpoint)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = listener.Close() }()
+
+	started := time.Unix(1700000000, 0)
+	server, err := NewServerWorker(ServerWorkerConfig{
+		Logger:                   loggertesting.WrapCheckLog(c),
+		Clock:                    testclock.NewClock(started),
+		Listener:                 listener,
+		JumpHostKey:              jujutesting.SSHServerHostKey,
+		MaxConcurrentConnections: maxConcurrentConnections,
+		disableAuth:              true,
+		SessionHandler:           s.sessionHandler,
+		SessionRecordingStore:    recordingStore,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, server)
+
+	session := s.newTerminatingSession(c, endpoint, "bob")
+	c.Assert(session.RequestPty("xterm", 24, 80, gossh.TerminalModes{}), tc.ErrorIsNil)
+
+	s.sessionHandler.EXPECT().Handle(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(session ssh.Session, destination virtualhostname.Info) {
+			_, _ = io.WriteString(session, "hello from the unit\n")
+		},
+	)
+	recorded := make(chan string, 1)
+	recordingStore.EXPECT().StoreRecording(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, destination virtualhostname.Info, key sshrecording.Key, r io.Reader, size int64) error {
+			c.Check(destination.String(), tc.Equals, testVirtualHostname)
+			c.Check(key.User, tc.Equals, "bob")
+			c.Check(key.Target, tc.Equals, "unit-postgresql-1")
+			c.Check(key.Started.Equal(started), tc.IsTrue)
+			data, err := io.ReadAll(r)
+			c.Check(err, tc.ErrorIsNil)
+			c.Check(int64(len(data)), tc.Equals, size)
+			recorded <- string(data)
+			return nil
+		},
+	)
+
+	output, err := session.Output("")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(output), tc.Equals, "hello from the unit\r\n")
+
+	select {
+	case recording := <-recorded:
+		c.Check(recording, tc.Matches, `(?s)\{"version":2,"width":80,"height":24,.*"env":\{"TERM":"xterm"\}\}\n\[[0-9.e-]+,"o","hello from the unit\\r\\n"\]\n`)
+	case <-time.After(jujutesting.LongWait):
+		c.Fatal("timed out waiting for the recording")
+	}
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *sshServerSuite) TestSSHServerSFTPSubsystem(c *tc.C) {
+	ctrl := s.SetUpMocks(c)
+	defer ctrl.Finish()
+
+	// Non interactive sessions aren't recorded, so the store must not be
+	// called.
+	recordingStore := NewMockSessionRecordingStore(ctrl)
+
+	endpoint := "@" + uuid.MustNewUUID().String()
+	listener, err := net.Listen("unix", endpoint)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = listener.Close() }()
+
+	server, err := NewServerWorker(ServerWorkerConfig{
+		Logger:                   loggertesting.WrapCheckLog(c),
+		Clock:                    clock.WallClock,
+		Listener:                 listener,
+		JumpHostKey:              jujutesting.SSHServerHostKey,
+		MaxConcurrentConnections: maxConcurrentConnections,
+		disableAuth:              true,
+		SessionHandler:           s.sessionHandler,
+		SessionRecordingStore:    recordingStore,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, server)
+
+	session := s.newTerminatingSession(c, endpoint, "bob")
+	stdout, err := session.StdoutPipe()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.sessionHandler.EXPECT().Handle(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(session ssh.Session, destination virtualhostname.Info) {
+			c.Check(session.Subsystem(), tc.Equals, "sftp")
+			c.Check(destination.String(), tc.Equals, testVirtualHostname)
+			_, _ = io.WriteString(session, "sftp from the unit")
+		},
+	)
+
+	c.Assert(session.RequestSubsystem("sftp"), tc.ErrorIsNil)
+	output, err := io.ReadAll(stdout)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(output), tc.Equals, "sftp from the unit")
+
+	workertest.CleanKill(c, server)
+}
+
+// newTerminatingSession opens a session to the test virtual hostname, by
+// the given user, through the jump server listening on the endpoint.
+func (s *sshServerSuite) newTerminatingSession(c *tc.C, endpoint, user string) *gossh.Session {
+	conn, err := net.Dial("unix", endpoint)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Cleanup(func() { _ = conn.Close() })
+
+	jumpConn, chans, reqs, err := gossh.NewClientConn(conn, "", &gossh.ClientConfig{
+		User:            user,
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+		Auth: []gossh.AuthMethod{
+			gossh.Password(""),
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	client := gossh.NewClient(jumpConn, chans, reqs)
+	tunnel, err := client.Dial("tcp", fmt.Sprintf("%s:0", testVirtualHostname))
+	c.Assert(err, tc.ErrorIsNil)
+
+	terminatingConn, terminatingChans, terminatingReqs, err := gossh.NewClientConn(
+		tunnel,
+		"",
+		&gossh.ClientConfig{
+			User:            "ubuntu",
+			HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+			Auth: []gossh.AuthMethod{
+				gossh.PublicKeys(s.userSigner),
+			},
+		})
+	c.Assert(err, tc.ErrorIsNil)
+
+	terminatingClient := gossh.NewClient(terminatingConn, terminatingChans, terminatingReqs)
+	session, err := terminatingClient.NewSession()
+	c.Assert(err, tc.ErrorIsNil)
+	return session
+}
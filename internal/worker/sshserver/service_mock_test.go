@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/sshserver (interfaces: ControllerConfigService,SessionHandler)
+// Source: github.com/juju/juju/internal/worker/sshserver (interfaces: ControllerConfigService,SessionHandler,SessionRecordingStore)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package sshserver -destination service_mock_test.go github.com/juju/juju/internal/worker/sshserver ControllerConfigService,SessionHandler
+//	mockgen -typed -package sshserver -destination service_mock_test.go github.com/juju/juju/internal/worker/sshserver ControllerConfigService,SessionHandler,SessionRecordingStore
 //
 
 // Package sshserver is a generated GoMock package.
@@ -11,10 +11,12 @@ package sshserver
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	ssh "github.com/gliderlabs/ssh"
 	controller "github.com/juju/juju/controller"
+	sshrecording "github.com/juju/juju/core/sshrecording"
 	virtualhostname "github.com/juju/juju/core/virtualhostname"
 	watcher "github.com/juju/juju/core/watcher"
 	gomock "go.uber.org/mock/gomock"
@@ -179,3 +181,64 @@ func (c *MockSessionHandlerHandleCall) DoAndReturn(f func(ssh.Session, virtualho
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSessionRecordingStore is a mock of SessionRecordingStore interface.
+type MockSessionRecordingStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRecordingStoreMockRecorder
+}
+
+// MockSessionRecordingStoreMockRecorder is the mock recorder for MockSessionRecordingStore.
+type MockSessionRecordingStoreMockRecorder struct {
+	mock *MockSessionRecordingStore
+}
+
+// NewMockSessionRecordingStore creates a new mock instance.
+func NewMockSessionRecordingStore(ctrl *gomock.Controller) *MockSessionRecordingStore {
+	mock := &MockSessionRecordingStore{ctrl: ctrl}
+	mock.recorder = &MockSessionRecordingStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRecordingStore) EXPECT() *MockSessionRecordingStoreMockRecorder {
+	return m.recorder
+}
+
+// StoreRecording mocks base method.
+func (m *MockSessionRecordingStore) StoreRecording(arg0 context.Context, arg1 virtualhostname.Info, arg2 sshrecording.Key, arg3 io.Reader, arg4 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreRecording", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreRecording indicates an expected call of StoreRecording.
+func (mr *MockSessionRecordingStoreMockRecorder) StoreRecording(arg0, arg1, arg2, arg3, arg4 any) *MockSessionRecordingStoreStoreRecordingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRecording", reflect.TypeOf((*MockSessionRecordingStore)(nil).StoreRecording), arg0, arg1, arg2, arg3, arg4)
+	return &MockSessionRecordingStoreStoreRecordingCall{Call: call}
+}
+
+// MockSessionRecordingStoreStoreRecordingCall wrap *gomock.Call
+type MockSessionRecordingStoreStoreRecordingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSessionRecordingStoreStoreRecordingCall) Return(arg0 error) *MockSessionRecordingStoreStoreRecordingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSessionRecordingStoreStoreRecordingCall) Do(f func(context.Context, virtualhostname.Info, sshrecording.Key, io.Reader, int64) error) *MockSessionRecordingStoreStoreRecordingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSessionRecordingStoreStoreRecordingCall) DoAndReturn(f func(context.Context, virtualhostname.Info, sshrecording.Key, io.Reader, int64) error) *MockSessionRecordingStoreStoreRecordingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -5,6 +5,8 @@ package sshserver
 
 import (
 	"context"
+	"encoding/binary"
+	"io"
 
 	"github.com/gliderlabs/ssh"
 	"github.com/juju/errors"
@@ -72,6 +74,10 @@ func (s *sessionHandler) machineSessionProxy(userSession ssh.Session, destinatio
 	}
 	defer client.Close()
 
+	if subsystem := userSession.Subsystem(); subsystem != "" {
+		return s.subsystemProxy(userSession, client, subsystem)
+	}
+
 	machineSSHSession, err := client.NewSession()
 	if err != nil {
 		return err
@@ -118,3 +124,62 @@ func (*sessionHandler) setupShellOrCommand(userSession ssh.Session, machineSSHSe
 	}
 	return nil
 }
+
+// subsystemProxy requests the subsystem (i.e. sftp) on the machine and pipes
+// the data between the user and the machine without interpreting it. Once the
+// subsystem exits, its exit status is reported to the user.
+// The subsystem is requested on a raw session channel, as a [gossh.Session]
+// can't be waited on after requesting a subsystem.
+func (*sessionHandler) subsystemProxy(userSession ssh.Session, client *gossh.Client, subsystem string) error {
+	channel, reqs, err := client.OpenChannel("session", nil)
+	if err != nil {
+		return err
+	}
+	defer channel.Close()
+
+	// The machine reports the exit status of the subsystem on the
+	// channel's requests, which are closed when the channel is.
+	exitStatus := make(chan int, 1)
+	go func() {
+		status := -1
+		for req := range reqs {
+			if req.Type == "exit-status" && len(req.Payload) >= 4 {
+				status = int(binary.BigEndian.Uint32(req.Payload))
+			}
+			if req.WantReply {
+				_ = req.Reply(false, nil)
+			}
+		}
+		exitStatus <- status
+	}()
+
+	ok, err := channel.SendRequest("subsystem", true, gossh.Marshal(&struct {
+		Subsystem string
+	}{Subsystem: subsystem}))
+	if err != nil {
+		return err
+	} else if !ok {
+		return errors.Errorf("subsystem %q request failed", subsystem)
+	}
+
+	go func() {
+		_, _ = io.Copy(channel, userSession)
+		_ = channel.CloseWrite()
+	}()
+	stderrDone := make(chan struct{})
+	go func() {
+		defer close(stderrDone)
+		_, _ = io.Copy(userSession.Stderr(), channel.Stderr())
+	}()
+
+	if _, err := io.Copy(userSession, channel); err != nil {
+		return err
+	}
+	<-stderrDone
+
+	status := <-exitStatus
+	if status < 0 {
+		return errors.Errorf("subsystem %q exited without an exit status", subsystem)
+	}
+	return userSession.Exit(status)
+}
@@ -57,6 +57,17 @@ func startTestServer(_ *tc.C) *testServer {
 				_, _ = io.WriteString(session.Stderr(), "An error from the server!\n")
 			}
 		},
+		SubsystemHandlers: map[string]ssh.SubsystemHandler{
+			"sftp": func(session ssh.Session) {
+				ts.serverRx, _ = io.ReadAll(session)
+				_, _ = io.WriteString(session, "Hello from the sftp server!\n")
+			},
+			"broken-sftp": func(session ssh.Session) {
+				ts.serverRx, _ = io.ReadAll(session)
+				_, _ = io.WriteString(session.Stderr(), "An error from the sftp server!\n")
+				_ = session.Exit(3)
+			},
+		},
 	}
 	ts.listener = bufconn.Listen(1024)
 	go func() {
@@ -72,6 +83,7 @@ type userSession struct {
 	stderr        bytes.Buffer
 	isPty         bool
 	clientCommand string
+	subsystem     string
 	exitCode      int
 }
 
@@ -98,6 +110,10 @@ func (u *userSession) RawCommand() string {
 	return u.clientCommand
 }
 
+func (u *userSession) Subsystem() string {
+	return u.subsystem
+}
+
 func (u *userSession) Exit(code int) error {
 	u.exitCode = code
 	return nil
@@ -209,6 +225,84 @@ func (s *machineSessionSuite) TestMachineCommandProxy(c *tc.C) {
 	c.Check(string(testServer.serverRx), tc.Equals, "neovim")
 }
 
+func (s *machineSessionSuite) TestMachineSubsystemProxy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.userSession = &userSession{
+		subsystem: "sftp",
+	}
+	s.userSession.stdin.Write([]byte("sftp packets"))
+
+	testServer := startTestServer(c)
+	defer testServer.listener.Close()
+
+	conn, err := testServer.listener.Dial()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.mockConnector.EXPECT().Connect(gomock.Any()).DoAndReturn(
+		func(destination virtualhostname.Info) (*gossh.Client, error) {
+			sshConn, newChan, reqs, err := gossh.NewClientConn(conn, "", &gossh.ClientConfig{
+				HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return gossh.NewClient(sshConn, newChan, reqs), nil
+		},
+	)
+
+	sessionHandler := sessionHandler{
+		connector: s.mockConnector,
+		modelType: state.ModelTypeIAAS,
+	}
+
+	err = sessionHandler.machineSessionProxy(s.userSession, virtualhostname.Info{})
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(s.userSession.stdout.String(), tc.Equals, "Hello from the sftp server!\n")
+	c.Check(string(testServer.serverRx), tc.Equals, "sftp packets")
+	c.Check(s.userSession.exitCode, tc.Equals, 0)
+}
+
+func (s *machineSessionSuite) TestMachineSubsystemProxyExitStatus(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.userSession = &userSession{
+		subsystem: "broken-sftp",
+		exitCode:  -1,
+	}
+	s.userSession.stdin.Write([]byte("sftp packets"))
+
+	testServer := startTestServer(c)
+	defer testServer.listener.Close()
+
+	conn, err := testServer.listener.Dial()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.mockConnector.EXPECT().Connect(gomock.Any()).DoAndReturn(
+		func(destination virtualhostname.Info) (*gossh.Client, error) {
+			sshConn, newChan, reqs, err := gossh.NewClientConn(conn, "", &gossh.ClientConfig{
+				HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return gossh.NewClient(sshConn, newChan, reqs), nil
+		},
+	)
+
+	sessionHandler := sessionHandler{
+		connector: s.mockConnector,
+		modelType: state.ModelTypeIAAS,
+	}
+
+	// The subsystem's stderr is copied in full before its exit status is
+	// reported to the user.
+	err = sessionHandler.machineSessionProxy(s.userSession, virtualhostname.Info{})
+	c.Check(err, tc.ErrorIsNil)
+	c.Check(s.userSession.stderr.String(), tc.Equals, "An error from the sftp server!\n")
+	c.Check(s.userSession.exitCode, tc.Equals, 3)
+}
+
 func (s *machineSessionSuite) TestConnectToMachineError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
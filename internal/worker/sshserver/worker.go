@@ -7,12 +7,14 @@ import (
 	"context"
 	"sync"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/catacomb"
 
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/watcher"
 )
 
@@ -47,7 +49,9 @@ type ServerWrapperWorkerConfig struct {
 	ControllerConfigService ControllerConfigService
 	NewServerWorker         func(ServerWorkerConfig) (worker.Worker, error)
 	Logger                  logger.Logger
+	Clock                   clock.Clock
 	SessionHandler          SessionHandler
+	ObjectStoreGetter       objectstore.ObjectStoreGetter
 }
 
 // Validate validates the workers configuration is as expected.
@@ -61,9 +65,15 @@ func (c ServerWrapperWorkerConfig) Validate() error {
 	if c.Logger == nil {
 		return errors.NotValidf("Logger is required")
 	}
+	if c.Clock == nil {
+		return errors.NotValidf("Clock is required")
+	}
 	if c.SessionHandler == nil {
 		return errors.NotValidf("SessionHandler is required")
 	}
+	if c.ObjectStoreGetter == nil {
+		return errors.NotValidf("ObjectStoreGetter is required")
+	}
 	return nil
 }
 
@@ -85,7 +95,7 @@ type serverWrapperWorker struct {
 
 // NewServerWrapperWorker returns a new worker that runs an ssh server worker internally.
 // This worker will listen for changes in the controller configuration and restart the
-// server worker when the port or max concurrent connections changes.
+// server worker when the port, max concurrent connections or session recording changes.
 func NewServerWrapperWorker(config ServerWrapperWorkerConfig) (worker.Worker, error) {
 	if err := config.Validate(); err != nil {
 		return nil, errors.Trace(err)
@@ -154,14 +164,21 @@ func (ssw *serverWrapperWorker) loop() error {
 
 	port := config.SSHServerPort()
 	maxConns := config.SSHMaxConcurrentConnections()
+	recording := config.SSHSessionRecording()
 
-	srv, err := ssw.config.NewServerWorker(ServerWorkerConfig{
+	serverConfig := ServerWorkerConfig{
 		Logger:                   ssw.config.Logger,
+		Clock:                    ssw.config.Clock,
 		JumpHostKey:              temporaryJumpHostKey,
 		Port:                     port,
 		MaxConcurrentConnections: maxConns,
 		SessionHandler:           ssw.config.SessionHandler,
-	})
+	}
+	if recording {
+		serverConfig.SessionRecordingStore = NewObjectStoreRecordingStore(ssw.config.ObjectStoreGetter)
+	}
+
+	srv, err := ssw.config.NewServerWorker(serverConfig)
 	ssw.addWorkerReporter("ssh-server", srv)
 	if err != nil {
 		return errors.Trace(err)
@@ -180,7 +197,8 @@ func (ssw *serverWrapperWorker) loop() error {
 			if err != nil {
 				return errors.Trace(err)
 			}
-			if maxConns == config.SSHMaxConcurrentConnections() {
+			if maxConns == config.SSHMaxConcurrentConnections() &&
+				recording == config.SSHSessionRecording() {
 				ssw.config.Logger.Debugf(context.Background(), "controller configuration changed, but nothing changed for the ssh server.")
 				continue
 			}
@@ -7,6 +7,7 @@ import (
 	"sync/atomic"
 	"testing"
 
+	"github.com/juju/clock"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/workertest"
@@ -35,7 +36,9 @@ func newServerWrapperWorkerConfig(
 		NewServerWorker:         func(ServerWorkerConfig) (worker.Worker, error) { return nil, nil },
 		ControllerConfigService: NewMockControllerConfigService(ctrl),
 		Logger:                  loggertesting.WrapCheckLog(c),
+		Clock:                   clock.WallClock,
 		SessionHandler:          &MockSessionHandler{},
+		ObjectStoreGetter:       NewMockObjectStoreGetter(ctrl),
 	}
 
 	modifier(cfg)
@@ -60,6 +63,16 @@ func (s *workerSuite) TestValidate(c *tc.C) {
 	)
 	c.Assert(cfg.Validate(), tc.ErrorMatches, ".*is required.*")
 
+	// Test no Clock.
+	cfg = newServerWrapperWorkerConfig(
+		c,
+		ctrl,
+		func(cfg *ServerWrapperWorkerConfig) {
+			cfg.Clock = nil
+		},
+	)
+	c.Assert(cfg.Validate(), tc.ErrorMatches, ".*is required.*")
+
 	// Test no NewServerWorker.
 	cfg = newServerWrapperWorkerConfig(
 		c,
@@ -79,6 +92,16 @@ func (s *workerSuite) TestValidate(c *tc.C) {
 		},
 	)
 	c.Assert(cfg.Validate(), tc.ErrorMatches, ".*is required.*")
+
+	// Test no ObjectStoreGetter.
+	cfg = newServerWrapperWorkerConfig(
+		c,
+		ctrl,
+		func(cfg *ServerWrapperWorkerConfig) {
+			cfg.ObjectStoreGetter = nil
+		},
+	)
+	c.Assert(cfg.Validate(), tc.ErrorMatches, ".*is required.*")
 }
 
 func (s *workerSuite) TestSSHServerWrapperWorkerCanBeKilled(c *tc.C) {
@@ -105,10 +128,12 @@ func (s *workerSuite) TestSSHServerWrapperWorkerCanBeKilled(c *tc.C) {
 	cfg := ServerWrapperWorkerConfig{
 		ControllerConfigService: controllerConfigService,
 		Logger:                  loggertesting.WrapCheckLog(c),
+		Clock:                   clock.WallClock,
 		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
 			return serverWorker, nil
 		},
-		SessionHandler: &stubSessionHandler{},
+		SessionHandler:    &stubSessionHandler{},
+		ObjectStoreGetter: NewMockObjectStoreGetter(ctrl),
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, tc.ErrorIsNil)
@@ -181,12 +206,14 @@ func (s *workerSuite) TestSSHServerWrapperWorkerRestartsServerWorker(c *tc.C) {
 	cfg := ServerWrapperWorkerConfig{
 		ControllerConfigService: controllerConfigService,
 		Logger:                  loggertesting.WrapCheckLog(c),
+		Clock:                   clock.WallClock,
 		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
 			atomic.StoreInt32(&serverStarted, 1)
 			c.Check(swc.Port, tc.Equals, 22)
 			return serverWorker, nil
 		},
-		SessionHandler: &stubSessionHandler{},
+		SessionHandler:    &stubSessionHandler{},
+		ObjectStoreGetter: NewMockObjectStoreGetter(ctrl),
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, tc.ErrorIsNil)
@@ -216,6 +243,68 @@ func (s *workerSuite) TestSSHServerWrapperWorkerRestartsServerWorker(c *tc.C) {
 	c.Check(workertest.CheckKilled(c, controllerConfigWatcher), tc.ErrorIsNil)
 }
 
+func (s *workerSuite) TestSSHServerWrapperWorkerSessionRecording(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	serverWorker := workertest.NewErrorWorker(nil)
+	defer workertest.DirtyKill(c, serverWorker)
+
+	ch := make(chan []string)
+	controllerConfigWatcher := watchertest.NewMockStringsWatcher(ch)
+	defer workertest.DirtyKill(c, controllerConfigWatcher)
+
+	controllerConfigService := NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).Return(controllerConfigWatcher, nil)
+
+	// Session recording is enabled on worker startup.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHSessionRecording:         true,
+			},
+			nil,
+		).
+		Times(1)
+	// Disabling session recording should restart the worker.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHSessionRecording:         false,
+			},
+			nil,
+		).
+		Times(1)
+
+	cfg := ServerWrapperWorkerConfig{
+		ControllerConfigService: controllerConfigService,
+		Logger:                  loggertesting.WrapCheckLog(c),
+		Clock:                   clock.WallClock,
+		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
+			c.Check(swc.SessionRecordingStore, tc.NotNil)
+			return serverWorker, nil
+		},
+		SessionHandler:    &stubSessionHandler{},
+		ObjectStoreGetter: NewMockObjectStoreGetter(ctrl),
+	}
+	w, err := NewServerWrapperWorker(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	workertest.CheckAlive(c, w)
+
+	ch <- nil
+
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, tc.ErrorMatches, "changes detected, stopping SSH server worker")
+}
+
 func (s *workerSuite) TestWrapperWorkerReport(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -245,10 +334,12 @@ func (s *workerSuite) TestWrapperWorkerReport(c *tc.C) {
 	cfg := ServerWrapperWorkerConfig{
 		ControllerConfigService: controllerConfigService,
 		Logger:                  loggertesting.WrapCheckLog(c),
+		Clock:                   clock.WallClock,
 		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
 			return &reportWorker{serverWorker}, nil
 		},
-		SessionHandler: &stubSessionHandler{},
+		SessionHandler:    &stubSessionHandler{},
+		ObjectStoreGetter: NewMockObjectStoreGetter(ctrl),
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, tc.ErrorIsNil)
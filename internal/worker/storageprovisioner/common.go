@@ -132,7 +132,7 @@ func setStatus(ctx context.Context, deps *dependencies, statuses []params.Entity
 var errNonDynamic = errors.New("non-dynamic storage provider")
 
 // volumeSource returns a volume source given a name, provider type,
-// environment config and storage directory.
+// storage pool attributes and storage directory.
 //
 // TODO(axw) move this to the main storageprovisioner, and have
 // it watch for changes to storage source configurations, updating
@@ -140,11 +140,13 @@ var errNonDynamic = errors.New("non-dynamic storage provider")
 // event handlers.
 func volumeSource(
 	baseStorageDir string,
+	modelUUID string,
 	sourceName string,
 	providerType storage.ProviderType,
+	attributes map[string]interface{},
 	registry storage.ProviderRegistry,
 ) (storage.VolumeSource, error) {
-	provider, sourceConfig, err := sourceParams(baseStorageDir, sourceName, providerType, registry)
+	provider, sourceConfig, err := sourceParams(baseStorageDir, modelUUID, sourceName, providerType, attributes, registry)
 	if err != nil {
 		return nil, errors.Annotatef(err, "getting storage source %q params", sourceName)
 	}
@@ -167,11 +169,12 @@ func volumeSource(
 // event handlers.
 func filesystemSource(
 	baseStorageDir string,
+	modelUUID string,
 	sourceName string,
 	providerType storage.ProviderType,
 	registry storage.ProviderRegistry,
 ) (storage.FilesystemSource, error) {
-	provider, sourceConfig, err := sourceParams(baseStorageDir, sourceName, providerType, registry)
+	provider, sourceConfig, err := sourceParams(baseStorageDir, modelUUID, sourceName, providerType, nil, registry)
 	if err != nil {
 		return nil, errors.Annotatef(err, "getting storage source %q params", sourceName)
 	}
@@ -182,21 +185,35 @@ func filesystemSource(
 	return source, nil
 }
 
+// sourceParams returns the storage provider and the configuration of a
+// storage source. The source is configured with the attributes of the
+// storage pool it's for, if any.
 func sourceParams(
 	baseStorageDir string,
+	modelUUID string,
 	sourceName string,
 	providerType storage.ProviderType,
+	attributes map[string]interface{},
 	registry storage.ProviderRegistry,
 ) (storage.Provider, *storage.Config, error) {
 	provider, err := registry.StorageProvider(providerType)
 	if err != nil {
 		return nil, nil, errors.Annotate(err, "getting provider")
 	}
-	attrs := make(map[string]interface{})
+	attrs := make(map[string]interface{}, len(attributes)+2)
+	for k, v := range attributes {
+		attrs[k] = v
+	}
 	if baseStorageDir != "" {
-		storageDir := filepath.Join(baseStorageDir, sourceName)
+		// The storage directory is named after the provider type
+		// rather than the pool, so that sources of all pools of a
+		// provider find the same existing storage.
+		storageDir := filepath.Join(baseStorageDir, string(providerType))
 		attrs[storage.ConfigStorageDir] = storageDir
 	}
+	if modelUUID != "" {
+		attrs[storage.ConfigModelUUID] = modelUUID
+	}
 	sourceConfig, err := storage.NewConfig(sourceName, providerType, attrs)
 	if err != nil {
 		return nil, nil, errors.Annotate(err, "getting config")
@@ -204,6 +221,17 @@ func sourceParams(
 	return provider, sourceConfig, nil
 }
 
+// volumeSourceName returns the name of the volume source for volumes
+// allocated from the named storage pool with the specified provider.
+// Volumes allocated directly from a provider share a source named after
+// the provider type.
+func volumeSourceName(pool string, providerType storage.ProviderType) string {
+	if pool == "" {
+		return string(providerType)
+	}
+	return pool
+}
+
 func copyMachineStorageIds(src []watcher.MachineStorageID) []params.MachineStorageId {
 	dst := make([]params.MachineStorageId, len(src))
 	for i, msid := range src {
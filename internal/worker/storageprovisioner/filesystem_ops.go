@@ -24,6 +24,7 @@ func createFilesystems(ctx context.Context, deps *dependencies, ops map[names.Fi
 	}
 	paramsBySource, filesystemSources, err := filesystemParamsBySource(
 		deps.config.StorageDir,
+		deps.config.Model.Id(),
 		filesystemParams,
 		deps.managedFilesystemSource,
 		deps.config.Registry,
@@ -128,6 +129,7 @@ func attachFilesystems(ctx context.Context, deps *dependencies, ops map[params.M
 	}
 	paramsBySource, filesystemSources, err := filesystemAttachmentParamsBySource(
 		deps.config.StorageDir,
+		deps.config.Model.Id(),
 		filesystemAttachmentParams,
 		deps.filesystems,
 		deps.managedFilesystemSource,
@@ -207,6 +209,7 @@ func removeFilesystems(ctx context.Context, deps *dependencies, ops map[names.Fi
 	}
 	paramsBySource, filesystemSources, err := filesystemParamsBySource(
 		deps.config.StorageDir,
+		deps.config.Model.Id(),
 		filesystemParams,
 		deps.managedFilesystemSource,
 		deps.config.Registry,
@@ -298,6 +301,7 @@ func detachFilesystems(ctx context.Context, deps *dependencies, ops map[params.M
 	}
 	paramsBySource, filesystemSources, err := filesystemAttachmentParamsBySource(
 		deps.config.StorageDir,
+		deps.config.Model.Id(),
 		filesystemAttachmentParams,
 		deps.filesystems,
 		deps.managedFilesystemSource,
@@ -366,6 +370,7 @@ func detachFilesystems(ctx context.Context, deps *dependencies, ops map[params.M
 // filesystemParamsBySource separates the filesystem parameters by filesystem source.
 func filesystemParamsBySource(
 	baseStorageDir string,
+	modelUUID string,
 	params []storage.FilesystemParams,
 	managedFilesystemSource storage.FilesystemSource,
 	registry storage.ProviderRegistry,
@@ -385,7 +390,7 @@ func filesystemParamsBySource(
 			continue
 		}
 		filesystemSource, err := filesystemSource(
-			baseStorageDir, sourceName, params.Provider, registry,
+			baseStorageDir, modelUUID, sourceName, params.Provider, registry,
 		)
 		// For k8s models, there may be a not found error as there's only
 		// one (model) storage provisioner worker which reacts to all storage,
@@ -433,6 +438,7 @@ func validateFilesystemParams(
 // filesystemAttachmentParamsBySource separates the filesystem attachment parameters by filesystem source.
 func filesystemAttachmentParamsBySource(
 	baseStorageDir string,
+	modelUUID string,
 	filesystemAttachmentParams []storage.FilesystemAttachmentParams,
 	filesystems map[names.FilesystemTag]storage.Filesystem,
 	managedFilesystemSource storage.FilesystemSource,
@@ -456,7 +462,7 @@ func filesystemAttachmentParamsBySource(
 			continue
 		}
 		filesystemSource, err := filesystemSource(
-			baseStorageDir, sourceName, params.Provider, registry,
+			baseStorageDir, modelUUID, sourceName, params.Provider, registry,
 		)
 		// For k8s models, there may be a not found error as there's only
 		// one (model) storage provisioner worker which reacts to all storage,
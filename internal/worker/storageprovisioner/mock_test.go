@@ -139,6 +139,8 @@ type mockVolumeAccessor struct {
 	volumesWatcher         *mockStringsWatcher
 	attachmentsWatcher     *mockAttachmentsWatcher
 	attachmentPlansWatcher *mockAttachmentPlansWatcher
+	resizesWatcher         *mockStringsWatcher
+	snapshotsWatcher       *mockNotifyWatcher
 	blockDevicesWatcher    *mockNotifyWatcher
 	provisionedMachines    map[string]instance.Id
 	provisionedVolumes     map[string]params.Volume
@@ -148,6 +150,30 @@ type mockVolumeAccessor struct {
 	setVolumeInfo               func([]params.Volume) ([]params.ErrorResult, error)
 	setVolumeAttachmentInfo     func([]params.VolumeAttachment) ([]params.ErrorResult, error)
 	createVolumeAttachmentPlans func([]params.VolumeAttachmentPlan) ([]params.ErrorResult, error)
+	machineStorageSnapshots     func() ([]params.MachineStorageSnapshot, error)
+	setStorageSnapshotInfo      func([]params.StorageSnapshotInfo) ([]params.ErrorResult, error)
+	removeStorageSnapshots      func([]string) ([]params.ErrorResult, error)
+
+	// pool is the storage pool from which volumes are allocated. If it
+	// is nil, volumes are allocated directly from the dummy provider.
+	pool *mockStoragePool
+}
+
+// mockStoragePool describes a storage pool, as the facade does in the
+// parameters of the volumes allocated from it.
+type mockStoragePool struct {
+	name       string
+	provider   string
+	attributes map[string]interface{}
+}
+
+// volumePool returns the storage provider, pool name and pool
+// attributes of the volumes.
+func (v *mockVolumeAccessor) volumePool() (string, string, map[string]interface{}) {
+	if v.pool == nil {
+		return "dummy", "", nil
+	}
+	return v.pool.provider, v.pool.name, v.pool.attributes
 }
 
 func (m *mockVolumeAccessor) provisionVolume(tag names.VolumeTag) params.Volume {
@@ -165,6 +191,35 @@ func (w *mockVolumeAccessor) WatchVolumes(context.Context, names.Tag) (watcher.S
 	return w.volumesWatcher, nil
 }
 
+func (w *mockVolumeAccessor) WatchVolumeResizes(context.Context, names.MachineTag) (watcher.StringsWatcher, error) {
+	return w.resizesWatcher, nil
+}
+
+func (w *mockVolumeAccessor) WatchStorageSnapshots(context.Context, names.MachineTag) (watcher.NotifyWatcher, error) {
+	return w.snapshotsWatcher, nil
+}
+
+func (v *mockVolumeAccessor) MachineStorageSnapshots(context.Context, names.MachineTag) ([]params.MachineStorageSnapshot, error) {
+	if v.machineStorageSnapshots != nil {
+		return v.machineStorageSnapshots()
+	}
+	return nil, nil
+}
+
+func (v *mockVolumeAccessor) SetStorageSnapshotInfo(_ context.Context, snapshots []params.StorageSnapshotInfo) ([]params.ErrorResult, error) {
+	if v.setStorageSnapshotInfo != nil {
+		return v.setStorageSnapshotInfo(snapshots)
+	}
+	return make([]params.ErrorResult, len(snapshots)), nil
+}
+
+func (v *mockVolumeAccessor) RemoveStorageSnapshots(_ context.Context, uuids []string) ([]params.ErrorResult, error) {
+	if v.removeStorageSnapshots != nil {
+		return v.removeStorageSnapshots(uuids)
+	}
+	return make([]params.ErrorResult, len(uuids)), nil
+}
+
 func (w *mockVolumeAccessor) WatchVolumeAttachments(context.Context, names.Tag) (watcher.MachineStorageIDsWatcher, error) {
 	return w.attachmentsWatcher, nil
 }
@@ -222,24 +277,42 @@ func (v *mockVolumeAccessor) VolumeBlockDevices(_ context.Context, ids []params.
 func (v *mockVolumeAccessor) VolumeParams(_ context.Context, volumes []names.VolumeTag) ([]params.VolumeParamsResult, error) {
 	var result []params.VolumeParamsResult
 	for _, tag := range volumes {
+		if attached := v.attachedMachine(tag); attached != "" {
+			// Mirror the facade, which cannot supply the
+			// params of a volume that is already attached.
+			result = append(result, params.VolumeParamsResult{
+				Error: apiservererrors.ServerError(errors.Errorf(
+					"volume %q is already attached to %q", tag.Id(), attached,
+				)),
+			})
+			continue
+		}
+		provider, pool, poolAttributes := v.volumePool()
+		attributes := map[string]interface{}{
+			"persistent": tag.String() == "volume-1",
+		}
+		for k, v := range poolAttributes {
+			attributes[k] = v
+		}
 		volumeParams := params.VolumeParams{
-			VolumeTag: tag.String(),
-			Size:      1024,
-			Provider:  "dummy",
-			Attributes: map[string]interface{}{
-				"persistent": tag.String() == "volume-1",
-			},
+			VolumeTag:  tag.String(),
+			Size:       1024,
+			Provider:   provider,
+			Attributes: attributes,
 			Tags: map[string]string{
 				"very": "fancy",
 			},
+			Pool: pool,
 		}
 		if tag.Id() != noAttachmentVolumeId {
 			volumeParams.Attachment = &params.VolumeAttachmentParams{
 				VolumeTag:  tag.String(),
 				MachineTag: "machine-1",
 				InstanceId: string(v.provisionedMachines["machine-1"]),
-				Provider:   "dummy",
+				Provider:   provider,
 				ReadOnly:   tag.String() == "volume-1",
+				Pool:       pool,
+				Attributes: attributes,
 			}
 		}
 		result = append(result, params.VolumeParamsResult{Result: volumeParams})
@@ -247,8 +320,20 @@ func (v *mockVolumeAccessor) VolumeParams(_ context.Context, volumes []names.Vol
 	return result, nil
 }
 
+// attachedMachine returns the tag of the machine to which
+// the volume has a provisioned attachment, if any.
+func (v *mockVolumeAccessor) attachedMachine(tag names.VolumeTag) string {
+	for id := range v.provisionedAttachments {
+		if id.AttachmentTag == tag.String() {
+			return id.MachineTag
+		}
+	}
+	return ""
+}
+
 func (v *mockVolumeAccessor) RemoveVolumeParams(_ context.Context, volumes []names.VolumeTag) ([]params.RemoveVolumeParamsResult, error) {
 	var result []params.RemoveVolumeParamsResult
+	provider, pool, attributes := v.volumePool()
 	for _, tag := range volumes {
 		v, ok := v.provisionedVolumes[tag.String()]
 		if !ok {
@@ -258,9 +343,11 @@ func (v *mockVolumeAccessor) RemoveVolumeParams(_ context.Context, volumes []nam
 			continue
 		}
 		volumeParams := params.RemoveVolumeParams{
-			Provider: "dummy",
-			VolumeId: v.Info.VolumeId,
-			Destroy:  tag.Id() != releasingVolumeId,
+			Provider:   provider,
+			Pool:       pool,
+			Attributes: attributes,
+			VolumeId:   v.Info.VolumeId,
+			Destroy:    tag.Id() != releasingVolumeId,
 		}
 		result = append(result, params.RemoveVolumeParamsResult{Result: volumeParams})
 	}
@@ -273,12 +360,15 @@ func (v *mockVolumeAccessor) VolumeAttachmentParams(_ context.Context, ids []par
 		// Parameters are returned regardless of whether the attachment
 		// exists; this is to support reattachment.
 		instanceId, _ := v.provisionedMachines[id.MachineTag]
+		provider, pool, attributes := v.volumePool()
 		result = append(result, params.VolumeAttachmentParamsResult{Result: params.VolumeAttachmentParams{
 			MachineTag: id.MachineTag,
 			VolumeTag:  id.AttachmentTag,
 			InstanceId: string(instanceId),
-			Provider:   "dummy",
+			Provider:   provider,
 			ReadOnly:   id.AttachmentTag == "volume-1",
+			Pool:       pool,
+			Attributes: attributes,
 		}})
 	}
 	return result, nil
@@ -322,6 +412,8 @@ func newMockVolumeAccessor() *mockVolumeAccessor {
 		volumesWatcher:         newMockStringsWatcher(),
 		attachmentsWatcher:     newMockAttachmentsWatcher(),
 		attachmentPlansWatcher: newMockAttachmentPlansWatcher(),
+		resizesWatcher:         newMockStringsWatcher(),
+		snapshotsWatcher:       newMockNotifyWatcher(),
 		blockDevicesWatcher:    newMockNotifyWatcher(),
 		provisionedMachines:    make(map[string]instance.Id),
 		provisionedVolumes:     make(map[string]params.Volume),
@@ -556,6 +648,9 @@ type dummyProvider struct {
 	detachVolumesFunc            func([]storage.VolumeAttachmentParams) ([]error, error)
 	detachFilesystemsFunc        func([]storage.FilesystemAttachmentParams) ([]error, error)
 	destroyVolumesFunc           func([]string) ([]error, error)
+	resizeVolumesFunc            func([]storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error)
+	createVolumeSnapshotsFunc    func([]storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error)
+	destroyVolumeSnapshotsFunc   func([]string) ([]error, error)
 	releaseVolumesFunc           func([]string) ([]error, error)
 	destroyFilesystemsFunc       func([]string) ([]error, error)
 	releaseFilesystemsFunc       func([]string) ([]error, error)
@@ -634,6 +729,36 @@ func (s *dummyVolumeSource) DestroyVolumes(ctx context.Context, volumeIds []stri
 	return make([]error, len(volumeIds)), nil
 }
 
+// ResizeVolumes resizes volumes.
+func (s *dummyVolumeSource) CreateVolumeSnapshots(ctx context.Context, params []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+	if s.provider.createVolumeSnapshotsFunc != nil {
+		return s.provider.createVolumeSnapshotsFunc(params)
+	}
+	results := make([]storage.CreateVolumeSnapshotsResult, len(params))
+	for i, p := range params {
+		results[i].Snapshot = &storage.VolumeSnapshot{SnapshotId: "snap-" + p.VolumeId}
+	}
+	return results, nil
+}
+
+func (s *dummyVolumeSource) DestroyVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	if s.provider.destroyVolumeSnapshotsFunc != nil {
+		return s.provider.destroyVolumeSnapshotsFunc(snapshotIds)
+	}
+	return make([]error, len(snapshotIds)), nil
+}
+
+func (s *dummyVolumeSource) ResizeVolumes(ctx context.Context, params []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+	if s.provider.resizeVolumesFunc != nil {
+		return s.provider.resizeVolumesFunc(params)
+	}
+	results := make([]storage.ResizeVolumesResult, len(params))
+	for i, p := range params {
+		results[i].Size = p.Size
+	}
+	return results, nil
+}
+
 // ReleaseVolumes destroys volumes.
 func (s *dummyVolumeSource) ReleaseVolumes(ctx context.Context, volumeIds []string) ([]error, error) {
 	if s.provider.releaseVolumesFunc != nil {
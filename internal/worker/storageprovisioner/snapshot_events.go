@@ -0,0 +1,223 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storageprovisioner
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/rpc/params"
+)
+
+// storageSnapshotsChanged is called when the snapshots of the volumes
+// scoped to the machine change. Pending snapshots are taken, and dying
+// snapshots are removed from the storage provider and then from the model.
+func storageSnapshotsChanged(ctx context.Context, deps *dependencies) error {
+	machineTag, ok := deps.config.Scope.(names.MachineTag)
+	if !ok {
+		return errors.Errorf("expected machine scope, got %s", deps.config.Scope)
+	}
+	snapshots, err := deps.config.Volumes.MachineStorageSnapshots(ctx, machineTag)
+	if err != nil {
+		return errors.Annotate(err, "getting storage snapshots")
+	}
+
+	var taking, removing []params.MachineStorageSnapshot
+	for _, snapshot := range snapshots {
+		switch {
+		case snapshot.Life == life.Alive && snapshot.Status == "pending" && snapshot.ProviderId == "":
+			taking = append(taking, snapshot)
+		case snapshot.Life != life.Alive:
+			removing = append(removing, snapshot)
+		}
+	}
+	if err := takeStorageSnapshots(ctx, deps, taking); err != nil {
+		return errors.Trace(err)
+	}
+	return removeStorageSnapshots(ctx, deps, removing)
+}
+
+// takeStorageSnapshots takes the specified snapshots, and records the
+// outcome of each. Snapshots taken by the storage provider that cannot be
+// recorded are destroyed, so that they are not leaked; the snapshots are
+// then taken again when the snapshots next change.
+func takeStorageSnapshots(ctx context.Context, deps *dependencies, snapshots []params.MachineStorageSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	bySource := make(map[string][]params.MachineStorageSnapshot)
+	for _, snapshot := range snapshots {
+		sourceName := volumeSourceName(snapshot.Pool, storage.ProviderType(snapshot.Provider))
+		bySource[sourceName] = append(bySource[sourceName], snapshot)
+	}
+
+	var infos []params.StorageSnapshotInfo
+	snapshotters := make(map[string]storage.VolumeSnapshotter)
+	for sourceName, snapshots := range bySource {
+		snapshotter, err := volumeSnapshotter(deps, sourceName, snapshots[0])
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if snapshotter == nil {
+			for _, snapshot := range snapshots {
+				infos = append(infos, params.StorageSnapshotInfo{
+					UUID:  snapshot.UUID,
+					Error: "storage provider " + snapshot.Provider + " does not support snapshots",
+				})
+			}
+			continue
+		}
+		args := make([]storage.VolumeSnapshotParams, len(snapshots))
+		for i, snapshot := range snapshots {
+			volumeTag, err := names.ParseVolumeTag(snapshot.VolumeTag)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			args[i] = storage.VolumeSnapshotParams{
+				Tag:      volumeTag,
+				VolumeId: snapshot.VolumeId,
+			}
+		}
+		results, err := snapshotter.CreateVolumeSnapshots(ctx, args)
+		if err != nil {
+			return errors.Annotate(err, "taking storage snapshots")
+		}
+		for i, result := range results {
+			info := params.StorageSnapshotInfo{UUID: snapshots[i].UUID}
+			if result.Error != nil {
+				deps.config.Logger.Errorf(ctx, "taking snapshot of %s: %v", names.ReadableString(args[i].Tag), result.Error)
+				info.Error = result.Error.Error()
+			} else {
+				info.ProviderId = result.Snapshot.SnapshotId
+				info.Size = result.Snapshot.Size
+				snapshotters[info.UUID] = snapshotter
+			}
+			infos = append(infos, info)
+		}
+	}
+	errorResults, err := deps.config.Volumes.SetStorageSnapshotInfo(ctx, infos)
+	if err != nil {
+		destroyUnrecordedSnapshots(ctx, deps, snapshotters, infos)
+		return errors.Annotate(err, "recording storage snapshots")
+	}
+	var unrecorded []params.StorageSnapshotInfo
+	for i, result := range errorResults {
+		if result.Error != nil {
+			deps.config.Logger.Errorf(ctx, "recording storage snapshot %s: %v", infos[i].UUID, result.Error)
+			unrecorded = append(unrecorded, infos[i])
+		}
+	}
+	destroyUnrecordedSnapshots(ctx, deps, snapshotters, unrecorded)
+	return nil
+}
+
+// destroyUnrecordedSnapshots destroys the snapshots taken by the storage
+// provider whose details could not be recorded in the model. Failures are
+// logged, as there is no record from which the removal could be retried.
+func destroyUnrecordedSnapshots(
+	ctx context.Context, deps *dependencies,
+	snapshotters map[string]storage.VolumeSnapshotter, infos []params.StorageSnapshotInfo,
+) {
+	for _, info := range infos {
+		snapshotter, ok := snapshotters[info.UUID]
+		if !ok || info.ProviderId == "" {
+			continue
+		}
+		errs, err := snapshotter.DestroyVolumeSnapshots(ctx, []string{info.ProviderId})
+		if err == nil && len(errs) > 0 {
+			err = errs[0]
+		}
+		if err != nil {
+			deps.config.Logger.Errorf(ctx,
+				"destroying unrecorded storage snapshot %s: %v", info.ProviderId, err,
+			)
+		}
+	}
+}
+
+// removeStorageSnapshots removes the specified dying snapshots from the
+// storage provider, and then removes their records from the model.
+// Snapshots that cannot be removed from the storage provider are left
+// dying, so that their removal is retried when the snapshots next change.
+func removeStorageSnapshots(ctx context.Context, deps *dependencies, snapshots []params.MachineStorageSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	bySource := make(map[string][]params.MachineStorageSnapshot)
+	var removed []string
+	for _, snapshot := range snapshots {
+		if snapshot.ProviderId == "" {
+			// The snapshot was never taken.
+			removed = append(removed, snapshot.UUID)
+			continue
+		}
+		sourceName := volumeSourceName(snapshot.Pool, storage.ProviderType(snapshot.Provider))
+		bySource[sourceName] = append(bySource[sourceName], snapshot)
+	}
+
+	for sourceName, snapshots := range bySource {
+		snapshotter, err := volumeSnapshotter(deps, sourceName, snapshots[0])
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if snapshotter == nil {
+			for _, snapshot := range snapshots {
+				deps.config.Logger.Warningf(ctx,
+					"cannot remove storage snapshot %s: storage provider %q does not support snapshots",
+					snapshot.ProviderId, snapshot.Provider,
+				)
+			}
+			continue
+		}
+		snapshotIds := make([]string, len(snapshots))
+		for i, snapshot := range snapshots {
+			snapshotIds[i] = snapshot.ProviderId
+		}
+		errs, err := snapshotter.DestroyVolumeSnapshots(ctx, snapshotIds)
+		if err != nil {
+			return errors.Annotate(err, "removing storage snapshots")
+		}
+		for i, err := range errs {
+			if err != nil {
+				deps.config.Logger.Errorf(ctx, "removing storage snapshot %s: %v", snapshotIds[i], err)
+				continue
+			}
+			removed = append(removed, snapshots[i].UUID)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	errorResults, err := deps.config.Volumes.RemoveStorageSnapshots(ctx, removed)
+	if err != nil {
+		return errors.Annotate(err, "removing storage snapshot records")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil && !params.IsCodeNotFound(result.Error) {
+			return errors.Annotatef(result.Error, "removing storage snapshot record %s", removed[i])
+		}
+	}
+	return nil
+}
+
+// volumeSnapshotter returns the named volume source, configured from the
+// storage pool of the snapshot's volume, as a VolumeSnapshotter, or nil
+// if the provider does not support snapshots.
+func volumeSnapshotter(
+	deps *dependencies, sourceName string, snapshot params.MachineStorageSnapshot,
+) (storage.VolumeSnapshotter, error) {
+	source, err := volumeSource(
+		deps.config.StorageDir, deps.config.Model.Id(), sourceName,
+		storage.ProviderType(snapshot.Provider), snapshot.Attributes, deps.config.Registry,
+	)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting volume source")
+	}
+	snapshotter, _ := source.(storage.VolumeSnapshotter)
+	return snapshotter, nil
+}
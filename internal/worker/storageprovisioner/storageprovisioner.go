@@ -49,6 +49,26 @@ type VolumeAccessor interface {
 	// initialization of the attachment, such as logging into the iSCSI target
 	WatchVolumeAttachmentPlans(ctx context.Context, scope names.Tag) (watcher.MachineStorageIDsWatcher, error)
 
+	// WatchVolumeResizes watches for changes to volumes scoped to the
+	// specified machine, so that requests to resize them can be observed.
+	WatchVolumeResizes(context.Context, names.MachineTag) (watcher.StringsWatcher, error)
+
+	// WatchStorageSnapshots watches for changes to the snapshots of the
+	// volumes scoped to the specified machine.
+	WatchStorageSnapshots(context.Context, names.MachineTag) (watcher.NotifyWatcher, error)
+
+	// MachineStorageSnapshots returns the snapshots of the volumes scoped
+	// to the specified machine.
+	MachineStorageSnapshots(context.Context, names.MachineTag) ([]params.MachineStorageSnapshot, error)
+
+	// SetStorageSnapshotInfo records the outcome of taking machine-scoped
+	// storage snapshots.
+	SetStorageSnapshotInfo(context.Context, []params.StorageSnapshotInfo) ([]params.ErrorResult, error)
+
+	// RemoveStorageSnapshots removes the records of the specified dying
+	// machine-scoped storage snapshots.
+	RemoveStorageSnapshots(context.Context, []string) ([]params.ErrorResult, error)
+
 	// Volumes returns details of volumes with the specified tags.
 	Volumes(context.Context, []names.VolumeTag) ([]params.VolumeResult, error)
 
@@ -209,6 +229,8 @@ func (w *storageProvisioner) loop() error {
 		filesystemsChanges           watcher.StringsChannel
 		volumeAttachmentsChanges     watcher.MachineStorageIDsChannel
 		volumeAttachmentPlansChanges watcher.MachineStorageIDsChannel
+		volumeResizesChanges         watcher.StringsChannel
+		storageSnapshotsChanges      watcher.NotifyChannel
 		filesystemAttachmentsChanges watcher.MachineStorageIDsChannel
 		machineBlockDevicesChanges   <-chan struct{}
 	)
@@ -235,6 +257,34 @@ func (w *storageProvisioner) loop() error {
 		}
 
 		volumeAttachmentPlansChanges = volumeAttachmentPlansWatcher.Changes()
+
+		// Machine-scoped volumes are resized by the machine's
+		// storage provisioner, as only it can reach them.
+		volumeResizesWatcher, err := w.config.Volumes.WatchVolumeResizes(ctx, machineTag)
+		if errors.Is(err, errors.NotSupported) {
+			w.config.Logger.Debugf(ctx, "not watching volume resizes: %v", err)
+		} else if err != nil {
+			return errors.Annotate(err, "watching volume resizes")
+		} else {
+			if err := w.catacomb.Add(volumeResizesWatcher); err != nil {
+				return errors.Trace(err)
+			}
+			volumeResizesChanges = volumeResizesWatcher.Changes()
+		}
+
+		// Snapshots of machine-scoped volumes are likewise taken
+		// and removed by the machine's storage provisioner.
+		storageSnapshotsWatcher, err := w.config.Volumes.WatchStorageSnapshots(ctx, machineTag)
+		if errors.Is(err, errors.NotSupported) {
+			w.config.Logger.Debugf(ctx, "not watching storage snapshots: %v", err)
+		} else if err != nil {
+			return errors.Annotate(err, "watching storage snapshots")
+		} else {
+			if err := w.catacomb.Add(storageSnapshotsWatcher); err != nil {
+				return errors.Trace(err)
+			}
+			storageSnapshotsChanges = storageSnapshotsWatcher.Changes()
+		}
 	}
 
 	deps := dependencies{
@@ -342,6 +392,20 @@ func (w *storageProvisioner) loop() error {
 			if err := volumeAttachmentPlansChanged(ctx, &deps, changes); err != nil {
 				return errors.Trace(err)
 			}
+		case changes, ok := <-volumeResizesChanges:
+			if !ok {
+				return errors.New("volume resizes watcher closed")
+			}
+			if err := volumesResized(ctx, &deps, changes); err != nil {
+				return errors.Trace(err)
+			}
+		case _, ok := <-storageSnapshotsChanges:
+			if !ok {
+				return errors.New("storage snapshots watcher closed")
+			}
+			if err := storageSnapshotsChanged(ctx, &deps); err != nil {
+				return errors.Trace(err)
+			}
 		case changes, ok := <-filesystemsChanges:
 			if !ok {
 				return errors.New("filesystems watcher closed")
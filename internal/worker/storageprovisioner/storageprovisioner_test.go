@@ -4,6 +4,7 @@
 package storageprovisioner_test
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -1270,6 +1271,7 @@ func (s *storageProvisionerSuite) TestResourceTags(c *tc.C) {
 				InstanceId: "already-provisioned-1",
 				ReadOnly:   true,
 			},
+			Attributes: map[string]interface{}{"persistent": true},
 		},
 	}}})
 	c.Assert(filesystemSource.createFilesystemsArgs, tc.DeepEquals, [][]storage.FilesystemParams{{{
@@ -1280,6 +1282,85 @@ func (s *storageProvisionerSuite) TestResourceTags(c *tc.C) {
 	}}})
 }
 
+// TestVolumesFromPool is asserting that volumes allocated from a storage
+// pool are created and destroyed by a volume source configured with the
+// pool's attributes. The lvm provider cannot make a volume source without
+// the volume group configured in the pool.
+func (s *storageProvisionerSuite) TestVolumesFromPool(c *tc.C) {
+	s.registry = storage.StaticProviderRegistry{
+		Providers: map[storage.ProviderType]storage.Provider{
+			"dummy": s.provider,
+			"lvm":   s.provider,
+		},
+	}
+	s.provider.volumeSourceFunc = func(sourceConfig *storage.Config) (storage.VolumeSource, error) {
+		c.Check(sourceConfig.Name(), tc.Equals, "fast")
+		c.Check(sourceConfig.Provider(), tc.Equals, storage.ProviderType("lvm"))
+		volumeGroup, _ := sourceConfig.ValueString("volume-group")
+		if volumeGroup != "vg0" {
+			return nil, errors.NotValidf("volume group %q", volumeGroup)
+		}
+		return &dummyVolumeSource{provider: s.provider}, nil
+	}
+
+	createdVolume := names.NewVolumeTag("1")
+	deadVolume := names.NewVolumeTag("3")
+
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.pool = &mockStoragePool{
+		name:     "fast",
+		provider: "lvm",
+		attributes: map[string]interface{}{
+			"volume-group": "vg0",
+		},
+	}
+	volumeAccessor.provisionedMachines["machine-1"] = "already-provisioned-1"
+	volumeAccessor.provisionVolume(deadVolume)
+
+	volumeInfoSet := make(chan interface{}, 1)
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		volumeInfoSet <- volumes
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	destroyedChan := make(chan interface{}, 1)
+	s.provider.destroyVolumesFunc = func(volumeIds []string) ([]error, error) {
+		destroyedChan <- volumeIds
+		return make([]error, len(volumeIds)), nil
+	}
+
+	life := func(tags []names.Tag) ([]params.LifeResult, error) {
+		results := make([]params.LifeResult, len(tags))
+		for i, tag := range tags {
+			results[i].Life = life.Alive
+			if tag == deadVolume {
+				results[i].Life = life.Dead
+			}
+		}
+		return results, nil
+	}
+
+	args := &workerArgs{
+		volumes: volumeAccessor,
+		life: &mockLifecycleManager{
+			life: life,
+		},
+		registry: s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.volumesWatcher.changes <- []string{createdVolume.Id(), deadVolume.Id()}
+
+	volumes := waitChannel(c, volumeInfoSet, "waiting for volume info to be set").([]params.Volume)
+	c.Assert(volumes, tc.HasLen, 1)
+	c.Check(volumes[0].VolumeTag, tc.Equals, createdVolume.String())
+
+	destroyed := waitChannel(c, destroyedChan, "waiting for volume to be destroyed")
+	c.Check(destroyed, tc.DeepEquals, []string{"vol-3"})
+}
+
 func (s *storageProvisionerSuite) TestSetVolumeInfoErrorStopsWorker(c *tc.C) {
 	volumeAccessor := newMockVolumeAccessor()
 	volumeAccessor.provisionedMachines["machine-1"] = "already-provisioned-1"
@@ -2362,3 +2443,449 @@ func assertNoEvent(c *tc.C, ch <-chan interface{}, event string) {
 	case <-time.After(coretesting.ShortWait):
 	}
 }
+
+func (s *storageProvisionerSuite) TestVolumeResized(c *tc.C) {
+	volumeInfoSet := make(chan interface{})
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		volumeInfoSet <- volumes
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+	volumeAccessor.provisionedVolumes["volume-0-0"] = params.Volume{
+		VolumeTag:     "volume-0-0",
+		Info:          params.VolumeInfo{VolumeId: "vol-0-0", HardwareId: "serial-0-0", Size: 1024},
+		RequestedSize: 2048,
+		Provider:      "dummy",
+	}
+	volumeAccessor.provisionedVolumes["volume-0-1"] = params.Volume{
+		VolumeTag: "volume-0-1",
+		Info:      params.VolumeInfo{VolumeId: "vol-0-1", Size: 1024},
+	}
+	// Volume 0/0 is attached, so its volume params cannot be obtained.
+	volumeAccessor.provisionedAttachments[params.MachineStorageId{
+		MachineTag: "machine-0", AttachmentTag: "volume-0-0",
+	}] = params.VolumeAttachment{
+		VolumeTag:  "volume-0-0",
+		MachineTag: "machine-0",
+		Info:       params.VolumeAttachmentInfo{DeviceName: "xvdf1"},
+	}
+
+	var resizeArgs []storage.ResizeVolumeParams
+	s.provider.resizeVolumesFunc = func(args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+		resizeArgs = append(resizeArgs, args...)
+		results := make([]storage.ResizeVolumesResult, len(args))
+		for i, arg := range args {
+			results[i].Size = arg.Size + 4
+		}
+		return results, nil
+	}
+
+	args := &workerArgs{
+		scope:    names.NewMachineTag("0"),
+		volumes:  volumeAccessor,
+		registry: s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	// Only volume 0/0 has an outstanding request to grow.
+	volumeAccessor.resizesWatcher.changes <- []string{"0/0", "0/1", "0/2"}
+	volumes := waitChannel(c, volumeInfoSet, "waiting for volume info to be set").([]params.Volume)
+	c.Assert(volumes, tc.DeepEquals, []params.Volume{{
+		VolumeTag: "volume-0-0",
+		Info:      params.VolumeInfo{VolumeId: "vol-0-0", HardwareId: "serial-0-0", Size: 2052},
+	}})
+	c.Assert(resizeArgs, tc.DeepEquals, []storage.ResizeVolumeParams{{
+		Tag:      names.NewVolumeTag("0/0"),
+		VolumeId: "vol-0-0",
+		Size:     2048,
+	}})
+}
+
+// TestVolumeResizedFromPool is asserting that a volume allocated from a
+// storage pool is resized by a volume source configured with the pool's
+// attributes.
+func (s *storageProvisionerSuite) TestVolumeResizedFromPool(c *tc.C) {
+	s.registry = storage.StaticProviderRegistry{
+		Providers: map[storage.ProviderType]storage.Provider{
+			"lvm": s.provider,
+		},
+	}
+	s.provider.volumeSourceFunc = func(sourceConfig *storage.Config) (storage.VolumeSource, error) {
+		c.Check(sourceConfig.Name(), tc.Equals, "fast")
+		c.Check(sourceConfig.Provider(), tc.Equals, storage.ProviderType("lvm"))
+		storageDir, _ := sourceConfig.ValueString(storage.ConfigStorageDir)
+		c.Check(storageDir, tc.Equals, filepath.Join("storage-dir", "lvm"))
+		volumeGroup, _ := sourceConfig.ValueString("volume-group")
+		if volumeGroup != "vg0" {
+			return nil, errors.NotValidf("volume group %q", volumeGroup)
+		}
+		return &dummyVolumeSource{provider: s.provider}, nil
+	}
+
+	volumeInfoSet := make(chan interface{})
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		volumeInfoSet <- volumes
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+	volumeAccessor.provisionedVolumes["volume-0-0"] = params.Volume{
+		VolumeTag:     "volume-0-0",
+		Info:          params.VolumeInfo{VolumeId: "vol-0-0", Pool: "fast", Size: 1024},
+		RequestedSize: 2048,
+		Provider:      "lvm",
+		Attributes:    map[string]interface{}{"volume-group": "vg0"},
+	}
+	s.provider.resizeVolumesFunc = func(args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+		results := make([]storage.ResizeVolumesResult, len(args))
+		for i, arg := range args {
+			results[i].Size = arg.Size
+		}
+		return results, nil
+	}
+
+	args := &workerArgs{
+		scope:    names.NewMachineTag("0"),
+		volumes:  volumeAccessor,
+		registry: s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.resizesWatcher.changes <- []string{"0/0"}
+	volumes := waitChannel(c, volumeInfoSet, "waiting for volume info to be set").([]params.Volume)
+	c.Assert(volumes, tc.DeepEquals, []params.Volume{{
+		VolumeTag: "volume-0-0",
+		Info:      params.VolumeInfo{VolumeId: "vol-0-0", Pool: "fast", Size: 2048},
+	}})
+}
+
+func (s *storageProvisionerSuite) TestVolumeResizedFailureNotFatal(c *tc.C) {
+	volumeInfoSet := make(chan interface{})
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		volumeInfoSet <- volumes
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+	volumeAccessor.provisionedVolumes["volume-0-0"] = params.Volume{
+		VolumeTag:     "volume-0-0",
+		Info:          params.VolumeInfo{VolumeId: "vol-0-0", Size: 1024},
+		RequestedSize: 2048,
+		Provider:      "dummy",
+	}
+	volumeAccessor.provisionedVolumes["volume-0-1"] = params.Volume{
+		VolumeTag:     "volume-0-1",
+		Info:          params.VolumeInfo{VolumeId: "vol-0-1", Size: 1024},
+		RequestedSize: 4096,
+		Provider:      "dummy",
+	}
+
+	s.provider.resizeVolumesFunc = func(args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+		results := make([]storage.ResizeVolumesResult, len(args))
+		for i, arg := range args {
+			if arg.Tag.Id() == "0/0" {
+				results[i].Error = errors.New("no room")
+				continue
+			}
+			results[i].Size = arg.Size
+		}
+		return results, nil
+	}
+
+	args := &workerArgs{
+		scope:    names.NewMachineTag("0"),
+		volumes:  volumeAccessor,
+		registry: s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	// The failure to resize volume 0/0 is logged, and
+	// does not prevent volume 0/1 from being resized.
+	volumeAccessor.resizesWatcher.changes <- []string{"0/0", "0/1"}
+	volumes := waitChannel(c, volumeInfoSet, "waiting for volume info to be set").([]params.Volume)
+	c.Assert(volumes, tc.DeepEquals, []params.Volume{{
+		VolumeTag: "volume-0-1",
+		Info:      params.VolumeInfo{VolumeId: "vol-0-1", Size: 4096},
+	}})
+
+	// The worker is still running, and retries volume 0/0
+	// when it next changes.
+	s.provider.resizeVolumesFunc = func(args []storage.ResizeVolumeParams) ([]storage.ResizeVolumesResult, error) {
+		results := make([]storage.ResizeVolumesResult, len(args))
+		for i, arg := range args {
+			results[i].Size = arg.Size
+		}
+		return results, nil
+	}
+	volumeAccessor.resizesWatcher.changes <- []string{"0/0"}
+	volumes = waitChannel(c, volumeInfoSet, "waiting for volume info to be set").([]params.Volume)
+	c.Assert(volumes, tc.DeepEquals, []params.Volume{{
+		VolumeTag: "volume-0-0",
+		Info:      params.VolumeInfo{VolumeId: "vol-0-0", Size: 2048},
+	}})
+}
+
+func (s *storageProvisionerSuite) TestStorageSnapshots(c *tc.C) {
+	snapshotInfoSet := make(chan interface{})
+	snapshotsRemoved := make(chan interface{})
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.machineStorageSnapshots = func() ([]params.MachineStorageSnapshot, error) {
+		return []params.MachineStorageSnapshot{{
+			UUID: "pending", VolumeTag: "volume-0-0", VolumeId: "vol-0-0",
+			Provider: "dummy", Life: life.Alive, Status: "pending",
+		}, {
+			UUID: "available", VolumeTag: "volume-0-1", VolumeId: "vol-0-1",
+			Provider: "dummy", ProviderId: "snap-vol-0-1", Life: life.Alive, Status: "available",
+		}, {
+			UUID: "dying", VolumeTag: "volume-0-2", VolumeId: "vol-0-2",
+			Provider: "dummy", ProviderId: "snap-vol-0-2", Life: life.Dying, Status: "available",
+		}, {
+			UUID: "untaken", VolumeTag: "volume-0-3", VolumeId: "vol-0-3",
+			Provider: "dummy", Life: life.Dying, Status: "pending",
+		}}, nil
+	}
+	volumeAccessor.setStorageSnapshotInfo = func(snapshots []params.StorageSnapshotInfo) ([]params.ErrorResult, error) {
+		snapshotInfoSet <- snapshots
+		return make([]params.ErrorResult, len(snapshots)), nil
+	}
+	volumeAccessor.removeStorageSnapshots = func(uuids []string) ([]params.ErrorResult, error) {
+		snapshotsRemoved <- uuids
+		return make([]params.ErrorResult, len(uuids)), nil
+	}
+
+	var createArgs []storage.VolumeSnapshotParams
+	s.provider.createVolumeSnapshotsFunc = func(args []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+		createArgs = append(createArgs, args...)
+		results := make([]storage.CreateVolumeSnapshotsResult, len(args))
+		for i, arg := range args {
+			results[i].Snapshot = &storage.VolumeSnapshot{SnapshotId: "snap-" + arg.VolumeId, Size: 1024}
+		}
+		return results, nil
+	}
+	var destroyArgs []string
+	s.provider.destroyVolumeSnapshotsFunc = func(snapshotIds []string) ([]error, error) {
+		destroyArgs = append(destroyArgs, snapshotIds...)
+		return make([]error, len(snapshotIds)), nil
+	}
+
+	args := &workerArgs{
+		scope:    names.NewMachineTag("0"),
+		volumes:  volumeAccessor,
+		registry: s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.snapshotsWatcher.changes <- struct{}{}
+	infos := waitChannel(c, snapshotInfoSet, "waiting for snapshot info to be set").([]params.StorageSnapshotInfo)
+	c.Assert(infos, tc.DeepEquals, []params.StorageSnapshotInfo{{
+		UUID: "pending", ProviderId: "snap-vol-0-0", Size: 1024,
+	}})
+	c.Assert(createArgs, tc.DeepEquals, []storage.VolumeSnapshotParams{{
+		Tag:      names.NewVolumeTag("0/0"),
+		VolumeId: "vol-0-0",
+	}})
+
+	removed := waitChannel(c, snapshotsRemoved, "waiting for snapshots to be removed").([]string)
+	c.Assert(removed, tc.DeepEquals, []string{"untaken", "dying"})
+	c.Assert(destroyArgs, tc.DeepEquals, []string{"snap-vol-0-2"})
+}
+
+// TestStorageSnapshotsFromPool is asserting that snapshots of volumes
+// allocated from a storage pool are taken and destroyed by a volume source
+// configured with the pool's attributes.
+func (s *storageProvisionerSuite) TestStorageSnapshotsFromPool(c *tc.C) {
+	s.registry = storage.StaticProviderRegistry{
+		Providers: map[storage.ProviderType]storage.Provider{
+			"lvm": s.provider,
+		},
+	}
+	s.provider.volumeSourceFunc = func(sourceConfig *storage.Config) (storage.VolumeSource, error) {
+		c.Check(sourceConfig.Name(), tc.Equals, "fast")
+		volumeGroup, _ := sourceConfig.ValueString("volume-group")
+		if volumeGroup != "vg0" {
+			return nil, errors.NotValidf("volume group %q", volumeGroup)
+		}
+		return &dummyVolumeSource{provider: s.provider}, nil
+	}
+
+	snapshotInfoSet := make(chan interface{})
+	snapshotsRemoved := make(chan interface{})
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.machineStorageSnapshots = func() ([]params.MachineStorageSnapshot, error) {
+		attributes := map[string]interface{}{"volume-group": "vg0"}
+		return []params.MachineStorageSnapshot{{
+			UUID: "pending", VolumeTag: "volume-0-0", VolumeId: "vol-0-0",
+			Provider: "lvm", Pool: "fast", Attributes: attributes,
+			Life: life.Alive, Status: "pending",
+		}, {
+			UUID: "dying", VolumeTag: "volume-0-1", VolumeId: "vol-0-1",
+			Provider: "lvm", Pool: "fast", Attributes: attributes,
+			ProviderId: "snap-vol-0-1", Life: life.Dying, Status: "available",
+		}}, nil
+	}
+	volumeAccessor.setStorageSnapshotInfo = func(snapshots []params.StorageSnapshotInfo) ([]params.ErrorResult, error) {
+		snapshotInfoSet <- snapshots
+		return make([]params.ErrorResult, len(snapshots)), nil
+	}
+	volumeAccessor.removeStorageSnapshots = func(uuids []string) ([]params.ErrorResult, error) {
+		snapshotsRemoved <- uuids
+		return make([]params.ErrorResult, len(uuids)), nil
+	}
+	s.provider.createVolumeSnapshotsFunc = func(args []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+		results := make([]storage.CreateVolumeSnapshotsResult, len(args))
+		for i, arg := range args {
+			results[i].Snapshot = &storage.VolumeSnapshot{SnapshotId: "snap-" + arg.VolumeId, Size: 1024}
+		}
+		return results, nil
+	}
+
+	args := &workerArgs{
+		scope:    names.NewMachineTag("0"),
+		volumes:  volumeAccessor,
+		registry: s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.snapshotsWatcher.changes <- struct{}{}
+	infos := waitChannel(c, snapshotInfoSet, "waiting for snapshot info to be set").([]params.StorageSnapshotInfo)
+	c.Assert(infos, tc.DeepEquals, []params.StorageSnapshotInfo{{
+		UUID: "pending", ProviderId: "snap-vol-0-0", Size: 1024,
+	}})
+	removed := waitChannel(c, snapshotsRemoved, "waiting for snapshots to be removed").([]string)
+	c.Assert(removed, tc.DeepEquals, []string{"dying"})
+}
+
+func (s *storageProvisionerSuite) TestStorageSnapshotsRemoveNotSupported(c *tc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.machineStorageSnapshots = func() ([]params.MachineStorageSnapshot, error) {
+		return []params.MachineStorageSnapshot{{
+			UUID: "dying", VolumeTag: "volume-0-0", VolumeId: "vol-0-0",
+			Provider: "dummy", ProviderId: "snap-vol-0-0", Life: life.Dying, Status: "available",
+		}}, nil
+	}
+	snapshotsRemoved := make(chan interface{})
+	volumeAccessor.removeStorageSnapshots = func(uuids []string) ([]params.ErrorResult, error) {
+		snapshotsRemoved <- uuids
+		return make([]params.ErrorResult, len(uuids)), nil
+	}
+	// The volume source does not support snapshots.
+	s.provider.volumeSourceFunc = func(*storage.Config) (storage.VolumeSource, error) {
+		return &struct{ storage.VolumeSource }{}, nil
+	}
+
+	args := &workerArgs{
+		scope:    names.NewMachineTag("0"),
+		volumes:  volumeAccessor,
+		registry: s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	// The snapshot is left dying, and the worker keeps running.
+	volumeAccessor.snapshotsWatcher.changes <- struct{}{}
+	assertNoEvent(c, snapshotsRemoved, "snapshots removed")
+}
+
+func (s *storageProvisionerSuite) TestStorageSnapshotsUnrecordedDestroyed(c *tc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.machineStorageSnapshots = func() ([]params.MachineStorageSnapshot, error) {
+		return []params.MachineStorageSnapshot{{
+			UUID: "pending", VolumeTag: "volume-0-0", VolumeId: "vol-0-0",
+			Provider: "dummy", Life: life.Alive, Status: "pending",
+		}, {
+			UUID: "removed", VolumeTag: "volume-0-1", VolumeId: "vol-0-1",
+			Provider: "dummy", Life: life.Alive, Status: "pending",
+		}}, nil
+	}
+	volumeAccessor.setStorageSnapshotInfo = func(snapshots []params.StorageSnapshotInfo) ([]params.ErrorResult, error) {
+		results := make([]params.ErrorResult, len(snapshots))
+		for i, snapshot := range snapshots {
+			if snapshot.UUID == "removed" {
+				results[i].Error = &params.Error{Code: params.CodeNotFound, Message: "storage snapshot not found"}
+			}
+		}
+		return results, nil
+	}
+	s.provider.createVolumeSnapshotsFunc = func(args []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+		results := make([]storage.CreateVolumeSnapshotsResult, len(args))
+		for i, arg := range args {
+			results[i].Snapshot = &storage.VolumeSnapshot{SnapshotId: "snap-" + arg.VolumeId}
+		}
+		return results, nil
+	}
+	snapshotsDestroyed := make(chan interface{})
+	s.provider.destroyVolumeSnapshotsFunc = func(snapshotIds []string) ([]error, error) {
+		snapshotsDestroyed <- snapshotIds
+		return make([]error, len(snapshotIds)), nil
+	}
+
+	args := &workerArgs{
+		scope:    names.NewMachineTag("0"),
+		volumes:  volumeAccessor,
+		registry: s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	// The snapshot that could not be recorded is destroyed,
+	// rather than leaked, and the worker keeps running.
+	volumeAccessor.snapshotsWatcher.changes <- struct{}{}
+	destroyed := waitChannel(c, snapshotsDestroyed, "waiting for snapshots to be destroyed").([]string)
+	c.Assert(destroyed, tc.DeepEquals, []string{"snap-vol-0-1"})
+	assertNoEvent(c, snapshotsDestroyed, "snapshots destroyed")
+}
+
+func (s *storageProvisionerSuite) TestSetStorageSnapshotInfoErrorDestroysSnapshots(c *tc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.machineStorageSnapshots = func() ([]params.MachineStorageSnapshot, error) {
+		return []params.MachineStorageSnapshot{{
+			UUID: "pending", VolumeTag: "volume-0-0", VolumeId: "vol-0-0",
+			Provider: "dummy", Life: life.Alive, Status: "pending",
+		}}, nil
+	}
+	volumeAccessor.setStorageSnapshotInfo = func(snapshots []params.StorageSnapshotInfo) ([]params.ErrorResult, error) {
+		return nil, errors.New("belly up")
+	}
+	s.provider.createVolumeSnapshotsFunc = func(args []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+		results := make([]storage.CreateVolumeSnapshotsResult, len(args))
+		for i, arg := range args {
+			results[i].Snapshot = &storage.VolumeSnapshot{SnapshotId: "snap-" + arg.VolumeId}
+		}
+		return results, nil
+	}
+	var destroyArgs []string
+	s.provider.destroyVolumeSnapshotsFunc = func(snapshotIds []string) ([]error, error) {
+		destroyArgs = append(destroyArgs, snapshotIds...)
+		return make([]error, len(snapshotIds)), nil
+	}
+
+	args := &workerArgs{
+		scope:    names.NewMachineTag("0"),
+		volumes:  volumeAccessor,
+		registry: s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer worker.Wait()
+	defer worker.Kill()
+
+	done := make(chan interface{})
+	go func() {
+		defer close(done)
+		err := worker.Wait()
+		c.Assert(err, tc.ErrorMatches, "recording storage snapshots: belly up")
+	}()
+
+	volumeAccessor.snapshotsWatcher.changes <- struct{}{}
+	waitChannel(c, done, "waiting for worker to exit")
+	c.Assert(destroyArgs, tc.DeepEquals, []string{"snap-vol-0-0"})
+}
@@ -60,6 +60,109 @@ func volumesChanged(ctx context.Context, deps *dependencies, changes []string) e
 	return nil
 }
 
+// volumesResized is called when volumes scoped to the machine have been seen
+// to have changed. Volumes with an outstanding request to grow are resized,
+// and their new sizes recorded. Failures to resize individual volumes are
+// logged rather than returned, leaving the request outstanding so that it is
+// retried when the volume next changes.
+func volumesResized(ctx context.Context, deps *dependencies, changes []string) error {
+	tags := make([]names.VolumeTag, len(changes))
+	for i, change := range changes {
+		tags[i] = names.NewVolumeTag(change)
+	}
+	volumeResults, err := deps.config.Volumes.Volumes(ctx, tags)
+	if err != nil {
+		return errors.Annotatef(err, "getting volume information")
+	}
+	resizeVolumes := make(map[names.VolumeTag]params.Volume)
+	sources := make(map[string]params.Volume)
+	bySource := make(map[string][]storage.ResizeVolumeParams)
+	for i, result := range volumeResults {
+		if result.Error != nil {
+			// The volume may have been removed, or not yet
+			// provisioned, since the change was observed.
+			if !params.IsCodeNotFound(result.Error) && !params.IsCodeNotProvisioned(result.Error) {
+				deps.config.Logger.Errorf(ctx,
+					"getting information for %s: %v", names.ReadableString(tags[i]), result.Error,
+				)
+			}
+			continue
+		}
+		if result.Result.RequestedSize <= result.Result.Info.Size {
+			continue
+		}
+		if result.Result.Provider == "" {
+			deps.config.Logger.Warningf(ctx,
+				"cannot resize %s: storage provider not known", names.ReadableString(tags[i]),
+			)
+			continue
+		}
+		// Volumes are resized by the source of their storage pool,
+		// as they are created.
+		sourceName := volumeSourceName(result.Result.Info.Pool, storage.ProviderType(result.Result.Provider))
+		resizeVolumes[tags[i]] = result.Result
+		sources[sourceName] = result.Result
+		bySource[sourceName] = append(bySource[sourceName], storage.ResizeVolumeParams{
+			Tag:      tags[i],
+			VolumeId: result.Result.Info.VolumeId,
+			Size:     result.Result.RequestedSize,
+		})
+	}
+
+	var resized []params.Volume
+	for sourceName, args := range bySource {
+		providerType := storage.ProviderType(sources[sourceName].Provider)
+		source, err := volumeSource(
+			deps.config.StorageDir, deps.config.Model.Id(), sourceName, providerType,
+			sources[sourceName].Attributes, deps.config.Registry,
+		)
+		if err != nil {
+			deps.config.Logger.Errorf(ctx, "getting volume source %q: %v", sourceName, err)
+			continue
+		}
+		resizer, ok := source.(storage.VolumeResizer)
+		if !ok {
+			for _, arg := range args {
+				deps.config.Logger.Warningf(ctx,
+					"cannot resize %s: storage provider %q does not support resizing volumes",
+					names.ReadableString(arg.Tag), providerType,
+				)
+			}
+			continue
+		}
+		results, err := resizer.ResizeVolumes(ctx, args)
+		if err != nil {
+			deps.config.Logger.Errorf(ctx, "resizing volumes from source %q: %v", sourceName, err)
+			continue
+		}
+		for i, result := range results {
+			if result.Error != nil {
+				deps.config.Logger.Errorf(ctx, "resizing %s: %v", names.ReadableString(args[i].Tag), result.Error)
+				continue
+			}
+			volume := resizeVolumes[args[i].Tag]
+			volume.Info.Size = result.Size
+			volume.RequestedSize = 0
+			volume.Provider = ""
+			volume.Attributes = nil
+			resized = append(resized, volume)
+		}
+	}
+	if len(resized) == 0 {
+		return nil
+	}
+	errorResults, err := deps.config.Volumes.SetVolumeInfo(ctx, resized)
+	if err != nil {
+		return errors.Annotate(err, "recording resized volumes")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			deps.config.Logger.Errorf(ctx, "recording new size of %s: %v", resized[i].VolumeTag, result.Error)
+		}
+	}
+	return nil
+}
+
 func sortVolumeAttachmentPlans(
 	ctx context.Context,
 	deps *dependencies, ids []params.MachineStorageId) (alive, dying, dead []params.VolumeAttachmentPlanResult, err error) {
@@ -685,16 +788,20 @@ func volumeParamsFromParams(in params.VolumeParams) (storage.VolumeParams, error
 				InstanceId: instance.Id(in.Attachment.InstanceId),
 				ReadOnly:   in.Attachment.ReadOnly,
 			},
-			Volume: volumeTag,
+			Volume:     volumeTag,
+			Pool:       in.Pool,
+			Attributes: in.Attributes,
 		}
 	}
 	return storage.VolumeParams{
 		Tag:          volumeTag,
 		Size:         in.Size,
 		Provider:     providerType,
+		Pool:         in.Pool,
 		Attributes:   in.Attributes,
 		ResourceTags: in.Tags,
 		Attachment:   attachment,
+		SnapshotId:   in.SnapshotId,
 	}, nil
 }
 
@@ -714,7 +821,9 @@ func volumeAttachmentParamsFromParams(in params.VolumeAttachmentParams) (storage
 			InstanceId: instance.Id(in.InstanceId),
 			ReadOnly:   in.ReadOnly,
 		},
-		Volume:   volumeTag,
-		VolumeId: in.VolumeId,
+		Volume:     volumeTag,
+		VolumeId:   in.VolumeId,
+		Pool:       in.Pool,
+		Attributes: in.Attributes,
 	}, nil
 }
@@ -23,7 +23,7 @@ func createVolumes(ctx context.Context, deps *dependencies, ops map[names.Volume
 		volumeParams = append(volumeParams, op.args)
 	}
 	paramsBySource, volumeSources, err := volumeParamsBySource(
-		deps.config.StorageDir, volumeParams, deps.config.Registry,
+		deps.config.StorageDir, deps.config.Model.Id(), volumeParams, deps.config.Registry,
 	)
 	if err != nil {
 		return errors.Trace(err)
@@ -132,7 +132,7 @@ func attachVolumes(ctx context.Context, deps *dependencies, ops map[params.Machi
 		volumeAttachmentParams = append(volumeAttachmentParams, op.args)
 	}
 	paramsBySource, volumeSources, err := volumeAttachmentParamsBySource(
-		deps.config.StorageDir, volumeAttachmentParams, deps.config.Registry,
+		deps.config.StorageDir, deps.config.Model.Id(), volumeAttachmentParams, deps.config.Registry,
 	)
 	if err != nil {
 		return errors.Trace(err)
@@ -265,12 +265,14 @@ func removeVolumes(ctx context.Context, deps *dependencies, ops map[names.Volume
 	for i, args := range removeVolumeParams {
 		removeVolumeParamsByTag[tags[i]] = args
 		volumeParams[i] = storage.VolumeParams{
-			Tag:      tags[i],
-			Provider: storage.ProviderType(args.Provider),
+			Tag:        tags[i],
+			Provider:   storage.ProviderType(args.Provider),
+			Pool:       args.Pool,
+			Attributes: args.Attributes,
 		}
 	}
 	paramsBySource, volumeSources, err := volumeParamsBySource(
-		deps.config.StorageDir, volumeParams, deps.config.Registry,
+		deps.config.StorageDir, deps.config.Model.Id(), volumeParams, deps.config.Registry,
 	)
 	if err != nil {
 		return errors.Trace(err)
@@ -358,7 +360,7 @@ func detachVolumes(ctx context.Context, deps *dependencies, ops map[params.Machi
 		volumeAttachmentParams = append(volumeAttachmentParams, op.args)
 	}
 	paramsBySource, volumeSources, err := volumeAttachmentParamsBySource(
-		deps.config.StorageDir, volumeAttachmentParams, deps.config.Registry,
+		deps.config.StorageDir, deps.config.Model.Id(), volumeAttachmentParams, deps.config.Registry,
 	)
 	if err != nil {
 		return errors.Trace(err)
@@ -426,21 +428,21 @@ func detachVolumes(ctx context.Context, deps *dependencies, ops map[params.Machi
 // volumeParamsBySource separates the volume parameters by volume source.
 func volumeParamsBySource(
 	baseStorageDir string,
+	modelUUID string,
 	params []storage.VolumeParams,
 	registry storage.ProviderRegistry,
 ) (map[string][]storage.VolumeParams, map[string]storage.VolumeSource, error) {
-	// TODO(axw) later we may have multiple instantiations (sources)
-	// for a storage provider, e.g. multiple Ceph installations. For
-	// now we assume a single source for each provider type, with no
-	// configuration.
+	// There is a source for each storage pool, configured with the
+	// pool's attributes, and a source with no configuration for each
+	// provider type that volumes are allocated from directly.
 	volumeSources := make(map[string]storage.VolumeSource)
 	for _, params := range params {
-		sourceName := string(params.Provider)
+		sourceName := volumeSourceName(params.Pool, params.Provider)
 		if _, ok := volumeSources[sourceName]; ok {
 			continue
 		}
 		volumeSource, err := volumeSource(
-			baseStorageDir, sourceName, params.Provider, registry,
+			baseStorageDir, modelUUID, sourceName, params.Provider, params.Attributes, registry,
 		)
 		if errors.Cause(err) == errNonDynamic {
 			volumeSource = nil
@@ -451,7 +453,7 @@ func volumeParamsBySource(
 	}
 	paramsBySource := make(map[string][]storage.VolumeParams)
 	for _, params := range params {
-		sourceName := string(params.Provider)
+		sourceName := volumeSourceName(params.Pool, params.Provider)
 		volumeSource := volumeSources[sourceName]
 		if volumeSource == nil {
 			// Ignore nil volume sources; this means that the
@@ -482,23 +484,22 @@ func validateVolumeParams(
 // volumeAttachmentParamsBySource separates the volume attachment parameters by volume source.
 func volumeAttachmentParamsBySource(
 	baseStorageDir string,
+	modelUUID string,
 	params []storage.VolumeAttachmentParams,
 	registry storage.ProviderRegistry,
 ) (map[string][]storage.VolumeAttachmentParams, map[string]storage.VolumeSource, error) {
-	// TODO(axw) later we may have multiple instantiations (sources)
-	// for a storage provider, e.g. multiple Ceph installations. For
-	// now we assume a single source for each provider type, with no
-	// configuration.
+	// There is a source for each storage pool, as there is for
+	// creating the volumes.
 	volumeSources := make(map[string]storage.VolumeSource)
 	paramsBySource := make(map[string][]storage.VolumeAttachmentParams)
 	for _, params := range params {
-		sourceName := string(params.Provider)
+		sourceName := volumeSourceName(params.Pool, params.Provider)
 		paramsBySource[sourceName] = append(paramsBySource[sourceName], params)
 		if _, ok := volumeSources[sourceName]; ok {
 			continue
 		}
 		volumeSource, err := volumeSource(
-			baseStorageDir, sourceName, params.Provider, registry,
+			baseStorageDir, modelUUID, sourceName, params.Provider, params.Attributes, registry,
 		)
 		if errors.Cause(err) == errNonDynamic {
 			volumeSource = nil
@@ -48,7 +48,7 @@ func (s *trackedWorkerSuite) TestStorageProviderTypesWithCommon(c *tc.C) {
 
 	types, err := w.(*trackedWorker).StorageProviderTypes()
 	c.Assert(err, tc.ErrorIsNil)
-	c.Check(types, tc.DeepEquals, []storage.ProviderType{"ebs", "loop", "rootfs", "tmpfs"})
+	c.Check(types, tc.DeepEquals, []storage.ProviderType{"ebs", "loop", "lvm", "rootfs", "tmpfs"})
 }
 
 func (s *trackedWorkerSuite) TestStorageProviderTypesWithEmptyProviderTypes(c *tc.C) {
@@ -62,7 +62,7 @@ func (s *trackedWorkerSuite) TestStorageProviderTypesWithEmptyProviderTypes(c *t
 
 	types, err := w.(*trackedWorker).StorageProviderTypes()
 	c.Assert(err, tc.ErrorIsNil)
-	c.Check(types, tc.DeepEquals, []storage.ProviderType{"loop", "rootfs", "tmpfs"})
+	c.Check(types, tc.DeepEquals, []storage.ProviderType{"loop", "lvm", "rootfs", "tmpfs"})
 }
 
 func (s *trackedWorkerSuite) TestStorageProvider(c *tc.C) {
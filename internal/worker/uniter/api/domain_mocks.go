@@ -12,6 +12,7 @@ package api
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uniter "github.com/juju/juju/api/agent/uniter"
 	life "github.com/juju/juju/core/life"
@@ -586,6 +587,84 @@ func (c *MockUnitHasSubordinatesCall) DoAndReturn(f func(context.Context) (bool,
 	return c
 }
 
+// HookSchedules mocks base method.
+func (m *MockUnit) HookSchedules(arg0 context.Context) ([]charm.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HookSchedules", arg0)
+	ret0, _ := ret[0].([]charm.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HookSchedules indicates an expected call of HookSchedules.
+func (mr *MockUnitMockRecorder) HookSchedules(arg0 any) *MockUnitHookSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HookSchedules", reflect.TypeOf((*MockUnit)(nil).HookSchedules), arg0)
+	return &MockUnitHookSchedulesCall{Call: call}
+}
+
+// MockUnitHookSchedulesCall wrap *gomock.Call
+type MockUnitHookSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitHookSchedulesCall) Return(arg0 []charm.Schedule, arg1 error) *MockUnitHookSchedulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitHookSchedulesCall) Do(f func(context.Context) ([]charm.Schedule, error)) *MockUnitHookSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitHookSchedulesCall) DoAndReturn(f func(context.Context) ([]charm.Schedule, error)) *MockUnitHookSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// HookTimeout mocks base method.
+func (m *MockUnit) HookTimeout(arg0 context.Context) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HookTimeout", arg0)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HookTimeout indicates an expected call of HookTimeout.
+func (mr *MockUnitMockRecorder) HookTimeout(arg0 any) *MockUnitHookTimeoutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HookTimeout", reflect.TypeOf((*MockUnit)(nil).HookTimeout), arg0)
+	return &MockUnitHookTimeoutCall{Call: call}
+}
+
+// MockUnitHookTimeoutCall wrap *gomock.Call
+type MockUnitHookTimeoutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitHookTimeoutCall) Return(arg0 time.Duration, arg1 error) *MockUnitHookTimeoutCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitHookTimeoutCall) Do(f func(context.Context) (time.Duration, error)) *MockUnitHookTimeoutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitHookTimeoutCall) DoAndReturn(f func(context.Context) (time.Duration, error)) *MockUnitHookTimeoutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // LXDProfileName mocks base method.
 func (m *MockUnit) LXDProfileName(arg0 context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -1512,6 +1591,45 @@ func (c *MockUnitWatchConfigSettingsHashCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// WatchHookSchedules mocks base method.
+func (m *MockUnit) WatchHookSchedules(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchHookSchedules", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchHookSchedules indicates an expected call of WatchHookSchedules.
+func (mr *MockUnitMockRecorder) WatchHookSchedules(arg0 any) *MockUnitWatchHookSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchHookSchedules", reflect.TypeOf((*MockUnit)(nil).WatchHookSchedules), arg0)
+	return &MockUnitWatchHookSchedulesCall{Call: call}
+}
+
+// MockUnitWatchHookSchedulesCall wrap *gomock.Call
+type MockUnitWatchHookSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitWatchHookSchedulesCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockUnitWatchHookSchedulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitWatchHookSchedulesCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockUnitWatchHookSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitWatchHookSchedulesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockUnitWatchHookSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchInstanceData mocks base method.
 func (m *MockUnit) WatchInstanceData(arg0 context.Context) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
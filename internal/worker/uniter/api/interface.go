@@ -5,6 +5,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/names/v6"
 
@@ -48,6 +49,7 @@ type Unit interface {
 
 	ApplicationName() string
 	ConfigSettings(context.Context) (charm.Settings, error)
+	HookTimeout(context.Context) (time.Duration, error)
 	LogActionMessage(context.Context, names.ActionTag, string) error
 	Name() string
 	NetworkInfo(ctx context.Context, bindings []string, relationId *int) (map[string]params.NetworkInfoResult, error)
@@ -76,6 +78,8 @@ type Unit interface {
 	WatchActionNotifications(context.Context) (watcher.StringsWatcher, error)
 	WatchStorage(context.Context) (watcher.StringsWatcher, error)
 	WatchInstanceData(context.Context) (watcher.NotifyWatcher, error)
+	HookSchedules(context.Context) ([]charm.Schedule, error)
+	WatchHookSchedules(context.Context) (watcher.NotifyWatcher, error)
 
 	// Used by relationer.
 
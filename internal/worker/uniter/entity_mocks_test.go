@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
@@ -107,6 +108,8 @@ func (ctx *testContext) makeUnit(c tc.LikeC, unitTag names.UnitTag, l life.Value
 	u.EXPECT().Refresh(gomock.Any()).Return(nil).AnyTimes()
 	u.EXPECT().ProviderID().Return("").AnyTimes()
 	u.EXPECT().PrincipalName(gomock.Any()).Return("u", false, nil).AnyTimes()
+	u.EXPECT().HookTimeout(gomock.Any()).Return(time.Duration(0), nil).AnyTimes()
+	u.EXPECT().HookSchedules(gomock.Any()).Return(nil, nil).AnyTimes()
 	u.EXPECT().EnsureDead(gomock.Any()).DoAndReturn(func(context.Context) error {
 		u.mu.Lock()
 		u.life = life.Dead
@@ -70,6 +70,10 @@ type Info struct {
 
 	// SecretLabel is the secret label to expose to the hook.
 	SecretLabel string `yaml:"secret-label,omitempty"`
+
+	// ScheduleName is the name of the charm schedule which triggered the
+	// hook. It is only set for the scheduled hook.
+	ScheduleName string `yaml:"schedule-name,omitempty"`
 }
 
 // SecretHookRequiresRevision returns true if the hook context needs a secret revision.
@@ -123,13 +127,18 @@ func (hi Info) Validate() error {
 		return nil
 	case hooks.Action:
 		return errors.Errorf("hooks.Kind Action is deprecated")
-	case hooks.StorageAttached, hooks.StorageDetaching:
+	case hooks.StorageAttached, hooks.StorageDetaching, hooks.StorageResized:
 		if !names.IsValidStorage(hi.StorageId) {
 			return errors.Errorf("invalid storage ID %q", hi.StorageId)
 		}
 		return nil
 	case hooks.LeaderElected, hooks.LeaderDeposed:
 		return nil
+	case hooks.Scheduled:
+		if hi.ScheduleName == "" {
+			return errors.Errorf("%q hook requires a schedule name", hi.Kind)
+		}
+		return nil
 	case hooks.SecretRotate, hooks.SecretChanged, hooks.SecretExpired, hooks.SecretRemove:
 		if hi.SecretURI == "" {
 			return errors.Errorf("%q hook requires a secret URI", hi.Kind)
@@ -76,6 +76,9 @@ var validateTests = []struct {
 	}, {
 		hook.Info{Kind: hooks.SecretRotate, SecretURI: "foo"},
 		`invalid secret URI "foo"`,
+	}, {
+		hook.Info{Kind: hooks.Scheduled},
+		`"scheduled" hook requires a schedule name`,
 	},
 	{hook.Info{Kind: hooks.Install}, ""},
 	{hook.Info{Kind: hooks.Start}, ""},
@@ -93,6 +96,7 @@ var validateTests = []struct {
 	{hook.Info{Kind: hooks.StorageAttached, StorageId: "data/0"}, ""},
 	{hook.Info{Kind: hooks.StorageDetaching, StorageId: "data/0"}, ""},
 	{hook.Info{Kind: hooks.PebbleReady, WorkloadName: "gitlab"}, ""},
+	{hook.Info{Kind: hooks.Scheduled, ScheduleName: "nightly-backup"}, ""},
 }
 
 func (s *InfoSuite) TestValidate(c *tc.C) {
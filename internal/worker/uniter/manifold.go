@@ -11,6 +11,7 @@ import (
 	"github.com/juju/names/v6"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/juju/juju/agent"
 	"github.com/juju/juju/api"
@@ -26,6 +27,7 @@ import (
 	"github.com/juju/juju/internal/observability/probe"
 	"github.com/juju/juju/internal/s3client"
 	"github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/worker/common"
 	"github.com/juju/juju/internal/worker/common/reboot"
 	"github.com/juju/juju/internal/worker/fortress"
 	"github.com/juju/juju/internal/worker/secretexpire"
@@ -58,6 +60,10 @@ type ManifoldConfig struct {
 	Sidecar                      bool
 	EnforcedCharmModifiedVersion int
 	ContainerNames               []string
+
+	// PrometheusRegisterer is used to register the uniter's hook metrics,
+	// so they are exposed on the agent's introspection endpoint.
+	PrometheusRegisterer prometheus.Registerer
 }
 
 // Validate ensures all the required values for the config are set.
@@ -74,6 +80,9 @@ func (config *ManifoldConfig) Validate() error {
 	if config.Logger == nil {
 		return errors.NotValidf("missing Logger")
 	}
+	if config.PrometheusRegisterer == nil {
+		return errors.NotValidf("missing PrometheusRegisterer")
+	}
 	return nil
 }
 
@@ -188,6 +197,13 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				return secrets.NewClient(jujuSecretsAPI)
 			}
 
+			// Register the metrics collector against the prometheus register.
+			metricsCollector := NewMetricsCollector()
+			if err := config.PrometheusRegisterer.Register(metricsCollector); err != nil {
+				return nil, errors.Trace(err)
+			}
+			appName, _ := names.UnitApplication(unitTag.Id())
+
 			manifoldConfig := config
 			uniter, err := NewUniter(&UniterParams{
 				UniterClient: uniterapi.UniterClientShim{
@@ -218,17 +234,26 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				EnforcedCharmModifiedVersion: config.EnforcedCharmModifiedVersion,
 				ContainerNames:               config.ContainerNames,
 				Tracer:                       tracer,
+				HookMetrics:                  metricsCollector.ForApplication(appName),
 			})
 			if err != nil {
+				config.PrometheusRegisterer.Unregister(metricsCollector)
 				return nil, errors.Trace(err)
 			}
-			return uniter, nil
+			return common.NewCleanupWorker(uniter, func() {
+				// Clean up the metrics for the worker, so the next time a
+				// worker is created we can safely register the metrics again.
+				config.PrometheusRegisterer.Unregister(metricsCollector)
+			}), nil
 		},
 		Output: output,
 	}
 }
 
 func output(in worker.Worker, out interface{}) error {
+	if w, ok := in.(*common.CleanupWorker); ok {
+		in = w.Worker
+	}
 	uniter, _ := in.(*Uniter)
 	if uniter == nil {
 		return errors.Errorf("expected Uniter in")
@@ -10,6 +10,7 @@ import (
 	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/core/model"
@@ -34,6 +35,8 @@ func (s *ManifoldSuite) SetUpTest(c *tc.C) {
 		MachineLock: fakeLock{},
 		Logger:      loggertesting.WrapCheckLog(c),
 		ModelType:   model.IAAS,
+
+		PrometheusRegisterer: prometheus.NewRegistry(),
 	}
 }
 
@@ -70,6 +73,13 @@ func (s *ManifoldSuite) TestConfigValidationMissingModelType(c *tc.C) {
 	c.Check(err, tc.ErrorMatches, "missing model type not valid")
 }
 
+func (s *ManifoldSuite) TestConfigValidationMissingPrometheusRegisterer(c *tc.C) {
+	s.config.PrometheusRegisterer = nil
+	err := s.config.Validate()
+	c.Check(err, tc.ErrorIs, errors.NotValid)
+	c.Check(err, tc.ErrorMatches, "missing PrometheusRegisterer not valid")
+}
+
 type fakeLock struct {
 	machinelock.Lock
 }
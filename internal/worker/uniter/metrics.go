@@ -0,0 +1,74 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package uniter
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/juju/juju/internal/charm/hooks"
+)
+
+const (
+	uniterMetricsNamespace   = "juju"
+	uniterSubsystemNamespace = "uniter"
+)
+
+// hookDurationBuckets covers hooks which complete almost instantly through
+// to hooks which run for the better part of an hour.
+var hookDurationBuckets = []float64{
+	0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600, 1800, 3600,
+}
+
+// Collector defines a prometheus collector for the uniter.
+type Collector struct {
+	HookDuration *prometheus.HistogramVec
+}
+
+// NewMetricsCollector returns a new Collector.
+func NewMetricsCollector() *Collector {
+	return &Collector{
+		HookDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: uniterMetricsNamespace,
+			Subsystem: uniterSubsystemNamespace,
+			Name:      "hook_duration_seconds",
+			Help:      "Time spent running charm hooks, labeled per application and hook kind.",
+			Buckets:   hookDurationBuckets,
+		}, []string{"application", "hook", "failed"}),
+	}
+}
+
+// ForApplication returns a collector of hook metrics for the given
+// application.
+func (c *Collector) ForApplication(application string) *ApplicationCollector {
+	return &ApplicationCollector{
+		Collector:   c,
+		Application: application,
+	}
+}
+
+// Describe is part of the prometheus.Collector interface.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.HookDuration.Describe(ch)
+}
+
+// Collect is part of the prometheus.Collector interface.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.HookDuration.Collect(ch)
+}
+
+// ApplicationCollector is a prometheus collector extended with an
+// Application argument used in the metric labels.
+type ApplicationCollector struct {
+	*Collector
+	Application string
+}
+
+// ObserveHookDuration records the duration of a hook run for the
+// application. It implements operation.Metrics.
+func (c *ApplicationCollector) ObserveHookDuration(kind hooks.Kind, failed bool, duration time.Duration) {
+	c.HookDuration.WithLabelValues(c.Application, string(kind), strconv.FormatBool(failed)).Observe(duration.Seconds())
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package uniter_test
+
+import (
+	"bytes"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/juju/juju/internal/charm/hooks"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/worker/uniter"
+)
+
+type metricsSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestMetricsSuite(t *stdtesting.T) {
+	tc.Run(t, &metricsSuite{})
+}
+
+func (s *metricsSuite) TestHookDurationIsCollectedPerApplication(c *tc.C) {
+	collector := uniter.NewMetricsCollector()
+
+	collector.ForApplication("mysql").ObserveHookDuration(hooks.ConfigChanged, false, 3*time.Second)
+	collector.ForApplication("mysql").ObserveHookDuration(hooks.ConfigChanged, true, 90*time.Second)
+	collector.ForApplication("wordpress").ObserveHookDuration(hooks.Install, false, 400*time.Millisecond)
+
+	expected := bytes.NewBuffer([]byte(`
+# HELP juju_uniter_hook_duration_seconds Time spent running charm hooks, labeled per application and hook kind.
+# TYPE juju_uniter_hook_duration_seconds histogram
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="0.1"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="0.25"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="0.5"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="1"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="2.5"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="5"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="10"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="30"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="60"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="120"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="300"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="600"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="1800"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="3600"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="false",hook="config-changed",le="+Inf"} 1
+juju_uniter_hook_duration_seconds_sum{application="mysql",failed="false",hook="config-changed"} 3
+juju_uniter_hook_duration_seconds_count{application="mysql",failed="false",hook="config-changed"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="0.1"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="0.25"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="0.5"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="1"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="2.5"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="5"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="10"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="30"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="60"} 0
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="120"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="300"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="600"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="1800"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="3600"} 1
+juju_uniter_hook_duration_seconds_bucket{application="mysql",failed="true",hook="config-changed",le="+Inf"} 1
+juju_uniter_hook_duration_seconds_sum{application="mysql",failed="true",hook="config-changed"} 90
+juju_uniter_hook_duration_seconds_count{application="mysql",failed="true",hook="config-changed"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="0.1"} 0
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="0.25"} 0
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="0.5"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="1"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="2.5"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="5"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="10"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="30"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="60"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="120"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="300"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="600"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="1800"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="3600"} 1
+juju_uniter_hook_duration_seconds_bucket{application="wordpress",failed="false",hook="install",le="+Inf"} 1
+juju_uniter_hook_duration_seconds_sum{application="wordpress",failed="false",hook="install"} 0.4
+juju_uniter_hook_duration_seconds_count{application="wordpress",failed="false",hook="install"} 1
+		`[1:]))
+
+	err := testutil.CollectAndCompare(collector, expected, "juju_uniter_hook_duration_seconds")
+	c.Assert(err, tc.ErrorIsNil)
+}
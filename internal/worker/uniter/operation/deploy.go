@@ -119,12 +119,14 @@ func (d *deploy) checkAlreadyDone(state State) error {
 }
 
 func (d *deploy) getState(state State, step Step) *State {
+	interruptedHook := d.interruptedHook(state)
 	return stateChange{
-		Kind:     d.kind,
-		Step:     step,
-		CharmURL: d.charmURL,
-		Hook:     d.interruptedHook(state),
-		HookStep: state.HookStep,
+		Kind:         d.kind,
+		Step:         step,
+		CharmURL:     d.charmURL,
+		Hook:         interruptedHook,
+		HookStep:     state.HookStep,
+		HookTimedOut: interruptedHook != nil && state.HookTimedOut,
 	}.apply(state)
 }
 
@@ -7,15 +7,21 @@ import (
 	"context"
 	"fmt"
 	"runtime/pprof"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/trace"
+	jujucharm "github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/charm/hooks"
+	"github.com/juju/juju/internal/worker/uniter/hook"
 	"github.com/juju/juju/internal/worker/uniter/remotestate"
 )
 
 type executorStep struct {
+	name string
 	verb string
 	run  func(op Operation, ctx context.Context, state State) (*State, error)
 }
@@ -25,16 +31,38 @@ func (step executorStep) message(op Operation, unitName string) string {
 }
 
 var (
-	stepPrepare = executorStep{"preparing", Operation.Prepare}
-	stepExecute = executorStep{"executing", Operation.Execute}
-	stepCommit  = executorStep{"committing", Operation.Commit}
+	stepPrepare = executorStep{"prepare", "preparing", Operation.Prepare}
+	stepExecute = executorStep{"execute", "executing", Operation.Execute}
+	stepCommit  = executorStep{"commit", "committing", Operation.Commit}
 )
 
+// Metrics records the time taken by the hooks run by an Executor.
+type Metrics interface {
+	// ObserveHookDuration records how long a hook of the given kind took
+	// to execute, and whether it exited with a non-zero exit code.
+	ObserveHookDuration(kind hooks.Kind, failed bool, duration time.Duration)
+}
+
+// hookOperation is implemented by operations which run a hook, so the
+// executor can record the details of the hook against its trace spans
+// and metrics.
+type hookOperation interface {
+	// hookInfo returns the details of the hook being run.
+	hookInfo() hook.Info
+
+	// hookExitCode returns the exit code of the hook process, and false if
+	// the hook has not been run.
+	hookExitCode() (int, bool)
+}
+
 type executor struct {
 	unitName           string
 	stateOps           *StateOps
 	state              *State
+	charmURL           string
 	acquireMachineLock func(string, string) (func(), error)
+	clock              clock.Clock
+	metrics            Metrics
 	logger             logger.Logger
 }
 
@@ -43,13 +71,21 @@ type ExecutorConfig struct {
 	StateReadWriter UnitStateReadWriter
 	InitialState    State
 	AcquireLock     func(string, string) (func(), error)
+	Clock           clock.Clock
 	Logger          logger.Logger
+
+	// Metrics, if not nil, records the duration of each hook run by
+	// the executor.
+	Metrics Metrics
 }
 
 func (e ExecutorConfig) validate() error {
 	if e.StateReadWriter == nil {
 		return errors.NotValidf("executor config with nil state ops")
 	}
+	if e.Clock == nil {
+		return errors.NotValidf("executor config with nil clock")
+	}
 	if e.Logger == nil {
 		return errors.NotValidf("executor config with nil logger")
 	}
@@ -70,11 +106,20 @@ func NewExecutor(ctx context.Context, unitName string, cfg ExecutorConfig) (Exec
 	} else if err != nil {
 		return nil, err
 	}
+	// The operation state only records the charm URL while a charm is
+	// being deployed, so fall back to the charm URL of the initial state.
+	charmURL := state.CharmURL
+	if charmURL == "" {
+		charmURL = cfg.InitialState.CharmURL
+	}
 	return &executor{
 		unitName:           unitName,
 		stateOps:           stateOps,
 		state:              state,
+		charmURL:           charmURL,
 		acquireMachineLock: cfg.AcquireLock,
+		clock:              cfg.Clock,
+		metrics:            cfg.Metrics,
 		logger:             cfg.Logger,
 	}, nil
 }
@@ -163,9 +208,30 @@ func (x *executor) run(ctx context.Context, op Operation, remoteStateChange <-ch
 }
 
 func (x *executor) do(ctx context.Context, op Operation, step executorStep) (err error) {
+	hookOp, isHook := unwrapHookOperation(op)
+	runsHook := isHook && step.name == stepExecute.name
+
+	ctx, span := trace.Start(ctx, trace.Name("operation."+step.name), trace.WithAttributes(
+		x.spanAttributes(op, hookOp, step)...,
+	))
+	defer func() {
+		span.RecordError(err)
+		var attrs []trace.Attribute
+		if runsHook {
+			if code, ok := hookOp.hookExitCode(); ok {
+				attrs = append(attrs, trace.IntAttr("hook.exit-code", code))
+			}
+		}
+		span.End(attrs...)
+	}()
+
 	message := step.message(op, x.unitName)
 	x.logger.Debugf(ctx, message)
+	started := x.clock.Now()
 	newState, firstErr := step.run(op, ctx, *x.state)
+	if runsHook {
+		x.observeHookDuration(hookOp, x.clock.Now().Sub(started))
+	}
 	if newState != nil {
 		writeErr := x.writeState(ctx, *newState)
 		if firstErr == nil {
@@ -177,6 +243,55 @@ func (x *executor) do(ctx context.Context, op Operation, step executorStep) (err
 	return errors.Annotate(firstErr, message)
 }
 
+// spanAttributes returns the attributes recorded against the span of an
+// operation step.
+func (x *executor) spanAttributes(op Operation, hookOp hookOperation, step executorStep) []trace.Attribute {
+	attrs := []trace.Attribute{
+		trace.StringAttr("executor.operation", op.String()),
+		trace.StringAttr("executor.step", step.name),
+		trace.StringAttr("executor.unit", x.unitName),
+	}
+	if curl, err := jujucharm.ParseURL(x.charmURL); err == nil && curl.Revision >= 0 {
+		attrs = append(attrs, trace.IntAttr("charm.revision", curl.Revision))
+	}
+	if hookOp != nil {
+		info := hookOp.hookInfo()
+		attrs = append(attrs, trace.StringAttr("hook.kind", string(info.Kind)))
+		if info.Kind.IsRelation() {
+			attrs = append(attrs, trace.IntAttr("hook.relation-id", info.RelationId))
+		}
+	}
+	return attrs
+}
+
+// observeHookDuration records the duration of a hook which has been run.
+func (x *executor) observeHookDuration(hookOp hookOperation, duration time.Duration) {
+	if x.metrics == nil {
+		return
+	}
+	code, ok := hookOp.hookExitCode()
+	if !ok {
+		return
+	}
+	x.metrics.ObserveHookDuration(hookOp.hookInfo().Kind, code != 0, duration)
+}
+
+// unwrapHookOperation peels back all the layers of a wrapped operation,
+// returning the operation if it runs a hook.
+func unwrapHookOperation(op Operation) (hookOperation, bool) {
+	for op != nil {
+		if hookOp, ok := op.(hookOperation); ok {
+			return hookOp, true
+		}
+		wrapped, ok := op.(WrappedOperation)
+		if !ok {
+			break
+		}
+		op = wrapped.WrappedOperation()
+	}
+	return nil, false
+}
+
 func (x *executor) writeState(ctx context.Context, newState State) error {
 	if err := newState.Validate(); err != nil {
 		return err
@@ -188,5 +303,8 @@ func (x *executor) writeState(ctx context.Context, newState State) error {
 		return errors.Annotatef(err, "writing state")
 	}
 	x.state = &newState
+	if newState.CharmURL != "" {
+		x.charmURL = newState.CharmURL
+	}
 	return nil
 }
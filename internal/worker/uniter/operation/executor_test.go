@@ -5,14 +5,17 @@ package operation_test
 
 import (
 	"context"
+	"os/exec"
 	"testing"
 	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 	"gopkg.in/yaml.v2"
 
+	"github.com/juju/juju/core/trace"
 	"github.com/juju/juju/internal/charm/hooks"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testhelpers"
@@ -69,6 +72,7 @@ func (s *NewExecutorSuite) TestNewExecutorInvalidStateRead(c *tc.C) {
 		StateReadWriter: s.mockStateRW,
 		InitialState:    initialState,
 		AcquireLock:     failAcquireLock,
+		Clock:           clock.WallClock,
 		Logger:          loggertesting.WrapCheckLog(c),
 	}
 	executor, err := operation.NewExecutor(c.Context(), "test", cfg)
@@ -84,6 +88,7 @@ func (s *NewExecutorSuite) TestNewExecutorNoInitialState(c *tc.C) {
 		StateReadWriter: s.mockStateRW,
 		InitialState:    initialState,
 		AcquireLock:     failAcquireLock,
+		Clock:           clock.WallClock,
 		Logger:          loggertesting.WrapCheckLog(c),
 	}
 	executor, err := operation.NewExecutor(c.Context(), "test", cfg)
@@ -102,6 +107,7 @@ func (s *NewExecutorSuite) TestNewExecutorValidFile(c *tc.C) {
 		StateReadWriter: s.mockStateRW,
 		InitialState:    operation.State{Step: operation.Queued},
 		AcquireLock:     failAcquireLock,
+		Clock:           clock.WallClock,
 		Logger:          loggertesting.WrapCheckLog(c),
 	}
 	executor, err := operation.NewExecutor(c.Context(), "test", cfg)
@@ -200,6 +206,7 @@ func (s *ExecutorSuite) newExecutor(c *tc.C, st *operation.State) operation.Exec
 		StateReadWriter: s.mockStateRW,
 		InitialState:    operation.State{Step: operation.Queued},
 		AcquireLock:     failAcquireLock,
+		Clock:           clock.WallClock,
 		Logger:          loggertesting.WrapCheckLog(c),
 	}
 	executor, err := operation.NewExecutor(c.Context(), "test", cfg)
@@ -484,6 +491,7 @@ func (s *ExecutorSuite) initLockTest(c *tc.C, lockFunc func(string, string) (fun
 		StateReadWriter: s.mockStateRW,
 		InitialState:    operation.State{Step: operation.Queued},
 		AcquireLock:     lockFunc,
+		Clock:           clock.WallClock,
 		Logger:          loggertesting.WrapCheckLog(c),
 	}
 	executor, err := operation.NewExecutor(c.Context(), "test", cfg)
@@ -689,6 +697,200 @@ func (s *ExecutorSuite) TestLockUnlocksOnError_Commit(c *tc.C) {
 	c.Assert(mockLock.stepsCalledOnUnlock, tc.DeepEquals, expectedStepsOnUnlock)
 }
 
+func (s *ExecutorSuite) TestRunHookRecordsSpansAndMetrics(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metrics := &mockMetrics{}
+	executor := s.newHookExecutor(c, metrics)
+	op := newRunHookOperation(c, hook.Info{
+		Kind:              hooks.RelationChanged,
+		RelationId:        7,
+		RemoteUnit:        "wordpress/0",
+		RemoteApplication: "wordpress",
+	}, nil)
+
+	tracer := &recordingTracer{}
+	err := executor.Run(trace.WithTracer(c.Context(), tracer), op, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(metrics.observed, tc.DeepEquals, []observedHook{{
+		kind:     hooks.RelationChanged,
+		failed:   false,
+		duration: time.Second,
+	}})
+
+	for _, step := range []string{"prepare", "execute", "commit"} {
+		span := tracer.span(c, "operation."+step)
+		c.Check(span.attrs["executor.step"], tc.Equals, step)
+		c.Check(span.attrs["executor.unit"], tc.Equals, "test")
+		c.Check(span.attrs["charm.revision"], tc.Equals, "42")
+		c.Check(span.attrs["hook.kind"], tc.Equals, "relation-changed")
+		c.Check(span.attrs["hook.relation-id"], tc.Equals, "7")
+	}
+	c.Check(tracer.span(c, "operation.execute").attrs["hook.exit-code"], tc.Equals, "0")
+	c.Check(tracer.span(c, "operation.commit").attrs["hook.exit-code"], tc.Equals, "")
+}
+
+func (s *ExecutorSuite) TestRunFailedHookRecordsExitCode(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	exitErr := exec.Command("/bin/sh", "-c", "exit 3").Run()
+	c.Assert(exitErr, tc.FitsTypeOf, &exec.ExitError{})
+
+	metrics := &mockMetrics{}
+	executor := s.newHookExecutor(c, metrics)
+	op := newRunHookOperation(c, hook.Info{Kind: hooks.ConfigChanged}, exitErr)
+
+	tracer := &recordingTracer{}
+	err := executor.Run(trace.WithTracer(c.Context(), tracer), op, nil)
+	c.Assert(errors.Cause(err), tc.Equals, operation.ErrHookFailed)
+
+	c.Check(metrics.observed, tc.DeepEquals, []observedHook{{
+		kind:     hooks.ConfigChanged,
+		failed:   true,
+		duration: time.Second,
+	}})
+
+	span := tracer.span(c, "operation.execute")
+	c.Check(span.attrs["hook.kind"], tc.Equals, "config-changed")
+	c.Check(span.attrs["hook.exit-code"], tc.Equals, "3")
+	_, ok := span.attrs["hook.relation-id"]
+	c.Check(ok, tc.IsFalse)
+}
+
+func (s *ExecutorSuite) TestRunNonHookOperationRecordsNoMetrics(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metrics := &mockMetrics{}
+	executor := s.newHookExecutor(c, metrics)
+	op := &mockOperation{
+		prepare: newStep(nil, nil),
+		execute: newStep(nil, nil),
+		commit:  newStep(nil, nil),
+	}
+
+	tracer := &recordingTracer{}
+	err := executor.Run(trace.WithTracer(c.Context(), tracer), op, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(metrics.observed, tc.HasLen, 0)
+	span := tracer.span(c, "operation.execute")
+	c.Check(span.attrs["executor.operation"], tc.Equals, "mock operation")
+	_, ok := span.attrs["hook.kind"]
+	c.Check(ok, tc.IsFalse)
+}
+
+func (s *ExecutorSuite) newHookExecutor(c *tc.C, metrics operation.Metrics) operation.Executor {
+	s.expectState(c, justInstalledState())
+	s.mockStateRW.EXPECT().SetState(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	executor, err := operation.NewExecutor(c.Context(), "test", operation.ExecutorConfig{
+		StateReadWriter: s.mockStateRW,
+		InitialState: operation.State{
+			Kind:     operation.Install,
+			Step:     operation.Queued,
+			CharmURL: "ch:amd64/mysql-42",
+		},
+		AcquireLock: func(_, _ string) (func(), error) {
+			return func() {}, nil
+		},
+		Clock:   &steppingClock{now: time.Now()},
+		Logger:  loggertesting.WrapCheckLog(c),
+		Metrics: metrics,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return executor
+}
+
+func newRunHookOperation(c *tc.C, info hook.Info, runErr error) operation.Operation {
+	callbacks := &commitHookCallbacks{
+		ExecuteHookCallbacks: &ExecuteHookCallbacks{
+			PrepareHookCallbacks:    NewPrepareHookCallbacks(info.Kind),
+			MockNotifyHookCompleted: &MockNotify{},
+			MockNotifyHookFailed:    &MockNotify{},
+		},
+	}
+	factory := newOpFactory(c, NewRunHookRunnerFactory(runErr), callbacks)
+	op, err := factory.NewRunHook(info)
+	c.Assert(err, tc.ErrorIsNil)
+	return op
+}
+
+type commitHookCallbacks struct {
+	*ExecuteHookCallbacks
+}
+
+func (cb *commitHookCallbacks) CommitHook(context.Context, hook.Info) error {
+	return nil
+}
+
+// steppingClock advances by a second every time it is asked the time.
+type steppingClock struct {
+	clock.Clock
+	now time.Time
+}
+
+func (c *steppingClock) Now() time.Time {
+	c.now = c.now.Add(time.Second)
+	return c.now
+}
+
+type observedHook struct {
+	kind     hooks.Kind
+	failed   bool
+	duration time.Duration
+}
+
+type mockMetrics struct {
+	observed []observedHook
+}
+
+func (m *mockMetrics) ObserveHookDuration(kind hooks.Kind, failed bool, duration time.Duration) {
+	m.observed = append(m.observed, observedHook{kind: kind, failed: failed, duration: duration})
+}
+
+type recordingTracer struct {
+	trace.NoopTracer
+	spans []*recordingSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, options ...trace.Option) (context.Context, trace.Span) {
+	opts := trace.NewTracerOptions()
+	for _, option := range options {
+		option(opts)
+	}
+	span := &recordingSpan{name: name, attrs: make(map[string]string)}
+	span.record(opts.Attributes())
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func (t *recordingTracer) span(c *tc.C, name string) *recordingSpan {
+	for _, span := range t.spans {
+		if span.name == name {
+			return span
+		}
+	}
+	c.Fatalf("span %q not recorded", name)
+	return nil
+}
+
+type recordingSpan struct {
+	trace.NoopSpan
+	name  string
+	attrs map[string]string
+}
+
+func (s *recordingSpan) End(attrs ...trace.Attribute) {
+	s.record(attrs)
+}
+
+func (s *recordingSpan) record(attrs []trace.Attribute) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key()] = attr.Value()
+	}
+}
+
 type mockLockFunc struct {
 	noStepsCalledOnLock bool
 	stepsCalledOnUnlock []bool
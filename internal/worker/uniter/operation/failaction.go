@@ -24,10 +24,11 @@ func (fa *failAction) String() string {
 // Prepare is part of the Operation interface.
 func (fa *failAction) Prepare(ctx context.Context, state State) (*State, error) {
 	return stateChange{
-		Kind:     RunAction,
-		Step:     Pending,
-		ActionId: &fa.actionId,
-		Hook:     state.Hook,
+		Kind:         RunAction,
+		Step:         Pending,
+		ActionId:     &fa.actionId,
+		Hook:         state.Hook,
+		HookTimedOut: state.HookTimedOut,
 	}.apply(state), nil
 }
 
@@ -38,10 +39,11 @@ func (fa *failAction) Execute(ctx context.Context, state State) (*State, error)
 	}
 
 	return stateChange{
-		Kind:     RunAction,
-		Step:     Done,
-		ActionId: &fa.actionId,
-		Hook:     state.Hook,
+		Kind:         RunAction,
+		Step:         Done,
+		ActionId:     &fa.actionId,
+		Hook:         state.Hook,
+		HookTimedOut: state.HookTimedOut,
 	}.apply(state), nil
 }
 
@@ -49,9 +51,10 @@ func (fa *failAction) Execute(ctx context.Context, state State) (*State, error)
 // Commit is part of the Operation interface.
 func (fa *failAction) Commit(ctx context.Context, state State) (*State, error) {
 	return stateChange{
-		Kind: continuationKind(state),
-		Step: Pending,
-		Hook: state.Hook,
+		Kind:         continuationKind(state),
+		Step:         Pending,
+		Hook:         state.Hook,
+		HookTimedOut: state.HookTimedOut,
 	}.apply(state), nil
 }
 
@@ -77,10 +77,11 @@ func (ra *runAction) Prepare(ctx context.Context, state State) (*State, error) {
 	ra.name = actionData.Name
 	ra.runner = rnr
 	return stateChange{
-		Kind:     RunAction,
-		Step:     Pending,
-		ActionId: &actionID,
-		Hook:     state.Hook,
+		Kind:         RunAction,
+		Step:         Pending,
+		ActionId:     &actionID,
+		Hook:         state.Hook,
+		HookTimedOut: state.HookTimedOut,
 	}.apply(state), nil
 }
 
@@ -126,10 +127,11 @@ func (ra *runAction) Execute(ctx context.Context, state State) (*State, error) {
 		return nil, errors.Annotatef(err, "action %q (via %s) failed", ra.name, handlerType)
 	}
 	return stateChange{
-		Kind:     RunAction,
-		Step:     Done,
-		ActionId: &actionID,
-		Hook:     state.Hook,
+		Kind:         RunAction,
+		Step:         Done,
+		ActionId:     &actionID,
+		Hook:         state.Hook,
+		HookTimedOut: state.HookTimedOut,
 	}.apply(state), nil
 }
 
@@ -137,9 +139,10 @@ func (ra *runAction) Execute(ctx context.Context, state State) (*State, error) {
 // Commit is part of the Operation interface.
 func (ra *runAction) Commit(ctx context.Context, state State) (*State, error) {
 	return stateChange{
-		Kind: continuationKind(state),
-		Step: Pending,
-		Hook: state.Hook,
+		Kind:         continuationKind(state),
+		Step:         Pending,
+		Hook:         state.Hook,
+		HookTimedOut: state.HookTimedOut,
 	}.apply(state), nil
 }
 
@@ -6,6 +6,7 @@ package operation
 import (
 	stdcontext "context"
 	"fmt"
+	"os/exec"
 
 	"github.com/juju/errors"
 
@@ -34,6 +35,11 @@ type runHook struct {
 
 	hookFound bool
 
+	// exitCode holds the exit code of the hook process, once the hook has
+	// been run.
+	exitCode    int
+	hasExitCode bool
+
 	RequiresMachineLock
 }
 
@@ -51,6 +57,8 @@ func (rh *runHook) String() string {
 		}
 	case rh.info.Kind.IsStorage():
 		suffix = fmt.Sprintf(" (%s)", rh.info.StorageId)
+	case rh.info.Kind == hooks.Scheduled:
+		suffix = fmt.Sprintf(" (%s)", rh.info.ScheduleName)
 	case rh.info.Kind.IsSecret():
 		if rh.info.SecretRevision == 0 || !hook.SecretHookRequiresRevision(rh.info.Kind) {
 			suffix = fmt.Sprintf(" (%s)", rh.info.SecretURI)
@@ -126,6 +134,9 @@ func RunningHookMessage(hookName string, info hook.Info) string {
 		}
 		return fmt.Sprintf("running %s hook for %s%s", hookName, info.SecretURI, revMsg)
 	}
+	if info.Kind == hooks.Scheduled {
+		return fmt.Sprintf("running %s hook for %s", hookName, info.ScheduleName)
+	}
 	return fmt.Sprintf("running %s hook", hookName)
 }
 
@@ -152,6 +163,7 @@ func (rh *runHook) Execute(ctx stdcontext.Context, state State) (*State, error)
 	step := Done
 
 	handlerType, err := rh.runner.RunHook(ctx, rh.name)
+	rh.exitCode, rh.hasExitCode = hookExitCode(err)
 	cause := errors.Cause(err)
 	switch {
 	case charmrunner.IsMissingHookError(cause):
@@ -175,6 +187,18 @@ func (rh *runHook) Execute(ctx stdcontext.Context, state State) (*State, error)
 			Hook:     &rh.info,
 			HookStep: &step,
 		}.apply(state), runner.ErrTerminated
+	case cause == runner.ErrHookTimedOut:
+		// Record that the hook timed out, so the unit agent can report
+		// why the hook failed.
+		rh.logger.Errorf(ctx, "hook %q (via %s) timed out", rh.name, handlerType)
+		rh.exitCode, rh.hasExitCode = -1, true
+		rh.callbacks.NotifyHookFailed(rh.name, rh.runner.Context())
+		return stateChange{
+			Kind:         RunHook,
+			Step:         Pending,
+			Hook:         &rh.info,
+			HookTimedOut: true,
+		}.apply(state), ErrHookFailed
 	case err == nil:
 	default:
 		rh.logger.Errorf(ctx, "hook %q (via %s) failed: %v", rh.name, handlerType, err)
@@ -203,6 +227,29 @@ func (rh *runHook) Execute(ctx stdcontext.Context, state State) (*State, error)
 	}.apply(state), err
 }
 
+// hookExitCode returns the exit code of a hook process from the error
+// returned by running it, and false if the hook process did not run.
+func hookExitCode(err error) (int, bool) {
+	if err == nil {
+		return 0, true
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), true
+	}
+	return 0, false
+}
+
+// hookInfo is part of the hookOperation interface.
+func (rh *runHook) hookInfo() hook.Info {
+	return rh.info
+}
+
+// hookExitCode is part of the hookOperation interface.
+func (rh *runHook) hookExitCode() (int, bool) {
+	return rh.exitCode, rh.hasExitCode
+}
+
 func (rh *runHook) beforeHook(ctx stdcontext.Context, state State) error {
 	var err error
 	switch rh.info.Kind {
@@ -307,6 +307,35 @@ func (s *RunHookSuite) TestExecuteTerminated(c *tc.C) {
 	c.Assert(callbacks.MockNotifyHookCompleted.gotName, tc.IsNil)
 }
 
+func (s *RunHookSuite) TestExecuteTimedOut(c *tc.C) {
+	runErr := runner.ErrHookTimedOut
+	op, callbacks, runnerFactory := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.ConfigChanged, runErr)
+	_, err := op.Prepare(c.Context(), operation.State{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	newState, err := op.Execute(c.Context(), operation.State{})
+	c.Assert(err, tc.Equals, operation.ErrHookFailed)
+
+	s.assertStateMatches(c, newState, operation.RunHook, operation.Pending, hooks.ConfigChanged)
+	c.Assert(newState.HookTimedOut, tc.IsTrue)
+
+	c.Assert(*runnerFactory.MockNewHookRunner.runner.MockRunHook.gotName, tc.Equals, "config-changed")
+	c.Assert(*callbacks.MockNotifyHookFailed.gotName, tc.Equals, "config-changed")
+	c.Assert(callbacks.MockNotifyHookCompleted.gotName, tc.IsNil)
+}
+
+func (s *RunHookSuite) TestPrepareClearsHookTimedOut(c *tc.C) {
+	op, _, _ := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.ConfigChanged, nil)
+	newState, err := op.Prepare(c.Context(), operation.State{
+		Kind:         operation.RunHook,
+		Step:         operation.Pending,
+		Hook:         &hook.Info{Kind: hooks.ConfigChanged},
+		HookTimedOut: true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(newState.HookTimedOut, tc.IsFalse)
+}
+
 func (s *RunHookSuite) TestInstallHookPreservesStatus(c *tc.C) {
 	op, callbacks, f := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.Install, nil)
 	err := f.MockNewHookRunner.runner.Context().SetUnitStatus(c.Context(), jujuc.StatusInfo{Status: "blocked", Info: "no database"})
@@ -923,6 +952,17 @@ func (s *RunHookSuite) TestRunningHookMessageForSecretHooksWithRevision(c *tc.C)
 	c.Assert(msg, tc.Equals, `running secret-expired hook for secret:9m4e2mr0ui3e8a215n4g/666`)
 }
 
+func (s *RunHookSuite) TestRunningHookMessageForScheduledHooks(c *tc.C) {
+	msg := operation.RunningHookMessage(
+		"scheduled",
+		hook.Info{
+			Kind:         hooks.Scheduled,
+			ScheduleName: "nightly-backup",
+		},
+	)
+	c.Assert(msg, tc.Equals, `running scheduled hook for nightly-backup`)
+}
+
 func (s *RunHookSuite) TestCommitSuccess_SecretRotate_SetRotated(c *tc.C) {
 	callbacks := &CommitHookCallbacks{
 		MockCommitHook: &MockCommitHook{},
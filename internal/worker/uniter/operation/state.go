@@ -98,6 +98,10 @@ type State struct {
 	// state when initialising the agent and running any upgrade operation.
 	HookStep *Step `yaml:"hook-step,omitempty"`
 
+	// HookTimedOut records whether the hook was killed because it ran for
+	// longer than the hook timeout. It will only be set if Hook is also set.
+	HookTimedOut bool `yaml:"hook-timed-out,omitempty"`
+
 	// ActionId holds action information relevant to the current operation. If
 	// Kind is Continue, it holds the last action that was executed; if Kind is
 	// RunAction, it holds the running action.
@@ -215,6 +219,7 @@ type stateChange struct {
 	Step            Step
 	Hook            *hook.Info
 	HookStep        *Step
+	HookTimedOut    bool
 	ActionId        *string
 	CharmURL        string
 	HasRunStatusSet bool
@@ -225,6 +230,7 @@ func (change stateChange) apply(state State) *State {
 	state.Step = change.Step
 	state.Hook = change.Hook
 	state.HookStep = change.HookStep
+	state.HookTimedOut = change.HookTimedOut
 	state.ActionId = change.ActionId
 	state.CharmURL = change.CharmURL
 	state.StatusSet = state.StatusSet || change.HasRunStatusSet
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/worker/uniter/api"
 	"github.com/juju/juju/rpc/params"
 )
@@ -234,6 +235,8 @@ type mockUnit struct {
 	actionWatcher                    *mockStringsWatcher
 	relationsWatcher                 *mockStringsWatcher
 	instanceDataWatcher              *mockNotifyWatcher
+	hookSchedulesWatcher             *mockNotifyWatcher
+	hookSchedules                    []charm.Schedule
 	lxdProfileName                   string
 }
 
@@ -301,6 +304,14 @@ func (u *mockUnit) WatchInstanceData(_ context.Context) (watcher.NotifyWatcher,
 	return u.instanceDataWatcher, nil
 }
 
+func (u *mockUnit) WatchHookSchedules(_ context.Context) (watcher.NotifyWatcher, error) {
+	return u.hookSchedulesWatcher, nil
+}
+
+func (u *mockUnit) HookSchedules(_ context.Context) ([]charm.Schedule, error) {
+	return u.hookSchedules, nil
+}
+
 type mockApplication struct {
 	api.Application
 	tag                  names.ApplicationTag
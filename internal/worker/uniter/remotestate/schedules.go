@@ -0,0 +1,128 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package remotestate
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/cron"
+)
+
+// hookSchedule tracks when the scheduled hook is next due to run for a
+// named schedule.
+type hookSchedule struct {
+	schedule charm.Schedule
+	cron     *cron.Schedule
+
+	// next is the time the hook is next due to run, including any jitter.
+	// It is zero if the schedule can never be activated.
+	next time.Time
+}
+
+// hookSchedulesChanged is called when the unit's hook schedules may have
+// changed, either because the operator changed them or because the charm
+// changed. The next run of any new or modified schedule is recalculated;
+// unmodified schedules keep their pending run.
+func (w *RemoteStateWatcher) hookSchedulesChanged(ctx context.Context) error {
+	schedules, err := w.unit.HookSchedules(ctx)
+	if errors.Is(err, errors.NotImplemented) {
+		w.logger.Debugf(ctx, "controller does not support scheduled hooks")
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	current := make(map[string]*hookSchedule, len(schedules))
+	for _, schedule := range schedules {
+		if existing, ok := w.hookSchedules[schedule.Name]; ok && existing.schedule == schedule {
+			current[schedule.Name] = existing
+			continue
+		}
+		parsed, err := cron.Parse(schedule.Cron)
+		if err != nil {
+			// The controller validates schedules, so this should never
+			// happen; don't stop the unit from running other hooks.
+			w.logger.Warningf(ctx, "ignoring schedule %q: %v", schedule.Name, err)
+			continue
+		}
+		hs := &hookSchedule{
+			schedule: schedule,
+			cron:     parsed,
+		}
+		hs.next = w.nextScheduledRun(hs)
+		w.logger.Debugf(ctx, "scheduled hook for %q next due at %v", schedule.Name, hs.next)
+		current[schedule.Name] = hs
+	}
+	w.hookSchedules = current
+
+	// Drop any pending run of a schedule which no longer exists.
+	w.mu.Lock()
+	for name := range w.current.ScheduledHookVersions {
+		if _, ok := current[name]; !ok {
+			delete(w.current.ScheduledHookVersions, name)
+		}
+	}
+	w.mu.Unlock()
+
+	w.resetScheduledHookTimer()
+	return nil
+}
+
+// scheduledHooksDue is called when the scheduled hook timer expires. It
+// increments the version of each schedule that is due, so that the
+// resolver runs the scheduled hook for it.
+func (w *RemoteStateWatcher) scheduledHooksDue() {
+	now := w.clock.Now()
+
+	w.mu.Lock()
+	for name, hs := range w.hookSchedules {
+		if hs.next.IsZero() || hs.next.After(now) {
+			continue
+		}
+		w.current.ScheduledHookVersions[name]++
+		hs.next = w.nextScheduledRun(hs)
+	}
+	w.mu.Unlock()
+
+	w.resetScheduledHookTimer()
+}
+
+// nextScheduledRun returns the time the hook is next due to run for the
+// schedule, adding a random delay of up to the schedule's jitter. Cron
+// expressions are evaluated in UTC.
+func (w *RemoteStateWatcher) nextScheduledRun(hs *hookSchedule) time.Time {
+	next := hs.cron.Next(w.clock.Now().UTC())
+	if next.IsZero() {
+		return next
+	}
+	if jitter := hs.schedule.Jitter; jitter > 0 {
+		next = next.Add(time.Duration(rand.Int64N(int64(jitter))))
+	}
+	return next
+}
+
+// resetScheduledHookTimer sets the scheduled hook timer to expire when the
+// earliest schedule is next due. The timer is cleared if there are no
+// schedules to run.
+func (w *RemoteStateWatcher) resetScheduledHookTimer() {
+	var earliest time.Time
+	for _, hs := range w.hookSchedules {
+		if hs.next.IsZero() {
+			continue
+		}
+		if earliest.IsZero() || hs.next.Before(earliest) {
+			earliest = hs.next
+		}
+	}
+	if earliest.IsZero() {
+		w.scheduledHookTimer = nil
+		return
+	}
+	w.scheduledHookTimer = w.clock.After(earliest.Sub(w.clock.Now()))
+}
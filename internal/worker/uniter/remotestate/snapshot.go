@@ -69,6 +69,11 @@ type Snapshot struct {
 	// update-status hook is supposed to run.
 	UpdateStatusVersion int
 
+	// ScheduledHookVersions holds, for each of the charm's named
+	// schedules, a version which increments each time the scheduled
+	// hook is supposed to run.
+	ScheduledHookVersions map[string]int
+
 	// ActionsPending is the list of pending actions to
 	// be performed by this unit.
 	ActionsPending []string
@@ -139,4 +144,5 @@ type StorageSnapshot struct {
 	Life     life.Value
 	Attached bool
 	Location string
+	Size     uint64
 }
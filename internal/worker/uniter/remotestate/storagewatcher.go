@@ -86,6 +86,7 @@ func getStorageSnapshot(
 		Kind:     attachment.Kind,
 		Attached: true,
 		Location: attachment.Location,
+		Size:     attachment.Size,
 	}
 	return snapshot, nil
 }
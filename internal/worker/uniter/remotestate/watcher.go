@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -54,6 +55,12 @@ type RemoteStateWatcher struct {
 	canApplyCharmProfile      bool
 	workloadEventChannel      <-chan string
 	shutdownChannel           <-chan bool
+	clock                     clock.Clock
+
+	// hookSchedules holds the unit's hook schedules, keyed on name, and
+	// scheduledHookTimer expires when the earliest of them is due.
+	hookSchedules      map[string]*hookSchedule
+	scheduledHookTimer <-chan time.Time
 
 	secretsClient api.SecretsWatcher
 
@@ -95,6 +102,7 @@ type WatcherConfig struct {
 	WorkloadEventChannel         <-chan string
 	InitialWorkloadEventIDs      []string
 	ShutdownChannel              <-chan bool
+	Clock                        clock.Clock
 }
 
 func (w WatcherConfig) validate() error {
@@ -104,6 +112,9 @@ func (w WatcherConfig) validate() error {
 	if w.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
+	if w.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
 	return nil
 }
 
@@ -129,6 +140,7 @@ func NewWatcher(config WatcherConfig) (*RemoteStateWatcher, error) {
 		modelType:                 config.ModelType,
 		logger:                    config.Logger,
 		canApplyCharmProfile:      config.CanApplyCharmProfile,
+		clock:                     config.Clock,
 		// Note: it is important that the out channel be buffered!
 		// The remote state watcher will perform a non-blocking send
 		// on the channel to wake up the observer. It is non-blocking
@@ -141,6 +153,7 @@ func NewWatcher(config WatcherConfig) (*RemoteStateWatcher, error) {
 			WorkloadEvents:          config.InitialWorkloadEventIDs,
 			ConsumedSecretInfo:      make(map[string]secrets.SecretRevisionInfo),
 			ObsoleteSecretRevisions: make(map[string][]int),
+			ScheduledHookVersions:   make(map[string]int),
 		},
 		sidecar:                      config.Sidecar,
 		enforcedCharmModifiedVersion: config.EnforcedCharmModifiedVersion,
@@ -222,6 +235,10 @@ func (w *RemoteStateWatcher) Snapshot() Snapshot {
 	}
 	snapshot.DeletedSecrets = make([]string, len(w.current.DeletedSecrets))
 	copy(snapshot.DeletedSecrets, w.current.DeletedSecrets)
+	snapshot.ScheduledHookVersions = make(map[string]int)
+	for name, version := range w.current.ScheduledHookVersions {
+		snapshot.ScheduledHookVersions[name] = version
+	}
 	return snapshot
 }
 
@@ -467,6 +484,23 @@ func (w *RemoteStateWatcher) loop(unitTag names.UnitTag) (err error) {
 	}
 	requiredEvents++
 
+	// Controllers which don't support scheduled hooks don't have a
+	// watcher for them, in which case no hooks are scheduled.
+	var (
+		seenHookSchedulesChange bool
+		hookSchedulesChannel    watcher.NotifyChannel
+	)
+	hookSchedulesw, err := w.unit.WatchHookSchedules(ctx)
+	if err != nil && !errors.Is(err, errors.NotImplemented) {
+		return errors.Trace(err)
+	} else if err == nil {
+		if err := w.catacomb.Add(hookSchedulesw); err != nil {
+			return errors.Trace(err)
+		}
+		hookSchedulesChannel = hookSchedulesw.Changes()
+		requiredEvents++
+	}
+
 	var seenLeadershipChange bool
 	// There's no watcher for this per se; we wait on a channel
 	// returned by the leadership tracker.
@@ -553,6 +587,16 @@ func (w *RemoteStateWatcher) loop(unitTag names.UnitTag) (err error) {
 			}
 			observedEvent(&seenApplicationChange)
 
+		case _, ok := <-hookSchedulesChannel:
+			w.logger.Debugf(ctx, "got hook schedules change for %s", w.unit.Tag().Id())
+			if !ok {
+				return errors.New("hook schedules watcher closed")
+			}
+			if err := w.hookSchedulesChanged(ctx); err != nil {
+				return errors.Trace(err)
+			}
+			observedEvent(&seenHookSchedulesChange)
+
 		case secrets, ok := <-secretsChanges:
 			w.logger.Debugf(ctx, "got secrets change for %s: %s", w.unit.Tag().Id(), secrets)
 			if !ok {
@@ -709,6 +753,10 @@ func (w *RemoteStateWatcher) loop(unitTag names.UnitTag) (err error) {
 			w.updateStatusChanged()
 			resetUpdateStatusTimer()
 
+		case <-w.scheduledHookTimer:
+			w.logger.Debugf(ctx, "scheduled hook timer triggered for %s", w.unit.Tag().Id())
+			w.scheduledHooksDue()
+
 		case id, ok := <-w.commandChannel:
 			if !ok {
 				return errors.New("commandChannel closed")
@@ -833,11 +881,18 @@ func (w *RemoteStateWatcher) applicationChanged(ctx context.Context) error {
 		return nil
 	}
 	w.mu.Lock()
+	charmChanged := w.current.CharmURL != "" && w.current.CharmURL != url
 	w.current.CharmURL = url
 	w.current.ForceCharmUpgrade = force
 	w.current.CharmModifiedVersion = ver
 	w.current.CharmProfileRequired = required
 	w.mu.Unlock()
+
+	// The schedules are declared by the charm, so a new charm may
+	// declare different schedules.
+	if charmChanged && w.hookSchedules != nil {
+		return errors.Trace(w.hookSchedulesChanged(ctx))
+	}
 	return nil
 }
 
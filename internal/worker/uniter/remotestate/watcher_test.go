@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/charm"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/uniter/remotestate"
@@ -106,6 +107,7 @@ func (s *WatcherSuite) SetUpTest(c *tc.C) {
 			actionWatcher:                    newMockStringsWatcher(),
 			relationsWatcher:                 newMockStringsWatcher(),
 			instanceDataWatcher:              newMockNotifyWatcher(),
+			hookSchedulesWatcher:             newMockNotifyWatcher(),
 		},
 		relations:                   make(map[names.RelationTag]*mockRelation),
 		storageAttachment:           make(map[params.StorageAttachmentId]params.StorageAttachment),
@@ -198,6 +200,7 @@ func (s *WatcherSuite) setupWatcherConfig(c *tc.C) remotestate.WatcherConfig {
 		CanApplyCharmProfile: s.modelType == model.IAAS,
 		WorkloadEventChannel: s.workloadEventChannel,
 		ShutdownChannel:      s.shutdownChannel,
+		Clock:                s.clock,
 	}
 }
 
@@ -225,6 +228,7 @@ func (s *WatcherSuiteIAAS) TestInitialSnapshot(c *tc.C) {
 		ActionChanged:           map[string]int{},
 		ConsumedSecretInfo:      map[string]secrets.SecretRevisionInfo{},
 		ObsoleteSecretRevisions: map[string][]int{},
+		ScheduledHookVersions:   map[string]int{},
 	})
 }
 
@@ -236,6 +240,7 @@ func (s *WatcherSuiteSidecar) TestInitialSnapshot(c *tc.C) {
 		ActionChanged:           map[string]int{},
 		ConsumedSecretInfo:      map[string]secrets.SecretRevisionInfo{},
 		ObsoleteSecretRevisions: map[string][]int{},
+		ScheduledHookVersions:   map[string]int{},
 	})
 }
 
@@ -258,6 +263,7 @@ func (s *WatcherSuite) TestInitialSignal(c *tc.C) {
 	}
 	s.uniterClient.unit.relationsWatcher.changes <- []string{}
 	s.uniterClient.updateStatusIntervalWatcher.changes <- struct{}{}
+	s.uniterClient.unit.hookSchedulesWatcher.changes <- struct{}{}
 	s.leadership.claimTicket.ch <- struct{}{}
 	s.secretsClient.secretsWatcher.changes <- []string{}
 	s.secretsClient.secretsRevisionsWatcher.changes <- []string{}
@@ -273,6 +279,7 @@ func (s *WatcherSuite) signalAll() {
 	s.uniterClient.unit.relationsWatcher.changes <- []string{}
 	s.uniterClient.unit.addressesWatcher.changes <- []string{"addresseshash"}
 	s.uniterClient.updateStatusIntervalWatcher.changes <- struct{}{}
+	s.uniterClient.unit.hookSchedulesWatcher.changes <- struct{}{}
 	s.leadership.claimTicket.ch <- struct{}{}
 	s.uniterClient.unit.storageWatcher.changes <- []string{}
 	s.applicationWatcher.changes <- struct{}{}
@@ -302,6 +309,7 @@ func (s *WatcherSuite) TestSnapshot(c *tc.C) {
 		Leader:                  true,
 		ConsumedSecretInfo:      map[string]secrets.SecretRevisionInfo{},
 		ObsoleteSecretRevisions: map[string][]int{},
+		ScheduledHookVersions:   map[string]int{},
 	})
 }
 
@@ -325,6 +333,7 @@ func (s *WatcherSuiteSidecar) TestSnapshot(c *tc.C) {
 		Leader:                  true,
 		ConsumedSecretInfo:      map[string]secrets.SecretRevisionInfo{},
 		ObsoleteSecretRevisions: map[string][]int{},
+		ScheduledHookVersions:   map[string]int{},
 	})
 }
 
@@ -862,6 +871,61 @@ func (s *WatcherSuite) TestUpdateStatusIntervalChanges(c *tc.C) {
 	c.Assert(s.watcher.Snapshot().UpdateStatusVersion, tc.Equals, initial.UpdateStatusVersion+2)
 }
 
+func (s *WatcherSuite) TestScheduledHooks(c *tc.C) {
+	s.uniterClient.unit.hookSchedules = []charm.Schedule{{
+		Name: "every-minute",
+		Cron: "* * * * *",
+	}, {
+		Name: "daily",
+		Cron: "@daily",
+	}}
+	s.signalAll()
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(s.watcher.Snapshot().ScheduledHookVersions, tc.HasLen, 0)
+
+	// The every-minute schedule is due within the next minute.
+	s.waitAlarmsStable(c)
+	s.clock.Advance(time.Minute)
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(s.watcher.Snapshot().ScheduledHookVersions, tc.DeepEquals, map[string]int{
+		"every-minute": 1,
+	})
+
+	s.waitAlarmsStable(c)
+	s.clock.Advance(time.Minute)
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(s.watcher.Snapshot().ScheduledHookVersions, tc.DeepEquals, map[string]int{
+		"every-minute": 2,
+	})
+}
+
+func (s *WatcherSuite) TestScheduledHooksRemoved(c *tc.C) {
+	s.uniterClient.unit.hookSchedules = []charm.Schedule{{
+		Name: "every-minute",
+		Cron: "* * * * *",
+	}}
+	s.signalAll()
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+
+	s.waitAlarmsStable(c)
+	s.clock.Advance(time.Minute)
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(s.watcher.Snapshot().ScheduledHookVersions, tc.DeepEquals, map[string]int{
+		"every-minute": 1,
+	})
+
+	// Once the schedule is removed, its pending run is dropped and the
+	// hook is no longer scheduled.
+	s.uniterClient.unit.hookSchedules = nil
+	s.uniterClient.unit.hookSchedulesWatcher.changes <- struct{}{}
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(s.watcher.Snapshot().ScheduledHookVersions, tc.HasLen, 0)
+
+	s.waitAlarmsStable(c)
+	s.clock.Advance(time.Minute)
+	assertNoNotifyEvent(c, s.watcher.RemoteStateChanged(), "unexpected remote state change")
+}
+
 // waitAlarmsStable is used to wait until the remote watcher's loop has
 // stopped churning (at least for testing.ShortWait), so that we can
 // then Advance the clock with some confidence that the SUT really is
@@ -895,6 +959,7 @@ func (s *WatcherSuiteSidecar) TestWatcherConfig(c *tc.C) {
 		ModelType: model.CAAS,
 		Sidecar:   true,
 		Logger:    loggertesting.WrapCheckLog(c),
+		Clock:     s.clock,
 	})
 	c.Assert(err, tc.ErrorIsNil)
 }
@@ -904,6 +969,13 @@ func (s *WatcherSuite) TestWatcherConfigMissingLogger(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "nil Logger not valid")
 }
 
+func (s *WatcherSuite) TestWatcherConfigMissingClock(c *tc.C) {
+	_, err := remotestate.NewWatcher(remotestate.WatcherConfig{
+		Logger: loggertesting.WrapCheckLog(c),
+	})
+	c.Assert(err, tc.ErrorMatches, "nil Clock not valid")
+}
+
 func (s *WatcherSuiteSidecarCharmModVer) TestRemoteStateChanged(c *tc.C) {
 	assertOneChange := func() {
 		assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
@@ -1012,6 +1084,7 @@ func (s *WatcherSuiteSidecarCharmModVer) TestSnapshot(c *tc.C) {
 		Leader:                  true,
 		ConsumedSecretInfo:      map[string]secrets.SecretRevisionInfo{},
 		ObsoleteSecretRevisions: map[string][]int{},
+		ScheduledHookVersions:   map[string]int{},
 	})
 }
 
@@ -1048,6 +1121,7 @@ func (s *WatcherSuite) TestInitialWorkloadEventIDs(c *tc.C) {
 	config := remotestate.WatcherConfig{
 		InitialWorkloadEventIDs: []string{"a", "b", "c"},
 		Logger:                  loggertesting.WrapCheckLog(c),
+		Clock:                   s.clock,
 	}
 	w, err := remotestate.NewWatcher(config)
 	c.Assert(err, tc.ErrorIsNil)
@@ -6,6 +6,8 @@ package uniter
 import (
 	stdcontext "context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/juju/errors"
 
@@ -27,7 +29,7 @@ import (
 type ResolverConfig struct {
 	ModelType           model.ModelType
 	ClearResolved       func() error
-	ReportHookError     func(stdcontext.Context, hook.Info) error
+	ReportHookError     func(stdcontext.Context, operation.State) error
 	ShouldRetryHooks    bool
 	StartRetryHookTimer func()
 	StopRetryHookTimer  func()
@@ -269,7 +271,7 @@ func (s *uniterResolver) nextOpHookError(
 ) (operation.Operation, error) {
 
 	// Report the hook error.
-	if err := s.config.ReportHookError(ctx, *localState.Hook); err != nil {
+	if err := s.config.ReportHookError(ctx, localState.State); err != nil {
 		return nil, errors.Trace(err)
 	}
 
@@ -401,6 +403,14 @@ func (s *uniterResolver) nextOp(
 		return op, err
 	}
 
+	// Scheduled hooks run once for each schedule that has become due,
+	// however many times it has become due since the hook last ran.
+	for _, name := range slices.Sorted(maps.Keys(remoteState.ScheduledHookVersions)) {
+		if localState.ScheduledHookVersions[name] != remoteState.ScheduledHookVersions[name] {
+			return opFactory.NewRunHook(hook.Info{Kind: hooks.Scheduled, ScheduleName: name})
+		}
+	}
+
 	// UpdateStatus hook runs if nothing else needs to.
 	if localState.UpdateStatusVersion != remoteState.UpdateStatusVersion {
 		return opFactory.NewRunHook(hook.Info{Kind: hooks.UpdateStatus})
@@ -80,6 +80,11 @@ type LocalState struct {
 	// for which an update-status hook has been committed.
 	UpdateStatusVersion int
 
+	// ScheduledHookVersions holds, for each schedule name, the version of
+	// the schedule from remotestate.Snapshot for which a scheduled hook
+	// has been committed.
+	ScheduledHookVersions map[string]int
+
 	// RetryHookVersion is the version of hook-retries from
 	// remotestate.Snapshot for which a hook has been retried.
 	RetryHookVersion int
@@ -138,6 +138,15 @@ func (s *resolverOpFactory) wrapHookOp(op operation.Operation, info hook.Info) o
 				state.AddressesHash = addressesHash
 			}
 		}}
+	case hooks.Scheduled:
+		name := info.ScheduleName
+		scheduledHookVersion := s.RemoteState.ScheduledHookVersions[name]
+		op = onCommitWrapper{op, func(*operation.State) {
+			if s.LocalState.ScheduledHookVersions == nil {
+				s.LocalState.ScheduledHookVersions = make(map[string]int)
+			}
+			s.LocalState.ScheduledHookVersions[name] = scheduledHookVersion
+		}}
 	}
 
 	charmModifiedVersion := s.RemoteState.CharmModifiedVersion
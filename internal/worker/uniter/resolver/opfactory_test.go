@@ -61,6 +61,37 @@ func (s *ResolverOpFactorySuite) testUpdateStatusChanged(
 	c.Assert(f.LocalState.UpdateStatusVersion, tc.Equals, 1)
 }
 
+func (s *ResolverOpFactorySuite) TestScheduledHookCommitted(c *tc.C) {
+	s.testScheduledHookCommitted(c, resolver.ResolverOpFactory.NewRunHook)
+	s.testScheduledHookCommitted(c, resolver.ResolverOpFactory.NewSkipHook)
+}
+
+func (s *ResolverOpFactorySuite) testScheduledHookCommitted(
+	c *tc.C, meth func(resolver.ResolverOpFactory, hook.Info) (operation.Operation, error),
+) {
+	f := resolver.NewResolverOpFactory(s.opFactory)
+	f.RemoteState.ScheduledHookVersions = map[string]int{
+		"nightly-backup": 1,
+		"hourly-report":  3,
+	}
+
+	op, err := meth(f, hook.Info{Kind: hooks.Scheduled, ScheduleName: "nightly-backup"})
+	c.Assert(err, tc.ErrorIsNil)
+	f.RemoteState.ScheduledHookVersions = map[string]int{
+		"nightly-backup": 2,
+		"hourly-report":  3,
+	}
+
+	_, err = op.Commit(c.Context(), operation.State{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Only the version of the schedule the hook ran for is recorded, as
+	// it was when the operation was constructed.
+	c.Assert(f.LocalState.ScheduledHookVersions, tc.DeepEquals, map[string]int{
+		"nightly-backup": 1,
+	})
+}
+
 func (s *ResolverOpFactorySuite) TestConfigChanged(c *tc.C) {
 	s.testConfigChanged(c, resolver.ResolverOpFactory.NewRunHook)
 	s.testConfigChanged(c, resolver.ResolverOpFactory.NewSkipHook)
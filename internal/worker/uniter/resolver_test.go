@@ -107,7 +107,7 @@ func (s *baseResolverSuite) SetUpTest(c *tc.C, modelType model.ModelType, reboot
 	s.lastOptionalResolver = &fakeResolver{}
 	s.resolverConfig = uniter.ResolverConfig{
 		ClearResolved:       func() error { return s.clearResolved() },
-		ReportHookError:     func(_ context.Context, info hook.Info) error { return s.reportHookError(info) },
+		ReportHookError:     func(_ context.Context, state operation.State) error { return s.reportHookError(*state.Hook) },
 		StartRetryHookTimer: func() { s.stub.AddCall("StartRetryHookTimer") },
 		StopRetryHookTimer:  func() { s.stub.AddCall("StopRetryHookTimer") },
 		ShouldRetryHooks:    true,
@@ -446,6 +446,48 @@ func (s *resolverSuite) TestNoOperationIfHashesAllMatch(c *tc.C) {
 	c.Assert(err, tc.Equals, resolver.ErrNoOperation)
 }
 
+func (s *resolverSuite) TestRunsScheduledHookIfScheduleDue(c *tc.C) {
+	localState := resolver.LocalState{
+		CharmURL: s.charmURL,
+		State: operation.State{
+			Kind:      operation.Continue,
+			Installed: true,
+			Started:   true,
+		},
+		ScheduledHookVersions: map[string]int{
+			"hourly-report": 2,
+		},
+	}
+	s.remoteState.ScheduledHookVersions = map[string]int{
+		"hourly-report":  2,
+		"nightly-backup": 1,
+	}
+
+	op, err := s.resolver.NextOp(c.Context(), localState, s.remoteState, s.opFactory)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(op.String(), tc.Equals, "run scheduled (nightly-backup) hook")
+}
+
+func (s *resolverSuite) TestNoOperationIfScheduledHooksRun(c *tc.C) {
+	localState := resolver.LocalState{
+		CharmURL: s.charmURL,
+		State: operation.State{
+			Kind:      operation.Continue,
+			Installed: true,
+			Started:   true,
+		},
+		ScheduledHookVersions: map[string]int{
+			"nightly-backup": 1,
+		},
+	}
+	s.remoteState.ScheduledHookVersions = map[string]int{
+		"nightly-backup": 1,
+	}
+
+	_, err := s.resolver.NextOp(c.Context(), localState, s.remoteState, s.opFactory)
+	c.Assert(err, tc.Equals, resolver.ErrNoOperation)
+}
+
 func (s *resolverSuite) TestUpgradeOperation(c *tc.C) {
 	opFactory := setupUpgradeOpFactory(c)
 	localState := resolver.LocalState{
@@ -50,6 +50,8 @@ type Context interface {
 	HasExecutionSetUnitStatus() bool
 	ResetExecutionSetUnitStatus()
 	ModelType() model.ModelType
+	HookTimeout() time.Duration
+	SetAgentStatus(ctx context.Context, agentStatus jujuc.StatusInfo) error
 
 	Prepare(ctx context.Context) error
 	Flush(ctx context.Context, badge string, failure error) error
@@ -173,6 +175,10 @@ type HookContext struct {
 
 	hookName string
 
+	// hookTimeout is the maximum duration the hook may run for. A zero
+	// timeout means that the hook runs without a deadline.
+	hookTimeout time.Duration
+
 	// actionData contains the values relevant to the run of an Action:
 	// its tag, its parameters, and its results.
 	actionData *ActionData
@@ -309,6 +315,10 @@ type HookContext struct {
 	// secretLabel is the secret label to expose to the hook.
 	secretLabel string
 
+	// scheduleName is the name of the charm schedule which triggered the
+	// hook.
+	scheduleName string
+
 	// secretMetadata contains the metadata for secrets created by this charm.
 	secretMetadata map[string]jujuc.SecretMetadata
 
@@ -495,6 +505,13 @@ func (c *HookContext) ModelType() model.ModelType {
 	return c.modelType
 }
 
+// HookTimeout returns the maximum duration the hook may run for. A zero
+// timeout means that the hook runs without a deadline.
+// Implements runner.Context.
+func (c *HookContext) HookTimeout() time.Duration {
+	return c.hookTimeout
+}
+
 // UnitStatus will return the status for the current Unit.
 // Implements jujuc.HookContext.ContextStatus, part of runner.Context.
 func (c *HookContext) UnitStatus(ctx context.Context) (*jujuc.StatusInfo, error) {
@@ -1378,6 +1395,10 @@ func (c *HookContext) HookVars(
 		}
 	}
 
+	if c.scheduleName != "" {
+		vars = append(vars, "JUJU_SCHEDULE_NAME="+c.scheduleName)
+	}
+
 	if storage, err := c.HookStorage(ctx); err == nil {
 		vars = append(vars,
 			"JUJU_STORAGE_ID="+storage.Tag().Id(),
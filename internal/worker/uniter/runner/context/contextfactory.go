@@ -305,11 +305,25 @@ func (f *contextFactory) HookContext(stdCtx context.Context, hookInfo hook.Info)
 			ctx.secretLabel = md.Label
 		}
 	}
+	if hookInfo.Kind == hooks.Scheduled {
+		ctx.scheduleName = hookInfo.ScheduleName
+	}
 	ctx.id, err = f.newId(hookName)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	ctx.hookName = hookName
+
+	// Only hooks are subject to the hook timeout, actions and commands
+	// have their own deadlines.
+	ctx.hookTimeout, err = f.unit.HookTimeout(stdCtx)
+	if errors.Is(err, errors.NotImplemented) {
+		// The controller doesn't support hook timeouts, so the hook
+		// runs without a deadline.
+		ctx.hookTimeout = 0
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
 	return ctx, nil
 }
 
@@ -234,6 +234,24 @@ func (s *ContextFactorySuite) TestSecretHookContext(c *tc.C) {
 	s.AssertNotStorageContext(c, ctx)
 }
 
+func (s *ContextFactorySuite) TestScheduledHookContext(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	s.setupContextFactory(c, ctrl)
+
+	ctx, err := s.factory.HookContext(c.Context(), hook.Info{
+		Kind:         hooks.Scheduled,
+		ScheduleName: "nightly-backup",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	s.AssertCoreContext(c, ctx)
+	c.Check(ctx.ScheduleName(), tc.Equals, "nightly-backup")
+	s.AssertNotActionContext(c, ctx)
+	s.AssertNotRelationContext(c, ctx)
+	s.AssertNotStorageContext(c, ctx)
+	s.AssertNotSecretContext(c, ctx)
+}
+
 func (s *ContextFactorySuite) TestNewHookContextCAASModel(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -164,6 +164,15 @@ func (s *EnvSuite) setCheck(ctx *context.HookContext) (expectVars []string) {
 	}
 }
 
+// setSchedule sets the context for a scheduled hook.
+func (s *EnvSuite) setSchedule(ctx *context.HookContext) (expectVars []string) {
+	name := "nightly-backup"
+	context.SetEnvironmentHookContextSchedule(ctx, name)
+	return []string{
+		"JUJU_SCHEDULE_NAME=" + name,
+	}
+}
+
 func (s *EnvSuite) setDepartingRelation(ctx *context.HookContext) (expectVars []string) {
 	context.SetEnvironmentHookContextRelation(ctx, 22, "an-endpoint", "that-unit/456", "that-app", "that-unit/456")
 	return []string{
@@ -239,9 +248,10 @@ func (s *EnvSuite) TestHostEnv(c *tc.C) {
 	workloadVars := s.setWorkload(hookContext)
 	noticeVars := s.setNotice(hookContext)
 	checkVars := s.setCheck(hookContext)
+	scheduleVars := s.setSchedule(hookContext)
 	actualVars, err = hookContext.HookVars(c.Context(), paths, environmenter)
 	c.Assert(err, tc.ErrorIsNil)
-	s.assertVars(c, actualVars, contextVars, pathsVars, ubuntuVars, relationVars, secretVars, storageVars, workloadVars, noticeVars, checkVars)
+	s.assertVars(c, actualVars, contextVars, pathsVars, ubuntuVars, relationVars, secretVars, storageVars, workloadVars, noticeVars, checkVars, scheduleVars)
 }
 
 func (s *EnvSuite) TestContextDependentDoesNotIncludeUnSet(c *tc.C) {
@@ -206,6 +206,12 @@ func SetEnvironmentHookContextCheck(context *HookContext, checkName string) {
 	context.checkName = checkName
 }
 
+// SetEnvironmentHookContextSchedule exists purely to set the fields used in hookVars.
+// It makes no assumptions about the validity of context.
+func SetEnvironmentHookContextSchedule(context *HookContext, scheduleName string) {
+	context.scheduleName = scheduleName
+}
+
 // SetRelationBroken sets the relation as broken.
 func SetRelationBroken(context jujuc.Context, relId int) {
 	context.(*HookContext).relations[relId].broken = true
@@ -327,6 +333,10 @@ func CachedAppSettings(cf0 ContextFactory, relId int, appName string) (params.Se
 	return settings, found
 }
 
+func (ctx *HookContext) ScheduleName() string {
+	return ctx.scheduleName
+}
+
 func (ctx *HookContext) PendingSecretRemoves() map[string]uniter.SecretDeleteArg {
 	return ctx.secretChanges.pendingDeletes
 }
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	application "github.com/juju/juju/core/application"
 	logger "github.com/juju/juju/core/logger"
@@ -861,6 +862,44 @@ func (c *MockContextHookStorageCall) DoAndReturn(f func(context.Context) (jujuc.
 	return c
 }
 
+// HookTimeout mocks base method.
+func (m *MockContext) HookTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HookTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// HookTimeout indicates an expected call of HookTimeout.
+func (mr *MockContextMockRecorder) HookTimeout() *MockContextHookTimeoutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HookTimeout", reflect.TypeOf((*MockContext)(nil).HookTimeout))
+	return &MockContextHookTimeoutCall{Call: call}
+}
+
+// MockContextHookTimeoutCall wrap *gomock.Call
+type MockContextHookTimeoutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextHookTimeoutCall) Return(arg0 time.Duration) *MockContextHookTimeoutCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextHookTimeoutCall) Do(f func() time.Duration) *MockContextHookTimeoutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextHookTimeoutCall) DoAndReturn(f func() time.Duration) *MockContextHookTimeoutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // HookVars mocks base method.
 func (m *MockContext) HookVars(arg0 context.Context, arg1 context0.Paths, arg2 context0.Environmenter) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1705,6 +1744,44 @@ func (c *MockContextSetActionMessageCall) DoAndReturn(f func(string) error) *Moc
 	return c
 }
 
+// SetAgentStatus mocks base method.
+func (m *MockContext) SetAgentStatus(arg0 context.Context, arg1 jujuc.StatusInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAgentStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAgentStatus indicates an expected call of SetAgentStatus.
+func (mr *MockContextMockRecorder) SetAgentStatus(arg0, arg1 any) *MockContextSetAgentStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAgentStatus", reflect.TypeOf((*MockContext)(nil).SetAgentStatus), arg0, arg1)
+	return &MockContextSetAgentStatusCall{Call: call}
+}
+
+// MockContextSetAgentStatusCall wrap *gomock.Call
+type MockContextSetAgentStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextSetAgentStatusCall) Return(arg0 error) *MockContextSetAgentStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextSetAgentStatusCall) Do(f func(context.Context, jujuc.StatusInfo) error) *MockContextSetAgentStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextSetAgentStatusCall) DoAndReturn(f func(context.Context, jujuc.StatusInfo) error) *MockContextSetAgentStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetApplicationStatus mocks base method.
 func (m *MockContext) SetApplicationStatus(arg0 context.Context, arg1 jujuc.StatusInfo) error {
 	m.ctrl.T.Helper()
@@ -24,6 +24,7 @@ import (
 
 	"github.com/juju/juju/core/actions"
 	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/internal/worker/common/charmrunner"
 	"github.com/juju/juju/internal/worker/uniter/runner/context"
@@ -93,6 +94,7 @@ type Option func(*options)
 
 type options struct {
 	executor ExecFunc
+	clock    clock.Clock
 }
 
 // WithExecutor passes a custom executor to the runner.
@@ -102,9 +104,18 @@ func WithExecutor(executor ExecFunc) Option {
 	}
 }
 
+// WithClock passes a custom clock to the runner, used to enforce the hook
+// timeout.
+func WithClock(clock clock.Clock) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
 func newOptions() *options {
 	return &options{
 		executor: execOnMachine,
+		clock:    clock.WallClock,
 	}
 }
 
@@ -118,6 +129,7 @@ func NewRunner(context context.Context, paths context.Paths, options ...Option)
 		context:  context,
 		paths:    paths,
 		executor: opts.executor,
+		clock:    opts.clock,
 	}
 }
 
@@ -164,6 +176,7 @@ type runner struct {
 	paths   context.Paths
 	// executor executes commands on a remote workload pod for CAAS.
 	executor ExecFunc
+	clock    clock.Clock
 }
 
 func (runner *runner) logger() corelogger.Logger {
@@ -312,15 +325,17 @@ func (runner *runner) RunAction(ctx stdcontext.Context, actionName string) (Hook
 		return InvalidHookHandler, runner.runJujuExecAction(ctx)
 	}
 	runner.logger().Debugf(ctx, "running action %q", actionName)
-	return runner.runCharmHookWithLocation(ctx, actionName, "actions")
+	return runner.runCharmHookWithLocation(ctx, actionName, "actions", 0)
 }
 
 // RunHook exists to satisfy the Runner interface.
 func (runner *runner) RunHook(ctx stdcontext.Context, hookName string) (HookHandlerType, error) {
-	return runner.runCharmHookWithLocation(ctx, hookName, "hooks")
+	return runner.runCharmHookWithLocation(ctx, hookName, "hooks", runner.context.HookTimeout())
 }
 
-func (runner *runner) runCharmHookWithLocation(ctx stdcontext.Context, hookName, charmLocation string) (hookHandlerType HookHandlerType, err error) {
+// runCharmHookWithLocation runs the named hook or action. If the timeout is
+// non-zero, the hook is killed once it has been running for that long.
+func (runner *runner) runCharmHookWithLocation(ctx stdcontext.Context, hookName, charmLocation string, timeout time.Duration) (hookHandlerType HookHandlerType, err error) {
 	srv, err := runner.startJujucServer(ctx)
 	if err != nil {
 		return InvalidHookHandler, errors.Trace(err)
@@ -361,7 +376,7 @@ func (runner *runner) runCharmHookWithLocation(ctx stdcontext.Context, hookName,
 	if err != nil {
 		return InvalidHookHandler, err
 	}
-	return hookHandlerType, runner.runCharmProcessOnLocal(hookScript, hookName, charmDir, env)
+	return hookHandlerType, runner.runCharmProcessOnLocal(ctx, hookScript, hookName, charmDir, env, timeout)
 }
 
 // loggerAdaptor implements MessageReceiver and
@@ -419,13 +434,24 @@ func (b *bufferAdaptor) Bytes() []byte {
 const (
 	// ErrTerminated indicate the hook or action exited due to a SIGTERM or SIGKILL signal.
 	ErrTerminated = errors.ConstError("terminated")
+
+	// ErrHookTimedOut indicates the hook was killed because it ran for
+	// longer than the hook timeout.
+	ErrHookTimedOut = errors.ConstError("hook timed out")
 )
 
 // Check still tested
-func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, env []string) error {
+func (runner *runner) runCharmProcessOnLocal(
+	ctx stdcontext.Context, hook, hookName, charmDir string, env []string, timeout time.Duration,
+) error {
 	ps := exec.Command(hook)
 	ps.Env = env
 	ps.Dir = charmDir
+	if timeout > 0 {
+		// Run the hook in its own process group, so that any processes it
+		// spawns are killed along with it if the hook times out.
+		ps.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	}
 	outReader, outWriter, err := os.Pipe()
 	if err != nil {
 		return errors.Errorf("cannot make logging pipe: %v", err)
@@ -467,6 +493,7 @@ func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, en
 
 	err = ps.Start()
 	var exitErr error
+	timedOut := make(chan struct{})
 	if err == nil {
 		done := make(chan struct{})
 		if cancel != nil {
@@ -478,6 +505,9 @@ func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, en
 				}
 			}()
 		}
+		if timeout > 0 {
+			go runner.enforceHookTimeout(ctx, hookName, ps.Process.Pid, timeout, timedOut, done)
+		}
 		// Record the *os.Process of the hook
 		runner.context.SetProcess(hookProcess{ps.Process})
 		// Block until execution finishes
@@ -503,6 +533,11 @@ func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, en
 			return errors.Trace(err)
 		}
 	}
+	select {
+	case <-timedOut:
+		return errors.Trace(ErrHookTimedOut)
+	default:
+	}
 	if exitError, ok := exitErr.(*exec.ExitError); ok && exitError != nil {
 		waitStatus := exitError.ProcessState.Sys().(syscall.WaitStatus)
 		if waitStatus.Signal() == syscall.SIGTERM || waitStatus.Signal() == syscall.SIGKILL {
@@ -513,6 +548,42 @@ func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, en
 	return errors.Trace(exitErr)
 }
 
+// enforceHookTimeout warns that the hook is slow once it has used half of the
+// timeout, and kills the hook's process group once the timeout has expired.
+// The timedOut channel is closed before the hook is killed.
+func (runner *runner) enforceHookTimeout(
+	ctx stdcontext.Context, hookName string, pid int, timeout time.Duration,
+	timedOut chan<- struct{}, done <-chan struct{},
+) {
+	logger := runner.logger()
+
+	slow := timeout / 2
+	select {
+	case <-runner.clock.After(slow):
+	case <-done:
+		return
+	}
+	logger.Warningf(ctx, "%s hook has been running for %v, it will be killed after %v", hookName, slow, timeout)
+	if err := runner.context.SetAgentStatus(ctx, jujuc.StatusInfo{
+		Status: string(status.Executing),
+		Info:   fmt.Sprintf("running %s hook (slow, will time out after %v)", hookName, timeout),
+	}); err != nil {
+		logger.Warningf(ctx, "cannot set slow hook status: %v", err)
+	}
+
+	select {
+	case <-runner.clock.After(timeout - slow):
+	case <-done:
+		return
+	}
+	logger.Errorf(ctx, "%s hook timed out after %v, killing it", hookName, timeout)
+	close(timedOut)
+	// A negative pid signals every process in the hook's process group.
+	if err := syscall.Kill(-pid, syscall.SIGKILL); err != nil {
+		logger.Warningf(ctx, "cannot kill %s hook: %v", hookName, err)
+	}
+}
+
 // discoverHookHandler checks to see if the dispatch script exists, if not,
 // check for the given hookName.  Based on what is discovered, return the
 // HookHandlerType and the actual script to be run.
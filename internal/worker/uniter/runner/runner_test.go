@@ -10,9 +10,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	stdtesting "testing"
 	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/loggo/v2"
 	"github.com/juju/tc"
@@ -251,6 +253,10 @@ type MockContext struct {
 	flushFailure    error
 	flushResult     error
 	modelType       model.ModelType
+	hookTimeout     time.Duration
+
+	mu          sync.Mutex
+	agentStatus []jujuc.StatusInfo
 }
 
 func (ctx *MockContext) GetLoggerByName(module string) logger.Logger {
@@ -311,6 +317,23 @@ func (ctx *MockContext) UpdateActionResults(keys []string, value interface{}) er
 	return nil
 }
 
+func (ctx *MockContext) HookTimeout() time.Duration {
+	return ctx.hookTimeout
+}
+
+func (ctx *MockContext) SetAgentStatus(_ stdcontext.Context, info jujuc.StatusInfo) error {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	ctx.agentStatus = append(ctx.agentStatus, info)
+	return nil
+}
+
+func (ctx *MockContext) AgentStatuses() []jujuc.StatusInfo {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	return append([]jujuc.StatusInfo(nil), ctx.agentStatus...)
+}
+
 func (ctx *MockContext) ModelType() model.ModelType {
 	if ctx.modelType == "" {
 		return model.IAAS
@@ -439,6 +462,60 @@ func (s *RunMockContextSuite) TestRunHookFlushFailure(c *tc.C) {
 	s.assertRecordedPid(c, ctx.expectPid)
 }
 
+func (s *RunMockContextSuite) TestRunHookWithTimeout(c *tc.C) {
+	ctx := &MockContext{
+		hookTimeout: time.Minute,
+	}
+	makeCharm(c, hookSpec{
+		dir:  "hooks",
+		name: hookName,
+		perm: 0700,
+	}, s.paths.GetCharmDir())
+	clock := testclock.NewClock(time.Now())
+	_, err := runner.NewRunner(ctx, s.paths, runner.WithClock(clock)).RunHook(c.Context(), "something-happened")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ctx.flushFailure, tc.IsNil)
+	c.Assert(ctx.AgentStatuses(), tc.HasLen, 0)
+}
+
+func (s *RunMockContextSuite) TestRunHookTimedOut(c *tc.C) {
+	ctx := &MockContext{
+		hookTimeout: time.Minute,
+	}
+	makeCharm(c, hookSpec{
+		dir:   "hooks",
+		name:  hookName,
+		perm:  0700,
+		sleep: 600,
+	}, s.paths.GetCharmDir())
+	clock := testclock.NewClock(time.Now())
+
+	result := make(chan error)
+	go func() {
+		_, err := runner.NewRunner(ctx, s.paths, runner.WithClock(clock)).RunHook(c.Context(), "something-happened")
+		result <- err
+	}()
+
+	// Half way through the timeout, the hook is reported as slow.
+	err := clock.WaitAdvance(30*time.Second, testing.LongWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	err = clock.WaitAdvance(30*time.Second, testing.LongWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	select {
+	case err := <-result:
+		c.Assert(err, tc.IsNil)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for hook to be killed")
+	}
+	c.Assert(ctx.flushBadge, tc.Equals, "something-happened")
+	c.Assert(ctx.flushFailure, tc.ErrorIs, runner.ErrHookTimedOut)
+	c.Assert(ctx.AgentStatuses(), tc.DeepEquals, []jujuc.StatusInfo{{
+		Status: "executing",
+		Info:   "running something-happened hook (slow, will time out after 1m0s)",
+	}})
+}
+
 func (s *RunHookSuite) TestRunActionDispatchingHookHandler(c *tc.C) {
 	ctx := &MockContext{
 		actionData:    &context.ActionData{},
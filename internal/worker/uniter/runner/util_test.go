@@ -86,6 +86,7 @@ func (s *ContextSuite) setupUnit(ctrl *gomock.Controller) names.MachineTag {
 	s.unit.EXPECT().PublicAddress(gomock.Any()).Return("u-0.testing.invalid", nil).AnyTimes()
 	s.unit.EXPECT().PrivateAddress(gomock.Any()).Return("u-0.testing.invalid", nil).AnyTimes()
 	s.unit.EXPECT().AvailabilityZone(gomock.Any()).Return("a-zone", nil).AnyTimes()
+	s.unit.EXPECT().HookTimeout(gomock.Any()).Return(time.Duration(0), nil).AnyTimes()
 
 	machineTag := names.NewMachineTag("0")
 	s.unit.EXPECT().AssignedMachine(gomock.Any()).Return(machineTag, nil).AnyTimes()
@@ -191,6 +192,8 @@ type hookSpec struct {
 	stderr string
 	// background holds a string to print in the background after 0.2s.
 	background string
+	// sleep holds the number of seconds to sleep for before exiting.
+	sleep int
 	// missingShebang will omit the '#!/bin/bash' line
 	missingShebang bool
 	// charmMissing will remove the charm before running the hook
@@ -242,6 +245,10 @@ func makeCharm(c *tc.C, spec hookSpec, charmDir string) {
 		// expected.
 		printf("(sleep 0.2; echo %s; sleep 10) &", spec.background)
 	}
+	if spec.sleep > 0 {
+		// Use the default PATH, as the mock context replaces it.
+		printf("command -p sleep %d", spec.sleep)
+	}
 	printf("exit %d", spec.code)
 }
 
@@ -30,6 +30,11 @@ type Attachments struct {
 	// TODO: hml
 	// Can this be a names.Set?
 	storageState *State
+
+	// seenSizes records the size of storage when a storage-attached
+	// or storage-resized hook was last queued for it, to be recorded
+	// in the State when the hook is committed.
+	seenSizes map[names.StorageTag]uint64
 }
 
 // NewAttachments returns a new Attachments.
@@ -41,11 +46,12 @@ func NewAttachments(
 	abort <-chan struct{},
 ) (*Attachments, error) {
 	a := &Attachments{
-		client:   client,
-		unitTag:  tag,
-		abort:    abort,
-		stateOps: NewStateOps(rw),
-		pending:  names.NewSet(),
+		client:    client,
+		unitTag:   tag,
+		abort:     abort,
+		stateOps:  NewStateOps(rw),
+		pending:   names.NewSet(),
+		seenSizes: make(map[names.StorageTag]uint64),
 	}
 	if err := a.init(ctx); err != nil {
 		return nil, err
@@ -87,6 +93,9 @@ func (a *Attachments) init(ctx context.Context) error {
 			continue
 		}
 		newStateStorage.Attach(storageTag.Id())
+		if size, ok := existingStorageState.Size(storageTag.Id()); ok {
+			newStateStorage.SetSize(storageTag.Id(), size)
+		}
 	}
 	a.storageState = newStateStorage
 	if a.storageState.Empty() {
@@ -132,6 +141,9 @@ func (a *Attachments) CommitHook(ctx context.Context, hi hook.Info) error {
 		}
 	} else {
 		a.storageState.Attach(hi.StorageId)
+		if size, ok := a.seenSizes[names.NewStorageTag(hi.StorageId)]; ok {
+			a.storageState.SetSize(hi.StorageId, size)
+		}
 	}
 	if err := a.stateOps.Write(ctx, a.storageState); err != nil {
 		return err
@@ -149,10 +161,18 @@ func (a *Attachments) CommitHook(ctx context.Context, hi hook.Info) error {
 	return nil
 }
 
+// recordSize records the size of attached storage in the State, without
+// a hook having been run for it.
+func (a *Attachments) recordSize(ctx context.Context, tag names.StorageTag, size uint64) error {
+	a.storageState.SetSize(tag.Id(), size)
+	return errors.Trace(a.stateOps.Write(ctx, a.storageState))
+}
+
 func (a *Attachments) removeStorageAttachment(ctx context.Context, tag names.StorageTag) error {
 	if err := a.client.RemoveStorageAttachment(ctx, tag, a.unitTag); err != nil {
 		return errors.Annotate(err, "removing storage attachment")
 	}
 	a.pending.Remove(tag)
+	delete(a.seenSizes, tag)
 	return nil
 }
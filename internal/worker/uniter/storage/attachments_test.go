@@ -353,6 +353,128 @@ func (s *attachmentsSuite) TestAttachmentsCommitHook(c *tc.C) {
 	c.Assert(removed, tc.IsTrue)
 }
 
+func (s *attachmentsSuite) TestAttachmentsStorageResized(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unitTag := names.NewUnitTag("mysql/0")
+	abort := make(chan struct{})
+
+	storageTag := names.NewStorageTag("data/0")
+	st := &mockStorageAccessor{
+		unitStorageAttachments: func(u names.UnitTag) ([]params.StorageAttachmentId, error) {
+			return nil, nil
+		},
+	}
+
+	att, err := storage.NewAttachments(c.Context(), st, unitTag, s.mockStateOps, abort)
+	c.Assert(err, tc.ErrorIsNil)
+	r := storage.NewResolver(loggertesting.WrapCheckLog(c), att, s.modelType)
+
+	localState := resolver.LocalState{State: operation.State{
+		Kind: operation.Continue,
+	}}
+	nextOp := func(size uint64) (operation.Operation, error) {
+		return r.NextOp(c.Context(), localState, remotestate.Snapshot{
+			Life: life.Alive,
+			Storage: map[names.StorageTag]remotestate.StorageSnapshot{
+				storageTag: {
+					Kind:     params.StorageKindBlock,
+					Life:     life.Alive,
+					Location: "/dev/sdb",
+					Attached: true,
+					Size:     size,
+				},
+			},
+		}, &mockOperations{})
+	}
+
+	op, err := nextOp(1024)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(op.String(), tc.Equals, "run hook storage-attached")
+
+	// The size seen when the hook was queued is recorded on commit.
+	s.storSt.Attach(storageTag.Id())
+	s.storSt.SetSize(storageTag.Id(), 1024)
+	s.expectSetState(c, "")
+	err = att.CommitHook(c.Context(), hook.Info{
+		Kind:      hooks.StorageAttached,
+		StorageId: storageTag.Id(),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// No change in size, so nothing to do.
+	_, err = nextOp(1024)
+	c.Assert(err, tc.Equals, resolver.ErrNoOperation)
+
+	// The storage has grown.
+	op, err = nextOp(2048)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(op.String(), tc.Equals, "run hook storage-resized")
+	hi := hook.Info{
+		Kind:      hooks.StorageResized,
+		StorageId: storageTag.Id(),
+	}
+	err = att.ValidateHook(hi)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.storSt.SetSize(storageTag.Id(), 2048)
+	s.expectSetState(c, "")
+	err = att.CommitHook(c.Context(), hi)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The hook is only run once for each resize.
+	_, err = nextOp(2048)
+	c.Assert(err, tc.Equals, resolver.ErrNoOperation)
+}
+
+func (s *attachmentsSuite) TestAttachmentsStorageResizedAfterRestart(c *tc.C) {
+	defer s.mockStateOpsSuite.setupMocks(c).Finish()
+
+	unitTag := names.NewUnitTag("mysql/0")
+	abort := make(chan struct{})
+
+	storageTag := names.NewStorageTag("data/0")
+	st := &mockStorageAccessor{
+		unitStorageAttachments: func(u names.UnitTag) ([]params.StorageAttachmentId, error) {
+			return []params.StorageAttachmentId{{
+				StorageTag: storageTag.String(),
+				UnitTag:    unitTag.String(),
+			}}, nil
+		},
+	}
+
+	// The storage was attached, and its size recorded, before the
+	// uniter restarted.
+	s.storSt.Attach(storageTag.Id())
+	s.storSt.SetSize(storageTag.Id(), 1024)
+	s.expectState(c)
+	s.expectSetState(c, "")
+
+	att, err := storage.NewAttachments(c.Context(), st, unitTag, s.mockStateOps, abort)
+	c.Assert(err, tc.ErrorIsNil)
+	r := storage.NewResolver(loggertesting.WrapCheckLog(c), att, s.modelType)
+
+	localState := resolver.LocalState{State: operation.State{
+		Kind:      operation.Continue,
+		Installed: true,
+		Started:   true,
+	}}
+	op, err := r.NextOp(c.Context(), localState, remotestate.Snapshot{
+		Life: life.Alive,
+		Storage: map[names.StorageTag]remotestate.StorageSnapshot{
+			storageTag: {
+				Kind:     params.StorageKindBlock,
+				Life:     life.Alive,
+				Location: "/dev/sdb",
+				Attached: true,
+				Size:     2048,
+			},
+		},
+	}, &mockOperations{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(op.String(), tc.Equals, "run hook storage-resized")
+}
+
 func (s *attachmentsSuite) TestAttachmentsSetDying(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
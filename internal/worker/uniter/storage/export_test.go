@@ -6,3 +6,10 @@ package storage
 func Storage(st *State) map[string]bool {
 	return st.storage
 }
+
+func PersistedState(st *State) interface{} {
+	return persistedState{
+		Storage: st.storage,
+		Sizes:   st.sizes,
+	}
+}
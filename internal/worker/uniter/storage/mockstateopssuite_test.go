@@ -27,7 +27,7 @@ func (s *mockStateOpsSuite) setupMocks(c *tc.C) *gomock.Controller {
 }
 
 func (s *mockStateOpsSuite) expectSetState(c *tc.C, errStr string) {
-	data, err := yaml.Marshal(storage.Storage(s.storSt))
+	data, err := yaml.Marshal(storage.PersistedState(s.storSt))
 	c.Assert(err, tc.ErrorIsNil)
 	strStorageState := string(data)
 	if errStr != "" {
@@ -45,7 +45,7 @@ func (s *mockStateOpsSuite) expectSetStateEmpty(c *tc.C) {
 }
 
 func (s *mockStateOpsSuite) expectState(c *tc.C) {
-	data, err := yaml.Marshal(storage.Storage(s.storSt))
+	data, err := yaml.Marshal(storage.PersistedState(s.storSt))
 	c.Assert(err, tc.ErrorIsNil)
 	strStorageState := string(data)
 
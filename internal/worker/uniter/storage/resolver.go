@@ -119,7 +119,7 @@ func (s *storageResolver) NextOp(
 	}
 
 	for tag, snap := range remoteState.Storage {
-		op, err := s.nextHookOp(tag, snap, opFactory)
+		op, err := s.nextHookOp(ctx, tag, snap, opFactory)
 		if errors.Cause(err) == resolver.ErrNoOperation {
 			continue
 		}
@@ -157,12 +157,13 @@ func (s *storageResolver) maybeShortCircuitRemoval(ctx context.Context, remote m
 }
 
 func (s *storageResolver) nextHookOp(
+	ctx context.Context,
 	tag names.StorageTag,
 	snap remotestate.StorageSnapshot,
 	opFactory operation.Factory,
 ) (operation.Operation, error) {
 
-	s.logger.Debugf(context.TODO(), "next hook op for %v: %+v", tag, snap)
+	s.logger.Debugf(ctx, "next hook op for %v: %+v", tag, snap)
 
 	if snap.Life == life.Dead {
 		// Storage must have been Dying to become Dead;
@@ -176,8 +177,8 @@ func (s *storageResolver) nextHookOp(
 		attached, ok := s.storage.storageState.Attached(tag.Id())
 		if ok && attached {
 			// Once the storage is attached, we only care about
-			// lifecycle State changes.
-			return nil, resolver.ErrNoOperation
+			// lifecycle State changes and the storage growing.
+			return s.nextResizedHookOp(ctx, tag, snap, opFactory)
 		}
 		// The storage-attached hook has not been committed, so add the
 		// storage to the pending set.
@@ -191,6 +192,9 @@ func (s *storageResolver) nextHookOp(
 		// The storage is alive, but we haven't previously run the
 		// "storage-attached" hook. Do so now.
 		hookInfo.Kind = hooks.StorageAttached
+		if snap.Size > 0 {
+			s.storage.seenSizes[tag] = snap.Size
+		}
 	case life.Dying:
 		attached, ok := s.storage.storageState.Attached(tag.Id())
 		if !ok || !attached {
@@ -206,3 +210,33 @@ func (s *storageResolver) nextHookOp(
 
 	return opFactory.NewRunHook(hookInfo)
 }
+
+// nextResizedHookOp returns an operation to run the "storage-resized" hook
+// if the attached storage has grown since the size recorded in the storage
+// State. If no size has been recorded, the current size is recorded without
+// running the hook.
+func (s *storageResolver) nextResizedHookOp(
+	ctx context.Context,
+	tag names.StorageTag,
+	snap remotestate.StorageSnapshot,
+	opFactory operation.Factory,
+) (operation.Operation, error) {
+	size, ok := s.storage.storageState.Size(tag.Id())
+	if snap.Size <= size {
+		return nil, resolver.ErrNoOperation
+	}
+	if !ok || size == 0 {
+		// The size was not previously known, e.g. because the storage
+		// was attached by an earlier version of Juju, so we cannot
+		// tell whether the storage grew.
+		if err := s.storage.recordSize(ctx, tag, snap.Size); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return nil, resolver.ErrNoOperation
+	}
+	s.storage.seenSizes[tag] = snap.Size
+	return opFactory.NewRunHook(hook.Info{
+		Kind:      hooks.StorageResized,
+		StorageId: tag.Id(),
+	})
+}
@@ -20,6 +20,17 @@ type State struct {
 	// key is the storage tag id, the value is attached
 	// or not.
 	storage map[string]bool
+
+	// sizes is a map of the size of attached storage, in MiB,
+	// when its storage-attached or storage-resized hook was last
+	// committed. The key is the storage tag id.
+	sizes map[string]uint64
+}
+
+// persistedState is the form in which State is stored on the controller.
+type persistedState struct {
+	Storage map[string]bool   `yaml:"storage"`
+	Sizes   map[string]uint64 `yaml:"sizes,omitempty"`
 }
 
 func (s *State) Detach(storageID string) error {
@@ -27,6 +38,7 @@ func (s *State) Detach(storageID string) error {
 		return errors.NotFoundf("storage %q", storageID)
 	}
 	s.storage[storageID] = false
+	delete(s.sizes, storageID)
 	return nil
 }
 
@@ -39,12 +51,27 @@ func (s *State) Attached(storageID string) (bool, bool) {
 	return attached, ok
 }
 
+// SetSize records the size of the attached storage, in MiB.
+func (s *State) SetSize(storageID string, size uint64) {
+	s.sizes[storageID] = size
+}
+
+// Size returns the recorded size of the attached storage, in MiB,
+// and whether a size has been recorded.
+func (s *State) Size(storageID string) (uint64, bool) {
+	size, ok := s.sizes[storageID]
+	return size, ok
+}
+
 func (s *State) Empty() bool {
 	return len(s.storage) == 0
 }
 
 func NewState() *State {
-	return &State{storage: make(map[string]bool)}
+	return &State{
+		storage: make(map[string]bool),
+		sizes:   make(map[string]uint64),
+	}
 }
 
 // ValidateHook returns an error if the supplied hook.Info does not represent
@@ -60,7 +87,7 @@ func (s *State) ValidateHook(hi hook.Info) (err error) {
 		if attached {
 			return errors.New("storage already attached")
 		}
-	case hooks.StorageDetaching:
+	case hooks.StorageDetaching, hooks.StorageResized:
 		if !attached {
 			return errors.New("storage not attached")
 		}
@@ -88,7 +115,6 @@ func NewStateOps(rw UnitStateReadWriter) *stateOps {
 // Read reads a storage State from the controller. If the saved State
 // does not exist it returns NotFound and a new state.
 func (f *stateOps) Read(ctx context.Context) (*State, error) {
-	var stor map[string]bool
 	unitState, err := f.unitStateRW.State(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -96,10 +122,25 @@ func (f *stateOps) Read(ctx context.Context) (*State, error) {
 	if unitState.StorageState == "" {
 		return NewState(), errors.NotFoundf("storage State")
 	}
-	if err = yaml.Unmarshal([]byte(unitState.StorageState), &stor); err != nil {
+	var persisted persistedState
+	if err = yaml.Unmarshal([]byte(unitState.StorageState), &persisted); err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &State{storage: stor}, nil
+	if persisted.Storage == nil {
+		// The State was written by an earlier version of Juju,
+		// which stored only the storage attachments map.
+		if err = yaml.Unmarshal([]byte(unitState.StorageState), &persisted.Storage); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	st := NewState()
+	for id, attached := range persisted.Storage {
+		st.storage[id] = attached
+	}
+	for id, size := range persisted.Sizes {
+		st.sizes[id] = size
+	}
+	return st, nil
 }
 
 // Write stores the supplied State storage map on the controller.  If
@@ -110,7 +151,10 @@ func (f *stateOps) Write(ctx context.Context, st *State) error {
 	}
 	var str string
 	if len(st.storage) > 0 {
-		data, err := yaml.Marshal(st.storage)
+		data, err := yaml.Marshal(persistedState{
+			Storage: st.storage,
+			Sizes:   st.sizes,
+		})
 		if err != nil {
 			return errors.Trace(err)
 		}
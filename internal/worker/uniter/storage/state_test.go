@@ -9,10 +9,13 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/yaml.v2"
 
 	"github.com/juju/juju/internal/charm/hooks"
 	"github.com/juju/juju/internal/worker/uniter/hook"
 	"github.com/juju/juju/internal/worker/uniter/storage"
+	"github.com/juju/juju/rpc/params"
 )
 
 type stateSuite struct {
@@ -136,6 +139,34 @@ func (s *stateOpsSuite) TestRead(c *tc.C) {
 	c.Assert(storage.Storage(obtainedSt), tc.DeepEquals, storage.Storage(s.storSt))
 }
 
+func (s *stateOpsSuite) TestReadSizes(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.storSt.SetSize(s.tag1.Id(), 1024)
+	s.expectState(c)
+	ops := storage.NewStateOps(s.mockStateOps)
+	obtainedSt, err := ops.Read(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	size, ok := obtainedSt.Size(s.tag1.Id())
+	c.Assert(ok, tc.IsTrue)
+	c.Assert(size, tc.Equals, uint64(1024))
+	_, ok = obtainedSt.Size(s.tag3.Id())
+	c.Assert(ok, tc.IsFalse)
+}
+
+func (s *stateOpsSuite) TestReadWithoutSizes(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	// Earlier versions of Juju stored only the attachments map.
+	data, err := yaml.Marshal(storage.Storage(s.storSt))
+	c.Assert(err, tc.ErrorIsNil)
+	s.mockStateOps.EXPECT().State(gomock.Any()).Return(params.UnitStateResult{StorageState: string(data)}, nil)
+	ops := storage.NewStateOps(s.mockStateOps)
+	obtainedSt, err := ops.Read(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(storage.Storage(obtainedSt), tc.DeepEquals, storage.Storage(s.storSt))
+	_, ok := obtainedSt.Size(s.tag1.Id())
+	c.Assert(ok, tc.IsFalse)
+}
+
 func (s *stateOpsSuite) TestReadNotFound(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	s.expectStateNotFound()
@@ -35,7 +35,6 @@ import (
 	"github.com/juju/juju/internal/worker/uniter/api"
 	"github.com/juju/juju/internal/worker/uniter/charm"
 	"github.com/juju/juju/internal/worker/uniter/container"
-	"github.com/juju/juju/internal/worker/uniter/hook"
 	uniterleadership "github.com/juju/juju/internal/worker/uniter/leadership"
 	"github.com/juju/juju/internal/worker/uniter/operation"
 	"github.com/juju/juju/internal/worker/uniter/reboot"
@@ -74,6 +73,7 @@ type Uniter struct {
 	storage                      *storage.Attachments
 	clock                        clock.Clock
 	tracer                       coretrace.Tracer
+	hookMetrics                  operation.Metrics
 
 	relationStateTracker relation.RelationStateTracker
 
@@ -185,6 +185,7 @@ type UniterParams struct {
 	ContainerNames               []string
 	NewPebbleClient              NewPebbleClientFunc
 	Tracer                       coretrace.Tracer
+	HookMetrics                  operation.Metrics
 }
 
 // NewOperationExecutorFunc is a func which returns an operations.Executor.
@@ -229,6 +230,7 @@ func newUniter(uniterParams *UniterParams) func() (worker.Worker, error) {
 			observer:                     uniterParams.Observer,
 			clock:                        uniterParams.Clock,
 			tracer:                       uniterParams.Tracer,
+			hookMetrics:                  uniterParams.HookMetrics,
 			downloader:                   uniterParams.Downloader,
 			runListener:                  uniterParams.RunListener,
 			rebootQuerier:                uniterParams.RebootQuerier,
@@ -379,6 +381,7 @@ func (u *Uniter) loop(unitTag names.UnitTag) (err error) {
 				WorkloadEventChannel:         u.workloadEventChannel,
 				InitialWorkloadEventIDs:      u.workloadEvents.EventIDs(),
 				ShutdownChannel:              u.shutdownChannel,
+				Clock:                        u.clock,
 			})
 		if err != nil {
 			return errors.Trace(err)
@@ -865,7 +868,9 @@ func (u *Uniter) init(ctx stdcontext.Context, unitTag names.UnitTag) (err error)
 		StateReadWriter: u.unit,
 		InitialState:    initialState,
 		AcquireLock:     u.acquireExecutionLock,
+		Clock:           u.clock,
 		Logger:          u.logger.Child("operation"),
+		Metrics:         u.hookMetrics,
 	})
 	if err != nil {
 		return errors.Trace(err)
@@ -960,10 +965,11 @@ func (u *Uniter) acquireExecutionLock(action, executionGroup string) (func(), er
 	return releaser, nil
 }
 
-func (u *Uniter) reportHookError(ctx stdcontext.Context, hookInfo hook.Info) error {
+func (u *Uniter) reportHookError(ctx stdcontext.Context, state operation.State) error {
 	// Set the agent status to "error". We must do this here in case the
 	// hook is interrupted (e.g. unit agent crashes), rather than immediately
 	// after attempting a runHookOp.
+	hookInfo := *state.Hook
 	hookName := string(hookInfo.Kind)
 	hookMessage := string(hookInfo.Kind)
 	statusData := map[string]interface{}{}
@@ -986,6 +992,9 @@ func (u *Uniter) reportHookError(ctx stdcontext.Context, hookInfo hook.Info) err
 	}
 	statusData["hook"] = hookName
 	statusMessage := fmt.Sprintf("hook failed: %q", hookMessage)
+	if state.HookTimedOut {
+		statusMessage = fmt.Sprintf("hook timed out: %q", hookMessage)
+	}
 	return setAgentStatus(ctx, u, status.Error, statusMessage, statusData)
 }
 
@@ -703,6 +703,13 @@ func (s *startUniter) expectRemoteStateWatchers(c tc.LikeC, ctx *testContext) {
 		return w, nil
 	}).AnyTimes()
 
+	ctx.unit.EXPECT().WatchHookSchedules(gomock.Any()).DoAndReturn(func(context.Context) (watcher.NotifyWatcher, error) {
+		ch := make(chan struct{}, 1)
+		ch <- struct{}{}
+		w := watchertest.NewMockNotifyWatcher(ch)
+		return w, nil
+	}).AnyTimes()
+
 	ctx.api.EXPECT().WatchUpdateStatusHookInterval(gomock.Any()).DoAndReturn(func(context.Context) (watcher.NotifyWatcher, error) {
 		ch := make(chan struct{}, 1)
 		ch <- struct{}{}
@@ -0,0 +1,6 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package usersecretsrotate provides a worker which generates new revisions
+// of user secrets with a generator when they are due to be rotated.
+package usersecretsrotate
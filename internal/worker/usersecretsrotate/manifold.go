@@ -0,0 +1,90 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usersecretsrotate
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/controller/usersecrets"
+	"github.com/juju/juju/core/logger"
+)
+
+// ManifoldConfig describes the resources used by the usersecretsrotate worker.
+type ManifoldConfig struct {
+	APICallerName string
+	ModelTag      names.ModelTag
+	Logger        logger.Logger
+	Clock         clock.Clock
+
+	NewUserSecretsFacade func(base.APICaller) SecretsFacade
+	NewWorker            func(Config) (worker.Worker, error)
+}
+
+// NewUserSecretsFacade returns a new SecretsFacade.
+func NewUserSecretsFacade(caller base.APICaller) SecretsFacade {
+	return usersecrets.NewClient(caller)
+}
+
+// Manifold returns a Manifold that encapsulates the usersecretsrotate worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.APICallerName,
+		},
+		Start: config.start,
+	}
+}
+
+// Validate is called by start to check for bad configuration.
+func (cfg ManifoldConfig) Validate() error {
+	if cfg.APICallerName == "" {
+		return errors.NotValidf("empty APICallerName")
+	}
+	if cfg.ModelTag.Id() == "" {
+		return errors.NotValidf("empty ModelTag")
+	}
+	if cfg.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if cfg.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if cfg.NewUserSecretsFacade == nil {
+		return errors.NotValidf("nil NewUserSecretsFacade")
+	}
+	if cfg.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	return nil
+}
+
+// start is a StartFunc for a Worker manifold.
+func (cfg ManifoldConfig) start(context context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var apiCaller base.APICaller
+	if err := getter.Get(cfg.APICallerName, &apiCaller); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	worker, err := cfg.NewWorker(Config{
+		SecretsFacade: cfg.NewUserSecretsFacade(apiCaller),
+		ModelTag:      cfg.ModelTag,
+		Logger:        cfg.Logger,
+		Clock:         cfg.Clock,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return worker, nil
+}
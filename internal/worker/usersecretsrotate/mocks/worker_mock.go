@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: worker.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -package mocks -destination mocks/worker_mock.go -source worker.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	watcher "github.com/juju/juju/core/watcher"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSecretsFacade is a mock of SecretsFacade interface.
+type MockSecretsFacade struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretsFacadeMockRecorder
+}
+
+// MockSecretsFacadeMockRecorder is the mock recorder for MockSecretsFacade.
+type MockSecretsFacadeMockRecorder struct {
+	mock *MockSecretsFacade
+}
+
+// NewMockSecretsFacade creates a new mock instance.
+func NewMockSecretsFacade(ctrl *gomock.Controller) *MockSecretsFacade {
+	mock := &MockSecretsFacade{ctrl: ctrl}
+	mock.recorder = &MockSecretsFacadeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretsFacade) EXPECT() *MockSecretsFacadeMockRecorder {
+	return m.recorder
+}
+
+// RotateUserSecrets mocks base method.
+func (m *MockSecretsFacade) RotateUserSecrets(ctx context.Context, uris ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range uris {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateUserSecrets", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateUserSecrets indicates an expected call of RotateUserSecrets.
+func (mr *MockSecretsFacadeMockRecorder) RotateUserSecrets(ctx any, uris ...any) *MockSecretsFacadeRotateUserSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, uris...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateUserSecrets", reflect.TypeOf((*MockSecretsFacade)(nil).RotateUserSecrets), varargs...)
+	return &MockSecretsFacadeRotateUserSecretsCall{Call: call}
+}
+
+// MockSecretsFacadeRotateUserSecretsCall wrap *gomock.Call
+type MockSecretsFacadeRotateUserSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretsFacadeRotateUserSecretsCall) Return(arg0 error) *MockSecretsFacadeRotateUserSecretsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretsFacadeRotateUserSecretsCall) Do(f func(context.Context, ...string) error) *MockSecretsFacadeRotateUserSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretsFacadeRotateUserSecretsCall) DoAndReturn(f func(context.Context, ...string) error) *MockSecretsFacadeRotateUserSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchUserSecretsRotationChanges mocks base method.
+func (m *MockSecretsFacade) WatchUserSecretsRotationChanges(arg0 context.Context) (watcher.SecretTriggerWatcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchUserSecretsRotationChanges", arg0)
+	ret0, _ := ret[0].(watcher.SecretTriggerWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchUserSecretsRotationChanges indicates an expected call of WatchUserSecretsRotationChanges.
+func (mr *MockSecretsFacadeMockRecorder) WatchUserSecretsRotationChanges(arg0 any) *MockSecretsFacadeWatchUserSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchUserSecretsRotationChanges", reflect.TypeOf((*MockSecretsFacade)(nil).WatchUserSecretsRotationChanges), arg0)
+	return &MockSecretsFacadeWatchUserSecretsRotationChangesCall{Call: call}
+}
+
+// MockSecretsFacadeWatchUserSecretsRotationChangesCall wrap *gomock.Call
+type MockSecretsFacadeWatchUserSecretsRotationChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretsFacadeWatchUserSecretsRotationChangesCall) Return(arg0 watcher.SecretTriggerWatcher, arg1 error) *MockSecretsFacadeWatchUserSecretsRotationChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretsFacadeWatchUserSecretsRotationChangesCall) Do(f func(context.Context) (watcher.SecretTriggerWatcher, error)) *MockSecretsFacadeWatchUserSecretsRotationChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretsFacadeWatchUserSecretsRotationChangesCall) DoAndReturn(f func(context.Context) (watcher.SecretTriggerWatcher, error)) *MockSecretsFacadeWatchUserSecretsRotationChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
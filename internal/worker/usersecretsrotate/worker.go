@@ -0,0 +1,138 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usersecretsrotate
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/worker/secretrotate"
+)
+
+// SecretsFacade instances provide a set of API for the worker to
+// rotate user secrets.
+type SecretsFacade interface {
+	WatchUserSecretsRotationChanges(context.Context) (watcher.SecretTriggerWatcher, error)
+	RotateUserSecrets(ctx context.Context, uris ...string) error
+}
+
+// Config defines the operation of the Worker.
+type Config struct {
+	SecretsFacade
+	ModelTag names.ModelTag
+	Logger   logger.Logger
+	Clock    clock.Clock
+}
+
+// Validate returns an error if config cannot drive the Worker.
+func (config Config) Validate() error {
+	if config.SecretsFacade == nil {
+		return errors.NotValidf("nil SecretsFacade")
+	}
+	if config.ModelTag.Id() == "" {
+		return errors.NotValidf("empty ModelTag")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	return nil
+}
+
+// NewWorker returns a usersecretsrotate Worker backed by config, or an error.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w := &Worker{
+		config:        config,
+		rotateSecrets: make(chan []string),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "user-secrets-rotate",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Trace(err)
+}
+
+// Worker generates new revisions of user secrets when they are due
+// to be rotated.
+type Worker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+
+	rotateSecrets chan []string
+}
+
+// Kill is defined on worker.Worker.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *Worker) loop() (err error) {
+	ctx, cancel := w.scopeContext()
+	defer cancel()
+
+	// The secretrotate worker does the scheduling; the model
+	// is the owner of all user secrets.
+	rotateWorker, err := secretrotate.New(secretrotate.Config{
+		SecretManagerFacade: rotationWatcher{w.config.SecretsFacade},
+		Logger:              w.config.Logger,
+		Clock:               w.config.Clock,
+		SecretOwners:        []names.Tag{w.config.ModelTag},
+		RotateSecrets:       w.rotateSecrets,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(rotateWorker); err != nil {
+		return errors.Trace(err)
+	}
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return errors.Trace(w.catacomb.ErrDying())
+		case uris := <-w.rotateSecrets:
+			w.config.Logger.Debugf(ctx, "rotating user secrets %v", uris)
+			// A failed rotation is rescheduled by the controller,
+			// so there's no need to stop the worker.
+			if err := w.config.SecretsFacade.RotateUserSecrets(ctx, uris...); err != nil {
+				w.config.Logger.Warningf(ctx, "rotating user secrets: %v", err)
+			}
+		}
+	}
+}
+
+func (w *Worker) scopeContext() (context.Context, context.CancelFunc) {
+	return context.WithCancel(w.catacomb.Context(context.Background()))
+}
+
+// rotationWatcher adapts the SecretsFacade to the facade
+// used by the secretrotate worker.
+type rotationWatcher struct {
+	facade SecretsFacade
+}
+
+// WatchSecretsRotationChanges is part of the secretrotate.SecretManagerFacade
+// interface. All user secrets are owned by the model, so the owners are ignored.
+func (r rotationWatcher) WatchSecretsRotationChanges(ctx context.Context, _ ...names.Tag) (watcher.SecretTriggerWatcher, error) {
+	return r.facade.WatchUserSecretsRotationChanges(ctx)
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usersecretsrotate_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	corewatcher "github.com/juju/juju/core/watcher"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/usersecretsrotate"
+	"github.com/juju/juju/internal/worker/usersecretsrotate/mocks"
+)
+
+type workerSuite struct {
+	testhelpers.IsolationSuite
+
+	clock         testclock.AdvanceableClock
+	facade        *mocks.MockSecretsFacade
+	rotateWatcher *mocks.MockSecretTriggerWatcher
+	changes       chan []corewatcher.SecretTriggerChange
+}
+
+func TestWorkerSuite(t *testing.T) {
+	tc.Run(t, &workerSuite{})
+}
+
+func (s *workerSuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.clock = testclock.NewDilatedWallClock(100 * time.Millisecond)
+	s.facade = mocks.NewMockSecretsFacade(ctrl)
+	s.rotateWatcher = mocks.NewMockSecretTriggerWatcher(ctrl)
+	s.changes = make(chan []corewatcher.SecretTriggerChange)
+
+	s.facade.EXPECT().WatchUserSecretsRotationChanges(gomock.Any()).Return(s.rotateWatcher, nil)
+	s.rotateWatcher.EXPECT().Changes().AnyTimes().Return(s.changes)
+	s.rotateWatcher.EXPECT().Kill().MaxTimes(1)
+	s.rotateWatcher.EXPECT().Wait().Return(nil).MinTimes(1)
+	return ctrl
+}
+
+func (s *workerSuite) config(c *tc.C) usersecretsrotate.Config {
+	return usersecretsrotate.Config{
+		SecretsFacade: s.facade,
+		ModelTag:      coretesting.ModelTag,
+		Logger:        loggertesting.WrapCheckLog(c),
+		Clock:         s.clock,
+	}
+}
+
+func (s *workerSuite) sendChange(c *tc.C, change corewatcher.SecretTriggerChange) {
+	select {
+	case s.changes <- []corewatcher.SecretTriggerChange{change}:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out sending change")
+	}
+}
+
+func (s *workerSuite) TestValidateConfig(c *tc.C) {
+	cfg := usersecretsrotate.Config{}
+	c.Check(cfg.Validate(), tc.ErrorMatches, "nil SecretsFacade not valid")
+}
+
+func (s *workerSuite) TestRotate(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	rotated := make(chan []string, 1)
+	s.facade.EXPECT().RotateUserSecrets(gomock.Any(), uri.String()).DoAndReturn(
+		func(_ context.Context, uris ...string) error {
+			rotated <- uris
+			return nil
+		})
+
+	w, err := usersecretsrotate.NewWorker(s.config(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.sendChange(c, corewatcher.SecretTriggerChange{
+		URI:             uri,
+		NextTriggerTime: s.clock.Now().Add(time.Hour),
+	})
+	s.clock.Advance(time.Hour)
+
+	select {
+	case uris := <-rotated:
+		c.Assert(uris, tc.DeepEquals, []string{uri.String()})
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for rotation")
+	}
+}
+
+func (s *workerSuite) TestRotateFailedKeepsRunning(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	rotated := make(chan struct{}, 1)
+	s.facade.EXPECT().RotateUserSecrets(gomock.Any(), uri.String()).DoAndReturn(
+		func(context.Context, ...string) error {
+			rotated <- struct{}{}
+			return errors.New("boom")
+		})
+
+	w, err := usersecretsrotate.NewWorker(s.config(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.sendChange(c, corewatcher.SecretTriggerChange{
+		URI:             uri,
+		NextTriggerTime: s.clock.Now(),
+	})
+
+	select {
+	case <-rotated:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for rotation")
+	}
+	workertest.CheckAlive(c, w)
+}
@@ -226,6 +226,64 @@ type ApplicationConfigUnsetArgs struct {
 	Args []ApplicationUnset
 }
 
+// ApplicationHookSchedulesResults holds the results of the application
+// HookSchedules call.
+type ApplicationHookSchedulesResults struct {
+	Results []ApplicationHookSchedulesResult `json:"results"`
+}
+
+// ApplicationHookSchedulesResult holds the hook schedules of an application,
+// or an error if they could not be retrieved.
+type ApplicationHookSchedulesResult struct {
+	Schedules []ApplicationHookSchedule `json:"schedules"`
+	Error     *Error                    `json:"error,omitempty"`
+}
+
+// ApplicationHookSchedule describes a schedule declared by the charm of an
+// application. Cron and Jitter are in effect for the application, and
+// differ from those declared by the charm when overridden by an operator.
+type ApplicationHookSchedule struct {
+	Name        string        `json:"name"`
+	Cron        string        `json:"cron"`
+	Jitter      time.Duration `json:"jitter"`
+	CharmCron   string        `json:"charm-cron"`
+	CharmJitter time.Duration `json:"charm-jitter"`
+}
+
+// SetApplicationHookSchedulesArgs holds the parameters for overriding the
+// hook schedules of specified applications.
+type SetApplicationHookSchedulesArgs struct {
+	Args []SetApplicationHookSchedules `json:"args"`
+}
+
+// SetApplicationHookSchedules holds the overrides for the hook schedules of
+// an application.
+type SetApplicationHookSchedules struct {
+	ApplicationTag string                 `json:"application-tag"`
+	Schedules      []HookScheduleOverride `json:"schedules"`
+}
+
+// HookScheduleOverride holds an override for a named hook schedule. A nil
+// Cron or Jitter leaves that part of the schedule unchanged.
+type HookScheduleOverride struct {
+	Name   string         `json:"name"`
+	Cron   *string        `json:"cron,omitempty"`
+	Jitter *time.Duration `json:"jitter,omitempty"`
+}
+
+// UnsetApplicationHookSchedulesArgs holds the parameters for resetting the
+// hook schedules of specified applications to those declared by the charm.
+type UnsetApplicationHookSchedulesArgs struct {
+	Args []UnsetApplicationHookSchedules `json:"args"`
+}
+
+// UnsetApplicationHookSchedules holds the names of the hook schedules of an
+// application to reset.
+type UnsetApplicationHookSchedules struct {
+	ApplicationTag string   `json:"application-tag"`
+	Names          []string `json:"names"`
+}
+
 // ApplicationCharmRelations holds parameters for making the application CharmRelations call.
 type ApplicationCharmRelations struct {
 	ApplicationName string `json:"application"`
@@ -0,0 +1,71 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// AuditLogQueryArgs holds the filters used to query the audit log of a
+// controller.
+type AuditLogQueryArgs struct {
+	// User restricts the results to requests made by the given user.
+	User string `json:"user,omitempty"`
+
+	// ModelUUID restricts the results to requests made against the given
+	// model.
+	ModelUUID string `json:"model-uuid,omitempty"`
+
+	// Facade restricts the results to calls to the given facade.
+	Facade string `json:"facade,omitempty"`
+
+	// Method restricts the results to calls to the given method.
+	Method string `json:"method,omitempty"`
+
+	// From restricts the results to requests made at or after this time.
+	From *time.Time `json:"from,omitempty"`
+
+	// To restricts the results to requests made at or before this time.
+	To *time.Time `json:"to,omitempty"`
+
+	// ErrorsOnly restricts the results to requests that returned errors.
+	ErrorsOnly bool `json:"errors-only,omitempty"`
+
+	// Limit is the maximum number of the most recent results to return.
+	Limit int `json:"limit,omitempty"`
+}
+
+// AuditLogError holds an error returned in response to an audited request.
+type AuditLogError struct {
+	Message string `json:"message"`
+	Code    string `json:"code,omitempty"`
+}
+
+// AuditLogEntry holds a single audited API request.
+type AuditLogEntry struct {
+	ConversationID string          `json:"conversation-id"`
+	ConnectionID   string          `json:"connection-id"`
+	RequestID      uint64          `json:"request-id"`
+	When           time.Time       `json:"when"`
+	User           string          `json:"user"`
+	Command        string          `json:"command,omitempty"`
+	ModelName      string          `json:"model-name,omitempty"`
+	ModelUUID      string          `json:"model-uuid,omitempty"`
+	Facade         string          `json:"facade"`
+	Method         string          `json:"method"`
+	Version        int             `json:"version"`
+	Args           string          `json:"args,omitempty"`
+	Errors         []AuditLogError `json:"errors,omitempty"`
+}
+
+// AuditLogQueryResult holds the audit log entries recorded by a single
+// controller.
+type AuditLogQueryResult struct {
+	// ControllerID identifies the controller the entries were read from.
+	ControllerID string          `json:"controller-id"`
+	Entries      []AuditLogEntry `json:"entries"`
+
+	// Truncated is true if more entries matched than could be returned,
+	// in which case only the most recent entries are returned.
+	Truncated bool   `json:"truncated,omitempty"`
+	Error     *Error `json:"error,omitempty"`
+}
@@ -58,6 +58,9 @@ type BackupsMetadataResult struct {
 
 	// HANodes reflects HA configuration: number of controller nodes in HA.
 	HANodes int64 `json:"ha-nodes"`
+
+	// Databases holds the namespaces of the Dqlite databases in the backup.
+	Databases []string `json:"databases,omitempty"`
 }
 
 // CreateResult updates the result with the information in the
@@ -92,6 +95,7 @@ func CreateResult(meta *corebackups.Metadata, filename string) BackupsMetadataRe
 	result.ControllerMachineID = meta.Controller.MachineID
 	result.ControllerMachineInstanceID = meta.Controller.MachineInstanceID
 	result.Filename = filename
+	result.Databases = meta.Databases
 
 	return result
 }
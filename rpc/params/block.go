@@ -3,6 +3,8 @@
 
 package params
 
+import "time"
+
 // BlockType values define model block type, which can be used to prevent
 // accidental damage to Juju deployments.
 type BlockType = string
@@ -34,6 +36,28 @@ type Block struct {
 	// Message is a descriptive or an explanatory message
 	// that the block was created with.
 	Message string `json:"message,omitempty"`
+
+	// CreatedBy is the name of the user that created the block.
+	CreatedBy string `json:"created-by,omitempty"`
+
+	// CreatedAt is the time at which the block was created.
+	CreatedAt *time.Time `json:"created-at,omitempty"`
+
+	// ExpiresAt is the time at which the block stops applying, if any.
+	ExpiresAt *time.Time `json:"expires-at,omitempty"`
+
+	// Applications, if set, limits the block to operations on the
+	// named applications.
+	Applications []string `json:"applications,omitempty"`
+
+	// Methods, if set, limits the block to the named facade methods.
+	Methods []string `json:"methods,omitempty"`
+
+	// ExemptUsers holds the names of the users not subject to the block.
+	ExemptUsers []string `json:"exempt-users,omitempty"`
+
+	// ExemptRoles holds the model access levels not subject to the block.
+	ExemptRoles []string `json:"exempt-roles,omitempty"`
 }
 
 // BlockSwitchParams holds the parameters for switching
@@ -46,6 +70,23 @@ type BlockSwitchParams struct {
 	// Message is a descriptive or an explanatory message
 	// that accompanies the switch.
 	Message string `json:"message,omitempty"`
+
+	// ExpiresAt is the optional time at which the block stops applying.
+	ExpiresAt *time.Time `json:"expires-at,omitempty"`
+
+	// Applications optionally limits the block to operations on the
+	// named applications.
+	Applications []string `json:"applications,omitempty"`
+
+	// Methods optionally limits the block to the named facade methods,
+	// in the form "Facade.Method" or "Facade.*".
+	Methods []string `json:"methods,omitempty"`
+
+	// ExemptUsers holds the names of the users not subject to the block.
+	ExemptUsers []string `json:"exempt-users,omitempty"`
+
+	// ExemptRoles holds the model access levels not subject to the block.
+	ExemptRoles []string `json:"exempt-roles,omitempty"`
 }
 
 // BlockResult holds the result of an API call to retrieve details
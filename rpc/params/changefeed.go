@@ -0,0 +1,47 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+// WatchChangesArgs holds the arguments for watching the change feed of a
+// model.
+type WatchChangesArgs struct {
+	// Namespaces restricts the changes to the given namespaces. If empty,
+	// changes from all namespaces are watched.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// FromChangeLogID, if set, replays all the changes after the given
+	// change log ID before emitting live changes.
+	FromChangeLogID *int64 `json:"from-change-log-id,omitempty"`
+}
+
+// ChangeFeedEvent describes a single change from the change feed.
+type ChangeFeedEvent struct {
+	// ID is the change log ID of the change. A value of -1 indicates that
+	// the ID is not known.
+	ID int64 `json:"id"`
+
+	// Namespace is the namespace the change belongs to.
+	Namespace string `json:"namespace"`
+
+	// Changed is the value that was changed.
+	Changed string `json:"changed"`
+
+	// Deleted is true if the change was a deletion.
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// ChangeFeedWatchResult holds a change feed watcher ID and the changes
+// emitted by the watcher.
+type ChangeFeedWatchResult struct {
+	WatcherId string            `json:"watcher-id"`
+	Changes   []ChangeFeedEvent `json:"changes"`
+	Error     *Error            `json:"error,omitempty"`
+}
+
+// ChangeFeedNamespacesResult holds the namespaces that can be watched
+// with the change feed.
+type ChangeFeedNamespacesResult struct {
+	Namespaces []string `json:"namespaces"`
+	Error      *Error   `json:"error,omitempty"`
+}
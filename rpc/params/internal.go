@@ -255,6 +255,37 @@ type ConfigSettingsResults struct {
 	Results []ConfigSettingsResult `json:"results"`
 }
 
+// HookTimeoutResult holds the hook timeout for a unit or an error. A zero
+// timeout means that hooks run without a deadline.
+type HookTimeoutResult struct {
+	Error   *Error        `json:"error,omitempty"`
+	Timeout time.Duration `json:"timeout"`
+}
+
+// HookTimeoutResults holds multiple hook timeouts or errors.
+type HookTimeoutResults struct {
+	Results []HookTimeoutResult `json:"results"`
+}
+
+// HookSchedule holds a named schedule on which a unit runs the scheduled
+// hook.
+type HookSchedule struct {
+	Name   string        `json:"name"`
+	Cron   string        `json:"cron"`
+	Jitter time.Duration `json:"jitter"`
+}
+
+// HookSchedulesResult holds the hook schedules for a unit or an error.
+type HookSchedulesResult struct {
+	Error     *Error         `json:"error,omitempty"`
+	Schedules []HookSchedule `json:"schedules"`
+}
+
+// HookSchedulesResults holds multiple hook schedules or errors.
+type HookSchedulesResults struct {
+	Results []HookSchedulesResult `json:"results"`
+}
+
 // UnitStateResult holds a unit's state map or an error.
 type UnitStateResult struct {
 	Error *Error `json:"error,omitempty"`
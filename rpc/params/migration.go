@@ -64,6 +64,42 @@ type InitiateMigrationResult struct {
 	MigrationId string `json:"migration-id"`
 }
 
+// MigrationDryRunResults is used to return the reports of one or more
+// migration dry runs.
+type MigrationDryRunResults struct {
+	Results []MigrationDryRunResult `json:"results"`
+}
+
+// MigrationDryRunResult is used to return the report of a dry run of
+// one model migration. The report lists every problem found by the
+// source and target prechecks, along with an estimate of the binaries
+// that the migration would transfer.
+type MigrationDryRunResult struct {
+	ModelTag string                    `json:"model-tag"`
+	Error    *Error                    `json:"error,omitempty"`
+	Blockers []string                  `json:"blockers,omitempty"`
+	Warnings []string                  `json:"warnings,omitempty"`
+	Transfer MigrationTransferEstimate `json:"transfer"`
+}
+
+// MigrationTransferEstimate holds an estimate of the binaries that a
+// model migration transfers to the target controller.
+type MigrationTransferEstimate struct {
+	Charms           int   `json:"charms"`
+	CharmBytes       int64 `json:"charm-bytes"`
+	Resources        int   `json:"resources"`
+	ResourceBytes    int64 `json:"resource-bytes"`
+	AgentBinaries    int   `json:"agent-binaries"`
+	AgentBinaryBytes int64 `json:"agent-binary-bytes"`
+}
+
+// MigrationPrecheckReport holds the problems found by running every
+// migration precheck on the target controller.
+type MigrationPrecheckReport struct {
+	Blockers []string `json:"blockers,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
 // SetMigrationPhaseArgs provides a migration phase to the
 // migrationmaster.SetPhase API method.
 type SetMigrationPhaseArgs struct {
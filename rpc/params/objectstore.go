@@ -0,0 +1,132 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// ObjectStoreModelArgs holds the models whose object stores are being
+// inspected. If no models are supplied, every model on the controller is
+// inspected.
+type ObjectStoreModelArgs struct {
+	ModelUUIDs []string `json:"model-uuids,omitempty"`
+}
+
+// ObjectStoreReference describes an entity that references an object in the
+// object store.
+type ObjectStoreReference struct {
+	// Kind is the kind of entity, one of charm, resource or agent-binary.
+	Kind string `json:"kind"`
+
+	// Name identifies the entity.
+	Name string `json:"name"`
+}
+
+// ObjectStoreObject describes a single object in the object store.
+type ObjectStoreObject struct {
+	SHA256     string                 `json:"sha256"`
+	SHA384     string                 `json:"sha384"`
+	Size       int64                  `json:"size"`
+	Paths      []string               `json:"paths,omitempty"`
+	References []ObjectStoreReference `json:"references,omitempty"`
+}
+
+// ObjectStoreListResult holds the objects in the object store of a model.
+type ObjectStoreListResult struct {
+	ModelUUID string              `json:"model-uuid"`
+	Objects   []ObjectStoreObject `json:"objects,omitempty"`
+	Error     *Error              `json:"error,omitempty"`
+}
+
+// ObjectStoreListResults holds the results of a ListObjects call.
+type ObjectStoreListResults struct {
+	Results []ObjectStoreListResult `json:"results"`
+}
+
+// ObjectStoreShowArgs identifies a single object in the object store of a
+// model.
+type ObjectStoreShowArgs struct {
+	ModelUUID string `json:"model-uuid"`
+
+	// Key is either a path of the object, its SHA256 hash or a unique prefix
+	// of its SHA256 hash.
+	Key string `json:"key"`
+}
+
+// ObjectStoreShowResult holds the result of a ShowObject call.
+type ObjectStoreShowResult struct {
+	Object *ObjectStoreObject `json:"object,omitempty"`
+	Error  *Error             `json:"error,omitempty"`
+}
+
+// ObjectStoreVerifyFailure describes an object whose contents don't match
+// its metadata.
+type ObjectStoreVerifyFailure struct {
+	SHA256 string   `json:"sha256"`
+	Paths  []string `json:"paths,omitempty"`
+	Reason string   `json:"reason"`
+}
+
+// ObjectStoreVerifyResult holds the outcome of verifying the object store of
+// a model.
+type ObjectStoreVerifyResult struct {
+	ModelUUID string                     `json:"model-uuid"`
+	Checked   int                        `json:"checked"`
+	Failures  []ObjectStoreVerifyFailure `json:"failures,omitempty"`
+	Error     *Error                     `json:"error,omitempty"`
+}
+
+// ObjectStoreVerifyResults holds the results of a VerifyObjects call.
+type ObjectStoreVerifyResults struct {
+	Results []ObjectStoreVerifyResult `json:"results"`
+}
+
+// ObjectStorePruneArgs holds the models whose object stores are being
+// pruned of unreferenced objects.
+type ObjectStorePruneArgs struct {
+	ModelUUIDs []string `json:"model-uuids,omitempty"`
+
+	// DryRun reports the objects that would be removed, without removing
+	// them.
+	DryRun bool `json:"dry-run,omitempty"`
+
+	// MinAge is the time since an object was last stored before it can be
+	// removed, so that objects which are still being added aren't removed
+	// before the entity referencing them is. If zero, a default is used.
+	MinAge time.Duration `json:"min-age,omitempty"`
+}
+
+// ObjectStorePruneResult holds the outcome of pruning the object store of a
+// model.
+type ObjectStorePruneResult struct {
+	ModelUUID string `json:"model-uuid"`
+
+	// Objects are the unreferenced objects that were, or with a dry run
+	// would be, removed.
+	Objects []ObjectStoreObject `json:"objects,omitempty"`
+
+	// Bytes is the total size of the removed objects.
+	Bytes int64  `json:"bytes"`
+	Error *Error `json:"error,omitempty"`
+}
+
+// ObjectStorePruneResults holds the results of a PruneObjects call.
+type ObjectStorePruneResults struct {
+	Results []ObjectStorePruneResult `json:"results"`
+}
+
+// ObjectStoreUsageResult holds the usage of the object store of a model.
+type ObjectStoreUsageResult struct {
+	ModelUUID string `json:"model-uuid"`
+	Objects   int    `json:"objects"`
+	Bytes     int64  `json:"bytes"`
+	Error     *Error `json:"error,omitempty"`
+}
+
+// ObjectStoreUsageResults holds the results of a Usage call.
+type ObjectStoreUsageResults struct {
+	// Backend is the type of object store used by the controller, either
+	// file or s3.
+	Backend string                   `json:"backend"`
+	Results []ObjectStoreUsageResult `json:"results"`
+}
@@ -0,0 +1,53 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// RemovalJob describes a scheduled removal of an entity in a model.
+type RemovalJob struct {
+	// UUID uniquely identifies the removal job.
+	UUID string `json:"uuid"`
+
+	// EntityType is the type of entity being removed, one of relation,
+	// unit or application.
+	EntityType string `json:"entity-type"`
+
+	// EntityUUID uniquely identifies the entity being removed.
+	EntityUUID string `json:"entity-uuid"`
+
+	// EntityName is the name of the entity being removed, if it still
+	// exists.
+	EntityName string `json:"entity-name,omitempty"`
+
+	// Force indicates whether the removal is forced.
+	Force bool `json:"force"`
+
+	// ScheduledFor is the earliest time at which the job will be executed.
+	// For forced removals, this is the end of the wait duration.
+	ScheduledFor time.Time `json:"scheduled-for"`
+
+	// LastError is the error returned by the last failed execution of the
+	// job.
+	LastError string `json:"last-error,omitempty"`
+
+	// LastErrorAt is the time at which the last error was recorded.
+	LastErrorAt *time.Time `json:"last-error-at,omitempty"`
+}
+
+// RemovalJobsResult holds the removal jobs scheduled in a model.
+type RemovalJobsResult struct {
+	Jobs  []RemovalJob `json:"jobs"`
+	Error *Error       `json:"error,omitempty"`
+}
+
+// RemovalJobArg identifies a removal job.
+type RemovalJobArg struct {
+	UUID string `json:"uuid"`
+}
+
+// RemovalJobArgs holds the removal jobs to cancel or force.
+type RemovalJobArgs struct {
+	Jobs []RemovalJobArg `json:"jobs"`
+}
@@ -78,6 +78,12 @@ type UpsertSecretArg struct {
 	Params map[string]interface{} `json:"params,omitempty"`
 	// Data is the key values of the secret value itself.
 	Content SecretContentParams `json:"content,omitempty"`
+	// Generator is the spec used to generate the content of a
+	// user secret, both initially and each time it is rotated.
+	Generator string `json:"generator,omitempty"`
+	// KeepRevisions is the number of revisions of a user secret
+	// with a generator to keep when pruning.
+	KeepRevisions *int `json:"keep-revisions,omitempty"`
 }
 
 // CreateSecretURIsArg holds args for creating secret URIs.
@@ -153,7 +159,8 @@ func (arg UpdateUserSecretArg) Validate() error {
 func (arg UpdateUserSecretArg) HasUpdate() bool {
 	return arg.AutoPrune != nil || arg.Description != nil || arg.Label != nil ||
 		arg.RotatePolicy != nil || arg.ExpireTime != nil ||
-		len(arg.Content.Data) != 0 || arg.Content.ValueRef != nil
+		len(arg.Content.Data) != 0 || arg.Content.ValueRef != nil ||
+		arg.Generator != "" || arg.KeepRevisions != nil
 }
 
 // DeleteSecretArgs holds args for deleting secrets.
@@ -346,6 +353,47 @@ type SecretRotatedArg struct {
 	Skip             bool   `json:"skip"`
 }
 
+// SecretURIArgs holds the args for operations on secrets.
+type SecretURIArgs struct {
+	Args []SecretURIArg `json:"args"`
+}
+
+// SecretURIArg holds the URI of a secret.
+type SecretURIArg struct {
+	URI string `json:"uri"`
+}
+
+// SecretAccessLogArgs holds the args for reading the access log of secrets.
+type SecretAccessLogArgs struct {
+	Args []SecretAccessLogArg `json:"args"`
+}
+
+// SecretAccessLogArg holds the args for reading the access log of a secret.
+type SecretAccessLogArg struct {
+	URI   string `json:"uri"`
+	Limit int    `json:"limit,omitempty"`
+}
+
+// SecretAccessLogResults holds the access logs of secrets.
+type SecretAccessLogResults struct {
+	Results []SecretAccessLogResult `json:"results"`
+}
+
+// SecretAccessLogResult holds the access log of a secret,
+// ordered from the most recent entry to the oldest.
+type SecretAccessLogResult struct {
+	Entries []SecretAccessLogEntry `json:"entries,omitempty"`
+	Error   *Error                 `json:"error,omitempty"`
+}
+
+// SecretAccessLogEntry records an attempt to read a secret revision.
+type SecretAccessLogEntry struct {
+	AccessorTag string    `json:"accessor-tag"`
+	Revision    int       `json:"revision"`
+	AccessTime  time.Time `json:"access-time"`
+	Denied      bool      `json:"denied,omitempty"`
+}
+
 // GrantRevokeSecretArgs holds args for changing access to secrets.
 type GrantRevokeSecretArgs struct {
 	Args []GrantRevokeSecretArg `json:"args"`
@@ -594,3 +642,56 @@ type SecretRevisionWatchResult struct {
 type SecretRevisionWatchResults struct {
 	Results []SecretRevisionWatchResult `json:"results"`
 }
+
+// MigrateSecretsArgs holds the names of the secret backends
+// between which secret content is migrated.
+type MigrateSecretsArgs struct {
+	FromBackend string `json:"from-backend"`
+	ToBackend   string `json:"to-backend"`
+}
+
+// SecretRevisionsToMigrate holds the revisions of a secret
+// stored in the secret backend being migrated from.
+type SecretRevisionsToMigrate struct {
+	URI       string `json:"uri"`
+	Label     string `json:"label,omitempty"`
+	OwnerTag  string `json:"owner-tag"`
+	Revisions []int  `json:"revisions"`
+}
+
+// SecretRevisionsToMigrateResults holds the secrets with
+// revisions to migrate.
+type SecretRevisionsToMigrateResults struct {
+	Results []SecretRevisionsToMigrate `json:"results"`
+}
+
+// MigrateSecretRevisionArg identifies a secret revision to migrate.
+type MigrateSecretRevisionArg struct {
+	URI      string `json:"uri"`
+	Revision int    `json:"revision"`
+}
+
+// MigrateSecretRevisionsArgs holds the secret revisions to
+// migrate between secret backends.
+type MigrateSecretRevisionsArgs struct {
+	FromBackend string                     `json:"from-backend"`
+	ToBackend   string                     `json:"to-backend"`
+	Args        []MigrateSecretRevisionArg `json:"args"`
+}
+
+// MigrateSecretRevisionResult holds the outcome of migrating
+// a secret revision.
+type MigrateSecretRevisionResult struct {
+	// Skipped is true if the revision was already stored
+	// in the target backend.
+	Skipped bool `json:"skipped,omitempty"`
+	// Checksum is the verified checksum of the migrated content.
+	Checksum string `json:"checksum,omitempty"`
+	Error    *Error `json:"error,omitempty"`
+}
+
+// MigrateSecretRevisionResults holds the outcome of migrating
+// secret revisions.
+type MigrateSecretRevisionResults struct {
+	Results []MigrateSecretRevisionResult `json:"results"`
+}
@@ -3,6 +3,8 @@
 
 package params
 
+import "time"
+
 // SSHHostKeySet defines SSH host keys for one or more entities
 // (typically machines).
 type SSHHostKeySet struct {
@@ -58,3 +60,69 @@ type SSHPublicKeysResult struct {
 	Error      *Error   `json:"error,omitempty"`
 	PublicKeys []string `json:"public-keys,omitempty"`
 }
+
+// SSHSessionRecordingFilter is used to filter the recordings of interactive
+// SSH sessions listed by the SSHClient.ListSessionRecordings API.
+type SSHSessionRecordingFilter struct {
+	User   string `json:"user,omitempty"`
+	Target string `json:"target,omitempty"`
+}
+
+// SSHSessionRecordingsResult is used to return the recordings of interactive
+// SSH sessions for the SSHClient.ListSessionRecordings API.
+type SSHSessionRecordingsResult struct {
+	Recordings []SSHSessionRecording `json:"recordings"`
+}
+
+// SSHSessionRecording describes a single recording of an interactive SSH
+// session (see SSHSessionRecordingsResult).
+type SSHSessionRecording struct {
+	Path    string    `json:"path"`
+	User    string    `json:"user"`
+	Target  string    `json:"target"`
+	Started time.Time `json:"started"`
+	Size    int64     `json:"size"`
+}
+
+// SSHSessionRecordingArg identifies a recording of an interactive SSH
+// session for the SSHClient.SessionRecording API.
+type SSHSessionRecordingArg struct {
+	Path string `json:"path"`
+}
+
+// SSHSessionRecordingResult is used to return the content of a recording of
+// an interactive SSH session, in the asciicast v2 format.
+type SSHSessionRecordingResult struct {
+	Error   *Error `json:"error,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+// SSHUserCertificateArg holds the public key that a short-lived user
+// certificate is requested for by the SSHClient.UserCertificate API.
+type SSHUserCertificateArg struct {
+	PublicKey string `json:"public-key"`
+}
+
+// SSHUserCertificateResult is used to return a short-lived user certificate,
+// signed by the SSH certificate authority of the controller.
+type SSHUserCertificateResult struct {
+	Error       *Error    `json:"error,omitempty"`
+	Certificate string    `json:"certificate,omitempty"`
+	Principals  []string  `json:"principals,omitempty"`
+	ValidBefore time.Time `json:"valid-before,omitempty"`
+}
+
+// SSHCertificateAuthorityResults holds the results of the
+// KeyUpdater.SSHCertificateAuthority API.
+type SSHCertificateAuthorityResults struct {
+	Results []SSHCertificateAuthorityResult `json:"results"`
+}
+
+// SSHCertificateAuthorityResult holds the public keys of the certificate
+// authorities that a machine trusts to sign user certificates, and the
+// principals that it accepts in them.
+type SSHCertificateAuthorityResult struct {
+	Error      *Error   `json:"error,omitempty"`
+	PublicKeys []string `json:"public-keys,omitempty"`
+	Principals []string `json:"principals,omitempty"`
+}
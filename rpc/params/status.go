@@ -254,6 +254,7 @@ type StatusHistoryFilter struct {
 	Size    int            `json:"size"`
 	Date    *time.Time     `json:"date"`
 	Delta   *time.Duration `json:"delta"`
+	ToDate  *time.Time     `json:"to-date,omitempty"`
 	Exclude []string       `json:"exclude"`
 }
 
@@ -118,6 +118,9 @@ type StorageAttachment struct {
 	Kind     StorageKind `json:"kind"`
 	Location string      `json:"location"`
 	Life     life.Value  `json:"life"`
+
+	// Size is the size of the attached storage in MiB.
+	Size uint64 `json:"size,omitempty"`
 }
 
 // StorageAttachmentId identifies a storage attachment by the tags of the
@@ -204,6 +207,18 @@ type MachineStorageIds struct {
 type Volume struct {
 	VolumeTag string     `json:"volume-tag"`
 	Info      VolumeInfo `json:"info"`
+
+	// RequestedSize is the size, in MiB, to which the volume has been
+	// requested to grow, if there is an outstanding resize request.
+	RequestedSize uint64 `json:"requested-size,omitempty"`
+
+	// Provider is the type of the storage provider of the volume's pool.
+	// It is only set while there is an outstanding resize request.
+	Provider string `json:"provider,omitempty"`
+
+	// Attributes holds the configuration of the volume's pool. It is
+	// only set while there is an outstanding resize request.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // VolumeInfo describes a storage volume in the model.
@@ -278,6 +293,12 @@ type VolumeParams struct {
 	Attributes map[string]interface{}  `json:"attributes,omitempty"`
 	Tags       map[string]string       `json:"tags,omitempty"`
 	Attachment *VolumeAttachmentParams `json:"attachment,omitempty"`
+	SnapshotId string                  `json:"snapshot-id,omitempty"`
+
+	// Pool is the name of the storage pool from which the volume is
+	// allocated. It is empty if the volume was allocated directly
+	// from a storage provider.
+	Pool string `json:"pool,omitempty"`
 }
 
 // RemoveVolumeParams holds the parameters for destroying or releasing a
@@ -286,6 +307,13 @@ type RemoveVolumeParams struct {
 	// Provider is the storage provider that manages the volume.
 	Provider string `json:"provider"`
 
+	// Pool is the name of the storage pool from which the volume
+	// was allocated, if any.
+	Pool string `json:"pool,omitempty"`
+
+	// Attributes holds the configuration of the storage pool.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
 	// VolumeId is the storage provider's unique ID for the volume.
 	VolumeId string `json:"volume-id"`
 
@@ -303,6 +331,13 @@ type VolumeAttachmentParams struct {
 	InstanceId string `json:"instance-id,omitempty"`
 	Provider   string `json:"provider"`
 	ReadOnly   bool   `json:"read-only,omitempty"`
+
+	// Pool is the name of the storage pool from which the volume
+	// was allocated, if any.
+	Pool string `json:"pool,omitempty"`
+
+	// Attributes holds the configuration of the storage pool.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // VolumeAttachmentsResult holds the volume attachments for a single
@@ -862,6 +897,10 @@ type StorageDirectives struct {
 
 	// Count is the required number of storage instances.
 	Count *uint64 `json:"count,omitempty"`
+
+	// Snapshot, if non-empty, is the UUID of a storage snapshot
+	// from which to create the storage instances.
+	Snapshot string `json:"snapshot,omitempty"`
 }
 
 // StorageAddParams holds storage details to add to a unit dynamically.
@@ -911,6 +950,161 @@ type RemoveStorageInstance struct {
 	MaxWait *time.Duration `json:"max-wait,omitempty"`
 }
 
+// ResizeStorage holds the parameters for resizing storage instances.
+type ResizeStorage struct {
+	Storage []ResizeStorageInstance `json:"storage"`
+}
+
+// ResizeStorageInstance holds the parameters for resizing a storage instance.
+type ResizeStorageInstance struct {
+	// Tag is the tag of the storage instance to be resized.
+	Tag string `json:"tag"`
+
+	// Size is the new size of the storage instance in MiB. It must
+	// be greater than the storage instance's current size.
+	Size uint64 `json:"size"`
+}
+
+// StorageSnapshotDetails describes a snapshot of the volume
+// backing a storage instance.
+type StorageSnapshotDetails struct {
+	// UUID is the unique ID of the snapshot within the model.
+	UUID string `json:"uuid"`
+
+	// StorageTag is the tag of the storage instance that was snapshotted.
+	StorageTag string `json:"storage-tag"`
+
+	// Pool is the name of the storage pool of the snapshotted volume.
+	Pool string `json:"pool"`
+
+	// ProviderId is the storage provider's unique ID for the snapshot.
+	ProviderId string `json:"provider-id,omitempty"`
+
+	// Size is the size of the snapshot in MiB.
+	Size uint64 `json:"size"`
+
+	// Life is the lifecycle state of the snapshot.
+	Life life.Value `json:"life"`
+
+	// Status is the status of the snapshot.
+	Status string `json:"status"`
+
+	// Message is the status message of the snapshot, if any.
+	Message string `json:"message,omitempty"`
+
+	// Created is the time at which the snapshot was taken.
+	Created time.Time `json:"created"`
+}
+
+// StorageSnapshotResult holds the details of a storage snapshot,
+// or an error.
+type StorageSnapshotResult struct {
+	Result *StorageSnapshotDetails `json:"result,omitempty"`
+	Error  *Error                  `json:"error,omitempty"`
+}
+
+// StorageSnapshotResults holds a collection of StorageSnapshotResult.
+type StorageSnapshotResults struct {
+	Results []StorageSnapshotResult `json:"results"`
+}
+
+// StorageSnapshotFilter holds the filter for listing storage snapshots.
+type StorageSnapshotFilter struct {
+	// StorageTag is the tag of the storage instance whose snapshots are
+	// to be listed. If empty, the snapshots of all storage are listed.
+	StorageTag string `json:"storage-tag,omitempty"`
+}
+
+// StorageSnapshotFilters holds a collection of StorageSnapshotFilter.
+type StorageSnapshotFilters struct {
+	Filters []StorageSnapshotFilter `json:"filters,omitempty"`
+}
+
+// StorageSnapshotsResult holds the storage snapshots matching
+// a filter, or an error.
+type StorageSnapshotsResult struct {
+	Result []StorageSnapshotDetails `json:"result,omitempty"`
+	Error  *Error                   `json:"error,omitempty"`
+}
+
+// StorageSnapshotsResults holds a collection of StorageSnapshotsResult.
+type StorageSnapshotsResults struct {
+	Results []StorageSnapshotsResult `json:"results"`
+}
+
+// RemoveStorageSnapshots holds the UUIDs of storage snapshots to remove.
+type RemoveStorageSnapshots struct {
+	Snapshots []string `json:"snapshots"`
+}
+
+// MachineStorageSnapshot describes a snapshot of a machine-scoped
+// volume, which is taken and removed by the machine's storage
+// provisioner.
+type MachineStorageSnapshot struct {
+	// UUID is the unique ID of the snapshot within the model.
+	UUID string `json:"uuid"`
+
+	// VolumeTag is the tag of the volume to snapshot.
+	VolumeTag string `json:"volume-tag"`
+
+	// VolumeId is the provider's unique ID for the volume to snapshot.
+	VolumeId string `json:"volume-id"`
+
+	// Provider is the type of the storage provider of the volume.
+	Provider string `json:"provider"`
+
+	// Pool is the name of the storage pool of the volume, if any.
+	Pool string `json:"pool,omitempty"`
+
+	// Attributes holds the configuration of the storage pool of the
+	// volume.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	// ProviderId is the storage provider's unique ID for the snapshot,
+	// if it has been taken.
+	ProviderId string `json:"provider-id,omitempty"`
+
+	// Life is the lifecycle state of the snapshot.
+	Life life.Value `json:"life"`
+
+	// Status is the status of the snapshot.
+	Status string `json:"status"`
+}
+
+// MachineStorageSnapshotsResult holds the storage snapshots of a
+// machine, or an error.
+type MachineStorageSnapshotsResult struct {
+	Result []MachineStorageSnapshot `json:"result,omitempty"`
+	Error  *Error                   `json:"error,omitempty"`
+}
+
+// MachineStorageSnapshotsResults holds a collection of
+// MachineStorageSnapshotsResult.
+type MachineStorageSnapshotsResults struct {
+	Results []MachineStorageSnapshotsResult `json:"results"`
+}
+
+// StorageSnapshotInfo records the outcome of taking a machine-scoped
+// storage snapshot.
+type StorageSnapshotInfo struct {
+	// UUID is the unique ID of the snapshot within the model.
+	UUID string `json:"uuid"`
+
+	// ProviderId is the storage provider's unique ID for the snapshot.
+	ProviderId string `json:"provider-id,omitempty"`
+
+	// Size is the size of the snapshot in MiB.
+	Size uint64 `json:"size"`
+
+	// Error, if non-empty, is the reason the snapshot could not be taken.
+	Error string `json:"error,omitempty"`
+}
+
+// StorageSnapshotInfos holds a collection of StorageSnapshotInfo.
+type StorageSnapshotInfos struct {
+	Snapshots []StorageSnapshotInfo `json:"snapshots"`
+}
+
 // BulkImportStorageParams contains the parameters for importing a collection
 // of storage entities.
 type BulkImportStorageParams struct {
@@ -156,6 +156,10 @@ type FilesystemParams struct {
 
 	Pool string `bson:"pool"`
 	Size uint64 `bson:"size"`
+
+	// SnapshotId, if non-empty, is the provider ID of a volume
+	// snapshot from which to create the filesystem's backing volume.
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 // FilesystemInfo describes information about a filesystem.
@@ -1113,6 +1117,7 @@ func (sb *storageConfigBackend) addFilesystemOps(params FilesystemParams, hostId
 			params.volumeInfo,
 			params.Pool,
 			params.Size,
+			params.SnapshotId,
 		}
 		volumeOps, volumeTag, err = sb.addVolumeOps(volumeParams, hostId)
 		if err != nil {
@@ -1276,21 +1281,33 @@ func (sb *storageBackend) SetFilesystemInfo(tag names.FilesystemTag, info Filesy
 		// If the filesystem has parameters, unset them
 		// when we set info for the first time, ensuring
 		// that params and info are mutually exclusive.
-		var unsetParams bool
 		if params, ok := fs.Params(); ok {
 			info.Pool = params.Pool
-			unsetParams = true
-		} else {
-			// Ensure immutable properties do not change.
-			oldInfo, err := fs.Info()
+			return setFilesystemInfoOps(tag, info, true), nil
+		}
+		// Ensure immutable properties do not change.
+		oldInfo, err := fs.Info()
+		if err != nil {
+			return nil, err
+		}
+		if err := validateFilesystemInfoChange(info, oldInfo); err != nil {
+			return nil, err
+		}
+		ops := setFilesystemInfoOps(tag, info, false)
+		if info.Size == oldInfo.Size {
+			return ops, nil
+		}
+		// The filesystem has been resized, so notify the
+		// units the storage is attached to.
+		if storageTag, err := fs.Storage(); err == nil {
+			touchOps, err := sb.touchStorageAttachmentsOps(storageTag)
 			if err != nil {
-				return nil, err
-			}
-			if err := validateFilesystemInfoChange(info, oldInfo); err != nil {
-				return nil, err
+				return nil, errors.Trace(err)
 			}
+			ops = append(ops, touchOps...)
+		} else if !errors.Is(err, errors.NotAssigned) {
+			return nil, errors.Trace(err)
 		}
-		ops := setFilesystemInfoOps(tag, info, unsetParams)
 		return ops, nil
 	}
 	return sb.mb.db().Run(buildTxn)
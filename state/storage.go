@@ -245,8 +245,9 @@ type storageInstanceDoc struct {
 // storageInstanceConstraints contains a subset of StorageConstraints,
 // for a single storage instance.
 type storageInstanceConstraints struct {
-	Pool string `bson:"pool"`
-	Size uint64 `bson:"size"`
+	Pool       string `bson:"pool"`
+	Size       uint64 `bson:"size"`
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 type storageAttachment struct {
@@ -816,8 +817,9 @@ func createStorageOps(
 				Owner:       owner,
 				StorageName: t.storageName,
 				Constraints: storageInstanceConstraints{
-					Pool: cons.Pool,
-					Size: cons.Size,
+					Pool:       cons.Pool,
+					Size:       cons.Size,
+					SnapshotId: cons.SnapshotId,
 				},
 			}
 			var hostStorageOps []txn.Op
@@ -953,6 +955,27 @@ func (sb *storageBackend) StorageAttachments(storage names.StorageTag) ([]Storag
 	return attachments, nil
 }
 
+// touchStorageAttachmentsOps returns the operations required to notify the
+// watchers of the specified storage instance's attachments of a change to the
+// storage, such as its size. The attachment documents are left unchanged, but
+// updating them bumps the revision observed by the watchers.
+func (sb *storageBackend) touchStorageAttachmentsOps(storage names.StorageTag) ([]txn.Op, error) {
+	attachments, err := sb.StorageAttachments(storage)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ops := make([]txn.Op, len(attachments))
+	for i, a := range attachments {
+		ops[i] = txn.Op{
+			C:      storageAttachmentsC,
+			Id:     storageAttachmentId(a.Unit().Id(), storage.Id()),
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{{"storageid", storage.Id()}}}},
+		}
+	}
+	return ops, nil
+}
+
 // UnitStorageAttachments returns the StorageAttachments for the specified unit.
 func (sb *storageBackend) UnitStorageAttachments(unit names.UnitTag) ([]StorageAttachment, error) {
 	query := bson.D{{"unitid", unit.Id()}}
@@ -1676,6 +1699,10 @@ type StorageConstraints struct {
 
 	// Count is the required number of storage instances.
 	Count uint64 `bson:"count"`
+
+	// SnapshotId, if non-empty, is the provider ID of a volume
+	// snapshot from which to create the storage instances.
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 func createStorageConstraintsOp(key string, cons map[string]StorageConstraints) txn.Op {
@@ -1399,9 +1399,10 @@ func storageParamsForStorageInstance(
 			}
 		} else if errors.Is(err, errors.NotFound) {
 			filesystemParams := FilesystemParams{
-				storage: storage.StorageTag(),
-				Pool:    storage.doc.Constraints.Pool,
-				Size:    storage.doc.Constraints.Size,
+				storage:    storage.StorageTag(),
+				Pool:       storage.doc.Constraints.Pool,
+				Size:       storage.doc.Constraints.Size,
+				SnapshotId: storage.doc.Constraints.SnapshotId,
 			}
 			filesystems = append(filesystems, HostFilesystemParams{
 				filesystemParams, filesystemAttachmentParams,
@@ -1444,9 +1445,10 @@ func storageParamsForStorageInstance(
 			volumeAttachments[volume.VolumeTag()] = volumeAttachmentParams
 		} else if errors.Is(err, errors.NotFound) {
 			volumeParams := VolumeParams{
-				storage: storage.StorageTag(),
-				Pool:    storage.doc.Constraints.Pool,
-				Size:    storage.doc.Constraints.Size,
+				storage:    storage.StorageTag(),
+				Pool:       storage.doc.Constraints.Pool,
+				Size:       storage.doc.Constraints.Size,
+				SnapshotId: storage.doc.Constraints.SnapshotId,
 			}
 			volumes = append(volumes, HostVolumeParams{
 				volumeParams, volumeAttachmentParams,
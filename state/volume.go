@@ -52,6 +52,11 @@ type Volume interface {
 	// Releasing reports whether or not the volume is to be released
 	// from the model when it is Dying/Dead.
 	Releasing() bool
+
+	// RequestedSize returns the size, in MiB, to which the provisioned
+	// volume has been requested to grow. RequestedSize returns false if
+	// there is no outstanding resize request.
+	RequestedSize() (uint64, bool)
 }
 
 // VolumeAttachment describes an attachment of a volume to a machine.
@@ -132,6 +137,12 @@ type volumeDoc struct {
 	// the volume as being non-detachable, and to determine
 	// which volumes must be removed along with said machine.
 	HostId string `bson:"hostid,omitempty"`
+
+	// RequestedSize is the size, in MiB, to which a provisioned
+	// volume has been requested to grow by the storage provisioner
+	// responsible for it. It is cleared once the volume info
+	// records the new size.
+	RequestedSize uint64 `bson:"requestedsize,omitempty"`
 }
 
 // volumeAttachmentDoc records information about a volume attachment.
@@ -192,6 +203,10 @@ type VolumeParams struct {
 
 	Pool string `bson:"pool"`
 	Size uint64 `bson:"size"`
+
+	// SnapshotId, if non-empty, is the provider ID of a volume
+	// snapshot from which to create the volume.
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 // VolumeInfo describes information about a volume.
@@ -294,6 +309,14 @@ func (v *volume) Params() (VolumeParams, bool) {
 	return *v.doc.Params, true
 }
 
+// RequestedSize is required to implement Volume.
+func (v *volume) RequestedSize() (uint64, bool) {
+	if v.doc.RequestedSize == 0 {
+		return 0, false
+	}
+	return v.doc.RequestedSize, true
+}
+
 // Releasing is required to imeplement Volume.
 func (v *volume) Releasing() bool {
 	return v.doc.Releasing
@@ -1463,22 +1486,110 @@ func (sb *storageBackend) SetVolumeInfo(tag names.VolumeTag, info VolumeInfo) (e
 		if params, ok := v.Params(); ok {
 			info.Pool = params.Pool
 			unsetParams = true
-		} else {
-			// Ensure immutable properties do not change.
-			oldInfo, err := v.Info()
+			ops = append(ops, setVolumeInfoOps(tag, info, unsetParams)...)
+			return ops, nil
+		}
+		// Ensure immutable properties do not change.
+		oldInfo, err := v.Info()
+		if err != nil {
+			return nil, err
+		}
+		if info.Pool == "" {
+			// Storage provisioners do not know the pool
+			// when they update the info, e.g. on resize.
+			info.Pool = oldInfo.Pool
+		}
+		if err := validateVolumeInfoChange(info, oldInfo); err != nil {
+			return nil, err
+		}
+		ops = append(ops, setVolumeInfoOps(tag, info, unsetParams)...)
+		if info.Size == oldInfo.Size {
+			return ops, nil
+		}
+		// The volume has been resized. Clear any request that
+		// has now been satisfied, grow the filesystem backed
+		// by the volume, and notify the units the storage is
+		// attached to.
+		if requested, ok := v.RequestedSize(); ok && info.Size >= requested {
+			ops = append(ops, txn.Op{
+				C:      volumesC,
+				Id:     tag.Id(),
+				Assert: txn.DocExists,
+				Update: bson.D{{"$unset", bson.D{{"requestedsize", nil}}}},
+			})
+		}
+		resizeOps, err := sb.resizeVolumeFilesystemOps(tag, info.Size)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ops = append(ops, resizeOps...)
+		if storageTag, err := v.StorageInstance(); err == nil {
+			touchOps, err := sb.touchStorageAttachmentsOps(storageTag)
 			if err != nil {
-				return nil, err
-			}
-			if err := validateVolumeInfoChange(info, oldInfo); err != nil {
-				return nil, err
+				return nil, errors.Trace(err)
 			}
+			ops = append(ops, touchOps...)
+		} else if !errors.Is(err, errors.NotAssigned) {
+			return nil, errors.Trace(err)
 		}
-		ops = append(ops, setVolumeInfoOps(tag, info, unsetParams)...)
 		return ops, nil
 	}
 	return sb.mb.db().Run(buildTxn)
 }
 
+// resizeVolumeFilesystemOps returns the operations required to record the
+// new size of the filesystem backed by the specified volume, if there is
+// one and it has been provisioned.
+func (sb *storageBackend) resizeVolumeFilesystemOps(tag names.VolumeTag, size uint64) ([]txn.Op, error) {
+	fs, err := sb.volumeFilesystem(tag)
+	if errors.Is(err, errors.NotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if fs.doc.Info == nil || fs.doc.Info.Size == size {
+		return nil, nil
+	}
+	return []txn.Op{{
+		C:      filesystemsC,
+		Id:     fs.doc.FilesystemId,
+		Assert: bson.D{{"info", bson.D{{"$exists", true}}}},
+		Update: bson.D{{"$set", bson.D{{"info.size", size}}}},
+	}}, nil
+}
+
+// RequestVolumeResize records that the specified provisioned volume should
+// grow to the given size, in MiB. The storage provisioner responsible for the
+// volume is notified, and resizes the volume before recording its new info.
+func (sb *storageBackend) RequestVolumeResize(tag names.VolumeTag, size uint64) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot request resize of volume %q", tag.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		v, err := sb.Volume(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if v.Life() != Alive {
+			return nil, errors.New("volume is not alive")
+		}
+		info, err := v.Info()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if size <= info.Size {
+			return nil, errors.NotValidf(
+				"size %dMiB, must be greater than current size %dMiB", size, info.Size,
+			)
+		}
+		return []txn.Op{{
+			C:      volumesC,
+			Id:     tag.Id(),
+			Assert: append(isAliveDoc, bson.DocElem{"info.size", info.Size}),
+			Update: bson.D{{"$set", bson.D{{"requestedsize", size}}}},
+		}}, nil
+	}
+	return sb.mb.db().Run(buildTxn)
+}
+
 func validateVolumeInfoChange(newInfo, oldInfo VolumeInfo) error {
 	if newInfo.Pool != oldInfo.Pool {
 		return errors.Errorf(
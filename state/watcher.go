@@ -218,6 +218,22 @@ func (sb *storageBackend) WatchMachineVolumes(m names.MachineTag) StringsWatcher
 	return sb.watchHostStorage(m, volumesC)
 }
 
+// WatchMachineVolumeResizes returns a StringsWatcher that notifies of
+// changes to volumes scoped to the specified machine, so that the machine
+// storage provisioner can observe requests to resize them.
+func (sb *storageBackend) WatchMachineVolumeResizes(m names.MachineTag) StringsWatcher {
+	mb := sb.mb
+	matchExp := regexp.MustCompile(fmt.Sprintf("^%s/%s$", regexp.QuoteMeta(m.Id()), names.NumberSnippet))
+	filter := func(id interface{}) bool {
+		k, err := mb.strictLocalID(id.(string))
+		if err != nil {
+			return false
+		}
+		return matchExp.MatchString(k)
+	}
+	return newCollectionWatcher(mb, colWCfg{col: volumesC, filter: filter})
+}
+
 // WatchMachineFilesystems returns a StringsWatcher that notifies of changes
 // to the lifecycles of all filesystems scoped to the specified machine.
 func (sb *storageBackend) WatchMachineFilesystems(m names.MachineTag) StringsWatcher {